@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/usememos/memos/internal/doctor"
+	"github.com/usememos/memos/store"
+	"github.com/usememos/memos/store/db"
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check the data integrity of the instance and optionally repair it",
+	// The unrepaired issues fail the command, without its usage.
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		repair, err := cmd.Flags().GetBool("repair")
+		if err != nil {
+			return err
+		}
+		instanceProfile := newInstanceProfile()
+		if err := instanceProfile.Validate(); err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		dbDriver, err := db.NewDBDriver(instanceProfile)
+		if err != nil {
+			return fmt.Errorf("failed to create db driver: %w", err)
+		}
+		storeInstance := store.New(dbDriver, instanceProfile)
+		defer storeInstance.Close()
+		// The writes must invalidate the values cached in Redis by the running servers.
+		if err := useRedisCache(ctx, storeInstance, instanceProfile); err != nil {
+			return fmt.Errorf("failed to set up redis cache: %w", err)
+		}
+		if err := storeInstance.Migrate(ctx); err != nil {
+			return fmt.Errorf("failed to migrate: %w", err)
+		}
+
+		report, err := doctor.NewDoctor(instanceProfile, storeInstance).Check(ctx, repair)
+		if err != nil {
+			return err
+		}
+		if len(report.Issues) == 0 {
+			fmt.Println("No issues found.")
+			return nil
+		}
+
+		unrepaired := 0
+		for _, issue := range report.Issues {
+			state := "found"
+			if issue.Repaired {
+				state = "repaired"
+			} else {
+				unrepaired++
+			}
+			fmt.Printf("[%s] %s %s: %s\n", state, issue.Type, issue.Resource, issue.Description)
+		}
+		fmt.Printf("%d issue(s) found, %d repaired.\n", len(report.Issues), len(report.Issues)-unrepaired)
+		if unrepaired > 0 {
+			return fmt.Errorf("%d issue(s) not repaired", unrepaired)
+		}
+		return nil
+	},
+}
+
+func init() {
+	doctorCmd.Flags().Bool("repair", false, "repair the issues that can be fixed safely, the missing creators and attachment contents being only reported")
+	rootCmd.AddCommand(doctorCmd)
+}
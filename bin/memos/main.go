@@ -8,14 +8,18 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/usememos/memos/internal/profile"
 	"github.com/usememos/memos/internal/version"
+	"github.com/usememos/memos/plugin/cluster"
+	"github.com/usememos/memos/plugin/tracing"
 	"github.com/usememos/memos/server"
 	"github.com/usememos/memos/store"
+	"github.com/usememos/memos/store/cache"
 	"github.com/usememos/memos/store/db"
 )
 
@@ -28,6 +32,9 @@ const (
 ██║ ╚═╝ ██║███████╗██║ ╚═╝ ██║╚██████╔╝███████║
 ╚═╝     ╚═╝╚══════╝╚═╝     ╚═╝ ╚═════╝ ╚══════╝
 `
+
+	// migrationLockTTL bounds the time the other instances of a cluster wait for the migration of a crashed instance.
+	migrationLockTTL = 10 * time.Minute
 )
 
 var (
@@ -35,22 +42,24 @@ var (
 		Use:   "memos",
 		Short: `An open source, lightweight note-taking service. Easily capture and share your great thoughts.`,
 		Run: func(_ *cobra.Command, _ []string) {
-			instanceProfile := &profile.Profile{
-				Mode:        viper.GetString("mode"),
-				Addr:        viper.GetString("addr"),
-				Port:        viper.GetInt("port"),
-				UNIXSock:    viper.GetString("unix-sock"),
-				Data:        viper.GetString("data"),
-				Driver:      viper.GetString("driver"),
-				DSN:         viper.GetString("dsn"),
-				InstanceURL: viper.GetString("instance-url"),
-				Version:     version.GetCurrentVersion(viper.GetString("mode")),
-			}
+			instanceProfile := newInstanceProfile()
 			if err := instanceProfile.Validate(); err != nil {
 				panic(err)
 			}
+			slog.SetLogLoggerLevel(instanceProfile.GetLogLevel())
 
 			ctx, cancel := context.WithCancel(context.Background())
+			// Set up tracing before opening the database, so that its connections are traced.
+			shutdownTracing := func(context.Context) error { return nil }
+			if instanceProfile.OTLPEndpoint != "" {
+				shutdown, err := tracing.Setup(ctx, instanceProfile.OTLPEndpoint, instanceProfile.TraceSampleRatio, instanceProfile.Version)
+				if err != nil {
+					cancel()
+					slog.Error("failed to set up tracing", "error", err)
+					return
+				}
+				shutdownTracing = shutdown
+			}
 			dbDriver, err := db.NewDBDriver(instanceProfile)
 			if err != nil {
 				cancel()
@@ -59,7 +68,12 @@ var (
 			}
 
 			storeInstance := store.New(dbDriver, instanceProfile)
-			if err := storeInstance.Migrate(ctx); err != nil {
+			if err := useRedisCache(ctx, storeInstance, instanceProfile); err != nil {
+				cancel()
+				slog.Error("failed to set up redis cache", "error", err)
+				return
+			}
+			if err := migrateStore(ctx, storeInstance, instanceProfile); err != nil {
 				cancel()
 				slog.Error("failed to migrate", "error", err)
 				return
@@ -87,9 +101,21 @@ var (
 
 			printGreetings(instanceProfile)
 
+			// Reload the configuration on SIGHUP, without dropping the in-flight requests.
+			hup := make(chan os.Signal, 1)
+			signal.Notify(hup, syscall.SIGHUP)
+			go func() {
+				for range hup {
+					s.Reload(ctx)
+				}
+			}()
+
 			go func() {
 				<-c
 				s.Shutdown(ctx)
+				if err := shutdownTracing(ctx); err != nil {
+					slog.Error("failed to flush traces", "error", err)
+				}
 				cancel()
 			}()
 
@@ -103,6 +129,15 @@ func init() {
 	viper.SetDefault("mode", "dev")
 	viper.SetDefault("driver", "sqlite")
 	viper.SetDefault("port", 8081)
+	viper.SetDefault("trace-sample-ratio", 1.0)
+	viper.SetDefault("access-log-max-size", 100)
+	viper.SetDefault("access-log-max-backups", 5)
+	viper.SetDefault("shutdown-timeout", profile.DefaultShutdownTimeout)
+	viper.SetDefault("log-level", "info")
+	viper.SetDefault("redis-url", "")
+	viper.SetDefault("memo-list-cache-size", 0)
+	viper.SetDefault("cluster", false)
+	viper.SetDefault("hooks", "")
 
 	rootCmd.PersistentFlags().String("mode", "dev", `mode of server, can be "prod" or "dev" or "demo"`)
 	rootCmd.PersistentFlags().String("addr", "", "address of server")
@@ -112,6 +147,24 @@ func init() {
 	rootCmd.PersistentFlags().String("driver", "sqlite", "database driver")
 	rootCmd.PersistentFlags().String("dsn", "", "database source name(aka. DSN)")
 	rootCmd.PersistentFlags().String("instance-url", "", "the url of your memos instance")
+	rootCmd.PersistentFlags().String("ffmpeg-path", "", "path to the ffmpeg binary extracting video poster frames, disabled if empty")
+	rootCmd.PersistentFlags().String("pdftoppm-path", "", "path to the pdftoppm binary rendering PDF previews, disabled if empty")
+	rootCmd.PersistentFlags().String("smtp-addr", "", "address of the SMTP/LMTP server receiving the memos emailed by the users, disabled if empty")
+	rootCmd.PersistentFlags().String("smtp-domain", "", "domain of the addresses receiving the memos, the host of the instance URL if empty")
+	rootCmd.PersistentFlags().String("event-bus-url", "", `URL of the NATS server or Kafka brokers the events are published to, e.g. "nats://localhost:4222" or "kafka://localhost:9092", disabled if empty`)
+	rootCmd.PersistentFlags().String("event-bus-topic", "", `Kafka topic of the events, or prefix of their NATS subjects, "memos" if empty`)
+	rootCmd.PersistentFlags().String("websub-hub", "", `URL of the WebSub hub notified of the updates of the feeds, or "embedded" to serve a hub at /websub, disabled if empty`)
+	rootCmd.PersistentFlags().String("otlp-endpoint", "", `OTLP/HTTP endpoint of the collector the traces are exported to, e.g. "http://localhost:4318", disabled if empty`)
+	rootCmd.PersistentFlags().Float64("trace-sample-ratio", 1.0, "ratio of the traces started by the server which are sampled, between 0 and 1")
+	rootCmd.PersistentFlags().String("access-log", "", `path to the file the requests are logged to as JSON lines, or "stdout", disabled if empty`)
+	rootCmd.PersistentFlags().Int("access-log-max-size", 100, "size in megabytes the access log file is rotated at")
+	rootCmd.PersistentFlags().Int("access-log-max-backups", 5, "number of rotated access log files kept")
+	rootCmd.PersistentFlags().String("log-level", "info", `level of the server logs, "debug", "info", "warn" or "error", applied again on SIGHUP`)
+	rootCmd.PersistentFlags().String("redis-url", "", `URL of the Redis server caching the users, settings and public content, e.g. "redis://localhost:6379/0", cached in memory if empty`)
+	rootCmd.PersistentFlags().Int("memo-list-cache-size", 0, "number of the memo lists cached in memory for single-instance installs, disabled if 0")
+	rootCmd.PersistentFlags().Bool("cluster", false, "run as one of the instances sharing the database, the redis server and the data directory, see --redis-url")
+	rootCmd.PersistentFlags().String("hooks", "", "path of the JSON file declaring the hooks called on the events of the memos, disabled if empty")
+	rootCmd.PersistentFlags().Duration("shutdown-timeout", profile.DefaultShutdownTimeout, "time the in-flight requests and background runners are given to finish on shutdown")
 
 	if err := viper.BindPFlag("mode", rootCmd.PersistentFlags().Lookup("mode")); err != nil {
 		panic(err)
@@ -137,6 +190,60 @@ func init() {
 	if err := viper.BindPFlag("instance-url", rootCmd.PersistentFlags().Lookup("instance-url")); err != nil {
 		panic(err)
 	}
+	if err := viper.BindPFlag("ffmpeg-path", rootCmd.PersistentFlags().Lookup("ffmpeg-path")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("pdftoppm-path", rootCmd.PersistentFlags().Lookup("pdftoppm-path")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("smtp-addr", rootCmd.PersistentFlags().Lookup("smtp-addr")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("smtp-domain", rootCmd.PersistentFlags().Lookup("smtp-domain")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("event-bus-url", rootCmd.PersistentFlags().Lookup("event-bus-url")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("event-bus-topic", rootCmd.PersistentFlags().Lookup("event-bus-topic")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("websub-hub", rootCmd.PersistentFlags().Lookup("websub-hub")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("otlp-endpoint", rootCmd.PersistentFlags().Lookup("otlp-endpoint")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("trace-sample-ratio", rootCmd.PersistentFlags().Lookup("trace-sample-ratio")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("access-log", rootCmd.PersistentFlags().Lookup("access-log")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("access-log-max-size", rootCmd.PersistentFlags().Lookup("access-log-max-size")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("access-log-max-backups", rootCmd.PersistentFlags().Lookup("access-log-max-backups")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("shutdown-timeout", rootCmd.PersistentFlags().Lookup("shutdown-timeout")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("log-level", rootCmd.PersistentFlags().Lookup("log-level")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("redis-url", rootCmd.PersistentFlags().Lookup("redis-url")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("memo-list-cache-size", rootCmd.PersistentFlags().Lookup("memo-list-cache-size")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("cluster", rootCmd.PersistentFlags().Lookup("cluster")); err != nil {
+		panic(err)
+	}
+	if err := viper.BindPFlag("hooks", rootCmd.PersistentFlags().Lookup("hooks")); err != nil {
+		panic(err)
+	}
 
 	viper.SetEnvPrefix("memos")
 	viper.AutomaticEnv()
@@ -145,6 +252,64 @@ func init() {
 	}
 }
 
+func newInstanceProfile() *profile.Profile {
+	return &profile.Profile{
+		Mode:                viper.GetString("mode"),
+		Addr:                viper.GetString("addr"),
+		Port:                viper.GetInt("port"),
+		UNIXSock:            viper.GetString("unix-sock"),
+		Data:                viper.GetString("data"),
+		Driver:              viper.GetString("driver"),
+		DSN:                 viper.GetString("dsn"),
+		InstanceURL:         viper.GetString("instance-url"),
+		FFmpegPath:          viper.GetString("ffmpeg-path"),
+		PdftoppmPath:        viper.GetString("pdftoppm-path"),
+		SMTPAddr:            viper.GetString("smtp-addr"),
+		SMTPDomain:          viper.GetString("smtp-domain"),
+		EventBusURL:         viper.GetString("event-bus-url"),
+		EventBusTopic:       viper.GetString("event-bus-topic"),
+		WebSubHub:           viper.GetString("websub-hub"),
+		OTLPEndpoint:        viper.GetString("otlp-endpoint"),
+		TraceSampleRatio:    viper.GetFloat64("trace-sample-ratio"),
+		AccessLog:           viper.GetString("access-log"),
+		AccessLogMaxSize:    viper.GetInt("access-log-max-size"),
+		AccessLogMaxBackups: viper.GetInt("access-log-max-backups"),
+		ShutdownTimeout:     viper.GetDuration("shutdown-timeout"),
+		LogLevel:            viper.GetString("log-level"),
+		RedisURL:            viper.GetString("redis-url"),
+		MemoListCacheSize:   viper.GetInt("memo-list-cache-size"),
+		Cluster:             viper.GetBool("cluster"),
+		Hooks:               viper.GetString("hooks"),
+		Version:             version.GetCurrentVersion(viper.GetString("mode")),
+	}
+}
+
+// useRedisCache caches the users, the settings and the public content of the store in Redis, if configured.
+func useRedisCache(ctx context.Context, storeInstance *store.Store, instanceProfile *profile.Profile) error {
+	if instanceProfile.RedisURL == "" {
+		return nil
+	}
+	client, err := cache.NewRedisClient(ctx, instanceProfile.RedisURL)
+	if err != nil {
+		return err
+	}
+	storeInstance.UseRedisCache(client)
+	return nil
+}
+
+// migrateStore migrates the database, one instance at a time in cluster mode.
+func migrateStore(ctx context.Context, storeInstance *store.Store, instanceProfile *profile.Profile) error {
+	if !instanceProfile.Cluster {
+		return storeInstance.Migrate(ctx)
+	}
+	lock, err := cluster.New(storeInstance.GetRedisClient()).Lock(ctx, "migration", migrationLockTTL)
+	if err != nil {
+		return err
+	}
+	defer lock.Unlock(ctx)
+	return storeInstance.Migrate(ctx)
+}
+
 func printGreetings(profile *profile.Profile) {
 	if profile.IsDev() {
 		println("Development mode is enabled")
@@ -181,7 +346,8 @@ See more in:
 }
 
 func main() {
+	// The errors are printed by the commands.
 	if err := rootCmd.Execute(); err != nil {
-		panic(err)
+		os.Exit(1)
 	}
 }
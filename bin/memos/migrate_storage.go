@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+	"github.com/usememos/memos/store/db"
+)
+
+var migrateStorageCmd = &cobra.Command{
+	Use:   "migrate-storage",
+	Short: "Move the attachments to the storage of the workspace storage setting",
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		dryRun, err := cmd.Flags().GetBool("dry-run")
+		if err != nil {
+			return err
+		}
+		deleteSource, err := cmd.Flags().GetBool("delete-source")
+		if err != nil {
+			return err
+		}
+		instanceProfile := newInstanceProfile()
+		if err := instanceProfile.Validate(); err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+		dbDriver, err := db.NewDBDriver(instanceProfile)
+		if err != nil {
+			return fmt.Errorf("failed to create db driver: %w", err)
+		}
+		storeInstance := store.New(dbDriver, instanceProfile)
+		defer storeInstance.Close()
+		// The writes must invalidate the values cached in Redis by the running servers.
+		if err := useRedisCache(ctx, storeInstance, instanceProfile); err != nil {
+			return fmt.Errorf("failed to set up redis cache: %w", err)
+		}
+		if err := storeInstance.Migrate(ctx); err != nil {
+			return fmt.Errorf("failed to migrate: %w", err)
+		}
+
+		progress, err := apiv1.MigrateAttachmentStorage(ctx, instanceProfile, storeInstance, apiv1.AttachmentStorageMigrationOptions{
+			DryRun:       dryRun,
+			DeleteSource: deleteSource,
+		}, func(progress *apiv1.AttachmentStorageMigrationProgress) {
+			if !dryRun {
+				fmt.Printf("\rMigrated %d/%d attachment(s), %d failed.", progress.Migrated, progress.Total, len(progress.Failures))
+			}
+		})
+		if !dryRun {
+			fmt.Println()
+		}
+		if err != nil {
+			return err
+		}
+		if dryRun {
+			fmt.Printf("%d attachment(s) to migrate.\n", progress.Total)
+			return nil
+		}
+
+		for _, failure := range progress.Failures {
+			fmt.Printf("[failed] attachments/%s: %s\n", failure.AttachmentUID, failure.Error)
+		}
+		if len(progress.Failures) > 0 {
+			storeInstance.Close()
+			os.Exit(1)
+		}
+		return nil
+	},
+}
+
+func init() {
+	migrateStorageCmd.Flags().Bool("dry-run", false, "count the attachments to migrate without migrating them")
+	migrateStorageCmd.Flags().Bool("delete-source", false, "remove the content from its previous storage once migrated and verified")
+	rootCmd.AddCommand(migrateStorageCmd)
+}
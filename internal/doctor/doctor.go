@@ -0,0 +1,247 @@
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usememos/memos/internal/profile"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+// IssueType is the type of a data integrity issue.
+type IssueType string
+
+const (
+	// IssueMemoCreatorMissing is reported for memos whose creator no longer exists. It is only reported, as repairing it
+	// would delete the memos or give them to another user.
+	IssueMemoCreatorMissing IssueType = "MEMO_CREATOR_MISSING"
+	// IssueMemoRelationDangling is reported for relations pointing to a memo that no longer exists.
+	IssueMemoRelationDangling IssueType = "MEMO_RELATION_DANGLING"
+	// IssueAttachmentBlobMissing is reported for attachments whose content cannot be found. It is only reported.
+	IssueAttachmentBlobMissing IssueType = "ATTACHMENT_BLOB_MISSING"
+	// IssueMemoPayloadDrift is reported for memos whose payload does not match the content.
+	IssueMemoPayloadDrift IssueType = "MEMO_PAYLOAD_DRIFT"
+	// IssueAttachmentBlobCorrupted is reported for attachments whose content the integrity runner found not matching its hash.
+	// It is only reported.
+	IssueAttachmentBlobCorrupted IssueType = "ATTACHMENT_BLOB_CORRUPTED"
+)
+
+// Issue is a single data integrity issue.
+type Issue struct {
+	Type IssueType
+	// Resource is the name of the affected resource, e.g. "memos/abc".
+	Resource    string
+	Description string
+	// Repaired is true if the issue was fixed automatically.
+	Repaired bool
+}
+
+// Report is the result of a data integrity check.
+type Report struct {
+	Issues []*Issue
+}
+
+// batchSize is the number of memos and attachments loaded at once.
+const batchSize = 100
+
+type Doctor struct {
+	Store   *store.Store
+	Profile *profile.Profile
+}
+
+func NewDoctor(profile *profile.Profile, store *store.Store) *Doctor {
+	return &Doctor{
+		Store:   store,
+		Profile: profile,
+	}
+}
+
+// Check verifies the referential integrity of the data.
+// If repair is true, the issues that can be fixed safely are repaired, i.e. the dangling memo relations and the memo
+// payload drifts. The missing creators and the missing or corrupted attachment contents are only reported.
+func (d *Doctor) Check(ctx context.Context, repair bool) (*Report, error) {
+	report := &Report{}
+
+	users, err := d.Store.ListUsers(ctx, &store.FindUser{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list users")
+	}
+	userIDs := map[int32]bool{}
+	for _, user := range users {
+		userIDs[user.ID] = true
+	}
+
+	memoIDs := map[int32]bool{}
+	offset := 0
+	for {
+		limit := batchSize
+		memos, err := d.Store.ListMemos(ctx, &store.FindMemo{
+			Limit:  &limit,
+			Offset: &offset,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list memos")
+		}
+		if len(memos) == 0 {
+			break
+		}
+		for _, memo := range memos {
+			memoIDs[memo.ID] = true
+			if !userIDs[memo.CreatorID] {
+				report.Issues = append(report.Issues, &Issue{
+					Type:        IssueMemoCreatorMissing,
+					Resource:    fmt.Sprintf("memos/%s", memo.UID),
+					Description: fmt.Sprintf("creator %d does not exist", memo.CreatorID),
+				})
+			}
+			issue, err := d.checkMemoPayload(ctx, memo, repair)
+			if err != nil {
+				return nil, err
+			}
+			if issue != nil {
+				report.Issues = append(report.Issues, issue)
+			}
+		}
+		offset += len(memos)
+	}
+
+	relations, err := d.Store.ListMemoRelations(ctx, &store.FindMemoRelation{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memo relations")
+	}
+	for _, relation := range relations {
+		if memoIDs[relation.MemoID] && memoIDs[relation.RelatedMemoID] {
+			continue
+		}
+		issue := &Issue{
+			Type:        IssueMemoRelationDangling,
+			Resource:    fmt.Sprintf("memos/%d/relations/%d", relation.MemoID, relation.RelatedMemoID),
+			Description: fmt.Sprintf("%s relation points to a missing memo", relation.Type),
+		}
+		if repair {
+			if err := d.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{
+				MemoID:        &relation.MemoID,
+				RelatedMemoID: &relation.RelatedMemoID,
+				Type:          &relation.Type,
+			}); err != nil {
+				return nil, errors.Wrap(err, "failed to delete memo relation")
+			}
+			issue.Repaired = true
+		}
+		report.Issues = append(report.Issues, issue)
+	}
+
+	offset = 0
+	for {
+		limit := batchSize
+		attachments, err := d.Store.ListAttachments(ctx, &store.FindAttachment{
+			Limit:  &limit,
+			Offset: &offset,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list attachments")
+		}
+		if len(attachments) == 0 {
+			break
+		}
+		for _, attachment := range attachments {
+			missing, err := d.isAttachmentBlobMissing(ctx, attachment)
+			if err != nil {
+				return nil, err
+			}
+			if missing {
+				report.Issues = append(report.Issues, &Issue{
+					Type:        IssueAttachmentBlobMissing,
+					Resource:    fmt.Sprintf("attachments/%s", attachment.UID),
+					Description: fmt.Sprintf("content of %q cannot be found", attachment.Filename),
+				})
+			}
+			// The content is hashed by the integrity runner, as it reads every stored file.
+			if detectedTime := attachment.Payload.GetCorruptionDetectedTime(); detectedTime != nil {
+				report.Issues = append(report.Issues, &Issue{
+					Type:        IssueAttachmentBlobCorrupted,
+					Resource:    fmt.Sprintf("attachments/%s", attachment.UID),
+					Description: fmt.Sprintf("content of %q does not match its hash since %s", attachment.Filename, detectedTime.AsTime().Format(time.RFC3339)),
+				})
+			}
+		}
+		offset += len(attachments)
+	}
+
+	return report, nil
+}
+
+// checkMemoPayload compares the stored payload with the one derived from the memo content.
+func (d *Doctor) checkMemoPayload(ctx context.Context, memo *store.Memo, repair bool) (*Issue, error) {
+	original := proto.Clone(memo.Payload).(*storepb.MemoPayload)
+	if original == nil {
+		original = &storepb.MemoPayload{}
+	}
+	if err := memopayload.RebuildMemoPayload(memo); err != nil {
+		return nil, errors.Wrapf(err, "failed to rebuild payload of memo %s", memo.UID)
+	}
+
+	originalTags, rebuiltTags := slices.Clone(original.Tags), slices.Clone(memo.Payload.Tags)
+	slices.Sort(originalTags)
+	slices.Sort(rebuiltTags)
+	if slices.Equal(originalTags, rebuiltTags) && proto.Equal(original.GetProperty(), memo.Payload.Property) {
+		return nil, nil
+	}
+
+	issue := &Issue{
+		Type:        IssueMemoPayloadDrift,
+		Resource:    fmt.Sprintf("memos/%s", memo.UID),
+		Description: fmt.Sprintf("stored tags %v do not match content tags %v", originalTags, rebuiltTags),
+	}
+	if repair {
+		if err := d.Store.UpdateMemo(ctx, &store.UpdateMemo{
+			ID:      memo.ID,
+			Payload: memo.Payload,
+		}); err != nil {
+			return nil, errors.Wrap(err, "failed to update memo payload")
+		}
+		issue.Repaired = true
+	}
+	return issue, nil
+}
+
+func (d *Doctor) isAttachmentBlobMissing(ctx context.Context, attachment *store.Attachment) (bool, error) {
+	switch attachment.StorageType {
+	case storepb.AttachmentStorageType_LOCAL:
+		p := filepath.FromSlash(attachment.Reference)
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(d.Profile.Data, p)
+		}
+		if _, err := os.Stat(p); err != nil {
+			if os.IsNotExist(err) {
+				return true, nil
+			}
+			return false, errors.Wrapf(err, "failed to stat file of attachment %s", attachment.UID)
+		}
+		return false, nil
+	case storepb.AttachmentStorageType_S3, storepb.AttachmentStorageType_GCS, storepb.AttachmentStorageType_SFTP, storepb.AttachmentStorageType_EXTERNAL:
+		// Remote objects are not checked to avoid network calls for every attachment.
+		return false, nil
+	default:
+		if attachment.Size == 0 {
+			return false, nil
+		}
+		withBlob, err := d.Store.GetAttachment(ctx, &store.FindAttachment{
+			ID:      &attachment.ID,
+			GetBlob: true,
+		})
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to get blob of attachment %s", attachment.UID)
+		}
+		return withBlob == nil || len(withBlob.Blob) == 0, nil
+	}
+}
@@ -0,0 +1,39 @@
+// Package passwordpolicy checks the passwords set by the users, the API and the SCIM provisioning against
+// the password policy of the workspace.
+package passwordpolicy
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"unicode/utf8"
+
+	"github.com/usememos/memos/plugin/pwned"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// ViolationError is the error of a password which does not satisfy the policy, whose message is told to the user.
+type ViolationError struct {
+	Message string
+}
+
+func (e *ViolationError) Error() string {
+	return e.Message
+}
+
+// Check returns a *ViolationError if the password does not satisfy the policy. The breach check is best effort,
+// so that an outage of the range API does not lock the users out of their passwords.
+func Check(ctx context.Context, policy *storepb.WorkspacePasswordPolicySetting, password string) error {
+	if policy.GetMinLength() > 0 && utf8.RuneCountInString(password) < int(policy.GetMinLength()) {
+		return &ViolationError{Message: fmt.Sprintf("password must be at least %d characters long", policy.GetMinLength())}
+	}
+	if policy.GetCheckBreached() {
+		breached, err := pwned.NewChecker(policy.GetBreachCheckEndpoint()).IsBreached(ctx, password)
+		if err != nil {
+			slog.Warn("failed to check password against breaches", slog.Any("err", err))
+		} else if breached {
+			return &ViolationError{Message: "password has appeared in a data breach, choose another one"}
+		}
+	}
+	return nil
+}
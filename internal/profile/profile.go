@@ -3,10 +3,12 @@ package profile
 import (
 	"fmt"
 	"log/slog"
+	"net/url"
 	"os"
 	"path/filepath"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -32,12 +34,97 @@ type Profile struct {
 	Version string
 	// InstanceURL is the url of your memos instance.
 	InstanceURL string
+	// FFmpegPath is the path of the ffmpeg binary extracting the poster frames of videos.
+	// Poster frames are disabled if empty.
+	FFmpegPath string
+	// PdftoppmPath is the path of the pdftoppm binary of poppler rendering the previews of PDF documents.
+	// PDF previews are disabled if empty.
+	PdftoppmPath string
+	// SMTPAddr is the address of the SMTP server receiving the memos emailed by the users, which also speaks LMTP.
+	// Email-to-memo is disabled if empty.
+	SMTPAddr string
+	// SMTPDomain is the domain of the addresses receiving the memos, the host of InstanceURL if empty.
+	SMTPDomain string
+	// EventBusURL is the URL of the NATS server or the Kafka brokers the activities are published to as events,
+	// e.g. "nats://localhost:4222" or "kafka://localhost:9092". The event bus is disabled if empty.
+	EventBusURL string
+	// EventBusTopic is the Kafka topic of the events, or the prefix of their NATS subjects, "memos" if empty.
+	EventBusTopic string
+	// WebSubHub is the URL of the WebSub hub notified of the updates of the feeds, or "embedded" to serve a hub
+	// at /websub. WebSub is disabled if empty, or if InstanceURL is empty as the topics are absolute URLs.
+	WebSubHub string
+	// OTLPEndpoint is the OTLP/HTTP endpoint of the collector the traces are exported to, e.g. "http://localhost:4318".
+	// Tracing is disabled if empty.
+	OTLPEndpoint string
+	// TraceSampleRatio is the ratio of the traces started by the server which are sampled, between 0 and 1.
+	TraceSampleRatio float64
+	// AccessLog is the path of the file the requests are logged to as JSON lines, or "stdout".
+	// The access log is disabled if empty.
+	AccessLog string
+	// AccessLogMaxSize is the size in megabytes the file of the access log is rotated at.
+	AccessLogMaxSize int
+	// AccessLogMaxBackups is the number of the rotated files of the access log which are kept.
+	AccessLogMaxBackups int
+	// ShutdownTimeout is the time the in-flight requests and the background runners are given to finish on shutdown,
+	// DefaultShutdownTimeout if zero.
+	ShutdownTimeout time.Duration
+	// LogLevel is the level of the server logs, one of "debug", "info", "warn" and "error", "info" if empty.
+	// It is applied again when the configuration is reloaded.
+	LogLevel string
+	// RedisURL is the URL of the Redis server caching the users, the settings and the public content,
+	// shared by the instances of the server, e.g. "redis://localhost:6379/0". They are cached in memory if empty.
+	RedisURL string
+	// MemoListCacheSize is the number of the memo lists cached in memory, disabled if 0.
+	// The cache is only invalidated by the writes of the server, so it is meant for single-instance installs.
+	MemoListCacheSize int
+	// Cluster runs the server as one of the instances sharing the database, the Redis server and the data directory,
+	// which must be a shared volume if the attachments are stored locally, as the unfinished uploads are stored in it.
+	// The background runners are run by the elected leader, and the locks and rate limits are shared through Redis.
+	Cluster bool
+	// Hooks is the path of the JSON file declaring the hooks, the external processes or HTTP endpoints called on
+	// the events of the memos, see plugin/hook. The hooks are disabled if empty.
+	Hooks string
 }
 
+// DefaultShutdownTimeout is the default shutdown timeout, shorter than the grace period of Kubernetes before the pods are killed.
+const DefaultShutdownTimeout = 25 * time.Second
+
 func (p *Profile) IsDev() bool {
 	return p.Mode != "prod"
 }
 
+// GetSMTPDomain returns the domain of the addresses receiving the memos emailed by the users.
+func (p *Profile) GetSMTPDomain() string {
+	if p.SMTPDomain != "" {
+		return p.SMTPDomain
+	}
+	if instanceURL, err := url.Parse(p.InstanceURL); err == nil && instanceURL.Hostname() != "" {
+		return instanceURL.Hostname()
+	}
+	return "localhost"
+}
+
+// GetLogLevel returns the level of the server logs.
+func (p *Profile) GetLogLevel() slog.Level {
+	level, err := ParseLogLevel(p.LogLevel)
+	if err != nil {
+		return slog.LevelInfo
+	}
+	return level
+}
+
+// ParseLogLevel parses the level of the server logs, "info" if empty.
+func ParseLogLevel(level string) (slog.Level, error) {
+	if level == "" {
+		return slog.LevelInfo, nil
+	}
+	var logLevel slog.Level
+	if err := logLevel.UnmarshalText([]byte(level)); err != nil {
+		return slog.LevelInfo, errors.Errorf("invalid log level %q", level)
+	}
+	return logLevel, nil
+}
+
 func checkDataDir(dataDir string) (string, error) {
 	// Convert to absolute path if relative path is supplied.
 	if !filepath.IsAbs(dataDir) {
@@ -57,10 +144,36 @@ func checkDataDir(dataDir string) (string, error) {
 	return dataDir, nil
 }
 
+// validateCluster rejects the options keeping state in the memory of a single instance in cluster mode.
+func (p *Profile) validateCluster() error {
+	if !p.Cluster {
+		return nil
+	}
+	if p.RedisURL == "" {
+		return errors.New("cluster mode requires a redis server, see --redis-url")
+	}
+	if p.Driver == "sqlite" {
+		return errors.New("cluster mode requires a mysql or postgres database shared by the instances")
+	}
+	if p.MemoListCacheSize > 0 {
+		return errors.New("the memo list cache is not invalidated by the other instances in cluster mode")
+	}
+	if p.WebSubHub == "embedded" {
+		return errors.New("the embedded websub hub keeps its subscriptions in memory, use an external hub in cluster mode")
+	}
+	return nil
+}
+
 func (p *Profile) Validate() error {
 	if p.Mode != "demo" && p.Mode != "dev" && p.Mode != "prod" {
 		p.Mode = "demo"
 	}
+	if _, err := ParseLogLevel(p.LogLevel); err != nil {
+		return err
+	}
+	if err := p.validateCluster(); err != nil {
+		return err
+	}
 
 	if p.Mode == "prod" && p.Data == "" {
 		if runtime.GOOS == "windows" {
@@ -6,6 +6,7 @@ import (
 	"net/mail"
 	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/google/uuid"
 )
@@ -29,6 +30,28 @@ func HasPrefixes(src string, prefixes ...string) bool {
 	return false
 }
 
+// CollapseSpaces replaces the runs of white space, line breaks included, with single spaces, keeping a space at the ends
+// of the text where it separates the text from its siblings, e.g. for the text nodes of HTML documents.
+func CollapseSpaces(text string) string {
+	var collapsed strings.Builder
+	space := false
+	for _, r := range text {
+		if unicode.IsSpace(r) {
+			space = true
+			continue
+		}
+		if space {
+			collapsed.WriteByte(' ')
+			space = false
+		}
+		collapsed.WriteRune(r)
+	}
+	if space {
+		collapsed.WriteByte(' ')
+	}
+	return collapsed.String()
+}
+
 // ValidateEmail validates the email.
 func ValidateEmail(email string) bool {
 	if _, err := mail.ParseAddress(email); err != nil {
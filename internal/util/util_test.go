@@ -29,3 +29,20 @@ func TestValidateEmail(t *testing.T) {
 		}
 	}
 }
+
+func TestCollapseSpaces(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "", want: ""},
+		{text: " \n\t ", want: " "},
+		{text: "hello \n  world", want: "hello world"},
+		{text: "\n hello world  ", want: " hello world "},
+	}
+	for _, test := range tests {
+		if result := CollapseSpaces(test.text); result != test.want {
+			t.Errorf("CollapseSpaces %q: got result %q, want %q.", test.text, result, test.want)
+		}
+	}
+}
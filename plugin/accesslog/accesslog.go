@@ -0,0 +1,89 @@
+// Package accesslog writes the requests served by the server as JSON lines, to the standard output or to a file
+// rotated by size, separately from the logs of the server.
+package accesslog
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Stdout is the target of the access log writing the entries to the standard output.
+const Stdout = "stdout"
+
+// Entry is a request served by the server.
+type Entry struct {
+	Time time.Time `json:"time"`
+	// The HTTP method of the request, e.g. "GET".
+	Method string `json:"method"`
+	// The path of the request, without the query which may contain secrets.
+	Path string `json:"path"`
+	// The username of the authenticated user, or empty for the anonymous visitors.
+	User string `json:"user,omitempty"`
+	// The HTTP status code of the response.
+	Status int `json:"status"`
+	// The number of bytes of the response body.
+	Bytes int64 `json:"bytes"`
+	// The latency of the request in milliseconds.
+	LatencyMs float64 `json:"latencyMs"`
+	RemoteIP  string  `json:"remoteIp"`
+	UserAgent string  `json:"userAgent,omitempty"`
+}
+
+// Logger writes the entries of the access log.
+type Logger struct {
+	mu     sync.Mutex
+	writer io.WriteCloser
+}
+
+// NewLogger returns the logger writing to the target, either Stdout or the path of a file, which is rotated once
+// it reaches maxSize bytes, keeping maxBackups rotated files.
+func NewLogger(target string, maxSize int64, maxBackups int) (*Logger, error) {
+	if target == Stdout {
+		return &Logger{writer: nopCloser{os.Stdout}}, nil
+	}
+	writer, err := newRotatingFile(target, maxSize, maxBackups)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open access log")
+	}
+	return &Logger{writer: writer}, nil
+}
+
+// NewWriterLogger returns the logger writing to the writer, which is not rotated.
+func NewWriterLogger(writer io.Writer) *Logger {
+	return &Logger{writer: nopCloser{writer}}
+}
+
+// Log writes the entry as a JSON line.
+func (l *Logger) Log(entry *Entry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal access log entry")
+	}
+	line = append(line, '\n')
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, err := l.writer.Write(line); err != nil {
+		return errors.Wrap(err, "failed to write access log entry")
+	}
+	return nil
+}
+
+// Close closes the file of the access log.
+func (l *Logger) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.writer.Close()
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
@@ -0,0 +1,62 @@
+package accesslog
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoggerRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "access.log")
+	entry := &Entry{
+		Time:   time.Unix(1700000000, 0).UTC(),
+		Method: "GET",
+		Path:   "/api/v1/memos",
+		User:   "john",
+		Status: 200,
+		Bytes:  42,
+	}
+	line, err := json.Marshal(entry)
+	require.NoError(t, err)
+	lineSize := int64(len(line) + 1)
+
+	// Every file holds two entries at most, and two backups are kept.
+	logger, err := NewLogger(path, 2*lineSize, 2)
+	require.NoError(t, err)
+	for i := 0; i < 7; i++ {
+		require.NoError(t, logger.Log(entry))
+	}
+	require.NoError(t, logger.Close())
+
+	countLines := func(path string) int {
+		file, err := os.Open(path)
+		require.NoError(t, err)
+		defer file.Close()
+		lines := 0
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			got := &Entry{}
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), got))
+			require.Equal(t, entry, got)
+			lines++
+		}
+		return lines
+	}
+	require.Equal(t, 1, countLines(path))
+	require.Equal(t, 2, countLines(path+".1"))
+	require.Equal(t, 2, countLines(path+".2"))
+	_, err = os.Stat(path + ".3")
+	require.True(t, os.IsNotExist(err))
+
+	// The existing file is appended to after a restart.
+	logger, err = NewLogger(path, 2*lineSize, 2)
+	require.NoError(t, err)
+	require.NoError(t, logger.Log(entry))
+	require.NoError(t, logger.Close())
+	require.Equal(t, 2, countLines(path))
+}
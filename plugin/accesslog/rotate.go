@@ -0,0 +1,91 @@
+package accesslog
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// rotatingFile appends to a file, which is renamed with the suffix ".1" once it reaches its maximum size,
+// the previous backups being shifted to ".2", ".3" and so on, and removed beyond the maximum number of backups.
+type rotatingFile struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	file *os.File
+	size int64
+}
+
+func newRotatingFile(path string, maxSize int64, maxBackups int) (*rotatingFile, error) {
+	if maxSize <= 0 {
+		return nil, errors.New("the maximum size of the access log must be positive")
+	}
+	f := &rotatingFile{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *rotatingFile) Write(p []byte) (int, error) {
+	// A single line larger than the maximum size is still written to an empty file.
+	if f.size > 0 && f.size+int64(len(p)) > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+func (f *rotatingFile) Close() error {
+	return f.file.Close()
+}
+
+func (f *rotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0640)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+func (f *rotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return errors.Wrap(err, "failed to close access log")
+	}
+	if f.maxBackups > 0 {
+		if err := os.Remove(f.backupPath(f.maxBackups)); err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, "failed to remove access log backup")
+		}
+		for i := f.maxBackups - 1; i >= 1; i-- {
+			if err := os.Rename(f.backupPath(i), f.backupPath(i+1)); err != nil && !os.IsNotExist(err) {
+				return errors.Wrap(err, "failed to rename access log backup")
+			}
+		}
+		if err := os.Rename(f.path, f.backupPath(1)); err != nil {
+			return errors.Wrap(err, "failed to rename access log")
+		}
+	} else if err := os.Remove(f.path); err != nil {
+		return errors.Wrap(err, "failed to remove access log")
+	}
+	return f.open()
+}
+
+func (f *rotatingFile) backupPath(i int) string {
+	return fmt.Sprintf("%s.%d", f.path, i)
+}
@@ -0,0 +1,92 @@
+// Package captcha verifies the CAPTCHA tokens, with the siteverify API of Cloudflare Turnstile or hCaptcha,
+// or as the solutions of the built-in proof-of-work challenges.
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// TurnstileEndpoint is the siteverify API of Cloudflare Turnstile.
+	TurnstileEndpoint = "https://challenges.cloudflare.com/turnstile/v0/siteverify"
+	// HCaptchaEndpoint is the siteverify API of hCaptcha.
+	HCaptchaEndpoint = "https://api.hcaptcha.com/siteverify"
+)
+
+// timeout is the timeout of the siteverify requests.
+var timeout = 5 * time.Second
+
+// SiteVerifier verifies the tokens with a siteverify API, which Turnstile and hCaptcha share.
+type SiteVerifier struct {
+	endpoint  string
+	secretKey string
+	client    *http.Client
+}
+
+// NewSiteVerifier returns a verifier of the tokens of the site with the secret key, at the siteverify API of the endpoint.
+func NewSiteVerifier(endpoint, secretKey string) *SiteVerifier {
+	return &SiteVerifier{
+		endpoint:  strings.TrimSuffix(endpoint, "/"),
+		secretKey: secretKey,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+type siteVerifyResponse struct {
+	Success    bool     `json:"success"`
+	ErrorCodes []string `json:"error-codes"`
+}
+
+// Verify returns whether the token was issued to the site and not verified before.
+// The remote IP of the client is optional, checked by the provider if set.
+func (v *SiteVerifier) Verify(ctx context.Context, token, remoteIP string) (bool, error) {
+	form := url.Values{}
+	form.Set("secret", v.secretKey)
+	form.Set("response", token)
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, errors.Wrap(err, "failed to construct siteverify request")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to verify token with %s", v.endpoint)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, errors.Errorf("failed to verify token, status code: %d", resp.StatusCode)
+	}
+
+	result := &siteVerifyResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return false, errors.Wrap(err, "failed to decode siteverify response")
+	}
+	if !result.Success && isSiteVerifyConfigError(result.ErrorCodes) {
+		// The errors of the site configuration are not the fault of the client, so they are not reported as invalid tokens.
+		return false, errors.Errorf("failed to verify token, error codes: %s", strings.Join(result.ErrorCodes, ", "))
+	}
+	return result.Success, nil
+}
+
+// isSiteVerifyConfigError returns whether the error codes report a misconfigured secret key rather than an invalid token.
+func isSiteVerifyConfigError(errorCodes []string) bool {
+	for _, code := range errorCodes {
+		switch code {
+		case "missing-input-secret", "invalid-input-secret", "sitekey-secret-mismatch":
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package captcha
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSiteVerifier(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "secret", r.PostForm.Get("secret"))
+		switch r.PostForm.Get("response") {
+		case "valid":
+			require.Equal(t, "203.0.113.7", r.PostForm.Get("remoteip"))
+			fmt.Fprint(w, `{"success": true}`)
+		case "misconfigured":
+			fmt.Fprint(w, `{"success": false, "error-codes": ["invalid-input-secret"]}`)
+		default:
+			fmt.Fprint(w, `{"success": false, "error-codes": ["invalid-input-response"]}`)
+		}
+	}))
+	defer server.Close()
+
+	verifier := NewSiteVerifier(server.URL, "secret")
+	ok, err := verifier.Verify(context.Background(), "valid", "203.0.113.7")
+	require.NoError(t, err)
+	require.True(t, ok)
+	ok, err = verifier.Verify(context.Background(), "invalid", "")
+	require.NoError(t, err)
+	require.False(t, ok)
+	_, err = verifier.Verify(context.Background(), "misconfigured", "")
+	require.Error(t, err)
+}
+
+func TestProofOfWork(t *testing.T) {
+	key := []byte("key")
+	now := time.Now()
+	challenge, err := NewChallenge(key, now.Add(time.Minute))
+	require.NoError(t, err)
+
+	token := solve(challenge, 8)
+	solved, ok := VerifySolution(key, token, 8, now)
+	require.True(t, ok)
+	require.Equal(t, challenge, solved)
+
+	// The solution is rejected once the challenge expires, with another key or below the difficulty.
+	_, ok = VerifySolution(key, token, 8, now.Add(2*time.Minute))
+	require.False(t, ok)
+	_, ok = VerifySolution([]byte("another key"), token, 8, now)
+	require.False(t, ok)
+	_, ok = VerifySolution(key, token, 64, now)
+	require.False(t, ok)
+	_, ok = VerifySolution(key, challenge, 0, now)
+	require.False(t, ok)
+}
+
+func solve(challenge string, difficulty int) string {
+	for nonce := 0; ; nonce++ {
+		token := challenge + ":" + strconv.Itoa(nonce)
+		if LeadingZeroBits(sha256.Sum256([]byte(token))) >= difficulty {
+			return token
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package captcha
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// A challenge is its expiration in Unix seconds, a random salt and the HMAC of both, separated by dots,
+// so that the challenges need not be stored until they are solved.
+// The solution of a challenge is a nonce, such that the SHA-256 hash of the challenge, a colon and the nonce
+// starts with the required number of zero bits.
+
+// saltSize is the number of random bytes of a challenge.
+const saltSize = 16
+
+var encoding = base64.RawURLEncoding
+
+// NewChallenge returns a new challenge signed with the key, valid until the expiration.
+func NewChallenge(key []byte, expiresAt time.Time) (string, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", errors.Wrap(err, "failed to generate salt")
+	}
+	payload := strconv.FormatInt(expiresAt.Unix(), 10) + "." + hex.EncodeToString(salt)
+	return payload + "." + sign(key, payload), nil
+}
+
+// VerifySolution returns the challenge solved by the token, the challenge, a colon and the nonce, if the challenge
+// was signed with the key and has not expired, and the hash of the token starts with the difficulty zero bits.
+func VerifySolution(key []byte, token string, difficulty int, now time.Time) (string, bool) {
+	challenge, nonce, ok := strings.Cut(token, ":")
+	if !ok || nonce == "" {
+		return "", false
+	}
+	payload, signature, ok := cutLast(challenge, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(sign(key, payload))) {
+		return "", false
+	}
+	expiresAt, _, _ := strings.Cut(payload, ".")
+	expiresAtUnix, err := strconv.ParseInt(expiresAt, 10, 64)
+	if err != nil || now.Unix() > expiresAtUnix {
+		return "", false
+	}
+	if LeadingZeroBits(sha256.Sum256([]byte(token))) < difficulty {
+		return "", false
+	}
+	return challenge, true
+}
+
+// LeadingZeroBits returns the number of leading zero bits of the hash.
+func LeadingZeroBits(hash [sha256.Size]byte) int {
+	count := 0
+	for _, b := range hash {
+		if b != 0 {
+			return count + bits.LeadingZeros8(b)
+		}
+		count += 8
+	}
+	return count
+}
+
+func sign(key []byte, payload string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(payload))
+	return encoding.EncodeToString(mac.Sum(nil))
+}
+
+func cutLast(s, sep string) (string, string, bool) {
+	i := strings.LastIndex(s, sep)
+	if i < 0 {
+		return s, "", false
+	}
+	return s[:i], s[i+len(sep):], true
+}
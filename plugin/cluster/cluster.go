@@ -0,0 +1,101 @@
+// Package cluster coordinates the instances of the server sharing a database and a Redis server: the locks serialize
+// their changes to the shared state, the leader runs the background runners, and the counters replace the state kept
+// in memory by a single instance, e.g. the rate limits.
+package cluster
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+// keyPrefix is the prefix of the Redis keys of the cluster, apart from the ones of the caches.
+const keyPrefix = "memos:cluster:"
+
+// Cluster is the instance of the server in the cluster.
+type Cluster struct {
+	client redis.UniversalClient
+	// instanceID identifies the instance holding a lock.
+	instanceID string
+}
+
+// New returns the instance of the server in the cluster coordinated by the Redis server of the client.
+func New(client redis.UniversalClient) *Cluster {
+	hostname, _ := os.Hostname()
+	return &Cluster{
+		client:     client,
+		instanceID: hostname + "-" + randomString(),
+	}
+}
+
+// randomString returns 16 random hexadecimal digits.
+func randomString() string {
+	data := make([]byte, 8)
+	_, _ = rand.Read(data)
+	return hex.EncodeToString(data)
+}
+
+// InstanceID returns the identifier of the instance, its hostname followed by a random suffix.
+func (c *Cluster) InstanceID() string {
+	return c.instanceID
+}
+
+// Increment increments the counter of the key, which expires after the window since its last increment,
+// returning its new value.
+func (c *Cluster) Increment(ctx context.Context, key string, window time.Duration) (int64, error) {
+	pipeline := c.client.TxPipeline()
+	incr := pipeline.Incr(ctx, keyPrefix+key)
+	pipeline.PExpire(ctx, keyPrefix+key, window)
+	if _, err := pipeline.Exec(ctx); err != nil {
+		return 0, errors.Wrap(err, "failed to increment counter")
+	}
+	return incr.Val(), nil
+}
+
+// GetCounter returns the value of the counter of the key, 0 if expired.
+func (c *Cluster) GetCounter(ctx context.Context, key string) (int64, error) {
+	value, err := c.client.Get(ctx, keyPrefix+key).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get counter")
+	}
+	return value, nil
+}
+
+// DeleteCounter resets the counter of the key.
+func (c *Cluster) DeleteCounter(ctx context.Context, key string) error {
+	if err := c.client.Del(ctx, keyPrefix+key).Err(); err != nil {
+		return errors.Wrap(err, "failed to delete counter")
+	}
+	return nil
+}
+
+// Claim marks the key as claimed for the TTL, returning false if it already was, e.g. by another instance.
+func (c *Cluster) Claim(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	claimed, err := c.client.SetNX(ctx, keyPrefix+key, c.instanceID, ttl).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to claim key")
+	}
+	return claimed, nil
+}
+
+// Allow returns whether a request of the key is allowed by the rate limit per minute, shared by the instances.
+// The requests are counted in fixed windows of a minute, so the whole limit may be used at once.
+func (c *Cluster) Allow(ctx context.Context, key string, ratePerMinute int32) (bool, error) {
+	if ratePerMinute <= 0 {
+		return true, nil
+	}
+	window := time.Now().Unix() / 60
+	count, err := c.Increment(ctx, "rate:"+key+":"+time.Unix(window*60, 0).UTC().Format("200601021504"), time.Minute)
+	if err != nil {
+		return false, err
+	}
+	return count <= int64(ratePerMinute), nil
+}
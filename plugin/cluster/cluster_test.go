@@ -0,0 +1,149 @@
+package cluster
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestingCluster(t *testing.T, server *miniredis.Miniredis) *Cluster {
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+	return New(client)
+}
+
+func TestLock(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	instance, other := newTestingCluster(t, server), newTestingCluster(t, server)
+	require.NotEqual(t, instance.InstanceID(), other.InstanceID())
+
+	lock, acquired, err := instance.TryLock(ctx, "upload", time.Minute)
+	require.NoError(t, err)
+	require.True(t, acquired)
+	_, acquired, err = other.TryLock(ctx, "upload", time.Minute)
+	require.NoError(t, err)
+	require.False(t, acquired)
+	// The lock is also exclusive among the callers of the instance.
+	_, acquired, err = instance.TryLock(ctx, "upload", time.Minute)
+	require.NoError(t, err)
+	require.False(t, acquired)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	_, err = other.Lock(timeoutCtx, "upload", time.Minute)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	require.NoError(t, lock.Refresh(ctx))
+	require.NoError(t, lock.Unlock(ctx))
+	otherLock, err := other.Lock(ctx, "upload", time.Minute)
+	require.NoError(t, err)
+
+	// The expired lock taken over by another instance is not refreshed nor released.
+	server.FastForward(2 * time.Minute)
+	lock, err = instance.Lock(ctx, "upload", time.Minute)
+	require.NoError(t, err)
+	require.ErrorIs(t, otherLock.Refresh(ctx), ErrLockLost)
+	require.NoError(t, otherLock.Unlock(ctx))
+	require.NoError(t, lock.Refresh(ctx))
+}
+
+func TestCounters(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	instance, other := newTestingCluster(t, server), newTestingCluster(t, server)
+
+	count, err := instance.Increment(ctx, "failures", time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+	count, err = other.Increment(ctx, "failures", time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+	count, err = instance.GetCounter(ctx, "failures")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+	require.NoError(t, other.DeleteCounter(ctx, "failures"))
+	count, err = instance.GetCounter(ctx, "failures")
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	claimed, err := instance.Claim(ctx, "challenge", time.Minute)
+	require.NoError(t, err)
+	require.True(t, claimed)
+	claimed, err = other.Claim(ctx, "challenge", time.Minute)
+	require.NoError(t, err)
+	require.False(t, claimed)
+
+	// The rate limit is shared by the instances.
+	for i := 0; i < 3; i++ {
+		allowed, err := []*Cluster{instance, other}[i%2].Allow(ctx, "token", 3)
+		require.NoError(t, err)
+		require.True(t, allowed)
+	}
+	allowed, err := other.Allow(ctx, "token", 3)
+	require.NoError(t, err)
+	require.False(t, allowed)
+	allowed, err = other.Allow(ctx, "token", 0)
+	require.NoError(t, err)
+	require.True(t, allowed)
+}
+
+func TestRunAsLeader(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	instances := []*Cluster{newTestingCluster(t, server), newTestingCluster(t, server)}
+
+	var mu sync.Mutex
+	leaders := []string{}
+	running := 0
+	contexts := make([]context.Context, len(instances))
+	cancels := make([]context.CancelFunc, len(instances))
+	var wg sync.WaitGroup
+	for i, instance := range instances {
+		contexts[i], cancels[i] = context.WithCancel(ctx)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			instance.runAsLeader(contexts[i], "runners", func(ctx context.Context) {
+				mu.Lock()
+				leaders = append(leaders, instance.InstanceID())
+				running++
+				require.Equal(t, 1, running)
+				mu.Unlock()
+				<-ctx.Done()
+				mu.Lock()
+				running--
+				mu.Unlock()
+			}, 10*time.Millisecond, 10*time.Millisecond, time.Second)
+		}()
+	}
+	getLeaders := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string{}, leaders...)
+	}
+	require.Eventually(t, func() bool { return len(getLeaders()) == 1 }, time.Second, 10*time.Millisecond)
+	// The other instance keeps campaigning while the leader refreshes its leadership.
+	time.Sleep(100 * time.Millisecond)
+	require.Len(t, getLeaders(), 1)
+
+	// The leader stopping hands the leadership over to the other instance.
+	leader := 0
+	if getLeaders()[0] == instances[1].InstanceID() {
+		leader = 1
+	}
+	cancels[leader]()
+	require.Eventually(t, func() bool {
+		leaders := getLeaders()
+		return len(leaders) == 2 && leaders[1] == instances[1-leader].InstanceID()
+	}, time.Second, 10*time.Millisecond)
+
+	cancels[1-leader]()
+	wg.Wait()
+	require.Zero(t, running)
+}
@@ -0,0 +1,74 @@
+package cluster
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+const (
+	// leaderLockTTL is the time after which the leadership of a crashed instance is taken over by another.
+	leaderLockTTL = 15 * time.Second
+	// leaderRefreshInterval is the interval between the refreshes of the leadership by the leader.
+	leaderRefreshInterval = leaderLockTTL / 3
+	// leaderCampaignInterval is the interval between the attempts of the other instances to become the leader.
+	leaderCampaignInterval = leaderLockTTL / 3
+)
+
+// RunAsLeader runs the function while the instance is the leader of the name, until the context is done.
+// The context of the function is canceled when the leadership is lost, e.g. when Redis is unreachable, and the function
+// is run again once the instance is elected again, so that only one instance runs it at a time.
+func (c *Cluster) RunAsLeader(ctx context.Context, name string, fn func(ctx context.Context)) {
+	c.runAsLeader(ctx, name, fn, leaderRefreshInterval, leaderCampaignInterval, leaderLockTTL)
+}
+
+func (c *Cluster) runAsLeader(ctx context.Context, name string, fn func(ctx context.Context), refreshInterval, campaignInterval, ttl time.Duration) {
+	for {
+		lock, acquired, err := c.TryLock(ctx, "leader:"+name, ttl)
+		if err != nil && ctx.Err() == nil {
+			slog.Warn("failed to campaign for leadership", slog.String("name", name), slog.Any("error", err))
+		}
+		if acquired {
+			slog.Info("elected as leader", slog.String("name", name), slog.String("instance", c.instanceID))
+			c.lead(ctx, lock, fn, refreshInterval)
+			// The leadership is handed over at once on shutdown, instead of after its TTL.
+			if err := lock.Unlock(context.WithoutCancel(ctx)); err != nil {
+				slog.Warn("failed to resign leadership", slog.String("name", name), slog.Any("error", err))
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(campaignInterval):
+		}
+	}
+}
+
+// lead runs the function until the context is done or the lock is lost.
+func (*Cluster) lead(ctx context.Context, lock *Lock, fn func(ctx context.Context), refreshInterval time.Duration) {
+	leaderCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn(leaderCtx)
+	}()
+
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			if err := lock.Refresh(leaderCtx); err != nil {
+				if ctx.Err() == nil {
+					slog.Warn("lost leadership", slog.Any("error", err))
+				}
+				cancel()
+				<-done
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package cluster
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+// lockRetryInterval is the interval between the attempts to acquire a lock held by another instance.
+const lockRetryInterval = 50 * time.Millisecond
+
+// ErrLockLost is returned when refreshing a lock which expired, and may be held by another instance.
+var ErrLockLost = errors.New("lock lost")
+
+// refreshScript extends the TTL of the lock if it is still held by the instance.
+var refreshScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// unlockScript deletes the lock if it is still held by the instance.
+var unlockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// Lock is a lock held by the instance until it is released or expires.
+type Lock struct {
+	cluster *Cluster
+	key     string
+	// token identifies the holder of the lock, unique even among the locks of the instance.
+	token string
+	ttl   time.Duration
+}
+
+// TryLock acquires the lock of the name for the TTL, returning false if it is held by another instance.
+func (c *Cluster) TryLock(ctx context.Context, name string, ttl time.Duration) (*Lock, bool, error) {
+	key := keyPrefix + "lock:" + name
+	token := c.instanceID + "-" + randomString()
+	acquired, err := c.client.SetNX(ctx, key, token, ttl).Result()
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "failed to acquire lock %s", name)
+	}
+	if !acquired {
+		return nil, false, nil
+	}
+	return &Lock{cluster: c, key: key, token: token, ttl: ttl}, true, nil
+}
+
+// Lock waits until the lock of the name is acquired for the TTL, or the context is done.
+func (c *Cluster) Lock(ctx context.Context, name string, ttl time.Duration) (*Lock, error) {
+	for {
+		lock, acquired, err := c.TryLock(ctx, name, ttl)
+		if err != nil {
+			return nil, err
+		}
+		if acquired {
+			return lock, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, errors.Wrapf(ctx.Err(), "failed to acquire lock %s", name)
+		case <-time.After(lockRetryInterval):
+		}
+	}
+}
+
+// Refresh extends the TTL of the lock, returning ErrLockLost if it expired.
+func (l *Lock) Refresh(ctx context.Context) error {
+	refreshed, err := refreshScript.Run(ctx, l.cluster.client, []string{l.key}, l.token, l.ttl.Milliseconds()).Int()
+	if err != nil {
+		return errors.Wrap(err, "failed to refresh lock")
+	}
+	if refreshed == 0 {
+		return ErrLockLost
+	}
+	return nil
+}
+
+// Unlock releases the lock, unless it expired and is held by another instance.
+func (l *Lock) Unlock(ctx context.Context) error {
+	if err := unlockScript.Run(ctx, l.cluster.client, []string{l.key}, l.token).Err(); err != nil {
+		return errors.Wrap(err, "failed to release lock")
+	}
+	return nil
+}
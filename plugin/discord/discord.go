@@ -0,0 +1,278 @@
+// Package discord verifies the interactions of a Discord application and calls the Discord API for its bot.
+package discord
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultAPIEndpoint is the endpoint of the Discord API.
+	DefaultAPIEndpoint = "https://discord.com/api/v10"
+
+	// SignatureHeader and TimestampHeader are the headers of the signature of the interactions and of their timestamp.
+	SignatureHeader = "X-Signature-Ed25519"
+	TimestampHeader = "X-Signature-Timestamp"
+
+	// timeout is the timeout of the requests to the Discord API.
+	timeout = 10 * time.Second
+	// MaxMessageLength is the maximum number of characters of the content of a message.
+	MaxMessageLength = 2000
+)
+
+// The types of the interactions.
+const (
+	InteractionTypePing               = 1
+	InteractionTypeApplicationCommand = 2
+)
+
+// The types of the application commands: the slash commands and the commands of the context menu of the messages.
+const (
+	CommandTypeChatInput = 1
+	CommandTypeMessage   = 3
+)
+
+// The types of the options of the slash commands.
+const (
+	OptionTypeSubCommand = 1
+	OptionTypeString     = 3
+)
+
+// The types of the responses to the interactions.
+const (
+	ResponseTypePong                     = 1
+	ResponseTypeChannelMessageWithSource = 4
+)
+
+// MessageFlagEphemeral shows the responses to the interactions to their user only.
+const MessageFlagEphemeral = 1 << 6
+
+var (
+	ErrInvalidSignature = errors.New("invalid Discord signature")
+	// ErrUserNotLinked is returned by the handlers for the Discord users without a linked account.
+	ErrUserNotLinked = errors.New("Discord user is not linked")
+)
+
+// Handler saves the messages of the Discord users as memos.
+type Handler interface {
+	// SaveMessage saves the message as a memo of the account linked to the Discord user.
+	SaveMessage(ctx context.Context, userID string, message *Message) error
+}
+
+// Verify checks that the interaction of the headers and the body is signed by Discord with the hex public key of the application.
+func Verify(publicKey string, header http.Header, body []byte) error {
+	key, err := hex.DecodeString(publicKey)
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return errors.New("invalid Discord public key")
+	}
+	signature, err := hex.DecodeString(header.Get(SignatureHeader))
+	if err != nil || len(signature) != ed25519.SignatureSize {
+		return ErrInvalidSignature
+	}
+	message := append([]byte(header.Get(TimestampHeader)), body...)
+	if !ed25519.Verify(key, message, signature) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+type User struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+	Bot      bool   `json:"bot,omitempty"`
+}
+
+type Message struct {
+	ID        string `json:"id"`
+	ChannelID string `json:"channel_id"`
+	GuildID   string `json:"guild_id,omitempty"`
+	Content   string `json:"content"`
+	Author    *User  `json:"author,omitempty"`
+}
+
+// Link returns the link of the message in Discord.
+func (m *Message) Link() string {
+	guildID := m.GuildID
+	if guildID == "" {
+		guildID = "@me"
+	}
+	return fmt.Sprintf("https://discord.com/channels/%s/%s/%s", guildID, m.ChannelID, m.ID)
+}
+
+// Command is an application command, registered for the users of the application.
+type Command struct {
+	Name        string           `json:"name"`
+	Type        int              `json:"type"`
+	Description string           `json:"description,omitempty"`
+	Options     []*CommandOption `json:"options,omitempty"`
+}
+
+type CommandOption struct {
+	Name        string           `json:"name"`
+	Type        int              `json:"type"`
+	Description string           `json:"description"`
+	Required    bool             `json:"required,omitempty"`
+	Options     []*CommandOption `json:"options,omitempty"`
+}
+
+// Interaction is an interaction of a user with the application, e.g. a command.
+type Interaction struct {
+	Type      int              `json:"type"`
+	GuildID   string           `json:"guild_id,omitempty"`
+	ChannelID string           `json:"channel_id,omitempty"`
+	Data      *InteractionData `json:"data,omitempty"`
+	// Member is the member sending the interaction in a guild, and User the user sending it in a direct message.
+	Member *struct {
+		User *User `json:"user"`
+	} `json:"member,omitempty"`
+	User *User `json:"user,omitempty"`
+}
+
+// UserID returns the ID of the user sending the interaction.
+func (i *Interaction) UserID() string {
+	if i.Member != nil && i.Member.User != nil {
+		return i.Member.User.ID
+	}
+	if i.User != nil {
+		return i.User.ID
+	}
+	return ""
+}
+
+type InteractionData struct {
+	Name     string               `json:"name"`
+	Type     int                  `json:"type"`
+	Options  []*InteractionOption `json:"options,omitempty"`
+	TargetID string               `json:"target_id,omitempty"`
+	Resolved *struct {
+		Messages map[string]*Message `json:"messages"`
+	} `json:"resolved,omitempty"`
+}
+
+// InteractionOption is an option of a slash command, with the options of its sub-command.
+type InteractionOption struct {
+	Name    string               `json:"name"`
+	Type    int                  `json:"type"`
+	Value   any                  `json:"value,omitempty"`
+	Options []*InteractionOption `json:"options,omitempty"`
+}
+
+// GetString returns the string value of the option of the name, empty if there is none.
+func GetString(options []*InteractionOption, name string) string {
+	for _, option := range options {
+		if option.Name == name {
+			value, _ := option.Value.(string)
+			return value
+		}
+	}
+	return ""
+}
+
+// InteractionResponse is the response to an interaction.
+type InteractionResponse struct {
+	Type int                      `json:"type"`
+	Data *InteractionResponseData `json:"data,omitempty"`
+}
+
+type InteractionResponseData struct {
+	Content string `json:"content,omitempty"`
+	Flags   int    `json:"flags,omitempty"`
+}
+
+// Client calls the Discord API as the bot.
+type Client struct {
+	Token string
+	// APIEndpoint is the endpoint of the Discord API, DefaultAPIEndpoint if empty.
+	APIEndpoint string
+}
+
+// RegisterCommands replaces the commands of the application with the commands.
+func (c *Client) RegisterCommands(ctx context.Context, applicationID string, commands []*Command) error {
+	return c.call(ctx, http.MethodPut, fmt.Sprintf("/applications/%s/commands", url.PathEscape(applicationID)), commands, nil)
+}
+
+// GetMessage returns the message of the channel.
+func (c *Client) GetMessage(ctx context.Context, channelID, messageID string) (*Message, error) {
+	message := &Message{}
+	if err := c.call(ctx, http.MethodGet, fmt.Sprintf("/channels/%s/messages/%s", url.PathEscape(channelID), url.PathEscape(messageID)), nil, message); err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// CreateMessage posts a message of the content to the channel, without notifying the users it mentions.
+func (c *Client) CreateMessage(ctx context.Context, channelID, content string) error {
+	return c.call(ctx, http.MethodPost, fmt.Sprintf("/channels/%s/messages", url.PathEscape(channelID)), map[string]any{
+		"content":          content,
+		"allowed_mentions": map[string]any{"parse": []string{}},
+	}, nil)
+}
+
+// getGatewayURL returns the URL of the gateway for the bot.
+func (c *Client) getGatewayURL(ctx context.Context) (string, error) {
+	gateway := struct {
+		URL string `json:"url"`
+	}{}
+	if err := c.call(ctx, http.MethodGet, "/gateway/bot", nil, &gateway); err != nil {
+		return "", err
+	}
+	if gateway.URL == "" {
+		return "", errors.New("empty Discord gateway URL")
+	}
+	return gateway.URL, nil
+}
+
+// call sends the request of the path with the JSON body if not nil, and decodes its JSON response into the result if not nil.
+func (c *Client) call(ctx context.Context, method, path string, body, result any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal Discord request")
+		}
+		reader = bytes.NewReader(data)
+	}
+	endpoint := c.APIEndpoint
+	if endpoint == "" {
+		endpoint = DefaultAPIEndpoint
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(endpoint, "/")+path, reader)
+	if err != nil {
+		return errors.Wrap(err, "failed to construct Discord request")
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Authorization", "Bot "+c.Token)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to call Discord API")
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return errors.Wrap(err, "failed to read Discord response")
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("Discord %s %s failed with status %d: %s", method, path, resp.StatusCode, respBody)
+	}
+	if result != nil {
+		if err := json.Unmarshal(respBody, result); err != nil {
+			return errors.Wrap(err, "failed to unmarshal Discord response")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package discord
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"nhooyr.io/websocket"
+	"nhooyr.io/websocket/wsjson"
+)
+
+func TestVerify(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	body := []byte(`{"type":1}`)
+	header := http.Header{}
+	header.Set(TimestampHeader, "1700000000")
+	header.Set(SignatureHeader, hex.EncodeToString(ed25519.Sign(privateKey, append([]byte("1700000000"), body...))))
+	require.NoError(t, Verify(hex.EncodeToString(publicKey), header, body))
+
+	require.ErrorIs(t, Verify(hex.EncodeToString(publicKey), header, []byte(`{"type":2}`)), ErrInvalidSignature)
+	anotherKey, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	require.ErrorIs(t, Verify(hex.EncodeToString(anotherKey), header, body), ErrInvalidSignature)
+	require.Error(t, Verify("invalid", header, body))
+}
+
+func TestGetString(t *testing.T) {
+	interaction := &Interaction{}
+	require.NoError(t, json.Unmarshal([]byte(`{"type":2,"member":{"user":{"id":"42"}},"data":{"name":"memo","options":[{"name":"save","type":1,"options":[{"name":"content","type":3,"value":"Hello"}]}]}}`), interaction))
+	require.Equal(t, "42", interaction.UserID())
+	require.Equal(t, "Hello", GetString(interaction.Data.Options[0].Options, "content"))
+	require.Empty(t, GetString(interaction.Data.Options[0].Options, "code"))
+}
+
+func TestConnectGateway(t *testing.T) {
+	var server *httptest.Server
+	identified := make(chan map[string]any, 1)
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/gateway/bot" {
+			require.Equal(t, "Bot token", r.Header.Get("Authorization"))
+			fmt.Fprintf(w, `{"url": %q}`, "ws"+strings.TrimPrefix(server.URL, "http")+"/gateway")
+			return
+		}
+		conn, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.CloseNow()
+		ctx := r.Context()
+		_ = wsjson.Write(ctx, conn, map[string]any{"op": opHello, "d": map[string]any{"heartbeat_interval": 45000}})
+		identify := struct {
+			Op   int            `json:"op"`
+			Data map[string]any `json:"d"`
+		}{}
+		if err := wsjson.Read(ctx, conn, &identify); err != nil || identify.Op != opIdentify {
+			return
+		}
+		identified <- identify.Data
+		_ = wsjson.Write(ctx, conn, map[string]any{"op": opDispatch, "s": 1, "t": "READY", "d": map[string]any{}})
+		_ = wsjson.Write(ctx, conn, map[string]any{"op": opDispatch, "s": 2, "t": EventMessageReactionAdd, "d": map[string]any{
+			"user_id": "42", "channel_id": "1", "message_id": "2", "emoji": map[string]any{"name": "📝"},
+		}})
+		_ = wsjson.Write(ctx, conn, map[string]any{"op": opReconnect})
+		_, _, _ = conn.Read(ctx)
+	}))
+	defer server.Close()
+
+	client := &Client{Token: "token", APIEndpoint: server.URL}
+	events := []*ReactionEvent{}
+	err := client.ConnectGateway(context.Background(), IntentGuildMessageReactions, func(_ context.Context, eventType string, data json.RawMessage) {
+		if eventType != EventMessageReactionAdd {
+			return
+		}
+		event := &ReactionEvent{}
+		require.NoError(t, json.Unmarshal(data, event))
+		events = append(events, event)
+	})
+	require.ErrorContains(t, err, "reconnection")
+	identify := <-identified
+	require.Equal(t, "token", identify["token"])
+	require.Equal(t, float64(IntentGuildMessageReactions), identify["intents"])
+	require.Len(t, events, 1)
+	require.Equal(t, "42", events[0].UserID)
+	require.Equal(t, "📝", events[0].Emoji.Name)
+}
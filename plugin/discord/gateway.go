@@ -0,0 +1,191 @@
+package discord
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"nhooyr.io/websocket"
+	"nhooyr.io/websocket/wsjson"
+)
+
+// The intents of the gateway events received by the bot.
+const (
+	IntentGuildMessageReactions  = 1 << 10
+	IntentDirectMessageReactions = 1 << 13
+)
+
+// The opcodes of the gateway payloads.
+const (
+	opDispatch       = 0
+	opHeartbeat      = 1
+	opIdentify       = 2
+	opReconnect      = 7
+	opInvalidSession = 9
+	opHello          = 10
+	opHeartbeatACK   = 11
+)
+
+// maxGatewayPayloadSize is the maximum size of the gateway payloads, some of which describe whole guilds.
+const maxGatewayPayloadSize = 16 << 20
+
+// EventMessageReactionAdd is the gateway event of a reaction added to a message.
+const EventMessageReactionAdd = "MESSAGE_REACTION_ADD"
+
+// ReactionEvent is the data of the EventMessageReactionAdd events.
+type ReactionEvent struct {
+	UserID    string `json:"user_id"`
+	ChannelID string `json:"channel_id"`
+	MessageID string `json:"message_id"`
+	GuildID   string `json:"guild_id,omitempty"`
+	Emoji     struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"emoji"`
+	Member *struct {
+		User *User `json:"user"`
+	} `json:"member,omitempty"`
+}
+
+type gatewayPayload struct {
+	Op       int             `json:"op"`
+	Data     json.RawMessage `json:"d,omitempty"`
+	Sequence *int64          `json:"s,omitempty"`
+	Type     string          `json:"t,omitempty"`
+}
+
+// EventHandler handles the dispatched gateway events, of their type and with their data.
+type EventHandler func(ctx context.Context, eventType string, data json.RawMessage)
+
+// ConnectGateway connects the bot to the gateway with the intents, and dispatches its events to the handler
+// until the context is done or the connection fails. The sessions are not resumed: the callers reconnect,
+// missing the events in between.
+func (c *Client) ConnectGateway(ctx context.Context, intents int, handle EventHandler) error {
+	gatewayURL, err := c.getGatewayURL(ctx)
+	if err != nil {
+		return err
+	}
+	conn, _, err := websocket.Dial(ctx, gatewayURL+"?v=10&encoding=json", nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to connect to Discord gateway")
+	}
+	defer conn.CloseNow()
+	conn.SetReadLimit(maxGatewayPayloadSize)
+
+	hello := &gatewayPayload{}
+	if err := wsjson.Read(ctx, conn, hello); err != nil {
+		return errors.Wrap(err, "failed to read Discord gateway hello")
+	}
+	helloData := struct {
+		HeartbeatInterval int64 `json:"heartbeat_interval"`
+	}{}
+	if hello.Op != opHello || json.Unmarshal(hello.Data, &helloData) != nil || helloData.HeartbeatInterval <= 0 {
+		return errors.Errorf("unexpected Discord gateway hello with opcode %d", hello.Op)
+	}
+	if err := writeGatewayPayload(ctx, conn, opIdentify, map[string]any{
+		"token":   c.Token,
+		"intents": intents,
+		"properties": map[string]string{
+			"os":      "linux",
+			"browser": "memos",
+			"device":  "memos",
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to identify to Discord gateway")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	heartbeat := &gatewayHeartbeat{acknowledged: true}
+	go heartbeat.run(ctx, conn, time.Duration(helloData.HeartbeatInterval)*time.Millisecond)
+
+	for {
+		payload := &gatewayPayload{}
+		if err := wsjson.Read(ctx, conn, payload); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return errors.Wrap(err, "failed to read Discord gateway payload")
+		}
+		switch payload.Op {
+		case opDispatch:
+			if payload.Sequence != nil {
+				heartbeat.setSequence(*payload.Sequence)
+			}
+			handle(ctx, payload.Type, payload.Data)
+		case opHeartbeat:
+			if err := heartbeat.send(ctx, conn); err != nil {
+				return err
+			}
+		case opHeartbeatACK:
+			heartbeat.acknowledge()
+		case opReconnect:
+			return errors.New("Discord gateway requested a reconnection")
+		case opInvalidSession:
+			return errors.New("Discord gateway session is invalid")
+		}
+	}
+}
+
+// gatewayHeartbeat sends the heartbeats of a connection with the last sequence number, and closes it
+// when the previous heartbeat is not acknowledged, as the connection is then zombied.
+type gatewayHeartbeat struct {
+	mu           sync.Mutex
+	sequence     *int64
+	acknowledged bool
+}
+
+func (h *gatewayHeartbeat) run(ctx context.Context, conn *websocket.Conn, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			h.mu.Lock()
+			acknowledged := h.acknowledged
+			h.acknowledged = false
+			h.mu.Unlock()
+			if !acknowledged {
+				_ = conn.Close(websocket.StatusCode(4000), "heartbeat not acknowledged")
+				return
+			}
+			if err := h.send(ctx, conn); err != nil {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (h *gatewayHeartbeat) send(ctx context.Context, conn *websocket.Conn) error {
+	h.mu.Lock()
+	sequence := h.sequence
+	h.mu.Unlock()
+	if err := writeGatewayPayload(ctx, conn, opHeartbeat, sequence); err != nil {
+		return errors.Wrap(err, "failed to send Discord gateway heartbeat")
+	}
+	return nil
+}
+
+func (h *gatewayHeartbeat) setSequence(sequence int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.sequence = &sequence
+}
+
+func (h *gatewayHeartbeat) acknowledge() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.acknowledged = true
+}
+
+func writeGatewayPayload(ctx context.Context, conn *websocket.Conn, op int, data any) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return wsjson.Write(ctx, conn, &gatewayPayload{Op: op, Data: raw})
+}
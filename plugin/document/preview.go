@@ -0,0 +1,44 @@
+// Package document renders the previews of documents, shown in place of generic icons in note lists.
+package document
+
+import (
+	"bytes"
+	"context"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// timeout is the timeout of the rendering of a preview.
+var timeout = time.Minute
+
+// previewPixels is the size of the square the preview fits in.
+const previewPixels = 1280
+
+// RenderPDFPreview returns a JPEG image of the first page of the PDF file,
+// running the pdftoppm binary of poppler at the path.
+// Reference: https://poppler.freedesktop.org
+func RenderPDFPreview(ctx context.Context, pdftoppmPath, pdfPath string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Without an output root, the single page is written to the standard output.
+	cmd := exec.CommandContext(ctx, pdftoppmPath,
+		"-f", "1", "-l", "1", "-singlefile",
+		"-scale-to", strconv.Itoa(previewPixels), "-jpeg",
+		pdfPath,
+	)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Wrapf(err, "failed to run pdftoppm: %s", strings.TrimSpace(stderr.String()))
+	}
+	if stdout.Len() == 0 {
+		return nil, errors.New("no page found in the document")
+	}
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,30 @@
+package document
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderPDFPreview(t *testing.T) {
+	dir := t.TempDir()
+
+	// A fake pdftoppm echoing its arguments.
+	pdftoppmPath := filepath.Join(dir, "pdftoppm")
+	require.NoError(t, os.WriteFile(pdftoppmPath, []byte("#!/bin/sh\necho \"$@\"\n"), 0o755))
+	preview, err := RenderPDFPreview(context.Background(), pdftoppmPath, "document.pdf")
+	require.NoError(t, err)
+	require.Equal(t, "-f 1 -l 1 -singlefile -scale-to 1280 -jpeg document.pdf\n", string(preview))
+
+	// A fake pdftoppm outputting nothing, as for documents without pages.
+	require.NoError(t, os.WriteFile(pdftoppmPath, []byte("#!/bin/sh\n"), 0o755))
+	_, err = RenderPDFPreview(context.Background(), pdftoppmPath, "document.pdf")
+	require.Error(t, err)
+
+	require.NoError(t, os.WriteFile(pdftoppmPath, []byte("#!/bin/sh\necho Syntax Error >&2\nexit 1\n"), 0o755))
+	_, err = RenderPDFPreview(context.Background(), pdftoppmPath, "document.pdf")
+	require.ErrorContains(t, err, "Syntax Error")
+}
@@ -0,0 +1,141 @@
+// Package email sends the emails of the workspace, e.g. for the email verification and the password reset, with SMTP.
+package email
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// timeout is the timeout of the connection to the SMTP server.
+var timeout = 10 * time.Second
+
+// Config is the configuration of the SMTP server sending the emails.
+type Config struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	// FromEmail and FromName form the sender of the emails.
+	FromEmail string
+	FromName  string
+	// TLS connects with implicit TLS, e.g. on the port 465, instead of upgrading the connection with STARTTLS when offered.
+	TLS bool
+}
+
+// Message is a plain text email.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Send sends the message with the SMTP server of the config.
+func Send(config *Config, message *Message) error {
+	from, err := mail.ParseAddress(config.FromEmail)
+	if err != nil {
+		return errors.Wrap(err, "invalid sender email")
+	}
+	from.Name = config.FromName
+	to, err := mail.ParseAddress(message.To)
+	if err != nil {
+		return errors.Wrap(err, "invalid recipient email")
+	}
+	data, err := buildMessage(from, to, message)
+	if err != nil {
+		return err
+	}
+
+	address := net.JoinHostPort(config.Host, strconv.Itoa(config.Port))
+	dialer := &net.Dialer{Timeout: timeout}
+	var conn net.Conn
+	if config.TLS {
+		conn, err = tls.DialWithDialer(dialer, "tcp", address, &tls.Config{ServerName: config.Host})
+	} else {
+		conn, err = dialer.Dial("tcp", address)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to connect to %s", address)
+	}
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		conn.Close()
+		return errors.Wrap(err, "failed to set deadline")
+	}
+	client, err := smtp.NewClient(conn, config.Host)
+	if err != nil {
+		conn.Close()
+		return errors.Wrapf(err, "failed to greet %s", address)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok && !config.TLS {
+		if err := client.StartTLS(&tls.Config{ServerName: config.Host}); err != nil {
+			return errors.Wrap(err, "failed to start TLS")
+		}
+	}
+	if config.Username != "" {
+		// The plain authentication is refused by net/smtp over the connections not encrypted, but to localhost.
+		if err := client.Auth(smtp.PlainAuth("", config.Username, config.Password, config.Host)); err != nil {
+			return errors.Wrap(err, "failed to authenticate")
+		}
+	}
+	if err := client.Mail(from.Address); err != nil {
+		return errors.Wrap(err, "failed to set sender")
+	}
+	if err := client.Rcpt(to.Address); err != nil {
+		return errors.Wrap(err, "failed to set recipient")
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return errors.Wrap(err, "failed to start data")
+	}
+	if _, err := writer.Write(data); err != nil {
+		return errors.Wrap(err, "failed to write data")
+	}
+	if err := writer.Close(); err != nil {
+		return errors.Wrap(err, "failed to send data")
+	}
+	return client.Quit()
+}
+
+// SendAsync sends the message in the background, logging the failures.
+func SendAsync(config *Config, message *Message) {
+	go func() {
+		if err := Send(config, message); err != nil {
+			// Since we're in a goroutine, we can only log the error
+			slog.Warn("Failed to send email asynchronously",
+				slog.String("subject", message.Subject),
+				slog.Any("err", err))
+		}
+	}()
+}
+
+// buildMessage returns the headers and the quoted-printable body of the message.
+func buildMessage(from, to *mail.Address, message *Message) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from.String())
+	fmt.Fprintf(&buf, "To: %s\r\n", to.String())
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+	writer := quotedprintable.NewWriter(&buf)
+	if _, err := writer.Write([]byte(message.Body)); err != nil {
+		return nil, errors.Wrap(err, "failed to encode body")
+	}
+	if err := writer.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to encode body")
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,45 @@
+package email
+
+import (
+	"io"
+	"mime/quotedprintable"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/email/emailtest"
+)
+
+func TestSend(t *testing.T) {
+	server := emailtest.NewServer(t)
+	config := &Config{
+		Host:      server.Host,
+		Port:      server.Port,
+		FromEmail: "memos@example.com",
+		FromName:  "Memos",
+	}
+
+	body := strings.Repeat("A long line of the body, wrapped by the quoted-printable encoding. ", 3) + "Ünïcode."
+	require.NoError(t, Send(config, &Message{
+		To:      "jane@example.com",
+		Subject: "Réinitialiser\r\nBcc: evil@example.com",
+		Body:    body,
+	}))
+	emails := server.Emails()
+	require.Len(t, emails, 1)
+	require.Equal(t, "memos@example.com", emails[0].From)
+	require.Equal(t, []string{"jane@example.com"}, emails[0].To)
+
+	headers, encodedBody, ok := strings.Cut(emails[0].Data, "\r\n\r\n")
+	require.True(t, ok)
+	require.Contains(t, headers, `From: "Memos" <memos@example.com>`)
+	require.Contains(t, headers, "To: <jane@example.com>")
+	// The subject is encoded, so that it cannot inject headers.
+	require.NotContains(t, headers, "\r\nBcc:")
+	decodedBody, err := io.ReadAll(quotedprintable.NewReader(strings.NewReader(encodedBody)))
+	require.NoError(t, err)
+	require.Equal(t, body, strings.TrimRight(string(decodedBody), "\r\n"))
+
+	require.Error(t, Send(config, &Message{To: "not an email", Subject: "Hello"}))
+}
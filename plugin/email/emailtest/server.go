@@ -0,0 +1,110 @@
+// Package emailtest provides a fake SMTP server recording the emails sent in tests.
+package emailtest
+
+import (
+	"bufio"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Email is an email received by the server.
+type Email struct {
+	From string
+	To   []string
+	// Data is the message with its headers, as sent.
+	Data string
+}
+
+// Server is a fake SMTP server accepting every email, without TLS nor authentication.
+type Server struct {
+	Host string
+	Port int
+
+	mu     sync.Mutex
+	emails []*Email
+}
+
+// NewServer starts a server listening on localhost until the end of the test.
+func NewServer(t *testing.T) *Server {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	address := listener.Addr().(*net.TCPAddr)
+	server := &Server{
+		Host: address.IP.String(),
+		Port: address.Port,
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.serve(conn)
+		}
+	}()
+	return server
+}
+
+// Emails returns the emails received so far.
+func (s *Server) Emails() []*Email {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Email{}, s.emails...)
+}
+
+func (s *Server) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	reply := func(line string) {
+		_, _ = conn.Write([]byte(line + "\r\n"))
+	}
+	reply("220 localhost ESMTP")
+	email := &Email{}
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(strings.TrimSpace(line))
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(command, "MAIL FROM:"):
+			email.From = strings.Trim(strings.TrimSpace(line)[len("MAIL FROM:"):], "<>")
+			reply("250 OK")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			email.To = append(email.To, strings.Trim(strings.TrimSpace(line)[len("RCPT TO:"):], "<>"))
+			reply("250 OK")
+		case command == "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(dataLine, "."))
+			}
+			email.Data = data.String()
+			s.mu.Lock()
+			s.emails = append(s.emails, email)
+			s.mu.Unlock()
+			email = &Email{}
+			reply("250 OK: queued as " + strconv.Itoa(len(s.Emails())))
+		case command == "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
@@ -0,0 +1,53 @@
+// Package embedding turns texts into vectors whose distance reflects the difference in meaning
+// of the texts, used for semantic search.
+package embedding
+
+import (
+	"context"
+	"math"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// Provider embeds texts into vectors.
+type Provider interface {
+	// Model returns the identifier of the model, vectors of different models are not comparable.
+	Model() string
+	// Embed returns the vectors of the texts, in the same order.
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+}
+
+// NewProvider returns the provider configured by the workspace embedding setting.
+func NewProvider(setting *storepb.WorkspaceEmbeddingSetting) (Provider, error) {
+	switch setting.Provider {
+	case storepb.WorkspaceEmbeddingSetting_OPENAI:
+		if setting.Endpoint == "" || setting.Model == "" {
+			return nil, errors.New("endpoint and model are required for the OpenAI provider")
+		}
+		return NewOpenAIProvider(setting.Endpoint, setting.ApiKey, setting.Model), nil
+	case storepb.WorkspaceEmbeddingSetting_LOCAL, storepb.WorkspaceEmbeddingSetting_PROVIDER_UNSPECIFIED:
+		return NewLocalProvider(), nil
+	default:
+		return nil, errors.Errorf("unsupported embedding provider: %v", setting.Provider)
+	}
+}
+
+// CosineSimilarity returns the cosine similarity of the vectors, from -1 to 1.
+// Vectors of different lengths are not comparable and have a similarity of 0.
+func CosineSimilarity(a, b []float32) float64 {
+	if len(a) != len(b) {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
@@ -0,0 +1,58 @@
+package embedding
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestLocalProvider(t *testing.T) {
+	provider := NewLocalProvider()
+	vectors, err := provider.Embed(context.Background(), []string{
+		"Planting tomatoes in the garden",
+		"Gardening tips for tomato plants",
+		"Quarterly tax report",
+	})
+	require.NoError(t, err)
+	require.Len(t, vectors, 3)
+	require.Len(t, vectors[0], localDimensions)
+	require.InDelta(t, 1, CosineSimilarity(vectors[0], vectors[0]), 1e-6)
+	require.Greater(t, CosineSimilarity(vectors[0], vectors[1]), CosineSimilarity(vectors[0], vectors[2]))
+}
+
+func TestOpenAIProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/embeddings", r.URL.Path)
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		request := &openAIEmbeddingRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(request))
+		require.Equal(t, "test-model", request.Model)
+		// Answer in reverse order to check that the index is respected.
+		w.Write([]byte(`{"data": [{"index": 1, "embedding": [0, 1]}, {"index": 0, "embedding": [1, 0]}]}`))
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider(&storepb.WorkspaceEmbeddingSetting{
+		Provider: storepb.WorkspaceEmbeddingSetting_OPENAI,
+		Endpoint: server.URL + "/v1/",
+		ApiKey:   "secret",
+		Model:    "test-model",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "openai:test-model", provider.Model())
+	vectors, err := provider.Embed(context.Background(), []string{"a", "b"})
+	require.NoError(t, err)
+	require.Equal(t, [][]float32{{1, 0}, {0, 1}}, vectors)
+}
+
+func TestCosineSimilarity(t *testing.T) {
+	require.InDelta(t, 0, CosineSimilarity([]float32{1, 0}, []float32{0, 1}), 1e-9)
+	require.InDelta(t, -1, CosineSimilarity([]float32{1, 0}, []float32{-2, 0}), 1e-9)
+	require.Equal(t, 0.0, CosineSimilarity([]float32{1}, []float32{1, 0}))
+}
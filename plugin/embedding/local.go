@@ -0,0 +1,73 @@
+package embedding
+
+import (
+	"context"
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// localDimensions is the number of dimensions of the vectors of the local provider.
+const localDimensions = 512
+
+// LocalProvider is a lightweight embedding model running in process.
+// It hashes the words and the character trigrams of the words into a fixed size vector,
+// so texts sharing words or word stems, e.g. "garden" and "gardening", are close.
+// It does not know about synonyms, use an OpenAI compatible provider for that.
+type LocalProvider struct{}
+
+// NewLocalProvider returns the local provider.
+func NewLocalProvider() *LocalProvider {
+	return &LocalProvider{}
+}
+
+func (*LocalProvider) Model() string {
+	return "local:hashing-v1"
+}
+
+func (*LocalProvider) Embed(_ context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		vectors[i] = embedLocal(text)
+	}
+	return vectors, nil
+}
+
+func embedLocal(text string) []float32 {
+	counts := map[string]int{}
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}) {
+		counts["w:"+word]++
+		runes := []rune("<" + word + ">")
+		for i := 0; i+3 <= len(runes); i++ {
+			counts["t:"+string(runes[i:i+3])]++
+		}
+	}
+
+	vector := make([]float32, localDimensions)
+	for feature, count := range counts {
+		h := fnv.New32a()
+		h.Write([]byte(feature))
+		sum := h.Sum32()
+		// The highest bit gives the sign so that hash collisions cancel out on average.
+		weight := float32(1 + math.Log(float64(count)))
+		if sum&(1<<31) != 0 {
+			weight = -weight
+		}
+		vector[sum%localDimensions] += weight
+	}
+
+	var norm float64
+	for _, v := range vector {
+		norm += float64(v) * float64(v)
+	}
+	if norm > 0 {
+		norm = math.Sqrt(norm)
+		for i := range vector {
+			vector[i] = float32(float64(vector[i]) / norm)
+		}
+	}
+	return vector
+}
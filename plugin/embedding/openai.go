@@ -0,0 +1,100 @@
+package embedding
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// timeout is the timeout of a request to the embeddings API.
+var timeout = 60 * time.Second
+
+// OpenAIProvider embeds texts with an embeddings API compatible with OpenAI.
+type OpenAIProvider struct {
+	endpoint string
+	apiKey   string
+	model    string
+	client   *http.Client
+}
+
+// NewOpenAIProvider returns a provider calling the embeddings API at the endpoint,
+// e.g. "https://api.openai.com/v1". The API key may be empty for local servers.
+func NewOpenAIProvider(endpoint, apiKey, model string) *OpenAIProvider {
+	return &OpenAIProvider{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		apiKey:   apiKey,
+		model:    model,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+func (p *OpenAIProvider) Model() string {
+	return "openai:" + p.model
+}
+
+type openAIEmbeddingRequest struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+}
+
+type openAIEmbeddingResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+}
+
+func (p *OpenAIProvider) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	body, err := json.Marshal(&openAIEmbeddingRequest{
+		Model: p.model,
+		Input: texts,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal embedding request")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint+"/embeddings", bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to construct embedding request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to post embedding request to %s", p.endpoint)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read embedding response")
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, errors.Errorf("failed to embed texts, status code: %d, response body: %s", resp.StatusCode, b)
+	}
+
+	response := &openAIEmbeddingResponse{}
+	if err := json.Unmarshal(b, response); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal embedding response")
+	}
+	if len(response.Data) != len(texts) {
+		return nil, errors.Errorf("expected %d embeddings, got %d", len(texts), len(response.Data))
+	}
+	vectors := make([][]float32, len(texts))
+	for _, data := range response.Data {
+		if data.Index < 0 || data.Index >= len(texts) {
+			return nil, errors.Errorf("invalid embedding index %d", data.Index)
+		}
+		vectors[data.Index] = data.Embedding
+	}
+	return vectors, nil
+}
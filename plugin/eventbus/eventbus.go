@@ -0,0 +1,60 @@
+// Package eventbus publishes the activities of the webhooks as events to a NATS server or a Kafka cluster.
+package eventbus
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// DefaultTopic is the Kafka topic of the events, and the prefix of their NATS subjects.
+const DefaultTopic = "memos"
+
+// Event is an activity of a user, with the same fields as the payloads of the webhooks.
+type Event struct {
+	// The type of the activity, one of the activity types of the webhooks, e.g. "memos.memo.created".
+	ActivityType string `json:"activityType"`
+	// The resource name of the creator. Format: users/{user}
+	Creator string `json:"creator"`
+	// The memo of the activity, if any.
+	Memo *v1pb.Memo `json:"memo"`
+	// The attachment of the activity, if any.
+	Attachment *v1pb.Attachment `json:"attachment,omitempty"`
+}
+
+// Publisher publishes the events to an event bus.
+type Publisher interface {
+	// Publish publishes the event without waiting for the bus to acknowledge it,
+	// the events failing to be published later on being logged and dropped.
+	Publish(ctx context.Context, event *Event) error
+	// Close publishes the pending events and closes the connection.
+	Close() error
+}
+
+// NewPublisher returns the publisher of the URL of the event bus, connecting to it in the background:
+// "nats://[user:password@]host:port" or "tls://host:port" for NATS, and "kafka://host:port[,host:port]" for Kafka.
+// The topic is the Kafka topic of the events, or the prefix of their NATS subjects, DefaultTopic if empty.
+func NewPublisher(rawURL, topic string) (Publisher, error) {
+	if topic == "" {
+		topic = DefaultTopic
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid event bus URL")
+	}
+	switch u.Scheme {
+	case "nats", "tls":
+		return newNATSPublisher(rawURL, topic)
+	case "kafka":
+		if u.Host == "" {
+			return nil, errors.New("the Kafka event bus URL lists no broker")
+		}
+		return newKafkaPublisher(strings.Split(u.Host, ","), topic), nil
+	default:
+		return nil, errors.Errorf("unsupported event bus scheme %q", u.Scheme)
+	}
+}
@@ -0,0 +1,92 @@
+package eventbus
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// serveNATS serves the client of the listener with the NATS protocol, sending the published messages to the channel.
+func serveNATS(t *testing.T, listener net.Listener, messages chan<- [2]string) {
+	conn, err := listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	_, err = conn.Write([]byte(`INFO {"server_id":"test","version":"2.10.0","proto":1,"max_payload":1048576}` + "\r\n"))
+	require.NoError(t, err)
+	reader := bufio.NewReader(conn)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) == 0:
+		case fields[0] == "PING":
+			_, _ = conn.Write([]byte("PONG\r\n"))
+		case fields[0] == "PUB" && len(fields) == 3:
+			size, err := strconv.Atoi(fields[2])
+			require.NoError(t, err)
+			payload := make([]byte, size+2)
+			_, err = io.ReadFull(reader, payload)
+			require.NoError(t, err)
+			messages <- [2]string{fields[1], string(payload[:size])}
+		}
+	}
+}
+
+func TestNATSPublisher(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	messages := make(chan [2]string, 1)
+	go serveNATS(t, listener, messages)
+
+	publisher, err := NewPublisher("nats://"+listener.Addr().String(), "")
+	require.NoError(t, err)
+	require.NoError(t, publisher.Publish(context.Background(), &Event{
+		ActivityType: "memos.memo.created",
+		Creator:      "users/1",
+		Memo:         &v1pb.Memo{Name: "memos/abc", Content: "Hello"},
+	}))
+	require.NoError(t, publisher.Close())
+
+	select {
+	case message := <-messages:
+		require.Equal(t, "memos.memo.created", message[0])
+		event := &Event{}
+		require.NoError(t, json.Unmarshal([]byte(message[1]), event))
+		require.Equal(t, "users/1", event.Creator)
+		require.Equal(t, "Hello", event.Memo.Content)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the event was not published")
+	}
+}
+
+func TestNewPublisher(t *testing.T) {
+	require.Equal(t, "acme.memos.comment.created", getNATSSubject("acme.memos", "memos.comment.created"))
+
+	publisher, err := NewPublisher("kafka://broker-1:9092,broker-2:9092", "")
+	require.NoError(t, err)
+	writer := publisher.(*kafkaPublisher).writer
+	require.Equal(t, DefaultTopic, writer.Topic)
+	require.Equal(t, "broker-1:9092,broker-2:9092", writer.Addr.String())
+	require.NoError(t, publisher.Close())
+
+	_, err = NewPublisher("kafka://", "")
+	require.Error(t, err)
+	_, err = NewPublisher("amqp://localhost", "")
+	require.Error(t, err)
+}
@@ -0,0 +1,51 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/pkg/errors"
+	"github.com/segmentio/kafka-go"
+)
+
+// kafkaPublisher publishes the events to the topic, keyed by their creator so that the events of a user stay in order.
+type kafkaPublisher struct {
+	writer *kafka.Writer
+}
+
+func newKafkaPublisher(brokers []string, topic string) *kafkaPublisher {
+	return &kafkaPublisher{
+		writer: &kafka.Writer{
+			Addr:                   kafka.TCP(brokers...),
+			Topic:                  topic,
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireOne,
+			AllowAutoTopicCreation: true,
+			Async:                  true,
+			Completion: func(messages []kafka.Message, err error) {
+				if err != nil {
+					slog.Error("Failed to publish events to Kafka event bus", slog.Int("count", len(messages)), slog.Any("err", err))
+				}
+			},
+		},
+	}
+}
+
+func (p *kafkaPublisher) Publish(ctx context.Context, event *Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal event")
+	}
+	return p.writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(event.Creator),
+		Value: data,
+		Headers: []kafka.Header{
+			{Key: "activityType", Value: []byte(event.ActivityType)},
+		},
+	})
+}
+
+func (p *kafkaPublisher) Close() error {
+	return p.writer.Close()
+}
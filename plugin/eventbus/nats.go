@@ -0,0 +1,53 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+
+	"github.com/nats-io/nats.go"
+	"github.com/pkg/errors"
+)
+
+// natsPublisher publishes the events to the subjects of their activity types under the topic,
+// e.g. "memos.memo.created" for the memos created with the default topic.
+type natsPublisher struct {
+	conn  *nats.Conn
+	topic string
+}
+
+func newNATSPublisher(rawURL, topic string) (*natsPublisher, error) {
+	conn, err := nats.Connect(rawURL,
+		nats.Name("memos"),
+		// The server may start after memos, the events being buffered until it is reached.
+		nats.RetryOnFailedConnect(true),
+		nats.MaxReconnects(-1),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			if err != nil {
+				slog.Warn("Disconnected from NATS event bus", slog.Any("err", err))
+			}
+		}),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to NATS event bus")
+	}
+	return &natsPublisher{conn: conn, topic: topic}, nil
+}
+
+func (p *natsPublisher) Publish(_ context.Context, event *Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal event")
+	}
+	return p.conn.Publish(getNATSSubject(p.topic, event.ActivityType), data)
+}
+
+func (p *natsPublisher) Close() error {
+	return p.conn.Drain()
+}
+
+// getNATSSubject returns the subject of the activity type under the topic, replacing its "memos" prefix.
+func getNATSSubject(topic, activityType string) string {
+	return topic + "." + strings.TrimPrefix(activityType, "memos.")
+}
@@ -0,0 +1,44 @@
+package filter
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// attachmentClassTypes are the MIME type patterns of each attachment class, "*" matching any subtype.
+var attachmentClassTypes = map[string][]string{
+	"image": {"image/*"},
+	"video": {"video/*"},
+	"audio": {"audio/*"},
+	"document": {
+		"text/*",
+		"application/pdf",
+		"application/rtf",
+		"application/msword",
+		"application/vnd.ms-*",
+		"application/vnd.openxmlformats-officedocument.*",
+		"application/vnd.oasis.opendocument.*",
+	},
+	"archive": {
+		"application/zip",
+		"application/gzip",
+		"application/x-tar",
+		"application/x-bzip2",
+		"application/x-7z-compressed",
+		"application/x-rar-compressed",
+		"application/vnd.rar",
+	},
+}
+
+// GetAttachmentClassTypes returns the MIME type patterns of the attachment class, e.g. "audio" for voice notes.
+func GetAttachmentClassTypes(class string) ([]string, error) {
+	types, ok := attachmentClassTypes[class]
+	if !ok {
+		classes := slices.Sorted(maps.Keys(attachmentClassTypes))
+		return nil, errors.Errorf("unknown attachment class %q, supported classes are: %s", class, strings.Join(classes, ", "))
+	}
+	return types, nil
+}
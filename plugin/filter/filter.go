@@ -21,6 +21,48 @@ var MemoFilterCELAttributes = []cel.EnvOption{
 	cel.Variable("tags", cel.ListType(cel.StringType)),
 	cel.Variable("visibility", cel.StringType),
 	cel.Variable("has_task_list", cel.BoolType),
+	cel.Variable("has_tags", cel.BoolType),
+	cel.Variable("has_incomplete_tasks", cel.BoolType),
+	cel.Variable("word_count", cel.IntType),
+	cel.Variable("attachment_count", cel.IntType),
+	// The total size of the attachments in bytes.
+	cel.Variable("attachment_size", cel.IntType),
+	cel.Variable("location", cel.MapType(cel.StringType, cel.DoubleType)),
+	// Location area function, e.g. location.within(48.85, 2.35, 10) for the memos within 10 km of a point.
+	cel.Function("within",
+		cel.MemberOverload("location_within",
+			[]*cel.Type{cel.MapType(cel.StringType, cel.DoubleType), cel.DynType, cel.DynType, cel.DynType},
+			cel.BoolType,
+		),
+	),
+	// Attachment type function, e.g. has_attachment_type("image/*").
+	cel.Function("has_attachment_type",
+		cel.Overload("has_attachment_type_string",
+			[]*cel.Type{cel.StringType},
+			cel.BoolType,
+		),
+	),
+	// Attachment class function, e.g. has_attachment_class("audio"), see GetAttachmentClassTypes.
+	cel.Function("has_attachment_class",
+		cel.Overload("has_attachment_class_string",
+			[]*cel.Type{cel.StringType},
+			cel.BoolType,
+		),
+	),
+	// Relation function matching the memos related to the memo with the given uid in either direction.
+	cel.Function("in_relation_with",
+		cel.Overload("in_relation_with_string",
+			[]*cel.Type{cel.StringType},
+			cel.BoolType,
+		),
+	),
+	// Group visibility function matching the GROUP memos visible to the user with the given id, e.g. group_visible_to(1).
+	cel.Function("group_visible_to",
+		cel.Overload("group_visible_to_int",
+			[]*cel.Type{cel.IntType},
+			cel.BoolType,
+		),
+	),
 	// Current timestamp function.
 	cel.Function("now",
 		cel.Overload("now",
@@ -0,0 +1,78 @@
+package filter
+
+import (
+	"math"
+
+	"github.com/pkg/errors"
+	exprv1 "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+)
+
+// kmPerDegree is the length of a degree of latitude in kilometers.
+const kmPerDegree = 111.32
+
+// LocationWithin is the area of a location.within(latitude, longitude, radius_km) call.
+type LocationWithin struct {
+	Latitude  float64
+	Longitude float64
+	RadiusKm  float64
+}
+
+// ParseLocationWithin parses the arguments of a location.within(latitude, longitude, radius_km) call.
+func ParseLocationWithin(call *exprv1.Expr_Call) (*LocationWithin, error) {
+	identifier, err := GetIdentExprName(call.Target)
+	if err != nil || identifier != "location" {
+		return nil, errors.New("within function only supports the 'location' identifier")
+	}
+	if len(call.Args) != 3 {
+		return nil, errors.New("within function requires latitude, longitude and radius in kilometers")
+	}
+	values := make([]float64, len(call.Args))
+	for i, arg := range call.Args {
+		value, err := GetConstValue(arg)
+		if err != nil {
+			return nil, err
+		}
+		switch v := value.(type) {
+		case int64:
+			values[i] = float64(v)
+		case uint64:
+			values[i] = float64(v)
+		case float64:
+			values[i] = v
+		default:
+			return nil, errors.New("within function arguments must be numbers")
+		}
+	}
+	within := &LocationWithin{Latitude: values[0], Longitude: values[1], RadiusKm: values[2]}
+	if within.Latitude < -90 || within.Latitude > 90 {
+		return nil, errors.Errorf("invalid latitude %v", within.Latitude)
+	}
+	if within.Longitude < -180 || within.Longitude > 180 {
+		return nil, errors.Errorf("invalid longitude %v", within.Longitude)
+	}
+	if within.RadiusKm <= 0 {
+		return nil, errors.Errorf("invalid radius %v", within.RadiusKm)
+	}
+	return within, nil
+}
+
+// Args returns the arguments of the location_within template.
+// The bounding box of the circle cheaply rejects distant memos, then the distance is approximated
+// with the equirectangular projection, which is precise for the radii of map views.
+// The bounding box is clamped to the antimeridian, so areas crossing it are truncated.
+func (l *LocationWithin) Args() []any {
+	radiusDegrees := l.RadiusKm / kmPerDegree
+	// Degrees of longitude shrink with the cosine of the latitude.
+	longitudeScale := math.Cos(l.Latitude * math.Pi / 180)
+	longitudeRadius := 180.0
+	if longitudeScale > radiusDegrees/180 {
+		longitudeRadius = radiusDegrees / longitudeScale
+	}
+	return []any{
+		math.Max(l.Latitude-radiusDegrees, -90), math.Min(l.Latitude+radiusDegrees, 90),
+		math.Max(l.Longitude-longitudeRadius, -180), math.Min(l.Longitude+longitudeRadius, 180),
+		l.Latitude, l.Latitude,
+		l.Longitude, l.Longitude, longitudeScale * longitudeScale,
+		radiusDegrees * radiusDegrees,
+	}
+}
@@ -0,0 +1,134 @@
+package filter
+
+import (
+	"cmp"
+	"regexp"
+	"slices"
+
+	"github.com/google/cel-go/cel"
+	"github.com/pkg/errors"
+	exprv1 "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+)
+
+// maxMacroDepth is the maximum nesting of macros referencing other macros.
+const maxMacroDepth = 8
+
+var macroNamePattern = regexp.MustCompile(`^[a-z_][a-z0-9_]{0,31}$`)
+
+// ValidateMacroName checks that the name can reference a macro in a filter without shadowing an attribute.
+func ValidateMacroName(name string) error {
+	if !macroNamePattern.MatchString(name) {
+		return errors.Errorf("invalid macro name %q, must be lower case letters, digits and underscores", name)
+	}
+	if slices.Contains([]string{"true", "false", "null", "in", "as", "break", "const", "continue", "else", "for", "function", "if",
+		"import", "let", "loop", "package", "namespace", "return", "var", "void", "while"}, name) {
+		return errors.Errorf("macro name %q is a reserved word", name)
+	}
+	env, err := cel.NewEnv(MemoFilterCELAttributes...)
+	if err != nil {
+		return errors.Wrap(err, "failed to create CEL environment")
+	}
+	if env.HasFunction(name) {
+		return errors.Errorf("macro name %q is a filter function", name)
+	}
+	if _, issues := env.Compile(name); issues == nil || issues.Err() == nil {
+		return errors.Errorf("macro name %q is a filter attribute", name)
+	}
+	return nil
+}
+
+// ExpandMacros replaces the identifiers of the filter naming a macro with the parenthesized macro expression.
+// Macros may reference other macros, but not themselves.
+func ExpandMacros(filter string, macros map[string]string) (string, error) {
+	if len(macros) == 0 {
+		return filter, nil
+	}
+	return expandMacros(filter, macros, nil)
+}
+
+func expandMacros(filter string, macros map[string]string, expanding []string) (string, error) {
+	if len(expanding) > maxMacroDepth {
+		return "", errors.Errorf("macros are nested deeper than %d levels", maxMacroDepth)
+	}
+	env, err := cel.NewEnv(MemoFilterCELAttributes...)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create CEL environment")
+	}
+	ast, issues := env.Parse(filter)
+	if issues != nil && issues.Err() != nil {
+		return "", errors.Errorf("failed to parse filter: %v", issues)
+	}
+	parsedExpr, err := cel.AstToParsedExpr(ast)
+	if err != nil {
+		return "", err
+	}
+
+	// The positions are offsets in characters of the identifiers to replace.
+	type reference struct {
+		name     string
+		position int
+	}
+	references := []reference{}
+	var walk func(expr *exprv1.Expr, shadowed []string)
+	walk = func(expr *exprv1.Expr, shadowed []string) {
+		if expr == nil {
+			return
+		}
+		switch v := expr.ExprKind.(type) {
+		case *exprv1.Expr_IdentExpr:
+			if _, ok := macros[v.IdentExpr.Name]; ok && !slices.Contains(shadowed, v.IdentExpr.Name) {
+				references = append(references, reference{name: v.IdentExpr.Name, position: int(parsedExpr.SourceInfo.Positions[expr.Id])})
+			}
+		case *exprv1.Expr_SelectExpr:
+			walk(v.SelectExpr.Operand, shadowed)
+		case *exprv1.Expr_CallExpr:
+			walk(v.CallExpr.Target, shadowed)
+			for _, arg := range v.CallExpr.Args {
+				walk(arg, shadowed)
+			}
+		case *exprv1.Expr_ListExpr:
+			for _, element := range v.ListExpr.Elements {
+				walk(element, shadowed)
+			}
+		case *exprv1.Expr_StructExpr:
+			for _, entry := range v.StructExpr.Entries {
+				walk(entry.GetMapKey(), shadowed)
+				walk(entry.Value, shadowed)
+			}
+		case *exprv1.Expr_ComprehensionExpr:
+			// The comprehension variables, e.g. t in tags.exists(t, t == "work"), shadow the macros of the same name.
+			comprehension := v.ComprehensionExpr
+			walk(comprehension.IterRange, shadowed)
+			walk(comprehension.AccuInit, shadowed)
+			inner := append(slices.Clone(shadowed), comprehension.IterVar, comprehension.AccuVar)
+			walk(comprehension.LoopCondition, inner)
+			walk(comprehension.LoopStep, inner)
+			walk(comprehension.Result, inner)
+		}
+	}
+	walk(parsedExpr.Expr, nil)
+	if len(references) == 0 {
+		return filter, nil
+	}
+
+	// Replace from the end so that the positions of the preceding references are kept.
+	slices.SortFunc(references, func(a, b reference) int {
+		return cmp.Compare(b.position, a.position)
+	})
+	runes := []rune(filter)
+	for _, ref := range references {
+		if slices.Contains(expanding, ref.name) {
+			return "", errors.Errorf("macro %q references itself", ref.name)
+		}
+		expansion, err := expandMacros(macros[ref.name], macros, append(slices.Clone(expanding), ref.name))
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to expand macro %q", ref.name)
+		}
+		end := ref.position + len([]rune(ref.name))
+		if ref.position < 0 || end > len(runes) || string(runes[ref.position:end]) != ref.name {
+			return "", errors.Errorf("failed to locate macro %q in the filter", ref.name)
+		}
+		runes = slices.Concat(runes[:ref.position], []rune("("+expansion+")"), runes[end:])
+	}
+	return string(runes), nil
+}
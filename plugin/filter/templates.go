@@ -2,6 +2,7 @@ package filter
 
 import (
 	"fmt"
+	"strings"
 )
 
 // SQLTemplate holds database-specific SQL fragments.
@@ -20,6 +21,16 @@ const (
 	PostgreSQLTemplate TemplateDBType = "postgres"
 )
 
+// The coordinates of the memo location, zero coordinates are omitted from the payload.
+const (
+	sqliteLatitude    = "COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.location.latitude'), 0)"
+	sqliteLongitude   = "COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.location.longitude'), 0)"
+	mysqlLatitude     = "COALESCE(CAST(JSON_EXTRACT(`memo`.`payload`, '$.location.latitude') AS DOUBLE), 0)"
+	mysqlLongitude    = "COALESCE(CAST(JSON_EXTRACT(`memo`.`payload`, '$.location.longitude') AS DOUBLE), 0)"
+	postgresLatitude  = "COALESCE((memo.payload->'location'->>'latitude')::double precision, 0)"
+	postgresLongitude = "COALESCE((memo.payload->'location'->>'longitude')::double precision, 0)"
+)
+
 // SQLTemplates contains common SQL patterns for different databases.
 var SQLTemplates = map[string]SQLTemplate{
 	"json_extract": {
@@ -32,16 +43,23 @@ var SQLTemplates = map[string]SQLTemplate{
 		MySQL:      "JSON_LENGTH(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.tags'), JSON_ARRAY()))",
 		PostgreSQL: "jsonb_array_length(COALESCE(memo.payload->'tags', '[]'::jsonb))",
 	},
+	// The tags are matched case-sensitively, as LIKE is case-insensitive in SQLite.
 	"json_contains_element": {
-		SQLite:     "JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?",
+		SQLite:     "EXISTS (SELECT 1 FROM JSON_EACH(`memo`.`payload`, '$.tags') WHERE `value` = ?)",
 		MySQL:      "JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?)",
 		PostgreSQL: "memo.payload->'tags' @> jsonb_build_array(?)",
 	},
 	"json_contains_tag": {
-		SQLite:     "JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?",
+		SQLite:     "EXISTS (SELECT 1 FROM JSON_EACH(`memo`.`payload`, '$.tags') WHERE `value` = ?)",
 		MySQL:      "JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?)",
 		PostgreSQL: "memo.payload->'tags' @> jsonb_build_array(?)",
 	},
+	// The tag is escaped in the patterns with a backslash, the default escape character of JSON_SEARCH.
+	"json_contains_tag_descendant": {
+		SQLite:     "EXISTS (SELECT 1 FROM JSON_EACH(`memo`.`payload`, '$.tags') WHERE INSTR(`value`, ?) = 1)",
+		MySQL:      "JSON_SEARCH(JSON_EXTRACT(`memo`.`payload`, '$.tags'), 'one', ?) IS NOT NULL",
+		PostgreSQL: "EXISTS (SELECT 1 FROM jsonb_array_elements_text(memo.payload->'tags') AS tag WHERE tag LIKE ? ESCAPE '\\')",
+	},
 	"boolean_true": {
 		SQLite:     "JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = 1",
 		MySQL:      "JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = CAST('true' AS JSON)",
@@ -72,6 +90,75 @@ var SQLTemplates = map[string]SQLTemplate{
 		MySQL:      "JSON_EXTRACT(`memo`.`payload`, '$.property.hasTaskList') = CAST('true' AS JSON)",
 		PostgreSQL: "(memo.payload->'property'->>'hasTaskList')::boolean IS TRUE",
 	},
+	"has_tags": {
+		SQLite:     "JSON_ARRAY_LENGTH(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.tags'), JSON_ARRAY())) > 0",
+		MySQL:      "JSON_LENGTH(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.tags'), JSON_ARRAY())) > 0",
+		PostgreSQL: "jsonb_array_length(COALESCE(memo.payload->'tags', '[]'::jsonb)) > 0",
+	},
+	"has_no_tags": {
+		SQLite:     "JSON_ARRAY_LENGTH(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.tags'), JSON_ARRAY())) = 0",
+		MySQL:      "JSON_LENGTH(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.tags'), JSON_ARRAY())) = 0",
+		PostgreSQL: "jsonb_array_length(COALESCE(memo.payload->'tags', '[]'::jsonb)) = 0",
+	},
+	"has_incomplete_tasks": {
+		SQLite:     "JSON_EXTRACT(`memo`.`payload`, '$.property.hasIncompleteTasks') IS TRUE",
+		MySQL:      "JSON_EXTRACT(`memo`.`payload`, '$.property.hasIncompleteTasks') = CAST('true' AS JSON)",
+		PostgreSQL: "(memo.payload->'property'->>'hasIncompleteTasks')::boolean IS TRUE",
+	},
+	"has_no_incomplete_tasks": {
+		SQLite:     "JSON_EXTRACT(`memo`.`payload`, '$.property.hasIncompleteTasks') IS NOT TRUE",
+		MySQL:      "COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.property.hasIncompleteTasks'), CAST('false' AS JSON)) = CAST('false' AS JSON)",
+		PostgreSQL: "(memo.payload->'property'->>'hasIncompleteTasks')::boolean IS NOT TRUE",
+	},
+	"word_count": {
+		SQLite:     "COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.property.wordCount'), 0)",
+		MySQL:      "COALESCE(CAST(JSON_EXTRACT(`memo`.`payload`, '$.property.wordCount') AS SIGNED), 0)",
+		PostgreSQL: "COALESCE((memo.payload->'property'->>'wordCount')::integer, 0)",
+	},
+	"has_attachment_type": {
+		SQLite:     "EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id` AND `resource`.`type` LIKE ?)",
+		MySQL:      "EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id` AND `resource`.`type` LIKE ?)",
+		PostgreSQL: "EXISTS (SELECT 1 FROM resource WHERE resource.memo_id = memo.id AND resource.type LIKE ?)",
+	},
+	"attachment_count": {
+		SQLite:     "(SELECT COUNT(*) FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id`)",
+		MySQL:      "(SELECT COUNT(*) FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id`)",
+		PostgreSQL: "(SELECT COUNT(*) FROM resource WHERE resource.memo_id = memo.id)",
+	},
+	"attachment_size": {
+		SQLite:     "(SELECT COALESCE(SUM(`resource`.`size`), 0) FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id`)",
+		MySQL:      "(SELECT COALESCE(SUM(`resource`.`size`), 0) FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id`)",
+		PostgreSQL: "(SELECT COALESCE(SUM(resource.size), 0) FROM resource WHERE resource.memo_id = memo.id)",
+	},
+	// The conditions are the attachment_type_like template joined by OR.
+	"has_attachment_class": {
+		SQLite:     "EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id` AND (%s))",
+		MySQL:      "EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id` AND (%s))",
+		PostgreSQL: "EXISTS (SELECT 1 FROM resource WHERE resource.memo_id = memo.id AND (%s))",
+	},
+	"attachment_type_like": {
+		SQLite:     "`resource`.`type` LIKE ?",
+		MySQL:      "`resource`.`type` LIKE ?",
+		PostgreSQL: "resource.type LIKE ?",
+	},
+	"in_relation_with": {
+		SQLite:     "EXISTS (SELECT 1 FROM `memo_relation` AS `relation` JOIN `memo` AS `related_memo` ON `related_memo`.`uid` = ? WHERE (`relation`.`memo_id` = `memo`.`id` AND `relation`.`related_memo_id` = `related_memo`.`id`) OR (`relation`.`related_memo_id` = `memo`.`id` AND `relation`.`memo_id` = `related_memo`.`id`))",
+		MySQL:      "EXISTS (SELECT 1 FROM `memo_relation` AS `relation` JOIN `memo` AS `related_memo` ON `related_memo`.`uid` = ? WHERE (`relation`.`memo_id` = `memo`.`id` AND `relation`.`related_memo_id` = `related_memo`.`id`) OR (`relation`.`related_memo_id` = `memo`.`id` AND `relation`.`memo_id` = `related_memo`.`id`))",
+		PostgreSQL: "EXISTS (SELECT 1 FROM memo_relation AS relation JOIN memo AS related_memo ON related_memo.uid = ? WHERE (relation.memo_id = memo.id AND relation.related_memo_id = related_memo.id) OR (relation.related_memo_id = memo.id AND relation.memo_id = related_memo.id))",
+	},
+	"group_visible_to": {
+		SQLite:     "(`memo`.`visibility` = 'GROUP' AND EXISTS (SELECT 1 FROM `memo_group` JOIN `user_group_member` ON `user_group_member`.`group_id` = `memo_group`.`group_id` WHERE `memo_group`.`memo_id` = `memo`.`id` AND `user_group_member`.`user_id` = ?))",
+		MySQL:      "(`memo`.`visibility` = 'GROUP' AND EXISTS (SELECT 1 FROM `memo_group` JOIN `user_group_member` ON `user_group_member`.`group_id` = `memo_group`.`group_id` WHERE `memo_group`.`memo_id` = `memo`.`id` AND `user_group_member`.`user_id` = ?))",
+		PostgreSQL: "(memo.visibility = 'GROUP' AND EXISTS (SELECT 1 FROM memo_group JOIN user_group_member ON user_group_member.group_id = memo_group.group_id WHERE memo_group.memo_id = memo.id AND user_group_member.user_id = ?))",
+	},
+	"location_within": {
+		SQLite: "(JSON_EXTRACT(`memo`.`payload`, '$.location') IS NOT NULL AND " + sqliteLatitude + " BETWEEN ? AND ? AND " + sqliteLongitude + " BETWEEN ? AND ? AND " +
+			"(" + sqliteLatitude + " - ?) * (" + sqliteLatitude + " - ?) + (" + sqliteLongitude + " - ?) * (" + sqliteLongitude + " - ?) * ? <= ?)",
+		MySQL: "(JSON_EXTRACT(`memo`.`payload`, '$.location') IS NOT NULL AND " + mysqlLatitude + " BETWEEN ? AND ? AND " + mysqlLongitude + " BETWEEN ? AND ? AND " +
+			"(" + mysqlLatitude + " - ?) * (" + mysqlLatitude + " - ?) + (" + mysqlLongitude + " - ?) * (" + mysqlLongitude + " - ?) * ? <= ?)",
+		PostgreSQL: "(memo.payload->'location' IS NOT NULL AND " + postgresLatitude + " BETWEEN ? AND ? AND " + postgresLongitude + " BETWEEN ? AND ? AND " +
+			"(" + postgresLatitude + " - ?) * (" + postgresLatitude + " - ?) + (" + postgresLongitude + " - ?) * (" + postgresLongitude + " - ?) * ? <= ?)",
+	},
 	"table_prefix": {
 		SQLite:     "`memo`",
 		MySQL:      "`memo`",
@@ -127,15 +214,26 @@ func GetParameterPlaceholder(dbType TemplateDBType, index int) string {
 func GetParameterValue(dbType TemplateDBType, templateName string, value interface{}) interface{} {
 	switch templateName {
 	case "json_contains_element", "json_contains_tag":
+		return value
+	case "json_contains_tag_descendant":
+		// Matches any tag nested below the given one, e.g. "work/project" for "work".
 		if dbType == SQLiteTemplate {
-			return fmt.Sprintf(`%%"%s"%%`, value)
+			return fmt.Sprintf("%s/", value)
 		}
-		return value
+		return fmt.Sprintf("%s/%%", escapeLikePattern(fmt.Sprintf("%s", value)))
+	case "has_attachment_type", "attachment_type_like":
+		// A wildcard matches any subtype, e.g. "image/*" matches "image/png".
+		return strings.ReplaceAll(fmt.Sprintf("%s", value), "*", "%")
 	default:
 		return value
 	}
 }
 
+// escapeLikePattern escapes the wildcards of the value matched by a LIKE pattern with a backslash, e.g. the "_" of a tag.
+func escapeLikePattern(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
 // FormatPlaceholders formats a list of placeholders for the given database type.
 func FormatPlaceholders(dbType TemplateDBType, count int, startIndex int) []string {
 	placeholders := make([]string, count)
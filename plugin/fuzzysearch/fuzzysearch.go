@@ -0,0 +1,137 @@
+// Package fuzzysearch matches search queries against texts while tolerating typos,
+// using the optimal string alignment distance between the query terms and the words of the text.
+package fuzzysearch
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Match returns the score of the text for the query and whether every term of the query
+// matches a word of the text. A term matches a word if it is a prefix of the word, or if
+// their distance is within the typo tolerance of the term length.
+func Match(query, text string) (float64, bool) {
+	terms := splitWords(query)
+	if len(terms) == 0 {
+		return 0, false
+	}
+	words := splitWords(text)
+	score := 0.0
+	for _, term := range terms {
+		best := 0.0
+		for _, word := range words {
+			if s := matchWord(term, word); s > best {
+				best = s
+			}
+		}
+		if best == 0 {
+			return 0, false
+		}
+		score += best
+	}
+	return score / float64(len(terms)), true
+}
+
+// MatchWord returns true if the term matches the word, ignoring case, e.g. to highlight the words matched by a query.
+func MatchWord(term, word string) bool {
+	return matchWord([]rune(strings.ToLower(term)), []rune(strings.ToLower(word))) > 0
+}
+
+// matchWord returns the similarity between 0 and 1 of the term and the word, 0 if they do not match.
+func matchWord(term, word []rune) float64 {
+	if string(term) == string(word) {
+		return 1
+	}
+	if len(term) < len(word) && string(term) == string(word[:len(term)]) {
+		return 0.9
+	}
+	tolerance := maxDistance(len(term))
+	if tolerance == 0 || abs(len(term)-len(word)) > tolerance {
+		return 0
+	}
+	distance := Distance(term, word)
+	if distance > tolerance {
+		return 0
+	}
+	return 0.8 * (1 - float64(distance)/float64(len(term)+1))
+}
+
+// Fragments returns, for each term of the query, fragments of the term of which the words matching the term contain
+// at least one, so that the texts matching the query are found among the ones containing a fragment of each term,
+// e.g. with a LIKE query. The term is split into one more fragment than the typos it tolerates, the fragments being
+// separated by a character, as a typo changes at most one of them.
+func Fragments(query string) [][]string {
+	fragments := [][]string{}
+	for _, term := range splitWords(query) {
+		count := maxDistance(len(term)) + 1
+		size := len(term) - (count - 1)
+		termFragments := []string{}
+		start := 0
+		for i := range count {
+			length := size / count
+			if i < size%count {
+				length++
+			}
+			termFragments = append(termFragments, string(term[start:start+length]))
+			start += length + 1
+		}
+		fragments = append(fragments, termFragments)
+	}
+	return fragments
+}
+
+// maxDistance returns the number of typos tolerated in a term of the length.
+func maxDistance(length int) int {
+	switch {
+	case length <= 3:
+		return 0
+	case length <= 6:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// Distance returns the optimal string alignment distance between a and b, i.e. the number of
+// insertions, deletions, substitutions and transpositions of adjacent characters turning a into b.
+func Distance(a, b []rune) int {
+	rows := make([][]int, len(a)+1)
+	for i := range rows {
+		rows[i] = make([]int, len(b)+1)
+		rows[i][0] = i
+	}
+	for j := range rows[0] {
+		rows[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			rows[i][j] = min(rows[i-1][j]+1, rows[i][j-1]+1, rows[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				rows[i][j] = min(rows[i][j], rows[i-2][j-2]+1)
+			}
+		}
+	}
+	return rows[len(a)][len(b)]
+}
+
+// splitWords splits the text into lower case words made of letters and numbers.
+func splitWords(text string) [][]rune {
+	words := [][]rune{}
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}) {
+		words = append(words, []rune(word))
+	}
+	return words
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
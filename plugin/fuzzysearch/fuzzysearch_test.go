@@ -0,0 +1,80 @@
+package fuzzysearch
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		distance int
+	}{
+		{"receive", "receive", 0},
+		{"recieve", "receive", 1},
+		{"kitten", "sitting", 3},
+		{"", "abc", 3},
+		{"café", "cafe", 1},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.distance, Distance([]rune(test.a), []rune(test.b)), "%s -> %s", test.a, test.b)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	text := "Did you receive the package from Berlin?"
+
+	exact, ok := Match("receive", text)
+	require.True(t, ok)
+	typo, ok := Match("recieve", text)
+	require.True(t, ok)
+	require.Greater(t, exact, typo)
+
+	_, ok = Match("pack", text)
+	require.True(t, ok)
+	_, ok = Match("recieve packgae", text)
+	require.True(t, ok)
+
+	// Every term must match.
+	_, ok = Match("receive parcel", text)
+	require.False(t, ok)
+	// Short terms must match exactly.
+	_, ok = Match("thw", text)
+	require.False(t, ok)
+	_, ok = Match("", text)
+	require.False(t, ok)
+}
+
+func TestMatchWord(t *testing.T) {
+	require.True(t, MatchWord("Recieve", "receive"))
+	require.True(t, MatchWord("pack", "Package"))
+	require.False(t, MatchWord("parcel", "package"))
+}
+
+func TestFragments(t *testing.T) {
+	require.Equal(t, [][]string{{"the"}, {"ab", "d"}, {"re", "ei", "e"}}, Fragments("The abcd receive"))
+	require.Empty(t, Fragments(""))
+
+	// The words matching a term contain one of its fragments.
+	for _, test := range []struct {
+		term string
+		word string
+	}{
+		{"receive", "recieve"},
+		{"receive", "receiver"},
+		{"receive", "rceeive"},
+		{"package", "packgae"},
+		{"travel", "trvael"},
+		{"hiking", "hikng"},
+		{"hiking", "hikinng"},
+	} {
+		_, ok := Match(test.term, test.word)
+		require.True(t, ok, "%s -> %s", test.term, test.word)
+		require.True(t, slices.ContainsFunc(Fragments(test.term)[0], func(fragment string) bool {
+			return strings.Contains(test.word, fragment)
+		}), "%s -> %s", test.term, test.word)
+	}
+}
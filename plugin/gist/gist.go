@@ -0,0 +1,141 @@
+// Package gist creates, updates and fetches the gists of a GitHub account with the GitHub REST API.
+package gist
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultAPIEndpoint is the endpoint of the GitHub API.
+	DefaultAPIEndpoint = "https://api.github.com"
+	// Tag is the tag of the memos mirrored to gists.
+	Tag = "gist"
+
+	// timeout is the timeout of the requests to the GitHub API.
+	timeout = 30 * time.Second
+	// maxResponseSize bounds the bytes of a response, above the 1 MB of the file contents returned by the API.
+	maxResponseSize = 16 << 20
+)
+
+var (
+	// ErrUnauthorized is returned for the invalid or revoked access tokens, or the tokens without the gist scope.
+	ErrUnauthorized = errors.New("invalid GitHub access token")
+	// ErrNotFound is returned for the gists deleted on GitHub.
+	ErrNotFound = errors.New("gist not found")
+)
+
+// Handler updates the memos of the users with the gists edited on GitHub.
+type Handler interface {
+	// UpdateMemoContent replaces the content of the memo of the memoUID of the user.
+	UpdateMemoContent(ctx context.Context, userID int32, memoUID string, content string) error
+}
+
+// Gist is a gist and its files by filename. The files are removed from a gist by updating them to nil.
+type Gist struct {
+	ID          string           `json:"id,omitempty"`
+	Description string           `json:"description"`
+	Public      bool             `json:"public"`
+	HTMLURL     string           `json:"html_url,omitempty"`
+	UpdatedAt   time.Time        `json:"updated_at,omitzero"`
+	Files       map[string]*File `json:"files"`
+}
+
+type File struct {
+	Content string `json:"content"`
+	// Truncated reports whether the content is cut at 1 MB by the API.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// Client calls the GitHub API with the access token of a user.
+type Client struct {
+	Token string
+	// APIEndpoint is the endpoint of the GitHub API, DefaultAPIEndpoint if empty.
+	APIEndpoint string
+}
+
+// CreateGist creates the gist and returns it as created.
+func (c *Client) CreateGist(ctx context.Context, gist *Gist) (*Gist, error) {
+	created := &Gist{}
+	if err := c.call(ctx, http.MethodPost, "/gists", gist, created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// UpdateGist updates the description and the files of the gist of the ID and returns it as updated.
+// Its visibility cannot be changed.
+func (c *Client) UpdateGist(ctx context.Context, id string, gist *Gist) (*Gist, error) {
+	updated := &Gist{}
+	if err := c.call(ctx, http.MethodPatch, "/gists/"+id, gist, updated); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// GetGist returns the gist of the ID.
+func (c *Client) GetGist(ctx context.Context, id string) (*Gist, error) {
+	gist := &Gist{}
+	if err := c.call(ctx, http.MethodGet, "/gists/"+id, nil, gist); err != nil {
+		return nil, err
+	}
+	return gist, nil
+}
+
+func (c *Client) call(ctx context.Context, method, path string, body, result any) error {
+	endpoint := c.APIEndpoint
+	if endpoint == "" {
+		endpoint = DefaultAPIEndpoint
+	}
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal GitHub request")
+		}
+		reader = bytes.NewReader(data)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(endpoint, "/")+path, reader)
+	if err != nil {
+		return errors.Wrap(err, "failed to construct GitHub request")
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to call GitHub API")
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return errors.Wrap(err, "failed to read GitHub response")
+	}
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized:
+		return ErrUnauthorized
+	case resp.StatusCode == http.StatusNotFound && body == nil:
+		return ErrNotFound
+	// The tokens without the gist scope cannot create or update the gists, which is reported as not found.
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusForbidden:
+		return errors.Wrapf(ErrUnauthorized, "GitHub request failed with status %d", resp.StatusCode)
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		return errors.Errorf("GitHub request failed with status %d: %s", resp.StatusCode, data)
+	}
+	if err := json.Unmarshal(data, result); err != nil {
+		return errors.Wrap(err, "failed to unmarshal GitHub response")
+	}
+	return nil
+}
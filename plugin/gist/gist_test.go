@@ -0,0 +1,71 @@
+package gist
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient(t *testing.T) {
+	gists := map[string]*Gist{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/gists":
+			gist := &Gist{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(gist))
+			gist.ID = "abc"
+			gist.HTMLURL = "https://gist.github.com/abc"
+			gist.UpdatedAt = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+			gists[gist.ID] = gist
+			w.WriteHeader(http.StatusCreated)
+			require.NoError(t, json.NewEncoder(w).Encode(gist))
+		case r.Method == http.MethodPatch && gists[r.URL.Path[len("/gists/"):]] != nil:
+			gist := gists[r.URL.Path[len("/gists/"):]]
+			update := &Gist{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(update))
+			for filename, file := range update.Files {
+				gist.Files[filename] = file
+			}
+			gist.UpdatedAt = gist.UpdatedAt.Add(time.Hour)
+			require.NoError(t, json.NewEncoder(w).Encode(gist))
+		case r.Method == http.MethodGet && gists[r.URL.Path[len("/gists/"):]] != nil:
+			require.NoError(t, json.NewEncoder(w).Encode(gists[r.URL.Path[len("/gists/"):]]))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	client := &Client{Token: "secret", APIEndpoint: server.URL}
+	created, err := client.CreateGist(ctx, &Gist{Description: "Snippet", Files: map[string]*File{"memo.md": {Content: "# Snippet"}}})
+	require.NoError(t, err)
+	require.Equal(t, "abc", created.ID)
+	require.Equal(t, "https://gist.github.com/abc", created.HTMLURL)
+	require.False(t, created.Public)
+
+	updated, err := client.UpdateGist(ctx, "abc", &Gist{Description: "Snippet", Files: map[string]*File{"memo.md": {Content: "# Updated"}}})
+	require.NoError(t, err)
+	require.Equal(t, "# Updated", updated.Files["memo.md"].Content)
+	require.True(t, updated.UpdatedAt.After(created.UpdatedAt))
+
+	gist, err := client.GetGist(ctx, "abc")
+	require.NoError(t, err)
+	require.Equal(t, updated.UpdatedAt, gist.UpdatedAt)
+	_, err = client.GetGist(ctx, "deleted")
+	require.ErrorIs(t, err, ErrNotFound)
+	_, err = client.UpdateGist(ctx, "deleted", &Gist{})
+	require.ErrorIs(t, err, ErrUnauthorized)
+	_, err = (&Client{Token: "revoked", APIEndpoint: server.URL}).GetGist(ctx, "abc")
+	require.ErrorIs(t, err, ErrUnauthorized)
+}
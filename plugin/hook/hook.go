@@ -0,0 +1,249 @@
+// Package hook calls the hooks of the instance, external processes or HTTP endpoints registered per event of the memos,
+// so that the instances add their own validation, content transforms or integrations without changing the server.
+//
+// The hooks are declared by the JSON file given to the --hooks flag, e.g.
+//
+//	{"hooks": [
+//	  {"name": "profanity", "event": "memo.pre_create", "url": "http://localhost:8000/check", "timeout": "2s"},
+//	  {"name": "archive", "event": "memo.post_create", "command": ["/usr/local/bin/archive-memo"]}
+//	]}
+//
+// Each hook is sent a Request as JSON, posted to its URL or written to the standard input of its command, and replies
+// with a Response as JSON, in the body of a 2xx response or on the standard output of a command exiting with 0.
+// An empty reply changes nothing.
+package hook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/webhook"
+)
+
+// The events the hooks are registered for.
+const (
+	// EventMemoPreCreate is sent before a memo is created. Its hooks may reject the memo or replace its content,
+	// the content replaced by a hook being sent to the next one.
+	EventMemoPreCreate = "memo.pre_create"
+	// EventMemoPostCreate is sent after a memo is created, from the job queue which retries the failed hooks.
+	// The replies of its hooks are ignored.
+	EventMemoPostCreate = "memo.post_create"
+	// EventMemoPreRender is sent before the content of a memo is rendered, by the API and the feeds. Its hooks may
+	// replace the rendered content, the stored content being unchanged. The memo is rendered as is if a hook fails.
+	// The hooks are called on the reads of the memos not cached yet, which wait for them, so they should reply fast:
+	// the memos of a page are rendered concurrently within 3 seconds, the memos not rendered in time are rendered
+	// as is, and the memos whose hooks failed are rendered as is for a minute.
+	EventMemoPreRender = "memo.pre_render"
+)
+
+// Events are all the events of the hooks.
+var Events = []string{
+	EventMemoPreCreate,
+	EventMemoPostCreate,
+	EventMemoPreRender,
+}
+
+// defaultTimeout is the time the hooks run at most when they do not set it.
+const defaultTimeout = 10 * time.Second
+
+// maxReplySize is the maximum size of the replies of the hooks.
+const maxReplySize = 8 << 20
+
+// Hook is a hook of the configuration file.
+type Hook struct {
+	// Name identifies the hook in the logs and the jobs.
+	Name  string `json:"name"`
+	Event string `json:"event"`
+	// URL is the endpoint the requests are posted to, or Command the process run with the request on its input.
+	URL     string   `json:"url,omitempty"`
+	Command []string `json:"command,omitempty"`
+	// Secret signs the requests posted to the URL like the payloads of the webhooks, unsigned if empty.
+	Secret string `json:"secret,omitempty"`
+	// Timeout is the time the hook runs at most, e.g. "5s", 10 seconds if empty. The memo.pre_render hooks
+	// are also bounded by the time the memos are rendered in.
+	Timeout string `json:"timeout,omitempty"`
+	// Optional hooks are skipped when they fail, while the failures of the other memo.pre_create hooks reject the memo.
+	Optional bool `json:"optional,omitempty"`
+
+	timeout time.Duration
+}
+
+// Config is the content of the configuration file.
+type Config struct {
+	Hooks []*Hook `json:"hooks"`
+}
+
+// Memo is the memo of the requests.
+type Memo struct {
+	// Name is the resource name of the memo, empty before it is created. Format: memos/{memo}
+	Name string `json:"name,omitempty"`
+	// Creator is the resource name of the creator. Format: users/{user}
+	Creator    string `json:"creator"`
+	Content    string `json:"content"`
+	Visibility string `json:"visibility"`
+}
+
+// Request is the request sent to the hooks.
+type Request struct {
+	Event string `json:"event"`
+	Memo  *Memo  `json:"memo"`
+}
+
+// Response is the reply of the hooks.
+type Response struct {
+	// Reject rejects the memo of a memo.pre_create hook, with the message told to the user.
+	Reject  bool   `json:"reject,omitempty"`
+	Message string `json:"message,omitempty"`
+	// Content replaces the content of the memo of a memo.pre_create or memo.pre_render hook, unless it is null.
+	Content *string `json:"content,omitempty"`
+}
+
+// RejectedError is the error of a memo rejected by a memo.pre_create hook.
+type RejectedError struct {
+	Hook    string
+	Message string
+}
+
+func (e *RejectedError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("rejected by hook %s", e.Hook)
+	}
+	return e.Message
+}
+
+// LoadConfig reads and validates the configuration file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read hooks file")
+	}
+	config := &Config{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, errors.Wrap(err, "failed to parse hooks file")
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// Validate checks the hooks and parses their timeouts.
+func (c *Config) Validate() error {
+	names := map[string]bool{}
+	for _, hook := range c.Hooks {
+		if hook.Name == "" {
+			return errors.New("hook name is required")
+		}
+		if names[hook.Name] {
+			return errors.Errorf("duplicate hook name %q", hook.Name)
+		}
+		names[hook.Name] = true
+		if !isValidEvent(hook.Event) {
+			return errors.Errorf("invalid event %q of hook %s", hook.Event, hook.Name)
+		}
+		if (hook.URL == "") == (len(hook.Command) == 0) {
+			return errors.Errorf("hook %s must have either a URL or a command", hook.Name)
+		}
+		hook.timeout = defaultTimeout
+		if hook.Timeout != "" {
+			timeout, err := time.ParseDuration(hook.Timeout)
+			if err != nil || timeout <= 0 {
+				return errors.Errorf("invalid timeout %q of hook %s", hook.Timeout, hook.Name)
+			}
+			hook.timeout = timeout
+		}
+	}
+	return nil
+}
+
+func isValidEvent(event string) bool {
+	for _, e := range Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Call sends the request to the hook and returns its reply.
+func Call(ctx context.Context, hook *Hook, request *Request) (*Response, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal hook request")
+	}
+	timeout := hook.timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var reply []byte
+	if hook.URL != "" {
+		reply, err = post(ctx, hook, body)
+	} else {
+		reply, err = run(ctx, hook, body)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to call hook %s", hook.Name)
+	}
+	response := &Response{}
+	if len(bytes.TrimSpace(reply)) == 0 {
+		return response, nil
+	}
+	if err := json.Unmarshal(reply, response); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse reply of hook %s", hook.Name)
+	}
+	return response, nil
+}
+
+func post(ctx context.Context, hook *Hook, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if hook.Secret != "" {
+		timestamp := time.Now()
+		req.Header.Set(webhook.SignatureHeader, fmt.Sprintf("t=%d,v1=%s", timestamp.Unix(), webhook.Sign(hook.Secret, timestamp, body)))
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	reply, err := io.ReadAll(io.LimitReader(resp.Body, maxReplySize))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, errors.Errorf("status code %d, response body: %s", resp.StatusCode, reply)
+	}
+	return reply, nil
+}
+
+func run(ctx context.Context, hook *Hook, body []byte) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, hook.Command[0], hook.Command[1:]...)
+	cmd.Stdin = bytes.NewReader(body)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		if stderr.Len() > 0 {
+			return nil, errors.Wrapf(err, "stderr: %s", bytes.TrimSpace(stderr.Bytes()))
+		}
+		return nil, err
+	}
+	if stdout.Len() > maxReplySize {
+		return nil, errors.New("reply too large")
+	}
+	return stdout.Bytes(), nil
+}
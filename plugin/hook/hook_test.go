@@ -0,0 +1,157 @@
+package hook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValidate(t *testing.T) {
+	config := &Config{Hooks: []*Hook{
+		{Name: "check", Event: EventMemoPreCreate, URL: "http://localhost:8000", Timeout: "2s"},
+		{Name: "archive", Event: EventMemoPostCreate, Command: []string{"archive"}},
+	}}
+	require.NoError(t, config.Validate())
+	require.Equal(t, defaultTimeout, config.Hooks[1].timeout)
+
+	for _, hook := range []*Hook{
+		{Event: EventMemoPreCreate, URL: "http://localhost:8000"},
+		{Name: "check", Event: "memo.deleted", URL: "http://localhost:8000"},
+		{Name: "check", Event: EventMemoPreCreate},
+		{Name: "check", Event: EventMemoPreCreate, URL: "http://localhost:8000", Command: []string{"check"}},
+		{Name: "check", Event: EventMemoPreCreate, URL: "http://localhost:8000", Timeout: "soon"},
+	} {
+		require.Error(t, (&Config{Hooks: []*Hook{hook}}).Validate())
+	}
+	require.Error(t, (&Config{Hooks: []*Hook{
+		{Name: "check", Event: EventMemoPreCreate, URL: "http://localhost:8000"},
+		{Name: "check", Event: EventMemoPreRender, URL: "http://localhost:8000"},
+	}}).Validate())
+}
+
+func TestPreCreate(t *testing.T) {
+	// The first hook rejects the spam, and the second one expands the abbreviations.
+	reject := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := &Request{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(request))
+		require.Equal(t, EventMemoPreCreate, request.Event)
+		if strings.Contains(request.Memo.Content, "spam") {
+			_ = json.NewEncoder(w).Encode(&Response{Reject: true, Message: "no spam"})
+		}
+	}))
+	defer reject.Close()
+	expand := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := &Request{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(request))
+		content := strings.ReplaceAll(request.Memo.Content, "asap", "as soon as possible")
+		_ = json.NewEncoder(w).Encode(&Response{Content: &content})
+	}))
+	defer expand.Close()
+
+	config := &Config{Hooks: []*Hook{
+		{Name: "reject", Event: EventMemoPreCreate, URL: reject.URL},
+		{Name: "expand", Event: EventMemoPreCreate, URL: expand.URL},
+	}}
+	require.NoError(t, config.Validate())
+	runner := NewRunner(config)
+	ctx := context.Background()
+
+	content, err := runner.PreCreate(ctx, &Memo{Creator: "users/1", Content: "Reply asap", Visibility: "PRIVATE"})
+	require.NoError(t, err)
+	require.Equal(t, "Reply as soon as possible", content)
+	_, err = runner.PreCreate(ctx, &Memo{Creator: "users/1", Content: "Buy spam", Visibility: "PRIVATE"})
+	rejectedErr := &RejectedError{}
+	require.ErrorAs(t, err, &rejectedErr)
+	require.Equal(t, "reject", rejectedErr.Hook)
+	require.Equal(t, "no spam", rejectedErr.Message)
+
+	// The failures of the hooks reject the memos, unless the hooks are optional.
+	reject.Close()
+	_, err = runner.PreCreate(ctx, &Memo{Content: "Reply asap"})
+	require.Error(t, err)
+	config.Hooks[0].Optional = true
+	content, err = runner.PreCreate(ctx, &Memo{Content: "Reply asap"})
+	require.NoError(t, err)
+	require.Equal(t, "Reply as soon as possible", content)
+
+	// A nil runner has no hooks.
+	var noHooks *Runner
+	content, err = noHooks.PreCreate(ctx, &Memo{Content: "Reply asap"})
+	require.NoError(t, err)
+	require.Equal(t, "Reply asap", content)
+}
+
+func TestRenderCommand(t *testing.T) {
+	config := &Config{Hooks: []*Hook{
+		{Name: "shout", Event: EventMemoPreRender, Command: []string{"sh", "-c", `echo '{"content": "HELLO"}'`}},
+	}}
+	require.NoError(t, config.Validate())
+	runner := NewRunner(config)
+	ctx := context.Background()
+	require.Equal(t, "HELLO", runner.Render(ctx, &Memo{Name: "memos/1", Content: "hello"}))
+
+	// The rendered contents are cached, and the memo is rendered as is if a hook fails.
+	config.Hooks[0].Command = []string{"sh", "-c", "exit 1"}
+	require.Equal(t, "HELLO", runner.Render(ctx, &Memo{Name: "memos/1", Content: "hello"}))
+	require.Equal(t, "bye", runner.Render(ctx, &Memo{Name: "memos/1", Content: "bye"}))
+}
+
+func TestRenderAll(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		request := &Request{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(request))
+		if request.Memo.Content == "slow" {
+			<-r.Context().Done()
+			return
+		}
+		time.Sleep(500 * time.Millisecond)
+		content := strings.ToUpper(request.Memo.Content)
+		require.NoError(t, json.NewEncoder(w).Encode(&Response{Content: &content}))
+	}))
+	defer server.Close()
+	runner := NewRunner(&Config{Hooks: []*Hook{{Name: "shout", Event: EventMemoPreRender, URL: server.URL}}})
+	ctx := context.Background()
+
+	// The memos are rendered concurrently, and those not rendered in time are rendered as is.
+	memos := []*Memo{}
+	for _, content := range []string{"a", "b", "c", "d", "slow"} {
+		memos = append(memos, &Memo{Name: "memos/" + content, Content: content})
+	}
+	start := time.Now()
+	require.Equal(t, []string{"A", "B", "C", "D", "slow"}, runner.RenderAll(ctx, memos))
+	require.Less(t, time.Since(start), renderTimeout+time.Second)
+
+	// The rendered contents are cached, as are the failures for a while.
+	require.Equal(t, []string{"A", "B", "C", "D", "slow"}, runner.RenderAll(ctx, memos))
+	require.Equal(t, int32(5), calls.Load())
+}
+
+func TestPostCreate(t *testing.T) {
+	calls := atomic.Int32{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{Hooks: []*Hook{{Name: "archive", Event: EventMemoPostCreate, URL: server.URL}}}
+	require.NoError(t, config.Validate())
+	runner := NewRunner(config)
+	ctx := context.Background()
+	memo := &Memo{Name: "memos/1", Creator: "users/1", Content: "hello", Visibility: "PUBLIC"}
+	require.Error(t, runner.PostCreate(ctx, "archive", memo))
+	require.NoError(t, runner.PostCreate(ctx, "archive", memo))
+	// The hooks removed from the configuration are skipped.
+	require.NoError(t, runner.PostCreate(ctx, "backup", memo))
+	require.Equal(t, int32(2), calls.Load())
+}
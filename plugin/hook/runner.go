@@ -0,0 +1,173 @@
+package hook
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/usememos/memos/store/cache"
+)
+
+const (
+	// renderCacheSize is the number of the contents transformed by the memo.pre_render hooks which are cached.
+	renderCacheSize = 1000
+	// renderCacheTTL is the time the transformed contents are cached for, after which the hooks are called again.
+	renderCacheTTL = time.Hour
+	// renderFailureTTL is the time the memos whose memo.pre_render hooks failed are rendered as is,
+	// so that a failing hook does not delay every read.
+	renderFailureTTL = time.Minute
+	// renderTimeout is the time rendering a memo, or a page of memos, takes at most. The memos whose hooks
+	// do not reply in time are rendered as is, whatever the timeouts of the hooks.
+	renderTimeout = 3 * time.Second
+	// renderConcurrency is the number of the memos of a page rendered at once.
+	renderConcurrency = 8
+)
+
+// Runner calls the hooks of a configuration. A nil runner has no hooks.
+type Runner struct {
+	hooks map[string][]*Hook
+	// renderCache caches the contents transformed by the memo.pre_render hooks, as the memos are rendered at each read.
+	renderCache *cache.LRU
+}
+
+func NewRunner(config *Config) *Runner {
+	r := &Runner{
+		hooks: map[string][]*Hook{},
+		renderCache: cache.NewLRU(cache.Config{
+			DefaultTTL: renderCacheTTL,
+			MaxItems:   renderCacheSize,
+		}),
+	}
+	for _, hook := range config.Hooks {
+		r.hooks[hook.Event] = append(r.hooks[hook.Event], hook)
+	}
+	return r
+}
+
+// Hooks returns the hooks of the event, in the order of the configuration.
+func (r *Runner) Hooks(event string) []*Hook {
+	if r == nil {
+		return nil
+	}
+	return r.hooks[event]
+}
+
+// GetHook returns the hook of the name, nil if there is none.
+func (r *Runner) GetHook(name string) *Hook {
+	if r == nil {
+		return nil
+	}
+	for _, hooks := range r.hooks {
+		for _, hook := range hooks {
+			if hook.Name == name {
+				return hook
+			}
+		}
+	}
+	return nil
+}
+
+// PreCreate calls the memo.pre_create hooks and returns the content of the memo once replaced by them.
+// It returns a RejectedError if a hook rejects the memo, or the error of a hook that is not optional.
+func (r *Runner) PreCreate(ctx context.Context, memo *Memo) (string, error) {
+	content := memo.Content
+	for _, hook := range r.Hooks(EventMemoPreCreate) {
+		hookMemo := *memo
+		hookMemo.Content = content
+		response, err := Call(ctx, hook, &Request{Event: EventMemoPreCreate, Memo: &hookMemo})
+		if err != nil {
+			if hook.Optional {
+				slog.Warn("Failed to call optional hook", slog.String("hook", hook.Name), slog.Any("err", err))
+				continue
+			}
+			return "", err
+		}
+		if response.Reject {
+			return "", &RejectedError{Hook: hook.Name, Message: response.Message}
+		}
+		if response.Content != nil {
+			content = *response.Content
+		}
+	}
+	return content, nil
+}
+
+// Render returns the content of the memo to render once replaced by the memo.pre_render hooks. The content is
+// rendered as is if a hook fails, and the replaced contents are cached by the name and the content of the memo.
+func (r *Runner) Render(ctx context.Context, memo *Memo) string {
+	if len(r.Hooks(EventMemoPreRender)) == 0 || memo.Content == "" {
+		return memo.Content
+	}
+	ctx, cancel := context.WithTimeout(ctx, renderTimeout)
+	defer cancel()
+	return r.render(ctx, memo)
+}
+
+// RenderAll renders the memos of a page like Render, calling the hooks of the memos concurrently within renderTimeout
+// so that a page of memos not cached takes no longer to render than one.
+func (r *Runner) RenderAll(ctx context.Context, memos []*Memo) []string {
+	contents := make([]string, len(memos))
+	if len(r.Hooks(EventMemoPreRender)) == 0 {
+		for i, memo := range memos {
+			contents[i] = memo.Content
+		}
+		return contents
+	}
+	ctx, cancel := context.WithTimeout(ctx, renderTimeout)
+	defer cancel()
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, renderConcurrency)
+	for i, memo := range memos {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			contents[i] = r.render(ctx, memo)
+		}()
+	}
+	wg.Wait()
+	return contents
+}
+
+func (r *Runner) render(ctx context.Context, memo *Memo) string {
+	if memo.Content == "" {
+		return memo.Content
+	}
+	sum := sha256.Sum256([]byte(memo.Content))
+	key := memo.Name + ":" + hex.EncodeToString(sum[:])
+	if content, ok := r.renderCache.Get(ctx, key); ok {
+		return content.(string)
+	}
+
+	content := memo.Content
+	for _, hook := range r.Hooks(EventMemoPreRender) {
+		hookMemo := *memo
+		hookMemo.Content = content
+		response, err := Call(ctx, hook, &Request{Event: EventMemoPreRender, Memo: &hookMemo})
+		if err != nil {
+			slog.Warn("Failed to call render hook", slog.String("hook", hook.Name), slog.Any("err", err))
+			r.renderCache.SetWithTTL(ctx, key, memo.Content, renderFailureTTL)
+			return memo.Content
+		}
+		if response.Content != nil {
+			content = *response.Content
+		}
+	}
+	r.renderCache.Set(ctx, key, content)
+	return content
+}
+
+// PostCreate calls the memo.post_create hook of the name, e.g. from the job queue retrying it on failures.
+// The hooks removed from the configuration since the memo was created are skipped.
+func (r *Runner) PostCreate(ctx context.Context, name string, memo *Memo) error {
+	hook := r.GetHook(name)
+	if hook == nil || hook.Event != EventMemoPostCreate {
+		return nil
+	}
+	_, err := Call(ctx, hook, &Request{Event: EventMemoPostCreate, Memo: memo})
+	return err
+}
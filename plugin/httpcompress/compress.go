@@ -0,0 +1,263 @@
+// Package httpcompress compresses the HTTP responses with Brotli or gzip, as accepted by the clients, e.g. the JSON
+// responses of the API, the feeds and the exports. The media already compressed, e.g. the images, the videos and the
+// archives, are sent as is, as are the responses too small to be worth it.
+package httpcompress
+
+import (
+	"bufio"
+	"compress/gzip"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	"github.com/pkg/errors"
+)
+
+const (
+	encodingBrotli = "br"
+	encodingGzip   = "gzip"
+)
+
+// MinSize is the size in bytes under which the responses are not compressed, as they would barely shrink.
+const MinSize = 1024
+
+// compressibleTypes are the media types of the responses compressed besides the text ones.
+var compressibleTypes = map[string]bool{
+	"application/json":          true,
+	"application/feed+json":     true,
+	"application/manifest+json": true,
+	"application/x-ndjson":      true,
+	"application/xml":           true,
+	"application/rss+xml":       true,
+	"application/atom+xml":      true,
+	"application/javascript":    true,
+	"application/wasm":          true,
+	"image/svg+xml":             true,
+}
+
+// Handler compresses the responses of the handler with the best encoding accepted by the client.
+// The range requests are not compressed, as the ranges apply to the original content.
+func Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encoding := negotiateEncoding(r.Header.Get("Accept-Encoding"))
+		if encoding == "" || r.Method == http.MethodHead || r.Header.Get("Range") != "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		cw := NewResponseWriter(w, encoding)
+		defer cw.Close()
+		next.ServeHTTP(cw, r)
+	})
+}
+
+// negotiateEncoding returns the encoding of the Accept-Encoding header, Brotli being preferred to gzip,
+// or an empty string if the client accepts neither.
+func negotiateEncoding(acceptEncoding string) string {
+	accepted := map[string]bool{}
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if weight, err := strconv.ParseFloat(q, 64); err == nil && weight <= 0 {
+				continue
+			}
+		}
+		accepted[name] = true
+	}
+	switch {
+	case accepted[encodingBrotli]:
+		return encodingBrotli
+	case accepted[encodingGzip], accepted["*"]:
+		return encodingGzip
+	default:
+		return ""
+	}
+}
+
+// isCompressible returns whether the responses of the content type are compressed.
+func isCompressible(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return strings.HasPrefix(mediaType, "text/") || compressibleTypes[mediaType]
+}
+
+// ResponseWriter compresses the response written to it, once it knows that the response is worth it:
+// the body is buffered until it reaches MinSize, and sent as is if it is smaller.
+type ResponseWriter struct {
+	http.ResponseWriter
+	encoding string
+
+	status      int
+	wroteHeader bool
+	// buf is the beginning of the body, until the response is compressed or sent as is.
+	buf []byte
+	// encoder compresses the body once the response is compressed, and passthrough sends the body as is.
+	encoder     io.WriteCloser
+	passthrough bool
+}
+
+// NewResponseWriter returns a writer compressing the response with the encoding, "br" or "gzip".
+// It must be closed once the response is written.
+func NewResponseWriter(w http.ResponseWriter, encoding string) *ResponseWriter {
+	return &ResponseWriter{
+		ResponseWriter: w,
+		encoding:       encoding,
+		status:         http.StatusOK,
+	}
+}
+
+func (w *ResponseWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	// The informational responses are sent at once, before the final one.
+	if status >= 100 && status < 200 {
+		w.ResponseWriter.WriteHeader(status)
+		return
+	}
+	w.status, w.wroteHeader = status, true
+
+	header := w.Header()
+	if contentType := header.Get("Content-Type"); contentType == "" || isCompressible(contentType) {
+		header.Add("Vary", "Accept-Encoding")
+	}
+	if status == http.StatusNoContent || status == http.StatusNotModified || status == http.StatusPartialContent ||
+		header.Get("Content-Encoding") != "" || header.Get("Content-Range") != "" {
+		w.startPassthrough()
+		return
+	}
+	if contentType := header.Get("Content-Type"); contentType != "" && !isCompressible(contentType) {
+		w.startPassthrough()
+		return
+	}
+	if contentLength, err := strconv.Atoi(header.Get("Content-Length")); err == nil && contentLength < MinSize {
+		w.startPassthrough()
+	}
+}
+
+func (w *ResponseWriter) Write(p []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	switch {
+	case w.passthrough:
+		return w.ResponseWriter.Write(p)
+	case w.encoder != nil:
+		return w.encoder.Write(p)
+	}
+	w.buf = append(w.buf, p...)
+	if len(w.buf) >= MinSize {
+		if err := w.startEncoding(); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush sends the buffered response, compressed if its type is worth it, e.g. for the streamed responses.
+func (w *ResponseWriter) Flush() {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if !w.passthrough && w.encoder == nil {
+		if err := w.startEncoding(); err != nil {
+			return
+		}
+	}
+	if flusher, ok := w.encoder.(interface{ Flush() error }); ok {
+		_ = flusher.Flush()
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Close sends the rest of the response.
+func (w *ResponseWriter) Close() error {
+	if !w.wroteHeader {
+		// Nothing was written, the handler having taken over the connection or left the response empty.
+		return nil
+	}
+	if w.encoder != nil {
+		return w.encoder.Close()
+	}
+	if !w.passthrough {
+		// The whole response is smaller than MinSize.
+		w.startPassthrough()
+	}
+	return nil
+}
+
+// ReadFrom lets the underlying writer copy the responses sent as is, e.g. with sendfile for the files.
+func (w *ResponseWriter) ReadFrom(src io.Reader) (int64, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if readerFrom, ok := w.ResponseWriter.(io.ReaderFrom); ok && w.passthrough {
+		return readerFrom.ReadFrom(src)
+	}
+	// The writer is hidden from io.Copy, which would call ReadFrom again.
+	return io.Copy(struct{ io.Writer }{w}, src)
+}
+
+// Hijack lets the handlers take over the connection, e.g. for the WebSockets.
+func (w *ResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not support hijacking")
+	}
+	return hijacker.Hijack()
+}
+
+// Unwrap returns the underlying writer, for http.ResponseController.
+func (w *ResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// startPassthrough sends the header of the response to send as is, followed by the buffered body if any.
+func (w *ResponseWriter) startPassthrough() {
+	w.passthrough = true
+	w.ResponseWriter.WriteHeader(w.status)
+	if len(w.buf) > 0 {
+		buf := w.buf
+		w.buf = nil
+		_, _ = w.ResponseWriter.Write(buf)
+	}
+}
+
+// startEncoding sends the header of the compressed response and compresses the buffered body,
+// unless the type of the content sniffed from it is not worth it.
+func (w *ResponseWriter) startEncoding() error {
+	header := w.Header()
+	if header.Get("Content-Type") == "" && len(w.buf) > 0 {
+		// The content type is sniffed as by net/http, which would sniff it from the compressed body otherwise.
+		contentType := http.DetectContentType(w.buf)
+		header.Set("Content-Type", contentType)
+		if !isCompressible(contentType) {
+			w.startPassthrough()
+			return nil
+		}
+	}
+	header.Set("Content-Encoding", w.encoding)
+	header.Del("Content-Length")
+	// The strong validators identify the original content.
+	if etag := header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+		header.Set("ETag", "W/"+etag)
+	}
+	w.ResponseWriter.WriteHeader(w.status)
+	if w.encoding == encodingBrotli {
+		w.encoder = brotli.NewWriterLevel(w.ResponseWriter, brotli.DefaultCompression)
+	} else {
+		w.encoder = gzip.NewWriter(w.ResponseWriter)
+	}
+	buf := w.buf
+	w.buf = nil
+	_, err := w.encoder.Write(buf)
+	return err
+}
@@ -0,0 +1,182 @@
+package httpcompress
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/stretchr/testify/require"
+)
+
+func serve(t *testing.T, handler http.HandlerFunc, header http.Header) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/memos", nil)
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	rec := httptest.NewRecorder()
+	Handler(handler).ServeHTTP(rec, req)
+	return rec
+}
+
+func writeBody(contentType, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		if contentType != "" {
+			w.Header().Set("Content-Type", contentType)
+		}
+		_, _ = io.WriteString(w, body)
+	}
+}
+
+func TestNegotiateEncoding(t *testing.T) {
+	tests := []struct {
+		acceptEncoding string
+		want           string
+	}{
+		{"", ""},
+		{"identity", ""},
+		{"gzip", "gzip"},
+		{"gzip, deflate, br", "br"},
+		{"br;q=0, gzip;q=0.8", "gzip"},
+		{"GZIP", "gzip"},
+		{"*", "gzip"},
+		{"gzip;q=0", ""},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, negotiateEncoding(test.acceptEncoding), test.acceptEncoding)
+	}
+}
+
+func TestHandlerCompressesJSON(t *testing.T) {
+	body := `{"memos":[` + strings.Repeat(`{"content":"hello world"},`, 200) + `{}]}`
+
+	rec := serve(t, writeBody("application/json", body), http.Header{"Accept-Encoding": {"gzip"}})
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+	require.Equal(t, "Accept-Encoding", rec.Header().Get("Vary"))
+	require.Less(t, rec.Body.Len(), len(body))
+	reader, err := gzip.NewReader(rec.Body)
+	require.NoError(t, err)
+	decoded, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, body, string(decoded))
+
+	rec = serve(t, writeBody("application/rss+xml; charset=utf-8", body), http.Header{"Accept-Encoding": {"gzip, br"}})
+	require.Equal(t, "br", rec.Header().Get("Content-Encoding"))
+	decoded, err = io.ReadAll(brotli.NewReader(rec.Body))
+	require.NoError(t, err)
+	require.Equal(t, body, string(decoded))
+}
+
+func TestHandlerSniffsContentType(t *testing.T) {
+	body := strings.Repeat("plain text ", 200)
+	rec := serve(t, writeBody("", body), http.Header{"Accept-Encoding": {"gzip"}})
+	require.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+	require.Equal(t, "text/plain; charset=utf-8", rec.Header().Get("Content-Type"))
+
+	png := "\x89PNG\r\n\x1a\n" + strings.Repeat("\x00", 2000)
+	rec = serve(t, writeBody("", png), http.Header{"Accept-Encoding": {"gzip"}})
+	require.Empty(t, rec.Header().Get("Content-Encoding"))
+	require.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+	require.Equal(t, png, rec.Body.String())
+}
+
+func TestHandlerSkipsResponses(t *testing.T) {
+	body := strings.Repeat("a", 4096)
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		header  http.Header
+	}{
+		{"no accept encoding", writeBody("text/plain", body), http.Header{}},
+		{"compressed media", writeBody("image/jpeg", body), http.Header{"Accept-Encoding": {"gzip"}}},
+		{"archive", writeBody("application/zip", body), http.Header{"Accept-Encoding": {"br"}}},
+		{"grpc-web", writeBody("application/grpc-web+proto", body), http.Header{"Accept-Encoding": {"gzip"}}},
+		{"small body", writeBody("application/json", `{"name":"memos/1"}`), http.Header{"Accept-Encoding": {"gzip"}}},
+		{"range", writeBody("text/plain", body), http.Header{"Accept-Encoding": {"gzip"}, "Range": {"bytes=0-99"}}},
+		{"already encoded", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Encoding", "gzip")
+			writeBody("application/json", body)(w, nil)
+		}, http.Header{"Accept-Encoding": {"br"}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rec := serve(t, test.handler, test.header)
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.NotEqual(t, "br", rec.Header().Get("Content-Encoding"))
+			if test.name != "already encoded" {
+				require.Empty(t, rec.Header().Get("Content-Encoding"))
+			}
+			require.NotEmpty(t, rec.Body.String())
+		})
+	}
+}
+
+func TestHandlerKeepsStatusAndFlushes(t *testing.T) {
+	rec := serve(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Content-Length", "4096")
+		w.Header().Set("ETag", `"abc"`)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = io.WriteString(w, "data: hello\n\n")
+		w.(http.Flusher).Flush()
+		_, _ = io.WriteString(w, "data: world\n\n")
+	}, http.Header{"Accept-Encoding": {"gzip"}})
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+	require.Empty(t, rec.Header().Get("Content-Length"))
+	require.Equal(t, `W/"abc"`, rec.Header().Get("ETag"))
+	require.True(t, rec.Flushed)
+	reader, err := gzip.NewReader(rec.Body)
+	require.NoError(t, err)
+	decoded, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, "data: hello\n\ndata: world\n\n", string(decoded))
+}
+
+// readerFromRecorder records whether the response is copied with ReadFrom.
+type readerFromRecorder struct {
+	*httptest.ResponseRecorder
+	readFrom bool
+}
+
+func (r *readerFromRecorder) ReadFrom(src io.Reader) (int64, error) {
+	r.readFrom = true
+	return io.Copy(r.ResponseRecorder, src)
+}
+
+func TestHandlerReadFrom(t *testing.T) {
+	readFrom := func(contentType, body string) *readerFromRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/file/attachments/1", nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+		rec := &readerFromRecorder{ResponseRecorder: httptest.NewRecorder()}
+		Handler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", contentType)
+			_, err := w.(io.ReaderFrom).ReadFrom(strings.NewReader(body))
+			require.NoError(t, err)
+		})).ServeHTTP(rec, req)
+		return rec
+	}
+
+	// The responses sent as is are copied by the underlying writer.
+	png := "\x89PNG\r\n\x1a\n" + strings.Repeat("\x00", 2000)
+	rec := readFrom("image/png", png)
+	require.True(t, rec.readFrom)
+	require.Empty(t, rec.Header().Get("Content-Encoding"))
+	require.Equal(t, png, rec.Body.String())
+
+	// The others are still compressed.
+	text := strings.Repeat("plain text ", 200)
+	rec = readFrom("text/plain", text)
+	require.False(t, rec.readFrom)
+	require.Equal(t, "gzip", rec.Header().Get("Content-Encoding"))
+	reader, err := gzip.NewReader(rec.Body)
+	require.NoError(t, err)
+	decoded, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, text, string(decoded))
+}
@@ -1,11 +1,16 @@
 package httpgetter
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
 	"net/url"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/pkg/errors"
 	"golang.org/x/net/html"
@@ -14,7 +19,36 @@ import (
 
 var ErrInternalIP = errors.New("internal IP addresses are not allowed")
 
+const (
+	// timeout is the timeout of the requests of the pages.
+	timeout = 10 * time.Second
+	// maxHTMLSize bounds the bytes of a page read.
+	maxHTMLSize = 5 << 20
+)
+
 var httpClient = &http.Client{
+	Timeout: timeout,
+	// The addresses are checked again when connecting, as the hostnames may resolve to other IPs than when validated.
+	// No proxy is used, the checks would apply to the proxy instead of the pages.
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: timeout,
+			Control: func(_, address string, _ syscall.RawConn) error {
+				host, _, err := net.SplitHostPort(address)
+				if err != nil {
+					return err
+				}
+				if ip := net.ParseIP(host); ip == nil || isInternalIP(ip) {
+					return errors.Wrap(ErrInternalIP, host)
+				}
+				return nil
+			},
+		}).DialContext,
+		TLSHandshakeTimeout:   timeout,
+		ResponseHeaderTimeout: timeout,
+		MaxIdleConns:          10,
+		IdleConnTimeout:       90 * time.Second,
+	},
 	CheckRedirect: func(req *http.Request, via []*http.Request) error {
 		if err := validateURL(req.URL.String()); err != nil {
 			return errors.Wrap(err, "redirect to internal IP")
@@ -26,22 +60,64 @@ var httpClient = &http.Client{
 	},
 }
 
+// Client returns the HTTP client of the getter, which refuses to connect to the internal addresses, also when redirected,
+// for the requests to the URLs given by the users.
+func Client() *http.Client {
+	return httpClient
+}
+
 type HTMLMeta struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
 	Image       string `json:"image"`
+	Favicon     string `json:"favicon"`
+	SiteName    string `json:"siteName"`
 }
 
 func GetHTMLMeta(urlStr string) (*HTMLMeta, error) {
+	return GetHTMLMetaWithContext(context.Background(), urlStr)
+}
+
+// GetHTMLMetaWithContext returns the metadata of the HTML page of the URL, refusing the internal addresses.
+// The image and favicon URLs are absolute, the favicon defaulting to the /favicon.ico of the site.
+func GetHTMLMetaWithContext(ctx context.Context, urlStr string) (*HTMLMeta, error) {
+	page, err := GetHTML(ctx, urlStr)
+	if err != nil {
+		return nil, err
+	}
+	htmlMeta := extractHTMLMeta(bytes.NewReader(page.Body))
+	resolveHTMLMetaURLs(page.URL, htmlMeta)
+	enrichSiteMeta(page.URL, htmlMeta)
+	return htmlMeta, nil
+}
+
+// HTMLPage is an HTML page fetched by GetHTML.
+type HTMLPage struct {
+	// URL is the URL of the page once redirected.
+	URL *url.URL
+	// Body is the HTML of the page, truncated to maxHTMLSize.
+	Body []byte
+}
+
+// GetHTML fetches the HTML page of the URL, refusing the internal addresses.
+func GetHTML(ctx context.Context, urlStr string) (*HTMLPage, error) {
 	if err := validateURL(urlStr); err != nil {
 		return nil, err
 	}
 
-	response, err := httpClient.Get(urlStr)
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", "text/html")
+	response, err := httpClient.Do(request)
 	if err != nil {
 		return nil, err
 	}
 	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return nil, errors.Errorf("unexpected status code %d", response.StatusCode)
+	}
 
 	mediatype, err := getMediatype(response)
 	if err != nil {
@@ -51,11 +127,11 @@ func GetHTMLMeta(urlStr string) (*HTMLMeta, error) {
 		return nil, errors.New("not a HTML page")
 	}
 
-	// TODO: limit the size of the response body
-
-	htmlMeta := extractHTMLMeta(response.Body)
-	enrichSiteMeta(response.Request.URL, htmlMeta)
-	return htmlMeta, nil
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxHTMLSize))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read page")
+	}
+	return &HTMLPage{URL: response.Request.URL, Body: body}, nil
 }
 
 func extractHTMLMeta(resp io.Reader) *HTMLMeta {
@@ -96,6 +172,15 @@ func extractHTMLMeta(resp io.Reader) *HTMLMeta {
 				if ok {
 					htmlMeta.Image = ogImage
 				}
+
+				ogSiteName, ok := extractMetaProperty(token, "og:site_name")
+				if ok {
+					htmlMeta.SiteName = ogSiteName
+				}
+			} else if token.DataAtom == atom.Link && htmlMeta.Favicon == "" {
+				if favicon, ok := extractIconLink(token); ok {
+					htmlMeta.Favicon = favicon
+				}
 			}
 		}
 	}
@@ -106,7 +191,7 @@ func extractHTMLMeta(resp io.Reader) *HTMLMeta {
 func extractMetaProperty(token html.Token, prop string) (content string, ok bool) {
 	content, ok = "", false
 	for _, attr := range token.Attr {
-		if attr.Key == "property" && attr.Val == prop {
+		if (attr.Key == "property" || attr.Key == "name") && attr.Val == prop {
 			ok = true
 		}
 		if attr.Key == "content" {
@@ -116,6 +201,46 @@ func extractMetaProperty(token html.Token, prop string) (content string, ok bool
 	return content, ok
 }
 
+// extractIconLink returns the href of the link of the icon of the site.
+func extractIconLink(token html.Token) (href string, ok bool) {
+	isIcon := false
+	for _, attr := range token.Attr {
+		switch attr.Key {
+		case "rel":
+			for _, rel := range strings.Fields(strings.ToLower(attr.Val)) {
+				if rel == "icon" || rel == "apple-touch-icon" {
+					isIcon = true
+				}
+			}
+		case "href":
+			href = attr.Val
+		}
+	}
+	return href, isIcon && href != ""
+}
+
+// resolveHTMLMetaURLs makes the image and favicon URLs absolute, relative to the URL of the page.
+func resolveHTMLMetaURLs(pageURL *url.URL, meta *HTMLMeta) {
+	if meta.Favicon == "" {
+		meta.Favicon = "/favicon.ico"
+	}
+	for _, value := range []*string{&meta.Image, &meta.Favicon} {
+		if *value == "" {
+			continue
+		}
+		u, err := pageURL.Parse(*value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			*value = ""
+			continue
+		}
+		*value = u.String()
+	}
+}
+
+func isInternalIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified()
+}
+
 func validateURL(urlStr string) error {
 	u, err := url.Parse(urlStr)
 	if err != nil {
@@ -133,7 +258,7 @@ func validateURL(urlStr string) error {
 
 	// check if the hostname is an IP
 	if ip := net.ParseIP(host); ip != nil {
-		if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() {
+		if isInternalIP(ip) {
 			return errors.Wrap(ErrInternalIP, ip.String())
 		}
 		return nil
@@ -146,7 +271,7 @@ func validateURL(urlStr string) error {
 	}
 
 	for _, ip := range ips {
-		if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() {
+		if isInternalIP(ip) {
 			return errors.Wrapf(ErrInternalIP, "host=%s, ip=%s", host, ip.String())
 		}
 	}
@@ -2,6 +2,9 @@ package httpgetter
 
 import (
 	"errors"
+	"net"
+	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -30,3 +33,37 @@ func TestGetHTMLMetaForInternal(t *testing.T) {
 		t.Errorf("Expected error for resolved internal IP, got %v", err)
 	}
 }
+
+func TestExtractHTMLMeta(t *testing.T) {
+	htmlMeta := extractHTMLMeta(strings.NewReader(`<html><head>
+<title>Fallback</title>
+<meta name="description" content="A page.">
+<meta property="og:title" content="Title">
+<meta property="og:site_name" content="Example">
+<meta property="og:image" content="/cover.png">
+<link rel="shortcut icon" href="icons/favicon.png">
+</head><body><link rel="icon" href="/ignored.png"></body></html>`))
+	pageURL, err := url.Parse("https://example.com/posts/1")
+	require.NoError(t, err)
+	resolveHTMLMetaURLs(pageURL, htmlMeta)
+	require.Equal(t, HTMLMeta{
+		Title:       "Title",
+		Description: "A page.",
+		Image:       "https://example.com/cover.png",
+		Favicon:     "https://example.com/posts/icons/favicon.png",
+		SiteName:    "Example",
+	}, *htmlMeta)
+
+	htmlMeta = extractHTMLMeta(strings.NewReader(`<html><head><title>Page</title></head></html>`))
+	resolveHTMLMetaURLs(pageURL, htmlMeta)
+	require.Equal(t, "https://example.com/favicon.ico", htmlMeta.Favicon)
+}
+
+func TestIsInternalIP(t *testing.T) {
+	for _, ip := range []string{"127.0.0.1", "10.0.0.1", "192.168.1.1", "169.254.169.254", "0.0.0.0", "::1", "fd00::1"} {
+		require.True(t, isInternalIP(net.ParseIP(ip)), ip)
+	}
+	for _, ip := range []string{"93.184.216.34", "2606:4700::6810:84e5"} {
+		require.False(t, isInternalIP(net.ParseIP(ip)), ip)
+	}
+}
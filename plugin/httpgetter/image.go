@@ -1,28 +1,44 @@
 package httpgetter
 
 import (
-	"errors"
+	"context"
 	"io"
 	"net/http"
-	"net/url"
 	"strings"
+
+	"github.com/pkg/errors"
 )
 
+// maxImageSize bounds the bytes of an image downloaded.
+const maxImageSize = 10 << 20
+
 type Image struct {
 	Blob      []byte
 	Mediatype string
 }
 
 func GetImage(urlStr string) (*Image, error) {
-	if _, err := url.Parse(urlStr); err != nil {
+	return GetImageWithContext(context.Background(), urlStr)
+}
+
+// GetImageWithContext downloads the image of the URL, refusing the internal addresses and the images above maxImageSize.
+func GetImageWithContext(ctx context.Context, urlStr string) (*Image, error) {
+	if err := validateURL(urlStr); err != nil {
 		return nil, err
 	}
 
-	response, err := http.Get(urlStr)
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := httpClient.Do(request)
 	if err != nil {
 		return nil, err
 	}
 	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return nil, errors.Errorf("unexpected status code %d", response.StatusCode)
+	}
 
 	mediatype, err := getMediatype(response)
 	if err != nil {
@@ -32,10 +48,13 @@ func GetImage(urlStr string) (*Image, error) {
 		return nil, errors.New("wrong image mediatype")
 	}
 
-	bodyBytes, err := io.ReadAll(response.Body)
+	bodyBytes, err := io.ReadAll(io.LimitReader(response.Body, maxImageSize+1))
 	if err != nil {
 		return nil, err
 	}
+	if len(bodyBytes) > maxImageSize {
+		return nil, errors.New("the image is too large")
+	}
 
 	image := &Image{
 		Blob:      bodyBytes,
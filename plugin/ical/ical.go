@@ -0,0 +1,92 @@
+// Package ical writes iCalendar (RFC 5545) calendars of events, to be subscribed to by the calendar applications.
+package ical
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// ContentType is the media type of the calendars.
+	ContentType = "text/calendar; charset=utf-8"
+
+	// maxLineLength is the maximum length in octets of the content lines, longer lines being folded.
+	maxLineLength = 75
+)
+
+// Calendar is a calendar of events.
+type Calendar struct {
+	// Name is the name of the calendar shown by the calendar applications.
+	Name   string
+	Events []*Event
+}
+
+// Event is an event of a calendar, either all-day or at a floating time shown in the time zone of the subscriber.
+type Event struct {
+	// UID is the unique and stable identifier of the event.
+	UID         string
+	Summary     string
+	Description string
+	URL         string
+	// Start is the date of the event if AllDay, or else its date and time regardless of its location.
+	Start  time.Time
+	AllDay bool
+	// Stamp is the last modification time of the event.
+	Stamp time.Time
+}
+
+// String returns the iCalendar object of the calendar.
+func (c *Calendar) String() string {
+	var builder strings.Builder
+	writeLine(&builder, "BEGIN:VCALENDAR")
+	writeLine(&builder, "VERSION:2.0")
+	writeLine(&builder, "PRODID:-//usememos//memos//EN")
+	writeLine(&builder, "CALSCALE:GREGORIAN")
+	if c.Name != "" {
+		writeLine(&builder, "X-WR-CALNAME:"+escapeText(c.Name))
+	}
+	for _, event := range c.Events {
+		writeLine(&builder, "BEGIN:VEVENT")
+		writeLine(&builder, "UID:"+escapeText(event.UID))
+		writeLine(&builder, "DTSTAMP:"+event.Stamp.UTC().Format("20060102T150405Z"))
+		if event.AllDay {
+			writeLine(&builder, "DTSTART;VALUE=DATE:"+event.Start.Format("20060102"))
+			writeLine(&builder, "DTEND;VALUE=DATE:"+event.Start.AddDate(0, 0, 1).Format("20060102"))
+		} else {
+			writeLine(&builder, "DTSTART:"+event.Start.Format("20060102T150405"))
+			writeLine(&builder, "DTEND:"+event.Start.Add(time.Hour).Format("20060102T150405"))
+		}
+		writeLine(&builder, "SUMMARY:"+escapeText(event.Summary))
+		if event.Description != "" {
+			writeLine(&builder, "DESCRIPTION:"+escapeText(event.Description))
+		}
+		if event.URL != "" {
+			writeLine(&builder, "URL:"+event.URL)
+		}
+		writeLine(&builder, "END:VEVENT")
+	}
+	writeLine(&builder, "END:VCALENDAR")
+	return builder.String()
+}
+
+// escapeText escapes the characters of the text values.
+func escapeText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`).Replace(text)
+}
+
+// writeLine writes the content line, folded every maxLineLength octets without splitting a character.
+func writeLine(builder *strings.Builder, line string) {
+	limit := maxLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		builder.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+		// The folded lines start with a space, which counts towards their length.
+		limit = maxLineLength - 1
+	}
+	builder.WriteString(line + "\r\n")
+}
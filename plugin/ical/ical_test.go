@@ -0,0 +1,84 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendarString(t *testing.T) {
+	stamp := time.Date(2025, 6, 1, 8, 30, 0, 0, time.UTC)
+	calendar := &Calendar{
+		Name: "jane's memos",
+		Events: []*Event{
+			{
+				UID:         "memo-1@memos",
+				Summary:     "Pay rent, water; gas",
+				Description: "line 1\nline 2",
+				URL:         "https://memos.example.com/memos/1",
+				Start:       time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+				AllDay:      true,
+				Stamp:       stamp,
+			},
+			{
+				UID:     "memo-2@memos",
+				Summary: "Dentist",
+				Start:   time.Date(2025, 7, 2, 14, 30, 0, 0, time.UTC),
+				Stamp:   stamp,
+			},
+		},
+	}
+	require.Equal(t, strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//usememos//memos//EN",
+		"CALSCALE:GREGORIAN",
+		"X-WR-CALNAME:jane's memos",
+		"BEGIN:VEVENT",
+		"UID:memo-1@memos",
+		"DTSTAMP:20250601T083000Z",
+		"DTSTART;VALUE=DATE:20250701",
+		"DTEND;VALUE=DATE:20250702",
+		`SUMMARY:Pay rent\, water\; gas`,
+		`DESCRIPTION:line 1\nline 2`,
+		"URL:https://memos.example.com/memos/1",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:memo-2@memos",
+		"DTSTAMP:20250601T083000Z",
+		"DTSTART:20250702T143000",
+		"DTEND:20250702T153000",
+		"SUMMARY:Dentist",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n"), calendar.String())
+}
+
+func TestWriteLine(t *testing.T) {
+	tests := []struct {
+		line string
+		want string
+	}{
+		{
+			line: "SUMMARY:short",
+			want: "SUMMARY:short\r\n",
+		},
+		{
+			line: "SUMMARY:" + strings.Repeat("a", 100),
+			want: "SUMMARY:" + strings.Repeat("a", 67) + "\r\n " + strings.Repeat("a", 33) + "\r\n",
+		},
+		{
+			// The multi-byte characters are not split.
+			line: "SUMMARY:" + strings.Repeat("a", 66) + "é",
+			want: "SUMMARY:" + strings.Repeat("a", 66) + "\r\n é\r\n",
+		},
+	}
+	for _, test := range tests {
+		var builder strings.Builder
+		writeLine(&builder, test.line)
+		require.Equal(t, test.want, builder.String())
+	}
+}
@@ -5,4 +5,6 @@ type IdentityProviderUserInfo struct {
 	DisplayName string
 	Email       string
 	AvatarURL   string
+	// Groups are the groups of the user, read if the identity provider maps them to roles.
+	Groups []string
 }
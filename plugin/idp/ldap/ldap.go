@@ -0,0 +1,156 @@
+// Package ldap is the plugin for LDAP and Active Directory Identity Provider.
+package ldap
+
+import (
+	"crypto/tls"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/idp"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// usernamePlaceholder is replaced by the escaped username in the user filter.
+const usernamePlaceholder = "{username}"
+
+// timeout bounds the connection to the server and each of its operations.
+const timeout = 10 * time.Second
+
+// ErrInvalidCredentials is returned when the user is not found in the directory or the password does not match.
+var ErrInvalidCredentials = errors.New("invalid username or password")
+
+// IdentityProvider represents an LDAP Identity Provider.
+type IdentityProvider struct {
+	config *storepb.LDAPConfig
+}
+
+// NewIdentityProvider initializes a new LDAP Identity Provider with the given configuration.
+func NewIdentityProvider(config *storepb.LDAPConfig) (*IdentityProvider, error) {
+	if config.GetFieldMapping() == nil {
+		return nil, errors.New(`the field "fieldMapping" is empty but required`)
+	}
+	for v, field := range map[string]string{
+		config.Url:                     "url",
+		config.BaseDn:                  "baseDn",
+		config.UserFilter:              "userFilter",
+		config.FieldMapping.Identifier: "fieldMapping.identifier",
+	} {
+		if v == "" {
+			return nil, errors.Errorf(`the field "%s" is empty but required`, field)
+		}
+	}
+	if !strings.Contains(config.UserFilter, usernamePlaceholder) {
+		return nil, errors.Errorf("the user filter %q does not contain %s", config.UserFilter, usernamePlaceholder)
+	}
+
+	return &IdentityProvider{
+		config: config,
+	}, nil
+}
+
+// Authenticate returns the information of the user in the directory, if the password of the user entry is valid.
+// The entry is searched with the service account, then bound with the password of the user.
+func (p *IdentityProvider) Authenticate(username, password string) (*idp.IdentityProviderUserInfo, error) {
+	// An empty password would be an unauthenticated bind, accepted by most servers.
+	if username == "" || password == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	conn, err := p.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if p.config.BindDn != "" {
+		if err := conn.Bind(p.config.BindDn, p.config.BindPassword); err != nil {
+			return nil, errors.Wrap(err, "failed to bind the service account")
+		}
+	}
+	fieldMapping := p.config.FieldMapping
+	attributes := []string{fieldMapping.Identifier}
+	for _, attribute := range []string{fieldMapping.DisplayName, fieldMapping.Email, fieldMapping.AvatarUrl} {
+		if attribute != "" {
+			attributes = append(attributes, attribute)
+		}
+	}
+	result, err := conn.Search(goldap.NewSearchRequest(
+		p.config.BaseDn,
+		goldap.ScopeWholeSubtree,
+		goldap.NeverDerefAliases,
+		// Two entries are enough to know that the filter is ambiguous.
+		2,
+		int(timeout.Seconds()),
+		false,
+		strings.ReplaceAll(p.config.UserFilter, usernamePlaceholder, goldap.EscapeFilter(username)),
+		attributes,
+		nil,
+	))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to search the user")
+	}
+	if len(result.Entries) == 0 {
+		return nil, ErrInvalidCredentials
+	}
+	if len(result.Entries) > 1 {
+		return nil, errors.Errorf("the user filter matches several entries for %q", username)
+	}
+	entry := result.Entries[0]
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, errors.Wrap(err, "failed to bind the user")
+	}
+
+	userInfo := &idp.IdentityProviderUserInfo{
+		Identifier: entry.GetAttributeValue(fieldMapping.Identifier),
+	}
+	if userInfo.Identifier == "" {
+		return nil, errors.Errorf("the attribute %q is not found in the user entry or has empty value", fieldMapping.Identifier)
+	}
+	// Best effort to map optional fields
+	if fieldMapping.DisplayName != "" {
+		userInfo.DisplayName = entry.GetAttributeValue(fieldMapping.DisplayName)
+	}
+	if userInfo.DisplayName == "" {
+		userInfo.DisplayName = userInfo.Identifier
+	}
+	if fieldMapping.Email != "" {
+		userInfo.Email = entry.GetAttributeValue(fieldMapping.Email)
+	}
+	if fieldMapping.AvatarUrl != "" {
+		userInfo.AvatarURL = entry.GetAttributeValue(fieldMapping.AvatarUrl)
+	}
+	return userInfo, nil
+}
+
+// dial connects to the server, upgrading the connection with StartTLS if configured.
+func (p *IdentityProvider) dial() (*goldap.Conn, error) {
+	serverURL, err := url.Parse(p.config.Url)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid url")
+	}
+	tlsConfig := &tls.Config{
+		ServerName: serverURL.Hostname(),
+		// Skipping the verification is an explicit choice of the host, e.g. for self-signed certificates.
+		InsecureSkipVerify: p.config.InsecureSkipVerify,
+	}
+	conn, err := goldap.DialURL(p.config.Url, goldap.DialWithDialer(&net.Dialer{Timeout: timeout}), goldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to the server")
+	}
+	conn.SetTimeout(timeout)
+	if p.config.StartTls {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, errors.Wrap(err, "failed to start TLS")
+		}
+	}
+	return conn, nil
+}
@@ -0,0 +1,110 @@
+package ldap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/idp"
+	"github.com/usememos/memos/plugin/idp/ldap/ldaptest"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestNewIdentityProvider(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *storepb.LDAPConfig
+		containsErr string
+	}{
+		{
+			name: "no url",
+			config: &storepb.LDAPConfig{
+				BaseDn:       "dc=example,dc=com",
+				UserFilter:   "(uid={username})",
+				FieldMapping: &storepb.FieldMapping{Identifier: "uid"},
+			},
+			containsErr: `the field "url" is empty but required`,
+		},
+		{
+			name: "no field mapping",
+			config: &storepb.LDAPConfig{
+				Url:        "ldap://ldap.example.com",
+				BaseDn:     "dc=example,dc=com",
+				UserFilter: "(uid={username})",
+			},
+			containsErr: `the field "fieldMapping" is empty but required`,
+		},
+		{
+			name: "no username placeholder",
+			config: &storepb.LDAPConfig{
+				Url:          "ldap://ldap.example.com",
+				BaseDn:       "dc=example,dc=com",
+				UserFilter:   "(uid=jane)",
+				FieldMapping: &storepb.FieldMapping{Identifier: "uid"},
+			},
+			containsErr: "does not contain {username}",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewIdentityProvider(test.config)
+			require.ErrorContains(t, err, test.containsErr)
+		})
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	url := ldaptest.NewServer(t,
+		ldaptest.Entry{DN: "cn=reader,dc=example,dc=com", Password: "reader-password"},
+		ldaptest.Entry{
+			DN:       "uid=jane,ou=people,dc=example,dc=com",
+			Password: "jane-password",
+			Attributes: map[string][]string{
+				"objectClass": {"person"},
+				"uid":         {"jane"},
+				"cn":          {"Jane Doe"},
+				"mail":        {"jane@example.com"},
+			},
+		},
+		ldaptest.Entry{
+			DN:         "uid=john,ou=people,dc=example,dc=com",
+			Password:   "john-password",
+			Attributes: map[string][]string{"objectClass": {"person"}, "uid": {"john"}},
+		},
+	)
+	identityProvider, err := NewIdentityProvider(&storepb.LDAPConfig{
+		Url:          url,
+		BindDn:       "cn=reader,dc=example,dc=com",
+		BindPassword: "reader-password",
+		BaseDn:       "ou=people,dc=example,dc=com",
+		UserFilter:   "(&(objectClass=person)(uid={username}))",
+		FieldMapping: &storepb.FieldMapping{
+			Identifier:  "uid",
+			DisplayName: "cn",
+			Email:       "mail",
+		},
+	})
+	require.NoError(t, err)
+
+	userInfo, err := identityProvider.Authenticate("jane", "jane-password")
+	require.NoError(t, err)
+	require.Equal(t, &idp.IdentityProviderUserInfo{
+		Identifier:  "jane",
+		DisplayName: "Jane Doe",
+		Email:       "jane@example.com",
+	}, userInfo)
+	// The display name falls back to the identifier.
+	userInfo, err = identityProvider.Authenticate("john", "john-password")
+	require.NoError(t, err)
+	require.Equal(t, "john", userInfo.DisplayName)
+
+	_, err = identityProvider.Authenticate("jane", "wrong-password")
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+	_, err = identityProvider.Authenticate("jane", "")
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+	_, err = identityProvider.Authenticate("nobody", "jane-password")
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+	// The username is escaped in the filter.
+	_, err = identityProvider.Authenticate("*", "jane-password")
+	require.ErrorIs(t, err, ErrInvalidCredentials)
+}
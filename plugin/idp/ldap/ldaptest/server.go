@@ -0,0 +1,152 @@
+// Package ldaptest provides a minimal LDAP server for testing, answering the simple binds and the searches
+// by equality, presence and conjunction filters of the LDAP Identity Provider.
+package ldaptest
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+// Entry is an entry of the directory, bound with its password.
+type Entry struct {
+	DN         string
+	Password   string
+	Attributes map[string][]string
+}
+
+// NewServer starts a server with the entries for the duration of the test, and returns its ldap:// URL.
+func NewServer(t testing.TB, entries ...Entry) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serve(conn, entries)
+		}
+	}()
+	return "ldap://" + listener.Addr().String()
+}
+
+func serve(conn net.Conn, entries []Entry) {
+	defer conn.Close()
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+		messageID := packet.Children[0].Value
+		request := packet.Children[1]
+		var responses []*ber.Packet
+		switch request.Tag {
+		case goldap.ApplicationBindRequest:
+			responses = append(responses, newResult(goldap.ApplicationBindResponse, bind(request, entries)))
+		case goldap.ApplicationSearchRequest:
+			baseDN, _ := request.Children[0].Value.(string)
+			for _, entry := range entries {
+				if strings.HasSuffix(strings.ToLower(entry.DN), strings.ToLower(baseDN)) && match(request.Children[6], entry) {
+					responses = append(responses, newSearchResultEntry(entry))
+				}
+			}
+			responses = append(responses, newResult(goldap.ApplicationSearchResultDone, goldap.LDAPResultSuccess))
+		case goldap.ApplicationUnbindRequest:
+			return
+		default:
+			responses = append(responses, newResult(goldap.ApplicationExtendedResponse, goldap.LDAPResultUnwillingToPerform))
+		}
+		for _, response := range responses {
+			envelope := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+			envelope.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "Message ID"))
+			envelope.AppendChild(response)
+			if _, err := conn.Write(envelope.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// bind returns the result code of the simple bind, anonymous if the name is empty.
+func bind(request *ber.Packet, entries []Entry) uint16 {
+	name, _ := request.Children[1].Value.(string)
+	password := request.Children[2].Data.String()
+	if name == "" {
+		return goldap.LDAPResultSuccess
+	}
+	for _, entry := range entries {
+		if strings.EqualFold(entry.DN, name) && entry.Password != "" && entry.Password == password {
+			return goldap.LDAPResultSuccess
+		}
+	}
+	return goldap.LDAPResultInvalidCredentials
+}
+
+// match returns whether the entry matches the filter.
+func match(filter *ber.Packet, entry Entry) bool {
+	switch filter.Tag {
+	case goldap.FilterAnd:
+		for _, child := range filter.Children {
+			if !match(child, entry) {
+				return false
+			}
+		}
+		return true
+	case goldap.FilterEqualityMatch:
+		attribute, value := filter.Children[0].Data.String(), filter.Children[1].Data.String()
+		for _, v := range getAttribute(entry, attribute) {
+			if strings.EqualFold(v, value) {
+				return true
+			}
+		}
+		return false
+	case goldap.FilterPresent:
+		return len(getAttribute(entry, filter.Data.String())) > 0
+	default:
+		return false
+	}
+}
+
+func getAttribute(entry Entry, attribute string) []string {
+	for name, values := range entry.Attributes {
+		if strings.EqualFold(name, attribute) {
+			return values
+		}
+	}
+	return nil
+}
+
+func newResult(tag ber.Tag, resultCode uint16) *ber.Packet {
+	result := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Result")
+	result.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(resultCode), "Result Code"))
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Diagnostic Message"))
+	return result
+}
+
+func newSearchResultEntry(entry Entry) *ber.Packet {
+	result := ber.Encode(ber.ClassApplication, ber.TypeConstructed, goldap.ApplicationSearchResultEntry, nil, "Search Result Entry")
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, entry.DN, "Object Name"))
+	attributes := ber.NewSequence("Attributes")
+	for name, values := range entry.Attributes {
+		attribute := ber.NewSequence("Attribute")
+		attribute.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, value := range values {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, value, "Value"))
+		}
+		attribute.AppendChild(set)
+		attributes.AppendChild(attribute)
+	}
+	result.AppendChild(attributes)
+	return result
+}
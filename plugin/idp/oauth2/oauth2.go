@@ -34,6 +34,9 @@ func NewIdentityProvider(config *storepb.OAuth2Config) (*IdentityProvider, error
 			return nil, errors.Errorf(`the field "%s" is empty but required`, field)
 		}
 	}
+	if len(config.GroupRoleMappings) > 0 && config.FieldMapping.Groups == "" {
+		return nil, errors.New(`the field "fieldMapping.groups" is empty but required by the group role mappings`)
+	}
 
 	return &IdentityProvider{
 		config: config,
@@ -118,6 +121,27 @@ func (p *IdentityProvider) UserInfo(token string) (*idp.IdentityProviderUserInfo
 			userInfo.AvatarURL = v
 		}
 	}
+	if p.config.FieldMapping.Groups != "" {
+		userInfo.Groups = getClaimStrings(claims[p.config.FieldMapping.Groups])
+	}
 	slog.Info("user info", "userInfo", userInfo)
 	return userInfo, nil
 }
+
+// getClaimStrings returns the strings of a claim, either an array of strings or a single string.
+func getClaimStrings(claim any) []string {
+	switch v := claim.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		values := []string{}
+		for _, item := range v {
+			if value, ok := item.(string); ok {
+				values = append(values, value)
+			}
+		}
+		return values
+	default:
+		return []string{}
+	}
+}
@@ -65,6 +65,20 @@ func TestNewIdentityProvider(t *testing.T) {
 			},
 			containsErr: `the field "fieldMapping.identifier" is empty but required`,
 		},
+		{
+			name: "no field mapping groups",
+			config: &storepb.OAuth2Config{
+				ClientId:     "test-client-id",
+				ClientSecret: "test-client-secret",
+				TokenUrl:     "https://example.com/token",
+				UserInfoUrl:  "https://example.com/api/user",
+				FieldMapping: &storepb.FieldMapping{
+					Identifier: "login",
+				},
+				GroupRoleMappings: []*storepb.GroupRoleMapping{{Group: "admins", Role: "ADMIN"}},
+			},
+			containsErr: `the field "fieldMapping.groups" is empty but required`,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(*testing.T) {
@@ -161,3 +175,42 @@ func TestIdentityProvider(t *testing.T) {
 	}
 	assert.Equal(t, wantUserInfo, userInfoResult)
 }
+
+func TestIdentityProviderGroups(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		groups any
+		want   []string
+	}{
+		{name: "array", groups: []any{"admins", "staff", 42}, want: []string{"admins", "staff"}},
+		{name: "string", groups: "admins", want: []string{"admins"}},
+		{name: "missing", groups: nil, want: []string{}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			claims := map[string]any{"sub": "123456789"}
+			if test.groups != nil {
+				claims["groups"] = test.groups
+			}
+			userInfo, err := json.Marshal(claims)
+			require.NoError(t, err)
+			s := newMockServer(t, "test-code", "test-access-token", userInfo)
+			defer s.Close()
+
+			oauth2, err := NewIdentityProvider(&storepb.OAuth2Config{
+				ClientId:     "test-client-id",
+				ClientSecret: "test-client-secret",
+				TokenUrl:     fmt.Sprintf("%s/oauth2/token", s.URL),
+				UserInfoUrl:  fmt.Sprintf("%s/oauth2/userinfo", s.URL),
+				FieldMapping: &storepb.FieldMapping{
+					Identifier: "sub",
+					Groups:     "groups",
+				},
+				GroupRoleMappings: []*storepb.GroupRoleMapping{{Group: "admins", Role: "ADMIN"}},
+			})
+			require.NoError(t, err)
+			userInfoResult, err := oauth2.UserInfo("test-access-token")
+			require.NoError(t, err)
+			assert.Equal(t, test.want, userInfoResult.Groups)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+// Package imageconvert recompresses the uploaded images, reducing their storage and bandwidth.
+package imageconvert
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/disintegration/imaging"
+	"github.com/pkg/errors"
+)
+
+const (
+	MimeTypeJPEG = "image/jpeg"
+	MimeTypePNG  = "image/png"
+	MimeTypeWebP = "image/webp"
+	MimeTypeAVIF = "image/avif"
+
+	defaultQuality = 80
+)
+
+// timeout is the timeout of the conversions with ffmpeg.
+var timeout = time.Minute
+
+// Options are the options of the recompression.
+type Options struct {
+	// MaxDimension is the max width and height in pixels of the images, which are scaled down to fit. 0 keeps their size.
+	MaxDimension int
+	// Quality is the quality from 1 to 100 of the lossy formats. 0 uses 80.
+	Quality int
+	// MimeType is the MIME type of the format to convert the images to. Empty keeps their format.
+	MimeType string
+	// FFmpegPath is the path of the ffmpeg binary, which encodes the WebP and AVIF images.
+	FFmpegPath string
+}
+
+// IsSupported returns whether the images of the MIME type are recompressed.
+func IsSupported(mimeType string) bool {
+	return mimeType == MimeTypeJPEG || mimeType == MimeTypePNG
+}
+
+// Compress scales down and encodes again the JPEG or PNG image, and returns the result with its MIME type.
+// The image is returned unchanged if the result is not smaller, e.g. for the images compressed already.
+func Compress(ctx context.Context, data []byte, mimeType string, options Options) ([]byte, string, error) {
+	if !IsSupported(mimeType) {
+		return data, mimeType, nil
+	}
+	targetMimeType := options.MimeType
+	if targetMimeType == "" {
+		targetMimeType = mimeType
+	}
+	quality := options.Quality
+	if quality <= 0 || quality > 100 {
+		quality = defaultQuality
+	}
+
+	// The orientation is applied to the pixels, as the encoded images have no EXIF.
+	img, err := imaging.Decode(bytes.NewReader(data), imaging.AutoOrientation(true))
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to decode image")
+	}
+	bounds := img.Bounds()
+	if options.MaxDimension > 0 && (bounds.Dx() > options.MaxDimension || bounds.Dy() > options.MaxDimension) {
+		img = imaging.Fit(img, options.MaxDimension, options.MaxDimension, imaging.Lanczos)
+	}
+
+	var encoded []byte
+	switch targetMimeType {
+	case MimeTypeJPEG:
+		// JPEG has no transparency, so the transparent pixels are made white rather than black.
+		background := imaging.New(img.Bounds().Dx(), img.Bounds().Dy(), color.White)
+		var buffer bytes.Buffer
+		if err := imaging.Encode(&buffer, imaging.Overlay(background, img, image.Pt(0, 0), 1), imaging.JPEG, imaging.JPEGQuality(quality)); err != nil {
+			return nil, "", errors.Wrap(err, "failed to encode jpeg")
+		}
+		encoded = buffer.Bytes()
+	case MimeTypePNG:
+		var buffer bytes.Buffer
+		if err := imaging.Encode(&buffer, img, imaging.PNG, imaging.PNGCompressionLevel(png.BestCompression)); err != nil {
+			return nil, "", errors.Wrap(err, "failed to encode png")
+		}
+		encoded = buffer.Bytes()
+	case MimeTypeWebP, MimeTypeAVIF:
+		encoded, err = encodeWithFFmpeg(ctx, options.FFmpegPath, img, targetMimeType, quality)
+		if err != nil {
+			return nil, "", err
+		}
+	default:
+		return nil, "", errors.Errorf("unsupported format %s", targetMimeType)
+	}
+
+	if len(encoded) == 0 || len(encoded) >= len(data) {
+		return data, mimeType, nil
+	}
+	return encoded, targetMimeType, nil
+}
+
+// Extension returns the file extension of the images of the MIME type.
+func Extension(mimeType string) string {
+	switch mimeType {
+	case MimeTypeJPEG:
+		return ".jpg"
+	case MimeTypePNG:
+		return ".png"
+	case MimeTypeWebP:
+		return ".webp"
+	case MimeTypeAVIF:
+		return ".avif"
+	default:
+		return ""
+	}
+}
+
+// encodeWithFFmpeg encodes the image to WebP or AVIF, which the standard library does not encode.
+func encodeWithFFmpeg(ctx context.Context, ffmpegPath string, img image.Image, mimeType string, quality int) ([]byte, error) {
+	if ffmpegPath == "" {
+		return nil, errors.Errorf("ffmpeg is required to encode %s", mimeType)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var input bytes.Buffer
+	if err := png.Encode(&input, img); err != nil {
+		return nil, errors.Wrap(err, "failed to encode png")
+	}
+	// The output is written to a file, as the AVIF muxer seeks in it.
+	dir, err := os.MkdirTemp("", "memos-image-*")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create temporary directory")
+	}
+	defer os.RemoveAll(dir)
+
+	args := []string{"-hide_banner", "-loglevel", "error", "-f", "png_pipe", "-i", "pipe:0"}
+	outputPath := ""
+	if mimeType == MimeTypeWebP {
+		outputPath = filepath.Join(dir, "image.webp")
+		args = append(args, "-c:v", "libwebp", "-quality", strconv.Itoa(quality), "-f", "webp", outputPath)
+	} else {
+		// The CRF of AV1 goes from 0, lossless, to 63.
+		crf := 63 - quality*63/100
+		outputPath = filepath.Join(dir, "image.avif")
+		args = append(args, "-c:v", "libaom-av1", "-still-picture", "1", "-crf", strconv.Itoa(crf), "-f", "avif", outputPath)
+	}
+	cmd := exec.CommandContext(ctx, ffmpegPath, args...)
+	var stderr bytes.Buffer
+	cmd.Stdin = &input
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Wrapf(err, "failed to run ffmpeg: %s", strings.TrimSpace(stderr.String()))
+	}
+	encoded, err := os.ReadFile(outputPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read the encoded image")
+	}
+	return encoded, nil
+}
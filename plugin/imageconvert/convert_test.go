@@ -0,0 +1,89 @@
+package imageconvert
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// noiseImage returns an image of random pixels, which compresses badly as phone photos do.
+func noiseImage(width, height int) image.Image {
+	random := rand.New(rand.NewSource(1))
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for i := range img.Pix {
+		img.Pix[i] = byte(random.Intn(256))
+	}
+	return img
+}
+
+func TestCompress(t *testing.T) {
+	ctx := context.Background()
+
+	var photo bytes.Buffer
+	require.NoError(t, jpeg.Encode(&photo, noiseImage(800, 400), &jpeg.Options{Quality: 100}))
+	compressed, mimeType, err := Compress(ctx, photo.Bytes(), MimeTypeJPEG, Options{MaxDimension: 400, Quality: 70})
+	require.NoError(t, err)
+	require.Equal(t, MimeTypeJPEG, mimeType)
+	require.Less(t, len(compressed), photo.Len())
+	config, err := jpeg.DecodeConfig(bytes.NewReader(compressed))
+	require.NoError(t, err)
+	require.Equal(t, 400, config.Width)
+	require.Equal(t, 200, config.Height)
+
+	// The transparent pixels of the PNG images converted to JPEG are white.
+	var screenshot bytes.Buffer
+	transparent := image.NewNRGBA(image.Rect(0, 0, 200, 200))
+	copy(transparent.Pix[200*4*100:], noiseImage(200, 100).(*image.RGBA).Pix)
+	require.NoError(t, png.Encode(&screenshot, transparent))
+	compressed, mimeType, err = Compress(ctx, screenshot.Bytes(), MimeTypePNG, Options{MimeType: MimeTypeJPEG})
+	require.NoError(t, err)
+	require.Equal(t, MimeTypeJPEG, mimeType)
+	converted, err := jpeg.Decode(bytes.NewReader(compressed))
+	require.NoError(t, err)
+	r, g, b, _ := converted.At(10, 10).RGBA()
+	require.Greater(t, min(r, g, b), uint32(0xF000))
+
+	// The images compressed already are unchanged.
+	var small bytes.Buffer
+	require.NoError(t, jpeg.Encode(&small, image.NewRGBA(image.Rect(0, 0, 8, 8)), &jpeg.Options{Quality: 10}))
+	compressed, mimeType, err = Compress(ctx, small.Bytes(), MimeTypeJPEG, Options{Quality: 100})
+	require.NoError(t, err)
+	require.Equal(t, MimeTypeJPEG, mimeType)
+	require.Equal(t, small.Bytes(), compressed)
+
+	// Other images are unchanged.
+	compressed, mimeType, err = Compress(ctx, []byte("GIF89a"), "image/gif", Options{MimeType: MimeTypeJPEG})
+	require.NoError(t, err)
+	require.Equal(t, "image/gif", mimeType)
+	require.Equal(t, []byte("GIF89a"), compressed)
+}
+
+func TestCompressWithFFmpeg(t *testing.T) {
+	ctx := context.Background()
+	var photo bytes.Buffer
+	require.NoError(t, jpeg.Encode(&photo, noiseImage(200, 100), &jpeg.Options{Quality: 100}))
+
+	_, _, err := Compress(ctx, photo.Bytes(), MimeTypeJPEG, Options{MimeType: MimeTypeWebP})
+	require.ErrorContains(t, err, "ffmpeg is required")
+
+	// A fake ffmpeg writing its arguments to the output file, which is the last one.
+	ffmpegPath := filepath.Join(t.TempDir(), "ffmpeg")
+	require.NoError(t, os.WriteFile(ffmpegPath, []byte("#!/bin/sh\nfor last; do :; done\ncat > /dev/null\necho \"$@\" > \"$last\"\n"), 0o755))
+	compressed, mimeType, err := Compress(ctx, photo.Bytes(), MimeTypeJPEG, Options{MimeType: MimeTypeWebP, Quality: 60, FFmpegPath: ffmpegPath})
+	require.NoError(t, err)
+	require.Equal(t, MimeTypeWebP, mimeType)
+	require.Contains(t, string(compressed), "-c:v libwebp -quality 60 -f webp")
+
+	compressed, mimeType, err = Compress(ctx, photo.Bytes(), MimeTypeJPEG, Options{MimeType: MimeTypeAVIF, Quality: 60, FFmpegPath: ffmpegPath})
+	require.NoError(t, err)
+	require.Equal(t, MimeTypeAVIF, mimeType)
+	require.Contains(t, string(compressed), "-c:v libaom-av1 -still-picture 1 -crf 26 -f avif")
+}
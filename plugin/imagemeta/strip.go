@@ -0,0 +1,262 @@
+package imagemeta
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+
+	"github.com/pkg/errors"
+)
+
+// orientationTag is the EXIF tag of the orientation, which tells how to rotate the stored pixels to display them.
+const orientationTag = 0x0112
+
+var (
+	jpegExifHeader = []byte("Exif\x00\x00")
+	jpegICCHeader  = []byte("ICC_PROFILE\x00")
+	pngSignature   = []byte("\x89PNG\r\n\x1a\n")
+)
+
+// IsSupported returns whether the metadata of the images of the MIME type are stripped.
+func IsSupported(mimeType string) bool {
+	switch mimeType {
+	case "image/jpeg", "image/png", "image/webp":
+		return true
+	default:
+		return false
+	}
+}
+
+// Strip removes the EXIF, XMP, IPTC and textual metadata of a JPEG, PNG or WebP image, such as its GPS position and camera,
+// without decoding the pixels. The color profiles are kept. With keepOrientation, the orientation is kept in a minimal EXIF,
+// so that the image is still displayed upright. The images of other types are returned unchanged.
+func Strip(data []byte, mimeType string, keepOrientation bool) ([]byte, error) {
+	switch mimeType {
+	case "image/jpeg":
+		return stripJPEG(data, keepOrientation)
+	case "image/png":
+		return stripPNG(data, keepOrientation)
+	case "image/webp":
+		return stripWebP(data, keepOrientation)
+	default:
+		return data, nil
+	}
+}
+
+func stripJPEG(data []byte, keepOrientation bool) ([]byte, error) {
+	if len(data) < 2 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, errors.New("invalid jpeg")
+	}
+	var orientation uint16
+	segments := [][]byte{}
+	position := 2
+	for {
+		// Markers may be preceded by fill bytes.
+		for position+1 < len(data) && data[position] == 0xFF && data[position+1] == 0xFF {
+			position++
+		}
+		if position+4 > len(data) || data[position] != 0xFF {
+			return nil, errors.New("invalid jpeg segment")
+		}
+		marker := data[position+1]
+		length := int(binary.BigEndian.Uint16(data[position+2:]))
+		end := position + 2 + length
+		if length < 2 || end > len(data) {
+			return nil, errors.New("invalid jpeg segment length")
+		}
+		segment := data[position:end]
+		payload := data[position+4 : end]
+		switch {
+		case marker == 0xDA:
+			// The scan runs to the end of the image, with no metadata.
+			segments = append(segments, data[position:])
+			return joinJPEG(segments, orientation, keepOrientation), nil
+		case marker == 0xE1:
+			if bytes.HasPrefix(payload, jpegExifHeader) {
+				orientation = readOrientation(payload[len(jpegExifHeader):])
+			}
+		case marker == 0xE2 && bytes.HasPrefix(payload, jpegICCHeader):
+			segments = append(segments, segment)
+		case marker == 0xE0 || marker == 0xEE:
+			// JFIF and Adobe segments describe the pixels.
+			segments = append(segments, segment)
+		case marker >= 0xE1 && marker <= 0xEF, marker == 0xFE:
+			// Other application segments and comments are metadata.
+		default:
+			segments = append(segments, segment)
+		}
+		position = end
+	}
+}
+
+func joinJPEG(segments [][]byte, orientation uint16, keepOrientation bool) []byte {
+	var buffer bytes.Buffer
+	buffer.Write([]byte{0xFF, 0xD8})
+	rest := segments
+	// The EXIF segment follows the JFIF segment, if any.
+	if len(rest) > 0 && rest[0][1] == 0xE0 {
+		buffer.Write(rest[0])
+		rest = rest[1:]
+	}
+	if keepOrientation && orientation > 1 {
+		payload := append(append([]byte{}, jpegExifHeader...), orientationTIFF(orientation)...)
+		buffer.Write([]byte{0xFF, 0xE1})
+		_ = binary.Write(&buffer, binary.BigEndian, uint16(len(payload)+2))
+		buffer.Write(payload)
+	}
+	for _, segment := range rest {
+		buffer.Write(segment)
+	}
+	return buffer.Bytes()
+}
+
+func stripPNG(data []byte, keepOrientation bool) ([]byte, error) {
+	if !bytes.HasPrefix(data, pngSignature) {
+		return nil, errors.New("invalid png")
+	}
+	var orientation uint16
+	chunks := [][]byte{}
+	position := len(pngSignature)
+	for position < len(data) {
+		if position+12 > len(data) {
+			return nil, errors.New("invalid png chunk")
+		}
+		length := int(binary.BigEndian.Uint32(data[position:]))
+		end := position + 12 + length
+		if end > len(data) {
+			return nil, errors.New("invalid png chunk length")
+		}
+		chunkType := string(data[position+4 : position+8])
+		switch chunkType {
+		case "eXIf":
+			orientation = readOrientation(data[position+8 : position+8+length])
+		case "tEXt", "zTXt", "iTXt", "tIME":
+		default:
+			chunks = append(chunks, data[position:end])
+		}
+		position = end
+	}
+
+	var buffer bytes.Buffer
+	buffer.Write(pngSignature)
+	for i, chunk := range chunks {
+		buffer.Write(chunk)
+		// The EXIF chunk follows the header chunk, before the image data.
+		if i == 0 && keepOrientation && orientation > 1 {
+			payload := orientationTIFF(orientation)
+			_ = binary.Write(&buffer, binary.BigEndian, uint32(len(payload)))
+			typed := append([]byte("eXIf"), payload...)
+			buffer.Write(typed)
+			_ = binary.Write(&buffer, binary.BigEndian, crc32.ChecksumIEEE(typed))
+		}
+	}
+	return buffer.Bytes(), nil
+}
+
+func stripWebP(data []byte, keepOrientation bool) ([]byte, error) {
+	if len(data) < 12 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return nil, errors.New("invalid webp")
+	}
+	var orientation uint16
+	chunks := [][]byte{}
+	position := 12
+	for position < len(data) {
+		if position+8 > len(data) {
+			return nil, errors.New("invalid webp chunk")
+		}
+		length := int(binary.LittleEndian.Uint32(data[position+4:]))
+		end := position + 8 + length + length%2
+		if end > len(data) {
+			return nil, errors.New("invalid webp chunk length")
+		}
+		switch string(data[position : position+4]) {
+		case "EXIF":
+			payload := data[position+8 : position+8+length]
+			orientation = readOrientation(bytes.TrimPrefix(payload, jpegExifHeader))
+		case "XMP ":
+		default:
+			chunks = append(chunks, data[position:end])
+		}
+		position = end
+	}
+
+	keepExif := keepOrientation && orientation > 1
+	var body bytes.Buffer
+	for _, chunk := range chunks {
+		if string(chunk[0:4]) == "VP8X" && len(chunk) >= 9 {
+			// Update the flags of the EXIF and XMP chunks in the extended header.
+			chunk = append([]byte{}, chunk...)
+			chunk[8] &^= 0x08 | 0x04
+			if keepExif {
+				chunk[8] |= 0x08
+			}
+		}
+		body.Write(chunk)
+	}
+	// The EXIF chunk goes after the image data, and requires the extended header.
+	if keepExif && len(chunks) > 0 && string(chunks[0][0:4]) == "VP8X" {
+		payload := orientationTIFF(orientation)
+		body.WriteString("EXIF")
+		_ = binary.Write(&body, binary.LittleEndian, uint32(len(payload)))
+		body.Write(payload)
+		if len(payload)%2 == 1 {
+			body.WriteByte(0)
+		}
+	}
+
+	var buffer bytes.Buffer
+	buffer.WriteString("RIFF")
+	_ = binary.Write(&buffer, binary.LittleEndian, uint32(body.Len()+4))
+	buffer.WriteString("WEBP")
+	buffer.Write(body.Bytes())
+	return buffer.Bytes(), nil
+}
+
+// readOrientation returns the orientation in the first IFD of a TIFF structure, or 0 if there is none.
+func readOrientation(tiff []byte) uint16 {
+	if len(tiff) < 8 {
+		return 0
+	}
+	var order binary.ByteOrder
+	switch string(tiff[0:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0
+	}
+	offset := int(order.Uint32(tiff[4:]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return 0
+	}
+	count := int(order.Uint16(tiff[offset:]))
+	for i := 0; i < count; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 0
+		}
+		if order.Uint16(tiff[entry:]) == orientationTag {
+			orientation := order.Uint16(tiff[entry+8:])
+			if orientation > 8 {
+				return 0
+			}
+			return orientation
+		}
+	}
+	return 0
+}
+
+// orientationTIFF returns a TIFF structure with only the orientation.
+func orientationTIFF(orientation uint16) []byte {
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	// One entry of a SHORT, with no next IFD.
+	tiff = binary.BigEndian.AppendUint16(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientationTag)
+	tiff = binary.BigEndian.AppendUint16(tiff, 3)
+	tiff = binary.BigEndian.AppendUint32(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = binary.BigEndian.AppendUint16(tiff, 0)
+	tiff = binary.BigEndian.AppendUint32(tiff, 0)
+	return tiff
+}
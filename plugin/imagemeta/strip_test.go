@@ -0,0 +1,128 @@
+package imagemeta
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// exifTIFF returns a little-endian TIFF structure with the orientation and a GPS version, standing for the GPS position.
+func exifTIFF(orientation uint16) []byte {
+	tiff := []byte("II\x2a\x00\x08\x00\x00\x00")
+	tiff = binary.LittleEndian.AppendUint16(tiff, 2)
+	tiff = binary.LittleEndian.AppendUint16(tiff, orientationTag)
+	tiff = binary.LittleEndian.AppendUint16(tiff, 3)
+	tiff = binary.LittleEndian.AppendUint32(tiff, 1)
+	tiff = binary.LittleEndian.AppendUint32(tiff, uint32(orientation))
+	// GPSVersionID.
+	tiff = binary.LittleEndian.AppendUint16(tiff, 0x0000)
+	tiff = binary.LittleEndian.AppendUint16(tiff, 1)
+	tiff = binary.LittleEndian.AppendUint32(tiff, 4)
+	tiff = append(tiff, 2, 3, 0, 0)
+	tiff = binary.LittleEndian.AppendUint32(tiff, 0)
+	return append(tiff, []byte("Canon EOS GPS 48.8584 2.2945")...)
+}
+
+func TestStripJPEG(t *testing.T) {
+	var encoded bytes.Buffer
+	require.NoError(t, jpeg.Encode(&encoded, image.NewRGBA(image.Rect(0, 0, 8, 8)), nil))
+	exif := append([]byte("Exif\x00\x00"), exifTIFF(6)...)
+	segment := append([]byte{0xFF, 0xE1, byte((len(exif) + 2) >> 8), byte(len(exif) + 2)}, exif...)
+	comment := []byte{0xFF, 0xFE, 0x00, 0x07, 'h', 'e', 'l', 'l', 'o'}
+	data := append(append(append([]byte{0xFF, 0xD8}, segment...), comment...), encoded.Bytes()[2:]...)
+
+	stripped, err := Strip(data, "image/jpeg", false)
+	require.NoError(t, err)
+	require.NotContains(t, string(stripped), "Exif")
+	require.NotContains(t, string(stripped), "GPS")
+	require.NotContains(t, string(stripped), "hello")
+	_, err = jpeg.Decode(bytes.NewReader(stripped))
+	require.NoError(t, err)
+
+	stripped, err = Strip(data, "image/jpeg", true)
+	require.NoError(t, err)
+	require.Contains(t, string(stripped), "Exif")
+	require.NotContains(t, string(stripped), "GPS")
+	position := bytes.Index(stripped, []byte("Exif\x00\x00"))
+	require.Equal(t, uint16(6), readOrientation(stripped[position+6:]))
+	_, err = jpeg.Decode(bytes.NewReader(stripped))
+	require.NoError(t, err)
+
+	_, err = Strip([]byte("not a jpeg"), "image/jpeg", false)
+	require.Error(t, err)
+}
+
+func TestStripPNG(t *testing.T) {
+	var encoded bytes.Buffer
+	require.NoError(t, png.Encode(&encoded, image.NewRGBA(image.Rect(0, 0, 8, 8))))
+	chunk := func(chunkType string, payload []byte) []byte {
+		typed := append([]byte(chunkType), payload...)
+		c := binary.BigEndian.AppendUint32(nil, uint32(len(payload)))
+		c = append(c, typed...)
+		return binary.BigEndian.AppendUint32(c, crc32.ChecksumIEEE(typed))
+	}
+	// The metadata chunks after the header chunk, which is 25 bytes long.
+	header := encoded.Bytes()[:8+25]
+	data := append(append([]byte{}, header...), chunk("eXIf", exifTIFF(8))...)
+	data = append(data, chunk("tEXt", []byte("Comment\x00hello"))...)
+	data = append(data, encoded.Bytes()[8+25:]...)
+
+	stripped, err := Strip(data, "image/png", false)
+	require.NoError(t, err)
+	require.NotContains(t, string(stripped), "eXIf")
+	require.NotContains(t, string(stripped), "hello")
+	_, err = png.Decode(bytes.NewReader(stripped))
+	require.NoError(t, err)
+
+	stripped, err = Strip(data, "image/png", true)
+	require.NoError(t, err)
+	require.NotContains(t, string(stripped), "GPS")
+	position := bytes.Index(stripped, []byte("eXIf"))
+	require.Equal(t, 8+25+4, position)
+	require.Equal(t, uint16(8), readOrientation(stripped[position+4:]))
+	_, err = png.Decode(bytes.NewReader(stripped))
+	require.NoError(t, err)
+}
+
+func TestStripWebP(t *testing.T) {
+	chunk := func(fourCC string, payload []byte) []byte {
+		c := append([]byte(fourCC), binary.LittleEndian.AppendUint32(nil, uint32(len(payload)))...)
+		c = append(c, payload...)
+		if len(payload)%2 == 1 {
+			c = append(c, 0)
+		}
+		return c
+	}
+	// The extended header with the EXIF and XMP flags.
+	body := chunk("VP8X", []byte{0x0C, 0, 0, 0, 7, 0, 0, 7, 0, 0})
+	body = append(body, chunk("VP8L", []byte("pixels"))...)
+	body = append(body, chunk("EXIF", exifTIFF(3))...)
+	body = append(body, chunk("XMP ", []byte("<x:xmpmeta>hello</x:xmpmeta>"))...)
+	data := append([]byte("RIFF"), binary.LittleEndian.AppendUint32(nil, uint32(len(body)+4))...)
+	data = append(append(data, []byte("WEBP")...), body...)
+
+	stripped, err := Strip(data, "image/webp", false)
+	require.NoError(t, err)
+	require.NotContains(t, string(stripped), "EXIF")
+	require.NotContains(t, string(stripped), "hello")
+	require.Equal(t, byte(0), stripped[20])
+	require.Equal(t, uint32(len(stripped)-8), binary.LittleEndian.Uint32(stripped[4:]))
+
+	stripped, err = Strip(data, "image/webp", true)
+	require.NoError(t, err)
+	require.NotContains(t, string(stripped), "GPS")
+	require.Equal(t, byte(0x08), stripped[20])
+	position := bytes.Index(stripped, []byte("EXIF"))
+	require.Equal(t, uint16(3), readOrientation(stripped[position+8:]))
+
+	// Other images are unchanged.
+	unchanged, err := Strip([]byte("GIF89a"), "image/gif", false)
+	require.NoError(t, err)
+	require.Equal(t, []byte("GIF89a"), unchanged)
+}
@@ -0,0 +1,259 @@
+package mailin
+
+import (
+	"bufio"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// imapTimeout is the time the IMAP server has to answer each command.
+	imapTimeout = time.Minute
+	// maxIMAPLiteralSize is the maximum size of the literals sent by the IMAP server, i.e. of the fetched messages.
+	maxIMAPLiteralSize = DefaultMaxMessageSize
+)
+
+// IMAPConfig is the configuration of the IMAP mailbox the emails are fetched from.
+type IMAPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	// UseTLS connects with implicit TLS, e.g. on the port 993, instead of upgrading with STARTTLS when offered.
+	UseTLS bool
+}
+
+// IMAPClient is a minimal IMAP4rev1 client fetching the unseen messages of a folder and archiving them.
+type IMAPClient struct {
+	conn         net.Conn
+	reader       *bufio.Reader
+	tag          int
+	capabilities map[string]bool
+}
+
+// IMAPResponse is an untagged response of the IMAP server, with its literals in order.
+type IMAPResponse struct {
+	Line     string
+	Literals [][]byte
+}
+
+// DialIMAP connects and logs in to the IMAP server.
+func DialIMAP(config *IMAPConfig) (*IMAPClient, error) {
+	address := net.JoinHostPort(config.Host, strconv.Itoa(config.Port))
+	dialer := &net.Dialer{Timeout: imapTimeout}
+	var conn net.Conn
+	var err error
+	if config.UseTLS {
+		conn, err = tls.DialWithDialer(dialer, "tcp", address, &tls.Config{ServerName: config.Host})
+	} else {
+		conn, err = dialer.Dial("tcp", address)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to IMAP server")
+	}
+	client := &IMAPClient{conn: conn, reader: bufio.NewReader(conn)}
+	if err := client.login(config); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return client, nil
+}
+
+func (c *IMAPClient) login(config *IMAPConfig) error {
+	greeting, err := c.readResponse()
+	if err != nil {
+		return errors.Wrap(err, "failed to read IMAP greeting")
+	}
+	if !strings.HasPrefix(greeting.Line, "* OK") {
+		return errors.Errorf("unexpected IMAP greeting: %s", greeting.Line)
+	}
+	if err := c.loadCapabilities(); err != nil {
+		return err
+	}
+	if !config.UseTLS && c.capabilities["STARTTLS"] {
+		if _, err := c.Execute("STARTTLS"); err != nil {
+			return err
+		}
+		c.conn = tls.Client(c.conn, &tls.Config{ServerName: config.Host})
+		c.reader = bufio.NewReader(c.conn)
+		if err := c.loadCapabilities(); err != nil {
+			return err
+		}
+	}
+	if _, err := c.Execute("LOGIN " + quoteIMAPString(config.Username) + " " + quoteIMAPString(config.Password)); err != nil {
+		return errors.Wrap(err, "failed to log in to IMAP server")
+	}
+	// The capabilities may change once logged in.
+	return c.loadCapabilities()
+}
+
+func (c *IMAPClient) loadCapabilities() error {
+	responses, err := c.Execute("CAPABILITY")
+	if err != nil {
+		return err
+	}
+	c.capabilities = map[string]bool{}
+	for _, response := range responses {
+		if capabilities, ok := strings.CutPrefix(response.Line, "* CAPABILITY "); ok {
+			for _, capability := range strings.Fields(capabilities) {
+				c.capabilities[strings.ToUpper(capability)] = true
+			}
+		}
+	}
+	return nil
+}
+
+// Select opens the folder.
+func (c *IMAPClient) Select(folder string) error {
+	_, err := c.Execute("SELECT " + quoteIMAPString(folder))
+	return err
+}
+
+// SearchUnseen returns the UIDs of the unseen messages of the selected folder.
+func (c *IMAPClient) SearchUnseen() ([]uint32, error) {
+	responses, err := c.Execute("UID SEARCH UNSEEN")
+	if err != nil {
+		return nil, err
+	}
+	uids := []uint32{}
+	for _, response := range responses {
+		numbers, ok := strings.CutPrefix(response.Line, "* SEARCH")
+		if !ok {
+			continue
+		}
+		for _, number := range strings.Fields(numbers) {
+			uid, err := strconv.ParseUint(number, 10, 32)
+			if err != nil {
+				return nil, errors.Errorf("invalid UID %q", number)
+			}
+			uids = append(uids, uint32(uid))
+		}
+	}
+	return uids, nil
+}
+
+// Fetch returns the raw message of the UID, without marking it as seen.
+func (c *IMAPClient) Fetch(uid uint32) ([]byte, error) {
+	responses, err := c.Execute(fmt.Sprintf("UID FETCH %d (BODY.PEEK[])", uid))
+	if err != nil {
+		return nil, err
+	}
+	for _, response := range responses {
+		if strings.Contains(response.Line, " FETCH ") && len(response.Literals) > 0 {
+			return response.Literals[0], nil
+		}
+	}
+	return nil, errors.Errorf("message %d not found", uid)
+}
+
+// MarkSeen flags the message of the UID as seen, so that it is no longer searched.
+func (c *IMAPClient) MarkSeen(uid uint32) error {
+	_, err := c.Execute(fmt.Sprintf("UID STORE %d +FLAGS.SILENT (\\Seen)", uid))
+	return err
+}
+
+// Archive marks the message of the UID as seen and moves it to the folder, created if it does not exist.
+func (c *IMAPClient) Archive(uid uint32, folder string) error {
+	if err := c.MarkSeen(uid); err != nil {
+		return err
+	}
+	// The folder mostly exists already, in which case creating it fails.
+	_, _ = c.Execute("CREATE " + quoteIMAPString(folder))
+	if c.capabilities["MOVE"] {
+		_, err := c.Execute(fmt.Sprintf("UID MOVE %d %s", uid, quoteIMAPString(folder)))
+		return err
+	}
+	if _, err := c.Execute(fmt.Sprintf("UID COPY %d %s", uid, quoteIMAPString(folder))); err != nil {
+		return err
+	}
+	if _, err := c.Execute(fmt.Sprintf("UID STORE %d +FLAGS.SILENT (\\Deleted)", uid)); err != nil {
+		return err
+	}
+	// Without UIDPLUS, the other messages flagged as deleted in the folder are expunged too.
+	if c.capabilities["UIDPLUS"] {
+		_, err := c.Execute(fmt.Sprintf("UID EXPUNGE %d", uid))
+		return err
+	}
+	_, err := c.Execute("EXPUNGE")
+	return err
+}
+
+// Close logs out and closes the connection.
+func (c *IMAPClient) Close() error {
+	_, _ = c.Execute("LOGOUT")
+	return c.conn.Close()
+}
+
+// Execute sends a command and returns its untagged responses, or an error unless it completes with OK.
+func (c *IMAPClient) Execute(command string) ([]*IMAPResponse, error) {
+	c.tag++
+	tag := fmt.Sprintf("m%d", c.tag)
+	_ = c.conn.SetDeadline(time.Now().Add(imapTimeout))
+	if _, err := fmt.Fprintf(c.conn, "%s %s\r\n", tag, command); err != nil {
+		return nil, errors.Wrap(err, "failed to send IMAP command")
+	}
+	responses := []*IMAPResponse{}
+	for {
+		response, err := c.readResponse()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read IMAP response")
+		}
+		result, ok := strings.CutPrefix(response.Line, tag+" ")
+		if !ok {
+			responses = append(responses, response)
+			continue
+		}
+		if !strings.HasPrefix(strings.ToUpper(result), "OK") {
+			verb, _, _ := strings.Cut(command, " ")
+			return nil, errors.Errorf("IMAP %s failed: %s", verb, result)
+		}
+		return responses, nil
+	}
+}
+
+// readResponse reads a response line, with the literals it announces with {size} at the end of its lines.
+func (c *IMAPClient) readResponse() (*IMAPResponse, error) {
+	response := &IMAPResponse{}
+	var line strings.Builder
+	for {
+		part, err := c.reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		part = strings.TrimRight(part, "\r\n")
+		line.WriteString(part)
+		if !strings.HasSuffix(part, "}") {
+			break
+		}
+		start := strings.LastIndex(part, "{")
+		if start < 0 {
+			break
+		}
+		size, err := strconv.ParseInt(strings.TrimSuffix(part[start+1:], "}"), 10, 64)
+		if err != nil {
+			break
+		}
+		if size > maxIMAPLiteralSize {
+			return nil, errors.Errorf("IMAP literal of %d bytes is too big", size)
+		}
+		literal := make([]byte, size)
+		if _, err := io.ReadFull(c.reader, literal); err != nil {
+			return nil, err
+		}
+		response.Literals = append(response.Literals, literal)
+	}
+	response.Line = line.String()
+	return response, nil
+}
+
+// quoteIMAPString returns the string as an IMAP quoted string.
+func quoteIMAPString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
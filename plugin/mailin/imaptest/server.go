@@ -0,0 +1,206 @@
+// Package imaptest provides a fake IMAP server holding the emails of a mailbox in tests.
+package imaptest
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Email is an email of a folder of the server.
+type Email struct {
+	UID  uint32
+	Seen bool
+	// Data is the message with its headers.
+	Data string
+}
+
+// Server is a fake IMAP server of a single mailbox, without TLS, supporting the commands of the mailin client.
+type Server struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+
+	mu      sync.Mutex
+	nextUID uint32
+	folders map[string][]*Email
+}
+
+// NewServer starts a server listening on localhost until the end of the test, with an empty INBOX.
+func NewServer(t *testing.T, username, password string) *Server {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	address := listener.Addr().(*net.TCPAddr)
+	server := &Server{
+		Host:     address.IP.String(),
+		Port:     address.Port,
+		Username: username,
+		Password: password,
+		nextUID:  1,
+		folders:  map[string][]*Email{"INBOX": {}},
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.serve(conn)
+		}
+	}()
+	return server
+}
+
+// Deliver adds an unseen email to the folder.
+func (s *Server) Deliver(folder, data string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.folders[folder] = append(s.folders[folder], &Email{UID: s.nextUID, Data: data})
+	s.nextUID++
+}
+
+// Emails returns the emails of the folder.
+func (s *Server) Emails(folder string) []Email {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	emails := []Email{}
+	for _, email := range s.folders[folder] {
+		emails = append(emails, *email)
+	}
+	return emails
+}
+
+func (s *Server) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	reply := func(format string, args ...any) {
+		_, _ = fmt.Fprintf(conn, format+"\r\n", args...)
+	}
+	reply("* OK IMAP4rev1 ready")
+	loggedIn, selected := false, ""
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(strings.TrimSpace(line))
+		if len(fields) < 2 {
+			reply("* BAD missing command")
+			continue
+		}
+		tag, command, args := fields[0], strings.ToUpper(fields[1]), fields[2:]
+		if command == "UID" && len(args) > 0 {
+			command, args = "UID "+strings.ToUpper(args[0]), args[1:]
+		}
+		switch {
+		case command == "CAPABILITY":
+			reply("* CAPABILITY IMAP4rev1 MOVE")
+			reply("%s OK CAPABILITY completed", tag)
+		case command == "LOGIN" && len(args) == 2:
+			if unquote(args[0]) != s.Username || unquote(args[1]) != s.Password {
+				reply("%s NO [AUTHENTICATIONFAILED] invalid credentials", tag)
+				continue
+			}
+			loggedIn = true
+			reply("%s OK LOGIN completed", tag)
+		case command == "LOGOUT":
+			reply("* BYE logging out")
+			reply("%s OK LOGOUT completed", tag)
+			return
+		case !loggedIn:
+			reply("%s NO not logged in", tag)
+		case command == "SELECT" && len(args) == 1:
+			s.mu.Lock()
+			_, ok := s.folders[unquote(args[0])]
+			s.mu.Unlock()
+			if !ok {
+				reply("%s NO no such folder", tag)
+				continue
+			}
+			selected = unquote(args[0])
+			reply("%s OK [READ-WRITE] SELECT completed", tag)
+		case command == "CREATE" && len(args) == 1:
+			s.mu.Lock()
+			_, ok := s.folders[unquote(args[0])]
+			if !ok {
+				s.folders[unquote(args[0])] = []*Email{}
+			}
+			s.mu.Unlock()
+			if ok {
+				reply("%s NO [ALREADYEXISTS] folder exists", tag)
+				continue
+			}
+			reply("%s OK CREATE completed", tag)
+		case selected == "":
+			reply("%s NO no folder selected", tag)
+		case command == "UID SEARCH":
+			uids := []string{}
+			for _, email := range s.Emails(selected) {
+				if !email.Seen {
+					uids = append(uids, strconv.FormatUint(uint64(email.UID), 10))
+				}
+			}
+			reply("* SEARCH %s", strings.Join(uids, " "))
+			reply("%s OK SEARCH completed", tag)
+		case command == "UID FETCH" && len(args) > 0:
+			if email := s.find(selected, args[0]); email != nil {
+				reply("* 1 FETCH (UID %d BODY[] {%d}\r\n%s)", email.UID, len(email.Data), email.Data)
+			}
+			reply("%s OK FETCH completed", tag)
+		case command == "UID STORE" && len(args) > 0:
+			if email := s.find(selected, args[0]); email != nil && strings.Contains(line, `\Seen`) {
+				s.mu.Lock()
+				email.Seen = true
+				s.mu.Unlock()
+			}
+			reply("%s OK STORE completed", tag)
+		case command == "UID MOVE" && len(args) == 2:
+			s.mu.Lock()
+			folder := unquote(args[1])
+			emails, ok := s.folders[folder]
+			if ok {
+				for i, email := range s.folders[selected] {
+					if strconv.FormatUint(uint64(email.UID), 10) == args[0] {
+						s.folders[folder] = append(emails, email)
+						s.folders[selected] = append(s.folders[selected][:i], s.folders[selected][i+1:]...)
+						break
+					}
+				}
+			}
+			s.mu.Unlock()
+			if !ok {
+				reply("%s NO [TRYCREATE] no such folder", tag)
+				continue
+			}
+			reply("%s OK MOVE completed", tag)
+		default:
+			reply("%s BAD unsupported command", tag)
+		}
+	}
+}
+
+func (s *Server) find(folder, uid string) *Email {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, email := range s.folders[folder] {
+		if strconv.FormatUint(uint64(email.UID), 10) == uid {
+			return email
+		}
+	}
+	return nil
+}
+
+func unquote(s string) string {
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		return unquoted
+	}
+	return s
+}
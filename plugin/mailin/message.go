@@ -0,0 +1,237 @@
+package mailin
+
+import (
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/usememos/memos/internal/util"
+)
+
+// maxPartDepth is the maximum nesting of the multipart bodies, deeper parts being ignored.
+const maxPartDepth = 10
+
+// Message is the content of a received email.
+type Message struct {
+	// From is the address of the author, empty if the email has none.
+	From string
+	// Recipients are the addresses of the Delivered-To, X-Original-To, To and Cc headers, which tell the mailbox
+	// the email was delivered to when it is fetched rather than received.
+	Recipients []string
+	Subject    string
+	// Text is the plain text body, converted from the HTML body if the email has no plain text one.
+	Text        string
+	Attachments []*Attachment
+}
+
+// Attachment is a file attached to an email, or an inline part which is neither its text nor its HTML body.
+type Attachment struct {
+	Filename string
+	Type     string
+	Content  []byte
+}
+
+// ParseMessage parses an RFC 5322 message with its MIME parts.
+func ParseMessage(r io.Reader) (*Message, error) {
+	msg, err := mail.ReadMessage(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read message")
+	}
+	decoder := &mime.WordDecoder{}
+	message := &Message{}
+	if subject, err := decoder.DecodeHeader(msg.Header.Get("Subject")); err == nil {
+		message.Subject = strings.TrimSpace(subject)
+	} else {
+		message.Subject = strings.TrimSpace(msg.Header.Get("Subject"))
+	}
+	if from, err := msg.Header.AddressList("From"); err == nil && len(from) > 0 {
+		message.From = from[0].Address
+	}
+	for _, key := range []string{"Delivered-To", "X-Original-To", "To", "Cc"} {
+		for _, value := range msg.Header[textproto.CanonicalMIMEHeaderKey(key)] {
+			if addresses, err := mail.ParseAddressList(value); err == nil {
+				for _, address := range addresses {
+					message.Recipients = append(message.Recipients, address.Address)
+				}
+			}
+		}
+	}
+
+	parser := &partParser{message: message}
+	if err := parser.parse(textproto.MIMEHeader(msg.Header), msg.Body, 0); err != nil {
+		return nil, err
+	}
+	message.Text = strings.TrimSpace(parser.text.String())
+	if message.Text == "" {
+		message.Text = htmlToText(parser.html.String())
+	}
+	return message, nil
+}
+
+// partParser collects the text, HTML and attachments of the parts of a message.
+type partParser struct {
+	message *Message
+	text    strings.Builder
+	html    strings.Builder
+}
+
+func (p *partParser) parse(header textproto.MIMEHeader, body io.Reader, depth int) error {
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		// The parts without a valid type are plain text, as the parts without a type.
+		mediaType, params = "text/plain", map[string]string{}
+	}
+	body = decodeTransferEncoding(header.Get("Content-Transfer-Encoding"), body)
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		if depth >= maxPartDepth || params["boundary"] == "" {
+			return nil
+		}
+		reader := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := reader.NextRawPart()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return errors.Wrap(err, "failed to read part")
+			}
+			if err := p.parse(part.Header, part, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return errors.Wrap(err, "failed to read part")
+	}
+	filename := getFilename(header, params)
+	disposition, _, _ := mime.ParseMediaType(header.Get("Content-Disposition"))
+	if disposition != "attachment" && filename == "" {
+		switch mediaType {
+		case "text/plain":
+			appendText(&p.text, content)
+			return nil
+		case "text/html":
+			appendText(&p.html, content)
+			return nil
+		}
+	}
+	if filename == "" {
+		filename = "attachment"
+		if extensions, _ := mime.ExtensionsByType(mediaType); len(extensions) > 0 {
+			filename += extensions[0]
+		}
+	}
+	p.message.Attachments = append(p.message.Attachments, &Attachment{
+		Filename: filename,
+		Type:     mediaType,
+		Content:  content,
+	})
+	return nil
+}
+
+func decodeTransferEncoding(encoding string, body io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		// The decoder skips the line breaks of the body.
+		return base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		return quotedprintable.NewReader(body)
+	default:
+		return body
+	}
+}
+
+// getFilename returns the decoded filename of a part, from its disposition or else from its type.
+func getFilename(header textproto.MIMEHeader, params map[string]string) string {
+	filename := ""
+	if _, dispositionParams, err := mime.ParseMediaType(header.Get("Content-Disposition")); err == nil {
+		filename = dispositionParams["filename"]
+	}
+	if filename == "" {
+		filename = params["name"]
+	}
+	if decoded, err := (&mime.WordDecoder{}).DecodeHeader(filename); err == nil {
+		filename = decoded
+	}
+	// The filenames are kept without their directories, which some clients include.
+	if index := strings.LastIndexAny(filename, `/\`); index >= 0 {
+		filename = filename[index+1:]
+	}
+	return strings.TrimSpace(filename)
+}
+
+// appendText appends a body to the text, separating the bodies of the messages split into several parts.
+// The bodies not in UTF-8 keep their valid characters only.
+func appendText(text *strings.Builder, content []byte) {
+	body := strings.ReplaceAll(strings.ToValidUTF8(string(content), ""), "\r\n", "\n")
+	if strings.TrimSpace(body) == "" {
+		return
+	}
+	if text.Len() > 0 {
+		text.WriteString("\n\n")
+	}
+	text.WriteString(body)
+}
+
+// htmlToText returns the text of an HTML body, with its blocks on separate lines.
+func htmlToText(body string) string {
+	if body == "" {
+		return ""
+	}
+	var text strings.Builder
+	tokenizer := html.NewTokenizer(strings.NewReader(body))
+	skipping := atom.Atom(0)
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return strings.TrimSpace(collapseBlankLines(text.String()))
+		case html.TextToken:
+			if skipping == 0 {
+				text.WriteString(util.CollapseSpaces(string(tokenizer.Text())))
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, _ := tokenizer.TagName()
+			switch tag := atom.Lookup(name); tag {
+			case atom.Script, atom.Style, atom.Head:
+				skipping = tag
+			case atom.Br, atom.P, atom.Div, atom.Li, atom.Tr, atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6, atom.Blockquote:
+				text.WriteString("\n")
+			}
+		case html.EndTagToken:
+			name, _ := tokenizer.TagName()
+			tag := atom.Lookup(name)
+			if tag == skipping {
+				skipping = 0
+			}
+			switch tag {
+			case atom.P, atom.Div, atom.Tr, atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6, atom.Blockquote:
+				text.WriteString("\n")
+			}
+		}
+	}
+}
+
+// collapseBlankLines trims the lines and keeps at most one blank line in a row.
+func collapseBlankLines(text string) string {
+	lines := []string{}
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" && (len(lines) == 0 || lines[len(lines)-1] == "") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
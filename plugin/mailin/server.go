@@ -0,0 +1,258 @@
+// Package mailin receives emails over SMTP or LMTP, or fetches them over IMAP, and parses them into their text and attachments.
+package mailin
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultMaxMessageSize is the maximum size of a message unless the server sets one.
+	DefaultMaxMessageSize = 32 << 20
+	// maxRecipients is the maximum number of recipients of a message.
+	maxRecipients = 100
+	// commandTimeout is the time a client has to send each command, and the data of a message.
+	commandTimeout = 5 * time.Minute
+)
+
+// ErrRejected is wrapped by the errors of the handler rejecting a message permanently, as retrying to send it would fail again.
+var ErrRejected = errors.New("message rejected")
+
+// Handler decides which recipients receive the emails and handles the emails they receive.
+type Handler interface {
+	// Accept returns whether the address receives emails, checked as each recipient is given.
+	Accept(ctx context.Context, recipient string) bool
+	// Handle handles a message received by an accepted recipient. An error rejects the message temporarily,
+	// so that the client retries to send it, unless it wraps ErrRejected.
+	Handle(ctx context.Context, recipient string, message *Message) error
+}
+
+// Server is an SMTP server, which also speaks LMTP to the clients greeting it with LHLO, so that it can sit either
+// at the edge or behind a mail server. It neither relays emails nor authenticates clients, and only accepts
+// the recipients of its handler.
+type Server struct {
+	// Hostname is the name of the server in its greeting.
+	Hostname string
+	// MaxMessageSize is the maximum size of a message in bytes, DefaultMaxMessageSize if zero.
+	MaxMessageSize int64
+	Handler        Handler
+}
+
+// Serve accepts the connections of the listener until the context is done.
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+	}()
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return errors.Wrap(err, "failed to accept connection")
+		}
+		go s.serve(ctx, conn)
+	}
+}
+
+// session is the state of a connection, reset after each message.
+type session struct {
+	lmtp       bool
+	greeted    bool
+	from       string
+	recipients []string
+}
+
+func (session *session) reset() {
+	session.from = ""
+	session.recipients = nil
+}
+
+func (s *Server) serve(ctx context.Context, netConn net.Conn) {
+	defer netConn.Close()
+	conn := textproto.NewConn(netConn)
+	hostname := s.Hostname
+	if hostname == "" {
+		hostname = "localhost"
+	}
+	reply := func(format string, args ...any) bool {
+		return conn.PrintfLine(format, args...) == nil
+	}
+
+	if !reply("220 %s ESMTP memos", hostname) {
+		return
+	}
+	session := &session{}
+	for {
+		_ = netConn.SetDeadline(time.Now().Add(commandTimeout))
+		line, err := conn.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		arg = strings.TrimSpace(arg)
+		ok := true
+		switch strings.ToUpper(verb) {
+		case "HELO":
+			session.greeted, session.lmtp = true, false
+			session.reset()
+			ok = reply("250 %s", hostname)
+		case "EHLO", "LHLO":
+			session.greeted, session.lmtp = true, strings.EqualFold(verb, "LHLO")
+			session.reset()
+			ok = reply("250-%s\r\n250-8BITMIME\r\n250 SIZE %d", hostname, s.maxMessageSize())
+		case "MAIL":
+			address, found := parsePath(arg, "FROM:")
+			switch {
+			case !session.greeted:
+				ok = reply("503 5.5.1 Send HELO first")
+			case session.from != "":
+				ok = reply("503 5.5.1 Sender already given")
+			case !found:
+				ok = reply("501 5.5.4 Syntax: MAIL FROM:<address>")
+			default:
+				// The null reverse-path of the bounces is kept as a placeholder, as the sender is only informative.
+				session.from = address
+				if session.from == "" {
+					session.from = "<>"
+				}
+				ok = reply("250 2.1.0 OK")
+			}
+		case "RCPT":
+			address, found := parsePath(arg, "TO:")
+			switch {
+			case session.from == "":
+				ok = reply("503 5.5.1 Send MAIL first")
+			case !found || address == "":
+				ok = reply("501 5.5.4 Syntax: RCPT TO:<address>")
+			case len(session.recipients) >= maxRecipients:
+				ok = reply("452 4.5.3 Too many recipients")
+			case !s.Handler.Accept(ctx, address):
+				ok = reply("550 5.1.1 Mailbox unavailable")
+			default:
+				session.recipients = append(session.recipients, address)
+				ok = reply("250 2.1.5 OK")
+			}
+		case "DATA":
+			if len(session.recipients) == 0 {
+				ok = reply("503 5.5.1 Send RCPT first")
+				break
+			}
+			if !reply("354 End data with <CR><LF>.<CR><LF>") {
+				return
+			}
+			ok = s.receive(ctx, conn, session)
+			session.reset()
+		case "RSET":
+			session.reset()
+			ok = reply("250 2.0.0 OK")
+		case "NOOP":
+			ok = reply("250 2.0.0 OK")
+		case "VRFY":
+			ok = reply("252 2.5.0 Cannot verify the address")
+		case "QUIT":
+			reply("221 2.0.0 Bye")
+			return
+		default:
+			ok = reply("502 5.5.2 Command not implemented")
+		}
+		if !ok {
+			return
+		}
+	}
+}
+
+// receive reads the data of a message and handles it for its recipients, replying once for SMTP
+// and once for each recipient for LMTP. It returns false if the connection is broken.
+func (s *Server) receive(ctx context.Context, conn *textproto.Conn, session *session) bool {
+	replies := len(session.recipients)
+	if !session.lmtp {
+		replies = 1
+	}
+	replyAll := func(format string, args ...any) bool {
+		for i := 0; i < replies; i++ {
+			if conn.PrintfLine(format, args...) != nil {
+				return false
+			}
+		}
+		return true
+	}
+
+	reader := conn.DotReader()
+	data, err := io.ReadAll(io.LimitReader(reader, s.maxMessageSize()+1))
+	if err != nil {
+		return false
+	}
+	if int64(len(data)) > s.maxMessageSize() {
+		// The rest of the data is read, so that the connection can go on.
+		if _, err := io.Copy(io.Discard, reader); err != nil {
+			return false
+		}
+		return replyAll("552 5.3.4 Message too big")
+	}
+	message, err := ParseMessage(bytes.NewReader(data))
+	if err != nil {
+		return replyAll("554 5.6.0 Invalid message: %s", err.Error())
+	}
+
+	// The SMTP reply is the one of the last failed recipient, if any.
+	smtpReply := "250 2.0.0 OK"
+	for _, recipient := range session.recipients {
+		reply := "250 2.0.0 Delivered"
+		if err := s.Handler.Handle(ctx, recipient, message); errors.Is(err, ErrRejected) {
+			reply = "554 5.7.1 " + err.Error()
+		} else if err != nil {
+			slog.Warn("Failed to handle received email", "recipient", recipient, "error", err)
+			reply = "451 4.3.0 Failed to process the message"
+		}
+		if !strings.HasPrefix(reply, "250") {
+			smtpReply = reply
+		}
+		if session.lmtp && conn.PrintfLine("%s to %s", reply, recipient) != nil {
+			return false
+		}
+	}
+	if session.lmtp {
+		return true
+	}
+	return replyAll("%s", smtpReply)
+}
+
+func (s *Server) maxMessageSize() int64 {
+	if s.MaxMessageSize <= 0 {
+		return DefaultMaxMessageSize
+	}
+	return s.MaxMessageSize
+}
+
+// parsePath returns the address of a MAIL FROM or RCPT TO argument, ignoring its parameters.
+func parsePath(arg, prefix string) (string, bool) {
+	if len(arg) < len(prefix) || !strings.EqualFold(arg[:len(prefix)], prefix) {
+		return "", false
+	}
+	path := strings.TrimSpace(arg[len(prefix):])
+	if !strings.HasPrefix(path, "<") {
+		return "", false
+	}
+	end := strings.Index(path, ">")
+	if end < 0 {
+		return "", false
+	}
+	address := path[1:end]
+	// The source routes, obsolete but allowed, are dropped.
+	if strings.HasPrefix(address, "@") {
+		if _, mailbox, found := strings.Cut(address, ":"); found {
+			address = mailbox
+		}
+	}
+	return address, true
+}
@@ -0,0 +1,170 @@
+package mailin
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/smtp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type testHandler struct {
+	mu       sync.Mutex
+	messages map[string][]*Message
+}
+
+func (*testHandler) Accept(_ context.Context, recipient string) bool {
+	return strings.HasPrefix(recipient, "inbox") || strings.HasPrefix(recipient, "failing") || strings.HasPrefix(recipient, "rejecting")
+}
+
+func (h *testHandler) Handle(_ context.Context, recipient string, message *Message) error {
+	if strings.HasPrefix(recipient, "failing") {
+		return errors.New("failed")
+	}
+	if strings.HasPrefix(recipient, "rejecting") {
+		return errors.Wrap(ErrRejected, "too long")
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.messages[recipient] = append(h.messages[recipient], message)
+	return nil
+}
+
+func (h *testHandler) received(recipient string) []*Message {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.messages[recipient]
+}
+
+func startTestServer(t *testing.T, maxMessageSize int64) (string, *testHandler) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	handler := &testHandler{messages: map[string][]*Message{}}
+	server := &Server{Hostname: "memos.test", MaxMessageSize: maxMessageSize, Handler: handler}
+	go func() {
+		_ = server.Serve(ctx, listener)
+	}()
+	return listener.Addr().String(), handler
+}
+
+const testMessage = "From: Jane <jane@example.com>\r\n" +
+	"To: inbox@memos.test\r\n" +
+	"Subject: =?UTF-8?Q?Caf=C3=A9_notes?=\r\n" +
+	"MIME-Version: 1.0\r\n" +
+	"Content-Type: multipart/mixed; boundary=\"mixed\"\r\n" +
+	"\r\n" +
+	"--mixed\r\n" +
+	"Content-Type: multipart/alternative; boundary=\"alternative\"\r\n" +
+	"\r\n" +
+	"--alternative\r\n" +
+	"Content-Type: text/plain; charset=utf-8\r\n" +
+	"Content-Transfer-Encoding: quoted-printable\r\n" +
+	"\r\n" +
+	"Buy co=\r\n" +
+	"ffee.\r\n" +
+	"--alternative\r\n" +
+	"Content-Type: text/html; charset=utf-8\r\n" +
+	"\r\n" +
+	"<p>Buy <b>coffee</b>.</p>\r\n" +
+	"--alternative--\r\n" +
+	"--mixed\r\n" +
+	"Content-Type: text/plain; name=\"list.txt\"\r\n" +
+	"Content-Disposition: attachment; filename=\"list.txt\"\r\n" +
+	"Content-Transfer-Encoding: base64\r\n" +
+	"\r\n" +
+	"YmVhbnMK\r\n" +
+	"--mixed--\r\n"
+
+func TestParseMessage(t *testing.T) {
+	message, err := ParseMessage(strings.NewReader(testMessage))
+	require.NoError(t, err)
+	require.Equal(t, "jane@example.com", message.From)
+	require.Equal(t, []string{"inbox@memos.test"}, message.Recipients)
+	require.Equal(t, "Café notes", message.Subject)
+	require.Equal(t, "Buy coffee.", message.Text)
+	require.Len(t, message.Attachments, 1)
+	require.Equal(t, "list.txt", message.Attachments[0].Filename)
+	require.Equal(t, "text/plain", message.Attachments[0].Type)
+	require.Equal(t, "beans\n", string(message.Attachments[0].Content))
+
+	// The HTML body is converted to text if the email has no plain text one.
+	message, err = ParseMessage(strings.NewReader("Subject: Hi\r\nContent-Type: text/html\r\n\r\n" +
+		"<html><head><style>p {}</style></head><body><p>Hello <b>world</b></p><p>Bye</p></body></html>\r\n"))
+	require.NoError(t, err)
+	require.Equal(t, "Hello world\n\nBye", message.Text)
+	require.Empty(t, message.Attachments)
+}
+
+func TestServerSMTP(t *testing.T) {
+	addr, handler := startTestServer(t, 0)
+
+	require.NoError(t, smtp.SendMail(addr, nil, "jane@example.com", []string{"inbox@memos.test"}, []byte(testMessage)))
+	require.Len(t, handler.received("inbox@memos.test"), 1)
+	require.Equal(t, "Café notes", handler.received("inbox@memos.test")[0].Subject)
+
+	// The unknown recipients are rejected before the data is sent.
+	err := smtp.SendMail(addr, nil, "jane@example.com", []string{"unknown@memos.test"}, []byte(testMessage))
+	require.ErrorContains(t, err, "550")
+	// The failures of the handler are temporary.
+	err = smtp.SendMail(addr, nil, "jane@example.com", []string{"failing@memos.test"}, []byte(testMessage))
+	require.ErrorContains(t, err, "451")
+	// The messages rejected by the handler are not retried.
+	err = smtp.SendMail(addr, nil, "jane@example.com", []string{"rejecting@memos.test"}, []byte(testMessage))
+	require.ErrorContains(t, err, "554")
+}
+
+func TestServerMaxMessageSize(t *testing.T) {
+	addr, handler := startTestServer(t, 100)
+
+	err := smtp.SendMail(addr, nil, "jane@example.com", []string{"inbox@memos.test"}, []byte(testMessage))
+	require.ErrorContains(t, err, "552")
+	require.Empty(t, handler.received("inbox@memos.test"))
+}
+
+func TestServerLMTP(t *testing.T) {
+	addr, handler := startTestServer(t, 0)
+
+	conn, err := net.Dial("tcp", addr)
+	require.NoError(t, err)
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	readReply := func() string {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		return strings.TrimSpace(line)
+	}
+	send := func(line string) {
+		_, err := conn.Write([]byte(line + "\r\n"))
+		require.NoError(t, err)
+	}
+
+	require.True(t, strings.HasPrefix(readReply(), "220"))
+	send("LHLO client")
+	for reply := readReply(); strings.HasPrefix(reply, "250-"); reply = readReply() {
+	}
+	send("MAIL FROM:<jane@example.com>")
+	require.True(t, strings.HasPrefix(readReply(), "250"))
+	send("RCPT TO:<inbox@memos.test>")
+	require.True(t, strings.HasPrefix(readReply(), "250"))
+	send("RCPT TO:<failing@memos.test>")
+	require.True(t, strings.HasPrefix(readReply(), "250"))
+	send("DATA")
+	require.True(t, strings.HasPrefix(readReply(), "354"))
+	_, err = conn.Write([]byte("Subject: Hello\r\n\r\n.Dotted line\r\n..\r\n.\r\n"))
+	require.NoError(t, err)
+	// LMTP replies once for each recipient.
+	require.True(t, strings.HasPrefix(readReply(), "250"))
+	require.True(t, strings.HasPrefix(readReply(), "451"))
+	send("QUIT")
+	require.True(t, strings.HasPrefix(readReply(), "221"))
+
+	require.Len(t, handler.received("inbox@memos.test"), 1)
+	require.Equal(t, "Dotted line\n.", handler.received("inbox@memos.test")[0].Text)
+}
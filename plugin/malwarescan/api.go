@@ -0,0 +1,77 @@
+package malwarescan
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// APIScanner scans files with an external HTTP API.
+// The file is posted as the request body with its name in the "filename" query parameter,
+// and the API responds with a JSON object like {"infected": true, "signature": "..."}.
+type APIScanner struct {
+	endpoint string
+	apiKey   string
+	client   *http.Client
+}
+
+// NewAPIScanner returns a scanner posting files to the endpoint.
+// The API key, sent as a bearer token, may be empty.
+func NewAPIScanner(endpoint, apiKey string) *APIScanner {
+	return &APIScanner{
+		endpoint: endpoint,
+		apiKey:   apiKey,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+type apiResponse struct {
+	Infected  bool   `json:"infected"`
+	Signature string `json:"signature"`
+}
+
+func (s *APIScanner) Scan(ctx context.Context, filename string, content io.Reader) (*Result, error) {
+	endpoint, err := url.Parse(s.endpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse endpoint")
+	}
+	query := endpoint.Query()
+	query.Set("filename", filename)
+	endpoint.RawQuery = query.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), content)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to construct scan request")
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	if s.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to post scan request to %s", s.endpoint)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read scan response")
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, errors.Errorf("failed to scan file, status code: %d, response body: %s", resp.StatusCode, b)
+	}
+
+	response := &apiResponse{}
+	if err := json.Unmarshal(b, response); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal scan response")
+	}
+	return &Result{
+		Infected:  response.Infected,
+		Signature: response.Signature,
+	}, nil
+}
@@ -0,0 +1,103 @@
+package malwarescan
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// clamAVChunkSize is the size of the chunks streamed to clamd.
+const clamAVChunkSize = 64 * 1024
+
+// ClamAVScanner scans files with the clamd daemon of ClamAV, streaming them with the INSTREAM command.
+// Reference: https://docs.clamav.net/manual/Usage/Scanning.html#clamd
+type ClamAVScanner struct {
+	network string
+	address string
+}
+
+// NewClamAVScanner returns a scanner connecting to clamd at the address, which is a host:port,
+// or the path of its unix socket, default to "localhost:3310".
+func NewClamAVScanner(address string) *ClamAVScanner {
+	if address == "" {
+		address = "localhost:3310"
+	}
+	network := "tcp"
+	if strings.HasPrefix(address, "unix:") || strings.HasPrefix(address, "/") {
+		network = "unix"
+		address = strings.TrimPrefix(strings.TrimPrefix(address, "unix:"), "//")
+	}
+	return &ClamAVScanner{
+		network: network,
+		address: address,
+	}
+}
+
+func (s *ClamAVScanner) Scan(ctx context.Context, _ string, content io.Reader) (*Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, s.network, s.address)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to clamd")
+	}
+	defer conn.Close()
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return nil, errors.Wrap(err, "failed to set deadline")
+	}
+
+	// The "z" prefix terminates the command and its reply with a null character.
+	if _, err := conn.Write([]byte("zINSTREAM\x00")); err != nil {
+		return nil, errors.Wrap(err, "failed to send command to clamd")
+	}
+	buffer := make([]byte, 4+clamAVChunkSize)
+	for {
+		n, err := io.ReadFull(content, buffer[4:])
+		if n > 0 {
+			binary.BigEndian.PutUint32(buffer, uint32(n))
+			if _, err := conn.Write(buffer[:4+n]); err != nil {
+				// clamd closes the connection when the stream exceeds its size limit, and replies with the error.
+				break
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read content")
+		}
+	}
+	// The stream ends with a chunk of length zero.
+	_, _ = conn.Write([]byte{0, 0, 0, 0})
+
+	reply, err := bufio.NewReader(conn).ReadBytes(0)
+	if err != nil && len(reply) == 0 {
+		return nil, errors.Wrap(err, "failed to read reply of clamd")
+	}
+	return parseClamAVReply(string(bytes.TrimRight(reply, "\x00")))
+}
+
+// parseClamAVReply parses a reply like "stream: OK", "stream: Eicar-Signature FOUND" or "... ERROR".
+func parseClamAVReply(reply string) (*Result, error) {
+	reply = strings.TrimSpace(reply)
+	_, verdict, _ := strings.Cut(reply, ": ")
+	switch {
+	case verdict == "OK":
+		return &Result{}, nil
+	case strings.HasSuffix(verdict, " FOUND"):
+		return &Result{
+			Infected:  true,
+			Signature: strings.TrimSuffix(verdict, " FOUND"),
+		}, nil
+	default:
+		return nil, errors.Errorf("clamd error: %s", reply)
+	}
+}
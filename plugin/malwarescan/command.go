@@ -0,0 +1,78 @@
+package malwarescan
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// CommandScanner scans files with a command line tool, e.g. clamscan, run with the path of a copy of the file appended.
+// The command exits with 0 if the file is clean and with 1 if it is flagged, and other codes are errors.
+type CommandScanner struct {
+	args []string
+}
+
+// NewCommandScanner returns a scanner running the command line, split on the spaces.
+func NewCommandScanner(command string) *CommandScanner {
+	return &CommandScanner{
+		args: strings.Fields(command),
+	}
+}
+
+func (s *CommandScanner) Scan(ctx context.Context, filename string, content io.Reader) (*Result, error) {
+	if len(s.args) == 0 {
+		return nil, errors.New("command is empty")
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// The file keeps its extension, which some scanners use to detect its type.
+	file, err := os.CreateTemp("", "memos-scan-*"+filepath.Ext(filepath.Base(filename)))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create temporary file")
+	}
+	defer os.Remove(file.Name())
+	if _, err := io.Copy(file, content); err != nil {
+		file.Close()
+		return nil, errors.Wrap(err, "failed to write temporary file")
+	}
+	if err := file.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to close temporary file")
+	}
+
+	cmd := exec.CommandContext(ctx, s.args[0], append(s.args[1:], file.Name())...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err = cmd.Run()
+	if err == nil {
+		return &Result{}, nil
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+		return &Result{
+			Infected:  true,
+			Signature: parseCommandSignature(stdout.String(), file.Name()),
+		}, nil
+	}
+	return nil, errors.Wrapf(err, "failed to run scanner: %s", strings.TrimSpace(stderr.String()))
+}
+
+// parseCommandSignature returns the signature in the first line of the output, like "/tmp/file: Eicar-Signature FOUND" for clamscan.
+func parseCommandSignature(output, path string) string {
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		line = strings.TrimPrefix(line, path+": ")
+		return strings.TrimSuffix(line, " FOUND")
+	}
+	return ""
+}
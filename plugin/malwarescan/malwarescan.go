@@ -0,0 +1,49 @@
+// Package malwarescan scans the uploaded files for malware, e.g. on the public instances.
+package malwarescan
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// timeout is the timeout of the scan of a file.
+var timeout = 2 * time.Minute
+
+// Result is the result of the scan of a file.
+type Result struct {
+	// Infected is true if the file is flagged as malware.
+	Infected bool
+	// Signature is the name of the detected malware, if known.
+	Signature string
+}
+
+// Scanner scans files for malware.
+type Scanner interface {
+	// Scan returns the result of the scan of the file with the name.
+	Scan(ctx context.Context, filename string, content io.Reader) (*Result, error)
+}
+
+// NewScanner returns the scanner configured by the workspace malware scan setting.
+func NewScanner(setting *storepb.WorkspaceMalwareScanSetting) (Scanner, error) {
+	switch setting.Scanner {
+	case storepb.WorkspaceMalwareScanSetting_CLAMAV, storepb.WorkspaceMalwareScanSetting_SCANNER_UNSPECIFIED:
+		return NewClamAVScanner(setting.ClamavAddress), nil
+	case storepb.WorkspaceMalwareScanSetting_COMMAND:
+		if setting.Command == "" {
+			return nil, errors.New("command is required for the command scanner")
+		}
+		return NewCommandScanner(setting.Command), nil
+	case storepb.WorkspaceMalwareScanSetting_API:
+		if setting.Endpoint == "" {
+			return nil, errors.New("endpoint is required for the API scanner")
+		}
+		return NewAPIScanner(setting.Endpoint, setting.ApiKey), nil
+	default:
+		return nil, errors.Errorf("unsupported malware scanner: %v", setting.Scanner)
+	}
+}
@@ -0,0 +1,155 @@
+package malwarescan
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// startFakeClamd starts a clamd answering INSTREAM, which flags the streams containing "virus", and returns its address.
+func startFakeClamd(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				reader := bufio.NewReader(conn)
+				command, err := reader.ReadString(0)
+				if err != nil || command != "zINSTREAM\x00" {
+					_, _ = conn.Write([]byte("UNKNOWN COMMAND\x00"))
+					return
+				}
+				var stream bytes.Buffer
+				for {
+					header := make([]byte, 4)
+					if _, err := io.ReadFull(reader, header); err != nil {
+						return
+					}
+					length := binary.BigEndian.Uint32(header)
+					if length == 0 {
+						break
+					}
+					if _, err := io.CopyN(&stream, reader, int64(length)); err != nil {
+						return
+					}
+				}
+				if bytes.Contains(stream.Bytes(), []byte("virus")) {
+					_, _ = conn.Write([]byte("stream: Test.Virus FOUND\x00"))
+				} else {
+					_, _ = conn.Write([]byte("stream: OK\x00"))
+				}
+			}()
+		}
+	}()
+	return listener.Addr().String()
+}
+
+func TestClamAVScanner(t *testing.T) {
+	ctx := context.Background()
+	scanner, err := NewScanner(&storepb.WorkspaceMalwareScanSetting{
+		Scanner:       storepb.WorkspaceMalwareScanSetting_CLAMAV,
+		ClamavAddress: startFakeClamd(t),
+	})
+	require.NoError(t, err)
+
+	// The content spans several chunks.
+	result, err := scanner.Scan(ctx, "notes.txt", bytes.NewReader(bytes.Repeat([]byte("clean "), 50000)))
+	require.NoError(t, err)
+	require.False(t, result.Infected)
+
+	result, err = scanner.Scan(ctx, "invoice.pdf", strings.NewReader(strings.Repeat("x", 100000)+"virus"))
+	require.NoError(t, err)
+	require.True(t, result.Infected)
+	require.Equal(t, "Test.Virus", result.Signature)
+
+	_, err = parseClamAVReply("INSTREAM size limit exceeded. ERROR")
+	require.Error(t, err)
+}
+
+func TestNewClamAVScanner(t *testing.T) {
+	scanner := NewClamAVScanner("")
+	require.Equal(t, "tcp", scanner.network)
+	require.Equal(t, "localhost:3310", scanner.address)
+	scanner = NewClamAVScanner("/run/clamav/clamd.ctl")
+	require.Equal(t, "unix", scanner.network)
+	require.Equal(t, "/run/clamav/clamd.ctl", scanner.address)
+	scanner = NewClamAVScanner("unix:///run/clamav/clamd.ctl")
+	require.Equal(t, "unix", scanner.network)
+	require.Equal(t, "/run/clamav/clamd.ctl", scanner.address)
+}
+
+func TestCommandScanner(t *testing.T) {
+	// A fake clamscan flagging the files containing "virus".
+	path := filepath.Join(t.TempDir(), "clamscan")
+	script := "#!/bin/sh\nfile=\"$2\"\ncase \"$file\" in *.pdf) ;; *) exit 2 ;; esac\nif grep -q virus \"$file\"; then echo \"$file: Test.Virus FOUND\"; exit 1; fi\necho \"$file: OK\"\n"
+	require.NoError(t, os.WriteFile(path, []byte(script), 0o755))
+
+	scanner, err := NewScanner(&storepb.WorkspaceMalwareScanSetting{
+		Scanner: storepb.WorkspaceMalwareScanSetting_COMMAND,
+		Command: path + " --no-summary",
+	})
+	require.NoError(t, err)
+	result, err := scanner.Scan(context.Background(), "invoice.pdf", strings.NewReader("clean"))
+	require.NoError(t, err)
+	require.False(t, result.Infected)
+	result, err = scanner.Scan(context.Background(), "invoice.pdf", strings.NewReader("virus"))
+	require.NoError(t, err)
+	require.True(t, result.Infected)
+	require.Equal(t, "Test.Virus", result.Signature)
+	// Other exit codes are errors.
+	_, err = scanner.Scan(context.Background(), "invoice.txt", strings.NewReader("clean"))
+	require.Error(t, err)
+
+	_, err = NewScanner(&storepb.WorkspaceMalwareScanSetting{Scanner: storepb.WorkspaceMalwareScanSetting_COMMAND})
+	require.Error(t, err)
+}
+
+func TestAPIScanner(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		require.Equal(t, "invoice.pdf", r.URL.Query().Get("filename"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		if string(body) == "virus" {
+			w.Write([]byte(`{"infected": true, "signature": "Test.Virus"}`))
+			return
+		}
+		w.Write([]byte(`{"infected": false}`))
+	}))
+	defer server.Close()
+
+	scanner, err := NewScanner(&storepb.WorkspaceMalwareScanSetting{
+		Scanner:  storepb.WorkspaceMalwareScanSetting_API,
+		Endpoint: server.URL,
+		ApiKey:   "secret",
+	})
+	require.NoError(t, err)
+	result, err := scanner.Scan(context.Background(), "invoice.pdf", strings.NewReader("clean"))
+	require.NoError(t, err)
+	require.False(t, result.Infected)
+	result, err = scanner.Scan(context.Background(), "invoice.pdf", strings.NewReader("virus"))
+	require.NoError(t, err)
+	require.Equal(t, &Result{Infected: true, Signature: "Test.Virus"}, result)
+
+	_, err = NewScanner(&storepb.WorkspaceMalwareScanSetting{Scanner: storepb.WorkspaceMalwareScanSetting_API})
+	require.Error(t, err)
+}
@@ -0,0 +1,71 @@
+package ocr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// timeout is the timeout of the recognition of an image.
+var timeout = 2 * time.Minute
+
+// APIProvider recognizes images with an external HTTP API.
+// The image is posted as the request body with its MIME type as content type,
+// and the API responds with a JSON object like {"text": "..."}.
+type APIProvider struct {
+	endpoint string
+	apiKey   string
+	client   *http.Client
+}
+
+// NewAPIProvider returns a provider posting images to the endpoint.
+// The API key, sent as a bearer token, may be empty.
+func NewAPIProvider(endpoint, apiKey string) *APIProvider {
+	return &APIProvider{
+		endpoint: endpoint,
+		apiKey:   apiKey,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+type apiResponse struct {
+	Text string `json:"text"`
+}
+
+func (p *APIProvider) Recognize(ctx context.Context, mimeType string, image []byte) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(image))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to construct OCR request")
+	}
+	req.Header.Set("Content-Type", mimeType)
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to post OCR request to %s", p.endpoint)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read OCR response")
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "", errors.Errorf("failed to recognize image, status code: %d, response body: %s", resp.StatusCode, b)
+	}
+
+	response := &apiResponse{}
+	if err := json.Unmarshal(b, response); err != nil {
+		return "", errors.Wrap(err, "failed to unmarshal OCR response")
+	}
+	return strings.TrimSpace(response.Text), nil
+}
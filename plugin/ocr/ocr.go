@@ -0,0 +1,42 @@
+// Package ocr recognizes the text of images, e.g. photographed whiteboards and receipts.
+package ocr
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// Provider recognizes the text of images.
+type Provider interface {
+	// Recognize returns the text of the image of the MIME type.
+	Recognize(ctx context.Context, mimeType string, image []byte) (string, error)
+}
+
+// NewProvider returns the provider configured by the workspace OCR setting.
+func NewProvider(setting *storepb.WorkspaceOCRSetting) (Provider, error) {
+	switch setting.Provider {
+	case storepb.WorkspaceOCRSetting_TESSERACT, storepb.WorkspaceOCRSetting_PROVIDER_UNSPECIFIED:
+		return NewTesseractProvider(setting.TesseractPath, setting.Languages), nil
+	case storepb.WorkspaceOCRSetting_API:
+		if setting.Endpoint == "" {
+			return nil, errors.New("endpoint is required for the API provider")
+		}
+		return NewAPIProvider(setting.Endpoint, setting.ApiKey), nil
+	default:
+		return nil, errors.Errorf("unsupported OCR provider: %v", setting.Provider)
+	}
+}
+
+// IsSupported returns true if images of the MIME type can be recognized.
+func IsSupported(mimeType string) bool {
+	mimeType, _, _ = strings.Cut(mimeType, ";")
+	switch strings.ToLower(strings.TrimSpace(mimeType)) {
+	case "image/png", "image/jpeg", "image/tiff", "image/bmp", "image/webp":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package ocr
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestAPIProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		require.Equal(t, "image/png", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, "image", string(body))
+		w.Write([]byte(`{"text": " Total: 42 EUR\n"}`))
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider(&storepb.WorkspaceOCRSetting{
+		Provider: storepb.WorkspaceOCRSetting_API,
+		Endpoint: server.URL,
+		ApiKey:   "secret",
+	})
+	require.NoError(t, err)
+	text, err := provider.Recognize(context.Background(), "image/png", []byte("image"))
+	require.NoError(t, err)
+	require.Equal(t, "Total: 42 EUR", text)
+
+	_, err = NewProvider(&storepb.WorkspaceOCRSetting{Provider: storepb.WorkspaceOCRSetting_API})
+	require.Error(t, err)
+}
+
+func TestTesseractProvider(t *testing.T) {
+	// A fake tesseract echoing its arguments and input.
+	path := filepath.Join(t.TempDir(), "tesseract")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\necho \"$@\"\ncat\n"), 0o755))
+
+	text, err := NewTesseractProvider(path, "").Recognize(context.Background(), "image/png", []byte("image"))
+	require.NoError(t, err)
+	require.Equal(t, "- - -l eng\nimage", text)
+
+	_, err = NewTesseractProvider(filepath.Join(t.TempDir(), "missing"), "").Recognize(context.Background(), "image/png", nil)
+	require.Error(t, err)
+}
+
+func TestIsSupported(t *testing.T) {
+	require.True(t, IsSupported("image/jpeg"))
+	require.False(t, IsSupported("image/svg+xml"))
+	require.False(t, IsSupported("application/pdf"))
+}
@@ -0,0 +1,47 @@
+package ocr
+
+import (
+	"bytes"
+	"context"
+	"os/exec"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// TesseractProvider recognizes images with the Tesseract command line tool.
+type TesseractProvider struct {
+	path      string
+	languages string
+}
+
+// NewTesseractProvider returns a provider running the tesseract binary at the path,
+// default to "tesseract" in the PATH, with the languages, e.g. "eng+deu", default to "eng".
+func NewTesseractProvider(path, languages string) *TesseractProvider {
+	if path == "" {
+		path = "tesseract"
+	}
+	if languages == "" {
+		languages = "eng"
+	}
+	return &TesseractProvider{
+		path:      path,
+		languages: languages,
+	}
+}
+
+func (p *TesseractProvider) Recognize(ctx context.Context, _ string, image []byte) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Tesseract detects the image format, and reads from stdin and writes to stdout with the "-" file names.
+	cmd := exec.CommandContext(ctx, p.path, "-", "-", "-l", p.languages)
+	cmd.Stdin = bytes.NewReader(image)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", errors.Wrapf(err, "failed to run tesseract: %s", strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
@@ -0,0 +1,75 @@
+// Package pwned checks the passwords against the known data breaches with the range API of Have I Been Pwned.
+// Only the first 5 characters of the SHA-1 hash of a password are sent, which a large number of passwords share.
+package pwned
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultEndpoint is the endpoint of the public range API.
+const DefaultEndpoint = "https://api.pwnedpasswords.com"
+
+// timeout is the timeout of the range requests.
+var timeout = 5 * time.Second
+
+// Checker checks the passwords with a range API.
+type Checker struct {
+	endpoint string
+	client   *http.Client
+}
+
+// NewChecker returns a checker querying the range API at the endpoint, or at DefaultEndpoint if empty.
+func NewChecker(endpoint string) *Checker {
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+	return &Checker{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+// IsBreached returns whether the password was found in a known data breach.
+func (c *Checker) IsBreached(ctx context.Context, password string) (bool, error) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:5], hash[5:]
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint+"/range/"+prefix, nil)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to construct range request")
+	}
+	// The padding hides the number of the suffixes of the prefix from the observers of the response size.
+	req.Header.Set("Add-Padding", "true")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get range from %s", c.endpoint)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, errors.Errorf("failed to get range, status code: %d", resp.StatusCode)
+	}
+
+	// Each line is the suffix of a hash and its count, the padding lines having a count of zero.
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		lineSuffix, count, ok := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if ok && strings.EqualFold(lineSuffix, suffix) && count != "0" {
+			return true, nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return false, errors.Wrap(err, "failed to read range response")
+	}
+	return false, nil
+}
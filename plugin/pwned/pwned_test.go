@@ -0,0 +1,49 @@
+package pwned
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecker(t *testing.T) {
+	sum := sha1.Sum([]byte("password"))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	requestedPaths := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPaths = append(requestedPaths, r.URL.Path)
+		require.Equal(t, "true", r.Header.Get("Add-Padding"))
+		// The range lists the breached password, a padding line and another suffix.
+		fmt.Fprintf(w, "%s:3861493\r\n", hash[5:])
+		fmt.Fprint(w, "0018A45C4D1DEF81644B54AB7F969B88D65:0\r\n")
+		fmt.Fprint(w, "00D4F6E8FA6EECAD2A3AA415EEC418D38EC:2\r\n")
+	}))
+	defer server.Close()
+
+	checker := NewChecker(server.URL + "/")
+	breached, err := checker.IsBreached(context.Background(), "password")
+	require.NoError(t, err)
+	require.True(t, breached)
+	require.Equal(t, []string{"/range/" + hash[:5]}, requestedPaths)
+
+	breached, err = checker.IsBreached(context.Background(), "correct horse battery staple 42")
+	require.NoError(t, err)
+	require.False(t, breached)
+}
+
+func TestCheckerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	_, err := NewChecker(server.URL).IsBreached(context.Background(), "password")
+	require.Error(t, err)
+}
@@ -0,0 +1,574 @@
+// Package readability extracts the main article of an HTML page as markdown, leaving out the navigation,
+// the sidebars, the comments and the other boilerplate around it.
+package readability
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/usememos/memos/internal/util"
+)
+
+// minParagraphLength is the length of text below which a paragraph does not count towards the score of its container.
+const minParagraphLength = 25
+
+var (
+	// unlikelyCandidates matches the classes and IDs of the boilerplate elements.
+	unlikelyCandidates = regexp.MustCompile(`(?i)comment|sidebar|footer|footnote|menu|\bnav|share|social|related|advert|\bads?\b|promo|sponsor|cookie|banner|subscribe|newsletter|popup|modal|breadcrumb`)
+	// likelyCandidates matches the classes and IDs of the elements holding the article.
+	likelyCandidates = regexp.MustCompile(`(?i)article|content|main|post|entry|story|text|body`)
+	// markdownEscaper escapes the markdown syntax characters of the text.
+	markdownEscaper = strings.NewReplacer(`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `#`, `\#`, `[`, `\[`, `]`, `\]`, `~`, `\~`, `|`, `\|`, `$`, `\$`)
+	// orderedListMarker matches the text read as the marker of an ordered list.
+	orderedListMarker = regexp.MustCompile(`^(\d+)\. `)
+)
+
+// removedElements are the elements never part of an article.
+var removedElements = map[atom.Atom]bool{
+	atom.Script: true, atom.Style: true, atom.Noscript: true, atom.Template: true, atom.Nav: true, atom.Footer: true,
+	atom.Aside: true, atom.Form: true, atom.Button: true, atom.Input: true, atom.Select: true, atom.Textarea: true,
+	atom.Iframe: true, atom.Object: true, atom.Embed: true, atom.Canvas: true, atom.Svg: true, atom.Dialog: true,
+}
+
+// blockElements are the elements rendered as markdown blocks, the others being rendered inline.
+var blockElements = map[atom.Atom]bool{
+	atom.Address: true, atom.Article: true, atom.Blockquote: true, atom.Details: true, atom.Div: true, atom.Dl: true,
+	atom.Dd: true, atom.Dt: true, atom.Fieldset: true, atom.Figcaption: true, atom.Figure: true, atom.Header: true,
+	atom.H1: true, atom.H2: true, atom.H3: true, atom.H4: true, atom.H5: true, atom.H6: true, atom.Hr: true,
+	atom.Li: true, atom.Main: true, atom.Ol: true, atom.P: true, atom.Pre: true, atom.Section: true,
+	atom.Summary: true, atom.Table: true, atom.Thead: true, atom.Tbody: true, atom.Tfoot: true, atom.Tr: true,
+	atom.Td: true, atom.Th: true, atom.Ul: true,
+}
+
+// Article is the main article of a page.
+type Article struct {
+	Title    string
+	Byline   string
+	SiteName string
+	// LeadImage is the absolute URL of the lead image of the article, empty if it has none.
+	LeadImage string
+	// Content is the article as markdown, its text being escaped so that it holds no tags.
+	Content string
+
+	blocks []string
+}
+
+// TruncatedContent returns the leading blocks of the content fitting in the length limit, in bytes.
+func (a *Article) TruncatedContent(limit int) string {
+	length := 0
+	for i, block := range a.blocks {
+		if i > 0 {
+			length += len("\n\n")
+		}
+		length += len(block)
+		if length > limit {
+			return strings.Join(a.blocks[:i], "\n\n")
+		}
+	}
+	return a.Content
+}
+
+// EscapeMarkdown escapes the characters of the text read as markdown syntax, notably # read as tags.
+func EscapeMarkdown(text string) string {
+	return markdownEscaper.Replace(text)
+}
+
+// Extract returns the main article of the HTML page of the URL, the links and images of its content being absolute.
+func Extract(r io.Reader, pageURL *url.URL) (*Article, error) {
+	document, err := html.Parse(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse HTML")
+	}
+	article := &Article{}
+	extractMeta(document, article)
+	if article.LeadImage != "" {
+		article.LeadImage = resolveURL(pageURL, article.LeadImage)
+	}
+
+	body := findFirst(document, atom.Body)
+	if body == nil {
+		return nil, errors.New("the page has no body")
+	}
+	if article.Title == "" {
+		if heading := findFirst(body, atom.H1); heading != nil {
+			article.Title = normalizeSpace(textContent(heading))
+		}
+	}
+	removeBoilerplate(body)
+	candidate := findCandidate(body)
+	if article.LeadImage == "" {
+		if image := findFirst(candidate, atom.Img); image != nil {
+			article.LeadImage = resolveURL(pageURL, getImageSource(image))
+		}
+	}
+
+	c := &converter{pageURL: pageURL, leadImage: article.LeadImage}
+	blocks := c.blocks(candidate)
+	// The title of the article is usually repeated as its first heading.
+	if len(blocks) > 0 && strings.TrimLeft(blocks[0], "# ") == EscapeMarkdown(article.Title) {
+		blocks = blocks[1:]
+	}
+	article.blocks = blocks
+	article.Content = strings.Join(blocks, "\n\n")
+	if article.Content == "" {
+		return nil, errors.New("the page has no article")
+	}
+	return article, nil
+}
+
+// extractMeta sets the title, byline, site name and lead image of the article from the head of the page.
+func extractMeta(document *html.Node, article *Article) {
+	head := findFirst(document, atom.Head)
+	if head == nil {
+		return
+	}
+	walk(head, func(n *html.Node) bool {
+		switch n.DataAtom {
+		case atom.Title:
+			if article.Title == "" {
+				article.Title = normalizeSpace(textContent(n))
+			}
+		case atom.Meta:
+			key := strings.ToLower(getAttribute(n, "property"))
+			if key == "" {
+				key = strings.ToLower(getAttribute(n, "name"))
+			}
+			content := normalizeSpace(getAttribute(n, "content"))
+			if content == "" {
+				return true
+			}
+			switch key {
+			case "og:title":
+				article.Title = content
+			case "og:site_name":
+				article.SiteName = content
+			case "og:image", "twitter:image":
+				if article.LeadImage == "" {
+					article.LeadImage = content
+				}
+			case "author", "article:author":
+				if article.Byline == "" && !strings.Contains(content, "://") {
+					article.Byline = content
+				}
+			}
+		}
+		return true
+	})
+}
+
+// removeBoilerplate removes the elements of the node which are not part of an article.
+func removeBoilerplate(node *html.Node) {
+	for child := node.FirstChild; child != nil; {
+		next := child.NextSibling
+		if child.Type == html.CommentNode || (child.Type == html.ElementNode && isBoilerplate(child)) {
+			node.RemoveChild(child)
+		} else {
+			removeBoilerplate(child)
+		}
+		child = next
+	}
+}
+
+func isBoilerplate(n *html.Node) bool {
+	if removedElements[n.DataAtom] {
+		return true
+	}
+	if _, ok := getAttributeOK(n, "hidden"); ok || getAttribute(n, "aria-hidden") == "true" {
+		return true
+	}
+	if style := strings.ReplaceAll(getAttribute(n, "style"), " ", ""); strings.Contains(style, "display:none") {
+		return true
+	}
+	if n.DataAtom == atom.Article || n.DataAtom == atom.Main {
+		return false
+	}
+	classAndID := getAttribute(n, "class") + " " + getAttribute(n, "id")
+	return unlikelyCandidates.MatchString(classAndID) && !likelyCandidates.MatchString(classAndID)
+}
+
+// findCandidate returns the element holding the article, the one whose paragraphs score the most, or else the body.
+func findCandidate(body *html.Node) *html.Node {
+	scores := map[*html.Node]float64{}
+	walk(body, func(n *html.Node) bool {
+		if n.DataAtom != atom.P && n.DataAtom != atom.Pre && n.DataAtom != atom.Td {
+			return true
+		}
+		text := normalizeSpace(textContent(n))
+		if len(text) < minParagraphLength {
+			return false
+		}
+		score := 1 + float64(strings.Count(text, ",")) + min(float64(len(text))/100, 3)
+		if parent := n.Parent; parent != nil {
+			scores[parent] += score
+			if grandparent := parent.Parent; grandparent != nil {
+				scores[grandparent] += score / 2
+			}
+		}
+		return false
+	})
+
+	var candidate *html.Node
+	bestScore := 0.0
+	for n, score := range scores {
+		if n.Type != html.ElementNode {
+			continue
+		}
+		if n.DataAtom == atom.Article || n.DataAtom == atom.Main {
+			score += 25
+		}
+		if likelyCandidates.MatchString(getAttribute(n, "class") + " " + getAttribute(n, "id")) {
+			score += 25
+		}
+		score *= 1 - getLinkDensity(n)
+		if score > bestScore || (score == bestScore && candidate != nil && isAncestor(n, candidate)) {
+			candidate, bestScore = n, score
+		}
+	}
+	if candidate == nil {
+		return body
+	}
+	return candidate
+}
+
+// getLinkDensity returns the share of the text of the node in links.
+func getLinkDensity(n *html.Node) float64 {
+	textLength := len(normalizeSpace(textContent(n)))
+	if textLength == 0 {
+		return 0
+	}
+	linkLength := 0
+	walk(n, func(child *html.Node) bool {
+		if child.DataAtom == atom.A {
+			linkLength += len(normalizeSpace(textContent(child)))
+			return false
+		}
+		return true
+	})
+	return float64(linkLength) / float64(textLength)
+}
+
+// converter renders the HTML of an article as markdown.
+type converter struct {
+	pageURL   *url.URL
+	leadImage string
+}
+
+// blocks renders the children of the node as markdown blocks, the inline children being grouped in paragraphs.
+func (c *converter) blocks(n *html.Node) []string {
+	blocks := []string{}
+	var inline strings.Builder
+	flush := func() {
+		if paragraph := formatParagraph(inline.String()); paragraph != "" {
+			blocks = append(blocks, paragraph)
+		}
+		inline.Reset()
+	}
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if child.Type == html.ElementNode && blockElements[child.DataAtom] {
+			flush()
+			blocks = append(blocks, c.block(child)...)
+		} else {
+			inline.WriteString(c.inline(child))
+		}
+	}
+	flush()
+	return blocks
+}
+
+func (c *converter) block(n *html.Node) []string {
+	switch n.DataAtom {
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		text := formatInline(c.inlineChildren(n))
+		if text == "" {
+			return nil
+		}
+		level := int(n.Data[1] - '0')
+		return []string{strings.Repeat("#", level) + " " + text}
+	case atom.Hr:
+		return []string{"---"}
+	case atom.Pre:
+		code := strings.Trim(textContent(n), "\n")
+		if strings.TrimSpace(code) == "" {
+			return nil
+		}
+		language := ""
+		if codeElement := findFirst(n, atom.Code); codeElement != nil {
+			for _, class := range strings.Fields(getAttribute(codeElement, "class")) {
+				if after, ok := strings.CutPrefix(class, "language-"); ok {
+					language = after
+				}
+			}
+		}
+		return []string{"```" + language + "\n" + code + "\n```"}
+	case atom.Blockquote:
+		lines := []string{}
+		for _, block := range c.blocks(n) {
+			for _, line := range strings.Split(block, "\n") {
+				lines = append(lines, "> "+line)
+			}
+		}
+		if len(lines) == 0 {
+			return nil
+		}
+		return []string{strings.Join(lines, "\n")}
+	case atom.Ul, atom.Ol:
+		lines := []string{}
+		number := 1
+		for item := n.FirstChild; item != nil; item = item.NextSibling {
+			if item.DataAtom != atom.Li {
+				continue
+			}
+			marker := "- "
+			if n.DataAtom == atom.Ol {
+				marker = fmt.Sprintf("%d. ", number)
+				number++
+			}
+			indent := strings.Repeat(" ", len(marker))
+			for i, line := range strings.Split(strings.Join(c.blocks(item), "\n"), "\n") {
+				if i == 0 {
+					lines = append(lines, marker+line)
+				} else if line != "" {
+					lines = append(lines, indent+line)
+				}
+			}
+		}
+		if len(lines) == 0 {
+			return nil
+		}
+		return []string{strings.Join(lines, "\n")}
+	case atom.Table:
+		return c.table(n)
+	default:
+		return c.blocks(n)
+	}
+}
+
+// table renders the rows of the table as a markdown table, its first row being the header.
+func (c *converter) table(n *html.Node) []string {
+	rows := [][]string{}
+	walk(n, func(child *html.Node) bool {
+		if child.DataAtom != atom.Tr {
+			return true
+		}
+		cells := []string{}
+		for cell := child.FirstChild; cell != nil; cell = cell.NextSibling {
+			if cell.DataAtom == atom.Td || cell.DataAtom == atom.Th {
+				cells = append(cells, strings.ReplaceAll(formatInline(c.inlineChildren(cell)), "\n", " "))
+			}
+		}
+		if len(cells) > 0 {
+			rows = append(rows, cells)
+		}
+		return false
+	})
+	if len(rows) == 0 {
+		return nil
+	}
+	// A single cell table is a layout table.
+	if len(rows) == 1 && len(rows[0]) == 1 {
+		return c.blocks(n)
+	}
+	columns := 0
+	for _, row := range rows {
+		columns = max(columns, len(row))
+	}
+	lines := []string{}
+	for i, row := range rows {
+		for len(row) < columns {
+			row = append(row, "")
+		}
+		lines = append(lines, "| "+strings.Join(row, " | ")+" |")
+		if i == 0 {
+			lines = append(lines, "|"+strings.Repeat(" --- |", columns))
+		}
+	}
+	return []string{strings.Join(lines, "\n")}
+}
+
+func (c *converter) inline(n *html.Node) string {
+	switch n.Type {
+	case html.TextNode:
+		return EscapeMarkdown(util.CollapseSpaces(n.Data))
+	case html.ElementNode:
+	default:
+		return ""
+	}
+	switch n.DataAtom {
+	case atom.Br:
+		return "\n"
+	case atom.Strong, atom.B:
+		return wrapInline(c.inlineChildren(n), "**")
+	case atom.Em, atom.I:
+		return wrapInline(c.inlineChildren(n), "*")
+	case atom.Del, atom.S, atom.Strike:
+		return wrapInline(c.inlineChildren(n), "~~")
+	case atom.Code, atom.Kbd, atom.Samp:
+		code := normalizeSpace(textContent(n))
+		if strings.TrimSpace(code) == "" || strings.Contains(code, "`") {
+			return EscapeMarkdown(code)
+		}
+		return "`" + code + "`"
+	case atom.A:
+		text := c.inlineChildren(n)
+		href := getAttribute(n, "href")
+		if strings.TrimSpace(text) == "" || href == "" || strings.HasPrefix(href, "#") {
+			return text
+		}
+		href = resolveURL(c.pageURL, href)
+		if href == "" {
+			return text
+		}
+		return wrapInline(text, "[", "]("+href+")")
+	case atom.Img:
+		src := resolveURL(c.pageURL, getImageSource(n))
+		if src == "" || src == c.leadImage {
+			return ""
+		}
+		return "![" + EscapeMarkdown(normalizeSpace(getAttribute(n, "alt"))) + "](" + src + ")"
+	default:
+		text := c.inlineChildren(n)
+		if blockElements[n.DataAtom] {
+			return " " + text + " "
+		}
+		return text
+	}
+}
+
+func (c *converter) inlineChildren(n *html.Node) string {
+	var builder strings.Builder
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		builder.WriteString(c.inline(child))
+	}
+	return builder.String()
+}
+
+// wrapInline wraps the text with the markers, leaving its surrounding spaces outside of them.
+func wrapInline(text, prefix string, suffix ...string) string {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return text
+	}
+	closing := prefix
+	if len(suffix) > 0 {
+		closing = suffix[0]
+	}
+	leading := text[:len(text)-len(strings.TrimLeft(text, " \n"))]
+	trailing := text[len(strings.TrimRight(text, " \n")):]
+	return leading + prefix + trimmed + closing + trailing
+}
+
+// formatInline trims the spaces of the lines of the inline markdown, dropping its empty lines.
+func formatInline(text string) string {
+	lines := []string{}
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.Join(strings.Fields(line), " "); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatParagraph formats the inline markdown as a paragraph, escaping the text read as the start of another block.
+func formatParagraph(text string) string {
+	lines := strings.Split(formatInline(text), "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "- "), strings.HasPrefix(line, "+ "), strings.HasPrefix(line, "> "), strings.HasPrefix(line, "---"):
+			lines[i] = `\` + line
+		case orderedListMarker.MatchString(line):
+			lines[i] = orderedListMarker.ReplaceAllString(line, `$1\. `)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// normalizeSpace replaces the runs of whitespace of the text with a single space, trimming it, as normalize-space()
+// in XPath.
+func normalizeSpace(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// resolveURL returns the absolute http or https URL of the reference, empty if it is of another scheme.
+func resolveURL(pageURL *url.URL, reference string) string {
+	u, err := pageURL.Parse(strings.TrimSpace(reference))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return ""
+	}
+	return u.String()
+}
+
+// getImageSource returns the source of the image, lazy-loaded images keeping theirs in a data attribute.
+func getImageSource(n *html.Node) string {
+	for _, key := range []string{"data-src", "data-original", "src"} {
+		if value := getAttribute(n, key); value != "" && !strings.HasPrefix(value, "data:") {
+			return value
+		}
+	}
+	return ""
+}
+
+func textContent(n *html.Node) string {
+	var builder strings.Builder
+	walk(n, func(child *html.Node) bool {
+		if child.Type == html.TextNode {
+			builder.WriteString(child.Data)
+		}
+		return true
+	})
+	return builder.String()
+}
+
+// walk calls the function on the descendants of the node in document order, skipping the children of the nodes
+// it returns false for.
+func walk(n *html.Node, fn func(*html.Node) bool) {
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		if fn(child) {
+			walk(child, fn)
+		}
+	}
+}
+
+func findFirst(n *html.Node, a atom.Atom) *html.Node {
+	var found *html.Node
+	walk(n, func(child *html.Node) bool {
+		if found != nil {
+			return false
+		}
+		if child.DataAtom == a {
+			found = child
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+func isAncestor(ancestor, n *html.Node) bool {
+	for parent := n.Parent; parent != nil; parent = parent.Parent {
+		if parent == ancestor {
+			return true
+		}
+	}
+	return false
+}
+
+func getAttribute(n *html.Node, key string) string {
+	value, _ := getAttributeOK(n, key)
+	return value
+}
+
+func getAttributeOK(n *html.Node, key string) (string, bool) {
+	for _, attr := range n.Attr {
+		if attr.Key == key {
+			return attr.Val, true
+		}
+	}
+	return "", false
+}
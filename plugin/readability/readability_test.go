@@ -0,0 +1,81 @@
+package readability
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testPage = `<!DOCTYPE html>
+<html><head>
+<title>Ignored title</title>
+<meta property="og:title" content="Why #tags matter">
+<meta property="og:site_name" content="The Blog">
+<meta property="og:image" content="/images/lead.jpg">
+<meta name="author" content="Jane Doe">
+<script>var tracking = true;</script>
+</head><body>
+<nav class="site-nav"><a href="/">Home</a> <a href="/about">About</a></nav>
+<div class="sidebar"><p>Subscribe to our newsletter, it is great, really, we promise.</p></div>
+<article class="post">
+  <h1>Why #tags matter</h1>
+  <p><img src="/images/lead.jpg" alt="Lead"></p>
+  <p>Tags are <strong>useful</strong>, as they group the notes by topic, like #work or #home.
+  See <a href="/docs/tags">the docs</a> for more.</p>
+  <h2>How to use them</h2>
+  <ul>
+    <li>Write a tag anywhere in the note.</li>
+    <li>Nest them with slashes:
+      <ol><li>First level</li><li>Second level</li></ol>
+    </li>
+  </ul>
+  <blockquote><p>Organize your thoughts, one tag at a time.</p></blockquote>
+  <pre><code class="language-go">fmt.Println("#not a tag")</code></pre>
+  <p>1. This line is not a list, and the <code>*stars*</code> stay as is.</p>
+  <div class="share-buttons"><a href="https://twitter.com">Share</a></div>
+</article>
+<div id="comments"><p>Great post, thanks a lot for writing it, very helpful!</p></div>
+<footer><p>Copyright 2024, The Blog, all rights reserved.</p></footer>
+</body></html>`
+
+func TestExtract(t *testing.T) {
+	pageURL, err := url.Parse("https://blog.example.com/posts/tags")
+	require.NoError(t, err)
+	article, err := Extract(strings.NewReader(testPage), pageURL)
+	require.NoError(t, err)
+	require.Equal(t, "Why #tags matter", article.Title)
+	require.Equal(t, "The Blog", article.SiteName)
+	require.Equal(t, "Jane Doe", article.Byline)
+	require.Equal(t, "https://blog.example.com/images/lead.jpg", article.LeadImage)
+	require.Equal(t, strings.Join([]string{
+		"Tags are **useful**, as they group the notes by topic, like \\#work or \\#home. See [the docs](https://blog.example.com/docs/tags) for more.",
+		"## How to use them",
+		"- Write a tag anywhere in the note.\n- Nest them with slashes:\n  1. First level\n  2. Second level",
+		"> Organize your thoughts, one tag at a time.",
+		"```go\nfmt.Println(\"#not a tag\")\n```",
+		"1\\. This line is not a list, and the `*stars*` stay as is.",
+	}, "\n\n"), article.Content)
+}
+
+func TestExtractWithoutArticle(t *testing.T) {
+	pageURL, err := url.Parse("https://example.com")
+	require.NoError(t, err)
+	article, err := Extract(strings.NewReader(`<html><body><h1>Hello</h1><p>Short <em>page</em> | <img data-src="/a.png" alt="An image"></p></body></html>`), pageURL)
+	require.NoError(t, err)
+	require.Equal(t, "Hello", article.Title)
+	require.Equal(t, "https://example.com/a.png", article.LeadImage)
+	require.Equal(t, `Short *page* \|`, article.Content)
+
+	_, err = Extract(strings.NewReader(`<html><body><nav>Menu</nav></body></html>`), pageURL)
+	require.Error(t, err)
+}
+
+func TestTruncatedContent(t *testing.T) {
+	article := &Article{Content: "First\n\n```\na\n\nb\n```\n\nLast", blocks: []string{"First", "```\na\n\nb\n```", "Last"}}
+	require.Equal(t, article.Content, article.TruncatedContent(100))
+	require.Equal(t, "First\n\n```\na\n\nb\n```", article.TruncatedContent(len("First\n\n```\na\n\nb\n```")))
+	require.Equal(t, "First", article.TruncatedContent(10))
+	require.Empty(t, article.TruncatedContent(3))
+}
@@ -0,0 +1,141 @@
+// Package readwise exports the highlights of a Readwise account with the Readwise export API.
+package readwise
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultAPIEndpoint is the endpoint of the Readwise API.
+	DefaultAPIEndpoint = "https://readwise.io/api/v2"
+	// DefaultTag is the tag of the memos of the highlights, followed by the category of their book.
+	DefaultTag = "readwise"
+
+	// timeout is the timeout of the requests to the Readwise API.
+	timeout = 30 * time.Second
+	// maxPages bounds the pages of an export, far above the pages of the largest libraries.
+	maxPages = 1000
+)
+
+// ErrUnauthorized is returned for the invalid or revoked access tokens.
+var ErrUnauthorized = errors.New("invalid Readwise access token")
+
+// Handler saves the books of the Readwise users as memos.
+type Handler interface {
+	// SaveBook creates the memo of the book and its highlights as the user, or updates the memo of the memoUID
+	// if it still exists, and returns the UID of the memo.
+	SaveBook(ctx context.Context, userID int32, memoUID string, book *Book, tag string) (string, error)
+}
+
+// Book is a book, an article or any other document of the Readwise library, with its highlights.
+type Book struct {
+	ID          int64        `json:"user_book_id"`
+	Title       string       `json:"title"`
+	Author      string       `json:"author"`
+	Category    string       `json:"category"`
+	SourceURL   string       `json:"source_url"`
+	ReadwiseURL string       `json:"readwise_url"`
+	Highlights  []*Highlight `json:"highlights"`
+}
+
+type Highlight struct {
+	ID            int64     `json:"id"`
+	Text          string    `json:"text"`
+	Note          string    `json:"note"`
+	Location      int64     `json:"location"`
+	HighlightedAt time.Time `json:"highlighted_at"`
+	URL           string    `json:"url"`
+	IsDeleted     bool      `json:"is_deleted"`
+}
+
+// Client calls the Readwise API with the access token of a user.
+type Client struct {
+	Token string
+	// APIEndpoint is the endpoint of the Readwise API, DefaultAPIEndpoint if empty.
+	APIEndpoint string
+}
+
+// Export returns the books with highlights updated after updatedAfter if not zero, or else all the books,
+// restricted to the IDs if any. The books updated after updatedAfter only list their updated highlights.
+func (c *Client) Export(ctx context.Context, updatedAfter time.Time, ids []int64) ([]*Book, error) {
+	query := url.Values{}
+	if !updatedAfter.IsZero() {
+		query.Set("updatedAfter", updatedAfter.UTC().Format(time.RFC3339))
+	}
+	if len(ids) > 0 {
+		values := make([]string, 0, len(ids))
+		for _, id := range ids {
+			values = append(values, strconv.FormatInt(id, 10))
+		}
+		query.Set("ids", strings.Join(values, ","))
+	}
+
+	books := []*Book{}
+	for range maxPages {
+		page := struct {
+			Results        []*Book `json:"results"`
+			NextPageCursor any     `json:"nextPageCursor"`
+		}{}
+		if err := c.call(ctx, "/export/?"+query.Encode(), &page); err != nil {
+			return nil, err
+		}
+		books = append(books, page.Results...)
+		// The cursors are numbers, but they are only passed back.
+		switch cursor := page.NextPageCursor.(type) {
+		case nil:
+			return books, nil
+		case float64:
+			query.Set("pageCursor", strconv.FormatInt(int64(cursor), 10))
+		case string:
+			if cursor == "" {
+				return books, nil
+			}
+			query.Set("pageCursor", cursor)
+		default:
+			return nil, errors.Errorf("invalid Readwise page cursor %v", cursor)
+		}
+	}
+	return nil, errors.New("too many Readwise export pages")
+}
+
+func (c *Client) call(ctx context.Context, path string, result any) error {
+	endpoint := c.APIEndpoint
+	if endpoint == "" {
+		endpoint = DefaultAPIEndpoint
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(endpoint, "/")+path, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to construct Readwise request")
+	}
+	req.Header.Set("Authorization", "Token "+c.Token)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to call Readwise API")
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64<<20))
+	if err != nil {
+		return errors.Wrap(err, "failed to read Readwise response")
+	}
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return ErrUnauthorized
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("Readwise export failed with status %d: %s", resp.StatusCode, body)
+	}
+	if err := json.Unmarshal(body, result); err != nil {
+		return errors.Wrap(err, "failed to unmarshal Readwise response")
+	}
+	return nil
+}
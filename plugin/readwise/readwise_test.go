@@ -0,0 +1,47 @@
+package readwise
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport(t *testing.T) {
+	queries := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Token secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		require.Equal(t, "/export/", r.URL.Path)
+		queries = append(queries, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("pageCursor") == "" {
+			_, _ = w.Write([]byte(`{"count":2,"nextPageCursor":42,"results":[{"user_book_id":1,"title":"Dune","author":"Frank Herbert","category":"books","highlights":[{"id":10,"text":"Fear is the mind-killer.","location":7,"highlighted_at":"2025-01-02T03:04:05Z"}]}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"count":2,"nextPageCursor":null,"results":[{"user_book_id":2,"title":"Essay","category":"articles","highlights":[]}]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{Token: "secret", APIEndpoint: server.URL}
+	books, err := client.Export(context.Background(), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), []int64{1, 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"ids=1%2C2&updatedAfter=2025-01-01T00%3A00%3A00Z",
+		"ids=1%2C2&pageCursor=42&updatedAfter=2025-01-01T00%3A00%3A00Z",
+	}, queries)
+	require.Len(t, books, 2)
+	require.Equal(t, "Dune", books[0].Title)
+	require.Len(t, books[0].Highlights, 1)
+	require.Equal(t, int64(10), books[0].Highlights[0].ID)
+	require.Equal(t, int64(7), books[0].Highlights[0].Location)
+	require.Equal(t, int64(2), books[1].ID)
+
+	_, err = (&Client{Token: "revoked", APIEndpoint: server.URL}).Export(context.Background(), time.Time{}, nil)
+	require.ErrorIs(t, err, ErrUnauthorized)
+}
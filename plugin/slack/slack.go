@@ -0,0 +1,127 @@
+// Package slack verifies the requests of a Slack app and calls the Slack Web API.
+package slack
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultAPIEndpoint is the endpoint of the Slack Web API.
+	DefaultAPIEndpoint = "https://slack.com/api"
+
+	// SignatureHeader and TimestampHeader are the headers of the signature of the requests of Slack and of their timestamp.
+	SignatureHeader = "X-Slack-Signature"
+	TimestampHeader = "X-Slack-Request-Timestamp"
+
+	// maxRequestAge is the age after which the requests are rejected against replays, as recommended by Slack.
+	maxRequestAge = 5 * time.Minute
+	// timeout is the timeout of the requests to the Slack Web API.
+	timeout = 10 * time.Second
+)
+
+var ErrInvalidSignature = errors.New("invalid Slack signature")
+
+// Sign returns the signature of the body sent at the timestamp, "v0=" and the hex HMAC-SHA256 of "v0:{timestamp}:{body}".
+func Sign(signingSecret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(signingSecret))
+	fmt.Fprintf(mac, "v0:%d:", timestamp.Unix())
+	mac.Write(body)
+	return "v0=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks that the request of the headers and the body is signed by Slack with the signing secret less than 5 minutes ago.
+func Verify(signingSecret string, header http.Header, body []byte, now time.Time) error {
+	seconds, err := strconv.ParseInt(header.Get(TimestampHeader), 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	timestamp := time.Unix(seconds, 0)
+	if age := now.Sub(timestamp); age > maxRequestAge || age < -maxRequestAge {
+		return errors.Wrap(ErrInvalidSignature, "request is too old")
+	}
+	if !hmac.Equal([]byte(header.Get(SignatureHeader)), []byte(Sign(signingSecret, timestamp, body))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// Unfurl is the preview of a link, as an attachment of the message sharing it.
+type Unfurl struct {
+	Title     string `json:"title,omitempty"`
+	TitleLink string `json:"title_link,omitempty"`
+	Text      string `json:"text,omitempty"`
+	Footer    string `json:"footer,omitempty"`
+	Timestamp int64  `json:"ts,omitempty"`
+}
+
+// Client calls the methods of the Slack Web API with a bot token.
+type Client struct {
+	Token string
+	// APIEndpoint is the endpoint of the Slack Web API, DefaultAPIEndpoint if empty.
+	APIEndpoint string
+}
+
+// Unfurl previews the links of the message of the channel, keyed by their URL.
+func (c *Client) Unfurl(ctx context.Context, channel, messageTS string, unfurls map[string]*Unfurl) error {
+	return c.call(ctx, "chat.unfurl", map[string]any{
+		"channel": channel,
+		"ts":      messageTS,
+		"unfurls": unfurls,
+	})
+}
+
+// call posts the arguments of the method as JSON, and returns an error unless Slack answers with ok.
+func (c *Client) call(ctx context.Context, method string, args any) error {
+	body, err := json.Marshal(args)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal Slack arguments")
+	}
+	endpoint := c.APIEndpoint
+	if endpoint == "" {
+		endpoint = DefaultAPIEndpoint
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(endpoint, "/")+"/"+method, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to construct Slack request")
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to call Slack %s", method)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return errors.Wrap(err, "failed to read Slack response")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("Slack %s failed with status %d", method, resp.StatusCode)
+	}
+	response := struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+	}{}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return errors.Wrap(err, "failed to unmarshal Slack response")
+	}
+	if !response.OK {
+		return errors.Errorf("Slack %s failed: %s", method, response.Error)
+	}
+	return nil
+}
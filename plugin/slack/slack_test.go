@@ -0,0 +1,58 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerify(t *testing.T) {
+	// The example request of the Slack documentation.
+	body := []byte("token=xyzz0WbapA4vBCDEFasx0q6G&team_id=T1DC2JH3J&team_domain=testteamnow&channel_id=G8PSS9T3V&channel_name=foobar&user_id=U2CERLKJA&user_name=roadrunner&command=%2Fwebhook-collect&text=&response_url=https%3A%2F%2Fhooks.slack.com%2Fcommands%2FT1DC2JH3J%2F397700885554%2F96rGlfmibIGlgcZRskXaIFfN&trigger_id=398738663015.47445629121.803a0bc887a14d10d2c447fce8b6703c")
+	secret := "8f742231b10e8888abcd99yyyzzz85a5"
+	timestamp := time.Unix(1531420618, 0)
+	header := http.Header{}
+	header.Set(TimestampHeader, strconv.FormatInt(timestamp.Unix(), 10))
+	header.Set(SignatureHeader, "v0=a2114d57b48eac39b9ad189dd8316235a7b4a8d21a10bd27519666489c69b503")
+	require.Equal(t, header.Get(SignatureHeader), Sign(secret, timestamp, body))
+	require.NoError(t, Verify(secret, header, body, timestamp.Add(time.Minute)))
+
+	require.ErrorIs(t, Verify("another secret", header, body, timestamp), ErrInvalidSignature)
+	require.ErrorIs(t, Verify(secret, header, append(body, '&'), timestamp), ErrInvalidSignature)
+	// The old requests are rejected against replays.
+	require.ErrorIs(t, Verify(secret, header, body, timestamp.Add(10*time.Minute)), ErrInvalidSignature)
+	header.Del(TimestampHeader)
+	require.ErrorIs(t, Verify(secret, header, body, timestamp), ErrInvalidSignature)
+}
+
+func TestUnfurl(t *testing.T) {
+	var authorization string
+	var args map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/chat.unfurl", r.URL.Path)
+		authorization = r.Header.Get("Authorization")
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&args))
+		if args["channel"] == "C0" {
+			fmt.Fprint(w, `{"ok": false, "error": "channel_not_found"}`)
+			return
+		}
+		fmt.Fprint(w, `{"ok": true}`)
+	}))
+	defer server.Close()
+
+	client := &Client{Token: "xoxb-token", APIEndpoint: server.URL}
+	unfurls := map[string]*Unfurl{"https://memos.example.com/memos/abc": {Title: "Hello"}}
+	require.NoError(t, client.Unfurl(context.Background(), "C1", "1700000000.000100", unfurls))
+	require.Equal(t, "Bearer xoxb-token", authorization)
+	require.Equal(t, "1700000000.000100", args["ts"])
+	require.Equal(t, map[string]any{"https://memos.example.com/memos/abc": map[string]any{"title": "Hello"}}, args["unfurls"])
+
+	require.ErrorContains(t, client.Unfurl(context.Background(), "C0", "1700000000.000100", unfurls), "channel_not_found")
+}
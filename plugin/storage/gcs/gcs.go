@@ -0,0 +1,286 @@
+package gcs
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/oauth2/jwt"
+
+	"github.com/usememos/memos/plugin/tracing"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+const (
+	defaultEndpoint = "https://storage.googleapis.com"
+	defaultTokenURL = "https://oauth2.googleapis.com/token"
+	readWriteScope  = "https://www.googleapis.com/auth/devstorage.read_write"
+
+	// SignedURLExpiration is the expiration time of the signed URLs, which is at most 7 days.
+	SignedURLExpiration = 5 * 24 * time.Hour
+)
+
+// serviceAccountKey is the JSON key of a service account.
+// Reference: https://cloud.google.com/iam/docs/keys-create-delete
+type serviceAccountKey struct {
+	ClientEmail  string `json:"client_email"`
+	PrivateKeyID string `json:"private_key_id"`
+	PrivateKey   string `json:"private_key"`
+	TokenURI     string `json:"token_uri"`
+}
+
+type Client struct {
+	HTTPClient *http.Client
+	Endpoint   string
+	Bucket     string
+
+	clientEmail string
+	privateKey  *rsa.PrivateKey
+}
+
+func NewClient(ctx context.Context, gcsConfig *storepb.StorageGCSConfig) (*Client, error) {
+	if gcsConfig.Bucket == "" {
+		return nil, errors.New("bucket is required")
+	}
+	key := &serviceAccountKey{}
+	if err := json.Unmarshal([]byte(gcsConfig.Credentials), key); err != nil {
+		return nil, errors.Wrap(err, "failed to parse service account credentials")
+	}
+	if key.ClientEmail == "" || key.PrivateKey == "" {
+		return nil, errors.New("service account credentials require client_email and private_key")
+	}
+	privateKey, err := parsePrivateKey(key.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	tokenURL := key.TokenURI
+	if tokenURL == "" {
+		tokenURL = defaultTokenURL
+	}
+	endpoint := defaultEndpoint
+	if gcsConfig.Endpoint != "" {
+		endpoint = strings.TrimSuffix(gcsConfig.Endpoint, "/")
+	}
+
+	jwtConfig := &jwt.Config{
+		Email:        key.ClientEmail,
+		PrivateKey:   []byte(key.PrivateKey),
+		PrivateKeyID: key.PrivateKeyID,
+		Scopes:       []string{readWriteScope},
+		TokenURL:     tokenURL,
+	}
+	return &Client{
+		HTTPClient:  jwtConfig.Client(ctx),
+		Endpoint:    endpoint,
+		Bucket:      gcsConfig.Bucket,
+		clientEmail: key.ClientEmail,
+		privateKey:  privateKey,
+	}, nil
+}
+
+// UploadObject uploads an object to GCS.
+func (c *Client) UploadObject(ctx context.Context, key string, fileType string, content io.Reader) (_ string, err error) {
+	ctx, span := tracing.StartSpan(ctx, "gcs.UploadObject", attribute.String("storage.key", key))
+	defer func() { tracing.EndSpan(span, err) }()
+	query := url.Values{}
+	query.Set("uploadType", "media")
+	query.Set("name", key)
+	uploadURL := fmt.Sprintf("%s/upload/storage/v1/b/%s/o?%s", c.Endpoint, url.PathEscape(c.Bucket), query.Encode())
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, uploadURL, content)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create upload request")
+	}
+	request.Header.Set("Content-Type", fileType)
+	response, err := c.do(request)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to upload object")
+	}
+	defer response.Body.Close()
+
+	object := struct {
+		Name string `json:"name"`
+	}{}
+	if err := json.NewDecoder(response.Body).Decode(&object); err != nil {
+		return "", errors.Wrap(err, "failed to decode uploaded object")
+	}
+	if object.Name == "" {
+		return "", errors.New("failed to get object name")
+	}
+	return object.Name, nil
+}
+
+// GetObject returns the content of an object in GCS.
+func (c *Client) GetObject(ctx context.Context, key string) (_ []byte, err error) {
+	ctx, span := tracing.StartSpan(ctx, "gcs.GetObject", attribute.String("storage.key", key))
+	defer func() { tracing.EndSpan(span, err) }()
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, c.objectURL(key)+"?alt=media", nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create get request")
+	}
+	response, err := c.do(request)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get object")
+	}
+	defer response.Body.Close()
+	content, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read object")
+	}
+	return content, nil
+}
+
+// DeleteObject deletes an object in GCS.
+func (c *Client) DeleteObject(ctx context.Context, key string) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "gcs.DeleteObject", attribute.String("storage.key", key))
+	defer func() { tracing.EndSpan(span, err) }()
+	request, err := http.NewRequestWithContext(ctx, http.MethodDelete, c.objectURL(key), nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to create delete request")
+	}
+	response, err := c.do(request)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete object")
+	}
+	return response.Body.Close()
+}
+
+// GetBucket checks that the bucket exists and is accessible with the credentials.
+func (c *Client) GetBucket(ctx context.Context) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "gcs.GetBucket", attribute.String("storage.bucket", c.Bucket))
+	defer func() { tracing.EndSpan(span, err) }()
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/storage/v1/b/%s", c.Endpoint, escape(c.Bucket)), nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to create get bucket request")
+	}
+	response, err := c.do(request)
+	if err != nil {
+		return errors.Wrap(err, "failed to get bucket")
+	}
+	return response.Body.Close()
+}
+
+// SignGetObject returns a V4 signed URL to download an object in GCS.
+// Reference: https://cloud.google.com/storage/docs/access-control/signing-urls-manually
+func (c *Client) SignGetObject(key string) (string, error) {
+	endpoint, err := url.Parse(c.Endpoint)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse endpoint")
+	}
+	now := time.Now().UTC()
+	date := now.Format("20060102")
+	credentialScope := fmt.Sprintf("%s/auto/storage/goog4_request", date)
+	canonicalURI := "/" + escape(c.Bucket) + "/" + escapePath(key)
+	query := map[string]string{
+		"X-Goog-Algorithm":     "GOOG4-RSA-SHA256",
+		"X-Goog-Credential":    fmt.Sprintf("%s/%s", c.clientEmail, credentialScope),
+		"X-Goog-Date":          now.Format("20060102T150405Z"),
+		"X-Goog-Expires":       fmt.Sprintf("%d", int64(SignedURLExpiration.Seconds())),
+		"X-Goog-SignedHeaders": "host",
+	}
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	canonicalQuery := make([]string, 0, len(names))
+	for _, name := range names {
+		canonicalQuery = append(canonicalQuery, escape(name)+"="+escape(query[name]))
+	}
+	canonicalRequest := strings.Join([]string{
+		http.MethodGet,
+		canonicalURI,
+		strings.Join(canonicalQuery, "&"),
+		"host:" + endpoint.Host,
+		"",
+		"host",
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		"GOOG4-RSA-SHA256",
+		query["X-Goog-Date"],
+		credentialScope,
+		hex.EncodeToString(canonicalRequestHash[:]),
+	}, "\n")
+	hash := sha256.Sum256([]byte(stringToSign))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, c.privateKey, crypto.SHA256, hash[:])
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign url")
+	}
+	return fmt.Sprintf("%s://%s%s?%s&X-Goog-Signature=%s", endpoint.Scheme, endpoint.Host, canonicalURI, strings.Join(canonicalQuery, "&"), hex.EncodeToString(signature)), nil
+}
+
+func (c *Client) objectURL(key string) string {
+	return fmt.Sprintf("%s/storage/v1/b/%s/o/%s", c.Endpoint, escape(c.Bucket), escape(key))
+}
+
+// do sends the request and returns an error for the responses other than 2xx.
+func (c *Client) do(request *http.Request) (*http.Response, error) {
+	response, err := c.HTTPClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		defer response.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return nil, errors.Errorf("unexpected status %d: %s", response.StatusCode, bytes.TrimSpace(body))
+	}
+	return response, nil
+}
+
+func parsePrivateKey(privateKey string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(privateKey))
+	if block == nil {
+		return nil, errors.New("failed to decode private key")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse private key")
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("private key is not a RSA key")
+	}
+	return rsaKey, nil
+}
+
+// escape percent-encodes all the characters but the unreserved ones of RFC 3986, as the signatures require.
+func escape(s string) string {
+	var builder strings.Builder
+	for _, b := range []byte(s) {
+		if ('A' <= b && b <= 'Z') || ('a' <= b && b <= 'z') || ('0' <= b && b <= '9') || b == '-' || b == '.' || b == '_' || b == '~' {
+			builder.WriteByte(b)
+		} else {
+			fmt.Fprintf(&builder, "%%%02X", b)
+		}
+	}
+	return builder.String()
+}
+
+// escapePath escapes the segments of an object key, keeping its slashes.
+func escapePath(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = escape(segment)
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,113 @@
+package gcs
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	objects := map[string][]byte{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":3600}`))
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/storage/v1/b/memos":
+			_ = json.NewEncoder(w).Encode(map[string]string{"name": "memos"})
+		case r.Method == http.MethodPost && r.URL.Path == "/upload/storage/v1/b/memos/o":
+			name := r.URL.Query().Get("name")
+			objects[name], _ = io.ReadAll(r.Body)
+			_ = json.NewEncoder(w).Encode(map[string]string{"name": name})
+		case strings.HasPrefix(r.URL.Path, "/storage/v1/b/memos/o/"):
+			name := strings.TrimPrefix(r.URL.Path, "/storage/v1/b/memos/o/")
+			object, ok := objects[name]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			if r.Method == http.MethodDelete {
+				delete(objects, name)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			_, _ = w.Write(object)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	credentials, err := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"client_email": "memos@project.iam.gserviceaccount.com",
+		"private_key":  string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})),
+		"token_uri":    server.URL + "/token",
+	})
+	require.NoError(t, err)
+	client, err := NewClient(ctx, &storepb.StorageGCSConfig{
+		Credentials: string(credentials),
+		Bucket:      "memos",
+		Endpoint:    server.URL,
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.GetBucket(ctx))
+
+	key, err := client.UploadObject(ctx, "assets/my photo.png", "image/png", strings.NewReader("content"))
+	require.NoError(t, err)
+	require.Equal(t, "assets/my photo.png", key)
+	content, err := client.GetObject(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, "content", string(content))
+
+	// The signature covers the canonical request of the signed URL.
+	signedURL, err := client.SignGetObject(key)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(signedURL, server.URL+"/memos/assets/my%20photo.png?X-Goog-Algorithm=GOOG4-RSA-SHA256&"), signedURL)
+	rawQuery, signature, ok := strings.Cut(strings.SplitN(signedURL, "?", 2)[1], "&X-Goog-Signature=")
+	require.True(t, ok)
+	query, err := url.ParseQuery(rawQuery)
+	require.NoError(t, err)
+	require.Equal(t, "432000", query.Get("X-Goog-Expires"))
+	endpoint, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	canonicalRequestHash := sha256.Sum256([]byte("GET\n/memos/assets/my%20photo.png\n" + rawQuery + "\nhost:" + endpoint.Host + "\n\nhost\nUNSIGNED-PAYLOAD"))
+	credentialScope := strings.TrimPrefix(query.Get("X-Goog-Credential"), "memos@project.iam.gserviceaccount.com/")
+	hash := sha256.Sum256([]byte("GOOG4-RSA-SHA256\n" + query.Get("X-Goog-Date") + "\n" + credentialScope + "\n" + hex.EncodeToString(canonicalRequestHash[:])))
+	signatureBytes, err := hex.DecodeString(signature)
+	require.NoError(t, err)
+	require.NoError(t, rsa.VerifyPKCS1v15(&privateKey.PublicKey, crypto.SHA256, hash[:], signatureBytes))
+
+	require.NoError(t, client.DeleteObject(ctx, key))
+	_, err = client.GetObject(ctx, key)
+	require.ErrorContains(t, err, "404")
+
+	_, err = NewClient(ctx, &storepb.StorageGCSConfig{Credentials: "{}", Bucket: "memos"})
+	require.Error(t, err)
+}
@@ -3,18 +3,25 @@ package s3
 import (
 	"context"
 	"io"
+	"net/http"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 
+	"github.com/usememos/memos/plugin/tracing"
 	storepb "github.com/usememos/memos/proto/gen/store"
 )
 
+// ErrInvalidRange is returned when the requested range is not satisfiable.
+var ErrInvalidRange = errors.New("invalid range")
+
 type Client struct {
 	Client *s3.Client
 	Bucket *string
@@ -41,8 +48,20 @@ func NewClient(ctx context.Context, s3Config *storepb.StorageS3Config) (*Client,
 	}, nil
 }
 
+// HeadBucket checks that the bucket exists and is accessible with the credentials.
+func (c *Client) HeadBucket(ctx context.Context) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "s3.HeadBucket", attribute.String("storage.bucket", aws.ToString(c.Bucket)))
+	defer func() { tracing.EndSpan(span, err) }()
+	if _, err := c.Client.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: c.Bucket}); err != nil {
+		return errors.Wrap(err, "failed to head bucket")
+	}
+	return nil
+}
+
 // UploadObject uploads an object to S3.
-func (c *Client) UploadObject(ctx context.Context, key string, fileType string, content io.Reader) (string, error) {
+func (c *Client) UploadObject(ctx context.Context, key string, fileType string, content io.Reader) (_ string, err error) {
+	ctx, span := tracing.StartSpan(ctx, "s3.UploadObject", attribute.String("storage.key", key))
+	defer func() { tracing.EndSpan(span, err) }()
 	uploader := manager.NewUploader(c.Client)
 	putInput := s3.PutObjectInput{
 		Bucket:      c.Bucket,
@@ -79,9 +98,98 @@ func (c *Client) PresignGetObject(ctx context.Context, key string) (string, erro
 	return presignResult.URL, nil
 }
 
+// PresignPutObject presigns the upload of an object to S3 with its content type and size,
+// which the upload must send as the Content-Type and Content-Length headers.
+func (c *Client) PresignPutObject(ctx context.Context, key string, fileType string, size int64, expires time.Duration) (string, error) {
+	presignClient := s3.NewPresignClient(c.Client)
+	presignResult, err := presignClient.PresignPutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(*c.Bucket),
+		Key:           aws.String(key),
+		ContentType:   aws.String(fileType),
+		ContentLength: aws.Int64(size),
+	}, func(opts *s3.PresignOptions) {
+		opts.Expires = expires
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to presign put object")
+	}
+	return presignResult.URL, nil
+}
+
+// GetObjectSize returns the size of an object in S3.
+func (c *Client) GetObjectSize(ctx context.Context, key string) (_ int64, err error) {
+	ctx, span := tracing.StartSpan(ctx, "s3.GetObjectSize", attribute.String("storage.key", key))
+	defer func() { tracing.EndSpan(span, err) }()
+	output, err := c.Client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: c.Bucket,
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to head object")
+	}
+	return aws.ToInt64(output.ContentLength), nil
+}
+
+// GetObject returns the content of an object in S3.
+func (c *Client) GetObject(ctx context.Context, key string) (_ []byte, err error) {
+	ctx, span := tracing.StartSpan(ctx, "s3.GetObject", attribute.String("storage.key", key))
+	defer func() { tracing.EndSpan(span, err) }()
+	output, err := c.Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: c.Bucket,
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get object")
+	}
+	defer output.Body.Close()
+	content, err := io.ReadAll(output.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read object")
+	}
+	return content, nil
+}
+
+// ObjectReader reads the content of an object in S3, which must be closed.
+type ObjectReader struct {
+	io.ReadCloser
+	// Size is the size of the content read.
+	Size int64
+	// ContentRange is the range of the content read, e.g. "bytes 0-99/1000", or empty if the whole object is read.
+	ContentRange string
+}
+
+// GetObjectReader returns a reader of the content of an object in S3, or of its range of the Range header if not empty,
+// so that the content is streamed instead of loaded at once.
+func (c *Client) GetObjectReader(ctx context.Context, key string, rangeHeader string) (_ *ObjectReader, err error) {
+	ctx, span := tracing.StartSpan(ctx, "s3.GetObjectReader", attribute.String("storage.key", key))
+	defer func() { tracing.EndSpan(span, err) }()
+	input := &s3.GetObjectInput{
+		Bucket: c.Bucket,
+		Key:    aws.String(key),
+	}
+	if rangeHeader != "" {
+		input.Range = aws.String(rangeHeader)
+	}
+	output, err := c.Client.GetObject(ctx, input)
+	if err != nil {
+		var responseError *awshttp.ResponseError
+		if errors.As(err, &responseError) && responseError.HTTPStatusCode() == http.StatusRequestedRangeNotSatisfiable {
+			return nil, ErrInvalidRange
+		}
+		return nil, errors.Wrap(err, "failed to get object")
+	}
+	return &ObjectReader{
+		ReadCloser:   output.Body,
+		Size:         aws.ToInt64(output.ContentLength),
+		ContentRange: aws.ToString(output.ContentRange),
+	}, nil
+}
+
 // DeleteObject deletes an object in S3.
-func (c *Client) DeleteObject(ctx context.Context, key string) error {
-	_, err := c.Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+func (c *Client) DeleteObject(ctx context.Context, key string) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "s3.DeleteObject", attribute.String("storage.key", key))
+	defer func() { tracing.EndSpan(span, err) }()
+	_, err = c.Client.DeleteObject(ctx, &s3.DeleteObjectInput{
 		Bucket: c.Bucket,
 		Key:    aws.String(key),
 	})
@@ -0,0 +1,238 @@
+package sftp
+
+import (
+	"encoding/binary"
+	"io"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// The packet types and flags of the version 3 of the SFTP protocol, which all the servers support.
+// Reference: https://datatracker.ietf.org/doc/html/draft-ietf-secsh-filexfer-02
+const (
+	packetInit       = 1
+	packetVersion    = 2
+	packetOpen       = 3
+	packetClose      = 4
+	packetRead       = 5
+	packetWrite      = 6
+	packetRemove     = 13
+	packetMkdir      = 14
+	packetStat       = 17
+	packetStatus     = 101
+	packetHandle     = 102
+	packetData       = 103
+	packetAttrs      = 105
+	openRead         = 0x01
+	openWrite        = 0x02
+	openCreate       = 0x08
+	openTruncate     = 0x10
+	statusOK         = 0
+	statusEOF        = 1
+	statusNoSuchFile = 2
+	protocolVersion  = 3
+
+	// chunkSize is the size of the read and written chunks, which all the servers accept.
+	chunkSize = 32 * 1024
+)
+
+// errNotExist is returned for the files missing on the server.
+var errNotExist = errors.New("file does not exist")
+
+// session sends the requests of the SFTP protocol one at a time.
+type session struct {
+	mutex  sync.Mutex
+	rw     io.ReadWriter
+	nextID uint32
+}
+
+func newSession(rw io.ReadWriter) (*session, error) {
+	s := &session{rw: rw}
+	if err := s.writePacket(packetInit, encodeUint32(nil, protocolVersion)); err != nil {
+		return nil, errors.Wrap(err, "failed to send init")
+	}
+	packetType, _, err := s.readPacket()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read version")
+	}
+	if packetType != packetVersion {
+		return nil, errors.Errorf("unexpected packet type %d instead of version", packetType)
+	}
+	return s, nil
+}
+
+// request sends a request and returns the type and payload of its response, without the request ID.
+func (s *session) request(packetType byte, payload []byte) (byte, []byte, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.nextID++
+	id := s.nextID
+	if err := s.writePacket(packetType, append(encodeUint32(nil, id), payload...)); err != nil {
+		return 0, nil, err
+	}
+	responseType, response, err := s.readPacket()
+	if err != nil {
+		return 0, nil, err
+	}
+	responseID, response, err := decodeUint32(response)
+	if err != nil {
+		return 0, nil, err
+	}
+	if responseID != id {
+		return 0, nil, errors.Errorf("unexpected response ID %d instead of %d", responseID, id)
+	}
+	return responseType, response, nil
+}
+
+// requestStatus sends a request answered by a status, which is returned as an error unless it is OK.
+func (s *session) requestStatus(packetType byte, payload []byte) error {
+	responseType, response, err := s.request(packetType, payload)
+	if err != nil {
+		return err
+	}
+	return expectStatus(responseType, response)
+}
+
+func (s *session) open(path string, flags uint32) ([]byte, error) {
+	payload := encodeString(nil, []byte(path))
+	payload = encodeUint32(payload, flags)
+	// No attributes.
+	payload = encodeUint32(payload, 0)
+	responseType, response, err := s.request(packetOpen, payload)
+	if err != nil {
+		return nil, err
+	}
+	if responseType != packetHandle {
+		return nil, expectStatus(responseType, response)
+	}
+	handle, _, err := decodeString(response)
+	return handle, err
+}
+
+func (s *session) close(handle []byte) error {
+	return s.requestStatus(packetClose, encodeString(nil, handle))
+}
+
+// read returns the data at the offset of the file, or io.EOF at its end.
+func (s *session) read(handle []byte, offset uint64) ([]byte, error) {
+	payload := encodeString(nil, handle)
+	payload = encodeUint64(payload, offset)
+	payload = encodeUint32(payload, chunkSize)
+	responseType, response, err := s.request(packetRead, payload)
+	if err != nil {
+		return nil, err
+	}
+	if responseType != packetData {
+		return nil, expectStatus(responseType, response)
+	}
+	data, _, err := decodeString(response)
+	return data, err
+}
+
+func (s *session) write(handle []byte, offset uint64, data []byte) error {
+	payload := encodeString(nil, handle)
+	payload = encodeUint64(payload, offset)
+	payload = encodeString(payload, data)
+	return s.requestStatus(packetWrite, payload)
+}
+
+func (s *session) stat(path string) error {
+	responseType, response, err := s.request(packetStat, encodeString(nil, []byte(path)))
+	if err != nil {
+		return err
+	}
+	if responseType != packetAttrs {
+		return expectStatus(responseType, response)
+	}
+	return nil
+}
+
+func (s *session) mkdir(path string) error {
+	// No attributes.
+	return s.requestStatus(packetMkdir, encodeUint32(encodeString(nil, []byte(path)), 0))
+}
+
+func (s *session) remove(path string) error {
+	return s.requestStatus(packetRemove, encodeString(nil, []byte(path)))
+}
+
+func (s *session) writePacket(packetType byte, payload []byte) error {
+	packet := encodeUint32(nil, uint32(len(payload)+1))
+	packet = append(packet, packetType)
+	packet = append(packet, payload...)
+	_, err := s.rw.Write(packet)
+	return err
+}
+
+func (s *session) readPacket() (byte, []byte, error) {
+	return readPacket(s.rw)
+}
+
+func readPacket(r io.Reader) (byte, []byte, error) {
+	header := make([]byte, 5)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, nil, err
+	}
+	length := binary.BigEndian.Uint32(header)
+	if length == 0 || length > 256*1024 {
+		return 0, nil, errors.Errorf("invalid packet length %d", length)
+	}
+	payload := make([]byte, length-1)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, nil, err
+	}
+	return header[4], payload, nil
+}
+
+// expectStatus returns the error of a status response, which is nil if it is OK.
+func expectStatus(responseType byte, response []byte) error {
+	if responseType != packetStatus {
+		return errors.Errorf("unexpected packet type %d", responseType)
+	}
+	code, response, err := decodeUint32(response)
+	if err != nil {
+		return err
+	}
+	switch code {
+	case statusOK:
+		return nil
+	case statusEOF:
+		return io.EOF
+	case statusNoSuchFile:
+		return errNotExist
+	default:
+		message, _, _ := decodeString(response)
+		return errors.Errorf("sftp error %d: %s", code, message)
+	}
+}
+
+func encodeUint32(b []byte, v uint32) []byte {
+	return binary.BigEndian.AppendUint32(b, v)
+}
+
+func encodeUint64(b []byte, v uint64) []byte {
+	return binary.BigEndian.AppendUint64(b, v)
+}
+
+func encodeString(b []byte, s []byte) []byte {
+	return append(encodeUint32(b, uint32(len(s))), s...)
+}
+
+func decodeUint32(b []byte) (uint32, []byte, error) {
+	if len(b) < 4 {
+		return 0, nil, errors.New("short packet")
+	}
+	return binary.BigEndian.Uint32(b), b[4:], nil
+}
+
+func decodeString(b []byte) ([]byte, []byte, error) {
+	length, b, err := decodeUint32(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	if uint32(len(b)) < length {
+		return nil, nil, errors.New("short packet")
+	}
+	return b[:length], b[length:], nil
+}
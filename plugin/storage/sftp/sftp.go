@@ -0,0 +1,199 @@
+package sftp
+
+import (
+	"context"
+	"io"
+	"net"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/usememos/memos/plugin/tracing"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+const dialTimeout = 10 * time.Second
+
+// Client stores the files on a SFTP server, in the paths relative to the home directory of the user unless absolute.
+type Client struct {
+	sshClient *ssh.Client
+	session   *session
+}
+
+func NewClient(ctx context.Context, sftpConfig *storepb.StorageSFTPConfig) (*Client, error) {
+	if sftpConfig.Host == "" || sftpConfig.Username == "" {
+		return nil, errors.New("host and username are required")
+	}
+	// The host key is required, so that the attachments are not sent to another server.
+	if sftpConfig.HostKey == "" {
+		return nil, errors.New("host key is required")
+	}
+	hostKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(sftpConfig.HostKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse host key")
+	}
+	auths := []ssh.AuthMethod{}
+	if sftpConfig.PrivateKey != "" {
+		signer, err := ssh.ParsePrivateKey([]byte(sftpConfig.PrivateKey))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse private key")
+		}
+		auths = append(auths, ssh.PublicKeys(signer))
+	}
+	if sftpConfig.Password != "" {
+		auths = append(auths, ssh.Password(sftpConfig.Password))
+	}
+	if len(auths) == 0 {
+		return nil, errors.New("private key or password is required")
+	}
+
+	address := sftpConfig.Host
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, "22")
+	}
+	dialer := &net.Dialer{Timeout: dialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to sftp server")
+	}
+	sshConn, channels, requests, err := ssh.NewClientConn(conn, address, &ssh.ClientConfig{
+		User:            sftpConfig.Username,
+		Auth:            auths,
+		HostKeyCallback: ssh.FixedHostKey(hostKey),
+		Timeout:         dialTimeout,
+	})
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "failed to handshake with sftp server")
+	}
+	sshClient := ssh.NewClient(sshConn, channels, requests)
+
+	sshSession, err := sshClient.NewSession()
+	if err != nil {
+		sshClient.Close()
+		return nil, errors.Wrap(err, "failed to open ssh session")
+	}
+	stdin, err := sshSession.StdinPipe()
+	if err != nil {
+		sshClient.Close()
+		return nil, errors.Wrap(err, "failed to get stdin")
+	}
+	stdout, err := sshSession.StdoutPipe()
+	if err != nil {
+		sshClient.Close()
+		return nil, errors.Wrap(err, "failed to get stdout")
+	}
+	if err := sshSession.RequestSubsystem("sftp"); err != nil {
+		sshClient.Close()
+		return nil, errors.Wrap(err, "failed to request sftp subsystem")
+	}
+	session, err := newSession(struct {
+		io.Reader
+		io.Writer
+	}{stdout, stdin})
+	if err != nil {
+		sshClient.Close()
+		return nil, err
+	}
+	return &Client{
+		sshClient: sshClient,
+		session:   session,
+	}, nil
+}
+
+// UploadObject writes the content to the file at the path, creating its parent directories.
+func (c *Client) UploadObject(ctx context.Context, filePath string, content io.Reader) (err error) {
+	_, span := tracing.StartSpan(ctx, "sftp.UploadObject", attribute.String("storage.path", filePath))
+	defer func() { tracing.EndSpan(span, err) }()
+	if err := c.mkdirAll(path.Dir(filePath)); err != nil {
+		return errors.Wrap(err, "failed to create directory")
+	}
+	handle, err := c.session.open(filePath, openWrite|openCreate|openTruncate)
+	if err != nil {
+		return errors.Wrap(err, "failed to create file")
+	}
+	buffer := make([]byte, chunkSize)
+	offset := uint64(0)
+	for {
+		n, err := io.ReadFull(content, buffer)
+		if n > 0 {
+			if err := c.session.write(handle, offset, buffer[:n]); err != nil {
+				_ = c.session.close(handle)
+				return errors.Wrap(err, "failed to write file")
+			}
+			offset += uint64(n)
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			_ = c.session.close(handle)
+			return errors.Wrap(err, "failed to read content")
+		}
+	}
+	return c.session.close(handle)
+}
+
+// GetObject returns the content of the file at the path.
+func (c *Client) GetObject(ctx context.Context, filePath string) (_ []byte, err error) {
+	_, span := tracing.StartSpan(ctx, "sftp.GetObject", attribute.String("storage.path", filePath))
+	defer func() { tracing.EndSpan(span, err) }()
+	handle, err := c.session.open(filePath, openRead)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open file")
+	}
+	defer c.session.close(handle)
+	content := []byte{}
+	for {
+		data, err := c.session.read(handle, uint64(len(content)))
+		if err == io.EOF {
+			return content, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read file")
+		}
+		content = append(content, data...)
+	}
+}
+
+// DeleteObject removes the file at the path, which may be missing already.
+func (c *Client) DeleteObject(ctx context.Context, filePath string) (err error) {
+	_, span := tracing.StartSpan(ctx, "sftp.DeleteObject", attribute.String("storage.path", filePath))
+	defer func() { tracing.EndSpan(span, err) }()
+	if err := c.session.remove(filePath); err != nil && err != errNotExist {
+		return errors.Wrap(err, "failed to remove file")
+	}
+	return nil
+}
+
+func (c *Client) Close() error {
+	return c.sshClient.Close()
+}
+
+// mkdirAll creates the directory and its missing parents.
+func (c *Client) mkdirAll(dir string) error {
+	if dir == "." || dir == "/" {
+		return nil
+	}
+	err := c.session.stat(dir)
+	if err == nil {
+		return nil
+	}
+	if err != errNotExist {
+		return err
+	}
+	if err := c.mkdirAll(path.Dir(dir)); err != nil {
+		return err
+	}
+	if err := c.session.mkdir(dir); err != nil {
+		// The directory may have been created concurrently.
+		if c.session.stat(dir) == nil {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+package sftp
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/pem"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// fakeServer is an in-memory SFTP server with the requests used by the client.
+type fakeServer struct {
+	mutex sync.Mutex
+	files map[string][]byte
+	dirs  map[string]bool
+}
+
+func (s *fakeServer) serve(rw io.ReadWriter) {
+	handles := map[string]string{}
+	for {
+		packetType, payload, err := readPacket(rw)
+		if err != nil {
+			return
+		}
+		if packetType == packetInit {
+			_, _ = rw.Write([]byte{0, 0, 0, 5, packetVersion, 0, 0, 0, protocolVersion})
+			continue
+		}
+		id, payload, _ := decodeUint32(payload)
+		respond := func(responseType byte, response []byte) {
+			packet := encodeUint32(nil, uint32(len(response)+5))
+			packet = append(packet, responseType)
+			packet = encodeUint32(packet, id)
+			_, _ = rw.Write(append(packet, response...))
+		}
+		status := func(code uint32) {
+			respond(packetStatus, encodeString(encodeString(encodeUint32(nil, code), nil), nil))
+		}
+		s.mutex.Lock()
+		switch packetType {
+		case packetOpen:
+			filePath, rest, _ := decodeString(payload)
+			flags, _, _ := decodeUint32(rest)
+			if _, ok := s.files[string(filePath)]; !ok && flags&openCreate == 0 {
+				status(statusNoSuchFile)
+				break
+			}
+			if flags&openTruncate != 0 {
+				s.files[string(filePath)] = nil
+			}
+			handle := string(filePath)
+			handles[handle] = string(filePath)
+			respond(packetHandle, encodeString(nil, []byte(handle)))
+		case packetRead:
+			handle, rest, _ := decodeString(payload)
+			offset := binary.BigEndian.Uint64(rest)
+			file := s.files[handles[string(handle)]]
+			if offset >= uint64(len(file)) {
+				status(statusEOF)
+				break
+			}
+			// Reads may return fewer bytes than requested.
+			end := min(offset+1000, uint64(len(file)))
+			respond(packetData, encodeString(nil, file[offset:end]))
+		case packetWrite:
+			handle, rest, _ := decodeString(payload)
+			offset := binary.BigEndian.Uint64(rest)
+			data, _, _ := decodeString(rest[8:])
+			filePath := handles[string(handle)]
+			s.files[filePath] = append(s.files[filePath][:offset], data...)
+			status(statusOK)
+		case packetClose:
+			status(statusOK)
+		case packetStat:
+			filePath, _, _ := decodeString(payload)
+			if s.dirs[string(filePath)] {
+				respond(packetAttrs, encodeUint32(nil, 0))
+			} else {
+				status(statusNoSuchFile)
+			}
+		case packetMkdir:
+			filePath, _, _ := decodeString(payload)
+			s.dirs[string(filePath)] = true
+			status(statusOK)
+		case packetRemove:
+			filePath, _, _ := decodeString(payload)
+			if _, ok := s.files[string(filePath)]; !ok {
+				status(statusNoSuchFile)
+				break
+			}
+			delete(s.files, string(filePath))
+			status(statusOK)
+		default:
+			status(4)
+		}
+		s.mutex.Unlock()
+	}
+}
+
+// startSSHServer starts a SSH server running the fake SFTP subsystem, and returns its address and host key.
+func startSSHServer(t *testing.T, sftpServer *fakeServer, password string) (string, string) {
+	_, hostPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	hostSigner, err := ssh.NewSignerFromKey(hostPrivateKey)
+	require.NoError(t, err)
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(_ ssh.ConnMetadata, given []byte) (*ssh.Permissions, error) {
+			if string(given) != password {
+				return nil, io.ErrUnexpectedEOF
+			}
+			return nil, nil
+		},
+	}
+	config.AddHostKey(hostSigner)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				_, channels, requests, err := ssh.NewServerConn(conn, config)
+				if err != nil {
+					return
+				}
+				go ssh.DiscardRequests(requests)
+				for newChannel := range channels {
+					channel, channelRequests, err := newChannel.Accept()
+					if err != nil {
+						continue
+					}
+					go func() {
+						for request := range channelRequests {
+							isSFTP := request.Type == "subsystem" && bytes.Equal(request.Payload[4:], []byte("sftp"))
+							_ = request.Reply(isSFTP, nil)
+							if isSFTP {
+								go sftpServer.serve(channel)
+							}
+						}
+					}()
+				}
+			}()
+		}
+	}()
+	return listener.Addr().String(), string(ssh.MarshalAuthorizedKey(hostSigner.PublicKey()))
+}
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	sftpServer := &fakeServer{files: map[string][]byte{}, dirs: map[string]bool{}}
+	address, hostKey := startSSHServer(t, sftpServer, "secret")
+
+	client, err := NewClient(ctx, &storepb.StorageSFTPConfig{
+		Host:     address,
+		Username: "memos",
+		Password: "secret",
+		HostKey:  hostKey,
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	content := bytes.Repeat([]byte("0123456789"), 10000)
+	require.NoError(t, client.UploadObject(ctx, "assets/2024/photo.png", bytes.NewReader(content)))
+	require.True(t, sftpServer.dirs["assets"])
+	require.True(t, sftpServer.dirs["assets/2024"])
+	got, err := client.GetObject(ctx, "assets/2024/photo.png")
+	require.NoError(t, err)
+	require.Equal(t, content, got)
+
+	require.NoError(t, client.DeleteObject(ctx, "assets/2024/photo.png"))
+	require.NoError(t, client.DeleteObject(ctx, "assets/2024/photo.png"))
+	_, err = client.GetObject(ctx, "assets/2024/photo.png")
+	require.Error(t, err)
+
+	// Another host key is rejected.
+	_, otherPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	otherSigner, err := ssh.NewSignerFromKey(otherPrivateKey)
+	require.NoError(t, err)
+	_, err = NewClient(ctx, &storepb.StorageSFTPConfig{
+		Host:     address,
+		Username: "memos",
+		Password: "secret",
+		HostKey:  string(ssh.MarshalAuthorizedKey(otherSigner.PublicKey())),
+	})
+	require.ErrorContains(t, err, "handshake")
+
+	_, err = NewClient(ctx, &storepb.StorageSFTPConfig{
+		Host:     address,
+		Username: "memos",
+		Password: "wrong",
+		HostKey:  hostKey,
+	})
+	require.Error(t, err)
+
+	block, err := ssh.MarshalPrivateKey(otherPrivateKey, "")
+	require.NoError(t, err)
+	_, err = NewClient(ctx, &storepb.StorageSFTPConfig{
+		Host:       address,
+		Username:   "memos",
+		PrivateKey: string(pem.EncodeToMemory(block)),
+	})
+	require.True(t, err != nil && strings.Contains(err.Error(), "host key"))
+}
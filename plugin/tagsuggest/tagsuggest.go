@@ -0,0 +1,151 @@
+// Package tagsuggest suggests tags for a text based on the tags of similar documents,
+// using TF-IDF weighted term vectors.
+package tagsuggest
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Document is a tagged text used to learn the tag-content associations.
+type Document struct {
+	Content string
+	Tags    []string
+}
+
+// Suggestion is a suggested tag with its similarity score between 0 and 1.
+type Suggestion struct {
+	Tag   string
+	Score float64
+}
+
+// Model holds the term statistics learned from the documents.
+type Model struct {
+	// idf is the inverse document frequency of each term.
+	idf map[string]float64
+	// centroids is the normalized sum of the document vectors of each tag.
+	centroids map[string]map[string]float64
+}
+
+// NewModel learns the tag-content associations from the documents.
+func NewModel(documents []*Document) *Model {
+	documentFrequency := map[string]int{}
+	documentTerms := make([]map[string]int, len(documents))
+	for i, document := range documents {
+		documentTerms[i] = countTerms(document.Content)
+		for term := range documentTerms[i] {
+			documentFrequency[term]++
+		}
+	}
+
+	model := &Model{
+		idf:       map[string]float64{},
+		centroids: map[string]map[string]float64{},
+	}
+	for term, frequency := range documentFrequency {
+		model.idf[term] = math.Log(float64(1+len(documents))/float64(1+frequency)) + 1
+	}
+	for i, document := range documents {
+		if len(document.Tags) == 0 {
+			continue
+		}
+		vector := model.vectorize(documentTerms[i])
+		for _, tag := range document.Tags {
+			centroid, ok := model.centroids[tag]
+			if !ok {
+				centroid = map[string]float64{}
+				model.centroids[tag] = centroid
+			}
+			for term, weight := range vector {
+				centroid[term] += weight
+			}
+		}
+	}
+	for _, centroid := range model.centroids {
+		normalize(centroid)
+	}
+	return model
+}
+
+// Suggest returns at most limit tags for the content, sorted by descending score.
+// Tags in exclude, e.g. the ones the content already has, are never suggested.
+func (m *Model) Suggest(content string, exclude []string, limit int) []*Suggestion {
+	vector := m.vectorize(countTerms(content))
+	suggestions := []*Suggestion{}
+	if len(vector) == 0 {
+		return suggestions
+	}
+	for tag, centroid := range m.centroids {
+		if slices.Contains(exclude, tag) {
+			continue
+		}
+		score := 0.0
+		for term, weight := range vector {
+			score += weight * centroid[term]
+		}
+		if score > 0 {
+			suggestions = append(suggestions, &Suggestion{Tag: tag, Score: score})
+		}
+	}
+	slices.SortFunc(suggestions, func(a, b *Suggestion) int {
+		if a.Score != b.Score {
+			if a.Score > b.Score {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Tag, b.Tag)
+	})
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions
+}
+
+// vectorize returns the normalized TF-IDF vector of the term counts, ignoring unknown terms.
+func (m *Model) vectorize(termCounts map[string]int) map[string]float64 {
+	vector := map[string]float64{}
+	for term, count := range termCounts {
+		if idf, ok := m.idf[term]; ok {
+			vector[term] = float64(count) * idf
+		}
+	}
+	normalize(vector)
+	return vector
+}
+
+// countTerms splits the text into lower case terms and counts them.
+// Tags, e.g. "#work", are skipped so that suggestions are based on the text only.
+func countTerms(text string) map[string]int {
+	counts := map[string]int{}
+	for _, word := range strings.Fields(text) {
+		if strings.HasPrefix(word, "#") {
+			continue
+		}
+		terms := strings.FieldsFunc(strings.ToLower(word), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+		})
+		for _, term := range terms {
+			if len([]rune(term)) > 1 {
+				counts[term]++
+			}
+		}
+	}
+	return counts
+}
+
+func normalize(vector map[string]float64) {
+	norm := 0.0
+	for _, weight := range vector {
+		norm += weight * weight
+	}
+	if norm == 0 {
+		return
+	}
+	norm = math.Sqrt(norm)
+	for term := range vector {
+		vector[term] /= norm
+	}
+}
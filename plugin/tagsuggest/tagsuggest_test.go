@@ -0,0 +1,38 @@
+package tagsuggest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuggest(t *testing.T) {
+	model := NewModel([]*Document{
+		{Content: "#cooking Pasta with tomato sauce and basil", Tags: []string{"cooking"}},
+		{Content: "Tomato soup recipe for the weekend", Tags: []string{"cooking"}},
+		{Content: "Fix the deployment pipeline of the backend", Tags: []string{"work"}},
+		{Content: "Backend meeting about the release", Tags: []string{"work"}},
+		{Content: "Random thoughts without tags"},
+	})
+
+	suggestions := model.Suggest("Tomato and basil salad", nil, 5)
+	require.NotEmpty(t, suggestions)
+	require.Equal(t, "cooking", suggestions[0].Tag)
+
+	suggestions = model.Suggest("Prepare the backend release notes", nil, 1)
+	require.Len(t, suggestions, 1)
+	require.Equal(t, "work", suggestions[0].Tag)
+
+	// Excluded tags are never suggested.
+	suggestions = model.Suggest("Tomato and basil salad", []string{"cooking"}, 5)
+	for _, suggestion := range suggestions {
+		require.NotEqual(t, "cooking", suggestion.Tag)
+	}
+
+	// Unknown terms do not match any tag.
+	require.Empty(t, model.Suggest("zebra", nil, 5))
+}
+
+func TestCountTerms(t *testing.T) {
+	require.Equal(t, map[string]int{"hello": 2, "world": 1}, countTerms("#greeting Hello, hello world! a"))
+}
@@ -0,0 +1,268 @@
+package textextract
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+
+	"github.com/pkg/errors"
+)
+
+// streamMatcher matches the stream keyword starting the data of a PDF stream object.
+var streamMatcher = regexp.MustCompile(`stream\r?\n`)
+
+// maxStreamSize is the maximum size of a decompressed PDF stream.
+const maxStreamSize = 16 << 20
+
+// extractPDF returns the text drawn by the content streams of the PDF.
+// It supports uncompressed and FlateDecode streams with text in single byte or
+// UTF-16 encodings, which covers most text documents. Text of fonts with custom
+// encodings, e.g. subsetted CID fonts without Unicode mapping, is not recovered.
+func extractPDF(data []byte) (string, error) {
+	if !bytes.HasPrefix(data, []byte("%PDF-")) {
+		return "", errors.New("not a PDF file")
+	}
+
+	var text strings.Builder
+	for _, loc := range streamMatcher.FindAllIndex(data, -1) {
+		// The keyword must follow the stream dictionary, not be part of another word, e.g. "endstream".
+		dictEnd := bytes.LastIndex(data[:loc[0]], []byte(">>"))
+		if dictEnd < 0 || strings.TrimSpace(string(data[dictEnd+2:loc[0]])) != "" {
+			continue
+		}
+		dictStart := bytes.LastIndex(data[:dictEnd], []byte("obj"))
+		if dictStart < 0 {
+			continue
+		}
+		dict := data[dictStart:dictEnd]
+		end := bytes.Index(data[loc[1]:], []byte("endstream"))
+		if end < 0 {
+			continue
+		}
+		stream := data[loc[1] : loc[1]+end]
+
+		if bytes.Contains(dict, []byte("/FlateDecode")) {
+			reader, err := zlib.NewReader(bytes.NewReader(stream))
+			if err != nil {
+				continue
+			}
+			// Streams are often followed by garbage bytes, keep what could be decoded.
+			decoded, _ := io.ReadAll(io.LimitReader(reader, maxStreamSize))
+			reader.Close()
+			stream = decoded
+		} else if bytes.Contains(dict, []byte("/Filter")) {
+			// Images and other encoded streams do not contain text.
+			continue
+		}
+		extractContentStreamText(stream, &text)
+	}
+	return text.String(), nil
+}
+
+// extractContentStreamText writes the text shown by the operators of the content stream.
+func extractContentStreamText(stream []byte, text *strings.Builder) {
+	var operands []string
+	inText := false
+	for i := 0; i < len(stream); {
+		c := stream[i]
+		switch {
+		case isPDFSpace(c):
+			i++
+		case c == '%':
+			for i < len(stream) && stream[i] != '\n' && stream[i] != '\r' {
+				i++
+			}
+		case c == '(':
+			s, n := readLiteralString(stream[i:])
+			operands = append(operands, s)
+			i += n
+		case c == '<' && i+1 < len(stream) && stream[i+1] != '<':
+			end := bytes.IndexByte(stream[i:], '>')
+			if end < 0 {
+				return
+			}
+			operands = append(operands, decodeHexString(stream[i+1:i+end]))
+			i += end + 1
+		case c == '[':
+			operands = append(operands, "[")
+			i++
+		case c == ']':
+			// Concatenate the strings of the array, large negative offsets separate words.
+			start := len(operands) - 1
+			for start >= 0 && operands[start] != "[" {
+				start--
+			}
+			if start < 0 {
+				operands = operands[:0]
+			} else {
+				operands = append(operands[:start], strings.Join(operands[start+1:], ""))
+			}
+			i++
+		case c == '-' || c == '.' || (c >= '0' && c <= '9'):
+			start := i
+			for i < len(stream) && (stream[i] == '-' || stream[i] == '.' || (stream[i] >= '0' && stream[i] <= '9')) {
+				i++
+			}
+			// Numbers only matter as word separators inside TJ arrays.
+			if len(operands) > 0 && isArrayOpen(operands) && isWordGap(string(stream[start:i])) {
+				operands = append(operands, " ")
+			}
+		default:
+			start := i
+			for i < len(stream) && !isPDFSpace(stream[i]) && !isPDFDelimiter(stream[i]) {
+				i++
+			}
+			if i == start {
+				i++
+				continue
+			}
+			operator := string(stream[start:i])
+			switch operator {
+			case "BT":
+				inText = true
+			case "ET":
+				inText = false
+				text.WriteString("\n")
+			case "Tj", "TJ":
+				if inText && len(operands) > 0 {
+					text.WriteString(operands[len(operands)-1])
+				}
+			case "'", `"`:
+				if inText && len(operands) > 0 {
+					text.WriteString("\n" + operands[len(operands)-1])
+				}
+			case "Td", "TD", "T*", "Tm":
+				if inText {
+					text.WriteString(" ")
+				}
+			}
+			if !strings.HasPrefix(operator, "/") {
+				operands = operands[:0]
+			}
+		}
+	}
+}
+
+func isArrayOpen(operands []string) bool {
+	for i := len(operands) - 1; i >= 0; i-- {
+		if operands[i] == "[" {
+			return true
+		}
+	}
+	return false
+}
+
+// isWordGap returns true if the TJ offset, in thousandths of text space, is wide enough to be a space.
+func isWordGap(number string) bool {
+	return strings.HasPrefix(number, "-") && len(strings.TrimLeft(strings.SplitN(number, ".", 2)[0], "-")) >= 3
+}
+
+// readLiteralString reads the literal string at the start of data and returns it with its length.
+func readLiteralString(data []byte) (string, int) {
+	var s []byte
+	depth := 0
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		switch {
+		case c == '\\' && i+1 < len(data):
+			i++
+			switch e := data[i]; e {
+			case 'n':
+				s = append(s, '\n')
+			case 'r':
+				s = append(s, '\r')
+			case 't':
+				s = append(s, '\t')
+			case 'b', 'f':
+			case '\r', '\n':
+				// Line continuation.
+			default:
+				if e >= '0' && e <= '7' {
+					value := 0
+					for j := 0; j < 3 && i < len(data) && data[i] >= '0' && data[i] <= '7'; j++ {
+						value = value*8 + int(data[i]-'0')
+						i++
+					}
+					i--
+					s = append(s, byte(value))
+				} else {
+					s = append(s, e)
+				}
+			}
+		case c == '(':
+			if depth > 0 {
+				s = append(s, c)
+			}
+			depth++
+		case c == ')':
+			depth--
+			if depth == 0 {
+				return decodeTextBytes(s), i + 1
+			}
+			s = append(s, c)
+		default:
+			s = append(s, c)
+		}
+	}
+	return decodeTextBytes(s), len(data)
+}
+
+func decodeHexString(data []byte) string {
+	var digits []byte
+	for _, c := range data {
+		if !isPDFSpace(c) {
+			digits = append(digits, c)
+		}
+	}
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+	s := make([]byte, 0, len(digits)/2)
+	for i := 0; i < len(digits); i += 2 {
+		s = append(s, hexValue(digits[i])<<4|hexValue(digits[i+1]))
+	}
+	return decodeTextBytes(s)
+}
+
+func hexValue(c byte) byte {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0'
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10
+	}
+	return 0
+}
+
+// decodeTextBytes decodes UTF-16BE strings with a byte order mark, and single byte strings otherwise.
+func decodeTextBytes(s []byte) string {
+	if len(s) >= 2 && s[0] == 0xfe && s[1] == 0xff {
+		units := make([]uint16, 0, len(s)/2)
+		for i := 2; i+1 < len(s); i += 2 {
+			units = append(units, uint16(s[i])<<8|uint16(s[i+1]))
+		}
+		return string(utf16.Decode(units))
+	}
+	runes := make([]rune, 0, len(s))
+	for _, b := range s {
+		r := rune(b)
+		if unicode.IsPrint(r) || r == '\n' || r == '\t' {
+			runes = append(runes, r)
+		}
+	}
+	return string(runes)
+}
+
+func isPDFSpace(c byte) bool {
+	return c == ' ' || c == '\n' || c == '\r' || c == '\t' || c == '\f' || c == 0
+}
+
+func isPDFDelimiter(c byte) bool {
+	return strings.IndexByte("()<>[]{}/%", c) >= 0
+}
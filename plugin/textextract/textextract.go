@@ -0,0 +1,64 @@
+// Package textextract extracts the searchable text of files.
+package textextract
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// MaxTextLength is the maximum length in bytes of an extracted text, longer texts are truncated.
+const MaxTextLength = 1 << 20
+
+// IsSupported returns true if the text of files of the MIME type can be extracted.
+func IsSupported(mimeType string) bool {
+	mimeType = normalizeMimeType(mimeType)
+	return mimeType == "application/pdf" || isPlainText(mimeType)
+}
+
+// Extract returns the text of the file of the MIME type.
+func Extract(mimeType string, data []byte) (string, error) {
+	mimeType = normalizeMimeType(mimeType)
+	var text string
+	switch {
+	case mimeType == "application/pdf":
+		var err error
+		text, err = extractPDF(data)
+		if err != nil {
+			return "", err
+		}
+	case isPlainText(mimeType):
+		if !utf8.Valid(data) {
+			return "", errors.New("text is not valid UTF-8")
+		}
+		text = string(data)
+	default:
+		return "", errors.Errorf("unsupported file type %q", mimeType)
+	}
+	return truncate(strings.TrimSpace(text), MaxTextLength), nil
+}
+
+func normalizeMimeType(mimeType string) string {
+	mimeType, _, _ = strings.Cut(mimeType, ";")
+	return strings.ToLower(strings.TrimSpace(mimeType))
+}
+
+func isPlainText(mimeType string) bool {
+	switch mimeType {
+	case "application/json", "application/xml", "application/x-yaml", "application/yaml":
+		return true
+	}
+	return strings.HasPrefix(mimeType, "text/")
+}
+
+// truncate shortens the text to at most n bytes without splitting a character.
+func truncate(text string, n int) string {
+	if len(text) <= n {
+		return text
+	}
+	for n > 0 && !utf8.RuneStart(text[n]) {
+		n--
+	}
+	return text[:n]
+}
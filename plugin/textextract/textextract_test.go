@@ -0,0 +1,81 @@
+package textextract
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractPlainText(t *testing.T) {
+	text, err := Extract("text/plain; charset=utf-8", []byte("  Hello, world!\n"))
+	require.NoError(t, err)
+	require.Equal(t, "Hello, world!", text)
+
+	_, err = Extract("text/plain", []byte{0xff, 0xfe})
+	require.Error(t, err)
+
+	_, err = Extract("image/png", []byte("data"))
+	require.Error(t, err)
+}
+
+func TestExtractPDF(t *testing.T) {
+	content := "BT /F1 12 Tf 72 712 Td (Quarterly report) Tj 0 -14 Td [(Reve) 20 (nue ) -300 (grew \\(a lot\\))] TJ ET\n" +
+		"BT <FEFF00E9007400E9> Tj ET"
+
+	t.Run("uncompressed", func(t *testing.T) {
+		text, err := Extract("application/pdf", buildPDF(t, []byte(content), false))
+		require.NoError(t, err)
+		require.Equal(t, "Quarterly report Revenue  grew (a lot)\nété", text)
+	})
+
+	t.Run("flate", func(t *testing.T) {
+		text, err := Extract("application/pdf", buildPDF(t, []byte(content), true))
+		require.NoError(t, err)
+		require.Contains(t, text, "Quarterly report")
+		require.Contains(t, text, "été")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := Extract("application/pdf", []byte("not a pdf"))
+		require.Error(t, err)
+	})
+}
+
+func TestIsSupported(t *testing.T) {
+	require.True(t, IsSupported("application/pdf"))
+	require.True(t, IsSupported("text/markdown"))
+	require.True(t, IsSupported("application/json"))
+	require.False(t, IsSupported("image/png"))
+}
+
+func TestTruncate(t *testing.T) {
+	require.Equal(t, "ab", truncate("abc", 2))
+	require.Equal(t, "a", truncate("aé", 2))
+}
+
+// buildPDF returns a single page PDF drawing the content stream.
+func buildPDF(t *testing.T, content []byte, compress bool) []byte {
+	filter := ""
+	if compress {
+		var buf bytes.Buffer
+		writer := zlib.NewWriter(&buf)
+		_, err := writer.Write(content)
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+		content = buf.Bytes()
+		filter = " /Filter /FlateDecode"
+	}
+
+	var pdf bytes.Buffer
+	pdf.WriteString("%PDF-1.4\n")
+	pdf.WriteString("1 0 obj\n<< /Type /Catalog /Pages 2 0 R >>\nendobj\n")
+	pdf.WriteString("2 0 obj\n<< /Type /Pages /Kids [3 0 R] /Count 1 >>\nendobj\n")
+	pdf.WriteString("3 0 obj\n<< /Type /Page /Parent 2 0 R /Contents 4 0 R >>\nendobj\n")
+	fmt.Fprintf(&pdf, "4 0 obj\n<< /Length %d%s >>\nstream\n", len(content), filter)
+	pdf.Write(content)
+	pdf.WriteString("\nendstream\nendobj\n%%EOF\n")
+	return pdf.Bytes()
+}
@@ -0,0 +1,67 @@
+package totp
+
+import (
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateCode(t *testing.T) {
+	// The SHA-1 test vectors of RFC 6238, truncated to 6 digits.
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+	for _, test := range tests {
+		code, err := GenerateCode(secret, time.Unix(test.unix, 0))
+		require.NoError(t, err)
+		require.Equal(t, test.code, code, "time %d", test.unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+	require.Len(t, secret, 32)
+
+	now := time.Unix(1700000000, 0)
+	code, err := GenerateCode(secret, now)
+	require.NoError(t, err)
+	step, ok := Validate(secret, code, now)
+	require.True(t, ok)
+	require.Equal(t, Step(now), step)
+
+	// The codes of the adjacent steps are accepted for the clock drift.
+	step, ok = Validate(secret, code, now.Add(Period*time.Second))
+	require.True(t, ok)
+	require.Equal(t, Step(now), step)
+	_, ok = Validate(secret, code, now.Add(2*Period*time.Second))
+	require.False(t, ok)
+
+	_, ok = Validate(secret, "000000x", now)
+	require.False(t, ok)
+	_, ok = Validate("not a secret!", code, now)
+	require.False(t, ok)
+}
+
+func TestProvisioningURI(t *testing.T) {
+	uri, err := url.Parse(ProvisioningURI("Memos", "jane doe", "JBSWY3DPEHPK3PXP"))
+	require.NoError(t, err)
+	require.Equal(t, "otpauth", uri.Scheme)
+	require.Equal(t, "totp", uri.Host)
+	require.Equal(t, "/Memos:jane doe", uri.Path)
+	require.Equal(t, "JBSWY3DPEHPK3PXP", uri.Query().Get("secret"))
+	require.Equal(t, "Memos", uri.Query().Get("issuer"))
+	require.Equal(t, "6", uri.Query().Get("digits"))
+}
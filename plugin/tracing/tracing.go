@@ -0,0 +1,87 @@
+// Package tracing exports the OpenTelemetry spans of the API calls, the SQL queries and the storage requests
+// to an OTLP collector, e.g. Jaeger or Grafana Tempo.
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+
+	"github.com/XSAM/otelsql"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ServiceName is the name of the service of the exported spans.
+const ServiceName = "memos"
+
+// tracerName is the name of the tracer of the spans started by StartSpan.
+const tracerName = "github.com/usememos/memos"
+
+// Setup exports the spans to the OTLP/HTTP endpoint, e.g. "http://localhost:4318", sampling the ratio of the traces
+// started by the server, while the traces of the sampled callers are always continued.
+// The returned function flushes the pending spans and stops the exporter.
+func Setup(ctx context.Context, endpoint string, sampleRatio float64, version string) (func(context.Context) error, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, errors.Errorf("invalid OTLP endpoint %q", endpoint)
+	}
+	if sampleRatio < 0 || sampleRatio > 1 {
+		return nil, errors.Errorf("the trace sample ratio %v is not between 0 and 1", sampleRatio)
+	}
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(endpoint))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create OTLP exporter")
+	}
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(ServiceName),
+			semconv.ServiceVersion(version),
+		)),
+	)
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return tracerProvider.Shutdown, nil
+}
+
+// StartSpan starts a span of the global tracer provider, which drops it unless tracing is set up.
+func StartSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// EndSpan ends the span, recording the error if any.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// OpenDB opens the database like sql.Open, tracing the SQL statements of the connections.
+func OpenDB(driverName, dataSourceName string) (*sql.DB, error) {
+	system := semconv.DBSystemKey.String(driverName)
+	if driverName == "postgres" {
+		system = semconv.DBSystemPostgreSQL
+	}
+	return otelsql.Open(driverName, dataSourceName,
+		otelsql.WithAttributes(system),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			// The rows and the session resets of the pooled connections only add noise to the traces.
+			OmitRows:             true,
+			OmitConnResetSession: true,
+			OmitConnPrepare:      true,
+		}),
+	)
+}
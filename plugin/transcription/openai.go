@@ -0,0 +1,103 @@
+package transcription
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// timeout is the timeout of the transcription of a recording.
+var timeout = 10 * time.Minute
+
+// OpenAIProvider transcribes recordings with a transcriptions API compatible with OpenAI.
+type OpenAIProvider struct {
+	endpoint string
+	apiKey   string
+	model    string
+	language string
+	client   *http.Client
+}
+
+// NewOpenAIProvider returns a provider calling the transcriptions API at the endpoint, e.g. "https://api.openai.com/v1",
+// with the model, default to "whisper-1". The API key may be empty for local servers, and the language is detected if empty.
+func NewOpenAIProvider(endpoint, apiKey, model, language string) *OpenAIProvider {
+	if model == "" {
+		model = "whisper-1"
+	}
+	return &OpenAIProvider{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		apiKey:   apiKey,
+		model:    model,
+		language: language,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+type openAITranscriptionResponse struct {
+	Text string `json:"text"`
+}
+
+func (p *OpenAIProvider) Transcribe(ctx context.Context, _, filename string, audio []byte) (string, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	// The API detects the format from the file extension.
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create file part")
+	}
+	if _, err := part.Write(audio); err != nil {
+		return "", errors.Wrap(err, "failed to write file part")
+	}
+	fields := map[string]string{
+		"model":           p.model,
+		"response_format": "json",
+	}
+	if p.language != "" {
+		fields["language"] = p.language
+	}
+	for name, value := range fields {
+		if err := writer.WriteField(name, value); err != nil {
+			return "", errors.Wrapf(err, "failed to write %s field", name)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return "", errors.Wrap(err, "failed to close multipart body")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint+"/audio/transcriptions", &body)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to construct transcription request")
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to post transcription request to %s", p.endpoint)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to read transcription response")
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "", errors.Errorf("failed to transcribe audio, status code: %d, response body: %s", resp.StatusCode, b)
+	}
+
+	response := &openAITranscriptionResponse{}
+	if err := json.Unmarshal(b, response); err != nil {
+		return "", errors.Wrap(err, "failed to unmarshal transcription response")
+	}
+	return strings.TrimSpace(response.Text), nil
+}
@@ -0,0 +1,42 @@
+// Package transcription converts the speech of audio recordings to text, e.g. of voice memos.
+package transcription
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// Provider transcribes audio recordings.
+type Provider interface {
+	// Transcribe returns the text of the audio recording of the MIME type with the filename.
+	Transcribe(ctx context.Context, mimeType, filename string, audio []byte) (string, error)
+}
+
+// NewProvider returns the provider configured by the workspace transcription setting.
+// The ffmpeg binary at ffmpegPath, which may be empty, converts the recordings for whisper.cpp.
+func NewProvider(setting *storepb.WorkspaceTranscriptionSetting, ffmpegPath string) (Provider, error) {
+	switch setting.Provider {
+	case storepb.WorkspaceTranscriptionSetting_WHISPER_CPP, storepb.WorkspaceTranscriptionSetting_PROVIDER_UNSPECIFIED:
+		if setting.WhisperModelPath == "" {
+			return nil, errors.New("model path is required for the whisper.cpp provider")
+		}
+		return NewWhisperProvider(setting.WhisperPath, setting.WhisperModelPath, setting.Language, ffmpegPath), nil
+	case storepb.WorkspaceTranscriptionSetting_OPENAI:
+		if setting.Endpoint == "" {
+			return nil, errors.New("endpoint is required for the OpenAI provider")
+		}
+		return NewOpenAIProvider(setting.Endpoint, setting.ApiKey, setting.Model, setting.Language), nil
+	default:
+		return nil, errors.Errorf("unsupported transcription provider: %v", setting.Provider)
+	}
+}
+
+// IsSupported returns true if recordings of the MIME type can be transcribed.
+func IsSupported(mimeType string) bool {
+	mimeType, _, _ = strings.Cut(mimeType, ";")
+	return strings.HasPrefix(strings.ToLower(strings.TrimSpace(mimeType)), "audio/")
+}
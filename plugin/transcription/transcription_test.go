@@ -0,0 +1,74 @@
+package transcription
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestOpenAIProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/audio/transcriptions", r.URL.Path)
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		file, header, err := r.FormFile("file")
+		require.NoError(t, err)
+		defer file.Close()
+		require.Equal(t, "memo.webm", header.Filename)
+		require.Equal(t, "whisper-1", r.FormValue("model"))
+		require.Equal(t, "de", r.FormValue("language"))
+		w.Write([]byte(`{"text": " Buy milk.\n"}`))
+	}))
+	defer server.Close()
+
+	provider, err := NewProvider(&storepb.WorkspaceTranscriptionSetting{
+		Provider: storepb.WorkspaceTranscriptionSetting_OPENAI,
+		Endpoint: server.URL + "/v1/",
+		ApiKey:   "secret",
+		Language: "de",
+	}, "")
+	require.NoError(t, err)
+	text, err := provider.Transcribe(context.Background(), "audio/webm", "memo.webm", []byte("audio"))
+	require.NoError(t, err)
+	require.Equal(t, "Buy milk.", text)
+
+	_, err = NewProvider(&storepb.WorkspaceTranscriptionSetting{Provider: storepb.WorkspaceTranscriptionSetting_OPENAI}, "")
+	require.Error(t, err)
+}
+
+func TestWhisperProvider(t *testing.T) {
+	dir := t.TempDir()
+	// A fake whisper.cpp printing its arguments and the segments on separate lines.
+	whisperPath := filepath.Join(dir, "whisper-cli")
+	require.NoError(t, os.WriteFile(whisperPath, []byte("#!/bin/sh\necho \" $1 $2 $3 $4\"\necho \" Buy milk.\"\n"), 0o755))
+	// A fake ffmpeg copying its input to its output, the last argument.
+	ffmpegPath := filepath.Join(dir, "ffmpeg")
+	require.NoError(t, os.WriteFile(ffmpegPath, []byte("#!/bin/sh\nfor last; do :; done\ncp \"$5\" \"$last\"\n"), 0o755))
+
+	text, err := NewWhisperProvider(whisperPath, "model.bin", "", "").Transcribe(context.Background(), "audio/wav", "memo.wav", []byte("audio"))
+	require.NoError(t, err)
+	require.Equal(t, "-m model.bin -l auto Buy milk.", text)
+
+	// The other recordings are converted by ffmpeg.
+	_, err = NewWhisperProvider(whisperPath, "model.bin", "en", "").Transcribe(context.Background(), "audio/webm", "memo.webm", []byte("audio"))
+	require.Error(t, err)
+	text, err = NewWhisperProvider(whisperPath, "model.bin", "en", ffmpegPath).Transcribe(context.Background(), "audio/webm", "memo.webm", []byte("audio"))
+	require.NoError(t, err)
+	require.Equal(t, "-m model.bin -l en Buy milk.", text)
+
+	_, err = NewProvider(&storepb.WorkspaceTranscriptionSetting{}, "")
+	require.Error(t, err)
+}
+
+func TestIsSupported(t *testing.T) {
+	require.True(t, IsSupported("audio/webm;codecs=opus"))
+	require.True(t, IsSupported("audio/mpeg"))
+	require.False(t, IsSupported("video/mp4"))
+	require.False(t, IsSupported("image/png"))
+}
@@ -0,0 +1,99 @@
+package transcription
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// WhisperProvider transcribes recordings with the command line tool of whisper.cpp.
+// Reference: https://github.com/ggml-org/whisper.cpp
+type WhisperProvider struct {
+	path       string
+	modelPath  string
+	language   string
+	ffmpegPath string
+}
+
+// NewWhisperProvider returns a provider running the whisper.cpp binary at the path, default to "whisper-cli" in the PATH,
+// with the model at modelPath. The language, e.g. "en", is detected if empty.
+// whisper.cpp reads WAV files, so the other recordings are converted with the ffmpeg binary at ffmpegPath.
+func NewWhisperProvider(path, modelPath, language, ffmpegPath string) *WhisperProvider {
+	if path == "" {
+		path = "whisper-cli"
+	}
+	if language == "" {
+		language = "auto"
+	}
+	return &WhisperProvider{
+		path:       path,
+		modelPath:  modelPath,
+		language:   language,
+		ffmpegPath: ffmpegPath,
+	}
+}
+
+func (p *WhisperProvider) Transcribe(ctx context.Context, mimeType, _ string, audio []byte) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	dir, err := os.MkdirTemp("", "memos-transcription-*")
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create temporary directory")
+	}
+	defer os.RemoveAll(dir)
+
+	wavPath := filepath.Join(dir, "audio.wav")
+	if isWAV(mimeType) {
+		if err := os.WriteFile(wavPath, audio, 0o600); err != nil {
+			return "", errors.Wrap(err, "failed to write audio")
+		}
+	} else if err := p.convertToWAV(ctx, dir, audio, wavPath); err != nil {
+		return "", err
+	}
+
+	// The text is printed without timestamps nor progress.
+	cmd := exec.CommandContext(ctx, p.path, "-m", p.modelPath, "-l", p.language, "-nt", "-np", "-f", wavPath)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", errors.Wrapf(err, "failed to run whisper.cpp: %s", strings.TrimSpace(stderr.String()))
+	}
+	// The segments are printed on separate lines.
+	return strings.Join(strings.Fields(stdout.String()), " "), nil
+}
+
+// convertToWAV converts the recording to the 16 kHz mono WAV read by whisper.cpp.
+func (p *WhisperProvider) convertToWAV(ctx context.Context, dir string, audio []byte, wavPath string) error {
+	if p.ffmpegPath == "" {
+		return errors.New("ffmpeg is required to convert the recordings other than WAV")
+	}
+	// The input is a file, as some containers like MP4 are not readable from a pipe.
+	inputPath := filepath.Join(dir, "input")
+	if err := os.WriteFile(inputPath, audio, 0o600); err != nil {
+		return errors.Wrap(err, "failed to write audio")
+	}
+	cmd := exec.CommandContext(ctx, p.ffmpegPath, "-hide_banner", "-loglevel", "error", "-i", inputPath,
+		"-ar", "16000", "-ac", "1", "-c:a", "pcm_s16le", "-f", "wav", wavPath)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return errors.Wrapf(err, "failed to run ffmpeg: %s", strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+func isWAV(mimeType string) bool {
+	mimeType, _, _ = strings.Cut(mimeType, ";")
+	switch strings.ToLower(strings.TrimSpace(mimeType)) {
+	case "audio/wav", "audio/x-wav", "audio/wave", "audio/vnd.wave":
+		return true
+	}
+	return false
+}
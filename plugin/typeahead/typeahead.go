@@ -0,0 +1,95 @@
+// Package typeahead provides an in-memory index completing prefixes to texts, e.g. for autocompletion.
+package typeahead
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Item is an indexed text.
+type Item struct {
+	// ID identifies the item for the caller.
+	ID int
+	// Text is the text to complete to.
+	Text string
+	// Weight ranks the items matching a prefix, the heaviest first.
+	Weight int64
+}
+
+// key is a lower case suffix of an item text starting at a word.
+type key struct {
+	text string
+	item *Item
+}
+
+// Index completes prefixes to the items whose text, or a word of whose text, starts with the prefix.
+// It is immutable and safe for concurrent use.
+type Index struct {
+	keys []key
+}
+
+// NewIndex indexes the items.
+func NewIndex(items []*Item) *Index {
+	index := &Index{}
+	for _, item := range items {
+		text := strings.ToLower(item.Text)
+		if text == "" {
+			continue
+		}
+		// The whole text is a key even if it starts with a symbol, e.g. "#tag".
+		index.keys = append(index.keys, key{text: text, item: item})
+		previous := ' '
+		for i, r := range text {
+			if i > 0 && isWordRune(r) && !isWordRune(previous) {
+				index.keys = append(index.keys, key{text: text[i:], item: item})
+			}
+			previous = r
+		}
+	}
+	slices.SortFunc(index.keys, func(a, b key) int {
+		return strings.Compare(a.text, b.text)
+	})
+	return index
+}
+
+// Search returns at most limit items matching the prefix, ignoring case, the heaviest first.
+// Items rejected by accept are skipped, accept may be nil to accept every item.
+func (i *Index) Search(prefix string, limit int, accept func(*Item) bool) []*Item {
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
+	items := []*Item{}
+	if prefix == "" || limit <= 0 {
+		return items
+	}
+	start, _ := slices.BinarySearchFunc(i.keys, prefix, func(k key, prefix string) int {
+		return strings.Compare(k.text, prefix)
+	})
+	seen := map[*Item]bool{}
+	for _, k := range i.keys[start:] {
+		if !strings.HasPrefix(k.text, prefix) {
+			break
+		}
+		if seen[k.item] {
+			continue
+		}
+		seen[k.item] = true
+		if accept == nil || accept(k.item) {
+			items = append(items, k.item)
+		}
+	}
+	slices.SortStableFunc(items, func(a, b *Item) int {
+		if a.Weight != b.Weight {
+			return cmp.Compare(b.Weight, a.Weight)
+		}
+		return strings.Compare(a.Text, b.Text)
+	})
+	if len(items) > limit {
+		items = items[:limit]
+	}
+	return items
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r)
+}
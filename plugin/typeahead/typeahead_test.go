@@ -0,0 +1,34 @@
+package typeahead
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearch(t *testing.T) {
+	index := NewIndex([]*Item{
+		{ID: 1, Text: "Meeting notes for Q3", Weight: 1},
+		{ID: 2, Text: "Grocery list", Weight: 2},
+		{ID: 3, Text: "#meetup", Weight: 3},
+		{ID: 4, Text: "work/project-notes", Weight: 4},
+	})
+	ids := func(items []*Item) []int {
+		result := []int{}
+		for _, item := range items {
+			result = append(result, item.ID)
+		}
+		return result
+	}
+
+	// The prefix matches the start of the text or of any word, ignoring case, the heaviest first.
+	require.Equal(t, []int{3, 1}, ids(index.Search("MEET", 10, nil)))
+	require.Equal(t, []int{4, 1}, ids(index.Search("notes", 10, nil)))
+	require.Equal(t, []int{1}, ids(index.Search("notes for", 10, nil)))
+	require.Equal(t, []int{3}, ids(index.Search("#me", 10, nil)))
+	require.Equal(t, []int{3}, ids(index.Search("meet", 1, nil)))
+	require.Equal(t, []int{1}, ids(index.Search("meet", 10, func(item *Item) bool { return item.ID != 3 })))
+
+	require.Empty(t, index.Search("otes", 10, nil))
+	require.Empty(t, index.Search("  ", 10, nil))
+}
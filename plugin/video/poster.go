@@ -0,0 +1,45 @@
+// Package video extracts the poster frames of videos, shown in place of the videos until they play.
+package video
+
+import (
+	"bytes"
+	"context"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// timeout is the timeout of the extraction of a poster frame.
+var timeout = time.Minute
+
+// posterOffsets are the offsets in seconds of the candidate poster frames.
+// The first second skips the black frames opening many videos, and videos shorter than it use their first frame.
+var posterOffsets = []string{"1", "0"}
+
+// ExtractPosterFrame returns a JPEG image of a frame near the start of the video file,
+// running the ffmpeg binary at the path.
+func ExtractPosterFrame(ctx context.Context, ffmpegPath, videoPath string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for _, offset := range posterOffsets {
+		cmd := exec.CommandContext(ctx, ffmpegPath,
+			"-hide_banner", "-loglevel", "error",
+			"-ss", offset, "-i", videoPath,
+			"-frames:v", "1", "-f", "image2", "-c:v", "mjpeg", "pipe:1",
+		)
+		var stdout, stderr bytes.Buffer
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		if err := cmd.Run(); err != nil {
+			return nil, errors.Wrapf(err, "failed to run ffmpeg: %s", strings.TrimSpace(stderr.String()))
+		}
+		// Seeking past the end of the video outputs nothing.
+		if stdout.Len() > 0 {
+			return stdout.Bytes(), nil
+		}
+	}
+	return nil, errors.New("no frame found in the video")
+}
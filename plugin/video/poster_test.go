@@ -0,0 +1,31 @@
+package video
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractPosterFrame(t *testing.T) {
+	dir := t.TempDir()
+
+	// A fake ffmpeg echoing its arguments.
+	ffmpegPath := filepath.Join(dir, "ffmpeg")
+	require.NoError(t, os.WriteFile(ffmpegPath, []byte("#!/bin/sh\necho \"$@\"\n"), 0o755))
+	poster, err := ExtractPosterFrame(context.Background(), ffmpegPath, "video.mp4")
+	require.NoError(t, err)
+	require.Equal(t, "-hide_banner -loglevel error -ss 1 -i video.mp4 -frames:v 1 -f image2 -c:v mjpeg pipe:1\n", string(poster))
+
+	// A fake ffmpeg outputting nothing but the first frame, as for videos shorter than a second.
+	require.NoError(t, os.WriteFile(ffmpegPath, []byte("#!/bin/sh\nif [ \"$5\" = \"0\" ]; then echo frame; fi\n"), 0o755))
+	poster, err = ExtractPosterFrame(context.Background(), ffmpegPath, "video.mp4")
+	require.NoError(t, err)
+	require.Equal(t, "frame\n", string(poster))
+
+	require.NoError(t, os.WriteFile(ffmpegPath, []byte("#!/bin/sh\necho invalid data >&2\nexit 1\n"), 0o755))
+	_, err = ExtractPosterFrame(context.Background(), ffmpegPath, "video.mp4")
+	require.ErrorContains(t, err, "invalid data")
+}
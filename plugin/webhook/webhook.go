@@ -2,9 +2,12 @@ package webhook
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"io"
-	"log/slog"
 	"net/http"
 	"time"
 
@@ -18,6 +21,35 @@ var (
 	timeout = 30 * time.Second
 )
 
+// SignatureHeader is the header of the signature of the payloads, "t={timestamp},v1={signature}".
+const SignatureHeader = "X-Memos-Signature"
+
+// The activity types of the payloads, which the webhooks subscribe to.
+const (
+	ActivityTypeMemoCreated       = "memos.memo.created"
+	ActivityTypeMemoUpdated       = "memos.memo.updated"
+	ActivityTypeMemoDeleted       = "memos.memo.deleted"
+	ActivityTypeMemoArchived      = "memos.memo.archived"
+	ActivityTypeAttachmentCreated = "memos.attachment.created"
+	// ActivityTypeCommentCreated is sent to the creator of the commented memo, with the comment as the memo.
+	ActivityTypeCommentCreated = "memos.comment.created"
+	// ActivityTypeMemoMentioned is sent to the users mentioned by a memo or a comment they can see, with the memo.
+	ActivityTypeMemoMentioned = "memos.memo.mentioned"
+	ActivityTypeUserSignedIn  = "memos.user.signed_in"
+)
+
+// ActivityTypes are all the activity types of the payloads.
+var ActivityTypes = []string{
+	ActivityTypeMemoCreated,
+	ActivityTypeMemoUpdated,
+	ActivityTypeMemoDeleted,
+	ActivityTypeMemoArchived,
+	ActivityTypeAttachmentCreated,
+	ActivityTypeCommentCreated,
+	ActivityTypeMemoMentioned,
+	ActivityTypeUserSignedIn,
+}
+
 type WebhookRequestPayload struct {
 	// The target URL for the webhook request.
 	URL string `json:"url"`
@@ -27,6 +59,25 @@ type WebhookRequestPayload struct {
 	Creator string `json:"creator"`
 	// The memo that triggered this webhook (if applicable).
 	Memo *v1pb.Memo `json:"memo"`
+	// The attachment that triggered this webhook (if applicable).
+	Attachment *v1pb.Attachment `json:"attachment,omitempty"`
+	// The secret signing the request, unsigned if empty.
+	Secret string `json:"-"`
+}
+
+// Sign returns the signature of the body sent at the timestamp, the hex HMAC-SHA256 of the Unix timestamp, a dot and the body.
+// The receivers compute it again to authenticate the payloads, and reject the old timestamps against replays.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp.Unix())
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Response is the response of a webhook endpoint.
+type Response struct {
+	StatusCode int
+	Body       []byte
 }
 
 // Post posts the message to webhook endpoint.
@@ -35,56 +86,57 @@ func Post(requestPayload *WebhookRequestPayload) error {
 	if err != nil {
 		return errors.Wrapf(err, "failed to marshal webhook request to %s", requestPayload.URL)
 	}
+	_, err = PostBody(requestPayload.URL, requestPayload.Secret, body)
+	return err
+}
 
-	req, err := http.NewRequest("POST", requestPayload.URL, bytes.NewBuffer(body))
+// PostBody posts the marshaled message to webhook endpoint, signed with the secret unless it is empty.
+// The deliveries attempted again post the body marshaled when the activity happened.
+// The response is returned once the endpoint responds, even if it rejects the message.
+func PostBody(url, secret string, body []byte) (*Response, error) {
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(body))
 	if err != nil {
-		return errors.Wrapf(err, "failed to construct webhook request to %s", requestPayload.URL)
+		return nil, errors.Wrapf(err, "failed to construct webhook request to %s", url)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if secret != "" {
+		timestamp := time.Now()
+		req.Header.Set(SignatureHeader, fmt.Sprintf("t=%d,v1=%s", timestamp.Unix(), Sign(secret, timestamp, body)))
+	}
 	client := &http.Client{
 		Timeout: timeout,
 	}
 	resp, err := client.Do(req)
 	if err != nil {
-		return errors.Wrapf(err, "failed to post webhook to %s", requestPayload.URL)
+		return nil, errors.Wrapf(err, "failed to post webhook to %s", url)
 	}
 
 	b, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return errors.Wrapf(err, "failed to read webhook response from %s", requestPayload.URL)
+		return nil, errors.Wrapf(err, "failed to read webhook response from %s", url)
 	}
 	defer resp.Body.Close()
+	response := &Response{
+		StatusCode: resp.StatusCode,
+		Body:       b,
+	}
 
 	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		return errors.Errorf("failed to post webhook %s, status code: %d, response body: %s", requestPayload.URL, resp.StatusCode, b)
+		return response, errors.Errorf("failed to post webhook %s, status code: %d, response body: %s", url, resp.StatusCode, b)
 	}
 
-	response := &struct {
+	responseMessage := &struct {
 		Code    int    `json:"code"`
 		Message string `json:"message"`
 	}{}
-	if err := json.Unmarshal(b, response); err != nil {
-		return errors.Wrapf(err, "failed to unmarshal webhook response from %s", requestPayload.URL)
+	if err := json.Unmarshal(b, responseMessage); err != nil {
+		return response, errors.Wrapf(err, "failed to unmarshal webhook response from %s", url)
 	}
 
-	if response.Code != 0 {
-		return errors.Errorf("receive error code sent by webhook server, code %d, msg: %s", response.Code, response.Message)
+	if responseMessage.Code != 0 {
+		return response, errors.Errorf("receive error code sent by webhook server, code %d, msg: %s", responseMessage.Code, responseMessage.Message)
 	}
 
-	return nil
-}
-
-// PostAsync posts the message to webhook endpoint asynchronously.
-// It spawns a new goroutine to handle the request and does not wait for the response.
-func PostAsync(requestPayload *WebhookRequestPayload) {
-	go func() {
-		if err := Post(requestPayload); err != nil {
-			// Since we're in a goroutine, we can only log the error
-			slog.Warn("Failed to dispatch webhook asynchronously",
-				slog.String("url", requestPayload.URL),
-				slog.String("activityType", requestPayload.ActivityType),
-				slog.Any("err", err))
-		}
-	}()
+	return response, nil
 }
@@ -1 +1,37 @@
 package webhook
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostSigned(t *testing.T) {
+	var signature string
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signature = r.Header.Get(SignatureHeader)
+		body, _ = io.ReadAll(r.Body)
+		fmt.Fprint(w, `{"code": 0}`)
+	}))
+	defer server.Close()
+
+	require.NoError(t, Post(&WebhookRequestPayload{URL: server.URL, ActivityType: "memos.memo.created", Secret: "secret"}))
+	timestamp, expected, ok := strings.Cut(strings.TrimPrefix(signature, "t="), ",v1=")
+	require.True(t, ok)
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	require.NoError(t, err)
+	require.Equal(t, expected, Sign("secret", time.Unix(unix, 0), body))
+	require.NotEqual(t, expected, Sign("another secret", time.Unix(unix, 0), body))
+
+	// The payloads of the webhooks without a secret are not signed.
+	require.NoError(t, Post(&WebhookRequestPayload{URL: server.URL, ActivityType: "memos.memo.created"}))
+	require.Empty(t, signature)
+}
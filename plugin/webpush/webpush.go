@@ -0,0 +1,91 @@
+// Package webpush sends notifications to the browsers subscribed with the Push API, signed with VAPID keys.
+package webpush
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/SherClockHolmes/webpush-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	// ttl is how long the push services keep the notifications of the offline browsers.
+	ttl = 24 * 60 * 60
+	// timeout is the timeout of the requests to the push services.
+	timeout = 10 * time.Second
+)
+
+// ErrSubscriptionGone is returned for the subscriptions expired or unsubscribed by their browser,
+// which must not be pushed to anymore.
+var ErrSubscriptionGone = errors.New("the Web Push subscription has expired or was unsubscribed")
+
+// Subscription is the PushSubscription of a browser.
+type Subscription struct {
+	Endpoint string
+	// P256dh is the public key of the browser, base64url encoded.
+	P256dh string
+	// Auth is the authentication secret of the browser, base64url encoded.
+	Auth string
+}
+
+// Notification is the payload of a push message, shown as a notification by the service worker.
+type Notification struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	// URL is the page opened when the notification is clicked.
+	URL string `json:"url,omitempty"`
+	// Tag replaces the notifications of the same tag still shown.
+	Tag string `json:"tag,omitempty"`
+}
+
+// Sender sends the notifications signed with the VAPID keys of the instance.
+type Sender struct {
+	VAPIDPublicKey  string
+	VAPIDPrivateKey string
+	// Subscriber is the contact of the instance given to the push services, a mailto or https URL.
+	Subscriber string
+}
+
+// GenerateVAPIDKeys returns a new pair of VAPID keys, base64url encoded.
+func GenerateVAPIDKeys() (privateKey, publicKey string, err error) {
+	return webpush.GenerateVAPIDKeys()
+}
+
+// Send pushes the notification to the subscription.
+func (s *Sender) Send(ctx context.Context, subscription *Subscription, notification *Notification) error {
+	message, err := json.Marshal(notification)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal notification")
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	response, err := webpush.SendNotificationWithContext(ctx, message, &webpush.Subscription{
+		Endpoint: subscription.Endpoint,
+		Keys: webpush.Keys{
+			P256dh: subscription.P256dh,
+			Auth:   subscription.Auth,
+		},
+	}, &webpush.Options{
+		Subscriber:      s.Subscriber,
+		VAPIDPublicKey:  s.VAPIDPublicKey,
+		VAPIDPrivateKey: s.VAPIDPrivateKey,
+		TTL:             ttl,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to send notification")
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 1<<16))
+
+	switch {
+	case response.StatusCode == http.StatusNotFound || response.StatusCode == http.StatusGone:
+		return ErrSubscriptionGone
+	case response.StatusCode < 200 || response.StatusCode >= 300:
+		return errors.Errorf("the push service responded with status %d", response.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package webpush
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestSubscription returns a subscription to the endpoint with the keys of a browser.
+func newTestSubscription(t *testing.T, endpoint string) *Subscription {
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	auth := make([]byte, 16)
+	_, err = rand.Read(auth)
+	require.NoError(t, err)
+	return &Subscription{
+		Endpoint: endpoint,
+		P256dh:   base64.RawURLEncoding.EncodeToString(key.PublicKey().Bytes()),
+		Auth:     base64.RawURLEncoding.EncodeToString(auth),
+	}
+}
+
+func TestSend(t *testing.T) {
+	statusCode := http.StatusCreated
+	var request *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.WriteHeader(statusCode)
+	}))
+	defer server.Close()
+
+	privateKey, publicKey, err := GenerateVAPIDKeys()
+	require.NoError(t, err)
+	sender := &Sender{VAPIDPublicKey: publicKey, VAPIDPrivateKey: privateKey, Subscriber: "admin@example.com"}
+	subscription := newTestSubscription(t, server.URL)
+	notification := &Notification{Title: "Alice commented on your memo", Body: "Nice!", URL: "https://example.com/memos/abc"}
+
+	require.NoError(t, sender.Send(context.Background(), subscription, notification))
+	require.Equal(t, "aes128gcm", request.Header.Get("Content-Encoding"))
+	require.True(t, strings.HasPrefix(request.Header.Get("Authorization"), "vapid t="))
+	require.Contains(t, request.Header.Get("Authorization"), "k="+publicKey)
+
+	statusCode = http.StatusGone
+	require.ErrorIs(t, sender.Send(context.Background(), subscription, notification), ErrSubscriptionGone)
+	statusCode = http.StatusInternalServerError
+	err = sender.Send(context.Background(), subscription, notification)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrSubscriptionGone)
+}
@@ -0,0 +1,311 @@
+package websub
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/httpgetter"
+)
+
+const (
+	// defaultLease is the lease of the subscriptions without hub.lease_seconds.
+	defaultLease = 24 * time.Hour
+	// maxLease bounds the leases of the subscriptions. The subscriptions are kept in memory, so the subscribers
+	// renewing them daily resubscribe soon after a restart.
+	maxLease = 24 * time.Hour
+	// maxSubscriptions bounds the subscriptions kept by the hub.
+	maxSubscriptions = 1000
+	// maxSecretLength is the maximum length of hub.secret, per the specification.
+	maxSecretLength = 200
+	// maxContentSize bounds the bytes of the topics distributed.
+	maxContentSize = 10 << 20
+	// maxPendingVerifications bounds the verifications of the intents of the subscribers running at once,
+	// as anyone may request subscriptions.
+	maxPendingVerifications = 16
+)
+
+// Hub is an embedded WebSub hub, verifying the subscriptions to the accepted topics and distributing the topics
+// to their subscribers when they are published. The subscriptions are kept in memory.
+type Hub struct {
+	// URL is the URL the hub is served at.
+	URL string
+	// AcceptTopic reports whether the topic can be subscribed to.
+	AcceptTopic func(topicURL string) bool
+	// GetContent fetches the content of the topic, with a GET request if nil.
+	GetContent func(ctx context.Context, topicURL string) (contentType string, content []byte, err error)
+	// CallbackClient sends the requests to the callbacks of the subscribers, which are given by anyone.
+	// It is httpgetter.Client() if nil, refusing the internal addresses.
+	CallbackClient *http.Client
+
+	mutex         sync.Mutex
+	subscriptions map[subscriptionKey]*subscription
+	// verifications holds a slot per verification running.
+	verifications chan struct{}
+}
+
+type subscriptionKey struct {
+	callback string
+	topic    string
+}
+
+type subscription struct {
+	secret     string
+	expireTime time.Time
+}
+
+func NewHub(hubURL string, acceptTopic func(topicURL string) bool) *Hub {
+	return &Hub{
+		URL:           hubURL,
+		AcceptTopic:   acceptTopic,
+		subscriptions: map[subscriptionKey]*subscription{},
+		verifications: make(chan struct{}, maxPendingVerifications),
+	}
+}
+
+// ServeHTTP handles the subscription requests, verifying the intent of the subscribers asynchronously.
+func (h *Hub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form", http.StatusBadRequest)
+		return
+	}
+	mode := r.PostForm.Get("hub.mode")
+	if mode != "subscribe" && mode != "unsubscribe" {
+		http.Error(w, "hub.mode must be subscribe or unsubscribe", http.StatusBadRequest)
+		return
+	}
+	callback, err := url.Parse(r.PostForm.Get("hub.callback"))
+	if err != nil || (callback.Scheme != "http" && callback.Scheme != "https") || callback.Host == "" {
+		http.Error(w, "hub.callback must be an absolute HTTP URL", http.StatusBadRequest)
+		return
+	}
+	topic := r.PostForm.Get("hub.topic")
+	if topic == "" || h.AcceptTopic == nil || !h.AcceptTopic(topic) {
+		http.Error(w, "hub.topic is not a feed of this instance", http.StatusBadRequest)
+		return
+	}
+	secret := r.PostForm.Get("hub.secret")
+	if len(secret) > maxSecretLength {
+		http.Error(w, "hub.secret is too long", http.StatusBadRequest)
+		return
+	}
+	lease := defaultLease
+	if value := r.PostForm.Get("hub.lease_seconds"); value != "" {
+		seconds, err := strconv.Atoi(value)
+		if err != nil || seconds <= 0 {
+			http.Error(w, "invalid hub.lease_seconds", http.StatusBadRequest)
+			return
+		}
+		lease = min(time.Duration(seconds)*time.Second, maxLease)
+	}
+	if mode == "subscribe" && !h.canSubscribe(subscriptionKey{callback: callback.String(), topic: topic}) {
+		http.Error(w, "too many subscriptions", http.StatusServiceUnavailable)
+		return
+	}
+
+	select {
+	case h.verifications <- struct{}{}:
+	default:
+		http.Error(w, "too many pending verifications", http.StatusServiceUnavailable)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	key := subscriptionKey{callback: callback.String(), topic: topic}
+	go func() {
+		defer func() {
+			<-h.verifications
+		}()
+		if err := h.verify(context.WithoutCancel(r.Context()), mode, key, secret, lease); err != nil {
+			slog.Debug("Failed to verify WebSub subscription", "callback", key.callback, "topic", key.topic, "error", err)
+		}
+	}()
+}
+
+// canSubscribe reports whether the subscription can be added or renewed, pruning the expired subscriptions.
+func (h *Hub) canSubscribe(key subscriptionKey) bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.pruneLocked()
+	_, ok := h.subscriptions[key]
+	return ok || len(h.subscriptions) < maxSubscriptions
+}
+
+// verify confirms the intent of the subscriber by echoing a challenge, then adds or removes the subscription.
+func (h *Hub) verify(ctx context.Context, mode string, key subscriptionKey, secret string, lease time.Duration) error {
+	challenge := rand.Text()
+	callback, err := url.Parse(key.callback)
+	if err != nil {
+		return err
+	}
+	query := callback.Query()
+	query.Set("hub.mode", mode)
+	query.Set("hub.topic", key.topic)
+	query.Set("hub.challenge", challenge)
+	if mode == "subscribe" {
+		query.Set("hub.lease_seconds", strconv.Itoa(int(lease.Seconds())))
+	}
+	callback.RawQuery = query.Encode()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, callback.String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := h.callbackClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, int64(len(challenge)+1)))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 || string(body) != challenge {
+		return errors.Errorf("subscriber did not confirm, status %d", resp.StatusCode)
+	}
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if mode == "unsubscribe" {
+		delete(h.subscriptions, key)
+		return nil
+	}
+	h.subscriptions[key] = &subscription{secret: secret, expireTime: time.Now().Add(lease)}
+	return nil
+}
+
+// Publish distributes the topics to their subscribers. The subscriptions to a topic with a query, such as a feed
+// narrowed down by a tag, are notified along with the topic without the query.
+func (h *Hub) Publish(ctx context.Context, topicURLs ...string) error {
+	published := map[string]bool{}
+	for _, topicURL := range topicURLs {
+		published[topicURL] = true
+	}
+	subscribers := map[string]map[string]*subscription{}
+	h.mutex.Lock()
+	h.pruneLocked()
+	for key, value := range h.subscriptions {
+		if !published[key.topic] && !published[stripQuery(key.topic)] {
+			continue
+		}
+		if subscribers[key.topic] == nil {
+			subscribers[key.topic] = map[string]*subscription{}
+		}
+		subscribers[key.topic][key.callback] = value
+	}
+	h.mutex.Unlock()
+
+	for topic, callbacks := range subscribers {
+		contentType, content, err := h.getContent(ctx, topic)
+		if err != nil {
+			slog.Warn("Failed to fetch WebSub topic", "topic", topic, "error", err)
+			continue
+		}
+		for callback, value := range callbacks {
+			if err := h.distribute(ctx, topic, callback, value.secret, contentType, content); err != nil {
+				slog.Debug("Failed to distribute WebSub topic", "topic", topic, "callback", callback, "error", err)
+			}
+		}
+	}
+	return nil
+}
+
+// distribute sends the content of the topic to the subscriber, signed with its secret if any.
+func (h *Hub) distribute(ctx context.Context, topic, callback, secret, contentType string, content []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, callback, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Link", LinkHeader(h.URL, topic))
+	if secret != "" {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(content)
+		req.Header.Set("X-Hub-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+	resp, err := h.callbackClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// The subscribers having removed their callback are unsubscribed, per the specification.
+	if resp.StatusCode == http.StatusGone {
+		h.mutex.Lock()
+		delete(h.subscriptions, subscriptionKey{callback: callback, topic: topic})
+		h.mutex.Unlock()
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("subscriber responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (h *Hub) getContent(ctx context.Context, topic string) (string, []byte, error) {
+	if h.GetContent != nil {
+		return h.GetContent(ctx, topic)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, topic, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "", nil, errors.Errorf("topic responded with status %d", resp.StatusCode)
+	}
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxContentSize))
+	if err != nil {
+		return "", nil, err
+	}
+	return resp.Header.Get("Content-Type"), content, nil
+}
+
+func (h *Hub) callbackClient() *http.Client {
+	if h.CallbackClient != nil {
+		return h.CallbackClient
+	}
+	return httpgetter.Client()
+}
+
+func (h *Hub) pruneLocked() {
+	now := time.Now()
+	for key, subscription := range h.subscriptions {
+		if now.After(subscription.expireTime) {
+			delete(h.subscriptions, key)
+		}
+	}
+}
+
+func stripQuery(topic string) string {
+	u, err := url.Parse(topic)
+	if err != nil {
+		return topic
+	}
+	u.RawQuery = ""
+	return u.String()
+}
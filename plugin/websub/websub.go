@@ -0,0 +1,67 @@
+// Package websub notifies the subscribers of the feeds of their updates with WebSub, see https://www.w3.org/TR/websub/,
+// either by pinging an external hub or with an embedded hub.
+package websub
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// timeout is the timeout of the requests to the hubs, the subscribers and the topics.
+const timeout = 10 * time.Second
+
+// Publisher notifies the subscribers of the topics, the URLs of the feeds, that they are updated.
+type Publisher interface {
+	Publish(ctx context.Context, topicURLs ...string) error
+}
+
+// Client pings an external hub, such as https://pubsubhubbub.appspot.com, which fetches the updated topics
+// and distributes them to their subscribers.
+type Client struct {
+	HubURL string
+}
+
+// Publish pings the hub for each topic, with the "publish" mode of the PubSubHubbub hubs.
+func (c *Client) Publish(ctx context.Context, topicURLs ...string) error {
+	for _, topicURL := range topicURLs {
+		form := url.Values{}
+		form.Set("hub.mode", "publish")
+		form.Set("hub.url", topicURL)
+		if err := c.ping(ctx, form); err != nil {
+			return errors.Wrapf(err, "failed to publish %s", topicURL)
+		}
+	}
+	return nil
+}
+
+func (c *Client) ping(ctx context.Context, form url.Values) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.HubURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return errors.Wrap(err, "failed to construct hub request")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to call hub")
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("hub responded with status %d: %s", resp.StatusCode, body)
+	}
+	return nil
+}
+
+// LinkHeader returns the value of the Link header advertising the hub and the canonical URL of the topic.
+func LinkHeader(hubURL, topicURL string) string {
+	return fmt.Sprintf(`<%s>; rel="hub", <%s>; rel="self"`, hubURL, topicURL)
+}
@@ -0,0 +1,146 @@
+package websub
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/httpgetter"
+)
+
+func TestClientPublish(t *testing.T) {
+	topics := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "publish", r.PostForm.Get("hub.mode"))
+		topics = append(topics, r.PostForm.Get("hub.url"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := &Client{HubURL: server.URL + "/"}
+	require.NoError(t, client.Publish(context.Background(), "https://memos.example.com/explore/rss.xml", "https://memos.example.com/explore/feed.json"))
+	require.Equal(t, []string{"https://memos.example.com/explore/rss.xml", "https://memos.example.com/explore/feed.json"}, topics)
+
+	client = &Client{HubURL: server.URL + "/missing"}
+	require.Error(t, client.Publish(context.Background(), "https://memos.example.com/explore/rss.xml"))
+}
+
+func TestHub(t *testing.T) {
+	const topic = "https://memos.example.com/explore/rss.xml"
+	hub := NewHub("https://memos.example.com/websub", func(topicURL string) bool {
+		return strings.HasPrefix(topicURL, topic)
+	})
+	hub.GetContent = func(_ context.Context, topicURL string) (string, []byte, error) {
+		return "application/rss+xml", []byte("<rss>" + topicURL + "</rss>"), nil
+	}
+
+	// The subscriber confirms the intent of the requests with the challenge, and records the distributed content.
+	var mu sync.Mutex
+	verified := make(chan string, 4)
+	deliveries := []*http.Request{}
+	bodies := []string{}
+	subscriber := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(r.URL.Query().Get("hub.challenge")))
+			verified <- r.URL.Query().Get("hub.mode") + " " + r.URL.Query().Get("hub.topic")
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		mu.Lock()
+		defer mu.Unlock()
+		deliveries = append(deliveries, r)
+		bodies = append(bodies, string(body))
+	}))
+	defer subscriber.Close()
+	// The subscriber of the test listens on the loopback address, refused by the default client.
+	hub.CallbackClient = subscriber.Client()
+	request := func(mode, topicURL, secret string) int {
+		form := url.Values{"hub.mode": {mode}, "hub.callback": {subscriber.URL + "/callback"}, "hub.topic": {topicURL}, "hub.secret": {secret}}
+		recorder := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/websub", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		hub.ServeHTTP(recorder, req)
+		return recorder.Code
+	}
+	waitVerified := func(expected string) {
+		select {
+		case got := <-verified:
+			require.Equal(t, expected, got)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "subscription not verified")
+		}
+		// The subscription is recorded after the response of the subscriber.
+		require.Eventually(t, func() bool {
+			hub.mutex.Lock()
+			defer hub.mutex.Unlock()
+			_, ok := hub.subscriptions[subscriptionKey{callback: subscriber.URL + "/callback", topic: topic + "?tag=go"}]
+			return ok == strings.HasPrefix(expected, "subscribe")
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	// The topics of other sites are refused.
+	require.Equal(t, http.StatusBadRequest, request("subscribe", "https://other.example.com/rss.xml", ""))
+	require.Equal(t, http.StatusAccepted, request("subscribe", topic+"?tag=go", "secret"))
+	waitVerified("subscribe " + topic + "?tag=go")
+
+	// The feeds narrowed down by a query are distributed along with their feed, signed with the secret.
+	require.NoError(t, hub.Publish(context.Background(), topic))
+	require.Len(t, deliveries, 1)
+	require.Equal(t, "<rss>"+topic+"?tag=go</rss>", bodies[0])
+	require.Equal(t, "application/rss+xml", deliveries[0].Header.Get("Content-Type"))
+	require.Equal(t, `<https://memos.example.com/websub>; rel="hub", <`+topic+`?tag=go>; rel="self"`, deliveries[0].Header.Get("Link"))
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte(bodies[0]))
+	require.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), deliveries[0].Header.Get("X-Hub-Signature"))
+	require.NoError(t, hub.Publish(context.Background(), "https://memos.example.com/explore/feed.json"))
+	require.Len(t, deliveries, 1)
+
+	require.Equal(t, http.StatusAccepted, request("unsubscribe", topic+"?tag=go", ""))
+	waitVerified("unsubscribe " + topic + "?tag=go")
+	require.NoError(t, hub.Publish(context.Background(), topic))
+	require.Len(t, deliveries, 1)
+}
+
+func TestHubRefusesInternalCallbacks(t *testing.T) {
+	const topic = "https://memos.example.com/explore/rss.xml"
+	hub := NewHub("https://memos.example.com/websub", func(topicURL string) bool {
+		return topicURL == topic
+	})
+	requested := false
+	subscriber := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		requested = true
+	}))
+	defer subscriber.Close()
+
+	err := hub.verify(context.Background(), "subscribe", subscriptionKey{callback: subscriber.URL, topic: topic}, "", time.Hour)
+	require.ErrorIs(t, err, httpgetter.ErrInternalIP)
+	require.False(t, requested)
+
+	// The requests are refused while the verifications running are at their limit.
+	for i := 0; i < maxPendingVerifications; i++ {
+		hub.verifications <- struct{}{}
+	}
+	form := url.Values{"hub.mode": {"subscribe"}, "hub.callback": {"https://subscriber.example.com/callback"}, "hub.topic": {topic}}
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/websub", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	hub.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+}
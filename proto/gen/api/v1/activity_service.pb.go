@@ -33,6 +33,16 @@ const (
 	Activity_MEMO_COMMENT Activity_Type = 1
 	// Version update activity.
 	Activity_VERSION_UPDATE Activity_Type = 2
+	// The host started to impersonate a user.
+	Activity_USER_IMPERSONATION_START Activity_Type = 3
+	// The host stopped to impersonate a user.
+	Activity_USER_IMPERSONATION_END Activity_Type = 4
+	// A user account was deleted with its data.
+	Activity_USER_DELETE Activity_Type = 5
+	// Memo mention activity.
+	Activity_MEMO_MENTION Activity_Type = 6
+	// Memo reaction activity.
+	Activity_MEMO_REACTION Activity_Type = 7
 )
 
 // Enum value maps for Activity_Type.
@@ -41,11 +51,21 @@ var (
 		0: "TYPE_UNSPECIFIED",
 		1: "MEMO_COMMENT",
 		2: "VERSION_UPDATE",
+		3: "USER_IMPERSONATION_START",
+		4: "USER_IMPERSONATION_END",
+		5: "USER_DELETE",
+		6: "MEMO_MENTION",
+		7: "MEMO_REACTION",
 	}
 	Activity_Type_value = map[string]int32{
-		"TYPE_UNSPECIFIED": 0,
-		"MEMO_COMMENT":     1,
-		"VERSION_UPDATE":   2,
+		"TYPE_UNSPECIFIED":         0,
+		"MEMO_COMMENT":             1,
+		"VERSION_UPDATE":           2,
+		"USER_IMPERSONATION_START": 3,
+		"USER_IMPERSONATION_END":   4,
+		"USER_DELETE":              5,
+		"MEMO_MENTION":             6,
+		"MEMO_REACTION":            7,
 	}
 )
 
@@ -230,6 +250,10 @@ type ActivityPayload struct {
 	// Types that are valid to be assigned to Payload:
 	//
 	//	*ActivityPayload_MemoComment
+	//	*ActivityPayload_UserImpersonation
+	//	*ActivityPayload_UserDelete
+	//	*ActivityPayload_MemoMention
+	//	*ActivityPayload_MemoReaction
 	Payload       isActivityPayload_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -281,6 +305,42 @@ func (x *ActivityPayload) GetMemoComment() *ActivityMemoCommentPayload {
 	return nil
 }
 
+func (x *ActivityPayload) GetUserImpersonation() *ActivityUserImpersonationPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*ActivityPayload_UserImpersonation); ok {
+			return x.UserImpersonation
+		}
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetUserDelete() *ActivityUserDeletePayload {
+	if x != nil {
+		if x, ok := x.Payload.(*ActivityPayload_UserDelete); ok {
+			return x.UserDelete
+		}
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoMention() *ActivityMemoMentionPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*ActivityPayload_MemoMention); ok {
+			return x.MemoMention
+		}
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoReaction() *ActivityMemoReactionPayload {
+	if x != nil {
+		if x, ok := x.Payload.(*ActivityPayload_MemoReaction); ok {
+			return x.MemoReaction
+		}
+	}
+	return nil
+}
+
 type isActivityPayload_Payload interface {
 	isActivityPayload_Payload()
 }
@@ -290,8 +350,166 @@ type ActivityPayload_MemoComment struct {
 	MemoComment *ActivityMemoCommentPayload `protobuf:"bytes,1,opt,name=memo_comment,json=memoComment,proto3,oneof"`
 }
 
+type ActivityPayload_UserImpersonation struct {
+	// User impersonation activity payload.
+	UserImpersonation *ActivityUserImpersonationPayload `protobuf:"bytes,2,opt,name=user_impersonation,json=userImpersonation,proto3,oneof"`
+}
+
+type ActivityPayload_UserDelete struct {
+	// User delete activity payload.
+	UserDelete *ActivityUserDeletePayload `protobuf:"bytes,3,opt,name=user_delete,json=userDelete,proto3,oneof"`
+}
+
+type ActivityPayload_MemoMention struct {
+	// Memo mention activity payload.
+	MemoMention *ActivityMemoMentionPayload `protobuf:"bytes,4,opt,name=memo_mention,json=memoMention,proto3,oneof"`
+}
+
+type ActivityPayload_MemoReaction struct {
+	// Memo reaction activity payload.
+	MemoReaction *ActivityMemoReactionPayload `protobuf:"bytes,5,opt,name=memo_reaction,json=memoReaction,proto3,oneof"`
+}
+
 func (*ActivityPayload_MemoComment) isActivityPayload_Payload() {}
 
+func (*ActivityPayload_UserImpersonation) isActivityPayload_Payload() {}
+
+func (*ActivityPayload_UserDelete) isActivityPayload_Payload() {}
+
+func (*ActivityPayload_MemoMention) isActivityPayload_Payload() {}
+
+func (*ActivityPayload_MemoReaction) isActivityPayload_Payload() {}
+
+// ActivityUserDeletePayload represents the payload of a user delete activity.
+type ActivityUserDeletePayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the deleted user.
+	// Format: users/{user}
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// The number of memos deleted with the user.
+	MemoCount int32 `protobuf:"varint,2,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
+	// The number of attachments deleted with the user.
+	AttachmentCount int32 `protobuf:"varint,3,opt,name=attachment_count,json=attachmentCount,proto3" json:"attachment_count,omitempty"`
+	// The number of reactions deleted with the user.
+	ReactionCount int32 `protobuf:"varint,4,opt,name=reaction_count,json=reactionCount,proto3" json:"reaction_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityUserDeletePayload) Reset() {
+	*x = ActivityUserDeletePayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityUserDeletePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityUserDeletePayload) ProtoMessage() {}
+
+func (x *ActivityUserDeletePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityUserDeletePayload.ProtoReflect.Descriptor instead.
+func (*ActivityUserDeletePayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ActivityUserDeletePayload) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ActivityUserDeletePayload) GetMemoCount() int32 {
+	if x != nil {
+		return x.MemoCount
+	}
+	return 0
+}
+
+func (x *ActivityUserDeletePayload) GetAttachmentCount() int32 {
+	if x != nil {
+		return x.AttachmentCount
+	}
+	return 0
+}
+
+func (x *ActivityUserDeletePayload) GetReactionCount() int32 {
+	if x != nil {
+		return x.ReactionCount
+	}
+	return 0
+}
+
+// ActivityUserImpersonationPayload represents the payload of a user impersonation activity.
+type ActivityUserImpersonationPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the impersonated user.
+	// Format: users/{user}
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// The expiration of the impersonation session.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityUserImpersonationPayload) Reset() {
+	*x = ActivityUserImpersonationPayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityUserImpersonationPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityUserImpersonationPayload) ProtoMessage() {}
+
+func (x *ActivityUserImpersonationPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityUserImpersonationPayload.ProtoReflect.Descriptor instead.
+func (*ActivityUserImpersonationPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ActivityUserImpersonationPayload) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ActivityUserImpersonationPayload) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
 // ActivityMemoCommentPayload represents the payload of a memo comment activity.
 type ActivityMemoCommentPayload struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -307,7 +525,7 @@ type ActivityMemoCommentPayload struct {
 
 func (x *ActivityMemoCommentPayload) Reset() {
 	*x = ActivityMemoCommentPayload{}
-	mi := &file_api_v1_activity_service_proto_msgTypes[2]
+	mi := &file_api_v1_activity_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -319,7 +537,7 @@ func (x *ActivityMemoCommentPayload) String() string {
 func (*ActivityMemoCommentPayload) ProtoMessage() {}
 
 func (x *ActivityMemoCommentPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_activity_service_proto_msgTypes[2]
+	mi := &file_api_v1_activity_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -332,7 +550,7 @@ func (x *ActivityMemoCommentPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActivityMemoCommentPayload.ProtoReflect.Descriptor instead.
 func (*ActivityMemoCommentPayload) Descriptor() ([]byte, []int) {
-	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{2}
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ActivityMemoCommentPayload) GetMemo() string {
@@ -349,6 +567,109 @@ func (x *ActivityMemoCommentPayload) GetRelatedMemo() string {
 	return ""
 }
 
+// ActivityMemoMentionPayload represents the payload of a memo mention activity.
+type ActivityMemoMentionPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo mentioning the user.
+	// Format: memos/{memo}
+	Memo          string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoMentionPayload) Reset() {
+	*x = ActivityMemoMentionPayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoMentionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoMentionPayload) ProtoMessage() {}
+
+func (x *ActivityMemoMentionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoMentionPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoMentionPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ActivityMemoMentionPayload) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+// ActivityMemoReactionPayload represents the payload of a memo reaction activity.
+type ActivityMemoReactionPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the memo reacted to.
+	// Format: memos/{memo}
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The type of the reaction, e.g. "👍".
+	ReactionType  string `protobuf:"bytes,2,opt,name=reaction_type,json=reactionType,proto3" json:"reaction_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoReactionPayload) Reset() {
+	*x = ActivityMemoReactionPayload{}
+	mi := &file_api_v1_activity_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoReactionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoReactionPayload) ProtoMessage() {}
+
+func (x *ActivityMemoReactionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_activity_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoReactionPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoReactionPayload) Descriptor() ([]byte, []int) {
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ActivityMemoReactionPayload) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *ActivityMemoReactionPayload) GetReactionType() string {
+	if x != nil {
+		return x.ReactionType
+	}
+	return ""
+}
+
 type ListActivitiesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The maximum number of activities to return.
@@ -365,7 +686,7 @@ type ListActivitiesRequest struct {
 
 func (x *ListActivitiesRequest) Reset() {
 	*x = ListActivitiesRequest{}
-	mi := &file_api_v1_activity_service_proto_msgTypes[3]
+	mi := &file_api_v1_activity_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -377,7 +698,7 @@ func (x *ListActivitiesRequest) String() string {
 func (*ListActivitiesRequest) ProtoMessage() {}
 
 func (x *ListActivitiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_activity_service_proto_msgTypes[3]
+	mi := &file_api_v1_activity_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -390,7 +711,7 @@ func (x *ListActivitiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListActivitiesRequest.ProtoReflect.Descriptor instead.
 func (*ListActivitiesRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{3}
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListActivitiesRequest) GetPageSize() int32 {
@@ -421,7 +742,7 @@ type ListActivitiesResponse struct {
 
 func (x *ListActivitiesResponse) Reset() {
 	*x = ListActivitiesResponse{}
-	mi := &file_api_v1_activity_service_proto_msgTypes[4]
+	mi := &file_api_v1_activity_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -433,7 +754,7 @@ func (x *ListActivitiesResponse) String() string {
 func (*ListActivitiesResponse) ProtoMessage() {}
 
 func (x *ListActivitiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_activity_service_proto_msgTypes[4]
+	mi := &file_api_v1_activity_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -446,7 +767,7 @@ func (x *ListActivitiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListActivitiesResponse.ProtoReflect.Descriptor instead.
 func (*ListActivitiesResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{4}
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListActivitiesResponse) GetActivities() []*Activity {
@@ -474,7 +795,7 @@ type GetActivityRequest struct {
 
 func (x *GetActivityRequest) Reset() {
 	*x = GetActivityRequest{}
-	mi := &file_api_v1_activity_service_proto_msgTypes[5]
+	mi := &file_api_v1_activity_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -486,7 +807,7 @@ func (x *GetActivityRequest) String() string {
 func (*GetActivityRequest) ProtoMessage() {}
 
 func (x *GetActivityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_activity_service_proto_msgTypes[5]
+	mi := &file_api_v1_activity_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -499,7 +820,7 @@ func (x *GetActivityRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetActivityRequest.ProtoReflect.Descriptor instead.
 func (*GetActivityRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{5}
+	return file_api_v1_activity_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetActivityRequest) GetName() string {
@@ -513,7 +834,7 @@ var File_api_v1_activity_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_activity_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1dapi/v1/activity_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x86\x04\n" +
+	"\x1dapi/v1/activity_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf7\x04\n" +
 	"\bActivity\x12\x1a\n" +
 	"\x04name\x18\x01 \x01(\tB\x06\xe0A\x03\xe0A\bR\x04name\x12\x1d\n" +
 	"\acreator\x18\x02 \x01(\tB\x03\xe0A\x03R\acreator\x124\n" +
@@ -521,24 +842,49 @@ const file_api_v1_activity_service_proto_rawDesc = "" +
 	"\x05level\x18\x04 \x01(\x0e2\x1c.memos.api.v1.Activity.LevelB\x03\xe0A\x03R\x05level\x12@\n" +
 	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime\x12<\n" +
-	"\apayload\x18\x06 \x01(\v2\x1d.memos.api.v1.ActivityPayloadB\x03\xe0A\x03R\apayload\"B\n" +
+	"\apayload\x18\x06 \x01(\v2\x1d.memos.api.v1.ActivityPayloadB\x03\xe0A\x03R\apayload\"\xb2\x01\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
 	"\fMEMO_COMMENT\x10\x01\x12\x12\n" +
-	"\x0eVERSION_UPDATE\x10\x02\"=\n" +
+	"\x0eVERSION_UPDATE\x10\x02\x12\x1c\n" +
+	"\x18USER_IMPERSONATION_START\x10\x03\x12\x1a\n" +
+	"\x16USER_IMPERSONATION_END\x10\x04\x12\x0f\n" +
+	"\vUSER_DELETE\x10\x05\x12\x10\n" +
+	"\fMEMO_MENTION\x10\x06\x12\x11\n" +
+	"\rMEMO_REACTION\x10\a\"=\n" +
 	"\x05Level\x12\x15\n" +
 	"\x11LEVEL_UNSPECIFIED\x10\x00\x12\b\n" +
 	"\x04INFO\x10\x01\x12\b\n" +
 	"\x04WARN\x10\x02\x12\t\n" +
 	"\x05ERROR\x10\x03:M\xeaAJ\n" +
 	"\x15memos.api.v1/Activity\x12\x15activities/{activity}\x1a\x04name*\n" +
-	"activities2\bactivity\"k\n" +
+	"activities2\bactivity\"\xb9\x03\n" +
 	"\x0fActivityPayload\x12M\n" +
-	"\fmemo_comment\x18\x01 \x01(\v2(.memos.api.v1.ActivityMemoCommentPayloadH\x00R\vmemoCommentB\t\n" +
-	"\apayload\"S\n" +
+	"\fmemo_comment\x18\x01 \x01(\v2(.memos.api.v1.ActivityMemoCommentPayloadH\x00R\vmemoComment\x12_\n" +
+	"\x12user_impersonation\x18\x02 \x01(\v2..memos.api.v1.ActivityUserImpersonationPayloadH\x00R\x11userImpersonation\x12J\n" +
+	"\vuser_delete\x18\x03 \x01(\v2'.memos.api.v1.ActivityUserDeletePayloadH\x00R\n" +
+	"userDelete\x12M\n" +
+	"\fmemo_mention\x18\x04 \x01(\v2(.memos.api.v1.ActivityMemoMentionPayloadH\x00R\vmemoMention\x12P\n" +
+	"\rmemo_reaction\x18\x05 \x01(\v2).memos.api.v1.ActivityMemoReactionPayloadH\x00R\fmemoReactionB\t\n" +
+	"\apayload\"\xa0\x01\n" +
+	"\x19ActivityUserDeletePayload\x12\x12\n" +
+	"\x04user\x18\x01 \x01(\tR\x04user\x12\x1d\n" +
+	"\n" +
+	"memo_count\x18\x02 \x01(\x05R\tmemoCount\x12)\n" +
+	"\x10attachment_count\x18\x03 \x01(\x05R\x0fattachmentCount\x12%\n" +
+	"\x0ereaction_count\x18\x04 \x01(\x05R\rreactionCount\"s\n" +
+	" ActivityUserImpersonationPayload\x12\x12\n" +
+	"\x04user\x18\x01 \x01(\tR\x04user\x12;\n" +
+	"\vexpire_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\"S\n" +
 	"\x1aActivityMemoCommentPayload\x12\x12\n" +
 	"\x04memo\x18\x01 \x01(\tR\x04memo\x12!\n" +
-	"\frelated_memo\x18\x02 \x01(\tR\vrelatedMemo\"S\n" +
+	"\frelated_memo\x18\x02 \x01(\tR\vrelatedMemo\"0\n" +
+	"\x1aActivityMemoMentionPayload\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\"V\n" +
+	"\x1bActivityMemoReactionPayload\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\x12#\n" +
+	"\rreaction_type\x18\x02 \x01(\tR\freactionType\"S\n" +
 	"\x15ListActivitiesRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
@@ -569,34 +915,43 @@ func file_api_v1_activity_service_proto_rawDescGZIP() []byte {
 }
 
 var file_api_v1_activity_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_activity_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_v1_activity_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_api_v1_activity_service_proto_goTypes = []any{
-	(Activity_Type)(0),                 // 0: memos.api.v1.Activity.Type
-	(Activity_Level)(0),                // 1: memos.api.v1.Activity.Level
-	(*Activity)(nil),                   // 2: memos.api.v1.Activity
-	(*ActivityPayload)(nil),            // 3: memos.api.v1.ActivityPayload
-	(*ActivityMemoCommentPayload)(nil), // 4: memos.api.v1.ActivityMemoCommentPayload
-	(*ListActivitiesRequest)(nil),      // 5: memos.api.v1.ListActivitiesRequest
-	(*ListActivitiesResponse)(nil),     // 6: memos.api.v1.ListActivitiesResponse
-	(*GetActivityRequest)(nil),         // 7: memos.api.v1.GetActivityRequest
-	(*timestamppb.Timestamp)(nil),      // 8: google.protobuf.Timestamp
+	(Activity_Type)(0),                       // 0: memos.api.v1.Activity.Type
+	(Activity_Level)(0),                      // 1: memos.api.v1.Activity.Level
+	(*Activity)(nil),                         // 2: memos.api.v1.Activity
+	(*ActivityPayload)(nil),                  // 3: memos.api.v1.ActivityPayload
+	(*ActivityUserDeletePayload)(nil),        // 4: memos.api.v1.ActivityUserDeletePayload
+	(*ActivityUserImpersonationPayload)(nil), // 5: memos.api.v1.ActivityUserImpersonationPayload
+	(*ActivityMemoCommentPayload)(nil),       // 6: memos.api.v1.ActivityMemoCommentPayload
+	(*ActivityMemoMentionPayload)(nil),       // 7: memos.api.v1.ActivityMemoMentionPayload
+	(*ActivityMemoReactionPayload)(nil),      // 8: memos.api.v1.ActivityMemoReactionPayload
+	(*ListActivitiesRequest)(nil),            // 9: memos.api.v1.ListActivitiesRequest
+	(*ListActivitiesResponse)(nil),           // 10: memos.api.v1.ListActivitiesResponse
+	(*GetActivityRequest)(nil),               // 11: memos.api.v1.GetActivityRequest
+	(*timestamppb.Timestamp)(nil),            // 12: google.protobuf.Timestamp
 }
 var file_api_v1_activity_service_proto_depIdxs = []int32{
-	0, // 0: memos.api.v1.Activity.type:type_name -> memos.api.v1.Activity.Type
-	1, // 1: memos.api.v1.Activity.level:type_name -> memos.api.v1.Activity.Level
-	8, // 2: memos.api.v1.Activity.create_time:type_name -> google.protobuf.Timestamp
-	3, // 3: memos.api.v1.Activity.payload:type_name -> memos.api.v1.ActivityPayload
-	4, // 4: memos.api.v1.ActivityPayload.memo_comment:type_name -> memos.api.v1.ActivityMemoCommentPayload
-	2, // 5: memos.api.v1.ListActivitiesResponse.activities:type_name -> memos.api.v1.Activity
-	5, // 6: memos.api.v1.ActivityService.ListActivities:input_type -> memos.api.v1.ListActivitiesRequest
-	7, // 7: memos.api.v1.ActivityService.GetActivity:input_type -> memos.api.v1.GetActivityRequest
-	6, // 8: memos.api.v1.ActivityService.ListActivities:output_type -> memos.api.v1.ListActivitiesResponse
-	2, // 9: memos.api.v1.ActivityService.GetActivity:output_type -> memos.api.v1.Activity
-	8, // [8:10] is the sub-list for method output_type
-	6, // [6:8] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	0,  // 0: memos.api.v1.Activity.type:type_name -> memos.api.v1.Activity.Type
+	1,  // 1: memos.api.v1.Activity.level:type_name -> memos.api.v1.Activity.Level
+	12, // 2: memos.api.v1.Activity.create_time:type_name -> google.protobuf.Timestamp
+	3,  // 3: memos.api.v1.Activity.payload:type_name -> memos.api.v1.ActivityPayload
+	6,  // 4: memos.api.v1.ActivityPayload.memo_comment:type_name -> memos.api.v1.ActivityMemoCommentPayload
+	5,  // 5: memos.api.v1.ActivityPayload.user_impersonation:type_name -> memos.api.v1.ActivityUserImpersonationPayload
+	4,  // 6: memos.api.v1.ActivityPayload.user_delete:type_name -> memos.api.v1.ActivityUserDeletePayload
+	7,  // 7: memos.api.v1.ActivityPayload.memo_mention:type_name -> memos.api.v1.ActivityMemoMentionPayload
+	8,  // 8: memos.api.v1.ActivityPayload.memo_reaction:type_name -> memos.api.v1.ActivityMemoReactionPayload
+	12, // 9: memos.api.v1.ActivityUserImpersonationPayload.expire_time:type_name -> google.protobuf.Timestamp
+	2,  // 10: memos.api.v1.ListActivitiesResponse.activities:type_name -> memos.api.v1.Activity
+	9,  // 11: memos.api.v1.ActivityService.ListActivities:input_type -> memos.api.v1.ListActivitiesRequest
+	11, // 12: memos.api.v1.ActivityService.GetActivity:input_type -> memos.api.v1.GetActivityRequest
+	10, // 13: memos.api.v1.ActivityService.ListActivities:output_type -> memos.api.v1.ListActivitiesResponse
+	2,  // 14: memos.api.v1.ActivityService.GetActivity:output_type -> memos.api.v1.Activity
+	13, // [13:15] is the sub-list for method output_type
+	11, // [11:13] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_activity_service_proto_init() }
@@ -606,6 +961,10 @@ func file_api_v1_activity_service_proto_init() {
 	}
 	file_api_v1_activity_service_proto_msgTypes[1].OneofWrappers = []any{
 		(*ActivityPayload_MemoComment)(nil),
+		(*ActivityPayload_UserImpersonation)(nil),
+		(*ActivityPayload_UserDelete)(nil),
+		(*ActivityPayload_MemoMention)(nil),
+		(*ActivityPayload_MemoReaction)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -613,7 +972,7 @@ func file_api_v1_activity_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_activity_service_proto_rawDesc), len(file_api_v1_activity_service_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   6,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
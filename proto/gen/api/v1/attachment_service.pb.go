@@ -26,6 +26,60 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type AttachmentStorageMigration_State int32
+
+const (
+	AttachmentStorageMigration_STATE_UNSPECIFIED AttachmentStorageMigration_State = 0
+	AttachmentStorageMigration_RUNNING           AttachmentStorageMigration_State = 1
+	// COMPLETED is the state of the migrations run to the end, even if some attachments failed to migrate.
+	AttachmentStorageMigration_COMPLETED AttachmentStorageMigration_State = 2
+	// FAILED is the state of the migrations stopped by an error.
+	AttachmentStorageMigration_FAILED AttachmentStorageMigration_State = 3
+)
+
+// Enum value maps for AttachmentStorageMigration_State.
+var (
+	AttachmentStorageMigration_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "RUNNING",
+		2: "COMPLETED",
+		3: "FAILED",
+	}
+	AttachmentStorageMigration_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"RUNNING":           1,
+		"COMPLETED":         2,
+		"FAILED":            3,
+	}
+)
+
+func (x AttachmentStorageMigration_State) Enum() *AttachmentStorageMigration_State {
+	p := new(AttachmentStorageMigration_State)
+	*p = x
+	return p
+}
+
+func (x AttachmentStorageMigration_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttachmentStorageMigration_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_attachment_service_proto_enumTypes[0].Descriptor()
+}
+
+func (AttachmentStorageMigration_State) Type() protoreflect.EnumType {
+	return &file_api_v1_attachment_service_proto_enumTypes[0]
+}
+
+func (x AttachmentStorageMigration_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttachmentStorageMigration_State.Descriptor instead.
+func (AttachmentStorageMigration_State) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{21, 0}
+}
+
 type Attachment struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the attachment.
@@ -45,7 +99,13 @@ type Attachment struct {
 	Size int64 `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
 	// Optional. The related memo. Refer to `Memo.name`.
 	// Format: memos/{memo}
-	Memo          *string `protobuf:"bytes,8,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	Memo *string `protobuf:"bytes,8,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	// Output only. The link of the poster frame image of a video attachment.
+	// Empty if the server does not extract poster frames.
+	PosterLink string `protobuf:"bytes,9,opt,name=poster_link,json=posterLink,proto3" json:"poster_link,omitempty"`
+	// Output only. The link of the preview image of the first page of a PDF attachment.
+	// Empty if the server does not render previews.
+	PreviewLink   string `protobuf:"bytes,10,opt,name=preview_link,json=previewLink,proto3" json:"preview_link,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -136,6 +196,20 @@ func (x *Attachment) GetMemo() string {
 	return ""
 }
 
+func (x *Attachment) GetPosterLink() string {
+	if x != nil {
+		return x.PosterLink
+	}
+	return ""
+}
+
+func (x *Attachment) GetPreviewLink() string {
+	if x != nil {
+		return x.PreviewLink
+	}
+	return ""
+}
+
 type CreateAttachmentRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The attachment to create.
@@ -388,8 +462,17 @@ type GetAttachmentBinaryRequest struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// The filename of the attachment. Mainly used for downloading.
 	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
-	// Optional. A flag indicating if the thumbnail version of the attachment should be returned.
-	Thumbnail     bool `protobuf:"varint,3,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	// Optional. The size of the thumbnail to return instead of an image attachment: small, medium or large.
+	// "true" returns the medium thumbnail.
+	Thumbnail string `protobuf:"bytes,3,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	// Optional. A flag indicating if the poster frame image of a video attachment should be returned.
+	Poster bool `protobuf:"varint,4,opt,name=poster,proto3" json:"poster,omitempty"`
+	// Optional. A flag indicating if the preview image of the first page of a PDF attachment should be returned.
+	Preview bool `protobuf:"varint,5,opt,name=preview,proto3" json:"preview,omitempty"`
+	// Optional. The token of a share link of the memo of the attachment, which grants access to its attachments.
+	ShareToken string `protobuf:"bytes,6,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	// Optional. The password of the share link, if it requires one.
+	SharePassword string `protobuf:"bytes,7,opt,name=share_password,json=sharePassword,proto3" json:"share_password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -438,13 +521,41 @@ func (x *GetAttachmentBinaryRequest) GetFilename() string {
 	return ""
 }
 
-func (x *GetAttachmentBinaryRequest) GetThumbnail() bool {
+func (x *GetAttachmentBinaryRequest) GetThumbnail() string {
 	if x != nil {
 		return x.Thumbnail
 	}
+	return ""
+}
+
+func (x *GetAttachmentBinaryRequest) GetPoster() bool {
+	if x != nil {
+		return x.Poster
+	}
+	return false
+}
+
+func (x *GetAttachmentBinaryRequest) GetPreview() bool {
+	if x != nil {
+		return x.Preview
+	}
 	return false
 }
 
+func (x *GetAttachmentBinaryRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+func (x *GetAttachmentBinaryRequest) GetSharePassword() string {
+	if x != nil {
+		return x.SharePassword
+	}
+	return ""
+}
+
 type UpdateAttachmentRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The attachment which replaces the attachment on the server.
@@ -545,58 +656,1058 @@ func (x *DeleteAttachmentRequest) GetName() string {
 	return ""
 }
 
-var File_api_v1_attachment_service_proto protoreflect.FileDescriptor
+// AttachmentVersion is a previous content of an attachment.
+type AttachmentVersion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the version.
+	// Format: attachments/{attachment}/versions/{version}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Output only. The time the content was replaced.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. The filename of the attachment with the content.
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Output only. The MIME type of the content.
+	Type string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	// Output only. The size of the content in bytes.
+	Size          int64 `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_api_v1_attachment_service_proto_rawDesc = "" +
-	"\n" +
-	"\x1fapi/v1/attachment_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/httpbody.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfb\x02\n" +
-	"\n" +
-	"Attachment\x12\x17\n" +
-	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12@\n" +
-	"\vcreate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
-	"createTime\x12\x1f\n" +
-	"\bfilename\x18\x03 \x01(\tB\x03\xe0A\x02R\bfilename\x12\x1d\n" +
-	"\acontent\x18\x04 \x01(\fB\x03\xe0A\x04R\acontent\x12(\n" +
-	"\rexternal_link\x18\x05 \x01(\tB\x03\xe0A\x01R\fexternalLink\x12\x17\n" +
-	"\x04type\x18\x06 \x01(\tB\x03\xe0A\x02R\x04type\x12\x17\n" +
-	"\x04size\x18\a \x01(\x03B\x03\xe0A\x03R\x04size\x12\x1c\n" +
-	"\x04memo\x18\b \x01(\tB\x03\xe0A\x01H\x00R\x04memo\x88\x01\x01:O\xeaAL\n" +
-	"\x17memos.api.v1/Attachment\x12\x18attachments/{attachment}*\vattachments2\n" +
-	"attachmentB\a\n" +
-	"\x05_memo\"\x82\x01\n" +
-	"\x17CreateAttachmentRequest\x12=\n" +
-	"\n" +
-	"attachment\x18\x01 \x01(\v2\x18.memos.api.v1.AttachmentB\x03\xe0A\x02R\n" +
-	"attachment\x12(\n" +
-	"\rattachment_id\x18\x02 \x01(\tB\x03\xe0A\x01R\fattachmentId\"\x9b\x01\n" +
-	"\x16ListAttachmentsRequest\x12 \n" +
-	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
-	"\n" +
-	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\x12\x1b\n" +
-	"\x06filter\x18\x03 \x01(\tB\x03\xe0A\x01R\x06filter\x12\x1e\n" +
-	"\border_by\x18\x04 \x01(\tB\x03\xe0A\x01R\aorderBy\"\x9c\x01\n" +
-	"\x17ListAttachmentsResponse\x12:\n" +
-	"\vattachments\x18\x01 \x03(\v2\x18.memos.api.v1.AttachmentR\vattachments\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
-	"\n" +
-	"total_size\x18\x03 \x01(\x05R\ttotalSize\"K\n" +
-	"\x14GetAttachmentRequest\x123\n" +
-	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
-	"\x17memos.api.v1/AttachmentR\x04name\"\x95\x01\n" +
-	"\x1aGetAttachmentBinaryRequest\x123\n" +
-	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
-	"\x17memos.api.v1/AttachmentR\x04name\x12\x1f\n" +
-	"\bfilename\x18\x02 \x01(\tB\x03\xe0A\x02R\bfilename\x12!\n" +
-	"\tthumbnail\x18\x03 \x01(\bB\x03\xe0A\x01R\tthumbnail\"\x9a\x01\n" +
-	"\x17UpdateAttachmentRequest\x12=\n" +
-	"\n" +
-	"attachment\x18\x01 \x01(\v2\x18.memos.api.v1.AttachmentB\x03\xe0A\x02R\n" +
-	"attachment\x12@\n" +
-	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
-	"updateMask\"N\n" +
-	"\x17DeleteAttachmentRequest\x123\n" +
-	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
-	"\x17memos.api.v1/AttachmentR\x04name2\xe5\x06\n" +
+func (x *AttachmentVersion) Reset() {
+	*x = AttachmentVersion{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentVersion) ProtoMessage() {}
+
+func (x *AttachmentVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentVersion.ProtoReflect.Descriptor instead.
+func (*AttachmentVersion) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AttachmentVersion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AttachmentVersion) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *AttachmentVersion) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *AttachmentVersion) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AttachmentVersion) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ReplaceAttachmentContentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The attachment name of the attachment to replace the content of.
+	// Format: attachments/{attachment}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The new content of the attachment.
+	Content []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// Optional. The new filename of the attachment. The filename is kept if empty.
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Optional. The MIME type of the new content. The type is kept if empty.
+	Type          string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceAttachmentContentRequest) Reset() {
+	*x = ReplaceAttachmentContentRequest{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceAttachmentContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceAttachmentContentRequest) ProtoMessage() {}
+
+func (x *ReplaceAttachmentContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceAttachmentContentRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceAttachmentContentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReplaceAttachmentContentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReplaceAttachmentContentRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ReplaceAttachmentContentRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ReplaceAttachmentContentRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type ListAttachmentVersionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The attachment name of the attachment.
+	// Format: attachments/{attachment}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentVersionsRequest) Reset() {
+	*x = ListAttachmentVersionsRequest{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentVersionsRequest) ProtoMessage() {}
+
+func (x *ListAttachmentVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttachmentVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListAttachmentVersionsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListAttachmentVersionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The versions of the attachment, the latest first.
+	Versions      []*AttachmentVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentVersionsResponse) Reset() {
+	*x = ListAttachmentVersionsResponse{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentVersionsResponse) ProtoMessage() {}
+
+func (x *ListAttachmentVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttachmentVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListAttachmentVersionsResponse) GetVersions() []*AttachmentVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type RestoreAttachmentVersionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The name of the version to restore.
+	// Format: attachments/{attachment}/versions/{version}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreAttachmentVersionRequest) Reset() {
+	*x = RestoreAttachmentVersionRequest{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreAttachmentVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreAttachmentVersionRequest) ProtoMessage() {}
+
+func (x *RestoreAttachmentVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreAttachmentVersionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreAttachmentVersionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RestoreAttachmentVersionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// AttachmentUpload is a resumable upload of an attachment.
+// Its name matches the name of the attachment it creates, e.g. attachmentUploads/abc creates attachments/abc.
+type AttachmentUpload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the upload.
+	// Format: attachmentUploads/{attachment_upload}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The attachment to create, without its content.
+	// Once the upload is done, the created attachment.
+	Attachment *Attachment `protobuf:"bytes,2,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	// Required. The size of the content in bytes.
+	Size int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// Output only. The number of bytes received, from which the upload resumes.
+	Offset int64 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Output only. Whether all the content was received and the attachment created.
+	Done bool `protobuf:"varint,5,opt,name=done,proto3" json:"done,omitempty"`
+	// Output only. The time after which an unfinished upload is discarded.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// Output only. The presigned URL of a presigned upload, to which the content is put directly,
+	// with the type of the attachment as Content-Type and the size as Content-Length.
+	UploadUrl     string `protobuf:"bytes,7,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentUpload) Reset() {
+	*x = AttachmentUpload{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentUpload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentUpload) ProtoMessage() {}
+
+func (x *AttachmentUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentUpload.ProtoReflect.Descriptor instead.
+func (*AttachmentUpload) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AttachmentUpload) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AttachmentUpload) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *AttachmentUpload) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *AttachmentUpload) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *AttachmentUpload) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *AttachmentUpload) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *AttachmentUpload) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+type CreateAttachmentUploadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The upload to start.
+	AttachmentUpload *AttachmentUpload `protobuf:"bytes,1,opt,name=attachment_upload,json=attachmentUpload,proto3" json:"attachment_upload,omitempty"`
+	// Optional. The attachment ID to use for the attachment and the upload.
+	// If empty, a unique ID will be generated.
+	AttachmentId string `protobuf:"bytes,2,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	// Optional. Whether the content is put directly to S3 with a presigned URL rather than appended in chunks,
+	// so that it does not go through the server. The workspace must use the S3 storage.
+	Presigned     bool `protobuf:"varint,3,opt,name=presigned,proto3" json:"presigned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAttachmentUploadRequest) Reset() {
+	*x = CreateAttachmentUploadRequest{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAttachmentUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAttachmentUploadRequest) ProtoMessage() {}
+
+func (x *CreateAttachmentUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAttachmentUploadRequest.ProtoReflect.Descriptor instead.
+func (*CreateAttachmentUploadRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateAttachmentUploadRequest) GetAttachmentUpload() *AttachmentUpload {
+	if x != nil {
+		return x.AttachmentUpload
+	}
+	return nil
+}
+
+func (x *CreateAttachmentUploadRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+func (x *CreateAttachmentUploadRequest) GetPresigned() bool {
+	if x != nil {
+		return x.Presigned
+	}
+	return false
+}
+
+type GetAttachmentUploadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The name of the upload.
+	// Format: attachmentUploads/{attachment_upload}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentUploadRequest) Reset() {
+	*x = GetAttachmentUploadRequest{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentUploadRequest) ProtoMessage() {}
+
+func (x *GetAttachmentUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentUploadRequest.ProtoReflect.Descriptor instead.
+func (*GetAttachmentUploadRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetAttachmentUploadRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type AppendAttachmentUploadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The name of the upload.
+	// Format: attachmentUploads/{attachment_upload}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The offset of the chunk, which must equal the offset of the upload.
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Required. The chunk of content, at most 32 MiB.
+	Data          []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppendAttachmentUploadRequest) Reset() {
+	*x = AppendAttachmentUploadRequest{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppendAttachmentUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppendAttachmentUploadRequest) ProtoMessage() {}
+
+func (x *AppendAttachmentUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppendAttachmentUploadRequest.ProtoReflect.Descriptor instead.
+func (*AppendAttachmentUploadRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AppendAttachmentUploadRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AppendAttachmentUploadRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *AppendAttachmentUploadRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CompleteAttachmentUploadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The name of the upload.
+	// Format: attachmentUploads/{attachment_upload}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteAttachmentUploadRequest) Reset() {
+	*x = CompleteAttachmentUploadRequest{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteAttachmentUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteAttachmentUploadRequest) ProtoMessage() {}
+
+func (x *CompleteAttachmentUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteAttachmentUploadRequest.ProtoReflect.Descriptor instead.
+func (*CompleteAttachmentUploadRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CompleteAttachmentUploadRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteAttachmentUploadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The name of the upload.
+	// Format: attachmentUploads/{attachment_upload}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentUploadRequest) Reset() {
+	*x = DeleteAttachmentUploadRequest{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentUploadRequest) ProtoMessage() {}
+
+func (x *DeleteAttachmentUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentUploadRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentUploadRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteAttachmentUploadRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type MigrateAttachmentStorageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// dry_run counts the attachments to migrate without migrating them, and returns once they are counted.
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// delete_source removes the content from its previous storage once it is migrated and verified.
+	DeleteSource  bool `protobuf:"varint,2,opt,name=delete_source,json=deleteSource,proto3" json:"delete_source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MigrateAttachmentStorageRequest) Reset() {
+	*x = MigrateAttachmentStorageRequest{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrateAttachmentStorageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrateAttachmentStorageRequest) ProtoMessage() {}
+
+func (x *MigrateAttachmentStorageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrateAttachmentStorageRequest.ProtoReflect.Descriptor instead.
+func (*MigrateAttachmentStorageRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MigrateAttachmentStorageRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *MigrateAttachmentStorageRequest) GetDeleteSource() bool {
+	if x != nil {
+		return x.DeleteSource
+	}
+	return false
+}
+
+type GetAttachmentStorageMigrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentStorageMigrationRequest) Reset() {
+	*x = GetAttachmentStorageMigrationRequest{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentStorageMigrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentStorageMigrationRequest) ProtoMessage() {}
+
+func (x *GetAttachmentStorageMigrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentStorageMigrationRequest.ProtoReflect.Descriptor instead.
+func (*GetAttachmentStorageMigrationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{20}
+}
+
+type AttachmentStorageMigration struct {
+	state  protoimpl.MessageState           `protogen:"open.v1"`
+	State  AttachmentStorageMigration_State `protobuf:"varint,1,opt,name=state,proto3,enum=memos.api.v1.AttachmentStorageMigration_State" json:"state,omitempty"`
+	DryRun bool                             `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// total is the number of attachments to migrate.
+	Total int32 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	// migrated is the number of attachments migrated.
+	Migrated int32 `protobuf:"varint,4,opt,name=migrated,proto3" json:"migrated,omitempty"`
+	// failures are the attachments failing to migrate, which keep their previous storage.
+	// The migration can be run again to retry them.
+	Failures []*AttachmentStorageMigration_Failure `protobuf:"bytes,5,rep,name=failures,proto3" json:"failures,omitempty"`
+	// error is the error stopping the migration, if it failed.
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentStorageMigration) Reset() {
+	*x = AttachmentStorageMigration{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentStorageMigration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentStorageMigration) ProtoMessage() {}
+
+func (x *AttachmentStorageMigration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentStorageMigration.ProtoReflect.Descriptor instead.
+func (*AttachmentStorageMigration) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AttachmentStorageMigration) GetState() AttachmentStorageMigration_State {
+	if x != nil {
+		return x.State
+	}
+	return AttachmentStorageMigration_STATE_UNSPECIFIED
+}
+
+func (x *AttachmentStorageMigration) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *AttachmentStorageMigration) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AttachmentStorageMigration) GetMigrated() int32 {
+	if x != nil {
+		return x.Migrated
+	}
+	return 0
+}
+
+func (x *AttachmentStorageMigration) GetFailures() []*AttachmentStorageMigration_Failure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+func (x *AttachmentStorageMigration) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AttachmentStorageMigration) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *AttachmentStorageMigration) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type AttachmentStorageMigration_Failure struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the attachment failing to migrate.
+	// Format: attachments/{attachment}
+	Attachment    string `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Error         string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentStorageMigration_Failure) Reset() {
+	*x = AttachmentStorageMigration_Failure{}
+	mi := &file_api_v1_attachment_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentStorageMigration_Failure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentStorageMigration_Failure) ProtoMessage() {}
+
+func (x *AttachmentStorageMigration_Failure) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_attachment_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentStorageMigration_Failure.ProtoReflect.Descriptor instead.
+func (*AttachmentStorageMigration_Failure) Descriptor() ([]byte, []int) {
+	return file_api_v1_attachment_service_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *AttachmentStorageMigration_Failure) GetAttachment() string {
+	if x != nil {
+		return x.Attachment
+	}
+	return ""
+}
+
+func (x *AttachmentStorageMigration_Failure) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_api_v1_attachment_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_attachment_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1fapi/v1/attachment_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/httpbody.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc9\x03\n" +
+	"\n" +
+	"Attachment\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12@\n" +
+	"\vcreate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12\x1f\n" +
+	"\bfilename\x18\x03 \x01(\tB\x03\xe0A\x02R\bfilename\x12\x1d\n" +
+	"\acontent\x18\x04 \x01(\fB\x03\xe0A\x04R\acontent\x12(\n" +
+	"\rexternal_link\x18\x05 \x01(\tB\x03\xe0A\x01R\fexternalLink\x12\x17\n" +
+	"\x04type\x18\x06 \x01(\tB\x03\xe0A\x02R\x04type\x12\x17\n" +
+	"\x04size\x18\a \x01(\x03B\x03\xe0A\x03R\x04size\x12\x1c\n" +
+	"\x04memo\x18\b \x01(\tB\x03\xe0A\x01H\x00R\x04memo\x88\x01\x01\x12$\n" +
+	"\vposter_link\x18\t \x01(\tB\x03\xe0A\x03R\n" +
+	"posterLink\x12&\n" +
+	"\fpreview_link\x18\n" +
+	" \x01(\tB\x03\xe0A\x03R\vpreviewLink:O\xeaAL\n" +
+	"\x17memos.api.v1/Attachment\x12\x18attachments/{attachment}*\vattachments2\n" +
+	"attachmentB\a\n" +
+	"\x05_memo\"\x82\x01\n" +
+	"\x17CreateAttachmentRequest\x12=\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x18.memos.api.v1.AttachmentB\x03\xe0A\x02R\n" +
+	"attachment\x12(\n" +
+	"\rattachment_id\x18\x02 \x01(\tB\x03\xe0A\x01R\fattachmentId\"\x9b\x01\n" +
+	"\x16ListAttachmentsRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\x12\x1b\n" +
+	"\x06filter\x18\x03 \x01(\tB\x03\xe0A\x01R\x06filter\x12\x1e\n" +
+	"\border_by\x18\x04 \x01(\tB\x03\xe0A\x01R\aorderBy\"\x9c\x01\n" +
+	"\x17ListAttachmentsResponse\x12:\n" +
+	"\vattachments\x18\x01 \x03(\v2\x18.memos.api.v1.AttachmentR\vattachments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"K\n" +
+	"\x14GetAttachmentRequest\x123\n" +
+	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
+	"\x17memos.api.v1/AttachmentR\x04name\"\xa3\x02\n" +
+	"\x1aGetAttachmentBinaryRequest\x123\n" +
+	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
+	"\x17memos.api.v1/AttachmentR\x04name\x12\x1f\n" +
+	"\bfilename\x18\x02 \x01(\tB\x03\xe0A\x02R\bfilename\x12!\n" +
+	"\tthumbnail\x18\x03 \x01(\tB\x03\xe0A\x01R\tthumbnail\x12\x1b\n" +
+	"\x06poster\x18\x04 \x01(\bB\x03\xe0A\x01R\x06poster\x12\x1d\n" +
+	"\apreview\x18\x05 \x01(\bB\x03\xe0A\x01R\apreview\x12$\n" +
+	"\vshare_token\x18\x06 \x01(\tB\x03\xe0A\x01R\n" +
+	"shareToken\x12*\n" +
+	"\x0eshare_password\x18\a \x01(\tB\x03\xe0A\x01R\rsharePassword\"\x9a\x01\n" +
+	"\x17UpdateAttachmentRequest\x12=\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x18.memos.api.v1.AttachmentB\x03\xe0A\x02R\n" +
+	"attachment\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
+	"updateMask\"N\n" +
+	"\x17DeleteAttachmentRequest\x123\n" +
+	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
+	"\x17memos.api.v1/AttachmentR\x04name\"\xba\x02\n" +
+	"\x11AttachmentVersion\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12@\n" +
+	"\vcreate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12\x1f\n" +
+	"\bfilename\x18\x03 \x01(\tB\x03\xe0A\x03R\bfilename\x12\x17\n" +
+	"\x04type\x18\x04 \x01(\tB\x03\xe0A\x03R\x04type\x12\x17\n" +
+	"\x04size\x18\x05 \x01(\x03B\x03\xe0A\x03R\x04size:w\xeaAt\n" +
+	"\x1ememos.api.v1/AttachmentVersion\x12+attachments/{attachment}/versions/{version}*\x12attachmentVersions2\x11attachmentVersion\"\xaf\x01\n" +
+	"\x1fReplaceAttachmentContentRequest\x123\n" +
+	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
+	"\x17memos.api.v1/AttachmentR\x04name\x12\x1d\n" +
+	"\acontent\x18\x02 \x01(\fB\x03\xe0A\x02R\acontent\x12\x1f\n" +
+	"\bfilename\x18\x03 \x01(\tB\x03\xe0A\x01R\bfilename\x12\x17\n" +
+	"\x04type\x18\x04 \x01(\tB\x03\xe0A\x01R\x04type\"X\n" +
+	"\x1dListAttachmentVersionsRequest\x127\n" +
+	"\x06parent\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
+	"\x17memos.api.v1/AttachmentR\x06parent\"]\n" +
+	"\x1eListAttachmentVersionsResponse\x12;\n" +
+	"\bversions\x18\x01 \x03(\v2\x1f.memos.api.v1.AttachmentVersionR\bversions\"]\n" +
+	"\x1fRestoreAttachmentVersionRequest\x12:\n" +
+	"\x04name\x18\x01 \x01(\tB&\xe0A\x02\xfaA \n" +
+	"\x1ememos.api.v1/AttachmentVersionR\x04name\"\x8f\x03\n" +
+	"\x10AttachmentUpload\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12=\n" +
+	"\n" +
+	"attachment\x18\x02 \x01(\v2\x18.memos.api.v1.AttachmentB\x03\xe0A\x02R\n" +
+	"attachment\x12\x17\n" +
+	"\x04size\x18\x03 \x01(\x03B\x03\xe0A\x02R\x04size\x12\x1b\n" +
+	"\x06offset\x18\x04 \x01(\x03B\x03\xe0A\x03R\x06offset\x12\x17\n" +
+	"\x04done\x18\x05 \x01(\bB\x03\xe0A\x03R\x04done\x12@\n" +
+	"\vexpire_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"expireTime\x12\"\n" +
+	"\n" +
+	"upload_url\x18\a \x01(\tB\x03\xe0A\x03R\tuploadUrl:n\xeaAk\n" +
+	"\x1dmemos.api.v1/AttachmentUpload\x12%attachmentUploads/{attachment_upload}*\x11attachmentUploads2\x10attachmentUpload\"\xbe\x01\n" +
+	"\x1dCreateAttachmentUploadRequest\x12P\n" +
+	"\x11attachment_upload\x18\x01 \x01(\v2\x1e.memos.api.v1.AttachmentUploadB\x03\xe0A\x02R\x10attachmentUpload\x12(\n" +
+	"\rattachment_id\x18\x02 \x01(\tB\x03\xe0A\x01R\fattachmentId\x12!\n" +
+	"\tpresigned\x18\x03 \x01(\bB\x03\xe0A\x01R\tpresigned\"W\n" +
+	"\x1aGetAttachmentUploadRequest\x129\n" +
+	"\x04name\x18\x01 \x01(\tB%\xe0A\x02\xfaA\x1f\n" +
+	"\x1dmemos.api.v1/AttachmentUploadR\x04name\"\x90\x01\n" +
+	"\x1dAppendAttachmentUploadRequest\x129\n" +
+	"\x04name\x18\x01 \x01(\tB%\xe0A\x02\xfaA\x1f\n" +
+	"\x1dmemos.api.v1/AttachmentUploadR\x04name\x12\x1b\n" +
+	"\x06offset\x18\x02 \x01(\x03B\x03\xe0A\x02R\x06offset\x12\x17\n" +
+	"\x04data\x18\x03 \x01(\fB\x03\xe0A\x02R\x04data\"\\\n" +
+	"\x1fCompleteAttachmentUploadRequest\x129\n" +
+	"\x04name\x18\x01 \x01(\tB%\xe0A\x02\xfaA\x1f\n" +
+	"\x1dmemos.api.v1/AttachmentUploadR\x04name\"Z\n" +
+	"\x1dDeleteAttachmentUploadRequest\x129\n" +
+	"\x04name\x18\x01 \x01(\tB%\xe0A\x02\xfaA\x1f\n" +
+	"\x1dmemos.api.v1/AttachmentUploadR\x04name\"_\n" +
+	"\x1fMigrateAttachmentStorageRequest\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12#\n" +
+	"\rdelete_source\x18\x02 \x01(\bR\fdeleteSource\"&\n" +
+	"$GetAttachmentStorageMigrationRequest\"\x8c\x04\n" +
+	"\x1aAttachmentStorageMigration\x12D\n" +
+	"\x05state\x18\x01 \x01(\x0e2..memos.api.v1.AttachmentStorageMigration.StateR\x05state\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x1a\n" +
+	"\bmigrated\x18\x04 \x01(\x05R\bmigrated\x12L\n" +
+	"\bfailures\x18\x05 \x03(\v20.memos.api.v1.AttachmentStorageMigration.FailureR\bfailures\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"start_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x1a?\n" +
+	"\aFailure\x12\x1e\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\tR\n" +
+	"attachment\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"F\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aRUNNING\x10\x01\x12\r\n" +
+	"\tCOMPLETED\x10\x02\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x032\xf4\x13\n" +
 	"\x11AttachmentService\x12\x89\x01\n" +
 	"\x10CreateAttachment\x12%.memos.api.v1.CreateAttachmentRequest\x1a\x18.memos.api.v1.Attachment\"4\xdaA\n" +
 	"attachment\x82\xd3\xe4\x93\x02!:\n" +
@@ -606,7 +1717,17 @@ const file_api_v1_attachment_service_proto_rawDesc = "" +
 	"\x13GetAttachmentBinary\x12(.memos.api.v1.GetAttachmentBinaryRequest\x1a\x14.google.api.HttpBody\"G\xdaA\x17name,filename,thumbnail\x82\xd3\xe4\x93\x02'\x12%/file/{name=attachments/*}/{filename}\x12\xa9\x01\n" +
 	"\x10UpdateAttachment\x12%.memos.api.v1.UpdateAttachmentRequest\x1a\x18.memos.api.v1.Attachment\"T\xdaA\x16attachment,update_mask\x82\xd3\xe4\x93\x025:\n" +
 	"attachment2'/api/v1/{attachment.name=attachments/*}\x12~\n" +
-	"\x10DeleteAttachment\x12%.memos.api.v1.DeleteAttachmentRequest\x1a\x16.google.protobuf.Empty\"+\xdaA\x04name\x82\xd3\xe4\x93\x02\x1e*\x1c/api/v1/{name=attachments/*}B\xae\x01\n" +
+	"\x10DeleteAttachment\x12%.memos.api.v1.DeleteAttachmentRequest\x1a\x16.google.protobuf.Empty\"+\xdaA\x04name\x82\xd3\xe4\x93\x02\x1e*\x1c/api/v1/{name=attachments/*}\x12\xaa\x01\n" +
+	"\x18ReplaceAttachmentContent\x12-.memos.api.v1.ReplaceAttachmentContentRequest\x1a\x18.memos.api.v1.Attachment\"E\xdaA\fname,content\x82\xd3\xe4\x93\x020:\x01*\"+/api/v1/{name=attachments/*}:replaceContent\x12\xad\x01\n" +
+	"\x16ListAttachmentVersions\x12+.memos.api.v1.ListAttachmentVersionsRequest\x1a,.memos.api.v1.ListAttachmentVersionsResponse\"8\xdaA\x06parent\x82\xd3\xe4\x93\x02)\x12'/api/v1/{parent=attachments/*}/versions\x12\xa6\x01\n" +
+	"\x18RestoreAttachmentVersion\x12-.memos.api.v1.RestoreAttachmentVersionRequest\x1a\x18.memos.api.v1.Attachment\"A\xdaA\x04name\x82\xd3\xe4\x93\x024:\x01*\"//api/v1/{name=attachments/*/versions/*}:restore\x12\xaf\x01\n" +
+	"\x16CreateAttachmentUpload\x12+.memos.api.v1.CreateAttachmentUploadRequest\x1a\x1e.memos.api.v1.AttachmentUpload\"H\xdaA\x11attachment_upload\x82\xd3\xe4\x93\x02.:\x11attachment_upload\"\x19/api/v1/attachmentUploads\x12\x92\x01\n" +
+	"\x13GetAttachmentUpload\x12(.memos.api.v1.GetAttachmentUploadRequest\x1a\x1e.memos.api.v1.AttachmentUpload\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{name=attachmentUploads/*}\x12\xae\x01\n" +
+	"\x16AppendAttachmentUpload\x12+.memos.api.v1.AppendAttachmentUploadRequest\x1a\x1e.memos.api.v1.AttachmentUpload\"G\xdaA\x10name,offset,data\x82\xd3\xe4\x93\x02.:\x01*\")/api/v1/{name=attachmentUploads/*}:append\x12\xa8\x01\n" +
+	"\x18CompleteAttachmentUpload\x12-.memos.api.v1.CompleteAttachmentUploadRequest\x1a\x1e.memos.api.v1.AttachmentUpload\"=\xdaA\x04name\x82\xd3\xe4\x93\x020:\x01*\"+/api/v1/{name=attachmentUploads/*}:complete\x12\x90\x01\n" +
+	"\x16DeleteAttachmentUpload\x12+.memos.api.v1.DeleteAttachmentUploadRequest\x1a\x16.google.protobuf.Empty\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$*\"/api/v1/{name=attachmentUploads/*}\x12\xa2\x01\n" +
+	"\x18MigrateAttachmentStorage\x12-.memos.api.v1.MigrateAttachmentStorageRequest\x1a(.memos.api.v1.AttachmentStorageMigration\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/attachments:migrateStorage\x12\xab\x01\n" +
+	"\x1dGetAttachmentStorageMigration\x122.memos.api.v1.GetAttachmentStorageMigrationRequest\x1a(.memos.api.v1.AttachmentStorageMigration\",\x82\xd3\xe4\x93\x02&\x12$/api/v1/attachments:storageMigrationB\xae\x01\n" +
 	"\x10com.memos.api.v1B\x16AttachmentServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -621,44 +1742,90 @@ func file_api_v1_attachment_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_attachment_service_proto_rawDescData
 }
 
-var file_api_v1_attachment_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_v1_attachment_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_v1_attachment_service_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_api_v1_attachment_service_proto_goTypes = []any{
-	(*Attachment)(nil),                 // 0: memos.api.v1.Attachment
-	(*CreateAttachmentRequest)(nil),    // 1: memos.api.v1.CreateAttachmentRequest
-	(*ListAttachmentsRequest)(nil),     // 2: memos.api.v1.ListAttachmentsRequest
-	(*ListAttachmentsResponse)(nil),    // 3: memos.api.v1.ListAttachmentsResponse
-	(*GetAttachmentRequest)(nil),       // 4: memos.api.v1.GetAttachmentRequest
-	(*GetAttachmentBinaryRequest)(nil), // 5: memos.api.v1.GetAttachmentBinaryRequest
-	(*UpdateAttachmentRequest)(nil),    // 6: memos.api.v1.UpdateAttachmentRequest
-	(*DeleteAttachmentRequest)(nil),    // 7: memos.api.v1.DeleteAttachmentRequest
-	(*timestamppb.Timestamp)(nil),      // 8: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),      // 9: google.protobuf.FieldMask
-	(*httpbody.HttpBody)(nil),          // 10: google.api.HttpBody
-	(*emptypb.Empty)(nil),              // 11: google.protobuf.Empty
+	(AttachmentStorageMigration_State)(0),        // 0: memos.api.v1.AttachmentStorageMigration.State
+	(*Attachment)(nil),                           // 1: memos.api.v1.Attachment
+	(*CreateAttachmentRequest)(nil),              // 2: memos.api.v1.CreateAttachmentRequest
+	(*ListAttachmentsRequest)(nil),               // 3: memos.api.v1.ListAttachmentsRequest
+	(*ListAttachmentsResponse)(nil),              // 4: memos.api.v1.ListAttachmentsResponse
+	(*GetAttachmentRequest)(nil),                 // 5: memos.api.v1.GetAttachmentRequest
+	(*GetAttachmentBinaryRequest)(nil),           // 6: memos.api.v1.GetAttachmentBinaryRequest
+	(*UpdateAttachmentRequest)(nil),              // 7: memos.api.v1.UpdateAttachmentRequest
+	(*DeleteAttachmentRequest)(nil),              // 8: memos.api.v1.DeleteAttachmentRequest
+	(*AttachmentVersion)(nil),                    // 9: memos.api.v1.AttachmentVersion
+	(*ReplaceAttachmentContentRequest)(nil),      // 10: memos.api.v1.ReplaceAttachmentContentRequest
+	(*ListAttachmentVersionsRequest)(nil),        // 11: memos.api.v1.ListAttachmentVersionsRequest
+	(*ListAttachmentVersionsResponse)(nil),       // 12: memos.api.v1.ListAttachmentVersionsResponse
+	(*RestoreAttachmentVersionRequest)(nil),      // 13: memos.api.v1.RestoreAttachmentVersionRequest
+	(*AttachmentUpload)(nil),                     // 14: memos.api.v1.AttachmentUpload
+	(*CreateAttachmentUploadRequest)(nil),        // 15: memos.api.v1.CreateAttachmentUploadRequest
+	(*GetAttachmentUploadRequest)(nil),           // 16: memos.api.v1.GetAttachmentUploadRequest
+	(*AppendAttachmentUploadRequest)(nil),        // 17: memos.api.v1.AppendAttachmentUploadRequest
+	(*CompleteAttachmentUploadRequest)(nil),      // 18: memos.api.v1.CompleteAttachmentUploadRequest
+	(*DeleteAttachmentUploadRequest)(nil),        // 19: memos.api.v1.DeleteAttachmentUploadRequest
+	(*MigrateAttachmentStorageRequest)(nil),      // 20: memos.api.v1.MigrateAttachmentStorageRequest
+	(*GetAttachmentStorageMigrationRequest)(nil), // 21: memos.api.v1.GetAttachmentStorageMigrationRequest
+	(*AttachmentStorageMigration)(nil),           // 22: memos.api.v1.AttachmentStorageMigration
+	(*AttachmentStorageMigration_Failure)(nil),   // 23: memos.api.v1.AttachmentStorageMigration.Failure
+	(*timestamppb.Timestamp)(nil),                // 24: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),                // 25: google.protobuf.FieldMask
+	(*httpbody.HttpBody)(nil),                    // 26: google.api.HttpBody
+	(*emptypb.Empty)(nil),                        // 27: google.protobuf.Empty
 }
 var file_api_v1_attachment_service_proto_depIdxs = []int32{
-	8,  // 0: memos.api.v1.Attachment.create_time:type_name -> google.protobuf.Timestamp
-	0,  // 1: memos.api.v1.CreateAttachmentRequest.attachment:type_name -> memos.api.v1.Attachment
-	0,  // 2: memos.api.v1.ListAttachmentsResponse.attachments:type_name -> memos.api.v1.Attachment
-	0,  // 3: memos.api.v1.UpdateAttachmentRequest.attachment:type_name -> memos.api.v1.Attachment
-	9,  // 4: memos.api.v1.UpdateAttachmentRequest.update_mask:type_name -> google.protobuf.FieldMask
-	1,  // 5: memos.api.v1.AttachmentService.CreateAttachment:input_type -> memos.api.v1.CreateAttachmentRequest
-	2,  // 6: memos.api.v1.AttachmentService.ListAttachments:input_type -> memos.api.v1.ListAttachmentsRequest
-	4,  // 7: memos.api.v1.AttachmentService.GetAttachment:input_type -> memos.api.v1.GetAttachmentRequest
-	5,  // 8: memos.api.v1.AttachmentService.GetAttachmentBinary:input_type -> memos.api.v1.GetAttachmentBinaryRequest
-	6,  // 9: memos.api.v1.AttachmentService.UpdateAttachment:input_type -> memos.api.v1.UpdateAttachmentRequest
-	7,  // 10: memos.api.v1.AttachmentService.DeleteAttachment:input_type -> memos.api.v1.DeleteAttachmentRequest
-	0,  // 11: memos.api.v1.AttachmentService.CreateAttachment:output_type -> memos.api.v1.Attachment
-	3,  // 12: memos.api.v1.AttachmentService.ListAttachments:output_type -> memos.api.v1.ListAttachmentsResponse
-	0,  // 13: memos.api.v1.AttachmentService.GetAttachment:output_type -> memos.api.v1.Attachment
-	10, // 14: memos.api.v1.AttachmentService.GetAttachmentBinary:output_type -> google.api.HttpBody
-	0,  // 15: memos.api.v1.AttachmentService.UpdateAttachment:output_type -> memos.api.v1.Attachment
-	11, // 16: memos.api.v1.AttachmentService.DeleteAttachment:output_type -> google.protobuf.Empty
-	11, // [11:17] is the sub-list for method output_type
-	5,  // [5:11] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	24, // 0: memos.api.v1.Attachment.create_time:type_name -> google.protobuf.Timestamp
+	1,  // 1: memos.api.v1.CreateAttachmentRequest.attachment:type_name -> memos.api.v1.Attachment
+	1,  // 2: memos.api.v1.ListAttachmentsResponse.attachments:type_name -> memos.api.v1.Attachment
+	1,  // 3: memos.api.v1.UpdateAttachmentRequest.attachment:type_name -> memos.api.v1.Attachment
+	25, // 4: memos.api.v1.UpdateAttachmentRequest.update_mask:type_name -> google.protobuf.FieldMask
+	24, // 5: memos.api.v1.AttachmentVersion.create_time:type_name -> google.protobuf.Timestamp
+	9,  // 6: memos.api.v1.ListAttachmentVersionsResponse.versions:type_name -> memos.api.v1.AttachmentVersion
+	1,  // 7: memos.api.v1.AttachmentUpload.attachment:type_name -> memos.api.v1.Attachment
+	24, // 8: memos.api.v1.AttachmentUpload.expire_time:type_name -> google.protobuf.Timestamp
+	14, // 9: memos.api.v1.CreateAttachmentUploadRequest.attachment_upload:type_name -> memos.api.v1.AttachmentUpload
+	0,  // 10: memos.api.v1.AttachmentStorageMigration.state:type_name -> memos.api.v1.AttachmentStorageMigration.State
+	23, // 11: memos.api.v1.AttachmentStorageMigration.failures:type_name -> memos.api.v1.AttachmentStorageMigration.Failure
+	24, // 12: memos.api.v1.AttachmentStorageMigration.start_time:type_name -> google.protobuf.Timestamp
+	24, // 13: memos.api.v1.AttachmentStorageMigration.end_time:type_name -> google.protobuf.Timestamp
+	2,  // 14: memos.api.v1.AttachmentService.CreateAttachment:input_type -> memos.api.v1.CreateAttachmentRequest
+	3,  // 15: memos.api.v1.AttachmentService.ListAttachments:input_type -> memos.api.v1.ListAttachmentsRequest
+	5,  // 16: memos.api.v1.AttachmentService.GetAttachment:input_type -> memos.api.v1.GetAttachmentRequest
+	6,  // 17: memos.api.v1.AttachmentService.GetAttachmentBinary:input_type -> memos.api.v1.GetAttachmentBinaryRequest
+	7,  // 18: memos.api.v1.AttachmentService.UpdateAttachment:input_type -> memos.api.v1.UpdateAttachmentRequest
+	8,  // 19: memos.api.v1.AttachmentService.DeleteAttachment:input_type -> memos.api.v1.DeleteAttachmentRequest
+	10, // 20: memos.api.v1.AttachmentService.ReplaceAttachmentContent:input_type -> memos.api.v1.ReplaceAttachmentContentRequest
+	11, // 21: memos.api.v1.AttachmentService.ListAttachmentVersions:input_type -> memos.api.v1.ListAttachmentVersionsRequest
+	13, // 22: memos.api.v1.AttachmentService.RestoreAttachmentVersion:input_type -> memos.api.v1.RestoreAttachmentVersionRequest
+	15, // 23: memos.api.v1.AttachmentService.CreateAttachmentUpload:input_type -> memos.api.v1.CreateAttachmentUploadRequest
+	16, // 24: memos.api.v1.AttachmentService.GetAttachmentUpload:input_type -> memos.api.v1.GetAttachmentUploadRequest
+	17, // 25: memos.api.v1.AttachmentService.AppendAttachmentUpload:input_type -> memos.api.v1.AppendAttachmentUploadRequest
+	18, // 26: memos.api.v1.AttachmentService.CompleteAttachmentUpload:input_type -> memos.api.v1.CompleteAttachmentUploadRequest
+	19, // 27: memos.api.v1.AttachmentService.DeleteAttachmentUpload:input_type -> memos.api.v1.DeleteAttachmentUploadRequest
+	20, // 28: memos.api.v1.AttachmentService.MigrateAttachmentStorage:input_type -> memos.api.v1.MigrateAttachmentStorageRequest
+	21, // 29: memos.api.v1.AttachmentService.GetAttachmentStorageMigration:input_type -> memos.api.v1.GetAttachmentStorageMigrationRequest
+	1,  // 30: memos.api.v1.AttachmentService.CreateAttachment:output_type -> memos.api.v1.Attachment
+	4,  // 31: memos.api.v1.AttachmentService.ListAttachments:output_type -> memos.api.v1.ListAttachmentsResponse
+	1,  // 32: memos.api.v1.AttachmentService.GetAttachment:output_type -> memos.api.v1.Attachment
+	26, // 33: memos.api.v1.AttachmentService.GetAttachmentBinary:output_type -> google.api.HttpBody
+	1,  // 34: memos.api.v1.AttachmentService.UpdateAttachment:output_type -> memos.api.v1.Attachment
+	27, // 35: memos.api.v1.AttachmentService.DeleteAttachment:output_type -> google.protobuf.Empty
+	1,  // 36: memos.api.v1.AttachmentService.ReplaceAttachmentContent:output_type -> memos.api.v1.Attachment
+	12, // 37: memos.api.v1.AttachmentService.ListAttachmentVersions:output_type -> memos.api.v1.ListAttachmentVersionsResponse
+	1,  // 38: memos.api.v1.AttachmentService.RestoreAttachmentVersion:output_type -> memos.api.v1.Attachment
+	14, // 39: memos.api.v1.AttachmentService.CreateAttachmentUpload:output_type -> memos.api.v1.AttachmentUpload
+	14, // 40: memos.api.v1.AttachmentService.GetAttachmentUpload:output_type -> memos.api.v1.AttachmentUpload
+	14, // 41: memos.api.v1.AttachmentService.AppendAttachmentUpload:output_type -> memos.api.v1.AttachmentUpload
+	14, // 42: memos.api.v1.AttachmentService.CompleteAttachmentUpload:output_type -> memos.api.v1.AttachmentUpload
+	27, // 43: memos.api.v1.AttachmentService.DeleteAttachmentUpload:output_type -> google.protobuf.Empty
+	22, // 44: memos.api.v1.AttachmentService.MigrateAttachmentStorage:output_type -> memos.api.v1.AttachmentStorageMigration
+	22, // 45: memos.api.v1.AttachmentService.GetAttachmentStorageMigration:output_type -> memos.api.v1.AttachmentStorageMigration
+	30, // [30:46] is the sub-list for method output_type
+	14, // [14:30] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_attachment_service_proto_init() }
@@ -672,13 +1839,14 @@ func file_api_v1_attachment_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_attachment_service_proto_rawDesc), len(file_api_v1_attachment_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      1,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_v1_attachment_service_proto_goTypes,
 		DependencyIndexes: file_api_v1_attachment_service_proto_depIdxs,
+		EnumInfos:         file_api_v1_attachment_service_proto_enumTypes,
 		MessageInfos:      file_api_v1_attachment_service_proto_msgTypes,
 	}.Build()
 	File_api_v1_attachment_service_proto = out.File
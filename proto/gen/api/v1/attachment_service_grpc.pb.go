@@ -21,12 +21,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AttachmentService_CreateAttachment_FullMethodName    = "/memos.api.v1.AttachmentService/CreateAttachment"
-	AttachmentService_ListAttachments_FullMethodName     = "/memos.api.v1.AttachmentService/ListAttachments"
-	AttachmentService_GetAttachment_FullMethodName       = "/memos.api.v1.AttachmentService/GetAttachment"
-	AttachmentService_GetAttachmentBinary_FullMethodName = "/memos.api.v1.AttachmentService/GetAttachmentBinary"
-	AttachmentService_UpdateAttachment_FullMethodName    = "/memos.api.v1.AttachmentService/UpdateAttachment"
-	AttachmentService_DeleteAttachment_FullMethodName    = "/memos.api.v1.AttachmentService/DeleteAttachment"
+	AttachmentService_CreateAttachment_FullMethodName              = "/memos.api.v1.AttachmentService/CreateAttachment"
+	AttachmentService_ListAttachments_FullMethodName               = "/memos.api.v1.AttachmentService/ListAttachments"
+	AttachmentService_GetAttachment_FullMethodName                 = "/memos.api.v1.AttachmentService/GetAttachment"
+	AttachmentService_GetAttachmentBinary_FullMethodName           = "/memos.api.v1.AttachmentService/GetAttachmentBinary"
+	AttachmentService_UpdateAttachment_FullMethodName              = "/memos.api.v1.AttachmentService/UpdateAttachment"
+	AttachmentService_DeleteAttachment_FullMethodName              = "/memos.api.v1.AttachmentService/DeleteAttachment"
+	AttachmentService_ReplaceAttachmentContent_FullMethodName      = "/memos.api.v1.AttachmentService/ReplaceAttachmentContent"
+	AttachmentService_ListAttachmentVersions_FullMethodName        = "/memos.api.v1.AttachmentService/ListAttachmentVersions"
+	AttachmentService_RestoreAttachmentVersion_FullMethodName      = "/memos.api.v1.AttachmentService/RestoreAttachmentVersion"
+	AttachmentService_CreateAttachmentUpload_FullMethodName        = "/memos.api.v1.AttachmentService/CreateAttachmentUpload"
+	AttachmentService_GetAttachmentUpload_FullMethodName           = "/memos.api.v1.AttachmentService/GetAttachmentUpload"
+	AttachmentService_AppendAttachmentUpload_FullMethodName        = "/memos.api.v1.AttachmentService/AppendAttachmentUpload"
+	AttachmentService_CompleteAttachmentUpload_FullMethodName      = "/memos.api.v1.AttachmentService/CompleteAttachmentUpload"
+	AttachmentService_DeleteAttachmentUpload_FullMethodName        = "/memos.api.v1.AttachmentService/DeleteAttachmentUpload"
+	AttachmentService_MigrateAttachmentStorage_FullMethodName      = "/memos.api.v1.AttachmentService/MigrateAttachmentStorage"
+	AttachmentService_GetAttachmentStorageMigration_FullMethodName = "/memos.api.v1.AttachmentService/GetAttachmentStorageMigration"
 )
 
 // AttachmentServiceClient is the client API for AttachmentService service.
@@ -34,6 +44,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AttachmentServiceClient interface {
 	// CreateAttachment creates a new attachment.
+	// Large files are better uploaded in chunks with CreateAttachmentUpload.
 	CreateAttachment(ctx context.Context, in *CreateAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error)
 	// ListAttachments lists all attachments.
 	ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
@@ -45,6 +56,33 @@ type AttachmentServiceClient interface {
 	UpdateAttachment(ctx context.Context, in *UpdateAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error)
 	// DeleteAttachment deletes a attachment by name.
 	DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ReplaceAttachmentContent replaces the content of an attachment, keeping its name so that its links stay valid.
+	// The previous content is kept as a version of the attachment.
+	ReplaceAttachmentContent(ctx context.Context, in *ReplaceAttachmentContentRequest, opts ...grpc.CallOption) (*Attachment, error)
+	// ListAttachmentVersions lists the previous contents of an attachment, the latest first.
+	ListAttachmentVersions(ctx context.Context, in *ListAttachmentVersionsRequest, opts ...grpc.CallOption) (*ListAttachmentVersionsResponse, error)
+	// RestoreAttachmentVersion makes a previous content of an attachment current again.
+	// The replaced content is kept as a version of the attachment.
+	RestoreAttachmentVersion(ctx context.Context, in *RestoreAttachmentVersionRequest, opts ...grpc.CallOption) (*Attachment, error)
+	// CreateAttachmentUpload starts a resumable upload of an attachment,
+	// whose content is then appended in chunks with AppendAttachmentUpload.
+	CreateAttachmentUpload(ctx context.Context, in *CreateAttachmentUploadRequest, opts ...grpc.CallOption) (*AttachmentUpload, error)
+	// GetAttachmentUpload returns the progress of an upload, i.e. the offset to resume it from.
+	GetAttachmentUpload(ctx context.Context, in *GetAttachmentUploadRequest, opts ...grpc.CallOption) (*AttachmentUpload, error)
+	// AppendAttachmentUpload appends a chunk of content to an upload.
+	// The attachment is created once the last chunk is appended.
+	AppendAttachmentUpload(ctx context.Context, in *AppendAttachmentUploadRequest, opts ...grpc.CallOption) (*AttachmentUpload, error)
+	// CompleteAttachmentUpload creates the attachment of a presigned upload,
+	// once its content is put to its upload URL.
+	CompleteAttachmentUpload(ctx context.Context, in *CompleteAttachmentUploadRequest, opts ...grpc.CallOption) (*AttachmentUpload, error)
+	// DeleteAttachmentUpload cancels an upload, discarding the appended content.
+	DeleteAttachmentUpload(ctx context.Context, in *DeleteAttachmentUploadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// MigrateAttachmentStorage starts moving the content of the attachments to the storage of the workspace storage setting,
+	// e.g. after switching from the local storage to S3. Only the host can migrate the storage.
+	// The migration runs in the background, and GetAttachmentStorageMigration returns its progress.
+	MigrateAttachmentStorage(ctx context.Context, in *MigrateAttachmentStorageRequest, opts ...grpc.CallOption) (*AttachmentStorageMigration, error)
+	// GetAttachmentStorageMigration returns the progress of the last storage migration.
+	GetAttachmentStorageMigration(ctx context.Context, in *GetAttachmentStorageMigrationRequest, opts ...grpc.CallOption) (*AttachmentStorageMigration, error)
 }
 
 type attachmentServiceClient struct {
@@ -115,11 +153,112 @@ func (c *attachmentServiceClient) DeleteAttachment(ctx context.Context, in *Dele
 	return out, nil
 }
 
+func (c *attachmentServiceClient) ReplaceAttachmentContent(ctx context.Context, in *ReplaceAttachmentContentRequest, opts ...grpc.CallOption) (*Attachment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attachment)
+	err := c.cc.Invoke(ctx, AttachmentService_ReplaceAttachmentContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) ListAttachmentVersions(ctx context.Context, in *ListAttachmentVersionsRequest, opts ...grpc.CallOption) (*ListAttachmentVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAttachmentVersionsResponse)
+	err := c.cc.Invoke(ctx, AttachmentService_ListAttachmentVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) RestoreAttachmentVersion(ctx context.Context, in *RestoreAttachmentVersionRequest, opts ...grpc.CallOption) (*Attachment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attachment)
+	err := c.cc.Invoke(ctx, AttachmentService_RestoreAttachmentVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) CreateAttachmentUpload(ctx context.Context, in *CreateAttachmentUploadRequest, opts ...grpc.CallOption) (*AttachmentUpload, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachmentUpload)
+	err := c.cc.Invoke(ctx, AttachmentService_CreateAttachmentUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) GetAttachmentUpload(ctx context.Context, in *GetAttachmentUploadRequest, opts ...grpc.CallOption) (*AttachmentUpload, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachmentUpload)
+	err := c.cc.Invoke(ctx, AttachmentService_GetAttachmentUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) AppendAttachmentUpload(ctx context.Context, in *AppendAttachmentUploadRequest, opts ...grpc.CallOption) (*AttachmentUpload, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachmentUpload)
+	err := c.cc.Invoke(ctx, AttachmentService_AppendAttachmentUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) CompleteAttachmentUpload(ctx context.Context, in *CompleteAttachmentUploadRequest, opts ...grpc.CallOption) (*AttachmentUpload, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachmentUpload)
+	err := c.cc.Invoke(ctx, AttachmentService_CompleteAttachmentUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) DeleteAttachmentUpload(ctx context.Context, in *DeleteAttachmentUploadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AttachmentService_DeleteAttachmentUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) MigrateAttachmentStorage(ctx context.Context, in *MigrateAttachmentStorageRequest, opts ...grpc.CallOption) (*AttachmentStorageMigration, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachmentStorageMigration)
+	err := c.cc.Invoke(ctx, AttachmentService_MigrateAttachmentStorage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) GetAttachmentStorageMigration(ctx context.Context, in *GetAttachmentStorageMigrationRequest, opts ...grpc.CallOption) (*AttachmentStorageMigration, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachmentStorageMigration)
+	err := c.cc.Invoke(ctx, AttachmentService_GetAttachmentStorageMigration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AttachmentServiceServer is the server API for AttachmentService service.
 // All implementations must embed UnimplementedAttachmentServiceServer
 // for forward compatibility.
 type AttachmentServiceServer interface {
 	// CreateAttachment creates a new attachment.
+	// Large files are better uploaded in chunks with CreateAttachmentUpload.
 	CreateAttachment(context.Context, *CreateAttachmentRequest) (*Attachment, error)
 	// ListAttachments lists all attachments.
 	ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
@@ -131,6 +270,33 @@ type AttachmentServiceServer interface {
 	UpdateAttachment(context.Context, *UpdateAttachmentRequest) (*Attachment, error)
 	// DeleteAttachment deletes a attachment by name.
 	DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*emptypb.Empty, error)
+	// ReplaceAttachmentContent replaces the content of an attachment, keeping its name so that its links stay valid.
+	// The previous content is kept as a version of the attachment.
+	ReplaceAttachmentContent(context.Context, *ReplaceAttachmentContentRequest) (*Attachment, error)
+	// ListAttachmentVersions lists the previous contents of an attachment, the latest first.
+	ListAttachmentVersions(context.Context, *ListAttachmentVersionsRequest) (*ListAttachmentVersionsResponse, error)
+	// RestoreAttachmentVersion makes a previous content of an attachment current again.
+	// The replaced content is kept as a version of the attachment.
+	RestoreAttachmentVersion(context.Context, *RestoreAttachmentVersionRequest) (*Attachment, error)
+	// CreateAttachmentUpload starts a resumable upload of an attachment,
+	// whose content is then appended in chunks with AppendAttachmentUpload.
+	CreateAttachmentUpload(context.Context, *CreateAttachmentUploadRequest) (*AttachmentUpload, error)
+	// GetAttachmentUpload returns the progress of an upload, i.e. the offset to resume it from.
+	GetAttachmentUpload(context.Context, *GetAttachmentUploadRequest) (*AttachmentUpload, error)
+	// AppendAttachmentUpload appends a chunk of content to an upload.
+	// The attachment is created once the last chunk is appended.
+	AppendAttachmentUpload(context.Context, *AppendAttachmentUploadRequest) (*AttachmentUpload, error)
+	// CompleteAttachmentUpload creates the attachment of a presigned upload,
+	// once its content is put to its upload URL.
+	CompleteAttachmentUpload(context.Context, *CompleteAttachmentUploadRequest) (*AttachmentUpload, error)
+	// DeleteAttachmentUpload cancels an upload, discarding the appended content.
+	DeleteAttachmentUpload(context.Context, *DeleteAttachmentUploadRequest) (*emptypb.Empty, error)
+	// MigrateAttachmentStorage starts moving the content of the attachments to the storage of the workspace storage setting,
+	// e.g. after switching from the local storage to S3. Only the host can migrate the storage.
+	// The migration runs in the background, and GetAttachmentStorageMigration returns its progress.
+	MigrateAttachmentStorage(context.Context, *MigrateAttachmentStorageRequest) (*AttachmentStorageMigration, error)
+	// GetAttachmentStorageMigration returns the progress of the last storage migration.
+	GetAttachmentStorageMigration(context.Context, *GetAttachmentStorageMigrationRequest) (*AttachmentStorageMigration, error)
 	mustEmbedUnimplementedAttachmentServiceServer()
 }
 
@@ -159,6 +325,36 @@ func (UnimplementedAttachmentServiceServer) UpdateAttachment(context.Context, *U
 func (UnimplementedAttachmentServiceServer) DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
 }
+func (UnimplementedAttachmentServiceServer) ReplaceAttachmentContent(context.Context, *ReplaceAttachmentContentRequest) (*Attachment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceAttachmentContent not implemented")
+}
+func (UnimplementedAttachmentServiceServer) ListAttachmentVersions(context.Context, *ListAttachmentVersionsRequest) (*ListAttachmentVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttachmentVersions not implemented")
+}
+func (UnimplementedAttachmentServiceServer) RestoreAttachmentVersion(context.Context, *RestoreAttachmentVersionRequest) (*Attachment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAttachmentVersion not implemented")
+}
+func (UnimplementedAttachmentServiceServer) CreateAttachmentUpload(context.Context, *CreateAttachmentUploadRequest) (*AttachmentUpload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAttachmentUpload not implemented")
+}
+func (UnimplementedAttachmentServiceServer) GetAttachmentUpload(context.Context, *GetAttachmentUploadRequest) (*AttachmentUpload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttachmentUpload not implemented")
+}
+func (UnimplementedAttachmentServiceServer) AppendAttachmentUpload(context.Context, *AppendAttachmentUploadRequest) (*AttachmentUpload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppendAttachmentUpload not implemented")
+}
+func (UnimplementedAttachmentServiceServer) CompleteAttachmentUpload(context.Context, *CompleteAttachmentUploadRequest) (*AttachmentUpload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteAttachmentUpload not implemented")
+}
+func (UnimplementedAttachmentServiceServer) DeleteAttachmentUpload(context.Context, *DeleteAttachmentUploadRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachmentUpload not implemented")
+}
+func (UnimplementedAttachmentServiceServer) MigrateAttachmentStorage(context.Context, *MigrateAttachmentStorageRequest) (*AttachmentStorageMigration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateAttachmentStorage not implemented")
+}
+func (UnimplementedAttachmentServiceServer) GetAttachmentStorageMigration(context.Context, *GetAttachmentStorageMigrationRequest) (*AttachmentStorageMigration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttachmentStorageMigration not implemented")
+}
 func (UnimplementedAttachmentServiceServer) mustEmbedUnimplementedAttachmentServiceServer() {}
 func (UnimplementedAttachmentServiceServer) testEmbeddedByValue()                           {}
 
@@ -288,6 +484,186 @@ func _AttachmentService_DeleteAttachment_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AttachmentService_ReplaceAttachmentContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceAttachmentContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).ReplaceAttachmentContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_ReplaceAttachmentContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).ReplaceAttachmentContent(ctx, req.(*ReplaceAttachmentContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_ListAttachmentVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).ListAttachmentVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_ListAttachmentVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).ListAttachmentVersions(ctx, req.(*ListAttachmentVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_RestoreAttachmentVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAttachmentVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).RestoreAttachmentVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_RestoreAttachmentVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).RestoreAttachmentVersion(ctx, req.(*RestoreAttachmentVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_CreateAttachmentUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAttachmentUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).CreateAttachmentUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_CreateAttachmentUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).CreateAttachmentUpload(ctx, req.(*CreateAttachmentUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_GetAttachmentUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttachmentUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).GetAttachmentUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_GetAttachmentUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).GetAttachmentUpload(ctx, req.(*GetAttachmentUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_AppendAttachmentUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppendAttachmentUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).AppendAttachmentUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_AppendAttachmentUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).AppendAttachmentUpload(ctx, req.(*AppendAttachmentUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_CompleteAttachmentUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteAttachmentUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).CompleteAttachmentUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_CompleteAttachmentUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).CompleteAttachmentUpload(ctx, req.(*CompleteAttachmentUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_DeleteAttachmentUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttachmentUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).DeleteAttachmentUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_DeleteAttachmentUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).DeleteAttachmentUpload(ctx, req.(*DeleteAttachmentUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_MigrateAttachmentStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateAttachmentStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).MigrateAttachmentStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_MigrateAttachmentStorage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).MigrateAttachmentStorage(ctx, req.(*MigrateAttachmentStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_GetAttachmentStorageMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttachmentStorageMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).GetAttachmentStorageMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_GetAttachmentStorageMigration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).GetAttachmentStorageMigration(ctx, req.(*GetAttachmentStorageMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AttachmentService_ServiceDesc is the grpc.ServiceDesc for AttachmentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -319,6 +695,46 @@ var AttachmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAttachment",
 			Handler:    _AttachmentService_DeleteAttachment_Handler,
 		},
+		{
+			MethodName: "ReplaceAttachmentContent",
+			Handler:    _AttachmentService_ReplaceAttachmentContent_Handler,
+		},
+		{
+			MethodName: "ListAttachmentVersions",
+			Handler:    _AttachmentService_ListAttachmentVersions_Handler,
+		},
+		{
+			MethodName: "RestoreAttachmentVersion",
+			Handler:    _AttachmentService_RestoreAttachmentVersion_Handler,
+		},
+		{
+			MethodName: "CreateAttachmentUpload",
+			Handler:    _AttachmentService_CreateAttachmentUpload_Handler,
+		},
+		{
+			MethodName: "GetAttachmentUpload",
+			Handler:    _AttachmentService_GetAttachmentUpload_Handler,
+		},
+		{
+			MethodName: "AppendAttachmentUpload",
+			Handler:    _AttachmentService_AppendAttachmentUpload_Handler,
+		},
+		{
+			MethodName: "CompleteAttachmentUpload",
+			Handler:    _AttachmentService_CompleteAttachmentUpload_Handler,
+		},
+		{
+			MethodName: "DeleteAttachmentUpload",
+			Handler:    _AttachmentService_DeleteAttachmentUpload_Handler,
+		},
+		{
+			MethodName: "MigrateAttachmentStorage",
+			Handler:    _AttachmentService_MigrateAttachmentStorage_Handler,
+		},
+		{
+			MethodName: "GetAttachmentStorageMigration",
+			Handler:    _AttachmentService_GetAttachmentStorageMigration_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/attachment_service.proto",
@@ -66,8 +66,15 @@ type GetCurrentSessionResponse struct {
 	// Last time the session was accessed.
 	// Used for sliding expiration calculation (last_accessed_time + 2 weeks).
 	LastAccessedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_accessed_at,json=lastAccessedAt,proto3" json:"last_accessed_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// The name of the host impersonating the user, if the session is an impersonation session.
+	// Format: users/{user}
+	Impersonator string `protobuf:"bytes,3,opt,name=impersonator,proto3" json:"impersonator,omitempty"`
+	// The fixed expiration of the session, set for the impersonation sessions.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// Whether the user must change the password set by an admin before using the API.
+	PasswordChangeRequired bool `protobuf:"varint,5,opt,name=password_change_required,json=passwordChangeRequired,proto3" json:"password_change_required,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *GetCurrentSessionResponse) Reset() {
@@ -114,23 +121,106 @@ func (x *GetCurrentSessionResponse) GetLastAccessedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *GetCurrentSessionResponse) GetImpersonator() string {
+	if x != nil {
+		return x.Impersonator
+	}
+	return ""
+}
+
+func (x *GetCurrentSessionResponse) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *GetCurrentSessionResponse) GetPasswordChangeRequired() bool {
+	if x != nil {
+		return x.PasswordChangeRequired
+	}
+	return false
+}
+
+type CreateImpersonationSessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The name of the user to impersonate.
+	// Format: users/{user}
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// Optional. The expiration of the session, one hour from now by default and at most eight hours from now.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateImpersonationSessionRequest) Reset() {
+	*x = CreateImpersonationSessionRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateImpersonationSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateImpersonationSessionRequest) ProtoMessage() {}
+
+func (x *CreateImpersonationSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateImpersonationSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateImpersonationSessionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateImpersonationSessionRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *CreateImpersonationSessionRequest) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
 type CreateSessionRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Provide one authentication method (username/password or SSO).
+	// Provide one authentication method (username/password, SSO or LDAP).
 	// Required field to specify the authentication method.
 	//
 	// Types that are valid to be assigned to Credentials:
 	//
 	//	*CreateSessionRequest_PasswordCredentials_
 	//	*CreateSessionRequest_SsoCredentials
-	Credentials   isCreateSessionRequest_Credentials `protobuf_oneof:"credentials"`
+	//	*CreateSessionRequest_LdapCredentials
+	Credentials isCreateSessionRequest_Credentials `protobuf_oneof:"credentials"`
+	// Optional. The TOTP code, or an unused recovery code, of a user with two-factor authentication.
+	// Required for the password and LDAP authentication of these users.
+	TwoFactorCode string `protobuf:"bytes,3,opt,name=two_factor_code,json=twoFactorCode,proto3" json:"two_factor_code,omitempty"`
+	// Optional. The CAPTCHA token, required once the sign-ins of the username or the client have failed
+	// as many times as the CAPTCHA setting of the workspace allows.
+	CaptchaToken  string `protobuf:"bytes,5,opt,name=captcha_token,json=captchaToken,proto3" json:"captcha_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateSessionRequest) Reset() {
 	*x = CreateSessionRequest{}
-	mi := &file_api_v1_auth_service_proto_msgTypes[2]
+	mi := &file_api_v1_auth_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -142,7 +232,7 @@ func (x *CreateSessionRequest) String() string {
 func (*CreateSessionRequest) ProtoMessage() {}
 
 func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_auth_service_proto_msgTypes[2]
+	mi := &file_api_v1_auth_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -155,7 +245,7 @@ func (x *CreateSessionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSessionRequest.ProtoReflect.Descriptor instead.
 func (*CreateSessionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{2}
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateSessionRequest) GetCredentials() isCreateSessionRequest_Credentials {
@@ -183,6 +273,29 @@ func (x *CreateSessionRequest) GetSsoCredentials() *CreateSessionRequest_SSOCred
 	return nil
 }
 
+func (x *CreateSessionRequest) GetLdapCredentials() *CreateSessionRequest_LDAPCredentials {
+	if x != nil {
+		if x, ok := x.Credentials.(*CreateSessionRequest_LdapCredentials); ok {
+			return x.LdapCredentials
+		}
+	}
+	return nil
+}
+
+func (x *CreateSessionRequest) GetTwoFactorCode() string {
+	if x != nil {
+		return x.TwoFactorCode
+	}
+	return ""
+}
+
+func (x *CreateSessionRequest) GetCaptchaToken() string {
+	if x != nil {
+		return x.CaptchaToken
+	}
+	return ""
+}
+
 type isCreateSessionRequest_Credentials interface {
 	isCreateSessionRequest_Credentials()
 }
@@ -197,10 +310,17 @@ type CreateSessionRequest_SsoCredentials struct {
 	SsoCredentials *CreateSessionRequest_SSOCredentials `protobuf:"bytes,2,opt,name=sso_credentials,json=ssoCredentials,proto3,oneof"`
 }
 
+type CreateSessionRequest_LdapCredentials struct {
+	// LDAP provider authentication method.
+	LdapCredentials *CreateSessionRequest_LDAPCredentials `protobuf:"bytes,4,opt,name=ldap_credentials,json=ldapCredentials,proto3,oneof"`
+}
+
 func (*CreateSessionRequest_PasswordCredentials_) isCreateSessionRequest_Credentials() {}
 
 func (*CreateSessionRequest_SsoCredentials) isCreateSessionRequest_Credentials() {}
 
+func (*CreateSessionRequest_LdapCredentials) isCreateSessionRequest_Credentials() {}
+
 type CreateSessionResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The authenticated user information.
@@ -214,7 +334,7 @@ type CreateSessionResponse struct {
 
 func (x *CreateSessionResponse) Reset() {
 	*x = CreateSessionResponse{}
-	mi := &file_api_v1_auth_service_proto_msgTypes[3]
+	mi := &file_api_v1_auth_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -226,7 +346,7 @@ func (x *CreateSessionResponse) String() string {
 func (*CreateSessionResponse) ProtoMessage() {}
 
 func (x *CreateSessionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_auth_service_proto_msgTypes[3]
+	mi := &file_api_v1_auth_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -239,7 +359,7 @@ func (x *CreateSessionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSessionResponse.ProtoReflect.Descriptor instead.
 func (*CreateSessionResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{3}
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateSessionResponse) GetUser() *User {
@@ -264,7 +384,7 @@ type DeleteSessionRequest struct {
 
 func (x *DeleteSessionRequest) Reset() {
 	*x = DeleteSessionRequest{}
-	mi := &file_api_v1_auth_service_proto_msgTypes[4]
+	mi := &file_api_v1_auth_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -276,7 +396,7 @@ func (x *DeleteSessionRequest) String() string {
 func (*DeleteSessionRequest) ProtoMessage() {}
 
 func (x *DeleteSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_auth_service_proto_msgTypes[4]
+	mi := &file_api_v1_auth_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -289,7 +409,287 @@ func (x *DeleteSessionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSessionRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSessionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{4}
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{5}
+}
+
+type VerifyEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The token of the verification email.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailRequest) Reset() {
+	*x = VerifyEmailRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailRequest) ProtoMessage() {}
+
+func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *VerifyEmailRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type SendVerificationEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The email to verify.
+	Email         string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendVerificationEmailRequest) Reset() {
+	*x = SendVerificationEmailRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendVerificationEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendVerificationEmailRequest) ProtoMessage() {}
+
+func (x *SendVerificationEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendVerificationEmailRequest.ProtoReflect.Descriptor instead.
+func (*SendVerificationEmailRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SendVerificationEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type RequestPasswordResetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The email of the user who forgot their password.
+	Email         string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ResetPasswordRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The token of the password reset email, valid for an hour and until the password is changed.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Required. The new password.
+	Password      string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ResetPasswordRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type CreateCaptchaChallengeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCaptchaChallengeRequest) Reset() {
+	*x = CreateCaptchaChallengeRequest{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCaptchaChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCaptchaChallengeRequest) ProtoMessage() {}
+
+func (x *CreateCaptchaChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCaptchaChallengeRequest.ProtoReflect.Descriptor instead.
+func (*CreateCaptchaChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{10}
+}
+
+type CaptchaChallenge struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The challenge, valid for ten minutes and solved once.
+	Challenge string `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	// The number of leading zero bits of the SHA-256 hash of the challenge, a colon and the nonce solving it.
+	// The CAPTCHA token is the challenge, a colon and the nonce.
+	Difficulty    int32 `protobuf:"varint,2,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaptchaChallenge) Reset() {
+	*x = CaptchaChallenge{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptchaChallenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptchaChallenge) ProtoMessage() {}
+
+func (x *CaptchaChallenge) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptchaChallenge.ProtoReflect.Descriptor instead.
+func (*CaptchaChallenge) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CaptchaChallenge) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+func (x *CaptchaChallenge) GetDifficulty() int32 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
 }
 
 // Nested message for password-based authentication credentials.
@@ -307,7 +707,7 @@ type CreateSessionRequest_PasswordCredentials struct {
 
 func (x *CreateSessionRequest_PasswordCredentials) Reset() {
 	*x = CreateSessionRequest_PasswordCredentials{}
-	mi := &file_api_v1_auth_service_proto_msgTypes[5]
+	mi := &file_api_v1_auth_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -319,7 +719,7 @@ func (x *CreateSessionRequest_PasswordCredentials) String() string {
 func (*CreateSessionRequest_PasswordCredentials) ProtoMessage() {}
 
 func (x *CreateSessionRequest_PasswordCredentials) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_auth_service_proto_msgTypes[5]
+	mi := &file_api_v1_auth_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -332,7 +732,7 @@ func (x *CreateSessionRequest_PasswordCredentials) ProtoReflect() protoreflect.M
 
 // Deprecated: Use CreateSessionRequest_PasswordCredentials.ProtoReflect.Descriptor instead.
 func (*CreateSessionRequest_PasswordCredentials) Descriptor() ([]byte, []int) {
-	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{2, 0}
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{3, 0}
 }
 
 func (x *CreateSessionRequest_PasswordCredentials) GetUsername() string {
@@ -367,7 +767,7 @@ type CreateSessionRequest_SSOCredentials struct {
 
 func (x *CreateSessionRequest_SSOCredentials) Reset() {
 	*x = CreateSessionRequest_SSOCredentials{}
-	mi := &file_api_v1_auth_service_proto_msgTypes[6]
+	mi := &file_api_v1_auth_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -379,7 +779,7 @@ func (x *CreateSessionRequest_SSOCredentials) String() string {
 func (*CreateSessionRequest_SSOCredentials) ProtoMessage() {}
 
 func (x *CreateSessionRequest_SSOCredentials) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_auth_service_proto_msgTypes[6]
+	mi := &file_api_v1_auth_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -392,7 +792,7 @@ func (x *CreateSessionRequest_SSOCredentials) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use CreateSessionRequest_SSOCredentials.ProtoReflect.Descriptor instead.
 func (*CreateSessionRequest_SSOCredentials) Descriptor() ([]byte, []int) {
-	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{2, 1}
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{3, 1}
 }
 
 func (x *CreateSessionRequest_SSOCredentials) GetIdpId() int32 {
@@ -416,34 +816,134 @@ func (x *CreateSessionRequest_SSOCredentials) GetRedirectUri() string {
 	return ""
 }
 
+// Nested message for LDAP authentication credentials.
+type CreateSessionRequest_LDAPCredentials struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the LDAP identity provider.
+	IdpId int32 `protobuf:"varint,1,opt,name=idp_id,json=idpId,proto3" json:"idp_id,omitempty"`
+	// The username in the directory.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// The password in the directory.
+	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSessionRequest_LDAPCredentials) Reset() {
+	*x = CreateSessionRequest_LDAPCredentials{}
+	mi := &file_api_v1_auth_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSessionRequest_LDAPCredentials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSessionRequest_LDAPCredentials) ProtoMessage() {}
+
+func (x *CreateSessionRequest_LDAPCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_auth_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSessionRequest_LDAPCredentials.ProtoReflect.Descriptor instead.
+func (*CreateSessionRequest_LDAPCredentials) Descriptor() ([]byte, []int) {
+	return file_api_v1_auth_service_proto_rawDescGZIP(), []int{3, 2}
+}
+
+func (x *CreateSessionRequest_LDAPCredentials) GetIdpId() int32 {
+	if x != nil {
+		return x.IdpId
+	}
+	return 0
+}
+
+func (x *CreateSessionRequest_LDAPCredentials) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CreateSessionRequest_LDAPCredentials) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
 var File_api_v1_auth_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_auth_service_proto_rawDesc = "" +
 	"\n" +
 	"\x19api/v1/auth_service.proto\x12\fmemos.api.v1\x1a\x19api/v1/user_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x1a\n" +
-	"\x18GetCurrentSessionRequest\"\x89\x01\n" +
+	"\x18GetCurrentSessionRequest\"\xa4\x02\n" +
 	"\x19GetCurrentSessionResponse\x12&\n" +
 	"\x04user\x18\x01 \x01(\v2\x12.memos.api.v1.UserR\x04user\x12D\n" +
-	"\x10last_accessed_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastAccessedAt\"\xb8\x03\n" +
+	"\x10last_accessed_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastAccessedAt\x12\"\n" +
+	"\fimpersonator\x18\x03 \x01(\tR\fimpersonator\x12;\n" +
+	"\vexpire_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x128\n" +
+	"\x18password_change_required\x18\x05 \x01(\bR\x16passwordChangeRequired\"~\n" +
+	"!CreateImpersonationSessionRequest\x12\x17\n" +
+	"\x04user\x18\x01 \x01(\tB\x03\xe0A\x02R\x04user\x12@\n" +
+	"\vexpire_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\n" +
+	"expireTime\"\xe1\x05\n" +
 	"\x14CreateSessionRequest\x12k\n" +
 	"\x14password_credentials\x18\x01 \x01(\v26.memos.api.v1.CreateSessionRequest.PasswordCredentialsH\x00R\x13passwordCredentials\x12\\\n" +
-	"\x0fsso_credentials\x18\x02 \x01(\v21.memos.api.v1.CreateSessionRequest.SSOCredentialsH\x00R\x0essoCredentials\x1aW\n" +
+	"\x0fsso_credentials\x18\x02 \x01(\v21.memos.api.v1.CreateSessionRequest.SSOCredentialsH\x00R\x0essoCredentials\x12_\n" +
+	"\x10ldap_credentials\x18\x04 \x01(\v22.memos.api.v1.CreateSessionRequest.LDAPCredentialsH\x00R\x0fldapCredentials\x12+\n" +
+	"\x0ftwo_factor_code\x18\x03 \x01(\tB\x03\xe0A\x01R\rtwoFactorCode\x12(\n" +
+	"\rcaptcha_token\x18\x05 \x01(\tB\x03\xe0A\x01R\fcaptchaToken\x1aW\n" +
 	"\x13PasswordCredentials\x12\x1f\n" +
 	"\busername\x18\x01 \x01(\tB\x03\xe0A\x02R\busername\x12\x1f\n" +
 	"\bpassword\x18\x02 \x01(\tB\x03\xe0A\x02R\bpassword\x1am\n" +
 	"\x0eSSOCredentials\x12\x1a\n" +
 	"\x06idp_id\x18\x01 \x01(\x05B\x03\xe0A\x02R\x05idpId\x12\x17\n" +
 	"\x04code\x18\x02 \x01(\tB\x03\xe0A\x02R\x04code\x12&\n" +
-	"\fredirect_uri\x18\x03 \x01(\tB\x03\xe0A\x02R\vredirectUriB\r\n" +
+	"\fredirect_uri\x18\x03 \x01(\tB\x03\xe0A\x02R\vredirectUri\x1ao\n" +
+	"\x0fLDAPCredentials\x12\x1a\n" +
+	"\x06idp_id\x18\x01 \x01(\x05B\x03\xe0A\x02R\x05idpId\x12\x1f\n" +
+	"\busername\x18\x02 \x01(\tB\x03\xe0A\x02R\busername\x12\x1f\n" +
+	"\bpassword\x18\x03 \x01(\tB\x03\xe0A\x02R\bpasswordB\r\n" +
 	"\vcredentials\"\x85\x01\n" +
 	"\x15CreateSessionResponse\x12&\n" +
 	"\x04user\x18\x01 \x01(\v2\x12.memos.api.v1.UserR\x04user\x12D\n" +
 	"\x10last_accessed_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastAccessedAt\"\x16\n" +
-	"\x14DeleteSessionRequest2\x8b\x03\n" +
+	"\x14DeleteSessionRequest\"/\n" +
+	"\x12VerifyEmailRequest\x12\x19\n" +
+	"\x05token\x18\x01 \x01(\tB\x03\xe0A\x02R\x05token\"9\n" +
+	"\x1cSendVerificationEmailRequest\x12\x19\n" +
+	"\x05email\x18\x01 \x01(\tB\x03\xe0A\x02R\x05email\"8\n" +
+	"\x1bRequestPasswordResetRequest\x12\x19\n" +
+	"\x05email\x18\x01 \x01(\tB\x03\xe0A\x02R\x05email\"R\n" +
+	"\x14ResetPasswordRequest\x12\x19\n" +
+	"\x05token\x18\x01 \x01(\tB\x03\xe0A\x02R\x05token\x12\x1f\n" +
+	"\bpassword\x18\x02 \x01(\tB\x03\xe0A\x02R\bpassword\"\x1f\n" +
+	"\x1dCreateCaptchaChallengeRequest\"P\n" +
+	"\x10CaptchaChallenge\x12\x1c\n" +
+	"\tchallenge\x18\x01 \x01(\tR\tchallenge\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\x02 \x01(\x05R\n" +
+	"difficulty2\xbe\t\n" +
 	"\vAuthService\x12\x8b\x01\n" +
 	"\x11GetCurrentSession\x12&.memos.api.v1.GetCurrentSessionRequest\x1a'.memos.api.v1.GetCurrentSessionResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/auth/sessions/current\x12z\n" +
-	"\rCreateSession\x12\".memos.api.v1.CreateSessionRequest\x1a#.memos.api.v1.CreateSessionResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/auth/sessions\x12r\n" +
-	"\rDeleteSession\x12\".memos.api.v1.DeleteSessionRequest\x1a\x16.google.protobuf.Empty\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/v1/auth/sessions/currentB\xa8\x01\n" +
+	"\rCreateSession\x12\".memos.api.v1.CreateSessionRequest\x1a#.memos.api.v1.CreateSessionResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/auth/sessions\x12\xa0\x01\n" +
+	"\x1aCreateImpersonationSession\x12/.memos.api.v1.CreateImpersonationSessionRequest\x1a#.memos.api.v1.CreateSessionResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/auth/sessions:impersonate\x12r\n" +
+	"\rDeleteSession\x12\".memos.api.v1.DeleteSessionRequest\x1a\x16.google.protobuf.Empty\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/api/v1/auth/sessions/current\x12m\n" +
+	"\vVerifyEmail\x12 .memos.api.v1.VerifyEmailRequest\x1a\x16.google.protobuf.Empty\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/auth/email:verify\x12\x8b\x01\n" +
+	"\x15SendVerificationEmail\x12*.memos.api.v1.SendVerificationEmailRequest\x1a\x16.google.protobuf.Empty\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/auth/email:sendVerification\x12\x88\x01\n" +
+	"\x14RequestPasswordReset\x12).memos.api.v1.RequestPasswordResetRequest\x1a\x16.google.protobuf.Empty\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/auth/password:requestReset\x12s\n" +
+	"\rResetPassword\x12\".memos.api.v1.ResetPasswordRequest\x1a\x16.google.protobuf.Empty\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/auth/password:reset\x12\x90\x01\n" +
+	"\x16CreateCaptchaChallenge\x12+.memos.api.v1.CreateCaptchaChallengeRequest\x1a\x1e.memos.api.v1.CaptchaChallenge\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/auth/captchaChallengesB\xa8\x01\n" +
 	"\x10com.memos.api.v1B\x10AuthServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -458,37 +958,60 @@ func file_api_v1_auth_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_auth_service_proto_rawDescData
 }
 
-var file_api_v1_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_api_v1_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_api_v1_auth_service_proto_goTypes = []any{
 	(*GetCurrentSessionRequest)(nil),                 // 0: memos.api.v1.GetCurrentSessionRequest
 	(*GetCurrentSessionResponse)(nil),                // 1: memos.api.v1.GetCurrentSessionResponse
-	(*CreateSessionRequest)(nil),                     // 2: memos.api.v1.CreateSessionRequest
-	(*CreateSessionResponse)(nil),                    // 3: memos.api.v1.CreateSessionResponse
-	(*DeleteSessionRequest)(nil),                     // 4: memos.api.v1.DeleteSessionRequest
-	(*CreateSessionRequest_PasswordCredentials)(nil), // 5: memos.api.v1.CreateSessionRequest.PasswordCredentials
-	(*CreateSessionRequest_SSOCredentials)(nil),      // 6: memos.api.v1.CreateSessionRequest.SSOCredentials
-	(*User)(nil),                  // 7: memos.api.v1.User
-	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 9: google.protobuf.Empty
+	(*CreateImpersonationSessionRequest)(nil),        // 2: memos.api.v1.CreateImpersonationSessionRequest
+	(*CreateSessionRequest)(nil),                     // 3: memos.api.v1.CreateSessionRequest
+	(*CreateSessionResponse)(nil),                    // 4: memos.api.v1.CreateSessionResponse
+	(*DeleteSessionRequest)(nil),                     // 5: memos.api.v1.DeleteSessionRequest
+	(*VerifyEmailRequest)(nil),                       // 6: memos.api.v1.VerifyEmailRequest
+	(*SendVerificationEmailRequest)(nil),             // 7: memos.api.v1.SendVerificationEmailRequest
+	(*RequestPasswordResetRequest)(nil),              // 8: memos.api.v1.RequestPasswordResetRequest
+	(*ResetPasswordRequest)(nil),                     // 9: memos.api.v1.ResetPasswordRequest
+	(*CreateCaptchaChallengeRequest)(nil),            // 10: memos.api.v1.CreateCaptchaChallengeRequest
+	(*CaptchaChallenge)(nil),                         // 11: memos.api.v1.CaptchaChallenge
+	(*CreateSessionRequest_PasswordCredentials)(nil), // 12: memos.api.v1.CreateSessionRequest.PasswordCredentials
+	(*CreateSessionRequest_SSOCredentials)(nil),      // 13: memos.api.v1.CreateSessionRequest.SSOCredentials
+	(*CreateSessionRequest_LDAPCredentials)(nil),     // 14: memos.api.v1.CreateSessionRequest.LDAPCredentials
+	(*User)(nil),                  // 15: memos.api.v1.User
+	(*timestamppb.Timestamp)(nil), // 16: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 17: google.protobuf.Empty
 }
 var file_api_v1_auth_service_proto_depIdxs = []int32{
-	7, // 0: memos.api.v1.GetCurrentSessionResponse.user:type_name -> memos.api.v1.User
-	8, // 1: memos.api.v1.GetCurrentSessionResponse.last_accessed_at:type_name -> google.protobuf.Timestamp
-	5, // 2: memos.api.v1.CreateSessionRequest.password_credentials:type_name -> memos.api.v1.CreateSessionRequest.PasswordCredentials
-	6, // 3: memos.api.v1.CreateSessionRequest.sso_credentials:type_name -> memos.api.v1.CreateSessionRequest.SSOCredentials
-	7, // 4: memos.api.v1.CreateSessionResponse.user:type_name -> memos.api.v1.User
-	8, // 5: memos.api.v1.CreateSessionResponse.last_accessed_at:type_name -> google.protobuf.Timestamp
-	0, // 6: memos.api.v1.AuthService.GetCurrentSession:input_type -> memos.api.v1.GetCurrentSessionRequest
-	2, // 7: memos.api.v1.AuthService.CreateSession:input_type -> memos.api.v1.CreateSessionRequest
-	4, // 8: memos.api.v1.AuthService.DeleteSession:input_type -> memos.api.v1.DeleteSessionRequest
-	1, // 9: memos.api.v1.AuthService.GetCurrentSession:output_type -> memos.api.v1.GetCurrentSessionResponse
-	3, // 10: memos.api.v1.AuthService.CreateSession:output_type -> memos.api.v1.CreateSessionResponse
-	9, // 11: memos.api.v1.AuthService.DeleteSession:output_type -> google.protobuf.Empty
-	9, // [9:12] is the sub-list for method output_type
-	6, // [6:9] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	15, // 0: memos.api.v1.GetCurrentSessionResponse.user:type_name -> memos.api.v1.User
+	16, // 1: memos.api.v1.GetCurrentSessionResponse.last_accessed_at:type_name -> google.protobuf.Timestamp
+	16, // 2: memos.api.v1.GetCurrentSessionResponse.expire_time:type_name -> google.protobuf.Timestamp
+	16, // 3: memos.api.v1.CreateImpersonationSessionRequest.expire_time:type_name -> google.protobuf.Timestamp
+	12, // 4: memos.api.v1.CreateSessionRequest.password_credentials:type_name -> memos.api.v1.CreateSessionRequest.PasswordCredentials
+	13, // 5: memos.api.v1.CreateSessionRequest.sso_credentials:type_name -> memos.api.v1.CreateSessionRequest.SSOCredentials
+	14, // 6: memos.api.v1.CreateSessionRequest.ldap_credentials:type_name -> memos.api.v1.CreateSessionRequest.LDAPCredentials
+	15, // 7: memos.api.v1.CreateSessionResponse.user:type_name -> memos.api.v1.User
+	16, // 8: memos.api.v1.CreateSessionResponse.last_accessed_at:type_name -> google.protobuf.Timestamp
+	0,  // 9: memos.api.v1.AuthService.GetCurrentSession:input_type -> memos.api.v1.GetCurrentSessionRequest
+	3,  // 10: memos.api.v1.AuthService.CreateSession:input_type -> memos.api.v1.CreateSessionRequest
+	2,  // 11: memos.api.v1.AuthService.CreateImpersonationSession:input_type -> memos.api.v1.CreateImpersonationSessionRequest
+	5,  // 12: memos.api.v1.AuthService.DeleteSession:input_type -> memos.api.v1.DeleteSessionRequest
+	6,  // 13: memos.api.v1.AuthService.VerifyEmail:input_type -> memos.api.v1.VerifyEmailRequest
+	7,  // 14: memos.api.v1.AuthService.SendVerificationEmail:input_type -> memos.api.v1.SendVerificationEmailRequest
+	8,  // 15: memos.api.v1.AuthService.RequestPasswordReset:input_type -> memos.api.v1.RequestPasswordResetRequest
+	9,  // 16: memos.api.v1.AuthService.ResetPassword:input_type -> memos.api.v1.ResetPasswordRequest
+	10, // 17: memos.api.v1.AuthService.CreateCaptchaChallenge:input_type -> memos.api.v1.CreateCaptchaChallengeRequest
+	1,  // 18: memos.api.v1.AuthService.GetCurrentSession:output_type -> memos.api.v1.GetCurrentSessionResponse
+	4,  // 19: memos.api.v1.AuthService.CreateSession:output_type -> memos.api.v1.CreateSessionResponse
+	4,  // 20: memos.api.v1.AuthService.CreateImpersonationSession:output_type -> memos.api.v1.CreateSessionResponse
+	17, // 21: memos.api.v1.AuthService.DeleteSession:output_type -> google.protobuf.Empty
+	17, // 22: memos.api.v1.AuthService.VerifyEmail:output_type -> google.protobuf.Empty
+	17, // 23: memos.api.v1.AuthService.SendVerificationEmail:output_type -> google.protobuf.Empty
+	17, // 24: memos.api.v1.AuthService.RequestPasswordReset:output_type -> google.protobuf.Empty
+	17, // 25: memos.api.v1.AuthService.ResetPassword:output_type -> google.protobuf.Empty
+	11, // 26: memos.api.v1.AuthService.CreateCaptchaChallenge:output_type -> memos.api.v1.CaptchaChallenge
+	18, // [18:27] is the sub-list for method output_type
+	9,  // [9:18] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_auth_service_proto_init() }
@@ -497,9 +1020,10 @@ func file_api_v1_auth_service_proto_init() {
 		return
 	}
 	file_api_v1_user_service_proto_init()
-	file_api_v1_auth_service_proto_msgTypes[2].OneofWrappers = []any{
+	file_api_v1_auth_service_proto_msgTypes[3].OneofWrappers = []any{
 		(*CreateSessionRequest_PasswordCredentials_)(nil),
 		(*CreateSessionRequest_SsoCredentials)(nil),
+		(*CreateSessionRequest_LdapCredentials)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -507,7 +1031,7 @@ func file_api_v1_auth_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_auth_service_proto_rawDesc), len(file_api_v1_auth_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -119,11 +119,88 @@ func (Direction) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_common_proto_rawDescGZIP(), []int{1}
 }
 
+// Permission is a capability granted to the users by their role.
+type Permission int32
+
+const (
+	Permission_PERMISSION_UNSPECIFIED Permission = 0
+	// Create, update, suspend and delete the users, and manage the invitations.
+	Permission_MANAGE_USERS Permission = 1
+	// List and search all the users.
+	Permission_VIEW_USERS Permission = 2
+	// Update and delete the memos and comments of the other users.
+	Permission_MODERATE_MEMOS Permission = 3
+	// Create webhooks.
+	Permission_MANAGE_WEBHOOKS Permission = 4
+	// Check and repair the integrity of the workspace data.
+	Permission_CHECK_INTEGRITY Permission = 5
+	// Update the workspace settings, but the roles.
+	Permission_MANAGE_WORKSPACE Permission = 6
+	// Migrate the attachments between storages.
+	Permission_MANAGE_STORAGE Permission = 7
+)
+
+// Enum value maps for Permission.
+var (
+	Permission_name = map[int32]string{
+		0: "PERMISSION_UNSPECIFIED",
+		1: "MANAGE_USERS",
+		2: "VIEW_USERS",
+		3: "MODERATE_MEMOS",
+		4: "MANAGE_WEBHOOKS",
+		5: "CHECK_INTEGRITY",
+		6: "MANAGE_WORKSPACE",
+		7: "MANAGE_STORAGE",
+	}
+	Permission_value = map[string]int32{
+		"PERMISSION_UNSPECIFIED": 0,
+		"MANAGE_USERS":           1,
+		"VIEW_USERS":             2,
+		"MODERATE_MEMOS":         3,
+		"MANAGE_WEBHOOKS":        4,
+		"CHECK_INTEGRITY":        5,
+		"MANAGE_WORKSPACE":       6,
+		"MANAGE_STORAGE":         7,
+	}
+)
+
+func (x Permission) Enum() *Permission {
+	p := new(Permission)
+	*p = x
+	return p
+}
+
+func (x Permission) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Permission) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_common_proto_enumTypes[2].Descriptor()
+}
+
+func (Permission) Type() protoreflect.EnumType {
+	return &file_api_v1_common_proto_enumTypes[2]
+}
+
+func (x Permission) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Permission.Descriptor instead.
+func (Permission) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_common_proto_rawDescGZIP(), []int{2}
+}
+
 // Used internally for obfuscating the page token.
 type PageToken struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Limit  int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The relevance score and the id of the last memo of the page, for searches ordered by relevance.
+	LastScore float64 `protobuf:"fixed64,3,opt,name=last_score,json=lastScore,proto3" json:"last_score,omitempty"`
+	LastId    int32   `protobuf:"varint,4,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"`
+	// The seed of a random order, kept across the pages.
+	RandomSeed    int64 `protobuf:"varint,5,opt,name=random_seed,json=randomSeed,proto3" json:"random_seed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -172,14 +249,40 @@ func (x *PageToken) GetOffset() int32 {
 	return 0
 }
 
+func (x *PageToken) GetLastScore() float64 {
+	if x != nil {
+		return x.LastScore
+	}
+	return 0
+}
+
+func (x *PageToken) GetLastId() int32 {
+	if x != nil {
+		return x.LastId
+	}
+	return 0
+}
+
+func (x *PageToken) GetRandomSeed() int64 {
+	if x != nil {
+		return x.RandomSeed
+	}
+	return 0
+}
+
 var File_api_v1_common_proto protoreflect.FileDescriptor
 
 const file_api_v1_common_proto_rawDesc = "" +
 	"\n" +
-	"\x13api/v1/common.proto\x12\fmemos.api.v1\"9\n" +
+	"\x13api/v1/common.proto\x12\fmemos.api.v1\"\x92\x01\n" +
 	"\tPageToken\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x05R\x06offset*8\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x1d\n" +
+	"\n" +
+	"last_score\x18\x03 \x01(\x01R\tlastScore\x12\x17\n" +
+	"\alast_id\x18\x04 \x01(\x05R\x06lastId\x12\x1f\n" +
+	"\vrandom_seed\x18\x05 \x01(\x03R\n" +
+	"randomSeed*8\n" +
 	"\x05State\x12\x15\n" +
 	"\x11STATE_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
@@ -188,7 +291,18 @@ const file_api_v1_common_proto_rawDesc = "" +
 	"\tDirection\x12\x19\n" +
 	"\x15DIRECTION_UNSPECIFIED\x10\x00\x12\a\n" +
 	"\x03ASC\x10\x01\x12\b\n" +
-	"\x04DESC\x10\x02B\xa3\x01\n" +
+	"\x04DESC\x10\x02*\xb2\x01\n" +
+	"\n" +
+	"Permission\x12\x1a\n" +
+	"\x16PERMISSION_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fMANAGE_USERS\x10\x01\x12\x0e\n" +
+	"\n" +
+	"VIEW_USERS\x10\x02\x12\x12\n" +
+	"\x0eMODERATE_MEMOS\x10\x03\x12\x13\n" +
+	"\x0fMANAGE_WEBHOOKS\x10\x04\x12\x13\n" +
+	"\x0fCHECK_INTEGRITY\x10\x05\x12\x14\n" +
+	"\x10MANAGE_WORKSPACE\x10\x06\x12\x12\n" +
+	"\x0eMANAGE_STORAGE\x10\aB\xa3\x01\n" +
 	"\x10com.memos.api.v1B\vCommonProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -203,12 +317,13 @@ func file_api_v1_common_proto_rawDescGZIP() []byte {
 	return file_api_v1_common_proto_rawDescData
 }
 
-var file_api_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
 var file_api_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_api_v1_common_proto_goTypes = []any{
 	(State)(0),        // 0: memos.api.v1.State
 	(Direction)(0),    // 1: memos.api.v1.Direction
-	(*PageToken)(nil), // 2: memos.api.v1.PageToken
+	(Permission)(0),   // 2: memos.api.v1.Permission
+	(*PageToken)(nil), // 3: memos.api.v1.PageToken
 }
 var file_api_v1_common_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -228,7 +343,7 @@ func file_api_v1_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_common_proto_rawDesc), len(file_api_v1_common_proto_rawDesc)),
-			NumEnums:      2,
+			NumEnums:      3,
 			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -0,0 +1,440 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: api/v1/filter_macro_service.proto
+
+package apiv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FilterMacro is a named filter fragment, which the filters of the user reference by its identifier.
+// For example, the macro "work" with the expression `tag in ["work", "meeting"]` makes the filter
+// `work && pinned` match the pinned memos tagged work or meeting.
+type FilterMacro struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the filter macro, whose last segment is the identifier referencing it.
+	// Format: users/{user}/filterMacros/{filter_macro}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The filter expression replacing the identifier, refer to `Shortcut.filter`.
+	// It may reference other macros.
+	Expression    string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterMacro) Reset() {
+	*x = FilterMacro{}
+	mi := &file_api_v1_filter_macro_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterMacro) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterMacro) ProtoMessage() {}
+
+func (x *FilterMacro) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_filter_macro_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterMacro.ProtoReflect.Descriptor instead.
+func (*FilterMacro) Descriptor() ([]byte, []int) {
+	return file_api_v1_filter_macro_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FilterMacro) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FilterMacro) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+type ListFilterMacrosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent resource where filter macros are listed.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFilterMacrosRequest) Reset() {
+	*x = ListFilterMacrosRequest{}
+	mi := &file_api_v1_filter_macro_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFilterMacrosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFilterMacrosRequest) ProtoMessage() {}
+
+func (x *ListFilterMacrosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_filter_macro_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFilterMacrosRequest.ProtoReflect.Descriptor instead.
+func (*ListFilterMacrosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_filter_macro_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListFilterMacrosRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListFilterMacrosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of filter macros.
+	FilterMacros  []*FilterMacro `protobuf:"bytes,1,rep,name=filter_macros,json=filterMacros,proto3" json:"filter_macros,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFilterMacrosResponse) Reset() {
+	*x = ListFilterMacrosResponse{}
+	mi := &file_api_v1_filter_macro_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFilterMacrosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFilterMacrosResponse) ProtoMessage() {}
+
+func (x *ListFilterMacrosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_filter_macro_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFilterMacrosResponse.ProtoReflect.Descriptor instead.
+func (*ListFilterMacrosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_filter_macro_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListFilterMacrosResponse) GetFilterMacros() []*FilterMacro {
+	if x != nil {
+		return x.FilterMacros
+	}
+	return nil
+}
+
+type CreateFilterMacroRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent resource where this filter macro will be created.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The filter macro to create.
+	FilterMacro *FilterMacro `protobuf:"bytes,2,opt,name=filter_macro,json=filterMacro,proto3" json:"filter_macro,omitempty"`
+	// Required. The identifier of the filter macro, which becomes the last segment of its name.
+	// It consists of lower case letters, digits and underscores, and must not be a filter attribute or function.
+	FilterMacroId string `protobuf:"bytes,3,opt,name=filter_macro_id,json=filterMacroId,proto3" json:"filter_macro_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFilterMacroRequest) Reset() {
+	*x = CreateFilterMacroRequest{}
+	mi := &file_api_v1_filter_macro_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFilterMacroRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFilterMacroRequest) ProtoMessage() {}
+
+func (x *CreateFilterMacroRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_filter_macro_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFilterMacroRequest.ProtoReflect.Descriptor instead.
+func (*CreateFilterMacroRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_filter_macro_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateFilterMacroRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateFilterMacroRequest) GetFilterMacro() *FilterMacro {
+	if x != nil {
+		return x.FilterMacro
+	}
+	return nil
+}
+
+func (x *CreateFilterMacroRequest) GetFilterMacroId() string {
+	if x != nil {
+		return x.FilterMacroId
+	}
+	return ""
+}
+
+type UpdateFilterMacroRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The filter macro resource which replaces the resource on the server.
+	FilterMacro *FilterMacro `protobuf:"bytes,1,opt,name=filter_macro,json=filterMacro,proto3" json:"filter_macro,omitempty"`
+	// Required. The list of fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFilterMacroRequest) Reset() {
+	*x = UpdateFilterMacroRequest{}
+	mi := &file_api_v1_filter_macro_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFilterMacroRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFilterMacroRequest) ProtoMessage() {}
+
+func (x *UpdateFilterMacroRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_filter_macro_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFilterMacroRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFilterMacroRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_filter_macro_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateFilterMacroRequest) GetFilterMacro() *FilterMacro {
+	if x != nil {
+		return x.FilterMacro
+	}
+	return nil
+}
+
+func (x *UpdateFilterMacroRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteFilterMacroRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the filter macro to delete.
+	// Format: users/{user}/filterMacros/{filter_macro}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFilterMacroRequest) Reset() {
+	*x = DeleteFilterMacroRequest{}
+	mi := &file_api_v1_filter_macro_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFilterMacroRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFilterMacroRequest) ProtoMessage() {}
+
+func (x *DeleteFilterMacroRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_filter_macro_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFilterMacroRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFilterMacroRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_filter_macro_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteFilterMacroRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_api_v1_filter_macro_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_filter_macro_service_proto_rawDesc = "" +
+	"\n" +
+	"!api/v1/filter_macro_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"\xaf\x01\n" +
+	"\vFilterMacro\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12#\n" +
+	"\n" +
+	"expression\x18\x02 \x01(\tB\x03\xe0A\x02R\n" +
+	"expression:b\xeaA_\n" +
+	"\x18memos.api.v1/FilterMacro\x12(users/{user}/filterMacros/{filter_macro}*\ffilterMacros2\vfilterMacro\"S\n" +
+	"\x17ListFilterMacrosRequest\x128\n" +
+	"\x06parent\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\x12\x18memos.api.v1/FilterMacroR\x06parent\"Z\n" +
+	"\x18ListFilterMacrosResponse\x12>\n" +
+	"\rfilter_macros\x18\x01 \x03(\v2\x19.memos.api.v1.FilterMacroR\ffilterMacros\"\xc4\x01\n" +
+	"\x18CreateFilterMacroRequest\x128\n" +
+	"\x06parent\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\x12\x18memos.api.v1/FilterMacroR\x06parent\x12A\n" +
+	"\ffilter_macro\x18\x02 \x01(\v2\x19.memos.api.v1.FilterMacroB\x03\xe0A\x02R\vfilterMacro\x12+\n" +
+	"\x0ffilter_macro_id\x18\x03 \x01(\tB\x03\xe0A\x02R\rfilterMacroId\"\x9f\x01\n" +
+	"\x18UpdateFilterMacroRequest\x12A\n" +
+	"\ffilter_macro\x18\x01 \x01(\v2\x19.memos.api.v1.FilterMacroB\x03\xe0A\x02R\vfilterMacro\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
+	"updateMask\"P\n" +
+	"\x18DeleteFilterMacroRequest\x124\n" +
+	"\x04name\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\n" +
+	"\x18memos.api.v1/FilterMacroR\x04name2\xa6\x05\n" +
+	"\x12FilterMacroService\x12\x99\x01\n" +
+	"\x10ListFilterMacros\x12%.memos.api.v1.ListFilterMacrosRequest\x1a&.memos.api.v1.ListFilterMacrosResponse\"6\xdaA\x06parent\x82\xd3\xe4\x93\x02'\x12%/api/v1/{parent=users/*}/filterMacros\x12\xa9\x01\n" +
+	"\x11CreateFilterMacro\x12&.memos.api.v1.CreateFilterMacroRequest\x1a\x19.memos.api.v1.FilterMacro\"Q\xdaA\x13parent,filter_macro\x82\xd3\xe4\x93\x025:\ffilter_macro\"%/api/v1/{parent=users/*}/filterMacros\x12\xbb\x01\n" +
+	"\x11UpdateFilterMacro\x12&.memos.api.v1.UpdateFilterMacroRequest\x1a\x19.memos.api.v1.FilterMacro\"c\xdaA\x18filter_macro,update_mask\x82\xd3\xe4\x93\x02B:\ffilter_macro22/api/v1/{filter_macro.name=users/*/filterMacros/*}\x12\x89\x01\n" +
+	"\x11DeleteFilterMacro\x12&.memos.api.v1.DeleteFilterMacroRequest\x1a\x16.google.protobuf.Empty\"4\xdaA\x04name\x82\xd3\xe4\x93\x02'*%/api/v1/{name=users/*/filterMacros/*}B\xaf\x01\n" +
+	"\x10com.memos.api.v1B\x17FilterMacroServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
+
+var (
+	file_api_v1_filter_macro_service_proto_rawDescOnce sync.Once
+	file_api_v1_filter_macro_service_proto_rawDescData []byte
+)
+
+func file_api_v1_filter_macro_service_proto_rawDescGZIP() []byte {
+	file_api_v1_filter_macro_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_filter_macro_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_filter_macro_service_proto_rawDesc), len(file_api_v1_filter_macro_service_proto_rawDesc)))
+	})
+	return file_api_v1_filter_macro_service_proto_rawDescData
+}
+
+var file_api_v1_filter_macro_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_v1_filter_macro_service_proto_goTypes = []any{
+	(*FilterMacro)(nil),              // 0: memos.api.v1.FilterMacro
+	(*ListFilterMacrosRequest)(nil),  // 1: memos.api.v1.ListFilterMacrosRequest
+	(*ListFilterMacrosResponse)(nil), // 2: memos.api.v1.ListFilterMacrosResponse
+	(*CreateFilterMacroRequest)(nil), // 3: memos.api.v1.CreateFilterMacroRequest
+	(*UpdateFilterMacroRequest)(nil), // 4: memos.api.v1.UpdateFilterMacroRequest
+	(*DeleteFilterMacroRequest)(nil), // 5: memos.api.v1.DeleteFilterMacroRequest
+	(*fieldmaskpb.FieldMask)(nil),    // 6: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),            // 7: google.protobuf.Empty
+}
+var file_api_v1_filter_macro_service_proto_depIdxs = []int32{
+	0, // 0: memos.api.v1.ListFilterMacrosResponse.filter_macros:type_name -> memos.api.v1.FilterMacro
+	0, // 1: memos.api.v1.CreateFilterMacroRequest.filter_macro:type_name -> memos.api.v1.FilterMacro
+	0, // 2: memos.api.v1.UpdateFilterMacroRequest.filter_macro:type_name -> memos.api.v1.FilterMacro
+	6, // 3: memos.api.v1.UpdateFilterMacroRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1, // 4: memos.api.v1.FilterMacroService.ListFilterMacros:input_type -> memos.api.v1.ListFilterMacrosRequest
+	3, // 5: memos.api.v1.FilterMacroService.CreateFilterMacro:input_type -> memos.api.v1.CreateFilterMacroRequest
+	4, // 6: memos.api.v1.FilterMacroService.UpdateFilterMacro:input_type -> memos.api.v1.UpdateFilterMacroRequest
+	5, // 7: memos.api.v1.FilterMacroService.DeleteFilterMacro:input_type -> memos.api.v1.DeleteFilterMacroRequest
+	2, // 8: memos.api.v1.FilterMacroService.ListFilterMacros:output_type -> memos.api.v1.ListFilterMacrosResponse
+	0, // 9: memos.api.v1.FilterMacroService.CreateFilterMacro:output_type -> memos.api.v1.FilterMacro
+	0, // 10: memos.api.v1.FilterMacroService.UpdateFilterMacro:output_type -> memos.api.v1.FilterMacro
+	7, // 11: memos.api.v1.FilterMacroService.DeleteFilterMacro:output_type -> google.protobuf.Empty
+	8, // [8:12] is the sub-list for method output_type
+	4, // [4:8] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_filter_macro_service_proto_init() }
+func file_api_v1_filter_macro_service_proto_init() {
+	if File_api_v1_filter_macro_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_filter_macro_service_proto_rawDesc), len(file_api_v1_filter_macro_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_filter_macro_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_filter_macro_service_proto_depIdxs,
+		MessageInfos:      file_api_v1_filter_macro_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_filter_macro_service_proto = out.File
+	file_api_v1_filter_macro_service_proto_goTypes = nil
+	file_api_v1_filter_macro_service_proto_depIdxs = nil
+}
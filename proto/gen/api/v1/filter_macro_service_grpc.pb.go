@@ -0,0 +1,244 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/v1/filter_macro_service.proto
+
+package apiv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	FilterMacroService_ListFilterMacros_FullMethodName  = "/memos.api.v1.FilterMacroService/ListFilterMacros"
+	FilterMacroService_CreateFilterMacro_FullMethodName = "/memos.api.v1.FilterMacroService/CreateFilterMacro"
+	FilterMacroService_UpdateFilterMacro_FullMethodName = "/memos.api.v1.FilterMacroService/UpdateFilterMacro"
+	FilterMacroService_DeleteFilterMacro_FullMethodName = "/memos.api.v1.FilterMacroService/DeleteFilterMacro"
+)
+
+// FilterMacroServiceClient is the client API for FilterMacroService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FilterMacroServiceClient interface {
+	// ListFilterMacros returns the filter macros of a user.
+	ListFilterMacros(ctx context.Context, in *ListFilterMacrosRequest, opts ...grpc.CallOption) (*ListFilterMacrosResponse, error)
+	// CreateFilterMacro creates a new filter macro for a user.
+	CreateFilterMacro(ctx context.Context, in *CreateFilterMacroRequest, opts ...grpc.CallOption) (*FilterMacro, error)
+	// UpdateFilterMacro updates the expression of a filter macro.
+	UpdateFilterMacro(ctx context.Context, in *UpdateFilterMacroRequest, opts ...grpc.CallOption) (*FilterMacro, error)
+	// DeleteFilterMacro deletes a filter macro for a user.
+	DeleteFilterMacro(ctx context.Context, in *DeleteFilterMacroRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type filterMacroServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFilterMacroServiceClient(cc grpc.ClientConnInterface) FilterMacroServiceClient {
+	return &filterMacroServiceClient{cc}
+}
+
+func (c *filterMacroServiceClient) ListFilterMacros(ctx context.Context, in *ListFilterMacrosRequest, opts ...grpc.CallOption) (*ListFilterMacrosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFilterMacrosResponse)
+	err := c.cc.Invoke(ctx, FilterMacroService_ListFilterMacros_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filterMacroServiceClient) CreateFilterMacro(ctx context.Context, in *CreateFilterMacroRequest, opts ...grpc.CallOption) (*FilterMacro, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FilterMacro)
+	err := c.cc.Invoke(ctx, FilterMacroService_CreateFilterMacro_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filterMacroServiceClient) UpdateFilterMacro(ctx context.Context, in *UpdateFilterMacroRequest, opts ...grpc.CallOption) (*FilterMacro, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FilterMacro)
+	err := c.cc.Invoke(ctx, FilterMacroService_UpdateFilterMacro_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filterMacroServiceClient) DeleteFilterMacro(ctx context.Context, in *DeleteFilterMacroRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FilterMacroService_DeleteFilterMacro_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FilterMacroServiceServer is the server API for FilterMacroService service.
+// All implementations must embed UnimplementedFilterMacroServiceServer
+// for forward compatibility.
+type FilterMacroServiceServer interface {
+	// ListFilterMacros returns the filter macros of a user.
+	ListFilterMacros(context.Context, *ListFilterMacrosRequest) (*ListFilterMacrosResponse, error)
+	// CreateFilterMacro creates a new filter macro for a user.
+	CreateFilterMacro(context.Context, *CreateFilterMacroRequest) (*FilterMacro, error)
+	// UpdateFilterMacro updates the expression of a filter macro.
+	UpdateFilterMacro(context.Context, *UpdateFilterMacroRequest) (*FilterMacro, error)
+	// DeleteFilterMacro deletes a filter macro for a user.
+	DeleteFilterMacro(context.Context, *DeleteFilterMacroRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedFilterMacroServiceServer()
+}
+
+// UnimplementedFilterMacroServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFilterMacroServiceServer struct{}
+
+func (UnimplementedFilterMacroServiceServer) ListFilterMacros(context.Context, *ListFilterMacrosRequest) (*ListFilterMacrosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFilterMacros not implemented")
+}
+func (UnimplementedFilterMacroServiceServer) CreateFilterMacro(context.Context, *CreateFilterMacroRequest) (*FilterMacro, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFilterMacro not implemented")
+}
+func (UnimplementedFilterMacroServiceServer) UpdateFilterMacro(context.Context, *UpdateFilterMacroRequest) (*FilterMacro, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFilterMacro not implemented")
+}
+func (UnimplementedFilterMacroServiceServer) DeleteFilterMacro(context.Context, *DeleteFilterMacroRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFilterMacro not implemented")
+}
+func (UnimplementedFilterMacroServiceServer) mustEmbedUnimplementedFilterMacroServiceServer() {}
+func (UnimplementedFilterMacroServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeFilterMacroServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FilterMacroServiceServer will
+// result in compilation errors.
+type UnsafeFilterMacroServiceServer interface {
+	mustEmbedUnimplementedFilterMacroServiceServer()
+}
+
+func RegisterFilterMacroServiceServer(s grpc.ServiceRegistrar, srv FilterMacroServiceServer) {
+	// If the following call pancis, it indicates UnimplementedFilterMacroServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FilterMacroService_ServiceDesc, srv)
+}
+
+func _FilterMacroService_ListFilterMacros_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFilterMacrosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilterMacroServiceServer).ListFilterMacros(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FilterMacroService_ListFilterMacros_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilterMacroServiceServer).ListFilterMacros(ctx, req.(*ListFilterMacrosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilterMacroService_CreateFilterMacro_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFilterMacroRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilterMacroServiceServer).CreateFilterMacro(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FilterMacroService_CreateFilterMacro_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilterMacroServiceServer).CreateFilterMacro(ctx, req.(*CreateFilterMacroRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilterMacroService_UpdateFilterMacro_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFilterMacroRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilterMacroServiceServer).UpdateFilterMacro(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FilterMacroService_UpdateFilterMacro_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilterMacroServiceServer).UpdateFilterMacro(ctx, req.(*UpdateFilterMacroRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FilterMacroService_DeleteFilterMacro_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFilterMacroRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilterMacroServiceServer).DeleteFilterMacro(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FilterMacroService_DeleteFilterMacro_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilterMacroServiceServer).DeleteFilterMacro(ctx, req.(*DeleteFilterMacroRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FilterMacroService_ServiceDesc is the grpc.ServiceDesc for FilterMacroService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FilterMacroService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "memos.api.v1.FilterMacroService",
+	HandlerType: (*FilterMacroServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListFilterMacros",
+			Handler:    _FilterMacroService_ListFilterMacros_Handler,
+		},
+		{
+			MethodName: "CreateFilterMacro",
+			Handler:    _FilterMacroService_CreateFilterMacro_Handler,
+		},
+		{
+			MethodName: "UpdateFilterMacro",
+			Handler:    _FilterMacroService_UpdateFilterMacro_Handler,
+		},
+		{
+			MethodName: "DeleteFilterMacro",
+			Handler:    _FilterMacroService_DeleteFilterMacro_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/filter_macro_service.proto",
+}
@@ -88,6 +88,14 @@ const (
 	Inbox_MEMO_COMMENT Inbox_Type = 1
 	// Version update notification.
 	Inbox_VERSION_UPDATE Inbox_Type = 2
+	// Memo mention notification.
+	Inbox_MEMO_MENTION Inbox_Type = 3
+	// Memos created on this day in past years notification.
+	Inbox_MEMO_ON_THIS_DAY Inbox_Type = 4
+	// Memo reaction notification.
+	Inbox_MEMO_REACTION Inbox_Type = 5
+	// Reply to a comment notification, i.e. a comment on a memo the receiver commented on.
+	Inbox_MEMO_COMMENT_REPLY Inbox_Type = 6
 )
 
 // Enum value maps for Inbox_Type.
@@ -96,11 +104,19 @@ var (
 		0: "TYPE_UNSPECIFIED",
 		1: "MEMO_COMMENT",
 		2: "VERSION_UPDATE",
+		3: "MEMO_MENTION",
+		4: "MEMO_ON_THIS_DAY",
+		5: "MEMO_REACTION",
+		6: "MEMO_COMMENT_REPLY",
 	}
 	Inbox_Type_value = map[string]int32{
-		"TYPE_UNSPECIFIED": 0,
-		"MEMO_COMMENT":     1,
-		"VERSION_UPDATE":   2,
+		"TYPE_UNSPECIFIED":   0,
+		"MEMO_COMMENT":       1,
+		"VERSION_UPDATE":     2,
+		"MEMO_MENTION":       3,
+		"MEMO_ON_THIS_DAY":   4,
+		"MEMO_REACTION":      5,
+		"MEMO_COMMENT_REPLY": 6,
 	}
 )
 
@@ -149,7 +165,12 @@ type Inbox struct {
 	// The type of the inbox notification.
 	Type Inbox_Type `protobuf:"varint,6,opt,name=type,proto3,enum=memos.api.v1.Inbox_Type" json:"type,omitempty"`
 	// Optional. The activity ID associated with this inbox notification.
-	ActivityId    *int32 `protobuf:"varint,7,opt,name=activity_id,json=activityId,proto3,oneof" json:"activity_id,omitempty"`
+	// The activity of the latest event for the aggregated notifications.
+	ActivityId *int32 `protobuf:"varint,7,opt,name=activity_id,json=activityId,proto3,oneof" json:"activity_id,omitempty"`
+	// Output only. The number of events aggregated into the notification.
+	// The unread comments, replies and reactions on the same memo are aggregated into one notification,
+	// whose sender, activity and creation time are those of the latest event.
+	Count         int32 `protobuf:"varint,8,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -233,6 +254,13 @@ func (x *Inbox) GetActivityId() int32 {
 	return 0
 }
 
+func (x *Inbox) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 type ListInboxesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The parent resource whose inboxes will be listed.
@@ -496,11 +524,213 @@ func (x *DeleteInboxRequest) GetName() string {
 	return ""
 }
 
+type MarkAllInboxReadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The user whose inboxes are marked as read.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAllInboxReadRequest) Reset() {
+	*x = MarkAllInboxReadRequest{}
+	mi := &file_api_v1_inbox_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAllInboxReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllInboxReadRequest) ProtoMessage() {}
+
+func (x *MarkAllInboxReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_inbox_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllInboxReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkAllInboxReadRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_inbox_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MarkAllInboxReadRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type MarkAllInboxReadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of inboxes marked as read.
+	UpdatedCount  int32 `protobuf:"varint,1,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAllInboxReadResponse) Reset() {
+	*x = MarkAllInboxReadResponse{}
+	mi := &file_api_v1_inbox_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAllInboxReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllInboxReadResponse) ProtoMessage() {}
+
+func (x *MarkAllInboxReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_inbox_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllInboxReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkAllInboxReadResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_inbox_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MarkAllInboxReadResponse) GetUpdatedCount() int32 {
+	if x != nil {
+		return x.UpdatedCount
+	}
+	return 0
+}
+
+type BatchDeleteInboxesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The user whose inboxes are deleted.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Optional. The resource names of the inboxes to delete.
+	// Format: inboxes/{inbox}
+	Names []string `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	// Optional. The status of the inboxes to delete, e.g. ARCHIVED to delete all the read inboxes.
+	// Either names or status is required, the inboxes matching both being deleted if both are set.
+	Status        Inbox_Status `protobuf:"varint,3,opt,name=status,proto3,enum=memos.api.v1.Inbox_Status" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteInboxesRequest) Reset() {
+	*x = BatchDeleteInboxesRequest{}
+	mi := &file_api_v1_inbox_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteInboxesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteInboxesRequest) ProtoMessage() {}
+
+func (x *BatchDeleteInboxesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_inbox_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteInboxesRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteInboxesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_inbox_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BatchDeleteInboxesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *BatchDeleteInboxesRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *BatchDeleteInboxesRequest) GetStatus() Inbox_Status {
+	if x != nil {
+		return x.Status
+	}
+	return Inbox_STATUS_UNSPECIFIED
+}
+
+type BatchDeleteInboxesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of inboxes deleted.
+	DeletedCount  int32 `protobuf:"varint,1,opt,name=deleted_count,json=deletedCount,proto3" json:"deleted_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteInboxesResponse) Reset() {
+	*x = BatchDeleteInboxesResponse{}
+	mi := &file_api_v1_inbox_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteInboxesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteInboxesResponse) ProtoMessage() {}
+
+func (x *BatchDeleteInboxesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_inbox_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteInboxesResponse.ProtoReflect.Descriptor instead.
+func (*BatchDeleteInboxesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_inbox_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BatchDeleteInboxesResponse) GetDeletedCount() int32 {
+	if x != nil {
+		return x.DeletedCount
+	}
+	return 0
+}
+
 var File_api_v1_inbox_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_inbox_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1aapi/v1/inbox_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x87\x04\n" +
+	"\x1aapi/v1/inbox_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf6\x04\n" +
 	"\x05Inbox\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x1b\n" +
 	"\x06sender\x18\x02 \x01(\tB\x03\xe0A\x03R\x06sender\x12\x1f\n" +
@@ -510,16 +740,21 @@ const file_api_v1_inbox_service_proto_rawDesc = "" +
 	"createTime\x121\n" +
 	"\x04type\x18\x06 \x01(\x0e2\x18.memos.api.v1.Inbox.TypeB\x03\xe0A\x03R\x04type\x12)\n" +
 	"\vactivity_id\x18\a \x01(\x05B\x03\xe0A\x01H\x00R\n" +
-	"activityId\x88\x01\x01\":\n" +
+	"activityId\x88\x01\x01\x12\x19\n" +
+	"\x05count\x18\b \x01(\x05B\x03\xe0A\x03R\x05count\":\n" +
 	"\x06Status\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
 	"\x06UNREAD\x10\x01\x12\f\n" +
-	"\bARCHIVED\x10\x02\"B\n" +
+	"\bARCHIVED\x10\x02\"\x95\x01\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
 	"\fMEMO_COMMENT\x10\x01\x12\x12\n" +
-	"\x0eVERSION_UPDATE\x10\x02:>\xeaA;\n" +
+	"\x0eVERSION_UPDATE\x10\x02\x12\x10\n" +
+	"\fMEMO_MENTION\x10\x03\x12\x14\n" +
+	"\x10MEMO_ON_THIS_DAY\x10\x04\x12\x11\n" +
+	"\rMEMO_REACTION\x10\x05\x12\x16\n" +
+	"\x12MEMO_COMMENT_REPLY\x10\x06:>\xeaA;\n" +
 	"\x12memos.api.v1/Inbox\x12\x0finboxes/{inbox}\x1a\x04name*\ainboxes2\x05inboxB\x0e\n" +
 	"\f_activity_id\"\xca\x01\n" +
 	"\x12ListInboxesRequest\x121\n" +
@@ -542,11 +777,26 @@ const file_api_v1_inbox_service_proto_rawDesc = "" +
 	"\rallow_missing\x18\x03 \x01(\bB\x03\xe0A\x01R\fallowMissing\"D\n" +
 	"\x12DeleteInboxRequest\x12.\n" +
 	"\x04name\x18\x01 \x01(\tB\x1a\xe0A\x02\xfaA\x14\n" +
-	"\x12memos.api.v1/InboxR\x04name2\x92\x03\n" +
+	"\x12memos.api.v1/InboxR\x04name\"L\n" +
+	"\x17MarkAllInboxReadRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\"?\n" +
+	"\x18MarkAllInboxReadResponse\x12#\n" +
+	"\rupdated_count\x18\x01 \x01(\x05R\fupdatedCount\"\xb9\x01\n" +
+	"\x19BatchDeleteInboxesRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\x120\n" +
+	"\x05names\x18\x02 \x03(\tB\x1a\xe0A\x01\xfaA\x14\n" +
+	"\x12memos.api.v1/InboxR\x05names\x127\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1a.memos.api.v1.Inbox.StatusB\x03\xe0A\x01R\x06status\"A\n" +
+	"\x1aBatchDeleteInboxesResponse\x12#\n" +
+	"\rdeleted_count\x18\x01 \x01(\x05R\fdeletedCount2\xea\x05\n" +
 	"\fInboxService\x12\x85\x01\n" +
 	"\vListInboxes\x12 .memos.api.v1.ListInboxesRequest\x1a!.memos.api.v1.ListInboxesResponse\"1\xdaA\x06parent\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{parent=users/*}/inboxes\x12\x87\x01\n" +
 	"\vUpdateInbox\x12 .memos.api.v1.UpdateInboxRequest\x1a\x13.memos.api.v1.Inbox\"A\xdaA\x11inbox,update_mask\x82\xd3\xe4\x93\x02':\x05inbox2\x1e/api/v1/{inbox.name=inboxes/*}\x12p\n" +
-	"\vDeleteInbox\x12 .memos.api.v1.DeleteInboxRequest\x1a\x16.google.protobuf.Empty\"'\xdaA\x04name\x82\xd3\xe4\x93\x02\x1a*\x18/api/v1/{name=inboxes/*}B\xa9\x01\n" +
+	"\vDeleteInbox\x12 .memos.api.v1.DeleteInboxRequest\x1a\x16.google.protobuf.Empty\"'\xdaA\x04name\x82\xd3\xe4\x93\x02\x1a*\x18/api/v1/{name=inboxes/*}\x12\xa3\x01\n" +
+	"\x10MarkAllInboxRead\x12%.memos.api.v1.MarkAllInboxReadRequest\x1a&.memos.api.v1.MarkAllInboxReadResponse\"@\xdaA\x06parent\x82\xd3\xe4\x93\x021:\x01*\",/api/v1/{parent=users/*}/inboxes:markAllRead\x12\xaf\x01\n" +
+	"\x12BatchDeleteInboxes\x12'.memos.api.v1.BatchDeleteInboxesRequest\x1a(.memos.api.v1.BatchDeleteInboxesResponse\"F\xdaA\fparent,names\x82\xd3\xe4\x93\x021:\x01*\",/api/v1/{parent=users/*}/inboxes:batchDeleteB\xa9\x01\n" +
 	"\x10com.memos.api.v1B\x11InboxServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -562,37 +812,46 @@ func file_api_v1_inbox_service_proto_rawDescGZIP() []byte {
 }
 
 var file_api_v1_inbox_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_inbox_service_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_api_v1_inbox_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_api_v1_inbox_service_proto_goTypes = []any{
-	(Inbox_Status)(0),             // 0: memos.api.v1.Inbox.Status
-	(Inbox_Type)(0),               // 1: memos.api.v1.Inbox.Type
-	(*Inbox)(nil),                 // 2: memos.api.v1.Inbox
-	(*ListInboxesRequest)(nil),    // 3: memos.api.v1.ListInboxesRequest
-	(*ListInboxesResponse)(nil),   // 4: memos.api.v1.ListInboxesResponse
-	(*UpdateInboxRequest)(nil),    // 5: memos.api.v1.UpdateInboxRequest
-	(*DeleteInboxRequest)(nil),    // 6: memos.api.v1.DeleteInboxRequest
-	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil), // 8: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),         // 9: google.protobuf.Empty
+	(Inbox_Status)(0),                  // 0: memos.api.v1.Inbox.Status
+	(Inbox_Type)(0),                    // 1: memos.api.v1.Inbox.Type
+	(*Inbox)(nil),                      // 2: memos.api.v1.Inbox
+	(*ListInboxesRequest)(nil),         // 3: memos.api.v1.ListInboxesRequest
+	(*ListInboxesResponse)(nil),        // 4: memos.api.v1.ListInboxesResponse
+	(*UpdateInboxRequest)(nil),         // 5: memos.api.v1.UpdateInboxRequest
+	(*DeleteInboxRequest)(nil),         // 6: memos.api.v1.DeleteInboxRequest
+	(*MarkAllInboxReadRequest)(nil),    // 7: memos.api.v1.MarkAllInboxReadRequest
+	(*MarkAllInboxReadResponse)(nil),   // 8: memos.api.v1.MarkAllInboxReadResponse
+	(*BatchDeleteInboxesRequest)(nil),  // 9: memos.api.v1.BatchDeleteInboxesRequest
+	(*BatchDeleteInboxesResponse)(nil), // 10: memos.api.v1.BatchDeleteInboxesResponse
+	(*timestamppb.Timestamp)(nil),      // 11: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),      // 12: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),              // 13: google.protobuf.Empty
 }
 var file_api_v1_inbox_service_proto_depIdxs = []int32{
-	0, // 0: memos.api.v1.Inbox.status:type_name -> memos.api.v1.Inbox.Status
-	7, // 1: memos.api.v1.Inbox.create_time:type_name -> google.protobuf.Timestamp
-	1, // 2: memos.api.v1.Inbox.type:type_name -> memos.api.v1.Inbox.Type
-	2, // 3: memos.api.v1.ListInboxesResponse.inboxes:type_name -> memos.api.v1.Inbox
-	2, // 4: memos.api.v1.UpdateInboxRequest.inbox:type_name -> memos.api.v1.Inbox
-	8, // 5: memos.api.v1.UpdateInboxRequest.update_mask:type_name -> google.protobuf.FieldMask
-	3, // 6: memos.api.v1.InboxService.ListInboxes:input_type -> memos.api.v1.ListInboxesRequest
-	5, // 7: memos.api.v1.InboxService.UpdateInbox:input_type -> memos.api.v1.UpdateInboxRequest
-	6, // 8: memos.api.v1.InboxService.DeleteInbox:input_type -> memos.api.v1.DeleteInboxRequest
-	4, // 9: memos.api.v1.InboxService.ListInboxes:output_type -> memos.api.v1.ListInboxesResponse
-	2, // 10: memos.api.v1.InboxService.UpdateInbox:output_type -> memos.api.v1.Inbox
-	9, // 11: memos.api.v1.InboxService.DeleteInbox:output_type -> google.protobuf.Empty
-	9, // [9:12] is the sub-list for method output_type
-	6, // [6:9] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	0,  // 0: memos.api.v1.Inbox.status:type_name -> memos.api.v1.Inbox.Status
+	11, // 1: memos.api.v1.Inbox.create_time:type_name -> google.protobuf.Timestamp
+	1,  // 2: memos.api.v1.Inbox.type:type_name -> memos.api.v1.Inbox.Type
+	2,  // 3: memos.api.v1.ListInboxesResponse.inboxes:type_name -> memos.api.v1.Inbox
+	2,  // 4: memos.api.v1.UpdateInboxRequest.inbox:type_name -> memos.api.v1.Inbox
+	12, // 5: memos.api.v1.UpdateInboxRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 6: memos.api.v1.BatchDeleteInboxesRequest.status:type_name -> memos.api.v1.Inbox.Status
+	3,  // 7: memos.api.v1.InboxService.ListInboxes:input_type -> memos.api.v1.ListInboxesRequest
+	5,  // 8: memos.api.v1.InboxService.UpdateInbox:input_type -> memos.api.v1.UpdateInboxRequest
+	6,  // 9: memos.api.v1.InboxService.DeleteInbox:input_type -> memos.api.v1.DeleteInboxRequest
+	7,  // 10: memos.api.v1.InboxService.MarkAllInboxRead:input_type -> memos.api.v1.MarkAllInboxReadRequest
+	9,  // 11: memos.api.v1.InboxService.BatchDeleteInboxes:input_type -> memos.api.v1.BatchDeleteInboxesRequest
+	4,  // 12: memos.api.v1.InboxService.ListInboxes:output_type -> memos.api.v1.ListInboxesResponse
+	2,  // 13: memos.api.v1.InboxService.UpdateInbox:output_type -> memos.api.v1.Inbox
+	13, // 14: memos.api.v1.InboxService.DeleteInbox:output_type -> google.protobuf.Empty
+	8,  // 15: memos.api.v1.InboxService.MarkAllInboxRead:output_type -> memos.api.v1.MarkAllInboxReadResponse
+	10, // 16: memos.api.v1.InboxService.BatchDeleteInboxes:output_type -> memos.api.v1.BatchDeleteInboxesResponse
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_inbox_service_proto_init() }
@@ -607,7 +866,7 @@ func file_api_v1_inbox_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_inbox_service_proto_rawDesc), len(file_api_v1_inbox_service_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   5,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
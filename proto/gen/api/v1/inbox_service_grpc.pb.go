@@ -20,9 +20,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	InboxService_ListInboxes_FullMethodName = "/memos.api.v1.InboxService/ListInboxes"
-	InboxService_UpdateInbox_FullMethodName = "/memos.api.v1.InboxService/UpdateInbox"
-	InboxService_DeleteInbox_FullMethodName = "/memos.api.v1.InboxService/DeleteInbox"
+	InboxService_ListInboxes_FullMethodName        = "/memos.api.v1.InboxService/ListInboxes"
+	InboxService_UpdateInbox_FullMethodName        = "/memos.api.v1.InboxService/UpdateInbox"
+	InboxService_DeleteInbox_FullMethodName        = "/memos.api.v1.InboxService/DeleteInbox"
+	InboxService_MarkAllInboxRead_FullMethodName   = "/memos.api.v1.InboxService/MarkAllInboxRead"
+	InboxService_BatchDeleteInboxes_FullMethodName = "/memos.api.v1.InboxService/BatchDeleteInboxes"
 )
 
 // InboxServiceClient is the client API for InboxService service.
@@ -35,6 +37,10 @@ type InboxServiceClient interface {
 	UpdateInbox(ctx context.Context, in *UpdateInboxRequest, opts ...grpc.CallOption) (*Inbox, error)
 	// DeleteInbox deletes an inbox.
 	DeleteInbox(ctx context.Context, in *DeleteInboxRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// MarkAllInboxRead archives all the unread inboxes of a user.
+	MarkAllInboxRead(ctx context.Context, in *MarkAllInboxReadRequest, opts ...grpc.CallOption) (*MarkAllInboxReadResponse, error)
+	// BatchDeleteInboxes deletes several inboxes of a user at once.
+	BatchDeleteInboxes(ctx context.Context, in *BatchDeleteInboxesRequest, opts ...grpc.CallOption) (*BatchDeleteInboxesResponse, error)
 }
 
 type inboxServiceClient struct {
@@ -75,6 +81,26 @@ func (c *inboxServiceClient) DeleteInbox(ctx context.Context, in *DeleteInboxReq
 	return out, nil
 }
 
+func (c *inboxServiceClient) MarkAllInboxRead(ctx context.Context, in *MarkAllInboxReadRequest, opts ...grpc.CallOption) (*MarkAllInboxReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkAllInboxReadResponse)
+	err := c.cc.Invoke(ctx, InboxService_MarkAllInboxRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inboxServiceClient) BatchDeleteInboxes(ctx context.Context, in *BatchDeleteInboxesRequest, opts ...grpc.CallOption) (*BatchDeleteInboxesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchDeleteInboxesResponse)
+	err := c.cc.Invoke(ctx, InboxService_BatchDeleteInboxes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InboxServiceServer is the server API for InboxService service.
 // All implementations must embed UnimplementedInboxServiceServer
 // for forward compatibility.
@@ -85,6 +111,10 @@ type InboxServiceServer interface {
 	UpdateInbox(context.Context, *UpdateInboxRequest) (*Inbox, error)
 	// DeleteInbox deletes an inbox.
 	DeleteInbox(context.Context, *DeleteInboxRequest) (*emptypb.Empty, error)
+	// MarkAllInboxRead archives all the unread inboxes of a user.
+	MarkAllInboxRead(context.Context, *MarkAllInboxReadRequest) (*MarkAllInboxReadResponse, error)
+	// BatchDeleteInboxes deletes several inboxes of a user at once.
+	BatchDeleteInboxes(context.Context, *BatchDeleteInboxesRequest) (*BatchDeleteInboxesResponse, error)
 	mustEmbedUnimplementedInboxServiceServer()
 }
 
@@ -104,6 +134,12 @@ func (UnimplementedInboxServiceServer) UpdateInbox(context.Context, *UpdateInbox
 func (UnimplementedInboxServiceServer) DeleteInbox(context.Context, *DeleteInboxRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteInbox not implemented")
 }
+func (UnimplementedInboxServiceServer) MarkAllInboxRead(context.Context, *MarkAllInboxReadRequest) (*MarkAllInboxReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAllInboxRead not implemented")
+}
+func (UnimplementedInboxServiceServer) BatchDeleteInboxes(context.Context, *BatchDeleteInboxesRequest) (*BatchDeleteInboxesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteInboxes not implemented")
+}
 func (UnimplementedInboxServiceServer) mustEmbedUnimplementedInboxServiceServer() {}
 func (UnimplementedInboxServiceServer) testEmbeddedByValue()                      {}
 
@@ -179,6 +215,42 @@ func _InboxService_DeleteInbox_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InboxService_MarkAllInboxRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAllInboxReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InboxServiceServer).MarkAllInboxRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InboxService_MarkAllInboxRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InboxServiceServer).MarkAllInboxRead(ctx, req.(*MarkAllInboxReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InboxService_BatchDeleteInboxes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteInboxesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InboxServiceServer).BatchDeleteInboxes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InboxService_BatchDeleteInboxes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InboxServiceServer).BatchDeleteInboxes(ctx, req.(*BatchDeleteInboxesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InboxService_ServiceDesc is the grpc.ServiceDesc for InboxService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -198,6 +270,14 @@ var InboxService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteInbox",
 			Handler:    _InboxService_DeleteInbox_Handler,
 		},
+		{
+			MethodName: "MarkAllInboxRead",
+			Handler:    _InboxService_MarkAllInboxRead_Handler,
+		},
+		{
+			MethodName: "BatchDeleteInboxes",
+			Handler:    _InboxService_BatchDeleteInboxes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/inbox_service.proto",
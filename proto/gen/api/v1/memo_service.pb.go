@@ -8,6 +8,7 @@ package apiv1
 
 import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -32,6 +33,8 @@ const (
 	Visibility_PRIVATE                Visibility = 1
 	Visibility_PROTECTED              Visibility = 2
 	Visibility_PUBLIC                 Visibility = 3
+	// Visible to the members of the selected user groups of the memo.
+	Visibility_GROUP Visibility = 4
 )
 
 // Enum value maps for Visibility.
@@ -41,12 +44,14 @@ var (
 		1: "PRIVATE",
 		2: "PROTECTED",
 		3: "PUBLIC",
+		4: "GROUP",
 	}
 	Visibility_value = map[string]int32{
 		"VISIBILITY_UNSPECIFIED": 0,
 		"PRIVATE":                1,
 		"PROTECTED":              2,
 		"PUBLIC":                 3,
+		"GROUP":                  4,
 	}
 )
 
@@ -77,6 +82,111 @@ func (Visibility) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{0}
 }
 
+type SearchMemosRequest_Scope int32
+
+const (
+	// The memos of `parent`, or all the memos visible to the caller if `parent` is not specified.
+	SearchMemosRequest_SCOPE_UNSPECIFIED SearchMemosRequest_Scope = 0
+	// The memos created by the caller.
+	SearchMemosRequest_OWN SearchMemosRequest_Scope = 1
+	// All the memos the caller is allowed to see: their own, PROTECTED and PUBLIC memos,
+	// as well as the memos shared with them by tag. The response includes the creator facets.
+	SearchMemosRequest_ACCESSIBLE SearchMemosRequest_Scope = 2
+)
+
+// Enum value maps for SearchMemosRequest_Scope.
+var (
+	SearchMemosRequest_Scope_name = map[int32]string{
+		0: "SCOPE_UNSPECIFIED",
+		1: "OWN",
+		2: "ACCESSIBLE",
+	}
+	SearchMemosRequest_Scope_value = map[string]int32{
+		"SCOPE_UNSPECIFIED": 0,
+		"OWN":               1,
+		"ACCESSIBLE":        2,
+	}
+)
+
+func (x SearchMemosRequest_Scope) Enum() *SearchMemosRequest_Scope {
+	p := new(SearchMemosRequest_Scope)
+	*p = x
+	return p
+}
+
+func (x SearchMemosRequest_Scope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchMemosRequest_Scope) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[1].Descriptor()
+}
+
+func (SearchMemosRequest_Scope) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[1]
+}
+
+func (x SearchMemosRequest_Scope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchMemosRequest_Scope.Descriptor instead.
+func (SearchMemosRequest_Scope) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{7, 0}
+}
+
+type MemoChange_Type int32
+
+const (
+	MemoChange_TYPE_UNSPECIFIED MemoChange_Type = 0
+	MemoChange_CREATED          MemoChange_Type = 1
+	MemoChange_UPDATED          MemoChange_Type = 2
+	MemoChange_DELETED          MemoChange_Type = 3
+)
+
+// Enum value maps for MemoChange_Type.
+var (
+	MemoChange_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "CREATED",
+		2: "UPDATED",
+		3: "DELETED",
+	}
+	MemoChange_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"CREATED":          1,
+		"UPDATED":          2,
+		"DELETED":          3,
+	}
+)
+
+func (x MemoChange_Type) Enum() *MemoChange_Type {
+	p := new(MemoChange_Type)
+	*p = x
+	return p
+}
+
+func (x MemoChange_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemoChange_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_memo_service_proto_enumTypes[2].Descriptor()
+}
+
+func (MemoChange_Type) Type() protoreflect.EnumType {
+	return &file_api_v1_memo_service_proto_enumTypes[2]
+}
+
+func (x MemoChange_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemoChange_Type.Descriptor instead.
+func (MemoChange_Type) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{14, 0}
+}
+
 // The type of the relation.
 type MemoRelation_Type int32
 
@@ -111,11 +221,11 @@ func (x MemoRelation_Type) String() string {
 }
 
 func (MemoRelation_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_memo_service_proto_enumTypes[1].Descriptor()
+	return file_api_v1_memo_service_proto_enumTypes[3].Descriptor()
 }
 
 func (MemoRelation_Type) Type() protoreflect.EnumType {
-	return &file_api_v1_memo_service_proto_enumTypes[1]
+	return &file_api_v1_memo_service_proto_enumTypes[3]
 }
 
 func (x MemoRelation_Type) Number() protoreflect.EnumNumber {
@@ -124,7 +234,7 @@ func (x MemoRelation_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MemoRelation_Type.Descriptor instead.
 func (MemoRelation_Type) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{14, 0}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{26, 0}
 }
 
 type Reaction struct {
@@ -252,7 +362,20 @@ type Memo struct {
 	// Output only. The snippet of the memo content. Plain text only.
 	Snippet string `protobuf:"bytes,17,opt,name=snippet,proto3" json:"snippet,omitempty"`
 	// Optional. The location of the memo.
-	Location      *Location `protobuf:"bytes,18,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	Location *Location `protobuf:"bytes,18,opt,name=location,proto3,oneof" json:"location,omitempty"`
+	// Output only. The transcript of the audio attachments of the memo, e.g. of a voice memo.
+	Transcript string `protobuf:"bytes,19,opt,name=transcript,proto3" json:"transcript,omitempty"`
+	// Optional. The shared space of the memo, readable by all of its members.
+	// Empty for the memos in the personal space of their creator.
+	// It can only be set on creation.
+	// Format: spaces/{space}
+	Space string `protobuf:"bytes,20,opt,name=space,proto3" json:"space,omitempty"`
+	// Optional. The user groups whose members can read the memo with the GROUP visibility.
+	// Format: groups/{group}
+	Groups []string `protobuf:"bytes,21,rep,name=groups,proto3" json:"groups,omitempty"`
+	// Output only. The previews of the bare URLs of the content, to render as link cards.
+	// They are fetched in the background, so a new URL has no preview for a while.
+	LinkPreviews  []*LinkPreview `protobuf:"bytes,22,rep,name=link_previews,json=linkPreviews,proto3" json:"link_previews,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -413,6 +536,124 @@ func (x *Memo) GetLocation() *Location {
 	return nil
 }
 
+func (x *Memo) GetTranscript() string {
+	if x != nil {
+		return x.Transcript
+	}
+	return ""
+}
+
+func (x *Memo) GetSpace() string {
+	if x != nil {
+		return x.Space
+	}
+	return ""
+}
+
+func (x *Memo) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *Memo) GetLinkPreviews() []*LinkPreview {
+	if x != nil {
+		return x.LinkPreviews
+	}
+	return nil
+}
+
+type LinkPreview struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The URL of the linked page, as written in the content.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The title of the linked page.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The description of the linked page.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The URL of the preview image of the linked page.
+	Image string `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`
+	// The URL of the icon of the linked site.
+	Favicon string `protobuf:"bytes,5,opt,name=favicon,proto3" json:"favicon,omitempty"`
+	// The name of the linked site.
+	SiteName      string `protobuf:"bytes,6,opt,name=site_name,json=siteName,proto3" json:"site_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkPreview) Reset() {
+	*x = LinkPreview{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkPreview) ProtoMessage() {}
+
+func (x *LinkPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkPreview.ProtoReflect.Descriptor instead.
+func (*LinkPreview) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LinkPreview) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetFavicon() string {
+	if x != nil {
+		return x.Favicon
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetSiteName() string {
+	if x != nil {
+		return x.SiteName
+	}
+	return ""
+}
+
 type Location struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// A placeholder text for the location.
@@ -427,7 +668,7 @@ type Location struct {
 
 func (x *Location) Reset() {
 	*x = Location{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[2]
+	mi := &file_api_v1_memo_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -439,7 +680,7 @@ func (x *Location) String() string {
 func (*Location) ProtoMessage() {}
 
 func (x *Location) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[2]
+	mi := &file_api_v1_memo_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -452,7 +693,7 @@ func (x *Location) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Location.ProtoReflect.Descriptor instead.
 func (*Location) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{2}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Location) GetPlaceholder() string {
@@ -493,7 +734,7 @@ type CreateMemoRequest struct {
 
 func (x *CreateMemoRequest) Reset() {
 	*x = CreateMemoRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[3]
+	mi := &file_api_v1_memo_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -505,7 +746,7 @@ func (x *CreateMemoRequest) String() string {
 func (*CreateMemoRequest) ProtoMessage() {}
 
 func (x *CreateMemoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[3]
+	mi := &file_api_v1_memo_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -518,7 +759,7 @@ func (x *CreateMemoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMemoRequest.ProtoReflect.Descriptor instead.
 func (*CreateMemoRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{3}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateMemoRequest) GetMemo() *Memo {
@@ -568,6 +809,12 @@ type ListMemosRequest struct {
 	State State `protobuf:"varint,4,opt,name=state,proto3,enum=memos.api.v1.State" json:"state,omitempty"`
 	// Optional. The order to sort results by.
 	// Default to "display_time desc".
+	// Supported fields are "display_time", "create_time" (or "created_time"), "update_time" (or "updated_time"),
+	// "relevance" and "random", each optionally followed by "asc" or "desc".
+	// "display_time" follows the workspace setting to display the update time.
+	// "relevance" requires a search query and is only supported by `SearchMemos`.
+	// "random" orders pseudo-randomly, the same seed giving the same order, e.g. "random(42)".
+	// Without a seed, a seed is picked and kept by the next page tokens.
 	// Example: "display_time desc" or "create_time asc"
 	OrderBy string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	// Optional. Filter to apply to the list results.
@@ -578,14 +825,18 @@ type ListMemosRequest struct {
 	ShowDeleted bool `protobuf:"varint,7,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
 	// [Deprecated] Old filter contains some specific conditions to filter memos.
 	// Format: "creator == 'users/{user}' && visibilities == ['PUBLIC', 'PROTECTED']"
-	OldFilter     string `protobuf:"bytes,8,opt,name=old_filter,json=oldFilter,proto3" json:"old_filter,omitempty"`
+	OldFilter string `protobuf:"bytes,8,opt,name=old_filter,json=oldFilter,proto3" json:"old_filter,omitempty"`
+	// Optional. The shared space to list the memos of, all of them being readable by its members.
+	// If not specified, the memos of the personal spaces are listed.
+	// Format: spaces/{space}
+	Space         string `protobuf:"bytes,9,opt,name=space,proto3" json:"space,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListMemosRequest) Reset() {
 	*x = ListMemosRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[4]
+	mi := &file_api_v1_memo_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -597,7 +848,7 @@ func (x *ListMemosRequest) String() string {
 func (*ListMemosRequest) ProtoMessage() {}
 
 func (x *ListMemosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[4]
+	mi := &file_api_v1_memo_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -610,7 +861,7 @@ func (x *ListMemosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMemosRequest.ProtoReflect.Descriptor instead.
 func (*ListMemosRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{4}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListMemosRequest) GetParent() string {
@@ -669,6 +920,13 @@ func (x *ListMemosRequest) GetOldFilter() string {
 	return ""
 }
 
+func (x *ListMemosRequest) GetSpace() string {
+	if x != nil {
+		return x.Space
+	}
+	return ""
+}
+
 type ListMemosResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The list of memos.
@@ -684,7 +942,7 @@ type ListMemosResponse struct {
 
 func (x *ListMemosResponse) Reset() {
 	*x = ListMemosResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[5]
+	mi := &file_api_v1_memo_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -696,7 +954,7 @@ func (x *ListMemosResponse) String() string {
 func (*ListMemosResponse) ProtoMessage() {}
 
 func (x *ListMemosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[5]
+	mi := &file_api_v1_memo_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -709,7 +967,7 @@ func (x *ListMemosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMemosResponse.ProtoReflect.Descriptor instead.
 func (*ListMemosResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{5}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListMemosResponse) GetMemos() []*Memo {
@@ -733,33 +991,66 @@ func (x *ListMemosResponse) GetTotalSize() int32 {
 	return 0
 }
 
-type GetMemoRequest struct {
+type SearchMemosRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Optional. The fields to return in the response.
-	// If not specified, all fields are returned.
-	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	// Required. The text to search for.
+	// Memos containing every term of the query are returned.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Optional. The parent is the owner of the memos.
+	// If not specified or `users/-`, it will search all memos.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Optional. The maximum number of memos to return.
+	// If unspecified, at most 50 memos will be returned.
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous `SearchMemos` call.
+	// Provide this to retrieve the subsequent page.
+	// When ordered by relevance, the token is a cursor after the last returned memo,
+	// so memos created or deleted in between do not shift the subsequent pages.
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. Filter to apply to the search results.
+	// Refer to `Shortcut.filter`.
+	Filter string `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Optional. If true, terms also match words with a few typos, e.g. "recieve" matches "receive",
+	// as well as words starting with the term.
+	// Fuzzy search scans every visible memo and is slower than the default search.
+	Fuzzy bool `protobuf:"varint,6,opt,name=fuzzy,proto3" json:"fuzzy,omitempty"`
+	// Optional. The order to sort results by, refer to `ListMemosRequest.order_by`.
+	// Default to the relevance to the query.
+	OrderBy string `protobuf:"bytes,7,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Optional. If true, the content, nodes and snippet of the returned memos are omitted,
+	// clients render the previews from `memo_matches` instead.
+	ExcludeContent bool `protobuf:"varint,8,opt,name=exclude_content,json=excludeContent,proto3" json:"exclude_content,omitempty"`
+	// Optional. If true, the query is a regular expression in RE2 syntax matched against the memo content,
+	// e.g. "JIRA-[0-9]+". Prefix the pattern with "(?i)" to ignore case.
+	// Results are not ranked, attachments are not searched, and complex patterns are rejected.
+	// Regex search scans every visible memo, narrow it down with `parent` or `filter` if it times out.
+	Regex bool `protobuf:"varint,9,opt,name=regex,proto3" json:"regex,omitempty"`
+	// Optional. The memos to search. `parent` must not be set if the scope is specified.
+	Scope SearchMemosRequest_Scope `protobuf:"varint,10,opt,name=scope,proto3,enum=memos.api.v1.SearchMemosRequest_Scope" json:"scope,omitempty"`
+	// Optional. The shared space to search the memos of, all of them being readable by its members.
+	// If not specified, the memos of the personal spaces are searched.
+	// Format: spaces/{space}
+	Space         string `protobuf:"bytes,11,opt,name=space,proto3" json:"space,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMemoRequest) Reset() {
-	*x = GetMemoRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[6]
+func (x *SearchMemosRequest) Reset() {
+	*x = SearchMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMemoRequest) String() string {
+func (x *SearchMemosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMemoRequest) ProtoMessage() {}
+func (*SearchMemosRequest) ProtoMessage() {}
 
-func (x *GetMemoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[6]
+func (x *SearchMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -770,114 +1061,113 @@ func (x *GetMemoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMemoRequest.ProtoReflect.Descriptor instead.
-func (*GetMemoRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use SearchMemosRequest.ProtoReflect.Descriptor instead.
+func (*SearchMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetMemoRequest) GetName() string {
+func (x *SearchMemosRequest) GetQuery() string {
 	if x != nil {
-		return x.Name
+		return x.Query
 	}
 	return ""
 }
 
-func (x *GetMemoRequest) GetReadMask() *fieldmaskpb.FieldMask {
+func (x *SearchMemosRequest) GetParent() string {
 	if x != nil {
-		return x.ReadMask
+		return x.Parent
 	}
-	return nil
-}
-
-type UpdateMemoRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The memo to update.
-	// The `name` field is required.
-	Memo *Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
-	// Required. The list of fields to update.
-	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
-	// Optional. If set to true, allows updating sensitive fields.
-	AllowMissing  bool `protobuf:"varint,3,opt,name=allow_missing,json=allowMissing,proto3" json:"allow_missing,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *UpdateMemoRequest) Reset() {
-	*x = UpdateMemoRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SearchMemosRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *UpdateMemoRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SearchMemosRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
 }
 
-func (*UpdateMemoRequest) ProtoMessage() {}
-
-func (x *UpdateMemoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[7]
+func (x *SearchMemosRequest) GetFilter() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Filter
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UpdateMemoRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMemoRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{7}
+func (x *SearchMemosRequest) GetFuzzy() bool {
+	if x != nil {
+		return x.Fuzzy
+	}
+	return false
 }
 
-func (x *UpdateMemoRequest) GetMemo() *Memo {
+func (x *SearchMemosRequest) GetOrderBy() string {
 	if x != nil {
-		return x.Memo
+		return x.OrderBy
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateMemoRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *SearchMemosRequest) GetExcludeContent() bool {
 	if x != nil {
-		return x.UpdateMask
+		return x.ExcludeContent
 	}
-	return nil
+	return false
 }
 
-func (x *UpdateMemoRequest) GetAllowMissing() bool {
+func (x *SearchMemosRequest) GetRegex() bool {
 	if x != nil {
-		return x.AllowMissing
+		return x.Regex
 	}
 	return false
 }
 
-type DeleteMemoRequest struct {
+func (x *SearchMemosRequest) GetScope() SearchMemosRequest_Scope {
+	if x != nil {
+		return x.Scope
+	}
+	return SearchMemosRequest_SCOPE_UNSPECIFIED
+}
+
+func (x *SearchMemosRequest) GetSpace() string {
+	if x != nil {
+		return x.Space
+	}
+	return ""
+}
+
+// A range of a text matching a search query.
+type TextHighlight struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo to delete.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Optional. If set to true, the memo will be deleted even if it has associated data.
-	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	// The offset of the first character of the match, in Unicode code points.
+	Start int32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	// The offset after the last character of the match, in Unicode code points.
+	End           int32 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMemoRequest) Reset() {
-	*x = DeleteMemoRequest{}
+func (x *TextHighlight) Reset() {
+	*x = TextHighlight{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMemoRequest) String() string {
+func (x *TextHighlight) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMemoRequest) ProtoMessage() {}
+func (*TextHighlight) ProtoMessage() {}
 
-func (x *DeleteMemoRequest) ProtoReflect() protoreflect.Message {
+func (x *TextHighlight) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -889,52 +1179,57 @@ func (x *DeleteMemoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMemoRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMemoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TextHighlight.ProtoReflect.Descriptor instead.
+func (*TextHighlight) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DeleteMemoRequest) GetName() string {
+func (x *TextHighlight) GetStart() int32 {
 	if x != nil {
-		return x.Name
+		return x.Start
 	}
-	return ""
+	return 0
 }
 
-func (x *DeleteMemoRequest) GetForce() bool {
+func (x *TextHighlight) GetEnd() int32 {
 	if x != nil {
-		return x.Force
+		return x.End
 	}
-	return false
+	return 0
 }
 
-type RenameMemoTagRequest struct {
+type SearchMemosResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The parent, who owns the tags.
-	// Format: memos/{memo}. Use "memos/-" to rename all tags.
-	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	// Required. The old tag name to rename.
-	OldTag string `protobuf:"bytes,2,opt,name=old_tag,json=oldTag,proto3" json:"old_tag,omitempty"`
-	// Required. The new tag name.
-	NewTag        string `protobuf:"bytes,3,opt,name=new_tag,json=newTag,proto3" json:"new_tag,omitempty"`
+	// The matching memos, the most relevant first.
+	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// A token that can be sent as `page_token` to retrieve the next page.
+	// If this field is omitted, there are no subsequent pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The attachments of the returned memos whose extracted text, e.g. of PDF, text or image files, matches the query.
+	AttachmentMatches []*SearchMemosResponse_AttachmentMatch `protobuf:"bytes,3,rep,name=attachment_matches,json=attachmentMatches,proto3" json:"attachment_matches,omitempty"`
+	// The snippets of the returned memos, in the same order as `memos`.
+	MemoMatches []*SearchMemosResponse_MemoMatch `protobuf:"bytes,4,rep,name=memo_matches,json=memoMatches,proto3" json:"memo_matches,omitempty"`
+	// The number of matching memos per creator, the largest first.
+	// Only set if the scope is `ACCESSIBLE`.
+	CreatorFacets []*SearchMemosResponse_CreatorFacet `protobuf:"bytes,5,rep,name=creator_facets,json=creatorFacets,proto3" json:"creator_facets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RenameMemoTagRequest) Reset() {
-	*x = RenameMemoTagRequest{}
+func (x *SearchMemosResponse) Reset() {
+	*x = SearchMemosResponse{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RenameMemoTagRequest) String() string {
+func (x *SearchMemosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameMemoTagRequest) ProtoMessage() {}
+func (*SearchMemosResponse) ProtoMessage() {}
 
-func (x *RenameMemoTagRequest) ProtoReflect() protoreflect.Message {
+func (x *SearchMemosResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -946,59 +1241,72 @@ func (x *RenameMemoTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameMemoTagRequest.ProtoReflect.Descriptor instead.
-func (*RenameMemoTagRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchMemosResponse.ProtoReflect.Descriptor instead.
+func (*SearchMemosResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *RenameMemoTagRequest) GetParent() string {
+func (x *SearchMemosResponse) GetMemos() []*Memo {
 	if x != nil {
-		return x.Parent
+		return x.Memos
 	}
-	return ""
+	return nil
 }
 
-func (x *RenameMemoTagRequest) GetOldTag() string {
+func (x *SearchMemosResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.OldTag
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *RenameMemoTagRequest) GetNewTag() string {
+func (x *SearchMemosResponse) GetAttachmentMatches() []*SearchMemosResponse_AttachmentMatch {
 	if x != nil {
-		return x.NewTag
+		return x.AttachmentMatches
 	}
-	return ""
+	return nil
 }
 
-type DeleteMemoTagRequest struct {
+func (x *SearchMemosResponse) GetMemoMatches() []*SearchMemosResponse_MemoMatch {
+	if x != nil {
+		return x.MemoMatches
+	}
+	return nil
+}
+
+func (x *SearchMemosResponse) GetCreatorFacets() []*SearchMemosResponse_CreatorFacet {
+	if x != nil {
+		return x.CreatorFacets
+	}
+	return nil
+}
+
+type SuggestMemosRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The parent, who owns the tags.
-	// Format: memos/{memo}. Use "memos/-" to delete all tags.
-	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
-	// Required. The tag name to delete.
-	Tag string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
-	// Optional. Whether to delete related memos.
-	DeleteRelatedMemos bool `protobuf:"varint,3,opt,name=delete_related_memos,json=deleteRelatedMemos,proto3" json:"delete_related_memos,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// Required. The prefix to complete, ignoring case.
+	// It matches the start of a tag, title or query, or of any of their words.
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Optional. The maximum number of suggestions of each kind.
+	// If unspecified, at most 10 suggestions of each kind will be returned.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMemoTagRequest) Reset() {
-	*x = DeleteMemoTagRequest{}
+func (x *SuggestMemosRequest) Reset() {
+	*x = SuggestMemosRequest{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMemoTagRequest) String() string {
+func (x *SuggestMemosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMemoTagRequest) ProtoMessage() {}
+func (*SuggestMemosRequest) ProtoMessage() {}
 
-func (x *DeleteMemoTagRequest) ProtoReflect() protoreflect.Message {
+func (x *SuggestMemosRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1010,57 +1318,51 @@ func (x *DeleteMemoTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMemoTagRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMemoTagRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SuggestMemosRequest.ProtoReflect.Descriptor instead.
+func (*SuggestMemosRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DeleteMemoTagRequest) GetParent() string {
-	if x != nil {
-		return x.Parent
-	}
-	return ""
-}
-
-func (x *DeleteMemoTagRequest) GetTag() string {
+func (x *SuggestMemosRequest) GetPrefix() string {
 	if x != nil {
-		return x.Tag
+		return x.Prefix
 	}
 	return ""
 }
 
-func (x *DeleteMemoTagRequest) GetDeleteRelatedMemos() bool {
+func (x *SuggestMemosRequest) GetLimit() int32 {
 	if x != nil {
-		return x.DeleteRelatedMemos
+		return x.Limit
 	}
-	return false
+	return 0
 }
 
-type SetMemoAttachmentsRequest struct {
+type SuggestMemosResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Required. The attachments to set for the memo.
-	Attachments   []*Attachment `protobuf:"bytes,2,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// The tags of the visible memos, the most used first, e.g. "work/project".
+	Tags []string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	// The visible memos whose title matches, the most recently updated first.
+	Memos []*SuggestMemosResponse_MemoSuggestion `protobuf:"bytes,2,rep,name=memos,proto3" json:"memos,omitempty"`
+	// The recent search queries of the current user, the most recent first.
+	RecentQueries []string `protobuf:"bytes,3,rep,name=recent_queries,json=recentQueries,proto3" json:"recent_queries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetMemoAttachmentsRequest) Reset() {
-	*x = SetMemoAttachmentsRequest{}
+func (x *SuggestMemosResponse) Reset() {
+	*x = SuggestMemosResponse{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetMemoAttachmentsRequest) String() string {
+func (x *SuggestMemosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMemoAttachmentsRequest) ProtoMessage() {}
+func (*SuggestMemosResponse) ProtoMessage() {}
 
-func (x *SetMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
+func (x *SuggestMemosResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1072,52 +1374,61 @@ func (x *SetMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMemoAttachmentsRequest.ProtoReflect.Descriptor instead.
-func (*SetMemoAttachmentsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SuggestMemosResponse.ProtoReflect.Descriptor instead.
+func (*SuggestMemosResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SetMemoAttachmentsRequest) GetName() string {
+func (x *SuggestMemosResponse) GetTags() []string {
 	if x != nil {
-		return x.Name
+		return x.Tags
 	}
-	return ""
+	return nil
 }
 
-func (x *SetMemoAttachmentsRequest) GetAttachments() []*Attachment {
+func (x *SuggestMemosResponse) GetMemos() []*SuggestMemosResponse_MemoSuggestion {
 	if x != nil {
-		return x.Attachments
+		return x.Memos
 	}
 	return nil
 }
 
-type ListMemoAttachmentsRequest struct {
+func (x *SuggestMemosResponse) GetRecentQueries() []string {
+	if x != nil {
+		return x.RecentQueries
+	}
+	return nil
+}
+
+type SemanticSearchMemosRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Optional. The maximum number of attachments to return.
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional. A page token for pagination.
-	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Required. The text to search for.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Optional. The parent is the owner of the memos.
+	// If not specified or `users/-`, it will search all memos.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,2,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Optional. The maximum number of results to return.
+	// Default to 10, the maximum value is 50.
+	PageSize      int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoAttachmentsRequest) Reset() {
-	*x = ListMemoAttachmentsRequest{}
+func (x *SemanticSearchMemosRequest) Reset() {
+	*x = SemanticSearchMemosRequest{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoAttachmentsRequest) String() string {
+func (x *SemanticSearchMemosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoAttachmentsRequest) ProtoMessage() {}
+func (*SemanticSearchMemosRequest) ProtoMessage() {}
 
-func (x *ListMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
+func (x *SemanticSearchMemosRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1129,58 +1440,54 @@ func (x *ListMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoAttachmentsRequest.ProtoReflect.Descriptor instead.
-func (*ListMemoAttachmentsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SemanticSearchMemosRequest.ProtoReflect.Descriptor instead.
+func (*SemanticSearchMemosRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListMemoAttachmentsRequest) GetName() string {
+func (x *SemanticSearchMemosRequest) GetQuery() string {
 	if x != nil {
-		return x.Name
+		return x.Query
 	}
 	return ""
 }
 
-func (x *ListMemoAttachmentsRequest) GetPageSize() int32 {
+func (x *SemanticSearchMemosRequest) GetParent() string {
 	if x != nil {
-		return x.PageSize
+		return x.Parent
 	}
-	return 0
+	return ""
 }
 
-func (x *ListMemoAttachmentsRequest) GetPageToken() string {
+func (x *SemanticSearchMemosRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.PageToken
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-type ListMemoAttachmentsResponse struct {
+type SemanticSearchMemosResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of attachments.
-	Attachments []*Attachment `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
-	// A token for the next page of results.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	// The total count of attachments.
-	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// The results, the closest first.
+	Results       []*SemanticSearchMemosResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoAttachmentsResponse) Reset() {
-	*x = ListMemoAttachmentsResponse{}
+func (x *SemanticSearchMemosResponse) Reset() {
+	*x = SemanticSearchMemosResponse{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoAttachmentsResponse) String() string {
+func (x *SemanticSearchMemosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoAttachmentsResponse) ProtoMessage() {}
+func (*SemanticSearchMemosResponse) ProtoMessage() {}
 
-func (x *ListMemoAttachmentsResponse) ProtoReflect() protoreflect.Message {
+func (x *SemanticSearchMemosResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1192,57 +1499,46 @@ func (x *ListMemoAttachmentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoAttachmentsResponse.ProtoReflect.Descriptor instead.
-func (*ListMemoAttachmentsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SemanticSearchMemosResponse.ProtoReflect.Descriptor instead.
+func (*SemanticSearchMemosResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListMemoAttachmentsResponse) GetAttachments() []*Attachment {
+func (x *SemanticSearchMemosResponse) GetResults() []*SemanticSearchMemosResponse_Result {
 	if x != nil {
-		return x.Attachments
+		return x.Results
 	}
 	return nil
 }
 
-func (x *ListMemoAttachmentsResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
-}
-
-func (x *ListMemoAttachmentsResponse) GetTotalSize() int32 {
-	if x != nil {
-		return x.TotalSize
-	}
-	return 0
-}
-
-type MemoRelation struct {
+type MemoChange struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The memo in the relation.
-	Memo *MemoRelation_Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
-	// The related memo.
-	RelatedMemo   *MemoRelation_Memo `protobuf:"bytes,2,opt,name=related_memo,json=relatedMemo,proto3" json:"related_memo,omitempty"`
-	Type          MemoRelation_Type  `protobuf:"varint,3,opt,name=type,proto3,enum=memos.api.v1.MemoRelation_Type" json:"type,omitempty"`
+	// The changed memo, which may have been renamed or deleted since.
+	// Format: memos/{memo}
+	Memo string          `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	Type MemoChange_Type `protobuf:"varint,2,opt,name=type,proto3,enum=memos.api.v1.MemoChange_Type" json:"type,omitempty"`
+	// The time of the change.
+	ChangeTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=change_time,json=changeTime,proto3" json:"change_time,omitempty"`
+	// The current state of the memo, unset if it was deleted since.
+	MemoData      *Memo `protobuf:"bytes,4,opt,name=memo_data,json=memoData,proto3" json:"memo_data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MemoRelation) Reset() {
-	*x = MemoRelation{}
+func (x *MemoChange) Reset() {
+	*x = MemoChange{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemoRelation) String() string {
+func (x *MemoChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemoRelation) ProtoMessage() {}
+func (*MemoChange) ProtoMessage() {}
 
-func (x *MemoRelation) ProtoReflect() protoreflect.Message {
+func (x *MemoChange) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1254,57 +1550,65 @@ func (x *MemoRelation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemoRelation.ProtoReflect.Descriptor instead.
-func (*MemoRelation) Descriptor() ([]byte, []int) {
+// Deprecated: Use MemoChange.ProtoReflect.Descriptor instead.
+func (*MemoChange) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *MemoRelation) GetMemo() *MemoRelation_Memo {
+func (x *MemoChange) GetMemo() string {
 	if x != nil {
 		return x.Memo
 	}
-	return nil
+	return ""
 }
 
-func (x *MemoRelation) GetRelatedMemo() *MemoRelation_Memo {
+func (x *MemoChange) GetType() MemoChange_Type {
 	if x != nil {
-		return x.RelatedMemo
+		return x.Type
+	}
+	return MemoChange_TYPE_UNSPECIFIED
+}
+
+func (x *MemoChange) GetChangeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangeTime
 	}
 	return nil
 }
 
-func (x *MemoRelation) GetType() MemoRelation_Type {
+func (x *MemoChange) GetMemoData() *Memo {
 	if x != nil {
-		return x.Type
+		return x.MemoData
 	}
-	return MemoRelation_TYPE_UNSPECIFIED
+	return nil
 }
 
-type SetMemoRelationsRequest struct {
+type ListMemoChangesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Required. The relations to set for the memo.
-	Relations     []*MemoRelation `protobuf:"bytes,2,rep,name=relations,proto3" json:"relations,omitempty"`
+	// Optional. The cursor returned by the previous call, the changes after it being listed.
+	// If unspecified, the changes are listed from the oldest one kept, the changes being kept for 30 days.
+	Cursor string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Optional. The maximum number of changes to return.
+	// If unspecified, at most 100 changes will be returned. The maximum value is 1000.
+	PageSize      int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetMemoRelationsRequest) Reset() {
-	*x = SetMemoRelationsRequest{}
+func (x *ListMemoChangesRequest) Reset() {
+	*x = ListMemoChangesRequest{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetMemoRelationsRequest) String() string {
+func (x *ListMemoChangesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMemoRelationsRequest) ProtoMessage() {}
+func (*ListMemoChangesRequest) ProtoMessage() {}
 
-func (x *SetMemoRelationsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListMemoChangesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1316,52 +1620,51 @@ func (x *SetMemoRelationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMemoRelationsRequest.ProtoReflect.Descriptor instead.
-func (*SetMemoRelationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListMemoChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoChangesRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *SetMemoRelationsRequest) GetName() string {
+func (x *ListMemoChangesRequest) GetCursor() string {
 	if x != nil {
-		return x.Name
+		return x.Cursor
 	}
 	return ""
 }
 
-func (x *SetMemoRelationsRequest) GetRelations() []*MemoRelation {
+func (x *ListMemoChangesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Relations
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-type ListMemoRelationsRequest struct {
+type ListMemoChangesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Optional. The maximum number of relations to return.
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional. A page token for pagination.
-	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The changes, the oldest first.
+	Changes []*MemoChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	// The cursor to pass to the next call, which is the request cursor if there are no new changes.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// Whether there are more changes after the next cursor, to be listed right away.
+	HasMore       bool `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoRelationsRequest) Reset() {
-	*x = ListMemoRelationsRequest{}
+func (x *ListMemoChangesResponse) Reset() {
+	*x = ListMemoChangesResponse{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoRelationsRequest) String() string {
+func (x *ListMemoChangesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoRelationsRequest) ProtoMessage() {}
+func (*ListMemoChangesResponse) ProtoMessage() {}
 
-func (x *ListMemoRelationsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListMemoChangesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1373,58 +1676,58 @@ func (x *ListMemoRelationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoRelationsRequest.ProtoReflect.Descriptor instead.
-func (*ListMemoRelationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListMemoChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoChangesResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ListMemoRelationsRequest) GetName() string {
+func (x *ListMemoChangesResponse) GetChanges() []*MemoChange {
 	if x != nil {
-		return x.Name
+		return x.Changes
 	}
-	return ""
+	return nil
 }
 
-func (x *ListMemoRelationsRequest) GetPageSize() int32 {
+func (x *ListMemoChangesResponse) GetNextCursor() string {
 	if x != nil {
-		return x.PageSize
+		return x.NextCursor
 	}
-	return 0
+	return ""
 }
 
-func (x *ListMemoRelationsRequest) GetPageToken() string {
+func (x *ListMemoChangesResponse) GetHasMore() bool {
 	if x != nil {
-		return x.PageToken
+		return x.HasMore
 	}
-	return ""
+	return false
 }
 
-type ListMemoRelationsResponse struct {
+type GetMemoRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of relations.
-	Relations []*MemoRelation `protobuf:"bytes,1,rep,name=relations,proto3" json:"relations,omitempty"`
-	// A token for the next page of results.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	// The total count of relations.
-	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The fields to return in the response.
+	// If not specified, all fields are returned.
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoRelationsResponse) Reset() {
-	*x = ListMemoRelationsResponse{}
+func (x *GetMemoRequest) Reset() {
+	*x = GetMemoRequest{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoRelationsResponse) String() string {
+func (x *GetMemoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoRelationsResponse) ProtoMessage() {}
+func (*GetMemoRequest) ProtoMessage() {}
 
-func (x *ListMemoRelationsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetMemoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1436,59 +1739,52 @@ func (x *ListMemoRelationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoRelationsResponse.ProtoReflect.Descriptor instead.
-func (*ListMemoRelationsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMemoRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ListMemoRelationsResponse) GetRelations() []*MemoRelation {
-	if x != nil {
-		return x.Relations
-	}
-	return nil
-}
-
-func (x *ListMemoRelationsResponse) GetNextPageToken() string {
+func (x *GetMemoRequest) GetName() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListMemoRelationsResponse) GetTotalSize() int32 {
+func (x *GetMemoRequest) GetReadMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.TotalSize
+		return x.ReadMask
 	}
-	return 0
+	return nil
 }
 
-type CreateMemoCommentRequest struct {
+type UpdateMemoRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Required. The comment to create.
-	Comment *Memo `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
-	// Optional. The comment ID to use.
-	CommentId     string `protobuf:"bytes,3,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	// Required. The memo to update.
+	// The `name` field is required.
+	Memo *Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Required. The list of fields to update.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Optional. If set to true, allows updating sensitive fields.
+	AllowMissing  bool `protobuf:"varint,3,opt,name=allow_missing,json=allowMissing,proto3" json:"allow_missing,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateMemoCommentRequest) Reset() {
-	*x = CreateMemoCommentRequest{}
+func (x *UpdateMemoRequest) Reset() {
+	*x = UpdateMemoRequest{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMemoCommentRequest) String() string {
+func (x *UpdateMemoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMemoCommentRequest) ProtoMessage() {}
+func (*UpdateMemoRequest) ProtoMessage() {}
 
-func (x *CreateMemoCommentRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateMemoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1500,61 +1796,57 @@ func (x *CreateMemoCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMemoCommentRequest.ProtoReflect.Descriptor instead.
-func (*CreateMemoCommentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateMemoRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemoRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CreateMemoCommentRequest) GetName() string {
+func (x *UpdateMemoRequest) GetMemo() *Memo {
 	if x != nil {
-		return x.Name
+		return x.Memo
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateMemoCommentRequest) GetComment() *Memo {
+func (x *UpdateMemoRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.Comment
+		return x.UpdateMask
 	}
 	return nil
 }
 
-func (x *CreateMemoCommentRequest) GetCommentId() string {
+func (x *UpdateMemoRequest) GetAllowMissing() bool {
 	if x != nil {
-		return x.CommentId
+		return x.AllowMissing
 	}
-	return ""
+	return false
 }
 
-type ListMemoCommentsRequest struct {
+type DeleteMemoRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
+	// Required. The resource name of the memo to delete.
 	// Format: memos/{memo}
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Optional. The maximum number of comments to return.
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional. A page token for pagination.
-	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	// Optional. The order to sort results by.
-	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Optional. If set to true, the memo will be deleted even if it has associated data.
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoCommentsRequest) Reset() {
-	*x = ListMemoCommentsRequest{}
+func (x *DeleteMemoRequest) Reset() {
+	*x = DeleteMemoRequest{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoCommentsRequest) String() string {
+func (x *DeleteMemoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoCommentsRequest) ProtoMessage() {}
+func (*DeleteMemoRequest) ProtoMessage() {}
 
-func (x *ListMemoCommentsRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteMemoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1566,65 +1858,52 @@ func (x *ListMemoCommentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoCommentsRequest.ProtoReflect.Descriptor instead.
-func (*ListMemoCommentsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteMemoRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ListMemoCommentsRequest) GetName() string {
+func (x *DeleteMemoRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *ListMemoCommentsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListMemoCommentsRequest) GetPageToken() string {
-	if x != nil {
-		return x.PageToken
-	}
-	return ""
-}
-
-func (x *ListMemoCommentsRequest) GetOrderBy() string {
+func (x *DeleteMemoRequest) GetForce() bool {
 	if x != nil {
-		return x.OrderBy
+		return x.Force
 	}
-	return ""
+	return false
 }
 
-type ListMemoCommentsResponse struct {
+type RenameMemoTagRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of comment memos.
-	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
-	// A token for the next page of results.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	// The total count of comments.
-	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// Required. The parent, who owns the tags.
+	// Format: memos/{memo}. Use "memos/-" to rename all tags.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The old tag name to rename.
+	OldTag string `protobuf:"bytes,2,opt,name=old_tag,json=oldTag,proto3" json:"old_tag,omitempty"`
+	// Required. The new tag name.
+	NewTag        string `protobuf:"bytes,3,opt,name=new_tag,json=newTag,proto3" json:"new_tag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoCommentsResponse) Reset() {
-	*x = ListMemoCommentsResponse{}
+func (x *RenameMemoTagRequest) Reset() {
+	*x = RenameMemoTagRequest{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoCommentsResponse) String() string {
+func (x *RenameMemoTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoCommentsResponse) ProtoMessage() {}
+func (*RenameMemoTagRequest) ProtoMessage() {}
 
-func (x *ListMemoCommentsResponse) ProtoReflect() protoreflect.Message {
+func (x *RenameMemoTagRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1636,59 +1915,59 @@ func (x *ListMemoCommentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoCommentsResponse.ProtoReflect.Descriptor instead.
-func (*ListMemoCommentsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RenameMemoTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameMemoTagRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ListMemoCommentsResponse) GetMemos() []*Memo {
+func (x *RenameMemoTagRequest) GetParent() string {
 	if x != nil {
-		return x.Memos
+		return x.Parent
 	}
-	return nil
+	return ""
 }
 
-func (x *ListMemoCommentsResponse) GetNextPageToken() string {
+func (x *RenameMemoTagRequest) GetOldTag() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.OldTag
 	}
 	return ""
 }
 
-func (x *ListMemoCommentsResponse) GetTotalSize() int32 {
+func (x *RenameMemoTagRequest) GetNewTag() string {
 	if x != nil {
-		return x.TotalSize
+		return x.NewTag
 	}
-	return 0
+	return ""
 }
 
-type ListMemoReactionsRequest struct {
+type DeleteMemoTagRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the memo.
-	// Format: memos/{memo}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Optional. The maximum number of reactions to return.
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional. A page token for pagination.
-	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Required. The parent, who owns the tags.
+	// Format: memos/{memo}. Use "memos/-" to delete all tags.
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The tag name to delete.
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Optional. Whether to delete related memos.
+	DeleteRelatedMemos bool `protobuf:"varint,3,opt,name=delete_related_memos,json=deleteRelatedMemos,proto3" json:"delete_related_memos,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *ListMemoReactionsRequest) Reset() {
-	*x = ListMemoReactionsRequest{}
+func (x *DeleteMemoTagRequest) Reset() {
+	*x = DeleteMemoTagRequest{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoReactionsRequest) String() string {
+func (x *DeleteMemoTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoReactionsRequest) ProtoMessage() {}
+func (*DeleteMemoTagRequest) ProtoMessage() {}
 
-func (x *ListMemoReactionsRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteMemoTagRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1700,58 +1979,57 @@ func (x *ListMemoReactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoReactionsRequest.ProtoReflect.Descriptor instead.
-func (*ListMemoReactionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteMemoTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoTagRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ListMemoReactionsRequest) GetName() string {
+func (x *DeleteMemoTagRequest) GetParent() string {
 	if x != nil {
-		return x.Name
+		return x.Parent
 	}
 	return ""
 }
 
-func (x *ListMemoReactionsRequest) GetPageSize() int32 {
+func (x *DeleteMemoTagRequest) GetTag() string {
 	if x != nil {
-		return x.PageSize
+		return x.Tag
 	}
-	return 0
+	return ""
 }
 
-func (x *ListMemoReactionsRequest) GetPageToken() string {
+func (x *DeleteMemoTagRequest) GetDeleteRelatedMemos() bool {
 	if x != nil {
-		return x.PageToken
+		return x.DeleteRelatedMemos
 	}
-	return ""
+	return false
 }
 
-type ListMemoReactionsResponse struct {
+type SetMemoAttachmentsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of reactions.
-	Reactions []*Reaction `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
-	// A token for the next page of results.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	// The total count of reactions.
-	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The attachments to set for the memo.
+	Attachments   []*Attachment `protobuf:"bytes,2,rep,name=attachments,proto3" json:"attachments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMemoReactionsResponse) Reset() {
-	*x = ListMemoReactionsResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[22]
+func (x *SetMemoAttachmentsRequest) Reset() {
+	*x = SetMemoAttachmentsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMemoReactionsResponse) String() string {
+func (x *SetMemoAttachmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMemoReactionsResponse) ProtoMessage() {}
+func (*SetMemoAttachmentsRequest) ProtoMessage() {}
 
-func (x *ListMemoReactionsResponse) ProtoReflect() protoreflect.Message {
+func (x *SetMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1763,57 +2041,52 @@ func (x *ListMemoReactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMemoReactionsResponse.ProtoReflect.Descriptor instead.
-func (*ListMemoReactionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetMemoAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*SetMemoAttachmentsRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ListMemoReactionsResponse) GetReactions() []*Reaction {
-	if x != nil {
-		return x.Reactions
-	}
-	return nil
-}
-
-func (x *ListMemoReactionsResponse) GetNextPageToken() string {
+func (x *SetMemoAttachmentsRequest) GetName() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListMemoReactionsResponse) GetTotalSize() int32 {
+func (x *SetMemoAttachmentsRequest) GetAttachments() []*Attachment {
 	if x != nil {
-		return x.TotalSize
+		return x.Attachments
 	}
-	return 0
+	return nil
 }
 
-type UpsertMemoReactionRequest struct {
+type ListMemoAttachmentsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The resource name of the memo.
 	// Format: memos/{memo}
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Required. The reaction to upsert.
-	Reaction      *Reaction `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	// Optional. The maximum number of attachments to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token for pagination.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertMemoReactionRequest) Reset() {
-	*x = UpsertMemoReactionRequest{}
+func (x *ListMemoAttachmentsRequest) Reset() {
+	*x = ListMemoAttachmentsRequest{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertMemoReactionRequest) String() string {
+func (x *ListMemoAttachmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertMemoReactionRequest) ProtoMessage() {}
+func (*ListMemoAttachmentsRequest) ProtoMessage() {}
 
-func (x *UpsertMemoReactionRequest) ProtoReflect() protoreflect.Message {
+func (x *ListMemoAttachmentsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1825,48 +2098,58 @@ func (x *UpsertMemoReactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertMemoReactionRequest.ProtoReflect.Descriptor instead.
-func (*UpsertMemoReactionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListMemoAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoAttachmentsRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *UpsertMemoReactionRequest) GetName() string {
+func (x *ListMemoAttachmentsRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *UpsertMemoReactionRequest) GetReaction() *Reaction {
+func (x *ListMemoAttachmentsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Reaction
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-type DeleteMemoReactionRequest struct {
+func (x *ListMemoAttachmentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMemoAttachmentsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The resource name of the reaction to delete.
-	// Format: reactions/{reaction}
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The list of attachments.
+	Attachments []*Attachment `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// A token for the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The total count of attachments.
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteMemoReactionRequest) Reset() {
-	*x = DeleteMemoReactionRequest{}
+func (x *ListMemoAttachmentsResponse) Reset() {
+	*x = ListMemoAttachmentsResponse{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMemoReactionRequest) String() string {
+func (x *ListMemoAttachmentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMemoReactionRequest) ProtoMessage() {}
+func (*ListMemoAttachmentsResponse) ProtoMessage() {}
 
-func (x *DeleteMemoReactionRequest) ProtoReflect() protoreflect.Message {
+func (x *ListMemoAttachmentsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1878,52 +2161,55 @@ func (x *DeleteMemoReactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMemoReactionRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMemoReactionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListMemoAttachmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoAttachmentsResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *DeleteMemoReactionRequest) GetName() string {
+func (x *ListMemoAttachmentsResponse) GetAttachments() []*Attachment {
 	if x != nil {
-		return x.Name
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *ListMemoAttachmentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
 	}
 	return ""
 }
 
-type ExportMemosRequest struct {
+func (x *ListMemoAttachmentsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type GetMemoAttachmentsArchiveRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional. Format for the export (currently only "json" is supported)
-	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
-	// Optional. Filter to apply to memos for export
-	// Uses the same filter format as ListMemosRequest
-	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
-	// Optional. Whether to exclude archived memos from export
-	// Default: false (include archived memos)
-	ExcludeArchived bool `protobuf:"varint,3,opt,name=exclude_archived,json=excludeArchived,proto3" json:"exclude_archived,omitempty"`
-	// Optional. Whether to include attachments in the export
-	// Default: true
-	IncludeAttachments bool `protobuf:"varint,4,opt,name=include_attachments,json=includeAttachments,proto3" json:"include_attachments,omitempty"`
-	// Optional. Whether to include memo relations in the export
-	// Default: true
-	IncludeRelations bool `protobuf:"varint,5,opt,name=include_relations,json=includeRelations,proto3" json:"include_relations,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExportMemosRequest) Reset() {
-	*x = ExportMemosRequest{}
+func (x *GetMemoAttachmentsArchiveRequest) Reset() {
+	*x = GetMemoAttachmentsArchiveRequest{}
 	mi := &file_api_v1_memo_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExportMemosRequest) String() string {
+func (x *GetMemoAttachmentsArchiveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportMemosRequest) ProtoMessage() {}
+func (*GetMemoAttachmentsArchiveRequest) ProtoMessage() {}
 
-func (x *ExportMemosRequest) ProtoReflect() protoreflect.Message {
+func (x *GetMemoAttachmentsArchiveRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_memo_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1935,77 +2221,106 @@ func (x *ExportMemosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportMemosRequest.ProtoReflect.Descriptor instead.
-func (*ExportMemosRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMemoAttachmentsArchiveRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoAttachmentsArchiveRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ExportMemosRequest) GetFormat() string {
+func (x *GetMemoAttachmentsArchiveRequest) GetName() string {
 	if x != nil {
-		return x.Format
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ExportMemosRequest) GetFilter() string {
+type MemoRelation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The memo in the relation.
+	Memo *MemoRelation_Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The related memo.
+	RelatedMemo   *MemoRelation_Memo `protobuf:"bytes,2,opt,name=related_memo,json=relatedMemo,proto3" json:"related_memo,omitempty"`
+	Type          MemoRelation_Type  `protobuf:"varint,3,opt,name=type,proto3,enum=memos.api.v1.MemoRelation_Type" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoRelation) Reset() {
+	*x = MemoRelation{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoRelation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoRelation) ProtoMessage() {}
+
+func (x *MemoRelation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[26]
 	if x != nil {
-		return x.Filter
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ExportMemosRequest) GetExcludeArchived() bool {
+// Deprecated: Use MemoRelation.ProtoReflect.Descriptor instead.
+func (*MemoRelation) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MemoRelation) GetMemo() *MemoRelation_Memo {
 	if x != nil {
-		return x.ExcludeArchived
+		return x.Memo
 	}
-	return false
+	return nil
 }
 
-func (x *ExportMemosRequest) GetIncludeAttachments() bool {
+func (x *MemoRelation) GetRelatedMemo() *MemoRelation_Memo {
 	if x != nil {
-		return x.IncludeAttachments
+		return x.RelatedMemo
 	}
-	return false
+	return nil
 }
 
-func (x *ExportMemosRequest) GetIncludeRelations() bool {
+func (x *MemoRelation) GetType() MemoRelation_Type {
 	if x != nil {
-		return x.IncludeRelations
+		return x.Type
 	}
-	return false
+	return MemoRelation_TYPE_UNSPECIFIED
 }
 
-type ExportMemosResponse struct {
+type SetMemoRelationsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The exported data as bytes
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	// The format of the exported data
-	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
-	// Suggested filename for the export
-	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
-	// Number of memos exported
-	MemoCount int32 `protobuf:"varint,4,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
-	// Size of the export data in bytes
-	SizeBytes     int64 `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The relations to set for the memo.
+	Relations     []*MemoRelation `protobuf:"bytes,2,rep,name=relations,proto3" json:"relations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExportMemosResponse) Reset() {
-	*x = ExportMemosResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[26]
+func (x *SetMemoRelationsRequest) Reset() {
+	*x = SetMemoRelationsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExportMemosResponse) String() string {
+func (x *SetMemoRelationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExportMemosResponse) ProtoMessage() {}
+func (*SetMemoRelationsRequest) ProtoMessage() {}
 
-func (x *ExportMemosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[26]
+func (x *SetMemoRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2016,86 +2331,1649 @@ func (x *ExportMemosResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExportMemosResponse.ProtoReflect.Descriptor instead.
-func (*ExportMemosResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *ExportMemosResponse) GetData() []byte {
-	if x != nil {
-		return x.Data
-	}
-	return nil
+// Deprecated: Use SetMemoRelationsRequest.ProtoReflect.Descriptor instead.
+func (*SetMemoRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ExportMemosResponse) GetFormat() string {
+func (x *SetMemoRelationsRequest) GetName() string {
 	if x != nil {
-		return x.Format
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ExportMemosResponse) GetFilename() string {
+func (x *SetMemoRelationsRequest) GetRelations() []*MemoRelation {
 	if x != nil {
-		return x.Filename
+		return x.Relations
 	}
-	return ""
+	return nil
 }
 
-func (x *ExportMemosResponse) GetMemoCount() int32 {
-	if x != nil {
+type ListMemoRelationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The maximum number of relations to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token for pagination.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoRelationsRequest) Reset() {
+	*x = ListMemoRelationsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoRelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoRelationsRequest) ProtoMessage() {}
+
+func (x *ListMemoRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoRelationsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListMemoRelationsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListMemoRelationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMemoRelationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMemoRelationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of relations.
+	Relations []*MemoRelation `protobuf:"bytes,1,rep,name=relations,proto3" json:"relations,omitempty"`
+	// A token for the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The total count of relations.
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoRelationsResponse) Reset() {
+	*x = ListMemoRelationsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoRelationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoRelationsResponse) ProtoMessage() {}
+
+func (x *ListMemoRelationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoRelationsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoRelationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListMemoRelationsResponse) GetRelations() []*MemoRelation {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+func (x *ListMemoRelationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListMemoRelationsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type CreateMemoCommentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The comment to create.
+	Comment *Memo `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	// Optional. The comment ID to use.
+	CommentId     string `protobuf:"bytes,3,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemoCommentRequest) Reset() {
+	*x = CreateMemoCommentRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemoCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemoCommentRequest) ProtoMessage() {}
+
+func (x *CreateMemoCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemoCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemoCommentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateMemoCommentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateMemoCommentRequest) GetComment() *Memo {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *CreateMemoCommentRequest) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+type ListMemoCommentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The maximum number of comments to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token for pagination.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. The order to sort results by.
+	OrderBy       string `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoCommentsRequest) Reset() {
+	*x = ListMemoCommentsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoCommentsRequest) ProtoMessage() {}
+
+func (x *ListMemoCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListMemoCommentsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListMemoCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMemoCommentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMemoCommentsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListMemoCommentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of comment memos.
+	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// A token for the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The total count of comments.
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoCommentsResponse) Reset() {
+	*x = ListMemoCommentsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoCommentsResponse) ProtoMessage() {}
+
+func (x *ListMemoCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListMemoCommentsResponse) GetMemos() []*Memo {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+func (x *ListMemoCommentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListMemoCommentsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type ListMemoReactionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The maximum number of reactions to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token for pagination.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoReactionsRequest) Reset() {
+	*x = ListMemoReactionsRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoReactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoReactionsRequest) ProtoMessage() {}
+
+func (x *ListMemoReactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoReactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoReactionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListMemoReactionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListMemoReactionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMemoReactionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMemoReactionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of reactions.
+	Reactions []*Reaction `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// A token for the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The total count of reactions.
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoReactionsResponse) Reset() {
+	*x = ListMemoReactionsResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoReactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoReactionsResponse) ProtoMessage() {}
+
+func (x *ListMemoReactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoReactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoReactionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListMemoReactionsResponse) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *ListMemoReactionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListMemoReactionsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type UpsertMemoReactionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo.
+	// Format: memos/{memo}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The reaction to upsert.
+	Reaction      *Reaction `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertMemoReactionRequest) Reset() {
+	*x = UpsertMemoReactionRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertMemoReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertMemoReactionRequest) ProtoMessage() {}
+
+func (x *UpsertMemoReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertMemoReactionRequest.ProtoReflect.Descriptor instead.
+func (*UpsertMemoReactionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpsertMemoReactionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpsertMemoReactionRequest) GetReaction() *Reaction {
+	if x != nil {
+		return x.Reaction
+	}
+	return nil
+}
+
+type DeleteMemoReactionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the reaction to delete.
+	// Format: reactions/{reaction}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemoReactionRequest) Reset() {
+	*x = DeleteMemoReactionRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemoReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemoReactionRequest) ProtoMessage() {}
+
+func (x *DeleteMemoReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemoReactionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoReactionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeleteMemoReactionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type MemoShare struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the memo share.
+	// Format: memos/{memo}/shares/{share}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The secret token of the share link.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// Optional. The password required to open the share link.
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// Whether the share link requires a password.
+	PasswordProtected bool `protobuf:"varint,4,opt,name=password_protected,json=passwordProtected,proto3" json:"password_protected,omitempty"`
+	// Optional. The expiration timestamp, the share link never expires if not set.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// The creation timestamp.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoShare) Reset() {
+	*x = MemoShare{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoShare) ProtoMessage() {}
+
+func (x *MemoShare) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoShare.ProtoReflect.Descriptor instead.
+func (*MemoShare) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MemoShare) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemoShare) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *MemoShare) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *MemoShare) GetPasswordProtected() bool {
+	if x != nil {
+		return x.PasswordProtected
+	}
+	return false
+}
+
+func (x *MemoShare) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *MemoShare) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ListMemoSharesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The memo whose share links are listed.
+	// Format: memos/{memo}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoSharesRequest) Reset() {
+	*x = ListMemoSharesRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoSharesRequest) ProtoMessage() {}
+
+func (x *ListMemoSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoSharesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListMemoSharesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListMemoSharesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of memo shares.
+	MemoShares    []*MemoShare `protobuf:"bytes,1,rep,name=memo_shares,json=memoShares,proto3" json:"memo_shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoSharesResponse) Reset() {
+	*x = ListMemoSharesResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoSharesResponse) ProtoMessage() {}
+
+func (x *ListMemoSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoSharesResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoSharesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListMemoSharesResponse) GetMemoShares() []*MemoShare {
+	if x != nil {
+		return x.MemoShares
+	}
+	return nil
+}
+
+type CreateMemoShareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The private memo to share.
+	// Format: memos/{memo}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The memo share to create.
+	MemoShare     *MemoShare `protobuf:"bytes,2,opt,name=memo_share,json=memoShare,proto3" json:"memo_share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemoShareRequest) Reset() {
+	*x = CreateMemoShareRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemoShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemoShareRequest) ProtoMessage() {}
+
+func (x *CreateMemoShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemoShareRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemoShareRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreateMemoShareRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateMemoShareRequest) GetMemoShare() *MemoShare {
+	if x != nil {
+		return x.MemoShare
+	}
+	return nil
+}
+
+type DeleteMemoShareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo share to delete.
+	// Format: memos/{memo}/shares/{share}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMemoShareRequest) Reset() {
+	*x = DeleteMemoShareRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMemoShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMemoShareRequest) ProtoMessage() {}
+
+func (x *DeleteMemoShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMemoShareRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMemoShareRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteMemoShareRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetSharedMemoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The token of the share link.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Optional. The password of the share link, if it requires one.
+	Password      string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedMemoRequest) Reset() {
+	*x = GetSharedMemoRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedMemoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedMemoRequest) ProtoMessage() {}
+
+func (x *GetSharedMemoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedMemoRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedMemoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetSharedMemoRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetSharedMemoRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ExportMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. Format for the export (currently only "json" is supported)
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// Optional. Filter to apply to memos for export
+	// Uses the same filter format as ListMemosRequest
+	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Optional. Whether to exclude archived memos from export
+	// Default: false (include archived memos)
+	ExcludeArchived bool `protobuf:"varint,3,opt,name=exclude_archived,json=excludeArchived,proto3" json:"exclude_archived,omitempty"`
+	// Optional. Whether to include attachments in the export
+	// Default: true
+	IncludeAttachments bool `protobuf:"varint,4,opt,name=include_attachments,json=includeAttachments,proto3" json:"include_attachments,omitempty"`
+	// Optional. Whether to include memo relations in the export
+	// Default: true
+	IncludeRelations bool `protobuf:"varint,5,opt,name=include_relations,json=includeRelations,proto3" json:"include_relations,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ExportMemosRequest) Reset() {
+	*x = ExportMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMemosRequest) ProtoMessage() {}
+
+func (x *ExportMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMemosRequest.ProtoReflect.Descriptor instead.
+func (*ExportMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ExportMemosRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportMemosRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ExportMemosRequest) GetExcludeArchived() bool {
+	if x != nil {
+		return x.ExcludeArchived
+	}
+	return false
+}
+
+func (x *ExportMemosRequest) GetIncludeAttachments() bool {
+	if x != nil {
+		return x.IncludeAttachments
+	}
+	return false
+}
+
+func (x *ExportMemosRequest) GetIncludeRelations() bool {
+	if x != nil {
+		return x.IncludeRelations
+	}
+	return false
+}
+
+type ExportMemosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The exported data as bytes
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// The format of the exported data
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// Suggested filename for the export
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Number of memos exported
+	MemoCount int32 `protobuf:"varint,4,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
+	// Size of the export data in bytes
+	SizeBytes     int64 `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMemosResponse) Reset() {
+	*x = ExportMemosResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMemosResponse) ProtoMessage() {}
+
+func (x *ExportMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMemosResponse.ProtoReflect.Descriptor instead.
+func (*ExportMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ExportMemosResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportMemosResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportMemosResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportMemosResponse) GetMemoCount() int32 {
+	if x != nil {
 		return x.MemoCount
 	}
 	return 0
 }
 
-func (x *ExportMemosResponse) GetSizeBytes() int64 {
+func (x *ExportMemosResponse) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type ImportMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The data to import (JSON format)
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Optional. Format of the import data (currently only "json" is supported)
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// Optional. Whether to overwrite existing memos with the same UID
+	// Default: false (skip existing memos)
+	OverwriteExisting bool `protobuf:"varint,3,opt,name=overwrite_existing,json=overwriteExisting,proto3" json:"overwrite_existing,omitempty"`
+	// Optional. Whether to validate only (dry run mode)
+	// If true, the import will be validated but no data will be created
+	ValidateOnly bool `protobuf:"varint,4,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	// Optional. Whether to preserve original timestamps
+	// Default: true
+	PreserveTimestamps bool `protobuf:"varint,5,opt,name=preserve_timestamps,json=preserveTimestamps,proto3" json:"preserve_timestamps,omitempty"`
+	// Optional. Whether to skip importing attachments
+	// Default: false (import attachments if present)
+	SkipAttachments bool `protobuf:"varint,6,opt,name=skip_attachments,json=skipAttachments,proto3" json:"skip_attachments,omitempty"`
+	// Optional. Whether to skip importing memo relations
+	// Default: false (import relations if present)
+	SkipRelations bool `protobuf:"varint,7,opt,name=skip_relations,json=skipRelations,proto3" json:"skip_relations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportMemosRequest) Reset() {
+	*x = ImportMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportMemosRequest) ProtoMessage() {}
+
+func (x *ImportMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportMemosRequest.ProtoReflect.Descriptor instead.
+func (*ImportMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ImportMemosRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ImportMemosRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportMemosRequest) GetOverwriteExisting() bool {
+	if x != nil {
+		return x.OverwriteExisting
+	}
+	return false
+}
+
+func (x *ImportMemosRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+func (x *ImportMemosRequest) GetPreserveTimestamps() bool {
+	if x != nil {
+		return x.PreserveTimestamps
+	}
+	return false
+}
+
+func (x *ImportMemosRequest) GetSkipAttachments() bool {
+	if x != nil {
+		return x.SkipAttachments
+	}
+	return false
+}
+
+func (x *ImportMemosRequest) GetSkipRelations() bool {
+	if x != nil {
+		return x.SkipRelations
+	}
+	return false
+}
+
+type ImportMemosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of memos successfully imported
+	ImportedCount int32 `protobuf:"varint,1,opt,name=imported_count,json=importedCount,proto3" json:"imported_count,omitempty"`
+	// Number of memos skipped (due to errors or existing UIDs)
+	SkippedCount int32 `protobuf:"varint,2,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	// Number of memos that failed validation (in validate_only mode)
+	ValidationErrors int32 `protobuf:"varint,3,opt,name=validation_errors,json=validationErrors,proto3" json:"validation_errors,omitempty"`
+	// List of error messages for failed imports
+	Errors []string `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	// List of warning messages for potential issues
+	Warnings []string `protobuf:"bytes,5,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// Summary of the import operation
+	Summary       *ImportSummary `protobuf:"bytes,6,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportMemosResponse) Reset() {
+	*x = ImportMemosResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportMemosResponse) ProtoMessage() {}
+
+func (x *ImportMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportMemosResponse.ProtoReflect.Descriptor instead.
+func (*ImportMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ImportMemosResponse) GetImportedCount() int32 {
+	if x != nil {
+		return x.ImportedCount
+	}
+	return 0
+}
+
+func (x *ImportMemosResponse) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+func (x *ImportMemosResponse) GetValidationErrors() int32 {
+	if x != nil {
+		return x.ValidationErrors
+	}
+	return 0
+}
+
+func (x *ImportMemosResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportMemosResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *ImportMemosResponse) GetSummary() *ImportSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+type ImportSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Total number of memos in the import data
+	TotalMemos int32 `protobuf:"varint,1,opt,name=total_memos,json=totalMemos,proto3" json:"total_memos,omitempty"`
+	// Number of new memos created
+	CreatedCount int32 `protobuf:"varint,2,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	// Number of existing memos updated
+	UpdatedCount int32 `protobuf:"varint,3,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
+	// Number of attachments imported
+	AttachmentsImported int32 `protobuf:"varint,4,opt,name=attachments_imported,json=attachmentsImported,proto3" json:"attachments_imported,omitempty"`
+	// Number of relations imported
+	RelationsImported int32 `protobuf:"varint,5,opt,name=relations_imported,json=relationsImported,proto3" json:"relations_imported,omitempty"`
+	// Import duration in milliseconds
+	DurationMs    int64 `protobuf:"varint,6,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSummary) Reset() {
+	*x = ImportSummary{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSummary) ProtoMessage() {}
+
+func (x *ImportSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSummary.ProtoReflect.Descriptor instead.
+func (*ImportSummary) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ImportSummary) GetTotalMemos() int32 {
+	if x != nil {
+		return x.TotalMemos
+	}
+	return 0
+}
+
+func (x *ImportSummary) GetCreatedCount() int32 {
 	if x != nil {
-		return x.SizeBytes
+		return x.CreatedCount
 	}
 	return 0
 }
 
-type ImportMemosRequest struct {
+func (x *ImportSummary) GetUpdatedCount() int32 {
+	if x != nil {
+		return x.UpdatedCount
+	}
+	return 0
+}
+
+func (x *ImportSummary) GetAttachmentsImported() int32 {
+	if x != nil {
+		return x.AttachmentsImported
+	}
+	return 0
+}
+
+func (x *ImportSummary) GetRelationsImported() int32 {
+	if x != nil {
+		return x.RelationsImported
+	}
+	return 0
+}
+
+func (x *ImportSummary) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type SaveLinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The URL of the web page to save.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Optional. The tags of the memo, "readlater" if empty.
+	Tags []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Optional. The visibility of the memo, the default visibility of the user if unspecified.
+	Visibility    Visibility `protobuf:"varint,3,opt,name=visibility,proto3,enum=memos.api.v1.Visibility" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveLinkRequest) Reset() {
+	*x = SaveLinkRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveLinkRequest) ProtoMessage() {}
+
+func (x *SaveLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveLinkRequest.ProtoReflect.Descriptor instead.
+func (*SaveLinkRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SaveLinkRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SaveLinkRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SaveLinkRequest) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+type ListOnThisDayMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The user whose memos are listed.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Optional. The day, e.g. "2024-05-17", today if empty.
+	Date string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// Optional. The IANA time zone of the day, e.g. "Europe/Berlin".
+	// Defaults to UTC.
+	TimeZone      string `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOnThisDayMemosRequest) Reset() {
+	*x = ListOnThisDayMemosRequest{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOnThisDayMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOnThisDayMemosRequest) ProtoMessage() {}
+
+func (x *ListOnThisDayMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOnThisDayMemosRequest.ProtoReflect.Descriptor instead.
+func (*ListOnThisDayMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListOnThisDayMemosRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListOnThisDayMemosRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ListOnThisDayMemosRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type ListOnThisDayMemosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The memos created on the day in past years, the most recent first.
+	Memos         []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOnThisDayMemosResponse) Reset() {
+	*x = ListOnThisDayMemosResponse{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOnThisDayMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOnThisDayMemosResponse) ProtoMessage() {}
+
+func (x *ListOnThisDayMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOnThisDayMemosResponse.ProtoReflect.Descriptor instead.
+func (*ListOnThisDayMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListOnThisDayMemosResponse) GetMemos() []*Memo {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+// Computed properties of a memo.
+type Memo_Property struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	HasLink            bool                   `protobuf:"varint,1,opt,name=has_link,json=hasLink,proto3" json:"has_link,omitempty"`
+	HasTaskList        bool                   `protobuf:"varint,2,opt,name=has_task_list,json=hasTaskList,proto3" json:"has_task_list,omitempty"`
+	HasCode            bool                   `protobuf:"varint,3,opt,name=has_code,json=hasCode,proto3" json:"has_code,omitempty"`
+	HasIncompleteTasks bool                   `protobuf:"varint,4,opt,name=has_incomplete_tasks,json=hasIncompleteTasks,proto3" json:"has_incomplete_tasks,omitempty"`
+	WordCount          int32                  `protobuf:"varint,5,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Memo_Property) Reset() {
+	*x = Memo_Property{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Memo_Property) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Memo_Property) ProtoMessage() {}
+
+func (x *Memo_Property) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Memo_Property.ProtoReflect.Descriptor instead.
+func (*Memo_Property) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Memo_Property) GetHasLink() bool {
+	if x != nil {
+		return x.HasLink
+	}
+	return false
+}
+
+func (x *Memo_Property) GetHasTaskList() bool {
+	if x != nil {
+		return x.HasTaskList
+	}
+	return false
+}
+
+func (x *Memo_Property) GetHasCode() bool {
+	if x != nil {
+		return x.HasCode
+	}
+	return false
+}
+
+func (x *Memo_Property) GetHasIncompleteTasks() bool {
+	if x != nil {
+		return x.HasIncompleteTasks
+	}
+	return false
+}
+
+func (x *Memo_Property) GetWordCount() int32 {
+	if x != nil {
+		return x.WordCount
+	}
+	return 0
+}
+
+type SearchMemosResponse_MemoMatch struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Required. The data to import (JSON format)
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	// Optional. Format of the import data (currently only "json" is supported)
-	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
-	// Optional. Whether to overwrite existing memos with the same UID
-	// Default: false (skip existing memos)
-	OverwriteExisting bool `protobuf:"varint,3,opt,name=overwrite_existing,json=overwriteExisting,proto3" json:"overwrite_existing,omitempty"`
-	// Optional. Whether to validate only (dry run mode)
-	// If true, the import will be validated but no data will be created
-	ValidateOnly bool `protobuf:"varint,4,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
-	// Optional. Whether to preserve original timestamps
-	// Default: true
-	PreserveTimestamps bool `protobuf:"varint,5,opt,name=preserve_timestamps,json=preserveTimestamps,proto3" json:"preserve_timestamps,omitempty"`
-	// Optional. Whether to skip importing attachments
-	// Default: false (import attachments if present)
-	SkipAttachments bool `protobuf:"varint,6,opt,name=skip_attachments,json=skipAttachments,proto3" json:"skip_attachments,omitempty"`
-	// Optional. Whether to skip importing memo relations
-	// Default: false (import relations if present)
-	SkipRelations bool `protobuf:"varint,7,opt,name=skip_relations,json=skipRelations,proto3" json:"skip_relations,omitempty"`
+	// The matching memo.
+	// Format: memos/{memo}
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// An excerpt of the memo content around the first match.
+	// It is the start of the content if only an attachment of the memo matches.
+	Snippet string `protobuf:"bytes,2,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	// The ranges of the snippet matching the query.
+	Highlights []*TextHighlight `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	// The relevance of the memo to the query, higher is more relevant.
+	// Scores are only comparable within a search, and are 0 unless ordering by relevance.
+	Score         float64 `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ImportMemosRequest) Reset() {
-	*x = ImportMemosRequest{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[27]
+func (x *SearchMemosResponse_MemoMatch) Reset() {
+	*x = SearchMemosResponse_MemoMatch{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ImportMemosRequest) String() string {
+func (x *SearchMemosResponse_MemoMatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportMemosRequest) ProtoMessage() {}
+func (*SearchMemosResponse_MemoMatch) ProtoMessage() {}
 
-func (x *ImportMemosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[27]
+func (x *SearchMemosResponse_MemoMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2106,93 +3984,72 @@ func (x *ImportMemosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportMemosRequest.ProtoReflect.Descriptor instead.
-func (*ImportMemosRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *ImportMemosRequest) GetData() []byte {
-	if x != nil {
-		return x.Data
-	}
-	return nil
+// Deprecated: Use SearchMemosResponse_MemoMatch.ProtoReflect.Descriptor instead.
+func (*SearchMemosResponse_MemoMatch) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{9, 0}
 }
 
-func (x *ImportMemosRequest) GetFormat() string {
+func (x *SearchMemosResponse_MemoMatch) GetMemo() string {
 	if x != nil {
-		return x.Format
+		return x.Memo
 	}
 	return ""
 }
 
-func (x *ImportMemosRequest) GetOverwriteExisting() bool {
-	if x != nil {
-		return x.OverwriteExisting
-	}
-	return false
-}
-
-func (x *ImportMemosRequest) GetValidateOnly() bool {
-	if x != nil {
-		return x.ValidateOnly
-	}
-	return false
-}
-
-func (x *ImportMemosRequest) GetPreserveTimestamps() bool {
+func (x *SearchMemosResponse_MemoMatch) GetSnippet() string {
 	if x != nil {
-		return x.PreserveTimestamps
+		return x.Snippet
 	}
-	return false
+	return ""
 }
 
-func (x *ImportMemosRequest) GetSkipAttachments() bool {
+func (x *SearchMemosResponse_MemoMatch) GetHighlights() []*TextHighlight {
 	if x != nil {
-		return x.SkipAttachments
+		return x.Highlights
 	}
-	return false
+	return nil
 }
 
-func (x *ImportMemosRequest) GetSkipRelations() bool {
+func (x *SearchMemosResponse_MemoMatch) GetScore() float64 {
 	if x != nil {
-		return x.SkipRelations
+		return x.Score
 	}
-	return false
+	return 0
 }
 
-type ImportMemosResponse struct {
+type SearchMemosResponse_AttachmentMatch struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Number of memos successfully imported
-	ImportedCount int32 `protobuf:"varint,1,opt,name=imported_count,json=importedCount,proto3" json:"imported_count,omitempty"`
-	// Number of memos skipped (due to errors or existing UIDs)
-	SkippedCount int32 `protobuf:"varint,2,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
-	// Number of memos that failed validation (in validate_only mode)
-	ValidationErrors int32 `protobuf:"varint,3,opt,name=validation_errors,json=validationErrors,proto3" json:"validation_errors,omitempty"`
-	// List of error messages for failed imports
-	Errors []string `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
-	// List of warning messages for potential issues
-	Warnings []string `protobuf:"bytes,5,rep,name=warnings,proto3" json:"warnings,omitempty"`
-	// Summary of the import operation
-	Summary       *ImportSummary `protobuf:"bytes,6,opt,name=summary,proto3" json:"summary,omitempty"`
+	// The memo the attachment belongs to.
+	// Format: memos/{memo}
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The attachment whose text matches the query.
+	// Format: attachments/{attachment}
+	Attachment string `protobuf:"bytes,2,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	// The filename of the attachment.
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	// An excerpt of the attachment text around the first matching term.
+	Snippet string `protobuf:"bytes,4,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	// The ranges of the snippet matching the query.
+	Highlights    []*TextHighlight `protobuf:"bytes,5,rep,name=highlights,proto3" json:"highlights,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ImportMemosResponse) Reset() {
-	*x = ImportMemosResponse{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[28]
+func (x *SearchMemosResponse_AttachmentMatch) Reset() {
+	*x = SearchMemosResponse_AttachmentMatch{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ImportMemosResponse) String() string {
+func (x *SearchMemosResponse_AttachmentMatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportMemosResponse) ProtoMessage() {}
+func (*SearchMemosResponse_AttachmentMatch) ProtoMessage() {}
 
-func (x *ImportMemosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[28]
+func (x *SearchMemosResponse_AttachmentMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2203,86 +4060,72 @@ func (x *ImportMemosResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportMemosResponse.ProtoReflect.Descriptor instead.
-func (*ImportMemosResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{28}
-}
-
-func (x *ImportMemosResponse) GetImportedCount() int32 {
-	if x != nil {
-		return x.ImportedCount
-	}
-	return 0
+// Deprecated: Use SearchMemosResponse_AttachmentMatch.ProtoReflect.Descriptor instead.
+func (*SearchMemosResponse_AttachmentMatch) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{9, 1}
 }
 
-func (x *ImportMemosResponse) GetSkippedCount() int32 {
+func (x *SearchMemosResponse_AttachmentMatch) GetMemo() string {
 	if x != nil {
-		return x.SkippedCount
+		return x.Memo
 	}
-	return 0
+	return ""
 }
 
-func (x *ImportMemosResponse) GetValidationErrors() int32 {
+func (x *SearchMemosResponse_AttachmentMatch) GetAttachment() string {
 	if x != nil {
-		return x.ValidationErrors
+		return x.Attachment
 	}
-	return 0
+	return ""
 }
 
-func (x *ImportMemosResponse) GetErrors() []string {
+func (x *SearchMemosResponse_AttachmentMatch) GetFilename() string {
 	if x != nil {
-		return x.Errors
+		return x.Filename
 	}
-	return nil
+	return ""
 }
 
-func (x *ImportMemosResponse) GetWarnings() []string {
+func (x *SearchMemosResponse_AttachmentMatch) GetSnippet() string {
 	if x != nil {
-		return x.Warnings
+		return x.Snippet
 	}
-	return nil
+	return ""
 }
 
-func (x *ImportMemosResponse) GetSummary() *ImportSummary {
+func (x *SearchMemosResponse_AttachmentMatch) GetHighlights() []*TextHighlight {
 	if x != nil {
-		return x.Summary
+		return x.Highlights
 	}
 	return nil
 }
 
-type ImportSummary struct {
+type SearchMemosResponse_CreatorFacet struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Total number of memos in the import data
-	TotalMemos int32 `protobuf:"varint,1,opt,name=total_memos,json=totalMemos,proto3" json:"total_memos,omitempty"`
-	// Number of new memos created
-	CreatedCount int32 `protobuf:"varint,2,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
-	// Number of existing memos updated
-	UpdatedCount int32 `protobuf:"varint,3,opt,name=updated_count,json=updatedCount,proto3" json:"updated_count,omitempty"`
-	// Number of attachments imported
-	AttachmentsImported int32 `protobuf:"varint,4,opt,name=attachments_imported,json=attachmentsImported,proto3" json:"attachments_imported,omitempty"`
-	// Number of relations imported
-	RelationsImported int32 `protobuf:"varint,5,opt,name=relations_imported,json=relationsImported,proto3" json:"relations_imported,omitempty"`
-	// Import duration in milliseconds
-	DurationMs    int64 `protobuf:"varint,6,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	// The creator of the matching memos.
+	// Format: users/{user}
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// The number of matching memos of the creator, across all pages.
+	MemoCount     int32 `protobuf:"varint,2,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ImportSummary) Reset() {
-	*x = ImportSummary{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[29]
+func (x *SearchMemosResponse_CreatorFacet) Reset() {
+	*x = SearchMemosResponse_CreatorFacet{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ImportSummary) String() string {
+func (x *SearchMemosResponse_CreatorFacet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportSummary) ProtoMessage() {}
+func (*SearchMemosResponse_CreatorFacet) ProtoMessage() {}
 
-func (x *ImportSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[29]
+func (x *SearchMemosResponse_CreatorFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2293,79 +4136,105 @@ func (x *ImportSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportSummary.ProtoReflect.Descriptor instead.
-func (*ImportSummary) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use SearchMemosResponse_CreatorFacet.ProtoReflect.Descriptor instead.
+func (*SearchMemosResponse_CreatorFacet) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{9, 2}
 }
 
-func (x *ImportSummary) GetTotalMemos() int32 {
+func (x *SearchMemosResponse_CreatorFacet) GetCreator() string {
 	if x != nil {
-		return x.TotalMemos
+		return x.Creator
 	}
-	return 0
+	return ""
 }
 
-func (x *ImportSummary) GetCreatedCount() int32 {
+func (x *SearchMemosResponse_CreatorFacet) GetMemoCount() int32 {
 	if x != nil {
-		return x.CreatedCount
+		return x.MemoCount
 	}
 	return 0
 }
 
-func (x *ImportSummary) GetUpdatedCount() int32 {
-	if x != nil {
-		return x.UpdatedCount
-	}
-	return 0
+type SuggestMemosResponse_MemoSuggestion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The suggested memo.
+	// Format: memos/{memo}
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The first line of the memo content.
+	Title         string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ImportSummary) GetAttachmentsImported() int32 {
+func (x *SuggestMemosResponse_MemoSuggestion) Reset() {
+	*x = SuggestMemosResponse_MemoSuggestion{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestMemosResponse_MemoSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestMemosResponse_MemoSuggestion) ProtoMessage() {}
+
+func (x *SuggestMemosResponse_MemoSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[55]
 	if x != nil {
-		return x.AttachmentsImported
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ImportSummary) GetRelationsImported() int32 {
+// Deprecated: Use SuggestMemosResponse_MemoSuggestion.ProtoReflect.Descriptor instead.
+func (*SuggestMemosResponse_MemoSuggestion) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *SuggestMemosResponse_MemoSuggestion) GetMemo() string {
 	if x != nil {
-		return x.RelationsImported
+		return x.Memo
 	}
-	return 0
+	return ""
 }
 
-func (x *ImportSummary) GetDurationMs() int64 {
+func (x *SuggestMemosResponse_MemoSuggestion) GetTitle() string {
 	if x != nil {
-		return x.DurationMs
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-// Computed properties of a memo.
-type Memo_Property struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	HasLink            bool                   `protobuf:"varint,1,opt,name=has_link,json=hasLink,proto3" json:"has_link,omitempty"`
-	HasTaskList        bool                   `protobuf:"varint,2,opt,name=has_task_list,json=hasTaskList,proto3" json:"has_task_list,omitempty"`
-	HasCode            bool                   `protobuf:"varint,3,opt,name=has_code,json=hasCode,proto3" json:"has_code,omitempty"`
-	HasIncompleteTasks bool                   `protobuf:"varint,4,opt,name=has_incomplete_tasks,json=hasIncompleteTasks,proto3" json:"has_incomplete_tasks,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type SemanticSearchMemosResponse_Result struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The matching memo.
+	Memo *Memo `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The cosine similarity between the memo and the query, from -1 to 1.
+	Score         float32 `protobuf:"fixed32,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Memo_Property) Reset() {
-	*x = Memo_Property{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[30]
+func (x *SemanticSearchMemosResponse_Result) Reset() {
+	*x = SemanticSearchMemosResponse_Result{}
+	mi := &file_api_v1_memo_service_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Memo_Property) String() string {
+func (x *SemanticSearchMemosResponse_Result) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Memo_Property) ProtoMessage() {}
+func (*SemanticSearchMemosResponse_Result) ProtoMessage() {}
 
-func (x *Memo_Property) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[30]
+func (x *SemanticSearchMemosResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_memo_service_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2376,37 +4245,23 @@ func (x *Memo_Property) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Memo_Property.ProtoReflect.Descriptor instead.
-func (*Memo_Property) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{1, 0}
-}
-
-func (x *Memo_Property) GetHasLink() bool {
-	if x != nil {
-		return x.HasLink
-	}
-	return false
-}
-
-func (x *Memo_Property) GetHasTaskList() bool {
-	if x != nil {
-		return x.HasTaskList
-	}
-	return false
+// Deprecated: Use SemanticSearchMemosResponse_Result.ProtoReflect.Descriptor instead.
+func (*SemanticSearchMemosResponse_Result) Descriptor() ([]byte, []int) {
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{13, 0}
 }
 
-func (x *Memo_Property) GetHasCode() bool {
+func (x *SemanticSearchMemosResponse_Result) GetMemo() *Memo {
 	if x != nil {
-		return x.HasCode
+		return x.Memo
 	}
-	return false
+	return nil
 }
 
-func (x *Memo_Property) GetHasIncompleteTasks() bool {
+func (x *SemanticSearchMemosResponse_Result) GetScore() float32 {
 	if x != nil {
-		return x.HasIncompleteTasks
+		return x.Score
 	}
-	return false
+	return 0
 }
 
 // Memo reference in relations.
@@ -2423,7 +4278,7 @@ type MemoRelation_Memo struct {
 
 func (x *MemoRelation_Memo) Reset() {
 	*x = MemoRelation_Memo{}
-	mi := &file_api_v1_memo_service_proto_msgTypes[31]
+	mi := &file_api_v1_memo_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2435,7 +4290,7 @@ func (x *MemoRelation_Memo) String() string {
 func (*MemoRelation_Memo) ProtoMessage() {}
 
 func (x *MemoRelation_Memo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_memo_service_proto_msgTypes[31]
+	mi := &file_api_v1_memo_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2448,7 +4303,7 @@ func (x *MemoRelation_Memo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MemoRelation_Memo.ProtoReflect.Descriptor instead.
 func (*MemoRelation_Memo) Descriptor() ([]byte, []int) {
-	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{14, 0}
+	return file_api_v1_memo_service_proto_rawDescGZIP(), []int{26, 0}
 }
 
 func (x *MemoRelation_Memo) GetName() string {
@@ -2469,7 +4324,7 @@ var File_api_v1_memo_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\n" +
-	"\x19api/v1/memo_service.proto\x12\fmemos.api.v1\x1a\x1fapi/v1/attachment_service.proto\x1a\x13api/v1/common.proto\x1a\x1dapi/v1/markdown_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xce\x02\n" +
+	"\x19api/v1/memo_service.proto\x12\fmemos.api.v1\x1a\x1fapi/v1/attachment_service.proto\x1a\x13api/v1/common.proto\x1a\x1dapi/v1/markdown_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/httpbody.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xce\x02\n" +
 	"\bReaction\x12\x1a\n" +
 	"\x04name\x18\x01 \x01(\tB\x06\xe0A\x03\xe0A\bR\x04name\x123\n" +
 	"\acreator\x18\x02 \x01(\tB\x19\xe0A\x03\xfaA\x13\n" +
@@ -2480,7 +4335,8 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\rreaction_type\x18\x04 \x01(\tB\x03\xe0A\x02R\freactionType\x12@\n" +
 	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
 	"createTime:K\xeaAH\n" +
-	"\x15memos.api.v1/Reaction\x12\x14reactions/{reaction}\x1a\x04name*\treactions2\breaction\"\x87\t\n" +
+	"\x15memos.api.v1/Reaction\x12\x14reactions/{reaction}\x1a\x04name*\treactions2\breaction\"\xf6\n" +
+	"\n" +
 	"\x04Memo\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12.\n" +
 	"\x05state\x18\x02 \x01(\x0e2\x13.memos.api.v1.StateB\x03\xe0A\x02R\x05state\x123\n" +
@@ -2506,15 +4362,32 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x06parent\x18\x10 \x01(\tB\x19\xe0A\x03\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoH\x00R\x06parent\x88\x01\x01\x12\x1d\n" +
 	"\asnippet\x18\x11 \x01(\tB\x03\xe0A\x03R\asnippet\x12<\n" +
-	"\blocation\x18\x12 \x01(\v2\x16.memos.api.v1.LocationB\x03\xe0A\x01H\x01R\blocation\x88\x01\x01\x1a\x96\x01\n" +
+	"\blocation\x18\x12 \x01(\v2\x16.memos.api.v1.LocationB\x03\xe0A\x01H\x01R\blocation\x88\x01\x01\x12#\n" +
+	"\n" +
+	"transcript\x18\x13 \x01(\tB\x03\xe0A\x03R\n" +
+	"transcript\x120\n" +
+	"\x05space\x18\x14 \x01(\tB\x1a\xe0A\x01\xfaA\x14\n" +
+	"\x12memos.api.v1/SpaceR\x05space\x122\n" +
+	"\x06groups\x18\x15 \x03(\tB\x1a\xe0A\x01\xfaA\x14\n" +
+	"\x12memos.api.v1/GroupR\x06groups\x12C\n" +
+	"\rlink_previews\x18\x16 \x03(\v2\x19.memos.api.v1.LinkPreviewB\x03\xe0A\x03R\flinkPreviews\x1a\xb5\x01\n" +
 	"\bProperty\x12\x19\n" +
 	"\bhas_link\x18\x01 \x01(\bR\ahasLink\x12\"\n" +
 	"\rhas_task_list\x18\x02 \x01(\bR\vhasTaskList\x12\x19\n" +
 	"\bhas_code\x18\x03 \x01(\bR\ahasCode\x120\n" +
-	"\x14has_incomplete_tasks\x18\x04 \x01(\bR\x12hasIncompleteTasks:7\xeaA4\n" +
+	"\x14has_incomplete_tasks\x18\x04 \x01(\bR\x12hasIncompleteTasks\x12\x1d\n" +
+	"\n" +
+	"word_count\x18\x05 \x01(\x05R\twordCount:7\xeaA4\n" +
 	"\x11memos.api.v1/Memo\x12\fmemos/{memo}\x1a\x04name*\x05memos2\x04memoB\t\n" +
 	"\a_parentB\v\n" +
-	"\t_location\"u\n" +
+	"\t_location\"\xa4\x01\n" +
+	"\vLinkPreview\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05image\x18\x04 \x01(\tR\x05image\x12\x18\n" +
+	"\afavicon\x18\x05 \x01(\tR\afavicon\x12\x1b\n" +
+	"\tsite_name\x18\x06 \x01(\tR\bsiteName\"u\n" +
 	"\bLocation\x12%\n" +
 	"\vplaceholder\x18\x01 \x01(\tB\x03\xe0A\x01R\vplaceholder\x12\x1f\n" +
 	"\blatitude\x18\x02 \x01(\x01B\x03\xe0A\x01R\blatitude\x12!\n" +
@@ -2524,7 +4397,7 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\amemo_id\x18\x02 \x01(\tB\x03\xe0A\x01R\x06memoId\x12(\n" +
 	"\rvalidate_only\x18\x03 \x01(\bB\x03\xe0A\x01R\fvalidateOnly\x12\"\n" +
 	"\n" +
-	"request_id\x18\x04 \x01(\tB\x03\xe0A\x01R\trequestId\"\xbf\x02\n" +
+	"request_id\x18\x04 \x01(\tB\x03\xe0A\x01R\trequestId\"\xf1\x02\n" +
 	"\x10ListMemosRequest\x121\n" +
 	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x01\xfaA\x13\n" +
 	"\x11memos.api.v1/UserR\x06parent\x12 \n" +
@@ -2536,12 +4409,106 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x06filter\x18\x06 \x01(\tB\x03\xe0A\x01R\x06filter\x12&\n" +
 	"\fshow_deleted\x18\a \x01(\bB\x03\xe0A\x01R\vshowDeleted\x12\x1d\n" +
 	"\n" +
-	"old_filter\x18\b \x01(\tR\toldFilter\"\x84\x01\n" +
+	"old_filter\x18\b \x01(\tR\toldFilter\x120\n" +
+	"\x05space\x18\t \x01(\tB\x1a\xe0A\x01\xfaA\x14\n" +
+	"\x12memos.api.v1/SpaceR\x05space\"\x84\x01\n" +
 	"\x11ListMemosResponse\x12(\n" +
 	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
 	"\n" +
-	"total_size\x18\x03 \x01(\x05R\ttotalSize\"}\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\xf7\x03\n" +
+	"\x12SearchMemosRequest\x12\x19\n" +
+	"\x05query\x18\x01 \x01(\tB\x03\xe0A\x02R\x05query\x121\n" +
+	"\x06parent\x18\x02 \x01(\tB\x19\xe0A\x01\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\x12 \n" +
+	"\tpage_size\x18\x03 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tB\x03\xe0A\x01R\tpageToken\x12\x1b\n" +
+	"\x06filter\x18\x05 \x01(\tB\x03\xe0A\x01R\x06filter\x12\x19\n" +
+	"\x05fuzzy\x18\x06 \x01(\bB\x03\xe0A\x01R\x05fuzzy\x12\x1e\n" +
+	"\border_by\x18\a \x01(\tB\x03\xe0A\x01R\aorderBy\x12,\n" +
+	"\x0fexclude_content\x18\b \x01(\bB\x03\xe0A\x01R\x0eexcludeContent\x12\x19\n" +
+	"\x05regex\x18\t \x01(\bB\x03\xe0A\x01R\x05regex\x12A\n" +
+	"\x05scope\x18\n" +
+	" \x01(\x0e2&.memos.api.v1.SearchMemosRequest.ScopeB\x03\xe0A\x01R\x05scope\x120\n" +
+	"\x05space\x18\v \x01(\tB\x1a\xe0A\x01\xfaA\x14\n" +
+	"\x12memos.api.v1/SpaceR\x05space\"7\n" +
+	"\x05Scope\x12\x15\n" +
+	"\x11SCOPE_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03OWN\x10\x01\x12\x0e\n" +
+	"\n" +
+	"ACCESSIBLE\x10\x02\"7\n" +
+	"\rTextHighlight\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\x05R\x03end\"\x83\x06\n" +
+	"\x13SearchMemosResponse\x12(\n" +
+	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12`\n" +
+	"\x12attachment_matches\x18\x03 \x03(\v21.memos.api.v1.SearchMemosResponse.AttachmentMatchR\x11attachmentMatches\x12N\n" +
+	"\fmemo_matches\x18\x04 \x03(\v2+.memos.api.v1.SearchMemosResponse.MemoMatchR\vmemoMatches\x12U\n" +
+	"\x0ecreator_facets\x18\x05 \x03(\v2..memos.api.v1.SearchMemosResponse.CreatorFacetR\rcreatorFacets\x1a\x8c\x01\n" +
+	"\tMemoMatch\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\x12\x18\n" +
+	"\asnippet\x18\x02 \x01(\tR\asnippet\x12;\n" +
+	"\n" +
+	"highlights\x18\x03 \x03(\v2\x1b.memos.api.v1.TextHighlightR\n" +
+	"highlights\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x01R\x05score\x1a\xb8\x01\n" +
+	"\x0fAttachmentMatch\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\x12\x1e\n" +
+	"\n" +
+	"attachment\x18\x02 \x01(\tR\n" +
+	"attachment\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12\x18\n" +
+	"\asnippet\x18\x04 \x01(\tR\asnippet\x12;\n" +
+	"\n" +
+	"highlights\x18\x05 \x03(\v2\x1b.memos.api.v1.TextHighlightR\n" +
+	"highlights\x1aG\n" +
+	"\fCreatorFacet\x12\x18\n" +
+	"\acreator\x18\x01 \x01(\tR\acreator\x12\x1d\n" +
+	"\n" +
+	"memo_count\x18\x02 \x01(\x05R\tmemoCount\"M\n" +
+	"\x13SuggestMemosRequest\x12\x1b\n" +
+	"\x06prefix\x18\x01 \x01(\tB\x03\xe0A\x02R\x06prefix\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\x05B\x03\xe0A\x01R\x05limit\"\xd6\x01\n" +
+	"\x14SuggestMemosResponse\x12\x12\n" +
+	"\x04tags\x18\x01 \x03(\tR\x04tags\x12G\n" +
+	"\x05memos\x18\x02 \x03(\v21.memos.api.v1.SuggestMemosResponse.MemoSuggestionR\x05memos\x12%\n" +
+	"\x0erecent_queries\x18\x03 \x03(\tR\rrecentQueries\x1a:\n" +
+	"\x0eMemoSuggestion\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\"\x8c\x01\n" +
+	"\x1aSemanticSearchMemosRequest\x12\x19\n" +
+	"\x05query\x18\x01 \x01(\tB\x03\xe0A\x02R\x05query\x121\n" +
+	"\x06parent\x18\x02 \x01(\tB\x19\xe0A\x01\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\x12 \n" +
+	"\tpage_size\x18\x03 \x01(\x05B\x03\xe0A\x01R\bpageSize\"\xb1\x01\n" +
+	"\x1bSemanticSearchMemosResponse\x12J\n" +
+	"\aresults\x18\x01 \x03(\v20.memos.api.v1.SemanticSearchMemosResponse.ResultR\aresults\x1aF\n" +
+	"\x06Result\x12&\n" +
+	"\x04memo\x18\x01 \x01(\v2\x12.memos.api.v1.MemoR\x04memo\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x02R\x05score\"\x9e\x02\n" +
+	"\n" +
+	"MemoChange\x12*\n" +
+	"\x04memo\x18\x01 \x01(\tB\x16\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04memo\x121\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1d.memos.api.v1.MemoChange.TypeR\x04type\x12;\n" +
+	"\vchange_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"changeTime\x12/\n" +
+	"\tmemo_data\x18\x04 \x01(\v2\x12.memos.api.v1.MemoR\bmemoData\"C\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aCREATED\x10\x01\x12\v\n" +
+	"\aUPDATED\x10\x02\x12\v\n" +
+	"\aDELETED\x10\x03\"W\n" +
+	"\x16ListMemoChangesRequest\x12\x1b\n" +
+	"\x06cursor\x18\x01 \x01(\tB\x03\xe0A\x01R\x06cursor\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\"\x89\x01\n" +
+	"\x17ListMemoChangesResponse\x122\n" +
+	"\achanges\x18\x01 \x03(\v2\x18.memos.api.v1.MemoChangeR\achanges\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"}\n" +
 	"\x0eGetMemoRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/MemoR\x04name\x12<\n" +
@@ -2579,7 +4546,10 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\vattachments\x18\x01 \x03(\v2\x18.memos.api.v1.AttachmentR\vattachments\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
 	"\n" +
-	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\xdb\x02\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"Q\n" +
+	" GetMemoAttachmentsArchiveRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/MemoR\x04name\"\xdb\x02\n" +
 	"\fMemoRelation\x128\n" +
 	"\x04memo\x18\x01 \x01(\v2\x1f.memos.api.v1.MemoRelation.MemoB\x03\xe0A\x02R\x04memo\x12G\n" +
 	"\frelated_memo\x18\x02 \x01(\v2\x1f.memos.api.v1.MemoRelation.MemoB\x03\xe0A\x02R\vrelatedMemo\x128\n" +
@@ -2642,7 +4612,33 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\breaction\x18\x02 \x01(\v2\x16.memos.api.v1.ReactionB\x03\xe0A\x02R\breaction\"N\n" +
 	"\x19DeleteMemoReactionRequest\x121\n" +
 	"\x04name\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
-	"\x15memos.api.v1/ReactionR\x04name\"\xe6\x01\n" +
+	"\x15memos.api.v1/ReactionR\x04name\"\xe9\x02\n" +
+	"\tMemoShare\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x19\n" +
+	"\x05token\x18\x02 \x01(\tB\x03\xe0A\x03R\x05token\x12\x1f\n" +
+	"\bpassword\x18\x03 \x01(\tB\x03\xe0A\x04R\bpassword\x122\n" +
+	"\x12password_protected\x18\x04 \x01(\bB\x03\xe0A\x03R\x11passwordProtected\x12@\n" +
+	"\vexpire_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\n" +
+	"expireTime\x12@\n" +
+	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime:O\xeaAL\n" +
+	"\x16memos.api.v1/MemoShare\x12\x1bmemos/{memo}/shares/{share}*\n" +
+	"memoShares2\tmemoShare\"O\n" +
+	"\x15ListMemoSharesRequest\x126\n" +
+	"\x06parent\x18\x01 \x01(\tB\x1e\xe0A\x02\xfaA\x18\x12\x16memos.api.v1/MemoShareR\x06parent\"R\n" +
+	"\x16ListMemoSharesResponse\x128\n" +
+	"\vmemo_shares\x18\x01 \x03(\v2\x17.memos.api.v1.MemoShareR\n" +
+	"memoShares\"\x8d\x01\n" +
+	"\x16CreateMemoShareRequest\x126\n" +
+	"\x06parent\x18\x01 \x01(\tB\x1e\xe0A\x02\xfaA\x18\x12\x16memos.api.v1/MemoShareR\x06parent\x12;\n" +
+	"\n" +
+	"memo_share\x18\x02 \x01(\v2\x17.memos.api.v1.MemoShareB\x03\xe0A\x02R\tmemoShare\"L\n" +
+	"\x16DeleteMemoShareRequest\x122\n" +
+	"\x04name\x18\x01 \x01(\tB\x1e\xe0A\x02\xfaA\x18\n" +
+	"\x16memos.api.v1/MemoShareR\x04name\"R\n" +
+	"\x14GetSharedMemoRequest\x12\x19\n" +
+	"\x05token\x18\x01 \x01(\tB\x03\xe0A\x02R\x05token\x12\x1f\n" +
+	"\bpassword\x18\x02 \x01(\tB\x03\xe0A\x01R\bpassword\"\xe6\x01\n" +
 	"\x12ExportMemosRequest\x12\x1b\n" +
 	"\x06format\x18\x01 \x01(\tB\x03\xe0A\x01R\x06format\x12\x1b\n" +
 	"\x06filter\x18\x02 \x01(\tB\x03\xe0A\x01R\x06filter\x12.\n" +
@@ -2680,18 +4676,36 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\x14attachments_imported\x18\x04 \x01(\x05R\x13attachmentsImported\x12-\n" +
 	"\x12relations_imported\x18\x05 \x01(\x05R\x11relationsImported\x12\x1f\n" +
 	"\vduration_ms\x18\x06 \x01(\x03R\n" +
-	"durationMs*P\n" +
+	"durationMs\"\x80\x01\n" +
+	"\x0fSaveLinkRequest\x12\x15\n" +
+	"\x03url\x18\x01 \x01(\tB\x03\xe0A\x02R\x03url\x12\x17\n" +
+	"\x04tags\x18\x02 \x03(\tB\x03\xe0A\x01R\x04tags\x12=\n" +
+	"\n" +
+	"visibility\x18\x03 \x01(\x0e2\x18.memos.api.v1.VisibilityB\x03\xe0A\x01R\n" +
+	"visibility\"\x89\x01\n" +
+	"\x19ListOnThisDayMemosRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\x12\x17\n" +
+	"\x04date\x18\x02 \x01(\tB\x03\xe0A\x01R\x04date\x12 \n" +
+	"\ttime_zone\x18\x03 \x01(\tB\x03\xe0A\x01R\btimeZone\"F\n" +
+	"\x1aListOnThisDayMemosResponse\x12(\n" +
+	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos*[\n" +
 	"\n" +
 	"Visibility\x12\x1a\n" +
 	"\x16VISIBILITY_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aPRIVATE\x10\x01\x12\r\n" +
 	"\tPROTECTED\x10\x02\x12\n" +
 	"\n" +
-	"\x06PUBLIC\x10\x032\x81\x13\n" +
+	"\x06PUBLIC\x10\x03\x12\t\n" +
+	"\x05GROUP\x10\x042\xf1\x1e\n" +
 	"\vMemoService\x12e\n" +
 	"\n" +
 	"CreateMemo\x12\x1f.memos.api.v1.CreateMemoRequest\x1a\x12.memos.api.v1.Memo\"\"\xdaA\x04memo\x82\xd3\xe4\x93\x02\x15:\x04memo\"\r/api/v1/memos\x12\x91\x01\n" +
-	"\tListMemos\x12\x1e.memos.api.v1.ListMemosRequest\x1a\x1f.memos.api.v1.ListMemosResponse\"C\xdaA\x00\xdaA\x06parent\x82\xd3\xe4\x93\x021Z \x12\x1e/api/v1/{parent=users/*}/memos\x12\r/api/v1/memos\x12b\n" +
+	"\tListMemos\x12\x1e.memos.api.v1.ListMemosRequest\x1a\x1f.memos.api.v1.ListMemosResponse\"C\xdaA\x00\xdaA\x06parent\x82\xd3\xe4\x93\x021Z \x12\x1e/api/v1/{parent=users/*}/memos\x12\r/api/v1/memos\x12x\n" +
+	"\vSearchMemos\x12 .memos.api.v1.SearchMemosRequest\x1a!.memos.api.v1.SearchMemosResponse\"$\xdaA\x05query\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/memos:search\x12}\n" +
+	"\fSuggestMemos\x12!.memos.api.v1.SuggestMemosRequest\x1a\".memos.api.v1.SuggestMemosResponse\"&\xdaA\x06prefix\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/memos:suggest\x12\x98\x01\n" +
+	"\x13SemanticSearchMemos\x12(.memos.api.v1.SemanticSearchMemosRequest\x1a).memos.api.v1.SemanticSearchMemosResponse\",\xdaA\x05query\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/memos:semanticSearch\x12\x86\x01\n" +
+	"\x0fListMemoChanges\x12$.memos.api.v1.ListMemoChangesRequest\x1a%.memos.api.v1.ListMemoChangesResponse\"&\xdaA\x06cursor\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/memos:changes\x12b\n" +
 	"\aGetMemo\x12\x1c.memos.api.v1.GetMemoRequest\x1a\x12.memos.api.v1.Memo\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/{name=memos/*}\x12\x7f\n" +
 	"\n" +
 	"UpdateMemo\x12\x1f.memos.api.v1.UpdateMemoRequest\x1a\x12.memos.api.v1.Memo\"<\xdaA\x10memo,update_mask\x82\xd3\xe4\x93\x02#:\x04memo2\x1b/api/v1/{memo.name=memos/*}\x12l\n" +
@@ -2701,16 +4715,24 @@ const file_api_v1_memo_service_proto_rawDesc = "" +
 	"\rDeleteMemoTag\x12\".memos.api.v1.DeleteMemoTagRequest\x1a\x16.google.protobuf.Empty\"8\xdaA\n" +
 	"parent,tag\x82\xd3\xe4\x93\x02%*#/api/v1/{parent=memos/*}/tags/{tag}\x12\x8b\x01\n" +
 	"\x12SetMemoAttachments\x12'.memos.api.v1.SetMemoAttachmentsRequest\x1a\x16.google.protobuf.Empty\"4\xdaA\x04name\x82\xd3\xe4\x93\x02':\x01*2\"/api/v1/{name=memos/*}/attachments\x12\x9d\x01\n" +
-	"\x13ListMemoAttachments\x12(.memos.api.v1.ListMemoAttachmentsRequest\x1a).memos.api.v1.ListMemoAttachmentsResponse\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{name=memos/*}/attachments\x12\x85\x01\n" +
+	"\x13ListMemoAttachments\x12(.memos.api.v1.ListMemoAttachmentsRequest\x1a).memos.api.v1.ListMemoAttachmentsResponse\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{name=memos/*}/attachments\x12\x96\x01\n" +
+	"\x19GetMemoAttachmentsArchive\x12..memos.api.v1.GetMemoAttachmentsArchiveRequest\x1a\x14.google.api.HttpBody\"3\xdaA\x04name\x82\xd3\xe4\x93\x02&\x12$/file/{name=memos/*}/attachments.zip\x12\x85\x01\n" +
 	"\x10SetMemoRelations\x12%.memos.api.v1.SetMemoRelationsRequest\x1a\x16.google.protobuf.Empty\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%:\x01*2 /api/v1/{name=memos/*}/relations\x12\x95\x01\n" +
 	"\x11ListMemoRelations\x12&.memos.api.v1.ListMemoRelationsRequest\x1a'.memos.api.v1.ListMemoRelationsResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/relations\x12\x90\x01\n" +
 	"\x11CreateMemoComment\x12&.memos.api.v1.CreateMemoCommentRequest\x1a\x12.memos.api.v1.Memo\"?\xdaA\fname,comment\x82\xd3\xe4\x93\x02*:\acomment\"\x1f/api/v1/{name=memos/*}/comments\x12\x91\x01\n" +
 	"\x10ListMemoComments\x12%.memos.api.v1.ListMemoCommentsRequest\x1a&.memos.api.v1.ListMemoCommentsResponse\".\xdaA\x04name\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/{name=memos/*}/comments\x12\x95\x01\n" +
 	"\x11ListMemoReactions\x12&.memos.api.v1.ListMemoReactionsRequest\x1a'.memos.api.v1.ListMemoReactionsResponse\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=memos/*}/reactions\x12\x89\x01\n" +
 	"\x12UpsertMemoReaction\x12'.memos.api.v1.UpsertMemoReactionRequest\x1a\x16.memos.api.v1.Reaction\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/{name=memos/*}/reactions\x12\x80\x01\n" +
-	"\x12DeleteMemoReaction\x12'.memos.api.v1.DeleteMemoReactionRequest\x1a\x16.google.protobuf.Empty\")\xdaA\x04name\x82\xd3\xe4\x93\x02\x1c*\x1a/api/v1/{name=reactions/*}\x12s\n" +
+	"\x12DeleteMemoReaction\x12'.memos.api.v1.DeleteMemoReactionRequest\x1a\x16.google.protobuf.Empty\")\xdaA\x04name\x82\xd3\xe4\x93\x02\x1c*\x1a/api/v1/{name=reactions/*}\x12\x8d\x01\n" +
+	"\x0eListMemoShares\x12#.memos.api.v1.ListMemoSharesRequest\x1a$.memos.api.v1.ListMemoSharesResponse\"0\xdaA\x06parent\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/{parent=memos/*}/shares\x12\x99\x01\n" +
+	"\x0fCreateMemoShare\x12$.memos.api.v1.CreateMemoShareRequest\x1a\x17.memos.api.v1.MemoShare\"G\xdaA\x11parent,memo_share\x82\xd3\xe4\x93\x02-:\n" +
+	"memo_share\"\x1f/api/v1/{parent=memos/*}/shares\x12\x7f\n" +
+	"\x0fDeleteMemoShare\x12$.memos.api.v1.DeleteMemoShareRequest\x1a\x16.google.protobuf.Empty\".\xdaA\x04name\x82\xd3\xe4\x93\x02!*\x1f/api/v1/{name=memos/*/shares/*}\x12~\n" +
+	"\rGetSharedMemo\x12\".memos.api.v1.GetSharedMemoRequest\x1a\x12.memos.api.v1.Memo\"5\xdaA\x05token\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/memoShares/{token}:getMemo\x12s\n" +
 	"\vExportMemos\x12 .memos.api.v1.ExportMemosRequest\x1a!.memos.api.v1.ExportMemosResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/memos:export\x12s\n" +
-	"\vImportMemos\x12 .memos.api.v1.ImportMemosRequest\x1a!.memos.api.v1.ImportMemosResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/memos:importB\xa8\x01\n" +
+	"\vImportMemos\x12 .memos.api.v1.ImportMemosRequest\x1a!.memos.api.v1.ImportMemosResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/v1/memos:import\x12f\n" +
+	"\bSaveLink\x12\x1d.memos.api.v1.SaveLinkRequest\x1a\x12.memos.api.v1.Memo\"'\xdaA\x03url\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/memos:saveLink\x12\xa2\x01\n" +
+	"\x12ListOnThisDayMemos\x12'.memos.api.v1.ListOnThisDayMemosRequest\x1a(.memos.api.v1.ListOnThisDayMemosResponse\"9\xdaA\x06parent\x82\xd3\xe4\x93\x02*\x12(/api/v1/{parent=users/*}/memos:onThisDayB\xa8\x01\n" +
 	"\x10com.memos.api.v1B\x10MemoServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -2725,122 +4747,194 @@ func file_api_v1_memo_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_memo_service_proto_rawDescData
 }
 
-var file_api_v1_memo_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_api_v1_memo_service_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_api_v1_memo_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_api_v1_memo_service_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
 var file_api_v1_memo_service_proto_goTypes = []any{
-	(Visibility)(0),                     // 0: memos.api.v1.Visibility
-	(MemoRelation_Type)(0),              // 1: memos.api.v1.MemoRelation.Type
-	(*Reaction)(nil),                    // 2: memos.api.v1.Reaction
-	(*Memo)(nil),                        // 3: memos.api.v1.Memo
-	(*Location)(nil),                    // 4: memos.api.v1.Location
-	(*CreateMemoRequest)(nil),           // 5: memos.api.v1.CreateMemoRequest
-	(*ListMemosRequest)(nil),            // 6: memos.api.v1.ListMemosRequest
-	(*ListMemosResponse)(nil),           // 7: memos.api.v1.ListMemosResponse
-	(*GetMemoRequest)(nil),              // 8: memos.api.v1.GetMemoRequest
-	(*UpdateMemoRequest)(nil),           // 9: memos.api.v1.UpdateMemoRequest
-	(*DeleteMemoRequest)(nil),           // 10: memos.api.v1.DeleteMemoRequest
-	(*RenameMemoTagRequest)(nil),        // 11: memos.api.v1.RenameMemoTagRequest
-	(*DeleteMemoTagRequest)(nil),        // 12: memos.api.v1.DeleteMemoTagRequest
-	(*SetMemoAttachmentsRequest)(nil),   // 13: memos.api.v1.SetMemoAttachmentsRequest
-	(*ListMemoAttachmentsRequest)(nil),  // 14: memos.api.v1.ListMemoAttachmentsRequest
-	(*ListMemoAttachmentsResponse)(nil), // 15: memos.api.v1.ListMemoAttachmentsResponse
-	(*MemoRelation)(nil),                // 16: memos.api.v1.MemoRelation
-	(*SetMemoRelationsRequest)(nil),     // 17: memos.api.v1.SetMemoRelationsRequest
-	(*ListMemoRelationsRequest)(nil),    // 18: memos.api.v1.ListMemoRelationsRequest
-	(*ListMemoRelationsResponse)(nil),   // 19: memos.api.v1.ListMemoRelationsResponse
-	(*CreateMemoCommentRequest)(nil),    // 20: memos.api.v1.CreateMemoCommentRequest
-	(*ListMemoCommentsRequest)(nil),     // 21: memos.api.v1.ListMemoCommentsRequest
-	(*ListMemoCommentsResponse)(nil),    // 22: memos.api.v1.ListMemoCommentsResponse
-	(*ListMemoReactionsRequest)(nil),    // 23: memos.api.v1.ListMemoReactionsRequest
-	(*ListMemoReactionsResponse)(nil),   // 24: memos.api.v1.ListMemoReactionsResponse
-	(*UpsertMemoReactionRequest)(nil),   // 25: memos.api.v1.UpsertMemoReactionRequest
-	(*DeleteMemoReactionRequest)(nil),   // 26: memos.api.v1.DeleteMemoReactionRequest
-	(*ExportMemosRequest)(nil),          // 27: memos.api.v1.ExportMemosRequest
-	(*ExportMemosResponse)(nil),         // 28: memos.api.v1.ExportMemosResponse
-	(*ImportMemosRequest)(nil),          // 29: memos.api.v1.ImportMemosRequest
-	(*ImportMemosResponse)(nil),         // 30: memos.api.v1.ImportMemosResponse
-	(*ImportSummary)(nil),               // 31: memos.api.v1.ImportSummary
-	(*Memo_Property)(nil),               // 32: memos.api.v1.Memo.Property
-	(*MemoRelation_Memo)(nil),           // 33: memos.api.v1.MemoRelation.Memo
-	(*timestamppb.Timestamp)(nil),       // 34: google.protobuf.Timestamp
-	(State)(0),                          // 35: memos.api.v1.State
-	(*Node)(nil),                        // 36: memos.api.v1.Node
-	(*Attachment)(nil),                  // 37: memos.api.v1.Attachment
-	(*fieldmaskpb.FieldMask)(nil),       // 38: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),               // 39: google.protobuf.Empty
+	(Visibility)(0),                             // 0: memos.api.v1.Visibility
+	(SearchMemosRequest_Scope)(0),               // 1: memos.api.v1.SearchMemosRequest.Scope
+	(MemoChange_Type)(0),                        // 2: memos.api.v1.MemoChange.Type
+	(MemoRelation_Type)(0),                      // 3: memos.api.v1.MemoRelation.Type
+	(*Reaction)(nil),                            // 4: memos.api.v1.Reaction
+	(*Memo)(nil),                                // 5: memos.api.v1.Memo
+	(*LinkPreview)(nil),                         // 6: memos.api.v1.LinkPreview
+	(*Location)(nil),                            // 7: memos.api.v1.Location
+	(*CreateMemoRequest)(nil),                   // 8: memos.api.v1.CreateMemoRequest
+	(*ListMemosRequest)(nil),                    // 9: memos.api.v1.ListMemosRequest
+	(*ListMemosResponse)(nil),                   // 10: memos.api.v1.ListMemosResponse
+	(*SearchMemosRequest)(nil),                  // 11: memos.api.v1.SearchMemosRequest
+	(*TextHighlight)(nil),                       // 12: memos.api.v1.TextHighlight
+	(*SearchMemosResponse)(nil),                 // 13: memos.api.v1.SearchMemosResponse
+	(*SuggestMemosRequest)(nil),                 // 14: memos.api.v1.SuggestMemosRequest
+	(*SuggestMemosResponse)(nil),                // 15: memos.api.v1.SuggestMemosResponse
+	(*SemanticSearchMemosRequest)(nil),          // 16: memos.api.v1.SemanticSearchMemosRequest
+	(*SemanticSearchMemosResponse)(nil),         // 17: memos.api.v1.SemanticSearchMemosResponse
+	(*MemoChange)(nil),                          // 18: memos.api.v1.MemoChange
+	(*ListMemoChangesRequest)(nil),              // 19: memos.api.v1.ListMemoChangesRequest
+	(*ListMemoChangesResponse)(nil),             // 20: memos.api.v1.ListMemoChangesResponse
+	(*GetMemoRequest)(nil),                      // 21: memos.api.v1.GetMemoRequest
+	(*UpdateMemoRequest)(nil),                   // 22: memos.api.v1.UpdateMemoRequest
+	(*DeleteMemoRequest)(nil),                   // 23: memos.api.v1.DeleteMemoRequest
+	(*RenameMemoTagRequest)(nil),                // 24: memos.api.v1.RenameMemoTagRequest
+	(*DeleteMemoTagRequest)(nil),                // 25: memos.api.v1.DeleteMemoTagRequest
+	(*SetMemoAttachmentsRequest)(nil),           // 26: memos.api.v1.SetMemoAttachmentsRequest
+	(*ListMemoAttachmentsRequest)(nil),          // 27: memos.api.v1.ListMemoAttachmentsRequest
+	(*ListMemoAttachmentsResponse)(nil),         // 28: memos.api.v1.ListMemoAttachmentsResponse
+	(*GetMemoAttachmentsArchiveRequest)(nil),    // 29: memos.api.v1.GetMemoAttachmentsArchiveRequest
+	(*MemoRelation)(nil),                        // 30: memos.api.v1.MemoRelation
+	(*SetMemoRelationsRequest)(nil),             // 31: memos.api.v1.SetMemoRelationsRequest
+	(*ListMemoRelationsRequest)(nil),            // 32: memos.api.v1.ListMemoRelationsRequest
+	(*ListMemoRelationsResponse)(nil),           // 33: memos.api.v1.ListMemoRelationsResponse
+	(*CreateMemoCommentRequest)(nil),            // 34: memos.api.v1.CreateMemoCommentRequest
+	(*ListMemoCommentsRequest)(nil),             // 35: memos.api.v1.ListMemoCommentsRequest
+	(*ListMemoCommentsResponse)(nil),            // 36: memos.api.v1.ListMemoCommentsResponse
+	(*ListMemoReactionsRequest)(nil),            // 37: memos.api.v1.ListMemoReactionsRequest
+	(*ListMemoReactionsResponse)(nil),           // 38: memos.api.v1.ListMemoReactionsResponse
+	(*UpsertMemoReactionRequest)(nil),           // 39: memos.api.v1.UpsertMemoReactionRequest
+	(*DeleteMemoReactionRequest)(nil),           // 40: memos.api.v1.DeleteMemoReactionRequest
+	(*MemoShare)(nil),                           // 41: memos.api.v1.MemoShare
+	(*ListMemoSharesRequest)(nil),               // 42: memos.api.v1.ListMemoSharesRequest
+	(*ListMemoSharesResponse)(nil),              // 43: memos.api.v1.ListMemoSharesResponse
+	(*CreateMemoShareRequest)(nil),              // 44: memos.api.v1.CreateMemoShareRequest
+	(*DeleteMemoShareRequest)(nil),              // 45: memos.api.v1.DeleteMemoShareRequest
+	(*GetSharedMemoRequest)(nil),                // 46: memos.api.v1.GetSharedMemoRequest
+	(*ExportMemosRequest)(nil),                  // 47: memos.api.v1.ExportMemosRequest
+	(*ExportMemosResponse)(nil),                 // 48: memos.api.v1.ExportMemosResponse
+	(*ImportMemosRequest)(nil),                  // 49: memos.api.v1.ImportMemosRequest
+	(*ImportMemosResponse)(nil),                 // 50: memos.api.v1.ImportMemosResponse
+	(*ImportSummary)(nil),                       // 51: memos.api.v1.ImportSummary
+	(*SaveLinkRequest)(nil),                     // 52: memos.api.v1.SaveLinkRequest
+	(*ListOnThisDayMemosRequest)(nil),           // 53: memos.api.v1.ListOnThisDayMemosRequest
+	(*ListOnThisDayMemosResponse)(nil),          // 54: memos.api.v1.ListOnThisDayMemosResponse
+	(*Memo_Property)(nil),                       // 55: memos.api.v1.Memo.Property
+	(*SearchMemosResponse_MemoMatch)(nil),       // 56: memos.api.v1.SearchMemosResponse.MemoMatch
+	(*SearchMemosResponse_AttachmentMatch)(nil), // 57: memos.api.v1.SearchMemosResponse.AttachmentMatch
+	(*SearchMemosResponse_CreatorFacet)(nil),    // 58: memos.api.v1.SearchMemosResponse.CreatorFacet
+	(*SuggestMemosResponse_MemoSuggestion)(nil), // 59: memos.api.v1.SuggestMemosResponse.MemoSuggestion
+	(*SemanticSearchMemosResponse_Result)(nil),  // 60: memos.api.v1.SemanticSearchMemosResponse.Result
+	(*MemoRelation_Memo)(nil),                   // 61: memos.api.v1.MemoRelation.Memo
+	(*timestamppb.Timestamp)(nil),               // 62: google.protobuf.Timestamp
+	(State)(0),                                  // 63: memos.api.v1.State
+	(*Node)(nil),                                // 64: memos.api.v1.Node
+	(*Attachment)(nil),                          // 65: memos.api.v1.Attachment
+	(*fieldmaskpb.FieldMask)(nil),               // 66: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                       // 67: google.protobuf.Empty
+	(*httpbody.HttpBody)(nil),                   // 68: google.api.HttpBody
 }
 var file_api_v1_memo_service_proto_depIdxs = []int32{
-	34, // 0: memos.api.v1.Reaction.create_time:type_name -> google.protobuf.Timestamp
-	35, // 1: memos.api.v1.Memo.state:type_name -> memos.api.v1.State
-	34, // 2: memos.api.v1.Memo.create_time:type_name -> google.protobuf.Timestamp
-	34, // 3: memos.api.v1.Memo.update_time:type_name -> google.protobuf.Timestamp
-	34, // 4: memos.api.v1.Memo.display_time:type_name -> google.protobuf.Timestamp
-	36, // 5: memos.api.v1.Memo.nodes:type_name -> memos.api.v1.Node
+	62, // 0: memos.api.v1.Reaction.create_time:type_name -> google.protobuf.Timestamp
+	63, // 1: memos.api.v1.Memo.state:type_name -> memos.api.v1.State
+	62, // 2: memos.api.v1.Memo.create_time:type_name -> google.protobuf.Timestamp
+	62, // 3: memos.api.v1.Memo.update_time:type_name -> google.protobuf.Timestamp
+	62, // 4: memos.api.v1.Memo.display_time:type_name -> google.protobuf.Timestamp
+	64, // 5: memos.api.v1.Memo.nodes:type_name -> memos.api.v1.Node
 	0,  // 6: memos.api.v1.Memo.visibility:type_name -> memos.api.v1.Visibility
-	37, // 7: memos.api.v1.Memo.attachments:type_name -> memos.api.v1.Attachment
-	16, // 8: memos.api.v1.Memo.relations:type_name -> memos.api.v1.MemoRelation
-	2,  // 9: memos.api.v1.Memo.reactions:type_name -> memos.api.v1.Reaction
-	32, // 10: memos.api.v1.Memo.property:type_name -> memos.api.v1.Memo.Property
-	4,  // 11: memos.api.v1.Memo.location:type_name -> memos.api.v1.Location
-	3,  // 12: memos.api.v1.CreateMemoRequest.memo:type_name -> memos.api.v1.Memo
-	35, // 13: memos.api.v1.ListMemosRequest.state:type_name -> memos.api.v1.State
-	3,  // 14: memos.api.v1.ListMemosResponse.memos:type_name -> memos.api.v1.Memo
-	38, // 15: memos.api.v1.GetMemoRequest.read_mask:type_name -> google.protobuf.FieldMask
-	3,  // 16: memos.api.v1.UpdateMemoRequest.memo:type_name -> memos.api.v1.Memo
-	38, // 17: memos.api.v1.UpdateMemoRequest.update_mask:type_name -> google.protobuf.FieldMask
-	37, // 18: memos.api.v1.SetMemoAttachmentsRequest.attachments:type_name -> memos.api.v1.Attachment
-	37, // 19: memos.api.v1.ListMemoAttachmentsResponse.attachments:type_name -> memos.api.v1.Attachment
-	33, // 20: memos.api.v1.MemoRelation.memo:type_name -> memos.api.v1.MemoRelation.Memo
-	33, // 21: memos.api.v1.MemoRelation.related_memo:type_name -> memos.api.v1.MemoRelation.Memo
-	1,  // 22: memos.api.v1.MemoRelation.type:type_name -> memos.api.v1.MemoRelation.Type
-	16, // 23: memos.api.v1.SetMemoRelationsRequest.relations:type_name -> memos.api.v1.MemoRelation
-	16, // 24: memos.api.v1.ListMemoRelationsResponse.relations:type_name -> memos.api.v1.MemoRelation
-	3,  // 25: memos.api.v1.CreateMemoCommentRequest.comment:type_name -> memos.api.v1.Memo
-	3,  // 26: memos.api.v1.ListMemoCommentsResponse.memos:type_name -> memos.api.v1.Memo
-	2,  // 27: memos.api.v1.ListMemoReactionsResponse.reactions:type_name -> memos.api.v1.Reaction
-	2,  // 28: memos.api.v1.UpsertMemoReactionRequest.reaction:type_name -> memos.api.v1.Reaction
-	31, // 29: memos.api.v1.ImportMemosResponse.summary:type_name -> memos.api.v1.ImportSummary
-	5,  // 30: memos.api.v1.MemoService.CreateMemo:input_type -> memos.api.v1.CreateMemoRequest
-	6,  // 31: memos.api.v1.MemoService.ListMemos:input_type -> memos.api.v1.ListMemosRequest
-	8,  // 32: memos.api.v1.MemoService.GetMemo:input_type -> memos.api.v1.GetMemoRequest
-	9,  // 33: memos.api.v1.MemoService.UpdateMemo:input_type -> memos.api.v1.UpdateMemoRequest
-	10, // 34: memos.api.v1.MemoService.DeleteMemo:input_type -> memos.api.v1.DeleteMemoRequest
-	11, // 35: memos.api.v1.MemoService.RenameMemoTag:input_type -> memos.api.v1.RenameMemoTagRequest
-	12, // 36: memos.api.v1.MemoService.DeleteMemoTag:input_type -> memos.api.v1.DeleteMemoTagRequest
-	13, // 37: memos.api.v1.MemoService.SetMemoAttachments:input_type -> memos.api.v1.SetMemoAttachmentsRequest
-	14, // 38: memos.api.v1.MemoService.ListMemoAttachments:input_type -> memos.api.v1.ListMemoAttachmentsRequest
-	17, // 39: memos.api.v1.MemoService.SetMemoRelations:input_type -> memos.api.v1.SetMemoRelationsRequest
-	18, // 40: memos.api.v1.MemoService.ListMemoRelations:input_type -> memos.api.v1.ListMemoRelationsRequest
-	20, // 41: memos.api.v1.MemoService.CreateMemoComment:input_type -> memos.api.v1.CreateMemoCommentRequest
-	21, // 42: memos.api.v1.MemoService.ListMemoComments:input_type -> memos.api.v1.ListMemoCommentsRequest
-	23, // 43: memos.api.v1.MemoService.ListMemoReactions:input_type -> memos.api.v1.ListMemoReactionsRequest
-	25, // 44: memos.api.v1.MemoService.UpsertMemoReaction:input_type -> memos.api.v1.UpsertMemoReactionRequest
-	26, // 45: memos.api.v1.MemoService.DeleteMemoReaction:input_type -> memos.api.v1.DeleteMemoReactionRequest
-	27, // 46: memos.api.v1.MemoService.ExportMemos:input_type -> memos.api.v1.ExportMemosRequest
-	29, // 47: memos.api.v1.MemoService.ImportMemos:input_type -> memos.api.v1.ImportMemosRequest
-	3,  // 48: memos.api.v1.MemoService.CreateMemo:output_type -> memos.api.v1.Memo
-	7,  // 49: memos.api.v1.MemoService.ListMemos:output_type -> memos.api.v1.ListMemosResponse
-	3,  // 50: memos.api.v1.MemoService.GetMemo:output_type -> memos.api.v1.Memo
-	3,  // 51: memos.api.v1.MemoService.UpdateMemo:output_type -> memos.api.v1.Memo
-	39, // 52: memos.api.v1.MemoService.DeleteMemo:output_type -> google.protobuf.Empty
-	39, // 53: memos.api.v1.MemoService.RenameMemoTag:output_type -> google.protobuf.Empty
-	39, // 54: memos.api.v1.MemoService.DeleteMemoTag:output_type -> google.protobuf.Empty
-	39, // 55: memos.api.v1.MemoService.SetMemoAttachments:output_type -> google.protobuf.Empty
-	15, // 56: memos.api.v1.MemoService.ListMemoAttachments:output_type -> memos.api.v1.ListMemoAttachmentsResponse
-	39, // 57: memos.api.v1.MemoService.SetMemoRelations:output_type -> google.protobuf.Empty
-	19, // 58: memos.api.v1.MemoService.ListMemoRelations:output_type -> memos.api.v1.ListMemoRelationsResponse
-	3,  // 59: memos.api.v1.MemoService.CreateMemoComment:output_type -> memos.api.v1.Memo
-	22, // 60: memos.api.v1.MemoService.ListMemoComments:output_type -> memos.api.v1.ListMemoCommentsResponse
-	24, // 61: memos.api.v1.MemoService.ListMemoReactions:output_type -> memos.api.v1.ListMemoReactionsResponse
-	2,  // 62: memos.api.v1.MemoService.UpsertMemoReaction:output_type -> memos.api.v1.Reaction
-	39, // 63: memos.api.v1.MemoService.DeleteMemoReaction:output_type -> google.protobuf.Empty
-	28, // 64: memos.api.v1.MemoService.ExportMemos:output_type -> memos.api.v1.ExportMemosResponse
-	30, // 65: memos.api.v1.MemoService.ImportMemos:output_type -> memos.api.v1.ImportMemosResponse
-	48, // [48:66] is the sub-list for method output_type
-	30, // [30:48] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	65, // 7: memos.api.v1.Memo.attachments:type_name -> memos.api.v1.Attachment
+	30, // 8: memos.api.v1.Memo.relations:type_name -> memos.api.v1.MemoRelation
+	4,  // 9: memos.api.v1.Memo.reactions:type_name -> memos.api.v1.Reaction
+	55, // 10: memos.api.v1.Memo.property:type_name -> memos.api.v1.Memo.Property
+	7,  // 11: memos.api.v1.Memo.location:type_name -> memos.api.v1.Location
+	6,  // 12: memos.api.v1.Memo.link_previews:type_name -> memos.api.v1.LinkPreview
+	5,  // 13: memos.api.v1.CreateMemoRequest.memo:type_name -> memos.api.v1.Memo
+	63, // 14: memos.api.v1.ListMemosRequest.state:type_name -> memos.api.v1.State
+	5,  // 15: memos.api.v1.ListMemosResponse.memos:type_name -> memos.api.v1.Memo
+	1,  // 16: memos.api.v1.SearchMemosRequest.scope:type_name -> memos.api.v1.SearchMemosRequest.Scope
+	5,  // 17: memos.api.v1.SearchMemosResponse.memos:type_name -> memos.api.v1.Memo
+	57, // 18: memos.api.v1.SearchMemosResponse.attachment_matches:type_name -> memos.api.v1.SearchMemosResponse.AttachmentMatch
+	56, // 19: memos.api.v1.SearchMemosResponse.memo_matches:type_name -> memos.api.v1.SearchMemosResponse.MemoMatch
+	58, // 20: memos.api.v1.SearchMemosResponse.creator_facets:type_name -> memos.api.v1.SearchMemosResponse.CreatorFacet
+	59, // 21: memos.api.v1.SuggestMemosResponse.memos:type_name -> memos.api.v1.SuggestMemosResponse.MemoSuggestion
+	60, // 22: memos.api.v1.SemanticSearchMemosResponse.results:type_name -> memos.api.v1.SemanticSearchMemosResponse.Result
+	2,  // 23: memos.api.v1.MemoChange.type:type_name -> memos.api.v1.MemoChange.Type
+	62, // 24: memos.api.v1.MemoChange.change_time:type_name -> google.protobuf.Timestamp
+	5,  // 25: memos.api.v1.MemoChange.memo_data:type_name -> memos.api.v1.Memo
+	18, // 26: memos.api.v1.ListMemoChangesResponse.changes:type_name -> memos.api.v1.MemoChange
+	66, // 27: memos.api.v1.GetMemoRequest.read_mask:type_name -> google.protobuf.FieldMask
+	5,  // 28: memos.api.v1.UpdateMemoRequest.memo:type_name -> memos.api.v1.Memo
+	66, // 29: memos.api.v1.UpdateMemoRequest.update_mask:type_name -> google.protobuf.FieldMask
+	65, // 30: memos.api.v1.SetMemoAttachmentsRequest.attachments:type_name -> memos.api.v1.Attachment
+	65, // 31: memos.api.v1.ListMemoAttachmentsResponse.attachments:type_name -> memos.api.v1.Attachment
+	61, // 32: memos.api.v1.MemoRelation.memo:type_name -> memos.api.v1.MemoRelation.Memo
+	61, // 33: memos.api.v1.MemoRelation.related_memo:type_name -> memos.api.v1.MemoRelation.Memo
+	3,  // 34: memos.api.v1.MemoRelation.type:type_name -> memos.api.v1.MemoRelation.Type
+	30, // 35: memos.api.v1.SetMemoRelationsRequest.relations:type_name -> memos.api.v1.MemoRelation
+	30, // 36: memos.api.v1.ListMemoRelationsResponse.relations:type_name -> memos.api.v1.MemoRelation
+	5,  // 37: memos.api.v1.CreateMemoCommentRequest.comment:type_name -> memos.api.v1.Memo
+	5,  // 38: memos.api.v1.ListMemoCommentsResponse.memos:type_name -> memos.api.v1.Memo
+	4,  // 39: memos.api.v1.ListMemoReactionsResponse.reactions:type_name -> memos.api.v1.Reaction
+	4,  // 40: memos.api.v1.UpsertMemoReactionRequest.reaction:type_name -> memos.api.v1.Reaction
+	62, // 41: memos.api.v1.MemoShare.expire_time:type_name -> google.protobuf.Timestamp
+	62, // 42: memos.api.v1.MemoShare.create_time:type_name -> google.protobuf.Timestamp
+	41, // 43: memos.api.v1.ListMemoSharesResponse.memo_shares:type_name -> memos.api.v1.MemoShare
+	41, // 44: memos.api.v1.CreateMemoShareRequest.memo_share:type_name -> memos.api.v1.MemoShare
+	51, // 45: memos.api.v1.ImportMemosResponse.summary:type_name -> memos.api.v1.ImportSummary
+	0,  // 46: memos.api.v1.SaveLinkRequest.visibility:type_name -> memos.api.v1.Visibility
+	5,  // 47: memos.api.v1.ListOnThisDayMemosResponse.memos:type_name -> memos.api.v1.Memo
+	12, // 48: memos.api.v1.SearchMemosResponse.MemoMatch.highlights:type_name -> memos.api.v1.TextHighlight
+	12, // 49: memos.api.v1.SearchMemosResponse.AttachmentMatch.highlights:type_name -> memos.api.v1.TextHighlight
+	5,  // 50: memos.api.v1.SemanticSearchMemosResponse.Result.memo:type_name -> memos.api.v1.Memo
+	8,  // 51: memos.api.v1.MemoService.CreateMemo:input_type -> memos.api.v1.CreateMemoRequest
+	9,  // 52: memos.api.v1.MemoService.ListMemos:input_type -> memos.api.v1.ListMemosRequest
+	11, // 53: memos.api.v1.MemoService.SearchMemos:input_type -> memos.api.v1.SearchMemosRequest
+	14, // 54: memos.api.v1.MemoService.SuggestMemos:input_type -> memos.api.v1.SuggestMemosRequest
+	16, // 55: memos.api.v1.MemoService.SemanticSearchMemos:input_type -> memos.api.v1.SemanticSearchMemosRequest
+	19, // 56: memos.api.v1.MemoService.ListMemoChanges:input_type -> memos.api.v1.ListMemoChangesRequest
+	21, // 57: memos.api.v1.MemoService.GetMemo:input_type -> memos.api.v1.GetMemoRequest
+	22, // 58: memos.api.v1.MemoService.UpdateMemo:input_type -> memos.api.v1.UpdateMemoRequest
+	23, // 59: memos.api.v1.MemoService.DeleteMemo:input_type -> memos.api.v1.DeleteMemoRequest
+	24, // 60: memos.api.v1.MemoService.RenameMemoTag:input_type -> memos.api.v1.RenameMemoTagRequest
+	25, // 61: memos.api.v1.MemoService.DeleteMemoTag:input_type -> memos.api.v1.DeleteMemoTagRequest
+	26, // 62: memos.api.v1.MemoService.SetMemoAttachments:input_type -> memos.api.v1.SetMemoAttachmentsRequest
+	27, // 63: memos.api.v1.MemoService.ListMemoAttachments:input_type -> memos.api.v1.ListMemoAttachmentsRequest
+	29, // 64: memos.api.v1.MemoService.GetMemoAttachmentsArchive:input_type -> memos.api.v1.GetMemoAttachmentsArchiveRequest
+	31, // 65: memos.api.v1.MemoService.SetMemoRelations:input_type -> memos.api.v1.SetMemoRelationsRequest
+	32, // 66: memos.api.v1.MemoService.ListMemoRelations:input_type -> memos.api.v1.ListMemoRelationsRequest
+	34, // 67: memos.api.v1.MemoService.CreateMemoComment:input_type -> memos.api.v1.CreateMemoCommentRequest
+	35, // 68: memos.api.v1.MemoService.ListMemoComments:input_type -> memos.api.v1.ListMemoCommentsRequest
+	37, // 69: memos.api.v1.MemoService.ListMemoReactions:input_type -> memos.api.v1.ListMemoReactionsRequest
+	39, // 70: memos.api.v1.MemoService.UpsertMemoReaction:input_type -> memos.api.v1.UpsertMemoReactionRequest
+	40, // 71: memos.api.v1.MemoService.DeleteMemoReaction:input_type -> memos.api.v1.DeleteMemoReactionRequest
+	42, // 72: memos.api.v1.MemoService.ListMemoShares:input_type -> memos.api.v1.ListMemoSharesRequest
+	44, // 73: memos.api.v1.MemoService.CreateMemoShare:input_type -> memos.api.v1.CreateMemoShareRequest
+	45, // 74: memos.api.v1.MemoService.DeleteMemoShare:input_type -> memos.api.v1.DeleteMemoShareRequest
+	46, // 75: memos.api.v1.MemoService.GetSharedMemo:input_type -> memos.api.v1.GetSharedMemoRequest
+	47, // 76: memos.api.v1.MemoService.ExportMemos:input_type -> memos.api.v1.ExportMemosRequest
+	49, // 77: memos.api.v1.MemoService.ImportMemos:input_type -> memos.api.v1.ImportMemosRequest
+	52, // 78: memos.api.v1.MemoService.SaveLink:input_type -> memos.api.v1.SaveLinkRequest
+	53, // 79: memos.api.v1.MemoService.ListOnThisDayMemos:input_type -> memos.api.v1.ListOnThisDayMemosRequest
+	5,  // 80: memos.api.v1.MemoService.CreateMemo:output_type -> memos.api.v1.Memo
+	10, // 81: memos.api.v1.MemoService.ListMemos:output_type -> memos.api.v1.ListMemosResponse
+	13, // 82: memos.api.v1.MemoService.SearchMemos:output_type -> memos.api.v1.SearchMemosResponse
+	15, // 83: memos.api.v1.MemoService.SuggestMemos:output_type -> memos.api.v1.SuggestMemosResponse
+	17, // 84: memos.api.v1.MemoService.SemanticSearchMemos:output_type -> memos.api.v1.SemanticSearchMemosResponse
+	20, // 85: memos.api.v1.MemoService.ListMemoChanges:output_type -> memos.api.v1.ListMemoChangesResponse
+	5,  // 86: memos.api.v1.MemoService.GetMemo:output_type -> memos.api.v1.Memo
+	5,  // 87: memos.api.v1.MemoService.UpdateMemo:output_type -> memos.api.v1.Memo
+	67, // 88: memos.api.v1.MemoService.DeleteMemo:output_type -> google.protobuf.Empty
+	67, // 89: memos.api.v1.MemoService.RenameMemoTag:output_type -> google.protobuf.Empty
+	67, // 90: memos.api.v1.MemoService.DeleteMemoTag:output_type -> google.protobuf.Empty
+	67, // 91: memos.api.v1.MemoService.SetMemoAttachments:output_type -> google.protobuf.Empty
+	28, // 92: memos.api.v1.MemoService.ListMemoAttachments:output_type -> memos.api.v1.ListMemoAttachmentsResponse
+	68, // 93: memos.api.v1.MemoService.GetMemoAttachmentsArchive:output_type -> google.api.HttpBody
+	67, // 94: memos.api.v1.MemoService.SetMemoRelations:output_type -> google.protobuf.Empty
+	33, // 95: memos.api.v1.MemoService.ListMemoRelations:output_type -> memos.api.v1.ListMemoRelationsResponse
+	5,  // 96: memos.api.v1.MemoService.CreateMemoComment:output_type -> memos.api.v1.Memo
+	36, // 97: memos.api.v1.MemoService.ListMemoComments:output_type -> memos.api.v1.ListMemoCommentsResponse
+	38, // 98: memos.api.v1.MemoService.ListMemoReactions:output_type -> memos.api.v1.ListMemoReactionsResponse
+	4,  // 99: memos.api.v1.MemoService.UpsertMemoReaction:output_type -> memos.api.v1.Reaction
+	67, // 100: memos.api.v1.MemoService.DeleteMemoReaction:output_type -> google.protobuf.Empty
+	43, // 101: memos.api.v1.MemoService.ListMemoShares:output_type -> memos.api.v1.ListMemoSharesResponse
+	41, // 102: memos.api.v1.MemoService.CreateMemoShare:output_type -> memos.api.v1.MemoShare
+	67, // 103: memos.api.v1.MemoService.DeleteMemoShare:output_type -> google.protobuf.Empty
+	5,  // 104: memos.api.v1.MemoService.GetSharedMemo:output_type -> memos.api.v1.Memo
+	48, // 105: memos.api.v1.MemoService.ExportMemos:output_type -> memos.api.v1.ExportMemosResponse
+	50, // 106: memos.api.v1.MemoService.ImportMemos:output_type -> memos.api.v1.ImportMemosResponse
+	5,  // 107: memos.api.v1.MemoService.SaveLink:output_type -> memos.api.v1.Memo
+	54, // 108: memos.api.v1.MemoService.ListOnThisDayMemos:output_type -> memos.api.v1.ListOnThisDayMemosResponse
+	80, // [80:109] is the sub-list for method output_type
+	51, // [51:80] is the sub-list for method input_type
+	51, // [51:51] is the sub-list for extension type_name
+	51, // [51:51] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_memo_service_proto_init() }
@@ -2857,8 +4951,8 @@ func file_api_v1_memo_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_memo_service_proto_rawDesc), len(file_api_v1_memo_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   32,
+			NumEnums:      4,
+			NumMessages:   58,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -8,6 +8,7 @@ package apiv1
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -20,24 +21,35 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MemoService_CreateMemo_FullMethodName          = "/memos.api.v1.MemoService/CreateMemo"
-	MemoService_ListMemos_FullMethodName           = "/memos.api.v1.MemoService/ListMemos"
-	MemoService_GetMemo_FullMethodName             = "/memos.api.v1.MemoService/GetMemo"
-	MemoService_UpdateMemo_FullMethodName          = "/memos.api.v1.MemoService/UpdateMemo"
-	MemoService_DeleteMemo_FullMethodName          = "/memos.api.v1.MemoService/DeleteMemo"
-	MemoService_RenameMemoTag_FullMethodName       = "/memos.api.v1.MemoService/RenameMemoTag"
-	MemoService_DeleteMemoTag_FullMethodName       = "/memos.api.v1.MemoService/DeleteMemoTag"
-	MemoService_SetMemoAttachments_FullMethodName  = "/memos.api.v1.MemoService/SetMemoAttachments"
-	MemoService_ListMemoAttachments_FullMethodName = "/memos.api.v1.MemoService/ListMemoAttachments"
-	MemoService_SetMemoRelations_FullMethodName    = "/memos.api.v1.MemoService/SetMemoRelations"
-	MemoService_ListMemoRelations_FullMethodName   = "/memos.api.v1.MemoService/ListMemoRelations"
-	MemoService_CreateMemoComment_FullMethodName   = "/memos.api.v1.MemoService/CreateMemoComment"
-	MemoService_ListMemoComments_FullMethodName    = "/memos.api.v1.MemoService/ListMemoComments"
-	MemoService_ListMemoReactions_FullMethodName   = "/memos.api.v1.MemoService/ListMemoReactions"
-	MemoService_UpsertMemoReaction_FullMethodName  = "/memos.api.v1.MemoService/UpsertMemoReaction"
-	MemoService_DeleteMemoReaction_FullMethodName  = "/memos.api.v1.MemoService/DeleteMemoReaction"
-	MemoService_ExportMemos_FullMethodName         = "/memos.api.v1.MemoService/ExportMemos"
-	MemoService_ImportMemos_FullMethodName         = "/memos.api.v1.MemoService/ImportMemos"
+	MemoService_CreateMemo_FullMethodName                = "/memos.api.v1.MemoService/CreateMemo"
+	MemoService_ListMemos_FullMethodName                 = "/memos.api.v1.MemoService/ListMemos"
+	MemoService_SearchMemos_FullMethodName               = "/memos.api.v1.MemoService/SearchMemos"
+	MemoService_SuggestMemos_FullMethodName              = "/memos.api.v1.MemoService/SuggestMemos"
+	MemoService_SemanticSearchMemos_FullMethodName       = "/memos.api.v1.MemoService/SemanticSearchMemos"
+	MemoService_ListMemoChanges_FullMethodName           = "/memos.api.v1.MemoService/ListMemoChanges"
+	MemoService_GetMemo_FullMethodName                   = "/memos.api.v1.MemoService/GetMemo"
+	MemoService_UpdateMemo_FullMethodName                = "/memos.api.v1.MemoService/UpdateMemo"
+	MemoService_DeleteMemo_FullMethodName                = "/memos.api.v1.MemoService/DeleteMemo"
+	MemoService_RenameMemoTag_FullMethodName             = "/memos.api.v1.MemoService/RenameMemoTag"
+	MemoService_DeleteMemoTag_FullMethodName             = "/memos.api.v1.MemoService/DeleteMemoTag"
+	MemoService_SetMemoAttachments_FullMethodName        = "/memos.api.v1.MemoService/SetMemoAttachments"
+	MemoService_ListMemoAttachments_FullMethodName       = "/memos.api.v1.MemoService/ListMemoAttachments"
+	MemoService_GetMemoAttachmentsArchive_FullMethodName = "/memos.api.v1.MemoService/GetMemoAttachmentsArchive"
+	MemoService_SetMemoRelations_FullMethodName          = "/memos.api.v1.MemoService/SetMemoRelations"
+	MemoService_ListMemoRelations_FullMethodName         = "/memos.api.v1.MemoService/ListMemoRelations"
+	MemoService_CreateMemoComment_FullMethodName         = "/memos.api.v1.MemoService/CreateMemoComment"
+	MemoService_ListMemoComments_FullMethodName          = "/memos.api.v1.MemoService/ListMemoComments"
+	MemoService_ListMemoReactions_FullMethodName         = "/memos.api.v1.MemoService/ListMemoReactions"
+	MemoService_UpsertMemoReaction_FullMethodName        = "/memos.api.v1.MemoService/UpsertMemoReaction"
+	MemoService_DeleteMemoReaction_FullMethodName        = "/memos.api.v1.MemoService/DeleteMemoReaction"
+	MemoService_ListMemoShares_FullMethodName            = "/memos.api.v1.MemoService/ListMemoShares"
+	MemoService_CreateMemoShare_FullMethodName           = "/memos.api.v1.MemoService/CreateMemoShare"
+	MemoService_DeleteMemoShare_FullMethodName           = "/memos.api.v1.MemoService/DeleteMemoShare"
+	MemoService_GetSharedMemo_FullMethodName             = "/memos.api.v1.MemoService/GetSharedMemo"
+	MemoService_ExportMemos_FullMethodName               = "/memos.api.v1.MemoService/ExportMemos"
+	MemoService_ImportMemos_FullMethodName               = "/memos.api.v1.MemoService/ImportMemos"
+	MemoService_SaveLink_FullMethodName                  = "/memos.api.v1.MemoService/SaveLink"
+	MemoService_ListOnThisDayMemos_FullMethodName        = "/memos.api.v1.MemoService/ListOnThisDayMemos"
 )
 
 // MemoServiceClient is the client API for MemoService service.
@@ -48,6 +60,17 @@ type MemoServiceClient interface {
 	CreateMemo(ctx context.Context, in *CreateMemoRequest, opts ...grpc.CallOption) (*Memo, error)
 	// ListMemos lists memos with pagination and filter.
 	ListMemos(ctx context.Context, in *ListMemosRequest, opts ...grpc.CallOption) (*ListMemosResponse, error)
+	// SearchMemos searches memos by their content, ordered by relevance.
+	SearchMemos(ctx context.Context, in *SearchMemosRequest, opts ...grpc.CallOption) (*SearchMemosResponse, error)
+	// SuggestMemos completes a prefix to tags, memo titles and recent search queries,
+	// e.g. for editor autocompletion and the search box.
+	SuggestMemos(ctx context.Context, in *SuggestMemosRequest, opts ...grpc.CallOption) (*SuggestMemosResponse, error)
+	// SemanticSearchMemos returns the memos closest in meaning to the query.
+	// Requires the embedding workspace setting to be enabled.
+	SemanticSearchMemos(ctx context.Context, in *SemanticSearchMemosRequest, opts ...grpc.CallOption) (*SemanticSearchMemosResponse, error)
+	// ListMemoChanges lists the changes of the memos of the current user since a cursor, in the order they were made,
+	// e.g. for the polling triggers of automation tools and the sync clients. The deleted memos are listed too.
+	ListMemoChanges(ctx context.Context, in *ListMemoChangesRequest, opts ...grpc.CallOption) (*ListMemoChangesResponse, error)
 	// GetMemo gets a memo.
 	GetMemo(ctx context.Context, in *GetMemoRequest, opts ...grpc.CallOption) (*Memo, error)
 	// UpdateMemo updates a memo.
@@ -62,6 +85,8 @@ type MemoServiceClient interface {
 	SetMemoAttachments(ctx context.Context, in *SetMemoAttachmentsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ListMemoAttachments lists attachments for a memo.
 	ListMemoAttachments(ctx context.Context, in *ListMemoAttachmentsRequest, opts ...grpc.CallOption) (*ListMemoAttachmentsResponse, error)
+	// GetMemoAttachmentsArchive downloads the attachments of a memo as a zip archive.
+	GetMemoAttachmentsArchive(ctx context.Context, in *GetMemoAttachmentsArchiveRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
 	// SetMemoRelations sets relations for a memo.
 	SetMemoRelations(ctx context.Context, in *SetMemoRelationsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ListMemoRelations lists relations for a memo.
@@ -76,10 +101,23 @@ type MemoServiceClient interface {
 	UpsertMemoReaction(ctx context.Context, in *UpsertMemoReactionRequest, opts ...grpc.CallOption) (*Reaction, error)
 	// DeleteMemoReaction deletes a reaction for a memo.
 	DeleteMemoReaction(ctx context.Context, in *DeleteMemoReactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListMemoShares lists the share links of a memo.
+	ListMemoShares(ctx context.Context, in *ListMemoSharesRequest, opts ...grpc.CallOption) (*ListMemoSharesResponse, error)
+	// CreateMemoShare creates a secret share link granting read-only access to a private memo.
+	CreateMemoShare(ctx context.Context, in *CreateMemoShareRequest, opts ...grpc.CallOption) (*MemoShare, error)
+	// DeleteMemoShare revokes a share link of a memo.
+	DeleteMemoShare(ctx context.Context, in *DeleteMemoShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetSharedMemo returns the memo shared via a share link.
+	// The password is sent in the body, so that it does not appear in the URLs.
+	GetSharedMemo(ctx context.Context, in *GetSharedMemoRequest, opts ...grpc.CallOption) (*Memo, error)
 	// ExportMemos exports memos for the current user
 	ExportMemos(ctx context.Context, in *ExportMemosRequest, opts ...grpc.CallOption) (*ExportMemosResponse, error)
 	// ImportMemos imports memos from provided data
 	ImportMemos(ctx context.Context, in *ImportMemosRequest, opts ...grpc.CallOption) (*ImportMemosResponse, error)
+	// SaveLink saves the article of a web page as a memo of the current user, to read it later.
+	SaveLink(ctx context.Context, in *SaveLinkRequest, opts ...grpc.CallOption) (*Memo, error)
+	// ListOnThisDayMemos lists the memos of a user created on the same day in past years, the most recent first.
+	ListOnThisDayMemos(ctx context.Context, in *ListOnThisDayMemosRequest, opts ...grpc.CallOption) (*ListOnThisDayMemosResponse, error)
 }
 
 type memoServiceClient struct {
@@ -110,6 +148,46 @@ func (c *memoServiceClient) ListMemos(ctx context.Context, in *ListMemosRequest,
 	return out, nil
 }
 
+func (c *memoServiceClient) SearchMemos(ctx context.Context, in *SearchMemosRequest, opts ...grpc.CallOption) (*SearchMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_SearchMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) SuggestMemos(ctx context.Context, in *SuggestMemosRequest, opts ...grpc.CallOption) (*SuggestMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_SuggestMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) SemanticSearchMemos(ctx context.Context, in *SemanticSearchMemosRequest, opts ...grpc.CallOption) (*SemanticSearchMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SemanticSearchMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_SemanticSearchMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListMemoChanges(ctx context.Context, in *ListMemoChangesRequest, opts ...grpc.CallOption) (*ListMemoChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoChangesResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memoServiceClient) GetMemo(ctx context.Context, in *GetMemoRequest, opts ...grpc.CallOption) (*Memo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Memo)
@@ -180,6 +258,16 @@ func (c *memoServiceClient) ListMemoAttachments(ctx context.Context, in *ListMem
 	return out, nil
 }
 
+func (c *memoServiceClient) GetMemoAttachmentsArchive(ctx context.Context, in *GetMemoAttachmentsArchiveRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, MemoService_GetMemoAttachmentsArchive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memoServiceClient) SetMemoRelations(ctx context.Context, in *SetMemoRelationsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -250,6 +338,46 @@ func (c *memoServiceClient) DeleteMemoReaction(ctx context.Context, in *DeleteMe
 	return out, nil
 }
 
+func (c *memoServiceClient) ListMemoShares(ctx context.Context, in *ListMemoSharesRequest, opts ...grpc.CallOption) (*ListMemoSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoSharesResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) CreateMemoShare(ctx context.Context, in *CreateMemoShareRequest, opts ...grpc.CallOption) (*MemoShare, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoShare)
+	err := c.cc.Invoke(ctx, MemoService_CreateMemoShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) DeleteMemoShare(ctx context.Context, in *DeleteMemoShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemoService_DeleteMemoShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetSharedMemo(ctx context.Context, in *GetSharedMemoRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_GetSharedMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memoServiceClient) ExportMemos(ctx context.Context, in *ExportMemosRequest, opts ...grpc.CallOption) (*ExportMemosResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExportMemosResponse)
@@ -270,6 +398,26 @@ func (c *memoServiceClient) ImportMemos(ctx context.Context, in *ImportMemosRequ
 	return out, nil
 }
 
+func (c *memoServiceClient) SaveLink(ctx context.Context, in *SaveLinkRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_SaveLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListOnThisDayMemos(ctx context.Context, in *ListOnThisDayMemosRequest, opts ...grpc.CallOption) (*ListOnThisDayMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOnThisDayMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListOnThisDayMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MemoServiceServer is the server API for MemoService service.
 // All implementations must embed UnimplementedMemoServiceServer
 // for forward compatibility.
@@ -278,6 +426,17 @@ type MemoServiceServer interface {
 	CreateMemo(context.Context, *CreateMemoRequest) (*Memo, error)
 	// ListMemos lists memos with pagination and filter.
 	ListMemos(context.Context, *ListMemosRequest) (*ListMemosResponse, error)
+	// SearchMemos searches memos by their content, ordered by relevance.
+	SearchMemos(context.Context, *SearchMemosRequest) (*SearchMemosResponse, error)
+	// SuggestMemos completes a prefix to tags, memo titles and recent search queries,
+	// e.g. for editor autocompletion and the search box.
+	SuggestMemos(context.Context, *SuggestMemosRequest) (*SuggestMemosResponse, error)
+	// SemanticSearchMemos returns the memos closest in meaning to the query.
+	// Requires the embedding workspace setting to be enabled.
+	SemanticSearchMemos(context.Context, *SemanticSearchMemosRequest) (*SemanticSearchMemosResponse, error)
+	// ListMemoChanges lists the changes of the memos of the current user since a cursor, in the order they were made,
+	// e.g. for the polling triggers of automation tools and the sync clients. The deleted memos are listed too.
+	ListMemoChanges(context.Context, *ListMemoChangesRequest) (*ListMemoChangesResponse, error)
 	// GetMemo gets a memo.
 	GetMemo(context.Context, *GetMemoRequest) (*Memo, error)
 	// UpdateMemo updates a memo.
@@ -292,6 +451,8 @@ type MemoServiceServer interface {
 	SetMemoAttachments(context.Context, *SetMemoAttachmentsRequest) (*emptypb.Empty, error)
 	// ListMemoAttachments lists attachments for a memo.
 	ListMemoAttachments(context.Context, *ListMemoAttachmentsRequest) (*ListMemoAttachmentsResponse, error)
+	// GetMemoAttachmentsArchive downloads the attachments of a memo as a zip archive.
+	GetMemoAttachmentsArchive(context.Context, *GetMemoAttachmentsArchiveRequest) (*httpbody.HttpBody, error)
 	// SetMemoRelations sets relations for a memo.
 	SetMemoRelations(context.Context, *SetMemoRelationsRequest) (*emptypb.Empty, error)
 	// ListMemoRelations lists relations for a memo.
@@ -306,10 +467,23 @@ type MemoServiceServer interface {
 	UpsertMemoReaction(context.Context, *UpsertMemoReactionRequest) (*Reaction, error)
 	// DeleteMemoReaction deletes a reaction for a memo.
 	DeleteMemoReaction(context.Context, *DeleteMemoReactionRequest) (*emptypb.Empty, error)
+	// ListMemoShares lists the share links of a memo.
+	ListMemoShares(context.Context, *ListMemoSharesRequest) (*ListMemoSharesResponse, error)
+	// CreateMemoShare creates a secret share link granting read-only access to a private memo.
+	CreateMemoShare(context.Context, *CreateMemoShareRequest) (*MemoShare, error)
+	// DeleteMemoShare revokes a share link of a memo.
+	DeleteMemoShare(context.Context, *DeleteMemoShareRequest) (*emptypb.Empty, error)
+	// GetSharedMemo returns the memo shared via a share link.
+	// The password is sent in the body, so that it does not appear in the URLs.
+	GetSharedMemo(context.Context, *GetSharedMemoRequest) (*Memo, error)
 	// ExportMemos exports memos for the current user
 	ExportMemos(context.Context, *ExportMemosRequest) (*ExportMemosResponse, error)
 	// ImportMemos imports memos from provided data
 	ImportMemos(context.Context, *ImportMemosRequest) (*ImportMemosResponse, error)
+	// SaveLink saves the article of a web page as a memo of the current user, to read it later.
+	SaveLink(context.Context, *SaveLinkRequest) (*Memo, error)
+	// ListOnThisDayMemos lists the memos of a user created on the same day in past years, the most recent first.
+	ListOnThisDayMemos(context.Context, *ListOnThisDayMemosRequest) (*ListOnThisDayMemosResponse, error)
 	mustEmbedUnimplementedMemoServiceServer()
 }
 
@@ -326,6 +500,18 @@ func (UnimplementedMemoServiceServer) CreateMemo(context.Context, *CreateMemoReq
 func (UnimplementedMemoServiceServer) ListMemos(context.Context, *ListMemosRequest) (*ListMemosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMemos not implemented")
 }
+func (UnimplementedMemoServiceServer) SearchMemos(context.Context, *SearchMemosRequest) (*SearchMemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMemos not implemented")
+}
+func (UnimplementedMemoServiceServer) SuggestMemos(context.Context, *SuggestMemosRequest) (*SuggestMemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestMemos not implemented")
+}
+func (UnimplementedMemoServiceServer) SemanticSearchMemos(context.Context, *SemanticSearchMemosRequest) (*SemanticSearchMemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SemanticSearchMemos not implemented")
+}
+func (UnimplementedMemoServiceServer) ListMemoChanges(context.Context, *ListMemoChangesRequest) (*ListMemoChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoChanges not implemented")
+}
 func (UnimplementedMemoServiceServer) GetMemo(context.Context, *GetMemoRequest) (*Memo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMemo not implemented")
 }
@@ -347,6 +533,9 @@ func (UnimplementedMemoServiceServer) SetMemoAttachments(context.Context, *SetMe
 func (UnimplementedMemoServiceServer) ListMemoAttachments(context.Context, *ListMemoAttachmentsRequest) (*ListMemoAttachmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMemoAttachments not implemented")
 }
+func (UnimplementedMemoServiceServer) GetMemoAttachmentsArchive(context.Context, *GetMemoAttachmentsArchiveRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemoAttachmentsArchive not implemented")
+}
 func (UnimplementedMemoServiceServer) SetMemoRelations(context.Context, *SetMemoRelationsRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetMemoRelations not implemented")
 }
@@ -368,12 +557,30 @@ func (UnimplementedMemoServiceServer) UpsertMemoReaction(context.Context, *Upser
 func (UnimplementedMemoServiceServer) DeleteMemoReaction(context.Context, *DeleteMemoReactionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMemoReaction not implemented")
 }
+func (UnimplementedMemoServiceServer) ListMemoShares(context.Context, *ListMemoSharesRequest) (*ListMemoSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoShares not implemented")
+}
+func (UnimplementedMemoServiceServer) CreateMemoShare(context.Context, *CreateMemoShareRequest) (*MemoShare, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMemoShare not implemented")
+}
+func (UnimplementedMemoServiceServer) DeleteMemoShare(context.Context, *DeleteMemoShareRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMemoShare not implemented")
+}
+func (UnimplementedMemoServiceServer) GetSharedMemo(context.Context, *GetSharedMemoRequest) (*Memo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedMemo not implemented")
+}
 func (UnimplementedMemoServiceServer) ExportMemos(context.Context, *ExportMemosRequest) (*ExportMemosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExportMemos not implemented")
 }
 func (UnimplementedMemoServiceServer) ImportMemos(context.Context, *ImportMemosRequest) (*ImportMemosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportMemos not implemented")
 }
+func (UnimplementedMemoServiceServer) SaveLink(context.Context, *SaveLinkRequest) (*Memo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveLink not implemented")
+}
+func (UnimplementedMemoServiceServer) ListOnThisDayMemos(context.Context, *ListOnThisDayMemosRequest) (*ListOnThisDayMemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOnThisDayMemos not implemented")
+}
 func (UnimplementedMemoServiceServer) mustEmbedUnimplementedMemoServiceServer() {}
 func (UnimplementedMemoServiceServer) testEmbeddedByValue()                     {}
 
@@ -431,6 +638,78 @@ func _MemoService_ListMemos_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_SearchMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).SearchMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_SearchMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).SearchMemos(ctx, req.(*SearchMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_SuggestMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).SuggestMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_SuggestMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).SuggestMemos(ctx, req.(*SuggestMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_SemanticSearchMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SemanticSearchMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).SemanticSearchMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_SemanticSearchMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).SemanticSearchMemos(ctx, req.(*SemanticSearchMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListMemoChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoChanges(ctx, req.(*ListMemoChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MemoService_GetMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMemoRequest)
 	if err := dec(in); err != nil {
@@ -557,6 +836,24 @@ func _MemoService_ListMemoAttachments_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_GetMemoAttachmentsArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoAttachmentsArchiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetMemoAttachmentsArchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetMemoAttachmentsArchive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetMemoAttachmentsArchive(ctx, req.(*GetMemoAttachmentsArchiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MemoService_SetMemoRelations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetMemoRelationsRequest)
 	if err := dec(in); err != nil {
@@ -683,6 +980,78 @@ func _MemoService_DeleteMemoReaction_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_ListMemoShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoShares(ctx, req.(*ListMemoSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_CreateMemoShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMemoShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).CreateMemoShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_CreateMemoShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).CreateMemoShare(ctx, req.(*CreateMemoShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_DeleteMemoShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMemoShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).DeleteMemoShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_DeleteMemoShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).DeleteMemoShare(ctx, req.(*DeleteMemoShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_GetSharedMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetSharedMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetSharedMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetSharedMemo(ctx, req.(*GetSharedMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MemoService_ExportMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExportMemosRequest)
 	if err := dec(in); err != nil {
@@ -719,6 +1088,42 @@ func _MemoService_ImportMemos_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_SaveLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).SaveLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_SaveLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).SaveLink(ctx, req.(*SaveLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListOnThisDayMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOnThisDayMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListOnThisDayMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListOnThisDayMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListOnThisDayMemos(ctx, req.(*ListOnThisDayMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MemoService_ServiceDesc is the grpc.ServiceDesc for MemoService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -734,6 +1139,22 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMemos",
 			Handler:    _MemoService_ListMemos_Handler,
 		},
+		{
+			MethodName: "SearchMemos",
+			Handler:    _MemoService_SearchMemos_Handler,
+		},
+		{
+			MethodName: "SuggestMemos",
+			Handler:    _MemoService_SuggestMemos_Handler,
+		},
+		{
+			MethodName: "SemanticSearchMemos",
+			Handler:    _MemoService_SemanticSearchMemos_Handler,
+		},
+		{
+			MethodName: "ListMemoChanges",
+			Handler:    _MemoService_ListMemoChanges_Handler,
+		},
 		{
 			MethodName: "GetMemo",
 			Handler:    _MemoService_GetMemo_Handler,
@@ -762,6 +1183,10 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMemoAttachments",
 			Handler:    _MemoService_ListMemoAttachments_Handler,
 		},
+		{
+			MethodName: "GetMemoAttachmentsArchive",
+			Handler:    _MemoService_GetMemoAttachmentsArchive_Handler,
+		},
 		{
 			MethodName: "SetMemoRelations",
 			Handler:    _MemoService_SetMemoRelations_Handler,
@@ -790,6 +1215,22 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMemoReaction",
 			Handler:    _MemoService_DeleteMemoReaction_Handler,
 		},
+		{
+			MethodName: "ListMemoShares",
+			Handler:    _MemoService_ListMemoShares_Handler,
+		},
+		{
+			MethodName: "CreateMemoShare",
+			Handler:    _MemoService_CreateMemoShare_Handler,
+		},
+		{
+			MethodName: "DeleteMemoShare",
+			Handler:    _MemoService_DeleteMemoShare_Handler,
+		},
+		{
+			MethodName: "GetSharedMemo",
+			Handler:    _MemoService_GetSharedMemo_Handler,
+		},
 		{
 			MethodName: "ExportMemos",
 			Handler:    _MemoService_ExportMemos_Handler,
@@ -798,6 +1239,14 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ImportMemos",
 			Handler:    _MemoService_ImportMemos_Handler,
 		},
+		{
+			MethodName: "SaveLink",
+			Handler:    _MemoService_SaveLink_Handler,
+		},
+		{
+			MethodName: "ListOnThisDayMemos",
+			Handler:    _MemoService_ListOnThisDayMemos_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/memo_service.proto",
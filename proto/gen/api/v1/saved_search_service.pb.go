@@ -0,0 +1,664 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: api/v1/saved_search_service.proto
+
+package apiv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SavedSearch struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the saved search.
+	// Format: users/{user}/savedSearches/{saved_search}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The title of the saved search.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The full-text search query, refer to `SearchMemosRequest.query`.
+	// If empty, the saved search lists the memos matching the filter.
+	Query string `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	// The filter expression, refer to `Shortcut.filter`.
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The order to sort results by, refer to `ListMemosRequest.order_by`.
+	// If empty, search results are ordered by relevance and listed memos by display time.
+	OrderBy string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Whether the query tolerates typos, refer to `SearchMemosRequest.fuzzy`.
+	Fuzzy bool `protobuf:"varint,6,opt,name=fuzzy,proto3" json:"fuzzy,omitempty"`
+	// Whether the saved search is pinned.
+	Pinned        bool `protobuf:"varint,7,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedSearch) Reset() {
+	*x = SavedSearch{}
+	mi := &file_api_v1_saved_search_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearch) ProtoMessage() {}
+
+func (x *SavedSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_saved_search_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearch.ProtoReflect.Descriptor instead.
+func (*SavedSearch) Descriptor() ([]byte, []int) {
+	return file_api_v1_saved_search_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SavedSearch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetFuzzy() bool {
+	if x != nil {
+		return x.Fuzzy
+	}
+	return false
+}
+
+func (x *SavedSearch) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+type ListSavedSearchesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent resource where saved searches are listed.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedSearchesRequest) Reset() {
+	*x = ListSavedSearchesRequest{}
+	mi := &file_api_v1_saved_search_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedSearchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchesRequest) ProtoMessage() {}
+
+func (x *ListSavedSearchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_saved_search_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchesRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_saved_search_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListSavedSearchesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListSavedSearchesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of saved searches.
+	SavedSearches []*SavedSearch `protobuf:"bytes,1,rep,name=saved_searches,json=savedSearches,proto3" json:"saved_searches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedSearchesResponse) Reset() {
+	*x = ListSavedSearchesResponse{}
+	mi := &file_api_v1_saved_search_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedSearchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchesResponse) ProtoMessage() {}
+
+func (x *ListSavedSearchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_saved_search_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchesResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_saved_search_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListSavedSearchesResponse) GetSavedSearches() []*SavedSearch {
+	if x != nil {
+		return x.SavedSearches
+	}
+	return nil
+}
+
+type GetSavedSearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the saved search to retrieve.
+	// Format: users/{user}/savedSearches/{saved_search}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSavedSearchRequest) Reset() {
+	*x = GetSavedSearchRequest{}
+	mi := &file_api_v1_saved_search_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSavedSearchRequest) ProtoMessage() {}
+
+func (x *GetSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_saved_search_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*GetSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_saved_search_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetSavedSearchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateSavedSearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent resource where this saved search will be created.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The saved search to create.
+	SavedSearch   *SavedSearch `protobuf:"bytes,2,opt,name=saved_search,json=savedSearch,proto3" json:"saved_search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSavedSearchRequest) Reset() {
+	*x = CreateSavedSearchRequest{}
+	mi := &file_api_v1_saved_search_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSavedSearchRequest) ProtoMessage() {}
+
+func (x *CreateSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_saved_search_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*CreateSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_saved_search_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateSavedSearchRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateSavedSearchRequest) GetSavedSearch() *SavedSearch {
+	if x != nil {
+		return x.SavedSearch
+	}
+	return nil
+}
+
+type UpdateSavedSearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The saved search resource which replaces the resource on the server.
+	SavedSearch *SavedSearch `protobuf:"bytes,1,opt,name=saved_search,json=savedSearch,proto3" json:"saved_search,omitempty"`
+	// Required. The list of fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSavedSearchRequest) Reset() {
+	*x = UpdateSavedSearchRequest{}
+	mi := &file_api_v1_saved_search_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSavedSearchRequest) ProtoMessage() {}
+
+func (x *UpdateSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_saved_search_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_saved_search_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateSavedSearchRequest) GetSavedSearch() *SavedSearch {
+	if x != nil {
+		return x.SavedSearch
+	}
+	return nil
+}
+
+func (x *UpdateSavedSearchRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteSavedSearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the saved search to delete.
+	// Format: users/{user}/savedSearches/{saved_search}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedSearchRequest) Reset() {
+	*x = DeleteSavedSearchRequest{}
+	mi := &file_api_v1_saved_search_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedSearchRequest) ProtoMessage() {}
+
+func (x *DeleteSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_saved_search_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_saved_search_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteSavedSearchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ExecuteSavedSearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the saved search to run.
+	// Format: users/{user}/savedSearches/{saved_search}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The maximum number of memos to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous `ExecuteSavedSearch` call.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteSavedSearchRequest) Reset() {
+	*x = ExecuteSavedSearchRequest{}
+	mi := &file_api_v1_saved_search_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteSavedSearchRequest) ProtoMessage() {}
+
+func (x *ExecuteSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_saved_search_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_saved_search_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExecuteSavedSearchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExecuteSavedSearchRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ExecuteSavedSearchRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ExecuteSavedSearchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The matching memos.
+	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// A token that can be sent as `page_token` to retrieve the next page.
+	// If this field is omitted, there are no subsequent pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteSavedSearchResponse) Reset() {
+	*x = ExecuteSavedSearchResponse{}
+	mi := &file_api_v1_saved_search_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteSavedSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteSavedSearchResponse) ProtoMessage() {}
+
+func (x *ExecuteSavedSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_saved_search_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteSavedSearchResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteSavedSearchResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_saved_search_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ExecuteSavedSearchResponse) GetMemos() []*Memo {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+func (x *ExecuteSavedSearchResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_api_v1_saved_search_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_saved_search_service_proto_rawDesc = "" +
+	"\n" +
+	"!api/v1/saved_search_service.proto\x12\fmemos.api.v1\x1a\x19api/v1/memo_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"\xb7\x02\n" +
+	"\vSavedSearch\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tB\x03\xe0A\x02R\x05title\x12\x19\n" +
+	"\x05query\x18\x03 \x01(\tB\x03\xe0A\x01R\x05query\x12\x1b\n" +
+	"\x06filter\x18\x04 \x01(\tB\x03\xe0A\x01R\x06filter\x12\x1e\n" +
+	"\border_by\x18\x05 \x01(\tB\x03\xe0A\x01R\aorderBy\x12\x19\n" +
+	"\x05fuzzy\x18\x06 \x01(\bB\x03\xe0A\x01R\x05fuzzy\x12\x1b\n" +
+	"\x06pinned\x18\a \x01(\bB\x03\xe0A\x01R\x06pinned:d\xeaAa\n" +
+	"\x18memos.api.v1/SavedSearch\x12)users/{user}/savedSearches/{saved_search}*\rsavedSearches2\vsavedSearch\"T\n" +
+	"\x18ListSavedSearchesRequest\x128\n" +
+	"\x06parent\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\x12\x18memos.api.v1/SavedSearchR\x06parent\"]\n" +
+	"\x19ListSavedSearchesResponse\x12@\n" +
+	"\x0esaved_searches\x18\x01 \x03(\v2\x19.memos.api.v1.SavedSearchR\rsavedSearches\"M\n" +
+	"\x15GetSavedSearchRequest\x124\n" +
+	"\x04name\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\n" +
+	"\x18memos.api.v1/SavedSearchR\x04name\"\x97\x01\n" +
+	"\x18CreateSavedSearchRequest\x128\n" +
+	"\x06parent\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\x12\x18memos.api.v1/SavedSearchR\x06parent\x12A\n" +
+	"\fsaved_search\x18\x02 \x01(\v2\x19.memos.api.v1.SavedSearchB\x03\xe0A\x02R\vsavedSearch\"\x9f\x01\n" +
+	"\x18UpdateSavedSearchRequest\x12A\n" +
+	"\fsaved_search\x18\x01 \x01(\v2\x19.memos.api.v1.SavedSearchB\x03\xe0A\x02R\vsavedSearch\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
+	"updateMask\"P\n" +
+	"\x18DeleteSavedSearchRequest\x124\n" +
+	"\x04name\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\n" +
+	"\x18memos.api.v1/SavedSearchR\x04name\"\x97\x01\n" +
+	"\x19ExecuteSavedSearchRequest\x124\n" +
+	"\x04name\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\n" +
+	"\x18memos.api.v1/SavedSearchR\x04name\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"n\n" +
+	"\x1aExecuteSavedSearchResponse\x12(\n" +
+	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\xe0\a\n" +
+	"\x12SavedSearchService\x12\x9d\x01\n" +
+	"\x11ListSavedSearches\x12&.memos.api.v1.ListSavedSearchesRequest\x1a'.memos.api.v1.ListSavedSearchesResponse\"7\xdaA\x06parent\x82\xd3\xe4\x93\x02(\x12&/api/v1/{parent=users/*}/savedSearches\x12\x87\x01\n" +
+	"\x0eGetSavedSearch\x12#.memos.api.v1.GetSavedSearchRequest\x1a\x19.memos.api.v1.SavedSearch\"5\xdaA\x04name\x82\xd3\xe4\x93\x02(\x12&/api/v1/{name=users/*/savedSearches/*}\x12\xaa\x01\n" +
+	"\x11CreateSavedSearch\x12&.memos.api.v1.CreateSavedSearchRequest\x1a\x19.memos.api.v1.SavedSearch\"R\xdaA\x13parent,saved_search\x82\xd3\xe4\x93\x026:\fsaved_search\"&/api/v1/{parent=users/*}/savedSearches\x12\xbc\x01\n" +
+	"\x11UpdateSavedSearch\x12&.memos.api.v1.UpdateSavedSearchRequest\x1a\x19.memos.api.v1.SavedSearch\"d\xdaA\x18saved_search,update_mask\x82\xd3\xe4\x93\x02C:\fsaved_search23/api/v1/{saved_search.name=users/*/savedSearches/*}\x12\x8a\x01\n" +
+	"\x11DeleteSavedSearch\x12&.memos.api.v1.DeleteSavedSearchRequest\x1a\x16.google.protobuf.Empty\"5\xdaA\x04name\x82\xd3\xe4\x93\x02(*&/api/v1/{name=users/*/savedSearches/*}\x12\xa6\x01\n" +
+	"\x12ExecuteSavedSearch\x12'.memos.api.v1.ExecuteSavedSearchRequest\x1a(.memos.api.v1.ExecuteSavedSearchResponse\"=\xdaA\x04name\x82\xd3\xe4\x93\x020\x12./api/v1/{name=users/*/savedSearches/*}:executeB\xaf\x01\n" +
+	"\x10com.memos.api.v1B\x17SavedSearchServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
+
+var (
+	file_api_v1_saved_search_service_proto_rawDescOnce sync.Once
+	file_api_v1_saved_search_service_proto_rawDescData []byte
+)
+
+func file_api_v1_saved_search_service_proto_rawDescGZIP() []byte {
+	file_api_v1_saved_search_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_saved_search_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_saved_search_service_proto_rawDesc), len(file_api_v1_saved_search_service_proto_rawDesc)))
+	})
+	return file_api_v1_saved_search_service_proto_rawDescData
+}
+
+var file_api_v1_saved_search_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_v1_saved_search_service_proto_goTypes = []any{
+	(*SavedSearch)(nil),                // 0: memos.api.v1.SavedSearch
+	(*ListSavedSearchesRequest)(nil),   // 1: memos.api.v1.ListSavedSearchesRequest
+	(*ListSavedSearchesResponse)(nil),  // 2: memos.api.v1.ListSavedSearchesResponse
+	(*GetSavedSearchRequest)(nil),      // 3: memos.api.v1.GetSavedSearchRequest
+	(*CreateSavedSearchRequest)(nil),   // 4: memos.api.v1.CreateSavedSearchRequest
+	(*UpdateSavedSearchRequest)(nil),   // 5: memos.api.v1.UpdateSavedSearchRequest
+	(*DeleteSavedSearchRequest)(nil),   // 6: memos.api.v1.DeleteSavedSearchRequest
+	(*ExecuteSavedSearchRequest)(nil),  // 7: memos.api.v1.ExecuteSavedSearchRequest
+	(*ExecuteSavedSearchResponse)(nil), // 8: memos.api.v1.ExecuteSavedSearchResponse
+	(*fieldmaskpb.FieldMask)(nil),      // 9: google.protobuf.FieldMask
+	(*Memo)(nil),                       // 10: memos.api.v1.Memo
+	(*emptypb.Empty)(nil),              // 11: google.protobuf.Empty
+}
+var file_api_v1_saved_search_service_proto_depIdxs = []int32{
+	0,  // 0: memos.api.v1.ListSavedSearchesResponse.saved_searches:type_name -> memos.api.v1.SavedSearch
+	0,  // 1: memos.api.v1.CreateSavedSearchRequest.saved_search:type_name -> memos.api.v1.SavedSearch
+	0,  // 2: memos.api.v1.UpdateSavedSearchRequest.saved_search:type_name -> memos.api.v1.SavedSearch
+	9,  // 3: memos.api.v1.UpdateSavedSearchRequest.update_mask:type_name -> google.protobuf.FieldMask
+	10, // 4: memos.api.v1.ExecuteSavedSearchResponse.memos:type_name -> memos.api.v1.Memo
+	1,  // 5: memos.api.v1.SavedSearchService.ListSavedSearches:input_type -> memos.api.v1.ListSavedSearchesRequest
+	3,  // 6: memos.api.v1.SavedSearchService.GetSavedSearch:input_type -> memos.api.v1.GetSavedSearchRequest
+	4,  // 7: memos.api.v1.SavedSearchService.CreateSavedSearch:input_type -> memos.api.v1.CreateSavedSearchRequest
+	5,  // 8: memos.api.v1.SavedSearchService.UpdateSavedSearch:input_type -> memos.api.v1.UpdateSavedSearchRequest
+	6,  // 9: memos.api.v1.SavedSearchService.DeleteSavedSearch:input_type -> memos.api.v1.DeleteSavedSearchRequest
+	7,  // 10: memos.api.v1.SavedSearchService.ExecuteSavedSearch:input_type -> memos.api.v1.ExecuteSavedSearchRequest
+	2,  // 11: memos.api.v1.SavedSearchService.ListSavedSearches:output_type -> memos.api.v1.ListSavedSearchesResponse
+	0,  // 12: memos.api.v1.SavedSearchService.GetSavedSearch:output_type -> memos.api.v1.SavedSearch
+	0,  // 13: memos.api.v1.SavedSearchService.CreateSavedSearch:output_type -> memos.api.v1.SavedSearch
+	0,  // 14: memos.api.v1.SavedSearchService.UpdateSavedSearch:output_type -> memos.api.v1.SavedSearch
+	11, // 15: memos.api.v1.SavedSearchService.DeleteSavedSearch:output_type -> google.protobuf.Empty
+	8,  // 16: memos.api.v1.SavedSearchService.ExecuteSavedSearch:output_type -> memos.api.v1.ExecuteSavedSearchResponse
+	11, // [11:17] is the sub-list for method output_type
+	5,  // [5:11] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_saved_search_service_proto_init() }
+func file_api_v1_saved_search_service_proto_init() {
+	if File_api_v1_saved_search_service_proto != nil {
+		return
+	}
+	file_api_v1_memo_service_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_saved_search_service_proto_rawDesc), len(file_api_v1_saved_search_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_saved_search_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_saved_search_service_proto_depIdxs,
+		MessageInfos:      file_api_v1_saved_search_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_saved_search_service_proto = out.File
+	file_api_v1_saved_search_service_proto_goTypes = nil
+	file_api_v1_saved_search_service_proto_depIdxs = nil
+}
@@ -0,0 +1,324 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/v1/saved_search_service.proto
+
+package apiv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SavedSearchService_ListSavedSearches_FullMethodName  = "/memos.api.v1.SavedSearchService/ListSavedSearches"
+	SavedSearchService_GetSavedSearch_FullMethodName     = "/memos.api.v1.SavedSearchService/GetSavedSearch"
+	SavedSearchService_CreateSavedSearch_FullMethodName  = "/memos.api.v1.SavedSearchService/CreateSavedSearch"
+	SavedSearchService_UpdateSavedSearch_FullMethodName  = "/memos.api.v1.SavedSearchService/UpdateSavedSearch"
+	SavedSearchService_DeleteSavedSearch_FullMethodName  = "/memos.api.v1.SavedSearchService/DeleteSavedSearch"
+	SavedSearchService_ExecuteSavedSearch_FullMethodName = "/memos.api.v1.SavedSearchService/ExecuteSavedSearch"
+)
+
+// SavedSearchServiceClient is the client API for SavedSearchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SavedSearchServiceClient interface {
+	// ListSavedSearches returns the saved searches of a user, the pinned ones first.
+	ListSavedSearches(ctx context.Context, in *ListSavedSearchesRequest, opts ...grpc.CallOption) (*ListSavedSearchesResponse, error)
+	// GetSavedSearch gets a saved search by name.
+	GetSavedSearch(ctx context.Context, in *GetSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearch, error)
+	// CreateSavedSearch creates a new saved search for a user.
+	CreateSavedSearch(ctx context.Context, in *CreateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearch, error)
+	// UpdateSavedSearch updates a saved search for a user.
+	UpdateSavedSearch(ctx context.Context, in *UpdateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearch, error)
+	// DeleteSavedSearch deletes a saved search for a user.
+	DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ExecuteSavedSearch runs a saved search and returns the matching memos.
+	ExecuteSavedSearch(ctx context.Context, in *ExecuteSavedSearchRequest, opts ...grpc.CallOption) (*ExecuteSavedSearchResponse, error)
+}
+
+type savedSearchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSavedSearchServiceClient(cc grpc.ClientConnInterface) SavedSearchServiceClient {
+	return &savedSearchServiceClient{cc}
+}
+
+func (c *savedSearchServiceClient) ListSavedSearches(ctx context.Context, in *ListSavedSearchesRequest, opts ...grpc.CallOption) (*ListSavedSearchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSavedSearchesResponse)
+	err := c.cc.Invoke(ctx, SavedSearchService_ListSavedSearches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchServiceClient) GetSavedSearch(ctx context.Context, in *GetSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearch, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedSearch)
+	err := c.cc.Invoke(ctx, SavedSearchService_GetSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchServiceClient) CreateSavedSearch(ctx context.Context, in *CreateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearch, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedSearch)
+	err := c.cc.Invoke(ctx, SavedSearchService_CreateSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchServiceClient) UpdateSavedSearch(ctx context.Context, in *UpdateSavedSearchRequest, opts ...grpc.CallOption) (*SavedSearch, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedSearch)
+	err := c.cc.Invoke(ctx, SavedSearchService_UpdateSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchServiceClient) DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SavedSearchService_DeleteSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedSearchServiceClient) ExecuteSavedSearch(ctx context.Context, in *ExecuteSavedSearchRequest, opts ...grpc.CallOption) (*ExecuteSavedSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExecuteSavedSearchResponse)
+	err := c.cc.Invoke(ctx, SavedSearchService_ExecuteSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SavedSearchServiceServer is the server API for SavedSearchService service.
+// All implementations must embed UnimplementedSavedSearchServiceServer
+// for forward compatibility.
+type SavedSearchServiceServer interface {
+	// ListSavedSearches returns the saved searches of a user, the pinned ones first.
+	ListSavedSearches(context.Context, *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error)
+	// GetSavedSearch gets a saved search by name.
+	GetSavedSearch(context.Context, *GetSavedSearchRequest) (*SavedSearch, error)
+	// CreateSavedSearch creates a new saved search for a user.
+	CreateSavedSearch(context.Context, *CreateSavedSearchRequest) (*SavedSearch, error)
+	// UpdateSavedSearch updates a saved search for a user.
+	UpdateSavedSearch(context.Context, *UpdateSavedSearchRequest) (*SavedSearch, error)
+	// DeleteSavedSearch deletes a saved search for a user.
+	DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*emptypb.Empty, error)
+	// ExecuteSavedSearch runs a saved search and returns the matching memos.
+	ExecuteSavedSearch(context.Context, *ExecuteSavedSearchRequest) (*ExecuteSavedSearchResponse, error)
+	mustEmbedUnimplementedSavedSearchServiceServer()
+}
+
+// UnimplementedSavedSearchServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSavedSearchServiceServer struct{}
+
+func (UnimplementedSavedSearchServiceServer) ListSavedSearches(context.Context, *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSavedSearches not implemented")
+}
+func (UnimplementedSavedSearchServiceServer) GetSavedSearch(context.Context, *GetSavedSearchRequest) (*SavedSearch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSavedSearch not implemented")
+}
+func (UnimplementedSavedSearchServiceServer) CreateSavedSearch(context.Context, *CreateSavedSearchRequest) (*SavedSearch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSavedSearch not implemented")
+}
+func (UnimplementedSavedSearchServiceServer) UpdateSavedSearch(context.Context, *UpdateSavedSearchRequest) (*SavedSearch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSavedSearch not implemented")
+}
+func (UnimplementedSavedSearchServiceServer) DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavedSearch not implemented")
+}
+func (UnimplementedSavedSearchServiceServer) ExecuteSavedSearch(context.Context, *ExecuteSavedSearchRequest) (*ExecuteSavedSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteSavedSearch not implemented")
+}
+func (UnimplementedSavedSearchServiceServer) mustEmbedUnimplementedSavedSearchServiceServer() {}
+func (UnimplementedSavedSearchServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeSavedSearchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SavedSearchServiceServer will
+// result in compilation errors.
+type UnsafeSavedSearchServiceServer interface {
+	mustEmbedUnimplementedSavedSearchServiceServer()
+}
+
+func RegisterSavedSearchServiceServer(s grpc.ServiceRegistrar, srv SavedSearchServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSavedSearchServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SavedSearchService_ServiceDesc, srv)
+}
+
+func _SavedSearchService_ListSavedSearches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedSearchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchServiceServer).ListSavedSearches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearchService_ListSavedSearches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchServiceServer).ListSavedSearches(ctx, req.(*ListSavedSearchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedSearchService_GetSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchServiceServer).GetSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearchService_GetSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchServiceServer).GetSavedSearch(ctx, req.(*GetSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedSearchService_CreateSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchServiceServer).CreateSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearchService_CreateSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchServiceServer).CreateSavedSearch(ctx, req.(*CreateSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedSearchService_UpdateSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchServiceServer).UpdateSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearchService_UpdateSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchServiceServer).UpdateSavedSearch(ctx, req.(*UpdateSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedSearchService_DeleteSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchServiceServer).DeleteSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearchService_DeleteSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchServiceServer).DeleteSavedSearch(ctx, req.(*DeleteSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedSearchService_ExecuteSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedSearchServiceServer).ExecuteSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedSearchService_ExecuteSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedSearchServiceServer).ExecuteSavedSearch(ctx, req.(*ExecuteSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SavedSearchService_ServiceDesc is the grpc.ServiceDesc for SavedSearchService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SavedSearchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "memos.api.v1.SavedSearchService",
+	HandlerType: (*SavedSearchServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSavedSearches",
+			Handler:    _SavedSearchService_ListSavedSearches_Handler,
+		},
+		{
+			MethodName: "GetSavedSearch",
+			Handler:    _SavedSearchService_GetSavedSearch_Handler,
+		},
+		{
+			MethodName: "CreateSavedSearch",
+			Handler:    _SavedSearchService_CreateSavedSearch_Handler,
+		},
+		{
+			MethodName: "UpdateSavedSearch",
+			Handler:    _SavedSearchService_UpdateSavedSearch_Handler,
+		},
+		{
+			MethodName: "DeleteSavedSearch",
+			Handler:    _SavedSearchService_DeleteSavedSearch_Handler,
+		},
+		{
+			MethodName: "ExecuteSavedSearch",
+			Handler:    _SavedSearchService_ExecuteSavedSearch_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/saved_search_service.proto",
+}
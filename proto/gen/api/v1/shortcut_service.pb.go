@@ -24,6 +24,62 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Shortcut_Visibility int32
+
+const (
+	Shortcut_VISIBILITY_UNSPECIFIED Shortcut_Visibility = 0
+	// Only the owner reads the shortcut.
+	Shortcut_PRIVATE Shortcut_Visibility = 1
+	// The owner and the users of `shared_users` read the shortcut.
+	Shortcut_SHARED Shortcut_Visibility = 2
+	// Every user of the workspace reads the shortcut.
+	// Only admins publish shortcuts to the workspace.
+	Shortcut_WORKSPACE Shortcut_Visibility = 3
+)
+
+// Enum value maps for Shortcut_Visibility.
+var (
+	Shortcut_Visibility_name = map[int32]string{
+		0: "VISIBILITY_UNSPECIFIED",
+		1: "PRIVATE",
+		2: "SHARED",
+		3: "WORKSPACE",
+	}
+	Shortcut_Visibility_value = map[string]int32{
+		"VISIBILITY_UNSPECIFIED": 0,
+		"PRIVATE":                1,
+		"SHARED":                 2,
+		"WORKSPACE":              3,
+	}
+)
+
+func (x Shortcut_Visibility) Enum() *Shortcut_Visibility {
+	p := new(Shortcut_Visibility)
+	*p = x
+	return p
+}
+
+func (x Shortcut_Visibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Shortcut_Visibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_shortcut_service_proto_enumTypes[0].Descriptor()
+}
+
+func (Shortcut_Visibility) Type() protoreflect.EnumType {
+	return &file_api_v1_shortcut_service_proto_enumTypes[0]
+}
+
+func (x Shortcut_Visibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Shortcut_Visibility.Descriptor instead.
+func (Shortcut_Visibility) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{0, 0}
+}
+
 type Shortcut struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The resource name of the shortcut.
@@ -32,7 +88,14 @@ type Shortcut struct {
 	// The title of the shortcut.
 	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	// The filter expression for the shortcut.
-	Filter        string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// For the readers other than the owner, the filter macros of the owner are expanded.
+	Filter string `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Who may read the shortcut besides its owner, default to PRIVATE.
+	// The memos listed by a shortcut are still restricted to the ones visible to the reader.
+	Visibility Shortcut_Visibility `protobuf:"varint,4,opt,name=visibility,proto3,enum=memos.api.v1.Shortcut_Visibility" json:"visibility,omitempty"`
+	// The users the shortcut is shared with, for the SHARED visibility.
+	// Format: users/{user}
+	SharedUsers   []string `protobuf:"bytes,5,rep,name=shared_users,json=sharedUsers,proto3" json:"shared_users,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -88,6 +151,20 @@ func (x *Shortcut) GetFilter() string {
 	return ""
 }
 
+func (x *Shortcut) GetVisibility() Shortcut_Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Shortcut_VISIBILITY_UNSPECIFIED
+}
+
+func (x *Shortcut) GetSharedUsers() []string {
+	if x != nil {
+		return x.SharedUsers
+	}
+	return nil
+}
+
 type ListShortcutsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The parent resource where shortcuts are listed.
@@ -389,15 +466,107 @@ func (x *DeleteShortcutRequest) GetName() string {
 	return ""
 }
 
+type ListSharedShortcutsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharedShortcutsRequest) Reset() {
+	*x = ListSharedShortcutsRequest{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharedShortcutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedShortcutsRequest) ProtoMessage() {}
+
+func (x *ListSharedShortcutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedShortcutsRequest.ProtoReflect.Descriptor instead.
+func (*ListSharedShortcutsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{7}
+}
+
+type ListSharedShortcutsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The shortcuts of other users readable by the current user.
+	Shortcuts     []*Shortcut `protobuf:"bytes,1,rep,name=shortcuts,proto3" json:"shortcuts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharedShortcutsResponse) Reset() {
+	*x = ListSharedShortcutsResponse{}
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharedShortcutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedShortcutsResponse) ProtoMessage() {}
+
+func (x *ListSharedShortcutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_shortcut_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedShortcutsResponse.ProtoReflect.Descriptor instead.
+func (*ListSharedShortcutsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_shortcut_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListSharedShortcutsResponse) GetShortcuts() []*Shortcut {
+	if x != nil {
+		return x.Shortcuts
+	}
+	return nil
+}
+
 var File_api_v1_shortcut_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_shortcut_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1dapi/v1/shortcut_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"\xaf\x01\n" +
+	"\x1dapi/v1/shortcut_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\"\xf1\x02\n" +
 	"\bShortcut\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tB\x03\xe0A\x02R\x05title\x12\x1b\n" +
-	"\x06filter\x18\x03 \x01(\tB\x03\xe0A\x01R\x06filter:R\xeaAO\n" +
+	"\x06filter\x18\x03 \x01(\tB\x03\xe0A\x01R\x06filter\x12F\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\x0e2!.memos.api.v1.Shortcut.VisibilityB\x03\xe0A\x01R\n" +
+	"visibility\x12&\n" +
+	"\fshared_users\x18\x05 \x03(\tB\x03\xe0A\x01R\vsharedUsers\"P\n" +
+	"\n" +
+	"Visibility\x12\x1a\n" +
+	"\x16VISIBILITY_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPRIVATE\x10\x01\x12\n" +
+	"\n" +
+	"\x06SHARED\x10\x02\x12\r\n" +
+	"\tWORKSPACE\x10\x03:R\xeaAO\n" +
 	"\x15memos.api.v1/Shortcut\x12!users/{user}/shortcuts/{shortcut}*\tshortcuts2\bshortcut\"M\n" +
 	"\x14ListShortcutsRequest\x125\n" +
 	"\x06parent\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\x12\x15memos.api.v1/ShortcutR\x06parent\"M\n" +
@@ -416,12 +585,16 @@ const file_api_v1_shortcut_service_proto_rawDesc = "" +
 	"updateMask\"J\n" +
 	"\x15DeleteShortcutRequest\x121\n" +
 	"\x04name\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
-	"\x15memos.api.v1/ShortcutR\x04name2\xde\x05\n" +
+	"\x15memos.api.v1/ShortcutR\x04name\"\x1c\n" +
+	"\x1aListSharedShortcutsRequest\"S\n" +
+	"\x1bListSharedShortcutsResponse\x124\n" +
+	"\tshortcuts\x18\x01 \x03(\v2\x16.memos.api.v1.ShortcutR\tshortcuts2\xed\x06\n" +
 	"\x0fShortcutService\x12\x8d\x01\n" +
 	"\rListShortcuts\x12\".memos.api.v1.ListShortcutsRequest\x1a#.memos.api.v1.ListShortcutsResponse\"3\xdaA\x06parent\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{parent=users/*}/shortcuts\x12z\n" +
 	"\vGetShortcut\x12 .memos.api.v1.GetShortcutRequest\x1a\x16.memos.api.v1.Shortcut\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{name=users/*/shortcuts/*}\x12\x95\x01\n" +
 	"\x0eCreateShortcut\x12#.memos.api.v1.CreateShortcutRequest\x1a\x16.memos.api.v1.Shortcut\"F\xdaA\x0fparent,shortcut\x82\xd3\xe4\x93\x02.:\bshortcut\"\"/api/v1/{parent=users/*}/shortcuts\x12\xa3\x01\n" +
-	"\x0eUpdateShortcut\x12#.memos.api.v1.UpdateShortcutRequest\x1a\x16.memos.api.v1.Shortcut\"T\xdaA\x14shortcut,update_mask\x82\xd3\xe4\x93\x027:\bshortcut2+/api/v1/{shortcut.name=users/*/shortcuts/*}\x12\x80\x01\n" +
+	"\x0eUpdateShortcut\x12#.memos.api.v1.UpdateShortcutRequest\x1a\x16.memos.api.v1.Shortcut\"T\xdaA\x14shortcut,update_mask\x82\xd3\xe4\x93\x027:\bshortcut2+/api/v1/{shortcut.name=users/*/shortcuts/*}\x12\x8c\x01\n" +
+	"\x13ListSharedShortcuts\x12(.memos.api.v1.ListSharedShortcutsRequest\x1a).memos.api.v1.ListSharedShortcutsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/shortcuts:shared\x12\x80\x01\n" +
 	"\x0eDeleteShortcut\x12#.memos.api.v1.DeleteShortcutRequest\x1a\x16.google.protobuf.Empty\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$*\"/api/v1/{name=users/*/shortcuts/*}B\xac\x01\n" +
 	"\x10com.memos.api.v1B\x14ShortcutServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
@@ -437,38 +610,46 @@ func file_api_v1_shortcut_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_shortcut_service_proto_rawDescData
 }
 
-var file_api_v1_shortcut_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_api_v1_shortcut_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_v1_shortcut_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_api_v1_shortcut_service_proto_goTypes = []any{
-	(*Shortcut)(nil),              // 0: memos.api.v1.Shortcut
-	(*ListShortcutsRequest)(nil),  // 1: memos.api.v1.ListShortcutsRequest
-	(*ListShortcutsResponse)(nil), // 2: memos.api.v1.ListShortcutsResponse
-	(*GetShortcutRequest)(nil),    // 3: memos.api.v1.GetShortcutRequest
-	(*CreateShortcutRequest)(nil), // 4: memos.api.v1.CreateShortcutRequest
-	(*UpdateShortcutRequest)(nil), // 5: memos.api.v1.UpdateShortcutRequest
-	(*DeleteShortcutRequest)(nil), // 6: memos.api.v1.DeleteShortcutRequest
-	(*fieldmaskpb.FieldMask)(nil), // 7: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),         // 8: google.protobuf.Empty
+	(Shortcut_Visibility)(0),            // 0: memos.api.v1.Shortcut.Visibility
+	(*Shortcut)(nil),                    // 1: memos.api.v1.Shortcut
+	(*ListShortcutsRequest)(nil),        // 2: memos.api.v1.ListShortcutsRequest
+	(*ListShortcutsResponse)(nil),       // 3: memos.api.v1.ListShortcutsResponse
+	(*GetShortcutRequest)(nil),          // 4: memos.api.v1.GetShortcutRequest
+	(*CreateShortcutRequest)(nil),       // 5: memos.api.v1.CreateShortcutRequest
+	(*UpdateShortcutRequest)(nil),       // 6: memos.api.v1.UpdateShortcutRequest
+	(*DeleteShortcutRequest)(nil),       // 7: memos.api.v1.DeleteShortcutRequest
+	(*ListSharedShortcutsRequest)(nil),  // 8: memos.api.v1.ListSharedShortcutsRequest
+	(*ListSharedShortcutsResponse)(nil), // 9: memos.api.v1.ListSharedShortcutsResponse
+	(*fieldmaskpb.FieldMask)(nil),       // 10: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),               // 11: google.protobuf.Empty
 }
 var file_api_v1_shortcut_service_proto_depIdxs = []int32{
-	0, // 0: memos.api.v1.ListShortcutsResponse.shortcuts:type_name -> memos.api.v1.Shortcut
-	0, // 1: memos.api.v1.CreateShortcutRequest.shortcut:type_name -> memos.api.v1.Shortcut
-	0, // 2: memos.api.v1.UpdateShortcutRequest.shortcut:type_name -> memos.api.v1.Shortcut
-	7, // 3: memos.api.v1.UpdateShortcutRequest.update_mask:type_name -> google.protobuf.FieldMask
-	1, // 4: memos.api.v1.ShortcutService.ListShortcuts:input_type -> memos.api.v1.ListShortcutsRequest
-	3, // 5: memos.api.v1.ShortcutService.GetShortcut:input_type -> memos.api.v1.GetShortcutRequest
-	4, // 6: memos.api.v1.ShortcutService.CreateShortcut:input_type -> memos.api.v1.CreateShortcutRequest
-	5, // 7: memos.api.v1.ShortcutService.UpdateShortcut:input_type -> memos.api.v1.UpdateShortcutRequest
-	6, // 8: memos.api.v1.ShortcutService.DeleteShortcut:input_type -> memos.api.v1.DeleteShortcutRequest
-	2, // 9: memos.api.v1.ShortcutService.ListShortcuts:output_type -> memos.api.v1.ListShortcutsResponse
-	0, // 10: memos.api.v1.ShortcutService.GetShortcut:output_type -> memos.api.v1.Shortcut
-	0, // 11: memos.api.v1.ShortcutService.CreateShortcut:output_type -> memos.api.v1.Shortcut
-	0, // 12: memos.api.v1.ShortcutService.UpdateShortcut:output_type -> memos.api.v1.Shortcut
-	8, // 13: memos.api.v1.ShortcutService.DeleteShortcut:output_type -> google.protobuf.Empty
-	9, // [9:14] is the sub-list for method output_type
-	4, // [4:9] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	0,  // 0: memos.api.v1.Shortcut.visibility:type_name -> memos.api.v1.Shortcut.Visibility
+	1,  // 1: memos.api.v1.ListShortcutsResponse.shortcuts:type_name -> memos.api.v1.Shortcut
+	1,  // 2: memos.api.v1.CreateShortcutRequest.shortcut:type_name -> memos.api.v1.Shortcut
+	1,  // 3: memos.api.v1.UpdateShortcutRequest.shortcut:type_name -> memos.api.v1.Shortcut
+	10, // 4: memos.api.v1.UpdateShortcutRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 5: memos.api.v1.ListSharedShortcutsResponse.shortcuts:type_name -> memos.api.v1.Shortcut
+	2,  // 6: memos.api.v1.ShortcutService.ListShortcuts:input_type -> memos.api.v1.ListShortcutsRequest
+	4,  // 7: memos.api.v1.ShortcutService.GetShortcut:input_type -> memos.api.v1.GetShortcutRequest
+	5,  // 8: memos.api.v1.ShortcutService.CreateShortcut:input_type -> memos.api.v1.CreateShortcutRequest
+	6,  // 9: memos.api.v1.ShortcutService.UpdateShortcut:input_type -> memos.api.v1.UpdateShortcutRequest
+	8,  // 10: memos.api.v1.ShortcutService.ListSharedShortcuts:input_type -> memos.api.v1.ListSharedShortcutsRequest
+	7,  // 11: memos.api.v1.ShortcutService.DeleteShortcut:input_type -> memos.api.v1.DeleteShortcutRequest
+	3,  // 12: memos.api.v1.ShortcutService.ListShortcuts:output_type -> memos.api.v1.ListShortcutsResponse
+	1,  // 13: memos.api.v1.ShortcutService.GetShortcut:output_type -> memos.api.v1.Shortcut
+	1,  // 14: memos.api.v1.ShortcutService.CreateShortcut:output_type -> memos.api.v1.Shortcut
+	1,  // 15: memos.api.v1.ShortcutService.UpdateShortcut:output_type -> memos.api.v1.Shortcut
+	9,  // 16: memos.api.v1.ShortcutService.ListSharedShortcuts:output_type -> memos.api.v1.ListSharedShortcutsResponse
+	11, // 17: memos.api.v1.ShortcutService.DeleteShortcut:output_type -> google.protobuf.Empty
+	12, // [12:18] is the sub-list for method output_type
+	6,  // [6:12] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_shortcut_service_proto_init() }
@@ -481,13 +662,14 @@ func file_api_v1_shortcut_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_shortcut_service_proto_rawDesc), len(file_api_v1_shortcut_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      1,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_v1_shortcut_service_proto_goTypes,
 		DependencyIndexes: file_api_v1_shortcut_service_proto_depIdxs,
+		EnumInfos:         file_api_v1_shortcut_service_proto_enumTypes,
 		MessageInfos:      file_api_v1_shortcut_service_proto_msgTypes,
 	}.Build()
 	File_api_v1_shortcut_service_proto = out.File
@@ -20,11 +20,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ShortcutService_ListShortcuts_FullMethodName  = "/memos.api.v1.ShortcutService/ListShortcuts"
-	ShortcutService_GetShortcut_FullMethodName    = "/memos.api.v1.ShortcutService/GetShortcut"
-	ShortcutService_CreateShortcut_FullMethodName = "/memos.api.v1.ShortcutService/CreateShortcut"
-	ShortcutService_UpdateShortcut_FullMethodName = "/memos.api.v1.ShortcutService/UpdateShortcut"
-	ShortcutService_DeleteShortcut_FullMethodName = "/memos.api.v1.ShortcutService/DeleteShortcut"
+	ShortcutService_ListShortcuts_FullMethodName       = "/memos.api.v1.ShortcutService/ListShortcuts"
+	ShortcutService_GetShortcut_FullMethodName         = "/memos.api.v1.ShortcutService/GetShortcut"
+	ShortcutService_CreateShortcut_FullMethodName      = "/memos.api.v1.ShortcutService/CreateShortcut"
+	ShortcutService_UpdateShortcut_FullMethodName      = "/memos.api.v1.ShortcutService/UpdateShortcut"
+	ShortcutService_ListSharedShortcuts_FullMethodName = "/memos.api.v1.ShortcutService/ListSharedShortcuts"
+	ShortcutService_DeleteShortcut_FullMethodName      = "/memos.api.v1.ShortcutService/DeleteShortcut"
 )
 
 // ShortcutServiceClient is the client API for ShortcutService service.
@@ -39,6 +40,9 @@ type ShortcutServiceClient interface {
 	CreateShortcut(ctx context.Context, in *CreateShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error)
 	// UpdateShortcut updates a shortcut for a user.
 	UpdateShortcut(ctx context.Context, in *UpdateShortcutRequest, opts ...grpc.CallOption) (*Shortcut, error)
+	// ListSharedShortcuts returns the shortcuts of other users shared with the current user,
+	// and the shortcuts published to the workspace.
+	ListSharedShortcuts(ctx context.Context, in *ListSharedShortcutsRequest, opts ...grpc.CallOption) (*ListSharedShortcutsResponse, error)
 	// DeleteShortcut deletes a shortcut for a user.
 	DeleteShortcut(ctx context.Context, in *DeleteShortcutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
@@ -91,6 +95,16 @@ func (c *shortcutServiceClient) UpdateShortcut(ctx context.Context, in *UpdateSh
 	return out, nil
 }
 
+func (c *shortcutServiceClient) ListSharedShortcuts(ctx context.Context, in *ListSharedShortcutsRequest, opts ...grpc.CallOption) (*ListSharedShortcutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSharedShortcutsResponse)
+	err := c.cc.Invoke(ctx, ShortcutService_ListSharedShortcuts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *shortcutServiceClient) DeleteShortcut(ctx context.Context, in *DeleteShortcutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -113,6 +127,9 @@ type ShortcutServiceServer interface {
 	CreateShortcut(context.Context, *CreateShortcutRequest) (*Shortcut, error)
 	// UpdateShortcut updates a shortcut for a user.
 	UpdateShortcut(context.Context, *UpdateShortcutRequest) (*Shortcut, error)
+	// ListSharedShortcuts returns the shortcuts of other users shared with the current user,
+	// and the shortcuts published to the workspace.
+	ListSharedShortcuts(context.Context, *ListSharedShortcutsRequest) (*ListSharedShortcutsResponse, error)
 	// DeleteShortcut deletes a shortcut for a user.
 	DeleteShortcut(context.Context, *DeleteShortcutRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedShortcutServiceServer()
@@ -137,6 +154,9 @@ func (UnimplementedShortcutServiceServer) CreateShortcut(context.Context, *Creat
 func (UnimplementedShortcutServiceServer) UpdateShortcut(context.Context, *UpdateShortcutRequest) (*Shortcut, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateShortcut not implemented")
 }
+func (UnimplementedShortcutServiceServer) ListSharedShortcuts(context.Context, *ListSharedShortcutsRequest) (*ListSharedShortcutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSharedShortcuts not implemented")
+}
 func (UnimplementedShortcutServiceServer) DeleteShortcut(context.Context, *DeleteShortcutRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteShortcut not implemented")
 }
@@ -233,6 +253,24 @@ func _ShortcutService_UpdateShortcut_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShortcutService_ListSharedShortcuts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSharedShortcutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortcutServiceServer).ListSharedShortcuts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShortcutService_ListSharedShortcuts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortcutServiceServer).ListSharedShortcuts(ctx, req.(*ListSharedShortcutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ShortcutService_DeleteShortcut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteShortcutRequest)
 	if err := dec(in); err != nil {
@@ -274,6 +312,10 @@ var ShortcutService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateShortcut",
 			Handler:    _ShortcutService_UpdateShortcut_Handler,
 		},
+		{
+			MethodName: "ListSharedShortcuts",
+			Handler:    _ShortcutService_ListSharedShortcuts_Handler,
+		},
 		{
 			MethodName: "DeleteShortcut",
 			Handler:    _ShortcutService_DeleteShortcut_Handler,
@@ -0,0 +1,948 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: api/v1/space_service.proto
+
+package apiv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Space member role enumeration.
+type SpaceMember_Role int32
+
+const (
+	// Unspecified role.
+	SpaceMember_ROLE_UNSPECIFIED SpaceMember_Role = 0
+	// Owner role, managing the space and its members.
+	SpaceMember_OWNER SpaceMember_Role = 1
+	// Editor role, creating memos in the space.
+	SpaceMember_EDITOR SpaceMember_Role = 2
+	// Viewer role, reading the memos of the space.
+	SpaceMember_VIEWER SpaceMember_Role = 3
+)
+
+// Enum value maps for SpaceMember_Role.
+var (
+	SpaceMember_Role_name = map[int32]string{
+		0: "ROLE_UNSPECIFIED",
+		1: "OWNER",
+		2: "EDITOR",
+		3: "VIEWER",
+	}
+	SpaceMember_Role_value = map[string]int32{
+		"ROLE_UNSPECIFIED": 0,
+		"OWNER":            1,
+		"EDITOR":           2,
+		"VIEWER":           3,
+	}
+)
+
+func (x SpaceMember_Role) Enum() *SpaceMember_Role {
+	p := new(SpaceMember_Role)
+	*p = x
+	return p
+}
+
+func (x SpaceMember_Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SpaceMember_Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_space_service_proto_enumTypes[0].Descriptor()
+}
+
+func (SpaceMember_Role) Type() protoreflect.EnumType {
+	return &file_api_v1_space_service_proto_enumTypes[0]
+}
+
+func (x SpaceMember_Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SpaceMember_Role.Descriptor instead.
+func (SpaceMember_Role) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_space_service_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type Space struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the space.
+	// Format: spaces/{space}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The display name of the space.
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Output only. The resource name of the creator.
+	// Format: users/{user}
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Output only. The creation timestamp.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Output only. The last update timestamp.
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Output only. The role of the current user in the space.
+	Role          SpaceMember_Role `protobuf:"varint,6,opt,name=role,proto3,enum=memos.api.v1.SpaceMember_Role" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Space) Reset() {
+	*x = Space{}
+	mi := &file_api_v1_space_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Space) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Space) ProtoMessage() {}
+
+func (x *Space) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_space_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Space.ProtoReflect.Descriptor instead.
+func (*Space) Descriptor() ([]byte, []int) {
+	return file_api_v1_space_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Space) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Space) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Space) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *Space) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Space) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Space) GetRole() SpaceMember_Role {
+	if x != nil {
+		return x.Role
+	}
+	return SpaceMember_ROLE_UNSPECIFIED
+}
+
+type SpaceMember struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the member, the member being the id of the user.
+	// Format: spaces/{space}/members/{member}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The resource name of the user.
+	// Format: users/{user}
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// Required. The role of the member.
+	Role SpaceMember_Role `protobuf:"varint,3,opt,name=role,proto3,enum=memos.api.v1.SpaceMember_Role" json:"role,omitempty"`
+	// Output only. The time the user joined the space.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpaceMember) Reset() {
+	*x = SpaceMember{}
+	mi := &file_api_v1_space_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpaceMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpaceMember) ProtoMessage() {}
+
+func (x *SpaceMember) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_space_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpaceMember.ProtoReflect.Descriptor instead.
+func (*SpaceMember) Descriptor() ([]byte, []int) {
+	return file_api_v1_space_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SpaceMember) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SpaceMember) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *SpaceMember) GetRole() SpaceMember_Role {
+	if x != nil {
+		return x.Role
+	}
+	return SpaceMember_ROLE_UNSPECIFIED
+}
+
+func (x *SpaceMember) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ListSpacesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSpacesRequest) Reset() {
+	*x = ListSpacesRequest{}
+	mi := &file_api_v1_space_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSpacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSpacesRequest) ProtoMessage() {}
+
+func (x *ListSpacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_space_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSpacesRequest.ProtoReflect.Descriptor instead.
+func (*ListSpacesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_space_service_proto_rawDescGZIP(), []int{2}
+}
+
+type ListSpacesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of spaces.
+	Spaces        []*Space `protobuf:"bytes,1,rep,name=spaces,proto3" json:"spaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSpacesResponse) Reset() {
+	*x = ListSpacesResponse{}
+	mi := &file_api_v1_space_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSpacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSpacesResponse) ProtoMessage() {}
+
+func (x *ListSpacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_space_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSpacesResponse.ProtoReflect.Descriptor instead.
+func (*ListSpacesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_space_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListSpacesResponse) GetSpaces() []*Space {
+	if x != nil {
+		return x.Spaces
+	}
+	return nil
+}
+
+type GetSpaceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the space.
+	// Format: spaces/{space}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSpaceRequest) Reset() {
+	*x = GetSpaceRequest{}
+	mi := &file_api_v1_space_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSpaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpaceRequest) ProtoMessage() {}
+
+func (x *GetSpaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_space_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpaceRequest.ProtoReflect.Descriptor instead.
+func (*GetSpaceRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_space_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetSpaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateSpaceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The space to create.
+	Space *Space `protobuf:"bytes,1,opt,name=space,proto3" json:"space,omitempty"`
+	// Optional. The space ID to use for this space.
+	// If empty, a unique ID will be generated.
+	SpaceId       string `protobuf:"bytes,2,opt,name=space_id,json=spaceId,proto3" json:"space_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSpaceRequest) Reset() {
+	*x = CreateSpaceRequest{}
+	mi := &file_api_v1_space_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSpaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSpaceRequest) ProtoMessage() {}
+
+func (x *CreateSpaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_space_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSpaceRequest.ProtoReflect.Descriptor instead.
+func (*CreateSpaceRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_space_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateSpaceRequest) GetSpace() *Space {
+	if x != nil {
+		return x.Space
+	}
+	return nil
+}
+
+func (x *CreateSpaceRequest) GetSpaceId() string {
+	if x != nil {
+		return x.SpaceId
+	}
+	return ""
+}
+
+type UpdateSpaceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The space to update.
+	Space *Space `protobuf:"bytes,1,opt,name=space,proto3" json:"space,omitempty"`
+	// Required. The list of fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSpaceRequest) Reset() {
+	*x = UpdateSpaceRequest{}
+	mi := &file_api_v1_space_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSpaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSpaceRequest) ProtoMessage() {}
+
+func (x *UpdateSpaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_space_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSpaceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSpaceRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_space_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateSpaceRequest) GetSpace() *Space {
+	if x != nil {
+		return x.Space
+	}
+	return nil
+}
+
+func (x *UpdateSpaceRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteSpaceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the space to delete.
+	// Format: spaces/{space}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSpaceRequest) Reset() {
+	*x = DeleteSpaceRequest{}
+	mi := &file_api_v1_space_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSpaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSpaceRequest) ProtoMessage() {}
+
+func (x *DeleteSpaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_space_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSpaceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSpaceRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_space_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteSpaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListSpaceMembersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the space.
+	// Format: spaces/{space}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSpaceMembersRequest) Reset() {
+	*x = ListSpaceMembersRequest{}
+	mi := &file_api_v1_space_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSpaceMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSpaceMembersRequest) ProtoMessage() {}
+
+func (x *ListSpaceMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_space_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSpaceMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListSpaceMembersRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_space_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListSpaceMembersRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListSpaceMembersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of members.
+	SpaceMembers  []*SpaceMember `protobuf:"bytes,1,rep,name=space_members,json=spaceMembers,proto3" json:"space_members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSpaceMembersResponse) Reset() {
+	*x = ListSpaceMembersResponse{}
+	mi := &file_api_v1_space_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSpaceMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSpaceMembersResponse) ProtoMessage() {}
+
+func (x *ListSpaceMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_space_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSpaceMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListSpaceMembersResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_space_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListSpaceMembersResponse) GetSpaceMembers() []*SpaceMember {
+	if x != nil {
+		return x.SpaceMembers
+	}
+	return nil
+}
+
+type CreateSpaceMemberRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the space.
+	// Format: spaces/{space}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The member to add.
+	SpaceMember   *SpaceMember `protobuf:"bytes,2,opt,name=space_member,json=spaceMember,proto3" json:"space_member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSpaceMemberRequest) Reset() {
+	*x = CreateSpaceMemberRequest{}
+	mi := &file_api_v1_space_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSpaceMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSpaceMemberRequest) ProtoMessage() {}
+
+func (x *CreateSpaceMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_space_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSpaceMemberRequest.ProtoReflect.Descriptor instead.
+func (*CreateSpaceMemberRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_space_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateSpaceMemberRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateSpaceMemberRequest) GetSpaceMember() *SpaceMember {
+	if x != nil {
+		return x.SpaceMember
+	}
+	return nil
+}
+
+type UpdateSpaceMemberRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The member to update.
+	SpaceMember *SpaceMember `protobuf:"bytes,1,opt,name=space_member,json=spaceMember,proto3" json:"space_member,omitempty"`
+	// Required. The list of fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSpaceMemberRequest) Reset() {
+	*x = UpdateSpaceMemberRequest{}
+	mi := &file_api_v1_space_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSpaceMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSpaceMemberRequest) ProtoMessage() {}
+
+func (x *UpdateSpaceMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_space_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSpaceMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSpaceMemberRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_space_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateSpaceMemberRequest) GetSpaceMember() *SpaceMember {
+	if x != nil {
+		return x.SpaceMember
+	}
+	return nil
+}
+
+func (x *UpdateSpaceMemberRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteSpaceMemberRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the member to remove.
+	// Format: spaces/{space}/members/{member}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSpaceMemberRequest) Reset() {
+	*x = DeleteSpaceMemberRequest{}
+	mi := &file_api_v1_space_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSpaceMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSpaceMemberRequest) ProtoMessage() {}
+
+func (x *DeleteSpaceMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_space_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSpaceMemberRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSpaceMemberRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_space_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteSpaceMemberRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_api_v1_space_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_space_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1aapi/v1/space_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf2\x02\n" +
+	"\x05Space\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12&\n" +
+	"\fdisplay_name\x18\x02 \x01(\tB\x03\xe0A\x02R\vdisplayName\x123\n" +
+	"\acreator\x18\x03 \x01(\tB\x19\xe0A\x03\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\acreator\x12@\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12@\n" +
+	"\vupdate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"updateTime\x127\n" +
+	"\x04role\x18\x06 \x01(\x0e2\x1e.memos.api.v1.SpaceMember.RoleB\x03\xe0A\x03R\x04role:6\xeaA3\n" +
+	"\x12memos.api.v1/Space\x12\x0espaces/{space}*\x06spaces2\x05space\"\xec\x02\n" +
+	"\vSpaceMember\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12-\n" +
+	"\x04user\x18\x02 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x04user\x127\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x1e.memos.api.v1.SpaceMember.RoleB\x03\xe0A\x02R\x04role\x12@\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\"?\n" +
+	"\x04Role\x12\x14\n" +
+	"\x10ROLE_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05OWNER\x10\x01\x12\n" +
+	"\n" +
+	"\x06EDITOR\x10\x02\x12\n" +
+	"\n" +
+	"\x06VIEWER\x10\x03:Y\xeaAV\n" +
+	"\x18memos.api.v1/SpaceMember\x12\x1fspaces/{space}/members/{member}*\fspaceMembers2\vspaceMember\"\x13\n" +
+	"\x11ListSpacesRequest\"A\n" +
+	"\x12ListSpacesResponse\x12+\n" +
+	"\x06spaces\x18\x01 \x03(\v2\x13.memos.api.v1.SpaceR\x06spaces\"A\n" +
+	"\x0fGetSpaceRequest\x12.\n" +
+	"\x04name\x18\x01 \x01(\tB\x1a\xe0A\x02\xfaA\x14\n" +
+	"\x12memos.api.v1/SpaceR\x04name\"d\n" +
+	"\x12CreateSpaceRequest\x12.\n" +
+	"\x05space\x18\x01 \x01(\v2\x13.memos.api.v1.SpaceB\x03\xe0A\x02R\x05space\x12\x1e\n" +
+	"\bspace_id\x18\x02 \x01(\tB\x03\xe0A\x01R\aspaceId\"\x86\x01\n" +
+	"\x12UpdateSpaceRequest\x12.\n" +
+	"\x05space\x18\x01 \x01(\v2\x13.memos.api.v1.SpaceB\x03\xe0A\x02R\x05space\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
+	"updateMask\"D\n" +
+	"\x12DeleteSpaceRequest\x12.\n" +
+	"\x04name\x18\x01 \x01(\tB\x1a\xe0A\x02\xfaA\x14\n" +
+	"\x12memos.api.v1/SpaceR\x04name\"S\n" +
+	"\x17ListSpaceMembersRequest\x128\n" +
+	"\x06parent\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\x12\x18memos.api.v1/SpaceMemberR\x06parent\"Z\n" +
+	"\x18ListSpaceMembersResponse\x12>\n" +
+	"\rspace_members\x18\x01 \x03(\v2\x19.memos.api.v1.SpaceMemberR\fspaceMembers\"\x97\x01\n" +
+	"\x18CreateSpaceMemberRequest\x128\n" +
+	"\x06parent\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\x12\x18memos.api.v1/SpaceMemberR\x06parent\x12A\n" +
+	"\fspace_member\x18\x02 \x01(\v2\x19.memos.api.v1.SpaceMemberB\x03\xe0A\x02R\vspaceMember\"\x9f\x01\n" +
+	"\x18UpdateSpaceMemberRequest\x12A\n" +
+	"\fspace_member\x18\x01 \x01(\v2\x19.memos.api.v1.SpaceMemberB\x03\xe0A\x02R\vspaceMember\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
+	"updateMask\"P\n" +
+	"\x18DeleteSpaceMemberRequest\x124\n" +
+	"\x04name\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\n" +
+	"\x18memos.api.v1/SpaceMemberR\x04name2\xc8\t\n" +
+	"\fSpaceService\x12g\n" +
+	"\n" +
+	"ListSpaces\x12\x1f.memos.api.v1.ListSpacesRequest\x1a .memos.api.v1.ListSpacesResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/spaces\x12f\n" +
+	"\bGetSpace\x12\x1d.memos.api.v1.GetSpaceRequest\x1a\x13.memos.api.v1.Space\"&\xdaA\x04name\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/{name=spaces/*}\x12k\n" +
+	"\vCreateSpace\x12 .memos.api.v1.CreateSpaceRequest\x1a\x13.memos.api.v1.Space\"%\xdaA\x05space\x82\xd3\xe4\x93\x02\x17:\x05space\"\x0e/api/v1/spaces\x12\x86\x01\n" +
+	"\vUpdateSpace\x12 .memos.api.v1.UpdateSpaceRequest\x1a\x13.memos.api.v1.Space\"@\xdaA\x11space,update_mask\x82\xd3\xe4\x93\x02&:\x05space2\x1d/api/v1/{space.name=spaces/*}\x12o\n" +
+	"\vDeleteSpace\x12 .memos.api.v1.DeleteSpaceRequest\x1a\x16.google.protobuf.Empty\"&\xdaA\x04name\x82\xd3\xe4\x93\x02\x19*\x17/api/v1/{name=spaces/*}\x12\x95\x01\n" +
+	"\x10ListSpaceMembers\x12%.memos.api.v1.ListSpaceMembersRequest\x1a&.memos.api.v1.ListSpaceMembersResponse\"2\xdaA\x06parent\x82\xd3\xe4\x93\x02#\x12!/api/v1/{parent=spaces/*}/members\x12\xa5\x01\n" +
+	"\x11CreateSpaceMember\x12&.memos.api.v1.CreateSpaceMemberRequest\x1a\x19.memos.api.v1.SpaceMember\"M\xdaA\x13parent,space_member\x82\xd3\xe4\x93\x021:\fspace_member\"!/api/v1/{parent=spaces/*}/members\x12\xb7\x01\n" +
+	"\x11UpdateSpaceMember\x12&.memos.api.v1.UpdateSpaceMemberRequest\x1a\x19.memos.api.v1.SpaceMember\"_\xdaA\x18space_member,update_mask\x82\xd3\xe4\x93\x02>:\fspace_member2./api/v1/{space_member.name=spaces/*/members/*}\x12\x85\x01\n" +
+	"\x11DeleteSpaceMember\x12&.memos.api.v1.DeleteSpaceMemberRequest\x1a\x16.google.protobuf.Empty\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#*!/api/v1/{name=spaces/*/members/*}B\xa9\x01\n" +
+	"\x10com.memos.api.v1B\x11SpaceServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
+
+var (
+	file_api_v1_space_service_proto_rawDescOnce sync.Once
+	file_api_v1_space_service_proto_rawDescData []byte
+)
+
+func file_api_v1_space_service_proto_rawDescGZIP() []byte {
+	file_api_v1_space_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_space_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_space_service_proto_rawDesc), len(file_api_v1_space_service_proto_rawDesc)))
+	})
+	return file_api_v1_space_service_proto_rawDescData
+}
+
+var file_api_v1_space_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_v1_space_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_api_v1_space_service_proto_goTypes = []any{
+	(SpaceMember_Role)(0),            // 0: memos.api.v1.SpaceMember.Role
+	(*Space)(nil),                    // 1: memos.api.v1.Space
+	(*SpaceMember)(nil),              // 2: memos.api.v1.SpaceMember
+	(*ListSpacesRequest)(nil),        // 3: memos.api.v1.ListSpacesRequest
+	(*ListSpacesResponse)(nil),       // 4: memos.api.v1.ListSpacesResponse
+	(*GetSpaceRequest)(nil),          // 5: memos.api.v1.GetSpaceRequest
+	(*CreateSpaceRequest)(nil),       // 6: memos.api.v1.CreateSpaceRequest
+	(*UpdateSpaceRequest)(nil),       // 7: memos.api.v1.UpdateSpaceRequest
+	(*DeleteSpaceRequest)(nil),       // 8: memos.api.v1.DeleteSpaceRequest
+	(*ListSpaceMembersRequest)(nil),  // 9: memos.api.v1.ListSpaceMembersRequest
+	(*ListSpaceMembersResponse)(nil), // 10: memos.api.v1.ListSpaceMembersResponse
+	(*CreateSpaceMemberRequest)(nil), // 11: memos.api.v1.CreateSpaceMemberRequest
+	(*UpdateSpaceMemberRequest)(nil), // 12: memos.api.v1.UpdateSpaceMemberRequest
+	(*DeleteSpaceMemberRequest)(nil), // 13: memos.api.v1.DeleteSpaceMemberRequest
+	(*timestamppb.Timestamp)(nil),    // 14: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),    // 15: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),            // 16: google.protobuf.Empty
+}
+var file_api_v1_space_service_proto_depIdxs = []int32{
+	14, // 0: memos.api.v1.Space.create_time:type_name -> google.protobuf.Timestamp
+	14, // 1: memos.api.v1.Space.update_time:type_name -> google.protobuf.Timestamp
+	0,  // 2: memos.api.v1.Space.role:type_name -> memos.api.v1.SpaceMember.Role
+	0,  // 3: memos.api.v1.SpaceMember.role:type_name -> memos.api.v1.SpaceMember.Role
+	14, // 4: memos.api.v1.SpaceMember.create_time:type_name -> google.protobuf.Timestamp
+	1,  // 5: memos.api.v1.ListSpacesResponse.spaces:type_name -> memos.api.v1.Space
+	1,  // 6: memos.api.v1.CreateSpaceRequest.space:type_name -> memos.api.v1.Space
+	1,  // 7: memos.api.v1.UpdateSpaceRequest.space:type_name -> memos.api.v1.Space
+	15, // 8: memos.api.v1.UpdateSpaceRequest.update_mask:type_name -> google.protobuf.FieldMask
+	2,  // 9: memos.api.v1.ListSpaceMembersResponse.space_members:type_name -> memos.api.v1.SpaceMember
+	2,  // 10: memos.api.v1.CreateSpaceMemberRequest.space_member:type_name -> memos.api.v1.SpaceMember
+	2,  // 11: memos.api.v1.UpdateSpaceMemberRequest.space_member:type_name -> memos.api.v1.SpaceMember
+	15, // 12: memos.api.v1.UpdateSpaceMemberRequest.update_mask:type_name -> google.protobuf.FieldMask
+	3,  // 13: memos.api.v1.SpaceService.ListSpaces:input_type -> memos.api.v1.ListSpacesRequest
+	5,  // 14: memos.api.v1.SpaceService.GetSpace:input_type -> memos.api.v1.GetSpaceRequest
+	6,  // 15: memos.api.v1.SpaceService.CreateSpace:input_type -> memos.api.v1.CreateSpaceRequest
+	7,  // 16: memos.api.v1.SpaceService.UpdateSpace:input_type -> memos.api.v1.UpdateSpaceRequest
+	8,  // 17: memos.api.v1.SpaceService.DeleteSpace:input_type -> memos.api.v1.DeleteSpaceRequest
+	9,  // 18: memos.api.v1.SpaceService.ListSpaceMembers:input_type -> memos.api.v1.ListSpaceMembersRequest
+	11, // 19: memos.api.v1.SpaceService.CreateSpaceMember:input_type -> memos.api.v1.CreateSpaceMemberRequest
+	12, // 20: memos.api.v1.SpaceService.UpdateSpaceMember:input_type -> memos.api.v1.UpdateSpaceMemberRequest
+	13, // 21: memos.api.v1.SpaceService.DeleteSpaceMember:input_type -> memos.api.v1.DeleteSpaceMemberRequest
+	4,  // 22: memos.api.v1.SpaceService.ListSpaces:output_type -> memos.api.v1.ListSpacesResponse
+	1,  // 23: memos.api.v1.SpaceService.GetSpace:output_type -> memos.api.v1.Space
+	1,  // 24: memos.api.v1.SpaceService.CreateSpace:output_type -> memos.api.v1.Space
+	1,  // 25: memos.api.v1.SpaceService.UpdateSpace:output_type -> memos.api.v1.Space
+	16, // 26: memos.api.v1.SpaceService.DeleteSpace:output_type -> google.protobuf.Empty
+	10, // 27: memos.api.v1.SpaceService.ListSpaceMembers:output_type -> memos.api.v1.ListSpaceMembersResponse
+	2,  // 28: memos.api.v1.SpaceService.CreateSpaceMember:output_type -> memos.api.v1.SpaceMember
+	2,  // 29: memos.api.v1.SpaceService.UpdateSpaceMember:output_type -> memos.api.v1.SpaceMember
+	16, // 30: memos.api.v1.SpaceService.DeleteSpaceMember:output_type -> google.protobuf.Empty
+	22, // [22:31] is the sub-list for method output_type
+	13, // [13:22] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_space_service_proto_init() }
+func file_api_v1_space_service_proto_init() {
+	if File_api_v1_space_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_space_service_proto_rawDesc), len(file_api_v1_space_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_space_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_space_service_proto_depIdxs,
+		EnumInfos:         file_api_v1_space_service_proto_enumTypes,
+		MessageInfos:      file_api_v1_space_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_space_service_proto = out.File
+	file_api_v1_space_service_proto_goTypes = nil
+	file_api_v1_space_service_proto_depIdxs = nil
+}
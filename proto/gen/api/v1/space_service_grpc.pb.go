@@ -0,0 +1,446 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/v1/space_service.proto
+
+package apiv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SpaceService_ListSpaces_FullMethodName        = "/memos.api.v1.SpaceService/ListSpaces"
+	SpaceService_GetSpace_FullMethodName          = "/memos.api.v1.SpaceService/GetSpace"
+	SpaceService_CreateSpace_FullMethodName       = "/memos.api.v1.SpaceService/CreateSpace"
+	SpaceService_UpdateSpace_FullMethodName       = "/memos.api.v1.SpaceService/UpdateSpace"
+	SpaceService_DeleteSpace_FullMethodName       = "/memos.api.v1.SpaceService/DeleteSpace"
+	SpaceService_ListSpaceMembers_FullMethodName  = "/memos.api.v1.SpaceService/ListSpaceMembers"
+	SpaceService_CreateSpaceMember_FullMethodName = "/memos.api.v1.SpaceService/CreateSpaceMember"
+	SpaceService_UpdateSpaceMember_FullMethodName = "/memos.api.v1.SpaceService/UpdateSpaceMember"
+	SpaceService_DeleteSpaceMember_FullMethodName = "/memos.api.v1.SpaceService/DeleteSpaceMember"
+)
+
+// SpaceServiceClient is the client API for SpaceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SpaceServiceClient interface {
+	// ListSpaces returns the shared spaces the current user is a member of.
+	ListSpaces(ctx context.Context, in *ListSpacesRequest, opts ...grpc.CallOption) (*ListSpacesResponse, error)
+	// GetSpace gets a space by name.
+	GetSpace(ctx context.Context, in *GetSpaceRequest, opts ...grpc.CallOption) (*Space, error)
+	// CreateSpace creates a shared space, owned by the current user.
+	CreateSpace(ctx context.Context, in *CreateSpaceRequest, opts ...grpc.CallOption) (*Space, error)
+	// UpdateSpace updates a space, only allowed to its owners.
+	UpdateSpace(ctx context.Context, in *UpdateSpaceRequest, opts ...grpc.CallOption) (*Space, error)
+	// DeleteSpace deletes an empty space, only allowed to its owners.
+	DeleteSpace(ctx context.Context, in *DeleteSpaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListSpaceMembers returns the members of a space.
+	ListSpaceMembers(ctx context.Context, in *ListSpaceMembersRequest, opts ...grpc.CallOption) (*ListSpaceMembersResponse, error)
+	// CreateSpaceMember adds a user to a space, only allowed to its owners.
+	CreateSpaceMember(ctx context.Context, in *CreateSpaceMemberRequest, opts ...grpc.CallOption) (*SpaceMember, error)
+	// UpdateSpaceMember changes the role of a member, only allowed to the owners of the space.
+	UpdateSpaceMember(ctx context.Context, in *UpdateSpaceMemberRequest, opts ...grpc.CallOption) (*SpaceMember, error)
+	// DeleteSpaceMember removes a member from a space.
+	// The owners remove any member, and the other members can leave the space.
+	DeleteSpaceMember(ctx context.Context, in *DeleteSpaceMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type spaceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSpaceServiceClient(cc grpc.ClientConnInterface) SpaceServiceClient {
+	return &spaceServiceClient{cc}
+}
+
+func (c *spaceServiceClient) ListSpaces(ctx context.Context, in *ListSpacesRequest, opts ...grpc.CallOption) (*ListSpacesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSpacesResponse)
+	err := c.cc.Invoke(ctx, SpaceService_ListSpaces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spaceServiceClient) GetSpace(ctx context.Context, in *GetSpaceRequest, opts ...grpc.CallOption) (*Space, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Space)
+	err := c.cc.Invoke(ctx, SpaceService_GetSpace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spaceServiceClient) CreateSpace(ctx context.Context, in *CreateSpaceRequest, opts ...grpc.CallOption) (*Space, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Space)
+	err := c.cc.Invoke(ctx, SpaceService_CreateSpace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spaceServiceClient) UpdateSpace(ctx context.Context, in *UpdateSpaceRequest, opts ...grpc.CallOption) (*Space, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Space)
+	err := c.cc.Invoke(ctx, SpaceService_UpdateSpace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spaceServiceClient) DeleteSpace(ctx context.Context, in *DeleteSpaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SpaceService_DeleteSpace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spaceServiceClient) ListSpaceMembers(ctx context.Context, in *ListSpaceMembersRequest, opts ...grpc.CallOption) (*ListSpaceMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSpaceMembersResponse)
+	err := c.cc.Invoke(ctx, SpaceService_ListSpaceMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spaceServiceClient) CreateSpaceMember(ctx context.Context, in *CreateSpaceMemberRequest, opts ...grpc.CallOption) (*SpaceMember, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpaceMember)
+	err := c.cc.Invoke(ctx, SpaceService_CreateSpaceMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spaceServiceClient) UpdateSpaceMember(ctx context.Context, in *UpdateSpaceMemberRequest, opts ...grpc.CallOption) (*SpaceMember, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpaceMember)
+	err := c.cc.Invoke(ctx, SpaceService_UpdateSpaceMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spaceServiceClient) DeleteSpaceMember(ctx context.Context, in *DeleteSpaceMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SpaceService_DeleteSpaceMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SpaceServiceServer is the server API for SpaceService service.
+// All implementations must embed UnimplementedSpaceServiceServer
+// for forward compatibility.
+type SpaceServiceServer interface {
+	// ListSpaces returns the shared spaces the current user is a member of.
+	ListSpaces(context.Context, *ListSpacesRequest) (*ListSpacesResponse, error)
+	// GetSpace gets a space by name.
+	GetSpace(context.Context, *GetSpaceRequest) (*Space, error)
+	// CreateSpace creates a shared space, owned by the current user.
+	CreateSpace(context.Context, *CreateSpaceRequest) (*Space, error)
+	// UpdateSpace updates a space, only allowed to its owners.
+	UpdateSpace(context.Context, *UpdateSpaceRequest) (*Space, error)
+	// DeleteSpace deletes an empty space, only allowed to its owners.
+	DeleteSpace(context.Context, *DeleteSpaceRequest) (*emptypb.Empty, error)
+	// ListSpaceMembers returns the members of a space.
+	ListSpaceMembers(context.Context, *ListSpaceMembersRequest) (*ListSpaceMembersResponse, error)
+	// CreateSpaceMember adds a user to a space, only allowed to its owners.
+	CreateSpaceMember(context.Context, *CreateSpaceMemberRequest) (*SpaceMember, error)
+	// UpdateSpaceMember changes the role of a member, only allowed to the owners of the space.
+	UpdateSpaceMember(context.Context, *UpdateSpaceMemberRequest) (*SpaceMember, error)
+	// DeleteSpaceMember removes a member from a space.
+	// The owners remove any member, and the other members can leave the space.
+	DeleteSpaceMember(context.Context, *DeleteSpaceMemberRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedSpaceServiceServer()
+}
+
+// UnimplementedSpaceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSpaceServiceServer struct{}
+
+func (UnimplementedSpaceServiceServer) ListSpaces(context.Context, *ListSpacesRequest) (*ListSpacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSpaces not implemented")
+}
+func (UnimplementedSpaceServiceServer) GetSpace(context.Context, *GetSpaceRequest) (*Space, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpace not implemented")
+}
+func (UnimplementedSpaceServiceServer) CreateSpace(context.Context, *CreateSpaceRequest) (*Space, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSpace not implemented")
+}
+func (UnimplementedSpaceServiceServer) UpdateSpace(context.Context, *UpdateSpaceRequest) (*Space, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSpace not implemented")
+}
+func (UnimplementedSpaceServiceServer) DeleteSpace(context.Context, *DeleteSpaceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSpace not implemented")
+}
+func (UnimplementedSpaceServiceServer) ListSpaceMembers(context.Context, *ListSpaceMembersRequest) (*ListSpaceMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSpaceMembers not implemented")
+}
+func (UnimplementedSpaceServiceServer) CreateSpaceMember(context.Context, *CreateSpaceMemberRequest) (*SpaceMember, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSpaceMember not implemented")
+}
+func (UnimplementedSpaceServiceServer) UpdateSpaceMember(context.Context, *UpdateSpaceMemberRequest) (*SpaceMember, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSpaceMember not implemented")
+}
+func (UnimplementedSpaceServiceServer) DeleteSpaceMember(context.Context, *DeleteSpaceMemberRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSpaceMember not implemented")
+}
+func (UnimplementedSpaceServiceServer) mustEmbedUnimplementedSpaceServiceServer() {}
+func (UnimplementedSpaceServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeSpaceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SpaceServiceServer will
+// result in compilation errors.
+type UnsafeSpaceServiceServer interface {
+	mustEmbedUnimplementedSpaceServiceServer()
+}
+
+func RegisterSpaceServiceServer(s grpc.ServiceRegistrar, srv SpaceServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSpaceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SpaceService_ServiceDesc, srv)
+}
+
+func _SpaceService_ListSpaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSpacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceServiceServer).ListSpaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SpaceService_ListSpaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceServiceServer).ListSpaces(ctx, req.(*ListSpacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpaceService_GetSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceServiceServer).GetSpace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SpaceService_GetSpace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceServiceServer).GetSpace(ctx, req.(*GetSpaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpaceService_CreateSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSpaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceServiceServer).CreateSpace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SpaceService_CreateSpace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceServiceServer).CreateSpace(ctx, req.(*CreateSpaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpaceService_UpdateSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSpaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceServiceServer).UpdateSpace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SpaceService_UpdateSpace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceServiceServer).UpdateSpace(ctx, req.(*UpdateSpaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpaceService_DeleteSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSpaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceServiceServer).DeleteSpace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SpaceService_DeleteSpace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceServiceServer).DeleteSpace(ctx, req.(*DeleteSpaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpaceService_ListSpaceMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSpaceMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceServiceServer).ListSpaceMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SpaceService_ListSpaceMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceServiceServer).ListSpaceMembers(ctx, req.(*ListSpaceMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpaceService_CreateSpaceMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSpaceMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceServiceServer).CreateSpaceMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SpaceService_CreateSpaceMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceServiceServer).CreateSpaceMember(ctx, req.(*CreateSpaceMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpaceService_UpdateSpaceMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSpaceMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceServiceServer).UpdateSpaceMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SpaceService_UpdateSpaceMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceServiceServer).UpdateSpaceMember(ctx, req.(*UpdateSpaceMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpaceService_DeleteSpaceMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSpaceMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceServiceServer).DeleteSpaceMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SpaceService_DeleteSpaceMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceServiceServer).DeleteSpaceMember(ctx, req.(*DeleteSpaceMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SpaceService_ServiceDesc is the grpc.ServiceDesc for SpaceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SpaceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "memos.api.v1.SpaceService",
+	HandlerType: (*SpaceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSpaces",
+			Handler:    _SpaceService_ListSpaces_Handler,
+		},
+		{
+			MethodName: "GetSpace",
+			Handler:    _SpaceService_GetSpace_Handler,
+		},
+		{
+			MethodName: "CreateSpace",
+			Handler:    _SpaceService_CreateSpace_Handler,
+		},
+		{
+			MethodName: "UpdateSpace",
+			Handler:    _SpaceService_UpdateSpace_Handler,
+		},
+		{
+			MethodName: "DeleteSpace",
+			Handler:    _SpaceService_DeleteSpace_Handler,
+		},
+		{
+			MethodName: "ListSpaceMembers",
+			Handler:    _SpaceService_ListSpaceMembers_Handler,
+		},
+		{
+			MethodName: "CreateSpaceMember",
+			Handler:    _SpaceService_CreateSpaceMember_Handler,
+		},
+		{
+			MethodName: "UpdateSpaceMember",
+			Handler:    _SpaceService_UpdateSpaceMember_Handler,
+		},
+		{
+			MethodName: "DeleteSpaceMember",
+			Handler:    _SpaceService_DeleteSpaceMember_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/space_service.proto",
+}
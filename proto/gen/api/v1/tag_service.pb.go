@@ -0,0 +1,2069 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: api/v1/tag_service.proto
+
+package apiv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Tag struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The full path of the tag, e.g. "work/project".
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// The last segment of the tag path, e.g. "project".
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// The number of memos tagged with exactly this tag.
+	MemoCount int32 `protobuf:"varint,3,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
+	// The number of memos tagged with this tag or any of its descendants.
+	TotalMemoCount int32 `protobuf:"varint,4,opt,name=total_memo_count,json=totalMemoCount,proto3" json:"total_memo_count,omitempty"`
+	// The child tags, sorted by path.
+	Children []*Tag `protobuf:"bytes,5,rep,name=children,proto3" json:"children,omitempty"`
+	// The metadata of the tag, only set for the owner of the tags.
+	Metadata      *TagMetadata `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Tag) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Tag) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Tag) GetMemoCount() int32 {
+	if x != nil {
+		return x.MemoCount
+	}
+	return 0
+}
+
+func (x *Tag) GetTotalMemoCount() int32 {
+	if x != nil {
+		return x.TotalMemoCount
+	}
+	return 0
+}
+
+func (x *Tag) GetChildren() []*Tag {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *Tag) GetMetadata() *TagMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type TagMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the tag metadata.
+	// Format: users/{user}/tagMetadata/{tag}, e.g. users/1/tagMetadata/work/project
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The tag path, e.g. "work/project".
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Optional. A description of the tag.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Optional. The display color of the tag in hex format, e.g. "#3b82f6".
+	Color string `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
+	// Optional. The display icon of the tag, e.g. an emoji.
+	Icon string `protobuf:"bytes,5,opt,name=icon,proto3" json:"icon,omitempty"`
+	// Optional. Whether the tag is pinned.
+	Pinned        bool `protobuf:"varint,6,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagMetadata) Reset() {
+	*x = TagMetadata{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagMetadata) ProtoMessage() {}
+
+func (x *TagMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagMetadata.ProtoReflect.Descriptor instead.
+func (*TagMetadata) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TagMetadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagMetadata) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagMetadata) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TagMetadata) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *TagMetadata) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *TagMetadata) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+type ListTagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The user whose tags are listed.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTagsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListTagsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The root tags, sorted by path.
+	Tags          []*Tag `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListTagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type TagStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The full path of the tag, e.g. "work/project".
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// The number of memos tagged with exactly this tag.
+	MemoCount int32 `protobuf:"varint,2,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
+	// The display time of the first memo with the tag.
+	FirstUsedTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=first_used_time,json=firstUsedTime,proto3" json:"first_used_time,omitempty"`
+	// The display time of the last memo with the tag.
+	LastUsedTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_used_time,json=lastUsedTime,proto3" json:"last_used_time,omitempty"`
+	// The number of memos per month, sorted by month. Months without memos are omitted.
+	MonthlyCounts []*TagStats_MonthlyCount `protobuf:"bytes,5,rep,name=monthly_counts,json=monthlyCounts,proto3" json:"monthly_counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagStats) Reset() {
+	*x = TagStats{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagStats) ProtoMessage() {}
+
+func (x *TagStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagStats.ProtoReflect.Descriptor instead.
+func (*TagStats) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TagStats) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagStats) GetMemoCount() int32 {
+	if x != nil {
+		return x.MemoCount
+	}
+	return 0
+}
+
+func (x *TagStats) GetFirstUsedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstUsedTime
+	}
+	return nil
+}
+
+func (x *TagStats) GetLastUsedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedTime
+	}
+	return nil
+}
+
+func (x *TagStats) GetMonthlyCounts() []*TagStats_MonthlyCount {
+	if x != nil {
+		return x.MonthlyCounts
+	}
+	return nil
+}
+
+type ListTagStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The user whose tag statistics are listed.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Optional. The IANA time zone used to group memos by month, e.g. "Europe/Berlin".
+	// Defaults to UTC.
+	TimeZone      string `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagStatsRequest) Reset() {
+	*x = ListTagStatsRequest{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagStatsRequest) ProtoMessage() {}
+
+func (x *ListTagStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagStatsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListTagStatsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListTagStatsRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type ListTagStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The statistics of each tag, sorted by tag.
+	TagStats      []*TagStats `protobuf:"bytes,1,rep,name=tag_stats,json=tagStats,proto3" json:"tag_stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagStatsResponse) Reset() {
+	*x = ListTagStatsResponse{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagStatsResponse) ProtoMessage() {}
+
+func (x *ListTagStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagStatsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagStatsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListTagStatsResponse) GetTagStats() []*TagStats {
+	if x != nil {
+		return x.TagStats
+	}
+	return nil
+}
+
+type RenameTagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The user whose tags are renamed.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The tag to rename, e.g. "work".
+	OldTag string `protobuf:"bytes,2,opt,name=old_tag,json=oldTag,proto3" json:"old_tag,omitempty"`
+	// Required. The new tag, e.g. "job".
+	NewTag string `protobuf:"bytes,3,opt,name=new_tag,json=newTag,proto3" json:"new_tag,omitempty"`
+	// Optional. If set, only count the affected memos without renaming the tag.
+	ValidateOnly  bool `protobuf:"varint,4,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagRequest) Reset() {
+	*x = RenameTagRequest{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagRequest) ProtoMessage() {}
+
+func (x *RenameTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameTagRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RenameTagRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *RenameTagRequest) GetOldTag() string {
+	if x != nil {
+		return x.OldTag
+	}
+	return ""
+}
+
+func (x *RenameTagRequest) GetNewTag() string {
+	if x != nil {
+		return x.NewTag
+	}
+	return ""
+}
+
+func (x *RenameTagRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type RenameTagResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of memos that are (or would be) updated.
+	AffectedMemoCount int32 `protobuf:"varint,1,opt,name=affected_memo_count,json=affectedMemoCount,proto3" json:"affected_memo_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RenameTagResponse) Reset() {
+	*x = RenameTagResponse{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagResponse) ProtoMessage() {}
+
+func (x *RenameTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagResponse.ProtoReflect.Descriptor instead.
+func (*RenameTagResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RenameTagResponse) GetAffectedMemoCount() int32 {
+	if x != nil {
+		return x.AffectedMemoCount
+	}
+	return 0
+}
+
+type MergeTagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The user whose tags are merged.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The tags to merge, e.g. ["js", "JavaScript"].
+	SourceTags []string `protobuf:"bytes,2,rep,name=source_tags,json=sourceTags,proto3" json:"source_tags,omitempty"`
+	// Required. The tag to merge into, e.g. "javascript".
+	TargetTag string `protobuf:"bytes,3,opt,name=target_tag,json=targetTag,proto3" json:"target_tag,omitempty"`
+	// Optional. If set, only count the affected memos without merging the tags.
+	ValidateOnly  bool `protobuf:"varint,4,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeTagsRequest) Reset() {
+	*x = MergeTagsRequest{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTagsRequest) ProtoMessage() {}
+
+func (x *MergeTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTagsRequest.ProtoReflect.Descriptor instead.
+func (*MergeTagsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MergeTagsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *MergeTagsRequest) GetSourceTags() []string {
+	if x != nil {
+		return x.SourceTags
+	}
+	return nil
+}
+
+func (x *MergeTagsRequest) GetTargetTag() string {
+	if x != nil {
+		return x.TargetTag
+	}
+	return ""
+}
+
+func (x *MergeTagsRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+type MergeTagsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of memos that are (or would be) updated.
+	AffectedMemoCount int32 `protobuf:"varint,1,opt,name=affected_memo_count,json=affectedMemoCount,proto3" json:"affected_memo_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MergeTagsResponse) Reset() {
+	*x = MergeTagsResponse{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTagsResponse) ProtoMessage() {}
+
+func (x *MergeTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTagsResponse.ProtoReflect.Descriptor instead.
+func (*MergeTagsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MergeTagsResponse) GetAffectedMemoCount() int32 {
+	if x != nil {
+		return x.AffectedMemoCount
+	}
+	return 0
+}
+
+type CheckTagConsistencyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The user whose memos are checked.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Optional. If set, the stored tags are rebuilt from the content.
+	Repair        bool `protobuf:"varint,2,opt,name=repair,proto3" json:"repair,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckTagConsistencyRequest) Reset() {
+	*x = CheckTagConsistencyRequest{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckTagConsistencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckTagConsistencyRequest) ProtoMessage() {}
+
+func (x *CheckTagConsistencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckTagConsistencyRequest.ProtoReflect.Descriptor instead.
+func (*CheckTagConsistencyRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CheckTagConsistencyRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CheckTagConsistencyRequest) GetRepair() bool {
+	if x != nil {
+		return x.Repair
+	}
+	return false
+}
+
+type CheckTagConsistencyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The memos with inconsistent tags.
+	Inconsistencies []*CheckTagConsistencyResponse_Inconsistency `protobuf:"bytes,1,rep,name=inconsistencies,proto3" json:"inconsistencies,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CheckTagConsistencyResponse) Reset() {
+	*x = CheckTagConsistencyResponse{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckTagConsistencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckTagConsistencyResponse) ProtoMessage() {}
+
+func (x *CheckTagConsistencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckTagConsistencyResponse.ProtoReflect.Descriptor instead.
+func (*CheckTagConsistencyResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CheckTagConsistencyResponse) GetInconsistencies() []*CheckTagConsistencyResponse_Inconsistency {
+	if x != nil {
+		return x.Inconsistencies
+	}
+	return nil
+}
+
+type SuggestTagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The user whose memos are used to suggest tags.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The memo content to suggest tags for.
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// Optional. The maximum number of suggestions to return.
+	// Defaults to 5, the maximum is 20.
+	PageSize      int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestTagsRequest) Reset() {
+	*x = SuggestTagsRequest{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestTagsRequest) ProtoMessage() {}
+
+func (x *SuggestTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestTagsRequest.ProtoReflect.Descriptor instead.
+func (*SuggestTagsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SuggestTagsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *SuggestTagsRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *SuggestTagsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type SuggestTagsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The suggestions, sorted by descending score.
+	// Tags the content already has are never suggested.
+	Suggestions   []*SuggestTagsResponse_Suggestion `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestTagsResponse) Reset() {
+	*x = SuggestTagsResponse{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestTagsResponse) ProtoMessage() {}
+
+func (x *SuggestTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestTagsResponse.ProtoReflect.Descriptor instead.
+func (*SuggestTagsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SuggestTagsResponse) GetSuggestions() []*SuggestTagsResponse_Suggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type ListTagMetadataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The user whose tag metadata is listed.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagMetadataRequest) Reset() {
+	*x = ListTagMetadataRequest{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagMetadataRequest) ProtoMessage() {}
+
+func (x *ListTagMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagMetadataRequest.ProtoReflect.Descriptor instead.
+func (*ListTagMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListTagMetadataRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListTagMetadataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The tag metadata, sorted by tag.
+	TagMetadata   []*TagMetadata `protobuf:"bytes,1,rep,name=tag_metadata,json=tagMetadata,proto3" json:"tag_metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagMetadataResponse) Reset() {
+	*x = ListTagMetadataResponse{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagMetadataResponse) ProtoMessage() {}
+
+func (x *ListTagMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagMetadataResponse.ProtoReflect.Descriptor instead.
+func (*ListTagMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListTagMetadataResponse) GetTagMetadata() []*TagMetadata {
+	if x != nil {
+		return x.TagMetadata
+	}
+	return nil
+}
+
+type GetTagMetadataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the tag metadata.
+	// Format: users/{user}/tagMetadata/{tag}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTagMetadataRequest) Reset() {
+	*x = GetTagMetadataRequest{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTagMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagMetadataRequest) ProtoMessage() {}
+
+func (x *GetTagMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagMetadataRequest.ProtoReflect.Descriptor instead.
+func (*GetTagMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetTagMetadataRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateTagMetadataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The user who owns the tag.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The tag metadata to create.
+	TagMetadata   *TagMetadata `protobuf:"bytes,2,opt,name=tag_metadata,json=tagMetadata,proto3" json:"tag_metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTagMetadataRequest) Reset() {
+	*x = CreateTagMetadataRequest{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTagMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagMetadataRequest) ProtoMessage() {}
+
+func (x *CreateTagMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagMetadataRequest.ProtoReflect.Descriptor instead.
+func (*CreateTagMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateTagMetadataRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateTagMetadataRequest) GetTagMetadata() *TagMetadata {
+	if x != nil {
+		return x.TagMetadata
+	}
+	return nil
+}
+
+type UpdateTagMetadataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The tag metadata to update.
+	TagMetadata *TagMetadata `protobuf:"bytes,1,opt,name=tag_metadata,json=tagMetadata,proto3" json:"tag_metadata,omitempty"`
+	// Required. The list of fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTagMetadataRequest) Reset() {
+	*x = UpdateTagMetadataRequest{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTagMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTagMetadataRequest) ProtoMessage() {}
+
+func (x *UpdateTagMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTagMetadataRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTagMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateTagMetadataRequest) GetTagMetadata() *TagMetadata {
+	if x != nil {
+		return x.TagMetadata
+	}
+	return nil
+}
+
+func (x *UpdateTagMetadataRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteTagMetadataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the tag metadata to delete.
+	// Format: users/{user}/tagMetadata/{tag}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagMetadataRequest) Reset() {
+	*x = DeleteTagMetadataRequest{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagMetadataRequest) ProtoMessage() {}
+
+func (x *DeleteTagMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagMetadataRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTagMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteTagMetadataRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type TagShare struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the tag share.
+	// Format: users/{user}/tagShares/{tag_share}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The shared tag, its descendants are shared as well.
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Optional. The user the tag is shared with. If empty, the tag is shared via a link.
+	// Format: users/{user}
+	Grantee string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// The secret token of link shares.
+	Token string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	// The creation timestamp.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagShare) Reset() {
+	*x = TagShare{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagShare) ProtoMessage() {}
+
+func (x *TagShare) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagShare.ProtoReflect.Descriptor instead.
+func (*TagShare) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TagShare) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagShare) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagShare) GetGrantee() string {
+	if x != nil {
+		return x.Grantee
+	}
+	return ""
+}
+
+func (x *TagShare) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *TagShare) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ListTagSharesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The user whose tag shares are listed.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagSharesRequest) Reset() {
+	*x = ListTagSharesRequest{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagSharesRequest) ProtoMessage() {}
+
+func (x *ListTagSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListTagSharesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListTagSharesRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListTagSharesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of tag shares.
+	TagShares     []*TagShare `protobuf:"bytes,1,rep,name=tag_shares,json=tagShares,proto3" json:"tag_shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagSharesResponse) Reset() {
+	*x = ListTagSharesResponse{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagSharesResponse) ProtoMessage() {}
+
+func (x *ListTagSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagSharesResponse.ProtoReflect.Descriptor instead.
+func (*ListTagSharesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListTagSharesResponse) GetTagShares() []*TagShare {
+	if x != nil {
+		return x.TagShares
+	}
+	return nil
+}
+
+type CreateTagShareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The user who owns the tag.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The tag share to create.
+	TagShare      *TagShare `protobuf:"bytes,2,opt,name=tag_share,json=tagShare,proto3" json:"tag_share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTagShareRequest) Reset() {
+	*x = CreateTagShareRequest{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTagShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagShareRequest) ProtoMessage() {}
+
+func (x *CreateTagShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagShareRequest.ProtoReflect.Descriptor instead.
+func (*CreateTagShareRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateTagShareRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateTagShareRequest) GetTagShare() *TagShare {
+	if x != nil {
+		return x.TagShare
+	}
+	return nil
+}
+
+type DeleteTagShareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the tag share to delete.
+	// Format: users/{user}/tagShares/{tag_share}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagShareRequest) Reset() {
+	*x = DeleteTagShareRequest{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagShareRequest) ProtoMessage() {}
+
+func (x *DeleteTagShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagShareRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTagShareRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteTagShareRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListSharedTagMemosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The token of the tag share link.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Optional. The maximum number of memos to return.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token, received from a previous call.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharedTagMemosRequest) Reset() {
+	*x = ListSharedTagMemosRequest{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharedTagMemosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedTagMemosRequest) ProtoMessage() {}
+
+func (x *ListSharedTagMemosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedTagMemosRequest.ProtoReflect.Descriptor instead.
+func (*ListSharedTagMemosRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListSharedTagMemosRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ListSharedTagMemosRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSharedTagMemosRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListSharedTagMemosResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The shared memos.
+	Memos []*Memo `protobuf:"bytes,1,rep,name=memos,proto3" json:"memos,omitempty"`
+	// A token to retrieve the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharedTagMemosResponse) Reset() {
+	*x = ListSharedTagMemosResponse{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharedTagMemosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedTagMemosResponse) ProtoMessage() {}
+
+func (x *ListSharedTagMemosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedTagMemosResponse.ProtoReflect.Descriptor instead.
+func (*ListSharedTagMemosResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListSharedTagMemosResponse) GetMemos() []*Memo {
+	if x != nil {
+		return x.Memos
+	}
+	return nil
+}
+
+func (x *ListSharedTagMemosResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type TagStats_MonthlyCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The month in the format "YYYY-MM", e.g. "2025-01".
+	Month string `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
+	// The number of memos tagged in the month.
+	MemoCount     int32 `protobuf:"varint,2,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagStats_MonthlyCount) Reset() {
+	*x = TagStats_MonthlyCount{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagStats_MonthlyCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagStats_MonthlyCount) ProtoMessage() {}
+
+func (x *TagStats_MonthlyCount) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagStats_MonthlyCount.ProtoReflect.Descriptor instead.
+func (*TagStats_MonthlyCount) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *TagStats_MonthlyCount) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *TagStats_MonthlyCount) GetMemoCount() int32 {
+	if x != nil {
+		return x.MemoCount
+	}
+	return 0
+}
+
+type CheckTagConsistencyResponse_Inconsistency struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the memo.
+	// Format: memos/{memo}
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// The tags stored for the memo that are no longer in its content.
+	OrphanedTags []string `protobuf:"bytes,2,rep,name=orphaned_tags,json=orphanedTags,proto3" json:"orphaned_tags,omitempty"`
+	// The tags in the content of the memo that are not stored.
+	MissingTags []string `protobuf:"bytes,3,rep,name=missing_tags,json=missingTags,proto3" json:"missing_tags,omitempty"`
+	// Whether the stored tags were rebuilt.
+	Repaired      bool `protobuf:"varint,4,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckTagConsistencyResponse_Inconsistency) Reset() {
+	*x = CheckTagConsistencyResponse_Inconsistency{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckTagConsistencyResponse_Inconsistency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckTagConsistencyResponse_Inconsistency) ProtoMessage() {}
+
+func (x *CheckTagConsistencyResponse_Inconsistency) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckTagConsistencyResponse_Inconsistency.ProtoReflect.Descriptor instead.
+func (*CheckTagConsistencyResponse_Inconsistency) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *CheckTagConsistencyResponse_Inconsistency) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *CheckTagConsistencyResponse_Inconsistency) GetOrphanedTags() []string {
+	if x != nil {
+		return x.OrphanedTags
+	}
+	return nil
+}
+
+func (x *CheckTagConsistencyResponse_Inconsistency) GetMissingTags() []string {
+	if x != nil {
+		return x.MissingTags
+	}
+	return nil
+}
+
+func (x *CheckTagConsistencyResponse_Inconsistency) GetRepaired() bool {
+	if x != nil {
+		return x.Repaired
+	}
+	return false
+}
+
+type SuggestTagsResponse_Suggestion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The suggested tag, e.g. "work/project".
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// The similarity score between 0 and 1.
+	Score         float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuggestTagsResponse_Suggestion) Reset() {
+	*x = SuggestTagsResponse_Suggestion{}
+	mi := &file_api_v1_tag_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestTagsResponse_Suggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestTagsResponse_Suggestion) ProtoMessage() {}
+
+func (x *SuggestTagsResponse_Suggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_tag_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestTagsResponse_Suggestion.ProtoReflect.Descriptor instead.
+func (*SuggestTagsResponse_Suggestion) Descriptor() ([]byte, []int) {
+	return file_api_v1_tag_service_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *SuggestTagsResponse_Suggestion) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *SuggestTagsResponse_Suggestion) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+var File_api_v1_tag_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_tag_service_proto_rawDesc = "" +
+	"\n" +
+	"\x18api/v1/tag_service.proto\x12\fmemos.api.v1\x1a\x19api/v1/memo_service.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x89\x02\n" +
+	"\x03Tag\x12\x17\n" +
+	"\x04path\x18\x01 \x01(\tB\x03\xe0A\x03R\x04path\x12&\n" +
+	"\fdisplay_name\x18\x02 \x01(\tB\x03\xe0A\x03R\vdisplayName\x12\"\n" +
+	"\n" +
+	"memo_count\x18\x03 \x01(\x05B\x03\xe0A\x03R\tmemoCount\x12-\n" +
+	"\x10total_memo_count\x18\x04 \x01(\x05B\x03\xe0A\x03R\x0etotalMemoCount\x122\n" +
+	"\bchildren\x18\x05 \x03(\v2\x11.memos.api.v1.TagB\x03\xe0A\x03R\bchildren\x12:\n" +
+	"\bmetadata\x18\x06 \x01(\v2\x19.memos.api.v1.TagMetadataB\x03\xe0A\x03R\bmetadata\"\x97\x02\n" +
+	"\vTagMetadata\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x15\n" +
+	"\x03tag\x18\x02 \x01(\tB\x03\xe0A\x02R\x03tag\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tB\x03\xe0A\x01R\vdescription\x12\x19\n" +
+	"\x05color\x18\x04 \x01(\tB\x03\xe0A\x01R\x05color\x12\x17\n" +
+	"\x04icon\x18\x05 \x01(\tB\x03\xe0A\x01R\x04icon\x12\x1b\n" +
+	"\x06pinned\x18\x06 \x01(\bB\x03\xe0A\x01R\x06pinned:`\xeaA]\n" +
+	"\x18memos.api.v1/TagMetadata\x12'users/{user}/tagMetadata/{tag_metadata}*\vtagMetadata2\vtagMetadata\"D\n" +
+	"\x0fListTagsRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\"9\n" +
+	"\x10ListTagsResponse\x12%\n" +
+	"\x04tags\x18\x01 \x03(\v2\x11.memos.api.v1.TagR\x04tags\"\xd2\x02\n" +
+	"\bTagStats\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x1d\n" +
+	"\n" +
+	"memo_count\x18\x02 \x01(\x05R\tmemoCount\x12B\n" +
+	"\x0ffirst_used_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rfirstUsedTime\x12@\n" +
+	"\x0elast_used_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\flastUsedTime\x12J\n" +
+	"\x0emonthly_counts\x18\x05 \x03(\v2#.memos.api.v1.TagStats.MonthlyCountR\rmonthlyCounts\x1aC\n" +
+	"\fMonthlyCount\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\x12\x1d\n" +
+	"\n" +
+	"memo_count\x18\x02 \x01(\x05R\tmemoCount\"j\n" +
+	"\x13ListTagStatsRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\x12 \n" +
+	"\ttime_zone\x18\x02 \x01(\tB\x03\xe0A\x01R\btimeZone\"K\n" +
+	"\x14ListTagStatsResponse\x123\n" +
+	"\ttag_stats\x18\x01 \x03(\v2\x16.memos.api.v1.TagStatsR\btagStats\"\xab\x01\n" +
+	"\x10RenameTagRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\x12\x1c\n" +
+	"\aold_tag\x18\x02 \x01(\tB\x03\xe0A\x02R\x06oldTag\x12\x1c\n" +
+	"\anew_tag\x18\x03 \x01(\tB\x03\xe0A\x02R\x06newTag\x12(\n" +
+	"\rvalidate_only\x18\x04 \x01(\bB\x03\xe0A\x01R\fvalidateOnly\"C\n" +
+	"\x11RenameTagResponse\x12.\n" +
+	"\x13affected_memo_count\x18\x01 \x01(\x05R\x11affectedMemoCount\"\xb9\x01\n" +
+	"\x10MergeTagsRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\x12$\n" +
+	"\vsource_tags\x18\x02 \x03(\tB\x03\xe0A\x02R\n" +
+	"sourceTags\x12\"\n" +
+	"\n" +
+	"target_tag\x18\x03 \x01(\tB\x03\xe0A\x02R\ttargetTag\x12(\n" +
+	"\rvalidate_only\x18\x04 \x01(\bB\x03\xe0A\x01R\fvalidateOnly\"C\n" +
+	"\x11MergeTagsResponse\x12.\n" +
+	"\x13affected_memo_count\x18\x01 \x01(\x05R\x11affectedMemoCount\"l\n" +
+	"\x1aCheckTagConsistencyRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\x12\x1b\n" +
+	"\x06repair\x18\x02 \x01(\bB\x03\xe0A\x01R\x06repair\"\x8a\x02\n" +
+	"\x1bCheckTagConsistencyResponse\x12a\n" +
+	"\x0finconsistencies\x18\x01 \x03(\v27.memos.api.v1.CheckTagConsistencyResponse.InconsistencyR\x0finconsistencies\x1a\x87\x01\n" +
+	"\rInconsistency\x12\x12\n" +
+	"\x04memo\x18\x01 \x01(\tR\x04memo\x12#\n" +
+	"\rorphaned_tags\x18\x02 \x03(\tR\forphanedTags\x12!\n" +
+	"\fmissing_tags\x18\x03 \x03(\tR\vmissingTags\x12\x1a\n" +
+	"\brepaired\x18\x04 \x01(\bR\brepaired\"\x88\x01\n" +
+	"\x12SuggestTagsRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\x12\x1d\n" +
+	"\acontent\x18\x02 \x01(\tB\x03\xe0A\x02R\acontent\x12 \n" +
+	"\tpage_size\x18\x03 \x01(\x05B\x03\xe0A\x01R\bpageSize\"\x9b\x01\n" +
+	"\x13SuggestTagsResponse\x12N\n" +
+	"\vsuggestions\x18\x01 \x03(\v2,.memos.api.v1.SuggestTagsResponse.SuggestionR\vsuggestions\x1a4\n" +
+	"\n" +
+	"Suggestion\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\"R\n" +
+	"\x16ListTagMetadataRequest\x128\n" +
+	"\x06parent\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\x12\x18memos.api.v1/TagMetadataR\x06parent\"W\n" +
+	"\x17ListTagMetadataResponse\x12<\n" +
+	"\ftag_metadata\x18\x01 \x03(\v2\x19.memos.api.v1.TagMetadataR\vtagMetadata\"M\n" +
+	"\x15GetTagMetadataRequest\x124\n" +
+	"\x04name\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\n" +
+	"\x18memos.api.v1/TagMetadataR\x04name\"\x97\x01\n" +
+	"\x18CreateTagMetadataRequest\x128\n" +
+	"\x06parent\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\x12\x18memos.api.v1/TagMetadataR\x06parent\x12A\n" +
+	"\ftag_metadata\x18\x02 \x01(\v2\x19.memos.api.v1.TagMetadataB\x03\xe0A\x02R\vtagMetadata\"\x9f\x01\n" +
+	"\x18UpdateTagMetadataRequest\x12A\n" +
+	"\ftag_metadata\x18\x01 \x01(\v2\x19.memos.api.v1.TagMetadataB\x03\xe0A\x02R\vtagMetadata\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
+	"updateMask\"P\n" +
+	"\x18DeleteTagMetadataRequest\x124\n" +
+	"\x04name\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\n" +
+	"\x18memos.api.v1/TagMetadataR\x04name\"\xa1\x02\n" +
+	"\bTagShare\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x15\n" +
+	"\x03tag\x18\x02 \x01(\tB\x03\xe0A\x02R\x03tag\x123\n" +
+	"\agrantee\x18\x03 \x01(\tB\x19\xe0A\x01\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\agrantee\x12\x19\n" +
+	"\x05token\x18\x04 \x01(\tB\x03\xe0A\x03R\x05token\x12@\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime:S\xeaAP\n" +
+	"\x15memos.api.v1/TagShare\x12\"users/{user}/tagShares/{tag_share}*\ttagShares2\btagShare\"M\n" +
+	"\x14ListTagSharesRequest\x125\n" +
+	"\x06parent\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\x12\x15memos.api.v1/TagShareR\x06parent\"N\n" +
+	"\x15ListTagSharesResponse\x125\n" +
+	"\n" +
+	"tag_shares\x18\x01 \x03(\v2\x16.memos.api.v1.TagShareR\ttagShares\"\x88\x01\n" +
+	"\x15CreateTagShareRequest\x125\n" +
+	"\x06parent\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\x12\x15memos.api.v1/TagShareR\x06parent\x128\n" +
+	"\ttag_share\x18\x02 \x01(\v2\x16.memos.api.v1.TagShareB\x03\xe0A\x02R\btagShare\"J\n" +
+	"\x15DeleteTagShareRequest\x121\n" +
+	"\x04name\x18\x01 \x01(\tB\x1d\xe0A\x02\xfaA\x17\n" +
+	"\x15memos.api.v1/TagShareR\x04name\"|\n" +
+	"\x19ListSharedTagMemosRequest\x12\x19\n" +
+	"\x05token\x18\x01 \x01(\tB\x03\xe0A\x02R\x05token\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\x03\xe0A\x01R\tpageToken\"n\n" +
+	"\x1aListSharedTagMemosResponse\x12(\n" +
+	"\x05memos\x18\x01 \x03(\v2\x12.memos.api.v1.MemoR\x05memos\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken2\xf2\x11\n" +
+	"\n" +
+	"TagService\x12y\n" +
+	"\bListTags\x12\x1d.memos.api.v1.ListTagsRequest\x1a\x1e.memos.api.v1.ListTagsResponse\".\xdaA\x06parent\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/{parent=users/*}/tags\x12\x89\x01\n" +
+	"\fListTagStats\x12!.memos.api.v1.ListTagStatsRequest\x1a\".memos.api.v1.ListTagStatsResponse\"2\xdaA\x06parent\x82\xd3\xe4\x93\x02#\x12!/api/v1/{parent=users/*}/tagStats\x12\x96\x01\n" +
+	"\tRenameTag\x12\x1e.memos.api.v1.RenameTagRequest\x1a\x1f.memos.api.v1.RenameTagResponse\"H\xdaA\x16parent,old_tag,new_tag\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/{parent=users/*}/tags:rename\x12\x9c\x01\n" +
+	"\tMergeTags\x12\x1e.memos.api.v1.MergeTagsRequest\x1a\x1f.memos.api.v1.MergeTagsResponse\"N\xdaA\x1dparent,source_tags,target_tag\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/{parent=users/*}/tags:merge\x12\xae\x01\n" +
+	"\x13CheckTagConsistency\x12(.memos.api.v1.CheckTagConsistencyRequest\x1a).memos.api.v1.CheckTagConsistencyResponse\"B\xdaA\x06parent\x82\xd3\xe4\x93\x023:\x01*\"./api/v1/{parent=users/*}/tags:checkConsistency\x12\x95\x01\n" +
+	"\vSuggestTags\x12 .memos.api.v1.SuggestTagsRequest\x1a!.memos.api.v1.SuggestTagsResponse\"A\xdaA\x0eparent,content\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/{parent=users/*}/tags:suggest\x12\x95\x01\n" +
+	"\x0fListTagMetadata\x12$.memos.api.v1.ListTagMetadataRequest\x1a%.memos.api.v1.ListTagMetadataResponse\"5\xdaA\x06parent\x82\xd3\xe4\x93\x02&\x12$/api/v1/{parent=users/*}/tagMetadata\x12\x86\x01\n" +
+	"\x0eGetTagMetadata\x12#.memos.api.v1.GetTagMetadataRequest\x1a\x19.memos.api.v1.TagMetadata\"4\xdaA\x04name\x82\xd3\xe4\x93\x02'\x12%/api/v1/{name=users/*/tagMetadata/**}\x12\xa8\x01\n" +
+	"\x11CreateTagMetadata\x12&.memos.api.v1.CreateTagMetadataRequest\x1a\x19.memos.api.v1.TagMetadata\"P\xdaA\x13parent,tag_metadata\x82\xd3\xe4\x93\x024:\ftag_metadata\"$/api/v1/{parent=users/*}/tagMetadata\x12\xbb\x01\n" +
+	"\x11UpdateTagMetadata\x12&.memos.api.v1.UpdateTagMetadataRequest\x1a\x19.memos.api.v1.TagMetadata\"c\xdaA\x18tag_metadata,update_mask\x82\xd3\xe4\x93\x02B:\ftag_metadata22/api/v1/{tag_metadata.name=users/*/tagMetadata/**}\x12\x89\x01\n" +
+	"\x11DeleteTagMetadata\x12&.memos.api.v1.DeleteTagMetadataRequest\x1a\x16.google.protobuf.Empty\"4\xdaA\x04name\x82\xd3\xe4\x93\x02'*%/api/v1/{name=users/*/tagMetadata/**}\x12\x8d\x01\n" +
+	"\rListTagShares\x12\".memos.api.v1.ListTagSharesRequest\x1a#.memos.api.v1.ListTagSharesResponse\"3\xdaA\x06parent\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{parent=users/*}/tagShares\x12\x97\x01\n" +
+	"\x0eCreateTagShare\x12#.memos.api.v1.CreateTagShareRequest\x1a\x16.memos.api.v1.TagShare\"H\xdaA\x10parent,tag_share\x82\xd3\xe4\x93\x02/:\ttag_share\"\"/api/v1/{parent=users/*}/tagShares\x12\x80\x01\n" +
+	"\x0eDeleteTagShare\x12#.memos.api.v1.DeleteTagShareRequest\x1a\x16.google.protobuf.Empty\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$*\"/api/v1/{name=users/*/tagShares/*}\x12\x98\x01\n" +
+	"\x12ListSharedTagMemos\x12'.memos.api.v1.ListSharedTagMemosRequest\x1a(.memos.api.v1.ListSharedTagMemosResponse\"/\xdaA\x05token\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/tagShares/{token}/memosB\xa7\x01\n" +
+	"\x10com.memos.api.v1B\x0fTagServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
+
+var (
+	file_api_v1_tag_service_proto_rawDescOnce sync.Once
+	file_api_v1_tag_service_proto_rawDescData []byte
+)
+
+func file_api_v1_tag_service_proto_rawDescGZIP() []byte {
+	file_api_v1_tag_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_tag_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_tag_service_proto_rawDesc), len(file_api_v1_tag_service_proto_rawDesc)))
+	})
+	return file_api_v1_tag_service_proto_rawDescData
+}
+
+var file_api_v1_tag_service_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_api_v1_tag_service_proto_goTypes = []any{
+	(*Tag)(nil),                                       // 0: memos.api.v1.Tag
+	(*TagMetadata)(nil),                               // 1: memos.api.v1.TagMetadata
+	(*ListTagsRequest)(nil),                           // 2: memos.api.v1.ListTagsRequest
+	(*ListTagsResponse)(nil),                          // 3: memos.api.v1.ListTagsResponse
+	(*TagStats)(nil),                                  // 4: memos.api.v1.TagStats
+	(*ListTagStatsRequest)(nil),                       // 5: memos.api.v1.ListTagStatsRequest
+	(*ListTagStatsResponse)(nil),                      // 6: memos.api.v1.ListTagStatsResponse
+	(*RenameTagRequest)(nil),                          // 7: memos.api.v1.RenameTagRequest
+	(*RenameTagResponse)(nil),                         // 8: memos.api.v1.RenameTagResponse
+	(*MergeTagsRequest)(nil),                          // 9: memos.api.v1.MergeTagsRequest
+	(*MergeTagsResponse)(nil),                         // 10: memos.api.v1.MergeTagsResponse
+	(*CheckTagConsistencyRequest)(nil),                // 11: memos.api.v1.CheckTagConsistencyRequest
+	(*CheckTagConsistencyResponse)(nil),               // 12: memos.api.v1.CheckTagConsistencyResponse
+	(*SuggestTagsRequest)(nil),                        // 13: memos.api.v1.SuggestTagsRequest
+	(*SuggestTagsResponse)(nil),                       // 14: memos.api.v1.SuggestTagsResponse
+	(*ListTagMetadataRequest)(nil),                    // 15: memos.api.v1.ListTagMetadataRequest
+	(*ListTagMetadataResponse)(nil),                   // 16: memos.api.v1.ListTagMetadataResponse
+	(*GetTagMetadataRequest)(nil),                     // 17: memos.api.v1.GetTagMetadataRequest
+	(*CreateTagMetadataRequest)(nil),                  // 18: memos.api.v1.CreateTagMetadataRequest
+	(*UpdateTagMetadataRequest)(nil),                  // 19: memos.api.v1.UpdateTagMetadataRequest
+	(*DeleteTagMetadataRequest)(nil),                  // 20: memos.api.v1.DeleteTagMetadataRequest
+	(*TagShare)(nil),                                  // 21: memos.api.v1.TagShare
+	(*ListTagSharesRequest)(nil),                      // 22: memos.api.v1.ListTagSharesRequest
+	(*ListTagSharesResponse)(nil),                     // 23: memos.api.v1.ListTagSharesResponse
+	(*CreateTagShareRequest)(nil),                     // 24: memos.api.v1.CreateTagShareRequest
+	(*DeleteTagShareRequest)(nil),                     // 25: memos.api.v1.DeleteTagShareRequest
+	(*ListSharedTagMemosRequest)(nil),                 // 26: memos.api.v1.ListSharedTagMemosRequest
+	(*ListSharedTagMemosResponse)(nil),                // 27: memos.api.v1.ListSharedTagMemosResponse
+	(*TagStats_MonthlyCount)(nil),                     // 28: memos.api.v1.TagStats.MonthlyCount
+	(*CheckTagConsistencyResponse_Inconsistency)(nil), // 29: memos.api.v1.CheckTagConsistencyResponse.Inconsistency
+	(*SuggestTagsResponse_Suggestion)(nil),            // 30: memos.api.v1.SuggestTagsResponse.Suggestion
+	(*timestamppb.Timestamp)(nil),                     // 31: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),                     // 32: google.protobuf.FieldMask
+	(*Memo)(nil),                                      // 33: memos.api.v1.Memo
+	(*emptypb.Empty)(nil),                             // 34: google.protobuf.Empty
+}
+var file_api_v1_tag_service_proto_depIdxs = []int32{
+	0,  // 0: memos.api.v1.Tag.children:type_name -> memos.api.v1.Tag
+	1,  // 1: memos.api.v1.Tag.metadata:type_name -> memos.api.v1.TagMetadata
+	0,  // 2: memos.api.v1.ListTagsResponse.tags:type_name -> memos.api.v1.Tag
+	31, // 3: memos.api.v1.TagStats.first_used_time:type_name -> google.protobuf.Timestamp
+	31, // 4: memos.api.v1.TagStats.last_used_time:type_name -> google.protobuf.Timestamp
+	28, // 5: memos.api.v1.TagStats.monthly_counts:type_name -> memos.api.v1.TagStats.MonthlyCount
+	4,  // 6: memos.api.v1.ListTagStatsResponse.tag_stats:type_name -> memos.api.v1.TagStats
+	29, // 7: memos.api.v1.CheckTagConsistencyResponse.inconsistencies:type_name -> memos.api.v1.CheckTagConsistencyResponse.Inconsistency
+	30, // 8: memos.api.v1.SuggestTagsResponse.suggestions:type_name -> memos.api.v1.SuggestTagsResponse.Suggestion
+	1,  // 9: memos.api.v1.ListTagMetadataResponse.tag_metadata:type_name -> memos.api.v1.TagMetadata
+	1,  // 10: memos.api.v1.CreateTagMetadataRequest.tag_metadata:type_name -> memos.api.v1.TagMetadata
+	1,  // 11: memos.api.v1.UpdateTagMetadataRequest.tag_metadata:type_name -> memos.api.v1.TagMetadata
+	32, // 12: memos.api.v1.UpdateTagMetadataRequest.update_mask:type_name -> google.protobuf.FieldMask
+	31, // 13: memos.api.v1.TagShare.create_time:type_name -> google.protobuf.Timestamp
+	21, // 14: memos.api.v1.ListTagSharesResponse.tag_shares:type_name -> memos.api.v1.TagShare
+	21, // 15: memos.api.v1.CreateTagShareRequest.tag_share:type_name -> memos.api.v1.TagShare
+	33, // 16: memos.api.v1.ListSharedTagMemosResponse.memos:type_name -> memos.api.v1.Memo
+	2,  // 17: memos.api.v1.TagService.ListTags:input_type -> memos.api.v1.ListTagsRequest
+	5,  // 18: memos.api.v1.TagService.ListTagStats:input_type -> memos.api.v1.ListTagStatsRequest
+	7,  // 19: memos.api.v1.TagService.RenameTag:input_type -> memos.api.v1.RenameTagRequest
+	9,  // 20: memos.api.v1.TagService.MergeTags:input_type -> memos.api.v1.MergeTagsRequest
+	11, // 21: memos.api.v1.TagService.CheckTagConsistency:input_type -> memos.api.v1.CheckTagConsistencyRequest
+	13, // 22: memos.api.v1.TagService.SuggestTags:input_type -> memos.api.v1.SuggestTagsRequest
+	15, // 23: memos.api.v1.TagService.ListTagMetadata:input_type -> memos.api.v1.ListTagMetadataRequest
+	17, // 24: memos.api.v1.TagService.GetTagMetadata:input_type -> memos.api.v1.GetTagMetadataRequest
+	18, // 25: memos.api.v1.TagService.CreateTagMetadata:input_type -> memos.api.v1.CreateTagMetadataRequest
+	19, // 26: memos.api.v1.TagService.UpdateTagMetadata:input_type -> memos.api.v1.UpdateTagMetadataRequest
+	20, // 27: memos.api.v1.TagService.DeleteTagMetadata:input_type -> memos.api.v1.DeleteTagMetadataRequest
+	22, // 28: memos.api.v1.TagService.ListTagShares:input_type -> memos.api.v1.ListTagSharesRequest
+	24, // 29: memos.api.v1.TagService.CreateTagShare:input_type -> memos.api.v1.CreateTagShareRequest
+	25, // 30: memos.api.v1.TagService.DeleteTagShare:input_type -> memos.api.v1.DeleteTagShareRequest
+	26, // 31: memos.api.v1.TagService.ListSharedTagMemos:input_type -> memos.api.v1.ListSharedTagMemosRequest
+	3,  // 32: memos.api.v1.TagService.ListTags:output_type -> memos.api.v1.ListTagsResponse
+	6,  // 33: memos.api.v1.TagService.ListTagStats:output_type -> memos.api.v1.ListTagStatsResponse
+	8,  // 34: memos.api.v1.TagService.RenameTag:output_type -> memos.api.v1.RenameTagResponse
+	10, // 35: memos.api.v1.TagService.MergeTags:output_type -> memos.api.v1.MergeTagsResponse
+	12, // 36: memos.api.v1.TagService.CheckTagConsistency:output_type -> memos.api.v1.CheckTagConsistencyResponse
+	14, // 37: memos.api.v1.TagService.SuggestTags:output_type -> memos.api.v1.SuggestTagsResponse
+	16, // 38: memos.api.v1.TagService.ListTagMetadata:output_type -> memos.api.v1.ListTagMetadataResponse
+	1,  // 39: memos.api.v1.TagService.GetTagMetadata:output_type -> memos.api.v1.TagMetadata
+	1,  // 40: memos.api.v1.TagService.CreateTagMetadata:output_type -> memos.api.v1.TagMetadata
+	1,  // 41: memos.api.v1.TagService.UpdateTagMetadata:output_type -> memos.api.v1.TagMetadata
+	34, // 42: memos.api.v1.TagService.DeleteTagMetadata:output_type -> google.protobuf.Empty
+	23, // 43: memos.api.v1.TagService.ListTagShares:output_type -> memos.api.v1.ListTagSharesResponse
+	21, // 44: memos.api.v1.TagService.CreateTagShare:output_type -> memos.api.v1.TagShare
+	34, // 45: memos.api.v1.TagService.DeleteTagShare:output_type -> google.protobuf.Empty
+	27, // 46: memos.api.v1.TagService.ListSharedTagMemos:output_type -> memos.api.v1.ListSharedTagMemosResponse
+	32, // [32:47] is the sub-list for method output_type
+	17, // [17:32] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_tag_service_proto_init() }
+func file_api_v1_tag_service_proto_init() {
+	if File_api_v1_tag_service_proto != nil {
+		return
+	}
+	file_api_v1_memo_service_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_tag_service_proto_rawDesc), len(file_api_v1_tag_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   31,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_tag_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_tag_service_proto_depIdxs,
+		MessageInfos:      file_api_v1_tag_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_tag_service_proto = out.File
+	file_api_v1_tag_service_proto_goTypes = nil
+	file_api_v1_tag_service_proto_depIdxs = nil
+}
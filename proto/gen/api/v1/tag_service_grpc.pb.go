@@ -0,0 +1,684 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/v1/tag_service.proto
+
+package apiv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TagService_ListTags_FullMethodName            = "/memos.api.v1.TagService/ListTags"
+	TagService_ListTagStats_FullMethodName        = "/memos.api.v1.TagService/ListTagStats"
+	TagService_RenameTag_FullMethodName           = "/memos.api.v1.TagService/RenameTag"
+	TagService_MergeTags_FullMethodName           = "/memos.api.v1.TagService/MergeTags"
+	TagService_CheckTagConsistency_FullMethodName = "/memos.api.v1.TagService/CheckTagConsistency"
+	TagService_SuggestTags_FullMethodName         = "/memos.api.v1.TagService/SuggestTags"
+	TagService_ListTagMetadata_FullMethodName     = "/memos.api.v1.TagService/ListTagMetadata"
+	TagService_GetTagMetadata_FullMethodName      = "/memos.api.v1.TagService/GetTagMetadata"
+	TagService_CreateTagMetadata_FullMethodName   = "/memos.api.v1.TagService/CreateTagMetadata"
+	TagService_UpdateTagMetadata_FullMethodName   = "/memos.api.v1.TagService/UpdateTagMetadata"
+	TagService_DeleteTagMetadata_FullMethodName   = "/memos.api.v1.TagService/DeleteTagMetadata"
+	TagService_ListTagShares_FullMethodName       = "/memos.api.v1.TagService/ListTagShares"
+	TagService_CreateTagShare_FullMethodName      = "/memos.api.v1.TagService/CreateTagShare"
+	TagService_DeleteTagShare_FullMethodName      = "/memos.api.v1.TagService/DeleteTagShare"
+	TagService_ListSharedTagMemos_FullMethodName  = "/memos.api.v1.TagService/ListSharedTagMemos"
+)
+
+// TagServiceClient is the client API for TagService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TagServiceClient interface {
+	// ListTags returns the tag tree of a user.
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	// ListTagStats returns the usage statistics of the tags of a user over time.
+	ListTagStats(ctx context.Context, in *ListTagStatsRequest, opts ...grpc.CallOption) (*ListTagStatsResponse, error)
+	// RenameTag renames a tag and all of its descendants across the memos of a user.
+	RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error)
+	// MergeTags merges several tags, including their descendants, into a single tag.
+	MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*MergeTagsResponse, error)
+	// CheckTagConsistency lists the memos whose stored tags do not match the tags in their content.
+	CheckTagConsistency(ctx context.Context, in *CheckTagConsistencyRequest, opts ...grpc.CallOption) (*CheckTagConsistencyResponse, error)
+	// SuggestTags suggests tags for a memo content based on the tags of similar memos of the user.
+	SuggestTags(ctx context.Context, in *SuggestTagsRequest, opts ...grpc.CallOption) (*SuggestTagsResponse, error)
+	// ListTagMetadata returns the tag metadata of a user.
+	ListTagMetadata(ctx context.Context, in *ListTagMetadataRequest, opts ...grpc.CallOption) (*ListTagMetadataResponse, error)
+	// GetTagMetadata gets the metadata of a tag.
+	GetTagMetadata(ctx context.Context, in *GetTagMetadataRequest, opts ...grpc.CallOption) (*TagMetadata, error)
+	// CreateTagMetadata creates the metadata of a tag.
+	CreateTagMetadata(ctx context.Context, in *CreateTagMetadataRequest, opts ...grpc.CallOption) (*TagMetadata, error)
+	// UpdateTagMetadata updates the metadata of a tag.
+	UpdateTagMetadata(ctx context.Context, in *UpdateTagMetadataRequest, opts ...grpc.CallOption) (*TagMetadata, error)
+	// DeleteTagMetadata deletes the metadata of a tag.
+	DeleteTagMetadata(ctx context.Context, in *DeleteTagMetadataRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListTagShares returns the tag shares created by a user.
+	ListTagShares(ctx context.Context, in *ListTagSharesRequest, opts ...grpc.CallOption) (*ListTagSharesResponse, error)
+	// CreateTagShare shares all memos carrying a tag with another user or via a link.
+	CreateTagShare(ctx context.Context, in *CreateTagShareRequest, opts ...grpc.CallOption) (*TagShare, error)
+	// DeleteTagShare revokes a tag share.
+	DeleteTagShare(ctx context.Context, in *DeleteTagShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListSharedTagMemos returns the memos shared via a tag share link.
+	ListSharedTagMemos(ctx context.Context, in *ListSharedTagMemosRequest, opts ...grpc.CallOption) (*ListSharedTagMemosResponse, error)
+}
+
+type tagServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTagServiceClient(cc grpc.ClientConnInterface) TagServiceClient {
+	return &tagServiceClient{cc}
+}
+
+func (c *tagServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, TagService_ListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) ListTagStats(ctx context.Context, in *ListTagStatsRequest, opts ...grpc.CallOption) (*ListTagStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagStatsResponse)
+	err := c.cc.Invoke(ctx, TagService_ListTagStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameTagResponse)
+	err := c.cc.Invoke(ctx, TagService_RenameTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*MergeTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeTagsResponse)
+	err := c.cc.Invoke(ctx, TagService_MergeTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) CheckTagConsistency(ctx context.Context, in *CheckTagConsistencyRequest, opts ...grpc.CallOption) (*CheckTagConsistencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckTagConsistencyResponse)
+	err := c.cc.Invoke(ctx, TagService_CheckTagConsistency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) SuggestTags(ctx context.Context, in *SuggestTagsRequest, opts ...grpc.CallOption) (*SuggestTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestTagsResponse)
+	err := c.cc.Invoke(ctx, TagService_SuggestTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) ListTagMetadata(ctx context.Context, in *ListTagMetadataRequest, opts ...grpc.CallOption) (*ListTagMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagMetadataResponse)
+	err := c.cc.Invoke(ctx, TagService_ListTagMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) GetTagMetadata(ctx context.Context, in *GetTagMetadataRequest, opts ...grpc.CallOption) (*TagMetadata, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagMetadata)
+	err := c.cc.Invoke(ctx, TagService_GetTagMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) CreateTagMetadata(ctx context.Context, in *CreateTagMetadataRequest, opts ...grpc.CallOption) (*TagMetadata, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagMetadata)
+	err := c.cc.Invoke(ctx, TagService_CreateTagMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) UpdateTagMetadata(ctx context.Context, in *UpdateTagMetadataRequest, opts ...grpc.CallOption) (*TagMetadata, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagMetadata)
+	err := c.cc.Invoke(ctx, TagService_UpdateTagMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) DeleteTagMetadata(ctx context.Context, in *DeleteTagMetadataRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TagService_DeleteTagMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) ListTagShares(ctx context.Context, in *ListTagSharesRequest, opts ...grpc.CallOption) (*ListTagSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagSharesResponse)
+	err := c.cc.Invoke(ctx, TagService_ListTagShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) CreateTagShare(ctx context.Context, in *CreateTagShareRequest, opts ...grpc.CallOption) (*TagShare, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagShare)
+	err := c.cc.Invoke(ctx, TagService_CreateTagShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) DeleteTagShare(ctx context.Context, in *DeleteTagShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TagService_DeleteTagShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tagServiceClient) ListSharedTagMemos(ctx context.Context, in *ListSharedTagMemosRequest, opts ...grpc.CallOption) (*ListSharedTagMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSharedTagMemosResponse)
+	err := c.cc.Invoke(ctx, TagService_ListSharedTagMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TagServiceServer is the server API for TagService service.
+// All implementations must embed UnimplementedTagServiceServer
+// for forward compatibility.
+type TagServiceServer interface {
+	// ListTags returns the tag tree of a user.
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	// ListTagStats returns the usage statistics of the tags of a user over time.
+	ListTagStats(context.Context, *ListTagStatsRequest) (*ListTagStatsResponse, error)
+	// RenameTag renames a tag and all of its descendants across the memos of a user.
+	RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error)
+	// MergeTags merges several tags, including their descendants, into a single tag.
+	MergeTags(context.Context, *MergeTagsRequest) (*MergeTagsResponse, error)
+	// CheckTagConsistency lists the memos whose stored tags do not match the tags in their content.
+	CheckTagConsistency(context.Context, *CheckTagConsistencyRequest) (*CheckTagConsistencyResponse, error)
+	// SuggestTags suggests tags for a memo content based on the tags of similar memos of the user.
+	SuggestTags(context.Context, *SuggestTagsRequest) (*SuggestTagsResponse, error)
+	// ListTagMetadata returns the tag metadata of a user.
+	ListTagMetadata(context.Context, *ListTagMetadataRequest) (*ListTagMetadataResponse, error)
+	// GetTagMetadata gets the metadata of a tag.
+	GetTagMetadata(context.Context, *GetTagMetadataRequest) (*TagMetadata, error)
+	// CreateTagMetadata creates the metadata of a tag.
+	CreateTagMetadata(context.Context, *CreateTagMetadataRequest) (*TagMetadata, error)
+	// UpdateTagMetadata updates the metadata of a tag.
+	UpdateTagMetadata(context.Context, *UpdateTagMetadataRequest) (*TagMetadata, error)
+	// DeleteTagMetadata deletes the metadata of a tag.
+	DeleteTagMetadata(context.Context, *DeleteTagMetadataRequest) (*emptypb.Empty, error)
+	// ListTagShares returns the tag shares created by a user.
+	ListTagShares(context.Context, *ListTagSharesRequest) (*ListTagSharesResponse, error)
+	// CreateTagShare shares all memos carrying a tag with another user or via a link.
+	CreateTagShare(context.Context, *CreateTagShareRequest) (*TagShare, error)
+	// DeleteTagShare revokes a tag share.
+	DeleteTagShare(context.Context, *DeleteTagShareRequest) (*emptypb.Empty, error)
+	// ListSharedTagMemos returns the memos shared via a tag share link.
+	ListSharedTagMemos(context.Context, *ListSharedTagMemosRequest) (*ListSharedTagMemosResponse, error)
+	mustEmbedUnimplementedTagServiceServer()
+}
+
+// UnimplementedTagServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTagServiceServer struct{}
+
+func (UnimplementedTagServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedTagServiceServer) ListTagStats(context.Context, *ListTagStatsRequest) (*ListTagStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTagStats not implemented")
+}
+func (UnimplementedTagServiceServer) RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameTag not implemented")
+}
+func (UnimplementedTagServiceServer) MergeTags(context.Context, *MergeTagsRequest) (*MergeTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeTags not implemented")
+}
+func (UnimplementedTagServiceServer) CheckTagConsistency(context.Context, *CheckTagConsistencyRequest) (*CheckTagConsistencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckTagConsistency not implemented")
+}
+func (UnimplementedTagServiceServer) SuggestTags(context.Context, *SuggestTagsRequest) (*SuggestTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestTags not implemented")
+}
+func (UnimplementedTagServiceServer) ListTagMetadata(context.Context, *ListTagMetadataRequest) (*ListTagMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTagMetadata not implemented")
+}
+func (UnimplementedTagServiceServer) GetTagMetadata(context.Context, *GetTagMetadataRequest) (*TagMetadata, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTagMetadata not implemented")
+}
+func (UnimplementedTagServiceServer) CreateTagMetadata(context.Context, *CreateTagMetadataRequest) (*TagMetadata, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTagMetadata not implemented")
+}
+func (UnimplementedTagServiceServer) UpdateTagMetadata(context.Context, *UpdateTagMetadataRequest) (*TagMetadata, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTagMetadata not implemented")
+}
+func (UnimplementedTagServiceServer) DeleteTagMetadata(context.Context, *DeleteTagMetadataRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTagMetadata not implemented")
+}
+func (UnimplementedTagServiceServer) ListTagShares(context.Context, *ListTagSharesRequest) (*ListTagSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTagShares not implemented")
+}
+func (UnimplementedTagServiceServer) CreateTagShare(context.Context, *CreateTagShareRequest) (*TagShare, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTagShare not implemented")
+}
+func (UnimplementedTagServiceServer) DeleteTagShare(context.Context, *DeleteTagShareRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTagShare not implemented")
+}
+func (UnimplementedTagServiceServer) ListSharedTagMemos(context.Context, *ListSharedTagMemosRequest) (*ListSharedTagMemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSharedTagMemos not implemented")
+}
+func (UnimplementedTagServiceServer) mustEmbedUnimplementedTagServiceServer() {}
+func (UnimplementedTagServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeTagServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TagServiceServer will
+// result in compilation errors.
+type UnsafeTagServiceServer interface {
+	mustEmbedUnimplementedTagServiceServer()
+}
+
+func RegisterTagServiceServer(s grpc.ServiceRegistrar, srv TagServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTagServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TagService_ServiceDesc, srv)
+}
+
+func _TagService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_ListTagStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).ListTagStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_ListTagStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).ListTagStats(ctx, req.(*ListTagStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_RenameTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).RenameTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_RenameTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).RenameTag(ctx, req.(*RenameTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_MergeTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).MergeTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_MergeTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).MergeTags(ctx, req.(*MergeTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_CheckTagConsistency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckTagConsistencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).CheckTagConsistency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_CheckTagConsistency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).CheckTagConsistency(ctx, req.(*CheckTagConsistencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_SuggestTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).SuggestTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_SuggestTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).SuggestTags(ctx, req.(*SuggestTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_ListTagMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).ListTagMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_ListTagMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).ListTagMetadata(ctx, req.(*ListTagMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_GetTagMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTagMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).GetTagMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_GetTagMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).GetTagMetadata(ctx, req.(*GetTagMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_CreateTagMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTagMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).CreateTagMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_CreateTagMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).CreateTagMetadata(ctx, req.(*CreateTagMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_UpdateTagMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTagMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).UpdateTagMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_UpdateTagMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).UpdateTagMetadata(ctx, req.(*UpdateTagMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_DeleteTagMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).DeleteTagMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_DeleteTagMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).DeleteTagMetadata(ctx, req.(*DeleteTagMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_ListTagShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).ListTagShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_ListTagShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).ListTagShares(ctx, req.(*ListTagSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_CreateTagShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTagShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).CreateTagShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_CreateTagShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).CreateTagShare(ctx, req.(*CreateTagShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_DeleteTagShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).DeleteTagShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_DeleteTagShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).DeleteTagShare(ctx, req.(*DeleteTagShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TagService_ListSharedTagMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSharedTagMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).ListSharedTagMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_ListSharedTagMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).ListSharedTagMemos(ctx, req.(*ListSharedTagMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TagService_ServiceDesc is the grpc.ServiceDesc for TagService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TagService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "memos.api.v1.TagService",
+	HandlerType: (*TagServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTags",
+			Handler:    _TagService_ListTags_Handler,
+		},
+		{
+			MethodName: "ListTagStats",
+			Handler:    _TagService_ListTagStats_Handler,
+		},
+		{
+			MethodName: "RenameTag",
+			Handler:    _TagService_RenameTag_Handler,
+		},
+		{
+			MethodName: "MergeTags",
+			Handler:    _TagService_MergeTags_Handler,
+		},
+		{
+			MethodName: "CheckTagConsistency",
+			Handler:    _TagService_CheckTagConsistency_Handler,
+		},
+		{
+			MethodName: "SuggestTags",
+			Handler:    _TagService_SuggestTags_Handler,
+		},
+		{
+			MethodName: "ListTagMetadata",
+			Handler:    _TagService_ListTagMetadata_Handler,
+		},
+		{
+			MethodName: "GetTagMetadata",
+			Handler:    _TagService_GetTagMetadata_Handler,
+		},
+		{
+			MethodName: "CreateTagMetadata",
+			Handler:    _TagService_CreateTagMetadata_Handler,
+		},
+		{
+			MethodName: "UpdateTagMetadata",
+			Handler:    _TagService_UpdateTagMetadata_Handler,
+		},
+		{
+			MethodName: "DeleteTagMetadata",
+			Handler:    _TagService_DeleteTagMetadata_Handler,
+		},
+		{
+			MethodName: "ListTagShares",
+			Handler:    _TagService_ListTagShares_Handler,
+		},
+		{
+			MethodName: "CreateTagShare",
+			Handler:    _TagService_CreateTagShare_Handler,
+		},
+		{
+			MethodName: "DeleteTagShare",
+			Handler:    _TagService_DeleteTagShare_Handler,
+		},
+		{
+			MethodName: "ListSharedTagMemos",
+			Handler:    _TagService_ListSharedTagMemos_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/tag_service.proto",
+}
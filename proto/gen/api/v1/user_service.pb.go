@@ -83,6 +83,123 @@ func (User_Role) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_user_service_proto_rawDescGZIP(), []int{0, 0}
 }
 
+type UserNotificationPreferences_Event int32
+
+const (
+	UserNotificationPreferences_EVENT_UNSPECIFIED UserNotificationPreferences_Event = 0
+	// A comment on a memo of the user.
+	UserNotificationPreferences_COMMENT UserNotificationPreferences_Event = 1
+	// A reaction to a memo of the user.
+	UserNotificationPreferences_REACTION UserNotificationPreferences_Event = 2
+	// A mention of the user in a memo.
+	UserNotificationPreferences_MENTION UserNotificationPreferences_Event = 3
+	// A reminder set by the user.
+	UserNotificationPreferences_REMINDER UserNotificationPreferences_Event = 4
+	// An announcement of the instance, such as a new version.
+	UserNotificationPreferences_ANNOUNCEMENT UserNotificationPreferences_Event = 5
+	// The memos of the user created on this day in past years.
+	UserNotificationPreferences_ON_THIS_DAY UserNotificationPreferences_Event = 6
+)
+
+// Enum value maps for UserNotificationPreferences_Event.
+var (
+	UserNotificationPreferences_Event_name = map[int32]string{
+		0: "EVENT_UNSPECIFIED",
+		1: "COMMENT",
+		2: "REACTION",
+		3: "MENTION",
+		4: "REMINDER",
+		5: "ANNOUNCEMENT",
+		6: "ON_THIS_DAY",
+	}
+	UserNotificationPreferences_Event_value = map[string]int32{
+		"EVENT_UNSPECIFIED": 0,
+		"COMMENT":           1,
+		"REACTION":          2,
+		"MENTION":           3,
+		"REMINDER":          4,
+		"ANNOUNCEMENT":      5,
+		"ON_THIS_DAY":       6,
+	}
+)
+
+func (x UserNotificationPreferences_Event) Enum() *UserNotificationPreferences_Event {
+	p := new(UserNotificationPreferences_Event)
+	*p = x
+	return p
+}
+
+func (x UserNotificationPreferences_Event) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserNotificationPreferences_Event) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_user_service_proto_enumTypes[1].Descriptor()
+}
+
+func (UserNotificationPreferences_Event) Type() protoreflect.EnumType {
+	return &file_api_v1_user_service_proto_enumTypes[1]
+}
+
+func (x UserNotificationPreferences_Event) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserNotificationPreferences_Event.Descriptor instead.
+func (UserNotificationPreferences_Event) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{61, 0}
+}
+
+type UserEmailDigest_Frequency int32
+
+const (
+	// The digest is disabled.
+	UserEmailDigest_FREQUENCY_UNSPECIFIED UserEmailDigest_Frequency = 0
+	UserEmailDigest_DAILY                 UserEmailDigest_Frequency = 1
+	UserEmailDigest_WEEKLY                UserEmailDigest_Frequency = 2
+)
+
+// Enum value maps for UserEmailDigest_Frequency.
+var (
+	UserEmailDigest_Frequency_name = map[int32]string{
+		0: "FREQUENCY_UNSPECIFIED",
+		1: "DAILY",
+		2: "WEEKLY",
+	}
+	UserEmailDigest_Frequency_value = map[string]int32{
+		"FREQUENCY_UNSPECIFIED": 0,
+		"DAILY":                 1,
+		"WEEKLY":                2,
+	}
+)
+
+func (x UserEmailDigest_Frequency) Enum() *UserEmailDigest_Frequency {
+	p := new(UserEmailDigest_Frequency)
+	*p = x
+	return p
+}
+
+func (x UserEmailDigest_Frequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserEmailDigest_Frequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_user_service_proto_enumTypes[2].Descriptor()
+}
+
+func (UserEmailDigest_Frequency) Type() protoreflect.EnumType {
+	return &file_api_v1_user_service_proto_enumTypes[2]
+}
+
+func (x UserEmailDigest_Frequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserEmailDigest_Frequency.Descriptor instead.
+func (UserEmailDigest_Frequency) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{64, 0}
+}
+
 type User struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The resource name of the user.
@@ -440,7 +557,12 @@ type CreateUserRequest struct {
 	ValidateOnly bool `protobuf:"varint,3,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
 	// Optional. An idempotency token that can be used to ensure that multiple
 	// requests to create a user have the same result.
-	RequestId     string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	RequestId string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Optional. The token of the invitation to sign up with.
+	// It is required to sign up when the user registration is disallowed, and presets the role of the user.
+	InvitationToken string `protobuf:"bytes,5,opt,name=invitation_token,json=invitationToken,proto3" json:"invitation_token,omitempty"`
+	// Optional. The CAPTCHA token, required to sign up if the CAPTCHA setting of the workspace requires it.
+	CaptchaToken  string `protobuf:"bytes,6,opt,name=captcha_token,json=captchaToken,proto3" json:"captcha_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -503,6 +625,20 @@ func (x *CreateUserRequest) GetRequestId() string {
 	return ""
 }
 
+func (x *CreateUserRequest) GetInvitationToken() string {
+	if x != nil {
+		return x.InvitationToken
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetCaptchaToken() string {
+	if x != nil {
+		return x.CaptchaToken
+	}
+	return ""
+}
+
 type UpdateUserRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The user to update.
@@ -621,6 +757,142 @@ func (x *DeleteUserRequest) GetForce() bool {
 	return false
 }
 
+type DeleteUserAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the current user.
+	// Format: users/{user}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The username of the current user, typed again to confirm the deletion.
+	ConfirmUsername string `protobuf:"bytes,2,opt,name=confirm_username,json=confirmUsername,proto3" json:"confirm_username,omitempty"`
+	// The password of the current user, required unless the two-factor authentication is enabled.
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// A TOTP code or an unused recovery code of the current user, required if the two-factor authentication is enabled.
+	Code          string `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserAccountRequest) Reset() {
+	*x = DeleteUserAccountRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserAccountRequest) ProtoMessage() {}
+
+func (x *DeleteUserAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteUserAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteUserAccountRequest) GetConfirmUsername() string {
+	if x != nil {
+		return x.ConfirmUsername
+	}
+	return ""
+}
+
+func (x *DeleteUserAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *DeleteUserAccountRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DeleteUserAccountResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The final export of the user and their memos, in the JSON format of ExportMemos.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Suggested filename for the export.
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Number of memos exported and deleted.
+	MemoCount     int32 `protobuf:"varint,3,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserAccountResponse) Reset() {
+	*x = DeleteUserAccountResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserAccountResponse) ProtoMessage() {}
+
+func (x *DeleteUserAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteUserAccountResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DeleteUserAccountResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *DeleteUserAccountResponse) GetMemoCount() int32 {
+	if x != nil {
+		return x.MemoCount
+	}
+	return 0
+}
+
 type SearchUsersRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The search query.
@@ -635,7 +907,7 @@ type SearchUsersRequest struct {
 
 func (x *SearchUsersRequest) Reset() {
 	*x = SearchUsersRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[7]
+	mi := &file_api_v1_user_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -647,7 +919,7 @@ func (x *SearchUsersRequest) String() string {
 func (*SearchUsersRequest) ProtoMessage() {}
 
 func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[7]
+	mi := &file_api_v1_user_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -660,7 +932,7 @@ func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
 func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{7}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SearchUsersRequest) GetQuery() string {
@@ -698,7 +970,7 @@ type SearchUsersResponse struct {
 
 func (x *SearchUsersResponse) Reset() {
 	*x = SearchUsersResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[8]
+	mi := &file_api_v1_user_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -710,7 +982,7 @@ func (x *SearchUsersResponse) String() string {
 func (*SearchUsersResponse) ProtoMessage() {}
 
 func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[8]
+	mi := &file_api_v1_user_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -723,7 +995,7 @@ func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
 func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{8}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SearchUsersResponse) GetUsers() []*User {
@@ -758,7 +1030,7 @@ type GetUserAvatarRequest struct {
 
 func (x *GetUserAvatarRequest) Reset() {
 	*x = GetUserAvatarRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[9]
+	mi := &file_api_v1_user_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -770,7 +1042,7 @@ func (x *GetUserAvatarRequest) String() string {
 func (*GetUserAvatarRequest) ProtoMessage() {}
 
 func (x *GetUserAvatarRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[9]
+	mi := &file_api_v1_user_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -783,7 +1055,7 @@ func (x *GetUserAvatarRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserAvatarRequest.ProtoReflect.Descriptor instead.
 func (*GetUserAvatarRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{9}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetUserAvatarRequest) GetName() string {
@@ -815,7 +1087,7 @@ type UserStats struct {
 
 func (x *UserStats) Reset() {
 	*x = UserStats{}
-	mi := &file_api_v1_user_service_proto_msgTypes[10]
+	mi := &file_api_v1_user_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -827,7 +1099,7 @@ func (x *UserStats) String() string {
 func (*UserStats) ProtoMessage() {}
 
 func (x *UserStats) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[10]
+	mi := &file_api_v1_user_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -840,7 +1112,7 @@ func (x *UserStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserStats.ProtoReflect.Descriptor instead.
 func (*UserStats) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{10}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UserStats) GetName() string {
@@ -889,14 +1161,18 @@ type GetUserStatsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The resource name of the user.
 	// Format: users/{user}
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The shared space to count the memos of.
+	// If not specified, the memos of the personal space of the user are counted.
+	// Format: spaces/{space}
+	Space         string `protobuf:"bytes,2,opt,name=space,proto3" json:"space,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetUserStatsRequest) Reset() {
 	*x = GetUserStatsRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[11]
+	mi := &file_api_v1_user_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -908,7 +1184,7 @@ func (x *GetUserStatsRequest) String() string {
 func (*GetUserStatsRequest) ProtoMessage() {}
 
 func (x *GetUserStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[11]
+	mi := &file_api_v1_user_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -921,7 +1197,7 @@ func (x *GetUserStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserStatsRequest.ProtoReflect.Descriptor instead.
 func (*GetUserStatsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{11}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetUserStatsRequest) GetName() string {
@@ -931,6 +1207,13 @@ func (x *GetUserStatsRequest) GetName() string {
 	return ""
 }
 
+func (x *GetUserStatsRequest) GetSpace() string {
+	if x != nil {
+		return x.Space
+	}
+	return ""
+}
+
 // User settings message
 type UserSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -946,14 +1229,19 @@ type UserSetting struct {
 	// The preferred theme of the user.
 	// This references a CSS file in the web/public/themes/ directory.
 	// If not set, the default theme will be used.
-	Theme         string `protobuf:"bytes,5,opt,name=theme,proto3" json:"theme,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Theme string `protobuf:"bytes,5,opt,name=theme,proto3" json:"theme,omitempty"`
+	// Whether to strip the EXIF metadata, e.g. the GPS position and camera, of the images uploaded by the user.
+	// The metadata are stripped as well when the workspace storage setting strips them for all users.
+	StripImageMetadata bool `protobuf:"varint,6,opt,name=strip_image_metadata,json=stripImageMetadata,proto3" json:"strip_image_metadata,omitempty"`
+	// Whether to keep the EXIF orientation of the stripped images, so that they are displayed upright.
+	KeepImageOrientation bool `protobuf:"varint,7,opt,name=keep_image_orientation,json=keepImageOrientation,proto3" json:"keep_image_orientation,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *UserSetting) Reset() {
 	*x = UserSetting{}
-	mi := &file_api_v1_user_service_proto_msgTypes[12]
+	mi := &file_api_v1_user_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -965,7 +1253,7 @@ func (x *UserSetting) String() string {
 func (*UserSetting) ProtoMessage() {}
 
 func (x *UserSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[12]
+	mi := &file_api_v1_user_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -978,7 +1266,7 @@ func (x *UserSetting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSetting.ProtoReflect.Descriptor instead.
 func (*UserSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{12}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *UserSetting) GetName() string {
@@ -1016,6 +1304,20 @@ func (x *UserSetting) GetTheme() string {
 	return ""
 }
 
+func (x *UserSetting) GetStripImageMetadata() bool {
+	if x != nil {
+		return x.StripImageMetadata
+	}
+	return false
+}
+
+func (x *UserSetting) GetKeepImageOrientation() bool {
+	if x != nil {
+		return x.KeepImageOrientation
+	}
+	return false
+}
+
 type GetUserSettingRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The resource name of the user.
@@ -1027,7 +1329,7 @@ type GetUserSettingRequest struct {
 
 func (x *GetUserSettingRequest) Reset() {
 	*x = GetUserSettingRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[13]
+	mi := &file_api_v1_user_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1039,7 +1341,7 @@ func (x *GetUserSettingRequest) String() string {
 func (*GetUserSettingRequest) ProtoMessage() {}
 
 func (x *GetUserSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[13]
+	mi := &file_api_v1_user_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1052,7 +1354,7 @@ func (x *GetUserSettingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserSettingRequest.ProtoReflect.Descriptor instead.
 func (*GetUserSettingRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{13}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetUserSettingRequest) GetName() string {
@@ -1074,7 +1376,7 @@ type UpdateUserSettingRequest struct {
 
 func (x *UpdateUserSettingRequest) Reset() {
 	*x = UpdateUserSettingRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[14]
+	mi := &file_api_v1_user_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1086,7 +1388,7 @@ func (x *UpdateUserSettingRequest) String() string {
 func (*UpdateUserSettingRequest) ProtoMessage() {}
 
 func (x *UpdateUserSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[14]
+	mi := &file_api_v1_user_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1099,7 +1401,7 @@ func (x *UpdateUserSettingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserSettingRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserSettingRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{14}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *UpdateUserSettingRequest) GetSetting() *UserSetting {
@@ -1129,14 +1431,22 @@ type UserAccessToken struct {
 	// Output only. The issued timestamp.
 	IssuedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
 	// Optional. The expiration timestamp.
-	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Optional. The maximum number of requests per minute, unlimited if 0.
+	RateLimitPerMinute int32 `protobuf:"varint,6,opt,name=rate_limit_per_minute,json=rateLimitPerMinute,proto3" json:"rate_limit_per_minute,omitempty"`
+	// Output only. The number of requests authenticated with the access token.
+	RequestCount int64 `protobuf:"varint,7,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+	// Output only. The timestamp of the last request authenticated with the access token.
+	LastUsedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	// Output only. The IP address of the last request authenticated with the access token.
+	LastUsedIp    string `protobuf:"bytes,9,opt,name=last_used_ip,json=lastUsedIp,proto3" json:"last_used_ip,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UserAccessToken) Reset() {
 	*x = UserAccessToken{}
-	mi := &file_api_v1_user_service_proto_msgTypes[15]
+	mi := &file_api_v1_user_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1148,7 +1458,7 @@ func (x *UserAccessToken) String() string {
 func (*UserAccessToken) ProtoMessage() {}
 
 func (x *UserAccessToken) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[15]
+	mi := &file_api_v1_user_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1161,7 +1471,7 @@ func (x *UserAccessToken) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserAccessToken.ProtoReflect.Descriptor instead.
 func (*UserAccessToken) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{15}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *UserAccessToken) GetName() string {
@@ -1199,6 +1509,34 @@ func (x *UserAccessToken) GetExpiresAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *UserAccessToken) GetRateLimitPerMinute() int32 {
+	if x != nil {
+		return x.RateLimitPerMinute
+	}
+	return 0
+}
+
+func (x *UserAccessToken) GetRequestCount() int64 {
+	if x != nil {
+		return x.RequestCount
+	}
+	return 0
+}
+
+func (x *UserAccessToken) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *UserAccessToken) GetLastUsedIp() string {
+	if x != nil {
+		return x.LastUsedIp
+	}
+	return ""
+}
+
 type ListUserAccessTokensRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The parent resource whose access tokens will be listed.
@@ -1214,7 +1552,7 @@ type ListUserAccessTokensRequest struct {
 
 func (x *ListUserAccessTokensRequest) Reset() {
 	*x = ListUserAccessTokensRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[16]
+	mi := &file_api_v1_user_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1226,7 +1564,7 @@ func (x *ListUserAccessTokensRequest) String() string {
 func (*ListUserAccessTokensRequest) ProtoMessage() {}
 
 func (x *ListUserAccessTokensRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[16]
+	mi := &file_api_v1_user_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1239,7 +1577,7 @@ func (x *ListUserAccessTokensRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserAccessTokensRequest.ProtoReflect.Descriptor instead.
 func (*ListUserAccessTokensRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{16}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListUserAccessTokensRequest) GetParent() string {
@@ -1277,7 +1615,7 @@ type ListUserAccessTokensResponse struct {
 
 func (x *ListUserAccessTokensResponse) Reset() {
 	*x = ListUserAccessTokensResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[17]
+	mi := &file_api_v1_user_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1289,7 +1627,7 @@ func (x *ListUserAccessTokensResponse) String() string {
 func (*ListUserAccessTokensResponse) ProtoMessage() {}
 
 func (x *ListUserAccessTokensResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[17]
+	mi := &file_api_v1_user_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1302,7 +1640,7 @@ func (x *ListUserAccessTokensResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserAccessTokensResponse.ProtoReflect.Descriptor instead.
 func (*ListUserAccessTokensResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{17}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ListUserAccessTokensResponse) GetAccessTokens() []*UserAccessToken {
@@ -1341,7 +1679,7 @@ type CreateUserAccessTokenRequest struct {
 
 func (x *CreateUserAccessTokenRequest) Reset() {
 	*x = CreateUserAccessTokenRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[18]
+	mi := &file_api_v1_user_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1353,7 +1691,7 @@ func (x *CreateUserAccessTokenRequest) String() string {
 func (*CreateUserAccessTokenRequest) ProtoMessage() {}
 
 func (x *CreateUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[18]
+	mi := &file_api_v1_user_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1366,7 +1704,7 @@ func (x *CreateUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserAccessTokenRequest.ProtoReflect.Descriptor instead.
 func (*CreateUserAccessTokenRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{18}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *CreateUserAccessTokenRequest) GetParent() string {
@@ -1390,6 +1728,60 @@ func (x *CreateUserAccessTokenRequest) GetAccessTokenId() string {
 	return ""
 }
 
+type UpdateUserAccessTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The access token to update.
+	AccessToken *UserAccessToken `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// Required. The list of fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserAccessTokenRequest) Reset() {
+	*x = UpdateUserAccessTokenRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserAccessTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserAccessTokenRequest) ProtoMessage() {}
+
+func (x *UpdateUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateUserAccessTokenRequest) GetAccessToken() *UserAccessToken {
+	if x != nil {
+		return x.AccessToken
+	}
+	return nil
+}
+
+func (x *UpdateUserAccessTokenRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 type DeleteUserAccessTokenRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Required. The resource name of the access token to delete.
@@ -1401,7 +1793,7 @@ type DeleteUserAccessTokenRequest struct {
 
 func (x *DeleteUserAccessTokenRequest) Reset() {
 	*x = DeleteUserAccessTokenRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[19]
+	mi := &file_api_v1_user_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1413,7 +1805,7 @@ func (x *DeleteUserAccessTokenRequest) String() string {
 func (*DeleteUserAccessTokenRequest) ProtoMessage() {}
 
 func (x *DeleteUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[19]
+	mi := &file_api_v1_user_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1426,7 +1818,7 @@ func (x *DeleteUserAccessTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserAccessTokenRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserAccessTokenRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{19}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DeleteUserAccessTokenRequest) GetName() string {
@@ -1456,7 +1848,7 @@ type UserSession struct {
 
 func (x *UserSession) Reset() {
 	*x = UserSession{}
-	mi := &file_api_v1_user_service_proto_msgTypes[20]
+	mi := &file_api_v1_user_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1468,7 +1860,7 @@ func (x *UserSession) String() string {
 func (*UserSession) ProtoMessage() {}
 
 func (x *UserSession) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[20]
+	mi := &file_api_v1_user_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1481,7 +1873,7 @@ func (x *UserSession) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSession.ProtoReflect.Descriptor instead.
 func (*UserSession) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{20}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *UserSession) GetName() string {
@@ -1530,7 +1922,7 @@ type ListUserSessionsRequest struct {
 
 func (x *ListUserSessionsRequest) Reset() {
 	*x = ListUserSessionsRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[21]
+	mi := &file_api_v1_user_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1542,7 +1934,7 @@ func (x *ListUserSessionsRequest) String() string {
 func (*ListUserSessionsRequest) ProtoMessage() {}
 
 func (x *ListUserSessionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[21]
+	mi := &file_api_v1_user_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1555,7 +1947,7 @@ func (x *ListUserSessionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserSessionsRequest.ProtoReflect.Descriptor instead.
 func (*ListUserSessionsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{21}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ListUserSessionsRequest) GetParent() string {
@@ -1575,7 +1967,7 @@ type ListUserSessionsResponse struct {
 
 func (x *ListUserSessionsResponse) Reset() {
 	*x = ListUserSessionsResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[22]
+	mi := &file_api_v1_user_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1587,7 +1979,7 @@ func (x *ListUserSessionsResponse) String() string {
 func (*ListUserSessionsResponse) ProtoMessage() {}
 
 func (x *ListUserSessionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[22]
+	mi := &file_api_v1_user_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1600,7 +1992,7 @@ func (x *ListUserSessionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserSessionsResponse.ProtoReflect.Descriptor instead.
 func (*ListUserSessionsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{22}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ListUserSessionsResponse) GetSessions() []*UserSession {
@@ -1621,7 +2013,7 @@ type RevokeUserSessionRequest struct {
 
 func (x *RevokeUserSessionRequest) Reset() {
 	*x = RevokeUserSessionRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[23]
+	mi := &file_api_v1_user_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1633,7 +2025,7 @@ func (x *RevokeUserSessionRequest) String() string {
 func (*RevokeUserSessionRequest) ProtoMessage() {}
 
 func (x *RevokeUserSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[23]
+	mi := &file_api_v1_user_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1646,7 +2038,7 @@ func (x *RevokeUserSessionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeUserSessionRequest.ProtoReflect.Descriptor instead.
 func (*RevokeUserSessionRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{23}
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *RevokeUserSessionRequest) GetName() string {
@@ -1656,31 +2048,34 @@ func (x *RevokeUserSessionRequest) GetName() string {
 	return ""
 }
 
-type ListAllUserStatsRequest struct {
+type UserTwoFactor struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional. The maximum number of user stats to return.
-	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional. A page token for pagination.
-	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// The resource name of the two-factor authentication.
+	// Format: users/{user}/twoFactor
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the two-factor authentication is enabled.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The number of unused recovery codes.
+	RecoveryCodeCount int32 `protobuf:"varint,3,opt,name=recovery_code_count,json=recoveryCodeCount,proto3" json:"recovery_code_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *ListAllUserStatsRequest) Reset() {
-	*x = ListAllUserStatsRequest{}
-	mi := &file_api_v1_user_service_proto_msgTypes[24]
+func (x *UserTwoFactor) Reset() {
+	*x = UserTwoFactor{}
+	mi := &file_api_v1_user_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAllUserStatsRequest) String() string {
+func (x *UserTwoFactor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllUserStatsRequest) ProtoMessage() {}
+func (*UserTwoFactor) ProtoMessage() {}
 
-func (x *ListAllUserStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[24]
+func (x *UserTwoFactor) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1691,52 +2086,56 @@ func (x *ListAllUserStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllUserStatsRequest.ProtoReflect.Descriptor instead.
-func (*ListAllUserStatsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use UserTwoFactor.ProtoReflect.Descriptor instead.
+func (*UserTwoFactor) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ListAllUserStatsRequest) GetPageSize() int32 {
+func (x *UserTwoFactor) GetName() string {
 	if x != nil {
-		return x.PageSize
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *ListAllUserStatsRequest) GetPageToken() string {
+func (x *UserTwoFactor) GetEnabled() bool {
 	if x != nil {
-		return x.PageToken
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-type ListAllUserStatsResponse struct {
+func (x *UserTwoFactor) GetRecoveryCodeCount() int32 {
+	if x != nil {
+		return x.RecoveryCodeCount
+	}
+	return 0
+}
+
+type GetUserTwoFactorRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The list of user statistics.
-	UserStats []*UserStats `protobuf:"bytes,1,rep,name=user_stats,json=userStats,proto3" json:"user_stats,omitempty"`
-	// A token for the next page of results.
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	// The total count of user statistics.
-	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// Required. The resource name of the two-factor authentication.
+	// Format: users/{user}/twoFactor
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAllUserStatsResponse) Reset() {
-	*x = ListAllUserStatsResponse{}
-	mi := &file_api_v1_user_service_proto_msgTypes[25]
+func (x *GetUserTwoFactorRequest) Reset() {
+	*x = GetUserTwoFactorRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAllUserStatsResponse) String() string {
+func (x *GetUserTwoFactorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListAllUserStatsResponse) ProtoMessage() {}
+func (*GetUserTwoFactorRequest) ProtoMessage() {}
 
-func (x *ListAllUserStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[25]
+func (x *GetUserTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1747,58 +2146,42 @@ func (x *ListAllUserStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListAllUserStatsResponse.ProtoReflect.Descriptor instead.
-func (*ListAllUserStatsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *ListAllUserStatsResponse) GetUserStats() []*UserStats {
-	if x != nil {
-		return x.UserStats
-	}
-	return nil
+// Deprecated: Use GetUserTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*GetUserTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ListAllUserStatsResponse) GetNextPageToken() string {
+func (x *GetUserTwoFactorRequest) GetName() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListAllUserStatsResponse) GetTotalSize() int32 {
-	if x != nil {
-		return x.TotalSize
-	}
-	return 0
-}
-
-// Memo type statistics.
-type UserStats_MemoTypeStats struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LinkCount     int32                  `protobuf:"varint,1,opt,name=link_count,json=linkCount,proto3" json:"link_count,omitempty"`
-	CodeCount     int32                  `protobuf:"varint,2,opt,name=code_count,json=codeCount,proto3" json:"code_count,omitempty"`
-	TodoCount     int32                  `protobuf:"varint,3,opt,name=todo_count,json=todoCount,proto3" json:"todo_count,omitempty"`
-	UndoCount     int32                  `protobuf:"varint,4,opt,name=undo_count,json=undoCount,proto3" json:"undo_count,omitempty"`
+type SetupUserTwoFactorRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the two-factor authentication.
+	// Format: users/{user}/twoFactor
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserStats_MemoTypeStats) Reset() {
-	*x = UserStats_MemoTypeStats{}
-	mi := &file_api_v1_user_service_proto_msgTypes[27]
+func (x *SetupUserTwoFactorRequest) Reset() {
+	*x = SetupUserTwoFactorRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserStats_MemoTypeStats) String() string {
+func (x *SetupUserTwoFactorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserStats_MemoTypeStats) ProtoMessage() {}
+func (*SetupUserTwoFactorRequest) ProtoMessage() {}
 
-func (x *UserStats_MemoTypeStats) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[27]
+func (x *SetupUserTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1809,70 +2192,98 @@ func (x *UserStats_MemoTypeStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserStats_MemoTypeStats.ProtoReflect.Descriptor instead.
-func (*UserStats_MemoTypeStats) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{10, 1}
+// Deprecated: Use SetupUserTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*SetupUserTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *UserStats_MemoTypeStats) GetLinkCount() int32 {
+func (x *SetupUserTwoFactorRequest) GetName() string {
 	if x != nil {
-		return x.LinkCount
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *UserStats_MemoTypeStats) GetCodeCount() int32 {
+type SetupUserTwoFactorResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The base32 encoded TOTP secret, to be entered in an authenticator app.
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// The otpauth:// URI of the secret, to be shown as a QR code.
+	ProvisioningUri string `protobuf:"bytes,2,opt,name=provisioning_uri,json=provisioningUri,proto3" json:"provisioning_uri,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetupUserTwoFactorResponse) Reset() {
+	*x = SetupUserTwoFactorResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetupUserTwoFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetupUserTwoFactorResponse) ProtoMessage() {}
+
+func (x *SetupUserTwoFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[30]
 	if x != nil {
-		return x.CodeCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UserStats_MemoTypeStats) GetTodoCount() int32 {
+// Deprecated: Use SetupUserTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*SetupUserTwoFactorResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetupUserTwoFactorResponse) GetSecret() string {
 	if x != nil {
-		return x.TodoCount
+		return x.Secret
 	}
-	return 0
+	return ""
 }
 
-func (x *UserStats_MemoTypeStats) GetUndoCount() int32 {
+func (x *SetupUserTwoFactorResponse) GetProvisioningUri() string {
 	if x != nil {
-		return x.UndoCount
+		return x.ProvisioningUri
 	}
-	return 0
+	return ""
 }
 
-type UserSession_ClientInfo struct {
+type EnableUserTwoFactorRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// User agent string of the client.
-	UserAgent string `protobuf:"bytes,1,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
-	// IP address of the client.
-	IpAddress string `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
-	// Optional. Device type (e.g., "mobile", "desktop", "tablet").
-	DeviceType string `protobuf:"bytes,3,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
-	// Optional. Operating system (e.g., "iOS 17.0", "Windows 11").
-	Os string `protobuf:"bytes,4,opt,name=os,proto3" json:"os,omitempty"`
-	// Optional. Browser name and version (e.g., "Chrome 119.0").
-	Browser       string `protobuf:"bytes,5,opt,name=browser,proto3" json:"browser,omitempty"`
+	// Required. The resource name of the two-factor authentication.
+	// Format: users/{user}/twoFactor
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. A TOTP code of the secret returned by the setup.
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserSession_ClientInfo) Reset() {
-	*x = UserSession_ClientInfo{}
-	mi := &file_api_v1_user_service_proto_msgTypes[28]
+func (x *EnableUserTwoFactorRequest) Reset() {
+	*x = EnableUserTwoFactorRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserSession_ClientInfo) String() string {
+func (x *EnableUserTwoFactorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSession_ClientInfo) ProtoMessage() {}
+func (*EnableUserTwoFactorRequest) ProtoMessage() {}
 
-func (x *UserSession_ClientInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_user_service_proto_msgTypes[28]
+func (x *EnableUserTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1883,44 +2294,3673 @@ func (x *UserSession_ClientInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSession_ClientInfo.ProtoReflect.Descriptor instead.
-func (*UserSession_ClientInfo) Descriptor() ([]byte, []int) {
-	return file_api_v1_user_service_proto_rawDescGZIP(), []int{20, 0}
+// Deprecated: Use EnableUserTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*EnableUserTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *UserSession_ClientInfo) GetUserAgent() string {
+func (x *EnableUserTwoFactorRequest) GetName() string {
 	if x != nil {
-		return x.UserAgent
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UserSession_ClientInfo) GetIpAddress() string {
+func (x *EnableUserTwoFactorRequest) GetCode() string {
 	if x != nil {
-		return x.IpAddress
+		return x.Code
 	}
 	return ""
 }
 
-func (x *UserSession_ClientInfo) GetDeviceType() string {
-	if x != nil {
-		return x.DeviceType
-	}
-	return ""
+type EnableUserTwoFactorResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The recovery codes, each signing in once without a TOTP code. They are only returned once.
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserSession_ClientInfo) GetOs() string {
-	if x != nil {
-		return x.Os
-	}
-	return ""
+func (x *EnableUserTwoFactorResponse) Reset() {
+	*x = EnableUserTwoFactorResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserSession_ClientInfo) GetBrowser() string {
+func (x *EnableUserTwoFactorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableUserTwoFactorResponse) ProtoMessage() {}
+
+func (x *EnableUserTwoFactorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[32]
 	if x != nil {
-		return x.Browser
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableUserTwoFactorResponse.ProtoReflect.Descriptor instead.
+func (*EnableUserTwoFactorResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *EnableUserTwoFactorResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type DisableUserTwoFactorRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the two-factor authentication.
+	// Format: users/{user}/twoFactor
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// A TOTP code or an unused recovery code of the user.
+	// Not required for the host disabling the two-factor authentication of another user.
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableUserTwoFactorRequest) Reset() {
+	*x = DisableUserTwoFactorRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableUserTwoFactorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableUserTwoFactorRequest) ProtoMessage() {}
+
+func (x *DisableUserTwoFactorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableUserTwoFactorRequest.ProtoReflect.Descriptor instead.
+func (*DisableUserTwoFactorRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DisableUserTwoFactorRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DisableUserTwoFactorRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RegenerateUserRecoveryCodesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the two-factor authentication.
+	// Format: users/{user}/twoFactor
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. A TOTP code of the user.
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateUserRecoveryCodesRequest) Reset() {
+	*x = RegenerateUserRecoveryCodesRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateUserRecoveryCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateUserRecoveryCodesRequest) ProtoMessage() {}
+
+func (x *RegenerateUserRecoveryCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateUserRecoveryCodesRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateUserRecoveryCodesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RegenerateUserRecoveryCodesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegenerateUserRecoveryCodesRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RegenerateUserRecoveryCodesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The new recovery codes, replacing the previous ones. They are only returned once.
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateUserRecoveryCodesResponse) Reset() {
+	*x = RegenerateUserRecoveryCodesResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateUserRecoveryCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateUserRecoveryCodesResponse) ProtoMessage() {}
+
+func (x *RegenerateUserRecoveryCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateUserRecoveryCodesResponse.ProtoReflect.Descriptor instead.
+func (*RegenerateUserRecoveryCodesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RegenerateUserRecoveryCodesResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type UserSuspension struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the suspension.
+	// Format: users/{user}/suspension
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the user is suspended.
+	Suspended bool `protobuf:"varint,2,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	// The reason of the suspension, shown to the user when signing in.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The time of the suspension.
+	SuspendTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=suspend_time,json=suspendTime,proto3" json:"suspend_time,omitempty"`
+	// The name of the admin who suspended the user.
+	// Format: users/{user}
+	Suspender     string `protobuf:"bytes,5,opt,name=suspender,proto3" json:"suspender,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSuspension) Reset() {
+	*x = UserSuspension{}
+	mi := &file_api_v1_user_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSuspension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSuspension) ProtoMessage() {}
+
+func (x *UserSuspension) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSuspension.ProtoReflect.Descriptor instead.
+func (*UserSuspension) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UserSuspension) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserSuspension) GetSuspended() bool {
+	if x != nil {
+		return x.Suspended
+	}
+	return false
+}
+
+func (x *UserSuspension) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UserSuspension) GetSuspendTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SuspendTime
+	}
+	return nil
+}
+
+func (x *UserSuspension) GetSuspender() string {
+	if x != nil {
+		return x.Suspender
+	}
+	return ""
+}
+
+type GetUserSuspensionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the suspension.
+	// Format: users/{user}/suspension
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserSuspensionRequest) Reset() {
+	*x = GetUserSuspensionRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserSuspensionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserSuspensionRequest) ProtoMessage() {}
+
+func (x *GetUserSuspensionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserSuspensionRequest.ProtoReflect.Descriptor instead.
+func (*GetUserSuspensionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetUserSuspensionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SuspendUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the user.
+	// Format: users/{user}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional. The reason of the suspension, shown to the user when signing in.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendUserRequest) Reset() {
+	*x = SuspendUserRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserRequest) ProtoMessage() {}
+
+func (x *SuspendUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserRequest.ProtoReflect.Descriptor instead.
+func (*SuspendUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SuspendUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SuspendUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UnsuspendUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the user.
+	// Format: users/{user}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsuspendUserRequest) Reset() {
+	*x = UnsuspendUserRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsuspendUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsuspendUserRequest) ProtoMessage() {}
+
+func (x *UnsuspendUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsuspendUserRequest.ProtoReflect.Descriptor instead.
+func (*UnsuspendUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UnsuspendUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UserMemoEmail struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the memo email.
+	// Format: users/{user}/memoEmail
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the emails sent to the address are created as memos.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The address receiving the memos, the subject of an email becoming the heading of its memo
+	// and its attachments the attachments of the memo. Empty if disabled.
+	Address       string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserMemoEmail) Reset() {
+	*x = UserMemoEmail{}
+	mi := &file_api_v1_user_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserMemoEmail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserMemoEmail) ProtoMessage() {}
+
+func (x *UserMemoEmail) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserMemoEmail.ProtoReflect.Descriptor instead.
+func (*UserMemoEmail) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UserMemoEmail) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserMemoEmail) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *UserMemoEmail) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type GetUserMemoEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo email.
+	// Format: users/{user}/memoEmail
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserMemoEmailRequest) Reset() {
+	*x = GetUserMemoEmailRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserMemoEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserMemoEmailRequest) ProtoMessage() {}
+
+func (x *GetUserMemoEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserMemoEmailRequest.ProtoReflect.Descriptor instead.
+func (*GetUserMemoEmailRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetUserMemoEmailRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ResetUserMemoEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo email.
+	// Format: users/{user}/memoEmail
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetUserMemoEmailRequest) Reset() {
+	*x = ResetUserMemoEmailRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetUserMemoEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetUserMemoEmailRequest) ProtoMessage() {}
+
+func (x *ResetUserMemoEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetUserMemoEmailRequest.ProtoReflect.Descriptor instead.
+func (*ResetUserMemoEmailRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ResetUserMemoEmailRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DisableUserMemoEmailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the memo email.
+	// Format: users/{user}/memoEmail
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableUserMemoEmailRequest) Reset() {
+	*x = DisableUserMemoEmailRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableUserMemoEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableUserMemoEmailRequest) ProtoMessage() {}
+
+func (x *DisableUserMemoEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableUserMemoEmailRequest.ProtoReflect.Descriptor instead.
+func (*DisableUserMemoEmailRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DisableUserMemoEmailRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UserCalendarFeed struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the calendar feed.
+	// Format: users/{user}/calendarFeed
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the calendar feed is published.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The iCalendar URL to subscribe to, listing the memos dated in their first line and the incomplete tasks
+	// dated in their text, e.g. "- [ ] Pay rent 2025-07-01". Relative to the instance if its URL is not configured.
+	// Empty if disabled.
+	Url           string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserCalendarFeed) Reset() {
+	*x = UserCalendarFeed{}
+	mi := &file_api_v1_user_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserCalendarFeed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCalendarFeed) ProtoMessage() {}
+
+func (x *UserCalendarFeed) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCalendarFeed.ProtoReflect.Descriptor instead.
+func (*UserCalendarFeed) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UserCalendarFeed) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserCalendarFeed) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *UserCalendarFeed) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type GetUserCalendarFeedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the calendar feed.
+	// Format: users/{user}/calendarFeed
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserCalendarFeedRequest) Reset() {
+	*x = GetUserCalendarFeedRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserCalendarFeedRequest) ProtoMessage() {}
+
+func (x *GetUserCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetUserCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetUserCalendarFeedRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ResetUserCalendarFeedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the calendar feed.
+	// Format: users/{user}/calendarFeed
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetUserCalendarFeedRequest) Reset() {
+	*x = ResetUserCalendarFeedRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetUserCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetUserCalendarFeedRequest) ProtoMessage() {}
+
+func (x *ResetUserCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetUserCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*ResetUserCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ResetUserCalendarFeedRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DisableUserCalendarFeedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the calendar feed.
+	// Format: users/{user}/calendarFeed
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableUserCalendarFeedRequest) Reset() {
+	*x = DisableUserCalendarFeedRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableUserCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableUserCalendarFeedRequest) ProtoMessage() {}
+
+func (x *DisableUserCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableUserCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*DisableUserCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DisableUserCalendarFeedRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UserReadwise struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the Readwise account.
+	// Format: users/{user}/readwise
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether a Readwise account is connected, its highlights being synced every hour as a memo per book or article.
+	Connected bool `protobuf:"varint,2,opt,name=connected,proto3" json:"connected,omitempty"`
+	// The tag of the memos of the highlights, followed by the category of their book, e.g. "readwise/articles".
+	Tag string `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	// The time of the last successful sync.
+	LastSyncTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_sync_time,json=lastSyncTime,proto3" json:"last_sync_time,omitempty"`
+	// The error of the last sync, empty if it succeeded.
+	LastSyncError string `protobuf:"bytes,5,opt,name=last_sync_error,json=lastSyncError,proto3" json:"last_sync_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserReadwise) Reset() {
+	*x = UserReadwise{}
+	mi := &file_api_v1_user_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserReadwise) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserReadwise) ProtoMessage() {}
+
+func (x *UserReadwise) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserReadwise.ProtoReflect.Descriptor instead.
+func (*UserReadwise) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UserReadwise) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserReadwise) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+func (x *UserReadwise) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *UserReadwise) GetLastSyncTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSyncTime
+	}
+	return nil
+}
+
+func (x *UserReadwise) GetLastSyncError() string {
+	if x != nil {
+		return x.LastSyncError
+	}
+	return ""
+}
+
+type GetUserReadwiseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the Readwise account.
+	// Format: users/{user}/readwise
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserReadwiseRequest) Reset() {
+	*x = GetUserReadwiseRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserReadwiseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserReadwiseRequest) ProtoMessage() {}
+
+func (x *GetUserReadwiseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserReadwiseRequest.ProtoReflect.Descriptor instead.
+func (*GetUserReadwiseRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetUserReadwiseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ConnectUserReadwiseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the Readwise account.
+	// Format: users/{user}/readwise
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The access token of the Readwise account, from https://readwise.io/access_token.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// Optional. The tag of the memos of the highlights, "readwise" if empty.
+	Tag           string `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectUserReadwiseRequest) Reset() {
+	*x = ConnectUserReadwiseRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectUserReadwiseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectUserReadwiseRequest) ProtoMessage() {}
+
+func (x *ConnectUserReadwiseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectUserReadwiseRequest.ProtoReflect.Descriptor instead.
+func (*ConnectUserReadwiseRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ConnectUserReadwiseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConnectUserReadwiseRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ConnectUserReadwiseRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type DisconnectUserReadwiseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the Readwise account.
+	// Format: users/{user}/readwise
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectUserReadwiseRequest) Reset() {
+	*x = DisconnectUserReadwiseRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectUserReadwiseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectUserReadwiseRequest) ProtoMessage() {}
+
+func (x *DisconnectUserReadwiseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectUserReadwiseRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectUserReadwiseRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DisconnectUserReadwiseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UserWebPushSubscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the subscription.
+	// Format: users/{user}/webPushSubscriptions/{web_push_subscription}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The URL of the push service of the browser, from its PushSubscription.
+	Endpoint string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Required. The keys of the browser encrypting the notifications, from its PushSubscription.
+	Keys *UserWebPushSubscription_Keys `protobuf:"bytes,3,opt,name=keys,proto3" json:"keys,omitempty"`
+	// Optional. The user agent of the browser, to tell the subscriptions apart.
+	UserAgent string `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// Output only. The creation timestamp.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserWebPushSubscription) Reset() {
+	*x = UserWebPushSubscription{}
+	mi := &file_api_v1_user_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserWebPushSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserWebPushSubscription) ProtoMessage() {}
+
+func (x *UserWebPushSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserWebPushSubscription.ProtoReflect.Descriptor instead.
+func (*UserWebPushSubscription) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UserWebPushSubscription) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserWebPushSubscription) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *UserWebPushSubscription) GetKeys() *UserWebPushSubscription_Keys {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *UserWebPushSubscription) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *UserWebPushSubscription) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ListUserWebPushSubscriptionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent resource whose subscriptions will be listed.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserWebPushSubscriptionsRequest) Reset() {
+	*x = ListUserWebPushSubscriptionsRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserWebPushSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserWebPushSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListUserWebPushSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserWebPushSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserWebPushSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListUserWebPushSubscriptionsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListUserWebPushSubscriptionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of subscriptions.
+	WebPushSubscriptions []*UserWebPushSubscription `protobuf:"bytes,1,rep,name=web_push_subscriptions,json=webPushSubscriptions,proto3" json:"web_push_subscriptions,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ListUserWebPushSubscriptionsResponse) Reset() {
+	*x = ListUserWebPushSubscriptionsResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserWebPushSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserWebPushSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListUserWebPushSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserWebPushSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserWebPushSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListUserWebPushSubscriptionsResponse) GetWebPushSubscriptions() []*UserWebPushSubscription {
+	if x != nil {
+		return x.WebPushSubscriptions
+	}
+	return nil
+}
+
+type CreateUserWebPushSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The parent resource where this subscription will be created.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The subscription to create.
+	WebPushSubscription *UserWebPushSubscription `protobuf:"bytes,2,opt,name=web_push_subscription,json=webPushSubscription,proto3" json:"web_push_subscription,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateUserWebPushSubscriptionRequest) Reset() {
+	*x = CreateUserWebPushSubscriptionRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserWebPushSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserWebPushSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateUserWebPushSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserWebPushSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserWebPushSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CreateUserWebPushSubscriptionRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateUserWebPushSubscriptionRequest) GetWebPushSubscription() *UserWebPushSubscription {
+	if x != nil {
+		return x.WebPushSubscription
+	}
+	return nil
+}
+
+type DeleteUserWebPushSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the subscription to delete.
+	// Format: users/{user}/webPushSubscriptions/{web_push_subscription}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserWebPushSubscriptionRequest) Reset() {
+	*x = DeleteUserWebPushSubscriptionRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserWebPushSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserWebPushSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteUserWebPushSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserWebPushSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserWebPushSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DeleteUserWebPushSubscriptionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UserGistSync struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the gist sync.
+	// Format: users/{user}/gistSync
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether a GitHub account is connected, the memos tagged #gist being synced with its gists every 10 minutes.
+	Connected bool `protobuf:"varint,2,opt,name=connected,proto3" json:"connected,omitempty"`
+	// The time of the last successful sync.
+	LastSyncTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_sync_time,json=lastSyncTime,proto3" json:"last_sync_time,omitempty"`
+	// The error of the last sync, empty if it succeeded.
+	LastSyncError string `protobuf:"bytes,4,opt,name=last_sync_error,json=lastSyncError,proto3" json:"last_sync_error,omitempty"`
+	// The URLs of the gists of the memos, by memo name.
+	GistUrls      map[string]string `protobuf:"bytes,5,rep,name=gist_urls,json=gistUrls,proto3" json:"gist_urls,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserGistSync) Reset() {
+	*x = UserGistSync{}
+	mi := &file_api_v1_user_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserGistSync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserGistSync) ProtoMessage() {}
+
+func (x *UserGistSync) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserGistSync.ProtoReflect.Descriptor instead.
+func (*UserGistSync) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UserGistSync) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserGistSync) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+func (x *UserGistSync) GetLastSyncTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSyncTime
+	}
+	return nil
+}
+
+func (x *UserGistSync) GetLastSyncError() string {
+	if x != nil {
+		return x.LastSyncError
+	}
+	return ""
+}
+
+func (x *UserGistSync) GetGistUrls() map[string]string {
+	if x != nil {
+		return x.GistUrls
+	}
+	return nil
+}
+
+type GetUserGistSyncRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the gist sync.
+	// Format: users/{user}/gistSync
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserGistSyncRequest) Reset() {
+	*x = GetUserGistSyncRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserGistSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserGistSyncRequest) ProtoMessage() {}
+
+func (x *GetUserGistSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserGistSyncRequest.ProtoReflect.Descriptor instead.
+func (*GetUserGistSyncRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetUserGistSyncRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ConnectUserGistSyncRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the gist sync.
+	// Format: users/{user}/gistSync
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. A personal access token of the GitHub account with the gist scope.
+	Token         string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectUserGistSyncRequest) Reset() {
+	*x = ConnectUserGistSyncRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectUserGistSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectUserGistSyncRequest) ProtoMessage() {}
+
+func (x *ConnectUserGistSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectUserGistSyncRequest.ProtoReflect.Descriptor instead.
+func (*ConnectUserGistSyncRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ConnectUserGistSyncRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConnectUserGistSyncRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type DisconnectUserGistSyncRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the gist sync.
+	// Format: users/{user}/gistSync
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectUserGistSyncRequest) Reset() {
+	*x = DisconnectUserGistSyncRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectUserGistSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectUserGistSyncRequest) ProtoMessage() {}
+
+func (x *DisconnectUserGistSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectUserGistSyncRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectUserGistSyncRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DisconnectUserGistSyncRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UserNotificationPreferences struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the notification preferences.
+	// Format: users/{user}/notificationPreferences
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The preferences of the events. The events the user has not set notify with the inbox and the push.
+	Preferences   []*UserNotificationPreferences_Preference `protobuf:"bytes,2,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserNotificationPreferences) Reset() {
+	*x = UserNotificationPreferences{}
+	mi := &file_api_v1_user_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserNotificationPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserNotificationPreferences) ProtoMessage() {}
+
+func (x *UserNotificationPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserNotificationPreferences.ProtoReflect.Descriptor instead.
+func (*UserNotificationPreferences) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *UserNotificationPreferences) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserNotificationPreferences) GetPreferences() []*UserNotificationPreferences_Preference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type GetUserNotificationPreferencesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the notification preferences.
+	// Format: users/{user}/notificationPreferences
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserNotificationPreferencesRequest) Reset() {
+	*x = GetUserNotificationPreferencesRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *GetUserNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetUserNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetUserNotificationPreferencesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateUserNotificationPreferencesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The notification preferences to update, with the preferences of the events to change.
+	NotificationPreferences *UserNotificationPreferences `protobuf:"bytes,1,opt,name=notification_preferences,json=notificationPreferences,proto3" json:"notification_preferences,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *UpdateUserNotificationPreferencesRequest) Reset() {
+	*x = UpdateUserNotificationPreferencesRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateUserNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UpdateUserNotificationPreferencesRequest) GetNotificationPreferences() *UserNotificationPreferences {
+	if x != nil {
+		return x.NotificationPreferences
+	}
+	return nil
+}
+
+type UserEmailDigest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the email digest.
+	// Format: users/{user}/emailDigest
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The frequency of the digest, disabled if unspecified.
+	Frequency UserEmailDigest_Frequency `protobuf:"varint,2,opt,name=frequency,proto3,enum=memos.api.v1.UserEmailDigest_Frequency" json:"frequency,omitempty"`
+	// The hour of the day the digest is sent at, from 0 to 23.
+	Hour int32 `protobuf:"varint,3,opt,name=hour,proto3" json:"hour,omitempty"`
+	// The day of the week the weekly digest is sent on, from 0 for Sunday to 6 for Saturday.
+	Weekday int32 `protobuf:"varint,4,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	// The IANA time zone of the hour and the day, e.g. "Europe/Paris", UTC if empty.
+	TimeZone string `protobuf:"bytes,5,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// The scheduled time of the last digest.
+	LastSendTime  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_send_time,json=lastSendTime,proto3" json:"last_send_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserEmailDigest) Reset() {
+	*x = UserEmailDigest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserEmailDigest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserEmailDigest) ProtoMessage() {}
+
+func (x *UserEmailDigest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserEmailDigest.ProtoReflect.Descriptor instead.
+func (*UserEmailDigest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UserEmailDigest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserEmailDigest) GetFrequency() UserEmailDigest_Frequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return UserEmailDigest_FREQUENCY_UNSPECIFIED
+}
+
+func (x *UserEmailDigest) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *UserEmailDigest) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *UserEmailDigest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *UserEmailDigest) GetLastSendTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSendTime
+	}
+	return nil
+}
+
+type GetUserEmailDigestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the email digest.
+	// Format: users/{user}/emailDigest
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserEmailDigestRequest) Reset() {
+	*x = GetUserEmailDigestRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserEmailDigestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserEmailDigestRequest) ProtoMessage() {}
+
+func (x *GetUserEmailDigestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserEmailDigestRequest.ProtoReflect.Descriptor instead.
+func (*GetUserEmailDigestRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetUserEmailDigestRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateUserEmailDigestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The email digest to update.
+	EmailDigest   *UserEmailDigest `protobuf:"bytes,1,opt,name=email_digest,json=emailDigest,proto3" json:"email_digest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserEmailDigestRequest) Reset() {
+	*x = UpdateUserEmailDigestRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserEmailDigestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserEmailDigestRequest) ProtoMessage() {}
+
+func (x *UpdateUserEmailDigestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserEmailDigestRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserEmailDigestRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UpdateUserEmailDigestRequest) GetEmailDigest() *UserEmailDigest {
+	if x != nil {
+		return x.EmailDigest
+	}
+	return nil
+}
+
+type UserOnThisDay struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the on this day notification.
+	// Format: users/{user}/onThisDay
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the user is notified daily of their memos created on this day in past years.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The hour of the day the user is notified at, from 0 to 23.
+	Hour int32 `protobuf:"varint,3,opt,name=hour,proto3" json:"hour,omitempty"`
+	// The IANA time zone of the hour and the day, e.g. "Europe/Paris", UTC if empty.
+	TimeZone string `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// The scheduled time of the last notification.
+	LastNotifyTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_notify_time,json=lastNotifyTime,proto3" json:"last_notify_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UserOnThisDay) Reset() {
+	*x = UserOnThisDay{}
+	mi := &file_api_v1_user_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserOnThisDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserOnThisDay) ProtoMessage() {}
+
+func (x *UserOnThisDay) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserOnThisDay.ProtoReflect.Descriptor instead.
+func (*UserOnThisDay) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UserOnThisDay) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserOnThisDay) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *UserOnThisDay) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *UserOnThisDay) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *UserOnThisDay) GetLastNotifyTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastNotifyTime
+	}
+	return nil
+}
+
+type GetUserOnThisDayRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the on this day notification.
+	// Format: users/{user}/onThisDay
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserOnThisDayRequest) Reset() {
+	*x = GetUserOnThisDayRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserOnThisDayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserOnThisDayRequest) ProtoMessage() {}
+
+func (x *GetUserOnThisDayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserOnThisDayRequest.ProtoReflect.Descriptor instead.
+func (*GetUserOnThisDayRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetUserOnThisDayRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateUserOnThisDayRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The on this day notification to update.
+	OnThisDay     *UserOnThisDay `protobuf:"bytes,1,opt,name=on_this_day,json=onThisDay,proto3" json:"on_this_day,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserOnThisDayRequest) Reset() {
+	*x = UpdateUserOnThisDayRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserOnThisDayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserOnThisDayRequest) ProtoMessage() {}
+
+func (x *UpdateUserOnThisDayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserOnThisDayRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserOnThisDayRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *UpdateUserOnThisDayRequest) GetOnThisDay() *UserOnThisDay {
+	if x != nil {
+		return x.OnThisDay
+	}
+	return nil
+}
+
+type UserSlack struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the Slack account.
+	// Format: users/{user}/slack
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether a Slack account is linked, capturing memos with the slash command.
+	Linked bool `protobuf:"varint,2,opt,name=linked,proto3" json:"linked,omitempty"`
+	// The Slack workspace ID of the linked account.
+	TeamId string `protobuf:"bytes,3,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// The Slack user ID of the linked account.
+	UserId string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The code to send with "link" to the slash command in Slack to link the account, until it expires.
+	// Only returned by GenerateUserSlackLinkCode.
+	LinkCode string `protobuf:"bytes,5,opt,name=link_code,json=linkCode,proto3" json:"link_code,omitempty"`
+	// The expiration time of the link code.
+	LinkCodeExpireTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=link_code_expire_time,json=linkCodeExpireTime,proto3" json:"link_code_expire_time,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UserSlack) Reset() {
+	*x = UserSlack{}
+	mi := &file_api_v1_user_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSlack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSlack) ProtoMessage() {}
+
+func (x *UserSlack) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSlack.ProtoReflect.Descriptor instead.
+func (*UserSlack) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UserSlack) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserSlack) GetLinked() bool {
+	if x != nil {
+		return x.Linked
+	}
+	return false
+}
+
+func (x *UserSlack) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *UserSlack) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserSlack) GetLinkCode() string {
+	if x != nil {
+		return x.LinkCode
+	}
+	return ""
+}
+
+func (x *UserSlack) GetLinkCodeExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinkCodeExpireTime
+	}
+	return nil
+}
+
+type GetUserSlackRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the Slack account.
+	// Format: users/{user}/slack
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserSlackRequest) Reset() {
+	*x = GetUserSlackRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserSlackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserSlackRequest) ProtoMessage() {}
+
+func (x *GetUserSlackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserSlackRequest.ProtoReflect.Descriptor instead.
+func (*GetUserSlackRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetUserSlackRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GenerateUserSlackLinkCodeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the Slack account.
+	// Format: users/{user}/slack
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateUserSlackLinkCodeRequest) Reset() {
+	*x = GenerateUserSlackLinkCodeRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateUserSlackLinkCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateUserSlackLinkCodeRequest) ProtoMessage() {}
+
+func (x *GenerateUserSlackLinkCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateUserSlackLinkCodeRequest.ProtoReflect.Descriptor instead.
+func (*GenerateUserSlackLinkCodeRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GenerateUserSlackLinkCodeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UnlinkUserSlackRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the Slack account.
+	// Format: users/{user}/slack
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkUserSlackRequest) Reset() {
+	*x = UnlinkUserSlackRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkUserSlackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkUserSlackRequest) ProtoMessage() {}
+
+func (x *UnlinkUserSlackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkUserSlackRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkUserSlackRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UnlinkUserSlackRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UserDiscord struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the Discord account.
+	// Format: users/{user}/discord
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether a Discord account is linked, saving memos with the bot.
+	Linked bool `protobuf:"varint,2,opt,name=linked,proto3" json:"linked,omitempty"`
+	// The Discord user ID of the linked account.
+	UserId string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The code to send to the "/memo link" command of the bot to link the account, until it expires.
+	// Only returned by GenerateUserDiscordLinkCode.
+	LinkCode string `protobuf:"bytes,4,opt,name=link_code,json=linkCode,proto3" json:"link_code,omitempty"`
+	// The expiration time of the link code.
+	LinkCodeExpireTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=link_code_expire_time,json=linkCodeExpireTime,proto3" json:"link_code_expire_time,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UserDiscord) Reset() {
+	*x = UserDiscord{}
+	mi := &file_api_v1_user_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDiscord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDiscord) ProtoMessage() {}
+
+func (x *UserDiscord) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDiscord.ProtoReflect.Descriptor instead.
+func (*UserDiscord) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UserDiscord) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserDiscord) GetLinked() bool {
+	if x != nil {
+		return x.Linked
+	}
+	return false
+}
+
+func (x *UserDiscord) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserDiscord) GetLinkCode() string {
+	if x != nil {
+		return x.LinkCode
+	}
+	return ""
+}
+
+func (x *UserDiscord) GetLinkCodeExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinkCodeExpireTime
+	}
+	return nil
+}
+
+type GetUserDiscordRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the Discord account.
+	// Format: users/{user}/discord
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserDiscordRequest) Reset() {
+	*x = GetUserDiscordRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserDiscordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserDiscordRequest) ProtoMessage() {}
+
+func (x *GetUserDiscordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserDiscordRequest.ProtoReflect.Descriptor instead.
+func (*GetUserDiscordRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetUserDiscordRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GenerateUserDiscordLinkCodeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the Discord account.
+	// Format: users/{user}/discord
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateUserDiscordLinkCodeRequest) Reset() {
+	*x = GenerateUserDiscordLinkCodeRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateUserDiscordLinkCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateUserDiscordLinkCodeRequest) ProtoMessage() {}
+
+func (x *GenerateUserDiscordLinkCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateUserDiscordLinkCodeRequest.ProtoReflect.Descriptor instead.
+func (*GenerateUserDiscordLinkCodeRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GenerateUserDiscordLinkCodeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UnlinkUserDiscordRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the Discord account.
+	// Format: users/{user}/discord
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkUserDiscordRequest) Reset() {
+	*x = UnlinkUserDiscordRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkUserDiscordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkUserDiscordRequest) ProtoMessage() {}
+
+func (x *UnlinkUserDiscordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkUserDiscordRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkUserDiscordRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *UnlinkUserDiscordRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListAllUserStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The maximum number of user stats to return.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token for pagination.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. The shared space to count the memos of.
+	// If not specified, the memos of the personal spaces are counted.
+	// Format: spaces/{space}
+	Space         string `protobuf:"bytes,3,opt,name=space,proto3" json:"space,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllUserStatsRequest) Reset() {
+	*x = ListAllUserStatsRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllUserStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllUserStatsRequest) ProtoMessage() {}
+
+func (x *ListAllUserStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllUserStatsRequest.ProtoReflect.Descriptor instead.
+func (*ListAllUserStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListAllUserStatsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAllUserStatsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAllUserStatsRequest) GetSpace() string {
+	if x != nil {
+		return x.Space
+	}
+	return ""
+}
+
+type ListAllUserStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of user statistics.
+	UserStats []*UserStats `protobuf:"bytes,1,rep,name=user_stats,json=userStats,proto3" json:"user_stats,omitempty"`
+	// A token for the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The total count of user statistics.
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllUserStatsResponse) Reset() {
+	*x = ListAllUserStatsResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllUserStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllUserStatsResponse) ProtoMessage() {}
+
+func (x *ListAllUserStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllUserStatsResponse.ProtoReflect.Descriptor instead.
+func (*ListAllUserStatsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListAllUserStatsResponse) GetUserStats() []*UserStats {
+	if x != nil {
+		return x.UserStats
+	}
+	return nil
+}
+
+func (x *ListAllUserStatsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListAllUserStatsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type UserPermissions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the permissions.
+	// Format: users/{user}/permissions
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The id of the custom role of the user, if any.
+	CustomRole string `protobuf:"bytes,2,opt,name=custom_role,json=customRole,proto3" json:"custom_role,omitempty"`
+	// The permissions granted by the built-in and the custom roles of the user.
+	Permissions   []Permission `protobuf:"varint,3,rep,packed,name=permissions,proto3,enum=memos.api.v1.Permission" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserPermissions) Reset() {
+	*x = UserPermissions{}
+	mi := &file_api_v1_user_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPermissions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPermissions) ProtoMessage() {}
+
+func (x *UserPermissions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPermissions.ProtoReflect.Descriptor instead.
+func (*UserPermissions) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *UserPermissions) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserPermissions) GetCustomRole() string {
+	if x != nil {
+		return x.CustomRole
+	}
+	return ""
+}
+
+func (x *UserPermissions) GetPermissions() []Permission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type GetUserPermissionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the permissions.
+	// Format: users/{user}/permissions
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserPermissionsRequest) Reset() {
+	*x = GetUserPermissionsRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPermissionsRequest) ProtoMessage() {}
+
+func (x *GetUserPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetUserPermissionsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SetUserCustomRoleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the user.
+	// Format: users/{user}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The id of the workspace custom role, or empty to remove it.
+	CustomRole    string `protobuf:"bytes,2,opt,name=custom_role,json=customRole,proto3" json:"custom_role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserCustomRoleRequest) Reset() {
+	*x = SetUserCustomRoleRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserCustomRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserCustomRoleRequest) ProtoMessage() {}
+
+func (x *SetUserCustomRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserCustomRoleRequest.ProtoReflect.Descriptor instead.
+func (*SetUserCustomRoleRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SetUserCustomRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetUserCustomRoleRequest) GetCustomRole() string {
+	if x != nil {
+		return x.CustomRole
+	}
+	return ""
+}
+
+type Invitation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the invitation.
+	// Format: invitations/{invitation}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The name of the admin who created the invitation.
+	// Format: users/{user}
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// The secret token to sign up with.
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// The role of the users signing up with the invitation, USER by default.
+	// Only the host can invite admins.
+	Role User_Role `protobuf:"varint,4,opt,name=role,proto3,enum=memos.api.v1.User_Role" json:"role,omitempty"`
+	// The number of sign ups allowed, or 0 for no limit.
+	MaxUses int32 `protobuf:"varint,5,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	// The number of sign ups so far.
+	UseCount int32 `protobuf:"varint,6,opt,name=use_count,json=useCount,proto3" json:"use_count,omitempty"`
+	// The time after which the invitation is no longer valid, if any.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// The creation time of the invitation.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invitation) Reset() {
+	*x = Invitation{}
+	mi := &file_api_v1_user_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invitation) ProtoMessage() {}
+
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *Invitation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Invitation) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *Invitation) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *Invitation) GetRole() User_Role {
+	if x != nil {
+		return x.Role
+	}
+	return User_ROLE_UNSPECIFIED
+}
+
+func (x *Invitation) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *Invitation) GetUseCount() int32 {
+	if x != nil {
+		return x.UseCount
+	}
+	return 0
+}
+
+func (x *Invitation) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *Invitation) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ListInvitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationsRequest) Reset() {
+	*x = ListInvitationsRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsRequest) ProtoMessage() {}
+
+func (x *ListInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{84}
+}
+
+type ListInvitationsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The invitations, including the expired and used up ones.
+	Invitations   []*Invitation `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationsResponse) Reset() {
+	*x = ListInvitationsResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsResponse) ProtoMessage() {}
+
+func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListInvitationsResponse) GetInvitations() []*Invitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+type CreateInvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The invitation to create.
+	Invitation    *Invitation `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInvitationRequest) Reset() {
+	*x = CreateInvitationRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvitationRequest) ProtoMessage() {}
+
+func (x *CreateInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvitationRequest.ProtoReflect.Descriptor instead.
+func (*CreateInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CreateInvitationRequest) GetInvitation() *Invitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+type DeleteInvitationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the invitation.
+	// Format: invitations/{invitation}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteInvitationRequest) Reset() {
+	*x = DeleteInvitationRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInvitationRequest) ProtoMessage() {}
+
+func (x *DeleteInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInvitationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *DeleteInvitationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UserReadGrant struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the read grant.
+	// Format: users/{user}/readGrants/{read_grant}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Required. The user granted read access.
+	// Format: users/{user}
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// Optional. The tag of the readable memos, its descendants included.
+	// If empty, all the private memos are readable.
+	Tag string `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	// The creation timestamp.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserReadGrant) Reset() {
+	*x = UserReadGrant{}
+	mi := &file_api_v1_user_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserReadGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserReadGrant) ProtoMessage() {}
+
+func (x *UserReadGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserReadGrant.ProtoReflect.Descriptor instead.
+func (*UserReadGrant) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *UserReadGrant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserReadGrant) GetGrantee() string {
+	if x != nil {
+		return x.Grantee
+	}
+	return ""
+}
+
+func (x *UserReadGrant) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *UserReadGrant) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ListUserReadGrantsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The user whose read grants are listed.
+	// Format: users/{user}
+	Parent        string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserReadGrantsRequest) Reset() {
+	*x = ListUserReadGrantsRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserReadGrantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserReadGrantsRequest) ProtoMessage() {}
+
+func (x *ListUserReadGrantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserReadGrantsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserReadGrantsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListUserReadGrantsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+type ListUserReadGrantsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The list of read grants.
+	ReadGrants    []*UserReadGrant `protobuf:"bytes,1,rep,name=read_grants,json=readGrants,proto3" json:"read_grants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserReadGrantsResponse) Reset() {
+	*x = ListUserReadGrantsResponse{}
+	mi := &file_api_v1_user_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserReadGrantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserReadGrantsResponse) ProtoMessage() {}
+
+func (x *ListUserReadGrantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserReadGrantsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserReadGrantsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListUserReadGrantsResponse) GetReadGrants() []*UserReadGrant {
+	if x != nil {
+		return x.ReadGrants
+	}
+	return nil
+}
+
+type CreateUserReadGrantRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The user who owns the memos.
+	// Format: users/{user}
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Required. The read grant to create.
+	ReadGrant     *UserReadGrant `protobuf:"bytes,2,opt,name=read_grant,json=readGrant,proto3" json:"read_grant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserReadGrantRequest) Reset() {
+	*x = CreateUserReadGrantRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserReadGrantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserReadGrantRequest) ProtoMessage() {}
+
+func (x *CreateUserReadGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserReadGrantRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserReadGrantRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *CreateUserReadGrantRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *CreateUserReadGrantRequest) GetReadGrant() *UserReadGrant {
+	if x != nil {
+		return x.ReadGrant
+	}
+	return nil
+}
+
+type DeleteUserReadGrantRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the read grant to revoke.
+	// Format: users/{user}/readGrants/{read_grant}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserReadGrantRequest) Reset() {
+	*x = DeleteUserReadGrantRequest{}
+	mi := &file_api_v1_user_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserReadGrantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserReadGrantRequest) ProtoMessage() {}
+
+func (x *DeleteUserReadGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserReadGrantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserReadGrantRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *DeleteUserReadGrantRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Memo type statistics.
+type UserStats_MemoTypeStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkCount     int32                  `protobuf:"varint,1,opt,name=link_count,json=linkCount,proto3" json:"link_count,omitempty"`
+	CodeCount     int32                  `protobuf:"varint,2,opt,name=code_count,json=codeCount,proto3" json:"code_count,omitempty"`
+	TodoCount     int32                  `protobuf:"varint,3,opt,name=todo_count,json=todoCount,proto3" json:"todo_count,omitempty"`
+	UndoCount     int32                  `protobuf:"varint,4,opt,name=undo_count,json=undoCount,proto3" json:"undo_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserStats_MemoTypeStats) Reset() {
+	*x = UserStats_MemoTypeStats{}
+	mi := &file_api_v1_user_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserStats_MemoTypeStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserStats_MemoTypeStats) ProtoMessage() {}
+
+func (x *UserStats_MemoTypeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserStats_MemoTypeStats.ProtoReflect.Descriptor instead.
+func (*UserStats_MemoTypeStats) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{12, 1}
+}
+
+func (x *UserStats_MemoTypeStats) GetLinkCount() int32 {
+	if x != nil {
+		return x.LinkCount
+	}
+	return 0
+}
+
+func (x *UserStats_MemoTypeStats) GetCodeCount() int32 {
+	if x != nil {
+		return x.CodeCount
+	}
+	return 0
+}
+
+func (x *UserStats_MemoTypeStats) GetTodoCount() int32 {
+	if x != nil {
+		return x.TodoCount
+	}
+	return 0
+}
+
+func (x *UserStats_MemoTypeStats) GetUndoCount() int32 {
+	if x != nil {
+		return x.UndoCount
+	}
+	return 0
+}
+
+type UserSession_ClientInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User agent string of the client.
+	UserAgent string `protobuf:"bytes,1,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// IP address of the client.
+	IpAddress string `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	// Optional. Device type (e.g., "mobile", "desktop", "tablet").
+	DeviceType string `protobuf:"bytes,3,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
+	// Optional. Operating system (e.g., "iOS 17.0", "Windows 11").
+	Os string `protobuf:"bytes,4,opt,name=os,proto3" json:"os,omitempty"`
+	// Optional. Browser name and version (e.g., "Chrome 119.0").
+	Browser       string `protobuf:"bytes,5,opt,name=browser,proto3" json:"browser,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSession_ClientInfo) Reset() {
+	*x = UserSession_ClientInfo{}
+	mi := &file_api_v1_user_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSession_ClientInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSession_ClientInfo) ProtoMessage() {}
+
+func (x *UserSession_ClientInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSession_ClientInfo.ProtoReflect.Descriptor instead.
+func (*UserSession_ClientInfo) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{23, 0}
+}
+
+func (x *UserSession_ClientInfo) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *UserSession_ClientInfo) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *UserSession_ClientInfo) GetDeviceType() string {
+	if x != nil {
+		return x.DeviceType
+	}
+	return ""
+}
+
+func (x *UserSession_ClientInfo) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *UserSession_ClientInfo) GetBrowser() string {
+	if x != nil {
+		return x.Browser
+	}
+	return ""
+}
+
+type UserWebPushSubscription_Keys struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The P-256 public key of the browser, base64url-encoded.
+	P256Dh string `protobuf:"bytes,1,opt,name=p256dh,proto3" json:"p256dh,omitempty"`
+	// The authentication secret of the browser, base64url-encoded.
+	Auth          string `protobuf:"bytes,2,opt,name=auth,proto3" json:"auth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserWebPushSubscription_Keys) Reset() {
+	*x = UserWebPushSubscription_Keys{}
+	mi := &file_api_v1_user_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserWebPushSubscription_Keys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserWebPushSubscription_Keys) ProtoMessage() {}
+
+func (x *UserWebPushSubscription_Keys) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserWebPushSubscription_Keys.ProtoReflect.Descriptor instead.
+func (*UserWebPushSubscription_Keys) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{52, 0}
+}
+
+func (x *UserWebPushSubscription_Keys) GetP256Dh() string {
+	if x != nil {
+		return x.P256Dh
+	}
+	return ""
+}
+
+func (x *UserWebPushSubscription_Keys) GetAuth() string {
+	if x != nil {
+		return x.Auth
+	}
+	return ""
+}
+
+type UserNotificationPreferences_Preference struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The event notifying the user.
+	Event UserNotificationPreferences_Event `protobuf:"varint,1,opt,name=event,proto3,enum=memos.api.v1.UserNotificationPreferences_Event" json:"event,omitempty"`
+	// Whether the event creates an inbox message.
+	Inbox bool `protobuf:"varint,2,opt,name=inbox,proto3" json:"inbox,omitempty"`
+	// Whether the event is emailed to the verified email of the user.
+	Email bool `protobuf:"varint,3,opt,name=email,proto3" json:"email,omitempty"`
+	// Whether the event is pushed to the browsers subscribed by the user.
+	Push          bool `protobuf:"varint,4,opt,name=push,proto3" json:"push,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserNotificationPreferences_Preference) Reset() {
+	*x = UserNotificationPreferences_Preference{}
+	mi := &file_api_v1_user_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserNotificationPreferences_Preference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserNotificationPreferences_Preference) ProtoMessage() {}
+
+func (x *UserNotificationPreferences_Preference) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_user_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserNotificationPreferences_Preference.ProtoReflect.Descriptor instead.
+func (*UserNotificationPreferences_Preference) Descriptor() ([]byte, []int) {
+	return file_api_v1_user_service_proto_rawDescGZIP(), []int{61, 0}
+}
+
+func (x *UserNotificationPreferences_Preference) GetEvent() UserNotificationPreferences_Event {
+	if x != nil {
+		return x.Event
+	}
+	return UserNotificationPreferences_EVENT_UNSPECIFIED
+}
+
+func (x *UserNotificationPreferences_Preference) GetInbox() bool {
+	if x != nil {
+		return x.Inbox
+	}
+	return false
+}
+
+func (x *UserNotificationPreferences_Preference) GetEmail() bool {
+	if x != nil {
+		return x.Email
+	}
+	return false
+}
+
+func (x *UserNotificationPreferences_Preference) GetPush() bool {
+	if x != nil {
+		return x.Push
+	}
+	return false
 }
 
 var File_api_v1_user_service_proto protoreflect.FileDescriptor
@@ -1965,13 +6005,15 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x0eGetUserRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/UserR\x04name\x12<\n" +
-	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\breadMask\"\xaf\x01\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\breadMask\"\x89\x02\n" +
 	"\x11CreateUserRequest\x12.\n" +
 	"\x04user\x18\x01 \x01(\v2\x12.memos.api.v1.UserB\x06\xe0A\x02\xe0A\x04R\x04user\x12\x1c\n" +
 	"\auser_id\x18\x02 \x01(\tB\x03\xe0A\x01R\x06userId\x12(\n" +
 	"\rvalidate_only\x18\x03 \x01(\bB\x03\xe0A\x01R\fvalidateOnly\x12\"\n" +
 	"\n" +
-	"request_id\x18\x04 \x01(\tB\x03\xe0A\x01R\trequestId\"\xac\x01\n" +
+	"request_id\x18\x04 \x01(\tB\x03\xe0A\x01R\trequestId\x12.\n" +
+	"\x10invitation_token\x18\x05 \x01(\tB\x03\xe0A\x01R\x0finvitationToken\x12(\n" +
+	"\rcaptcha_token\x18\x06 \x01(\tB\x03\xe0A\x01R\fcaptchaToken\"\xac\x01\n" +
 	"\x11UpdateUserRequest\x12+\n" +
 	"\x04user\x18\x01 \x01(\v2\x12.memos.api.v1.UserB\x03\xe0A\x02R\x04user\x12@\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
@@ -1980,7 +6022,18 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x11DeleteUserRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/UserR\x04name\x12\x19\n" +
-	"\x05force\x18\x02 \x01(\bB\x03\xe0A\x01R\x05force\"u\n" +
+	"\x05force\x18\x02 \x01(\bB\x03\xe0A\x01R\x05force\"\xb3\x01\n" +
+	"\x18DeleteUserAccountRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x04name\x12.\n" +
+	"\x10confirm_username\x18\x02 \x01(\tB\x03\xe0A\x02R\x0fconfirmUsername\x12\x1f\n" +
+	"\bpassword\x18\x03 \x01(\tB\x03\xe0A\x01R\bpassword\x12\x17\n" +
+	"\x04code\x18\x04 \x01(\tB\x03\xe0A\x01R\x04code\"j\n" +
+	"\x19DeleteUserAccountResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x1d\n" +
+	"\n" +
+	"memo_count\x18\x03 \x01(\x05R\tmemoCount\"u\n" +
 	"\x12SearchUsersRequest\x12\x19\n" +
 	"\x05query\x18\x01 \x01(\tB\x03\xe0A\x02R\x05query\x12 \n" +
 	"\tpage_size\x18\x02 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
@@ -2013,10 +6066,12 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"todo_count\x18\x03 \x01(\x05R\ttodoCount\x12\x1d\n" +
 	"\n" +
 	"undo_count\x18\x04 \x01(\x05R\tundoCount:?\xeaA<\n" +
-	"\x16memos.api.v1/UserStats\x12\fusers/{user}*\tuserStats2\tuserStats\"D\n" +
+	"\x16memos.api.v1/UserStats\x12\fusers/{user}*\tuserStats2\tuserStats\"v\n" +
 	"\x13GetUserStatsRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
-	"\x11memos.api.v1/UserR\x04name\"\xf9\x01\n" +
+	"\x11memos.api.v1/UserR\x04name\x120\n" +
+	"\x05space\x18\x02 \x01(\tB\x1a\xe0A\x01\xfaA\x14\n" +
+	"\x12memos.api.v1/SpaceR\x05space\"\xeb\x02\n" +
 	"\vUserSetting\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x1b\n" +
 	"\x06locale\x18\x02 \x01(\tB\x03\xe0A\x01R\x06locale\x12#\n" +
@@ -2024,7 +6079,9 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"appearance\x18\x03 \x01(\tB\x03\xe0A\x01R\n" +
 	"appearance\x12,\n" +
 	"\x0fmemo_visibility\x18\x04 \x01(\tB\x03\xe0A\x01R\x0ememoVisibility\x12\x19\n" +
-	"\x05theme\x18\x05 \x01(\tB\x03\xe0A\x01R\x05theme:F\xeaAC\n" +
+	"\x05theme\x18\x05 \x01(\tB\x03\xe0A\x01R\x05theme\x125\n" +
+	"\x14strip_image_metadata\x18\x06 \x01(\bB\x03\xe0A\x01R\x12stripImageMetadata\x129\n" +
+	"\x16keep_image_orientation\x18\a \x01(\bB\x03\xe0A\x01R\x14keepImageOrientation:F\xeaAC\n" +
 	"\x18memos.api.v1/UserSetting\x12\fusers/{user}*\fuserSettings2\vuserSetting\"F\n" +
 	"\x15GetUserSettingRequest\x12-\n" +
 	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
@@ -2032,14 +6089,20 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x18UpdateUserSettingRequest\x128\n" +
 	"\asetting\x18\x01 \x01(\v2\x19.memos.api.v1.UserSettingB\x03\xe0A\x02R\asetting\x12@\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
-	"updateMask\"\xe7\x02\n" +
+	"updateMask\"\xb3\x04\n" +
 	"\x0fUserAccessToken\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12&\n" +
 	"\faccess_token\x18\x02 \x01(\tB\x03\xe0A\x03R\vaccessToken\x12%\n" +
 	"\vdescription\x18\x03 \x01(\tB\x03\xe0A\x01R\vdescription\x12<\n" +
 	"\tissued_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\bissuedAt\x12>\n" +
 	"\n" +
-	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\texpiresAt:n\xeaAk\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\texpiresAt\x126\n" +
+	"\x15rate_limit_per_minute\x18\x06 \x01(\x05B\x03\xe0A\x01R\x12rateLimitPerMinute\x12(\n" +
+	"\rrequest_count\x18\a \x01(\x03B\x03\xe0A\x03R\frequestCount\x12A\n" +
+	"\flast_used_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"lastUsedAt\x12%\n" +
+	"\flast_used_ip\x18\t \x01(\tB\x03\xe0A\x03R\n" +
+	"lastUsedIp:n\xeaAk\n" +
 	"\x1cmemos.api.v1/UserAccessToken\x12(users/{user}/accessTokens/{access_token}*\x10userAccessTokens2\x0fuserAccessToken\"\x96\x01\n" +
 	"\x1bListUserAccessTokensRequest\x121\n" +
 	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
@@ -2056,7 +6119,11 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
 	"\x11memos.api.v1/UserR\x06parent\x12E\n" +
 	"\faccess_token\x18\x02 \x01(\v2\x1d.memos.api.v1.UserAccessTokenB\x03\xe0A\x02R\vaccessToken\x12+\n" +
-	"\x0faccess_token_id\x18\x03 \x01(\tB\x03\xe0A\x01R\raccessTokenId\"X\n" +
+	"\x0faccess_token_id\x18\x03 \x01(\tB\x03\xe0A\x01R\raccessTokenId\"\xa7\x01\n" +
+	"\x1cUpdateUserAccessTokenRequest\x12E\n" +
+	"\faccess_token\x18\x01 \x01(\v2\x1d.memos.api.v1.UserAccessTokenB\x03\xe0A\x02R\vaccessToken\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
+	"updateMask\"X\n" +
 	"\x1cDeleteUserAccessTokenRequest\x128\n" +
 	"\x04name\x18\x01 \x01(\tB$\xe0A\x02\xfaA\x1e\n" +
 	"\x1cmemos.api.v1/UserAccessTokenR\x04name\"\x94\x04\n" +
@@ -2087,17 +6154,301 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\bsessions\x18\x01 \x03(\v2\x19.memos.api.v1.UserSessionR\bsessions\"P\n" +
 	"\x18RevokeUserSessionRequest\x124\n" +
 	"\x04name\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\n" +
-	"\x18memos.api.v1/UserSessionR\x04name\"_\n" +
+	"\x18memos.api.v1/UserSessionR\x04name\"\xc0\x01\n" +
+	"\rUserTwoFactor\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x1d\n" +
+	"\aenabled\x18\x02 \x01(\bB\x03\xe0A\x03R\aenabled\x123\n" +
+	"\x13recovery_code_count\x18\x03 \x01(\x05B\x03\xe0A\x03R\x11recoveryCodeCount:B\xeaA?\n" +
+	"\x1amemos.api.v1/UserTwoFactor\x12\x16users/{user}/twoFactor2\ttwoFactor\"Q\n" +
+	"\x17GetUserTwoFactorRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/UserTwoFactorR\x04name\"S\n" +
+	"\x19SetupUserTwoFactorRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/UserTwoFactorR\x04name\"_\n" +
+	"\x1aSetupUserTwoFactorResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12)\n" +
+	"\x10provisioning_uri\x18\x02 \x01(\tR\x0fprovisioningUri\"m\n" +
+	"\x1aEnableUserTwoFactorRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/UserTwoFactorR\x04name\x12\x17\n" +
+	"\x04code\x18\x02 \x01(\tB\x03\xe0A\x02R\x04code\"D\n" +
+	"\x1bEnableUserTwoFactorResponse\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\"n\n" +
+	"\x1bDisableUserTwoFactorRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/UserTwoFactorR\x04name\x12\x17\n" +
+	"\x04code\x18\x02 \x01(\tB\x03\xe0A\x01R\x04code\"u\n" +
+	"\"RegenerateUserRecoveryCodesRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/UserTwoFactorR\x04name\x12\x17\n" +
+	"\x04code\x18\x02 \x01(\tB\x03\xe0A\x02R\x04code\"L\n" +
+	"#RegenerateUserRecoveryCodesResponse\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\"\x97\x02\n" +
+	"\x0eUserSuspension\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12!\n" +
+	"\tsuspended\x18\x02 \x01(\bB\x03\xe0A\x03R\tsuspended\x12\x1b\n" +
+	"\x06reason\x18\x03 \x01(\tB\x03\xe0A\x03R\x06reason\x12B\n" +
+	"\fsuspend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vsuspendTime\x12!\n" +
+	"\tsuspender\x18\x05 \x01(\tB\x03\xe0A\x03R\tsuspender:E\xeaAB\n" +
+	"\x1bmemos.api.v1/UserSuspension\x12\x17users/{user}/suspension2\n" +
+	"suspension\"S\n" +
+	"\x18GetUserSuspensionRequest\x127\n" +
+	"\x04name\x18\x01 \x01(\tB#\xe0A\x02\xfaA\x1d\n" +
+	"\x1bmemos.api.v1/UserSuspensionR\x04name\"`\n" +
+	"\x12SuspendUserRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x04name\x12\x1b\n" +
+	"\x06reason\x18\x02 \x01(\tB\x03\xe0A\x01R\x06reason\"E\n" +
+	"\x14UnsuspendUserRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x04name\"\xaa\x01\n" +
+	"\rUserMemoEmail\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x1d\n" +
+	"\aenabled\x18\x02 \x01(\bB\x03\xe0A\x03R\aenabled\x12\x1d\n" +
+	"\aaddress\x18\x03 \x01(\tB\x03\xe0A\x03R\aaddress:B\xeaA?\n" +
+	"\x1amemos.api.v1/UserMemoEmail\x12\x16users/{user}/memoEmail2\tmemoEmail\"Q\n" +
+	"\x17GetUserMemoEmailRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/UserMemoEmailR\x04name\"S\n" +
+	"\x19ResetUserMemoEmailRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/UserMemoEmailR\x04name\"U\n" +
+	"\x1bDisableUserMemoEmailRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/UserMemoEmailR\x04name\"\xae\x01\n" +
+	"\x10UserCalendarFeed\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x1d\n" +
+	"\aenabled\x18\x02 \x01(\bB\x03\xe0A\x03R\aenabled\x12\x15\n" +
+	"\x03url\x18\x03 \x01(\tB\x03\xe0A\x03R\x03url:K\xeaAH\n" +
+	"\x1dmemos.api.v1/UserCalendarFeed\x12\x19users/{user}/calendarFeed2\fcalendarFeed\"W\n" +
+	"\x1aGetUserCalendarFeedRequest\x129\n" +
+	"\x04name\x18\x01 \x01(\tB%\xe0A\x02\xfaA\x1f\n" +
+	"\x1dmemos.api.v1/UserCalendarFeedR\x04name\"Y\n" +
+	"\x1cResetUserCalendarFeedRequest\x129\n" +
+	"\x04name\x18\x01 \x01(\tB%\xe0A\x02\xfaA\x1f\n" +
+	"\x1dmemos.api.v1/UserCalendarFeedR\x04name\"[\n" +
+	"\x1eDisableUserCalendarFeedRequest\x129\n" +
+	"\x04name\x18\x01 \x01(\tB%\xe0A\x02\xfaA\x1f\n" +
+	"\x1dmemos.api.v1/UserCalendarFeedR\x04name\"\x96\x02\n" +
+	"\fUserReadwise\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12!\n" +
+	"\tconnected\x18\x02 \x01(\bB\x03\xe0A\x03R\tconnected\x12\x15\n" +
+	"\x03tag\x18\x03 \x01(\tB\x03\xe0A\x03R\x03tag\x12E\n" +
+	"\x0elast_sync_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\flastSyncTime\x12+\n" +
+	"\x0flast_sync_error\x18\x05 \x01(\tB\x03\xe0A\x03R\rlastSyncError:?\xeaA<\n" +
+	"\x19memos.api.v1/UserReadwise\x12\x15users/{user}/readwise2\breadwise\"O\n" +
+	"\x16GetUserReadwiseRequest\x125\n" +
+	"\x04name\x18\x01 \x01(\tB!\xe0A\x02\xfaA\x1b\n" +
+	"\x19memos.api.v1/UserReadwiseR\x04name\"\x85\x01\n" +
+	"\x1aConnectUserReadwiseRequest\x125\n" +
+	"\x04name\x18\x01 \x01(\tB!\xe0A\x02\xfaA\x1b\n" +
+	"\x19memos.api.v1/UserReadwiseR\x04name\x12\x19\n" +
+	"\x05token\x18\x02 \x01(\tB\x03\xe0A\x02R\x05token\x12\x15\n" +
+	"\x03tag\x18\x03 \x01(\tB\x03\xe0A\x01R\x03tag\"V\n" +
+	"\x1dDisconnectUserReadwiseRequest\x125\n" +
+	"\x04name\x18\x01 \x01(\tB!\xe0A\x02\xfaA\x1b\n" +
+	"\x19memos.api.v1/UserReadwiseR\x04name\"\xcd\x03\n" +
+	"\x17UserWebPushSubscription\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x1f\n" +
+	"\bendpoint\x18\x02 \x01(\tB\x03\xe0A\x02R\bendpoint\x12C\n" +
+	"\x04keys\x18\x03 \x01(\v2*.memos.api.v1.UserWebPushSubscription.KeysB\x03\xe0A\x04R\x04keys\x12\"\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tB\x03\xe0A\x01R\tuserAgent\x12@\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x1a2\n" +
+	"\x04Keys\x12\x16\n" +
+	"\x06p256dh\x18\x01 \x01(\tR\x06p256dh\x12\x12\n" +
+	"\x04auth\x18\x02 \x01(\tR\x04auth:\x98\x01\xeaA\x94\x01\n" +
+	"$memos.api.v1/UserWebPushSubscription\x129users/{user}/webPushSubscriptions/{web_push_subscription}*\x18userWebPushSubscriptions2\x17userWebPushSubscription\"X\n" +
+	"#ListUserWebPushSubscriptionsRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\"\x83\x01\n" +
+	"$ListUserWebPushSubscriptionsResponse\x12[\n" +
+	"\x16web_push_subscriptions\x18\x01 \x03(\v2%.memos.api.v1.UserWebPushSubscriptionR\x14webPushSubscriptions\"\xb9\x01\n" +
+	"$CreateUserWebPushSubscriptionRequest\x121\n" +
+	"\x06parent\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x06parent\x12^\n" +
+	"\x15web_push_subscription\x18\x02 \x01(\v2%.memos.api.v1.UserWebPushSubscriptionB\x03\xe0A\x02R\x13webPushSubscription\"h\n" +
+	"$DeleteUserWebPushSubscriptionRequest\x12@\n" +
+	"\x04name\x18\x01 \x01(\tB,\xe0A\x02\xfaA&\n" +
+	"$memos.api.v1/UserWebPushSubscriptionR\x04name\"\x88\x03\n" +
+	"\fUserGistSync\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12!\n" +
+	"\tconnected\x18\x02 \x01(\bB\x03\xe0A\x03R\tconnected\x12E\n" +
+	"\x0elast_sync_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\flastSyncTime\x12+\n" +
+	"\x0flast_sync_error\x18\x04 \x01(\tB\x03\xe0A\x03R\rlastSyncError\x12J\n" +
+	"\tgist_urls\x18\x05 \x03(\v2(.memos.api.v1.UserGistSync.GistUrlsEntryB\x03\xe0A\x03R\bgistUrls\x1a;\n" +
+	"\rGistUrlsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01:?\xeaA<\n" +
+	"\x19memos.api.v1/UserGistSync\x12\x15users/{user}/gistSync2\bgistSync\"O\n" +
+	"\x16GetUserGistSyncRequest\x125\n" +
+	"\x04name\x18\x01 \x01(\tB!\xe0A\x02\xfaA\x1b\n" +
+	"\x19memos.api.v1/UserGistSyncR\x04name\"n\n" +
+	"\x1aConnectUserGistSyncRequest\x125\n" +
+	"\x04name\x18\x01 \x01(\tB!\xe0A\x02\xfaA\x1b\n" +
+	"\x19memos.api.v1/UserGistSyncR\x04name\x12\x19\n" +
+	"\x05token\x18\x02 \x01(\tB\x03\xe0A\x02R\x05token\"V\n" +
+	"\x1dDisconnectUserGistSyncRequest\x125\n" +
+	"\x04name\x18\x01 \x01(\tB!\xe0A\x02\xfaA\x1b\n" +
+	"\x19memos.api.v1/UserGistSyncR\x04name\"\x90\x04\n" +
+	"\x1bUserNotificationPreferences\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12V\n" +
+	"\vpreferences\x18\x02 \x03(\v24.memos.api.v1.UserNotificationPreferences.PreferenceR\vpreferences\x1a\x98\x01\n" +
+	"\n" +
+	"Preference\x12J\n" +
+	"\x05event\x18\x01 \x01(\x0e2/.memos.api.v1.UserNotificationPreferences.EventB\x03\xe0A\x02R\x05event\x12\x14\n" +
+	"\x05inbox\x18\x02 \x01(\bR\x05inbox\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\bR\x05email\x12\x12\n" +
+	"\x04push\x18\x04 \x01(\bR\x04push\"w\n" +
+	"\x05Event\x12\x15\n" +
+	"\x11EVENT_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aCOMMENT\x10\x01\x12\f\n" +
+	"\bREACTION\x10\x02\x12\v\n" +
+	"\aMENTION\x10\x03\x12\f\n" +
+	"\bREMINDER\x10\x04\x12\x10\n" +
+	"\fANNOUNCEMENT\x10\x05\x12\x0f\n" +
+	"\vON_THIS_DAY\x10\x06:l\xeaAi\n" +
+	"(memos.api.v1/UserNotificationPreferences\x12$users/{user}/notificationPreferences2\x17notificationPreferences\"m\n" +
+	"%GetUserNotificationPreferencesRequest\x12D\n" +
+	"\x04name\x18\x01 \x01(\tB0\xe0A\x02\xfaA*\n" +
+	"(memos.api.v1/UserNotificationPreferencesR\x04name\"\x95\x01\n" +
+	"(UpdateUserNotificationPreferencesRequest\x12i\n" +
+	"\x18notification_preferences\x18\x01 \x01(\v2).memos.api.v1.UserNotificationPreferencesB\x03\xe0A\x02R\x17notificationPreferences\"\x8c\x03\n" +
+	"\x0fUserEmailDigest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12E\n" +
+	"\tfrequency\x18\x02 \x01(\x0e2'.memos.api.v1.UserEmailDigest.FrequencyR\tfrequency\x12\x12\n" +
+	"\x04hour\x18\x03 \x01(\x05R\x04hour\x12\x18\n" +
+	"\aweekday\x18\x04 \x01(\x05R\aweekday\x12\x1b\n" +
+	"\ttime_zone\x18\x05 \x01(\tR\btimeZone\x12E\n" +
+	"\x0elast_send_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\flastSendTime\"=\n" +
+	"\tFrequency\x12\x19\n" +
+	"\x15FREQUENCY_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05DAILY\x10\x01\x12\n" +
+	"\n" +
+	"\x06WEEKLY\x10\x02:H\xeaAE\n" +
+	"\x1cmemos.api.v1/UserEmailDigest\x12\x18users/{user}/emailDigest2\vemailDigest\"U\n" +
+	"\x19GetUserEmailDigestRequest\x128\n" +
+	"\x04name\x18\x01 \x01(\tB$\xe0A\x02\xfaA\x1e\n" +
+	"\x1cmemos.api.v1/UserEmailDigestR\x04name\"e\n" +
+	"\x1cUpdateUserEmailDigestRequest\x12E\n" +
+	"\femail_digest\x18\x01 \x01(\v2\x1d.memos.api.v1.UserEmailDigestB\x03\xe0A\x02R\vemailDigest\"\x82\x02\n" +
+	"\rUserOnThisDay\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12\x12\n" +
+	"\x04hour\x18\x03 \x01(\x05R\x04hour\x12\x1b\n" +
+	"\ttime_zone\x18\x04 \x01(\tR\btimeZone\x12I\n" +
+	"\x10last_notify_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\x0elastNotifyTime:B\xeaA?\n" +
+	"\x1amemos.api.v1/UserOnThisDay\x12\x16users/{user}/onThisDay2\tonThisDay\"Q\n" +
+	"\x17GetUserOnThisDayRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/UserOnThisDayR\x04name\"^\n" +
+	"\x1aUpdateUserOnThisDayRequest\x12@\n" +
+	"\von_this_day\x18\x01 \x01(\v2\x1b.memos.api.v1.UserOnThisDayB\x03\xe0A\x02R\tonThisDay\"\xab\x02\n" +
+	"\tUserSlack\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x1b\n" +
+	"\x06linked\x18\x02 \x01(\bB\x03\xe0A\x03R\x06linked\x12\x1c\n" +
+	"\ateam_id\x18\x03 \x01(\tB\x03\xe0A\x03R\x06teamId\x12\x1c\n" +
+	"\auser_id\x18\x04 \x01(\tB\x03\xe0A\x03R\x06userId\x12 \n" +
+	"\tlink_code\x18\x05 \x01(\tB\x03\xe0A\x03R\blinkCode\x12R\n" +
+	"\x15link_code_expire_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\x12linkCodeExpireTime:6\xeaA3\n" +
+	"\x16memos.api.v1/UserSlack\x12\x12users/{user}/slack2\x05slack\"I\n" +
+	"\x13GetUserSlackRequest\x122\n" +
+	"\x04name\x18\x01 \x01(\tB\x1e\xe0A\x02\xfaA\x18\n" +
+	"\x16memos.api.v1/UserSlackR\x04name\"V\n" +
+	" GenerateUserSlackLinkCodeRequest\x122\n" +
+	"\x04name\x18\x01 \x01(\tB\x1e\xe0A\x02\xfaA\x18\n" +
+	"\x16memos.api.v1/UserSlackR\x04name\"L\n" +
+	"\x16UnlinkUserSlackRequest\x122\n" +
+	"\x04name\x18\x01 \x01(\tB\x1e\xe0A\x02\xfaA\x18\n" +
+	"\x16memos.api.v1/UserSlackR\x04name\"\x95\x02\n" +
+	"\vUserDiscord\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x1b\n" +
+	"\x06linked\x18\x02 \x01(\bB\x03\xe0A\x03R\x06linked\x12\x1c\n" +
+	"\auser_id\x18\x03 \x01(\tB\x03\xe0A\x03R\x06userId\x12 \n" +
+	"\tlink_code\x18\x04 \x01(\tB\x03\xe0A\x03R\blinkCode\x12R\n" +
+	"\x15link_code_expire_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\x12linkCodeExpireTime:<\xeaA9\n" +
+	"\x18memos.api.v1/UserDiscord\x12\x14users/{user}/discord2\adiscord\"M\n" +
+	"\x15GetUserDiscordRequest\x124\n" +
+	"\x04name\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\n" +
+	"\x18memos.api.v1/UserDiscordR\x04name\"Z\n" +
+	"\"GenerateUserDiscordLinkCodeRequest\x124\n" +
+	"\x04name\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\n" +
+	"\x18memos.api.v1/UserDiscordR\x04name\"P\n" +
+	"\x18UnlinkUserDiscordRequest\x124\n" +
+	"\x04name\x18\x01 \x01(\tB \xe0A\x02\xfaA\x1a\n" +
+	"\x18memos.api.v1/UserDiscordR\x04name\"\x91\x01\n" +
 	"\x17ListAllUserStatsRequest\x12 \n" +
 	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\"\x99\x01\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\x120\n" +
+	"\x05space\x18\x03 \x01(\tB\x1a\xe0A\x01\xfaA\x14\n" +
+	"\x12memos.api.v1/SpaceR\x05space\"\x99\x01\n" +
 	"\x18ListAllUserStatsResponse\x126\n" +
 	"\n" +
 	"user_stats\x18\x01 \x03(\v2\x17.memos.api.v1.UserStatsR\tuserStats\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
 	"\n" +
-	"total_size\x18\x03 \x01(\x05R\ttotalSize2\xe2\x10\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\xdb\x01\n" +
+	"\x0fUserPermissions\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12$\n" +
+	"\vcustom_role\x18\x02 \x01(\tB\x03\xe0A\x03R\n" +
+	"customRole\x12?\n" +
+	"\vpermissions\x18\x03 \x03(\x0e2\x18.memos.api.v1.PermissionB\x03\xe0A\x03R\vpermissions:H\xeaAE\n" +
+	"\x1cmemos.api.v1/UserPermissions\x12\x18users/{user}/permissions2\vpermissions\"U\n" +
+	"\x19GetUserPermissionsRequest\x128\n" +
+	"\x04name\x18\x01 \x01(\tB$\xe0A\x02\xfaA\x1e\n" +
+	"\x1cmemos.api.v1/UserPermissionsR\x04name\"o\n" +
+	"\x18SetUserCustomRoleRequest\x12-\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\x04name\x12$\n" +
+	"\vcustom_role\x18\x02 \x01(\tB\x03\xe0A\x01R\n" +
+	"customRole\"\xa8\x03\n" +
+	"\n" +
+	"Invitation\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x1d\n" +
+	"\acreator\x18\x02 \x01(\tB\x03\xe0A\x03R\acreator\x12\x19\n" +
+	"\x05token\x18\x03 \x01(\tB\x03\xe0A\x03R\x05token\x120\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x17.memos.api.v1.User.RoleB\x03\xe0A\x01R\x04role\x12\x1e\n" +
+	"\bmax_uses\x18\x05 \x01(\x05B\x03\xe0A\x01R\amaxUses\x12 \n" +
+	"\tuse_count\x18\x06 \x01(\x05B\x03\xe0A\x03R\buseCount\x12@\n" +
+	"\vexpire_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x01R\n" +
+	"expireTime\x12@\n" +
+	"\vcreate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime:O\xeaAL\n" +
+	"\x17memos.api.v1/Invitation\x12\x18invitations/{invitation}*\vinvitations2\n" +
+	"invitation\"\x18\n" +
+	"\x16ListInvitationsRequest\"U\n" +
+	"\x17ListInvitationsResponse\x12:\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x18.memos.api.v1.InvitationR\vinvitations\"X\n" +
+	"\x17CreateInvitationRequest\x12=\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2\x18.memos.api.v1.InvitationB\x03\xe0A\x02R\n" +
+	"invitation\"N\n" +
+	"\x17DeleteInvitationRequest\x123\n" +
+	"\x04name\x18\x01 \x01(\tB\x1f\xe0A\x02\xfaA\x19\n" +
+	"\x17memos.api.v1/InvitationR\x04name\"\x9c\x02\n" +
+	"\rUserReadGrant\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x123\n" +
+	"\agrantee\x18\x02 \x01(\tB\x19\xe0A\x02\xfaA\x13\n" +
+	"\x11memos.api.v1/UserR\agrantee\x12\x15\n" +
+	"\x03tag\x18\x03 \x01(\tB\x03\xe0A\x01R\x03tag\x12@\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime:d\xeaAa\n" +
+	"\x1amemos.api.v1/UserReadGrant\x12$users/{user}/readGrants/{read_grant}*\x0euserReadGrants2\ruserReadGrant\"W\n" +
+	"\x19ListUserReadGrantsRequest\x12:\n" +
+	"\x06parent\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\x12\x1amemos.api.v1/UserReadGrantR\x06parent\"Z\n" +
+	"\x1aListUserReadGrantsResponse\x12<\n" +
+	"\vread_grants\x18\x01 \x03(\v2\x1b.memos.api.v1.UserReadGrantR\n" +
+	"readGrants\"\x99\x01\n" +
+	"\x1aCreateUserReadGrantRequest\x12:\n" +
+	"\x06parent\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\x12\x1amemos.api.v1/UserReadGrantR\x06parent\x12?\n" +
+	"\n" +
+	"read_grant\x18\x02 \x01(\v2\x1b.memos.api.v1.UserReadGrantB\x03\xe0A\x02R\treadGrant\"T\n" +
+	"\x1aDeleteUserReadGrantRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/UserReadGrantR\x04name2\xb2I\n" +
 	"\vUserService\x12c\n" +
 	"\tListUsers\x12\x1e.memos.api.v1.ListUsersRequest\x1a\x1f.memos.api.v1.ListUsersResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/users\x12b\n" +
 	"\aGetUser\x12\x1c.memos.api.v1.GetUserRequest\x1a\x12.memos.api.v1.User\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/{name=users/*}\x12e\n" +
@@ -2106,7 +6457,8 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\n" +
 	"UpdateUser\x12\x1f.memos.api.v1.UpdateUserRequest\x1a\x12.memos.api.v1.User\"<\xdaA\x10user,update_mask\x82\xd3\xe4\x93\x02#:\x04user2\x1b/api/v1/{user.name=users/*}\x12l\n" +
 	"\n" +
-	"DeleteUser\x12\x1f.memos.api.v1.DeleteUserRequest\x1a\x16.google.protobuf.Empty\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/{name=users/*}\x12x\n" +
+	"DeleteUser\x12\x1f.memos.api.v1.DeleteUserRequest\x1a\x16.google.protobuf.Empty\"%\xdaA\x04name\x82\xd3\xe4\x93\x02\x18*\x16/api/v1/{name=users/*}\x12\xad\x01\n" +
+	"\x11DeleteUserAccount\x12&.memos.api.v1.DeleteUserAccountRequest\x1a'.memos.api.v1.DeleteUserAccountResponse\"G\xdaA\x15name,confirm_username\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/{name=users/*}:deleteAccount\x12x\n" +
 	"\vSearchUsers\x12 .memos.api.v1.SearchUsersRequest\x1a!.memos.api.v1.SearchUsersResponse\"$\xdaA\x05query\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/users:search\x12w\n" +
 	"\rGetUserAvatar\x12\".memos.api.v1.GetUserAvatarRequest\x1a\x14.google.api.HttpBody\",\xdaA\x04name\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/{name=users/*}/avatar\x12~\n" +
 	"\x10ListAllUserStats\x12%.memos.api.v1.ListAllUserStatsRequest\x1a&.memos.api.v1.ListAllUserStatsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/users:stats\x12z\n" +
@@ -2114,10 +6466,59 @@ const file_api_v1_user_service_proto_rawDesc = "" +
 	"\x0eGetUserSetting\x12#.memos.api.v1.GetUserSettingRequest\x1a\x19.memos.api.v1.UserSetting\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#\x12!/api/v1/{name=users/*}:getSetting\x12\xab\x01\n" +
 	"\x11UpdateUserSetting\x12&.memos.api.v1.UpdateUserSettingRequest\x1a\x19.memos.api.v1.UserSetting\"S\xdaA\x13setting,update_mask\x82\xd3\xe4\x93\x027:\asetting2,/api/v1/{setting.name=users/*}:updateSetting\x12\xa5\x01\n" +
 	"\x14ListUserAccessTokens\x12).memos.api.v1.ListUserAccessTokensRequest\x1a*.memos.api.v1.ListUserAccessTokensResponse\"6\xdaA\x06parent\x82\xd3\xe4\x93\x02'\x12%/api/v1/{parent=users/*}/accessTokens\x12\xb5\x01\n" +
-	"\x15CreateUserAccessToken\x12*.memos.api.v1.CreateUserAccessTokenRequest\x1a\x1d.memos.api.v1.UserAccessToken\"Q\xdaA\x13parent,access_token\x82\xd3\xe4\x93\x025:\faccess_token\"%/api/v1/{parent=users/*}/accessTokens\x12\x91\x01\n" +
+	"\x15CreateUserAccessToken\x12*.memos.api.v1.CreateUserAccessTokenRequest\x1a\x1d.memos.api.v1.UserAccessToken\"Q\xdaA\x13parent,access_token\x82\xd3\xe4\x93\x025:\faccess_token\"%/api/v1/{parent=users/*}/accessTokens\x12\xc7\x01\n" +
+	"\x15UpdateUserAccessToken\x12*.memos.api.v1.UpdateUserAccessTokenRequest\x1a\x1d.memos.api.v1.UserAccessToken\"c\xdaA\x18access_token,update_mask\x82\xd3\xe4\x93\x02B:\faccess_token22/api/v1/{access_token.name=users/*/accessTokens/*}\x12\x91\x01\n" +
 	"\x15DeleteUserAccessToken\x12*.memos.api.v1.DeleteUserAccessTokenRequest\x1a\x16.google.protobuf.Empty\"4\xdaA\x04name\x82\xd3\xe4\x93\x02'*%/api/v1/{name=users/*/accessTokens/*}\x12\x95\x01\n" +
 	"\x10ListUserSessions\x12%.memos.api.v1.ListUserSessionsRequest\x1a&.memos.api.v1.ListUserSessionsResponse\"2\xdaA\x06parent\x82\xd3\xe4\x93\x02#\x12!/api/v1/{parent=users/*}/sessions\x12\x85\x01\n" +
-	"\x11RevokeUserSession\x12&.memos.api.v1.RevokeUserSessionRequest\x1a\x16.google.protobuf.Empty\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#*!/api/v1/{name=users/*/sessions/*}B\xa8\x01\n" +
+	"\x11RevokeUserSession\x12&.memos.api.v1.RevokeUserSessionRequest\x1a\x16.google.protobuf.Empty\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#*!/api/v1/{name=users/*/sessions/*}\x12\x87\x01\n" +
+	"\x10GetUserTwoFactor\x12%.memos.api.v1.GetUserTwoFactorRequest\x1a\x1b.memos.api.v1.UserTwoFactor\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=users/*/twoFactor}\x12\xa1\x01\n" +
+	"\x12SetupUserTwoFactor\x12'.memos.api.v1.SetupUserTwoFactorRequest\x1a(.memos.api.v1.SetupUserTwoFactorResponse\"8\xdaA\x04name\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/{name=users/*/twoFactor}:setup\x12\xaa\x01\n" +
+	"\x13EnableUserTwoFactor\x12(.memos.api.v1.EnableUserTwoFactorRequest\x1a).memos.api.v1.EnableUserTwoFactorResponse\">\xdaA\tname,code\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/{name=users/*/twoFactor}:enable\x12\x9a\x01\n" +
+	"\x14DisableUserTwoFactor\x12).memos.api.v1.DisableUserTwoFactorRequest\x1a\x16.google.protobuf.Empty\"?\xdaA\tname,code\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/{name=users/*/twoFactor}:disable\x12\xd3\x01\n" +
+	"\x1bRegenerateUserRecoveryCodes\x120.memos.api.v1.RegenerateUserRecoveryCodesRequest\x1a1.memos.api.v1.RegenerateUserRecoveryCodesResponse\"O\xdaA\tname,code\x82\xd3\xe4\x93\x02=:\x01*\"8/api/v1/{name=users/*/twoFactor}:regenerateRecoveryCodes\x12\x8b\x01\n" +
+	"\x11GetUserSuspension\x12&.memos.api.v1.GetUserSuspensionRequest\x1a\x1c.memos.api.v1.UserSuspension\"0\xdaA\x04name\x82\xd3\xe4\x93\x02#\x12!/api/v1/{name=users/*/suspension}\x12\x86\x01\n" +
+	"\vSuspendUser\x12 .memos.api.v1.SuspendUserRequest\x1a\x1c.memos.api.v1.UserSuspension\"7\xdaA\vname,reason\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/{name=users/*}:suspend\x12\x85\x01\n" +
+	"\rUnsuspendUser\x12\".memos.api.v1.UnsuspendUserRequest\x1a\x1c.memos.api.v1.UserSuspension\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/{name=users/*}:unsuspend\x12\x87\x01\n" +
+	"\x10GetUserMemoEmail\x12%.memos.api.v1.GetUserMemoEmailRequest\x1a\x1b.memos.api.v1.UserMemoEmail\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=users/*/memoEmail}\x12\x94\x01\n" +
+	"\x12ResetUserMemoEmail\x12'.memos.api.v1.ResetUserMemoEmailRequest\x1a\x1b.memos.api.v1.UserMemoEmail\"8\xdaA\x04name\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/{name=users/*/memoEmail}:reset\x12\x9a\x01\n" +
+	"\x14DisableUserMemoEmail\x12).memos.api.v1.DisableUserMemoEmailRequest\x1a\x1b.memos.api.v1.UserMemoEmail\":\xdaA\x04name\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/{name=users/*/memoEmail}:disable\x12\x93\x01\n" +
+	"\x13GetUserCalendarFeed\x12(.memos.api.v1.GetUserCalendarFeedRequest\x1a\x1e.memos.api.v1.UserCalendarFeed\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%\x12#/api/v1/{name=users/*/calendarFeed}\x12\xa0\x01\n" +
+	"\x15ResetUserCalendarFeed\x12*.memos.api.v1.ResetUserCalendarFeedRequest\x1a\x1e.memos.api.v1.UserCalendarFeed\";\xdaA\x04name\x82\xd3\xe4\x93\x02.:\x01*\")/api/v1/{name=users/*/calendarFeed}:reset\x12\xa6\x01\n" +
+	"\x17DisableUserCalendarFeed\x12,.memos.api.v1.DisableUserCalendarFeedRequest\x1a\x1e.memos.api.v1.UserCalendarFeed\"=\xdaA\x04name\x82\xd3\xe4\x93\x020:\x01*\"+/api/v1/{name=users/*/calendarFeed}:disable\x12\x83\x01\n" +
+	"\x0fGetUserReadwise\x12$.memos.api.v1.GetUserReadwiseRequest\x1a\x1a.memos.api.v1.UserReadwise\".\xdaA\x04name\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/{name=users/*/readwise}\x12\x9c\x01\n" +
+	"\x13ConnectUserReadwise\x12(.memos.api.v1.ConnectUserReadwiseRequest\x1a\x1a.memos.api.v1.UserReadwise\"?\xdaA\n" +
+	"name,token\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/{name=users/*/readwise}:connect\x12\x9f\x01\n" +
+	"\x16DisconnectUserReadwise\x12+.memos.api.v1.DisconnectUserReadwiseRequest\x1a\x1a.memos.api.v1.UserReadwise\"<\xdaA\x04name\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/{name=users/*/readwise}:disconnect\x12\x83\x01\n" +
+	"\x0fGetUserGistSync\x12$.memos.api.v1.GetUserGistSyncRequest\x1a\x1a.memos.api.v1.UserGistSync\".\xdaA\x04name\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/{name=users/*/gistSync}\x12\x9c\x01\n" +
+	"\x13ConnectUserGistSync\x12(.memos.api.v1.ConnectUserGistSyncRequest\x1a\x1a.memos.api.v1.UserGistSync\"?\xdaA\n" +
+	"name,token\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/{name=users/*/gistSync}:connect\x12\x9f\x01\n" +
+	"\x16DisconnectUserGistSync\x12+.memos.api.v1.DisconnectUserGistSyncRequest\x1a\x1a.memos.api.v1.UserGistSync\"<\xdaA\x04name\x82\xd3\xe4\x93\x02/:\x01*\"*/api/v1/{name=users/*/gistSync}:disconnect\x12\xbf\x01\n" +
+	"\x1eGetUserNotificationPreferences\x123.memos.api.v1.GetUserNotificationPreferencesRequest\x1a).memos.api.v1.UserNotificationPreferences\"=\xdaA\x04name\x82\xd3\xe4\x93\x020\x12./api/v1/{name=users/*/notificationPreferences}\x12\x8d\x02\n" +
+	"!UpdateUserNotificationPreferences\x126.memos.api.v1.UpdateUserNotificationPreferencesRequest\x1a).memos.api.v1.UserNotificationPreferences\"\x84\x01\xdaA\x18notification_preferences\x82\xd3\xe4\x93\x02c:\x18notification_preferences2G/api/v1/{notification_preferences.name=users/*/notificationPreferences}\x12\x8f\x01\n" +
+	"\x12GetUserEmailDigest\x12'.memos.api.v1.GetUserEmailDigestRequest\x1a\x1d.memos.api.v1.UserEmailDigest\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{name=users/*/emailDigest}\x12\xb8\x01\n" +
+	"\x15UpdateUserEmailDigest\x12*.memos.api.v1.UpdateUserEmailDigestRequest\x1a\x1d.memos.api.v1.UserEmailDigest\"T\xdaA\femail_digest\x82\xd3\xe4\x93\x02?:\femail_digest2//api/v1/{email_digest.name=users/*/emailDigest}\x12\x87\x01\n" +
+	"\x10GetUserOnThisDay\x12%.memos.api.v1.GetUserOnThisDayRequest\x1a\x1b.memos.api.v1.UserOnThisDay\"/\xdaA\x04name\x82\xd3\xe4\x93\x02\"\x12 /api/v1/{name=users/*/onThisDay}\x12\xad\x01\n" +
+	"\x13UpdateUserOnThisDay\x12(.memos.api.v1.UpdateUserOnThisDayRequest\x1a\x1b.memos.api.v1.UserOnThisDay\"O\xdaA\von_this_day\x82\xd3\xe4\x93\x02;:\von_this_day2,/api/v1/{on_this_day.name=users/*/onThisDay}\x12\xc5\x01\n" +
+	"\x1cListUserWebPushSubscriptions\x121.memos.api.v1.ListUserWebPushSubscriptionsRequest\x1a2.memos.api.v1.ListUserWebPushSubscriptionsResponse\">\xdaA\x06parent\x82\xd3\xe4\x93\x02/\x12-/api/v1/{parent=users/*}/webPushSubscriptions\x12\xe7\x01\n" +
+	"\x1dCreateUserWebPushSubscription\x122.memos.api.v1.CreateUserWebPushSubscriptionRequest\x1a%.memos.api.v1.UserWebPushSubscription\"k\xdaA\x1cparent,web_push_subscription\x82\xd3\xe4\x93\x02F:\x15web_push_subscription\"-/api/v1/{parent=users/*}/webPushSubscriptions\x12\xa9\x01\n" +
+	"\x1dDeleteUserWebPushSubscription\x122.memos.api.v1.DeleteUserWebPushSubscriptionRequest\x1a\x16.google.protobuf.Empty\"<\xdaA\x04name\x82\xd3\xe4\x93\x02/*-/api/v1/{name=users/*/webPushSubscriptions/*}\x12w\n" +
+	"\fGetUserSlack\x12!.memos.api.v1.GetUserSlackRequest\x1a\x17.memos.api.v1.UserSlack\"+\xdaA\x04name\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/{name=users/*/slack}\x12\xa5\x01\n" +
+	"\x19GenerateUserSlackLinkCode\x12..memos.api.v1.GenerateUserSlackLinkCodeRequest\x1a\x17.memos.api.v1.UserSlack\"?\xdaA\x04name\x82\xd3\xe4\x93\x022:\x01*\"-/api/v1/{name=users/*/slack}:generateLinkCode\x12\x87\x01\n" +
+	"\x0fUnlinkUserSlack\x12$.memos.api.v1.UnlinkUserSlackRequest\x1a\x17.memos.api.v1.UserSlack\"5\xdaA\x04name\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/{name=users/*/slack}:unlink\x12\x7f\n" +
+	"\x0eGetUserDiscord\x12#.memos.api.v1.GetUserDiscordRequest\x1a\x19.memos.api.v1.UserDiscord\"-\xdaA\x04name\x82\xd3\xe4\x93\x02 \x12\x1e/api/v1/{name=users/*/discord}\x12\xad\x01\n" +
+	"\x1bGenerateUserDiscordLinkCode\x120.memos.api.v1.GenerateUserDiscordLinkCodeRequest\x1a\x19.memos.api.v1.UserDiscord\"A\xdaA\x04name\x82\xd3\xe4\x93\x024:\x01*\"//api/v1/{name=users/*/discord}:generateLinkCode\x12\x8f\x01\n" +
+	"\x11UnlinkUserDiscord\x12&.memos.api.v1.UnlinkUserDiscordRequest\x1a\x19.memos.api.v1.UserDiscord\"7\xdaA\x04name\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/{name=users/*/discord}:unlink\x12\x8f\x01\n" +
+	"\x12GetUserPermissions\x12'.memos.api.v1.GetUserPermissionsRequest\x1a\x1d.memos.api.v1.UserPermissions\"1\xdaA\x04name\x82\xd3\xe4\x93\x02$\x12\"/api/v1/{name=users/*/permissions}\x12\x9e\x01\n" +
+	"\x11SetUserCustomRole\x12&.memos.api.v1.SetUserCustomRoleRequest\x1a\x1d.memos.api.v1.UserPermissions\"B\xdaA\x10name,custom_role\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/{name=users/*}:setCustomRole\x12{\n" +
+	"\x0fListInvitations\x12$.memos.api.v1.ListInvitationsRequest\x1a%.memos.api.v1.ListInvitationsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/invitations\x12\x89\x01\n" +
+	"\x10CreateInvitation\x12%.memos.api.v1.CreateInvitationRequest\x1a\x18.memos.api.v1.Invitation\"4\xdaA\n" +
+	"invitation\x82\xd3\xe4\x93\x02!:\n" +
+	"invitation\"\x13/api/v1/invitations\x12~\n" +
+	"\x10DeleteInvitation\x12%.memos.api.v1.DeleteInvitationRequest\x1a\x16.google.protobuf.Empty\"+\xdaA\x04name\x82\xd3\xe4\x93\x02\x1e*\x1c/api/v1/{name=invitations/*}\x12\x9d\x01\n" +
+	"\x12ListUserReadGrants\x12'.memos.api.v1.ListUserReadGrantsRequest\x1a(.memos.api.v1.ListUserReadGrantsResponse\"4\xdaA\x06parent\x82\xd3\xe4\x93\x02%\x12#/api/v1/{parent=users/*}/readGrants\x12\xa9\x01\n" +
+	"\x13CreateUserReadGrant\x12(.memos.api.v1.CreateUserReadGrantRequest\x1a\x1b.memos.api.v1.UserReadGrant\"K\xdaA\x11parent,read_grant\x82\xd3\xe4\x93\x021:\n" +
+	"read_grant\"#/api/v1/{parent=users/*}/readGrants\x12\x8b\x01\n" +
+	"\x13DeleteUserReadGrant\x12(.memos.api.v1.DeleteUserReadGrantRequest\x1a\x16.google.protobuf.Empty\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%*#/api/v1/{name=users/*/readGrants/*}B\xa8\x01\n" +
 	"\x10com.memos.api.v1B\x10UserServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -2132,107 +6533,300 @@ func file_api_v1_user_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_user_service_proto_rawDescData
 }
 
-var file_api_v1_user_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_api_v1_user_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_v1_user_service_proto_msgTypes = make([]protoimpl.MessageInfo, 99)
 var file_api_v1_user_service_proto_goTypes = []any{
-	(User_Role)(0),                       // 0: memos.api.v1.User.Role
-	(*User)(nil),                         // 1: memos.api.v1.User
-	(*ListUsersRequest)(nil),             // 2: memos.api.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),            // 3: memos.api.v1.ListUsersResponse
-	(*GetUserRequest)(nil),               // 4: memos.api.v1.GetUserRequest
-	(*CreateUserRequest)(nil),            // 5: memos.api.v1.CreateUserRequest
-	(*UpdateUserRequest)(nil),            // 6: memos.api.v1.UpdateUserRequest
-	(*DeleteUserRequest)(nil),            // 7: memos.api.v1.DeleteUserRequest
-	(*SearchUsersRequest)(nil),           // 8: memos.api.v1.SearchUsersRequest
-	(*SearchUsersResponse)(nil),          // 9: memos.api.v1.SearchUsersResponse
-	(*GetUserAvatarRequest)(nil),         // 10: memos.api.v1.GetUserAvatarRequest
-	(*UserStats)(nil),                    // 11: memos.api.v1.UserStats
-	(*GetUserStatsRequest)(nil),          // 12: memos.api.v1.GetUserStatsRequest
-	(*UserSetting)(nil),                  // 13: memos.api.v1.UserSetting
-	(*GetUserSettingRequest)(nil),        // 14: memos.api.v1.GetUserSettingRequest
-	(*UpdateUserSettingRequest)(nil),     // 15: memos.api.v1.UpdateUserSettingRequest
-	(*UserAccessToken)(nil),              // 16: memos.api.v1.UserAccessToken
-	(*ListUserAccessTokensRequest)(nil),  // 17: memos.api.v1.ListUserAccessTokensRequest
-	(*ListUserAccessTokensResponse)(nil), // 18: memos.api.v1.ListUserAccessTokensResponse
-	(*CreateUserAccessTokenRequest)(nil), // 19: memos.api.v1.CreateUserAccessTokenRequest
-	(*DeleteUserAccessTokenRequest)(nil), // 20: memos.api.v1.DeleteUserAccessTokenRequest
-	(*UserSession)(nil),                  // 21: memos.api.v1.UserSession
-	(*ListUserSessionsRequest)(nil),      // 22: memos.api.v1.ListUserSessionsRequest
-	(*ListUserSessionsResponse)(nil),     // 23: memos.api.v1.ListUserSessionsResponse
-	(*RevokeUserSessionRequest)(nil),     // 24: memos.api.v1.RevokeUserSessionRequest
-	(*ListAllUserStatsRequest)(nil),      // 25: memos.api.v1.ListAllUserStatsRequest
-	(*ListAllUserStatsResponse)(nil),     // 26: memos.api.v1.ListAllUserStatsResponse
-	nil,                                  // 27: memos.api.v1.UserStats.TagCountEntry
-	(*UserStats_MemoTypeStats)(nil),      // 28: memos.api.v1.UserStats.MemoTypeStats
-	(*UserSession_ClientInfo)(nil),       // 29: memos.api.v1.UserSession.ClientInfo
-	(State)(0),                           // 30: memos.api.v1.State
-	(*timestamppb.Timestamp)(nil),        // 31: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),        // 32: google.protobuf.FieldMask
-	(*emptypb.Empty)(nil),                // 33: google.protobuf.Empty
-	(*httpbody.HttpBody)(nil),            // 34: google.api.HttpBody
+	(User_Role)(0),                                   // 0: memos.api.v1.User.Role
+	(UserNotificationPreferences_Event)(0),           // 1: memos.api.v1.UserNotificationPreferences.Event
+	(UserEmailDigest_Frequency)(0),                   // 2: memos.api.v1.UserEmailDigest.Frequency
+	(*User)(nil),                                     // 3: memos.api.v1.User
+	(*ListUsersRequest)(nil),                         // 4: memos.api.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),                        // 5: memos.api.v1.ListUsersResponse
+	(*GetUserRequest)(nil),                           // 6: memos.api.v1.GetUserRequest
+	(*CreateUserRequest)(nil),                        // 7: memos.api.v1.CreateUserRequest
+	(*UpdateUserRequest)(nil),                        // 8: memos.api.v1.UpdateUserRequest
+	(*DeleteUserRequest)(nil),                        // 9: memos.api.v1.DeleteUserRequest
+	(*DeleteUserAccountRequest)(nil),                 // 10: memos.api.v1.DeleteUserAccountRequest
+	(*DeleteUserAccountResponse)(nil),                // 11: memos.api.v1.DeleteUserAccountResponse
+	(*SearchUsersRequest)(nil),                       // 12: memos.api.v1.SearchUsersRequest
+	(*SearchUsersResponse)(nil),                      // 13: memos.api.v1.SearchUsersResponse
+	(*GetUserAvatarRequest)(nil),                     // 14: memos.api.v1.GetUserAvatarRequest
+	(*UserStats)(nil),                                // 15: memos.api.v1.UserStats
+	(*GetUserStatsRequest)(nil),                      // 16: memos.api.v1.GetUserStatsRequest
+	(*UserSetting)(nil),                              // 17: memos.api.v1.UserSetting
+	(*GetUserSettingRequest)(nil),                    // 18: memos.api.v1.GetUserSettingRequest
+	(*UpdateUserSettingRequest)(nil),                 // 19: memos.api.v1.UpdateUserSettingRequest
+	(*UserAccessToken)(nil),                          // 20: memos.api.v1.UserAccessToken
+	(*ListUserAccessTokensRequest)(nil),              // 21: memos.api.v1.ListUserAccessTokensRequest
+	(*ListUserAccessTokensResponse)(nil),             // 22: memos.api.v1.ListUserAccessTokensResponse
+	(*CreateUserAccessTokenRequest)(nil),             // 23: memos.api.v1.CreateUserAccessTokenRequest
+	(*UpdateUserAccessTokenRequest)(nil),             // 24: memos.api.v1.UpdateUserAccessTokenRequest
+	(*DeleteUserAccessTokenRequest)(nil),             // 25: memos.api.v1.DeleteUserAccessTokenRequest
+	(*UserSession)(nil),                              // 26: memos.api.v1.UserSession
+	(*ListUserSessionsRequest)(nil),                  // 27: memos.api.v1.ListUserSessionsRequest
+	(*ListUserSessionsResponse)(nil),                 // 28: memos.api.v1.ListUserSessionsResponse
+	(*RevokeUserSessionRequest)(nil),                 // 29: memos.api.v1.RevokeUserSessionRequest
+	(*UserTwoFactor)(nil),                            // 30: memos.api.v1.UserTwoFactor
+	(*GetUserTwoFactorRequest)(nil),                  // 31: memos.api.v1.GetUserTwoFactorRequest
+	(*SetupUserTwoFactorRequest)(nil),                // 32: memos.api.v1.SetupUserTwoFactorRequest
+	(*SetupUserTwoFactorResponse)(nil),               // 33: memos.api.v1.SetupUserTwoFactorResponse
+	(*EnableUserTwoFactorRequest)(nil),               // 34: memos.api.v1.EnableUserTwoFactorRequest
+	(*EnableUserTwoFactorResponse)(nil),              // 35: memos.api.v1.EnableUserTwoFactorResponse
+	(*DisableUserTwoFactorRequest)(nil),              // 36: memos.api.v1.DisableUserTwoFactorRequest
+	(*RegenerateUserRecoveryCodesRequest)(nil),       // 37: memos.api.v1.RegenerateUserRecoveryCodesRequest
+	(*RegenerateUserRecoveryCodesResponse)(nil),      // 38: memos.api.v1.RegenerateUserRecoveryCodesResponse
+	(*UserSuspension)(nil),                           // 39: memos.api.v1.UserSuspension
+	(*GetUserSuspensionRequest)(nil),                 // 40: memos.api.v1.GetUserSuspensionRequest
+	(*SuspendUserRequest)(nil),                       // 41: memos.api.v1.SuspendUserRequest
+	(*UnsuspendUserRequest)(nil),                     // 42: memos.api.v1.UnsuspendUserRequest
+	(*UserMemoEmail)(nil),                            // 43: memos.api.v1.UserMemoEmail
+	(*GetUserMemoEmailRequest)(nil),                  // 44: memos.api.v1.GetUserMemoEmailRequest
+	(*ResetUserMemoEmailRequest)(nil),                // 45: memos.api.v1.ResetUserMemoEmailRequest
+	(*DisableUserMemoEmailRequest)(nil),              // 46: memos.api.v1.DisableUserMemoEmailRequest
+	(*UserCalendarFeed)(nil),                         // 47: memos.api.v1.UserCalendarFeed
+	(*GetUserCalendarFeedRequest)(nil),               // 48: memos.api.v1.GetUserCalendarFeedRequest
+	(*ResetUserCalendarFeedRequest)(nil),             // 49: memos.api.v1.ResetUserCalendarFeedRequest
+	(*DisableUserCalendarFeedRequest)(nil),           // 50: memos.api.v1.DisableUserCalendarFeedRequest
+	(*UserReadwise)(nil),                             // 51: memos.api.v1.UserReadwise
+	(*GetUserReadwiseRequest)(nil),                   // 52: memos.api.v1.GetUserReadwiseRequest
+	(*ConnectUserReadwiseRequest)(nil),               // 53: memos.api.v1.ConnectUserReadwiseRequest
+	(*DisconnectUserReadwiseRequest)(nil),            // 54: memos.api.v1.DisconnectUserReadwiseRequest
+	(*UserWebPushSubscription)(nil),                  // 55: memos.api.v1.UserWebPushSubscription
+	(*ListUserWebPushSubscriptionsRequest)(nil),      // 56: memos.api.v1.ListUserWebPushSubscriptionsRequest
+	(*ListUserWebPushSubscriptionsResponse)(nil),     // 57: memos.api.v1.ListUserWebPushSubscriptionsResponse
+	(*CreateUserWebPushSubscriptionRequest)(nil),     // 58: memos.api.v1.CreateUserWebPushSubscriptionRequest
+	(*DeleteUserWebPushSubscriptionRequest)(nil),     // 59: memos.api.v1.DeleteUserWebPushSubscriptionRequest
+	(*UserGistSync)(nil),                             // 60: memos.api.v1.UserGistSync
+	(*GetUserGistSyncRequest)(nil),                   // 61: memos.api.v1.GetUserGistSyncRequest
+	(*ConnectUserGistSyncRequest)(nil),               // 62: memos.api.v1.ConnectUserGistSyncRequest
+	(*DisconnectUserGistSyncRequest)(nil),            // 63: memos.api.v1.DisconnectUserGistSyncRequest
+	(*UserNotificationPreferences)(nil),              // 64: memos.api.v1.UserNotificationPreferences
+	(*GetUserNotificationPreferencesRequest)(nil),    // 65: memos.api.v1.GetUserNotificationPreferencesRequest
+	(*UpdateUserNotificationPreferencesRequest)(nil), // 66: memos.api.v1.UpdateUserNotificationPreferencesRequest
+	(*UserEmailDigest)(nil),                          // 67: memos.api.v1.UserEmailDigest
+	(*GetUserEmailDigestRequest)(nil),                // 68: memos.api.v1.GetUserEmailDigestRequest
+	(*UpdateUserEmailDigestRequest)(nil),             // 69: memos.api.v1.UpdateUserEmailDigestRequest
+	(*UserOnThisDay)(nil),                            // 70: memos.api.v1.UserOnThisDay
+	(*GetUserOnThisDayRequest)(nil),                  // 71: memos.api.v1.GetUserOnThisDayRequest
+	(*UpdateUserOnThisDayRequest)(nil),               // 72: memos.api.v1.UpdateUserOnThisDayRequest
+	(*UserSlack)(nil),                                // 73: memos.api.v1.UserSlack
+	(*GetUserSlackRequest)(nil),                      // 74: memos.api.v1.GetUserSlackRequest
+	(*GenerateUserSlackLinkCodeRequest)(nil),         // 75: memos.api.v1.GenerateUserSlackLinkCodeRequest
+	(*UnlinkUserSlackRequest)(nil),                   // 76: memos.api.v1.UnlinkUserSlackRequest
+	(*UserDiscord)(nil),                              // 77: memos.api.v1.UserDiscord
+	(*GetUserDiscordRequest)(nil),                    // 78: memos.api.v1.GetUserDiscordRequest
+	(*GenerateUserDiscordLinkCodeRequest)(nil),       // 79: memos.api.v1.GenerateUserDiscordLinkCodeRequest
+	(*UnlinkUserDiscordRequest)(nil),                 // 80: memos.api.v1.UnlinkUserDiscordRequest
+	(*ListAllUserStatsRequest)(nil),                  // 81: memos.api.v1.ListAllUserStatsRequest
+	(*ListAllUserStatsResponse)(nil),                 // 82: memos.api.v1.ListAllUserStatsResponse
+	(*UserPermissions)(nil),                          // 83: memos.api.v1.UserPermissions
+	(*GetUserPermissionsRequest)(nil),                // 84: memos.api.v1.GetUserPermissionsRequest
+	(*SetUserCustomRoleRequest)(nil),                 // 85: memos.api.v1.SetUserCustomRoleRequest
+	(*Invitation)(nil),                               // 86: memos.api.v1.Invitation
+	(*ListInvitationsRequest)(nil),                   // 87: memos.api.v1.ListInvitationsRequest
+	(*ListInvitationsResponse)(nil),                  // 88: memos.api.v1.ListInvitationsResponse
+	(*CreateInvitationRequest)(nil),                  // 89: memos.api.v1.CreateInvitationRequest
+	(*DeleteInvitationRequest)(nil),                  // 90: memos.api.v1.DeleteInvitationRequest
+	(*UserReadGrant)(nil),                            // 91: memos.api.v1.UserReadGrant
+	(*ListUserReadGrantsRequest)(nil),                // 92: memos.api.v1.ListUserReadGrantsRequest
+	(*ListUserReadGrantsResponse)(nil),               // 93: memos.api.v1.ListUserReadGrantsResponse
+	(*CreateUserReadGrantRequest)(nil),               // 94: memos.api.v1.CreateUserReadGrantRequest
+	(*DeleteUserReadGrantRequest)(nil),               // 95: memos.api.v1.DeleteUserReadGrantRequest
+	nil,                                              // 96: memos.api.v1.UserStats.TagCountEntry
+	(*UserStats_MemoTypeStats)(nil),                  // 97: memos.api.v1.UserStats.MemoTypeStats
+	(*UserSession_ClientInfo)(nil),                   // 98: memos.api.v1.UserSession.ClientInfo
+	(*UserWebPushSubscription_Keys)(nil),             // 99: memos.api.v1.UserWebPushSubscription.Keys
+	nil,                                              // 100: memos.api.v1.UserGistSync.GistUrlsEntry
+	(*UserNotificationPreferences_Preference)(nil),   // 101: memos.api.v1.UserNotificationPreferences.Preference
+	(State)(0),                    // 102: memos.api.v1.State
+	(*timestamppb.Timestamp)(nil), // 103: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil), // 104: google.protobuf.FieldMask
+	(Permission)(0),               // 105: memos.api.v1.Permission
+	(*emptypb.Empty)(nil),         // 106: google.protobuf.Empty
+	(*httpbody.HttpBody)(nil),     // 107: google.api.HttpBody
 }
 var file_api_v1_user_service_proto_depIdxs = []int32{
-	0,  // 0: memos.api.v1.User.role:type_name -> memos.api.v1.User.Role
-	30, // 1: memos.api.v1.User.state:type_name -> memos.api.v1.State
-	31, // 2: memos.api.v1.User.create_time:type_name -> google.protobuf.Timestamp
-	31, // 3: memos.api.v1.User.update_time:type_name -> google.protobuf.Timestamp
-	1,  // 4: memos.api.v1.ListUsersResponse.users:type_name -> memos.api.v1.User
-	32, // 5: memos.api.v1.GetUserRequest.read_mask:type_name -> google.protobuf.FieldMask
-	1,  // 6: memos.api.v1.CreateUserRequest.user:type_name -> memos.api.v1.User
-	1,  // 7: memos.api.v1.UpdateUserRequest.user:type_name -> memos.api.v1.User
-	32, // 8: memos.api.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
-	1,  // 9: memos.api.v1.SearchUsersResponse.users:type_name -> memos.api.v1.User
-	31, // 10: memos.api.v1.UserStats.memo_display_timestamps:type_name -> google.protobuf.Timestamp
-	28, // 11: memos.api.v1.UserStats.memo_type_stats:type_name -> memos.api.v1.UserStats.MemoTypeStats
-	27, // 12: memos.api.v1.UserStats.tag_count:type_name -> memos.api.v1.UserStats.TagCountEntry
-	13, // 13: memos.api.v1.UpdateUserSettingRequest.setting:type_name -> memos.api.v1.UserSetting
-	32, // 14: memos.api.v1.UpdateUserSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
-	31, // 15: memos.api.v1.UserAccessToken.issued_at:type_name -> google.protobuf.Timestamp
-	31, // 16: memos.api.v1.UserAccessToken.expires_at:type_name -> google.protobuf.Timestamp
-	16, // 17: memos.api.v1.ListUserAccessTokensResponse.access_tokens:type_name -> memos.api.v1.UserAccessToken
-	16, // 18: memos.api.v1.CreateUserAccessTokenRequest.access_token:type_name -> memos.api.v1.UserAccessToken
-	31, // 19: memos.api.v1.UserSession.create_time:type_name -> google.protobuf.Timestamp
-	31, // 20: memos.api.v1.UserSession.last_accessed_time:type_name -> google.protobuf.Timestamp
-	29, // 21: memos.api.v1.UserSession.client_info:type_name -> memos.api.v1.UserSession.ClientInfo
-	21, // 22: memos.api.v1.ListUserSessionsResponse.sessions:type_name -> memos.api.v1.UserSession
-	11, // 23: memos.api.v1.ListAllUserStatsResponse.user_stats:type_name -> memos.api.v1.UserStats
-	2,  // 24: memos.api.v1.UserService.ListUsers:input_type -> memos.api.v1.ListUsersRequest
-	4,  // 25: memos.api.v1.UserService.GetUser:input_type -> memos.api.v1.GetUserRequest
-	5,  // 26: memos.api.v1.UserService.CreateUser:input_type -> memos.api.v1.CreateUserRequest
-	6,  // 27: memos.api.v1.UserService.UpdateUser:input_type -> memos.api.v1.UpdateUserRequest
-	7,  // 28: memos.api.v1.UserService.DeleteUser:input_type -> memos.api.v1.DeleteUserRequest
-	8,  // 29: memos.api.v1.UserService.SearchUsers:input_type -> memos.api.v1.SearchUsersRequest
-	10, // 30: memos.api.v1.UserService.GetUserAvatar:input_type -> memos.api.v1.GetUserAvatarRequest
-	25, // 31: memos.api.v1.UserService.ListAllUserStats:input_type -> memos.api.v1.ListAllUserStatsRequest
-	12, // 32: memos.api.v1.UserService.GetUserStats:input_type -> memos.api.v1.GetUserStatsRequest
-	14, // 33: memos.api.v1.UserService.GetUserSetting:input_type -> memos.api.v1.GetUserSettingRequest
-	15, // 34: memos.api.v1.UserService.UpdateUserSetting:input_type -> memos.api.v1.UpdateUserSettingRequest
-	17, // 35: memos.api.v1.UserService.ListUserAccessTokens:input_type -> memos.api.v1.ListUserAccessTokensRequest
-	19, // 36: memos.api.v1.UserService.CreateUserAccessToken:input_type -> memos.api.v1.CreateUserAccessTokenRequest
-	20, // 37: memos.api.v1.UserService.DeleteUserAccessToken:input_type -> memos.api.v1.DeleteUserAccessTokenRequest
-	22, // 38: memos.api.v1.UserService.ListUserSessions:input_type -> memos.api.v1.ListUserSessionsRequest
-	24, // 39: memos.api.v1.UserService.RevokeUserSession:input_type -> memos.api.v1.RevokeUserSessionRequest
-	3,  // 40: memos.api.v1.UserService.ListUsers:output_type -> memos.api.v1.ListUsersResponse
-	1,  // 41: memos.api.v1.UserService.GetUser:output_type -> memos.api.v1.User
-	1,  // 42: memos.api.v1.UserService.CreateUser:output_type -> memos.api.v1.User
-	1,  // 43: memos.api.v1.UserService.UpdateUser:output_type -> memos.api.v1.User
-	33, // 44: memos.api.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
-	9,  // 45: memos.api.v1.UserService.SearchUsers:output_type -> memos.api.v1.SearchUsersResponse
-	34, // 46: memos.api.v1.UserService.GetUserAvatar:output_type -> google.api.HttpBody
-	26, // 47: memos.api.v1.UserService.ListAllUserStats:output_type -> memos.api.v1.ListAllUserStatsResponse
-	11, // 48: memos.api.v1.UserService.GetUserStats:output_type -> memos.api.v1.UserStats
-	13, // 49: memos.api.v1.UserService.GetUserSetting:output_type -> memos.api.v1.UserSetting
-	13, // 50: memos.api.v1.UserService.UpdateUserSetting:output_type -> memos.api.v1.UserSetting
-	18, // 51: memos.api.v1.UserService.ListUserAccessTokens:output_type -> memos.api.v1.ListUserAccessTokensResponse
-	16, // 52: memos.api.v1.UserService.CreateUserAccessToken:output_type -> memos.api.v1.UserAccessToken
-	33, // 53: memos.api.v1.UserService.DeleteUserAccessToken:output_type -> google.protobuf.Empty
-	23, // 54: memos.api.v1.UserService.ListUserSessions:output_type -> memos.api.v1.ListUserSessionsResponse
-	33, // 55: memos.api.v1.UserService.RevokeUserSession:output_type -> google.protobuf.Empty
-	40, // [40:56] is the sub-list for method output_type
-	24, // [24:40] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	0,   // 0: memos.api.v1.User.role:type_name -> memos.api.v1.User.Role
+	102, // 1: memos.api.v1.User.state:type_name -> memos.api.v1.State
+	103, // 2: memos.api.v1.User.create_time:type_name -> google.protobuf.Timestamp
+	103, // 3: memos.api.v1.User.update_time:type_name -> google.protobuf.Timestamp
+	3,   // 4: memos.api.v1.ListUsersResponse.users:type_name -> memos.api.v1.User
+	104, // 5: memos.api.v1.GetUserRequest.read_mask:type_name -> google.protobuf.FieldMask
+	3,   // 6: memos.api.v1.CreateUserRequest.user:type_name -> memos.api.v1.User
+	3,   // 7: memos.api.v1.UpdateUserRequest.user:type_name -> memos.api.v1.User
+	104, // 8: memos.api.v1.UpdateUserRequest.update_mask:type_name -> google.protobuf.FieldMask
+	3,   // 9: memos.api.v1.SearchUsersResponse.users:type_name -> memos.api.v1.User
+	103, // 10: memos.api.v1.UserStats.memo_display_timestamps:type_name -> google.protobuf.Timestamp
+	97,  // 11: memos.api.v1.UserStats.memo_type_stats:type_name -> memos.api.v1.UserStats.MemoTypeStats
+	96,  // 12: memos.api.v1.UserStats.tag_count:type_name -> memos.api.v1.UserStats.TagCountEntry
+	17,  // 13: memos.api.v1.UpdateUserSettingRequest.setting:type_name -> memos.api.v1.UserSetting
+	104, // 14: memos.api.v1.UpdateUserSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	103, // 15: memos.api.v1.UserAccessToken.issued_at:type_name -> google.protobuf.Timestamp
+	103, // 16: memos.api.v1.UserAccessToken.expires_at:type_name -> google.protobuf.Timestamp
+	103, // 17: memos.api.v1.UserAccessToken.last_used_at:type_name -> google.protobuf.Timestamp
+	20,  // 18: memos.api.v1.ListUserAccessTokensResponse.access_tokens:type_name -> memos.api.v1.UserAccessToken
+	20,  // 19: memos.api.v1.CreateUserAccessTokenRequest.access_token:type_name -> memos.api.v1.UserAccessToken
+	20,  // 20: memos.api.v1.UpdateUserAccessTokenRequest.access_token:type_name -> memos.api.v1.UserAccessToken
+	104, // 21: memos.api.v1.UpdateUserAccessTokenRequest.update_mask:type_name -> google.protobuf.FieldMask
+	103, // 22: memos.api.v1.UserSession.create_time:type_name -> google.protobuf.Timestamp
+	103, // 23: memos.api.v1.UserSession.last_accessed_time:type_name -> google.protobuf.Timestamp
+	98,  // 24: memos.api.v1.UserSession.client_info:type_name -> memos.api.v1.UserSession.ClientInfo
+	26,  // 25: memos.api.v1.ListUserSessionsResponse.sessions:type_name -> memos.api.v1.UserSession
+	103, // 26: memos.api.v1.UserSuspension.suspend_time:type_name -> google.protobuf.Timestamp
+	103, // 27: memos.api.v1.UserReadwise.last_sync_time:type_name -> google.protobuf.Timestamp
+	99,  // 28: memos.api.v1.UserWebPushSubscription.keys:type_name -> memos.api.v1.UserWebPushSubscription.Keys
+	103, // 29: memos.api.v1.UserWebPushSubscription.create_time:type_name -> google.protobuf.Timestamp
+	55,  // 30: memos.api.v1.ListUserWebPushSubscriptionsResponse.web_push_subscriptions:type_name -> memos.api.v1.UserWebPushSubscription
+	55,  // 31: memos.api.v1.CreateUserWebPushSubscriptionRequest.web_push_subscription:type_name -> memos.api.v1.UserWebPushSubscription
+	103, // 32: memos.api.v1.UserGistSync.last_sync_time:type_name -> google.protobuf.Timestamp
+	100, // 33: memos.api.v1.UserGistSync.gist_urls:type_name -> memos.api.v1.UserGistSync.GistUrlsEntry
+	101, // 34: memos.api.v1.UserNotificationPreferences.preferences:type_name -> memos.api.v1.UserNotificationPreferences.Preference
+	64,  // 35: memos.api.v1.UpdateUserNotificationPreferencesRequest.notification_preferences:type_name -> memos.api.v1.UserNotificationPreferences
+	2,   // 36: memos.api.v1.UserEmailDigest.frequency:type_name -> memos.api.v1.UserEmailDigest.Frequency
+	103, // 37: memos.api.v1.UserEmailDigest.last_send_time:type_name -> google.protobuf.Timestamp
+	67,  // 38: memos.api.v1.UpdateUserEmailDigestRequest.email_digest:type_name -> memos.api.v1.UserEmailDigest
+	103, // 39: memos.api.v1.UserOnThisDay.last_notify_time:type_name -> google.protobuf.Timestamp
+	70,  // 40: memos.api.v1.UpdateUserOnThisDayRequest.on_this_day:type_name -> memos.api.v1.UserOnThisDay
+	103, // 41: memos.api.v1.UserSlack.link_code_expire_time:type_name -> google.protobuf.Timestamp
+	103, // 42: memos.api.v1.UserDiscord.link_code_expire_time:type_name -> google.protobuf.Timestamp
+	15,  // 43: memos.api.v1.ListAllUserStatsResponse.user_stats:type_name -> memos.api.v1.UserStats
+	105, // 44: memos.api.v1.UserPermissions.permissions:type_name -> memos.api.v1.Permission
+	0,   // 45: memos.api.v1.Invitation.role:type_name -> memos.api.v1.User.Role
+	103, // 46: memos.api.v1.Invitation.expire_time:type_name -> google.protobuf.Timestamp
+	103, // 47: memos.api.v1.Invitation.create_time:type_name -> google.protobuf.Timestamp
+	86,  // 48: memos.api.v1.ListInvitationsResponse.invitations:type_name -> memos.api.v1.Invitation
+	86,  // 49: memos.api.v1.CreateInvitationRequest.invitation:type_name -> memos.api.v1.Invitation
+	103, // 50: memos.api.v1.UserReadGrant.create_time:type_name -> google.protobuf.Timestamp
+	91,  // 51: memos.api.v1.ListUserReadGrantsResponse.read_grants:type_name -> memos.api.v1.UserReadGrant
+	91,  // 52: memos.api.v1.CreateUserReadGrantRequest.read_grant:type_name -> memos.api.v1.UserReadGrant
+	1,   // 53: memos.api.v1.UserNotificationPreferences.Preference.event:type_name -> memos.api.v1.UserNotificationPreferences.Event
+	4,   // 54: memos.api.v1.UserService.ListUsers:input_type -> memos.api.v1.ListUsersRequest
+	6,   // 55: memos.api.v1.UserService.GetUser:input_type -> memos.api.v1.GetUserRequest
+	7,   // 56: memos.api.v1.UserService.CreateUser:input_type -> memos.api.v1.CreateUserRequest
+	8,   // 57: memos.api.v1.UserService.UpdateUser:input_type -> memos.api.v1.UpdateUserRequest
+	9,   // 58: memos.api.v1.UserService.DeleteUser:input_type -> memos.api.v1.DeleteUserRequest
+	10,  // 59: memos.api.v1.UserService.DeleteUserAccount:input_type -> memos.api.v1.DeleteUserAccountRequest
+	12,  // 60: memos.api.v1.UserService.SearchUsers:input_type -> memos.api.v1.SearchUsersRequest
+	14,  // 61: memos.api.v1.UserService.GetUserAvatar:input_type -> memos.api.v1.GetUserAvatarRequest
+	81,  // 62: memos.api.v1.UserService.ListAllUserStats:input_type -> memos.api.v1.ListAllUserStatsRequest
+	16,  // 63: memos.api.v1.UserService.GetUserStats:input_type -> memos.api.v1.GetUserStatsRequest
+	18,  // 64: memos.api.v1.UserService.GetUserSetting:input_type -> memos.api.v1.GetUserSettingRequest
+	19,  // 65: memos.api.v1.UserService.UpdateUserSetting:input_type -> memos.api.v1.UpdateUserSettingRequest
+	21,  // 66: memos.api.v1.UserService.ListUserAccessTokens:input_type -> memos.api.v1.ListUserAccessTokensRequest
+	23,  // 67: memos.api.v1.UserService.CreateUserAccessToken:input_type -> memos.api.v1.CreateUserAccessTokenRequest
+	24,  // 68: memos.api.v1.UserService.UpdateUserAccessToken:input_type -> memos.api.v1.UpdateUserAccessTokenRequest
+	25,  // 69: memos.api.v1.UserService.DeleteUserAccessToken:input_type -> memos.api.v1.DeleteUserAccessTokenRequest
+	27,  // 70: memos.api.v1.UserService.ListUserSessions:input_type -> memos.api.v1.ListUserSessionsRequest
+	29,  // 71: memos.api.v1.UserService.RevokeUserSession:input_type -> memos.api.v1.RevokeUserSessionRequest
+	31,  // 72: memos.api.v1.UserService.GetUserTwoFactor:input_type -> memos.api.v1.GetUserTwoFactorRequest
+	32,  // 73: memos.api.v1.UserService.SetupUserTwoFactor:input_type -> memos.api.v1.SetupUserTwoFactorRequest
+	34,  // 74: memos.api.v1.UserService.EnableUserTwoFactor:input_type -> memos.api.v1.EnableUserTwoFactorRequest
+	36,  // 75: memos.api.v1.UserService.DisableUserTwoFactor:input_type -> memos.api.v1.DisableUserTwoFactorRequest
+	37,  // 76: memos.api.v1.UserService.RegenerateUserRecoveryCodes:input_type -> memos.api.v1.RegenerateUserRecoveryCodesRequest
+	40,  // 77: memos.api.v1.UserService.GetUserSuspension:input_type -> memos.api.v1.GetUserSuspensionRequest
+	41,  // 78: memos.api.v1.UserService.SuspendUser:input_type -> memos.api.v1.SuspendUserRequest
+	42,  // 79: memos.api.v1.UserService.UnsuspendUser:input_type -> memos.api.v1.UnsuspendUserRequest
+	44,  // 80: memos.api.v1.UserService.GetUserMemoEmail:input_type -> memos.api.v1.GetUserMemoEmailRequest
+	45,  // 81: memos.api.v1.UserService.ResetUserMemoEmail:input_type -> memos.api.v1.ResetUserMemoEmailRequest
+	46,  // 82: memos.api.v1.UserService.DisableUserMemoEmail:input_type -> memos.api.v1.DisableUserMemoEmailRequest
+	48,  // 83: memos.api.v1.UserService.GetUserCalendarFeed:input_type -> memos.api.v1.GetUserCalendarFeedRequest
+	49,  // 84: memos.api.v1.UserService.ResetUserCalendarFeed:input_type -> memos.api.v1.ResetUserCalendarFeedRequest
+	50,  // 85: memos.api.v1.UserService.DisableUserCalendarFeed:input_type -> memos.api.v1.DisableUserCalendarFeedRequest
+	52,  // 86: memos.api.v1.UserService.GetUserReadwise:input_type -> memos.api.v1.GetUserReadwiseRequest
+	53,  // 87: memos.api.v1.UserService.ConnectUserReadwise:input_type -> memos.api.v1.ConnectUserReadwiseRequest
+	54,  // 88: memos.api.v1.UserService.DisconnectUserReadwise:input_type -> memos.api.v1.DisconnectUserReadwiseRequest
+	61,  // 89: memos.api.v1.UserService.GetUserGistSync:input_type -> memos.api.v1.GetUserGistSyncRequest
+	62,  // 90: memos.api.v1.UserService.ConnectUserGistSync:input_type -> memos.api.v1.ConnectUserGistSyncRequest
+	63,  // 91: memos.api.v1.UserService.DisconnectUserGistSync:input_type -> memos.api.v1.DisconnectUserGistSyncRequest
+	65,  // 92: memos.api.v1.UserService.GetUserNotificationPreferences:input_type -> memos.api.v1.GetUserNotificationPreferencesRequest
+	66,  // 93: memos.api.v1.UserService.UpdateUserNotificationPreferences:input_type -> memos.api.v1.UpdateUserNotificationPreferencesRequest
+	68,  // 94: memos.api.v1.UserService.GetUserEmailDigest:input_type -> memos.api.v1.GetUserEmailDigestRequest
+	69,  // 95: memos.api.v1.UserService.UpdateUserEmailDigest:input_type -> memos.api.v1.UpdateUserEmailDigestRequest
+	71,  // 96: memos.api.v1.UserService.GetUserOnThisDay:input_type -> memos.api.v1.GetUserOnThisDayRequest
+	72,  // 97: memos.api.v1.UserService.UpdateUserOnThisDay:input_type -> memos.api.v1.UpdateUserOnThisDayRequest
+	56,  // 98: memos.api.v1.UserService.ListUserWebPushSubscriptions:input_type -> memos.api.v1.ListUserWebPushSubscriptionsRequest
+	58,  // 99: memos.api.v1.UserService.CreateUserWebPushSubscription:input_type -> memos.api.v1.CreateUserWebPushSubscriptionRequest
+	59,  // 100: memos.api.v1.UserService.DeleteUserWebPushSubscription:input_type -> memos.api.v1.DeleteUserWebPushSubscriptionRequest
+	74,  // 101: memos.api.v1.UserService.GetUserSlack:input_type -> memos.api.v1.GetUserSlackRequest
+	75,  // 102: memos.api.v1.UserService.GenerateUserSlackLinkCode:input_type -> memos.api.v1.GenerateUserSlackLinkCodeRequest
+	76,  // 103: memos.api.v1.UserService.UnlinkUserSlack:input_type -> memos.api.v1.UnlinkUserSlackRequest
+	78,  // 104: memos.api.v1.UserService.GetUserDiscord:input_type -> memos.api.v1.GetUserDiscordRequest
+	79,  // 105: memos.api.v1.UserService.GenerateUserDiscordLinkCode:input_type -> memos.api.v1.GenerateUserDiscordLinkCodeRequest
+	80,  // 106: memos.api.v1.UserService.UnlinkUserDiscord:input_type -> memos.api.v1.UnlinkUserDiscordRequest
+	84,  // 107: memos.api.v1.UserService.GetUserPermissions:input_type -> memos.api.v1.GetUserPermissionsRequest
+	85,  // 108: memos.api.v1.UserService.SetUserCustomRole:input_type -> memos.api.v1.SetUserCustomRoleRequest
+	87,  // 109: memos.api.v1.UserService.ListInvitations:input_type -> memos.api.v1.ListInvitationsRequest
+	89,  // 110: memos.api.v1.UserService.CreateInvitation:input_type -> memos.api.v1.CreateInvitationRequest
+	90,  // 111: memos.api.v1.UserService.DeleteInvitation:input_type -> memos.api.v1.DeleteInvitationRequest
+	92,  // 112: memos.api.v1.UserService.ListUserReadGrants:input_type -> memos.api.v1.ListUserReadGrantsRequest
+	94,  // 113: memos.api.v1.UserService.CreateUserReadGrant:input_type -> memos.api.v1.CreateUserReadGrantRequest
+	95,  // 114: memos.api.v1.UserService.DeleteUserReadGrant:input_type -> memos.api.v1.DeleteUserReadGrantRequest
+	5,   // 115: memos.api.v1.UserService.ListUsers:output_type -> memos.api.v1.ListUsersResponse
+	3,   // 116: memos.api.v1.UserService.GetUser:output_type -> memos.api.v1.User
+	3,   // 117: memos.api.v1.UserService.CreateUser:output_type -> memos.api.v1.User
+	3,   // 118: memos.api.v1.UserService.UpdateUser:output_type -> memos.api.v1.User
+	106, // 119: memos.api.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
+	11,  // 120: memos.api.v1.UserService.DeleteUserAccount:output_type -> memos.api.v1.DeleteUserAccountResponse
+	13,  // 121: memos.api.v1.UserService.SearchUsers:output_type -> memos.api.v1.SearchUsersResponse
+	107, // 122: memos.api.v1.UserService.GetUserAvatar:output_type -> google.api.HttpBody
+	82,  // 123: memos.api.v1.UserService.ListAllUserStats:output_type -> memos.api.v1.ListAllUserStatsResponse
+	15,  // 124: memos.api.v1.UserService.GetUserStats:output_type -> memos.api.v1.UserStats
+	17,  // 125: memos.api.v1.UserService.GetUserSetting:output_type -> memos.api.v1.UserSetting
+	17,  // 126: memos.api.v1.UserService.UpdateUserSetting:output_type -> memos.api.v1.UserSetting
+	22,  // 127: memos.api.v1.UserService.ListUserAccessTokens:output_type -> memos.api.v1.ListUserAccessTokensResponse
+	20,  // 128: memos.api.v1.UserService.CreateUserAccessToken:output_type -> memos.api.v1.UserAccessToken
+	20,  // 129: memos.api.v1.UserService.UpdateUserAccessToken:output_type -> memos.api.v1.UserAccessToken
+	106, // 130: memos.api.v1.UserService.DeleteUserAccessToken:output_type -> google.protobuf.Empty
+	28,  // 131: memos.api.v1.UserService.ListUserSessions:output_type -> memos.api.v1.ListUserSessionsResponse
+	106, // 132: memos.api.v1.UserService.RevokeUserSession:output_type -> google.protobuf.Empty
+	30,  // 133: memos.api.v1.UserService.GetUserTwoFactor:output_type -> memos.api.v1.UserTwoFactor
+	33,  // 134: memos.api.v1.UserService.SetupUserTwoFactor:output_type -> memos.api.v1.SetupUserTwoFactorResponse
+	35,  // 135: memos.api.v1.UserService.EnableUserTwoFactor:output_type -> memos.api.v1.EnableUserTwoFactorResponse
+	106, // 136: memos.api.v1.UserService.DisableUserTwoFactor:output_type -> google.protobuf.Empty
+	38,  // 137: memos.api.v1.UserService.RegenerateUserRecoveryCodes:output_type -> memos.api.v1.RegenerateUserRecoveryCodesResponse
+	39,  // 138: memos.api.v1.UserService.GetUserSuspension:output_type -> memos.api.v1.UserSuspension
+	39,  // 139: memos.api.v1.UserService.SuspendUser:output_type -> memos.api.v1.UserSuspension
+	39,  // 140: memos.api.v1.UserService.UnsuspendUser:output_type -> memos.api.v1.UserSuspension
+	43,  // 141: memos.api.v1.UserService.GetUserMemoEmail:output_type -> memos.api.v1.UserMemoEmail
+	43,  // 142: memos.api.v1.UserService.ResetUserMemoEmail:output_type -> memos.api.v1.UserMemoEmail
+	43,  // 143: memos.api.v1.UserService.DisableUserMemoEmail:output_type -> memos.api.v1.UserMemoEmail
+	47,  // 144: memos.api.v1.UserService.GetUserCalendarFeed:output_type -> memos.api.v1.UserCalendarFeed
+	47,  // 145: memos.api.v1.UserService.ResetUserCalendarFeed:output_type -> memos.api.v1.UserCalendarFeed
+	47,  // 146: memos.api.v1.UserService.DisableUserCalendarFeed:output_type -> memos.api.v1.UserCalendarFeed
+	51,  // 147: memos.api.v1.UserService.GetUserReadwise:output_type -> memos.api.v1.UserReadwise
+	51,  // 148: memos.api.v1.UserService.ConnectUserReadwise:output_type -> memos.api.v1.UserReadwise
+	51,  // 149: memos.api.v1.UserService.DisconnectUserReadwise:output_type -> memos.api.v1.UserReadwise
+	60,  // 150: memos.api.v1.UserService.GetUserGistSync:output_type -> memos.api.v1.UserGistSync
+	60,  // 151: memos.api.v1.UserService.ConnectUserGistSync:output_type -> memos.api.v1.UserGistSync
+	60,  // 152: memos.api.v1.UserService.DisconnectUserGistSync:output_type -> memos.api.v1.UserGistSync
+	64,  // 153: memos.api.v1.UserService.GetUserNotificationPreferences:output_type -> memos.api.v1.UserNotificationPreferences
+	64,  // 154: memos.api.v1.UserService.UpdateUserNotificationPreferences:output_type -> memos.api.v1.UserNotificationPreferences
+	67,  // 155: memos.api.v1.UserService.GetUserEmailDigest:output_type -> memos.api.v1.UserEmailDigest
+	67,  // 156: memos.api.v1.UserService.UpdateUserEmailDigest:output_type -> memos.api.v1.UserEmailDigest
+	70,  // 157: memos.api.v1.UserService.GetUserOnThisDay:output_type -> memos.api.v1.UserOnThisDay
+	70,  // 158: memos.api.v1.UserService.UpdateUserOnThisDay:output_type -> memos.api.v1.UserOnThisDay
+	57,  // 159: memos.api.v1.UserService.ListUserWebPushSubscriptions:output_type -> memos.api.v1.ListUserWebPushSubscriptionsResponse
+	55,  // 160: memos.api.v1.UserService.CreateUserWebPushSubscription:output_type -> memos.api.v1.UserWebPushSubscription
+	106, // 161: memos.api.v1.UserService.DeleteUserWebPushSubscription:output_type -> google.protobuf.Empty
+	73,  // 162: memos.api.v1.UserService.GetUserSlack:output_type -> memos.api.v1.UserSlack
+	73,  // 163: memos.api.v1.UserService.GenerateUserSlackLinkCode:output_type -> memos.api.v1.UserSlack
+	73,  // 164: memos.api.v1.UserService.UnlinkUserSlack:output_type -> memos.api.v1.UserSlack
+	77,  // 165: memos.api.v1.UserService.GetUserDiscord:output_type -> memos.api.v1.UserDiscord
+	77,  // 166: memos.api.v1.UserService.GenerateUserDiscordLinkCode:output_type -> memos.api.v1.UserDiscord
+	77,  // 167: memos.api.v1.UserService.UnlinkUserDiscord:output_type -> memos.api.v1.UserDiscord
+	83,  // 168: memos.api.v1.UserService.GetUserPermissions:output_type -> memos.api.v1.UserPermissions
+	83,  // 169: memos.api.v1.UserService.SetUserCustomRole:output_type -> memos.api.v1.UserPermissions
+	88,  // 170: memos.api.v1.UserService.ListInvitations:output_type -> memos.api.v1.ListInvitationsResponse
+	86,  // 171: memos.api.v1.UserService.CreateInvitation:output_type -> memos.api.v1.Invitation
+	106, // 172: memos.api.v1.UserService.DeleteInvitation:output_type -> google.protobuf.Empty
+	93,  // 173: memos.api.v1.UserService.ListUserReadGrants:output_type -> memos.api.v1.ListUserReadGrantsResponse
+	91,  // 174: memos.api.v1.UserService.CreateUserReadGrant:output_type -> memos.api.v1.UserReadGrant
+	106, // 175: memos.api.v1.UserService.DeleteUserReadGrant:output_type -> google.protobuf.Empty
+	115, // [115:176] is the sub-list for method output_type
+	54,  // [54:115] is the sub-list for method input_type
+	54,  // [54:54] is the sub-list for extension type_name
+	54,  // [54:54] is the sub-list for extension extendee
+	0,   // [0:54] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_user_service_proto_init() }
@@ -2246,8 +6840,8 @@ func file_api_v1_user_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_user_service_proto_rawDesc), len(file_api_v1_user_service_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   29,
+			NumEnums:      3,
+			NumMessages:   99,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
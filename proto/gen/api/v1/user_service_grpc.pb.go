@@ -21,22 +21,67 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_ListUsers_FullMethodName             = "/memos.api.v1.UserService/ListUsers"
-	UserService_GetUser_FullMethodName               = "/memos.api.v1.UserService/GetUser"
-	UserService_CreateUser_FullMethodName            = "/memos.api.v1.UserService/CreateUser"
-	UserService_UpdateUser_FullMethodName            = "/memos.api.v1.UserService/UpdateUser"
-	UserService_DeleteUser_FullMethodName            = "/memos.api.v1.UserService/DeleteUser"
-	UserService_SearchUsers_FullMethodName           = "/memos.api.v1.UserService/SearchUsers"
-	UserService_GetUserAvatar_FullMethodName         = "/memos.api.v1.UserService/GetUserAvatar"
-	UserService_ListAllUserStats_FullMethodName      = "/memos.api.v1.UserService/ListAllUserStats"
-	UserService_GetUserStats_FullMethodName          = "/memos.api.v1.UserService/GetUserStats"
-	UserService_GetUserSetting_FullMethodName        = "/memos.api.v1.UserService/GetUserSetting"
-	UserService_UpdateUserSetting_FullMethodName     = "/memos.api.v1.UserService/UpdateUserSetting"
-	UserService_ListUserAccessTokens_FullMethodName  = "/memos.api.v1.UserService/ListUserAccessTokens"
-	UserService_CreateUserAccessToken_FullMethodName = "/memos.api.v1.UserService/CreateUserAccessToken"
-	UserService_DeleteUserAccessToken_FullMethodName = "/memos.api.v1.UserService/DeleteUserAccessToken"
-	UserService_ListUserSessions_FullMethodName      = "/memos.api.v1.UserService/ListUserSessions"
-	UserService_RevokeUserSession_FullMethodName     = "/memos.api.v1.UserService/RevokeUserSession"
+	UserService_ListUsers_FullMethodName                         = "/memos.api.v1.UserService/ListUsers"
+	UserService_GetUser_FullMethodName                           = "/memos.api.v1.UserService/GetUser"
+	UserService_CreateUser_FullMethodName                        = "/memos.api.v1.UserService/CreateUser"
+	UserService_UpdateUser_FullMethodName                        = "/memos.api.v1.UserService/UpdateUser"
+	UserService_DeleteUser_FullMethodName                        = "/memos.api.v1.UserService/DeleteUser"
+	UserService_DeleteUserAccount_FullMethodName                 = "/memos.api.v1.UserService/DeleteUserAccount"
+	UserService_SearchUsers_FullMethodName                       = "/memos.api.v1.UserService/SearchUsers"
+	UserService_GetUserAvatar_FullMethodName                     = "/memos.api.v1.UserService/GetUserAvatar"
+	UserService_ListAllUserStats_FullMethodName                  = "/memos.api.v1.UserService/ListAllUserStats"
+	UserService_GetUserStats_FullMethodName                      = "/memos.api.v1.UserService/GetUserStats"
+	UserService_GetUserSetting_FullMethodName                    = "/memos.api.v1.UserService/GetUserSetting"
+	UserService_UpdateUserSetting_FullMethodName                 = "/memos.api.v1.UserService/UpdateUserSetting"
+	UserService_ListUserAccessTokens_FullMethodName              = "/memos.api.v1.UserService/ListUserAccessTokens"
+	UserService_CreateUserAccessToken_FullMethodName             = "/memos.api.v1.UserService/CreateUserAccessToken"
+	UserService_UpdateUserAccessToken_FullMethodName             = "/memos.api.v1.UserService/UpdateUserAccessToken"
+	UserService_DeleteUserAccessToken_FullMethodName             = "/memos.api.v1.UserService/DeleteUserAccessToken"
+	UserService_ListUserSessions_FullMethodName                  = "/memos.api.v1.UserService/ListUserSessions"
+	UserService_RevokeUserSession_FullMethodName                 = "/memos.api.v1.UserService/RevokeUserSession"
+	UserService_GetUserTwoFactor_FullMethodName                  = "/memos.api.v1.UserService/GetUserTwoFactor"
+	UserService_SetupUserTwoFactor_FullMethodName                = "/memos.api.v1.UserService/SetupUserTwoFactor"
+	UserService_EnableUserTwoFactor_FullMethodName               = "/memos.api.v1.UserService/EnableUserTwoFactor"
+	UserService_DisableUserTwoFactor_FullMethodName              = "/memos.api.v1.UserService/DisableUserTwoFactor"
+	UserService_RegenerateUserRecoveryCodes_FullMethodName       = "/memos.api.v1.UserService/RegenerateUserRecoveryCodes"
+	UserService_GetUserSuspension_FullMethodName                 = "/memos.api.v1.UserService/GetUserSuspension"
+	UserService_SuspendUser_FullMethodName                       = "/memos.api.v1.UserService/SuspendUser"
+	UserService_UnsuspendUser_FullMethodName                     = "/memos.api.v1.UserService/UnsuspendUser"
+	UserService_GetUserMemoEmail_FullMethodName                  = "/memos.api.v1.UserService/GetUserMemoEmail"
+	UserService_ResetUserMemoEmail_FullMethodName                = "/memos.api.v1.UserService/ResetUserMemoEmail"
+	UserService_DisableUserMemoEmail_FullMethodName              = "/memos.api.v1.UserService/DisableUserMemoEmail"
+	UserService_GetUserCalendarFeed_FullMethodName               = "/memos.api.v1.UserService/GetUserCalendarFeed"
+	UserService_ResetUserCalendarFeed_FullMethodName             = "/memos.api.v1.UserService/ResetUserCalendarFeed"
+	UserService_DisableUserCalendarFeed_FullMethodName           = "/memos.api.v1.UserService/DisableUserCalendarFeed"
+	UserService_GetUserReadwise_FullMethodName                   = "/memos.api.v1.UserService/GetUserReadwise"
+	UserService_ConnectUserReadwise_FullMethodName               = "/memos.api.v1.UserService/ConnectUserReadwise"
+	UserService_DisconnectUserReadwise_FullMethodName            = "/memos.api.v1.UserService/DisconnectUserReadwise"
+	UserService_GetUserGistSync_FullMethodName                   = "/memos.api.v1.UserService/GetUserGistSync"
+	UserService_ConnectUserGistSync_FullMethodName               = "/memos.api.v1.UserService/ConnectUserGistSync"
+	UserService_DisconnectUserGistSync_FullMethodName            = "/memos.api.v1.UserService/DisconnectUserGistSync"
+	UserService_GetUserNotificationPreferences_FullMethodName    = "/memos.api.v1.UserService/GetUserNotificationPreferences"
+	UserService_UpdateUserNotificationPreferences_FullMethodName = "/memos.api.v1.UserService/UpdateUserNotificationPreferences"
+	UserService_GetUserEmailDigest_FullMethodName                = "/memos.api.v1.UserService/GetUserEmailDigest"
+	UserService_UpdateUserEmailDigest_FullMethodName             = "/memos.api.v1.UserService/UpdateUserEmailDigest"
+	UserService_GetUserOnThisDay_FullMethodName                  = "/memos.api.v1.UserService/GetUserOnThisDay"
+	UserService_UpdateUserOnThisDay_FullMethodName               = "/memos.api.v1.UserService/UpdateUserOnThisDay"
+	UserService_ListUserWebPushSubscriptions_FullMethodName      = "/memos.api.v1.UserService/ListUserWebPushSubscriptions"
+	UserService_CreateUserWebPushSubscription_FullMethodName     = "/memos.api.v1.UserService/CreateUserWebPushSubscription"
+	UserService_DeleteUserWebPushSubscription_FullMethodName     = "/memos.api.v1.UserService/DeleteUserWebPushSubscription"
+	UserService_GetUserSlack_FullMethodName                      = "/memos.api.v1.UserService/GetUserSlack"
+	UserService_GenerateUserSlackLinkCode_FullMethodName         = "/memos.api.v1.UserService/GenerateUserSlackLinkCode"
+	UserService_UnlinkUserSlack_FullMethodName                   = "/memos.api.v1.UserService/UnlinkUserSlack"
+	UserService_GetUserDiscord_FullMethodName                    = "/memos.api.v1.UserService/GetUserDiscord"
+	UserService_GenerateUserDiscordLinkCode_FullMethodName       = "/memos.api.v1.UserService/GenerateUserDiscordLinkCode"
+	UserService_UnlinkUserDiscord_FullMethodName                 = "/memos.api.v1.UserService/UnlinkUserDiscord"
+	UserService_GetUserPermissions_FullMethodName                = "/memos.api.v1.UserService/GetUserPermissions"
+	UserService_SetUserCustomRole_FullMethodName                 = "/memos.api.v1.UserService/SetUserCustomRole"
+	UserService_ListInvitations_FullMethodName                   = "/memos.api.v1.UserService/ListInvitations"
+	UserService_CreateInvitation_FullMethodName                  = "/memos.api.v1.UserService/CreateInvitation"
+	UserService_DeleteInvitation_FullMethodName                  = "/memos.api.v1.UserService/DeleteInvitation"
+	UserService_ListUserReadGrants_FullMethodName                = "/memos.api.v1.UserService/ListUserReadGrants"
+	UserService_CreateUserReadGrant_FullMethodName               = "/memos.api.v1.UserService/CreateUserReadGrant"
+	UserService_DeleteUserReadGrant_FullMethodName               = "/memos.api.v1.UserService/DeleteUserReadGrant"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -53,6 +98,9 @@ type UserServiceClient interface {
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error)
 	// DeleteUser deletes a user.
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// DeleteUserAccount deletes the account of the current user with all of their data,
+	// and returns a final export of it.
+	DeleteUserAccount(ctx context.Context, in *DeleteUserAccountRequest, opts ...grpc.CallOption) (*DeleteUserAccountResponse, error)
 	// SearchUsers searches for users based on query.
 	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
 	// GetUserAvatar gets the avatar of a user.
@@ -69,12 +117,109 @@ type UserServiceClient interface {
 	ListUserAccessTokens(ctx context.Context, in *ListUserAccessTokensRequest, opts ...grpc.CallOption) (*ListUserAccessTokensResponse, error)
 	// CreateUserAccessToken creates a new access token for a user.
 	CreateUserAccessToken(ctx context.Context, in *CreateUserAccessTokenRequest, opts ...grpc.CallOption) (*UserAccessToken, error)
+	// UpdateUserAccessToken updates the description or the rate limit of an access token.
+	UpdateUserAccessToken(ctx context.Context, in *UpdateUserAccessTokenRequest, opts ...grpc.CallOption) (*UserAccessToken, error)
 	// DeleteUserAccessToken deletes an access token.
 	DeleteUserAccessToken(ctx context.Context, in *DeleteUserAccessTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ListUserSessions returns a list of active sessions for a user.
 	ListUserSessions(ctx context.Context, in *ListUserSessionsRequest, opts ...grpc.CallOption) (*ListUserSessionsResponse, error)
 	// RevokeUserSession revokes a specific session for a user.
 	RevokeUserSession(ctx context.Context, in *RevokeUserSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetUserTwoFactor gets the two-factor authentication status of a user.
+	GetUserTwoFactor(ctx context.Context, in *GetUserTwoFactorRequest, opts ...grpc.CallOption) (*UserTwoFactor, error)
+	// SetupUserTwoFactor generates a new TOTP secret for a user, enabled once a code of it is verified.
+	SetupUserTwoFactor(ctx context.Context, in *SetupUserTwoFactorRequest, opts ...grpc.CallOption) (*SetupUserTwoFactorResponse, error)
+	// EnableUserTwoFactor enables the two-factor authentication of a user with a code of the new secret.
+	EnableUserTwoFactor(ctx context.Context, in *EnableUserTwoFactorRequest, opts ...grpc.CallOption) (*EnableUserTwoFactorResponse, error)
+	// DisableUserTwoFactor disables the two-factor authentication of a user.
+	DisableUserTwoFactor(ctx context.Context, in *DisableUserTwoFactorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RegenerateUserRecoveryCodes replaces the recovery codes of a user.
+	RegenerateUserRecoveryCodes(ctx context.Context, in *RegenerateUserRecoveryCodesRequest, opts ...grpc.CallOption) (*RegenerateUserRecoveryCodesResponse, error)
+	// GetUserSuspension gets the suspension of a user.
+	GetUserSuspension(ctx context.Context, in *GetUserSuspensionRequest, opts ...grpc.CallOption) (*UserSuspension, error)
+	// SuspendUser suspends a user, who can neither sign in nor use the API until unsuspended.
+	// The data of the user is kept, but their memos are no longer shown to others.
+	SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*UserSuspension, error)
+	// UnsuspendUser lifts the suspension of a user.
+	UnsuspendUser(ctx context.Context, in *UnsuspendUserRequest, opts ...grpc.CallOption) (*UserSuspension, error)
+	// GetUserMemoEmail gets the address receiving the memos emailed by a user.
+	GetUserMemoEmail(ctx context.Context, in *GetUserMemoEmailRequest, opts ...grpc.CallOption) (*UserMemoEmail, error)
+	// ResetUserMemoEmail generates a new address receiving the memos emailed by a user, replacing the previous one.
+	ResetUserMemoEmail(ctx context.Context, in *ResetUserMemoEmailRequest, opts ...grpc.CallOption) (*UserMemoEmail, error)
+	// DisableUserMemoEmail removes the address receiving the memos emailed by a user.
+	DisableUserMemoEmail(ctx context.Context, in *DisableUserMemoEmailRequest, opts ...grpc.CallOption) (*UserMemoEmail, error)
+	// GetUserCalendarFeed gets the calendar feed of the dated memos and tasks of a user.
+	GetUserCalendarFeed(ctx context.Context, in *GetUserCalendarFeedRequest, opts ...grpc.CallOption) (*UserCalendarFeed, error)
+	// ResetUserCalendarFeed generates a new URL of the calendar feed of a user, replacing the previous one.
+	ResetUserCalendarFeed(ctx context.Context, in *ResetUserCalendarFeedRequest, opts ...grpc.CallOption) (*UserCalendarFeed, error)
+	// DisableUserCalendarFeed removes the calendar feed of a user.
+	DisableUserCalendarFeed(ctx context.Context, in *DisableUserCalendarFeedRequest, opts ...grpc.CallOption) (*UserCalendarFeed, error)
+	// GetUserReadwise gets the Readwise account whose highlights are synced as memos of a user.
+	GetUserReadwise(ctx context.Context, in *GetUserReadwiseRequest, opts ...grpc.CallOption) (*UserReadwise, error)
+	// ConnectUserReadwise connects a Readwise account with its access token, syncing its highlights as memos of a user.
+	ConnectUserReadwise(ctx context.Context, in *ConnectUserReadwiseRequest, opts ...grpc.CallOption) (*UserReadwise, error)
+	// DisconnectUserReadwise stops syncing the highlights of the Readwise account of a user, keeping their memos.
+	DisconnectUserReadwise(ctx context.Context, in *DisconnectUserReadwiseRequest, opts ...grpc.CallOption) (*UserReadwise, error)
+	// GetUserGistSync gets the GitHub account whose gists mirror the memos of a user tagged #gist.
+	GetUserGistSync(ctx context.Context, in *GetUserGistSyncRequest, opts ...grpc.CallOption) (*UserGistSync, error)
+	// ConnectUserGistSync connects a GitHub account with its access token, mirroring the memos of a user tagged #gist
+	// to its gists both ways.
+	ConnectUserGistSync(ctx context.Context, in *ConnectUserGistSyncRequest, opts ...grpc.CallOption) (*UserGistSync, error)
+	// DisconnectUserGistSync stops syncing the memos of a user with the gists of their GitHub account, keeping the gists.
+	DisconnectUserGistSync(ctx context.Context, in *DisconnectUserGistSyncRequest, opts ...grpc.CallOption) (*UserGistSync, error)
+	// GetUserNotificationPreferences gets the channels notifying a user of each event.
+	GetUserNotificationPreferences(ctx context.Context, in *GetUserNotificationPreferencesRequest, opts ...grpc.CallOption) (*UserNotificationPreferences, error)
+	// UpdateUserNotificationPreferences updates the channels notifying a user of the given events,
+	// the preferences of the other events being kept.
+	UpdateUserNotificationPreferences(ctx context.Context, in *UpdateUserNotificationPreferencesRequest, opts ...grpc.CallOption) (*UserNotificationPreferences, error)
+	// GetUserEmailDigest gets the schedule of the email digest of a user.
+	GetUserEmailDigest(ctx context.Context, in *GetUserEmailDigestRequest, opts ...grpc.CallOption) (*UserEmailDigest, error)
+	// UpdateUserEmailDigest updates the schedule of the email digest of a user, summarizing their memos created,
+	// their open tasks, their dated memos coming up and their memos of the same day in past years.
+	UpdateUserEmailDigest(ctx context.Context, in *UpdateUserEmailDigestRequest, opts ...grpc.CallOption) (*UserEmailDigest, error)
+	// GetUserOnThisDay gets the schedule of the daily notification of the memos of a user created on this day
+	// in past years.
+	GetUserOnThisDay(ctx context.Context, in *GetUserOnThisDayRequest, opts ...grpc.CallOption) (*UserOnThisDay, error)
+	// UpdateUserOnThisDay updates the schedule of the daily notification of the memos of a user created on this day
+	// in past years, sent through the channels of the ON_THIS_DAY notification preference.
+	UpdateUserOnThisDay(ctx context.Context, in *UpdateUserOnThisDayRequest, opts ...grpc.CallOption) (*UserOnThisDay, error)
+	// ListUserWebPushSubscriptions lists the browsers of a user receiving the Web Push notifications of their inbox.
+	ListUserWebPushSubscriptions(ctx context.Context, in *ListUserWebPushSubscriptionsRequest, opts ...grpc.CallOption) (*ListUserWebPushSubscriptionsResponse, error)
+	// CreateUserWebPushSubscription subscribes a browser of a user to the Web Push notifications of their inbox,
+	// replacing the subscription of the same endpoint if any.
+	CreateUserWebPushSubscription(ctx context.Context, in *CreateUserWebPushSubscriptionRequest, opts ...grpc.CallOption) (*UserWebPushSubscription, error)
+	// DeleteUserWebPushSubscription unsubscribes a browser of a user from the Web Push notifications.
+	DeleteUserWebPushSubscription(ctx context.Context, in *DeleteUserWebPushSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetUserSlack gets the Slack account linked to a user.
+	GetUserSlack(ctx context.Context, in *GetUserSlackRequest, opts ...grpc.CallOption) (*UserSlack, error)
+	// GenerateUserSlackLinkCode generates the code linking a Slack account to a user with the slash command.
+	GenerateUserSlackLinkCode(ctx context.Context, in *GenerateUserSlackLinkCodeRequest, opts ...grpc.CallOption) (*UserSlack, error)
+	// UnlinkUserSlack unlinks the Slack account of a user.
+	UnlinkUserSlack(ctx context.Context, in *UnlinkUserSlackRequest, opts ...grpc.CallOption) (*UserSlack, error)
+	// GetUserDiscord gets the Discord account linked to a user.
+	GetUserDiscord(ctx context.Context, in *GetUserDiscordRequest, opts ...grpc.CallOption) (*UserDiscord, error)
+	// GenerateUserDiscordLinkCode generates the code linking a Discord account to a user with the command of the bot.
+	GenerateUserDiscordLinkCode(ctx context.Context, in *GenerateUserDiscordLinkCodeRequest, opts ...grpc.CallOption) (*UserDiscord, error)
+	// UnlinkUserDiscord unlinks the Discord account of a user.
+	UnlinkUserDiscord(ctx context.Context, in *UnlinkUserDiscordRequest, opts ...grpc.CallOption) (*UserDiscord, error)
+	// GetUserPermissions returns the custom role and the effective permissions of a user.
+	GetUserPermissions(ctx context.Context, in *GetUserPermissionsRequest, opts ...grpc.CallOption) (*UserPermissions, error)
+	// SetUserCustomRole assigns a custom role to a user, or removes it with an empty role.
+	// The permissions of the role must be held by the caller.
+	SetUserCustomRole(ctx context.Context, in *SetUserCustomRoleRequest, opts ...grpc.CallOption) (*UserPermissions, error)
+	// ListInvitations returns the invitations of the workspace.
+	ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsResponse, error)
+	// CreateInvitation creates an invitation whose token allows signing up,
+	// even when the user registration is disallowed.
+	CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*Invitation, error)
+	// DeleteInvitation revokes an invitation.
+	DeleteInvitation(ctx context.Context, in *DeleteInvitationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListUserReadGrants returns the read grants of a user, the delegations of read access to their private memos.
+	ListUserReadGrants(ctx context.Context, in *ListUserReadGrantsRequest, opts ...grpc.CallOption) (*ListUserReadGrantsResponse, error)
+	// CreateUserReadGrant grants another user read access to the private memos of a user, all of them or by tag.
+	CreateUserReadGrant(ctx context.Context, in *CreateUserReadGrantRequest, opts ...grpc.CallOption) (*UserReadGrant, error)
+	// DeleteUserReadGrant revokes a read grant.
+	DeleteUserReadGrant(ctx context.Context, in *DeleteUserReadGrantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type userServiceClient struct {
@@ -135,6 +280,16 @@ func (c *userServiceClient) DeleteUser(ctx context.Context, in *DeleteUserReques
 	return out, nil
 }
 
+func (c *userServiceClient) DeleteUserAccount(ctx context.Context, in *DeleteUserAccountRequest, opts ...grpc.CallOption) (*DeleteUserAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserAccountResponse)
+	err := c.cc.Invoke(ctx, UserService_DeleteUserAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SearchUsersResponse)
@@ -215,6 +370,16 @@ func (c *userServiceClient) CreateUserAccessToken(ctx context.Context, in *Creat
 	return out, nil
 }
 
+func (c *userServiceClient) UpdateUserAccessToken(ctx context.Context, in *UpdateUserAccessTokenRequest, opts ...grpc.CallOption) (*UserAccessToken, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserAccessToken)
+	err := c.cc.Invoke(ctx, UserService_UpdateUserAccessToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) DeleteUserAccessToken(ctx context.Context, in *DeleteUserAccessTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -245,405 +410,1880 @@ func (c *userServiceClient) RevokeUserSession(ctx context.Context, in *RevokeUse
 	return out, nil
 }
 
-// UserServiceServer is the server API for UserService service.
-// All implementations must embed UnimplementedUserServiceServer
-// for forward compatibility.
-type UserServiceServer interface {
-	// ListUsers returns a list of users.
-	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
-	// GetUser gets a user by name.
-	GetUser(context.Context, *GetUserRequest) (*User, error)
-	// CreateUser creates a new user.
-	CreateUser(context.Context, *CreateUserRequest) (*User, error)
-	// UpdateUser updates a user.
-	UpdateUser(context.Context, *UpdateUserRequest) (*User, error)
-	// DeleteUser deletes a user.
-	DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error)
-	// SearchUsers searches for users based on query.
-	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
-	// GetUserAvatar gets the avatar of a user.
-	GetUserAvatar(context.Context, *GetUserAvatarRequest) (*httpbody.HttpBody, error)
-	// ListAllUserStats returns statistics for all users.
-	ListAllUserStats(context.Context, *ListAllUserStatsRequest) (*ListAllUserStatsResponse, error)
-	// GetUserStats returns statistics for a specific user.
-	GetUserStats(context.Context, *GetUserStatsRequest) (*UserStats, error)
-	// GetUserSetting returns the user setting.
-	GetUserSetting(context.Context, *GetUserSettingRequest) (*UserSetting, error)
-	// UpdateUserSetting updates the user setting.
-	UpdateUserSetting(context.Context, *UpdateUserSettingRequest) (*UserSetting, error)
-	// ListUserAccessTokens returns a list of access tokens for a user.
-	ListUserAccessTokens(context.Context, *ListUserAccessTokensRequest) (*ListUserAccessTokensResponse, error)
-	// CreateUserAccessToken creates a new access token for a user.
-	CreateUserAccessToken(context.Context, *CreateUserAccessTokenRequest) (*UserAccessToken, error)
-	// DeleteUserAccessToken deletes an access token.
-	DeleteUserAccessToken(context.Context, *DeleteUserAccessTokenRequest) (*emptypb.Empty, error)
-	// ListUserSessions returns a list of active sessions for a user.
-	ListUserSessions(context.Context, *ListUserSessionsRequest) (*ListUserSessionsResponse, error)
-	// RevokeUserSession revokes a specific session for a user.
-	RevokeUserSession(context.Context, *RevokeUserSessionRequest) (*emptypb.Empty, error)
-	mustEmbedUnimplementedUserServiceServer()
+func (c *userServiceClient) GetUserTwoFactor(ctx context.Context, in *GetUserTwoFactorRequest, opts ...grpc.CallOption) (*UserTwoFactor, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserTwoFactor)
+	err := c.cc.Invoke(ctx, UserService_GetUserTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedUserServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedUserServiceServer struct{}
+func (c *userServiceClient) SetupUserTwoFactor(ctx context.Context, in *SetupUserTwoFactorRequest, opts ...grpc.CallOption) (*SetupUserTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetupUserTwoFactorResponse)
+	err := c.cc.Invoke(ctx, UserService_SetupUserTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedUserServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+func (c *userServiceClient) EnableUserTwoFactor(ctx context.Context, in *EnableUserTwoFactorRequest, opts ...grpc.CallOption) (*EnableUserTwoFactorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnableUserTwoFactorResponse)
+	err := c.cc.Invoke(ctx, UserService_EnableUserTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) GetUser(context.Context, *GetUserRequest) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+
+func (c *userServiceClient) DisableUserTwoFactor(ctx context.Context, in *DisableUserTwoFactorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DisableUserTwoFactor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) CreateUser(context.Context, *CreateUserRequest) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+
+func (c *userServiceClient) RegenerateUserRecoveryCodes(ctx context.Context, in *RegenerateUserRecoveryCodesRequest, opts ...grpc.CallOption) (*RegenerateUserRecoveryCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegenerateUserRecoveryCodesResponse)
+	err := c.cc.Invoke(ctx, UserService_RegenerateUserRecoveryCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+
+func (c *userServiceClient) GetUserSuspension(ctx context.Context, in *GetUserSuspensionRequest, opts ...grpc.CallOption) (*UserSuspension, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserSuspension)
+	err := c.cc.Invoke(ctx, UserService_GetUserSuspension_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+
+func (c *userServiceClient) SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*UserSuspension, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserSuspension)
+	err := c.cc.Invoke(ctx, UserService_SuspendUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
+
+func (c *userServiceClient) UnsuspendUser(ctx context.Context, in *UnsuspendUserRequest, opts ...grpc.CallOption) (*UserSuspension, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserSuspension)
+	err := c.cc.Invoke(ctx, UserService_UnsuspendUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) GetUserAvatar(context.Context, *GetUserAvatarRequest) (*httpbody.HttpBody, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserAvatar not implemented")
+
+func (c *userServiceClient) GetUserMemoEmail(ctx context.Context, in *GetUserMemoEmailRequest, opts ...grpc.CallOption) (*UserMemoEmail, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserMemoEmail)
+	err := c.cc.Invoke(ctx, UserService_GetUserMemoEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) ListAllUserStats(context.Context, *ListAllUserStatsRequest) (*ListAllUserStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAllUserStats not implemented")
+
+func (c *userServiceClient) ResetUserMemoEmail(ctx context.Context, in *ResetUserMemoEmailRequest, opts ...grpc.CallOption) (*UserMemoEmail, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserMemoEmail)
+	err := c.cc.Invoke(ctx, UserService_ResetUserMemoEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) GetUserStats(context.Context, *GetUserStatsRequest) (*UserStats, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserStats not implemented")
+
+func (c *userServiceClient) DisableUserMemoEmail(ctx context.Context, in *DisableUserMemoEmailRequest, opts ...grpc.CallOption) (*UserMemoEmail, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserMemoEmail)
+	err := c.cc.Invoke(ctx, UserService_DisableUserMemoEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) GetUserSetting(context.Context, *GetUserSettingRequest) (*UserSetting, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserSetting not implemented")
+
+func (c *userServiceClient) GetUserCalendarFeed(ctx context.Context, in *GetUserCalendarFeedRequest, opts ...grpc.CallOption) (*UserCalendarFeed, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserCalendarFeed)
+	err := c.cc.Invoke(ctx, UserService_GetUserCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) UpdateUserSetting(context.Context, *UpdateUserSettingRequest) (*UserSetting, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserSetting not implemented")
+
+func (c *userServiceClient) ResetUserCalendarFeed(ctx context.Context, in *ResetUserCalendarFeedRequest, opts ...grpc.CallOption) (*UserCalendarFeed, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserCalendarFeed)
+	err := c.cc.Invoke(ctx, UserService_ResetUserCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) ListUserAccessTokens(context.Context, *ListUserAccessTokensRequest) (*ListUserAccessTokensResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListUserAccessTokens not implemented")
+
+func (c *userServiceClient) DisableUserCalendarFeed(ctx context.Context, in *DisableUserCalendarFeedRequest, opts ...grpc.CallOption) (*UserCalendarFeed, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserCalendarFeed)
+	err := c.cc.Invoke(ctx, UserService_DisableUserCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) CreateUserAccessToken(context.Context, *CreateUserAccessTokenRequest) (*UserAccessToken, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateUserAccessToken not implemented")
+
+func (c *userServiceClient) GetUserReadwise(ctx context.Context, in *GetUserReadwiseRequest, opts ...grpc.CallOption) (*UserReadwise, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserReadwise)
+	err := c.cc.Invoke(ctx, UserService_GetUserReadwise_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ConnectUserReadwise(ctx context.Context, in *ConnectUserReadwiseRequest, opts ...grpc.CallOption) (*UserReadwise, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserReadwise)
+	err := c.cc.Invoke(ctx, UserService_ConnectUserReadwise_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DisconnectUserReadwise(ctx context.Context, in *DisconnectUserReadwiseRequest, opts ...grpc.CallOption) (*UserReadwise, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserReadwise)
+	err := c.cc.Invoke(ctx, UserService_DisconnectUserReadwise_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserGistSync(ctx context.Context, in *GetUserGistSyncRequest, opts ...grpc.CallOption) (*UserGistSync, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserGistSync)
+	err := c.cc.Invoke(ctx, UserService_GetUserGistSync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ConnectUserGistSync(ctx context.Context, in *ConnectUserGistSyncRequest, opts ...grpc.CallOption) (*UserGistSync, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserGistSync)
+	err := c.cc.Invoke(ctx, UserService_ConnectUserGistSync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DisconnectUserGistSync(ctx context.Context, in *DisconnectUserGistSyncRequest, opts ...grpc.CallOption) (*UserGistSync, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserGistSync)
+	err := c.cc.Invoke(ctx, UserService_DisconnectUserGistSync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserNotificationPreferences(ctx context.Context, in *GetUserNotificationPreferencesRequest, opts ...grpc.CallOption) (*UserNotificationPreferences, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserNotificationPreferences)
+	err := c.cc.Invoke(ctx, UserService_GetUserNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateUserNotificationPreferences(ctx context.Context, in *UpdateUserNotificationPreferencesRequest, opts ...grpc.CallOption) (*UserNotificationPreferences, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserNotificationPreferences)
+	err := c.cc.Invoke(ctx, UserService_UpdateUserNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserEmailDigest(ctx context.Context, in *GetUserEmailDigestRequest, opts ...grpc.CallOption) (*UserEmailDigest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserEmailDigest)
+	err := c.cc.Invoke(ctx, UserService_GetUserEmailDigest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateUserEmailDigest(ctx context.Context, in *UpdateUserEmailDigestRequest, opts ...grpc.CallOption) (*UserEmailDigest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserEmailDigest)
+	err := c.cc.Invoke(ctx, UserService_UpdateUserEmailDigest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserOnThisDay(ctx context.Context, in *GetUserOnThisDayRequest, opts ...grpc.CallOption) (*UserOnThisDay, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserOnThisDay)
+	err := c.cc.Invoke(ctx, UserService_GetUserOnThisDay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateUserOnThisDay(ctx context.Context, in *UpdateUserOnThisDayRequest, opts ...grpc.CallOption) (*UserOnThisDay, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserOnThisDay)
+	err := c.cc.Invoke(ctx, UserService_UpdateUserOnThisDay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListUserWebPushSubscriptions(ctx context.Context, in *ListUserWebPushSubscriptionsRequest, opts ...grpc.CallOption) (*ListUserWebPushSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserWebPushSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListUserWebPushSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateUserWebPushSubscription(ctx context.Context, in *CreateUserWebPushSubscriptionRequest, opts ...grpc.CallOption) (*UserWebPushSubscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserWebPushSubscription)
+	err := c.cc.Invoke(ctx, UserService_CreateUserWebPushSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteUserWebPushSubscription(ctx context.Context, in *DeleteUserWebPushSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteUserWebPushSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserSlack(ctx context.Context, in *GetUserSlackRequest, opts ...grpc.CallOption) (*UserSlack, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserSlack)
+	err := c.cc.Invoke(ctx, UserService_GetUserSlack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GenerateUserSlackLinkCode(ctx context.Context, in *GenerateUserSlackLinkCodeRequest, opts ...grpc.CallOption) (*UserSlack, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserSlack)
+	err := c.cc.Invoke(ctx, UserService_GenerateUserSlackLinkCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnlinkUserSlack(ctx context.Context, in *UnlinkUserSlackRequest, opts ...grpc.CallOption) (*UserSlack, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserSlack)
+	err := c.cc.Invoke(ctx, UserService_UnlinkUserSlack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserDiscord(ctx context.Context, in *GetUserDiscordRequest, opts ...grpc.CallOption) (*UserDiscord, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserDiscord)
+	err := c.cc.Invoke(ctx, UserService_GetUserDiscord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GenerateUserDiscordLinkCode(ctx context.Context, in *GenerateUserDiscordLinkCodeRequest, opts ...grpc.CallOption) (*UserDiscord, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserDiscord)
+	err := c.cc.Invoke(ctx, UserService_GenerateUserDiscordLinkCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnlinkUserDiscord(ctx context.Context, in *UnlinkUserDiscordRequest, opts ...grpc.CallOption) (*UserDiscord, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserDiscord)
+	err := c.cc.Invoke(ctx, UserService_UnlinkUserDiscord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserPermissions(ctx context.Context, in *GetUserPermissionsRequest, opts ...grpc.CallOption) (*UserPermissions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserPermissions)
+	err := c.cc.Invoke(ctx, UserService_GetUserPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetUserCustomRole(ctx context.Context, in *SetUserCustomRoleRequest, opts ...grpc.CallOption) (*UserPermissions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserPermissions)
+	err := c.cc.Invoke(ctx, UserService_SetUserCustomRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInvitationsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*Invitation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Invitation)
+	err := c.cc.Invoke(ctx, UserService_CreateInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteInvitation(ctx context.Context, in *DeleteInvitationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListUserReadGrants(ctx context.Context, in *ListUserReadGrantsRequest, opts ...grpc.CallOption) (*ListUserReadGrantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserReadGrantsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListUserReadGrants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateUserReadGrant(ctx context.Context, in *CreateUserReadGrantRequest, opts ...grpc.CallOption) (*UserReadGrant, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserReadGrant)
+	err := c.cc.Invoke(ctx, UserService_CreateUserReadGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteUserReadGrant(ctx context.Context, in *DeleteUserReadGrantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteUserReadGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserServiceServer is the server API for UserService service.
+// All implementations must embed UnimplementedUserServiceServer
+// for forward compatibility.
+type UserServiceServer interface {
+	// ListUsers returns a list of users.
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	// GetUser gets a user by name.
+	GetUser(context.Context, *GetUserRequest) (*User, error)
+	// CreateUser creates a new user.
+	CreateUser(context.Context, *CreateUserRequest) (*User, error)
+	// UpdateUser updates a user.
+	UpdateUser(context.Context, *UpdateUserRequest) (*User, error)
+	// DeleteUser deletes a user.
+	DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error)
+	// DeleteUserAccount deletes the account of the current user with all of their data,
+	// and returns a final export of it.
+	DeleteUserAccount(context.Context, *DeleteUserAccountRequest) (*DeleteUserAccountResponse, error)
+	// SearchUsers searches for users based on query.
+	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
+	// GetUserAvatar gets the avatar of a user.
+	GetUserAvatar(context.Context, *GetUserAvatarRequest) (*httpbody.HttpBody, error)
+	// ListAllUserStats returns statistics for all users.
+	ListAllUserStats(context.Context, *ListAllUserStatsRequest) (*ListAllUserStatsResponse, error)
+	// GetUserStats returns statistics for a specific user.
+	GetUserStats(context.Context, *GetUserStatsRequest) (*UserStats, error)
+	// GetUserSetting returns the user setting.
+	GetUserSetting(context.Context, *GetUserSettingRequest) (*UserSetting, error)
+	// UpdateUserSetting updates the user setting.
+	UpdateUserSetting(context.Context, *UpdateUserSettingRequest) (*UserSetting, error)
+	// ListUserAccessTokens returns a list of access tokens for a user.
+	ListUserAccessTokens(context.Context, *ListUserAccessTokensRequest) (*ListUserAccessTokensResponse, error)
+	// CreateUserAccessToken creates a new access token for a user.
+	CreateUserAccessToken(context.Context, *CreateUserAccessTokenRequest) (*UserAccessToken, error)
+	// UpdateUserAccessToken updates the description or the rate limit of an access token.
+	UpdateUserAccessToken(context.Context, *UpdateUserAccessTokenRequest) (*UserAccessToken, error)
+	// DeleteUserAccessToken deletes an access token.
+	DeleteUserAccessToken(context.Context, *DeleteUserAccessTokenRequest) (*emptypb.Empty, error)
+	// ListUserSessions returns a list of active sessions for a user.
+	ListUserSessions(context.Context, *ListUserSessionsRequest) (*ListUserSessionsResponse, error)
+	// RevokeUserSession revokes a specific session for a user.
+	RevokeUserSession(context.Context, *RevokeUserSessionRequest) (*emptypb.Empty, error)
+	// GetUserTwoFactor gets the two-factor authentication status of a user.
+	GetUserTwoFactor(context.Context, *GetUserTwoFactorRequest) (*UserTwoFactor, error)
+	// SetupUserTwoFactor generates a new TOTP secret for a user, enabled once a code of it is verified.
+	SetupUserTwoFactor(context.Context, *SetupUserTwoFactorRequest) (*SetupUserTwoFactorResponse, error)
+	// EnableUserTwoFactor enables the two-factor authentication of a user with a code of the new secret.
+	EnableUserTwoFactor(context.Context, *EnableUserTwoFactorRequest) (*EnableUserTwoFactorResponse, error)
+	// DisableUserTwoFactor disables the two-factor authentication of a user.
+	DisableUserTwoFactor(context.Context, *DisableUserTwoFactorRequest) (*emptypb.Empty, error)
+	// RegenerateUserRecoveryCodes replaces the recovery codes of a user.
+	RegenerateUserRecoveryCodes(context.Context, *RegenerateUserRecoveryCodesRequest) (*RegenerateUserRecoveryCodesResponse, error)
+	// GetUserSuspension gets the suspension of a user.
+	GetUserSuspension(context.Context, *GetUserSuspensionRequest) (*UserSuspension, error)
+	// SuspendUser suspends a user, who can neither sign in nor use the API until unsuspended.
+	// The data of the user is kept, but their memos are no longer shown to others.
+	SuspendUser(context.Context, *SuspendUserRequest) (*UserSuspension, error)
+	// UnsuspendUser lifts the suspension of a user.
+	UnsuspendUser(context.Context, *UnsuspendUserRequest) (*UserSuspension, error)
+	// GetUserMemoEmail gets the address receiving the memos emailed by a user.
+	GetUserMemoEmail(context.Context, *GetUserMemoEmailRequest) (*UserMemoEmail, error)
+	// ResetUserMemoEmail generates a new address receiving the memos emailed by a user, replacing the previous one.
+	ResetUserMemoEmail(context.Context, *ResetUserMemoEmailRequest) (*UserMemoEmail, error)
+	// DisableUserMemoEmail removes the address receiving the memos emailed by a user.
+	DisableUserMemoEmail(context.Context, *DisableUserMemoEmailRequest) (*UserMemoEmail, error)
+	// GetUserCalendarFeed gets the calendar feed of the dated memos and tasks of a user.
+	GetUserCalendarFeed(context.Context, *GetUserCalendarFeedRequest) (*UserCalendarFeed, error)
+	// ResetUserCalendarFeed generates a new URL of the calendar feed of a user, replacing the previous one.
+	ResetUserCalendarFeed(context.Context, *ResetUserCalendarFeedRequest) (*UserCalendarFeed, error)
+	// DisableUserCalendarFeed removes the calendar feed of a user.
+	DisableUserCalendarFeed(context.Context, *DisableUserCalendarFeedRequest) (*UserCalendarFeed, error)
+	// GetUserReadwise gets the Readwise account whose highlights are synced as memos of a user.
+	GetUserReadwise(context.Context, *GetUserReadwiseRequest) (*UserReadwise, error)
+	// ConnectUserReadwise connects a Readwise account with its access token, syncing its highlights as memos of a user.
+	ConnectUserReadwise(context.Context, *ConnectUserReadwiseRequest) (*UserReadwise, error)
+	// DisconnectUserReadwise stops syncing the highlights of the Readwise account of a user, keeping their memos.
+	DisconnectUserReadwise(context.Context, *DisconnectUserReadwiseRequest) (*UserReadwise, error)
+	// GetUserGistSync gets the GitHub account whose gists mirror the memos of a user tagged #gist.
+	GetUserGistSync(context.Context, *GetUserGistSyncRequest) (*UserGistSync, error)
+	// ConnectUserGistSync connects a GitHub account with its access token, mirroring the memos of a user tagged #gist
+	// to its gists both ways.
+	ConnectUserGistSync(context.Context, *ConnectUserGistSyncRequest) (*UserGistSync, error)
+	// DisconnectUserGistSync stops syncing the memos of a user with the gists of their GitHub account, keeping the gists.
+	DisconnectUserGistSync(context.Context, *DisconnectUserGistSyncRequest) (*UserGistSync, error)
+	// GetUserNotificationPreferences gets the channels notifying a user of each event.
+	GetUserNotificationPreferences(context.Context, *GetUserNotificationPreferencesRequest) (*UserNotificationPreferences, error)
+	// UpdateUserNotificationPreferences updates the channels notifying a user of the given events,
+	// the preferences of the other events being kept.
+	UpdateUserNotificationPreferences(context.Context, *UpdateUserNotificationPreferencesRequest) (*UserNotificationPreferences, error)
+	// GetUserEmailDigest gets the schedule of the email digest of a user.
+	GetUserEmailDigest(context.Context, *GetUserEmailDigestRequest) (*UserEmailDigest, error)
+	// UpdateUserEmailDigest updates the schedule of the email digest of a user, summarizing their memos created,
+	// their open tasks, their dated memos coming up and their memos of the same day in past years.
+	UpdateUserEmailDigest(context.Context, *UpdateUserEmailDigestRequest) (*UserEmailDigest, error)
+	// GetUserOnThisDay gets the schedule of the daily notification of the memos of a user created on this day
+	// in past years.
+	GetUserOnThisDay(context.Context, *GetUserOnThisDayRequest) (*UserOnThisDay, error)
+	// UpdateUserOnThisDay updates the schedule of the daily notification of the memos of a user created on this day
+	// in past years, sent through the channels of the ON_THIS_DAY notification preference.
+	UpdateUserOnThisDay(context.Context, *UpdateUserOnThisDayRequest) (*UserOnThisDay, error)
+	// ListUserWebPushSubscriptions lists the browsers of a user receiving the Web Push notifications of their inbox.
+	ListUserWebPushSubscriptions(context.Context, *ListUserWebPushSubscriptionsRequest) (*ListUserWebPushSubscriptionsResponse, error)
+	// CreateUserWebPushSubscription subscribes a browser of a user to the Web Push notifications of their inbox,
+	// replacing the subscription of the same endpoint if any.
+	CreateUserWebPushSubscription(context.Context, *CreateUserWebPushSubscriptionRequest) (*UserWebPushSubscription, error)
+	// DeleteUserWebPushSubscription unsubscribes a browser of a user from the Web Push notifications.
+	DeleteUserWebPushSubscription(context.Context, *DeleteUserWebPushSubscriptionRequest) (*emptypb.Empty, error)
+	// GetUserSlack gets the Slack account linked to a user.
+	GetUserSlack(context.Context, *GetUserSlackRequest) (*UserSlack, error)
+	// GenerateUserSlackLinkCode generates the code linking a Slack account to a user with the slash command.
+	GenerateUserSlackLinkCode(context.Context, *GenerateUserSlackLinkCodeRequest) (*UserSlack, error)
+	// UnlinkUserSlack unlinks the Slack account of a user.
+	UnlinkUserSlack(context.Context, *UnlinkUserSlackRequest) (*UserSlack, error)
+	// GetUserDiscord gets the Discord account linked to a user.
+	GetUserDiscord(context.Context, *GetUserDiscordRequest) (*UserDiscord, error)
+	// GenerateUserDiscordLinkCode generates the code linking a Discord account to a user with the command of the bot.
+	GenerateUserDiscordLinkCode(context.Context, *GenerateUserDiscordLinkCodeRequest) (*UserDiscord, error)
+	// UnlinkUserDiscord unlinks the Discord account of a user.
+	UnlinkUserDiscord(context.Context, *UnlinkUserDiscordRequest) (*UserDiscord, error)
+	// GetUserPermissions returns the custom role and the effective permissions of a user.
+	GetUserPermissions(context.Context, *GetUserPermissionsRequest) (*UserPermissions, error)
+	// SetUserCustomRole assigns a custom role to a user, or removes it with an empty role.
+	// The permissions of the role must be held by the caller.
+	SetUserCustomRole(context.Context, *SetUserCustomRoleRequest) (*UserPermissions, error)
+	// ListInvitations returns the invitations of the workspace.
+	ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error)
+	// CreateInvitation creates an invitation whose token allows signing up,
+	// even when the user registration is disallowed.
+	CreateInvitation(context.Context, *CreateInvitationRequest) (*Invitation, error)
+	// DeleteInvitation revokes an invitation.
+	DeleteInvitation(context.Context, *DeleteInvitationRequest) (*emptypb.Empty, error)
+	// ListUserReadGrants returns the read grants of a user, the delegations of read access to their private memos.
+	ListUserReadGrants(context.Context, *ListUserReadGrantsRequest) (*ListUserReadGrantsResponse, error)
+	// CreateUserReadGrant grants another user read access to the private memos of a user, all of them or by tag.
+	CreateUserReadGrant(context.Context, *CreateUserReadGrantRequest) (*UserReadGrant, error)
+	// DeleteUserReadGrant revokes a read grant.
+	DeleteUserReadGrant(context.Context, *DeleteUserReadGrantRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+// UnimplementedUserServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserServiceServer struct{}
+
+func (UnimplementedUserServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedUserServiceServer) GetUser(context.Context, *GetUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedUserServiceServer) CreateUser(context.Context, *CreateUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUserAccount(context.Context, *DeleteUserAccountRequest) (*DeleteUserAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserAccount not implemented")
+}
+func (UnimplementedUserServiceServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserAvatar(context.Context, *GetUserAvatarRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserAvatar not implemented")
+}
+func (UnimplementedUserServiceServer) ListAllUserStats(context.Context, *ListAllUserStatsRequest) (*ListAllUserStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllUserStats not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserStats(context.Context, *GetUserStatsRequest) (*UserStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserStats not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserSetting(context.Context, *GetUserSettingRequest) (*UserSetting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserSetting not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateUserSetting(context.Context, *UpdateUserSettingRequest) (*UserSetting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserSetting not implemented")
+}
+func (UnimplementedUserServiceServer) ListUserAccessTokens(context.Context, *ListUserAccessTokensRequest) (*ListUserAccessTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserAccessTokens not implemented")
+}
+func (UnimplementedUserServiceServer) CreateUserAccessToken(context.Context, *CreateUserAccessTokenRequest) (*UserAccessToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUserAccessToken not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateUserAccessToken(context.Context, *UpdateUserAccessTokenRequest) (*UserAccessToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserAccessToken not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUserAccessToken(context.Context, *DeleteUserAccessTokenRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserAccessToken not implemented")
+}
+func (UnimplementedUserServiceServer) ListUserSessions(context.Context, *ListUserSessionsRequest) (*ListUserSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserSessions not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeUserSession(context.Context, *RevokeUserSessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserSession not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserTwoFactor(context.Context, *GetUserTwoFactorRequest) (*UserTwoFactor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserTwoFactor not implemented")
+}
+func (UnimplementedUserServiceServer) SetupUserTwoFactor(context.Context, *SetupUserTwoFactorRequest) (*SetupUserTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetupUserTwoFactor not implemented")
+}
+func (UnimplementedUserServiceServer) EnableUserTwoFactor(context.Context, *EnableUserTwoFactorRequest) (*EnableUserTwoFactorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableUserTwoFactor not implemented")
+}
+func (UnimplementedUserServiceServer) DisableUserTwoFactor(context.Context, *DisableUserTwoFactorRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableUserTwoFactor not implemented")
+}
+func (UnimplementedUserServiceServer) RegenerateUserRecoveryCodes(context.Context, *RegenerateUserRecoveryCodesRequest) (*RegenerateUserRecoveryCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateUserRecoveryCodes not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserSuspension(context.Context, *GetUserSuspensionRequest) (*UserSuspension, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserSuspension not implemented")
+}
+func (UnimplementedUserServiceServer) SuspendUser(context.Context, *SuspendUserRequest) (*UserSuspension, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendUser not implemented")
+}
+func (UnimplementedUserServiceServer) UnsuspendUser(context.Context, *UnsuspendUserRequest) (*UserSuspension, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsuspendUser not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserMemoEmail(context.Context, *GetUserMemoEmailRequest) (*UserMemoEmail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserMemoEmail not implemented")
+}
+func (UnimplementedUserServiceServer) ResetUserMemoEmail(context.Context, *ResetUserMemoEmailRequest) (*UserMemoEmail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetUserMemoEmail not implemented")
+}
+func (UnimplementedUserServiceServer) DisableUserMemoEmail(context.Context, *DisableUserMemoEmailRequest) (*UserMemoEmail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableUserMemoEmail not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserCalendarFeed(context.Context, *GetUserCalendarFeedRequest) (*UserCalendarFeed, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserCalendarFeed not implemented")
+}
+func (UnimplementedUserServiceServer) ResetUserCalendarFeed(context.Context, *ResetUserCalendarFeedRequest) (*UserCalendarFeed, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetUserCalendarFeed not implemented")
+}
+func (UnimplementedUserServiceServer) DisableUserCalendarFeed(context.Context, *DisableUserCalendarFeedRequest) (*UserCalendarFeed, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableUserCalendarFeed not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserReadwise(context.Context, *GetUserReadwiseRequest) (*UserReadwise, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserReadwise not implemented")
+}
+func (UnimplementedUserServiceServer) ConnectUserReadwise(context.Context, *ConnectUserReadwiseRequest) (*UserReadwise, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectUserReadwise not implemented")
+}
+func (UnimplementedUserServiceServer) DisconnectUserReadwise(context.Context, *DisconnectUserReadwiseRequest) (*UserReadwise, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisconnectUserReadwise not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserGistSync(context.Context, *GetUserGistSyncRequest) (*UserGistSync, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserGistSync not implemented")
+}
+func (UnimplementedUserServiceServer) ConnectUserGistSync(context.Context, *ConnectUserGistSyncRequest) (*UserGistSync, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectUserGistSync not implemented")
+}
+func (UnimplementedUserServiceServer) DisconnectUserGistSync(context.Context, *DisconnectUserGistSyncRequest) (*UserGistSync, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisconnectUserGistSync not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserNotificationPreferences(context.Context, *GetUserNotificationPreferencesRequest) (*UserNotificationPreferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserNotificationPreferences not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateUserNotificationPreferences(context.Context, *UpdateUserNotificationPreferencesRequest) (*UserNotificationPreferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserNotificationPreferences not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserEmailDigest(context.Context, *GetUserEmailDigestRequest) (*UserEmailDigest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserEmailDigest not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateUserEmailDigest(context.Context, *UpdateUserEmailDigestRequest) (*UserEmailDigest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserEmailDigest not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserOnThisDay(context.Context, *GetUserOnThisDayRequest) (*UserOnThisDay, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserOnThisDay not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateUserOnThisDay(context.Context, *UpdateUserOnThisDayRequest) (*UserOnThisDay, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserOnThisDay not implemented")
+}
+func (UnimplementedUserServiceServer) ListUserWebPushSubscriptions(context.Context, *ListUserWebPushSubscriptionsRequest) (*ListUserWebPushSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserWebPushSubscriptions not implemented")
+}
+func (UnimplementedUserServiceServer) CreateUserWebPushSubscription(context.Context, *CreateUserWebPushSubscriptionRequest) (*UserWebPushSubscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUserWebPushSubscription not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUserWebPushSubscription(context.Context, *DeleteUserWebPushSubscriptionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserWebPushSubscription not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserSlack(context.Context, *GetUserSlackRequest) (*UserSlack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserSlack not implemented")
+}
+func (UnimplementedUserServiceServer) GenerateUserSlackLinkCode(context.Context, *GenerateUserSlackLinkCodeRequest) (*UserSlack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateUserSlackLinkCode not implemented")
+}
+func (UnimplementedUserServiceServer) UnlinkUserSlack(context.Context, *UnlinkUserSlackRequest) (*UserSlack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkUserSlack not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserDiscord(context.Context, *GetUserDiscordRequest) (*UserDiscord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserDiscord not implemented")
+}
+func (UnimplementedUserServiceServer) GenerateUserDiscordLinkCode(context.Context, *GenerateUserDiscordLinkCodeRequest) (*UserDiscord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateUserDiscordLinkCode not implemented")
+}
+func (UnimplementedUserServiceServer) UnlinkUserDiscord(context.Context, *UnlinkUserDiscordRequest) (*UserDiscord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkUserDiscord not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserPermissions(context.Context, *GetUserPermissionsRequest) (*UserPermissions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserPermissions not implemented")
+}
+func (UnimplementedUserServiceServer) SetUserCustomRole(context.Context, *SetUserCustomRoleRequest) (*UserPermissions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserCustomRole not implemented")
+}
+func (UnimplementedUserServiceServer) ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvitations not implemented")
+}
+func (UnimplementedUserServiceServer) CreateInvitation(context.Context, *CreateInvitationRequest) (*Invitation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvitation not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteInvitation(context.Context, *DeleteInvitationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInvitation not implemented")
+}
+func (UnimplementedUserServiceServer) ListUserReadGrants(context.Context, *ListUserReadGrantsRequest) (*ListUserReadGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserReadGrants not implemented")
+}
+func (UnimplementedUserServiceServer) CreateUserReadGrant(context.Context, *CreateUserReadGrantRequest) (*UserReadGrant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUserReadGrant not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUserReadGrant(context.Context, *DeleteUserReadGrantRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserReadGrant not implemented")
+}
+func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
+func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserServiceServer will
+// result in compilation errors.
+type UnsafeUserServiceServer interface {
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
+	// If the following call pancis, it indicates UnimplementedUserServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UserService_ServiceDesc, srv)
+}
+
+func _UserService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteUserAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUserAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteUserAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUserAccount(ctx, req.(*DeleteUserAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SearchUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SearchUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SearchUsers(ctx, req.(*SearchUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserAvatarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserAvatar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserAvatar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserAvatar(ctx, req.(*GetUserAvatarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListAllUserStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllUserStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListAllUserStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListAllUserStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListAllUserStats(ctx, req.(*ListAllUserStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserStats(ctx, req.(*GetUserStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserSetting(ctx, req.(*GetUserSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateUserSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateUserSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateUserSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateUserSetting(ctx, req.(*UpdateUserSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUserAccessTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserAccessTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUserAccessTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUserAccessTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUserAccessTokens(ctx, req.(*ListUserAccessTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateUserAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateUserAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateUserAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateUserAccessToken(ctx, req.(*CreateUserAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateUserAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateUserAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateUserAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateUserAccessToken(ctx, req.(*UpdateUserAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteUserAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserAccessTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUserAccessToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteUserAccessToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUserAccessToken(ctx, req.(*DeleteUserAccessTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUserSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUserSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUserSessions(ctx, req.(*ListUserSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeUserSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeUserSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeUserSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeUserSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeUserSession(ctx, req.(*RevokeUserSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserTwoFactor(ctx, req.(*GetUserTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetupUserTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetupUserTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetupUserTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetupUserTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetupUserTwoFactor(ctx, req.(*SetupUserTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_EnableUserTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableUserTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).EnableUserTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_EnableUserTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).EnableUserTwoFactor(ctx, req.(*EnableUserTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DisableUserTwoFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableUserTwoFactorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DisableUserTwoFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DisableUserTwoFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DisableUserTwoFactor(ctx, req.(*DisableUserTwoFactorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RegenerateUserRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateUserRecoveryCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RegenerateUserRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RegenerateUserRecoveryCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RegenerateUserRecoveryCodes(ctx, req.(*RegenerateUserRecoveryCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserSuspension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserSuspensionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserSuspension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserSuspension_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserSuspension(ctx, req.(*GetUserSuspensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SuspendUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SuspendUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SuspendUser(ctx, req.(*SuspendUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnsuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsuspendUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnsuspendUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnsuspendUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnsuspendUser(ctx, req.(*UnsuspendUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserMemoEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserMemoEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserMemoEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserMemoEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserMemoEmail(ctx, req.(*GetUserMemoEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ResetUserMemoEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetUserMemoEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ResetUserMemoEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ResetUserMemoEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ResetUserMemoEmail(ctx, req.(*ResetUserMemoEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DisableUserMemoEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableUserMemoEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DisableUserMemoEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DisableUserMemoEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DisableUserMemoEmail(ctx, req.(*DisableUserMemoEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserCalendarFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserCalendarFeed(ctx, req.(*GetUserCalendarFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ResetUserCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetUserCalendarFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ResetUserCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ResetUserCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ResetUserCalendarFeed(ctx, req.(*ResetUserCalendarFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DisableUserCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableUserCalendarFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DisableUserCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DisableUserCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DisableUserCalendarFeed(ctx, req.(*DisableUserCalendarFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserReadwise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserReadwiseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserReadwise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserReadwise_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserReadwise(ctx, req.(*GetUserReadwiseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ConnectUserReadwise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectUserReadwiseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ConnectUserReadwise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ConnectUserReadwise_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ConnectUserReadwise(ctx, req.(*ConnectUserReadwiseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DisconnectUserReadwise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectUserReadwiseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DisconnectUserReadwise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DisconnectUserReadwise_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DisconnectUserReadwise(ctx, req.(*DisconnectUserReadwiseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserGistSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserGistSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserGistSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserGistSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserGistSync(ctx, req.(*GetUserGistSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ConnectUserGistSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectUserGistSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ConnectUserGistSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ConnectUserGistSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ConnectUserGistSync(ctx, req.(*ConnectUserGistSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DisconnectUserGistSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectUserGistSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DisconnectUserGistSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DisconnectUserGistSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DisconnectUserGistSync(ctx, req.(*DisconnectUserGistSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserNotificationPreferences(ctx, req.(*GetUserNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateUserNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateUserNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateUserNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateUserNotificationPreferences(ctx, req.(*UpdateUserNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUserServiceServer) DeleteUserAccessToken(context.Context, *DeleteUserAccessTokenRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserAccessToken not implemented")
+
+func _UserService_GetUserEmailDigest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserEmailDigestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserEmailDigest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserEmailDigest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserEmailDigest(ctx, req.(*GetUserEmailDigestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUserServiceServer) ListUserSessions(context.Context, *ListUserSessionsRequest) (*ListUserSessionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListUserSessions not implemented")
+
+func _UserService_UpdateUserEmailDigest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserEmailDigestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateUserEmailDigest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateUserEmailDigest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateUserEmailDigest(ctx, req.(*UpdateUserEmailDigestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUserServiceServer) RevokeUserSession(context.Context, *RevokeUserSessionRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserSession not implemented")
+
+func _UserService_GetUserOnThisDay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserOnThisDayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserOnThisDay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserOnThisDay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserOnThisDay(ctx, req.(*GetUserOnThisDayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
-func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
-// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to UserServiceServer will
-// result in compilation errors.
-type UnsafeUserServiceServer interface {
-	mustEmbedUnimplementedUserServiceServer()
+func _UserService_UpdateUserOnThisDay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserOnThisDayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateUserOnThisDay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateUserOnThisDay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateUserOnThisDay(ctx, req.(*UpdateUserOnThisDayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
-	// If the following call pancis, it indicates UnimplementedUserServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _UserService_ListUserWebPushSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserWebPushSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&UserService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUserWebPushSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUserWebPushSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUserWebPushSubscriptions(ctx, req.(*ListUserWebPushSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUsersRequest)
+func _UserService_CreateUserWebPushSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserWebPushSubscriptionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).ListUsers(ctx, in)
+		return srv.(UserServiceServer).CreateUserWebPushSubscription(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_ListUsers_FullMethodName,
+		FullMethod: UserService_CreateUserWebPushSubscription_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+		return srv.(UserServiceServer).CreateUserWebPushSubscription(ctx, req.(*CreateUserWebPushSubscriptionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserRequest)
+func _UserService_DeleteUserWebPushSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserWebPushSubscriptionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetUser(ctx, in)
+		return srv.(UserServiceServer).DeleteUserWebPushSubscription(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetUser_FullMethodName,
+		FullMethod: UserService_DeleteUserWebPushSubscription_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetUser(ctx, req.(*GetUserRequest))
+		return srv.(UserServiceServer).DeleteUserWebPushSubscription(ctx, req.(*DeleteUserWebPushSubscriptionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _UserService_GetUserSlack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserSlackRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).CreateUser(ctx, in)
+		return srv.(UserServiceServer).GetUserSlack(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_CreateUser_FullMethodName,
+		FullMethod: UserService_GetUserSlack_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(UserServiceServer).GetUserSlack(ctx, req.(*GetUserSlackRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _UserService_GenerateUserSlackLinkCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateUserSlackLinkCodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).UpdateUser(ctx, in)
+		return srv.(UserServiceServer).GenerateUserSlackLinkCode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_UpdateUser_FullMethodName,
+		FullMethod: UserService_GenerateUserSlackLinkCode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(UserServiceServer).GenerateUserSlackLinkCode(ctx, req.(*GenerateUserSlackLinkCodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserRequest)
+func _UserService_UnlinkUserSlack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkUserSlackRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).DeleteUser(ctx, in)
+		return srv.(UserServiceServer).UnlinkUserSlack(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_DeleteUser_FullMethodName,
+		FullMethod: UserService_UnlinkUserSlack_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+		return srv.(UserServiceServer).UnlinkUserSlack(ctx, req.(*UnlinkUserSlackRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchUsersRequest)
+func _UserService_GetUserDiscord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserDiscordRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).SearchUsers(ctx, in)
+		return srv.(UserServiceServer).GetUserDiscord(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_SearchUsers_FullMethodName,
+		FullMethod: UserService_GetUserDiscord_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).SearchUsers(ctx, req.(*SearchUsersRequest))
+		return srv.(UserServiceServer).GetUserDiscord(ctx, req.(*GetUserDiscordRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetUserAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserAvatarRequest)
+func _UserService_GenerateUserDiscordLinkCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateUserDiscordLinkCodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetUserAvatar(ctx, in)
+		return srv.(UserServiceServer).GenerateUserDiscordLinkCode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetUserAvatar_FullMethodName,
+		FullMethod: UserService_GenerateUserDiscordLinkCode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetUserAvatar(ctx, req.(*GetUserAvatarRequest))
+		return srv.(UserServiceServer).GenerateUserDiscordLinkCode(ctx, req.(*GenerateUserDiscordLinkCodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_ListAllUserStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAllUserStatsRequest)
+func _UserService_UnlinkUserDiscord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkUserDiscordRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).ListAllUserStats(ctx, in)
+		return srv.(UserServiceServer).UnlinkUserDiscord(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_ListAllUserStats_FullMethodName,
+		FullMethod: UserService_UnlinkUserDiscord_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ListAllUserStats(ctx, req.(*ListAllUserStatsRequest))
+		return srv.(UserServiceServer).UnlinkUserDiscord(ctx, req.(*UnlinkUserDiscordRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetUserStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserStatsRequest)
+func _UserService_GetUserPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserPermissionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetUserStats(ctx, in)
+		return srv.(UserServiceServer).GetUserPermissions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetUserStats_FullMethodName,
+		FullMethod: UserService_GetUserPermissions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetUserStats(ctx, req.(*GetUserStatsRequest))
+		return srv.(UserServiceServer).GetUserPermissions(ctx, req.(*GetUserPermissionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetUserSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserSettingRequest)
+func _UserService_SetUserCustomRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserCustomRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetUserSetting(ctx, in)
+		return srv.(UserServiceServer).SetUserCustomRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetUserSetting_FullMethodName,
+		FullMethod: UserService_SetUserCustomRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetUserSetting(ctx, req.(*GetUserSettingRequest))
+		return srv.(UserServiceServer).SetUserCustomRole(ctx, req.(*SetUserCustomRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_UpdateUserSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserSettingRequest)
+func _UserService_ListInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).UpdateUserSetting(ctx, in)
+		return srv.(UserServiceServer).ListInvitations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_UpdateUserSetting_FullMethodName,
+		FullMethod: UserService_ListInvitations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).UpdateUserSetting(ctx, req.(*UpdateUserSettingRequest))
+		return srv.(UserServiceServer).ListInvitations(ctx, req.(*ListInvitationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_ListUserAccessTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUserAccessTokensRequest)
+func _UserService_CreateInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInvitationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).ListUserAccessTokens(ctx, in)
+		return srv.(UserServiceServer).CreateInvitation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_ListUserAccessTokens_FullMethodName,
+		FullMethod: UserService_CreateInvitation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ListUserAccessTokens(ctx, req.(*ListUserAccessTokensRequest))
+		return srv.(UserServiceServer).CreateInvitation(ctx, req.(*CreateInvitationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_CreateUserAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserAccessTokenRequest)
+func _UserService_DeleteInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInvitationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).CreateUserAccessToken(ctx, in)
+		return srv.(UserServiceServer).DeleteInvitation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_CreateUserAccessToken_FullMethodName,
+		FullMethod: UserService_DeleteInvitation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).CreateUserAccessToken(ctx, req.(*CreateUserAccessTokenRequest))
+		return srv.(UserServiceServer).DeleteInvitation(ctx, req.(*DeleteInvitationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_DeleteUserAccessToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserAccessTokenRequest)
+func _UserService_ListUserReadGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserReadGrantsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).DeleteUserAccessToken(ctx, in)
+		return srv.(UserServiceServer).ListUserReadGrants(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_DeleteUserAccessToken_FullMethodName,
+		FullMethod: UserService_ListUserReadGrants_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).DeleteUserAccessToken(ctx, req.(*DeleteUserAccessTokenRequest))
+		return srv.(UserServiceServer).ListUserReadGrants(ctx, req.(*ListUserReadGrantsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_ListUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUserSessionsRequest)
+func _UserService_CreateUserReadGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserReadGrantRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).ListUserSessions(ctx, in)
+		return srv.(UserServiceServer).CreateUserReadGrant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_ListUserSessions_FullMethodName,
+		FullMethod: UserService_CreateUserReadGrant_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ListUserSessions(ctx, req.(*ListUserSessionsRequest))
+		return srv.(UserServiceServer).CreateUserReadGrant(ctx, req.(*CreateUserReadGrantRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_RevokeUserSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RevokeUserSessionRequest)
+func _UserService_DeleteUserReadGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserReadGrantRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).RevokeUserSession(ctx, in)
+		return srv.(UserServiceServer).DeleteUserReadGrant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_RevokeUserSession_FullMethodName,
+		FullMethod: UserService_DeleteUserReadGrant_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).RevokeUserSession(ctx, req.(*RevokeUserSessionRequest))
+		return srv.(UserServiceServer).DeleteUserReadGrant(ctx, req.(*DeleteUserReadGrantRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -675,6 +2315,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _UserService_DeleteUser_Handler,
 		},
+		{
+			MethodName: "DeleteUserAccount",
+			Handler:    _UserService_DeleteUserAccount_Handler,
+		},
 		{
 			MethodName: "SearchUsers",
 			Handler:    _UserService_SearchUsers_Handler,
@@ -707,6 +2351,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateUserAccessToken",
 			Handler:    _UserService_CreateUserAccessToken_Handler,
 		},
+		{
+			MethodName: "UpdateUserAccessToken",
+			Handler:    _UserService_UpdateUserAccessToken_Handler,
+		},
 		{
 			MethodName: "DeleteUserAccessToken",
 			Handler:    _UserService_DeleteUserAccessToken_Handler,
@@ -719,6 +2367,178 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeUserSession",
 			Handler:    _UserService_RevokeUserSession_Handler,
 		},
+		{
+			MethodName: "GetUserTwoFactor",
+			Handler:    _UserService_GetUserTwoFactor_Handler,
+		},
+		{
+			MethodName: "SetupUserTwoFactor",
+			Handler:    _UserService_SetupUserTwoFactor_Handler,
+		},
+		{
+			MethodName: "EnableUserTwoFactor",
+			Handler:    _UserService_EnableUserTwoFactor_Handler,
+		},
+		{
+			MethodName: "DisableUserTwoFactor",
+			Handler:    _UserService_DisableUserTwoFactor_Handler,
+		},
+		{
+			MethodName: "RegenerateUserRecoveryCodes",
+			Handler:    _UserService_RegenerateUserRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "GetUserSuspension",
+			Handler:    _UserService_GetUserSuspension_Handler,
+		},
+		{
+			MethodName: "SuspendUser",
+			Handler:    _UserService_SuspendUser_Handler,
+		},
+		{
+			MethodName: "UnsuspendUser",
+			Handler:    _UserService_UnsuspendUser_Handler,
+		},
+		{
+			MethodName: "GetUserMemoEmail",
+			Handler:    _UserService_GetUserMemoEmail_Handler,
+		},
+		{
+			MethodName: "ResetUserMemoEmail",
+			Handler:    _UserService_ResetUserMemoEmail_Handler,
+		},
+		{
+			MethodName: "DisableUserMemoEmail",
+			Handler:    _UserService_DisableUserMemoEmail_Handler,
+		},
+		{
+			MethodName: "GetUserCalendarFeed",
+			Handler:    _UserService_GetUserCalendarFeed_Handler,
+		},
+		{
+			MethodName: "ResetUserCalendarFeed",
+			Handler:    _UserService_ResetUserCalendarFeed_Handler,
+		},
+		{
+			MethodName: "DisableUserCalendarFeed",
+			Handler:    _UserService_DisableUserCalendarFeed_Handler,
+		},
+		{
+			MethodName: "GetUserReadwise",
+			Handler:    _UserService_GetUserReadwise_Handler,
+		},
+		{
+			MethodName: "ConnectUserReadwise",
+			Handler:    _UserService_ConnectUserReadwise_Handler,
+		},
+		{
+			MethodName: "DisconnectUserReadwise",
+			Handler:    _UserService_DisconnectUserReadwise_Handler,
+		},
+		{
+			MethodName: "GetUserGistSync",
+			Handler:    _UserService_GetUserGistSync_Handler,
+		},
+		{
+			MethodName: "ConnectUserGistSync",
+			Handler:    _UserService_ConnectUserGistSync_Handler,
+		},
+		{
+			MethodName: "DisconnectUserGistSync",
+			Handler:    _UserService_DisconnectUserGistSync_Handler,
+		},
+		{
+			MethodName: "GetUserNotificationPreferences",
+			Handler:    _UserService_GetUserNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "UpdateUserNotificationPreferences",
+			Handler:    _UserService_UpdateUserNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "GetUserEmailDigest",
+			Handler:    _UserService_GetUserEmailDigest_Handler,
+		},
+		{
+			MethodName: "UpdateUserEmailDigest",
+			Handler:    _UserService_UpdateUserEmailDigest_Handler,
+		},
+		{
+			MethodName: "GetUserOnThisDay",
+			Handler:    _UserService_GetUserOnThisDay_Handler,
+		},
+		{
+			MethodName: "UpdateUserOnThisDay",
+			Handler:    _UserService_UpdateUserOnThisDay_Handler,
+		},
+		{
+			MethodName: "ListUserWebPushSubscriptions",
+			Handler:    _UserService_ListUserWebPushSubscriptions_Handler,
+		},
+		{
+			MethodName: "CreateUserWebPushSubscription",
+			Handler:    _UserService_CreateUserWebPushSubscription_Handler,
+		},
+		{
+			MethodName: "DeleteUserWebPushSubscription",
+			Handler:    _UserService_DeleteUserWebPushSubscription_Handler,
+		},
+		{
+			MethodName: "GetUserSlack",
+			Handler:    _UserService_GetUserSlack_Handler,
+		},
+		{
+			MethodName: "GenerateUserSlackLinkCode",
+			Handler:    _UserService_GenerateUserSlackLinkCode_Handler,
+		},
+		{
+			MethodName: "UnlinkUserSlack",
+			Handler:    _UserService_UnlinkUserSlack_Handler,
+		},
+		{
+			MethodName: "GetUserDiscord",
+			Handler:    _UserService_GetUserDiscord_Handler,
+		},
+		{
+			MethodName: "GenerateUserDiscordLinkCode",
+			Handler:    _UserService_GenerateUserDiscordLinkCode_Handler,
+		},
+		{
+			MethodName: "UnlinkUserDiscord",
+			Handler:    _UserService_UnlinkUserDiscord_Handler,
+		},
+		{
+			MethodName: "GetUserPermissions",
+			Handler:    _UserService_GetUserPermissions_Handler,
+		},
+		{
+			MethodName: "SetUserCustomRole",
+			Handler:    _UserService_SetUserCustomRole_Handler,
+		},
+		{
+			MethodName: "ListInvitations",
+			Handler:    _UserService_ListInvitations_Handler,
+		},
+		{
+			MethodName: "CreateInvitation",
+			Handler:    _UserService_CreateInvitation_Handler,
+		},
+		{
+			MethodName: "DeleteInvitation",
+			Handler:    _UserService_DeleteInvitation_Handler,
+		},
+		{
+			MethodName: "ListUserReadGrants",
+			Handler:    _UserService_ListUserReadGrants_Handler,
+		},
+		{
+			MethodName: "CreateUserReadGrant",
+			Handler:    _UserService_CreateUserReadGrant_Handler,
+		},
+		{
+			MethodName: "DeleteUserReadGrant",
+			Handler:    _UserService_DeleteUserReadGrant_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/user_service.proto",
@@ -11,6 +11,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -33,6 +34,10 @@ const (
 	WorkspaceStorageSetting_LOCAL WorkspaceStorageSetting_StorageType = 2
 	// S3 is the S3 storage type.
 	WorkspaceStorageSetting_S3 WorkspaceStorageSetting_StorageType = 3
+	// GCS is the Google Cloud Storage storage type.
+	WorkspaceStorageSetting_GCS WorkspaceStorageSetting_StorageType = 4
+	// SFTP is the SFTP storage type.
+	WorkspaceStorageSetting_SFTP WorkspaceStorageSetting_StorageType = 5
 )
 
 // Enum value maps for WorkspaceStorageSetting_StorageType.
@@ -42,12 +47,16 @@ var (
 		1: "DATABASE",
 		2: "LOCAL",
 		3: "S3",
+		4: "GCS",
+		5: "SFTP",
 	}
 	WorkspaceStorageSetting_StorageType_value = map[string]int32{
 		"STORAGE_TYPE_UNSPECIFIED": 0,
 		"DATABASE":                 1,
 		"LOCAL":                    2,
 		"S3":                       3,
+		"GCS":                      4,
+		"SFTP":                     5,
 	}
 )
 
@@ -78,6 +87,551 @@ func (WorkspaceStorageSetting_StorageType) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{5, 0}
 }
 
+type WorkspaceStorageSetting_ImageCompression_Format int32
+
+const (
+	// Keeps the format of the images.
+	WorkspaceStorageSetting_ImageCompression_FORMAT_UNSPECIFIED WorkspaceStorageSetting_ImageCompression_Format = 0
+	WorkspaceStorageSetting_ImageCompression_JPEG               WorkspaceStorageSetting_ImageCompression_Format = 1
+	// Requires the server to run with --ffmpeg-path.
+	WorkspaceStorageSetting_ImageCompression_WEBP WorkspaceStorageSetting_ImageCompression_Format = 2
+	// Requires the server to run with --ffmpeg-path.
+	WorkspaceStorageSetting_ImageCompression_AVIF WorkspaceStorageSetting_ImageCompression_Format = 3
+)
+
+// Enum value maps for WorkspaceStorageSetting_ImageCompression_Format.
+var (
+	WorkspaceStorageSetting_ImageCompression_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "JPEG",
+		2: "WEBP",
+		3: "AVIF",
+	}
+	WorkspaceStorageSetting_ImageCompression_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"JPEG":               1,
+		"WEBP":               2,
+		"AVIF":               3,
+	}
+)
+
+func (x WorkspaceStorageSetting_ImageCompression_Format) Enum() *WorkspaceStorageSetting_ImageCompression_Format {
+	p := new(WorkspaceStorageSetting_ImageCompression_Format)
+	*p = x
+	return p
+}
+
+func (x WorkspaceStorageSetting_ImageCompression_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceStorageSetting_ImageCompression_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_service_proto_enumTypes[1].Descriptor()
+}
+
+func (WorkspaceStorageSetting_ImageCompression_Format) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_service_proto_enumTypes[1]
+}
+
+func (x WorkspaceStorageSetting_ImageCompression_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceStorageSetting_ImageCompression_Format.Descriptor instead.
+func (WorkspaceStorageSetting_ImageCompression_Format) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{5, 3, 0}
+}
+
+type WorkspaceEmbeddingSetting_Provider int32
+
+const (
+	WorkspaceEmbeddingSetting_PROVIDER_UNSPECIFIED WorkspaceEmbeddingSetting_Provider = 0
+	// OPENAI is any embeddings API compatible with OpenAI, e.g. OpenAI, Ollama or LocalAI.
+	WorkspaceEmbeddingSetting_OPENAI WorkspaceEmbeddingSetting_Provider = 1
+	// LOCAL is the built-in embedding model, which runs without any external service.
+	WorkspaceEmbeddingSetting_LOCAL WorkspaceEmbeddingSetting_Provider = 2
+)
+
+// Enum value maps for WorkspaceEmbeddingSetting_Provider.
+var (
+	WorkspaceEmbeddingSetting_Provider_name = map[int32]string{
+		0: "PROVIDER_UNSPECIFIED",
+		1: "OPENAI",
+		2: "LOCAL",
+	}
+	WorkspaceEmbeddingSetting_Provider_value = map[string]int32{
+		"PROVIDER_UNSPECIFIED": 0,
+		"OPENAI":               1,
+		"LOCAL":                2,
+	}
+)
+
+func (x WorkspaceEmbeddingSetting_Provider) Enum() *WorkspaceEmbeddingSetting_Provider {
+	p := new(WorkspaceEmbeddingSetting_Provider)
+	*p = x
+	return p
+}
+
+func (x WorkspaceEmbeddingSetting_Provider) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceEmbeddingSetting_Provider) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_service_proto_enumTypes[2].Descriptor()
+}
+
+func (WorkspaceEmbeddingSetting_Provider) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_service_proto_enumTypes[2]
+}
+
+func (x WorkspaceEmbeddingSetting_Provider) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceEmbeddingSetting_Provider.Descriptor instead.
+func (WorkspaceEmbeddingSetting_Provider) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{7, 0}
+}
+
+type WorkspaceOCRSetting_Provider int32
+
+const (
+	WorkspaceOCRSetting_PROVIDER_UNSPECIFIED WorkspaceOCRSetting_Provider = 0
+	// TESSERACT runs the Tesseract command line tool installed on the server.
+	WorkspaceOCRSetting_TESSERACT WorkspaceOCRSetting_Provider = 1
+	// API posts the image to an external HTTP API, which responds with a JSON object like {"text": "..."}.
+	WorkspaceOCRSetting_API WorkspaceOCRSetting_Provider = 2
+)
+
+// Enum value maps for WorkspaceOCRSetting_Provider.
+var (
+	WorkspaceOCRSetting_Provider_name = map[int32]string{
+		0: "PROVIDER_UNSPECIFIED",
+		1: "TESSERACT",
+		2: "API",
+	}
+	WorkspaceOCRSetting_Provider_value = map[string]int32{
+		"PROVIDER_UNSPECIFIED": 0,
+		"TESSERACT":            1,
+		"API":                  2,
+	}
+)
+
+func (x WorkspaceOCRSetting_Provider) Enum() *WorkspaceOCRSetting_Provider {
+	p := new(WorkspaceOCRSetting_Provider)
+	*p = x
+	return p
+}
+
+func (x WorkspaceOCRSetting_Provider) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceOCRSetting_Provider) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_service_proto_enumTypes[3].Descriptor()
+}
+
+func (WorkspaceOCRSetting_Provider) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_service_proto_enumTypes[3]
+}
+
+func (x WorkspaceOCRSetting_Provider) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceOCRSetting_Provider.Descriptor instead.
+func (WorkspaceOCRSetting_Provider) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{8, 0}
+}
+
+type WorkspaceMalwareScanSetting_Scanner int32
+
+const (
+	WorkspaceMalwareScanSetting_SCANNER_UNSPECIFIED WorkspaceMalwareScanSetting_Scanner = 0
+	// CLAMAV streams the file to a clamd daemon.
+	WorkspaceMalwareScanSetting_CLAMAV WorkspaceMalwareScanSetting_Scanner = 1
+	// COMMAND runs a command line with the path of the file appended, which exits with 1 if the file is flagged, like clamscan.
+	WorkspaceMalwareScanSetting_COMMAND WorkspaceMalwareScanSetting_Scanner = 2
+	// API posts the file to an external HTTP API, which responds with a JSON object like {"infected": true, "signature": "..."}.
+	WorkspaceMalwareScanSetting_API WorkspaceMalwareScanSetting_Scanner = 3
+)
+
+// Enum value maps for WorkspaceMalwareScanSetting_Scanner.
+var (
+	WorkspaceMalwareScanSetting_Scanner_name = map[int32]string{
+		0: "SCANNER_UNSPECIFIED",
+		1: "CLAMAV",
+		2: "COMMAND",
+		3: "API",
+	}
+	WorkspaceMalwareScanSetting_Scanner_value = map[string]int32{
+		"SCANNER_UNSPECIFIED": 0,
+		"CLAMAV":              1,
+		"COMMAND":             2,
+		"API":                 3,
+	}
+)
+
+func (x WorkspaceMalwareScanSetting_Scanner) Enum() *WorkspaceMalwareScanSetting_Scanner {
+	p := new(WorkspaceMalwareScanSetting_Scanner)
+	*p = x
+	return p
+}
+
+func (x WorkspaceMalwareScanSetting_Scanner) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceMalwareScanSetting_Scanner) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_service_proto_enumTypes[4].Descriptor()
+}
+
+func (WorkspaceMalwareScanSetting_Scanner) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_service_proto_enumTypes[4]
+}
+
+func (x WorkspaceMalwareScanSetting_Scanner) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceMalwareScanSetting_Scanner.Descriptor instead.
+func (WorkspaceMalwareScanSetting_Scanner) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{9, 0}
+}
+
+type WorkspaceMalwareScanSetting_Action int32
+
+const (
+	WorkspaceMalwareScanSetting_ACTION_UNSPECIFIED WorkspaceMalwareScanSetting_Action = 0
+	// REJECT rejects the flagged files.
+	WorkspaceMalwareScanSetting_REJECT WorkspaceMalwareScanSetting_Action = 1
+	// QUARANTINE rejects the flagged files, and keeps a copy of them in the quarantine directory of the data directory for review.
+	WorkspaceMalwareScanSetting_QUARANTINE WorkspaceMalwareScanSetting_Action = 2
+)
+
+// Enum value maps for WorkspaceMalwareScanSetting_Action.
+var (
+	WorkspaceMalwareScanSetting_Action_name = map[int32]string{
+		0: "ACTION_UNSPECIFIED",
+		1: "REJECT",
+		2: "QUARANTINE",
+	}
+	WorkspaceMalwareScanSetting_Action_value = map[string]int32{
+		"ACTION_UNSPECIFIED": 0,
+		"REJECT":             1,
+		"QUARANTINE":         2,
+	}
+)
+
+func (x WorkspaceMalwareScanSetting_Action) Enum() *WorkspaceMalwareScanSetting_Action {
+	p := new(WorkspaceMalwareScanSetting_Action)
+	*p = x
+	return p
+}
+
+func (x WorkspaceMalwareScanSetting_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceMalwareScanSetting_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_service_proto_enumTypes[5].Descriptor()
+}
+
+func (WorkspaceMalwareScanSetting_Action) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_service_proto_enumTypes[5]
+}
+
+func (x WorkspaceMalwareScanSetting_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceMalwareScanSetting_Action.Descriptor instead.
+func (WorkspaceMalwareScanSetting_Action) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{9, 1}
+}
+
+type WorkspaceTranscriptionSetting_Provider int32
+
+const (
+	WorkspaceTranscriptionSetting_PROVIDER_UNSPECIFIED WorkspaceTranscriptionSetting_Provider = 0
+	// WHISPER_CPP runs the whisper.cpp command line tool installed on the server.
+	WorkspaceTranscriptionSetting_WHISPER_CPP WorkspaceTranscriptionSetting_Provider = 1
+	// OPENAI posts the audio to a transcriptions API compatible with OpenAI.
+	WorkspaceTranscriptionSetting_OPENAI WorkspaceTranscriptionSetting_Provider = 2
+)
+
+// Enum value maps for WorkspaceTranscriptionSetting_Provider.
+var (
+	WorkspaceTranscriptionSetting_Provider_name = map[int32]string{
+		0: "PROVIDER_UNSPECIFIED",
+		1: "WHISPER_CPP",
+		2: "OPENAI",
+	}
+	WorkspaceTranscriptionSetting_Provider_value = map[string]int32{
+		"PROVIDER_UNSPECIFIED": 0,
+		"WHISPER_CPP":          1,
+		"OPENAI":               2,
+	}
+)
+
+func (x WorkspaceTranscriptionSetting_Provider) Enum() *WorkspaceTranscriptionSetting_Provider {
+	p := new(WorkspaceTranscriptionSetting_Provider)
+	*p = x
+	return p
+}
+
+func (x WorkspaceTranscriptionSetting_Provider) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceTranscriptionSetting_Provider) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_service_proto_enumTypes[6].Descriptor()
+}
+
+func (WorkspaceTranscriptionSetting_Provider) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_service_proto_enumTypes[6]
+}
+
+func (x WorkspaceTranscriptionSetting_Provider) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceTranscriptionSetting_Provider.Descriptor instead.
+func (WorkspaceTranscriptionSetting_Provider) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{10, 0}
+}
+
+type WorkspaceCaptchaSetting_Provider int32
+
+const (
+	WorkspaceCaptchaSetting_PROVIDER_UNSPECIFIED WorkspaceCaptchaSetting_Provider = 0
+	// TURNSTILE verifies the tokens of Cloudflare Turnstile.
+	WorkspaceCaptchaSetting_TURNSTILE WorkspaceCaptchaSetting_Provider = 1
+	// HCAPTCHA verifies the tokens of hCaptcha.
+	WorkspaceCaptchaSetting_HCAPTCHA WorkspaceCaptchaSetting_Provider = 2
+	// PROOF_OF_WORK is the built-in proof-of-work challenge, which runs without any external service.
+	WorkspaceCaptchaSetting_PROOF_OF_WORK WorkspaceCaptchaSetting_Provider = 3
+)
+
+// Enum value maps for WorkspaceCaptchaSetting_Provider.
+var (
+	WorkspaceCaptchaSetting_Provider_name = map[int32]string{
+		0: "PROVIDER_UNSPECIFIED",
+		1: "TURNSTILE",
+		2: "HCAPTCHA",
+		3: "PROOF_OF_WORK",
+	}
+	WorkspaceCaptchaSetting_Provider_value = map[string]int32{
+		"PROVIDER_UNSPECIFIED": 0,
+		"TURNSTILE":            1,
+		"HCAPTCHA":             2,
+		"PROOF_OF_WORK":        3,
+	}
+)
+
+func (x WorkspaceCaptchaSetting_Provider) Enum() *WorkspaceCaptchaSetting_Provider {
+	p := new(WorkspaceCaptchaSetting_Provider)
+	*p = x
+	return p
+}
+
+func (x WorkspaceCaptchaSetting_Provider) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceCaptchaSetting_Provider) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_service_proto_enumTypes[7].Descriptor()
+}
+
+func (WorkspaceCaptchaSetting_Provider) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_service_proto_enumTypes[7]
+}
+
+func (x WorkspaceCaptchaSetting_Provider) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceCaptchaSetting_Provider.Descriptor instead.
+func (WorkspaceCaptchaSetting_Provider) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{15, 0}
+}
+
+type WorkspaceIntegrityReport_Issue_Type int32
+
+const (
+	WorkspaceIntegrityReport_Issue_TYPE_UNSPECIFIED WorkspaceIntegrityReport_Issue_Type = 0
+	// The creator of a memo does not exist.
+	WorkspaceIntegrityReport_Issue_MEMO_CREATOR_MISSING WorkspaceIntegrityReport_Issue_Type = 1
+	// A memo relation points to a memo that does not exist.
+	WorkspaceIntegrityReport_Issue_MEMO_RELATION_DANGLING WorkspaceIntegrityReport_Issue_Type = 2
+	// The content of an attachment cannot be found.
+	WorkspaceIntegrityReport_Issue_ATTACHMENT_BLOB_MISSING WorkspaceIntegrityReport_Issue_Type = 3
+	// The memo payload does not match the memo content.
+	WorkspaceIntegrityReport_Issue_MEMO_PAYLOAD_DRIFT WorkspaceIntegrityReport_Issue_Type = 4
+	// The content of an attachment does not match its hash.
+	WorkspaceIntegrityReport_Issue_ATTACHMENT_BLOB_CORRUPTED WorkspaceIntegrityReport_Issue_Type = 5
+)
+
+// Enum value maps for WorkspaceIntegrityReport_Issue_Type.
+var (
+	WorkspaceIntegrityReport_Issue_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "MEMO_CREATOR_MISSING",
+		2: "MEMO_RELATION_DANGLING",
+		3: "ATTACHMENT_BLOB_MISSING",
+		4: "MEMO_PAYLOAD_DRIFT",
+		5: "ATTACHMENT_BLOB_CORRUPTED",
+	}
+	WorkspaceIntegrityReport_Issue_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":          0,
+		"MEMO_CREATOR_MISSING":      1,
+		"MEMO_RELATION_DANGLING":    2,
+		"ATTACHMENT_BLOB_MISSING":   3,
+		"MEMO_PAYLOAD_DRIFT":        4,
+		"ATTACHMENT_BLOB_CORRUPTED": 5,
+	}
+)
+
+func (x WorkspaceIntegrityReport_Issue_Type) Enum() *WorkspaceIntegrityReport_Issue_Type {
+	p := new(WorkspaceIntegrityReport_Issue_Type)
+	*p = x
+	return p
+}
+
+func (x WorkspaceIntegrityReport_Issue_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceIntegrityReport_Issue_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_service_proto_enumTypes[8].Descriptor()
+}
+
+func (WorkspaceIntegrityReport_Issue_Type) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_service_proto_enumTypes[8]
+}
+
+func (x WorkspaceIntegrityReport_Issue_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceIntegrityReport_Issue_Type.Descriptor instead.
+func (WorkspaceIntegrityReport_Issue_Type) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{22, 0, 0}
+}
+
+type Job_State int32
+
+const (
+	Job_STATE_UNSPECIFIED Job_State = 0
+	// PENDING is the state of the jobs to be run.
+	Job_PENDING   Job_State = 1
+	Job_RUNNING   Job_State = 2
+	Job_SUCCEEDED Job_State = 3
+	// FAILED is the state of the jobs failed at each of their attempts.
+	Job_FAILED Job_State = 4
+)
+
+// Enum value maps for Job_State.
+var (
+	Job_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "PENDING",
+		2: "RUNNING",
+		3: "SUCCEEDED",
+		4: "FAILED",
+	}
+	Job_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"PENDING":           1,
+		"RUNNING":           2,
+		"SUCCEEDED":         3,
+		"FAILED":            4,
+	}
+)
+
+func (x Job_State) Enum() *Job_State {
+	p := new(Job_State)
+	*p = x
+	return p
+}
+
+func (x Job_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Job_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_service_proto_enumTypes[9].Descriptor()
+}
+
+func (Job_State) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_service_proto_enumTypes[9]
+}
+
+func (x Job_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Job_State.Descriptor instead.
+func (Job_State) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{25, 0}
+}
+
+type ScheduledTask_State int32
+
+const (
+	ScheduledTask_STATE_UNSPECIFIED ScheduledTask_State = 0
+	// PENDING is the state of the runs waiting for a worker of the job queue.
+	ScheduledTask_PENDING   ScheduledTask_State = 1
+	ScheduledTask_RUNNING   ScheduledTask_State = 2
+	ScheduledTask_SUCCEEDED ScheduledTask_State = 3
+	ScheduledTask_FAILED    ScheduledTask_State = 4
+)
+
+// Enum value maps for ScheduledTask_State.
+var (
+	ScheduledTask_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "PENDING",
+		2: "RUNNING",
+		3: "SUCCEEDED",
+		4: "FAILED",
+	}
+	ScheduledTask_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"PENDING":           1,
+		"RUNNING":           2,
+		"SUCCEEDED":         3,
+		"FAILED":            4,
+	}
+)
+
+func (x ScheduledTask_State) Enum() *ScheduledTask_State {
+	p := new(ScheduledTask_State)
+	*p = x
+	return p
+}
+
+func (x ScheduledTask_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduledTask_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_workspace_service_proto_enumTypes[10].Descriptor()
+}
+
+func (ScheduledTask_State) Type() protoreflect.EnumType {
+	return &file_api_v1_workspace_service_proto_enumTypes[10]
+}
+
+func (x ScheduledTask_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduledTask_State.Descriptor instead.
+func (ScheduledTask_State) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{30, 0}
+}
+
 // Workspace profile message containing basic workspace information.
 type WorkspaceProfile struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -89,9 +643,12 @@ type WorkspaceProfile struct {
 	// Mode is the instance mode (e.g. "prod", "dev" or "demo").
 	Mode string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
 	// Instance URL is the URL of the instance.
-	InstanceUrl   string `protobuf:"bytes,6,opt,name=instance_url,json=instanceUrl,proto3" json:"instance_url,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	InstanceUrl string `protobuf:"bytes,6,opt,name=instance_url,json=instanceUrl,proto3" json:"instance_url,omitempty"`
+	// The VAPID public key of the Web Push notifications, base64url-encoded,
+	// to pass as the applicationServerKey of the push subscriptions of the browsers.
+	WebPushPublicKey string `protobuf:"bytes,7,opt,name=web_push_public_key,json=webPushPublicKey,proto3" json:"web_push_public_key,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *WorkspaceProfile) Reset() {
@@ -124,56 +681,2686 @@ func (*WorkspaceProfile) Descriptor() ([]byte, []int) {
 	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *WorkspaceProfile) GetOwner() string {
+func (x *WorkspaceProfile) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *WorkspaceProfile) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *WorkspaceProfile) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *WorkspaceProfile) GetInstanceUrl() string {
+	if x != nil {
+		return x.InstanceUrl
+	}
+	return ""
+}
+
+func (x *WorkspaceProfile) GetWebPushPublicKey() string {
+	if x != nil {
+		return x.WebPushPublicKey
+	}
+	return ""
+}
+
+// Request for workspace profile.
+type GetWorkspaceProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceProfileRequest) Reset() {
+	*x = GetWorkspaceProfileRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceProfileRequest) ProtoMessage() {}
+
+func (x *GetWorkspaceProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceProfileRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{1}
+}
+
+// A workspace setting resource.
+type WorkspaceSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the workspace setting.
+	// Format: workspace/settings/{setting}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*WorkspaceSetting_GeneralSetting
+	//	*WorkspaceSetting_StorageSetting
+	//	*WorkspaceSetting_MemoRelatedSetting
+	//	*WorkspaceSetting_EmbeddingSetting
+	//	*WorkspaceSetting_OcrSetting
+	//	*WorkspaceSetting_MalwareScanSetting
+	//	*WorkspaceSetting_TranscriptionSetting
+	//	*WorkspaceSetting_ScimSetting
+	//	*WorkspaceSetting_PasswordPolicySetting
+	//	*WorkspaceSetting_EmailSetting
+	//	*WorkspaceSetting_RolesSetting
+	//	*WorkspaceSetting_AccessTokenPolicySetting
+	//	*WorkspaceSetting_CaptchaSetting
+	//	*WorkspaceSetting_SlackSetting
+	//	*WorkspaceSetting_DiscordSetting
+	Value         isWorkspaceSetting_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceSetting) Reset() {
+	*x = WorkspaceSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WorkspaceSetting) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkspaceSetting) GetValue() isWorkspaceSetting_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetGeneralSetting() *WorkspaceGeneralSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_GeneralSetting); ok {
+			return x.GeneralSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetStorageSetting() *WorkspaceStorageSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_StorageSetting); ok {
+			return x.StorageSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetMemoRelatedSetting() *WorkspaceMemoRelatedSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_MemoRelatedSetting); ok {
+			return x.MemoRelatedSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetEmbeddingSetting() *WorkspaceEmbeddingSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_EmbeddingSetting); ok {
+			return x.EmbeddingSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetOcrSetting() *WorkspaceOCRSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_OcrSetting); ok {
+			return x.OcrSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetMalwareScanSetting() *WorkspaceMalwareScanSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_MalwareScanSetting); ok {
+			return x.MalwareScanSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetTranscriptionSetting() *WorkspaceTranscriptionSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_TranscriptionSetting); ok {
+			return x.TranscriptionSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetScimSetting() *WorkspaceSCIMSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_ScimSetting); ok {
+			return x.ScimSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetPasswordPolicySetting() *WorkspacePasswordPolicySetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_PasswordPolicySetting); ok {
+			return x.PasswordPolicySetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetEmailSetting() *WorkspaceEmailSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_EmailSetting); ok {
+			return x.EmailSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetRolesSetting() *WorkspaceRolesSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_RolesSetting); ok {
+			return x.RolesSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetAccessTokenPolicySetting() *WorkspaceAccessTokenPolicySetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_AccessTokenPolicySetting); ok {
+			return x.AccessTokenPolicySetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetCaptchaSetting() *WorkspaceCaptchaSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_CaptchaSetting); ok {
+			return x.CaptchaSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetSlackSetting() *WorkspaceSlackSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_SlackSetting); ok {
+			return x.SlackSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetDiscordSetting() *WorkspaceDiscordSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_DiscordSetting); ok {
+			return x.DiscordSetting
+		}
+	}
+	return nil
+}
+
+type isWorkspaceSetting_Value interface {
+	isWorkspaceSetting_Value()
+}
+
+type WorkspaceSetting_GeneralSetting struct {
+	GeneralSetting *WorkspaceGeneralSetting `protobuf:"bytes,2,opt,name=general_setting,json=generalSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_StorageSetting struct {
+	StorageSetting *WorkspaceStorageSetting `protobuf:"bytes,3,opt,name=storage_setting,json=storageSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_MemoRelatedSetting struct {
+	MemoRelatedSetting *WorkspaceMemoRelatedSetting `protobuf:"bytes,4,opt,name=memo_related_setting,json=memoRelatedSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_EmbeddingSetting struct {
+	EmbeddingSetting *WorkspaceEmbeddingSetting `protobuf:"bytes,5,opt,name=embedding_setting,json=embeddingSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_OcrSetting struct {
+	OcrSetting *WorkspaceOCRSetting `protobuf:"bytes,6,opt,name=ocr_setting,json=ocrSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_MalwareScanSetting struct {
+	MalwareScanSetting *WorkspaceMalwareScanSetting `protobuf:"bytes,7,opt,name=malware_scan_setting,json=malwareScanSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_TranscriptionSetting struct {
+	TranscriptionSetting *WorkspaceTranscriptionSetting `protobuf:"bytes,8,opt,name=transcription_setting,json=transcriptionSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_ScimSetting struct {
+	ScimSetting *WorkspaceSCIMSetting `protobuf:"bytes,9,opt,name=scim_setting,json=scimSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_PasswordPolicySetting struct {
+	PasswordPolicySetting *WorkspacePasswordPolicySetting `protobuf:"bytes,10,opt,name=password_policy_setting,json=passwordPolicySetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_EmailSetting struct {
+	EmailSetting *WorkspaceEmailSetting `protobuf:"bytes,11,opt,name=email_setting,json=emailSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_RolesSetting struct {
+	RolesSetting *WorkspaceRolesSetting `protobuf:"bytes,12,opt,name=roles_setting,json=rolesSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_AccessTokenPolicySetting struct {
+	AccessTokenPolicySetting *WorkspaceAccessTokenPolicySetting `protobuf:"bytes,13,opt,name=access_token_policy_setting,json=accessTokenPolicySetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_CaptchaSetting struct {
+	CaptchaSetting *WorkspaceCaptchaSetting `protobuf:"bytes,14,opt,name=captcha_setting,json=captchaSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_SlackSetting struct {
+	SlackSetting *WorkspaceSlackSetting `protobuf:"bytes,15,opt,name=slack_setting,json=slackSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_DiscordSetting struct {
+	DiscordSetting *WorkspaceDiscordSetting `protobuf:"bytes,16,opt,name=discord_setting,json=discordSetting,proto3,oneof"`
+}
+
+func (*WorkspaceSetting_GeneralSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_StorageSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_MemoRelatedSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_EmbeddingSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_OcrSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_MalwareScanSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_TranscriptionSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_ScimSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_PasswordPolicySetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_EmailSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_RolesSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_AccessTokenPolicySetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_CaptchaSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_SlackSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_DiscordSetting) isWorkspaceSetting_Value() {}
+
+type WorkspaceGeneralSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// theme is the name of the selected theme.
+	// This references a CSS file in the web/public/themes/ directory.
+	Theme string `protobuf:"bytes,1,opt,name=theme,proto3" json:"theme,omitempty"`
+	// disallow_user_registration disallows user registration.
+	DisallowUserRegistration bool `protobuf:"varint,2,opt,name=disallow_user_registration,json=disallowUserRegistration,proto3" json:"disallow_user_registration,omitempty"`
+	// disallow_password_auth disallows password authentication.
+	DisallowPasswordAuth bool `protobuf:"varint,3,opt,name=disallow_password_auth,json=disallowPasswordAuth,proto3" json:"disallow_password_auth,omitempty"`
+	// additional_script is the additional script.
+	AdditionalScript string `protobuf:"bytes,4,opt,name=additional_script,json=additionalScript,proto3" json:"additional_script,omitempty"`
+	// additional_style is the additional style.
+	AdditionalStyle string `protobuf:"bytes,5,opt,name=additional_style,json=additionalStyle,proto3" json:"additional_style,omitempty"`
+	// custom_profile is the custom profile.
+	CustomProfile *WorkspaceCustomProfile `protobuf:"bytes,6,opt,name=custom_profile,json=customProfile,proto3" json:"custom_profile,omitempty"`
+	// week_start_day_offset is the week start day offset from Sunday.
+	// 0: Sunday, 1: Monday, 2: Tuesday, 3: Wednesday, 4: Thursday, 5: Friday, 6: Saturday
+	// Default is Sunday.
+	WeekStartDayOffset int32 `protobuf:"varint,7,opt,name=week_start_day_offset,json=weekStartDayOffset,proto3" json:"week_start_day_offset,omitempty"`
+	// disallow_change_username disallows changing username.
+	DisallowChangeUsername bool `protobuf:"varint,8,opt,name=disallow_change_username,json=disallowChangeUsername,proto3" json:"disallow_change_username,omitempty"`
+	// disallow_change_nickname disallows changing nickname.
+	DisallowChangeNickname bool `protobuf:"varint,9,opt,name=disallow_change_nickname,json=disallowChangeNickname,proto3" json:"disallow_change_nickname,omitempty"`
+	// require_admin_two_factor requires the host and admins to enable two-factor authentication.
+	RequireAdminTwoFactor bool `protobuf:"varint,10,opt,name=require_admin_two_factor,json=requireAdminTwoFactor,proto3" json:"require_admin_two_factor,omitempty"`
+	// sso_only disables the password sign in and sign up, to authenticate with the identity providers only.
+	// The host keeps the password sign in, as a break-glass account for when the identity providers are down.
+	SsoOnly bool `protobuf:"varint,11,opt,name=sso_only,json=ssoOnly,proto3" json:"sso_only,omitempty"`
+	// inbox_retention_days is the number of days the read inbox messages are kept for.
+	// The read inbox messages are kept forever if 0.
+	InboxRetentionDays int32 `protobuf:"varint,12,opt,name=inbox_retention_days,json=inboxRetentionDays,proto3" json:"inbox_retention_days,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *WorkspaceGeneralSetting) Reset() {
+	*x = WorkspaceGeneralSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceGeneralSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceGeneralSetting) ProtoMessage() {}
+
+func (x *WorkspaceGeneralSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceGeneralSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceGeneralSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WorkspaceGeneralSetting) GetTheme() string {
+	if x != nil {
+		return x.Theme
+	}
+	return ""
+}
+
+func (x *WorkspaceGeneralSetting) GetDisallowUserRegistration() bool {
+	if x != nil {
+		return x.DisallowUserRegistration
+	}
+	return false
+}
+
+func (x *WorkspaceGeneralSetting) GetDisallowPasswordAuth() bool {
+	if x != nil {
+		return x.DisallowPasswordAuth
+	}
+	return false
+}
+
+func (x *WorkspaceGeneralSetting) GetAdditionalScript() string {
+	if x != nil {
+		return x.AdditionalScript
+	}
+	return ""
+}
+
+func (x *WorkspaceGeneralSetting) GetAdditionalStyle() string {
+	if x != nil {
+		return x.AdditionalStyle
+	}
+	return ""
+}
+
+func (x *WorkspaceGeneralSetting) GetCustomProfile() *WorkspaceCustomProfile {
+	if x != nil {
+		return x.CustomProfile
+	}
+	return nil
+}
+
+func (x *WorkspaceGeneralSetting) GetWeekStartDayOffset() int32 {
+	if x != nil {
+		return x.WeekStartDayOffset
+	}
+	return 0
+}
+
+func (x *WorkspaceGeneralSetting) GetDisallowChangeUsername() bool {
+	if x != nil {
+		return x.DisallowChangeUsername
+	}
+	return false
+}
+
+func (x *WorkspaceGeneralSetting) GetDisallowChangeNickname() bool {
+	if x != nil {
+		return x.DisallowChangeNickname
+	}
+	return false
+}
+
+func (x *WorkspaceGeneralSetting) GetRequireAdminTwoFactor() bool {
+	if x != nil {
+		return x.RequireAdminTwoFactor
+	}
+	return false
+}
+
+func (x *WorkspaceGeneralSetting) GetSsoOnly() bool {
+	if x != nil {
+		return x.SsoOnly
+	}
+	return false
+}
+
+func (x *WorkspaceGeneralSetting) GetInboxRetentionDays() int32 {
+	if x != nil {
+		return x.InboxRetentionDays
+	}
+	return 0
+}
+
+type WorkspaceCustomProfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	LogoUrl       string                 `protobuf:"bytes,3,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	Locale        string                 `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
+	Appearance    string                 `protobuf:"bytes,5,opt,name=appearance,proto3" json:"appearance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceCustomProfile) Reset() {
+	*x = WorkspaceCustomProfile{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceCustomProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceCustomProfile) ProtoMessage() {}
+
+func (x *WorkspaceCustomProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceCustomProfile.ProtoReflect.Descriptor instead.
+func (*WorkspaceCustomProfile) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WorkspaceCustomProfile) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *WorkspaceCustomProfile) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WorkspaceCustomProfile) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *WorkspaceCustomProfile) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *WorkspaceCustomProfile) GetAppearance() string {
+	if x != nil {
+		return x.Appearance
+	}
+	return ""
+}
+
+type WorkspaceStorageSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// storage_type is the storage type.
+	StorageType WorkspaceStorageSetting_StorageType `protobuf:"varint,1,opt,name=storage_type,json=storageType,proto3,enum=memos.api.v1.WorkspaceStorageSetting_StorageType" json:"storage_type,omitempty"`
+	// The template of file path.
+	// e.g. assets/{timestamp}_{filename}
+	// {hash} is the SHA-256 hash of the content, e.g. assets/{hash} stores the files by their content.
+	FilepathTemplate string `protobuf:"bytes,2,opt,name=filepath_template,json=filepathTemplate,proto3" json:"filepath_template,omitempty"`
+	// The max upload size in megabytes.
+	UploadSizeLimitMb int64 `protobuf:"varint,3,opt,name=upload_size_limit_mb,json=uploadSizeLimitMb,proto3" json:"upload_size_limit_mb,omitempty"`
+	// The S3 config.
+	S3Config *WorkspaceStorageSetting_S3Config `protobuf:"bytes,4,opt,name=s3_config,json=s3Config,proto3" json:"s3_config,omitempty"`
+	// The Google Cloud Storage config.
+	GcsConfig *WorkspaceStorageSetting_GCSConfig `protobuf:"bytes,5,opt,name=gcs_config,json=gcsConfig,proto3" json:"gcs_config,omitempty"`
+	// The SFTP config.
+	SftpConfig *WorkspaceStorageSetting_SFTPConfig `protobuf:"bytes,6,opt,name=sftp_config,json=sftpConfig,proto3" json:"sftp_config,omitempty"`
+	// Whether to strip the EXIF metadata, e.g. the GPS position and camera, of the uploaded images for all users.
+	// The presigned uploads to S3 are not stripped, as their content does not go through the server.
+	StripImageMetadata bool `protobuf:"varint,7,opt,name=strip_image_metadata,json=stripImageMetadata,proto3" json:"strip_image_metadata,omitempty"`
+	// Whether to keep the EXIF orientation of the stripped images, so that they are displayed upright.
+	KeepImageOrientation bool `protobuf:"varint,8,opt,name=keep_image_orientation,json=keepImageOrientation,proto3" json:"keep_image_orientation,omitempty"`
+	// The recompression of the uploaded images.
+	ImageCompression *WorkspaceStorageSetting_ImageCompression `protobuf:"bytes,9,opt,name=image_compression,json=imageCompression,proto3" json:"image_compression,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WorkspaceStorageSetting) Reset() {
+	*x = WorkspaceStorageSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceStorageSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceStorageSetting) ProtoMessage() {}
+
+func (x *WorkspaceStorageSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceStorageSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceStorageSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WorkspaceStorageSetting) GetStorageType() WorkspaceStorageSetting_StorageType {
+	if x != nil {
+		return x.StorageType
+	}
+	return WorkspaceStorageSetting_STORAGE_TYPE_UNSPECIFIED
+}
+
+func (x *WorkspaceStorageSetting) GetFilepathTemplate() string {
+	if x != nil {
+		return x.FilepathTemplate
+	}
+	return ""
+}
+
+func (x *WorkspaceStorageSetting) GetUploadSizeLimitMb() int64 {
+	if x != nil {
+		return x.UploadSizeLimitMb
+	}
+	return 0
+}
+
+func (x *WorkspaceStorageSetting) GetS3Config() *WorkspaceStorageSetting_S3Config {
+	if x != nil {
+		return x.S3Config
+	}
+	return nil
+}
+
+func (x *WorkspaceStorageSetting) GetGcsConfig() *WorkspaceStorageSetting_GCSConfig {
+	if x != nil {
+		return x.GcsConfig
+	}
+	return nil
+}
+
+func (x *WorkspaceStorageSetting) GetSftpConfig() *WorkspaceStorageSetting_SFTPConfig {
+	if x != nil {
+		return x.SftpConfig
+	}
+	return nil
+}
+
+func (x *WorkspaceStorageSetting) GetStripImageMetadata() bool {
+	if x != nil {
+		return x.StripImageMetadata
+	}
+	return false
+}
+
+func (x *WorkspaceStorageSetting) GetKeepImageOrientation() bool {
+	if x != nil {
+		return x.KeepImageOrientation
+	}
+	return false
+}
+
+func (x *WorkspaceStorageSetting) GetImageCompression() *WorkspaceStorageSetting_ImageCompression {
+	if x != nil {
+		return x.ImageCompression
+	}
+	return nil
+}
+
+type WorkspaceMemoRelatedSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// disallow_public_visibility disallows set memo as public visibility.
+	DisallowPublicVisibility bool `protobuf:"varint,1,opt,name=disallow_public_visibility,json=disallowPublicVisibility,proto3" json:"disallow_public_visibility,omitempty"`
+	// display_with_update_time orders and displays memo with update time.
+	DisplayWithUpdateTime bool `protobuf:"varint,2,opt,name=display_with_update_time,json=displayWithUpdateTime,proto3" json:"display_with_update_time,omitempty"`
+	// content_length_limit is the limit of content length. Unit is byte.
+	ContentLengthLimit int32 `protobuf:"varint,3,opt,name=content_length_limit,json=contentLengthLimit,proto3" json:"content_length_limit,omitempty"`
+	// enable_double_click_edit enables editing on double click.
+	EnableDoubleClickEdit bool `protobuf:"varint,4,opt,name=enable_double_click_edit,json=enableDoubleClickEdit,proto3" json:"enable_double_click_edit,omitempty"`
+	// enable_link_preview enables links preview.
+	EnableLinkPreview bool `protobuf:"varint,5,opt,name=enable_link_preview,json=enableLinkPreview,proto3" json:"enable_link_preview,omitempty"`
+	// enable_comment enables comment.
+	EnableComment bool `protobuf:"varint,6,opt,name=enable_comment,json=enableComment,proto3" json:"enable_comment,omitempty"`
+	// reactions is the list of reactions.
+	Reactions []string `protobuf:"bytes,7,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// disable_markdown_shortcuts disallow the registration of markdown shortcuts.
+	DisableMarkdownShortcuts bool `protobuf:"varint,8,opt,name=disable_markdown_shortcuts,json=disableMarkdownShortcuts,proto3" json:"disable_markdown_shortcuts,omitempty"`
+	// enable_blur_nsfw_content enables blurring of content marked as not safe for work (NSFW).
+	EnableBlurNsfwContent bool `protobuf:"varint,9,opt,name=enable_blur_nsfw_content,json=enableBlurNsfwContent,proto3" json:"enable_blur_nsfw_content,omitempty"`
+	// nsfw_tags is the list of tags that mark content as NSFW for blurring.
+	NsfwTags      []string `protobuf:"bytes,10,rep,name=nsfw_tags,json=nsfwTags,proto3" json:"nsfw_tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceMemoRelatedSetting) Reset() {
+	*x = WorkspaceMemoRelatedSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceMemoRelatedSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceMemoRelatedSetting) ProtoMessage() {}
+
+func (x *WorkspaceMemoRelatedSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceMemoRelatedSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceMemoRelatedSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetDisallowPublicVisibility() bool {
+	if x != nil {
+		return x.DisallowPublicVisibility
+	}
+	return false
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetDisplayWithUpdateTime() bool {
+	if x != nil {
+		return x.DisplayWithUpdateTime
+	}
+	return false
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetContentLengthLimit() int32 {
+	if x != nil {
+		return x.ContentLengthLimit
+	}
+	return 0
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetEnableDoubleClickEdit() bool {
+	if x != nil {
+		return x.EnableDoubleClickEdit
+	}
+	return false
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetEnableLinkPreview() bool {
+	if x != nil {
+		return x.EnableLinkPreview
+	}
+	return false
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetEnableComment() bool {
+	if x != nil {
+		return x.EnableComment
+	}
+	return false
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetReactions() []string {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetDisableMarkdownShortcuts() bool {
+	if x != nil {
+		return x.DisableMarkdownShortcuts
+	}
+	return false
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetEnableBlurNsfwContent() bool {
+	if x != nil {
+		return x.EnableBlurNsfwContent
+	}
+	return false
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetNsfwTags() []string {
+	if x != nil {
+		return x.NsfwTags
+	}
+	return nil
+}
+
+type WorkspaceEmbeddingSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enabled enables indexing memos and semantic search.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// provider is the embedding provider.
+	Provider WorkspaceEmbeddingSetting_Provider `protobuf:"varint,2,opt,name=provider,proto3,enum=memos.api.v1.WorkspaceEmbeddingSetting_Provider" json:"provider,omitempty"`
+	// endpoint is the base URL of the OpenAI compatible API, e.g. https://api.openai.com/v1.
+	Endpoint string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// api_key is the API key of the OpenAI compatible API.
+	ApiKey string `protobuf:"bytes,4,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// model is the name of the embedding model, e.g. text-embedding-3-small.
+	Model         string `protobuf:"bytes,5,opt,name=model,proto3" json:"model,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceEmbeddingSetting) Reset() {
+	*x = WorkspaceEmbeddingSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceEmbeddingSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceEmbeddingSetting) ProtoMessage() {}
+
+func (x *WorkspaceEmbeddingSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceEmbeddingSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceEmbeddingSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WorkspaceEmbeddingSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WorkspaceEmbeddingSetting) GetProvider() WorkspaceEmbeddingSetting_Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return WorkspaceEmbeddingSetting_PROVIDER_UNSPECIFIED
+}
+
+func (x *WorkspaceEmbeddingSetting) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *WorkspaceEmbeddingSetting) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *WorkspaceEmbeddingSetting) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+type WorkspaceOCRSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enabled enables extracting the text of image attachments for search.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// provider is the OCR provider.
+	Provider WorkspaceOCRSetting_Provider `protobuf:"varint,2,opt,name=provider,proto3,enum=memos.api.v1.WorkspaceOCRSetting_Provider" json:"provider,omitempty"`
+	// tesseract_path is the path of the tesseract binary, default to "tesseract" in the PATH.
+	TesseractPath string `protobuf:"bytes,3,opt,name=tesseract_path,json=tesseractPath,proto3" json:"tesseract_path,omitempty"`
+	// languages are the Tesseract languages to recognize, e.g. "eng+deu", default to "eng".
+	Languages string `protobuf:"bytes,4,opt,name=languages,proto3" json:"languages,omitempty"`
+	// endpoint is the URL of the OCR API.
+	Endpoint string `protobuf:"bytes,5,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// api_key is sent as a bearer token to the OCR API.
+	ApiKey        string `protobuf:"bytes,6,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceOCRSetting) Reset() {
+	*x = WorkspaceOCRSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceOCRSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceOCRSetting) ProtoMessage() {}
+
+func (x *WorkspaceOCRSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceOCRSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceOCRSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WorkspaceOCRSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WorkspaceOCRSetting) GetProvider() WorkspaceOCRSetting_Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return WorkspaceOCRSetting_PROVIDER_UNSPECIFIED
+}
+
+func (x *WorkspaceOCRSetting) GetTesseractPath() string {
+	if x != nil {
+		return x.TesseractPath
+	}
+	return ""
+}
+
+func (x *WorkspaceOCRSetting) GetLanguages() string {
+	if x != nil {
+		return x.Languages
+	}
+	return ""
+}
+
+func (x *WorkspaceOCRSetting) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *WorkspaceOCRSetting) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+type WorkspaceMalwareScanSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enabled enables scanning the uploaded attachments for malware.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// scanner is the malware scanner.
+	Scanner WorkspaceMalwareScanSetting_Scanner `protobuf:"varint,2,opt,name=scanner,proto3,enum=memos.api.v1.WorkspaceMalwareScanSetting_Scanner" json:"scanner,omitempty"`
+	// clamav_address is the address of clamd, a host:port or the path of its unix socket, default to "localhost:3310".
+	ClamavAddress string `protobuf:"bytes,3,opt,name=clamav_address,json=clamavAddress,proto3" json:"clamav_address,omitempty"`
+	// command is the scanning command line, e.g. "clamscan --no-summary".
+	Command string `protobuf:"bytes,4,opt,name=command,proto3" json:"command,omitempty"`
+	// endpoint is the URL of the scanning API.
+	Endpoint string `protobuf:"bytes,5,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// api_key is sent as a bearer token to the scanning API.
+	ApiKey string `protobuf:"bytes,6,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// action is the action on the flagged files, default to REJECT.
+	Action WorkspaceMalwareScanSetting_Action `protobuf:"varint,7,opt,name=action,proto3,enum=memos.api.v1.WorkspaceMalwareScanSetting_Action" json:"action,omitempty"`
+	// fail_open accepts the files when the scanner fails, rather than rejecting them.
+	FailOpen      bool `protobuf:"varint,8,opt,name=fail_open,json=failOpen,proto3" json:"fail_open,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceMalwareScanSetting) Reset() {
+	*x = WorkspaceMalwareScanSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceMalwareScanSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceMalwareScanSetting) ProtoMessage() {}
+
+func (x *WorkspaceMalwareScanSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceMalwareScanSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceMalwareScanSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WorkspaceMalwareScanSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WorkspaceMalwareScanSetting) GetScanner() WorkspaceMalwareScanSetting_Scanner {
+	if x != nil {
+		return x.Scanner
+	}
+	return WorkspaceMalwareScanSetting_SCANNER_UNSPECIFIED
+}
+
+func (x *WorkspaceMalwareScanSetting) GetClamavAddress() string {
+	if x != nil {
+		return x.ClamavAddress
+	}
+	return ""
+}
+
+func (x *WorkspaceMalwareScanSetting) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *WorkspaceMalwareScanSetting) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *WorkspaceMalwareScanSetting) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *WorkspaceMalwareScanSetting) GetAction() WorkspaceMalwareScanSetting_Action {
+	if x != nil {
+		return x.Action
+	}
+	return WorkspaceMalwareScanSetting_ACTION_UNSPECIFIED
+}
+
+func (x *WorkspaceMalwareScanSetting) GetFailOpen() bool {
+	if x != nil {
+		return x.FailOpen
+	}
+	return false
+}
+
+type WorkspaceTranscriptionSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enabled enables transcribing the audio attachments of memos, e.g. voice memos.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// provider is the transcription provider.
+	Provider WorkspaceTranscriptionSetting_Provider `protobuf:"varint,2,opt,name=provider,proto3,enum=memos.api.v1.WorkspaceTranscriptionSetting_Provider" json:"provider,omitempty"`
+	// whisper_path is the path of the whisper.cpp binary, default to "whisper-cli" in the PATH.
+	WhisperPath string `protobuf:"bytes,3,opt,name=whisper_path,json=whisperPath,proto3" json:"whisper_path,omitempty"`
+	// whisper_model_path is the path of the whisper.cpp model file, e.g. "/models/ggml-base.bin".
+	WhisperModelPath string `protobuf:"bytes,4,opt,name=whisper_model_path,json=whisperModelPath,proto3" json:"whisper_model_path,omitempty"`
+	// language is the spoken language, e.g. "en", default to detecting it.
+	Language string `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	// endpoint is the base URL of the OpenAI compatible API, e.g. https://api.openai.com/v1.
+	Endpoint string `protobuf:"bytes,6,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// api_key is the API key of the OpenAI compatible API.
+	ApiKey string `protobuf:"bytes,7,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// model is the name of the transcription model of the API, default to "whisper-1".
+	Model         string `protobuf:"bytes,8,opt,name=model,proto3" json:"model,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceTranscriptionSetting) Reset() {
+	*x = WorkspaceTranscriptionSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceTranscriptionSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceTranscriptionSetting) ProtoMessage() {}
+
+func (x *WorkspaceTranscriptionSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceTranscriptionSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceTranscriptionSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WorkspaceTranscriptionSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WorkspaceTranscriptionSetting) GetProvider() WorkspaceTranscriptionSetting_Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return WorkspaceTranscriptionSetting_PROVIDER_UNSPECIFIED
+}
+
+func (x *WorkspaceTranscriptionSetting) GetWhisperPath() string {
+	if x != nil {
+		return x.WhisperPath
+	}
+	return ""
+}
+
+func (x *WorkspaceTranscriptionSetting) GetWhisperModelPath() string {
+	if x != nil {
+		return x.WhisperModelPath
+	}
+	return ""
+}
+
+func (x *WorkspaceTranscriptionSetting) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *WorkspaceTranscriptionSetting) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *WorkspaceTranscriptionSetting) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *WorkspaceTranscriptionSetting) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+type WorkspaceSCIMSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enabled enables the SCIM 2.0 provisioning endpoint at /scim/v2, for identity providers to manage the users.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// token is the bearer token of the identity provider calling the endpoint.
+	Token         string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceSCIMSetting) Reset() {
+	*x = WorkspaceSCIMSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSCIMSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSCIMSetting) ProtoMessage() {}
+
+func (x *WorkspaceSCIMSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSCIMSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSCIMSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WorkspaceSCIMSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WorkspaceSCIMSetting) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type WorkspacePasswordPolicySetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// min_length is the minimum length of the passwords, not enforced if zero.
+	MinLength int32 `protobuf:"varint,1,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	// check_breached rejects the passwords found in known data breaches, checked with the k-anonymity range API of Have I Been Pwned.
+	CheckBreached bool `protobuf:"varint,2,opt,name=check_breached,json=checkBreached,proto3" json:"check_breached,omitempty"`
+	// breach_check_endpoint is the endpoint of the range API, https://api.pwnedpasswords.com if empty.
+	BreachCheckEndpoint string `protobuf:"bytes,3,opt,name=breach_check_endpoint,json=breachCheckEndpoint,proto3" json:"breach_check_endpoint,omitempty"`
+	// require_change_after_reset requires the users to change the passwords set by an admin before using the API.
+	RequireChangeAfterReset bool `protobuf:"varint,4,opt,name=require_change_after_reset,json=requireChangeAfterReset,proto3" json:"require_change_after_reset,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *WorkspacePasswordPolicySetting) Reset() {
+	*x = WorkspacePasswordPolicySetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspacePasswordPolicySetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspacePasswordPolicySetting) ProtoMessage() {}
+
+func (x *WorkspacePasswordPolicySetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspacePasswordPolicySetting.ProtoReflect.Descriptor instead.
+func (*WorkspacePasswordPolicySetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WorkspacePasswordPolicySetting) GetMinLength() int32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *WorkspacePasswordPolicySetting) GetCheckBreached() bool {
+	if x != nil {
+		return x.CheckBreached
+	}
+	return false
+}
+
+func (x *WorkspacePasswordPolicySetting) GetBreachCheckEndpoint() string {
+	if x != nil {
+		return x.BreachCheckEndpoint
+	}
+	return ""
+}
+
+func (x *WorkspacePasswordPolicySetting) GetRequireChangeAfterReset() bool {
+	if x != nil {
+		return x.RequireChangeAfterReset
+	}
+	return false
+}
+
+type WorkspaceEmailSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// smtp_host and smtp_port are the address of the SMTP server sending the emails.
+	SmtpHost string `protobuf:"bytes,1,opt,name=smtp_host,json=smtpHost,proto3" json:"smtp_host,omitempty"`
+	SmtpPort int32  `protobuf:"varint,2,opt,name=smtp_port,json=smtpPort,proto3" json:"smtp_port,omitempty"`
+	// smtp_username and smtp_password authenticate to the SMTP server, if the username is not empty.
+	SmtpUsername string `protobuf:"bytes,3,opt,name=smtp_username,json=smtpUsername,proto3" json:"smtp_username,omitempty"`
+	SmtpPassword string `protobuf:"bytes,4,opt,name=smtp_password,json=smtpPassword,proto3" json:"smtp_password,omitempty"`
+	// use_tls connects with implicit TLS, e.g. on the port 465, instead of upgrading with STARTTLS when offered.
+	UseTls bool `protobuf:"varint,5,opt,name=use_tls,json=useTls,proto3" json:"use_tls,omitempty"`
+	// from_email and from_name are the sender of the emails.
+	FromEmail string `protobuf:"bytes,6,opt,name=from_email,json=fromEmail,proto3" json:"from_email,omitempty"`
+	FromName  string `protobuf:"bytes,7,opt,name=from_name,json=fromName,proto3" json:"from_name,omitempty"`
+	// require_email_verification requires the users signing up to verify their email before signing in.
+	RequireEmailVerification bool `protobuf:"varint,8,opt,name=require_email_verification,json=requireEmailVerification,proto3" json:"require_email_verification,omitempty"`
+	// allow_password_reset lets the users reset their forgotten password by email.
+	AllowPasswordReset bool `protobuf:"varint,9,opt,name=allow_password_reset,json=allowPasswordReset,proto3" json:"allow_password_reset,omitempty"`
+	// imap_host and imap_port are the address of the IMAP server of the mailbox whose emails are created as memos,
+	// polled instead of or alongside the built-in SMTP server. Disabled if the host is empty.
+	// The emails are created as memos of the users whose memo email token is the subaddress of one of their recipients,
+	// e.g. memos+token@example.com.
+	ImapHost string `protobuf:"bytes,10,opt,name=imap_host,json=imapHost,proto3" json:"imap_host,omitempty"`
+	ImapPort int32  `protobuf:"varint,11,opt,name=imap_port,json=imapPort,proto3" json:"imap_port,omitempty"`
+	// imap_username and imap_password authenticate to the IMAP server.
+	ImapUsername string `protobuf:"bytes,12,opt,name=imap_username,json=imapUsername,proto3" json:"imap_username,omitempty"`
+	ImapPassword string `protobuf:"bytes,13,opt,name=imap_password,json=imapPassword,proto3" json:"imap_password,omitempty"`
+	// imap_use_tls connects with implicit TLS, e.g. on the port 993, instead of upgrading with STARTTLS when offered.
+	ImapUseTls bool `protobuf:"varint,14,opt,name=imap_use_tls,json=imapUseTls,proto3" json:"imap_use_tls,omitempty"`
+	// imap_folder is the folder whose unseen emails are created as memos, INBOX if empty.
+	ImapFolder string `protobuf:"bytes,15,opt,name=imap_folder,json=imapFolder,proto3" json:"imap_folder,omitempty"`
+	// imap_archive_folder is the folder the processed emails are moved to, Archive if empty.
+	ImapArchiveFolder string `protobuf:"bytes,16,opt,name=imap_archive_folder,json=imapArchiveFolder,proto3" json:"imap_archive_folder,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WorkspaceEmailSetting) Reset() {
+	*x = WorkspaceEmailSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceEmailSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceEmailSetting) ProtoMessage() {}
+
+func (x *WorkspaceEmailSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceEmailSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceEmailSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WorkspaceEmailSetting) GetSmtpHost() string {
+	if x != nil {
+		return x.SmtpHost
+	}
+	return ""
+}
+
+func (x *WorkspaceEmailSetting) GetSmtpPort() int32 {
+	if x != nil {
+		return x.SmtpPort
+	}
+	return 0
+}
+
+func (x *WorkspaceEmailSetting) GetSmtpUsername() string {
+	if x != nil {
+		return x.SmtpUsername
+	}
+	return ""
+}
+
+func (x *WorkspaceEmailSetting) GetSmtpPassword() string {
+	if x != nil {
+		return x.SmtpPassword
+	}
+	return ""
+}
+
+func (x *WorkspaceEmailSetting) GetUseTls() bool {
+	if x != nil {
+		return x.UseTls
+	}
+	return false
+}
+
+func (x *WorkspaceEmailSetting) GetFromEmail() string {
+	if x != nil {
+		return x.FromEmail
+	}
+	return ""
+}
+
+func (x *WorkspaceEmailSetting) GetFromName() string {
+	if x != nil {
+		return x.FromName
+	}
+	return ""
+}
+
+func (x *WorkspaceEmailSetting) GetRequireEmailVerification() bool {
+	if x != nil {
+		return x.RequireEmailVerification
+	}
+	return false
+}
+
+func (x *WorkspaceEmailSetting) GetAllowPasswordReset() bool {
+	if x != nil {
+		return x.AllowPasswordReset
+	}
+	return false
+}
+
+func (x *WorkspaceEmailSetting) GetImapHost() string {
+	if x != nil {
+		return x.ImapHost
+	}
+	return ""
+}
+
+func (x *WorkspaceEmailSetting) GetImapPort() int32 {
+	if x != nil {
+		return x.ImapPort
+	}
+	return 0
+}
+
+func (x *WorkspaceEmailSetting) GetImapUsername() string {
+	if x != nil {
+		return x.ImapUsername
+	}
+	return ""
+}
+
+func (x *WorkspaceEmailSetting) GetImapPassword() string {
+	if x != nil {
+		return x.ImapPassword
+	}
+	return ""
+}
+
+func (x *WorkspaceEmailSetting) GetImapUseTls() bool {
+	if x != nil {
+		return x.ImapUseTls
+	}
+	return false
+}
+
+func (x *WorkspaceEmailSetting) GetImapFolder() string {
+	if x != nil {
+		return x.ImapFolder
+	}
+	return ""
+}
+
+func (x *WorkspaceEmailSetting) GetImapArchiveFolder() string {
+	if x != nil {
+		return x.ImapArchiveFolder
+	}
+	return ""
+}
+
+type WorkspaceAccessTokenPolicySetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum lifetime of the access tokens in days, unlimited if zero.
+	// The tokens issued before the policy are rejected once older than it.
+	MaxLifetimeDays int32 `protobuf:"varint,1,opt,name=max_lifetime_days,json=maxLifetimeDays,proto3" json:"max_lifetime_days,omitempty"`
+	// The number of days after which the unused access tokens are revoked, never if zero.
+	IdleRevocationDays int32 `protobuf:"varint,2,opt,name=idle_revocation_days,json=idleRevocationDays,proto3" json:"idle_revocation_days,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *WorkspaceAccessTokenPolicySetting) Reset() {
+	*x = WorkspaceAccessTokenPolicySetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceAccessTokenPolicySetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceAccessTokenPolicySetting) ProtoMessage() {}
+
+func (x *WorkspaceAccessTokenPolicySetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceAccessTokenPolicySetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceAccessTokenPolicySetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WorkspaceAccessTokenPolicySetting) GetMaxLifetimeDays() int32 {
+	if x != nil {
+		return x.MaxLifetimeDays
+	}
+	return 0
+}
+
+func (x *WorkspaceAccessTokenPolicySetting) GetIdleRevocationDays() int32 {
+	if x != nil {
+		return x.IdleRevocationDays
+	}
+	return 0
+}
+
+type WorkspaceCaptchaSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The CAPTCHA provider, no challenge is required if unspecified.
+	Provider WorkspaceCaptchaSetting_Provider `protobuf:"varint,1,opt,name=provider,proto3,enum=memos.api.v1.WorkspaceCaptchaSetting_Provider" json:"provider,omitempty"`
+	// The public key of the site at the provider, rendering its widget.
+	SiteKey string `protobuf:"bytes,2,opt,name=site_key,json=siteKey,proto3" json:"site_key,omitempty"`
+	// The secret key of the site at the provider, verifying the tokens.
+	// Only returned to the host.
+	SecretKey string `protobuf:"bytes,3,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	// Whether a challenge is required to sign up.
+	RequireOnSignUp bool `protobuf:"varint,4,opt,name=require_on_sign_up,json=requireOnSignUp,proto3" json:"require_on_sign_up,omitempty"`
+	// The number of failed sign-ins of a username or a client after which a challenge is required to sign in, never if zero.
+	SignInFailureThreshold int32 `protobuf:"varint,5,opt,name=sign_in_failure_threshold,json=signInFailureThreshold,proto3" json:"sign_in_failure_threshold,omitempty"`
+	// The number of leading zero bits of the proof-of-work hashes, 20 if zero.
+	ProofOfWorkDifficulty int32 `protobuf:"varint,6,opt,name=proof_of_work_difficulty,json=proofOfWorkDifficulty,proto3" json:"proof_of_work_difficulty,omitempty"`
+	// The endpoint of the siteverify API, the one of the provider if empty.
+	VerifyEndpoint string `protobuf:"bytes,7,opt,name=verify_endpoint,json=verifyEndpoint,proto3" json:"verify_endpoint,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WorkspaceCaptchaSetting) Reset() {
+	*x = WorkspaceCaptchaSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceCaptchaSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceCaptchaSetting) ProtoMessage() {}
+
+func (x *WorkspaceCaptchaSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceCaptchaSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceCaptchaSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WorkspaceCaptchaSetting) GetProvider() WorkspaceCaptchaSetting_Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return WorkspaceCaptchaSetting_PROVIDER_UNSPECIFIED
+}
+
+func (x *WorkspaceCaptchaSetting) GetSiteKey() string {
+	if x != nil {
+		return x.SiteKey
+	}
+	return ""
+}
+
+func (x *WorkspaceCaptchaSetting) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+func (x *WorkspaceCaptchaSetting) GetRequireOnSignUp() bool {
+	if x != nil {
+		return x.RequireOnSignUp
+	}
+	return false
+}
+
+func (x *WorkspaceCaptchaSetting) GetSignInFailureThreshold() int32 {
+	if x != nil {
+		return x.SignInFailureThreshold
+	}
+	return 0
+}
+
+func (x *WorkspaceCaptchaSetting) GetProofOfWorkDifficulty() int32 {
+	if x != nil {
+		return x.ProofOfWorkDifficulty
+	}
+	return 0
+}
+
+func (x *WorkspaceCaptchaSetting) GetVerifyEndpoint() string {
+	if x != nil {
+		return x.VerifyEndpoint
+	}
+	return ""
+}
+
+type WorkspaceSlackSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The signing secret of the Slack app, verifying its requests. The app is disabled if empty.
+	SigningSecret string `protobuf:"bytes,1,opt,name=signing_secret,json=signingSecret,proto3" json:"signing_secret,omitempty"`
+	// The bot token of the Slack app, unfurling the links of the public memos if not empty.
+	BotToken string `protobuf:"bytes,2,opt,name=bot_token,json=botToken,proto3" json:"bot_token,omitempty"`
+	// The endpoint of the Slack Web API, https://slack.com/api if empty.
+	ApiEndpoint   string `protobuf:"bytes,3,opt,name=api_endpoint,json=apiEndpoint,proto3" json:"api_endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceSlackSetting) Reset() {
+	*x = WorkspaceSlackSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSlackSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSlackSetting) ProtoMessage() {}
+
+func (x *WorkspaceSlackSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSlackSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSlackSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WorkspaceSlackSetting) GetSigningSecret() string {
+	if x != nil {
+		return x.SigningSecret
+	}
+	return ""
+}
+
+func (x *WorkspaceSlackSetting) GetBotToken() string {
+	if x != nil {
+		return x.BotToken
+	}
+	return ""
+}
+
+func (x *WorkspaceSlackSetting) GetApiEndpoint() string {
+	if x != nil {
+		return x.ApiEndpoint
+	}
+	return ""
+}
+
+type WorkspaceDiscordSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the Discord application of the bot, registering its commands.
+	ApplicationId string `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	// The hex public key of the application, verifying its interactions. The commands are disabled if empty.
+	PublicKey string `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// The token of the bot, connecting to the gateway and posting the digests.
+	BotToken string `protobuf:"bytes,3,opt,name=bot_token,json=botToken,proto3" json:"bot_token,omitempty"`
+	// The emoji saving the messages it is added to, disabled if empty.
+	// The bot needs the message content intent to read the messages.
+	SaveReaction string `protobuf:"bytes,4,opt,name=save_reaction,json=saveReaction,proto3" json:"save_reaction,omitempty"`
+	// The channel the digest of the new memos is posted to, disabled if empty.
+	DigestChannelId string `protobuf:"bytes,5,opt,name=digest_channel_id,json=digestChannelId,proto3" json:"digest_channel_id,omitempty"`
+	// The cron schedule of the digest in UTC, every day at 9:00 if empty.
+	DigestSchedule string `protobuf:"bytes,6,opt,name=digest_schedule,json=digestSchedule,proto3" json:"digest_schedule,omitempty"`
+	// Whether the memos visible to the workspace members are included in the digest, besides the public ones.
+	DigestIncludeProtected bool `protobuf:"varint,7,opt,name=digest_include_protected,json=digestIncludeProtected,proto3" json:"digest_include_protected,omitempty"`
+	// The endpoint of the Discord API, https://discord.com/api/v10 if empty.
+	ApiEndpoint   string `protobuf:"bytes,8,opt,name=api_endpoint,json=apiEndpoint,proto3" json:"api_endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceDiscordSetting) Reset() {
+	*x = WorkspaceDiscordSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceDiscordSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceDiscordSetting) ProtoMessage() {}
+
+func (x *WorkspaceDiscordSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceDiscordSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceDiscordSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WorkspaceDiscordSetting) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *WorkspaceDiscordSetting) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *WorkspaceDiscordSetting) GetBotToken() string {
+	if x != nil {
+		return x.BotToken
+	}
+	return ""
+}
+
+func (x *WorkspaceDiscordSetting) GetSaveReaction() string {
+	if x != nil {
+		return x.SaveReaction
+	}
+	return ""
+}
+
+func (x *WorkspaceDiscordSetting) GetDigestChannelId() string {
+	if x != nil {
+		return x.DigestChannelId
+	}
+	return ""
+}
+
+func (x *WorkspaceDiscordSetting) GetDigestSchedule() string {
+	if x != nil {
+		return x.DigestSchedule
+	}
+	return ""
+}
+
+func (x *WorkspaceDiscordSetting) GetDigestIncludeProtected() bool {
+	if x != nil {
+		return x.DigestIncludeProtected
+	}
+	return false
+}
+
+func (x *WorkspaceDiscordSetting) GetApiEndpoint() string {
+	if x != nil {
+		return x.ApiEndpoint
+	}
+	return ""
+}
+
+type WorkspaceRolesSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The custom roles assignable to the users, on top of their built-in role.
+	// Only the host can update them.
+	Roles         []*WorkspaceRolesSetting_CustomRole `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceRolesSetting) Reset() {
+	*x = WorkspaceRolesSetting{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceRolesSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceRolesSetting) ProtoMessage() {}
+
+func (x *WorkspaceRolesSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceRolesSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceRolesSetting) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WorkspaceRolesSetting) GetRoles() []*WorkspaceRolesSetting_CustomRole {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// Request message for GetWorkspaceSetting method.
+type GetWorkspaceSettingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the workspace setting.
+	// Format: workspace/settings/{setting}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkspaceSettingRequest) Reset() {
+	*x = GetWorkspaceSettingRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkspaceSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkspaceSettingRequest) ProtoMessage() {}
+
+func (x *GetWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkspaceSettingRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkspaceSettingRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetWorkspaceSettingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Request message for UpdateWorkspaceSetting method.
+type UpdateWorkspaceSettingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The workspace setting resource which replaces the resource on the server.
+	Setting *WorkspaceSetting `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
+	// The list of fields to update.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWorkspaceSettingRequest) Reset() {
+	*x = UpdateWorkspaceSettingRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkspaceSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkspaceSettingRequest) ProtoMessage() {}
+
+func (x *UpdateWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkspaceSettingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWorkspaceSettingRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateWorkspaceSettingRequest) GetSetting() *WorkspaceSetting {
+	if x != nil {
+		return x.Setting
+	}
+	return nil
+}
+
+func (x *UpdateWorkspaceSettingRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// Request message for CheckWorkspaceIntegrity method.
+type CheckWorkspaceIntegrityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether to repair the issues that can be fixed safely.
+	Repair        bool `protobuf:"varint,1,opt,name=repair,proto3" json:"repair,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckWorkspaceIntegrityRequest) Reset() {
+	*x = CheckWorkspaceIntegrityRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckWorkspaceIntegrityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckWorkspaceIntegrityRequest) ProtoMessage() {}
+
+func (x *CheckWorkspaceIntegrityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckWorkspaceIntegrityRequest.ProtoReflect.Descriptor instead.
+func (*CheckWorkspaceIntegrityRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CheckWorkspaceIntegrityRequest) GetRepair() bool {
+	if x != nil {
+		return x.Repair
+	}
+	return false
+}
+
+// The result of a workspace data integrity check.
+type WorkspaceIntegrityReport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The issues found during the check.
+	Issues        []*WorkspaceIntegrityReport_Issue `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceIntegrityReport) Reset() {
+	*x = WorkspaceIntegrityReport{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceIntegrityReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceIntegrityReport) ProtoMessage() {}
+
+func (x *WorkspaceIntegrityReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceIntegrityReport.ProtoReflect.Descriptor instead.
+func (*WorkspaceIntegrityReport) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *WorkspaceIntegrityReport) GetIssues() []*WorkspaceIntegrityReport_Issue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type ReloadWorkspaceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The level of the server logs to set, one of "debug", "info", "warn" and "error".
+	// The level is unchanged if empty.
+	LogLevel      string `protobuf:"bytes,1,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadWorkspaceRequest) Reset() {
+	*x = ReloadWorkspaceRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadWorkspaceRequest) ProtoMessage() {}
+
+func (x *ReloadWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*ReloadWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReloadWorkspaceRequest) GetLogLevel() string {
+	if x != nil {
+		return x.LogLevel
+	}
+	return ""
+}
+
+type ReloadWorkspaceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The current level of the server logs.
+	LogLevel      string `protobuf:"bytes,1,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadWorkspaceResponse) Reset() {
+	*x = ReloadWorkspaceResponse{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadWorkspaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadWorkspaceResponse) ProtoMessage() {}
+
+func (x *ReloadWorkspaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadWorkspaceResponse.ProtoReflect.Descriptor instead.
+func (*ReloadWorkspaceResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReloadWorkspaceResponse) GetLogLevel() string {
+	if x != nil {
+		return x.LogLevel
+	}
+	return ""
+}
+
+// Job is a background job of the workspace, e.g. generating the thumbnails of an attachment, attempted again with
+// an exponential backoff until it succeeds, or fails after the maximum attempts.
+type Job struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the job.
+	// Format: workspace/jobs/{job}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The kind of the job, e.g. "attachment_thumbnails".
+	Kind  string    `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	State Job_State `protobuf:"varint,3,opt,name=state,proto3,enum=memos.api.v1.Job_State" json:"state,omitempty"`
+	// The number of the attempts of the job.
+	AttemptCount int32 `protobuf:"varint,4,opt,name=attempt_count,json=attemptCount,proto3" json:"attempt_count,omitempty"`
+	// The number of the attempts after which the job fails.
+	MaxAttempts int32 `protobuf:"varint,5,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// The error of the last failed attempt.
+	LastError  string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The time of the next attempt of the pending jobs.
+	NextAttemptTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=next_attempt_time,json=nextAttemptTime,proto3" json:"next_attempt_time,omitempty"`
+	// The time the job succeeded or failed.
+	FinishTime    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=finish_time,json=finishTime,proto3" json:"finish_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Job) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Job) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Job) GetState() Job_State {
+	if x != nil {
+		return x.State
+	}
+	return Job_STATE_UNSPECIFIED
+}
+
+func (x *Job) GetAttemptCount() int32 {
+	if x != nil {
+		return x.AttemptCount
+	}
+	return 0
+}
+
+func (x *Job) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *Job) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Job) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Job) GetNextAttemptTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptTime
+	}
+	return nil
+}
+
+func (x *Job) GetFinishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishTime
+	}
+	return nil
+}
+
+type ListJobsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional. The maximum number of jobs to return.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A page token from a previous call.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional. Only the jobs of the kind are listed, if set.
+	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Optional. Only the jobs in the state are listed, if set.
+	State         Job_State `protobuf:"varint,4,opt,name=state,proto3,enum=memos.api.v1.Job_State" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsRequest) Reset() {
+	*x = ListJobsRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsRequest) ProtoMessage() {}
+
+func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListJobsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListJobsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListJobsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ListJobsRequest) GetState() Job_State {
+	if x != nil {
+		return x.State
+	}
+	return Job_STATE_UNSPECIFIED
+}
+
+type ListJobsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The jobs of the workspace.
+	Jobs []*Job `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	// A token for the next page of results.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsResponse) Reset() {
+	*x = ListJobsResponse{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsResponse) ProtoMessage() {}
+
+func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListJobsResponse) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *ListJobsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the job.
+	// Format: workspace/jobs/{job}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobRequest) Reset() {
+	*x = GetJobRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobRequest) ProtoMessage() {}
+
+func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetJobRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RetryJobRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the job to retry.
+	// Format: workspace/jobs/{job}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryJobRequest) Reset() {
+	*x = RetryJobRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryJobRequest) ProtoMessage() {}
+
+func (x *RetryJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryJobRequest.ProtoReflect.Descriptor instead.
+func (*RetryJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RetryJobRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// ScheduledTask is a built-in maintenance task of the workspace run on a cron schedule, e.g. a cleanup or the
+// database backup.
+type ScheduledTask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resource name of the task.
+	// Format: workspace/scheduledTasks/{task}
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The description of what the task does.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// The standard cron expression of the task, e.g. "0 3 * * *" for every day at 3:00 UTC.
+	// The default schedule is restored if empty.
+	Schedule string `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// The schedule of the task until it is changed.
+	DefaultSchedule string `protobuf:"bytes,4,opt,name=default_schedule,json=defaultSchedule,proto3" json:"default_schedule,omitempty"`
+	// Whether the task is not run on its schedule. A disabled task can still be run by hand.
+	Disabled bool `protobuf:"varint,5,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// The time of the next scheduled run, unset if the task is disabled.
+	NextRunTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=next_run_time,json=nextRunTime,proto3" json:"next_run_time,omitempty"`
+	// The state of the last run, unspecified if the task never ran.
+	LastRunState ScheduledTask_State `protobuf:"varint,7,opt,name=last_run_state,json=lastRunState,proto3,enum=memos.api.v1.ScheduledTask_State" json:"last_run_state,omitempty"`
+	// The time the last run was started.
+	LastRunTime *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_run_time,json=lastRunTime,proto3" json:"last_run_time,omitempty"`
+	// The time the last run succeeded or failed.
+	LastFinishTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_finish_time,json=lastFinishTime,proto3" json:"last_finish_time,omitempty"`
+	// The error of the last run, if it failed.
+	LastError     string `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledTask) Reset() {
+	*x = ScheduledTask{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledTask) ProtoMessage() {}
+
+func (x *ScheduledTask) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledTask.ProtoReflect.Descriptor instead.
+func (*ScheduledTask) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ScheduledTask) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScheduledTask) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ScheduledTask) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *ScheduledTask) GetDefaultSchedule() string {
+	if x != nil {
+		return x.DefaultSchedule
+	}
+	return ""
+}
+
+func (x *ScheduledTask) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *ScheduledTask) GetNextRunTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunTime
+	}
+	return nil
+}
+
+func (x *ScheduledTask) GetLastRunState() ScheduledTask_State {
 	if x != nil {
-		return x.Owner
+		return x.LastRunState
 	}
-	return ""
+	return ScheduledTask_STATE_UNSPECIFIED
 }
 
-func (x *WorkspaceProfile) GetVersion() string {
+func (x *ScheduledTask) GetLastRunTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Version
+		return x.LastRunTime
 	}
-	return ""
+	return nil
 }
 
-func (x *WorkspaceProfile) GetMode() string {
+func (x *ScheduledTask) GetLastFinishTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Mode
+		return x.LastFinishTime
 	}
-	return ""
+	return nil
 }
 
-func (x *WorkspaceProfile) GetInstanceUrl() string {
+func (x *ScheduledTask) GetLastError() string {
 	if x != nil {
-		return x.InstanceUrl
+		return x.LastError
 	}
 	return ""
 }
 
-// Request for workspace profile.
-type GetWorkspaceProfileRequest struct {
+type ListScheduledTasksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWorkspaceProfileRequest) Reset() {
-	*x = GetWorkspaceProfileRequest{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[1]
+func (x *ListScheduledTasksRequest) Reset() {
+	*x = ListScheduledTasksRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkspaceProfileRequest) String() string {
+func (x *ListScheduledTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkspaceProfileRequest) ProtoMessage() {}
+func (*ListScheduledTasksRequest) ProtoMessage() {}
 
-func (x *GetWorkspaceProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[1]
+func (x *ListScheduledTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -184,42 +3371,34 @@ func (x *GetWorkspaceProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkspaceProfileRequest.ProtoReflect.Descriptor instead.
-func (*GetWorkspaceProfileRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ListScheduledTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledTasksRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{31}
 }
 
-// A workspace setting resource.
-type WorkspaceSetting struct {
+type ListScheduledTasksResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of the workspace setting.
-	// Format: workspace/settings/{setting}
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Types that are valid to be assigned to Value:
-	//
-	//	*WorkspaceSetting_GeneralSetting
-	//	*WorkspaceSetting_StorageSetting
-	//	*WorkspaceSetting_MemoRelatedSetting
-	Value         isWorkspaceSetting_Value `protobuf_oneof:"value"`
+	// The scheduled tasks of the workspace.
+	Tasks         []*ScheduledTask `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkspaceSetting) Reset() {
-	*x = WorkspaceSetting{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[2]
+func (x *ListScheduledTasksResponse) Reset() {
+	*x = ListScheduledTasksResponse{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkspaceSetting) String() string {
+func (x *ListScheduledTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceSetting) ProtoMessage() {}
+func (*ListScheduledTasksResponse) ProtoMessage() {}
 
-func (x *WorkspaceSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[2]
+func (x *ListScheduledTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -230,116 +3409,43 @@ func (x *WorkspaceSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceSetting.ProtoReflect.Descriptor instead.
-func (*WorkspaceSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *WorkspaceSetting) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *WorkspaceSetting) GetValue() isWorkspaceSetting_Value {
-	if x != nil {
-		return x.Value
-	}
-	return nil
-}
-
-func (x *WorkspaceSetting) GetGeneralSetting() *WorkspaceGeneralSetting {
-	if x != nil {
-		if x, ok := x.Value.(*WorkspaceSetting_GeneralSetting); ok {
-			return x.GeneralSetting
-		}
-	}
-	return nil
-}
-
-func (x *WorkspaceSetting) GetStorageSetting() *WorkspaceStorageSetting {
-	if x != nil {
-		if x, ok := x.Value.(*WorkspaceSetting_StorageSetting); ok {
-			return x.StorageSetting
-		}
-	}
-	return nil
+// Deprecated: Use ListScheduledTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledTasksResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *WorkspaceSetting) GetMemoRelatedSetting() *WorkspaceMemoRelatedSetting {
+func (x *ListScheduledTasksResponse) GetTasks() []*ScheduledTask {
 	if x != nil {
-		if x, ok := x.Value.(*WorkspaceSetting_MemoRelatedSetting); ok {
-			return x.MemoRelatedSetting
-		}
+		return x.Tasks
 	}
 	return nil
 }
 
-type isWorkspaceSetting_Value interface {
-	isWorkspaceSetting_Value()
-}
-
-type WorkspaceSetting_GeneralSetting struct {
-	GeneralSetting *WorkspaceGeneralSetting `protobuf:"bytes,2,opt,name=general_setting,json=generalSetting,proto3,oneof"`
-}
-
-type WorkspaceSetting_StorageSetting struct {
-	StorageSetting *WorkspaceStorageSetting `protobuf:"bytes,3,opt,name=storage_setting,json=storageSetting,proto3,oneof"`
-}
-
-type WorkspaceSetting_MemoRelatedSetting struct {
-	MemoRelatedSetting *WorkspaceMemoRelatedSetting `protobuf:"bytes,4,opt,name=memo_related_setting,json=memoRelatedSetting,proto3,oneof"`
-}
-
-func (*WorkspaceSetting_GeneralSetting) isWorkspaceSetting_Value() {}
-
-func (*WorkspaceSetting_StorageSetting) isWorkspaceSetting_Value() {}
-
-func (*WorkspaceSetting_MemoRelatedSetting) isWorkspaceSetting_Value() {}
-
-type WorkspaceGeneralSetting struct {
+type UpdateScheduledTaskRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// theme is the name of the selected theme.
-	// This references a CSS file in the web/public/themes/ directory.
-	Theme string `protobuf:"bytes,1,opt,name=theme,proto3" json:"theme,omitempty"`
-	// disallow_user_registration disallows user registration.
-	DisallowUserRegistration bool `protobuf:"varint,2,opt,name=disallow_user_registration,json=disallowUserRegistration,proto3" json:"disallow_user_registration,omitempty"`
-	// disallow_password_auth disallows password authentication.
-	DisallowPasswordAuth bool `protobuf:"varint,3,opt,name=disallow_password_auth,json=disallowPasswordAuth,proto3" json:"disallow_password_auth,omitempty"`
-	// additional_script is the additional script.
-	AdditionalScript string `protobuf:"bytes,4,opt,name=additional_script,json=additionalScript,proto3" json:"additional_script,omitempty"`
-	// additional_style is the additional style.
-	AdditionalStyle string `protobuf:"bytes,5,opt,name=additional_style,json=additionalStyle,proto3" json:"additional_style,omitempty"`
-	// custom_profile is the custom profile.
-	CustomProfile *WorkspaceCustomProfile `protobuf:"bytes,6,opt,name=custom_profile,json=customProfile,proto3" json:"custom_profile,omitempty"`
-	// week_start_day_offset is the week start day offset from Sunday.
-	// 0: Sunday, 1: Monday, 2: Tuesday, 3: Wednesday, 4: Thursday, 5: Friday, 6: Saturday
-	// Default is Sunday.
-	WeekStartDayOffset int32 `protobuf:"varint,7,opt,name=week_start_day_offset,json=weekStartDayOffset,proto3" json:"week_start_day_offset,omitempty"`
-	// disallow_change_username disallows changing username.
-	DisallowChangeUsername bool `protobuf:"varint,8,opt,name=disallow_change_username,json=disallowChangeUsername,proto3" json:"disallow_change_username,omitempty"`
-	// disallow_change_nickname disallows changing nickname.
-	DisallowChangeNickname bool `protobuf:"varint,9,opt,name=disallow_change_nickname,json=disallowChangeNickname,proto3" json:"disallow_change_nickname,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	// The task whose fields of the update mask are updated.
+	Task *ScheduledTask `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	// The list of fields to update, among "schedule" and "disabled".
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkspaceGeneralSetting) Reset() {
-	*x = WorkspaceGeneralSetting{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[3]
+func (x *UpdateScheduledTaskRequest) Reset() {
+	*x = UpdateScheduledTaskRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkspaceGeneralSetting) String() string {
+func (x *UpdateScheduledTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceGeneralSetting) ProtoMessage() {}
+func (*UpdateScheduledTaskRequest) ProtoMessage() {}
 
-func (x *WorkspaceGeneralSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[3]
+func (x *UpdateScheduledTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -350,100 +3456,99 @@ func (x *WorkspaceGeneralSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceGeneralSetting.ProtoReflect.Descriptor instead.
-func (*WorkspaceGeneralSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use UpdateScheduledTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScheduledTaskRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *WorkspaceGeneralSetting) GetTheme() string {
+func (x *UpdateScheduledTaskRequest) GetTask() *ScheduledTask {
 	if x != nil {
-		return x.Theme
+		return x.Task
 	}
-	return ""
+	return nil
 }
 
-func (x *WorkspaceGeneralSetting) GetDisallowUserRegistration() bool {
+func (x *UpdateScheduledTaskRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.DisallowUserRegistration
+		return x.UpdateMask
 	}
-	return false
+	return nil
 }
 
-func (x *WorkspaceGeneralSetting) GetDisallowPasswordAuth() bool {
-	if x != nil {
-		return x.DisallowPasswordAuth
-	}
-	return false
+type RunScheduledTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. The resource name of the task to run.
+	// Format: workspace/scheduledTasks/{task}
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkspaceGeneralSetting) GetAdditionalScript() string {
-	if x != nil {
-		return x.AdditionalScript
-	}
-	return ""
+func (x *RunScheduledTaskRequest) Reset() {
+	*x = RunScheduledTaskRequest{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkspaceGeneralSetting) GetAdditionalStyle() string {
-	if x != nil {
-		return x.AdditionalStyle
-	}
-	return ""
+func (x *RunScheduledTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *WorkspaceGeneralSetting) GetCustomProfile() *WorkspaceCustomProfile {
-	if x != nil {
-		return x.CustomProfile
-	}
-	return nil
-}
+func (*RunScheduledTaskRequest) ProtoMessage() {}
 
-func (x *WorkspaceGeneralSetting) GetWeekStartDayOffset() int32 {
+func (x *RunScheduledTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[34]
 	if x != nil {
-		return x.WeekStartDayOffset
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *WorkspaceGeneralSetting) GetDisallowChangeUsername() bool {
-	if x != nil {
-		return x.DisallowChangeUsername
-	}
-	return false
+// Deprecated: Use RunScheduledTaskRequest.ProtoReflect.Descriptor instead.
+func (*RunScheduledTaskRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *WorkspaceGeneralSetting) GetDisallowChangeNickname() bool {
+func (x *RunScheduledTaskRequest) GetName() string {
 	if x != nil {
-		return x.DisallowChangeNickname
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-type WorkspaceCustomProfile struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	LogoUrl       string                 `protobuf:"bytes,3,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
-	Locale        string                 `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
-	Appearance    string                 `protobuf:"bytes,5,opt,name=appearance,proto3" json:"appearance,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Reference: https://developers.cloudflare.com/r2/examples/aws/aws-sdk-go/
+type WorkspaceStorageSetting_S3Config struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccessKeyId     string                 `protobuf:"bytes,1,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
+	AccessKeySecret string                 `protobuf:"bytes,2,opt,name=access_key_secret,json=accessKeySecret,proto3" json:"access_key_secret,omitempty"`
+	Endpoint        string                 `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Region          string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	Bucket          string                 `protobuf:"bytes,5,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	UsePathStyle    bool                   `protobuf:"varint,6,opt,name=use_path_style,json=usePathStyle,proto3" json:"use_path_style,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *WorkspaceCustomProfile) Reset() {
-	*x = WorkspaceCustomProfile{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[4]
+func (x *WorkspaceStorageSetting_S3Config) Reset() {
+	*x = WorkspaceStorageSetting_S3Config{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkspaceCustomProfile) String() string {
+func (x *WorkspaceStorageSetting_S3Config) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceCustomProfile) ProtoMessage() {}
+func (*WorkspaceStorageSetting_S3Config) ProtoMessage() {}
 
-func (x *WorkspaceCustomProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[4]
+func (x *WorkspaceStorageSetting_S3Config) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -454,76 +3559,80 @@ func (x *WorkspaceCustomProfile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceCustomProfile.ProtoReflect.Descriptor instead.
-func (*WorkspaceCustomProfile) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use WorkspaceStorageSetting_S3Config.ProtoReflect.Descriptor instead.
+func (*WorkspaceStorageSetting_S3Config) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{5, 0}
 }
 
-func (x *WorkspaceCustomProfile) GetTitle() string {
+func (x *WorkspaceStorageSetting_S3Config) GetAccessKeyId() string {
 	if x != nil {
-		return x.Title
+		return x.AccessKeyId
 	}
 	return ""
 }
 
-func (x *WorkspaceCustomProfile) GetDescription() string {
+func (x *WorkspaceStorageSetting_S3Config) GetAccessKeySecret() string {
 	if x != nil {
-		return x.Description
+		return x.AccessKeySecret
 	}
 	return ""
 }
 
-func (x *WorkspaceCustomProfile) GetLogoUrl() string {
+func (x *WorkspaceStorageSetting_S3Config) GetEndpoint() string {
 	if x != nil {
-		return x.LogoUrl
+		return x.Endpoint
 	}
 	return ""
 }
 
-func (x *WorkspaceCustomProfile) GetLocale() string {
+func (x *WorkspaceStorageSetting_S3Config) GetRegion() string {
 	if x != nil {
-		return x.Locale
+		return x.Region
+	}
+	return ""
+}
+
+func (x *WorkspaceStorageSetting_S3Config) GetBucket() string {
+	if x != nil {
+		return x.Bucket
 	}
 	return ""
 }
 
-func (x *WorkspaceCustomProfile) GetAppearance() string {
+func (x *WorkspaceStorageSetting_S3Config) GetUsePathStyle() bool {
 	if x != nil {
-		return x.Appearance
+		return x.UsePathStyle
 	}
-	return ""
+	return false
 }
 
-type WorkspaceStorageSetting struct {
+// Reference: https://cloud.google.com/storage/docs/authentication#service_accounts
+type WorkspaceStorageSetting_GCSConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// storage_type is the storage type.
-	StorageType WorkspaceStorageSetting_StorageType `protobuf:"varint,1,opt,name=storage_type,json=storageType,proto3,enum=memos.api.v1.WorkspaceStorageSetting_StorageType" json:"storage_type,omitempty"`
-	// The template of file path.
-	// e.g. assets/{timestamp}_{filename}
-	FilepathTemplate string `protobuf:"bytes,2,opt,name=filepath_template,json=filepathTemplate,proto3" json:"filepath_template,omitempty"`
-	// The max upload size in megabytes.
-	UploadSizeLimitMb int64 `protobuf:"varint,3,opt,name=upload_size_limit_mb,json=uploadSizeLimitMb,proto3" json:"upload_size_limit_mb,omitempty"`
-	// The S3 config.
-	S3Config      *WorkspaceStorageSetting_S3Config `protobuf:"bytes,4,opt,name=s3_config,json=s3Config,proto3" json:"s3_config,omitempty"`
+	// The JSON key of the service account.
+	Credentials string `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	Bucket      string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// Overrides https://storage.googleapis.com, e.g. for an emulator.
+	Endpoint      string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkspaceStorageSetting) Reset() {
-	*x = WorkspaceStorageSetting{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[5]
+func (x *WorkspaceStorageSetting_GCSConfig) Reset() {
+	*x = WorkspaceStorageSetting_GCSConfig{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkspaceStorageSetting) String() string {
+func (x *WorkspaceStorageSetting_GCSConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceStorageSetting) ProtoMessage() {}
+func (*WorkspaceStorageSetting_GCSConfig) ProtoMessage() {}
 
-func (x *WorkspaceStorageSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[5]
+func (x *WorkspaceStorageSetting_GCSConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -534,80 +3643,62 @@ func (x *WorkspaceStorageSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceStorageSetting.ProtoReflect.Descriptor instead.
-func (*WorkspaceStorageSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *WorkspaceStorageSetting) GetStorageType() WorkspaceStorageSetting_StorageType {
-	if x != nil {
-		return x.StorageType
-	}
-	return WorkspaceStorageSetting_STORAGE_TYPE_UNSPECIFIED
+// Deprecated: Use WorkspaceStorageSetting_GCSConfig.ProtoReflect.Descriptor instead.
+func (*WorkspaceStorageSetting_GCSConfig) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{5, 1}
 }
 
-func (x *WorkspaceStorageSetting) GetFilepathTemplate() string {
+func (x *WorkspaceStorageSetting_GCSConfig) GetCredentials() string {
 	if x != nil {
-		return x.FilepathTemplate
+		return x.Credentials
 	}
 	return ""
 }
 
-func (x *WorkspaceStorageSetting) GetUploadSizeLimitMb() int64 {
+func (x *WorkspaceStorageSetting_GCSConfig) GetBucket() string {
 	if x != nil {
-		return x.UploadSizeLimitMb
+		return x.Bucket
 	}
-	return 0
+	return ""
 }
 
-func (x *WorkspaceStorageSetting) GetS3Config() *WorkspaceStorageSetting_S3Config {
+func (x *WorkspaceStorageSetting_GCSConfig) GetEndpoint() string {
 	if x != nil {
-		return x.S3Config
+		return x.Endpoint
 	}
-	return nil
+	return ""
 }
 
-type WorkspaceMemoRelatedSetting struct {
+type WorkspaceStorageSetting_SFTPConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// disallow_public_visibility disallows set memo as public visibility.
-	DisallowPublicVisibility bool `protobuf:"varint,1,opt,name=disallow_public_visibility,json=disallowPublicVisibility,proto3" json:"disallow_public_visibility,omitempty"`
-	// display_with_update_time orders and displays memo with update time.
-	DisplayWithUpdateTime bool `protobuf:"varint,2,opt,name=display_with_update_time,json=displayWithUpdateTime,proto3" json:"display_with_update_time,omitempty"`
-	// content_length_limit is the limit of content length. Unit is byte.
-	ContentLengthLimit int32 `protobuf:"varint,3,opt,name=content_length_limit,json=contentLengthLimit,proto3" json:"content_length_limit,omitempty"`
-	// enable_double_click_edit enables editing on double click.
-	EnableDoubleClickEdit bool `protobuf:"varint,4,opt,name=enable_double_click_edit,json=enableDoubleClickEdit,proto3" json:"enable_double_click_edit,omitempty"`
-	// enable_link_preview enables links preview.
-	EnableLinkPreview bool `protobuf:"varint,5,opt,name=enable_link_preview,json=enableLinkPreview,proto3" json:"enable_link_preview,omitempty"`
-	// enable_comment enables comment.
-	EnableComment bool `protobuf:"varint,6,opt,name=enable_comment,json=enableComment,proto3" json:"enable_comment,omitempty"`
-	// reactions is the list of reactions.
-	Reactions []string `protobuf:"bytes,7,rep,name=reactions,proto3" json:"reactions,omitempty"`
-	// disable_markdown_shortcuts disallow the registration of markdown shortcuts.
-	DisableMarkdownShortcuts bool `protobuf:"varint,8,opt,name=disable_markdown_shortcuts,json=disableMarkdownShortcuts,proto3" json:"disable_markdown_shortcuts,omitempty"`
-	// enable_blur_nsfw_content enables blurring of content marked as not safe for work (NSFW).
-	EnableBlurNsfwContent bool `protobuf:"varint,9,opt,name=enable_blur_nsfw_content,json=enableBlurNsfwContent,proto3" json:"enable_blur_nsfw_content,omitempty"`
-	// nsfw_tags is the list of tags that mark content as NSFW for blurring.
-	NsfwTags      []string `protobuf:"bytes,10,rep,name=nsfw_tags,json=nsfwTags,proto3" json:"nsfw_tags,omitempty"`
+	// The address of the server, with an optional port which defaults to 22.
+	Host     string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// The password of the user, unless the private key authenticates it.
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// The PEM encoded private key of the user.
+	PrivateKey string `protobuf:"bytes,4,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	// The public key of the server, in the authorized_keys format of ssh-keyscan.
+	HostKey       string `protobuf:"bytes,5,opt,name=host_key,json=hostKey,proto3" json:"host_key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkspaceMemoRelatedSetting) Reset() {
-	*x = WorkspaceMemoRelatedSetting{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[6]
+func (x *WorkspaceStorageSetting_SFTPConfig) Reset() {
+	*x = WorkspaceStorageSetting_SFTPConfig{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkspaceMemoRelatedSetting) String() string {
+func (x *WorkspaceStorageSetting_SFTPConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceMemoRelatedSetting) ProtoMessage() {}
+func (*WorkspaceStorageSetting_SFTPConfig) ProtoMessage() {}
 
-func (x *WorkspaceMemoRelatedSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[6]
+func (x *WorkspaceStorageSetting_SFTPConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -618,106 +3709,75 @@ func (x *WorkspaceMemoRelatedSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceMemoRelatedSetting.ProtoReflect.Descriptor instead.
-func (*WorkspaceMemoRelatedSetting) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *WorkspaceMemoRelatedSetting) GetDisallowPublicVisibility() bool {
-	if x != nil {
-		return x.DisallowPublicVisibility
-	}
-	return false
-}
-
-func (x *WorkspaceMemoRelatedSetting) GetDisplayWithUpdateTime() bool {
-	if x != nil {
-		return x.DisplayWithUpdateTime
-	}
-	return false
-}
-
-func (x *WorkspaceMemoRelatedSetting) GetContentLengthLimit() int32 {
-	if x != nil {
-		return x.ContentLengthLimit
-	}
-	return 0
-}
-
-func (x *WorkspaceMemoRelatedSetting) GetEnableDoubleClickEdit() bool {
-	if x != nil {
-		return x.EnableDoubleClickEdit
-	}
-	return false
-}
-
-func (x *WorkspaceMemoRelatedSetting) GetEnableLinkPreview() bool {
-	if x != nil {
-		return x.EnableLinkPreview
-	}
-	return false
+// Deprecated: Use WorkspaceStorageSetting_SFTPConfig.ProtoReflect.Descriptor instead.
+func (*WorkspaceStorageSetting_SFTPConfig) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{5, 2}
 }
 
-func (x *WorkspaceMemoRelatedSetting) GetEnableComment() bool {
+func (x *WorkspaceStorageSetting_SFTPConfig) GetHost() string {
 	if x != nil {
-		return x.EnableComment
+		return x.Host
 	}
-	return false
+	return ""
 }
 
-func (x *WorkspaceMemoRelatedSetting) GetReactions() []string {
+func (x *WorkspaceStorageSetting_SFTPConfig) GetUsername() string {
 	if x != nil {
-		return x.Reactions
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-func (x *WorkspaceMemoRelatedSetting) GetDisableMarkdownShortcuts() bool {
+func (x *WorkspaceStorageSetting_SFTPConfig) GetPassword() string {
 	if x != nil {
-		return x.DisableMarkdownShortcuts
+		return x.Password
 	}
-	return false
+	return ""
 }
 
-func (x *WorkspaceMemoRelatedSetting) GetEnableBlurNsfwContent() bool {
+func (x *WorkspaceStorageSetting_SFTPConfig) GetPrivateKey() string {
 	if x != nil {
-		return x.EnableBlurNsfwContent
+		return x.PrivateKey
 	}
-	return false
+	return ""
 }
 
-func (x *WorkspaceMemoRelatedSetting) GetNsfwTags() []string {
+func (x *WorkspaceStorageSetting_SFTPConfig) GetHostKey() string {
 	if x != nil {
-		return x.NsfwTags
+		return x.HostKey
 	}
-	return nil
+	return ""
 }
 
-// Request message for GetWorkspaceSetting method.
-type GetWorkspaceSettingRequest struct {
+type WorkspaceStorageSetting_ImageCompression struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The resource name of the workspace setting.
-	// Format: workspace/settings/{setting}
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether to recompress the uploaded JPEG and PNG images. The images are kept as they are if the result is not smaller.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The max width and height in pixels of the images, which are scaled down to fit. 0 keeps their size.
+	MaxDimension int32 `protobuf:"varint,2,opt,name=max_dimension,json=maxDimension,proto3" json:"max_dimension,omitempty"`
+	// The quality from 1 to 100 of the lossy formats. 0 uses 80.
+	Quality int32 `protobuf:"varint,3,opt,name=quality,proto3" json:"quality,omitempty"`
+	// The format to convert the images to.
+	Format        WorkspaceStorageSetting_ImageCompression_Format `protobuf:"varint,4,opt,name=format,proto3,enum=memos.api.v1.WorkspaceStorageSetting_ImageCompression_Format" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWorkspaceSettingRequest) Reset() {
-	*x = GetWorkspaceSettingRequest{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[7]
+func (x *WorkspaceStorageSetting_ImageCompression) Reset() {
+	*x = WorkspaceStorageSetting_ImageCompression{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkspaceSettingRequest) String() string {
+func (x *WorkspaceStorageSetting_ImageCompression) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkspaceSettingRequest) ProtoMessage() {}
+func (*WorkspaceStorageSetting_ImageCompression) ProtoMessage() {}
 
-func (x *GetWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[7]
+func (x *WorkspaceStorageSetting_ImageCompression) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -728,44 +3788,66 @@ func (x *GetWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkspaceSettingRequest.ProtoReflect.Descriptor instead.
-func (*GetWorkspaceSettingRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use WorkspaceStorageSetting_ImageCompression.ProtoReflect.Descriptor instead.
+func (*WorkspaceStorageSetting_ImageCompression) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{5, 3}
 }
 
-func (x *GetWorkspaceSettingRequest) GetName() string {
+func (x *WorkspaceStorageSetting_ImageCompression) GetEnabled() bool {
 	if x != nil {
-		return x.Name
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-// Request message for UpdateWorkspaceSetting method.
-type UpdateWorkspaceSettingRequest struct {
+func (x *WorkspaceStorageSetting_ImageCompression) GetMaxDimension() int32 {
+	if x != nil {
+		return x.MaxDimension
+	}
+	return 0
+}
+
+func (x *WorkspaceStorageSetting_ImageCompression) GetQuality() int32 {
+	if x != nil {
+		return x.Quality
+	}
+	return 0
+}
+
+func (x *WorkspaceStorageSetting_ImageCompression) GetFormat() WorkspaceStorageSetting_ImageCompression_Format {
+	if x != nil {
+		return x.Format
+	}
+	return WorkspaceStorageSetting_ImageCompression_FORMAT_UNSPECIFIED
+}
+
+type WorkspaceRolesSetting_CustomRole struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The workspace setting resource which replaces the resource on the server.
-	Setting *WorkspaceSetting `protobuf:"bytes,1,opt,name=setting,proto3" json:"setting,omitempty"`
-	// The list of fields to update.
-	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// The unique identifier of the role, assigned to the users.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The display name of the role.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The permissions granted to the users with the role.
+	Permissions   []Permission `protobuf:"varint,3,rep,packed,name=permissions,proto3,enum=memos.api.v1.Permission" json:"permissions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateWorkspaceSettingRequest) Reset() {
-	*x = UpdateWorkspaceSettingRequest{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[8]
+func (x *WorkspaceRolesSetting_CustomRole) Reset() {
+	*x = WorkspaceRolesSetting_CustomRole{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateWorkspaceSettingRequest) String() string {
+func (x *WorkspaceRolesSetting_CustomRole) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateWorkspaceSettingRequest) ProtoMessage() {}
+func (*WorkspaceRolesSetting_CustomRole) ProtoMessage() {}
 
-func (x *UpdateWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[8]
+func (x *WorkspaceRolesSetting_CustomRole) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -776,53 +3858,62 @@ func (x *UpdateWorkspaceSettingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateWorkspaceSettingRequest.ProtoReflect.Descriptor instead.
-func (*UpdateWorkspaceSettingRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use WorkspaceRolesSetting_CustomRole.ProtoReflect.Descriptor instead.
+func (*WorkspaceRolesSetting_CustomRole) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{18, 0}
 }
 
-func (x *UpdateWorkspaceSettingRequest) GetSetting() *WorkspaceSetting {
+func (x *WorkspaceRolesSetting_CustomRole) GetId() string {
 	if x != nil {
-		return x.Setting
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateWorkspaceSettingRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *WorkspaceRolesSetting_CustomRole) GetTitle() string {
 	if x != nil {
-		return x.UpdateMask
+		return x.Title
+	}
+	return ""
+}
+
+func (x *WorkspaceRolesSetting_CustomRole) GetPermissions() []Permission {
+	if x != nil {
+		return x.Permissions
 	}
 	return nil
 }
 
-// Reference: https://developers.cloudflare.com/r2/examples/aws/aws-sdk-go/
-type WorkspaceStorageSetting_S3Config struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	AccessKeyId     string                 `protobuf:"bytes,1,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
-	AccessKeySecret string                 `protobuf:"bytes,2,opt,name=access_key_secret,json=accessKeySecret,proto3" json:"access_key_secret,omitempty"`
-	Endpoint        string                 `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	Region          string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
-	Bucket          string                 `protobuf:"bytes,5,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	UsePathStyle    bool                   `protobuf:"varint,6,opt,name=use_path_style,json=usePathStyle,proto3" json:"use_path_style,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// A single data integrity issue.
+type WorkspaceIntegrityReport_Issue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The type of the issue.
+	Type WorkspaceIntegrityReport_Issue_Type `protobuf:"varint,1,opt,name=type,proto3,enum=memos.api.v1.WorkspaceIntegrityReport_Issue_Type" json:"type,omitempty"`
+	// The name of the affected resource.
+	Resource string `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	// The human readable description of the issue.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Whether the issue has been repaired.
+	Repaired      bool `protobuf:"varint,4,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkspaceStorageSetting_S3Config) Reset() {
-	*x = WorkspaceStorageSetting_S3Config{}
-	mi := &file_api_v1_workspace_service_proto_msgTypes[9]
+func (x *WorkspaceIntegrityReport_Issue) Reset() {
+	*x = WorkspaceIntegrityReport_Issue{}
+	mi := &file_api_v1_workspace_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkspaceStorageSetting_S3Config) String() string {
+func (x *WorkspaceIntegrityReport_Issue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceStorageSetting_S3Config) ProtoMessage() {}
+func (*WorkspaceIntegrityReport_Issue) ProtoMessage() {}
 
-func (x *WorkspaceStorageSetting_S3Config) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_workspace_service_proto_msgTypes[9]
+func (x *WorkspaceIntegrityReport_Issue) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_workspace_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -833,49 +3924,35 @@ func (x *WorkspaceStorageSetting_S3Config) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceStorageSetting_S3Config.ProtoReflect.Descriptor instead.
-func (*WorkspaceStorageSetting_S3Config) Descriptor() ([]byte, []int) {
-	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{5, 0}
-}
-
-func (x *WorkspaceStorageSetting_S3Config) GetAccessKeyId() string {
-	if x != nil {
-		return x.AccessKeyId
-	}
-	return ""
-}
-
-func (x *WorkspaceStorageSetting_S3Config) GetAccessKeySecret() string {
-	if x != nil {
-		return x.AccessKeySecret
-	}
-	return ""
+// Deprecated: Use WorkspaceIntegrityReport_Issue.ProtoReflect.Descriptor instead.
+func (*WorkspaceIntegrityReport_Issue) Descriptor() ([]byte, []int) {
+	return file_api_v1_workspace_service_proto_rawDescGZIP(), []int{22, 0}
 }
 
-func (x *WorkspaceStorageSetting_S3Config) GetEndpoint() string {
+func (x *WorkspaceIntegrityReport_Issue) GetType() WorkspaceIntegrityReport_Issue_Type {
 	if x != nil {
-		return x.Endpoint
+		return x.Type
 	}
-	return ""
+	return WorkspaceIntegrityReport_Issue_TYPE_UNSPECIFIED
 }
 
-func (x *WorkspaceStorageSetting_S3Config) GetRegion() string {
+func (x *WorkspaceIntegrityReport_Issue) GetResource() string {
 	if x != nil {
-		return x.Region
+		return x.Resource
 	}
 	return ""
 }
 
-func (x *WorkspaceStorageSetting_S3Config) GetBucket() string {
+func (x *WorkspaceIntegrityReport_Issue) GetDescription() string {
 	if x != nil {
-		return x.Bucket
+		return x.Description
 	}
 	return ""
 }
 
-func (x *WorkspaceStorageSetting_S3Config) GetUsePathStyle() bool {
+func (x *WorkspaceIntegrityReport_Issue) GetRepaired() bool {
 	if x != nil {
-		return x.UsePathStyle
+		return x.Repaired
 	}
 	return false
 }
@@ -884,20 +3961,35 @@ var File_api_v1_workspace_service_proto protoreflect.FileDescriptor
 
 const file_api_v1_workspace_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1eapi/v1/workspace_service.proto\x12\fmemos.api.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\"y\n" +
+	"\x1eapi/v1/workspace_service.proto\x12\fmemos.api.v1\x1a\x13api/v1/common.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/resource.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa8\x01\n" +
 	"\x10WorkspaceProfile\x12\x14\n" +
 	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12\x12\n" +
 	"\x04mode\x18\x03 \x01(\tR\x04mode\x12!\n" +
-	"\finstance_url\x18\x06 \x01(\tR\vinstanceUrl\"\x1c\n" +
-	"\x1aGetWorkspaceProfileRequest\"\x9f\x03\n" +
+	"\finstance_url\x18\x06 \x01(\tR\vinstanceUrl\x12-\n" +
+	"\x13web_push_public_key\x18\a \x01(\tR\x10webPushPublicKey\"\x1c\n" +
+	"\x1aGetWorkspaceProfileRequest\"\xab\v\n" +
 	"\x10WorkspaceSetting\x12\x17\n" +
 	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12P\n" +
 	"\x0fgeneral_setting\x18\x02 \x01(\v2%.memos.api.v1.WorkspaceGeneralSettingH\x00R\x0egeneralSetting\x12P\n" +
 	"\x0fstorage_setting\x18\x03 \x01(\v2%.memos.api.v1.WorkspaceStorageSettingH\x00R\x0estorageSetting\x12]\n" +
-	"\x14memo_related_setting\x18\x04 \x01(\v2).memos.api.v1.WorkspaceMemoRelatedSettingH\x00R\x12memoRelatedSetting:f\xeaAc\n" +
+	"\x14memo_related_setting\x18\x04 \x01(\v2).memos.api.v1.WorkspaceMemoRelatedSettingH\x00R\x12memoRelatedSetting\x12V\n" +
+	"\x11embedding_setting\x18\x05 \x01(\v2'.memos.api.v1.WorkspaceEmbeddingSettingH\x00R\x10embeddingSetting\x12D\n" +
+	"\vocr_setting\x18\x06 \x01(\v2!.memos.api.v1.WorkspaceOCRSettingH\x00R\n" +
+	"ocrSetting\x12]\n" +
+	"\x14malware_scan_setting\x18\a \x01(\v2).memos.api.v1.WorkspaceMalwareScanSettingH\x00R\x12malwareScanSetting\x12b\n" +
+	"\x15transcription_setting\x18\b \x01(\v2+.memos.api.v1.WorkspaceTranscriptionSettingH\x00R\x14transcriptionSetting\x12G\n" +
+	"\fscim_setting\x18\t \x01(\v2\".memos.api.v1.WorkspaceSCIMSettingH\x00R\vscimSetting\x12f\n" +
+	"\x17password_policy_setting\x18\n" +
+	" \x01(\v2,.memos.api.v1.WorkspacePasswordPolicySettingH\x00R\x15passwordPolicySetting\x12J\n" +
+	"\remail_setting\x18\v \x01(\v2#.memos.api.v1.WorkspaceEmailSettingH\x00R\femailSetting\x12J\n" +
+	"\rroles_setting\x18\f \x01(\v2#.memos.api.v1.WorkspaceRolesSettingH\x00R\frolesSetting\x12p\n" +
+	"\x1baccess_token_policy_setting\x18\r \x01(\v2/.memos.api.v1.WorkspaceAccessTokenPolicySettingH\x00R\x18accessTokenPolicySetting\x12P\n" +
+	"\x0fcaptcha_setting\x18\x0e \x01(\v2%.memos.api.v1.WorkspaceCaptchaSettingH\x00R\x0ecaptchaSetting\x12J\n" +
+	"\rslack_setting\x18\x0f \x01(\v2#.memos.api.v1.WorkspaceSlackSettingH\x00R\fslackSetting\x12P\n" +
+	"\x0fdiscord_setting\x18\x10 \x01(\v2%.memos.api.v1.WorkspaceDiscordSettingH\x00R\x0ediscordSetting:f\xeaAc\n" +
 	"\x1eapi.memos.dev/WorkspaceSetting\x12\x1cworkspace/settings/{setting}*\x11workspaceSettings2\x10workspaceSettingB\a\n" +
-	"\x05value\"\xef\x03\n" +
+	"\x05value\"\xf5\x04\n" +
 	"\x17WorkspaceGeneralSetting\x12\x14\n" +
 	"\x05theme\x18\x01 \x01(\tR\x05theme\x12<\n" +
 	"\x1adisallow_user_registration\x18\x02 \x01(\bR\x18disallowUserRegistration\x124\n" +
@@ -907,7 +3999,11 @@ const file_api_v1_workspace_service_proto_rawDesc = "" +
 	"\x0ecustom_profile\x18\x06 \x01(\v2$.memos.api.v1.WorkspaceCustomProfileR\rcustomProfile\x121\n" +
 	"\x15week_start_day_offset\x18\a \x01(\x05R\x12weekStartDayOffset\x128\n" +
 	"\x18disallow_change_username\x18\b \x01(\bR\x16disallowChangeUsername\x128\n" +
-	"\x18disallow_change_nickname\x18\t \x01(\bR\x16disallowChangeNickname\"\xa3\x01\n" +
+	"\x18disallow_change_nickname\x18\t \x01(\bR\x16disallowChangeNickname\x127\n" +
+	"\x18require_admin_two_factor\x18\n" +
+	" \x01(\bR\x15requireAdminTwoFactor\x12\x19\n" +
+	"\bsso_only\x18\v \x01(\bR\assoOnly\x120\n" +
+	"\x14inbox_retention_days\x18\f \x01(\x05R\x12inboxRetentionDays\"\xa3\x01\n" +
 	"\x16WorkspaceCustomProfile\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x19\n" +
@@ -915,24 +4011,55 @@ const file_api_v1_workspace_service_proto_rawDesc = "" +
 	"\x06locale\x18\x04 \x01(\tR\x06locale\x12\x1e\n" +
 	"\n" +
 	"appearance\x18\x05 \x01(\tR\n" +
-	"appearance\"\xb7\x04\n" +
+	"appearance\"\xb9\v\n" +
 	"\x17WorkspaceStorageSetting\x12T\n" +
 	"\fstorage_type\x18\x01 \x01(\x0e21.memos.api.v1.WorkspaceStorageSetting.StorageTypeR\vstorageType\x12+\n" +
 	"\x11filepath_template\x18\x02 \x01(\tR\x10filepathTemplate\x12/\n" +
 	"\x14upload_size_limit_mb\x18\x03 \x01(\x03R\x11uploadSizeLimitMb\x12K\n" +
-	"\ts3_config\x18\x04 \x01(\v2..memos.api.v1.WorkspaceStorageSetting.S3ConfigR\bs3Config\x1a\xcc\x01\n" +
+	"\ts3_config\x18\x04 \x01(\v2..memos.api.v1.WorkspaceStorageSetting.S3ConfigR\bs3Config\x12N\n" +
+	"\n" +
+	"gcs_config\x18\x05 \x01(\v2/.memos.api.v1.WorkspaceStorageSetting.GCSConfigR\tgcsConfig\x12Q\n" +
+	"\vsftp_config\x18\x06 \x01(\v20.memos.api.v1.WorkspaceStorageSetting.SFTPConfigR\n" +
+	"sftpConfig\x120\n" +
+	"\x14strip_image_metadata\x18\a \x01(\bR\x12stripImageMetadata\x124\n" +
+	"\x16keep_image_orientation\x18\b \x01(\bR\x14keepImageOrientation\x12c\n" +
+	"\x11image_compression\x18\t \x01(\v26.memos.api.v1.WorkspaceStorageSetting.ImageCompressionR\x10imageCompression\x1a\xcc\x01\n" +
 	"\bS3Config\x12\"\n" +
 	"\raccess_key_id\x18\x01 \x01(\tR\vaccessKeyId\x12*\n" +
 	"\x11access_key_secret\x18\x02 \x01(\tR\x0faccessKeySecret\x12\x1a\n" +
 	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x16\n" +
 	"\x06region\x18\x04 \x01(\tR\x06region\x12\x16\n" +
 	"\x06bucket\x18\x05 \x01(\tR\x06bucket\x12$\n" +
-	"\x0euse_path_style\x18\x06 \x01(\bR\fusePathStyle\"L\n" +
+	"\x0euse_path_style\x18\x06 \x01(\bR\fusePathStyle\x1aa\n" +
+	"\tGCSConfig\x12 \n" +
+	"\vcredentials\x18\x01 \x01(\tR\vcredentials\x12\x16\n" +
+	"\x06bucket\x18\x02 \x01(\tR\x06bucket\x12\x1a\n" +
+	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x1a\x94\x01\n" +
+	"\n" +
+	"SFTPConfig\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x1f\n" +
+	"\vprivate_key\x18\x04 \x01(\tR\n" +
+	"privateKey\x12\x19\n" +
+	"\bhost_key\x18\x05 \x01(\tR\ahostKey\x1a\x82\x02\n" +
+	"\x10ImageCompression\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12#\n" +
+	"\rmax_dimension\x18\x02 \x01(\x05R\fmaxDimension\x12\x18\n" +
+	"\aquality\x18\x03 \x01(\x05R\aquality\x12U\n" +
+	"\x06format\x18\x04 \x01(\x0e2=.memos.api.v1.WorkspaceStorageSetting.ImageCompression.FormatR\x06format\">\n" +
+	"\x06Format\x12\x16\n" +
+	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04JPEG\x10\x01\x12\b\n" +
+	"\x04WEBP\x10\x02\x12\b\n" +
+	"\x04AVIF\x10\x03\"_\n" +
 	"\vStorageType\x12\x1c\n" +
 	"\x18STORAGE_TYPE_UNSPECIFIED\x10\x00\x12\f\n" +
 	"\bDATABASE\x10\x01\x12\t\n" +
 	"\x05LOCAL\x10\x02\x12\x06\n" +
-	"\x02S3\x10\x03\"\x88\x04\n" +
+	"\x02S3\x10\x03\x12\a\n" +
+	"\x03GCS\x10\x04\x12\b\n" +
+	"\x04SFTP\x10\x05\"\x88\x04\n" +
 	"\x1bWorkspaceMemoRelatedSetting\x12<\n" +
 	"\x1adisallow_public_visibility\x18\x01 \x01(\bR\x18disallowPublicVisibility\x127\n" +
 	"\x18display_with_update_time\x18\x02 \x01(\bR\x15displayWithUpdateTime\x120\n" +
@@ -944,18 +4071,238 @@ const file_api_v1_workspace_service_proto_rawDesc = "" +
 	"\x1adisable_markdown_shortcuts\x18\b \x01(\bR\x18disableMarkdownShortcuts\x127\n" +
 	"\x18enable_blur_nsfw_content\x18\t \x01(\bR\x15enableBlurNsfwContent\x12\x1b\n" +
 	"\tnsfw_tags\x18\n" +
-	" \x03(\tR\bnsfwTags\"X\n" +
+	" \x03(\tR\bnsfwTags\"\x8b\x02\n" +
+	"\x19WorkspaceEmbeddingSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12L\n" +
+	"\bprovider\x18\x02 \x01(\x0e20.memos.api.v1.WorkspaceEmbeddingSetting.ProviderR\bprovider\x12\x1a\n" +
+	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x17\n" +
+	"\aapi_key\x18\x04 \x01(\tR\x06apiKey\x12\x14\n" +
+	"\x05model\x18\x05 \x01(\tR\x05model\";\n" +
+	"\bProvider\x12\x18\n" +
+	"\x14PROVIDER_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06OPENAI\x10\x01\x12\t\n" +
+	"\x05LOCAL\x10\x02\"\xaf\x02\n" +
+	"\x13WorkspaceOCRSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12F\n" +
+	"\bprovider\x18\x02 \x01(\x0e2*.memos.api.v1.WorkspaceOCRSetting.ProviderR\bprovider\x12%\n" +
+	"\x0etesseract_path\x18\x03 \x01(\tR\rtesseractPath\x12\x1c\n" +
+	"\tlanguages\x18\x04 \x01(\tR\tlanguages\x12\x1a\n" +
+	"\bendpoint\x18\x05 \x01(\tR\bendpoint\x12\x17\n" +
+	"\aapi_key\x18\x06 \x01(\tR\x06apiKey\"<\n" +
+	"\bProvider\x12\x18\n" +
+	"\x14PROVIDER_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tTESSERACT\x10\x01\x12\a\n" +
+	"\x03API\x10\x02\"\xe5\x03\n" +
+	"\x1bWorkspaceMalwareScanSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12K\n" +
+	"\ascanner\x18\x02 \x01(\x0e21.memos.api.v1.WorkspaceMalwareScanSetting.ScannerR\ascanner\x12%\n" +
+	"\x0eclamav_address\x18\x03 \x01(\tR\rclamavAddress\x12\x18\n" +
+	"\acommand\x18\x04 \x01(\tR\acommand\x12\x1a\n" +
+	"\bendpoint\x18\x05 \x01(\tR\bendpoint\x12\x17\n" +
+	"\aapi_key\x18\x06 \x01(\tR\x06apiKey\x12H\n" +
+	"\x06action\x18\a \x01(\x0e20.memos.api.v1.WorkspaceMalwareScanSetting.ActionR\x06action\x12\x1b\n" +
+	"\tfail_open\x18\b \x01(\bR\bfailOpen\"D\n" +
+	"\aScanner\x12\x17\n" +
+	"\x13SCANNER_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06CLAMAV\x10\x01\x12\v\n" +
+	"\aCOMMAND\x10\x02\x12\a\n" +
+	"\x03API\x10\x03\"<\n" +
+	"\x06Action\x12\x16\n" +
+	"\x12ACTION_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06REJECT\x10\x01\x12\x0e\n" +
+	"\n" +
+	"QUARANTINE\x10\x02\"\x86\x03\n" +
+	"\x1dWorkspaceTranscriptionSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12P\n" +
+	"\bprovider\x18\x02 \x01(\x0e24.memos.api.v1.WorkspaceTranscriptionSetting.ProviderR\bprovider\x12!\n" +
+	"\fwhisper_path\x18\x03 \x01(\tR\vwhisperPath\x12,\n" +
+	"\x12whisper_model_path\x18\x04 \x01(\tR\x10whisperModelPath\x12\x1a\n" +
+	"\blanguage\x18\x05 \x01(\tR\blanguage\x12\x1a\n" +
+	"\bendpoint\x18\x06 \x01(\tR\bendpoint\x12\x17\n" +
+	"\aapi_key\x18\a \x01(\tR\x06apiKey\x12\x14\n" +
+	"\x05model\x18\b \x01(\tR\x05model\"A\n" +
+	"\bProvider\x12\x18\n" +
+	"\x14PROVIDER_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vWHISPER_CPP\x10\x01\x12\n" +
+	"\n" +
+	"\x06OPENAI\x10\x02\"F\n" +
+	"\x14WorkspaceSCIMSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\xd7\x01\n" +
+	"\x1eWorkspacePasswordPolicySetting\x12\x1d\n" +
+	"\n" +
+	"min_length\x18\x01 \x01(\x05R\tminLength\x12%\n" +
+	"\x0echeck_breached\x18\x02 \x01(\bR\rcheckBreached\x122\n" +
+	"\x15breach_check_endpoint\x18\x03 \x01(\tR\x13breachCheckEndpoint\x12;\n" +
+	"\x1arequire_change_after_reset\x18\x04 \x01(\bR\x17requireChangeAfterReset\"\xd7\x04\n" +
+	"\x15WorkspaceEmailSetting\x12\x1b\n" +
+	"\tsmtp_host\x18\x01 \x01(\tR\bsmtpHost\x12\x1b\n" +
+	"\tsmtp_port\x18\x02 \x01(\x05R\bsmtpPort\x12#\n" +
+	"\rsmtp_username\x18\x03 \x01(\tR\fsmtpUsername\x12#\n" +
+	"\rsmtp_password\x18\x04 \x01(\tR\fsmtpPassword\x12\x17\n" +
+	"\ause_tls\x18\x05 \x01(\bR\x06useTls\x12\x1d\n" +
+	"\n" +
+	"from_email\x18\x06 \x01(\tR\tfromEmail\x12\x1b\n" +
+	"\tfrom_name\x18\a \x01(\tR\bfromName\x12<\n" +
+	"\x1arequire_email_verification\x18\b \x01(\bR\x18requireEmailVerification\x120\n" +
+	"\x14allow_password_reset\x18\t \x01(\bR\x12allowPasswordReset\x12\x1b\n" +
+	"\timap_host\x18\n" +
+	" \x01(\tR\bimapHost\x12\x1b\n" +
+	"\timap_port\x18\v \x01(\x05R\bimapPort\x12#\n" +
+	"\rimap_username\x18\f \x01(\tR\fimapUsername\x12#\n" +
+	"\rimap_password\x18\r \x01(\tR\fimapPassword\x12 \n" +
+	"\fimap_use_tls\x18\x0e \x01(\bR\n" +
+	"imapUseTls\x12\x1f\n" +
+	"\vimap_folder\x18\x0f \x01(\tR\n" +
+	"imapFolder\x12.\n" +
+	"\x13imap_archive_folder\x18\x10 \x01(\tR\x11imapArchiveFolder\"\x81\x01\n" +
+	"!WorkspaceAccessTokenPolicySetting\x12*\n" +
+	"\x11max_lifetime_days\x18\x01 \x01(\x05R\x0fmaxLifetimeDays\x120\n" +
+	"\x14idle_revocation_days\x18\x02 \x01(\x05R\x12idleRevocationDays\"\xbf\x03\n" +
+	"\x17WorkspaceCaptchaSetting\x12J\n" +
+	"\bprovider\x18\x01 \x01(\x0e2..memos.api.v1.WorkspaceCaptchaSetting.ProviderR\bprovider\x12\x19\n" +
+	"\bsite_key\x18\x02 \x01(\tR\asiteKey\x12\x1d\n" +
+	"\n" +
+	"secret_key\x18\x03 \x01(\tR\tsecretKey\x12+\n" +
+	"\x12require_on_sign_up\x18\x04 \x01(\bR\x0frequireOnSignUp\x129\n" +
+	"\x19sign_in_failure_threshold\x18\x05 \x01(\x05R\x16signInFailureThreshold\x127\n" +
+	"\x18proof_of_work_difficulty\x18\x06 \x01(\x05R\x15proofOfWorkDifficulty\x12'\n" +
+	"\x0fverify_endpoint\x18\a \x01(\tR\x0everifyEndpoint\"T\n" +
+	"\bProvider\x12\x18\n" +
+	"\x14PROVIDER_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tTURNSTILE\x10\x01\x12\f\n" +
+	"\bHCAPTCHA\x10\x02\x12\x11\n" +
+	"\rPROOF_OF_WORK\x10\x03\"~\n" +
+	"\x15WorkspaceSlackSetting\x12%\n" +
+	"\x0esigning_secret\x18\x01 \x01(\tR\rsigningSecret\x12\x1b\n" +
+	"\tbot_token\x18\x02 \x01(\tR\bbotToken\x12!\n" +
+	"\fapi_endpoint\x18\x03 \x01(\tR\vapiEndpoint\"\xd3\x02\n" +
+	"\x17WorkspaceDiscordSetting\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tR\tpublicKey\x12\x1b\n" +
+	"\tbot_token\x18\x03 \x01(\tR\bbotToken\x12#\n" +
+	"\rsave_reaction\x18\x04 \x01(\tR\fsaveReaction\x12*\n" +
+	"\x11digest_channel_id\x18\x05 \x01(\tR\x0fdigestChannelId\x12'\n" +
+	"\x0fdigest_schedule\x18\x06 \x01(\tR\x0edigestSchedule\x128\n" +
+	"\x18digest_include_protected\x18\a \x01(\bR\x16digestIncludeProtected\x12!\n" +
+	"\fapi_endpoint\x18\b \x01(\tR\vapiEndpoint\"\xcd\x01\n" +
+	"\x15WorkspaceRolesSetting\x12D\n" +
+	"\x05roles\x18\x01 \x03(\v2..memos.api.v1.WorkspaceRolesSetting.CustomRoleR\x05roles\x1an\n" +
+	"\n" +
+	"CustomRole\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12:\n" +
+	"\vpermissions\x18\x03 \x03(\x0e2\x18.memos.api.v1.PermissionR\vpermissions\"X\n" +
 	"\x1aGetWorkspaceSettingRequest\x12:\n" +
 	"\x04name\x18\x01 \x01(\tB&\xe0A\x02\xfaA \n" +
 	"\x1eapi.memos.dev/WorkspaceSettingR\x04name\"\xa0\x01\n" +
 	"\x1dUpdateWorkspaceSettingRequest\x12=\n" +
 	"\asetting\x18\x01 \x01(\v2\x1e.memos.api.v1.WorkspaceSettingB\x03\xe0A\x02R\asetting\x12@\n" +
 	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x01R\n" +
-	"updateMask2\xe9\x03\n" +
+	"updateMask\"=\n" +
+	"\x1eCheckWorkspaceIntegrityRequest\x12\x1b\n" +
+	"\x06repair\x18\x01 \x01(\bB\x03\xe0A\x01R\x06repair\"\xb4\x03\n" +
+	"\x18WorkspaceIntegrityReport\x12D\n" +
+	"\x06issues\x18\x01 \x03(\v2,.memos.api.v1.WorkspaceIntegrityReport.IssueR\x06issues\x1a\xd1\x02\n" +
+	"\x05Issue\x12E\n" +
+	"\x04type\x18\x01 \x01(\x0e21.memos.api.v1.WorkspaceIntegrityReport.Issue.TypeR\x04type\x12\x1a\n" +
+	"\bresource\x18\x02 \x01(\tR\bresource\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\brepaired\x18\x04 \x01(\bR\brepaired\"\xa6\x01\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14MEMO_CREATOR_MISSING\x10\x01\x12\x1a\n" +
+	"\x16MEMO_RELATION_DANGLING\x10\x02\x12\x1b\n" +
+	"\x17ATTACHMENT_BLOB_MISSING\x10\x03\x12\x16\n" +
+	"\x12MEMO_PAYLOAD_DRIFT\x10\x04\x12\x1d\n" +
+	"\x19ATTACHMENT_BLOB_CORRUPTED\x10\x05\":\n" +
+	"\x16ReloadWorkspaceRequest\x12 \n" +
+	"\tlog_level\x18\x01 \x01(\tB\x03\xe0A\x01R\blogLevel\"6\n" +
+	"\x17ReloadWorkspaceResponse\x12\x1b\n" +
+	"\tlog_level\x18\x01 \x01(\tR\blogLevel\"\xbf\x04\n" +
+	"\x03Job\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12\x17\n" +
+	"\x04kind\x18\x02 \x01(\tB\x03\xe0A\x03R\x04kind\x122\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x17.memos.api.v1.Job.StateB\x03\xe0A\x03R\x05state\x12(\n" +
+	"\rattempt_count\x18\x04 \x01(\x05B\x03\xe0A\x03R\fattemptCount\x12&\n" +
+	"\fmax_attempts\x18\x05 \x01(\x05B\x03\xe0A\x03R\vmaxAttempts\x12\"\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tB\x03\xe0A\x03R\tlastError\x12@\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"createTime\x12K\n" +
+	"\x11next_attempt_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\x0fnextAttemptTime\x12@\n" +
+	"\vfinish_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\n" +
+	"finishTime\"S\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPENDING\x10\x01\x12\v\n" +
+	"\aRUNNING\x10\x02\x12\r\n" +
+	"\tSUCCEEDED\x10\x03\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x04:6\xeaA3\n" +
+	"\x10memos.api.v1/Job\x12\x14workspace/jobs/{job}*\x04jobs2\x03job\"\xa4\x01\n" +
+	"\x0fListJobsRequest\x12 \n" +
+	"\tpage_size\x18\x01 \x01(\x05B\x03\xe0A\x01R\bpageSize\x12\"\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tpageToken\x12\x17\n" +
+	"\x04kind\x18\x03 \x01(\tB\x03\xe0A\x01R\x04kind\x122\n" +
+	"\x05state\x18\x04 \x01(\x0e2\x17.memos.api.v1.Job.StateB\x03\xe0A\x01R\x05state\"a\n" +
+	"\x10ListJobsResponse\x12%\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x11.memos.api.v1.JobR\x04jobs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"=\n" +
+	"\rGetJobRequest\x12,\n" +
+	"\x04name\x18\x01 \x01(\tB\x18\xe0A\x02\xfaA\x12\n" +
+	"\x10memos.api.v1/JobR\x04name\"?\n" +
+	"\x0fRetryJobRequest\x12,\n" +
+	"\x04name\x18\x01 \x01(\tB\x18\xe0A\x02\xfaA\x12\n" +
+	"\x10memos.api.v1/JobR\x04name\"\xb4\x05\n" +
+	"\rScheduledTask\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\bR\x04name\x12%\n" +
+	"\vdescription\x18\x02 \x01(\tB\x03\xe0A\x03R\vdescription\x12\x1a\n" +
+	"\bschedule\x18\x03 \x01(\tR\bschedule\x12.\n" +
+	"\x10default_schedule\x18\x04 \x01(\tB\x03\xe0A\x03R\x0fdefaultSchedule\x12\x1a\n" +
+	"\bdisabled\x18\x05 \x01(\bR\bdisabled\x12C\n" +
+	"\rnext_run_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vnextRunTime\x12L\n" +
+	"\x0elast_run_state\x18\a \x01(\x0e2!.memos.api.v1.ScheduledTask.StateB\x03\xe0A\x03R\flastRunState\x12C\n" +
+	"\rlast_run_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\vlastRunTime\x12I\n" +
+	"\x10last_finish_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x03R\x0elastFinishTime\x12\"\n" +
+	"\n" +
+	"last_error\x18\n" +
+	" \x01(\tB\x03\xe0A\x03R\tlastError\"S\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPENDING\x10\x01\x12\v\n" +
+	"\aRUNNING\x10\x02\x12\r\n" +
+	"\tSUCCEEDED\x10\x03\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x04:_\xeaA\\\n" +
+	"\x1amemos.api.v1/ScheduledTask\x12\x1fworkspace/scheduledTasks/{task}*\x0escheduledTasks2\rscheduledTask\"\x1b\n" +
+	"\x19ListScheduledTasksRequest\"O\n" +
+	"\x1aListScheduledTasksResponse\x121\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x1b.memos.api.v1.ScheduledTaskR\x05tasks\"\x94\x01\n" +
+	"\x1aUpdateScheduledTaskRequest\x124\n" +
+	"\x04task\x18\x01 \x01(\v2\x1b.memos.api.v1.ScheduledTaskB\x03\xe0A\x02R\x04task\x12@\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskB\x03\xe0A\x02R\n" +
+	"updateMask\"Q\n" +
+	"\x17RunScheduledTaskRequest\x126\n" +
+	"\x04name\x18\x01 \x01(\tB\"\xe0A\x02\xfaA\x1c\n" +
+	"\x1amemos.api.v1/ScheduledTaskR\x04name2\xb8\f\n" +
 	"\x10WorkspaceService\x12\x82\x01\n" +
 	"\x13GetWorkspaceProfile\x12(.memos.api.v1.GetWorkspaceProfileRequest\x1a\x1e.memos.api.v1.WorkspaceProfile\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/v1/workspace/profile\x12\x93\x01\n" +
 	"\x13GetWorkspaceSetting\x12(.memos.api.v1.GetWorkspaceSettingRequest\x1a\x1e.memos.api.v1.WorkspaceSetting\"2\xdaA\x04name\x82\xd3\xe4\x93\x02%\x12#/api/v1/{name=workspace/settings/*}\x12\xb9\x01\n" +
-	"\x16UpdateWorkspaceSetting\x12+.memos.api.v1.UpdateWorkspaceSettingRequest\x1a\x1e.memos.api.v1.WorkspaceSetting\"R\xdaA\x13setting,update_mask\x82\xd3\xe4\x93\x026:\asetting2+/api/v1/{setting.name=workspace/settings/*}B\xad\x01\n" +
+	"\x16UpdateWorkspaceSetting\x12+.memos.api.v1.UpdateWorkspaceSettingRequest\x1a\x1e.memos.api.v1.WorkspaceSetting\"R\xdaA\x13setting,update_mask\x82\xd3\xe4\x93\x026:\asetting2+/api/v1/{setting.name=workspace/settings/*}\x12\x9c\x01\n" +
+	"\x17CheckWorkspaceIntegrity\x12,.memos.api.v1.CheckWorkspaceIntegrityRequest\x1a&.memos.api.v1.WorkspaceIntegrityReport\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/workspace:checkIntegrity\x12\x83\x01\n" +
+	"\x0fReloadWorkspace\x12$.memos.api.v1.ReloadWorkspaceRequest\x1a%.memos.api.v1.ReloadWorkspaceResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/workspace:reload\x12i\n" +
+	"\bListJobs\x12\x1d.memos.api.v1.ListJobsRequest\x1a\x1e.memos.api.v1.ListJobsResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/api/v1/workspace/jobs\x12h\n" +
+	"\x06GetJob\x12\x1b.memos.api.v1.GetJobRequest\x1a\x11.memos.api.v1.Job\".\xdaA\x04name\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/{name=workspace/jobs/*}\x12u\n" +
+	"\bRetryJob\x12\x1d.memos.api.v1.RetryJobRequest\x1a\x11.memos.api.v1.Job\"7\xdaA\x04name\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/{name=workspace/jobs/*}:retry\x12\x91\x01\n" +
+	"\x12ListScheduledTasks\x12'.memos.api.v1.ListScheduledTasksRequest\x1a(.memos.api.v1.ListScheduledTasksResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/workspace/scheduledTasks\x12\xad\x01\n" +
+	"\x13UpdateScheduledTask\x12(.memos.api.v1.UpdateScheduledTaskRequest\x1a\x1b.memos.api.v1.ScheduledTask\"O\xdaA\x10task,update_mask\x82\xd3\xe4\x93\x026:\x04task2./api/v1/{task.name=workspace/scheduledTasks/*}\x12\x97\x01\n" +
+	"\x10RunScheduledTask\x12%.memos.api.v1.RunScheduledTaskRequest\x1a\x1b.memos.api.v1.ScheduledTask\"?\xdaA\x04name\x82\xd3\xe4\x93\x022:\x01*\"-/api/v1/{name=workspace/scheduledTasks/*}:runB\xad\x01\n" +
 	"\x10com.memos.api.v1B\x15WorkspaceServiceProtoP\x01Z0github.com/usememos/memos/proto/gen/api/v1;apiv1\xa2\x02\x03MAX\xaa\x02\fMemos.Api.V1\xca\x02\fMemos\\Api\\V1\xe2\x02\x18Memos\\Api\\V1\\GPBMetadata\xea\x02\x0eMemos::Api::V1b\x06proto3"
 
 var (
@@ -970,42 +4317,140 @@ func file_api_v1_workspace_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_workspace_service_proto_rawDescData
 }
 
-var file_api_v1_workspace_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_v1_workspace_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_v1_workspace_service_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_api_v1_workspace_service_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_api_v1_workspace_service_proto_goTypes = []any{
-	(WorkspaceStorageSetting_StorageType)(0), // 0: memos.api.v1.WorkspaceStorageSetting.StorageType
-	(*WorkspaceProfile)(nil),                 // 1: memos.api.v1.WorkspaceProfile
-	(*GetWorkspaceProfileRequest)(nil),       // 2: memos.api.v1.GetWorkspaceProfileRequest
-	(*WorkspaceSetting)(nil),                 // 3: memos.api.v1.WorkspaceSetting
-	(*WorkspaceGeneralSetting)(nil),          // 4: memos.api.v1.WorkspaceGeneralSetting
-	(*WorkspaceCustomProfile)(nil),           // 5: memos.api.v1.WorkspaceCustomProfile
-	(*WorkspaceStorageSetting)(nil),          // 6: memos.api.v1.WorkspaceStorageSetting
-	(*WorkspaceMemoRelatedSetting)(nil),      // 7: memos.api.v1.WorkspaceMemoRelatedSetting
-	(*GetWorkspaceSettingRequest)(nil),       // 8: memos.api.v1.GetWorkspaceSettingRequest
-	(*UpdateWorkspaceSettingRequest)(nil),    // 9: memos.api.v1.UpdateWorkspaceSettingRequest
-	(*WorkspaceStorageSetting_S3Config)(nil), // 10: memos.api.v1.WorkspaceStorageSetting.S3Config
-	(*fieldmaskpb.FieldMask)(nil),            // 11: google.protobuf.FieldMask
+	(WorkspaceStorageSetting_StorageType)(0),             // 0: memos.api.v1.WorkspaceStorageSetting.StorageType
+	(WorkspaceStorageSetting_ImageCompression_Format)(0), // 1: memos.api.v1.WorkspaceStorageSetting.ImageCompression.Format
+	(WorkspaceEmbeddingSetting_Provider)(0),              // 2: memos.api.v1.WorkspaceEmbeddingSetting.Provider
+	(WorkspaceOCRSetting_Provider)(0),                    // 3: memos.api.v1.WorkspaceOCRSetting.Provider
+	(WorkspaceMalwareScanSetting_Scanner)(0),             // 4: memos.api.v1.WorkspaceMalwareScanSetting.Scanner
+	(WorkspaceMalwareScanSetting_Action)(0),              // 5: memos.api.v1.WorkspaceMalwareScanSetting.Action
+	(WorkspaceTranscriptionSetting_Provider)(0),          // 6: memos.api.v1.WorkspaceTranscriptionSetting.Provider
+	(WorkspaceCaptchaSetting_Provider)(0),                // 7: memos.api.v1.WorkspaceCaptchaSetting.Provider
+	(WorkspaceIntegrityReport_Issue_Type)(0),             // 8: memos.api.v1.WorkspaceIntegrityReport.Issue.Type
+	(Job_State)(0),                                       // 9: memos.api.v1.Job.State
+	(ScheduledTask_State)(0),                             // 10: memos.api.v1.ScheduledTask.State
+	(*WorkspaceProfile)(nil),                             // 11: memos.api.v1.WorkspaceProfile
+	(*GetWorkspaceProfileRequest)(nil),                   // 12: memos.api.v1.GetWorkspaceProfileRequest
+	(*WorkspaceSetting)(nil),                             // 13: memos.api.v1.WorkspaceSetting
+	(*WorkspaceGeneralSetting)(nil),                      // 14: memos.api.v1.WorkspaceGeneralSetting
+	(*WorkspaceCustomProfile)(nil),                       // 15: memos.api.v1.WorkspaceCustomProfile
+	(*WorkspaceStorageSetting)(nil),                      // 16: memos.api.v1.WorkspaceStorageSetting
+	(*WorkspaceMemoRelatedSetting)(nil),                  // 17: memos.api.v1.WorkspaceMemoRelatedSetting
+	(*WorkspaceEmbeddingSetting)(nil),                    // 18: memos.api.v1.WorkspaceEmbeddingSetting
+	(*WorkspaceOCRSetting)(nil),                          // 19: memos.api.v1.WorkspaceOCRSetting
+	(*WorkspaceMalwareScanSetting)(nil),                  // 20: memos.api.v1.WorkspaceMalwareScanSetting
+	(*WorkspaceTranscriptionSetting)(nil),                // 21: memos.api.v1.WorkspaceTranscriptionSetting
+	(*WorkspaceSCIMSetting)(nil),                         // 22: memos.api.v1.WorkspaceSCIMSetting
+	(*WorkspacePasswordPolicySetting)(nil),               // 23: memos.api.v1.WorkspacePasswordPolicySetting
+	(*WorkspaceEmailSetting)(nil),                        // 24: memos.api.v1.WorkspaceEmailSetting
+	(*WorkspaceAccessTokenPolicySetting)(nil),            // 25: memos.api.v1.WorkspaceAccessTokenPolicySetting
+	(*WorkspaceCaptchaSetting)(nil),                      // 26: memos.api.v1.WorkspaceCaptchaSetting
+	(*WorkspaceSlackSetting)(nil),                        // 27: memos.api.v1.WorkspaceSlackSetting
+	(*WorkspaceDiscordSetting)(nil),                      // 28: memos.api.v1.WorkspaceDiscordSetting
+	(*WorkspaceRolesSetting)(nil),                        // 29: memos.api.v1.WorkspaceRolesSetting
+	(*GetWorkspaceSettingRequest)(nil),                   // 30: memos.api.v1.GetWorkspaceSettingRequest
+	(*UpdateWorkspaceSettingRequest)(nil),                // 31: memos.api.v1.UpdateWorkspaceSettingRequest
+	(*CheckWorkspaceIntegrityRequest)(nil),               // 32: memos.api.v1.CheckWorkspaceIntegrityRequest
+	(*WorkspaceIntegrityReport)(nil),                     // 33: memos.api.v1.WorkspaceIntegrityReport
+	(*ReloadWorkspaceRequest)(nil),                       // 34: memos.api.v1.ReloadWorkspaceRequest
+	(*ReloadWorkspaceResponse)(nil),                      // 35: memos.api.v1.ReloadWorkspaceResponse
+	(*Job)(nil),                                          // 36: memos.api.v1.Job
+	(*ListJobsRequest)(nil),                              // 37: memos.api.v1.ListJobsRequest
+	(*ListJobsResponse)(nil),                             // 38: memos.api.v1.ListJobsResponse
+	(*GetJobRequest)(nil),                                // 39: memos.api.v1.GetJobRequest
+	(*RetryJobRequest)(nil),                              // 40: memos.api.v1.RetryJobRequest
+	(*ScheduledTask)(nil),                                // 41: memos.api.v1.ScheduledTask
+	(*ListScheduledTasksRequest)(nil),                    // 42: memos.api.v1.ListScheduledTasksRequest
+	(*ListScheduledTasksResponse)(nil),                   // 43: memos.api.v1.ListScheduledTasksResponse
+	(*UpdateScheduledTaskRequest)(nil),                   // 44: memos.api.v1.UpdateScheduledTaskRequest
+	(*RunScheduledTaskRequest)(nil),                      // 45: memos.api.v1.RunScheduledTaskRequest
+	(*WorkspaceStorageSetting_S3Config)(nil),             // 46: memos.api.v1.WorkspaceStorageSetting.S3Config
+	(*WorkspaceStorageSetting_GCSConfig)(nil),            // 47: memos.api.v1.WorkspaceStorageSetting.GCSConfig
+	(*WorkspaceStorageSetting_SFTPConfig)(nil),           // 48: memos.api.v1.WorkspaceStorageSetting.SFTPConfig
+	(*WorkspaceStorageSetting_ImageCompression)(nil),     // 49: memos.api.v1.WorkspaceStorageSetting.ImageCompression
+	(*WorkspaceRolesSetting_CustomRole)(nil),             // 50: memos.api.v1.WorkspaceRolesSetting.CustomRole
+	(*WorkspaceIntegrityReport_Issue)(nil),               // 51: memos.api.v1.WorkspaceIntegrityReport.Issue
+	(*fieldmaskpb.FieldMask)(nil),                        // 52: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),                        // 53: google.protobuf.Timestamp
+	(Permission)(0),                                      // 54: memos.api.v1.Permission
 }
 var file_api_v1_workspace_service_proto_depIdxs = []int32{
-	4,  // 0: memos.api.v1.WorkspaceSetting.general_setting:type_name -> memos.api.v1.WorkspaceGeneralSetting
-	6,  // 1: memos.api.v1.WorkspaceSetting.storage_setting:type_name -> memos.api.v1.WorkspaceStorageSetting
-	7,  // 2: memos.api.v1.WorkspaceSetting.memo_related_setting:type_name -> memos.api.v1.WorkspaceMemoRelatedSetting
-	5,  // 3: memos.api.v1.WorkspaceGeneralSetting.custom_profile:type_name -> memos.api.v1.WorkspaceCustomProfile
-	0,  // 4: memos.api.v1.WorkspaceStorageSetting.storage_type:type_name -> memos.api.v1.WorkspaceStorageSetting.StorageType
-	10, // 5: memos.api.v1.WorkspaceStorageSetting.s3_config:type_name -> memos.api.v1.WorkspaceStorageSetting.S3Config
-	3,  // 6: memos.api.v1.UpdateWorkspaceSettingRequest.setting:type_name -> memos.api.v1.WorkspaceSetting
-	11, // 7: memos.api.v1.UpdateWorkspaceSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
-	2,  // 8: memos.api.v1.WorkspaceService.GetWorkspaceProfile:input_type -> memos.api.v1.GetWorkspaceProfileRequest
-	8,  // 9: memos.api.v1.WorkspaceService.GetWorkspaceSetting:input_type -> memos.api.v1.GetWorkspaceSettingRequest
-	9,  // 10: memos.api.v1.WorkspaceService.UpdateWorkspaceSetting:input_type -> memos.api.v1.UpdateWorkspaceSettingRequest
-	1,  // 11: memos.api.v1.WorkspaceService.GetWorkspaceProfile:output_type -> memos.api.v1.WorkspaceProfile
-	3,  // 12: memos.api.v1.WorkspaceService.GetWorkspaceSetting:output_type -> memos.api.v1.WorkspaceSetting
-	3,  // 13: memos.api.v1.WorkspaceService.UpdateWorkspaceSetting:output_type -> memos.api.v1.WorkspaceSetting
-	11, // [11:14] is the sub-list for method output_type
-	8,  // [8:11] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	14, // 0: memos.api.v1.WorkspaceSetting.general_setting:type_name -> memos.api.v1.WorkspaceGeneralSetting
+	16, // 1: memos.api.v1.WorkspaceSetting.storage_setting:type_name -> memos.api.v1.WorkspaceStorageSetting
+	17, // 2: memos.api.v1.WorkspaceSetting.memo_related_setting:type_name -> memos.api.v1.WorkspaceMemoRelatedSetting
+	18, // 3: memos.api.v1.WorkspaceSetting.embedding_setting:type_name -> memos.api.v1.WorkspaceEmbeddingSetting
+	19, // 4: memos.api.v1.WorkspaceSetting.ocr_setting:type_name -> memos.api.v1.WorkspaceOCRSetting
+	20, // 5: memos.api.v1.WorkspaceSetting.malware_scan_setting:type_name -> memos.api.v1.WorkspaceMalwareScanSetting
+	21, // 6: memos.api.v1.WorkspaceSetting.transcription_setting:type_name -> memos.api.v1.WorkspaceTranscriptionSetting
+	22, // 7: memos.api.v1.WorkspaceSetting.scim_setting:type_name -> memos.api.v1.WorkspaceSCIMSetting
+	23, // 8: memos.api.v1.WorkspaceSetting.password_policy_setting:type_name -> memos.api.v1.WorkspacePasswordPolicySetting
+	24, // 9: memos.api.v1.WorkspaceSetting.email_setting:type_name -> memos.api.v1.WorkspaceEmailSetting
+	29, // 10: memos.api.v1.WorkspaceSetting.roles_setting:type_name -> memos.api.v1.WorkspaceRolesSetting
+	25, // 11: memos.api.v1.WorkspaceSetting.access_token_policy_setting:type_name -> memos.api.v1.WorkspaceAccessTokenPolicySetting
+	26, // 12: memos.api.v1.WorkspaceSetting.captcha_setting:type_name -> memos.api.v1.WorkspaceCaptchaSetting
+	27, // 13: memos.api.v1.WorkspaceSetting.slack_setting:type_name -> memos.api.v1.WorkspaceSlackSetting
+	28, // 14: memos.api.v1.WorkspaceSetting.discord_setting:type_name -> memos.api.v1.WorkspaceDiscordSetting
+	15, // 15: memos.api.v1.WorkspaceGeneralSetting.custom_profile:type_name -> memos.api.v1.WorkspaceCustomProfile
+	0,  // 16: memos.api.v1.WorkspaceStorageSetting.storage_type:type_name -> memos.api.v1.WorkspaceStorageSetting.StorageType
+	46, // 17: memos.api.v1.WorkspaceStorageSetting.s3_config:type_name -> memos.api.v1.WorkspaceStorageSetting.S3Config
+	47, // 18: memos.api.v1.WorkspaceStorageSetting.gcs_config:type_name -> memos.api.v1.WorkspaceStorageSetting.GCSConfig
+	48, // 19: memos.api.v1.WorkspaceStorageSetting.sftp_config:type_name -> memos.api.v1.WorkspaceStorageSetting.SFTPConfig
+	49, // 20: memos.api.v1.WorkspaceStorageSetting.image_compression:type_name -> memos.api.v1.WorkspaceStorageSetting.ImageCompression
+	2,  // 21: memos.api.v1.WorkspaceEmbeddingSetting.provider:type_name -> memos.api.v1.WorkspaceEmbeddingSetting.Provider
+	3,  // 22: memos.api.v1.WorkspaceOCRSetting.provider:type_name -> memos.api.v1.WorkspaceOCRSetting.Provider
+	4,  // 23: memos.api.v1.WorkspaceMalwareScanSetting.scanner:type_name -> memos.api.v1.WorkspaceMalwareScanSetting.Scanner
+	5,  // 24: memos.api.v1.WorkspaceMalwareScanSetting.action:type_name -> memos.api.v1.WorkspaceMalwareScanSetting.Action
+	6,  // 25: memos.api.v1.WorkspaceTranscriptionSetting.provider:type_name -> memos.api.v1.WorkspaceTranscriptionSetting.Provider
+	7,  // 26: memos.api.v1.WorkspaceCaptchaSetting.provider:type_name -> memos.api.v1.WorkspaceCaptchaSetting.Provider
+	50, // 27: memos.api.v1.WorkspaceRolesSetting.roles:type_name -> memos.api.v1.WorkspaceRolesSetting.CustomRole
+	13, // 28: memos.api.v1.UpdateWorkspaceSettingRequest.setting:type_name -> memos.api.v1.WorkspaceSetting
+	52, // 29: memos.api.v1.UpdateWorkspaceSettingRequest.update_mask:type_name -> google.protobuf.FieldMask
+	51, // 30: memos.api.v1.WorkspaceIntegrityReport.issues:type_name -> memos.api.v1.WorkspaceIntegrityReport.Issue
+	9,  // 31: memos.api.v1.Job.state:type_name -> memos.api.v1.Job.State
+	53, // 32: memos.api.v1.Job.create_time:type_name -> google.protobuf.Timestamp
+	53, // 33: memos.api.v1.Job.next_attempt_time:type_name -> google.protobuf.Timestamp
+	53, // 34: memos.api.v1.Job.finish_time:type_name -> google.protobuf.Timestamp
+	9,  // 35: memos.api.v1.ListJobsRequest.state:type_name -> memos.api.v1.Job.State
+	36, // 36: memos.api.v1.ListJobsResponse.jobs:type_name -> memos.api.v1.Job
+	53, // 37: memos.api.v1.ScheduledTask.next_run_time:type_name -> google.protobuf.Timestamp
+	10, // 38: memos.api.v1.ScheduledTask.last_run_state:type_name -> memos.api.v1.ScheduledTask.State
+	53, // 39: memos.api.v1.ScheduledTask.last_run_time:type_name -> google.protobuf.Timestamp
+	53, // 40: memos.api.v1.ScheduledTask.last_finish_time:type_name -> google.protobuf.Timestamp
+	41, // 41: memos.api.v1.ListScheduledTasksResponse.tasks:type_name -> memos.api.v1.ScheduledTask
+	41, // 42: memos.api.v1.UpdateScheduledTaskRequest.task:type_name -> memos.api.v1.ScheduledTask
+	52, // 43: memos.api.v1.UpdateScheduledTaskRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 44: memos.api.v1.WorkspaceStorageSetting.ImageCompression.format:type_name -> memos.api.v1.WorkspaceStorageSetting.ImageCompression.Format
+	54, // 45: memos.api.v1.WorkspaceRolesSetting.CustomRole.permissions:type_name -> memos.api.v1.Permission
+	8,  // 46: memos.api.v1.WorkspaceIntegrityReport.Issue.type:type_name -> memos.api.v1.WorkspaceIntegrityReport.Issue.Type
+	12, // 47: memos.api.v1.WorkspaceService.GetWorkspaceProfile:input_type -> memos.api.v1.GetWorkspaceProfileRequest
+	30, // 48: memos.api.v1.WorkspaceService.GetWorkspaceSetting:input_type -> memos.api.v1.GetWorkspaceSettingRequest
+	31, // 49: memos.api.v1.WorkspaceService.UpdateWorkspaceSetting:input_type -> memos.api.v1.UpdateWorkspaceSettingRequest
+	32, // 50: memos.api.v1.WorkspaceService.CheckWorkspaceIntegrity:input_type -> memos.api.v1.CheckWorkspaceIntegrityRequest
+	34, // 51: memos.api.v1.WorkspaceService.ReloadWorkspace:input_type -> memos.api.v1.ReloadWorkspaceRequest
+	37, // 52: memos.api.v1.WorkspaceService.ListJobs:input_type -> memos.api.v1.ListJobsRequest
+	39, // 53: memos.api.v1.WorkspaceService.GetJob:input_type -> memos.api.v1.GetJobRequest
+	40, // 54: memos.api.v1.WorkspaceService.RetryJob:input_type -> memos.api.v1.RetryJobRequest
+	42, // 55: memos.api.v1.WorkspaceService.ListScheduledTasks:input_type -> memos.api.v1.ListScheduledTasksRequest
+	44, // 56: memos.api.v1.WorkspaceService.UpdateScheduledTask:input_type -> memos.api.v1.UpdateScheduledTaskRequest
+	45, // 57: memos.api.v1.WorkspaceService.RunScheduledTask:input_type -> memos.api.v1.RunScheduledTaskRequest
+	11, // 58: memos.api.v1.WorkspaceService.GetWorkspaceProfile:output_type -> memos.api.v1.WorkspaceProfile
+	13, // 59: memos.api.v1.WorkspaceService.GetWorkspaceSetting:output_type -> memos.api.v1.WorkspaceSetting
+	13, // 60: memos.api.v1.WorkspaceService.UpdateWorkspaceSetting:output_type -> memos.api.v1.WorkspaceSetting
+	33, // 61: memos.api.v1.WorkspaceService.CheckWorkspaceIntegrity:output_type -> memos.api.v1.WorkspaceIntegrityReport
+	35, // 62: memos.api.v1.WorkspaceService.ReloadWorkspace:output_type -> memos.api.v1.ReloadWorkspaceResponse
+	38, // 63: memos.api.v1.WorkspaceService.ListJobs:output_type -> memos.api.v1.ListJobsResponse
+	36, // 64: memos.api.v1.WorkspaceService.GetJob:output_type -> memos.api.v1.Job
+	36, // 65: memos.api.v1.WorkspaceService.RetryJob:output_type -> memos.api.v1.Job
+	43, // 66: memos.api.v1.WorkspaceService.ListScheduledTasks:output_type -> memos.api.v1.ListScheduledTasksResponse
+	41, // 67: memos.api.v1.WorkspaceService.UpdateScheduledTask:output_type -> memos.api.v1.ScheduledTask
+	41, // 68: memos.api.v1.WorkspaceService.RunScheduledTask:output_type -> memos.api.v1.ScheduledTask
+	58, // [58:69] is the sub-list for method output_type
+	47, // [47:58] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_workspace_service_proto_init() }
@@ -1013,18 +4458,31 @@ func file_api_v1_workspace_service_proto_init() {
 	if File_api_v1_workspace_service_proto != nil {
 		return
 	}
+	file_api_v1_common_proto_init()
 	file_api_v1_workspace_service_proto_msgTypes[2].OneofWrappers = []any{
 		(*WorkspaceSetting_GeneralSetting)(nil),
 		(*WorkspaceSetting_StorageSetting)(nil),
 		(*WorkspaceSetting_MemoRelatedSetting)(nil),
+		(*WorkspaceSetting_EmbeddingSetting)(nil),
+		(*WorkspaceSetting_OcrSetting)(nil),
+		(*WorkspaceSetting_MalwareScanSetting)(nil),
+		(*WorkspaceSetting_TranscriptionSetting)(nil),
+		(*WorkspaceSetting_ScimSetting)(nil),
+		(*WorkspaceSetting_PasswordPolicySetting)(nil),
+		(*WorkspaceSetting_EmailSetting)(nil),
+		(*WorkspaceSetting_RolesSetting)(nil),
+		(*WorkspaceSetting_AccessTokenPolicySetting)(nil),
+		(*WorkspaceSetting_CaptchaSetting)(nil),
+		(*WorkspaceSetting_SlackSetting)(nil),
+		(*WorkspaceSetting_DiscordSetting)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_workspace_service_proto_rawDesc), len(file_api_v1_workspace_service_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      11,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,9 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	WorkspaceService_GetWorkspaceProfile_FullMethodName    = "/memos.api.v1.WorkspaceService/GetWorkspaceProfile"
-	WorkspaceService_GetWorkspaceSetting_FullMethodName    = "/memos.api.v1.WorkspaceService/GetWorkspaceSetting"
-	WorkspaceService_UpdateWorkspaceSetting_FullMethodName = "/memos.api.v1.WorkspaceService/UpdateWorkspaceSetting"
+	WorkspaceService_GetWorkspaceProfile_FullMethodName     = "/memos.api.v1.WorkspaceService/GetWorkspaceProfile"
+	WorkspaceService_GetWorkspaceSetting_FullMethodName     = "/memos.api.v1.WorkspaceService/GetWorkspaceSetting"
+	WorkspaceService_UpdateWorkspaceSetting_FullMethodName  = "/memos.api.v1.WorkspaceService/UpdateWorkspaceSetting"
+	WorkspaceService_CheckWorkspaceIntegrity_FullMethodName = "/memos.api.v1.WorkspaceService/CheckWorkspaceIntegrity"
+	WorkspaceService_ReloadWorkspace_FullMethodName         = "/memos.api.v1.WorkspaceService/ReloadWorkspace"
+	WorkspaceService_ListJobs_FullMethodName                = "/memos.api.v1.WorkspaceService/ListJobs"
+	WorkspaceService_GetJob_FullMethodName                  = "/memos.api.v1.WorkspaceService/GetJob"
+	WorkspaceService_RetryJob_FullMethodName                = "/memos.api.v1.WorkspaceService/RetryJob"
+	WorkspaceService_ListScheduledTasks_FullMethodName      = "/memos.api.v1.WorkspaceService/ListScheduledTasks"
+	WorkspaceService_UpdateScheduledTask_FullMethodName     = "/memos.api.v1.WorkspaceService/UpdateScheduledTask"
+	WorkspaceService_RunScheduledTask_FullMethodName        = "/memos.api.v1.WorkspaceService/RunScheduledTask"
 )
 
 // WorkspaceServiceClient is the client API for WorkspaceService service.
@@ -34,6 +42,23 @@ type WorkspaceServiceClient interface {
 	GetWorkspaceSetting(ctx context.Context, in *GetWorkspaceSettingRequest, opts ...grpc.CallOption) (*WorkspaceSetting, error)
 	// Updates a workspace setting.
 	UpdateWorkspaceSetting(ctx context.Context, in *UpdateWorkspaceSettingRequest, opts ...grpc.CallOption) (*WorkspaceSetting, error)
+	// Checks the data integrity of the workspace and optionally repairs it.
+	CheckWorkspaceIntegrity(ctx context.Context, in *CheckWorkspaceIntegrityRequest, opts ...grpc.CallOption) (*WorkspaceIntegrityReport, error)
+	// Reloads the settings of the workspace from the database, e.g. after they are edited by another instance,
+	// and optionally sets the level of the server logs, without restarting the server.
+	ReloadWorkspace(ctx context.Context, in *ReloadWorkspaceRequest, opts ...grpc.CallOption) (*ReloadWorkspaceResponse, error)
+	// Lists the background jobs of the workspace, the latest first.
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	// Gets a background job.
+	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*Job, error)
+	// Runs a failed background job again, with its attempts reset.
+	RetryJob(ctx context.Context, in *RetryJobRequest, opts ...grpc.CallOption) (*Job, error)
+	// Lists the scheduled maintenance tasks of the workspace, e.g. the cleanups and the database backups.
+	ListScheduledTasks(ctx context.Context, in *ListScheduledTasksRequest, opts ...grpc.CallOption) (*ListScheduledTasksResponse, error)
+	// Updates the schedule of a scheduled task, or disables it.
+	UpdateScheduledTask(ctx context.Context, in *UpdateScheduledTaskRequest, opts ...grpc.CallOption) (*ScheduledTask, error)
+	// Runs a scheduled task now, besides its schedule.
+	RunScheduledTask(ctx context.Context, in *RunScheduledTaskRequest, opts ...grpc.CallOption) (*ScheduledTask, error)
 }
 
 type workspaceServiceClient struct {
@@ -74,6 +99,86 @@ func (c *workspaceServiceClient) UpdateWorkspaceSetting(ctx context.Context, in
 	return out, nil
 }
 
+func (c *workspaceServiceClient) CheckWorkspaceIntegrity(ctx context.Context, in *CheckWorkspaceIntegrityRequest, opts ...grpc.CallOption) (*WorkspaceIntegrityReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkspaceIntegrityReport)
+	err := c.cc.Invoke(ctx, WorkspaceService_CheckWorkspaceIntegrity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) ReloadWorkspace(ctx context.Context, in *ReloadWorkspaceRequest, opts ...grpc.CallOption) (*ReloadWorkspaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReloadWorkspaceResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_ReloadWorkspace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_ListJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*Job, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Job)
+	err := c.cc.Invoke(ctx, WorkspaceService_GetJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) RetryJob(ctx context.Context, in *RetryJobRequest, opts ...grpc.CallOption) (*Job, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Job)
+	err := c.cc.Invoke(ctx, WorkspaceService_RetryJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) ListScheduledTasks(ctx context.Context, in *ListScheduledTasksRequest, opts ...grpc.CallOption) (*ListScheduledTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledTasksResponse)
+	err := c.cc.Invoke(ctx, WorkspaceService_ListScheduledTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) UpdateScheduledTask(ctx context.Context, in *UpdateScheduledTaskRequest, opts ...grpc.CallOption) (*ScheduledTask, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduledTask)
+	err := c.cc.Invoke(ctx, WorkspaceService_UpdateScheduledTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) RunScheduledTask(ctx context.Context, in *RunScheduledTaskRequest, opts ...grpc.CallOption) (*ScheduledTask, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduledTask)
+	err := c.cc.Invoke(ctx, WorkspaceService_RunScheduledTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkspaceServiceServer is the server API for WorkspaceService service.
 // All implementations must embed UnimplementedWorkspaceServiceServer
 // for forward compatibility.
@@ -84,6 +189,23 @@ type WorkspaceServiceServer interface {
 	GetWorkspaceSetting(context.Context, *GetWorkspaceSettingRequest) (*WorkspaceSetting, error)
 	// Updates a workspace setting.
 	UpdateWorkspaceSetting(context.Context, *UpdateWorkspaceSettingRequest) (*WorkspaceSetting, error)
+	// Checks the data integrity of the workspace and optionally repairs it.
+	CheckWorkspaceIntegrity(context.Context, *CheckWorkspaceIntegrityRequest) (*WorkspaceIntegrityReport, error)
+	// Reloads the settings of the workspace from the database, e.g. after they are edited by another instance,
+	// and optionally sets the level of the server logs, without restarting the server.
+	ReloadWorkspace(context.Context, *ReloadWorkspaceRequest) (*ReloadWorkspaceResponse, error)
+	// Lists the background jobs of the workspace, the latest first.
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
+	// Gets a background job.
+	GetJob(context.Context, *GetJobRequest) (*Job, error)
+	// Runs a failed background job again, with its attempts reset.
+	RetryJob(context.Context, *RetryJobRequest) (*Job, error)
+	// Lists the scheduled maintenance tasks of the workspace, e.g. the cleanups and the database backups.
+	ListScheduledTasks(context.Context, *ListScheduledTasksRequest) (*ListScheduledTasksResponse, error)
+	// Updates the schedule of a scheduled task, or disables it.
+	UpdateScheduledTask(context.Context, *UpdateScheduledTaskRequest) (*ScheduledTask, error)
+	// Runs a scheduled task now, besides its schedule.
+	RunScheduledTask(context.Context, *RunScheduledTaskRequest) (*ScheduledTask, error)
 	mustEmbedUnimplementedWorkspaceServiceServer()
 }
 
@@ -103,6 +225,30 @@ func (UnimplementedWorkspaceServiceServer) GetWorkspaceSetting(context.Context,
 func (UnimplementedWorkspaceServiceServer) UpdateWorkspaceSetting(context.Context, *UpdateWorkspaceSettingRequest) (*WorkspaceSetting, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkspaceSetting not implemented")
 }
+func (UnimplementedWorkspaceServiceServer) CheckWorkspaceIntegrity(context.Context, *CheckWorkspaceIntegrityRequest) (*WorkspaceIntegrityReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckWorkspaceIntegrity not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ReloadWorkspace(context.Context, *ReloadWorkspaceRequest) (*ReloadWorkspaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadWorkspace not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) GetJob(context.Context, *GetJobRequest) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJob not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) RetryJob(context.Context, *RetryJobRequest) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryJob not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ListScheduledTasks(context.Context, *ListScheduledTasksRequest) (*ListScheduledTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledTasks not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) UpdateScheduledTask(context.Context, *UpdateScheduledTaskRequest) (*ScheduledTask, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScheduledTask not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) RunScheduledTask(context.Context, *RunScheduledTaskRequest) (*ScheduledTask, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunScheduledTask not implemented")
+}
 func (UnimplementedWorkspaceServiceServer) mustEmbedUnimplementedWorkspaceServiceServer() {}
 func (UnimplementedWorkspaceServiceServer) testEmbeddedByValue()                          {}
 
@@ -178,6 +324,150 @@ func _WorkspaceService_UpdateWorkspaceSetting_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkspaceService_CheckWorkspaceIntegrity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckWorkspaceIntegrityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).CheckWorkspaceIntegrity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_CheckWorkspaceIntegrity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).CheckWorkspaceIntegrity(ctx, req.(*CheckWorkspaceIntegrityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_ReloadWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadWorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ReloadWorkspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_ReloadWorkspace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ReloadWorkspace(ctx, req.(*ReloadWorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_ListJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_GetJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).GetJob(ctx, req.(*GetJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_RetryJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).RetryJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_RetryJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).RetryJob(ctx, req.(*RetryJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_ListScheduledTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ListScheduledTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_ListScheduledTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ListScheduledTasks(ctx, req.(*ListScheduledTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_UpdateScheduledTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduledTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).UpdateScheduledTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_UpdateScheduledTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).UpdateScheduledTask(ctx, req.(*UpdateScheduledTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_RunScheduledTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunScheduledTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).RunScheduledTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkspaceService_RunScheduledTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).RunScheduledTask(ctx, req.(*RunScheduledTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorkspaceService_ServiceDesc is the grpc.ServiceDesc for WorkspaceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -197,6 +487,38 @@ var WorkspaceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateWorkspaceSetting",
 			Handler:    _WorkspaceService_UpdateWorkspaceSetting_Handler,
 		},
+		{
+			MethodName: "CheckWorkspaceIntegrity",
+			Handler:    _WorkspaceService_CheckWorkspaceIntegrity_Handler,
+		},
+		{
+			MethodName: "ReloadWorkspace",
+			Handler:    _WorkspaceService_ReloadWorkspace_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _WorkspaceService_ListJobs_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _WorkspaceService_GetJob_Handler,
+		},
+		{
+			MethodName: "RetryJob",
+			Handler:    _WorkspaceService_RetryJob_Handler,
+		},
+		{
+			MethodName: "ListScheduledTasks",
+			Handler:    _WorkspaceService_ListScheduledTasks_Handler,
+		},
+		{
+			MethodName: "UpdateScheduledTask",
+			Handler:    _WorkspaceService_UpdateScheduledTask_Handler,
+		},
+		{
+			MethodName: "RunScheduledTask",
+			Handler:    _WorkspaceService_RunScheduledTask_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/workspace_service.proto",
@@ -9,6 +9,7 @@ package store
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -73,16 +74,248 @@ func (x *ActivityMemoCommentPayload) GetRelatedMemoId() int32 {
 	return 0
 }
 
-type ActivityPayload struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	MemoComment   *ActivityMemoCommentPayload `protobuf:"bytes,1,opt,name=memo_comment,json=memoComment,proto3" json:"memo_comment,omitempty"`
+type ActivityMemoMentionPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the memo mentioning the user, who is the receiver of the inbox message.
+	MemoId        int32 `protobuf:"varint,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoMentionPayload) Reset() {
+	*x = ActivityMemoMentionPayload{}
+	mi := &file_store_activity_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoMentionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoMentionPayload) ProtoMessage() {}
+
+func (x *ActivityMemoMentionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoMentionPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoMentionPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ActivityMemoMentionPayload) GetMemoId() int32 {
+	if x != nil {
+		return x.MemoId
+	}
+	return 0
+}
+
+type ActivityMemoReactionPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the memo reacted to, whose creator is the receiver of the inbox message.
+	MemoId        int32  `protobuf:"varint,1,opt,name=memo_id,json=memoId,proto3" json:"memo_id,omitempty"`
+	ReactionType  string `protobuf:"bytes,2,opt,name=reaction_type,json=reactionType,proto3" json:"reaction_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityMemoReactionPayload) Reset() {
+	*x = ActivityMemoReactionPayload{}
+	mi := &file_store_activity_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityMemoReactionPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityMemoReactionPayload) ProtoMessage() {}
+
+func (x *ActivityMemoReactionPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityMemoReactionPayload.ProtoReflect.Descriptor instead.
+func (*ActivityMemoReactionPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ActivityMemoReactionPayload) GetMemoId() int32 {
+	if x != nil {
+		return x.MemoId
+	}
+	return 0
+}
+
+func (x *ActivityMemoReactionPayload) GetReactionType() string {
+	if x != nil {
+		return x.ReactionType
+	}
+	return ""
+}
+
+type ActivityUserImpersonationPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the impersonated user. The creator of the activity is the host.
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *ActivityUserImpersonationPayload) Reset() {
+	*x = ActivityUserImpersonationPayload{}
+	mi := &file_store_activity_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityUserImpersonationPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityUserImpersonationPayload) ProtoMessage() {}
+
+func (x *ActivityUserImpersonationPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityUserImpersonationPayload.ProtoReflect.Descriptor instead.
+func (*ActivityUserImpersonationPayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ActivityUserImpersonationPayload) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ActivityUserImpersonationPayload) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ActivityUserImpersonationPayload) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type ActivityUserDeletePayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the deleted user. The creator of the activity is the user who deleted the account.
+	UserId          int32 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MemoCount       int32 `protobuf:"varint,2,opt,name=memo_count,json=memoCount,proto3" json:"memo_count,omitempty"`
+	AttachmentCount int32 `protobuf:"varint,3,opt,name=attachment_count,json=attachmentCount,proto3" json:"attachment_count,omitempty"`
+	ReactionCount   int32 `protobuf:"varint,4,opt,name=reaction_count,json=reactionCount,proto3" json:"reaction_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ActivityUserDeletePayload) Reset() {
+	*x = ActivityUserDeletePayload{}
+	mi := &file_store_activity_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityUserDeletePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityUserDeletePayload) ProtoMessage() {}
+
+func (x *ActivityUserDeletePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_activity_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityUserDeletePayload.ProtoReflect.Descriptor instead.
+func (*ActivityUserDeletePayload) Descriptor() ([]byte, []int) {
+	return file_store_activity_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ActivityUserDeletePayload) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ActivityUserDeletePayload) GetMemoCount() int32 {
+	if x != nil {
+		return x.MemoCount
+	}
+	return 0
+}
+
+func (x *ActivityUserDeletePayload) GetAttachmentCount() int32 {
+	if x != nil {
+		return x.AttachmentCount
+	}
+	return 0
+}
+
+func (x *ActivityUserDeletePayload) GetReactionCount() int32 {
+	if x != nil {
+		return x.ReactionCount
+	}
+	return 0
+}
+
+type ActivityPayload struct {
+	state             protoimpl.MessageState            `protogen:"open.v1"`
+	MemoComment       *ActivityMemoCommentPayload       `protobuf:"bytes,1,opt,name=memo_comment,json=memoComment,proto3" json:"memo_comment,omitempty"`
+	UserImpersonation *ActivityUserImpersonationPayload `protobuf:"bytes,2,opt,name=user_impersonation,json=userImpersonation,proto3" json:"user_impersonation,omitempty"`
+	UserDelete        *ActivityUserDeletePayload        `protobuf:"bytes,3,opt,name=user_delete,json=userDelete,proto3" json:"user_delete,omitempty"`
+	MemoMention       *ActivityMemoMentionPayload       `protobuf:"bytes,4,opt,name=memo_mention,json=memoMention,proto3" json:"memo_mention,omitempty"`
+	MemoReaction      *ActivityMemoReactionPayload      `protobuf:"bytes,5,opt,name=memo_reaction,json=memoReaction,proto3" json:"memo_reaction,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
 func (x *ActivityPayload) Reset() {
 	*x = ActivityPayload{}
-	mi := &file_store_activity_proto_msgTypes[1]
+	mi := &file_store_activity_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -94,7 +327,7 @@ func (x *ActivityPayload) String() string {
 func (*ActivityPayload) ProtoMessage() {}
 
 func (x *ActivityPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_store_activity_proto_msgTypes[1]
+	mi := &file_store_activity_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -107,7 +340,7 @@ func (x *ActivityPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActivityPayload.ProtoReflect.Descriptor instead.
 func (*ActivityPayload) Descriptor() ([]byte, []int) {
-	return file_store_activity_proto_rawDescGZIP(), []int{1}
+	return file_store_activity_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ActivityPayload) GetMemoComment() *ActivityMemoCommentPayload {
@@ -117,16 +350,66 @@ func (x *ActivityPayload) GetMemoComment() *ActivityMemoCommentPayload {
 	return nil
 }
 
+func (x *ActivityPayload) GetUserImpersonation() *ActivityUserImpersonationPayload {
+	if x != nil {
+		return x.UserImpersonation
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetUserDelete() *ActivityUserDeletePayload {
+	if x != nil {
+		return x.UserDelete
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoMention() *ActivityMemoMentionPayload {
+	if x != nil {
+		return x.MemoMention
+	}
+	return nil
+}
+
+func (x *ActivityPayload) GetMemoReaction() *ActivityMemoReactionPayload {
+	if x != nil {
+		return x.MemoReaction
+	}
+	return nil
+}
+
 var File_store_activity_proto protoreflect.FileDescriptor
 
 const file_store_activity_proto_rawDesc = "" +
 	"\n" +
-	"\x14store/activity.proto\x12\vmemos.store\"]\n" +
+	"\x14store/activity.proto\x12\vmemos.store\x1a\x1fgoogle/protobuf/timestamp.proto\"]\n" +
 	"\x1aActivityMemoCommentPayload\x12\x17\n" +
 	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\x12&\n" +
-	"\x0frelated_memo_id\x18\x02 \x01(\x05R\rrelatedMemoId\"]\n" +
+	"\x0frelated_memo_id\x18\x02 \x01(\x05R\rrelatedMemoId\"5\n" +
+	"\x1aActivityMemoMentionPayload\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\"[\n" +
+	"\x1bActivityMemoReactionPayload\x12\x17\n" +
+	"\amemo_id\x18\x01 \x01(\x05R\x06memoId\x12#\n" +
+	"\rreaction_type\x18\x02 \x01(\tR\freactionType\"\x97\x01\n" +
+	" ActivityUserImpersonationPayload\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12;\n" +
+	"\vexpire_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\"\xa5\x01\n" +
+	"\x19ActivityUserDeletePayload\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1d\n" +
+	"\n" +
+	"memo_count\x18\x02 \x01(\x05R\tmemoCount\x12)\n" +
+	"\x10attachment_count\x18\x03 \x01(\x05R\x0fattachmentCount\x12%\n" +
+	"\x0ereaction_count\x18\x04 \x01(\x05R\rreactionCount\"\x9f\x03\n" +
 	"\x0fActivityPayload\x12J\n" +
-	"\fmemo_comment\x18\x01 \x01(\v2'.memos.store.ActivityMemoCommentPayloadR\vmemoCommentB\x98\x01\n" +
+	"\fmemo_comment\x18\x01 \x01(\v2'.memos.store.ActivityMemoCommentPayloadR\vmemoComment\x12\\\n" +
+	"\x12user_impersonation\x18\x02 \x01(\v2-.memos.store.ActivityUserImpersonationPayloadR\x11userImpersonation\x12G\n" +
+	"\vuser_delete\x18\x03 \x01(\v2&.memos.store.ActivityUserDeletePayloadR\n" +
+	"userDelete\x12J\n" +
+	"\fmemo_mention\x18\x04 \x01(\v2'.memos.store.ActivityMemoMentionPayloadR\vmemoMention\x12M\n" +
+	"\rmemo_reaction\x18\x05 \x01(\v2(.memos.store.ActivityMemoReactionPayloadR\fmemoReactionB\x98\x01\n" +
 	"\x0fcom.memos.storeB\rActivityProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -141,18 +424,28 @@ func file_store_activity_proto_rawDescGZIP() []byte {
 	return file_store_activity_proto_rawDescData
 }
 
-var file_store_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_activity_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_store_activity_proto_goTypes = []any{
-	(*ActivityMemoCommentPayload)(nil), // 0: memos.store.ActivityMemoCommentPayload
-	(*ActivityPayload)(nil),            // 1: memos.store.ActivityPayload
+	(*ActivityMemoCommentPayload)(nil),       // 0: memos.store.ActivityMemoCommentPayload
+	(*ActivityMemoMentionPayload)(nil),       // 1: memos.store.ActivityMemoMentionPayload
+	(*ActivityMemoReactionPayload)(nil),      // 2: memos.store.ActivityMemoReactionPayload
+	(*ActivityUserImpersonationPayload)(nil), // 3: memos.store.ActivityUserImpersonationPayload
+	(*ActivityUserDeletePayload)(nil),        // 4: memos.store.ActivityUserDeletePayload
+	(*ActivityPayload)(nil),                  // 5: memos.store.ActivityPayload
+	(*timestamppb.Timestamp)(nil),            // 6: google.protobuf.Timestamp
 }
 var file_store_activity_proto_depIdxs = []int32{
-	0, // 0: memos.store.ActivityPayload.memo_comment:type_name -> memos.store.ActivityMemoCommentPayload
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	6, // 0: memos.store.ActivityUserImpersonationPayload.expire_time:type_name -> google.protobuf.Timestamp
+	0, // 1: memos.store.ActivityPayload.memo_comment:type_name -> memos.store.ActivityMemoCommentPayload
+	3, // 2: memos.store.ActivityPayload.user_impersonation:type_name -> memos.store.ActivityUserImpersonationPayload
+	4, // 3: memos.store.ActivityPayload.user_delete:type_name -> memos.store.ActivityUserDeletePayload
+	1, // 4: memos.store.ActivityPayload.memo_mention:type_name -> memos.store.ActivityMemoMentionPayload
+	2, // 5: memos.store.ActivityPayload.memo_reaction:type_name -> memos.store.ActivityMemoReactionPayload
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_store_activity_proto_init() }
@@ -166,7 +459,7 @@ func file_store_activity_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_activity_proto_rawDesc), len(file_store_activity_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -32,6 +32,10 @@ const (
 	AttachmentStorageType_S3 AttachmentStorageType = 2
 	// Attachment is stored in an external storage. The reference is a URL.
 	AttachmentStorageType_EXTERNAL AttachmentStorageType = 3
+	// Attachment is stored in Google Cloud Storage.
+	AttachmentStorageType_GCS AttachmentStorageType = 4
+	// Attachment is stored on a SFTP server.
+	AttachmentStorageType_SFTP AttachmentStorageType = 5
 )
 
 // Enum value maps for AttachmentStorageType.
@@ -41,12 +45,16 @@ var (
 		1: "LOCAL",
 		2: "S3",
 		3: "EXTERNAL",
+		4: "GCS",
+		5: "SFTP",
 	}
 	AttachmentStorageType_value = map[string]int32{
 		"ATTACHMENT_STORAGE_TYPE_UNSPECIFIED": 0,
 		"LOCAL":                               1,
 		"S3":                                  2,
 		"EXTERNAL":                            3,
+		"GCS":                                 4,
+		"SFTP":                                5,
 	}
 )
 
@@ -82,9 +90,14 @@ type AttachmentPayload struct {
 	// Types that are valid to be assigned to Payload:
 	//
 	//	*AttachmentPayload_S3Object_
-	Payload       isAttachmentPayload_Payload `protobuf_oneof:"payload"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	//	*AttachmentPayload_GcsObject
+	//	*AttachmentPayload_SftpObject
+	Payload isAttachmentPayload_Payload `protobuf_oneof:"payload"`
+	// corruption_detected_time is the time the integrity runner found the content not matching its hash.
+	// It is cleared once the content matches again.
+	CorruptionDetectedTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=corruption_detected_time,json=corruptionDetectedTime,proto3" json:"corruption_detected_time,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *AttachmentPayload) Reset() {
@@ -133,6 +146,31 @@ func (x *AttachmentPayload) GetS3Object() *AttachmentPayload_S3Object {
 	return nil
 }
 
+func (x *AttachmentPayload) GetGcsObject() *AttachmentPayload_GCSObject {
+	if x != nil {
+		if x, ok := x.Payload.(*AttachmentPayload_GcsObject); ok {
+			return x.GcsObject
+		}
+	}
+	return nil
+}
+
+func (x *AttachmentPayload) GetSftpObject() *AttachmentPayload_SFTPObject {
+	if x != nil {
+		if x, ok := x.Payload.(*AttachmentPayload_SftpObject); ok {
+			return x.SftpObject
+		}
+	}
+	return nil
+}
+
+func (x *AttachmentPayload) GetCorruptionDetectedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CorruptionDetectedTime
+	}
+	return nil
+}
+
 type isAttachmentPayload_Payload interface {
 	isAttachmentPayload_Payload()
 }
@@ -141,8 +179,20 @@ type AttachmentPayload_S3Object_ struct {
 	S3Object *AttachmentPayload_S3Object `protobuf:"bytes,1,opt,name=s3_object,json=s3Object,proto3,oneof"`
 }
 
+type AttachmentPayload_GcsObject struct {
+	GcsObject *AttachmentPayload_GCSObject `protobuf:"bytes,2,opt,name=gcs_object,json=gcsObject,proto3,oneof"`
+}
+
+type AttachmentPayload_SftpObject struct {
+	SftpObject *AttachmentPayload_SFTPObject `protobuf:"bytes,3,opt,name=sftp_object,json=sftpObject,proto3,oneof"`
+}
+
 func (*AttachmentPayload_S3Object_) isAttachmentPayload_Payload() {}
 
+func (*AttachmentPayload_GcsObject) isAttachmentPayload_Payload() {}
+
+func (*AttachmentPayload_SftpObject) isAttachmentPayload_Payload() {}
+
 type AttachmentPayload_S3Object struct {
 	state    protoimpl.MessageState `protogen:"open.v1"`
 	S3Config *StorageS3Config       `protobuf:"bytes,1,opt,name=s3_config,json=s3Config,proto3" json:"s3_config,omitempty"`
@@ -206,23 +256,156 @@ func (x *AttachmentPayload_S3Object) GetLastPresignedTime() *timestamppb.Timesta
 	return nil
 }
 
+type AttachmentPayload_GCSObject struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	GcsConfig *StorageGCSConfig      `protobuf:"bytes,1,opt,name=gcs_config,json=gcsConfig,proto3" json:"gcs_config,omitempty"`
+	// key is the GCS object name.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// last_signed_time is the last time the object URL was signed.
+	// This is used to determine if the signed URL is still valid.
+	LastSignedTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_signed_time,json=lastSignedTime,proto3" json:"last_signed_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AttachmentPayload_GCSObject) Reset() {
+	*x = AttachmentPayload_GCSObject{}
+	mi := &file_store_attachment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentPayload_GCSObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentPayload_GCSObject) ProtoMessage() {}
+
+func (x *AttachmentPayload_GCSObject) ProtoReflect() protoreflect.Message {
+	mi := &file_store_attachment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentPayload_GCSObject.ProtoReflect.Descriptor instead.
+func (*AttachmentPayload_GCSObject) Descriptor() ([]byte, []int) {
+	return file_store_attachment_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *AttachmentPayload_GCSObject) GetGcsConfig() *StorageGCSConfig {
+	if x != nil {
+		return x.GcsConfig
+	}
+	return nil
+}
+
+func (x *AttachmentPayload_GCSObject) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AttachmentPayload_GCSObject) GetLastSignedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSignedTime
+	}
+	return nil
+}
+
+type AttachmentPayload_SFTPObject struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	SftpConfig *StorageSFTPConfig     `protobuf:"bytes,1,opt,name=sftp_config,json=sftpConfig,proto3" json:"sftp_config,omitempty"`
+	// path is the path of the file on the server.
+	Path          string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentPayload_SFTPObject) Reset() {
+	*x = AttachmentPayload_SFTPObject{}
+	mi := &file_store_attachment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentPayload_SFTPObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentPayload_SFTPObject) ProtoMessage() {}
+
+func (x *AttachmentPayload_SFTPObject) ProtoReflect() protoreflect.Message {
+	mi := &file_store_attachment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentPayload_SFTPObject.ProtoReflect.Descriptor instead.
+func (*AttachmentPayload_SFTPObject) Descriptor() ([]byte, []int) {
+	return file_store_attachment_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *AttachmentPayload_SFTPObject) GetSftpConfig() *StorageSFTPConfig {
+	if x != nil {
+		return x.SftpConfig
+	}
+	return nil
+}
+
+func (x *AttachmentPayload_SFTPObject) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
 var File_store_attachment_proto protoreflect.FileDescriptor
 
 const file_store_attachment_proto_rawDesc = "" +
 	"\n" +
-	"\x16store/attachment.proto\x12\vmemos.store\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1dstore/workspace_setting.proto\"\x8c\x02\n" +
+	"\x16store/attachment.proto\x12\vmemos.store\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1dstore/workspace_setting.proto\"\x82\x06\n" +
 	"\x11AttachmentPayload\x12F\n" +
-	"\ts3_object\x18\x01 \x01(\v2'.memos.store.AttachmentPayload.S3ObjectH\x00R\bs3Object\x1a\xa3\x01\n" +
+	"\ts3_object\x18\x01 \x01(\v2'.memos.store.AttachmentPayload.S3ObjectH\x00R\bs3Object\x12I\n" +
+	"\n" +
+	"gcs_object\x18\x02 \x01(\v2(.memos.store.AttachmentPayload.GCSObjectH\x00R\tgcsObject\x12L\n" +
+	"\vsftp_object\x18\x03 \x01(\v2).memos.store.AttachmentPayload.SFTPObjectH\x00R\n" +
+	"sftpObject\x12T\n" +
+	"\x18corruption_detected_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x16corruptionDetectedTime\x1a\xa3\x01\n" +
 	"\bS3Object\x129\n" +
 	"\ts3_config\x18\x01 \x01(\v2\x1c.memos.store.StorageS3ConfigR\bs3Config\x12\x10\n" +
 	"\x03key\x18\x02 \x01(\tR\x03key\x12J\n" +
-	"\x13last_presigned_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x11lastPresignedTimeB\t\n" +
-	"\apayload*a\n" +
+	"\x13last_presigned_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x11lastPresignedTime\x1a\xa1\x01\n" +
+	"\tGCSObject\x12<\n" +
+	"\n" +
+	"gcs_config\x18\x01 \x01(\v2\x1d.memos.store.StorageGCSConfigR\tgcsConfig\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12D\n" +
+	"\x10last_signed_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastSignedTime\x1aa\n" +
+	"\n" +
+	"SFTPObject\x12?\n" +
+	"\vsftp_config\x18\x01 \x01(\v2\x1e.memos.store.StorageSFTPConfigR\n" +
+	"sftpConfig\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04pathB\t\n" +
+	"\apayload*t\n" +
 	"\x15AttachmentStorageType\x12'\n" +
 	"#ATTACHMENT_STORAGE_TYPE_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05LOCAL\x10\x01\x12\x06\n" +
 	"\x02S3\x10\x02\x12\f\n" +
-	"\bEXTERNAL\x10\x03B\x9a\x01\n" +
+	"\bEXTERNAL\x10\x03\x12\a\n" +
+	"\x03GCS\x10\x04\x12\b\n" +
+	"\x04SFTP\x10\x05B\x9a\x01\n" +
 	"\x0fcom.memos.storeB\x0fAttachmentProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -238,23 +421,33 @@ func file_store_attachment_proto_rawDescGZIP() []byte {
 }
 
 var file_store_attachment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_attachment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_attachment_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_store_attachment_proto_goTypes = []any{
-	(AttachmentStorageType)(0),         // 0: memos.store.AttachmentStorageType
-	(*AttachmentPayload)(nil),          // 1: memos.store.AttachmentPayload
-	(*AttachmentPayload_S3Object)(nil), // 2: memos.store.AttachmentPayload.S3Object
-	(*StorageS3Config)(nil),            // 3: memos.store.StorageS3Config
-	(*timestamppb.Timestamp)(nil),      // 4: google.protobuf.Timestamp
+	(AttachmentStorageType)(0),           // 0: memos.store.AttachmentStorageType
+	(*AttachmentPayload)(nil),            // 1: memos.store.AttachmentPayload
+	(*AttachmentPayload_S3Object)(nil),   // 2: memos.store.AttachmentPayload.S3Object
+	(*AttachmentPayload_GCSObject)(nil),  // 3: memos.store.AttachmentPayload.GCSObject
+	(*AttachmentPayload_SFTPObject)(nil), // 4: memos.store.AttachmentPayload.SFTPObject
+	(*timestamppb.Timestamp)(nil),        // 5: google.protobuf.Timestamp
+	(*StorageS3Config)(nil),              // 6: memos.store.StorageS3Config
+	(*StorageGCSConfig)(nil),             // 7: memos.store.StorageGCSConfig
+	(*StorageSFTPConfig)(nil),            // 8: memos.store.StorageSFTPConfig
 }
 var file_store_attachment_proto_depIdxs = []int32{
 	2, // 0: memos.store.AttachmentPayload.s3_object:type_name -> memos.store.AttachmentPayload.S3Object
-	3, // 1: memos.store.AttachmentPayload.S3Object.s3_config:type_name -> memos.store.StorageS3Config
-	4, // 2: memos.store.AttachmentPayload.S3Object.last_presigned_time:type_name -> google.protobuf.Timestamp
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	3, // 1: memos.store.AttachmentPayload.gcs_object:type_name -> memos.store.AttachmentPayload.GCSObject
+	4, // 2: memos.store.AttachmentPayload.sftp_object:type_name -> memos.store.AttachmentPayload.SFTPObject
+	5, // 3: memos.store.AttachmentPayload.corruption_detected_time:type_name -> google.protobuf.Timestamp
+	6, // 4: memos.store.AttachmentPayload.S3Object.s3_config:type_name -> memos.store.StorageS3Config
+	5, // 5: memos.store.AttachmentPayload.S3Object.last_presigned_time:type_name -> google.protobuf.Timestamp
+	7, // 6: memos.store.AttachmentPayload.GCSObject.gcs_config:type_name -> memos.store.StorageGCSConfig
+	5, // 7: memos.store.AttachmentPayload.GCSObject.last_signed_time:type_name -> google.protobuf.Timestamp
+	8, // 8: memos.store.AttachmentPayload.SFTPObject.sftp_config:type_name -> memos.store.StorageSFTPConfig
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_store_attachment_proto_init() }
@@ -265,6 +458,8 @@ func file_store_attachment_proto_init() {
 	file_store_workspace_setting_proto_init()
 	file_store_attachment_proto_msgTypes[0].OneofWrappers = []any{
 		(*AttachmentPayload_S3Object_)(nil),
+		(*AttachmentPayload_GcsObject)(nil),
+		(*AttachmentPayload_SftpObject)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -272,7 +467,7 @@ func file_store_attachment_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_attachment_proto_rawDesc), len(file_store_attachment_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
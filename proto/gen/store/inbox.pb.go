@@ -24,9 +24,13 @@ const (
 type InboxMessage_Type int32
 
 const (
-	InboxMessage_TYPE_UNSPECIFIED InboxMessage_Type = 0
-	InboxMessage_MEMO_COMMENT     InboxMessage_Type = 1
-	InboxMessage_VERSION_UPDATE   InboxMessage_Type = 2
+	InboxMessage_TYPE_UNSPECIFIED   InboxMessage_Type = 0
+	InboxMessage_MEMO_COMMENT       InboxMessage_Type = 1
+	InboxMessage_VERSION_UPDATE     InboxMessage_Type = 2
+	InboxMessage_MEMO_MENTION       InboxMessage_Type = 3
+	InboxMessage_MEMO_ON_THIS_DAY   InboxMessage_Type = 4
+	InboxMessage_MEMO_REACTION      InboxMessage_Type = 5
+	InboxMessage_MEMO_COMMENT_REPLY InboxMessage_Type = 6
 )
 
 // Enum value maps for InboxMessage_Type.
@@ -35,11 +39,19 @@ var (
 		0: "TYPE_UNSPECIFIED",
 		1: "MEMO_COMMENT",
 		2: "VERSION_UPDATE",
+		3: "MEMO_MENTION",
+		4: "MEMO_ON_THIS_DAY",
+		5: "MEMO_REACTION",
+		6: "MEMO_COMMENT_REPLY",
 	}
 	InboxMessage_Type_value = map[string]int32{
-		"TYPE_UNSPECIFIED": 0,
-		"MEMO_COMMENT":     1,
-		"VERSION_UPDATE":   2,
+		"TYPE_UNSPECIFIED":   0,
+		"MEMO_COMMENT":       1,
+		"VERSION_UPDATE":     2,
+		"MEMO_MENTION":       3,
+		"MEMO_ON_THIS_DAY":   4,
+		"MEMO_REACTION":      5,
+		"MEMO_COMMENT_REPLY": 6,
 	}
 )
 
@@ -71,9 +83,14 @@ func (InboxMessage_Type) EnumDescriptor() ([]byte, []int) {
 }
 
 type InboxMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          InboxMessage_Type      `protobuf:"varint,1,opt,name=type,proto3,enum=memos.store.InboxMessage_Type" json:"type,omitempty"`
-	ActivityId    *int32                 `protobuf:"varint,2,opt,name=activity_id,json=activityId,proto3,oneof" json:"activity_id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  InboxMessage_Type      `protobuf:"varint,1,opt,name=type,proto3,enum=memos.store.InboxMessage_Type" json:"type,omitempty"`
+	// The activity of the latest event of the message.
+	ActivityId *int32 `protobuf:"varint,2,opt,name=activity_id,json=activityId,proto3,oneof" json:"activity_id,omitempty"`
+	// The memo the message is about. The unread messages of the same type about the same memo are aggregated into one.
+	MemoId *int32 `protobuf:"varint,3,opt,name=memo_id,json=memoId,proto3,oneof" json:"memo_id,omitempty"`
+	// The number of events aggregated into the message, 1 if 0.
+	Count         int32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -122,20 +139,42 @@ func (x *InboxMessage) GetActivityId() int32 {
 	return 0
 }
 
+func (x *InboxMessage) GetMemoId() int32 {
+	if x != nil && x.MemoId != nil {
+		return *x.MemoId
+	}
+	return 0
+}
+
+func (x *InboxMessage) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 var File_store_inbox_proto protoreflect.FileDescriptor
 
 const file_store_inbox_proto_rawDesc = "" +
 	"\n" +
-	"\x11store/inbox.proto\x12\vmemos.store\"\xbc\x01\n" +
+	"\x11store/inbox.proto\x12\vmemos.store\"\xd0\x02\n" +
 	"\fInboxMessage\x122\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x1e.memos.store.InboxMessage.TypeR\x04type\x12$\n" +
 	"\vactivity_id\x18\x02 \x01(\x05H\x00R\n" +
-	"activityId\x88\x01\x01\"B\n" +
+	"activityId\x88\x01\x01\x12\x1c\n" +
+	"\amemo_id\x18\x03 \x01(\x05H\x01R\x06memoId\x88\x01\x01\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\"\x95\x01\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
 	"\fMEMO_COMMENT\x10\x01\x12\x12\n" +
-	"\x0eVERSION_UPDATE\x10\x02B\x0e\n" +
-	"\f_activity_idB\x95\x01\n" +
+	"\x0eVERSION_UPDATE\x10\x02\x12\x10\n" +
+	"\fMEMO_MENTION\x10\x03\x12\x14\n" +
+	"\x10MEMO_ON_THIS_DAY\x10\x04\x12\x11\n" +
+	"\rMEMO_REACTION\x10\x05\x12\x16\n" +
+	"\x12MEMO_COMMENT_REPLY\x10\x06B\x0e\n" +
+	"\f_activity_idB\n" +
+	"\n" +
+	"\b_memo_idB\x95\x01\n" +
 	"\x0fcom.memos.storeB\n" +
 	"InboxProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
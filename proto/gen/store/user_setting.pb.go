@@ -36,25 +36,101 @@ const (
 	UserSetting_SHORTCUTS UserSetting_Key = 4
 	// The webhooks of the user.
 	UserSetting_WEBHOOKS UserSetting_Key = 5
+	// The tag metadata of the user.
+	UserSetting_TAGS UserSetting_Key = 6
+	// The saved searches of the user.
+	UserSetting_SAVED_SEARCHES UserSetting_Key = 7
+	// The filter macros of the user.
+	UserSetting_FILTER_MACROS UserSetting_Key = 8
+	// The two-factor authentication of the user.
+	UserSetting_TWO_FACTOR UserSetting_Key = 9
+	// The usage of the access tokens of the user.
+	UserSetting_ACCESS_TOKEN_USAGES UserSetting_Key = 10
+	// The suspension of the user.
+	UserSetting_SUSPENSION UserSetting_Key = 11
+	// The password state of the user.
+	UserSetting_PASSWORD UserSetting_Key = 12
+	// The email verification of the user.
+	UserSetting_EMAIL_VERIFICATION UserSetting_Key = 13
+	// The custom role of the user.
+	UserSetting_CUSTOM_ROLE UserSetting_Key = 14
+	// The address receiving the memos emailed by the user.
+	UserSetting_MEMO_EMAIL UserSetting_Key = 15
+	// The Slack account of the user capturing memos with the slash command.
+	UserSetting_SLACK UserSetting_Key = 16
+	// The Discord account of the user saving memos with the bot.
+	UserSetting_DISCORD UserSetting_Key = 17
+	// The token of the calendar feed of the user.
+	UserSetting_CALENDAR_FEED UserSetting_Key = 18
+	// The Readwise account of the user whose highlights are synced as memos.
+	UserSetting_READWISE UserSetting_Key = 19
+	// The Web Push subscriptions of the browsers of the user.
+	UserSetting_WEB_PUSH_SUBSCRIPTIONS UserSetting_Key = 20
+	// The GitHub account of the user whose gists mirror the memos tagged #gist.
+	UserSetting_GIST_SYNC UserSetting_Key = 21
+	// The channels notifying the user of each event.
+	UserSetting_NOTIFICATION_PREFERENCES UserSetting_Key = 22
+	// The schedule of the email digest of the user.
+	UserSetting_EMAIL_DIGEST UserSetting_Key = 23
+	// The daily notification of the memos of the user created on this day in past years.
+	UserSetting_ON_THIS_DAY UserSetting_Key = 24
 )
 
 // Enum value maps for UserSetting_Key.
 var (
 	UserSetting_Key_name = map[int32]string{
-		0: "KEY_UNSPECIFIED",
-		1: "GENERAL",
-		2: "SESSIONS",
-		3: "ACCESS_TOKENS",
-		4: "SHORTCUTS",
-		5: "WEBHOOKS",
+		0:  "KEY_UNSPECIFIED",
+		1:  "GENERAL",
+		2:  "SESSIONS",
+		3:  "ACCESS_TOKENS",
+		4:  "SHORTCUTS",
+		5:  "WEBHOOKS",
+		6:  "TAGS",
+		7:  "SAVED_SEARCHES",
+		8:  "FILTER_MACROS",
+		9:  "TWO_FACTOR",
+		10: "ACCESS_TOKEN_USAGES",
+		11: "SUSPENSION",
+		12: "PASSWORD",
+		13: "EMAIL_VERIFICATION",
+		14: "CUSTOM_ROLE",
+		15: "MEMO_EMAIL",
+		16: "SLACK",
+		17: "DISCORD",
+		18: "CALENDAR_FEED",
+		19: "READWISE",
+		20: "WEB_PUSH_SUBSCRIPTIONS",
+		21: "GIST_SYNC",
+		22: "NOTIFICATION_PREFERENCES",
+		23: "EMAIL_DIGEST",
+		24: "ON_THIS_DAY",
 	}
 	UserSetting_Key_value = map[string]int32{
-		"KEY_UNSPECIFIED": 0,
-		"GENERAL":         1,
-		"SESSIONS":        2,
-		"ACCESS_TOKENS":   3,
-		"SHORTCUTS":       4,
-		"WEBHOOKS":        5,
+		"KEY_UNSPECIFIED":          0,
+		"GENERAL":                  1,
+		"SESSIONS":                 2,
+		"ACCESS_TOKENS":            3,
+		"SHORTCUTS":                4,
+		"WEBHOOKS":                 5,
+		"TAGS":                     6,
+		"SAVED_SEARCHES":           7,
+		"FILTER_MACROS":            8,
+		"TWO_FACTOR":               9,
+		"ACCESS_TOKEN_USAGES":      10,
+		"SUSPENSION":               11,
+		"PASSWORD":                 12,
+		"EMAIL_VERIFICATION":       13,
+		"CUSTOM_ROLE":              14,
+		"MEMO_EMAIL":               15,
+		"SLACK":                    16,
+		"DISCORD":                  17,
+		"CALENDAR_FEED":            18,
+		"READWISE":                 19,
+		"WEB_PUSH_SUBSCRIPTIONS":   20,
+		"GIST_SYNC":                21,
+		"NOTIFICATION_PREFERENCES": 22,
+		"EMAIL_DIGEST":             23,
+		"ON_THIS_DAY":              24,
 	}
 )
 
@@ -85,6 +161,178 @@ func (UserSetting_Key) EnumDescriptor() ([]byte, []int) {
 	return file_store_user_setting_proto_rawDescGZIP(), []int{0, 0}
 }
 
+type ShortcutsUserSetting_Visibility int32
+
+const (
+	ShortcutsUserSetting_VISIBILITY_UNSPECIFIED ShortcutsUserSetting_Visibility = 0
+	// Only the owner reads the shortcut.
+	ShortcutsUserSetting_PRIVATE ShortcutsUserSetting_Visibility = 1
+	// The owner and the shared users read the shortcut.
+	ShortcutsUserSetting_SHARED ShortcutsUserSetting_Visibility = 2
+	// Every user of the workspace reads the shortcut, only admins publish shortcuts.
+	ShortcutsUserSetting_WORKSPACE ShortcutsUserSetting_Visibility = 3
+)
+
+// Enum value maps for ShortcutsUserSetting_Visibility.
+var (
+	ShortcutsUserSetting_Visibility_name = map[int32]string{
+		0: "VISIBILITY_UNSPECIFIED",
+		1: "PRIVATE",
+		2: "SHARED",
+		3: "WORKSPACE",
+	}
+	ShortcutsUserSetting_Visibility_value = map[string]int32{
+		"VISIBILITY_UNSPECIFIED": 0,
+		"PRIVATE":                1,
+		"SHARED":                 2,
+		"WORKSPACE":              3,
+	}
+)
+
+func (x ShortcutsUserSetting_Visibility) Enum() *ShortcutsUserSetting_Visibility {
+	p := new(ShortcutsUserSetting_Visibility)
+	*p = x
+	return p
+}
+
+func (x ShortcutsUserSetting_Visibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShortcutsUserSetting_Visibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_user_setting_proto_enumTypes[1].Descriptor()
+}
+
+func (ShortcutsUserSetting_Visibility) Type() protoreflect.EnumType {
+	return &file_store_user_setting_proto_enumTypes[1]
+}
+
+func (x ShortcutsUserSetting_Visibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShortcutsUserSetting_Visibility.Descriptor instead.
+func (ShortcutsUserSetting_Visibility) EnumDescriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type NotificationPreferencesUserSetting_Event int32
+
+const (
+	NotificationPreferencesUserSetting_EVENT_UNSPECIFIED NotificationPreferencesUserSetting_Event = 0
+	// A comment on a memo of the user.
+	NotificationPreferencesUserSetting_COMMENT NotificationPreferencesUserSetting_Event = 1
+	// A reaction to a memo of the user.
+	NotificationPreferencesUserSetting_REACTION NotificationPreferencesUserSetting_Event = 2
+	// A mention of the user in a memo.
+	NotificationPreferencesUserSetting_MENTION NotificationPreferencesUserSetting_Event = 3
+	// A reminder set by the user.
+	NotificationPreferencesUserSetting_REMINDER NotificationPreferencesUserSetting_Event = 4
+	// An announcement of the instance, such as a new version.
+	NotificationPreferencesUserSetting_ANNOUNCEMENT NotificationPreferencesUserSetting_Event = 5
+	// The memos of the user created on this day in past years.
+	NotificationPreferencesUserSetting_ON_THIS_DAY NotificationPreferencesUserSetting_Event = 6
+)
+
+// Enum value maps for NotificationPreferencesUserSetting_Event.
+var (
+	NotificationPreferencesUserSetting_Event_name = map[int32]string{
+		0: "EVENT_UNSPECIFIED",
+		1: "COMMENT",
+		2: "REACTION",
+		3: "MENTION",
+		4: "REMINDER",
+		5: "ANNOUNCEMENT",
+		6: "ON_THIS_DAY",
+	}
+	NotificationPreferencesUserSetting_Event_value = map[string]int32{
+		"EVENT_UNSPECIFIED": 0,
+		"COMMENT":           1,
+		"REACTION":          2,
+		"MENTION":           3,
+		"REMINDER":          4,
+		"ANNOUNCEMENT":      5,
+		"ON_THIS_DAY":       6,
+	}
+)
+
+func (x NotificationPreferencesUserSetting_Event) Enum() *NotificationPreferencesUserSetting_Event {
+	p := new(NotificationPreferencesUserSetting_Event)
+	*p = x
+	return p
+}
+
+func (x NotificationPreferencesUserSetting_Event) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationPreferencesUserSetting_Event) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_user_setting_proto_enumTypes[2].Descriptor()
+}
+
+func (NotificationPreferencesUserSetting_Event) Type() protoreflect.EnumType {
+	return &file_store_user_setting_proto_enumTypes[2]
+}
+
+func (x NotificationPreferencesUserSetting_Event) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationPreferencesUserSetting_Event.Descriptor instead.
+func (NotificationPreferencesUserSetting_Event) EnumDescriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{22, 0}
+}
+
+type EmailDigestUserSetting_Frequency int32
+
+const (
+	// The digest is disabled.
+	EmailDigestUserSetting_FREQUENCY_UNSPECIFIED EmailDigestUserSetting_Frequency = 0
+	EmailDigestUserSetting_DAILY                 EmailDigestUserSetting_Frequency = 1
+	EmailDigestUserSetting_WEEKLY                EmailDigestUserSetting_Frequency = 2
+)
+
+// Enum value maps for EmailDigestUserSetting_Frequency.
+var (
+	EmailDigestUserSetting_Frequency_name = map[int32]string{
+		0: "FREQUENCY_UNSPECIFIED",
+		1: "DAILY",
+		2: "WEEKLY",
+	}
+	EmailDigestUserSetting_Frequency_value = map[string]int32{
+		"FREQUENCY_UNSPECIFIED": 0,
+		"DAILY":                 1,
+		"WEEKLY":                2,
+	}
+)
+
+func (x EmailDigestUserSetting_Frequency) Enum() *EmailDigestUserSetting_Frequency {
+	p := new(EmailDigestUserSetting_Frequency)
+	*p = x
+	return p
+}
+
+func (x EmailDigestUserSetting_Frequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmailDigestUserSetting_Frequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_user_setting_proto_enumTypes[3].Descriptor()
+}
+
+func (EmailDigestUserSetting_Frequency) Type() protoreflect.EnumType {
+	return &file_store_user_setting_proto_enumTypes[3]
+}
+
+func (x EmailDigestUserSetting_Frequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmailDigestUserSetting_Frequency.Descriptor instead.
+func (EmailDigestUserSetting_Frequency) EnumDescriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{23, 0}
+}
+
 type UserSetting struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
 	UserId int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -96,6 +344,25 @@ type UserSetting struct {
 	//	*UserSetting_AccessTokens
 	//	*UserSetting_Shortcuts
 	//	*UserSetting_Webhooks
+	//	*UserSetting_Tags
+	//	*UserSetting_SavedSearches
+	//	*UserSetting_FilterMacros
+	//	*UserSetting_TwoFactor
+	//	*UserSetting_AccessTokenUsages
+	//	*UserSetting_Suspension
+	//	*UserSetting_Password
+	//	*UserSetting_EmailVerification
+	//	*UserSetting_CustomRole
+	//	*UserSetting_MemoEmail
+	//	*UserSetting_Slack
+	//	*UserSetting_Discord
+	//	*UserSetting_CalendarFeed
+	//	*UserSetting_Readwise
+	//	*UserSetting_WebPushSubscriptions
+	//	*UserSetting_GistSync
+	//	*UserSetting_NotificationPreferences
+	//	*UserSetting_EmailDigest
+	//	*UserSetting_OnThisDay
 	Value         isUserSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -197,6 +464,177 @@ func (x *UserSetting) GetWebhooks() *WebhooksUserSetting {
 	return nil
 }
 
+func (x *UserSetting) GetTags() *TagsUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Tags); ok {
+			return x.Tags
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetSavedSearches() *SavedSearchesUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_SavedSearches); ok {
+			return x.SavedSearches
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetFilterMacros() *FilterMacrosUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_FilterMacros); ok {
+			return x.FilterMacros
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetTwoFactor() *TwoFactorUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_TwoFactor); ok {
+			return x.TwoFactor
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetAccessTokenUsages() *AccessTokenUsagesUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_AccessTokenUsages); ok {
+			return x.AccessTokenUsages
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetSuspension() *SuspensionUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Suspension); ok {
+			return x.Suspension
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetPassword() *PasswordUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Password); ok {
+			return x.Password
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetEmailVerification() *EmailVerificationUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_EmailVerification); ok {
+			return x.EmailVerification
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetCustomRole() *CustomRoleUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_CustomRole); ok {
+			return x.CustomRole
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetMemoEmail() *MemoEmailUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_MemoEmail); ok {
+			return x.MemoEmail
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetSlack() *SlackUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Slack); ok {
+			return x.Slack
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetDiscord() *DiscordUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Discord); ok {
+			return x.Discord
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetCalendarFeed() *CalendarFeedUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_CalendarFeed); ok {
+			return x.CalendarFeed
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetReadwise() *ReadwiseUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_Readwise); ok {
+			return x.Readwise
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetWebPushSubscriptions() *WebPushSubscriptionsUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_WebPushSubscriptions); ok {
+			return x.WebPushSubscriptions
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetGistSync() *GistSyncUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_GistSync); ok {
+			return x.GistSync
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetNotificationPreferences() *NotificationPreferencesUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_NotificationPreferences); ok {
+			return x.NotificationPreferences
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetEmailDigest() *EmailDigestUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_EmailDigest); ok {
+			return x.EmailDigest
+		}
+	}
+	return nil
+}
+
+func (x *UserSetting) GetOnThisDay() *OnThisDayUserSetting {
+	if x != nil {
+		if x, ok := x.Value.(*UserSetting_OnThisDay); ok {
+			return x.OnThisDay
+		}
+	}
+	return nil
+}
+
 type isUserSetting_Value interface {
 	isUserSetting_Value()
 }
@@ -221,6 +659,82 @@ type UserSetting_Webhooks struct {
 	Webhooks *WebhooksUserSetting `protobuf:"bytes,7,opt,name=webhooks,proto3,oneof"`
 }
 
+type UserSetting_Tags struct {
+	Tags *TagsUserSetting `protobuf:"bytes,8,opt,name=tags,proto3,oneof"`
+}
+
+type UserSetting_SavedSearches struct {
+	SavedSearches *SavedSearchesUserSetting `protobuf:"bytes,9,opt,name=saved_searches,json=savedSearches,proto3,oneof"`
+}
+
+type UserSetting_FilterMacros struct {
+	FilterMacros *FilterMacrosUserSetting `protobuf:"bytes,10,opt,name=filter_macros,json=filterMacros,proto3,oneof"`
+}
+
+type UserSetting_TwoFactor struct {
+	TwoFactor *TwoFactorUserSetting `protobuf:"bytes,11,opt,name=two_factor,json=twoFactor,proto3,oneof"`
+}
+
+type UserSetting_AccessTokenUsages struct {
+	AccessTokenUsages *AccessTokenUsagesUserSetting `protobuf:"bytes,12,opt,name=access_token_usages,json=accessTokenUsages,proto3,oneof"`
+}
+
+type UserSetting_Suspension struct {
+	Suspension *SuspensionUserSetting `protobuf:"bytes,13,opt,name=suspension,proto3,oneof"`
+}
+
+type UserSetting_Password struct {
+	Password *PasswordUserSetting `protobuf:"bytes,14,opt,name=password,proto3,oneof"`
+}
+
+type UserSetting_EmailVerification struct {
+	EmailVerification *EmailVerificationUserSetting `protobuf:"bytes,15,opt,name=email_verification,json=emailVerification,proto3,oneof"`
+}
+
+type UserSetting_CustomRole struct {
+	CustomRole *CustomRoleUserSetting `protobuf:"bytes,16,opt,name=custom_role,json=customRole,proto3,oneof"`
+}
+
+type UserSetting_MemoEmail struct {
+	MemoEmail *MemoEmailUserSetting `protobuf:"bytes,17,opt,name=memo_email,json=memoEmail,proto3,oneof"`
+}
+
+type UserSetting_Slack struct {
+	Slack *SlackUserSetting `protobuf:"bytes,18,opt,name=slack,proto3,oneof"`
+}
+
+type UserSetting_Discord struct {
+	Discord *DiscordUserSetting `protobuf:"bytes,19,opt,name=discord,proto3,oneof"`
+}
+
+type UserSetting_CalendarFeed struct {
+	CalendarFeed *CalendarFeedUserSetting `protobuf:"bytes,20,opt,name=calendar_feed,json=calendarFeed,proto3,oneof"`
+}
+
+type UserSetting_Readwise struct {
+	Readwise *ReadwiseUserSetting `protobuf:"bytes,21,opt,name=readwise,proto3,oneof"`
+}
+
+type UserSetting_WebPushSubscriptions struct {
+	WebPushSubscriptions *WebPushSubscriptionsUserSetting `protobuf:"bytes,22,opt,name=web_push_subscriptions,json=webPushSubscriptions,proto3,oneof"`
+}
+
+type UserSetting_GistSync struct {
+	GistSync *GistSyncUserSetting `protobuf:"bytes,23,opt,name=gist_sync,json=gistSync,proto3,oneof"`
+}
+
+type UserSetting_NotificationPreferences struct {
+	NotificationPreferences *NotificationPreferencesUserSetting `protobuf:"bytes,24,opt,name=notification_preferences,json=notificationPreferences,proto3,oneof"`
+}
+
+type UserSetting_EmailDigest struct {
+	EmailDigest *EmailDigestUserSetting `protobuf:"bytes,25,opt,name=email_digest,json=emailDigest,proto3,oneof"`
+}
+
+type UserSetting_OnThisDay struct {
+	OnThisDay *OnThisDayUserSetting `protobuf:"bytes,26,opt,name=on_this_day,json=onThisDay,proto3,oneof"`
+}
+
 func (*UserSetting_General) isUserSetting_Value() {}
 
 func (*UserSetting_Sessions) isUserSetting_Value() {}
@@ -231,6 +745,44 @@ func (*UserSetting_Shortcuts) isUserSetting_Value() {}
 
 func (*UserSetting_Webhooks) isUserSetting_Value() {}
 
+func (*UserSetting_Tags) isUserSetting_Value() {}
+
+func (*UserSetting_SavedSearches) isUserSetting_Value() {}
+
+func (*UserSetting_FilterMacros) isUserSetting_Value() {}
+
+func (*UserSetting_TwoFactor) isUserSetting_Value() {}
+
+func (*UserSetting_AccessTokenUsages) isUserSetting_Value() {}
+
+func (*UserSetting_Suspension) isUserSetting_Value() {}
+
+func (*UserSetting_Password) isUserSetting_Value() {}
+
+func (*UserSetting_EmailVerification) isUserSetting_Value() {}
+
+func (*UserSetting_CustomRole) isUserSetting_Value() {}
+
+func (*UserSetting_MemoEmail) isUserSetting_Value() {}
+
+func (*UserSetting_Slack) isUserSetting_Value() {}
+
+func (*UserSetting_Discord) isUserSetting_Value() {}
+
+func (*UserSetting_CalendarFeed) isUserSetting_Value() {}
+
+func (*UserSetting_Readwise) isUserSetting_Value() {}
+
+func (*UserSetting_WebPushSubscriptions) isUserSetting_Value() {}
+
+func (*UserSetting_GistSync) isUserSetting_Value() {}
+
+func (*UserSetting_NotificationPreferences) isUserSetting_Value() {}
+
+func (*UserSetting_EmailDigest) isUserSetting_Value() {}
+
+func (*UserSetting_OnThisDay) isUserSetting_Value() {}
+
 type GeneralUserSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The user's locale.
@@ -241,9 +793,13 @@ type GeneralUserSetting struct {
 	MemoVisibility string `protobuf:"bytes,3,opt,name=memo_visibility,json=memoVisibility,proto3" json:"memo_visibility,omitempty"`
 	// The user's theme preference.
 	// This references a CSS file in the web/public/themes/ directory.
-	Theme         string `protobuf:"bytes,4,opt,name=theme,proto3" json:"theme,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Theme string `protobuf:"bytes,4,opt,name=theme,proto3" json:"theme,omitempty"`
+	// The user's setting to strip the EXIF metadata of the uploaded images.
+	StripImageMetadata bool `protobuf:"varint,5,opt,name=strip_image_metadata,json=stripImageMetadata,proto3" json:"strip_image_metadata,omitempty"`
+	// The user's setting to keep the EXIF orientation of the stripped images.
+	KeepImageOrientation bool `protobuf:"varint,6,opt,name=keep_image_orientation,json=keepImageOrientation,proto3" json:"keep_image_orientation,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *GeneralUserSetting) Reset() {
@@ -304,11 +860,25 @@ func (x *GeneralUserSetting) GetTheme() string {
 	return ""
 }
 
-type SessionsUserSetting struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Sessions      []*SessionsUserSetting_Session `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GeneralUserSetting) GetStripImageMetadata() bool {
+	if x != nil {
+		return x.StripImageMetadata
+	}
+	return false
+}
+
+func (x *GeneralUserSetting) GetKeepImageOrientation() bool {
+	if x != nil {
+		return x.KeepImageOrientation
+	}
+	return false
+}
+
+type SessionsUserSetting struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Sessions      []*SessionsUserSetting_Session `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SessionsUserSetting) Reset() {
@@ -392,6 +962,51 @@ func (x *AccessTokensUserSetting) GetAccessTokens() []*AccessTokensUserSetting_A
 	return nil
 }
 
+// The usage is stored apart from the access tokens, so that counting the requests never rewrites the tokens.
+type AccessTokenUsagesUserSetting struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Usages        []*AccessTokenUsagesUserSetting_Usage `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessTokenUsagesUserSetting) Reset() {
+	*x = AccessTokenUsagesUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessTokenUsagesUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessTokenUsagesUserSetting) ProtoMessage() {}
+
+func (x *AccessTokenUsagesUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessTokenUsagesUserSetting.ProtoReflect.Descriptor instead.
+func (*AccessTokenUsagesUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AccessTokenUsagesUserSetting) GetUsages() []*AccessTokenUsagesUserSetting_Usage {
+	if x != nil {
+		return x.Usages
+	}
+	return nil
+}
+
 type ShortcutsUserSetting struct {
 	state         protoimpl.MessageState           `protogen:"open.v1"`
 	Shortcuts     []*ShortcutsUserSetting_Shortcut `protobuf:"bytes,1,rep,name=shortcuts,proto3" json:"shortcuts,omitempty"`
@@ -401,7 +1016,7 @@ type ShortcutsUserSetting struct {
 
 func (x *ShortcutsUserSetting) Reset() {
 	*x = ShortcutsUserSetting{}
-	mi := &file_store_user_setting_proto_msgTypes[4]
+	mi := &file_store_user_setting_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -413,7 +1028,234 @@ func (x *ShortcutsUserSetting) String() string {
 func (*ShortcutsUserSetting) ProtoMessage() {}
 
 func (x *ShortcutsUserSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[4]
+	mi := &file_store_user_setting_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutsUserSetting.ProtoReflect.Descriptor instead.
+func (*ShortcutsUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ShortcutsUserSetting) GetShortcuts() []*ShortcutsUserSetting_Shortcut {
+	if x != nil {
+		return x.Shortcuts
+	}
+	return nil
+}
+
+type WebhooksUserSetting struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Webhooks      []*WebhooksUserSetting_Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhooksUserSetting) Reset() {
+	*x = WebhooksUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhooksUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhooksUserSetting) ProtoMessage() {}
+
+func (x *WebhooksUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhooksUserSetting.ProtoReflect.Descriptor instead.
+func (*WebhooksUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WebhooksUserSetting) GetWebhooks() []*WebhooksUserSetting_Webhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+type TagsUserSetting struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*TagsUserSetting_Tag `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagsUserSetting) Reset() {
+	*x = TagsUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagsUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagsUserSetting) ProtoMessage() {}
+
+func (x *TagsUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagsUserSetting.ProtoReflect.Descriptor instead.
+func (*TagsUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TagsUserSetting) GetTags() []*TagsUserSetting_Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type SavedSearchesUserSetting struct {
+	state         protoimpl.MessageState                  `protogen:"open.v1"`
+	SavedSearches []*SavedSearchesUserSetting_SavedSearch `protobuf:"bytes,1,rep,name=saved_searches,json=savedSearches,proto3" json:"saved_searches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedSearchesUserSetting) Reset() {
+	*x = SavedSearchesUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearchesUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearchesUserSetting) ProtoMessage() {}
+
+func (x *SavedSearchesUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearchesUserSetting.ProtoReflect.Descriptor instead.
+func (*SavedSearchesUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SavedSearchesUserSetting) GetSavedSearches() []*SavedSearchesUserSetting_SavedSearch {
+	if x != nil {
+		return x.SavedSearches
+	}
+	return nil
+}
+
+type FilterMacrosUserSetting struct {
+	state         protoimpl.MessageState                 `protogen:"open.v1"`
+	FilterMacros  []*FilterMacrosUserSetting_FilterMacro `protobuf:"bytes,1,rep,name=filter_macros,json=filterMacros,proto3" json:"filter_macros,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterMacrosUserSetting) Reset() {
+	*x = FilterMacrosUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterMacrosUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterMacrosUserSetting) ProtoMessage() {}
+
+func (x *FilterMacrosUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterMacrosUserSetting.ProtoReflect.Descriptor instead.
+func (*FilterMacrosUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FilterMacrosUserSetting) GetFilterMacros() []*FilterMacrosUserSetting_FilterMacro {
+	if x != nil {
+		return x.FilterMacros
+	}
+	return nil
+}
+
+type TwoFactorUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The base32 encoded TOTP secret.
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Whether the two-factor authentication is enabled, once a code of the secret is verified.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The SHA-256 hashes of the unused recovery codes.
+	RecoveryCodeHashes []string `protobuf:"bytes,3,rep,name=recovery_code_hashes,json=recoveryCodeHashes,proto3" json:"recovery_code_hashes,omitempty"`
+	// The time step of the last verified code, so that a code is not used twice.
+	LastUsedStep  int64 `protobuf:"varint,4,opt,name=last_used_step,json=lastUsedStep,proto3" json:"last_used_step,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TwoFactorUserSetting) Reset() {
+	*x = TwoFactorUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TwoFactorUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TwoFactorUserSetting) ProtoMessage() {}
+
+func (x *TwoFactorUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -421,43 +1263,1378 @@ func (x *ShortcutsUserSetting) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TwoFactorUserSetting.ProtoReflect.Descriptor instead.
+func (*TwoFactorUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TwoFactorUserSetting) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *TwoFactorUserSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *TwoFactorUserSetting) GetRecoveryCodeHashes() []string {
+	if x != nil {
+		return x.RecoveryCodeHashes
+	}
+	return nil
+}
+
+func (x *TwoFactorUserSetting) GetLastUsedStep() int64 {
+	if x != nil {
+		return x.LastUsedStep
+	}
+	return 0
+}
+
+type SuspensionUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the user is suspended, and so can neither sign in nor use the API.
+	Suspended bool `protobuf:"varint,1,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	// Optional. The reason shown to the user when signing in.
+	Reason      string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	SuspendTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=suspend_time,json=suspendTime,proto3" json:"suspend_time,omitempty"`
+	// The ID of the admin who suspended the user.
+	SuspenderId   int32 `protobuf:"varint,4,opt,name=suspender_id,json=suspenderId,proto3" json:"suspender_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspensionUserSetting) Reset() {
+	*x = SuspensionUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspensionUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspensionUserSetting) ProtoMessage() {}
+
+func (x *SuspensionUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspensionUserSetting.ProtoReflect.Descriptor instead.
+func (*SuspensionUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SuspensionUserSetting) GetSuspended() bool {
+	if x != nil {
+		return x.Suspended
+	}
+	return false
+}
+
+func (x *SuspensionUserSetting) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SuspensionUserSetting) GetSuspendTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SuspendTime
+	}
+	return nil
+}
+
+func (x *SuspensionUserSetting) GetSuspenderId() int32 {
+	if x != nil {
+		return x.SuspenderId
+	}
+	return 0
+}
+
+type PasswordUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the user must change their password, set by an admin, before using the API.
+	ChangeRequired bool `protobuf:"varint,1,opt,name=change_required,json=changeRequired,proto3" json:"change_required,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PasswordUserSetting) Reset() {
+	*x = PasswordUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordUserSetting) ProtoMessage() {}
+
+func (x *PasswordUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordUserSetting.ProtoReflect.Descriptor instead.
+func (*PasswordUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PasswordUserSetting) GetChangeRequired() bool {
+	if x != nil {
+		return x.ChangeRequired
+	}
+	return false
+}
+
+type EmailVerificationUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the user signed up and has not verified their email yet, and so cannot sign in with their password.
+	Pending       bool `protobuf:"varint,1,opt,name=pending,proto3" json:"pending,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmailVerificationUserSetting) Reset() {
+	*x = EmailVerificationUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailVerificationUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailVerificationUserSetting) ProtoMessage() {}
+
+func (x *EmailVerificationUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailVerificationUserSetting.ProtoReflect.Descriptor instead.
+func (*EmailVerificationUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EmailVerificationUserSetting) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+type CustomRoleUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the workspace custom role granting permissions to the user, on top of their built-in role.
+	RoleId        string `protobuf:"bytes,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomRoleUserSetting) Reset() {
+	*x = CustomRoleUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomRoleUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomRoleUserSetting) ProtoMessage() {}
+
+func (x *CustomRoleUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomRoleUserSetting.ProtoReflect.Descriptor instead.
+func (*CustomRoleUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CustomRoleUserSetting) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+type MemoEmailUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The random local part of the address receiving the memos emailed by the user, disabled if empty.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoEmailUserSetting) Reset() {
+	*x = MemoEmailUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoEmailUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoEmailUserSetting) ProtoMessage() {}
+
+func (x *MemoEmailUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoEmailUserSetting.ProtoReflect.Descriptor instead.
+func (*MemoEmailUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MemoEmailUserSetting) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type SlackUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The Slack workspace and user IDs of the linked account, unlinked if empty.
+	TeamId string `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The code linking a Slack account with the slash command, until it expires.
+	LinkCode           string                 `protobuf:"bytes,3,opt,name=link_code,json=linkCode,proto3" json:"link_code,omitempty"`
+	LinkCodeExpireTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=link_code_expire_time,json=linkCodeExpireTime,proto3" json:"link_code_expire_time,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SlackUserSetting) Reset() {
+	*x = SlackUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlackUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlackUserSetting) ProtoMessage() {}
+
+func (x *SlackUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlackUserSetting.ProtoReflect.Descriptor instead.
+func (*SlackUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SlackUserSetting) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *SlackUserSetting) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SlackUserSetting) GetLinkCode() string {
+	if x != nil {
+		return x.LinkCode
+	}
+	return ""
+}
+
+func (x *SlackUserSetting) GetLinkCodeExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinkCodeExpireTime
+	}
+	return nil
+}
+
+type DiscordUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The Discord user ID of the linked account, unlinked if empty.
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// The code linking a Discord account with the command of the bot, until it expires.
+	LinkCode           string                 `protobuf:"bytes,2,opt,name=link_code,json=linkCode,proto3" json:"link_code,omitempty"`
+	LinkCodeExpireTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=link_code_expire_time,json=linkCodeExpireTime,proto3" json:"link_code_expire_time,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DiscordUserSetting) Reset() {
+	*x = DiscordUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscordUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscordUserSetting) ProtoMessage() {}
+
+func (x *DiscordUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscordUserSetting.ProtoReflect.Descriptor instead.
+func (*DiscordUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DiscordUserSetting) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DiscordUserSetting) GetLinkCode() string {
+	if x != nil {
+		return x.LinkCode
+	}
+	return ""
+}
+
+func (x *DiscordUserSetting) GetLinkCodeExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinkCodeExpireTime
+	}
+	return nil
+}
+
+type CalendarFeedUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The random token of the URL of the calendar feed, disabled if empty.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarFeedUserSetting) Reset() {
+	*x = CalendarFeedUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarFeedUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarFeedUserSetting) ProtoMessage() {}
+
+func (x *CalendarFeedUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarFeedUserSetting.ProtoReflect.Descriptor instead.
+func (*CalendarFeedUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CalendarFeedUserSetting) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ReadwiseUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The access token of the Readwise account, disconnected if empty.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The tag of the memos of the highlights, "readwise" if empty.
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	// The start time of the last successful sync, the next one fetching the books updated since then.
+	LastSyncTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_sync_time,json=lastSyncTime,proto3" json:"last_sync_time,omitempty"`
+	// The error of the last sync, empty if it succeeded.
+	LastSyncError string `protobuf:"bytes,4,opt,name=last_sync_error,json=lastSyncError,proto3" json:"last_sync_error,omitempty"`
+	// The UIDs of the memos of the books, by Readwise book ID.
+	BookMemos     map[int64]string `protobuf:"bytes,5,rep,name=book_memos,json=bookMemos,proto3" json:"book_memos,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadwiseUserSetting) Reset() {
+	*x = ReadwiseUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadwiseUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadwiseUserSetting) ProtoMessage() {}
+
+func (x *ReadwiseUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadwiseUserSetting.ProtoReflect.Descriptor instead.
+func (*ReadwiseUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReadwiseUserSetting) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ReadwiseUserSetting) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ReadwiseUserSetting) GetLastSyncTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSyncTime
+	}
+	return nil
+}
+
+func (x *ReadwiseUserSetting) GetLastSyncError() string {
+	if x != nil {
+		return x.LastSyncError
+	}
+	return ""
+}
+
+func (x *ReadwiseUserSetting) GetBookMemos() map[int64]string {
+	if x != nil {
+		return x.BookMemos
+	}
+	return nil
+}
+
+type WebPushSubscriptionsUserSetting struct {
+	state         protoimpl.MessageState                          `protogen:"open.v1"`
+	Subscriptions []*WebPushSubscriptionsUserSetting_Subscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebPushSubscriptionsUserSetting) Reset() {
+	*x = WebPushSubscriptionsUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebPushSubscriptionsUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebPushSubscriptionsUserSetting) ProtoMessage() {}
+
+func (x *WebPushSubscriptionsUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebPushSubscriptionsUserSetting.ProtoReflect.Descriptor instead.
+func (*WebPushSubscriptionsUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *WebPushSubscriptionsUserSetting) GetSubscriptions() []*WebPushSubscriptionsUserSetting_Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type GistSyncUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The personal access token of the GitHub account with the gist scope, disconnected if empty.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The time of the last successful sync.
+	LastSyncTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_sync_time,json=lastSyncTime,proto3" json:"last_sync_time,omitempty"`
+	// The error of the last sync, empty if it succeeded.
+	LastSyncError string `protobuf:"bytes,3,opt,name=last_sync_error,json=lastSyncError,proto3" json:"last_sync_error,omitempty"`
+	// The gists of the memos, by memo UID.
+	MemoGists     map[string]*GistSyncUserSetting_MemoGist `protobuf:"bytes,4,rep,name=memo_gists,json=memoGists,proto3" json:"memo_gists,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GistSyncUserSetting) Reset() {
+	*x = GistSyncUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GistSyncUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GistSyncUserSetting) ProtoMessage() {}
+
+func (x *GistSyncUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GistSyncUserSetting.ProtoReflect.Descriptor instead.
+func (*GistSyncUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GistSyncUserSetting) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GistSyncUserSetting) GetLastSyncTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSyncTime
+	}
+	return nil
+}
+
+func (x *GistSyncUserSetting) GetLastSyncError() string {
+	if x != nil {
+		return x.LastSyncError
+	}
+	return ""
+}
+
+func (x *GistSyncUserSetting) GetMemoGists() map[string]*GistSyncUserSetting_MemoGist {
+	if x != nil {
+		return x.MemoGists
+	}
+	return nil
+}
+
+type NotificationPreferencesUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The preferences of the events, the events without one using the default channels.
+	Preferences   []*NotificationPreferencesUserSetting_Preference `protobuf:"bytes,1,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationPreferencesUserSetting) Reset() {
+	*x = NotificationPreferencesUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreferencesUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreferencesUserSetting) ProtoMessage() {}
+
+func (x *NotificationPreferencesUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreferencesUserSetting.ProtoReflect.Descriptor instead.
+func (*NotificationPreferencesUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *NotificationPreferencesUserSetting) GetPreferences() []*NotificationPreferencesUserSetting_Preference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type EmailDigestUserSetting struct {
+	state     protoimpl.MessageState           `protogen:"open.v1"`
+	Frequency EmailDigestUserSetting_Frequency `protobuf:"varint,1,opt,name=frequency,proto3,enum=memos.store.EmailDigestUserSetting_Frequency" json:"frequency,omitempty"`
+	// The hour of the day the digest is sent at, from 0 to 23.
+	Hour int32 `protobuf:"varint,2,opt,name=hour,proto3" json:"hour,omitempty"`
+	// The day of the week the weekly digest is sent on, from 0 for Sunday to 6 for Saturday.
+	Weekday int32 `protobuf:"varint,3,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	// The IANA time zone of the hour and the day, UTC if empty.
+	TimeZone string `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// The scheduled time of the last digest, the next one being sent at the following scheduled time.
+	LastSendTime  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_send_time,json=lastSendTime,proto3" json:"last_send_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmailDigestUserSetting) Reset() {
+	*x = EmailDigestUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailDigestUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailDigestUserSetting) ProtoMessage() {}
+
+func (x *EmailDigestUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailDigestUserSetting.ProtoReflect.Descriptor instead.
+func (*EmailDigestUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *EmailDigestUserSetting) GetFrequency() EmailDigestUserSetting_Frequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return EmailDigestUserSetting_FREQUENCY_UNSPECIFIED
+}
+
+func (x *EmailDigestUserSetting) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *EmailDigestUserSetting) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *EmailDigestUserSetting) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *EmailDigestUserSetting) GetLastSendTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSendTime
+	}
+	return nil
+}
+
+type OnThisDayUserSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the user is notified daily of their memos created on this day in past years.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The hour of the day the user is notified at, from 0 to 23.
+	Hour int32 `protobuf:"varint,2,opt,name=hour,proto3" json:"hour,omitempty"`
+	// The IANA time zone of the hour and the day, UTC if empty.
+	TimeZone string `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// The scheduled time of the last notification, the next one being sent on the following day.
+	LastNotifyTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_notify_time,json=lastNotifyTime,proto3" json:"last_notify_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OnThisDayUserSetting) Reset() {
+	*x = OnThisDayUserSetting{}
+	mi := &file_store_user_setting_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnThisDayUserSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnThisDayUserSetting) ProtoMessage() {}
+
+func (x *OnThisDayUserSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnThisDayUserSetting.ProtoReflect.Descriptor instead.
+func (*OnThisDayUserSetting) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *OnThisDayUserSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *OnThisDayUserSetting) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *OnThisDayUserSetting) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *OnThisDayUserSetting) GetLastNotifyTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastNotifyTime
+	}
+	return nil
+}
+
+type SessionsUserSetting_Session struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique session identifier.
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Timestamp when the session was created.
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Timestamp when the session was last accessed.
+	// Used for sliding expiration calculation (last_accessed_time + 2 weeks).
+	LastAccessedTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_accessed_time,json=lastAccessedTime,proto3" json:"last_accessed_time,omitempty"`
+	// Client information associated with this session.
+	ClientInfo *SessionsUserSetting_ClientInfo `protobuf:"bytes,4,opt,name=client_info,json=clientInfo,proto3" json:"client_info,omitempty"`
+	// The ID of the host who impersonates the user with this session, 0 for the own sessions of the user.
+	ImpersonatorId int32 `protobuf:"varint,5,opt,name=impersonator_id,json=impersonatorId,proto3" json:"impersonator_id,omitempty"`
+	// Optional. The fixed expiration of the session, set for the impersonation sessions.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionsUserSetting_Session) Reset() {
+	*x = SessionsUserSetting_Session{}
+	mi := &file_store_user_setting_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionsUserSetting_Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionsUserSetting_Session) ProtoMessage() {}
+
+func (x *SessionsUserSetting_Session) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionsUserSetting_Session.ProtoReflect.Descriptor instead.
+func (*SessionsUserSetting_Session) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *SessionsUserSetting_Session) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionsUserSetting_Session) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *SessionsUserSetting_Session) GetLastAccessedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessedTime
+	}
+	return nil
+}
+
+func (x *SessionsUserSetting_Session) GetClientInfo() *SessionsUserSetting_ClientInfo {
+	if x != nil {
+		return x.ClientInfo
+	}
+	return nil
+}
+
+func (x *SessionsUserSetting_Session) GetImpersonatorId() int32 {
+	if x != nil {
+		return x.ImpersonatorId
+	}
+	return 0
+}
+
+func (x *SessionsUserSetting_Session) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type SessionsUserSetting_ClientInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User agent string of the client.
+	UserAgent string `protobuf:"bytes,1,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	// IP address of the client.
+	IpAddress string `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	// Optional. Device type (e.g., "mobile", "desktop", "tablet").
+	DeviceType string `protobuf:"bytes,3,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
+	// Optional. Operating system (e.g., "iOS 17.0", "Windows 11").
+	Os string `protobuf:"bytes,4,opt,name=os,proto3" json:"os,omitempty"`
+	// Optional. Browser name and version (e.g., "Chrome 119.0").
+	Browser       string `protobuf:"bytes,5,opt,name=browser,proto3" json:"browser,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionsUserSetting_ClientInfo) Reset() {
+	*x = SessionsUserSetting_ClientInfo{}
+	mi := &file_store_user_setting_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionsUserSetting_ClientInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionsUserSetting_ClientInfo) ProtoMessage() {}
+
+func (x *SessionsUserSetting_ClientInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionsUserSetting_ClientInfo.ProtoReflect.Descriptor instead.
+func (*SessionsUserSetting_ClientInfo) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *SessionsUserSetting_ClientInfo) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *SessionsUserSetting_ClientInfo) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *SessionsUserSetting_ClientInfo) GetDeviceType() string {
+	if x != nil {
+		return x.DeviceType
+	}
+	return ""
+}
+
+func (x *SessionsUserSetting_ClientInfo) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *SessionsUserSetting_ClientInfo) GetBrowser() string {
+	if x != nil {
+		return x.Browser
+	}
+	return ""
+}
+
+type AccessTokensUserSetting_AccessToken struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The access token is a JWT token.
+	// Including expiration time, issuer, etc.
+	AccessToken string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// A description for the access token.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// The maximum number of requests per minute, unlimited if 0.
+	RateLimitPerMinute int32 `protobuf:"varint,3,opt,name=rate_limit_per_minute,json=rateLimitPerMinute,proto3" json:"rate_limit_per_minute,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AccessTokensUserSetting_AccessToken) Reset() {
+	*x = AccessTokensUserSetting_AccessToken{}
+	mi := &file_store_user_setting_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessTokensUserSetting_AccessToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessTokensUserSetting_AccessToken) ProtoMessage() {}
+
+func (x *AccessTokensUserSetting_AccessToken) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessTokensUserSetting_AccessToken.ProtoReflect.Descriptor instead.
+func (*AccessTokensUserSetting_AccessToken) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *AccessTokensUserSetting_AccessToken) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *AccessTokensUserSetting_AccessToken) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AccessTokensUserSetting_AccessToken) GetRateLimitPerMinute() int32 {
+	if x != nil {
+		return x.RateLimitPerMinute
+	}
+	return 0
+}
+
+type AccessTokenUsagesUserSetting_Usage struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// The number of requests authenticated with the access token.
+	RequestCount int64                  `protobuf:"varint,2,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+	LastUsedTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_used_time,json=lastUsedTime,proto3" json:"last_used_time,omitempty"`
+	// The IP address of the last request authenticated with the access token.
+	LastUsedIp    string `protobuf:"bytes,4,opt,name=last_used_ip,json=lastUsedIp,proto3" json:"last_used_ip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessTokenUsagesUserSetting_Usage) Reset() {
+	*x = AccessTokenUsagesUserSetting_Usage{}
+	mi := &file_store_user_setting_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessTokenUsagesUserSetting_Usage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessTokenUsagesUserSetting_Usage) ProtoMessage() {}
+
+func (x *AccessTokenUsagesUserSetting_Usage) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessTokenUsagesUserSetting_Usage.ProtoReflect.Descriptor instead.
+func (*AccessTokenUsagesUserSetting_Usage) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *AccessTokenUsagesUserSetting_Usage) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *AccessTokenUsagesUserSetting_Usage) GetRequestCount() int64 {
+	if x != nil {
+		return x.RequestCount
+	}
+	return 0
+}
+
+func (x *AccessTokenUsagesUserSetting_Usage) GetLastUsedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedTime
+	}
+	return nil
+}
+
+func (x *AccessTokenUsagesUserSetting_Usage) GetLastUsedIp() string {
+	if x != nil {
+		return x.LastUsedIp
+	}
+	return ""
+}
+
+type ShortcutsUserSetting_Shortcut struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title  string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Filter string                 `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Who may read the shortcut besides its owner.
+	Visibility ShortcutsUserSetting_Visibility `protobuf:"varint,4,opt,name=visibility,proto3,enum=memos.store.ShortcutsUserSetting_Visibility" json:"visibility,omitempty"`
+	// The users the shortcut is shared with, for the SHARED visibility.
+	SharedUserIds []int32 `protobuf:"varint,5,rep,packed,name=shared_user_ids,json=sharedUserIds,proto3" json:"shared_user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShortcutsUserSetting_Shortcut) Reset() {
+	*x = ShortcutsUserSetting_Shortcut{}
+	mi := &file_store_user_setting_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShortcutsUserSetting_Shortcut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShortcutsUserSetting_Shortcut) ProtoMessage() {}
+
+func (x *ShortcutsUserSetting_Shortcut) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShortcutsUserSetting_Shortcut.ProtoReflect.Descriptor instead.
+func (*ShortcutsUserSetting_Shortcut) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *ShortcutsUserSetting_Shortcut) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShortcutsUserSetting_Shortcut) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ShortcutsUserSetting_Shortcut) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ShortcutsUserSetting_Shortcut) GetVisibility() ShortcutsUserSetting_Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return ShortcutsUserSetting_VISIBILITY_UNSPECIFIED
+}
+
+func (x *ShortcutsUserSetting_Shortcut) GetSharedUserIds() []int32 {
+	if x != nil {
+		return x.SharedUserIds
+	}
+	return nil
+}
+
+type WebhooksUserSetting_Webhook struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier for the webhook
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Descriptive title for the webhook
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The webhook URL endpoint
+	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// The secret signing the payloads with HMAC-SHA256, unsigned if empty
+	Secret string `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Whether the deliveries are paused, set once the endpoint fails continuously
+	Disabled bool `protobuf:"varint,5,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// The number of failed delivery attempts since the last successful one
+	FailureCount int32 `protobuf:"varint,6,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	// The activity types the webhook subscribes to, all of them if empty
+	EventTypes    []string `protobuf:"bytes,7,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhooksUserSetting_Webhook) Reset() {
+	*x = WebhooksUserSetting_Webhook{}
+	mi := &file_store_user_setting_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhooksUserSetting_Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhooksUserSetting_Webhook) ProtoMessage() {}
+
+func (x *WebhooksUserSetting_Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhooksUserSetting_Webhook.ProtoReflect.Descriptor instead.
+func (*WebhooksUserSetting_Webhook) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *WebhooksUserSetting_Webhook) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhooksUserSetting_Webhook) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *WebhooksUserSetting_Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhooksUserSetting_Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhooksUserSetting_Webhook) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
 }
 
-// Deprecated: Use ShortcutsUserSetting.ProtoReflect.Descriptor instead.
-func (*ShortcutsUserSetting) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{4}
+func (x *WebhooksUserSetting_Webhook) GetFailureCount() int32 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
 }
 
-func (x *ShortcutsUserSetting) GetShortcuts() []*ShortcutsUserSetting_Shortcut {
+func (x *WebhooksUserSetting_Webhook) GetEventTypes() []string {
 	if x != nil {
-		return x.Shortcuts
+		return x.EventTypes
 	}
 	return nil
 }
 
-type WebhooksUserSetting struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Webhooks      []*WebhooksUserSetting_Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+type TagsUserSetting_Tag struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The tag path, e.g. "work/project".
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// A description of the tag.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// The display color of the tag, e.g. "#3b82f6".
+	Color string `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	// The display icon of the tag, e.g. an emoji.
+	Icon string `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	// Whether the tag is pinned by the user.
+	Pinned        bool `protobuf:"varint,5,opt,name=pinned,proto3" json:"pinned,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebhooksUserSetting) Reset() {
-	*x = WebhooksUserSetting{}
-	mi := &file_store_user_setting_proto_msgTypes[5]
+func (x *TagsUserSetting_Tag) Reset() {
+	*x = TagsUserSetting_Tag{}
+	mi := &file_store_user_setting_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebhooksUserSetting) String() string {
+func (x *TagsUserSetting_Tag) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebhooksUserSetting) ProtoMessage() {}
+func (*TagsUserSetting_Tag) ProtoMessage() {}
 
-func (x *WebhooksUserSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[5]
+func (x *TagsUserSetting_Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -468,48 +2645,79 @@ func (x *WebhooksUserSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebhooksUserSetting.ProtoReflect.Descriptor instead.
-func (*WebhooksUserSetting) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use TagsUserSetting_Tag.ProtoReflect.Descriptor instead.
+func (*TagsUserSetting_Tag) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{7, 0}
 }
 
-func (x *WebhooksUserSetting) GetWebhooks() []*WebhooksUserSetting_Webhook {
+func (x *TagsUserSetting_Tag) GetTag() string {
 	if x != nil {
-		return x.Webhooks
+		return x.Tag
 	}
-	return nil
+	return ""
 }
 
-type SessionsUserSetting_Session struct {
+func (x *TagsUserSetting_Tag) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TagsUserSetting_Tag) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *TagsUserSetting_Tag) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *TagsUserSetting_Tag) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+type SavedSearchesUserSetting_SavedSearch struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique session identifier.
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	// Timestamp when the session was created.
-	CreateTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
-	// Timestamp when the session was last accessed.
-	// Used for sliding expiration calculation (last_accessed_time + 2 weeks).
-	LastAccessedTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_accessed_time,json=lastAccessedTime,proto3" json:"last_accessed_time,omitempty"`
-	// Client information associated with this session.
-	ClientInfo    *SessionsUserSetting_ClientInfo `protobuf:"bytes,4,opt,name=client_info,json=clientInfo,proto3" json:"client_info,omitempty"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// The full-text search query, empty to list memos matching the filter.
+	Query string `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	// The CEL filter expression, refer to `Shortcut.filter`.
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// The order of the results, e.g. "display_time desc".
+	OrderBy string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Whether the query tolerates typos.
+	Fuzzy bool `protobuf:"varint,6,opt,name=fuzzy,proto3" json:"fuzzy,omitempty"`
+	// Whether the saved search is pinned by the user.
+	Pinned        bool `protobuf:"varint,7,opt,name=pinned,proto3" json:"pinned,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionsUserSetting_Session) Reset() {
-	*x = SessionsUserSetting_Session{}
-	mi := &file_store_user_setting_proto_msgTypes[6]
+func (x *SavedSearchesUserSetting_SavedSearch) Reset() {
+	*x = SavedSearchesUserSetting_SavedSearch{}
+	mi := &file_store_user_setting_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionsUserSetting_Session) String() string {
+func (x *SavedSearchesUserSetting_SavedSearch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionsUserSetting_Session) ProtoMessage() {}
+func (*SavedSearchesUserSetting_SavedSearch) ProtoMessage() {}
 
-func (x *SessionsUserSetting_Session) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[6]
+func (x *SavedSearchesUserSetting_SavedSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -520,70 +2728,85 @@ func (x *SessionsUserSetting_Session) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionsUserSetting_Session.ProtoReflect.Descriptor instead.
-func (*SessionsUserSetting_Session) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{2, 0}
+// Deprecated: Use SavedSearchesUserSetting_SavedSearch.ProtoReflect.Descriptor instead.
+func (*SavedSearchesUserSetting_SavedSearch) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{8, 0}
 }
 
-func (x *SessionsUserSetting_Session) GetSessionId() string {
+func (x *SavedSearchesUserSetting_SavedSearch) GetId() string {
 	if x != nil {
-		return x.SessionId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SessionsUserSetting_Session) GetCreateTime() *timestamppb.Timestamp {
+func (x *SavedSearchesUserSetting_SavedSearch) GetTitle() string {
 	if x != nil {
-		return x.CreateTime
+		return x.Title
 	}
-	return nil
+	return ""
 }
 
-func (x *SessionsUserSetting_Session) GetLastAccessedTime() *timestamppb.Timestamp {
+func (x *SavedSearchesUserSetting_SavedSearch) GetQuery() string {
 	if x != nil {
-		return x.LastAccessedTime
+		return x.Query
 	}
-	return nil
+	return ""
 }
 
-func (x *SessionsUserSetting_Session) GetClientInfo() *SessionsUserSetting_ClientInfo {
+func (x *SavedSearchesUserSetting_SavedSearch) GetFilter() string {
 	if x != nil {
-		return x.ClientInfo
+		return x.Filter
 	}
-	return nil
+	return ""
 }
 
-type SessionsUserSetting_ClientInfo struct {
+func (x *SavedSearchesUserSetting_SavedSearch) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *SavedSearchesUserSetting_SavedSearch) GetFuzzy() bool {
+	if x != nil {
+		return x.Fuzzy
+	}
+	return false
+}
+
+func (x *SavedSearchesUserSetting_SavedSearch) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+type FilterMacrosUserSetting_FilterMacro struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// User agent string of the client.
-	UserAgent string `protobuf:"bytes,1,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
-	// IP address of the client.
-	IpAddress string `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
-	// Optional. Device type (e.g., "mobile", "desktop", "tablet").
-	DeviceType string `protobuf:"bytes,3,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
-	// Optional. Operating system (e.g., "iOS 17.0", "Windows 11").
-	Os string `protobuf:"bytes,4,opt,name=os,proto3" json:"os,omitempty"`
-	// Optional. Browser name and version (e.g., "Chrome 119.0").
-	Browser       string `protobuf:"bytes,5,opt,name=browser,proto3" json:"browser,omitempty"`
+	// The identifier referencing the macro in filters, e.g. "work".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The CEL filter expression replacing the identifier, refer to `Shortcut.filter`.
+	Expression    string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SessionsUserSetting_ClientInfo) Reset() {
-	*x = SessionsUserSetting_ClientInfo{}
-	mi := &file_store_user_setting_proto_msgTypes[7]
+func (x *FilterMacrosUserSetting_FilterMacro) Reset() {
+	*x = FilterMacrosUserSetting_FilterMacro{}
+	mi := &file_store_user_setting_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SessionsUserSetting_ClientInfo) String() string {
+func (x *FilterMacrosUserSetting_FilterMacro) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SessionsUserSetting_ClientInfo) ProtoMessage() {}
+func (*FilterMacrosUserSetting_FilterMacro) ProtoMessage() {}
 
-func (x *SessionsUserSetting_ClientInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[7]
+func (x *FilterMacrosUserSetting_FilterMacro) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,72 +2817,57 @@ func (x *SessionsUserSetting_ClientInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SessionsUserSetting_ClientInfo.ProtoReflect.Descriptor instead.
-func (*SessionsUserSetting_ClientInfo) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{2, 1}
-}
-
-func (x *SessionsUserSetting_ClientInfo) GetUserAgent() string {
-	if x != nil {
-		return x.UserAgent
-	}
-	return ""
-}
-
-func (x *SessionsUserSetting_ClientInfo) GetIpAddress() string {
-	if x != nil {
-		return x.IpAddress
-	}
-	return ""
-}
-
-func (x *SessionsUserSetting_ClientInfo) GetDeviceType() string {
-	if x != nil {
-		return x.DeviceType
-	}
-	return ""
+// Deprecated: Use FilterMacrosUserSetting_FilterMacro.ProtoReflect.Descriptor instead.
+func (*FilterMacrosUserSetting_FilterMacro) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{9, 0}
 }
 
-func (x *SessionsUserSetting_ClientInfo) GetOs() string {
+func (x *FilterMacrosUserSetting_FilterMacro) GetName() string {
 	if x != nil {
-		return x.Os
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SessionsUserSetting_ClientInfo) GetBrowser() string {
+func (x *FilterMacrosUserSetting_FilterMacro) GetExpression() string {
 	if x != nil {
-		return x.Browser
+		return x.Expression
 	}
 	return ""
 }
 
-type AccessTokensUserSetting_AccessToken struct {
+type WebPushSubscriptionsUserSetting_Subscription struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The access token is a JWT token.
-	// Including expiration time, issuer, etc.
-	AccessToken string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	// A description for the access token.
-	Description   string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Unique identifier for the subscription.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The URL of the push service receiving the notifications of the browser.
+	Endpoint string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// The P-256 public key of the browser encrypting the notifications, base64url-encoded.
+	P256Dh string `protobuf:"bytes,3,opt,name=p256dh,proto3" json:"p256dh,omitempty"`
+	// The authentication secret of the browser, base64url-encoded.
+	Auth       string                 `protobuf:"bytes,4,opt,name=auth,proto3" json:"auth,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The user agent of the browser, to tell the subscriptions apart.
+	UserAgent     string `protobuf:"bytes,6,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AccessTokensUserSetting_AccessToken) Reset() {
-	*x = AccessTokensUserSetting_AccessToken{}
-	mi := &file_store_user_setting_proto_msgTypes[8]
+func (x *WebPushSubscriptionsUserSetting_Subscription) Reset() {
+	*x = WebPushSubscriptionsUserSetting_Subscription{}
+	mi := &file_store_user_setting_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AccessTokensUserSetting_AccessToken) String() string {
+func (x *WebPushSubscriptionsUserSetting_Subscription) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccessTokensUserSetting_AccessToken) ProtoMessage() {}
+func (*WebPushSubscriptionsUserSetting_Subscription) ProtoMessage() {}
 
-func (x *AccessTokensUserSetting_AccessToken) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[8]
+func (x *WebPushSubscriptionsUserSetting_Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -670,49 +2878,82 @@ func (x *AccessTokensUserSetting_AccessToken) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccessTokensUserSetting_AccessToken.ProtoReflect.Descriptor instead.
-func (*AccessTokensUserSetting_AccessToken) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use WebPushSubscriptionsUserSetting_Subscription.ProtoReflect.Descriptor instead.
+func (*WebPushSubscriptionsUserSetting_Subscription) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{20, 0}
 }
 
-func (x *AccessTokensUserSetting_AccessToken) GetAccessToken() string {
+func (x *WebPushSubscriptionsUserSetting_Subscription) GetId() string {
 	if x != nil {
-		return x.AccessToken
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AccessTokensUserSetting_AccessToken) GetDescription() string {
+func (x *WebPushSubscriptionsUserSetting_Subscription) GetEndpoint() string {
 	if x != nil {
-		return x.Description
+		return x.Endpoint
 	}
 	return ""
 }
 
-type ShortcutsUserSetting_Shortcut struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Filter        string                 `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *WebPushSubscriptionsUserSetting_Subscription) GetP256Dh() string {
+	if x != nil {
+		return x.P256Dh
+	}
+	return ""
 }
 
-func (x *ShortcutsUserSetting_Shortcut) Reset() {
-	*x = ShortcutsUserSetting_Shortcut{}
-	mi := &file_store_user_setting_proto_msgTypes[9]
+func (x *WebPushSubscriptionsUserSetting_Subscription) GetAuth() string {
+	if x != nil {
+		return x.Auth
+	}
+	return ""
+}
+
+func (x *WebPushSubscriptionsUserSetting_Subscription) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *WebPushSubscriptionsUserSetting_Subscription) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+type GistSyncUserSetting_MemoGist struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the gist mirroring the memo.
+	GistId string `protobuf:"bytes,1,opt,name=gist_id,json=gistId,proto3" json:"gist_id,omitempty"`
+	// The URL of the gist on GitHub.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// The SHA-256 of the content last synced, the memo being pushed to the gist once its content differs.
+	ContentHash string `protobuf:"bytes,3,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	// The update time of the gist when last synced, the gist being pulled into the memo once updated.
+	GistUpdateTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=gist_update_time,json=gistUpdateTime,proto3" json:"gist_update_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GistSyncUserSetting_MemoGist) Reset() {
+	*x = GistSyncUserSetting_MemoGist{}
+	mi := &file_store_user_setting_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShortcutsUserSetting_Shortcut) String() string {
+func (x *GistSyncUserSetting_MemoGist) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShortcutsUserSetting_Shortcut) ProtoMessage() {}
+func (*GistSyncUserSetting_MemoGist) ProtoMessage() {}
 
-func (x *ShortcutsUserSetting_Shortcut) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[9]
+func (x *GistSyncUserSetting_MemoGist) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -723,59 +2964,67 @@ func (x *ShortcutsUserSetting_Shortcut) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShortcutsUserSetting_Shortcut.ProtoReflect.Descriptor instead.
-func (*ShortcutsUserSetting_Shortcut) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use GistSyncUserSetting_MemoGist.ProtoReflect.Descriptor instead.
+func (*GistSyncUserSetting_MemoGist) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{21, 0}
 }
 
-func (x *ShortcutsUserSetting_Shortcut) GetId() string {
+func (x *GistSyncUserSetting_MemoGist) GetGistId() string {
 	if x != nil {
-		return x.Id
+		return x.GistId
 	}
 	return ""
 }
 
-func (x *ShortcutsUserSetting_Shortcut) GetTitle() string {
+func (x *GistSyncUserSetting_MemoGist) GetUrl() string {
 	if x != nil {
-		return x.Title
+		return x.Url
 	}
 	return ""
 }
 
-func (x *ShortcutsUserSetting_Shortcut) GetFilter() string {
+func (x *GistSyncUserSetting_MemoGist) GetContentHash() string {
 	if x != nil {
-		return x.Filter
+		return x.ContentHash
 	}
 	return ""
 }
 
-type WebhooksUserSetting_Webhook struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique identifier for the webhook
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Descriptive title for the webhook
-	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	// The webhook URL endpoint
-	Url           string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+func (x *GistSyncUserSetting_MemoGist) GetGistUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GistUpdateTime
+	}
+	return nil
+}
+
+type NotificationPreferencesUserSetting_Preference struct {
+	state protoimpl.MessageState                   `protogen:"open.v1"`
+	Event NotificationPreferencesUserSetting_Event `protobuf:"varint,1,opt,name=event,proto3,enum=memos.store.NotificationPreferencesUserSetting_Event" json:"event,omitempty"`
+	// Whether the event creates an inbox message.
+	Inbox bool `protobuf:"varint,2,opt,name=inbox,proto3" json:"inbox,omitempty"`
+	// Whether the event is emailed.
+	Email bool `protobuf:"varint,3,opt,name=email,proto3" json:"email,omitempty"`
+	// Whether the event is pushed to the browsers.
+	Push          bool `protobuf:"varint,4,opt,name=push,proto3" json:"push,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebhooksUserSetting_Webhook) Reset() {
-	*x = WebhooksUserSetting_Webhook{}
-	mi := &file_store_user_setting_proto_msgTypes[10]
+func (x *NotificationPreferencesUserSetting_Preference) Reset() {
+	*x = NotificationPreferencesUserSetting_Preference{}
+	mi := &file_store_user_setting_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebhooksUserSetting_Webhook) String() string {
+func (x *NotificationPreferencesUserSetting_Preference) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebhooksUserSetting_Webhook) ProtoMessage() {}
+func (*NotificationPreferencesUserSetting_Preference) ProtoMessage() {}
 
-func (x *WebhooksUserSetting_Webhook) ProtoReflect() protoreflect.Message {
-	mi := &file_store_user_setting_proto_msgTypes[10]
+func (x *NotificationPreferencesUserSetting_Preference) ProtoReflect() protoreflect.Message {
+	mi := &file_store_user_setting_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -786,37 +3035,44 @@ func (x *WebhooksUserSetting_Webhook) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebhooksUserSetting_Webhook.ProtoReflect.Descriptor instead.
-func (*WebhooksUserSetting_Webhook) Descriptor() ([]byte, []int) {
-	return file_store_user_setting_proto_rawDescGZIP(), []int{5, 0}
+// Deprecated: Use NotificationPreferencesUserSetting_Preference.ProtoReflect.Descriptor instead.
+func (*NotificationPreferencesUserSetting_Preference) Descriptor() ([]byte, []int) {
+	return file_store_user_setting_proto_rawDescGZIP(), []int{22, 0}
 }
 
-func (x *WebhooksUserSetting_Webhook) GetId() string {
+func (x *NotificationPreferencesUserSetting_Preference) GetEvent() NotificationPreferencesUserSetting_Event {
 	if x != nil {
-		return x.Id
+		return x.Event
 	}
-	return ""
+	return NotificationPreferencesUserSetting_EVENT_UNSPECIFIED
 }
 
-func (x *WebhooksUserSetting_Webhook) GetTitle() string {
+func (x *NotificationPreferencesUserSetting_Preference) GetInbox() bool {
 	if x != nil {
-		return x.Title
+		return x.Inbox
 	}
-	return ""
+	return false
 }
 
-func (x *WebhooksUserSetting_Webhook) GetUrl() string {
+func (x *NotificationPreferencesUserSetting_Preference) GetEmail() bool {
 	if x != nil {
-		return x.Url
+		return x.Email
 	}
-	return ""
+	return false
+}
+
+func (x *NotificationPreferencesUserSetting_Preference) GetPush() bool {
+	if x != nil {
+		return x.Push
+	}
+	return false
 }
 
 var File_store_user_setting_proto protoreflect.FileDescriptor
 
 const file_store_user_setting_proto_rawDesc = "" +
 	"\n" +
-	"\x18store/user_setting.proto\x12\vmemos.store\x1a\x1fgoogle/protobuf/timestamp.proto\"\x93\x04\n" +
+	"\x18store/user_setting.proto\x12\vmemos.store\x1a\x1fgoogle/protobuf/timestamp.proto\"\xee\x11\n" +
 	"\vUserSetting\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12.\n" +
 	"\x03key\x18\x02 \x01(\x0e2\x1c.memos.store.UserSetting.KeyR\x03key\x12;\n" +
@@ -824,24 +3080,74 @@ const file_store_user_setting_proto_rawDesc = "" +
 	"\bsessions\x18\x04 \x01(\v2 .memos.store.SessionsUserSettingH\x00R\bsessions\x12K\n" +
 	"\raccess_tokens\x18\x05 \x01(\v2$.memos.store.AccessTokensUserSettingH\x00R\faccessTokens\x12A\n" +
 	"\tshortcuts\x18\x06 \x01(\v2!.memos.store.ShortcutsUserSettingH\x00R\tshortcuts\x12>\n" +
-	"\bwebhooks\x18\a \x01(\v2 .memos.store.WebhooksUserSettingH\x00R\bwebhooks\"e\n" +
+	"\bwebhooks\x18\a \x01(\v2 .memos.store.WebhooksUserSettingH\x00R\bwebhooks\x122\n" +
+	"\x04tags\x18\b \x01(\v2\x1c.memos.store.TagsUserSettingH\x00R\x04tags\x12N\n" +
+	"\x0esaved_searches\x18\t \x01(\v2%.memos.store.SavedSearchesUserSettingH\x00R\rsavedSearches\x12K\n" +
+	"\rfilter_macros\x18\n" +
+	" \x01(\v2$.memos.store.FilterMacrosUserSettingH\x00R\ffilterMacros\x12B\n" +
+	"\n" +
+	"two_factor\x18\v \x01(\v2!.memos.store.TwoFactorUserSettingH\x00R\ttwoFactor\x12[\n" +
+	"\x13access_token_usages\x18\f \x01(\v2).memos.store.AccessTokenUsagesUserSettingH\x00R\x11accessTokenUsages\x12D\n" +
+	"\n" +
+	"suspension\x18\r \x01(\v2\".memos.store.SuspensionUserSettingH\x00R\n" +
+	"suspension\x12>\n" +
+	"\bpassword\x18\x0e \x01(\v2 .memos.store.PasswordUserSettingH\x00R\bpassword\x12Z\n" +
+	"\x12email_verification\x18\x0f \x01(\v2).memos.store.EmailVerificationUserSettingH\x00R\x11emailVerification\x12E\n" +
+	"\vcustom_role\x18\x10 \x01(\v2\".memos.store.CustomRoleUserSettingH\x00R\n" +
+	"customRole\x12B\n" +
+	"\n" +
+	"memo_email\x18\x11 \x01(\v2!.memos.store.MemoEmailUserSettingH\x00R\tmemoEmail\x125\n" +
+	"\x05slack\x18\x12 \x01(\v2\x1d.memos.store.SlackUserSettingH\x00R\x05slack\x12;\n" +
+	"\adiscord\x18\x13 \x01(\v2\x1f.memos.store.DiscordUserSettingH\x00R\adiscord\x12K\n" +
+	"\rcalendar_feed\x18\x14 \x01(\v2$.memos.store.CalendarFeedUserSettingH\x00R\fcalendarFeed\x12>\n" +
+	"\breadwise\x18\x15 \x01(\v2 .memos.store.ReadwiseUserSettingH\x00R\breadwise\x12d\n" +
+	"\x16web_push_subscriptions\x18\x16 \x01(\v2,.memos.store.WebPushSubscriptionsUserSettingH\x00R\x14webPushSubscriptions\x12?\n" +
+	"\tgist_sync\x18\x17 \x01(\v2 .memos.store.GistSyncUserSettingH\x00R\bgistSync\x12l\n" +
+	"\x18notification_preferences\x18\x18 \x01(\v2/.memos.store.NotificationPreferencesUserSettingH\x00R\x17notificationPreferences\x12H\n" +
+	"\femail_digest\x18\x19 \x01(\v2#.memos.store.EmailDigestUserSettingH\x00R\vemailDigest\x12C\n" +
+	"\von_this_day\x18\x1a \x01(\v2!.memos.store.OnThisDayUserSettingH\x00R\tonThisDay\"\xbb\x03\n" +
 	"\x03Key\x12\x13\n" +
 	"\x0fKEY_UNSPECIFIED\x10\x00\x12\v\n" +
 	"\aGENERAL\x10\x01\x12\f\n" +
 	"\bSESSIONS\x10\x02\x12\x11\n" +
 	"\rACCESS_TOKENS\x10\x03\x12\r\n" +
 	"\tSHORTCUTS\x10\x04\x12\f\n" +
-	"\bWEBHOOKS\x10\x05B\a\n" +
-	"\x05value\"\x8b\x01\n" +
+	"\bWEBHOOKS\x10\x05\x12\b\n" +
+	"\x04TAGS\x10\x06\x12\x12\n" +
+	"\x0eSAVED_SEARCHES\x10\a\x12\x11\n" +
+	"\rFILTER_MACROS\x10\b\x12\x0e\n" +
+	"\n" +
+	"TWO_FACTOR\x10\t\x12\x17\n" +
+	"\x13ACCESS_TOKEN_USAGES\x10\n" +
+	"\x12\x0e\n" +
+	"\n" +
+	"SUSPENSION\x10\v\x12\f\n" +
+	"\bPASSWORD\x10\f\x12\x16\n" +
+	"\x12EMAIL_VERIFICATION\x10\r\x12\x0f\n" +
+	"\vCUSTOM_ROLE\x10\x0e\x12\x0e\n" +
+	"\n" +
+	"MEMO_EMAIL\x10\x0f\x12\t\n" +
+	"\x05SLACK\x10\x10\x12\v\n" +
+	"\aDISCORD\x10\x11\x12\x11\n" +
+	"\rCALENDAR_FEED\x10\x12\x12\f\n" +
+	"\bREADWISE\x10\x13\x12\x1a\n" +
+	"\x16WEB_PUSH_SUBSCRIPTIONS\x10\x14\x12\r\n" +
+	"\tGIST_SYNC\x10\x15\x12\x1c\n" +
+	"\x18NOTIFICATION_PREFERENCES\x10\x16\x12\x10\n" +
+	"\fEMAIL_DIGEST\x10\x17\x12\x0f\n" +
+	"\vON_THIS_DAY\x10\x18B\a\n" +
+	"\x05value\"\xf3\x01\n" +
 	"\x12GeneralUserSetting\x12\x16\n" +
 	"\x06locale\x18\x01 \x01(\tR\x06locale\x12\x1e\n" +
 	"\n" +
 	"appearance\x18\x02 \x01(\tR\n" +
 	"appearance\x12'\n" +
 	"\x0fmemo_visibility\x18\x03 \x01(\tR\x0ememoVisibility\x12\x14\n" +
-	"\x05theme\x18\x04 \x01(\tR\x05theme\"\xf3\x03\n" +
+	"\x05theme\x18\x04 \x01(\tR\x05theme\x120\n" +
+	"\x14strip_image_metadata\x18\x05 \x01(\bR\x12stripImageMetadata\x124\n" +
+	"\x16keep_image_orientation\x18\x06 \x01(\bR\x14keepImageOrientation\"\xd9\x04\n" +
 	"\x13SessionsUserSetting\x12D\n" +
-	"\bsessions\x18\x01 \x03(\v2(.memos.store.SessionsUserSetting.SessionR\bsessions\x1a\xfd\x01\n" +
+	"\bsessions\x18\x01 \x03(\v2(.memos.store.SessionsUserSetting.SessionR\bsessions\x1a\xe3\x02\n" +
 	"\aSession\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12;\n" +
@@ -849,7 +3155,10 @@ const file_store_user_setting_proto_rawDesc = "" +
 	"createTime\x12H\n" +
 	"\x12last_accessed_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x10lastAccessedTime\x12L\n" +
 	"\vclient_info\x18\x04 \x01(\v2+.memos.store.SessionsUserSetting.ClientInfoR\n" +
-	"clientInfo\x1a\x95\x01\n" +
+	"clientInfo\x12'\n" +
+	"\x0fimpersonator_id\x18\x05 \x01(\x05R\x0eimpersonatorId\x12;\n" +
+	"\vexpire_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x1a\x95\x01\n" +
 	"\n" +
 	"ClientInfo\x12\x1d\n" +
 	"\n" +
@@ -859,24 +3168,170 @@ const file_store_user_setting_proto_rawDesc = "" +
 	"\vdevice_type\x18\x03 \x01(\tR\n" +
 	"deviceType\x12\x0e\n" +
 	"\x02os\x18\x04 \x01(\tR\x02os\x12\x18\n" +
-	"\abrowser\x18\x05 \x01(\tR\abrowser\"\xc4\x01\n" +
+	"\abrowser\x18\x05 \x01(\tR\abrowser\"\xf8\x01\n" +
 	"\x17AccessTokensUserSetting\x12U\n" +
-	"\raccess_tokens\x18\x01 \x03(\v20.memos.store.AccessTokensUserSetting.AccessTokenR\faccessTokens\x1aR\n" +
+	"\raccess_tokens\x18\x01 \x03(\v20.memos.store.AccessTokensUserSetting.AccessTokenR\faccessTokens\x1a\x85\x01\n" +
 	"\vAccessToken\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\"\xaa\x01\n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x121\n" +
+	"\x15rate_limit_per_minute\x18\x03 \x01(\x05R\x12rateLimitPerMinute\"\x9d\x02\n" +
+	"\x1cAccessTokenUsagesUserSetting\x12G\n" +
+	"\x06usages\x18\x01 \x03(\v2/.memos.store.AccessTokenUsagesUserSetting.UsageR\x06usages\x1a\xb3\x01\n" +
+	"\x05Usage\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrequest_count\x18\x02 \x01(\x03R\frequestCount\x12@\n" +
+	"\x0elast_used_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\flastUsedTime\x12 \n" +
+	"\flast_used_ip\x18\x04 \x01(\tR\n" +
+	"lastUsedIp\"\xf3\x02\n" +
 	"\x14ShortcutsUserSetting\x12H\n" +
-	"\tshortcuts\x18\x01 \x03(\v2*.memos.store.ShortcutsUserSetting.ShortcutR\tshortcuts\x1aH\n" +
+	"\tshortcuts\x18\x01 \x03(\v2*.memos.store.ShortcutsUserSetting.ShortcutR\tshortcuts\x1a\xbe\x01\n" +
 	"\bShortcut\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
-	"\x06filter\x18\x03 \x01(\tR\x06filter\"\x9e\x01\n" +
+	"\x06filter\x18\x03 \x01(\tR\x06filter\x12L\n" +
+	"\n" +
+	"visibility\x18\x04 \x01(\x0e2,.memos.store.ShortcutsUserSetting.VisibilityR\n" +
+	"visibility\x12&\n" +
+	"\x0fshared_user_ids\x18\x05 \x03(\x05R\rsharedUserIds\"P\n" +
+	"\n" +
+	"Visibility\x12\x1a\n" +
+	"\x16VISIBILITY_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPRIVATE\x10\x01\x12\n" +
+	"\n" +
+	"\x06SHARED\x10\x02\x12\r\n" +
+	"\tWORKSPACE\x10\x03\"\x99\x02\n" +
 	"\x13WebhooksUserSetting\x12D\n" +
-	"\bwebhooks\x18\x01 \x03(\v2(.memos.store.WebhooksUserSetting.WebhookR\bwebhooks\x1aA\n" +
+	"\bwebhooks\x18\x01 \x03(\v2(.memos.store.WebhooksUserSetting.WebhookR\bwebhooks\x1a\xbb\x01\n" +
 	"\aWebhook\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x10\n" +
-	"\x03url\x18\x03 \x01(\tR\x03urlB\x9b\x01\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12\x1a\n" +
+	"\bdisabled\x18\x05 \x01(\bR\bdisabled\x12#\n" +
+	"\rfailure_count\x18\x06 \x01(\x05R\ffailureCount\x12\x1f\n" +
+	"\vevent_types\x18\a \x03(\tR\n" +
+	"eventTypes\"\xc4\x01\n" +
+	"\x0fTagsUserSetting\x124\n" +
+	"\x04tags\x18\x01 \x03(\v2 .memos.store.TagsUserSetting.TagR\x04tags\x1a{\n" +
+	"\x03Tag\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\x12\x12\n" +
+	"\x04icon\x18\x04 \x01(\tR\x04icon\x12\x16\n" +
+	"\x06pinned\x18\x05 \x01(\bR\x06pinned\"\xa1\x02\n" +
+	"\x18SavedSearchesUserSetting\x12X\n" +
+	"\x0esaved_searches\x18\x01 \x03(\v21.memos.store.SavedSearchesUserSetting.SavedSearchR\rsavedSearches\x1a\xaa\x01\n" +
+	"\vSavedSearch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\x12\x16\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x05 \x01(\tR\aorderBy\x12\x14\n" +
+	"\x05fuzzy\x18\x06 \x01(\bR\x05fuzzy\x12\x16\n" +
+	"\x06pinned\x18\a \x01(\bR\x06pinned\"\xb3\x01\n" +
+	"\x17FilterMacrosUserSetting\x12U\n" +
+	"\rfilter_macros\x18\x01 \x03(\v20.memos.store.FilterMacrosUserSetting.FilterMacroR\ffilterMacros\x1aA\n" +
+	"\vFilterMacro\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x02 \x01(\tR\n" +
+	"expression\"\xa0\x01\n" +
+	"\x14TwoFactorUserSetting\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x120\n" +
+	"\x14recovery_code_hashes\x18\x03 \x03(\tR\x12recoveryCodeHashes\x12$\n" +
+	"\x0elast_used_step\x18\x04 \x01(\x03R\flastUsedStep\"\xaf\x01\n" +
+	"\x15SuspensionUserSetting\x12\x1c\n" +
+	"\tsuspended\x18\x01 \x01(\bR\tsuspended\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12=\n" +
+	"\fsuspend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vsuspendTime\x12!\n" +
+	"\fsuspender_id\x18\x04 \x01(\x05R\vsuspenderId\">\n" +
+	"\x13PasswordUserSetting\x12'\n" +
+	"\x0fchange_required\x18\x01 \x01(\bR\x0echangeRequired\"8\n" +
+	"\x1cEmailVerificationUserSetting\x12\x18\n" +
+	"\apending\x18\x01 \x01(\bR\apending\"0\n" +
+	"\x15CustomRoleUserSetting\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\tR\x06roleId\",\n" +
+	"\x14MemoEmailUserSetting\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xb0\x01\n" +
+	"\x10SlackUserSetting\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tlink_code\x18\x03 \x01(\tR\blinkCode\x12M\n" +
+	"\x15link_code_expire_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x12linkCodeExpireTime\"\x99\x01\n" +
+	"\x12DiscordUserSetting\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tlink_code\x18\x02 \x01(\tR\blinkCode\x12M\n" +
+	"\x15link_code_expire_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x12linkCodeExpireTime\"/\n" +
+	"\x17CalendarFeedUserSetting\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xb5\x02\n" +
+	"\x13ReadwiseUserSetting\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\x12@\n" +
+	"\x0elast_sync_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\flastSyncTime\x12&\n" +
+	"\x0flast_sync_error\x18\x04 \x01(\tR\rlastSyncError\x12N\n" +
+	"\n" +
+	"book_memos\x18\x05 \x03(\v2/.memos.store.ReadwiseUserSetting.BookMemosEntryR\tbookMemos\x1a<\n" +
+	"\x0eBookMemosEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc7\x02\n" +
+	"\x1fWebPushSubscriptionsUserSetting\x12_\n" +
+	"\rsubscriptions\x18\x01 \x03(\v29.memos.store.WebPushSubscriptionsUserSetting.SubscriptionR\rsubscriptions\x1a\xc2\x01\n" +
+	"\fSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bendpoint\x18\x02 \x01(\tR\bendpoint\x12\x16\n" +
+	"\x06p256dh\x18\x03 \x01(\tR\x06p256dh\x12\x12\n" +
+	"\x04auth\x18\x04 \x01(\tR\x04auth\x12;\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x06 \x01(\tR\tuserAgent\"\xef\x03\n" +
+	"\x13GistSyncUserSetting\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12@\n" +
+	"\x0elast_sync_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\flastSyncTime\x12&\n" +
+	"\x0flast_sync_error\x18\x03 \x01(\tR\rlastSyncError\x12N\n" +
+	"\n" +
+	"memo_gists\x18\x04 \x03(\v2/.memos.store.GistSyncUserSetting.MemoGistsEntryR\tmemoGists\x1a\x9e\x01\n" +
+	"\bMemoGist\x12\x17\n" +
+	"\agist_id\x18\x01 \x01(\tR\x06gistId\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12!\n" +
+	"\fcontent_hash\x18\x03 \x01(\tR\vcontentHash\x12D\n" +
+	"\x10gist_update_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0egistUpdateTime\x1ag\n" +
+	"\x0eMemoGistsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12?\n" +
+	"\x05value\x18\x02 \x01(\v2).memos.store.GistSyncUserSetting.MemoGistR\x05value:\x028\x01\"\x97\x03\n" +
+	"\"NotificationPreferencesUserSetting\x12\\\n" +
+	"\vpreferences\x18\x01 \x03(\v2:.memos.store.NotificationPreferencesUserSetting.PreferenceR\vpreferences\x1a\x99\x01\n" +
+	"\n" +
+	"Preference\x12K\n" +
+	"\x05event\x18\x01 \x01(\x0e25.memos.store.NotificationPreferencesUserSetting.EventR\x05event\x12\x14\n" +
+	"\x05inbox\x18\x02 \x01(\bR\x05inbox\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\bR\x05email\x12\x12\n" +
+	"\x04push\x18\x04 \x01(\bR\x04push\"w\n" +
+	"\x05Event\x12\x15\n" +
+	"\x11EVENT_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aCOMMENT\x10\x01\x12\f\n" +
+	"\bREACTION\x10\x02\x12\v\n" +
+	"\aMENTION\x10\x03\x12\f\n" +
+	"\bREMINDER\x10\x04\x12\x10\n" +
+	"\fANNOUNCEMENT\x10\x05\x12\x0f\n" +
+	"\vON_THIS_DAY\x10\x06\"\xb1\x02\n" +
+	"\x16EmailDigestUserSetting\x12K\n" +
+	"\tfrequency\x18\x01 \x01(\x0e2-.memos.store.EmailDigestUserSetting.FrequencyR\tfrequency\x12\x12\n" +
+	"\x04hour\x18\x02 \x01(\x05R\x04hour\x12\x18\n" +
+	"\aweekday\x18\x03 \x01(\x05R\aweekday\x12\x1b\n" +
+	"\ttime_zone\x18\x04 \x01(\tR\btimeZone\x12@\n" +
+	"\x0elast_send_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\flastSendTime\"=\n" +
+	"\tFrequency\x12\x19\n" +
+	"\x15FREQUENCY_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05DAILY\x10\x01\x12\n" +
+	"\n" +
+	"\x06WEEKLY\x10\x02\"\xa7\x01\n" +
+	"\x14OnThisDayUserSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x12\n" +
+	"\x04hour\x18\x02 \x01(\x05R\x04hour\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\x12D\n" +
+	"\x10last_notify_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastNotifyTimeB\x9b\x01\n" +
 	"\x0fcom.memos.storeB\x10UserSettingProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -891,42 +3346,115 @@ func file_store_user_setting_proto_rawDescGZIP() []byte {
 	return file_store_user_setting_proto_rawDescData
 }
 
-var file_store_user_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_store_user_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_store_user_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_store_user_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_store_user_setting_proto_goTypes = []any{
-	(UserSetting_Key)(0),                        // 0: memos.store.UserSetting.Key
-	(*UserSetting)(nil),                         // 1: memos.store.UserSetting
-	(*GeneralUserSetting)(nil),                  // 2: memos.store.GeneralUserSetting
-	(*SessionsUserSetting)(nil),                 // 3: memos.store.SessionsUserSetting
-	(*AccessTokensUserSetting)(nil),             // 4: memos.store.AccessTokensUserSetting
-	(*ShortcutsUserSetting)(nil),                // 5: memos.store.ShortcutsUserSetting
-	(*WebhooksUserSetting)(nil),                 // 6: memos.store.WebhooksUserSetting
-	(*SessionsUserSetting_Session)(nil),         // 7: memos.store.SessionsUserSetting.Session
-	(*SessionsUserSetting_ClientInfo)(nil),      // 8: memos.store.SessionsUserSetting.ClientInfo
-	(*AccessTokensUserSetting_AccessToken)(nil), // 9: memos.store.AccessTokensUserSetting.AccessToken
-	(*ShortcutsUserSetting_Shortcut)(nil),       // 10: memos.store.ShortcutsUserSetting.Shortcut
-	(*WebhooksUserSetting_Webhook)(nil),         // 11: memos.store.WebhooksUserSetting.Webhook
-	(*timestamppb.Timestamp)(nil),               // 12: google.protobuf.Timestamp
+	(UserSetting_Key)(0),                          // 0: memos.store.UserSetting.Key
+	(ShortcutsUserSetting_Visibility)(0),          // 1: memos.store.ShortcutsUserSetting.Visibility
+	(NotificationPreferencesUserSetting_Event)(0), // 2: memos.store.NotificationPreferencesUserSetting.Event
+	(EmailDigestUserSetting_Frequency)(0),         // 3: memos.store.EmailDigestUserSetting.Frequency
+	(*UserSetting)(nil),                           // 4: memos.store.UserSetting
+	(*GeneralUserSetting)(nil),                    // 5: memos.store.GeneralUserSetting
+	(*SessionsUserSetting)(nil),                   // 6: memos.store.SessionsUserSetting
+	(*AccessTokensUserSetting)(nil),               // 7: memos.store.AccessTokensUserSetting
+	(*AccessTokenUsagesUserSetting)(nil),          // 8: memos.store.AccessTokenUsagesUserSetting
+	(*ShortcutsUserSetting)(nil),                  // 9: memos.store.ShortcutsUserSetting
+	(*WebhooksUserSetting)(nil),                   // 10: memos.store.WebhooksUserSetting
+	(*TagsUserSetting)(nil),                       // 11: memos.store.TagsUserSetting
+	(*SavedSearchesUserSetting)(nil),              // 12: memos.store.SavedSearchesUserSetting
+	(*FilterMacrosUserSetting)(nil),               // 13: memos.store.FilterMacrosUserSetting
+	(*TwoFactorUserSetting)(nil),                  // 14: memos.store.TwoFactorUserSetting
+	(*SuspensionUserSetting)(nil),                 // 15: memos.store.SuspensionUserSetting
+	(*PasswordUserSetting)(nil),                   // 16: memos.store.PasswordUserSetting
+	(*EmailVerificationUserSetting)(nil),          // 17: memos.store.EmailVerificationUserSetting
+	(*CustomRoleUserSetting)(nil),                 // 18: memos.store.CustomRoleUserSetting
+	(*MemoEmailUserSetting)(nil),                  // 19: memos.store.MemoEmailUserSetting
+	(*SlackUserSetting)(nil),                      // 20: memos.store.SlackUserSetting
+	(*DiscordUserSetting)(nil),                    // 21: memos.store.DiscordUserSetting
+	(*CalendarFeedUserSetting)(nil),               // 22: memos.store.CalendarFeedUserSetting
+	(*ReadwiseUserSetting)(nil),                   // 23: memos.store.ReadwiseUserSetting
+	(*WebPushSubscriptionsUserSetting)(nil),       // 24: memos.store.WebPushSubscriptionsUserSetting
+	(*GistSyncUserSetting)(nil),                   // 25: memos.store.GistSyncUserSetting
+	(*NotificationPreferencesUserSetting)(nil),    // 26: memos.store.NotificationPreferencesUserSetting
+	(*EmailDigestUserSetting)(nil),                // 27: memos.store.EmailDigestUserSetting
+	(*OnThisDayUserSetting)(nil),                  // 28: memos.store.OnThisDayUserSetting
+	(*SessionsUserSetting_Session)(nil),           // 29: memos.store.SessionsUserSetting.Session
+	(*SessionsUserSetting_ClientInfo)(nil),        // 30: memos.store.SessionsUserSetting.ClientInfo
+	(*AccessTokensUserSetting_AccessToken)(nil),   // 31: memos.store.AccessTokensUserSetting.AccessToken
+	(*AccessTokenUsagesUserSetting_Usage)(nil),    // 32: memos.store.AccessTokenUsagesUserSetting.Usage
+	(*ShortcutsUserSetting_Shortcut)(nil),         // 33: memos.store.ShortcutsUserSetting.Shortcut
+	(*WebhooksUserSetting_Webhook)(nil),           // 34: memos.store.WebhooksUserSetting.Webhook
+	(*TagsUserSetting_Tag)(nil),                   // 35: memos.store.TagsUserSetting.Tag
+	(*SavedSearchesUserSetting_SavedSearch)(nil),  // 36: memos.store.SavedSearchesUserSetting.SavedSearch
+	(*FilterMacrosUserSetting_FilterMacro)(nil),   // 37: memos.store.FilterMacrosUserSetting.FilterMacro
+	nil, // 38: memos.store.ReadwiseUserSetting.BookMemosEntry
+	(*WebPushSubscriptionsUserSetting_Subscription)(nil), // 39: memos.store.WebPushSubscriptionsUserSetting.Subscription
+	(*GistSyncUserSetting_MemoGist)(nil),                 // 40: memos.store.GistSyncUserSetting.MemoGist
+	nil,                                                  // 41: memos.store.GistSyncUserSetting.MemoGistsEntry
+	(*NotificationPreferencesUserSetting_Preference)(nil), // 42: memos.store.NotificationPreferencesUserSetting.Preference
+	(*timestamppb.Timestamp)(nil),                         // 43: google.protobuf.Timestamp
 }
 var file_store_user_setting_proto_depIdxs = []int32{
 	0,  // 0: memos.store.UserSetting.key:type_name -> memos.store.UserSetting.Key
-	2,  // 1: memos.store.UserSetting.general:type_name -> memos.store.GeneralUserSetting
-	3,  // 2: memos.store.UserSetting.sessions:type_name -> memos.store.SessionsUserSetting
-	4,  // 3: memos.store.UserSetting.access_tokens:type_name -> memos.store.AccessTokensUserSetting
-	5,  // 4: memos.store.UserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting
-	6,  // 5: memos.store.UserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting
-	7,  // 6: memos.store.SessionsUserSetting.sessions:type_name -> memos.store.SessionsUserSetting.Session
-	9,  // 7: memos.store.AccessTokensUserSetting.access_tokens:type_name -> memos.store.AccessTokensUserSetting.AccessToken
-	10, // 8: memos.store.ShortcutsUserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting.Shortcut
-	11, // 9: memos.store.WebhooksUserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting.Webhook
-	12, // 10: memos.store.SessionsUserSetting.Session.create_time:type_name -> google.protobuf.Timestamp
-	12, // 11: memos.store.SessionsUserSetting.Session.last_accessed_time:type_name -> google.protobuf.Timestamp
-	8,  // 12: memos.store.SessionsUserSetting.Session.client_info:type_name -> memos.store.SessionsUserSetting.ClientInfo
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	5,  // 1: memos.store.UserSetting.general:type_name -> memos.store.GeneralUserSetting
+	6,  // 2: memos.store.UserSetting.sessions:type_name -> memos.store.SessionsUserSetting
+	7,  // 3: memos.store.UserSetting.access_tokens:type_name -> memos.store.AccessTokensUserSetting
+	9,  // 4: memos.store.UserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting
+	10, // 5: memos.store.UserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting
+	11, // 6: memos.store.UserSetting.tags:type_name -> memos.store.TagsUserSetting
+	12, // 7: memos.store.UserSetting.saved_searches:type_name -> memos.store.SavedSearchesUserSetting
+	13, // 8: memos.store.UserSetting.filter_macros:type_name -> memos.store.FilterMacrosUserSetting
+	14, // 9: memos.store.UserSetting.two_factor:type_name -> memos.store.TwoFactorUserSetting
+	8,  // 10: memos.store.UserSetting.access_token_usages:type_name -> memos.store.AccessTokenUsagesUserSetting
+	15, // 11: memos.store.UserSetting.suspension:type_name -> memos.store.SuspensionUserSetting
+	16, // 12: memos.store.UserSetting.password:type_name -> memos.store.PasswordUserSetting
+	17, // 13: memos.store.UserSetting.email_verification:type_name -> memos.store.EmailVerificationUserSetting
+	18, // 14: memos.store.UserSetting.custom_role:type_name -> memos.store.CustomRoleUserSetting
+	19, // 15: memos.store.UserSetting.memo_email:type_name -> memos.store.MemoEmailUserSetting
+	20, // 16: memos.store.UserSetting.slack:type_name -> memos.store.SlackUserSetting
+	21, // 17: memos.store.UserSetting.discord:type_name -> memos.store.DiscordUserSetting
+	22, // 18: memos.store.UserSetting.calendar_feed:type_name -> memos.store.CalendarFeedUserSetting
+	23, // 19: memos.store.UserSetting.readwise:type_name -> memos.store.ReadwiseUserSetting
+	24, // 20: memos.store.UserSetting.web_push_subscriptions:type_name -> memos.store.WebPushSubscriptionsUserSetting
+	25, // 21: memos.store.UserSetting.gist_sync:type_name -> memos.store.GistSyncUserSetting
+	26, // 22: memos.store.UserSetting.notification_preferences:type_name -> memos.store.NotificationPreferencesUserSetting
+	27, // 23: memos.store.UserSetting.email_digest:type_name -> memos.store.EmailDigestUserSetting
+	28, // 24: memos.store.UserSetting.on_this_day:type_name -> memos.store.OnThisDayUserSetting
+	29, // 25: memos.store.SessionsUserSetting.sessions:type_name -> memos.store.SessionsUserSetting.Session
+	31, // 26: memos.store.AccessTokensUserSetting.access_tokens:type_name -> memos.store.AccessTokensUserSetting.AccessToken
+	32, // 27: memos.store.AccessTokenUsagesUserSetting.usages:type_name -> memos.store.AccessTokenUsagesUserSetting.Usage
+	33, // 28: memos.store.ShortcutsUserSetting.shortcuts:type_name -> memos.store.ShortcutsUserSetting.Shortcut
+	34, // 29: memos.store.WebhooksUserSetting.webhooks:type_name -> memos.store.WebhooksUserSetting.Webhook
+	35, // 30: memos.store.TagsUserSetting.tags:type_name -> memos.store.TagsUserSetting.Tag
+	36, // 31: memos.store.SavedSearchesUserSetting.saved_searches:type_name -> memos.store.SavedSearchesUserSetting.SavedSearch
+	37, // 32: memos.store.FilterMacrosUserSetting.filter_macros:type_name -> memos.store.FilterMacrosUserSetting.FilterMacro
+	43, // 33: memos.store.SuspensionUserSetting.suspend_time:type_name -> google.protobuf.Timestamp
+	43, // 34: memos.store.SlackUserSetting.link_code_expire_time:type_name -> google.protobuf.Timestamp
+	43, // 35: memos.store.DiscordUserSetting.link_code_expire_time:type_name -> google.protobuf.Timestamp
+	43, // 36: memos.store.ReadwiseUserSetting.last_sync_time:type_name -> google.protobuf.Timestamp
+	38, // 37: memos.store.ReadwiseUserSetting.book_memos:type_name -> memos.store.ReadwiseUserSetting.BookMemosEntry
+	39, // 38: memos.store.WebPushSubscriptionsUserSetting.subscriptions:type_name -> memos.store.WebPushSubscriptionsUserSetting.Subscription
+	43, // 39: memos.store.GistSyncUserSetting.last_sync_time:type_name -> google.protobuf.Timestamp
+	41, // 40: memos.store.GistSyncUserSetting.memo_gists:type_name -> memos.store.GistSyncUserSetting.MemoGistsEntry
+	42, // 41: memos.store.NotificationPreferencesUserSetting.preferences:type_name -> memos.store.NotificationPreferencesUserSetting.Preference
+	3,  // 42: memos.store.EmailDigestUserSetting.frequency:type_name -> memos.store.EmailDigestUserSetting.Frequency
+	43, // 43: memos.store.EmailDigestUserSetting.last_send_time:type_name -> google.protobuf.Timestamp
+	43, // 44: memos.store.OnThisDayUserSetting.last_notify_time:type_name -> google.protobuf.Timestamp
+	43, // 45: memos.store.SessionsUserSetting.Session.create_time:type_name -> google.protobuf.Timestamp
+	43, // 46: memos.store.SessionsUserSetting.Session.last_accessed_time:type_name -> google.protobuf.Timestamp
+	30, // 47: memos.store.SessionsUserSetting.Session.client_info:type_name -> memos.store.SessionsUserSetting.ClientInfo
+	43, // 48: memos.store.SessionsUserSetting.Session.expire_time:type_name -> google.protobuf.Timestamp
+	43, // 49: memos.store.AccessTokenUsagesUserSetting.Usage.last_used_time:type_name -> google.protobuf.Timestamp
+	1,  // 50: memos.store.ShortcutsUserSetting.Shortcut.visibility:type_name -> memos.store.ShortcutsUserSetting.Visibility
+	43, // 51: memos.store.WebPushSubscriptionsUserSetting.Subscription.create_time:type_name -> google.protobuf.Timestamp
+	43, // 52: memos.store.GistSyncUserSetting.MemoGist.gist_update_time:type_name -> google.protobuf.Timestamp
+	40, // 53: memos.store.GistSyncUserSetting.MemoGistsEntry.value:type_name -> memos.store.GistSyncUserSetting.MemoGist
+	2,  // 54: memos.store.NotificationPreferencesUserSetting.Preference.event:type_name -> memos.store.NotificationPreferencesUserSetting.Event
+	55, // [55:55] is the sub-list for method output_type
+	55, // [55:55] is the sub-list for method input_type
+	55, // [55:55] is the sub-list for extension type_name
+	55, // [55:55] is the sub-list for extension extendee
+	0,  // [0:55] is the sub-list for field type_name
 }
 
 func init() { file_store_user_setting_proto_init() }
@@ -940,14 +3468,33 @@ func file_store_user_setting_proto_init() {
 		(*UserSetting_AccessTokens)(nil),
 		(*UserSetting_Shortcuts)(nil),
 		(*UserSetting_Webhooks)(nil),
+		(*UserSetting_Tags)(nil),
+		(*UserSetting_SavedSearches)(nil),
+		(*UserSetting_FilterMacros)(nil),
+		(*UserSetting_TwoFactor)(nil),
+		(*UserSetting_AccessTokenUsages)(nil),
+		(*UserSetting_Suspension)(nil),
+		(*UserSetting_Password)(nil),
+		(*UserSetting_EmailVerification)(nil),
+		(*UserSetting_CustomRole)(nil),
+		(*UserSetting_MemoEmail)(nil),
+		(*UserSetting_Slack)(nil),
+		(*UserSetting_Discord)(nil),
+		(*UserSetting_CalendarFeed)(nil),
+		(*UserSetting_Readwise)(nil),
+		(*UserSetting_WebPushSubscriptions)(nil),
+		(*UserSetting_GistSync)(nil),
+		(*UserSetting_NotificationPreferences)(nil),
+		(*UserSetting_EmailDigest)(nil),
+		(*UserSetting_OnThisDay)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_user_setting_proto_rawDesc), len(file_store_user_setting_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   11,
+			NumEnums:      4,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -33,16 +33,52 @@ const (
 	WorkspaceSettingKey_STORAGE WorkspaceSettingKey = 3
 	// MEMO_RELATED is the key for memo related settings.
 	WorkspaceSettingKey_MEMO_RELATED WorkspaceSettingKey = 4
+	// EMBEDDING is the key for embedding settings.
+	WorkspaceSettingKey_EMBEDDING WorkspaceSettingKey = 5
+	// OCR is the key for OCR settings.
+	WorkspaceSettingKey_OCR WorkspaceSettingKey = 6
+	// MALWARE_SCAN is the key for malware scanning settings.
+	WorkspaceSettingKey_MALWARE_SCAN WorkspaceSettingKey = 7
+	// TRANSCRIPTION is the key for audio transcription settings.
+	WorkspaceSettingKey_TRANSCRIPTION WorkspaceSettingKey = 8
+	// SCIM is the key for SCIM provisioning settings.
+	WorkspaceSettingKey_SCIM WorkspaceSettingKey = 9
+	// PASSWORD_POLICY is the key for password policy settings.
+	WorkspaceSettingKey_PASSWORD_POLICY WorkspaceSettingKey = 10
+	// EMAIL is the key for email settings.
+	WorkspaceSettingKey_EMAIL WorkspaceSettingKey = 11
+	// ROLES is the key for the custom roles.
+	WorkspaceSettingKey_ROLES WorkspaceSettingKey = 12
+	// ACCESS_TOKEN_POLICY is the key for access token policy settings.
+	WorkspaceSettingKey_ACCESS_TOKEN_POLICY WorkspaceSettingKey = 13
+	// CAPTCHA is the key for CAPTCHA settings.
+	WorkspaceSettingKey_CAPTCHA WorkspaceSettingKey = 14
+	// SLACK is the key for Slack app settings.
+	WorkspaceSettingKey_SLACK WorkspaceSettingKey = 15
+	// DISCORD is the key for Discord bot settings.
+	WorkspaceSettingKey_DISCORD WorkspaceSettingKey = 16
 )
 
 // Enum value maps for WorkspaceSettingKey.
 var (
 	WorkspaceSettingKey_name = map[int32]string{
-		0: "WORKSPACE_SETTING_KEY_UNSPECIFIED",
-		1: "BASIC",
-		2: "GENERAL",
-		3: "STORAGE",
-		4: "MEMO_RELATED",
+		0:  "WORKSPACE_SETTING_KEY_UNSPECIFIED",
+		1:  "BASIC",
+		2:  "GENERAL",
+		3:  "STORAGE",
+		4:  "MEMO_RELATED",
+		5:  "EMBEDDING",
+		6:  "OCR",
+		7:  "MALWARE_SCAN",
+		8:  "TRANSCRIPTION",
+		9:  "SCIM",
+		10: "PASSWORD_POLICY",
+		11: "EMAIL",
+		12: "ROLES",
+		13: "ACCESS_TOKEN_POLICY",
+		14: "CAPTCHA",
+		15: "SLACK",
+		16: "DISCORD",
 	}
 	WorkspaceSettingKey_value = map[string]int32{
 		"WORKSPACE_SETTING_KEY_UNSPECIFIED": 0,
@@ -50,6 +86,18 @@ var (
 		"GENERAL":                           2,
 		"STORAGE":                           3,
 		"MEMO_RELATED":                      4,
+		"EMBEDDING":                         5,
+		"OCR":                               6,
+		"MALWARE_SCAN":                      7,
+		"TRANSCRIPTION":                     8,
+		"SCIM":                              9,
+		"PASSWORD_POLICY":                   10,
+		"EMAIL":                             11,
+		"ROLES":                             12,
+		"ACCESS_TOKEN_POLICY":               13,
+		"CAPTCHA":                           14,
+		"SLACK":                             15,
+		"DISCORD":                           16,
 	}
 )
 
@@ -80,6 +128,78 @@ func (WorkspaceSettingKey) EnumDescriptor() ([]byte, []int) {
 	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0}
 }
 
+// Permission is a capability granted to the users by their role.
+type Permission int32
+
+const (
+	Permission_PERMISSION_UNSPECIFIED Permission = 0
+	// MANAGE_USERS allows creating, updating, suspending and deleting the users, and managing the invitations.
+	Permission_MANAGE_USERS Permission = 1
+	// VIEW_USERS allows listing and searching all the users.
+	Permission_VIEW_USERS Permission = 2
+	// MODERATE_MEMOS allows updating and deleting the memos and comments of the other users.
+	Permission_MODERATE_MEMOS Permission = 3
+	// MANAGE_WEBHOOKS allows creating webhooks.
+	Permission_MANAGE_WEBHOOKS Permission = 4
+	// CHECK_INTEGRITY allows checking and repairing the integrity of the workspace data.
+	Permission_CHECK_INTEGRITY Permission = 5
+	// MANAGE_WORKSPACE allows updating the workspace settings, but the roles.
+	Permission_MANAGE_WORKSPACE Permission = 6
+	// MANAGE_STORAGE allows migrating the attachments between storages.
+	Permission_MANAGE_STORAGE Permission = 7
+)
+
+// Enum value maps for Permission.
+var (
+	Permission_name = map[int32]string{
+		0: "PERMISSION_UNSPECIFIED",
+		1: "MANAGE_USERS",
+		2: "VIEW_USERS",
+		3: "MODERATE_MEMOS",
+		4: "MANAGE_WEBHOOKS",
+		5: "CHECK_INTEGRITY",
+		6: "MANAGE_WORKSPACE",
+		7: "MANAGE_STORAGE",
+	}
+	Permission_value = map[string]int32{
+		"PERMISSION_UNSPECIFIED": 0,
+		"MANAGE_USERS":           1,
+		"VIEW_USERS":             2,
+		"MODERATE_MEMOS":         3,
+		"MANAGE_WEBHOOKS":        4,
+		"CHECK_INTEGRITY":        5,
+		"MANAGE_WORKSPACE":       6,
+		"MANAGE_STORAGE":         7,
+	}
+)
+
+func (x Permission) Enum() *Permission {
+	p := new(Permission)
+	*p = x
+	return p
+}
+
+func (x Permission) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Permission) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[1].Descriptor()
+}
+
+func (Permission) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[1]
+}
+
+func (x Permission) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Permission.Descriptor instead.
+func (Permission) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{1}
+}
+
 type WorkspaceStorageSetting_StorageType int32
 
 const (
@@ -90,6 +210,10 @@ const (
 	WorkspaceStorageSetting_LOCAL WorkspaceStorageSetting_StorageType = 2
 	// STORAGE_TYPE_S3 is the S3 storage type.
 	WorkspaceStorageSetting_S3 WorkspaceStorageSetting_StorageType = 3
+	// STORAGE_TYPE_GCS is the Google Cloud Storage storage type.
+	WorkspaceStorageSetting_GCS WorkspaceStorageSetting_StorageType = 4
+	// STORAGE_TYPE_SFTP is the SFTP storage type.
+	WorkspaceStorageSetting_SFTP WorkspaceStorageSetting_StorageType = 5
 )
 
 // Enum value maps for WorkspaceStorageSetting_StorageType.
@@ -99,12 +223,16 @@ var (
 		1: "DATABASE",
 		2: "LOCAL",
 		3: "S3",
+		4: "GCS",
+		5: "SFTP",
 	}
 	WorkspaceStorageSetting_StorageType_value = map[string]int32{
 		"STORAGE_TYPE_UNSPECIFIED": 0,
 		"DATABASE":                 1,
 		"LOCAL":                    2,
 		"S3":                       3,
+		"GCS":                      4,
+		"SFTP":                     5,
 	}
 )
 
@@ -119,11 +247,11 @@ func (x WorkspaceStorageSetting_StorageType) String() string {
 }
 
 func (WorkspaceStorageSetting_StorageType) Descriptor() protoreflect.EnumDescriptor {
-	return file_store_workspace_setting_proto_enumTypes[1].Descriptor()
+	return file_store_workspace_setting_proto_enumTypes[2].Descriptor()
 }
 
 func (WorkspaceStorageSetting_StorageType) Type() protoreflect.EnumType {
-	return &file_store_workspace_setting_proto_enumTypes[1]
+	return &file_store_workspace_setting_proto_enumTypes[2]
 }
 
 func (x WorkspaceStorageSetting_StorageType) Number() protoreflect.EnumNumber {
@@ -135,6 +263,375 @@ func (WorkspaceStorageSetting_StorageType) EnumDescriptor() ([]byte, []int) {
 	return file_store_workspace_setting_proto_rawDescGZIP(), []int{4, 0}
 }
 
+type WorkspaceStorageSetting_ImageCompression_Format int32
+
+const (
+	// FORMAT_UNSPECIFIED keeps the format of the images.
+	WorkspaceStorageSetting_ImageCompression_FORMAT_UNSPECIFIED WorkspaceStorageSetting_ImageCompression_Format = 0
+	WorkspaceStorageSetting_ImageCompression_JPEG               WorkspaceStorageSetting_ImageCompression_Format = 1
+	// WEBP requires ffmpeg.
+	WorkspaceStorageSetting_ImageCompression_WEBP WorkspaceStorageSetting_ImageCompression_Format = 2
+	// AVIF requires ffmpeg.
+	WorkspaceStorageSetting_ImageCompression_AVIF WorkspaceStorageSetting_ImageCompression_Format = 3
+)
+
+// Enum value maps for WorkspaceStorageSetting_ImageCompression_Format.
+var (
+	WorkspaceStorageSetting_ImageCompression_Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "JPEG",
+		2: "WEBP",
+		3: "AVIF",
+	}
+	WorkspaceStorageSetting_ImageCompression_Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"JPEG":               1,
+		"WEBP":               2,
+		"AVIF":               3,
+	}
+)
+
+func (x WorkspaceStorageSetting_ImageCompression_Format) Enum() *WorkspaceStorageSetting_ImageCompression_Format {
+	p := new(WorkspaceStorageSetting_ImageCompression_Format)
+	*p = x
+	return p
+}
+
+func (x WorkspaceStorageSetting_ImageCompression_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceStorageSetting_ImageCompression_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[3].Descriptor()
+}
+
+func (WorkspaceStorageSetting_ImageCompression_Format) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[3]
+}
+
+func (x WorkspaceStorageSetting_ImageCompression_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceStorageSetting_ImageCompression_Format.Descriptor instead.
+func (WorkspaceStorageSetting_ImageCompression_Format) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{4, 0, 0}
+}
+
+type WorkspaceEmbeddingSetting_Provider int32
+
+const (
+	WorkspaceEmbeddingSetting_PROVIDER_UNSPECIFIED WorkspaceEmbeddingSetting_Provider = 0
+	// OPENAI is any embeddings API compatible with OpenAI, e.g. OpenAI, Ollama or LocalAI.
+	WorkspaceEmbeddingSetting_OPENAI WorkspaceEmbeddingSetting_Provider = 1
+	// LOCAL is the built-in embedding model, which runs without any external service.
+	WorkspaceEmbeddingSetting_LOCAL WorkspaceEmbeddingSetting_Provider = 2
+)
+
+// Enum value maps for WorkspaceEmbeddingSetting_Provider.
+var (
+	WorkspaceEmbeddingSetting_Provider_name = map[int32]string{
+		0: "PROVIDER_UNSPECIFIED",
+		1: "OPENAI",
+		2: "LOCAL",
+	}
+	WorkspaceEmbeddingSetting_Provider_value = map[string]int32{
+		"PROVIDER_UNSPECIFIED": 0,
+		"OPENAI":               1,
+		"LOCAL":                2,
+	}
+)
+
+func (x WorkspaceEmbeddingSetting_Provider) Enum() *WorkspaceEmbeddingSetting_Provider {
+	p := new(WorkspaceEmbeddingSetting_Provider)
+	*p = x
+	return p
+}
+
+func (x WorkspaceEmbeddingSetting_Provider) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceEmbeddingSetting_Provider) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[4].Descriptor()
+}
+
+func (WorkspaceEmbeddingSetting_Provider) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[4]
+}
+
+func (x WorkspaceEmbeddingSetting_Provider) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceEmbeddingSetting_Provider.Descriptor instead.
+func (WorkspaceEmbeddingSetting_Provider) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{9, 0}
+}
+
+type WorkspaceOCRSetting_Provider int32
+
+const (
+	WorkspaceOCRSetting_PROVIDER_UNSPECIFIED WorkspaceOCRSetting_Provider = 0
+	// TESSERACT runs the Tesseract command line tool installed on the server.
+	WorkspaceOCRSetting_TESSERACT WorkspaceOCRSetting_Provider = 1
+	// API posts the image to an external HTTP API, which responds with a JSON object like {"text": "..."}.
+	WorkspaceOCRSetting_API WorkspaceOCRSetting_Provider = 2
+)
+
+// Enum value maps for WorkspaceOCRSetting_Provider.
+var (
+	WorkspaceOCRSetting_Provider_name = map[int32]string{
+		0: "PROVIDER_UNSPECIFIED",
+		1: "TESSERACT",
+		2: "API",
+	}
+	WorkspaceOCRSetting_Provider_value = map[string]int32{
+		"PROVIDER_UNSPECIFIED": 0,
+		"TESSERACT":            1,
+		"API":                  2,
+	}
+)
+
+func (x WorkspaceOCRSetting_Provider) Enum() *WorkspaceOCRSetting_Provider {
+	p := new(WorkspaceOCRSetting_Provider)
+	*p = x
+	return p
+}
+
+func (x WorkspaceOCRSetting_Provider) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceOCRSetting_Provider) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[5].Descriptor()
+}
+
+func (WorkspaceOCRSetting_Provider) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[5]
+}
+
+func (x WorkspaceOCRSetting_Provider) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceOCRSetting_Provider.Descriptor instead.
+func (WorkspaceOCRSetting_Provider) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{10, 0}
+}
+
+type WorkspaceMalwareScanSetting_Scanner int32
+
+const (
+	WorkspaceMalwareScanSetting_SCANNER_UNSPECIFIED WorkspaceMalwareScanSetting_Scanner = 0
+	// CLAMAV streams the file to a clamd daemon.
+	WorkspaceMalwareScanSetting_CLAMAV WorkspaceMalwareScanSetting_Scanner = 1
+	// COMMAND runs a command line with the path of the file appended, which exits with 1 if the file is flagged, like clamscan.
+	WorkspaceMalwareScanSetting_COMMAND WorkspaceMalwareScanSetting_Scanner = 2
+	// API posts the file to an external HTTP API, which responds with a JSON object like {"infected": true, "signature": "..."}.
+	WorkspaceMalwareScanSetting_API WorkspaceMalwareScanSetting_Scanner = 3
+)
+
+// Enum value maps for WorkspaceMalwareScanSetting_Scanner.
+var (
+	WorkspaceMalwareScanSetting_Scanner_name = map[int32]string{
+		0: "SCANNER_UNSPECIFIED",
+		1: "CLAMAV",
+		2: "COMMAND",
+		3: "API",
+	}
+	WorkspaceMalwareScanSetting_Scanner_value = map[string]int32{
+		"SCANNER_UNSPECIFIED": 0,
+		"CLAMAV":              1,
+		"COMMAND":             2,
+		"API":                 3,
+	}
+)
+
+func (x WorkspaceMalwareScanSetting_Scanner) Enum() *WorkspaceMalwareScanSetting_Scanner {
+	p := new(WorkspaceMalwareScanSetting_Scanner)
+	*p = x
+	return p
+}
+
+func (x WorkspaceMalwareScanSetting_Scanner) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceMalwareScanSetting_Scanner) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[6].Descriptor()
+}
+
+func (WorkspaceMalwareScanSetting_Scanner) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[6]
+}
+
+func (x WorkspaceMalwareScanSetting_Scanner) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceMalwareScanSetting_Scanner.Descriptor instead.
+func (WorkspaceMalwareScanSetting_Scanner) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{11, 0}
+}
+
+type WorkspaceMalwareScanSetting_Action int32
+
+const (
+	WorkspaceMalwareScanSetting_ACTION_UNSPECIFIED WorkspaceMalwareScanSetting_Action = 0
+	// REJECT rejects the flagged files.
+	WorkspaceMalwareScanSetting_REJECT WorkspaceMalwareScanSetting_Action = 1
+	// QUARANTINE rejects the flagged files, and keeps a copy of them in the quarantine directory of the data directory for review.
+	WorkspaceMalwareScanSetting_QUARANTINE WorkspaceMalwareScanSetting_Action = 2
+)
+
+// Enum value maps for WorkspaceMalwareScanSetting_Action.
+var (
+	WorkspaceMalwareScanSetting_Action_name = map[int32]string{
+		0: "ACTION_UNSPECIFIED",
+		1: "REJECT",
+		2: "QUARANTINE",
+	}
+	WorkspaceMalwareScanSetting_Action_value = map[string]int32{
+		"ACTION_UNSPECIFIED": 0,
+		"REJECT":             1,
+		"QUARANTINE":         2,
+	}
+)
+
+func (x WorkspaceMalwareScanSetting_Action) Enum() *WorkspaceMalwareScanSetting_Action {
+	p := new(WorkspaceMalwareScanSetting_Action)
+	*p = x
+	return p
+}
+
+func (x WorkspaceMalwareScanSetting_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceMalwareScanSetting_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[7].Descriptor()
+}
+
+func (WorkspaceMalwareScanSetting_Action) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[7]
+}
+
+func (x WorkspaceMalwareScanSetting_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceMalwareScanSetting_Action.Descriptor instead.
+func (WorkspaceMalwareScanSetting_Action) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{11, 1}
+}
+
+type WorkspaceTranscriptionSetting_Provider int32
+
+const (
+	WorkspaceTranscriptionSetting_PROVIDER_UNSPECIFIED WorkspaceTranscriptionSetting_Provider = 0
+	// WHISPER_CPP runs the whisper.cpp command line tool installed on the server.
+	WorkspaceTranscriptionSetting_WHISPER_CPP WorkspaceTranscriptionSetting_Provider = 1
+	// OPENAI posts the audio to a transcriptions API compatible with OpenAI.
+	WorkspaceTranscriptionSetting_OPENAI WorkspaceTranscriptionSetting_Provider = 2
+)
+
+// Enum value maps for WorkspaceTranscriptionSetting_Provider.
+var (
+	WorkspaceTranscriptionSetting_Provider_name = map[int32]string{
+		0: "PROVIDER_UNSPECIFIED",
+		1: "WHISPER_CPP",
+		2: "OPENAI",
+	}
+	WorkspaceTranscriptionSetting_Provider_value = map[string]int32{
+		"PROVIDER_UNSPECIFIED": 0,
+		"WHISPER_CPP":          1,
+		"OPENAI":               2,
+	}
+)
+
+func (x WorkspaceTranscriptionSetting_Provider) Enum() *WorkspaceTranscriptionSetting_Provider {
+	p := new(WorkspaceTranscriptionSetting_Provider)
+	*p = x
+	return p
+}
+
+func (x WorkspaceTranscriptionSetting_Provider) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceTranscriptionSetting_Provider) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[8].Descriptor()
+}
+
+func (WorkspaceTranscriptionSetting_Provider) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[8]
+}
+
+func (x WorkspaceTranscriptionSetting_Provider) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceTranscriptionSetting_Provider.Descriptor instead.
+func (WorkspaceTranscriptionSetting_Provider) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{12, 0}
+}
+
+type WorkspaceCaptchaSetting_Provider int32
+
+const (
+	WorkspaceCaptchaSetting_PROVIDER_UNSPECIFIED WorkspaceCaptchaSetting_Provider = 0
+	// TURNSTILE verifies the tokens of Cloudflare Turnstile.
+	WorkspaceCaptchaSetting_TURNSTILE WorkspaceCaptchaSetting_Provider = 1
+	// HCAPTCHA verifies the tokens of hCaptcha.
+	WorkspaceCaptchaSetting_HCAPTCHA WorkspaceCaptchaSetting_Provider = 2
+	// PROOF_OF_WORK is the built-in proof-of-work challenge, which runs without any external service.
+	WorkspaceCaptchaSetting_PROOF_OF_WORK WorkspaceCaptchaSetting_Provider = 3
+)
+
+// Enum value maps for WorkspaceCaptchaSetting_Provider.
+var (
+	WorkspaceCaptchaSetting_Provider_name = map[int32]string{
+		0: "PROVIDER_UNSPECIFIED",
+		1: "TURNSTILE",
+		2: "HCAPTCHA",
+		3: "PROOF_OF_WORK",
+	}
+	WorkspaceCaptchaSetting_Provider_value = map[string]int32{
+		"PROVIDER_UNSPECIFIED": 0,
+		"TURNSTILE":            1,
+		"HCAPTCHA":             2,
+		"PROOF_OF_WORK":        3,
+	}
+)
+
+func (x WorkspaceCaptchaSetting_Provider) Enum() *WorkspaceCaptchaSetting_Provider {
+	p := new(WorkspaceCaptchaSetting_Provider)
+	*p = x
+	return p
+}
+
+func (x WorkspaceCaptchaSetting_Provider) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkspaceCaptchaSetting_Provider) Descriptor() protoreflect.EnumDescriptor {
+	return file_store_workspace_setting_proto_enumTypes[9].Descriptor()
+}
+
+func (WorkspaceCaptchaSetting_Provider) Type() protoreflect.EnumType {
+	return &file_store_workspace_setting_proto_enumTypes[9]
+}
+
+func (x WorkspaceCaptchaSetting_Provider) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkspaceCaptchaSetting_Provider.Descriptor instead.
+func (WorkspaceCaptchaSetting_Provider) EnumDescriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{16, 0}
+}
+
 type WorkspaceSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Key   WorkspaceSettingKey    `protobuf:"varint,1,opt,name=key,proto3,enum=memos.store.WorkspaceSettingKey" json:"key,omitempty"`
@@ -144,26 +641,1396 @@ type WorkspaceSetting struct {
 	//	*WorkspaceSetting_GeneralSetting
 	//	*WorkspaceSetting_StorageSetting
 	//	*WorkspaceSetting_MemoRelatedSetting
+	//	*WorkspaceSetting_EmbeddingSetting
+	//	*WorkspaceSetting_OcrSetting
+	//	*WorkspaceSetting_MalwareScanSetting
+	//	*WorkspaceSetting_TranscriptionSetting
+	//	*WorkspaceSetting_ScimSetting
+	//	*WorkspaceSetting_PasswordPolicySetting
+	//	*WorkspaceSetting_EmailSetting
+	//	*WorkspaceSetting_RolesSetting
+	//	*WorkspaceSetting_AccessTokenPolicySetting
+	//	*WorkspaceSetting_CaptchaSetting
+	//	*WorkspaceSetting_SlackSetting
+	//	*WorkspaceSetting_DiscordSetting
 	Value         isWorkspaceSetting_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkspaceSetting) Reset() {
-	*x = WorkspaceSetting{}
-	mi := &file_store_workspace_setting_proto_msgTypes[0]
+func (x *WorkspaceSetting) Reset() {
+	*x = WorkspaceSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSetting) ProtoMessage() {}
+
+func (x *WorkspaceSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WorkspaceSetting) GetKey() WorkspaceSettingKey {
+	if x != nil {
+		return x.Key
+	}
+	return WorkspaceSettingKey_WORKSPACE_SETTING_KEY_UNSPECIFIED
+}
+
+func (x *WorkspaceSetting) GetValue() isWorkspaceSetting_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetBasicSetting() *WorkspaceBasicSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_BasicSetting); ok {
+			return x.BasicSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetGeneralSetting() *WorkspaceGeneralSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_GeneralSetting); ok {
+			return x.GeneralSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetStorageSetting() *WorkspaceStorageSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_StorageSetting); ok {
+			return x.StorageSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetMemoRelatedSetting() *WorkspaceMemoRelatedSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_MemoRelatedSetting); ok {
+			return x.MemoRelatedSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetEmbeddingSetting() *WorkspaceEmbeddingSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_EmbeddingSetting); ok {
+			return x.EmbeddingSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetOcrSetting() *WorkspaceOCRSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_OcrSetting); ok {
+			return x.OcrSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetMalwareScanSetting() *WorkspaceMalwareScanSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_MalwareScanSetting); ok {
+			return x.MalwareScanSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetTranscriptionSetting() *WorkspaceTranscriptionSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_TranscriptionSetting); ok {
+			return x.TranscriptionSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetScimSetting() *WorkspaceSCIMSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_ScimSetting); ok {
+			return x.ScimSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetPasswordPolicySetting() *WorkspacePasswordPolicySetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_PasswordPolicySetting); ok {
+			return x.PasswordPolicySetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetEmailSetting() *WorkspaceEmailSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_EmailSetting); ok {
+			return x.EmailSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetRolesSetting() *WorkspaceRolesSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_RolesSetting); ok {
+			return x.RolesSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetAccessTokenPolicySetting() *WorkspaceAccessTokenPolicySetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_AccessTokenPolicySetting); ok {
+			return x.AccessTokenPolicySetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetCaptchaSetting() *WorkspaceCaptchaSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_CaptchaSetting); ok {
+			return x.CaptchaSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetSlackSetting() *WorkspaceSlackSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_SlackSetting); ok {
+			return x.SlackSetting
+		}
+	}
+	return nil
+}
+
+func (x *WorkspaceSetting) GetDiscordSetting() *WorkspaceDiscordSetting {
+	if x != nil {
+		if x, ok := x.Value.(*WorkspaceSetting_DiscordSetting); ok {
+			return x.DiscordSetting
+		}
+	}
+	return nil
+}
+
+type isWorkspaceSetting_Value interface {
+	isWorkspaceSetting_Value()
+}
+
+type WorkspaceSetting_BasicSetting struct {
+	BasicSetting *WorkspaceBasicSetting `protobuf:"bytes,2,opt,name=basic_setting,json=basicSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_GeneralSetting struct {
+	GeneralSetting *WorkspaceGeneralSetting `protobuf:"bytes,3,opt,name=general_setting,json=generalSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_StorageSetting struct {
+	StorageSetting *WorkspaceStorageSetting `protobuf:"bytes,4,opt,name=storage_setting,json=storageSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_MemoRelatedSetting struct {
+	MemoRelatedSetting *WorkspaceMemoRelatedSetting `protobuf:"bytes,5,opt,name=memo_related_setting,json=memoRelatedSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_EmbeddingSetting struct {
+	EmbeddingSetting *WorkspaceEmbeddingSetting `protobuf:"bytes,6,opt,name=embedding_setting,json=embeddingSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_OcrSetting struct {
+	OcrSetting *WorkspaceOCRSetting `protobuf:"bytes,7,opt,name=ocr_setting,json=ocrSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_MalwareScanSetting struct {
+	MalwareScanSetting *WorkspaceMalwareScanSetting `protobuf:"bytes,8,opt,name=malware_scan_setting,json=malwareScanSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_TranscriptionSetting struct {
+	TranscriptionSetting *WorkspaceTranscriptionSetting `protobuf:"bytes,9,opt,name=transcription_setting,json=transcriptionSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_ScimSetting struct {
+	ScimSetting *WorkspaceSCIMSetting `protobuf:"bytes,10,opt,name=scim_setting,json=scimSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_PasswordPolicySetting struct {
+	PasswordPolicySetting *WorkspacePasswordPolicySetting `protobuf:"bytes,11,opt,name=password_policy_setting,json=passwordPolicySetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_EmailSetting struct {
+	EmailSetting *WorkspaceEmailSetting `protobuf:"bytes,12,opt,name=email_setting,json=emailSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_RolesSetting struct {
+	RolesSetting *WorkspaceRolesSetting `protobuf:"bytes,13,opt,name=roles_setting,json=rolesSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_AccessTokenPolicySetting struct {
+	AccessTokenPolicySetting *WorkspaceAccessTokenPolicySetting `protobuf:"bytes,14,opt,name=access_token_policy_setting,json=accessTokenPolicySetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_CaptchaSetting struct {
+	CaptchaSetting *WorkspaceCaptchaSetting `protobuf:"bytes,15,opt,name=captcha_setting,json=captchaSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_SlackSetting struct {
+	SlackSetting *WorkspaceSlackSetting `protobuf:"bytes,16,opt,name=slack_setting,json=slackSetting,proto3,oneof"`
+}
+
+type WorkspaceSetting_DiscordSetting struct {
+	DiscordSetting *WorkspaceDiscordSetting `protobuf:"bytes,17,opt,name=discord_setting,json=discordSetting,proto3,oneof"`
+}
+
+func (*WorkspaceSetting_BasicSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_GeneralSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_StorageSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_MemoRelatedSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_EmbeddingSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_OcrSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_MalwareScanSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_TranscriptionSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_ScimSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_PasswordPolicySetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_EmailSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_RolesSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_AccessTokenPolicySetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_CaptchaSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_SlackSetting) isWorkspaceSetting_Value() {}
+
+func (*WorkspaceSetting_DiscordSetting) isWorkspaceSetting_Value() {}
+
+type WorkspaceBasicSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The secret key for workspace. Mainly used for session management.
+	SecretKey string `protobuf:"bytes,1,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	// The current schema version of database.
+	SchemaVersion string `protobuf:"bytes,2,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	// The VAPID key pair identifying the workspace to the Web Push services, base64url-encoded.
+	VapidPrivateKey string `protobuf:"bytes,3,opt,name=vapid_private_key,json=vapidPrivateKey,proto3" json:"vapid_private_key,omitempty"`
+	VapidPublicKey  string `protobuf:"bytes,4,opt,name=vapid_public_key,json=vapidPublicKey,proto3" json:"vapid_public_key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WorkspaceBasicSetting) Reset() {
+	*x = WorkspaceBasicSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceBasicSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceBasicSetting) ProtoMessage() {}
+
+func (x *WorkspaceBasicSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceBasicSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceBasicSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WorkspaceBasicSetting) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+func (x *WorkspaceBasicSetting) GetSchemaVersion() string {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return ""
+}
+
+func (x *WorkspaceBasicSetting) GetVapidPrivateKey() string {
+	if x != nil {
+		return x.VapidPrivateKey
+	}
+	return ""
+}
+
+func (x *WorkspaceBasicSetting) GetVapidPublicKey() string {
+	if x != nil {
+		return x.VapidPublicKey
+	}
+	return ""
+}
+
+type WorkspaceGeneralSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// theme is the name of the selected theme.
+	// This references a CSS file in the web/public/themes/ directory.
+	Theme string `protobuf:"bytes,1,opt,name=theme,proto3" json:"theme,omitempty"`
+	// disallow_user_registration disallows user registration.
+	DisallowUserRegistration bool `protobuf:"varint,2,opt,name=disallow_user_registration,json=disallowUserRegistration,proto3" json:"disallow_user_registration,omitempty"`
+	// disallow_password_auth disallows password authentication.
+	DisallowPasswordAuth bool `protobuf:"varint,3,opt,name=disallow_password_auth,json=disallowPasswordAuth,proto3" json:"disallow_password_auth,omitempty"`
+	// additional_script is the additional script.
+	AdditionalScript string `protobuf:"bytes,4,opt,name=additional_script,json=additionalScript,proto3" json:"additional_script,omitempty"`
+	// additional_style is the additional style.
+	AdditionalStyle string `protobuf:"bytes,5,opt,name=additional_style,json=additionalStyle,proto3" json:"additional_style,omitempty"`
+	// custom_profile is the custom profile.
+	CustomProfile *WorkspaceCustomProfile `protobuf:"bytes,6,opt,name=custom_profile,json=customProfile,proto3" json:"custom_profile,omitempty"`
+	// week_start_day_offset is the week start day offset from Sunday.
+	// 0: Sunday, 1: Monday, 2: Tuesday, 3: Wednesday, 4: Thursday, 5: Friday, 6: Saturday
+	// Default is Sunday.
+	WeekStartDayOffset int32 `protobuf:"varint,7,opt,name=week_start_day_offset,json=weekStartDayOffset,proto3" json:"week_start_day_offset,omitempty"`
+	// disallow_change_username disallows changing username.
+	DisallowChangeUsername bool `protobuf:"varint,8,opt,name=disallow_change_username,json=disallowChangeUsername,proto3" json:"disallow_change_username,omitempty"`
+	// disallow_change_nickname disallows changing nickname.
+	DisallowChangeNickname bool `protobuf:"varint,9,opt,name=disallow_change_nickname,json=disallowChangeNickname,proto3" json:"disallow_change_nickname,omitempty"`
+	// require_admin_two_factor requires the host and admins to enable two-factor authentication.
+	RequireAdminTwoFactor bool `protobuf:"varint,10,opt,name=require_admin_two_factor,json=requireAdminTwoFactor,proto3" json:"require_admin_two_factor,omitempty"`
+	// sso_only disables the password sign in and sign up, to authenticate with the identity providers only.
+	// The host keeps the password sign in, as a break-glass account for when the identity providers are down.
+	SsoOnly bool `protobuf:"varint,11,opt,name=sso_only,json=ssoOnly,proto3" json:"sso_only,omitempty"`
+	// inbox_retention_days is the number of days the read inbox messages are kept for.
+	// The read inbox messages are kept forever if 0.
+	InboxRetentionDays int32 `protobuf:"varint,12,opt,name=inbox_retention_days,json=inboxRetentionDays,proto3" json:"inbox_retention_days,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *WorkspaceGeneralSetting) Reset() {
+	*x = WorkspaceGeneralSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceGeneralSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceGeneralSetting) ProtoMessage() {}
+
+func (x *WorkspaceGeneralSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceGeneralSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceGeneralSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WorkspaceGeneralSetting) GetTheme() string {
+	if x != nil {
+		return x.Theme
+	}
+	return ""
+}
+
+func (x *WorkspaceGeneralSetting) GetDisallowUserRegistration() bool {
+	if x != nil {
+		return x.DisallowUserRegistration
+	}
+	return false
+}
+
+func (x *WorkspaceGeneralSetting) GetDisallowPasswordAuth() bool {
+	if x != nil {
+		return x.DisallowPasswordAuth
+	}
+	return false
+}
+
+func (x *WorkspaceGeneralSetting) GetAdditionalScript() string {
+	if x != nil {
+		return x.AdditionalScript
+	}
+	return ""
+}
+
+func (x *WorkspaceGeneralSetting) GetAdditionalStyle() string {
+	if x != nil {
+		return x.AdditionalStyle
+	}
+	return ""
+}
+
+func (x *WorkspaceGeneralSetting) GetCustomProfile() *WorkspaceCustomProfile {
+	if x != nil {
+		return x.CustomProfile
+	}
+	return nil
+}
+
+func (x *WorkspaceGeneralSetting) GetWeekStartDayOffset() int32 {
+	if x != nil {
+		return x.WeekStartDayOffset
+	}
+	return 0
+}
+
+func (x *WorkspaceGeneralSetting) GetDisallowChangeUsername() bool {
+	if x != nil {
+		return x.DisallowChangeUsername
+	}
+	return false
+}
+
+func (x *WorkspaceGeneralSetting) GetDisallowChangeNickname() bool {
+	if x != nil {
+		return x.DisallowChangeNickname
+	}
+	return false
+}
+
+func (x *WorkspaceGeneralSetting) GetRequireAdminTwoFactor() bool {
+	if x != nil {
+		return x.RequireAdminTwoFactor
+	}
+	return false
+}
+
+func (x *WorkspaceGeneralSetting) GetSsoOnly() bool {
+	if x != nil {
+		return x.SsoOnly
+	}
+	return false
+}
+
+func (x *WorkspaceGeneralSetting) GetInboxRetentionDays() int32 {
+	if x != nil {
+		return x.InboxRetentionDays
+	}
+	return 0
+}
+
+type WorkspaceCustomProfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	LogoUrl       string                 `protobuf:"bytes,3,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	Locale        string                 `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
+	Appearance    string                 `protobuf:"bytes,5,opt,name=appearance,proto3" json:"appearance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceCustomProfile) Reset() {
+	*x = WorkspaceCustomProfile{}
+	mi := &file_store_workspace_setting_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceCustomProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceCustomProfile) ProtoMessage() {}
+
+func (x *WorkspaceCustomProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceCustomProfile.ProtoReflect.Descriptor instead.
+func (*WorkspaceCustomProfile) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WorkspaceCustomProfile) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *WorkspaceCustomProfile) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WorkspaceCustomProfile) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *WorkspaceCustomProfile) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *WorkspaceCustomProfile) GetAppearance() string {
+	if x != nil {
+		return x.Appearance
+	}
+	return ""
+}
+
+type WorkspaceStorageSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// storage_type is the storage type.
+	StorageType WorkspaceStorageSetting_StorageType `protobuf:"varint,1,opt,name=storage_type,json=storageType,proto3,enum=memos.store.WorkspaceStorageSetting_StorageType" json:"storage_type,omitempty"`
+	// The template of file path.
+	// e.g. assets/{timestamp}_{filename}
+	// {hash} is the SHA-256 hash of the content, e.g. assets/{hash} stores the files by their content.
+	FilepathTemplate string `protobuf:"bytes,2,opt,name=filepath_template,json=filepathTemplate,proto3" json:"filepath_template,omitempty"`
+	// The max upload size in megabytes.
+	UploadSizeLimitMb int64 `protobuf:"varint,3,opt,name=upload_size_limit_mb,json=uploadSizeLimitMb,proto3" json:"upload_size_limit_mb,omitempty"`
+	// The S3 config.
+	S3Config *StorageS3Config `protobuf:"bytes,4,opt,name=s3_config,json=s3Config,proto3" json:"s3_config,omitempty"`
+	// The Google Cloud Storage config.
+	GcsConfig *StorageGCSConfig `protobuf:"bytes,5,opt,name=gcs_config,json=gcsConfig,proto3" json:"gcs_config,omitempty"`
+	// The SFTP config.
+	SftpConfig *StorageSFTPConfig `protobuf:"bytes,6,opt,name=sftp_config,json=sftpConfig,proto3" json:"sftp_config,omitempty"`
+	// strip_image_metadata strips the EXIF metadata, e.g. the GPS position and camera, of the uploaded images.
+	StripImageMetadata bool `protobuf:"varint,7,opt,name=strip_image_metadata,json=stripImageMetadata,proto3" json:"strip_image_metadata,omitempty"`
+	// keep_image_orientation keeps the EXIF orientation of the stripped images, so that they are displayed upright.
+	KeepImageOrientation bool `protobuf:"varint,8,opt,name=keep_image_orientation,json=keepImageOrientation,proto3" json:"keep_image_orientation,omitempty"`
+	// image_compression recompresses the uploaded images.
+	ImageCompression *WorkspaceStorageSetting_ImageCompression `protobuf:"bytes,9,opt,name=image_compression,json=imageCompression,proto3" json:"image_compression,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WorkspaceStorageSetting) Reset() {
+	*x = WorkspaceStorageSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceStorageSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceStorageSetting) ProtoMessage() {}
+
+func (x *WorkspaceStorageSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceStorageSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceStorageSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WorkspaceStorageSetting) GetStorageType() WorkspaceStorageSetting_StorageType {
+	if x != nil {
+		return x.StorageType
+	}
+	return WorkspaceStorageSetting_STORAGE_TYPE_UNSPECIFIED
+}
+
+func (x *WorkspaceStorageSetting) GetFilepathTemplate() string {
+	if x != nil {
+		return x.FilepathTemplate
+	}
+	return ""
+}
+
+func (x *WorkspaceStorageSetting) GetUploadSizeLimitMb() int64 {
+	if x != nil {
+		return x.UploadSizeLimitMb
+	}
+	return 0
+}
+
+func (x *WorkspaceStorageSetting) GetS3Config() *StorageS3Config {
+	if x != nil {
+		return x.S3Config
+	}
+	return nil
+}
+
+func (x *WorkspaceStorageSetting) GetGcsConfig() *StorageGCSConfig {
+	if x != nil {
+		return x.GcsConfig
+	}
+	return nil
+}
+
+func (x *WorkspaceStorageSetting) GetSftpConfig() *StorageSFTPConfig {
+	if x != nil {
+		return x.SftpConfig
+	}
+	return nil
+}
+
+func (x *WorkspaceStorageSetting) GetStripImageMetadata() bool {
+	if x != nil {
+		return x.StripImageMetadata
+	}
+	return false
+}
+
+func (x *WorkspaceStorageSetting) GetKeepImageOrientation() bool {
+	if x != nil {
+		return x.KeepImageOrientation
+	}
+	return false
+}
+
+func (x *WorkspaceStorageSetting) GetImageCompression() *WorkspaceStorageSetting_ImageCompression {
+	if x != nil {
+		return x.ImageCompression
+	}
+	return nil
+}
+
+// Reference: https://developers.cloudflare.com/r2/examples/aws/aws-sdk-go/
+type StorageS3Config struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccessKeyId     string                 `protobuf:"bytes,1,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
+	AccessKeySecret string                 `protobuf:"bytes,2,opt,name=access_key_secret,json=accessKeySecret,proto3" json:"access_key_secret,omitempty"`
+	Endpoint        string                 `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Region          string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	Bucket          string                 `protobuf:"bytes,5,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	UsePathStyle    bool                   `protobuf:"varint,6,opt,name=use_path_style,json=usePathStyle,proto3" json:"use_path_style,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StorageS3Config) Reset() {
+	*x = StorageS3Config{}
+	mi := &file_store_workspace_setting_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorageS3Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageS3Config) ProtoMessage() {}
+
+func (x *StorageS3Config) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageS3Config.ProtoReflect.Descriptor instead.
+func (*StorageS3Config) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StorageS3Config) GetAccessKeyId() string {
+	if x != nil {
+		return x.AccessKeyId
+	}
+	return ""
+}
+
+func (x *StorageS3Config) GetAccessKeySecret() string {
+	if x != nil {
+		return x.AccessKeySecret
+	}
+	return ""
+}
+
+func (x *StorageS3Config) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *StorageS3Config) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *StorageS3Config) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *StorageS3Config) GetUsePathStyle() bool {
+	if x != nil {
+		return x.UsePathStyle
+	}
+	return false
+}
+
+// Reference: https://cloud.google.com/storage/docs/authentication#service_accounts
+type StorageGCSConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// credentials is the JSON key of the service account.
+	Credentials string `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	Bucket      string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// endpoint overrides https://storage.googleapis.com, e.g. for an emulator.
+	Endpoint      string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StorageGCSConfig) Reset() {
+	*x = StorageGCSConfig{}
+	mi := &file_store_workspace_setting_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorageGCSConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageGCSConfig) ProtoMessage() {}
+
+func (x *StorageGCSConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageGCSConfig.ProtoReflect.Descriptor instead.
+func (*StorageGCSConfig) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StorageGCSConfig) GetCredentials() string {
+	if x != nil {
+		return x.Credentials
+	}
+	return ""
+}
+
+func (x *StorageGCSConfig) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *StorageGCSConfig) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+type StorageSFTPConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// host is the address of the server, with an optional port which defaults to 22.
+	Host     string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// password authenticates the user, unless the private key does.
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// private_key is the PEM encoded private key of the user.
+	PrivateKey string `protobuf:"bytes,4,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	// host_key is the public key of the server, in the authorized_keys format of ssh-keyscan.
+	HostKey       string `protobuf:"bytes,5,opt,name=host_key,json=hostKey,proto3" json:"host_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StorageSFTPConfig) Reset() {
+	*x = StorageSFTPConfig{}
+	mi := &file_store_workspace_setting_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorageSFTPConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageSFTPConfig) ProtoMessage() {}
+
+func (x *StorageSFTPConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageSFTPConfig.ProtoReflect.Descriptor instead.
+func (*StorageSFTPConfig) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StorageSFTPConfig) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *StorageSFTPConfig) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *StorageSFTPConfig) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *StorageSFTPConfig) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *StorageSFTPConfig) GetHostKey() string {
+	if x != nil {
+		return x.HostKey
+	}
+	return ""
+}
+
+type WorkspaceMemoRelatedSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// disallow_public_visibility disallows set memo as public visibility.
+	DisallowPublicVisibility bool `protobuf:"varint,1,opt,name=disallow_public_visibility,json=disallowPublicVisibility,proto3" json:"disallow_public_visibility,omitempty"`
+	// display_with_update_time orders and displays memo with update time.
+	DisplayWithUpdateTime bool `protobuf:"varint,2,opt,name=display_with_update_time,json=displayWithUpdateTime,proto3" json:"display_with_update_time,omitempty"`
+	// content_length_limit is the limit of content length. Unit is byte.
+	ContentLengthLimit int32 `protobuf:"varint,3,opt,name=content_length_limit,json=contentLengthLimit,proto3" json:"content_length_limit,omitempty"`
+	// enable_double_click_edit enables editing on double click.
+	EnableDoubleClickEdit bool `protobuf:"varint,4,opt,name=enable_double_click_edit,json=enableDoubleClickEdit,proto3" json:"enable_double_click_edit,omitempty"`
+	// enable_link_preview enables links preview.
+	EnableLinkPreview bool `protobuf:"varint,5,opt,name=enable_link_preview,json=enableLinkPreview,proto3" json:"enable_link_preview,omitempty"`
+	// enable_comment enables comment.
+	EnableComment bool `protobuf:"varint,6,opt,name=enable_comment,json=enableComment,proto3" json:"enable_comment,omitempty"`
+	// reactions is the list of reactions.
+	Reactions []string `protobuf:"bytes,7,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	// disable markdown shortcuts
+	DisableMarkdownShortcuts bool `protobuf:"varint,8,opt,name=disable_markdown_shortcuts,json=disableMarkdownShortcuts,proto3" json:"disable_markdown_shortcuts,omitempty"`
+	// enable_blur_nsfw_content enables blurring of content marked as not safe for work (NSFW).
+	EnableBlurNsfwContent bool `protobuf:"varint,9,opt,name=enable_blur_nsfw_content,json=enableBlurNsfwContent,proto3" json:"enable_blur_nsfw_content,omitempty"`
+	// nsfw_tags is the list of tags that mark content as NSFW for blurring.
+	NsfwTags      []string `protobuf:"bytes,10,rep,name=nsfw_tags,json=nsfwTags,proto3" json:"nsfw_tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceMemoRelatedSetting) Reset() {
+	*x = WorkspaceMemoRelatedSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceMemoRelatedSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceMemoRelatedSetting) ProtoMessage() {}
+
+func (x *WorkspaceMemoRelatedSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceMemoRelatedSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceMemoRelatedSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetDisallowPublicVisibility() bool {
+	if x != nil {
+		return x.DisallowPublicVisibility
+	}
+	return false
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetDisplayWithUpdateTime() bool {
+	if x != nil {
+		return x.DisplayWithUpdateTime
+	}
+	return false
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetContentLengthLimit() int32 {
+	if x != nil {
+		return x.ContentLengthLimit
+	}
+	return 0
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetEnableDoubleClickEdit() bool {
+	if x != nil {
+		return x.EnableDoubleClickEdit
+	}
+	return false
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetEnableLinkPreview() bool {
+	if x != nil {
+		return x.EnableLinkPreview
+	}
+	return false
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetEnableComment() bool {
+	if x != nil {
+		return x.EnableComment
+	}
+	return false
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetReactions() []string {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetDisableMarkdownShortcuts() bool {
+	if x != nil {
+		return x.DisableMarkdownShortcuts
+	}
+	return false
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetEnableBlurNsfwContent() bool {
+	if x != nil {
+		return x.EnableBlurNsfwContent
+	}
+	return false
+}
+
+func (x *WorkspaceMemoRelatedSetting) GetNsfwTags() []string {
+	if x != nil {
+		return x.NsfwTags
+	}
+	return nil
+}
+
+type WorkspaceEmbeddingSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enabled enables indexing memos and semantic search.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// provider is the embedding provider.
+	Provider WorkspaceEmbeddingSetting_Provider `protobuf:"varint,2,opt,name=provider,proto3,enum=memos.store.WorkspaceEmbeddingSetting_Provider" json:"provider,omitempty"`
+	// endpoint is the base URL of the OpenAI compatible API, e.g. https://api.openai.com/v1.
+	Endpoint string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// api_key is the API key of the OpenAI compatible API.
+	ApiKey string `protobuf:"bytes,4,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// model is the name of the embedding model, e.g. text-embedding-3-small.
+	Model         string `protobuf:"bytes,5,opt,name=model,proto3" json:"model,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceEmbeddingSetting) Reset() {
+	*x = WorkspaceEmbeddingSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceEmbeddingSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceEmbeddingSetting) ProtoMessage() {}
+
+func (x *WorkspaceEmbeddingSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceEmbeddingSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceEmbeddingSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WorkspaceEmbeddingSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WorkspaceEmbeddingSetting) GetProvider() WorkspaceEmbeddingSetting_Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return WorkspaceEmbeddingSetting_PROVIDER_UNSPECIFIED
+}
+
+func (x *WorkspaceEmbeddingSetting) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *WorkspaceEmbeddingSetting) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *WorkspaceEmbeddingSetting) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+type WorkspaceOCRSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enabled enables extracting the text of image attachments for search.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// provider is the OCR provider.
+	Provider WorkspaceOCRSetting_Provider `protobuf:"varint,2,opt,name=provider,proto3,enum=memos.store.WorkspaceOCRSetting_Provider" json:"provider,omitempty"`
+	// tesseract_path is the path of the tesseract binary, default to "tesseract" in the PATH.
+	TesseractPath string `protobuf:"bytes,3,opt,name=tesseract_path,json=tesseractPath,proto3" json:"tesseract_path,omitempty"`
+	// languages are the Tesseract languages to recognize, e.g. "eng+deu", default to "eng".
+	Languages string `protobuf:"bytes,4,opt,name=languages,proto3" json:"languages,omitempty"`
+	// endpoint is the URL of the OCR API.
+	Endpoint string `protobuf:"bytes,5,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// api_key is sent as a bearer token to the OCR API.
+	ApiKey        string `protobuf:"bytes,6,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceOCRSetting) Reset() {
+	*x = WorkspaceOCRSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceOCRSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceOCRSetting) ProtoMessage() {}
+
+func (x *WorkspaceOCRSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceOCRSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceOCRSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WorkspaceOCRSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WorkspaceOCRSetting) GetProvider() WorkspaceOCRSetting_Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return WorkspaceOCRSetting_PROVIDER_UNSPECIFIED
+}
+
+func (x *WorkspaceOCRSetting) GetTesseractPath() string {
+	if x != nil {
+		return x.TesseractPath
+	}
+	return ""
+}
+
+func (x *WorkspaceOCRSetting) GetLanguages() string {
+	if x != nil {
+		return x.Languages
+	}
+	return ""
+}
+
+func (x *WorkspaceOCRSetting) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *WorkspaceOCRSetting) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+type WorkspaceMalwareScanSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enabled enables scanning the uploaded attachments for malware.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// scanner is the malware scanner.
+	Scanner WorkspaceMalwareScanSetting_Scanner `protobuf:"varint,2,opt,name=scanner,proto3,enum=memos.store.WorkspaceMalwareScanSetting_Scanner" json:"scanner,omitempty"`
+	// clamav_address is the address of clamd, a host:port or the path of its unix socket, default to "localhost:3310".
+	ClamavAddress string `protobuf:"bytes,3,opt,name=clamav_address,json=clamavAddress,proto3" json:"clamav_address,omitempty"`
+	// command is the scanning command line, e.g. "clamscan --no-summary".
+	Command string `protobuf:"bytes,4,opt,name=command,proto3" json:"command,omitempty"`
+	// endpoint is the URL of the scanning API.
+	Endpoint string `protobuf:"bytes,5,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// api_key is sent as a bearer token to the scanning API.
+	ApiKey string `protobuf:"bytes,6,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// action is the action on the flagged files, default to REJECT.
+	Action WorkspaceMalwareScanSetting_Action `protobuf:"varint,7,opt,name=action,proto3,enum=memos.store.WorkspaceMalwareScanSetting_Action" json:"action,omitempty"`
+	// fail_open accepts the files when the scanner fails, rather than rejecting them.
+	FailOpen      bool `protobuf:"varint,8,opt,name=fail_open,json=failOpen,proto3" json:"fail_open,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceMalwareScanSetting) Reset() {
+	*x = WorkspaceMalwareScanSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceMalwareScanSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceMalwareScanSetting) ProtoMessage() {}
+
+func (x *WorkspaceMalwareScanSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceMalwareScanSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceMalwareScanSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WorkspaceMalwareScanSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WorkspaceMalwareScanSetting) GetScanner() WorkspaceMalwareScanSetting_Scanner {
+	if x != nil {
+		return x.Scanner
+	}
+	return WorkspaceMalwareScanSetting_SCANNER_UNSPECIFIED
+}
+
+func (x *WorkspaceMalwareScanSetting) GetClamavAddress() string {
+	if x != nil {
+		return x.ClamavAddress
+	}
+	return ""
+}
+
+func (x *WorkspaceMalwareScanSetting) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *WorkspaceMalwareScanSetting) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *WorkspaceMalwareScanSetting) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *WorkspaceMalwareScanSetting) GetAction() WorkspaceMalwareScanSetting_Action {
+	if x != nil {
+		return x.Action
+	}
+	return WorkspaceMalwareScanSetting_ACTION_UNSPECIFIED
+}
+
+func (x *WorkspaceMalwareScanSetting) GetFailOpen() bool {
+	if x != nil {
+		return x.FailOpen
+	}
+	return false
+}
+
+type WorkspaceTranscriptionSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enabled enables transcribing the audio attachments of memos, e.g. voice memos.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// provider is the transcription provider.
+	Provider WorkspaceTranscriptionSetting_Provider `protobuf:"varint,2,opt,name=provider,proto3,enum=memos.store.WorkspaceTranscriptionSetting_Provider" json:"provider,omitempty"`
+	// whisper_path is the path of the whisper.cpp binary, default to "whisper-cli" in the PATH.
+	WhisperPath string `protobuf:"bytes,3,opt,name=whisper_path,json=whisperPath,proto3" json:"whisper_path,omitempty"`
+	// whisper_model_path is the path of the whisper.cpp model file, e.g. "/models/ggml-base.bin".
+	WhisperModelPath string `protobuf:"bytes,4,opt,name=whisper_model_path,json=whisperModelPath,proto3" json:"whisper_model_path,omitempty"`
+	// language is the spoken language, e.g. "en", default to detecting it.
+	Language string `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	// endpoint is the base URL of the OpenAI compatible API, e.g. https://api.openai.com/v1.
+	Endpoint string `protobuf:"bytes,6,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// api_key is the API key of the OpenAI compatible API.
+	ApiKey string `protobuf:"bytes,7,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// model is the name of the transcription model of the API, default to "whisper-1".
+	Model         string `protobuf:"bytes,8,opt,name=model,proto3" json:"model,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceTranscriptionSetting) Reset() {
+	*x = WorkspaceTranscriptionSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkspaceSetting) String() string {
+func (x *WorkspaceTranscriptionSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceSetting) ProtoMessage() {}
+func (*WorkspaceTranscriptionSetting) ProtoMessage() {}
 
-func (x *WorkspaceSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_workspace_setting_proto_msgTypes[0]
+func (x *WorkspaceTranscriptionSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -174,114 +2041,150 @@ func (x *WorkspaceSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceSetting.ProtoReflect.Descriptor instead.
-func (*WorkspaceSetting) Descriptor() ([]byte, []int) {
-	return file_store_workspace_setting_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use WorkspaceTranscriptionSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceTranscriptionSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *WorkspaceSetting) GetKey() WorkspaceSettingKey {
+func (x *WorkspaceTranscriptionSetting) GetEnabled() bool {
 	if x != nil {
-		return x.Key
+		return x.Enabled
 	}
-	return WorkspaceSettingKey_WORKSPACE_SETTING_KEY_UNSPECIFIED
+	return false
 }
 
-func (x *WorkspaceSetting) GetValue() isWorkspaceSetting_Value {
+func (x *WorkspaceTranscriptionSetting) GetProvider() WorkspaceTranscriptionSetting_Provider {
 	if x != nil {
-		return x.Value
+		return x.Provider
 	}
-	return nil
+	return WorkspaceTranscriptionSetting_PROVIDER_UNSPECIFIED
 }
 
-func (x *WorkspaceSetting) GetBasicSetting() *WorkspaceBasicSetting {
+func (x *WorkspaceTranscriptionSetting) GetWhisperPath() string {
 	if x != nil {
-		if x, ok := x.Value.(*WorkspaceSetting_BasicSetting); ok {
-			return x.BasicSetting
-		}
+		return x.WhisperPath
 	}
-	return nil
+	return ""
 }
 
-func (x *WorkspaceSetting) GetGeneralSetting() *WorkspaceGeneralSetting {
+func (x *WorkspaceTranscriptionSetting) GetWhisperModelPath() string {
 	if x != nil {
-		if x, ok := x.Value.(*WorkspaceSetting_GeneralSetting); ok {
-			return x.GeneralSetting
-		}
+		return x.WhisperModelPath
 	}
-	return nil
+	return ""
 }
 
-func (x *WorkspaceSetting) GetStorageSetting() *WorkspaceStorageSetting {
+func (x *WorkspaceTranscriptionSetting) GetLanguage() string {
 	if x != nil {
-		if x, ok := x.Value.(*WorkspaceSetting_StorageSetting); ok {
-			return x.StorageSetting
-		}
+		return x.Language
 	}
-	return nil
+	return ""
 }
 
-func (x *WorkspaceSetting) GetMemoRelatedSetting() *WorkspaceMemoRelatedSetting {
+func (x *WorkspaceTranscriptionSetting) GetEndpoint() string {
 	if x != nil {
-		if x, ok := x.Value.(*WorkspaceSetting_MemoRelatedSetting); ok {
-			return x.MemoRelatedSetting
-		}
+		return x.Endpoint
 	}
-	return nil
+	return ""
 }
 
-type isWorkspaceSetting_Value interface {
-	isWorkspaceSetting_Value()
+func (x *WorkspaceTranscriptionSetting) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
 }
 
-type WorkspaceSetting_BasicSetting struct {
-	BasicSetting *WorkspaceBasicSetting `protobuf:"bytes,2,opt,name=basic_setting,json=basicSetting,proto3,oneof"`
+func (x *WorkspaceTranscriptionSetting) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
 }
 
-type WorkspaceSetting_GeneralSetting struct {
-	GeneralSetting *WorkspaceGeneralSetting `protobuf:"bytes,3,opt,name=general_setting,json=generalSetting,proto3,oneof"`
+type WorkspaceSCIMSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enabled enables the SCIM 2.0 provisioning endpoint at /scim/v2, for identity providers to manage the users.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// token is the bearer token of the identity provider calling the endpoint.
+	Token         string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type WorkspaceSetting_StorageSetting struct {
-	StorageSetting *WorkspaceStorageSetting `protobuf:"bytes,4,opt,name=storage_setting,json=storageSetting,proto3,oneof"`
+func (x *WorkspaceSCIMSetting) Reset() {
+	*x = WorkspaceSCIMSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type WorkspaceSetting_MemoRelatedSetting struct {
-	MemoRelatedSetting *WorkspaceMemoRelatedSetting `protobuf:"bytes,5,opt,name=memo_related_setting,json=memoRelatedSetting,proto3,oneof"`
+func (x *WorkspaceSCIMSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceSetting_BasicSetting) isWorkspaceSetting_Value() {}
+func (*WorkspaceSCIMSetting) ProtoMessage() {}
 
-func (*WorkspaceSetting_GeneralSetting) isWorkspaceSetting_Value() {}
+func (x *WorkspaceSCIMSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-func (*WorkspaceSetting_StorageSetting) isWorkspaceSetting_Value() {}
+// Deprecated: Use WorkspaceSCIMSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSCIMSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{13}
+}
 
-func (*WorkspaceSetting_MemoRelatedSetting) isWorkspaceSetting_Value() {}
+func (x *WorkspaceSCIMSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
 
-type WorkspaceBasicSetting struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The secret key for workspace. Mainly used for session management.
-	SecretKey string `protobuf:"bytes,1,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
-	// The current schema version of database.
-	SchemaVersion string `protobuf:"bytes,2,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *WorkspaceSCIMSetting) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
 }
 
-func (x *WorkspaceBasicSetting) Reset() {
-	*x = WorkspaceBasicSetting{}
-	mi := &file_store_workspace_setting_proto_msgTypes[1]
+type WorkspacePasswordPolicySetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// min_length is the minimum length of the passwords, not enforced if zero.
+	MinLength int32 `protobuf:"varint,1,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	// check_breached rejects the passwords found in known data breaches, checked with the k-anonymity range API of Have I Been Pwned.
+	CheckBreached bool `protobuf:"varint,2,opt,name=check_breached,json=checkBreached,proto3" json:"check_breached,omitempty"`
+	// breach_check_endpoint is the endpoint of the range API, https://api.pwnedpasswords.com if empty.
+	BreachCheckEndpoint string `protobuf:"bytes,3,opt,name=breach_check_endpoint,json=breachCheckEndpoint,proto3" json:"breach_check_endpoint,omitempty"`
+	// require_change_after_reset requires the users to change the passwords set by an admin before using the API.
+	RequireChangeAfterReset bool `protobuf:"varint,4,opt,name=require_change_after_reset,json=requireChangeAfterReset,proto3" json:"require_change_after_reset,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *WorkspacePasswordPolicySetting) Reset() {
+	*x = WorkspacePasswordPolicySetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkspaceBasicSetting) String() string {
+func (x *WorkspacePasswordPolicySetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceBasicSetting) ProtoMessage() {}
+func (*WorkspacePasswordPolicySetting) ProtoMessage() {}
 
-func (x *WorkspaceBasicSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_workspace_setting_proto_msgTypes[1]
+func (x *WorkspacePasswordPolicySetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -292,67 +2195,129 @@ func (x *WorkspaceBasicSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceBasicSetting.ProtoReflect.Descriptor instead.
-func (*WorkspaceBasicSetting) Descriptor() ([]byte, []int) {
-	return file_store_workspace_setting_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use WorkspacePasswordPolicySetting.ProtoReflect.Descriptor instead.
+func (*WorkspacePasswordPolicySetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *WorkspaceBasicSetting) GetSecretKey() string {
+func (x *WorkspacePasswordPolicySetting) GetMinLength() int32 {
 	if x != nil {
-		return x.SecretKey
+		return x.MinLength
 	}
-	return ""
+	return 0
 }
 
-func (x *WorkspaceBasicSetting) GetSchemaVersion() string {
+func (x *WorkspacePasswordPolicySetting) GetCheckBreached() bool {
 	if x != nil {
-		return x.SchemaVersion
+		return x.CheckBreached
+	}
+	return false
+}
+
+func (x *WorkspacePasswordPolicySetting) GetBreachCheckEndpoint() string {
+	if x != nil {
+		return x.BreachCheckEndpoint
 	}
 	return ""
 }
 
-type WorkspaceGeneralSetting struct {
+func (x *WorkspacePasswordPolicySetting) GetRequireChangeAfterReset() bool {
+	if x != nil {
+		return x.RequireChangeAfterReset
+	}
+	return false
+}
+
+type WorkspaceAccessTokenPolicySetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// theme is the name of the selected theme.
-	// This references a CSS file in the web/public/themes/ directory.
-	Theme string `protobuf:"bytes,1,opt,name=theme,proto3" json:"theme,omitempty"`
-	// disallow_user_registration disallows user registration.
-	DisallowUserRegistration bool `protobuf:"varint,2,opt,name=disallow_user_registration,json=disallowUserRegistration,proto3" json:"disallow_user_registration,omitempty"`
-	// disallow_password_auth disallows password authentication.
-	DisallowPasswordAuth bool `protobuf:"varint,3,opt,name=disallow_password_auth,json=disallowPasswordAuth,proto3" json:"disallow_password_auth,omitempty"`
-	// additional_script is the additional script.
-	AdditionalScript string `protobuf:"bytes,4,opt,name=additional_script,json=additionalScript,proto3" json:"additional_script,omitempty"`
-	// additional_style is the additional style.
-	AdditionalStyle string `protobuf:"bytes,5,opt,name=additional_style,json=additionalStyle,proto3" json:"additional_style,omitempty"`
-	// custom_profile is the custom profile.
-	CustomProfile *WorkspaceCustomProfile `protobuf:"bytes,6,opt,name=custom_profile,json=customProfile,proto3" json:"custom_profile,omitempty"`
-	// week_start_day_offset is the week start day offset from Sunday.
-	// 0: Sunday, 1: Monday, 2: Tuesday, 3: Wednesday, 4: Thursday, 5: Friday, 6: Saturday
-	// Default is Sunday.
-	WeekStartDayOffset int32 `protobuf:"varint,7,opt,name=week_start_day_offset,json=weekStartDayOffset,proto3" json:"week_start_day_offset,omitempty"`
-	// disallow_change_username disallows changing username.
-	DisallowChangeUsername bool `protobuf:"varint,8,opt,name=disallow_change_username,json=disallowChangeUsername,proto3" json:"disallow_change_username,omitempty"`
-	// disallow_change_nickname disallows changing nickname.
-	DisallowChangeNickname bool `protobuf:"varint,9,opt,name=disallow_change_nickname,json=disallowChangeNickname,proto3" json:"disallow_change_nickname,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	// max_lifetime_days is the maximum lifetime of the access tokens, unlimited if zero.
+	// The tokens issued before the policy are rejected once older than it.
+	MaxLifetimeDays int32 `protobuf:"varint,1,opt,name=max_lifetime_days,json=maxLifetimeDays,proto3" json:"max_lifetime_days,omitempty"`
+	// idle_revocation_days revokes the access tokens unused for that many days, never if zero.
+	IdleRevocationDays int32 `protobuf:"varint,2,opt,name=idle_revocation_days,json=idleRevocationDays,proto3" json:"idle_revocation_days,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *WorkspaceAccessTokenPolicySetting) Reset() {
+	*x = WorkspaceAccessTokenPolicySetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkspaceGeneralSetting) Reset() {
-	*x = WorkspaceGeneralSetting{}
-	mi := &file_store_workspace_setting_proto_msgTypes[2]
+func (x *WorkspaceAccessTokenPolicySetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceAccessTokenPolicySetting) ProtoMessage() {}
+
+func (x *WorkspaceAccessTokenPolicySetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceAccessTokenPolicySetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceAccessTokenPolicySetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WorkspaceAccessTokenPolicySetting) GetMaxLifetimeDays() int32 {
+	if x != nil {
+		return x.MaxLifetimeDays
+	}
+	return 0
+}
+
+func (x *WorkspaceAccessTokenPolicySetting) GetIdleRevocationDays() int32 {
+	if x != nil {
+		return x.IdleRevocationDays
+	}
+	return 0
+}
+
+type WorkspaceCaptchaSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// provider is the CAPTCHA provider, no challenge is required if unspecified.
+	Provider WorkspaceCaptchaSetting_Provider `protobuf:"varint,1,opt,name=provider,proto3,enum=memos.store.WorkspaceCaptchaSetting_Provider" json:"provider,omitempty"`
+	// site_key is the public key of the site at the provider, rendering its widget.
+	SiteKey string `protobuf:"bytes,2,opt,name=site_key,json=siteKey,proto3" json:"site_key,omitempty"`
+	// secret_key is the secret key of the site at the provider, verifying the tokens.
+	SecretKey string `protobuf:"bytes,3,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	// require_on_sign_up requires a challenge to sign up.
+	RequireOnSignUp bool `protobuf:"varint,4,opt,name=require_on_sign_up,json=requireOnSignUp,proto3" json:"require_on_sign_up,omitempty"`
+	// sign_in_failure_threshold requires a challenge to sign in after that many failed sign-ins of the username or the client, never if zero.
+	SignInFailureThreshold int32 `protobuf:"varint,5,opt,name=sign_in_failure_threshold,json=signInFailureThreshold,proto3" json:"sign_in_failure_threshold,omitempty"`
+	// proof_of_work_difficulty is the number of leading zero bits of the proof-of-work hashes, 20 if zero.
+	ProofOfWorkDifficulty int32 `protobuf:"varint,6,opt,name=proof_of_work_difficulty,json=proofOfWorkDifficulty,proto3" json:"proof_of_work_difficulty,omitempty"`
+	// verify_endpoint is the endpoint of the siteverify API, the one of the provider if empty.
+	VerifyEndpoint string `protobuf:"bytes,7,opt,name=verify_endpoint,json=verifyEndpoint,proto3" json:"verify_endpoint,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WorkspaceCaptchaSetting) Reset() {
+	*x = WorkspaceCaptchaSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkspaceGeneralSetting) String() string {
+func (x *WorkspaceCaptchaSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceGeneralSetting) ProtoMessage() {}
+func (*WorkspaceCaptchaSetting) ProtoMessage() {}
 
-func (x *WorkspaceGeneralSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_workspace_setting_proto_msgTypes[2]
+func (x *WorkspaceCaptchaSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -363,100 +2328,161 @@ func (x *WorkspaceGeneralSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceGeneralSetting.ProtoReflect.Descriptor instead.
-func (*WorkspaceGeneralSetting) Descriptor() ([]byte, []int) {
-	return file_store_workspace_setting_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use WorkspaceCaptchaSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceCaptchaSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *WorkspaceGeneralSetting) GetTheme() string {
+func (x *WorkspaceCaptchaSetting) GetProvider() WorkspaceCaptchaSetting_Provider {
 	if x != nil {
-		return x.Theme
+		return x.Provider
+	}
+	return WorkspaceCaptchaSetting_PROVIDER_UNSPECIFIED
+}
+
+func (x *WorkspaceCaptchaSetting) GetSiteKey() string {
+	if x != nil {
+		return x.SiteKey
 	}
 	return ""
 }
 
-func (x *WorkspaceGeneralSetting) GetDisallowUserRegistration() bool {
+func (x *WorkspaceCaptchaSetting) GetSecretKey() string {
 	if x != nil {
-		return x.DisallowUserRegistration
+		return x.SecretKey
 	}
-	return false
+	return ""
 }
 
-func (x *WorkspaceGeneralSetting) GetDisallowPasswordAuth() bool {
+func (x *WorkspaceCaptchaSetting) GetRequireOnSignUp() bool {
 	if x != nil {
-		return x.DisallowPasswordAuth
+		return x.RequireOnSignUp
 	}
 	return false
 }
 
-func (x *WorkspaceGeneralSetting) GetAdditionalScript() string {
+func (x *WorkspaceCaptchaSetting) GetSignInFailureThreshold() int32 {
 	if x != nil {
-		return x.AdditionalScript
+		return x.SignInFailureThreshold
 	}
-	return ""
+	return 0
 }
 
-func (x *WorkspaceGeneralSetting) GetAdditionalStyle() string {
+func (x *WorkspaceCaptchaSetting) GetProofOfWorkDifficulty() int32 {
 	if x != nil {
-		return x.AdditionalStyle
+		return x.ProofOfWorkDifficulty
+	}
+	return 0
+}
+
+func (x *WorkspaceCaptchaSetting) GetVerifyEndpoint() string {
+	if x != nil {
+		return x.VerifyEndpoint
 	}
 	return ""
 }
 
-func (x *WorkspaceGeneralSetting) GetCustomProfile() *WorkspaceCustomProfile {
+type WorkspaceSlackSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// signing_secret is the signing secret of the Slack app, verifying its requests. The app is disabled if empty.
+	SigningSecret string `protobuf:"bytes,1,opt,name=signing_secret,json=signingSecret,proto3" json:"signing_secret,omitempty"`
+	// bot_token is the bot token of the Slack app, unfurling the links of the memos if not empty.
+	BotToken string `protobuf:"bytes,2,opt,name=bot_token,json=botToken,proto3" json:"bot_token,omitempty"`
+	// api_endpoint is the endpoint of the Slack Web API, https://slack.com/api if empty.
+	ApiEndpoint   string `protobuf:"bytes,3,opt,name=api_endpoint,json=apiEndpoint,proto3" json:"api_endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceSlackSetting) Reset() {
+	*x = WorkspaceSlackSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceSlackSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceSlackSetting) ProtoMessage() {}
+
+func (x *WorkspaceSlackSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[17]
 	if x != nil {
-		return x.CustomProfile
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *WorkspaceGeneralSetting) GetWeekStartDayOffset() int32 {
+// Deprecated: Use WorkspaceSlackSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceSlackSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WorkspaceSlackSetting) GetSigningSecret() string {
 	if x != nil {
-		return x.WeekStartDayOffset
+		return x.SigningSecret
 	}
-	return 0
+	return ""
 }
 
-func (x *WorkspaceGeneralSetting) GetDisallowChangeUsername() bool {
+func (x *WorkspaceSlackSetting) GetBotToken() string {
 	if x != nil {
-		return x.DisallowChangeUsername
+		return x.BotToken
 	}
-	return false
+	return ""
 }
 
-func (x *WorkspaceGeneralSetting) GetDisallowChangeNickname() bool {
+func (x *WorkspaceSlackSetting) GetApiEndpoint() string {
 	if x != nil {
-		return x.DisallowChangeNickname
+		return x.ApiEndpoint
 	}
-	return false
+	return ""
 }
 
-type WorkspaceCustomProfile struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	LogoUrl       string                 `protobuf:"bytes,3,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
-	Locale        string                 `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
-	Appearance    string                 `protobuf:"bytes,5,opt,name=appearance,proto3" json:"appearance,omitempty"`
+type WorkspaceDiscordSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// application_id is the ID of the Discord application of the bot, registering its commands.
+	ApplicationId string `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	// public_key is the hex public key of the application, verifying its interactions. The commands are disabled if empty.
+	PublicKey string `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// bot_token is the token of the bot, connecting to the gateway and posting the digests.
+	BotToken string `protobuf:"bytes,3,opt,name=bot_token,json=botToken,proto3" json:"bot_token,omitempty"`
+	// save_reaction is the emoji saving the messages it is added to, disabled if empty.
+	// The bot needs the message content intent to read the messages.
+	SaveReaction string `protobuf:"bytes,4,opt,name=save_reaction,json=saveReaction,proto3" json:"save_reaction,omitempty"`
+	// digest_channel_id is the channel the digest of the new memos is posted to, disabled if empty.
+	DigestChannelId string `protobuf:"bytes,5,opt,name=digest_channel_id,json=digestChannelId,proto3" json:"digest_channel_id,omitempty"`
+	// digest_schedule is the cron schedule of the digest in UTC, every day at 9:00 if empty.
+	DigestSchedule string `protobuf:"bytes,6,opt,name=digest_schedule,json=digestSchedule,proto3" json:"digest_schedule,omitempty"`
+	// digest_include_protected includes the memos visible to the workspace members in the digest, besides the public ones.
+	DigestIncludeProtected bool `protobuf:"varint,7,opt,name=digest_include_protected,json=digestIncludeProtected,proto3" json:"digest_include_protected,omitempty"`
+	// api_endpoint is the endpoint of the Discord API, https://discord.com/api/v10 if empty.
+	ApiEndpoint   string `protobuf:"bytes,8,opt,name=api_endpoint,json=apiEndpoint,proto3" json:"api_endpoint,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkspaceCustomProfile) Reset() {
-	*x = WorkspaceCustomProfile{}
-	mi := &file_store_workspace_setting_proto_msgTypes[3]
+func (x *WorkspaceDiscordSetting) Reset() {
+	*x = WorkspaceDiscordSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkspaceCustomProfile) String() string {
+func (x *WorkspaceDiscordSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceCustomProfile) ProtoMessage() {}
+func (*WorkspaceDiscordSetting) ProtoMessage() {}
 
-func (x *WorkspaceCustomProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_store_workspace_setting_proto_msgTypes[3]
+func (x *WorkspaceDiscordSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -467,76 +2493,118 @@ func (x *WorkspaceCustomProfile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceCustomProfile.ProtoReflect.Descriptor instead.
-func (*WorkspaceCustomProfile) Descriptor() ([]byte, []int) {
-	return file_store_workspace_setting_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use WorkspaceDiscordSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceDiscordSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *WorkspaceCustomProfile) GetTitle() string {
+func (x *WorkspaceDiscordSetting) GetApplicationId() string {
 	if x != nil {
-		return x.Title
+		return x.ApplicationId
 	}
 	return ""
 }
 
-func (x *WorkspaceCustomProfile) GetDescription() string {
+func (x *WorkspaceDiscordSetting) GetPublicKey() string {
 	if x != nil {
-		return x.Description
+		return x.PublicKey
 	}
 	return ""
 }
 
-func (x *WorkspaceCustomProfile) GetLogoUrl() string {
+func (x *WorkspaceDiscordSetting) GetBotToken() string {
 	if x != nil {
-		return x.LogoUrl
+		return x.BotToken
 	}
 	return ""
 }
 
-func (x *WorkspaceCustomProfile) GetLocale() string {
+func (x *WorkspaceDiscordSetting) GetSaveReaction() string {
 	if x != nil {
-		return x.Locale
+		return x.SaveReaction
 	}
 	return ""
 }
 
-func (x *WorkspaceCustomProfile) GetAppearance() string {
+func (x *WorkspaceDiscordSetting) GetDigestChannelId() string {
 	if x != nil {
-		return x.Appearance
+		return x.DigestChannelId
 	}
 	return ""
 }
 
-type WorkspaceStorageSetting struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// storage_type is the storage type.
-	StorageType WorkspaceStorageSetting_StorageType `protobuf:"varint,1,opt,name=storage_type,json=storageType,proto3,enum=memos.store.WorkspaceStorageSetting_StorageType" json:"storage_type,omitempty"`
-	// The template of file path.
-	// e.g. assets/{timestamp}_{filename}
-	FilepathTemplate string `protobuf:"bytes,2,opt,name=filepath_template,json=filepathTemplate,proto3" json:"filepath_template,omitempty"`
-	// The max upload size in megabytes.
-	UploadSizeLimitMb int64 `protobuf:"varint,3,opt,name=upload_size_limit_mb,json=uploadSizeLimitMb,proto3" json:"upload_size_limit_mb,omitempty"`
-	// The S3 config.
-	S3Config      *StorageS3Config `protobuf:"bytes,4,opt,name=s3_config,json=s3Config,proto3" json:"s3_config,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *WorkspaceDiscordSetting) GetDigestSchedule() string {
+	if x != nil {
+		return x.DigestSchedule
+	}
+	return ""
 }
 
-func (x *WorkspaceStorageSetting) Reset() {
-	*x = WorkspaceStorageSetting{}
-	mi := &file_store_workspace_setting_proto_msgTypes[4]
+func (x *WorkspaceDiscordSetting) GetDigestIncludeProtected() bool {
+	if x != nil {
+		return x.DigestIncludeProtected
+	}
+	return false
+}
+
+func (x *WorkspaceDiscordSetting) GetApiEndpoint() string {
+	if x != nil {
+		return x.ApiEndpoint
+	}
+	return ""
+}
+
+type WorkspaceEmailSetting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// smtp_host and smtp_port are the address of the SMTP server sending the emails.
+	SmtpHost string `protobuf:"bytes,1,opt,name=smtp_host,json=smtpHost,proto3" json:"smtp_host,omitempty"`
+	SmtpPort int32  `protobuf:"varint,2,opt,name=smtp_port,json=smtpPort,proto3" json:"smtp_port,omitempty"`
+	// smtp_username and smtp_password authenticate to the SMTP server, if the username is not empty.
+	SmtpUsername string `protobuf:"bytes,3,opt,name=smtp_username,json=smtpUsername,proto3" json:"smtp_username,omitempty"`
+	SmtpPassword string `protobuf:"bytes,4,opt,name=smtp_password,json=smtpPassword,proto3" json:"smtp_password,omitempty"`
+	// use_tls connects with implicit TLS, e.g. on the port 465, instead of upgrading with STARTTLS when offered.
+	UseTls bool `protobuf:"varint,5,opt,name=use_tls,json=useTls,proto3" json:"use_tls,omitempty"`
+	// from_email and from_name are the sender of the emails.
+	FromEmail string `protobuf:"bytes,6,opt,name=from_email,json=fromEmail,proto3" json:"from_email,omitempty"`
+	FromName  string `protobuf:"bytes,7,opt,name=from_name,json=fromName,proto3" json:"from_name,omitempty"`
+	// require_email_verification requires the users signing up to verify their email before signing in.
+	RequireEmailVerification bool `protobuf:"varint,8,opt,name=require_email_verification,json=requireEmailVerification,proto3" json:"require_email_verification,omitempty"`
+	// allow_password_reset lets the users reset their forgotten password by email.
+	AllowPasswordReset bool `protobuf:"varint,9,opt,name=allow_password_reset,json=allowPasswordReset,proto3" json:"allow_password_reset,omitempty"`
+	// imap_host and imap_port are the address of the IMAP server of the mailbox whose emails are created as memos,
+	// polled instead of or alongside the built-in SMTP server. Disabled if the host is empty.
+	// The emails are created as memos of the users whose memo email token is the subaddress of one of their recipients,
+	// e.g. memos+token@example.com.
+	ImapHost string `protobuf:"bytes,10,opt,name=imap_host,json=imapHost,proto3" json:"imap_host,omitempty"`
+	ImapPort int32  `protobuf:"varint,11,opt,name=imap_port,json=imapPort,proto3" json:"imap_port,omitempty"`
+	// imap_username and imap_password authenticate to the IMAP server.
+	ImapUsername string `protobuf:"bytes,12,opt,name=imap_username,json=imapUsername,proto3" json:"imap_username,omitempty"`
+	ImapPassword string `protobuf:"bytes,13,opt,name=imap_password,json=imapPassword,proto3" json:"imap_password,omitempty"`
+	// imap_use_tls connects with implicit TLS, e.g. on the port 993, instead of upgrading with STARTTLS when offered.
+	ImapUseTls bool `protobuf:"varint,14,opt,name=imap_use_tls,json=imapUseTls,proto3" json:"imap_use_tls,omitempty"`
+	// imap_folder is the folder whose unseen emails are created as memos, INBOX if empty.
+	ImapFolder string `protobuf:"bytes,15,opt,name=imap_folder,json=imapFolder,proto3" json:"imap_folder,omitempty"`
+	// imap_archive_folder is the folder the processed emails are moved to, Archive if empty.
+	ImapArchiveFolder string `protobuf:"bytes,16,opt,name=imap_archive_folder,json=imapArchiveFolder,proto3" json:"imap_archive_folder,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WorkspaceEmailSetting) Reset() {
+	*x = WorkspaceEmailSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkspaceStorageSetting) String() string {
+func (x *WorkspaceEmailSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceStorageSetting) ProtoMessage() {}
+func (*WorkspaceEmailSetting) ProtoMessage() {}
 
-func (x *WorkspaceStorageSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_workspace_setting_proto_msgTypes[4]
+func (x *WorkspaceEmailSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -547,165 +2615,146 @@ func (x *WorkspaceStorageSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceStorageSetting.ProtoReflect.Descriptor instead.
-func (*WorkspaceStorageSetting) Descriptor() ([]byte, []int) {
-	return file_store_workspace_setting_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use WorkspaceEmailSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceEmailSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *WorkspaceStorageSetting) GetStorageType() WorkspaceStorageSetting_StorageType {
+func (x *WorkspaceEmailSetting) GetSmtpHost() string {
 	if x != nil {
-		return x.StorageType
+		return x.SmtpHost
 	}
-	return WorkspaceStorageSetting_STORAGE_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *WorkspaceStorageSetting) GetFilepathTemplate() string {
+func (x *WorkspaceEmailSetting) GetSmtpPort() int32 {
 	if x != nil {
-		return x.FilepathTemplate
+		return x.SmtpPort
 	}
-	return ""
+	return 0
 }
 
-func (x *WorkspaceStorageSetting) GetUploadSizeLimitMb() int64 {
+func (x *WorkspaceEmailSetting) GetSmtpUsername() string {
 	if x != nil {
-		return x.UploadSizeLimitMb
+		return x.SmtpUsername
 	}
-	return 0
+	return ""
 }
 
-func (x *WorkspaceStorageSetting) GetS3Config() *StorageS3Config {
+func (x *WorkspaceEmailSetting) GetSmtpPassword() string {
 	if x != nil {
-		return x.S3Config
+		return x.SmtpPassword
 	}
-	return nil
+	return ""
 }
 
-// Reference: https://developers.cloudflare.com/r2/examples/aws/aws-sdk-go/
-type StorageS3Config struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	AccessKeyId     string                 `protobuf:"bytes,1,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
-	AccessKeySecret string                 `protobuf:"bytes,2,opt,name=access_key_secret,json=accessKeySecret,proto3" json:"access_key_secret,omitempty"`
-	Endpoint        string                 `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	Region          string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
-	Bucket          string                 `protobuf:"bytes,5,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	UsePathStyle    bool                   `protobuf:"varint,6,opt,name=use_path_style,json=usePathStyle,proto3" json:"use_path_style,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *WorkspaceEmailSetting) GetUseTls() bool {
+	if x != nil {
+		return x.UseTls
+	}
+	return false
 }
 
-func (x *StorageS3Config) Reset() {
-	*x = StorageS3Config{}
-	mi := &file_store_workspace_setting_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *WorkspaceEmailSetting) GetFromEmail() string {
+	if x != nil {
+		return x.FromEmail
+	}
+	return ""
 }
 
-func (x *StorageS3Config) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *WorkspaceEmailSetting) GetFromName() string {
+	if x != nil {
+		return x.FromName
+	}
+	return ""
 }
 
-func (*StorageS3Config) ProtoMessage() {}
-
-func (x *StorageS3Config) ProtoReflect() protoreflect.Message {
-	mi := &file_store_workspace_setting_proto_msgTypes[5]
+func (x *WorkspaceEmailSetting) GetRequireEmailVerification() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RequireEmailVerification
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use StorageS3Config.ProtoReflect.Descriptor instead.
-func (*StorageS3Config) Descriptor() ([]byte, []int) {
-	return file_store_workspace_setting_proto_rawDescGZIP(), []int{5}
+func (x *WorkspaceEmailSetting) GetAllowPasswordReset() bool {
+	if x != nil {
+		return x.AllowPasswordReset
+	}
+	return false
 }
 
-func (x *StorageS3Config) GetAccessKeyId() string {
+func (x *WorkspaceEmailSetting) GetImapHost() string {
 	if x != nil {
-		return x.AccessKeyId
+		return x.ImapHost
 	}
 	return ""
 }
 
-func (x *StorageS3Config) GetAccessKeySecret() string {
+func (x *WorkspaceEmailSetting) GetImapPort() int32 {
 	if x != nil {
-		return x.AccessKeySecret
+		return x.ImapPort
 	}
-	return ""
+	return 0
 }
 
-func (x *StorageS3Config) GetEndpoint() string {
+func (x *WorkspaceEmailSetting) GetImapUsername() string {
 	if x != nil {
-		return x.Endpoint
+		return x.ImapUsername
 	}
 	return ""
 }
 
-func (x *StorageS3Config) GetRegion() string {
+func (x *WorkspaceEmailSetting) GetImapPassword() string {
 	if x != nil {
-		return x.Region
+		return x.ImapPassword
 	}
 	return ""
 }
 
-func (x *StorageS3Config) GetBucket() string {
+func (x *WorkspaceEmailSetting) GetImapUseTls() bool {
 	if x != nil {
-		return x.Bucket
+		return x.ImapUseTls
+	}
+	return false
+}
+
+func (x *WorkspaceEmailSetting) GetImapFolder() string {
+	if x != nil {
+		return x.ImapFolder
 	}
 	return ""
 }
 
-func (x *StorageS3Config) GetUsePathStyle() bool {
+func (x *WorkspaceEmailSetting) GetImapArchiveFolder() string {
 	if x != nil {
-		return x.UsePathStyle
+		return x.ImapArchiveFolder
 	}
-	return false
+	return ""
 }
 
-type WorkspaceMemoRelatedSetting struct {
+type WorkspaceRolesSetting struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// disallow_public_visibility disallows set memo as public visibility.
-	DisallowPublicVisibility bool `protobuf:"varint,1,opt,name=disallow_public_visibility,json=disallowPublicVisibility,proto3" json:"disallow_public_visibility,omitempty"`
-	// display_with_update_time orders and displays memo with update time.
-	DisplayWithUpdateTime bool `protobuf:"varint,2,opt,name=display_with_update_time,json=displayWithUpdateTime,proto3" json:"display_with_update_time,omitempty"`
-	// content_length_limit is the limit of content length. Unit is byte.
-	ContentLengthLimit int32 `protobuf:"varint,3,opt,name=content_length_limit,json=contentLengthLimit,proto3" json:"content_length_limit,omitempty"`
-	// enable_double_click_edit enables editing on double click.
-	EnableDoubleClickEdit bool `protobuf:"varint,4,opt,name=enable_double_click_edit,json=enableDoubleClickEdit,proto3" json:"enable_double_click_edit,omitempty"`
-	// enable_link_preview enables links preview.
-	EnableLinkPreview bool `protobuf:"varint,5,opt,name=enable_link_preview,json=enableLinkPreview,proto3" json:"enable_link_preview,omitempty"`
-	// enable_comment enables comment.
-	EnableComment bool `protobuf:"varint,6,opt,name=enable_comment,json=enableComment,proto3" json:"enable_comment,omitempty"`
-	// reactions is the list of reactions.
-	Reactions []string `protobuf:"bytes,7,rep,name=reactions,proto3" json:"reactions,omitempty"`
-	// disable markdown shortcuts
-	DisableMarkdownShortcuts bool `protobuf:"varint,8,opt,name=disable_markdown_shortcuts,json=disableMarkdownShortcuts,proto3" json:"disable_markdown_shortcuts,omitempty"`
-	// enable_blur_nsfw_content enables blurring of content marked as not safe for work (NSFW).
-	EnableBlurNsfwContent bool `protobuf:"varint,9,opt,name=enable_blur_nsfw_content,json=enableBlurNsfwContent,proto3" json:"enable_blur_nsfw_content,omitempty"`
-	// nsfw_tags is the list of tags that mark content as NSFW for blurring.
-	NsfwTags      []string `protobuf:"bytes,10,rep,name=nsfw_tags,json=nsfwTags,proto3" json:"nsfw_tags,omitempty"`
+	// roles are the custom roles assignable to the users, on top of their built-in role.
+	Roles         []*WorkspaceCustomRole `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkspaceMemoRelatedSetting) Reset() {
-	*x = WorkspaceMemoRelatedSetting{}
-	mi := &file_store_workspace_setting_proto_msgTypes[6]
+func (x *WorkspaceRolesSetting) Reset() {
+	*x = WorkspaceRolesSetting{}
+	mi := &file_store_workspace_setting_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkspaceMemoRelatedSetting) String() string {
+func (x *WorkspaceRolesSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceMemoRelatedSetting) ProtoMessage() {}
+func (*WorkspaceRolesSetting) ProtoMessage() {}
 
-func (x *WorkspaceMemoRelatedSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_store_workspace_setting_proto_msgTypes[6]
+func (x *WorkspaceRolesSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -716,97 +2765,185 @@ func (x *WorkspaceMemoRelatedSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceMemoRelatedSetting.ProtoReflect.Descriptor instead.
-func (*WorkspaceMemoRelatedSetting) Descriptor() ([]byte, []int) {
-	return file_store_workspace_setting_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use WorkspaceRolesSetting.ProtoReflect.Descriptor instead.
+func (*WorkspaceRolesSetting) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *WorkspaceMemoRelatedSetting) GetDisallowPublicVisibility() bool {
+func (x *WorkspaceRolesSetting) GetRoles() []*WorkspaceCustomRole {
 	if x != nil {
-		return x.DisallowPublicVisibility
+		return x.Roles
 	}
-	return false
+	return nil
 }
 
-func (x *WorkspaceMemoRelatedSetting) GetDisplayWithUpdateTime() bool {
+type WorkspaceCustomRole struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the role, assigned to the users.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// title is the display name of the role.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// permissions are the permissions granted to the users with the role.
+	Permissions   []Permission `protobuf:"varint,3,rep,packed,name=permissions,proto3,enum=memos.store.Permission" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceCustomRole) Reset() {
+	*x = WorkspaceCustomRole{}
+	mi := &file_store_workspace_setting_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceCustomRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceCustomRole) ProtoMessage() {}
+
+func (x *WorkspaceCustomRole) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[21]
 	if x != nil {
-		return x.DisplayWithUpdateTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *WorkspaceMemoRelatedSetting) GetContentLengthLimit() int32 {
+// Deprecated: Use WorkspaceCustomRole.ProtoReflect.Descriptor instead.
+func (*WorkspaceCustomRole) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WorkspaceCustomRole) GetId() string {
 	if x != nil {
-		return x.ContentLengthLimit
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *WorkspaceMemoRelatedSetting) GetEnableDoubleClickEdit() bool {
+func (x *WorkspaceCustomRole) GetTitle() string {
 	if x != nil {
-		return x.EnableDoubleClickEdit
+		return x.Title
 	}
-	return false
+	return ""
 }
 
-func (x *WorkspaceMemoRelatedSetting) GetEnableLinkPreview() bool {
+func (x *WorkspaceCustomRole) GetPermissions() []Permission {
 	if x != nil {
-		return x.EnableLinkPreview
+		return x.Permissions
 	}
-	return false
+	return nil
 }
 
-func (x *WorkspaceMemoRelatedSetting) GetEnableComment() bool {
+type WorkspaceStorageSetting_ImageCompression struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// enabled recompresses the uploaded JPEG and PNG images, unless the result is not smaller.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// max_dimension is the max width and height in pixels of the images. 0 keeps their size.
+	MaxDimension int32 `protobuf:"varint,2,opt,name=max_dimension,json=maxDimension,proto3" json:"max_dimension,omitempty"`
+	// quality is the quality from 1 to 100 of the lossy formats. 0 uses 80.
+	Quality int32 `protobuf:"varint,3,opt,name=quality,proto3" json:"quality,omitempty"`
+	// format is the format to convert the images to.
+	Format        WorkspaceStorageSetting_ImageCompression_Format `protobuf:"varint,4,opt,name=format,proto3,enum=memos.store.WorkspaceStorageSetting_ImageCompression_Format" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkspaceStorageSetting_ImageCompression) Reset() {
+	*x = WorkspaceStorageSetting_ImageCompression{}
+	mi := &file_store_workspace_setting_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkspaceStorageSetting_ImageCompression) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceStorageSetting_ImageCompression) ProtoMessage() {}
+
+func (x *WorkspaceStorageSetting_ImageCompression) ProtoReflect() protoreflect.Message {
+	mi := &file_store_workspace_setting_proto_msgTypes[22]
 	if x != nil {
-		return x.EnableComment
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *WorkspaceMemoRelatedSetting) GetReactions() []string {
+// Deprecated: Use WorkspaceStorageSetting_ImageCompression.ProtoReflect.Descriptor instead.
+func (*WorkspaceStorageSetting_ImageCompression) Descriptor() ([]byte, []int) {
+	return file_store_workspace_setting_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *WorkspaceStorageSetting_ImageCompression) GetEnabled() bool {
 	if x != nil {
-		return x.Reactions
+		return x.Enabled
 	}
-	return nil
+	return false
 }
 
-func (x *WorkspaceMemoRelatedSetting) GetDisableMarkdownShortcuts() bool {
+func (x *WorkspaceStorageSetting_ImageCompression) GetMaxDimension() int32 {
 	if x != nil {
-		return x.DisableMarkdownShortcuts
+		return x.MaxDimension
 	}
-	return false
+	return 0
 }
 
-func (x *WorkspaceMemoRelatedSetting) GetEnableBlurNsfwContent() bool {
+func (x *WorkspaceStorageSetting_ImageCompression) GetQuality() int32 {
 	if x != nil {
-		return x.EnableBlurNsfwContent
+		return x.Quality
 	}
-	return false
+	return 0
 }
 
-func (x *WorkspaceMemoRelatedSetting) GetNsfwTags() []string {
+func (x *WorkspaceStorageSetting_ImageCompression) GetFormat() WorkspaceStorageSetting_ImageCompression_Format {
 	if x != nil {
-		return x.NsfwTags
+		return x.Format
 	}
-	return nil
+	return WorkspaceStorageSetting_ImageCompression_FORMAT_UNSPECIFIED
 }
 
 var File_store_workspace_setting_proto protoreflect.FileDescriptor
 
 const file_store_workspace_setting_proto_rawDesc = "" +
 	"\n" +
-	"\x1dstore/workspace_setting.proto\x12\vmemos.store\"\x9a\x03\n" +
+	"\x1dstore/workspace_setting.proto\x12\vmemos.store\"\x9a\v\n" +
 	"\x10WorkspaceSetting\x122\n" +
 	"\x03key\x18\x01 \x01(\x0e2 .memos.store.WorkspaceSettingKeyR\x03key\x12I\n" +
 	"\rbasic_setting\x18\x02 \x01(\v2\".memos.store.WorkspaceBasicSettingH\x00R\fbasicSetting\x12O\n" +
 	"\x0fgeneral_setting\x18\x03 \x01(\v2$.memos.store.WorkspaceGeneralSettingH\x00R\x0egeneralSetting\x12O\n" +
 	"\x0fstorage_setting\x18\x04 \x01(\v2$.memos.store.WorkspaceStorageSettingH\x00R\x0estorageSetting\x12\\\n" +
-	"\x14memo_related_setting\x18\x05 \x01(\v2(.memos.store.WorkspaceMemoRelatedSettingH\x00R\x12memoRelatedSettingB\a\n" +
-	"\x05value\"]\n" +
+	"\x14memo_related_setting\x18\x05 \x01(\v2(.memos.store.WorkspaceMemoRelatedSettingH\x00R\x12memoRelatedSetting\x12U\n" +
+	"\x11embedding_setting\x18\x06 \x01(\v2&.memos.store.WorkspaceEmbeddingSettingH\x00R\x10embeddingSetting\x12C\n" +
+	"\vocr_setting\x18\a \x01(\v2 .memos.store.WorkspaceOCRSettingH\x00R\n" +
+	"ocrSetting\x12\\\n" +
+	"\x14malware_scan_setting\x18\b \x01(\v2(.memos.store.WorkspaceMalwareScanSettingH\x00R\x12malwareScanSetting\x12a\n" +
+	"\x15transcription_setting\x18\t \x01(\v2*.memos.store.WorkspaceTranscriptionSettingH\x00R\x14transcriptionSetting\x12F\n" +
+	"\fscim_setting\x18\n" +
+	" \x01(\v2!.memos.store.WorkspaceSCIMSettingH\x00R\vscimSetting\x12e\n" +
+	"\x17password_policy_setting\x18\v \x01(\v2+.memos.store.WorkspacePasswordPolicySettingH\x00R\x15passwordPolicySetting\x12I\n" +
+	"\remail_setting\x18\f \x01(\v2\".memos.store.WorkspaceEmailSettingH\x00R\femailSetting\x12I\n" +
+	"\rroles_setting\x18\r \x01(\v2\".memos.store.WorkspaceRolesSettingH\x00R\frolesSetting\x12o\n" +
+	"\x1baccess_token_policy_setting\x18\x0e \x01(\v2..memos.store.WorkspaceAccessTokenPolicySettingH\x00R\x18accessTokenPolicySetting\x12O\n" +
+	"\x0fcaptcha_setting\x18\x0f \x01(\v2$.memos.store.WorkspaceCaptchaSettingH\x00R\x0ecaptchaSetting\x12I\n" +
+	"\rslack_setting\x18\x10 \x01(\v2\".memos.store.WorkspaceSlackSettingH\x00R\fslackSetting\x12O\n" +
+	"\x0fdiscord_setting\x18\x11 \x01(\v2$.memos.store.WorkspaceDiscordSettingH\x00R\x0ediscordSettingB\a\n" +
+	"\x05value\"\xb3\x01\n" +
 	"\x15WorkspaceBasicSetting\x12\x1d\n" +
 	"\n" +
 	"secret_key\x18\x01 \x01(\tR\tsecretKey\x12%\n" +
-	"\x0eschema_version\x18\x02 \x01(\tR\rschemaVersion\"\xee\x03\n" +
+	"\x0eschema_version\x18\x02 \x01(\tR\rschemaVersion\x12*\n" +
+	"\x11vapid_private_key\x18\x03 \x01(\tR\x0fvapidPrivateKey\x12(\n" +
+	"\x10vapid_public_key\x18\x04 \x01(\tR\x0evapidPublicKey\"\xf4\x04\n" +
 	"\x17WorkspaceGeneralSetting\x12\x14\n" +
 	"\x05theme\x18\x01 \x01(\tR\x05theme\x12<\n" +
 	"\x1adisallow_user_registration\x18\x02 \x01(\bR\x18disallowUserRegistration\x124\n" +
@@ -816,7 +2953,11 @@ const file_store_workspace_setting_proto_rawDesc = "" +
 	"\x0ecustom_profile\x18\x06 \x01(\v2#.memos.store.WorkspaceCustomProfileR\rcustomProfile\x121\n" +
 	"\x15week_start_day_offset\x18\a \x01(\x05R\x12weekStartDayOffset\x128\n" +
 	"\x18disallow_change_username\x18\b \x01(\bR\x16disallowChangeUsername\x128\n" +
-	"\x18disallow_change_nickname\x18\t \x01(\bR\x16disallowChangeNickname\"\xa3\x01\n" +
+	"\x18disallow_change_nickname\x18\t \x01(\bR\x16disallowChangeNickname\x127\n" +
+	"\x18require_admin_two_factor\x18\n" +
+	" \x01(\bR\x15requireAdminTwoFactor\x12\x19\n" +
+	"\bsso_only\x18\v \x01(\bR\assoOnly\x120\n" +
+	"\x14inbox_retention_days\x18\f \x01(\x05R\x12inboxRetentionDays\"\xa3\x01\n" +
 	"\x16WorkspaceCustomProfile\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x19\n" +
@@ -824,24 +2965,54 @@ const file_store_workspace_setting_proto_rawDesc = "" +
 	"\x06locale\x18\x04 \x01(\tR\x06locale\x12\x1e\n" +
 	"\n" +
 	"appearance\x18\x05 \x01(\tR\n" +
-	"appearance\"\xd5\x02\n" +
+	"appearance\"\xb7\a\n" +
 	"\x17WorkspaceStorageSetting\x12S\n" +
 	"\fstorage_type\x18\x01 \x01(\x0e20.memos.store.WorkspaceStorageSetting.StorageTypeR\vstorageType\x12+\n" +
 	"\x11filepath_template\x18\x02 \x01(\tR\x10filepathTemplate\x12/\n" +
 	"\x14upload_size_limit_mb\x18\x03 \x01(\x03R\x11uploadSizeLimitMb\x129\n" +
-	"\ts3_config\x18\x04 \x01(\v2\x1c.memos.store.StorageS3ConfigR\bs3Config\"L\n" +
+	"\ts3_config\x18\x04 \x01(\v2\x1c.memos.store.StorageS3ConfigR\bs3Config\x12<\n" +
+	"\n" +
+	"gcs_config\x18\x05 \x01(\v2\x1d.memos.store.StorageGCSConfigR\tgcsConfig\x12?\n" +
+	"\vsftp_config\x18\x06 \x01(\v2\x1e.memos.store.StorageSFTPConfigR\n" +
+	"sftpConfig\x120\n" +
+	"\x14strip_image_metadata\x18\a \x01(\bR\x12stripImageMetadata\x124\n" +
+	"\x16keep_image_orientation\x18\b \x01(\bR\x14keepImageOrientation\x12b\n" +
+	"\x11image_compression\x18\t \x01(\v25.memos.store.WorkspaceStorageSetting.ImageCompressionR\x10imageCompression\x1a\x81\x02\n" +
+	"\x10ImageCompression\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12#\n" +
+	"\rmax_dimension\x18\x02 \x01(\x05R\fmaxDimension\x12\x18\n" +
+	"\aquality\x18\x03 \x01(\x05R\aquality\x12T\n" +
+	"\x06format\x18\x04 \x01(\x0e2<.memos.store.WorkspaceStorageSetting.ImageCompression.FormatR\x06format\">\n" +
+	"\x06Format\x12\x16\n" +
+	"\x12FORMAT_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04JPEG\x10\x01\x12\b\n" +
+	"\x04WEBP\x10\x02\x12\b\n" +
+	"\x04AVIF\x10\x03\"_\n" +
 	"\vStorageType\x12\x1c\n" +
 	"\x18STORAGE_TYPE_UNSPECIFIED\x10\x00\x12\f\n" +
 	"\bDATABASE\x10\x01\x12\t\n" +
 	"\x05LOCAL\x10\x02\x12\x06\n" +
-	"\x02S3\x10\x03\"\xd3\x01\n" +
+	"\x02S3\x10\x03\x12\a\n" +
+	"\x03GCS\x10\x04\x12\b\n" +
+	"\x04SFTP\x10\x05\"\xd3\x01\n" +
 	"\x0fStorageS3Config\x12\"\n" +
 	"\raccess_key_id\x18\x01 \x01(\tR\vaccessKeyId\x12*\n" +
 	"\x11access_key_secret\x18\x02 \x01(\tR\x0faccessKeySecret\x12\x1a\n" +
 	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x16\n" +
 	"\x06region\x18\x04 \x01(\tR\x06region\x12\x16\n" +
 	"\x06bucket\x18\x05 \x01(\tR\x06bucket\x12$\n" +
-	"\x0euse_path_style\x18\x06 \x01(\bR\fusePathStyle\"\x88\x04\n" +
+	"\x0euse_path_style\x18\x06 \x01(\bR\fusePathStyle\"h\n" +
+	"\x10StorageGCSConfig\x12 \n" +
+	"\vcredentials\x18\x01 \x01(\tR\vcredentials\x12\x16\n" +
+	"\x06bucket\x18\x02 \x01(\tR\x06bucket\x12\x1a\n" +
+	"\bendpoint\x18\x03 \x01(\tR\bendpoint\"\x9b\x01\n" +
+	"\x11StorageSFTPConfig\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x1f\n" +
+	"\vprivate_key\x18\x04 \x01(\tR\n" +
+	"privateKey\x12\x19\n" +
+	"\bhost_key\x18\x05 \x01(\tR\ahostKey\"\x88\x04\n" +
 	"\x1bWorkspaceMemoRelatedSetting\x12<\n" +
 	"\x1adisallow_public_visibility\x18\x01 \x01(\bR\x18disallowPublicVisibility\x127\n" +
 	"\x18display_with_update_time\x18\x02 \x01(\bR\x15displayWithUpdateTime\x120\n" +
@@ -853,13 +3024,161 @@ const file_store_workspace_setting_proto_rawDesc = "" +
 	"\x1adisable_markdown_shortcuts\x18\b \x01(\bR\x18disableMarkdownShortcuts\x127\n" +
 	"\x18enable_blur_nsfw_content\x18\t \x01(\bR\x15enableBlurNsfwContent\x12\x1b\n" +
 	"\tnsfw_tags\x18\n" +
-	" \x03(\tR\bnsfwTags*s\n" +
+	" \x03(\tR\bnsfwTags\"\x8a\x02\n" +
+	"\x19WorkspaceEmbeddingSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12K\n" +
+	"\bprovider\x18\x02 \x01(\x0e2/.memos.store.WorkspaceEmbeddingSetting.ProviderR\bprovider\x12\x1a\n" +
+	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x17\n" +
+	"\aapi_key\x18\x04 \x01(\tR\x06apiKey\x12\x14\n" +
+	"\x05model\x18\x05 \x01(\tR\x05model\";\n" +
+	"\bProvider\x12\x18\n" +
+	"\x14PROVIDER_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06OPENAI\x10\x01\x12\t\n" +
+	"\x05LOCAL\x10\x02\"\xae\x02\n" +
+	"\x13WorkspaceOCRSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12E\n" +
+	"\bprovider\x18\x02 \x01(\x0e2).memos.store.WorkspaceOCRSetting.ProviderR\bprovider\x12%\n" +
+	"\x0etesseract_path\x18\x03 \x01(\tR\rtesseractPath\x12\x1c\n" +
+	"\tlanguages\x18\x04 \x01(\tR\tlanguages\x12\x1a\n" +
+	"\bendpoint\x18\x05 \x01(\tR\bendpoint\x12\x17\n" +
+	"\aapi_key\x18\x06 \x01(\tR\x06apiKey\"<\n" +
+	"\bProvider\x12\x18\n" +
+	"\x14PROVIDER_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tTESSERACT\x10\x01\x12\a\n" +
+	"\x03API\x10\x02\"\xe3\x03\n" +
+	"\x1bWorkspaceMalwareScanSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12J\n" +
+	"\ascanner\x18\x02 \x01(\x0e20.memos.store.WorkspaceMalwareScanSetting.ScannerR\ascanner\x12%\n" +
+	"\x0eclamav_address\x18\x03 \x01(\tR\rclamavAddress\x12\x18\n" +
+	"\acommand\x18\x04 \x01(\tR\acommand\x12\x1a\n" +
+	"\bendpoint\x18\x05 \x01(\tR\bendpoint\x12\x17\n" +
+	"\aapi_key\x18\x06 \x01(\tR\x06apiKey\x12G\n" +
+	"\x06action\x18\a \x01(\x0e2/.memos.store.WorkspaceMalwareScanSetting.ActionR\x06action\x12\x1b\n" +
+	"\tfail_open\x18\b \x01(\bR\bfailOpen\"D\n" +
+	"\aScanner\x12\x17\n" +
+	"\x13SCANNER_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06CLAMAV\x10\x01\x12\v\n" +
+	"\aCOMMAND\x10\x02\x12\a\n" +
+	"\x03API\x10\x03\"<\n" +
+	"\x06Action\x12\x16\n" +
+	"\x12ACTION_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06REJECT\x10\x01\x12\x0e\n" +
+	"\n" +
+	"QUARANTINE\x10\x02\"\x85\x03\n" +
+	"\x1dWorkspaceTranscriptionSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12O\n" +
+	"\bprovider\x18\x02 \x01(\x0e23.memos.store.WorkspaceTranscriptionSetting.ProviderR\bprovider\x12!\n" +
+	"\fwhisper_path\x18\x03 \x01(\tR\vwhisperPath\x12,\n" +
+	"\x12whisper_model_path\x18\x04 \x01(\tR\x10whisperModelPath\x12\x1a\n" +
+	"\blanguage\x18\x05 \x01(\tR\blanguage\x12\x1a\n" +
+	"\bendpoint\x18\x06 \x01(\tR\bendpoint\x12\x17\n" +
+	"\aapi_key\x18\a \x01(\tR\x06apiKey\x12\x14\n" +
+	"\x05model\x18\b \x01(\tR\x05model\"A\n" +
+	"\bProvider\x12\x18\n" +
+	"\x14PROVIDER_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vWHISPER_CPP\x10\x01\x12\n" +
+	"\n" +
+	"\x06OPENAI\x10\x02\"F\n" +
+	"\x14WorkspaceSCIMSetting\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\xd7\x01\n" +
+	"\x1eWorkspacePasswordPolicySetting\x12\x1d\n" +
+	"\n" +
+	"min_length\x18\x01 \x01(\x05R\tminLength\x12%\n" +
+	"\x0echeck_breached\x18\x02 \x01(\bR\rcheckBreached\x122\n" +
+	"\x15breach_check_endpoint\x18\x03 \x01(\tR\x13breachCheckEndpoint\x12;\n" +
+	"\x1arequire_change_after_reset\x18\x04 \x01(\bR\x17requireChangeAfterReset\"\x81\x01\n" +
+	"!WorkspaceAccessTokenPolicySetting\x12*\n" +
+	"\x11max_lifetime_days\x18\x01 \x01(\x05R\x0fmaxLifetimeDays\x120\n" +
+	"\x14idle_revocation_days\x18\x02 \x01(\x05R\x12idleRevocationDays\"\xbe\x03\n" +
+	"\x17WorkspaceCaptchaSetting\x12I\n" +
+	"\bprovider\x18\x01 \x01(\x0e2-.memos.store.WorkspaceCaptchaSetting.ProviderR\bprovider\x12\x19\n" +
+	"\bsite_key\x18\x02 \x01(\tR\asiteKey\x12\x1d\n" +
+	"\n" +
+	"secret_key\x18\x03 \x01(\tR\tsecretKey\x12+\n" +
+	"\x12require_on_sign_up\x18\x04 \x01(\bR\x0frequireOnSignUp\x129\n" +
+	"\x19sign_in_failure_threshold\x18\x05 \x01(\x05R\x16signInFailureThreshold\x127\n" +
+	"\x18proof_of_work_difficulty\x18\x06 \x01(\x05R\x15proofOfWorkDifficulty\x12'\n" +
+	"\x0fverify_endpoint\x18\a \x01(\tR\x0everifyEndpoint\"T\n" +
+	"\bProvider\x12\x18\n" +
+	"\x14PROVIDER_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tTURNSTILE\x10\x01\x12\f\n" +
+	"\bHCAPTCHA\x10\x02\x12\x11\n" +
+	"\rPROOF_OF_WORK\x10\x03\"~\n" +
+	"\x15WorkspaceSlackSetting\x12%\n" +
+	"\x0esigning_secret\x18\x01 \x01(\tR\rsigningSecret\x12\x1b\n" +
+	"\tbot_token\x18\x02 \x01(\tR\bbotToken\x12!\n" +
+	"\fapi_endpoint\x18\x03 \x01(\tR\vapiEndpoint\"\xd3\x02\n" +
+	"\x17WorkspaceDiscordSetting\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tR\tpublicKey\x12\x1b\n" +
+	"\tbot_token\x18\x03 \x01(\tR\bbotToken\x12#\n" +
+	"\rsave_reaction\x18\x04 \x01(\tR\fsaveReaction\x12*\n" +
+	"\x11digest_channel_id\x18\x05 \x01(\tR\x0fdigestChannelId\x12'\n" +
+	"\x0fdigest_schedule\x18\x06 \x01(\tR\x0edigestSchedule\x128\n" +
+	"\x18digest_include_protected\x18\a \x01(\bR\x16digestIncludeProtected\x12!\n" +
+	"\fapi_endpoint\x18\b \x01(\tR\vapiEndpoint\"\xd7\x04\n" +
+	"\x15WorkspaceEmailSetting\x12\x1b\n" +
+	"\tsmtp_host\x18\x01 \x01(\tR\bsmtpHost\x12\x1b\n" +
+	"\tsmtp_port\x18\x02 \x01(\x05R\bsmtpPort\x12#\n" +
+	"\rsmtp_username\x18\x03 \x01(\tR\fsmtpUsername\x12#\n" +
+	"\rsmtp_password\x18\x04 \x01(\tR\fsmtpPassword\x12\x17\n" +
+	"\ause_tls\x18\x05 \x01(\bR\x06useTls\x12\x1d\n" +
+	"\n" +
+	"from_email\x18\x06 \x01(\tR\tfromEmail\x12\x1b\n" +
+	"\tfrom_name\x18\a \x01(\tR\bfromName\x12<\n" +
+	"\x1arequire_email_verification\x18\b \x01(\bR\x18requireEmailVerification\x120\n" +
+	"\x14allow_password_reset\x18\t \x01(\bR\x12allowPasswordReset\x12\x1b\n" +
+	"\timap_host\x18\n" +
+	" \x01(\tR\bimapHost\x12\x1b\n" +
+	"\timap_port\x18\v \x01(\x05R\bimapPort\x12#\n" +
+	"\rimap_username\x18\f \x01(\tR\fimapUsername\x12#\n" +
+	"\rimap_password\x18\r \x01(\tR\fimapPassword\x12 \n" +
+	"\fimap_use_tls\x18\x0e \x01(\bR\n" +
+	"imapUseTls\x12\x1f\n" +
+	"\vimap_folder\x18\x0f \x01(\tR\n" +
+	"imapFolder\x12.\n" +
+	"\x13imap_archive_folder\x18\x10 \x01(\tR\x11imapArchiveFolder\"O\n" +
+	"\x15WorkspaceRolesSetting\x126\n" +
+	"\x05roles\x18\x01 \x03(\v2 .memos.store.WorkspaceCustomRoleR\x05roles\"v\n" +
+	"\x13WorkspaceCustomRole\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x129\n" +
+	"\vpermissions\x18\x03 \x03(\x0e2\x17.memos.store.PermissionR\vpermissions*\xa3\x02\n" +
 	"\x13WorkspaceSettingKey\x12%\n" +
 	"!WORKSPACE_SETTING_KEY_UNSPECIFIED\x10\x00\x12\t\n" +
 	"\x05BASIC\x10\x01\x12\v\n" +
 	"\aGENERAL\x10\x02\x12\v\n" +
 	"\aSTORAGE\x10\x03\x12\x10\n" +
-	"\fMEMO_RELATED\x10\x04B\xa0\x01\n" +
+	"\fMEMO_RELATED\x10\x04\x12\r\n" +
+	"\tEMBEDDING\x10\x05\x12\a\n" +
+	"\x03OCR\x10\x06\x12\x10\n" +
+	"\fMALWARE_SCAN\x10\a\x12\x11\n" +
+	"\rTRANSCRIPTION\x10\b\x12\b\n" +
+	"\x04SCIM\x10\t\x12\x13\n" +
+	"\x0fPASSWORD_POLICY\x10\n" +
+	"\x12\t\n" +
+	"\x05EMAIL\x10\v\x12\t\n" +
+	"\x05ROLES\x10\f\x12\x17\n" +
+	"\x13ACCESS_TOKEN_POLICY\x10\r\x12\v\n" +
+	"\aCAPTCHA\x10\x0e\x12\t\n" +
+	"\x05SLACK\x10\x0f\x12\v\n" +
+	"\aDISCORD\x10\x10*\xb2\x01\n" +
+	"\n" +
+	"Permission\x12\x1a\n" +
+	"\x16PERMISSION_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fMANAGE_USERS\x10\x01\x12\x0e\n" +
+	"\n" +
+	"VIEW_USERS\x10\x02\x12\x12\n" +
+	"\x0eMODERATE_MEMOS\x10\x03\x12\x13\n" +
+	"\x0fMANAGE_WEBHOOKS\x10\x04\x12\x13\n" +
+	"\x0fCHECK_INTEGRITY\x10\x05\x12\x14\n" +
+	"\x10MANAGE_WORKSPACE\x10\x06\x12\x12\n" +
+	"\x0eMANAGE_STORAGE\x10\aB\xa0\x01\n" +
 	"\x0fcom.memos.storeB\x15WorkspaceSettingProtoP\x01Z)github.com/usememos/memos/proto/gen/store\xa2\x02\x03MSX\xaa\x02\vMemos.Store\xca\x02\vMemos\\Store\xe2\x02\x17Memos\\Store\\GPBMetadata\xea\x02\fMemos::Storeb\x06proto3"
 
 var (
@@ -874,33 +3193,81 @@ func file_store_workspace_setting_proto_rawDescGZIP() []byte {
 	return file_store_workspace_setting_proto_rawDescData
 }
 
-var file_store_workspace_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_store_workspace_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_store_workspace_setting_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_store_workspace_setting_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_store_workspace_setting_proto_goTypes = []any{
-	(WorkspaceSettingKey)(0),                 // 0: memos.store.WorkspaceSettingKey
-	(WorkspaceStorageSetting_StorageType)(0), // 1: memos.store.WorkspaceStorageSetting.StorageType
-	(*WorkspaceSetting)(nil),                 // 2: memos.store.WorkspaceSetting
-	(*WorkspaceBasicSetting)(nil),            // 3: memos.store.WorkspaceBasicSetting
-	(*WorkspaceGeneralSetting)(nil),          // 4: memos.store.WorkspaceGeneralSetting
-	(*WorkspaceCustomProfile)(nil),           // 5: memos.store.WorkspaceCustomProfile
-	(*WorkspaceStorageSetting)(nil),          // 6: memos.store.WorkspaceStorageSetting
-	(*StorageS3Config)(nil),                  // 7: memos.store.StorageS3Config
-	(*WorkspaceMemoRelatedSetting)(nil),      // 8: memos.store.WorkspaceMemoRelatedSetting
+	(WorkspaceSettingKey)(0),                             // 0: memos.store.WorkspaceSettingKey
+	(Permission)(0),                                      // 1: memos.store.Permission
+	(WorkspaceStorageSetting_StorageType)(0),             // 2: memos.store.WorkspaceStorageSetting.StorageType
+	(WorkspaceStorageSetting_ImageCompression_Format)(0), // 3: memos.store.WorkspaceStorageSetting.ImageCompression.Format
+	(WorkspaceEmbeddingSetting_Provider)(0),              // 4: memos.store.WorkspaceEmbeddingSetting.Provider
+	(WorkspaceOCRSetting_Provider)(0),                    // 5: memos.store.WorkspaceOCRSetting.Provider
+	(WorkspaceMalwareScanSetting_Scanner)(0),             // 6: memos.store.WorkspaceMalwareScanSetting.Scanner
+	(WorkspaceMalwareScanSetting_Action)(0),              // 7: memos.store.WorkspaceMalwareScanSetting.Action
+	(WorkspaceTranscriptionSetting_Provider)(0),          // 8: memos.store.WorkspaceTranscriptionSetting.Provider
+	(WorkspaceCaptchaSetting_Provider)(0),                // 9: memos.store.WorkspaceCaptchaSetting.Provider
+	(*WorkspaceSetting)(nil),                             // 10: memos.store.WorkspaceSetting
+	(*WorkspaceBasicSetting)(nil),                        // 11: memos.store.WorkspaceBasicSetting
+	(*WorkspaceGeneralSetting)(nil),                      // 12: memos.store.WorkspaceGeneralSetting
+	(*WorkspaceCustomProfile)(nil),                       // 13: memos.store.WorkspaceCustomProfile
+	(*WorkspaceStorageSetting)(nil),                      // 14: memos.store.WorkspaceStorageSetting
+	(*StorageS3Config)(nil),                              // 15: memos.store.StorageS3Config
+	(*StorageGCSConfig)(nil),                             // 16: memos.store.StorageGCSConfig
+	(*StorageSFTPConfig)(nil),                            // 17: memos.store.StorageSFTPConfig
+	(*WorkspaceMemoRelatedSetting)(nil),                  // 18: memos.store.WorkspaceMemoRelatedSetting
+	(*WorkspaceEmbeddingSetting)(nil),                    // 19: memos.store.WorkspaceEmbeddingSetting
+	(*WorkspaceOCRSetting)(nil),                          // 20: memos.store.WorkspaceOCRSetting
+	(*WorkspaceMalwareScanSetting)(nil),                  // 21: memos.store.WorkspaceMalwareScanSetting
+	(*WorkspaceTranscriptionSetting)(nil),                // 22: memos.store.WorkspaceTranscriptionSetting
+	(*WorkspaceSCIMSetting)(nil),                         // 23: memos.store.WorkspaceSCIMSetting
+	(*WorkspacePasswordPolicySetting)(nil),               // 24: memos.store.WorkspacePasswordPolicySetting
+	(*WorkspaceAccessTokenPolicySetting)(nil),            // 25: memos.store.WorkspaceAccessTokenPolicySetting
+	(*WorkspaceCaptchaSetting)(nil),                      // 26: memos.store.WorkspaceCaptchaSetting
+	(*WorkspaceSlackSetting)(nil),                        // 27: memos.store.WorkspaceSlackSetting
+	(*WorkspaceDiscordSetting)(nil),                      // 28: memos.store.WorkspaceDiscordSetting
+	(*WorkspaceEmailSetting)(nil),                        // 29: memos.store.WorkspaceEmailSetting
+	(*WorkspaceRolesSetting)(nil),                        // 30: memos.store.WorkspaceRolesSetting
+	(*WorkspaceCustomRole)(nil),                          // 31: memos.store.WorkspaceCustomRole
+	(*WorkspaceStorageSetting_ImageCompression)(nil),     // 32: memos.store.WorkspaceStorageSetting.ImageCompression
 }
 var file_store_workspace_setting_proto_depIdxs = []int32{
-	0, // 0: memos.store.WorkspaceSetting.key:type_name -> memos.store.WorkspaceSettingKey
-	3, // 1: memos.store.WorkspaceSetting.basic_setting:type_name -> memos.store.WorkspaceBasicSetting
-	4, // 2: memos.store.WorkspaceSetting.general_setting:type_name -> memos.store.WorkspaceGeneralSetting
-	6, // 3: memos.store.WorkspaceSetting.storage_setting:type_name -> memos.store.WorkspaceStorageSetting
-	8, // 4: memos.store.WorkspaceSetting.memo_related_setting:type_name -> memos.store.WorkspaceMemoRelatedSetting
-	5, // 5: memos.store.WorkspaceGeneralSetting.custom_profile:type_name -> memos.store.WorkspaceCustomProfile
-	1, // 6: memos.store.WorkspaceStorageSetting.storage_type:type_name -> memos.store.WorkspaceStorageSetting.StorageType
-	7, // 7: memos.store.WorkspaceStorageSetting.s3_config:type_name -> memos.store.StorageS3Config
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	0,  // 0: memos.store.WorkspaceSetting.key:type_name -> memos.store.WorkspaceSettingKey
+	11, // 1: memos.store.WorkspaceSetting.basic_setting:type_name -> memos.store.WorkspaceBasicSetting
+	12, // 2: memos.store.WorkspaceSetting.general_setting:type_name -> memos.store.WorkspaceGeneralSetting
+	14, // 3: memos.store.WorkspaceSetting.storage_setting:type_name -> memos.store.WorkspaceStorageSetting
+	18, // 4: memos.store.WorkspaceSetting.memo_related_setting:type_name -> memos.store.WorkspaceMemoRelatedSetting
+	19, // 5: memos.store.WorkspaceSetting.embedding_setting:type_name -> memos.store.WorkspaceEmbeddingSetting
+	20, // 6: memos.store.WorkspaceSetting.ocr_setting:type_name -> memos.store.WorkspaceOCRSetting
+	21, // 7: memos.store.WorkspaceSetting.malware_scan_setting:type_name -> memos.store.WorkspaceMalwareScanSetting
+	22, // 8: memos.store.WorkspaceSetting.transcription_setting:type_name -> memos.store.WorkspaceTranscriptionSetting
+	23, // 9: memos.store.WorkspaceSetting.scim_setting:type_name -> memos.store.WorkspaceSCIMSetting
+	24, // 10: memos.store.WorkspaceSetting.password_policy_setting:type_name -> memos.store.WorkspacePasswordPolicySetting
+	29, // 11: memos.store.WorkspaceSetting.email_setting:type_name -> memos.store.WorkspaceEmailSetting
+	30, // 12: memos.store.WorkspaceSetting.roles_setting:type_name -> memos.store.WorkspaceRolesSetting
+	25, // 13: memos.store.WorkspaceSetting.access_token_policy_setting:type_name -> memos.store.WorkspaceAccessTokenPolicySetting
+	26, // 14: memos.store.WorkspaceSetting.captcha_setting:type_name -> memos.store.WorkspaceCaptchaSetting
+	27, // 15: memos.store.WorkspaceSetting.slack_setting:type_name -> memos.store.WorkspaceSlackSetting
+	28, // 16: memos.store.WorkspaceSetting.discord_setting:type_name -> memos.store.WorkspaceDiscordSetting
+	13, // 17: memos.store.WorkspaceGeneralSetting.custom_profile:type_name -> memos.store.WorkspaceCustomProfile
+	2,  // 18: memos.store.WorkspaceStorageSetting.storage_type:type_name -> memos.store.WorkspaceStorageSetting.StorageType
+	15, // 19: memos.store.WorkspaceStorageSetting.s3_config:type_name -> memos.store.StorageS3Config
+	16, // 20: memos.store.WorkspaceStorageSetting.gcs_config:type_name -> memos.store.StorageGCSConfig
+	17, // 21: memos.store.WorkspaceStorageSetting.sftp_config:type_name -> memos.store.StorageSFTPConfig
+	32, // 22: memos.store.WorkspaceStorageSetting.image_compression:type_name -> memos.store.WorkspaceStorageSetting.ImageCompression
+	4,  // 23: memos.store.WorkspaceEmbeddingSetting.provider:type_name -> memos.store.WorkspaceEmbeddingSetting.Provider
+	5,  // 24: memos.store.WorkspaceOCRSetting.provider:type_name -> memos.store.WorkspaceOCRSetting.Provider
+	6,  // 25: memos.store.WorkspaceMalwareScanSetting.scanner:type_name -> memos.store.WorkspaceMalwareScanSetting.Scanner
+	7,  // 26: memos.store.WorkspaceMalwareScanSetting.action:type_name -> memos.store.WorkspaceMalwareScanSetting.Action
+	8,  // 27: memos.store.WorkspaceTranscriptionSetting.provider:type_name -> memos.store.WorkspaceTranscriptionSetting.Provider
+	9,  // 28: memos.store.WorkspaceCaptchaSetting.provider:type_name -> memos.store.WorkspaceCaptchaSetting.Provider
+	31, // 29: memos.store.WorkspaceRolesSetting.roles:type_name -> memos.store.WorkspaceCustomRole
+	1,  // 30: memos.store.WorkspaceCustomRole.permissions:type_name -> memos.store.Permission
+	3,  // 31: memos.store.WorkspaceStorageSetting.ImageCompression.format:type_name -> memos.store.WorkspaceStorageSetting.ImageCompression.Format
+	32, // [32:32] is the sub-list for method output_type
+	32, // [32:32] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_store_workspace_setting_proto_init() }
@@ -913,14 +3280,26 @@ func file_store_workspace_setting_proto_init() {
 		(*WorkspaceSetting_GeneralSetting)(nil),
 		(*WorkspaceSetting_StorageSetting)(nil),
 		(*WorkspaceSetting_MemoRelatedSetting)(nil),
+		(*WorkspaceSetting_EmbeddingSetting)(nil),
+		(*WorkspaceSetting_OcrSetting)(nil),
+		(*WorkspaceSetting_MalwareScanSetting)(nil),
+		(*WorkspaceSetting_TranscriptionSetting)(nil),
+		(*WorkspaceSetting_ScimSetting)(nil),
+		(*WorkspaceSetting_PasswordPolicySetting)(nil),
+		(*WorkspaceSetting_EmailSetting)(nil),
+		(*WorkspaceSetting_RolesSetting)(nil),
+		(*WorkspaceSetting_AccessTokenPolicySetting)(nil),
+		(*WorkspaceSetting_CaptchaSetting)(nil),
+		(*WorkspaceSetting_SlackSetting)(nil),
+		(*WorkspaceSetting_DiscordSetting)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_workspace_setting_proto_rawDesc), len(file_store_workspace_setting_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   7,
+			NumEnums:      10,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
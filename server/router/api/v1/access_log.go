@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/usememos/memos/plugin/accesslog"
+)
+
+// AccessLogMiddleware writes the requests served by the echo server to the access log,
+// with the user authenticated by the session cookie or the access token of the request.
+func (s *APIV1Service) AccessLogMiddleware(logger *accesslog.Logger) echo.MiddlewareFunc {
+	authInterceptor := NewGRPCAuthInterceptor(s.Store, s.Secret)
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			err := next(c)
+			if err != nil {
+				// Let the error handler write the response, so that its status is logged.
+				c.Error(err)
+			}
+			request, response := c.Request(), c.Response()
+			entry := &accesslog.Entry{
+				Time:      start,
+				Method:    request.Method,
+				Path:      request.URL.Path,
+				Status:    response.Status,
+				Bytes:     response.Size,
+				LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+				RemoteIP:  c.RealIP(),
+				UserAgent: request.UserAgent(),
+			}
+			if request.Header.Get("Cookie") != "" || request.Header.Get("Authorization") != "" {
+				md := metadata.MD{}
+				md.Append("cookie", request.Header.Values("Cookie")...)
+				md.Append("authorization", request.Header.Values("Authorization")...)
+				if user, _, _, err := authInterceptor.authenticate(request.Context(), md); err == nil && user != nil {
+					entry.User = user.Username
+				}
+			}
+			if err := logger.Log(entry); err != nil {
+				slog.Warn("failed to write access log", slog.Any("error", err))
+			}
+			return nil
+		}
+	}
+}
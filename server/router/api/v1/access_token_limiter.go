@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// accessTokenLimiter limits the requests of the access tokens with a rate limit, in memory.
+type accessTokenLimiter struct {
+	mutex    sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+func newAccessTokenLimiter() *accessTokenLimiter {
+	return &accessTokenLimiter{
+		limiters: map[string]*rate.Limiter{},
+	}
+}
+
+// allow returns whether a request of the access token is allowed by its rate limit, unlimited if 0.
+// The whole limit may be used at once, then the requests are allowed evenly over the minute.
+func (l *accessTokenLimiter) allow(accessToken string, ratePerMinute int32) bool {
+	if ratePerMinute <= 0 {
+		return true
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	limit := rate.Every(time.Minute / time.Duration(ratePerMinute))
+	limiter, ok := l.limiters[accessToken]
+	if !ok {
+		limiter = rate.NewLimiter(limit, int(ratePerMinute))
+		l.limiters[accessToken] = limiter
+	} else if limiter.Burst() != int(ratePerMinute) {
+		// The rate limit of the access token has been updated.
+		limiter.SetLimit(limit)
+		limiter.SetBurst(int(ratePerMinute))
+	}
+	return limiter.Allow()
+}
+
+// allowAccessTokenRequest returns whether a request of the access token is allowed by its rate limit, shared by the
+// instances of the cluster if any. The limit of the instance applies while Redis is unreachable.
+func (in *GRPCAuthInterceptor) allowAccessTokenRequest(ctx context.Context, accessToken string, ratePerMinute int32) bool {
+	if in.Cluster != nil {
+		// The access tokens are not stored in Redis.
+		hash := sha256.Sum256([]byte(accessToken))
+		allowed, err := in.Cluster.Allow(ctx, "access_token:"+hex.EncodeToString(hash[:]), ratePerMinute)
+		if err == nil {
+			return allowed
+		}
+		slog.Warn("failed to check the rate limit of the access token in the cluster", slog.Any("error", err))
+	}
+	return in.accessTokenLimiter.allow(accessToken, ratePerMinute)
+}
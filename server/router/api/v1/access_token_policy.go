@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/store"
+)
+
+// getAccessTokenExpiration returns the expiration of a new access token, within the maximum lifetime of the workspace.
+// The maximum lifetime is the default expiration of the tokens without one.
+func (s *APIV1Service) getAccessTokenExpiration(ctx context.Context, expiresAt time.Time) (time.Time, error) {
+	policy, err := s.Store.GetWorkspaceAccessTokenPolicySetting(ctx)
+	if err != nil {
+		return time.Time{}, status.Errorf(codes.Internal, "failed to get workspace access token policy setting: %v", err)
+	}
+	if policy.MaxLifetimeDays <= 0 {
+		return expiresAt, nil
+	}
+	maxExpiresAt := time.Now().AddDate(0, 0, int(policy.MaxLifetimeDays))
+	if expiresAt.IsZero() {
+		return maxExpiresAt, nil
+	}
+	if expiresAt.After(maxExpiresAt) {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "access tokens must expire within %d days", policy.MaxLifetimeDays)
+	}
+	return expiresAt, nil
+}
+
+// checkAccessTokenLifetime returns an Unauthenticated error if the access token is older than the maximum lifetime of the workspace,
+// so that the tokens issued before the policy are bound by it too.
+func checkAccessTokenLifetime(ctx context.Context, stores *store.Store, claims *ClaimsMessage) error {
+	policy, err := stores.GetWorkspaceAccessTokenPolicySetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace access token policy setting: %v", err)
+	}
+	if policy.MaxLifetimeDays <= 0 || claims.IssuedAt == nil {
+		return nil
+	}
+	if time.Now().After(claims.IssuedAt.AddDate(0, 0, int(policy.MaxLifetimeDays))) {
+		return status.Errorf(codes.Unauthenticated, "access token exceeds the maximum lifetime")
+	}
+	return nil
+}
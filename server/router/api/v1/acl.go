@@ -2,6 +2,7 @@ package v1
 
 import (
 	"context"
+	"log/slog"
 	"net/http"
 	"strings"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/cluster"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
@@ -33,15 +35,20 @@ const (
 
 // GRPCAuthInterceptor is the auth interceptor for gRPC server.
 type GRPCAuthInterceptor struct {
-	Store  *store.Store
-	secret string
+	Store *store.Store
+	// Cluster shares the rate limits of the access tokens among the instances, if not nil.
+	Cluster *cluster.Cluster
+	secret  string
+
+	accessTokenLimiter *accessTokenLimiter
 }
 
 // NewGRPCAuthInterceptor returns a new API auth interceptor.
 func NewGRPCAuthInterceptor(store *store.Store, secret string) *GRPCAuthInterceptor {
 	return &GRPCAuthInterceptor{
-		Store:  store,
-		secret: secret,
+		Store:              store,
+		secret:             secret,
+		accessTokenLimiter: newAccessTokenLimiter(),
 	}
 }
 
@@ -52,6 +59,26 @@ func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, re
 		return nil, status.Errorf(codes.Unauthenticated, "failed to parse metadata from incoming context")
 	}
 
+	user, sessionID, accessToken, err := in.authenticate(ctx, md)
+	if err != nil {
+		return nil, err
+	}
+	if user != nil {
+		return in.handleAuthenticatedRequest(ctx, request, serverInfo, handler, user, sessionID, accessToken)
+	}
+
+	// If no valid authentication found, check if this method is in the allowlist (public endpoints)
+	if isUnauthorizeAllowedMethod(serverInfo.FullMethod) {
+		return handler(ctx, request)
+	}
+
+	// If authentication is required but not found, reject the request
+	return nil, status.Errorf(codes.Unauthenticated, "authentication required")
+}
+
+// authenticate returns the user authenticated by the session cookie or by the access token in the metadata,
+// with the session ID or the access token, or a nil user if none is valid.
+func (in *GRPCAuthInterceptor) authenticate(ctx context.Context, md metadata.MD) (*store.User, string, string, error) {
 	// Try to authenticate via session ID (from cookie) first
 	if sessionCookieValue, err := getSessionIDFromMetadata(md); err == nil && sessionCookieValue != "" {
 		user, err := in.authenticateBySession(ctx, sessionCookieValue)
@@ -59,9 +86,9 @@ func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, re
 			// Extract just the sessionID part for context storage
 			_, sessionID, parseErr := ParseSessionCookieValue(sessionCookieValue)
 			if parseErr != nil {
-				return nil, status.Errorf(codes.Internal, "failed to parse session cookie: %v", parseErr)
+				return nil, "", "", status.Errorf(codes.Internal, "failed to parse session cookie: %v", parseErr)
 			}
-			return in.handleAuthenticatedRequest(ctx, request, serverInfo, handler, user, sessionID, "")
+			return user, sessionID, "", nil
 		}
 	}
 
@@ -69,27 +96,49 @@ func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, re
 	if accessToken, err := getAccessTokenFromMetadata(md); err == nil && accessToken != "" {
 		user, err := in.authenticateByJWT(ctx, accessToken)
 		if err == nil && user != nil {
-			return in.handleAuthenticatedRequest(ctx, request, serverInfo, handler, user, "", accessToken)
+			return user, "", accessToken, nil
 		}
 	}
-
-	// If no valid authentication found, check if this method is in the allowlist (public endpoints)
-	if isUnauthorizeAllowedMethod(serverInfo.FullMethod) {
-		return handler(ctx, request)
-	}
-
-	// If authentication is required but not found, reject the request
-	return nil, status.Errorf(codes.Unauthenticated, "authentication required")
+	return nil, "", "", nil
 }
 
 // handleAuthenticatedRequest processes an authenticated request with the given user and auth info.
 func (in *GRPCAuthInterceptor) handleAuthenticatedRequest(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler, user *store.User, sessionID, accessToken string) (any, error) {
+	ctx, err := in.authorize(ctx, serverInfo.FullMethod, user, sessionID, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, request)
+}
+
+// authorize checks that the authenticated user may call the method, and returns the context of the request
+// with the user and its auth info. The context is left anonymous for the suspended users calling a public method.
+func (in *GRPCAuthInterceptor) authorize(ctx context.Context, fullMethodName string, user *store.User, sessionID, accessToken string) (context.Context, error) {
 	// Check user status
 	if user.RowStatus == store.Archived {
 		return nil, errors.Errorf("user %q is archived", user.Username)
 	}
-	if isOnlyForAdminAllowedMethod(serverInfo.FullMethod) && user.Role != store.RoleHost && user.Role != store.RoleAdmin {
-		return nil, errors.Errorf("user %q is not admin", user.Username)
+	if err := checkUserNotSuspended(ctx, in.Store, user); err != nil && fullMethodName != "/memos.api.v1.AuthService/DeleteSession" {
+		// The suspended users can still sign out, and read the public endpoints as anonymous visitors.
+		if status.Code(err) == codes.PermissionDenied && isUnauthorizeAllowedMethod(fullMethodName) {
+			return ctx, nil
+		}
+		return nil, err
+	}
+	if permission, ok := getMethodPermission(fullMethodName); ok {
+		allowed, err := hasPermission(ctx, in.Store, user, permission)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user permissions: %v", err)
+		}
+		if !allowed {
+			return nil, errors.Errorf("user %q does not have the %s permission", user.Username, permission)
+		}
+	}
+	if err := in.checkTwoFactorRequirement(ctx, fullMethodName, user); err != nil {
+		return nil, err
+	}
+	if err := in.checkPasswordChangeRequirement(ctx, fullMethodName, user); err != nil {
+		return nil, err
 	}
 
 	// Set context values
@@ -97,15 +146,105 @@ func (in *GRPCAuthInterceptor) handleAuthenticatedRequest(ctx context.Context, r
 
 	if sessionID != "" {
 		// Session-based authentication
+		if err := in.checkImpersonation(ctx, fullMethodName, user, sessionID); err != nil {
+			return nil, err
+		}
 		ctx = context.WithValue(ctx, sessionIDContextKey, sessionID)
 		// Update session last accessed time
 		_ = in.updateSessionLastAccessed(ctx, user.ID, sessionID)
 	} else if accessToken != "" {
 		// JWT access token-based authentication
+		if err := in.checkAccessTokenRateLimit(ctx, user.ID, accessToken); err != nil {
+			return nil, err
+		}
 		ctx = context.WithValue(ctx, accessTokenContextKey, accessToken)
+		// Count the request once allowed, the throttled requests of a script not being counted.
+		md, _ := metadata.FromIncomingContext(ctx)
+		in.Store.IncrementUserAccessTokenUsage(user.ID, accessToken, timestamppb.Now(), getClientIPFromMetadata(md))
 	}
+	return ctx, nil
+}
 
-	return handler(ctx, request)
+// checkImpersonation restricts the impersonation sessions to the read methods, as long as their impersonator is the host,
+// and logs their requests for audit.
+func (in *GRPCAuthInterceptor) checkImpersonation(ctx context.Context, fullMethodName string, user *store.User, sessionID string) error {
+	session, err := in.Store.GetUserSession(ctx, user.ID, sessionID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user session: %v", err)
+	}
+	if session == nil || session.ImpersonatorId == 0 {
+		return nil
+	}
+	impersonator, err := in.Store.GetUser(ctx, &store.FindUser{
+		ID: &session.ImpersonatorId,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get impersonator: %v", err)
+	}
+	if impersonator == nil || impersonator.Role != store.RoleHost || impersonator.RowStatus == store.Archived {
+		return status.Errorf(codes.Unauthenticated, "the impersonator is no longer the host")
+	}
+	if !isImpersonationAllowedMethod(fullMethodName) {
+		return status.Errorf(codes.PermissionDenied, "the impersonation session is read-only")
+	}
+	slog.Info("impersonated request", "impersonator", impersonator.Username, "user", user.Username, "method", fullMethodName)
+	return nil
+}
+
+// checkAccessTokenRateLimit rejects the request if the access token has exceeded its rate limit.
+func (in *GRPCAuthInterceptor) checkAccessTokenRateLimit(ctx context.Context, userID int32, accessToken string) error {
+	accessTokens, err := in.Store.GetUserAccessTokens(ctx, userID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user access tokens: %v", err)
+	}
+	for _, userAccessToken := range accessTokens {
+		if userAccessToken.AccessToken == accessToken && !in.allowAccessTokenRequest(ctx, accessToken, userAccessToken.RateLimitPerMinute) {
+			return status.Errorf(codes.ResourceExhausted, "rate limit of %d requests per minute exceeded for the access token", userAccessToken.RateLimitPerMinute)
+		}
+	}
+	return nil
+}
+
+// checkTwoFactorRequirement rejects the requests of the host and admins without two-factor authentication
+// when the workspace requires it, except the ones needed to enable it.
+func (in *GRPCAuthInterceptor) checkTwoFactorRequirement(ctx context.Context, fullMethodName string, user *store.User) error {
+	if user.Role != store.RoleHost && user.Role != store.RoleAdmin {
+		return nil
+	}
+	if isTwoFactorSetupAllowedMethod(fullMethodName) {
+		return nil
+	}
+	workspaceGeneralSetting, err := in.Store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace general setting: %v", err)
+	}
+	if !workspaceGeneralSetting.RequireAdminTwoFactor {
+		return nil
+	}
+	twoFactor, err := in.Store.GetUserTwoFactor(ctx, user.ID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user two-factor: %v", err)
+	}
+	if !twoFactor.Enabled {
+		return status.Errorf(codes.FailedPrecondition, "two-factor authentication is required for user %q", user.Username)
+	}
+	return nil
+}
+
+// checkPasswordChangeRequirement rejects the requests of the users who have to change the password set by an admin,
+// but the ones needed to change it.
+func (in *GRPCAuthInterceptor) checkPasswordChangeRequirement(ctx context.Context, fullMethodName string, user *store.User) error {
+	if isPasswordChangeAllowedMethod(fullMethodName) {
+		return nil
+	}
+	password, err := in.Store.GetUserPassword(ctx, user.ID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user password: %v", err)
+	}
+	if password.ChangeRequired {
+		return status.Errorf(codes.FailedPrecondition, "password change is required for user %q", user.Username)
+	}
+	return nil
 }
 
 // authenticateByJWT authenticates a user using JWT access token from Authorization header.
@@ -155,6 +294,9 @@ func (in *GRPCAuthInterceptor) authenticateByJWT(ctx context.Context, accessToke
 	if !validateAccessToken(accessToken, accessTokens) {
 		return nil, status.Errorf(codes.Unauthenticated, "invalid access token")
 	}
+	if err := checkAccessTokenLifetime(ctx, in.Store, claims); err != nil {
+		return nil, err
+	}
 
 	return user, nil
 }
@@ -207,6 +349,10 @@ func (in *GRPCAuthInterceptor) updateSessionLastAccessed(ctx context.Context, us
 func validateUserSession(sessionID string, userSessions []*storepb.SessionsUserSetting_Session) bool {
 	for _, session := range userSessions {
 		if sessionID == session.SessionId {
+			// The impersonation sessions have a fixed expiration.
+			if session.ExpireTime != nil && session.ExpireTime.AsTime().Before(time.Now()) {
+				return false
+			}
 			// Use sliding expiration: check if last_accessed_time + 2 weeks > current_time
 			if session.LastAccessedTime != nil {
 				expirationTime := session.LastAccessedTime.AsTime().Add(SessionSlidingDuration)
@@ -1,11 +1,20 @@
 package v1
 
+import (
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
 var authenticationAllowlistMethods = map[string]bool{
 	"/memos.api.v1.WorkspaceService/GetWorkspaceProfile":          true,
 	"/memos.api.v1.WorkspaceService/GetWorkspaceSetting":          true,
 	"/memos.api.v1.IdentityProviderService/ListIdentityProviders": true,
 	"/memos.api.v1.AuthService/CreateSession":                     true,
 	"/memos.api.v1.AuthService/GetCurrentSession":                 true,
+	"/memos.api.v1.AuthService/VerifyEmail":                       true,
+	"/memos.api.v1.AuthService/SendVerificationEmail":             true,
+	"/memos.api.v1.AuthService/RequestPasswordReset":              true,
+	"/memos.api.v1.AuthService/ResetPassword":                     true,
+	"/memos.api.v1.AuthService/CreateCaptchaChallenge":            true,
 	"/memos.api.v1.UserService/CreateUser":                        true,
 	"/memos.api.v1.UserService/GetUser":                           true,
 	"/memos.api.v1.UserService/GetUserAvatar":                     true,
@@ -14,8 +23,15 @@ var authenticationAllowlistMethods = map[string]bool{
 	"/memos.api.v1.UserService/SearchUsers":                       true,
 	"/memos.api.v1.MemoService/GetMemo":                           true,
 	"/memos.api.v1.MemoService/ListMemos":                         true,
+	"/memos.api.v1.MemoService/SearchMemos":                       true,
+	"/memos.api.v1.MemoService/SuggestMemos":                      true,
+	"/memos.api.v1.MemoService/GetSharedMemo":                     true,
+	"/memos.api.v1.TagService/ListTagStats":                       true,
+	"/memos.api.v1.TagService/ListTags":                           true,
+	"/memos.api.v1.TagService/ListSharedTagMemos":                 true,
 	"/memos.api.v1.MarkdownService/GetLinkMetadata":               true,
 	"/memos.api.v1.AttachmentService/GetAttachmentBinary":         true,
+	"/memos.api.v1.MemoService/GetMemoAttachmentsArchive":         true,
 }
 
 // isUnauthorizeAllowedMethod returns whether the method is exempted from authentication.
@@ -23,12 +39,144 @@ func isUnauthorizeAllowedMethod(fullMethodName string) bool {
 	return authenticationAllowlistMethods[fullMethodName]
 }
 
-var allowedMethodsOnlyForAdmin = map[string]bool{
-	"/memos.api.v1.UserService/CreateUser":                  true,
-	"/memos.api.v1.WorkspaceService/UpdateWorkspaceSetting": true,
+// methodPermissions are the permissions required to call the methods, on top of the checks of the methods themselves.
+var methodPermissions = map[string]storepb.Permission{
+	"/memos.api.v1.UserService/CreateUser":                          storepb.Permission_MANAGE_USERS,
+	"/memos.api.v1.WorkspaceService/UpdateWorkspaceSetting":         storepb.Permission_MANAGE_WORKSPACE,
+	"/memos.api.v1.WorkspaceService/CheckWorkspaceIntegrity":        storepb.Permission_CHECK_INTEGRITY,
+	"/memos.api.v1.WorkspaceService/ReloadWorkspace":                storepb.Permission_MANAGE_WORKSPACE,
+	"/memos.api.v1.WorkspaceService/ListJobs":                       storepb.Permission_MANAGE_WORKSPACE,
+	"/memos.api.v1.WorkspaceService/GetJob":                         storepb.Permission_MANAGE_WORKSPACE,
+	"/memos.api.v1.WorkspaceService/RetryJob":                       storepb.Permission_MANAGE_WORKSPACE,
+	"/memos.api.v1.WorkspaceService/ListScheduledTasks":             storepb.Permission_MANAGE_WORKSPACE,
+	"/memos.api.v1.WorkspaceService/UpdateScheduledTask":            storepb.Permission_MANAGE_WORKSPACE,
+	"/memos.api.v1.WorkspaceService/RunScheduledTask":               storepb.Permission_MANAGE_WORKSPACE,
+	"/memos.api.v1.AttachmentService/MigrateAttachmentStorage":      storepb.Permission_MANAGE_STORAGE,
+	"/memos.api.v1.AttachmentService/GetAttachmentStorageMigration": storepb.Permission_MANAGE_STORAGE,
+	"/memos.api.v1.UserService/GetUserSuspension":                   storepb.Permission_MANAGE_USERS,
+	"/memos.api.v1.UserService/SuspendUser":                         storepb.Permission_MANAGE_USERS,
+	"/memos.api.v1.UserService/UnsuspendUser":                       storepb.Permission_MANAGE_USERS,
+	"/memos.api.v1.UserService/SetUserCustomRole":                   storepb.Permission_MANAGE_USERS,
+	"/memos.api.v1.UserService/ListInvitations":                     storepb.Permission_MANAGE_USERS,
+	"/memos.api.v1.UserService/CreateInvitation":                    storepb.Permission_MANAGE_USERS,
+	"/memos.api.v1.UserService/DeleteInvitation":                    storepb.Permission_MANAGE_USERS,
+}
+
+// getMethodPermission returns the permission required to call the method, if any.
+func getMethodPermission(methodName string) (storepb.Permission, bool) {
+	permission, ok := methodPermissions[methodName]
+	return permission, ok
+}
+
+var twoFactorSetupAllowedMethods = map[string]bool{
+	"/memos.api.v1.WorkspaceService/GetWorkspaceProfile": true,
+	"/memos.api.v1.WorkspaceService/GetWorkspaceSetting": true,
+	"/memos.api.v1.AuthService/GetCurrentSession":        true,
+	"/memos.api.v1.AuthService/DeleteSession":            true,
+	"/memos.api.v1.UserService/GetUser":                  true,
+	"/memos.api.v1.UserService/GetUserSetting":           true,
+	"/memos.api.v1.UserService/GetUserTwoFactor":         true,
+	"/memos.api.v1.UserService/SetupUserTwoFactor":       true,
+	"/memos.api.v1.UserService/EnableUserTwoFactor":      true,
+}
+
+// isTwoFactorSetupAllowedMethod returns whether the method is allowed for the host and admins
+// who have to enable two-factor authentication before using the workspace.
+func isTwoFactorSetupAllowedMethod(methodName string) bool {
+	return twoFactorSetupAllowedMethods[methodName]
+}
+
+var passwordChangeAllowedMethods = map[string]bool{
+	"/memos.api.v1.WorkspaceService/GetWorkspaceProfile": true,
+	"/memos.api.v1.WorkspaceService/GetWorkspaceSetting": true,
+	"/memos.api.v1.AuthService/GetCurrentSession":        true,
+	"/memos.api.v1.AuthService/DeleteSession":            true,
+	"/memos.api.v1.UserService/GetUser":                  true,
+	"/memos.api.v1.UserService/GetUserSetting":           true,
+	"/memos.api.v1.UserService/UpdateUser":               true,
+}
+
+// isPasswordChangeAllowedMethod returns whether the method is allowed for the users
+// who have to change the password set by an admin before using the workspace.
+func isPasswordChangeAllowedMethod(methodName string) bool {
+	return passwordChangeAllowedMethods[methodName]
+}
+
+// impersonationAllowedMethods are the methods allowed for the read-only impersonation sessions: the read methods,
+// and DeleteSession ending the impersonation. The read methods revealing the credentials of the impersonated user,
+// e.g. its access tokens, sessions, webhook secrets, memo email address, or the tokens of its calendar feed and share links,
+// are left out, as are the methods added later until reviewed.
+var impersonationAllowedMethods = map[string]bool{
+	"/memos.api.v1.ActivityService/ListActivities":                  true,
+	"/memos.api.v1.ActivityService/GetActivity":                     true,
+	"/memos.api.v1.AttachmentService/ListAttachments":               true,
+	"/memos.api.v1.AttachmentService/GetAttachment":                 true,
+	"/memos.api.v1.AttachmentService/GetAttachmentBinary":           true,
+	"/memos.api.v1.AttachmentService/ListAttachmentVersions":        true,
+	"/memos.api.v1.AttachmentService/GetAttachmentUpload":           true,
+	"/memos.api.v1.AttachmentService/GetAttachmentStorageMigration": true,
+	"/memos.api.v1.AuthService/GetCurrentSession":                   true,
+	"/memos.api.v1.AuthService/DeleteSession":                       true,
+	"/memos.api.v1.FilterMacroService/ListFilterMacros":             true,
+	"/memos.api.v1.GroupService/ListGroups":                         true,
+	"/memos.api.v1.GroupService/GetGroup":                           true,
+	"/memos.api.v1.IdentityProviderService/ListIdentityProviders":   true,
+	"/memos.api.v1.IdentityProviderService/GetIdentityProvider":     true,
+	"/memos.api.v1.InboxService/ListInboxes":                        true,
+	"/memos.api.v1.MarkdownService/GetLinkMetadata":                 true,
+	"/memos.api.v1.MemoService/ListMemos":                           true,
+	"/memos.api.v1.MemoService/SearchMemos":                         true,
+	"/memos.api.v1.MemoService/SuggestMemos":                        true,
+	"/memos.api.v1.MemoService/ListMemoChanges":                     true,
+	"/memos.api.v1.MemoService/GetMemo":                             true,
+	"/memos.api.v1.MemoService/ListMemoAttachments":                 true,
+	"/memos.api.v1.MemoService/GetMemoAttachmentsArchive":           true,
+	"/memos.api.v1.MemoService/ListMemoRelations":                   true,
+	"/memos.api.v1.MemoService/ListMemoComments":                    true,
+	"/memos.api.v1.MemoService/ListMemoReactions":                   true,
+	"/memos.api.v1.MemoService/GetSharedMemo":                       true,
+	"/memos.api.v1.MemoService/ListOnThisDayMemos":                  true,
+	"/memos.api.v1.SavedSearchService/ListSavedSearches":            true,
+	"/memos.api.v1.SavedSearchService/GetSavedSearch":               true,
+	"/memos.api.v1.ShortcutService/ListShortcuts":                   true,
+	"/memos.api.v1.ShortcutService/GetShortcut":                     true,
+	"/memos.api.v1.ShortcutService/ListSharedShortcuts":             true,
+	"/memos.api.v1.SpaceService/ListSpaces":                         true,
+	"/memos.api.v1.SpaceService/GetSpace":                           true,
+	"/memos.api.v1.SpaceService/ListSpaceMembers":                   true,
+	"/memos.api.v1.TagService/ListTags":                             true,
+	"/memos.api.v1.TagService/ListTagStats":                         true,
+	"/memos.api.v1.TagService/SuggestTags":                          true,
+	"/memos.api.v1.TagService/ListTagMetadata":                      true,
+	"/memos.api.v1.TagService/GetTagMetadata":                       true,
+	"/memos.api.v1.TagService/ListSharedTagMemos":                   true,
+	"/memos.api.v1.UserService/ListUsers":                           true,
+	"/memos.api.v1.UserService/GetUser":                             true,
+	"/memos.api.v1.UserService/SearchUsers":                         true,
+	"/memos.api.v1.UserService/GetUserAvatar":                       true,
+	"/memos.api.v1.UserService/ListAllUserStats":                    true,
+	"/memos.api.v1.UserService/GetUserStats":                        true,
+	"/memos.api.v1.UserService/GetUserSetting":                      true,
+	"/memos.api.v1.UserService/GetUserTwoFactor":                    true,
+	"/memos.api.v1.UserService/GetUserSuspension":                   true,
+	"/memos.api.v1.UserService/GetUserReadwise":                     true,
+	"/memos.api.v1.UserService/GetUserGistSync":                     true,
+	"/memos.api.v1.UserService/GetUserNotificationPreferences":      true,
+	"/memos.api.v1.UserService/GetUserEmailDigest":                  true,
+	"/memos.api.v1.UserService/GetUserOnThisDay":                    true,
+	"/memos.api.v1.UserService/GetUserSlack":                        true,
+	"/memos.api.v1.UserService/GetUserDiscord":                      true,
+	"/memos.api.v1.UserService/GetUserPermissions":                  true,
+	"/memos.api.v1.UserService/ListUserReadGrants":                  true,
+	"/memos.api.v1.WebhookService/ListWebhookDeliveries":            true,
+	"/memos.api.v1.WorkspaceService/GetWorkspaceProfile":            true,
+	"/memos.api.v1.WorkspaceService/GetWorkspaceSetting":            true,
+	"/memos.api.v1.WorkspaceService/ListJobs":                       true,
+	"/memos.api.v1.WorkspaceService/GetJob":                         true,
+	"/memos.api.v1.WorkspaceService/ListScheduledTasks":             true,
 }
 
-// isOnlyForAdminAllowedMethod returns true if the method is allowed to be called only by admin.
-func isOnlyForAdminAllowedMethod(methodName string) bool {
-	return allowedMethodsOnlyForAdmin[methodName]
+// isImpersonationAllowedMethod returns whether the method is allowed for the read-only impersonation sessions.
+func isImpersonationAllowedMethod(fullMethodName string) bool {
+	return impersonationAllowedMethods[fullMethodName]
 }
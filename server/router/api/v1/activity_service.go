@@ -29,9 +29,16 @@ func (s *APIV1Service) ListActivities(ctx context.Context, request *v1pb.ListAct
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list activities: %v", err)
 	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
 
 	var activityMessages []*v1pb.Activity
 	for _, activity := range activities {
+		if !canViewActivity(currentUser, activity) {
+			continue
+		}
 		activityMessage, err := s.convertActivityFromStore(ctx, activity)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to convert activity from store: %v", err)
@@ -56,6 +63,13 @@ func (s *APIV1Service) GetActivity(ctx context.Context, request *v1pb.GetActivit
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get activity: %v", err)
 	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if activity == nil || !canViewActivity(currentUser, activity) {
+		return nil, status.Errorf(codes.NotFound, "activity not found")
+	}
 
 	activityMessage, err := s.convertActivityFromStore(ctx, activity)
 	if err != nil {
@@ -75,6 +89,16 @@ func (s *APIV1Service) convertActivityFromStore(ctx context.Context, activity *s
 	switch activity.Type {
 	case store.ActivityTypeMemoComment:
 		activityType = v1pb.Activity_MEMO_COMMENT
+	case store.ActivityTypeMemoMention:
+		activityType = v1pb.Activity_MEMO_MENTION
+	case store.ActivityTypeMemoReaction:
+		activityType = v1pb.Activity_MEMO_REACTION
+	case store.ActivityTypeUserImpersonationStart:
+		activityType = v1pb.Activity_USER_IMPERSONATION_START
+	case store.ActivityTypeUserImpersonationEnd:
+		activityType = v1pb.Activity_USER_IMPERSONATION_END
+	case store.ActivityTypeUserDelete:
+		activityType = v1pb.Activity_USER_DELETE
 	default:
 		activityType = v1pb.Activity_TYPE_UNSPECIFIED
 	}
@@ -115,6 +139,10 @@ func (s *APIV1Service) convertActivityPayloadFromStore(ctx context.Context, payl
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get related memo: %v", err)
 		}
+		// The memos are gone with the account of their creator.
+		if memo == nil || relatedMemo == nil {
+			return v2Payload, nil
+		}
 		v2Payload.Payload = &v1pb.ActivityPayload_MemoComment{
 			MemoComment: &v1pb.ActivityMemoCommentPayload{
 				Memo:        fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
@@ -122,5 +150,71 @@ func (s *APIV1Service) convertActivityPayloadFromStore(ctx context.Context, payl
 			},
 		}
 	}
+	if payload.MemoMention != nil {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &payload.MemoMention.MemoId,
+			ExcludeContent: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		if memo == nil {
+			return v2Payload, nil
+		}
+		v2Payload.Payload = &v1pb.ActivityPayload_MemoMention{
+			MemoMention: &v1pb.ActivityMemoMentionPayload{
+				Memo: fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+			},
+		}
+	}
+	if payload.MemoReaction != nil {
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &payload.MemoReaction.MemoId,
+			ExcludeContent: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		if memo == nil {
+			return v2Payload, nil
+		}
+		v2Payload.Payload = &v1pb.ActivityPayload_MemoReaction{
+			MemoReaction: &v1pb.ActivityMemoReactionPayload{
+				Memo:         fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+				ReactionType: payload.MemoReaction.ReactionType,
+			},
+		}
+	}
+	if payload.UserImpersonation != nil {
+		v2Payload.Payload = &v1pb.ActivityPayload_UserImpersonation{
+			UserImpersonation: &v1pb.ActivityUserImpersonationPayload{
+				User:       fmt.Sprintf("%s%d", UserNamePrefix, payload.UserImpersonation.UserId),
+				ExpireTime: payload.UserImpersonation.ExpireTime,
+			},
+		}
+	}
+	if payload.UserDelete != nil {
+		v2Payload.Payload = &v1pb.ActivityPayload_UserDelete{
+			UserDelete: &v1pb.ActivityUserDeletePayload{
+				User:            fmt.Sprintf("%s%d", UserNamePrefix, payload.UserDelete.UserId),
+				MemoCount:       payload.UserDelete.MemoCount,
+				AttachmentCount: payload.UserDelete.AttachmentCount,
+				ReactionCount:   payload.UserDelete.ReactionCount,
+			},
+		}
+	}
 	return v2Payload, nil
 }
+
+// canViewActivity returns whether the user can view the activity, the impersonation activities being only for the host
+// and the user deletions for the admins.
+func canViewActivity(user *store.User, activity *store.Activity) bool {
+	switch activity.Type {
+	case store.ActivityTypeUserImpersonationStart, store.ActivityTypeUserImpersonationEnd:
+		return user != nil && user.Role == store.RoleHost
+	case store.ActivityTypeUserDelete:
+		return user != nil && isSuperUser(user)
+	default:
+		return true
+	}
+}
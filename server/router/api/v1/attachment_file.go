@@ -0,0 +1,174 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/storage/s3"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/attachmenttext"
+	"github.com/usememos/memos/store"
+)
+
+// getAttachmentBinaryMethod is the method of the gateway serving the attachments, whose checks apply to the files served directly.
+const getAttachmentBinaryMethod = "/memos.api.v1.AttachmentService/GetAttachmentBinary"
+
+// ServeAttachmentFile returns the handler serving the original content of the attachments directly, instead of through
+// the gateway, so that the ranges of large media are read from their storage rather than the whole files:
+// the local files are sent with sendfile, and the ranges of S3 objects are requested from S3.
+// The derived images, the external links and the GCS objects are served by the gateway handler.
+func (s *APIV1Service) ServeAttachmentFile(gateway echo.HandlerFunc) echo.HandlerFunc {
+	authInterceptor := s.AuthInterceptor
+	if authInterceptor == nil {
+		authInterceptor = NewGRPCAuthInterceptor(s.Store, s.Secret)
+	}
+	return func(c echo.Context) error {
+		query := c.QueryParams()
+		if query.Has("thumbnail") || query.Has("poster") || query.Has("preview") {
+			return gateway(c)
+		}
+
+		ctx, err := authenticateHTTPRequest(authInterceptor, c.Request())
+		if err != nil {
+			return echo.NewHTTPError(runtime.HTTPStatusFromCode(status.Code(err)), status.Convert(err).Message())
+		}
+		attachmentUID := c.Param("uid")
+		attachment, err := s.Store.GetAttachment(ctx, &store.FindAttachment{UID: &attachmentUID})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get attachment").SetInternal(err)
+		}
+		if attachment == nil {
+			return echo.NewHTTPError(http.StatusNotFound, "attachment not found")
+		}
+		share := &attachmentShare{Token: query.Get("shareToken"), Password: query.Get("sharePassword")}
+		if err := s.checkAttachmentAccess(ctx, attachment, share); err != nil {
+			return echo.NewHTTPError(runtime.HTTPStatusFromCode(status.Code(err)), status.Convert(err).Message())
+		}
+
+		c.Response().Header().Set(echo.HeaderContentType, getAttachmentContentType(attachment))
+		modTime := time.Unix(attachment.UpdatedTs, 0)
+		switch attachment.StorageType {
+		case storepb.AttachmentStorageType_LOCAL:
+			attachmentPath := filepath.FromSlash(attachment.Reference)
+			if !filepath.IsAbs(attachmentPath) {
+				attachmentPath = filepath.Join(s.Profile.Data, attachmentPath)
+			}
+			file, err := os.Open(attachmentPath)
+			if err != nil {
+				if os.IsNotExist(err) {
+					return echo.NewHTTPError(http.StatusNotFound, "file not found")
+				}
+				return echo.NewHTTPError(http.StatusInternalServerError, "failed to open the file").SetInternal(err)
+			}
+			defer file.Close()
+			http.ServeContent(sendfileResponse{c.Response()}, c.Request(), attachment.Filename, modTime, file)
+			return nil
+		case storepb.AttachmentStorageType_S3:
+			return s.serveS3Object(ctx, c, attachment)
+		case storepb.AttachmentStorageType_GCS, storepb.AttachmentStorageType_EXTERNAL:
+			return gateway(c)
+		default:
+			// The blobs stored in the database or on SFTP are loaded at once.
+			blob, err := attachmenttext.ReadAttachmentBlob(ctx, s.Store, s.Profile, attachment)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "failed to get attachment blob").SetInternal(err)
+			}
+			http.ServeContent(c.Response(), c.Request(), attachment.Filename, modTime, bytes.NewReader(blob))
+			return nil
+		}
+	}
+}
+
+// serveS3Object streams the S3 object of the attachment, passing the range of the request through to S3.
+func (s *APIV1Service) serveS3Object(ctx context.Context, c echo.Context, attachment *store.Attachment) error {
+	s3Object := attachment.Payload.GetS3Object()
+	if s3Object == nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "no s3 object found")
+	}
+	s3Config := s3Object.S3Config
+	if s3Config == nil {
+		workspaceStorageSetting, err := s.Store.GetWorkspaceStorageSetting(ctx)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to get workspace storage setting").SetInternal(err)
+		}
+		if workspaceStorageSetting.S3Config == nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "s3 config is not found")
+		}
+		s3Config = workspaceStorageSetting.S3Config
+	}
+	s3Client, err := s3.NewClient(ctx, s3Config)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to create s3 client").SetInternal(err)
+	}
+	object, err := s3Client.GetObjectReader(ctx, s3Object.Key, c.Request().Header.Get("Range"))
+	if err != nil {
+		if errors.Is(err, s3.ErrInvalidRange) {
+			c.Response().Header().Set("Content-Range", "bytes */"+strconv.FormatInt(attachment.Size, 10))
+			return echo.NewHTTPError(http.StatusRequestedRangeNotSatisfiable, "requested range not satisfiable")
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get s3 object").SetInternal(err)
+	}
+	defer object.Close()
+
+	header := c.Response().Header()
+	header.Set("Accept-Ranges", "bytes")
+	header.Set(echo.HeaderContentLength, strconv.FormatInt(object.Size, 10))
+	statusCode := http.StatusOK
+	if object.ContentRange != "" {
+		header.Set("Content-Range", object.ContentRange)
+		statusCode = http.StatusPartialContent
+	}
+	c.Response().WriteHeader(statusCode)
+	if c.Request().Method == http.MethodHead {
+		return nil
+	}
+	_, err = io.Copy(c.Response(), object)
+	return err
+}
+
+// authenticateHTTPRequest returns the context of the request, with the user authenticated by its session cookie or access token.
+// The user is checked as by the gateway serving the attachments, e.g. for the archived or suspended users and the required
+// two-factor authentication, and the request is counted against the rate limit of its access token.
+func authenticateHTTPRequest(authInterceptor *GRPCAuthInterceptor, r *http.Request) (context.Context, error) {
+	md := metadata.MD{}
+	md.Append("cookie", r.Header.Values("Cookie")...)
+	md.Append("authorization", r.Header.Values("Authorization")...)
+	md.Append("x-forwarded-for", r.Header.Values("X-Forwarded-For")...)
+	md.Append("x-real-ip", r.Header.Values("X-Real-Ip")...)
+	ctx := metadata.NewIncomingContext(r.Context(), md)
+	user, sessionID, accessToken, err := authInterceptor.authenticate(ctx, md)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return ctx, nil
+	}
+	return authInterceptor.authorize(ctx, getAttachmentBinaryMethod, user, sessionID, accessToken)
+}
+
+// sendfileResponse lets the files be copied to the connection by the underlying response writer,
+// which uses sendfile where available.
+type sendfileResponse struct {
+	*echo.Response
+}
+
+func (r sendfileResponse) ReadFrom(src io.Reader) (int64, error) {
+	if !r.Committed {
+		r.WriteHeader(http.StatusOK)
+	}
+	n, err := io.Copy(r.Unwrap(), src)
+	r.Size += n
+	return n, err
+}
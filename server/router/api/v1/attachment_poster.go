@@ -0,0 +1,121 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/video"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// hasPoster returns whether the server extracts the poster frame of the attachment,
+// i.e. ffmpeg is configured and the attachment is a video stored by the server.
+func (s *APIV1Service) hasPoster(attachment *store.Attachment) bool {
+	if s.Profile.FFmpegPath == "" || !strings.HasPrefix(attachment.Type, "video/") {
+		return false
+	}
+	return !isExternalAttachment(attachment)
+}
+
+// getOrGeneratePoster returns the poster frame of the video attachment, generating it on the first request.
+func (s *APIV1Service) getOrGeneratePoster(ctx context.Context, attachment *store.Attachment) ([]byte, error) {
+	filePath, err := s.getPosterPath(attachment)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(filePath); err != nil {
+		if !os.IsNotExist(err) {
+			return nil, errors.Wrap(err, "failed to check poster frame stat")
+		}
+		if err := s.generatePoster(ctx, attachment); err != nil {
+			return nil, err
+		}
+	}
+	blob, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read poster frame file")
+	}
+	return blob, nil
+}
+
+// generatePoster extracts the poster frame of the video attachment and saves it next to the thumbnails.
+func (s *APIV1Service) generatePoster(ctx context.Context, attachment *store.Attachment) error {
+	videoPath, cleanup, err := s.getAttachmentFilePath(ctx, attachment)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	poster, err := video.ExtractPosterFrame(ctx, s.Profile.FFmpegPath, videoPath)
+	if err != nil {
+		return errors.Wrap(err, "failed to extract poster frame")
+	}
+	filePath, err := s.getPosterPath(attachment)
+	if err != nil {
+		return err
+	}
+	return writeCacheFile(filePath, func(w io.Writer) error {
+		_, err := w.Write(poster)
+		return err
+	})
+}
+
+// getAttachmentFilePath returns the path of a file with the content of the attachment, for the tools reading files.
+// The attachments stored locally are read in place, while the others are written to a temporary file removed by the returned func.
+func (s *APIV1Service) getAttachmentFilePath(ctx context.Context, attachment *store.Attachment) (string, func(), error) {
+	if attachment.StorageType == storepb.AttachmentStorageType_LOCAL {
+		filePath := filepath.FromSlash(attachment.Reference)
+		if !filepath.IsAbs(filePath) {
+			filePath = filepath.Join(s.Profile.Data, filePath)
+		}
+		return filePath, func() {}, nil
+	}
+
+	// The tools seek in the files, so the blobs stored in the database or on SFTP are written to a temporary file.
+	blob := attachment.Blob
+	if attachment.StorageType == storepb.AttachmentStorageType_SFTP {
+		var err error
+		if blob, err = s.GetAttachmentBlob(attachment); err != nil {
+			return "", nil, errors.Wrap(err, "failed to get attachment blob")
+		}
+	} else if blob == nil {
+		withBlob, err := s.Store.GetAttachment(ctx, &store.FindAttachment{ID: &attachment.ID, GetBlob: true})
+		if err != nil {
+			return "", nil, errors.Wrap(err, "failed to get attachment blob")
+		}
+		if withBlob == nil {
+			return "", nil, errors.New("attachment not found")
+		}
+		blob = withBlob.Blob
+	}
+	tempFile, err := os.CreateTemp("", "memos-attachment-*"+filepath.Ext(attachment.Filename))
+	if err != nil {
+		return "", nil, errors.Wrap(err, "failed to create temporary attachment file")
+	}
+	cleanup := func() { os.Remove(tempFile.Name()) }
+	_, err = tempFile.Write(blob)
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		cleanup()
+		return "", nil, errors.Wrap(err, "failed to write temporary attachment file")
+	}
+	return tempFile.Name(), cleanup, nil
+}
+
+// getPosterPath returns the path of the poster frame of the attachment, creating its folder.
+func (s *APIV1Service) getPosterPath(attachment *store.Attachment) (string, error) {
+	thumbnailCacheFolder := filepath.Join(s.Profile.Data, ThumbnailCacheFolder)
+	if err := os.MkdirAll(thumbnailCacheFolder, os.ModePerm); err != nil {
+		return "", errors.Wrap(err, "failed to create thumbnail cache folder")
+	}
+	return filepath.Join(thumbnailCacheFolder, fmt.Sprintf("%d_poster.jpg", attachment.ID)), nil
+}
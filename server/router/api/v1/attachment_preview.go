@@ -0,0 +1,75 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/document"
+	"github.com/usememos/memos/store"
+)
+
+// hasPreview returns whether the server renders the preview of the attachment,
+// i.e. pdftoppm is configured and the attachment is a PDF document stored by the server.
+func (s *APIV1Service) hasPreview(attachment *store.Attachment) bool {
+	if s.Profile.PdftoppmPath == "" || attachment.Type != "application/pdf" {
+		return false
+	}
+	return !isExternalAttachment(attachment)
+}
+
+// getOrGeneratePreview returns the preview of the first page of the PDF attachment, generating it on the first request.
+func (s *APIV1Service) getOrGeneratePreview(ctx context.Context, attachment *store.Attachment) ([]byte, error) {
+	filePath, err := s.getPreviewPath(attachment)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(filePath); err != nil {
+		if !os.IsNotExist(err) {
+			return nil, errors.Wrap(err, "failed to check preview image stat")
+		}
+		if err := s.generatePreview(ctx, attachment); err != nil {
+			return nil, err
+		}
+	}
+	blob, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read preview image file")
+	}
+	return blob, nil
+}
+
+// generatePreview renders the first page of the PDF attachment and saves it next to the thumbnails.
+func (s *APIV1Service) generatePreview(ctx context.Context, attachment *store.Attachment) error {
+	pdfPath, cleanup, err := s.getAttachmentFilePath(ctx, attachment)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	preview, err := document.RenderPDFPreview(ctx, s.Profile.PdftoppmPath, pdfPath)
+	if err != nil {
+		return errors.Wrap(err, "failed to render preview")
+	}
+	filePath, err := s.getPreviewPath(attachment)
+	if err != nil {
+		return err
+	}
+	return writeCacheFile(filePath, func(w io.Writer) error {
+		_, err := w.Write(preview)
+		return err
+	})
+}
+
+// getPreviewPath returns the path of the preview image of the attachment, creating its folder.
+func (s *APIV1Service) getPreviewPath(attachment *store.Attachment) (string, error) {
+	thumbnailCacheFolder := filepath.Join(s.Profile.Data, ThumbnailCacheFolder)
+	if err := os.MkdirAll(thumbnailCacheFolder, os.ModePerm); err != nil {
+		return "", errors.Wrap(err, "failed to create thumbnail cache folder")
+	}
+	return filepath.Join(thumbnailCacheFolder, fmt.Sprintf("%d_preview.jpg", attachment.ID)), nil
+}
@@ -3,10 +3,15 @@ package v1
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"image"
 	"io"
 	"log/slog"
+	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -27,7 +32,13 @@ import (
 
 	"github.com/usememos/memos/internal/profile"
 	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/imageconvert"
+	"github.com/usememos/memos/plugin/imagemeta"
+	"github.com/usememos/memos/plugin/malwarescan"
+	"github.com/usememos/memos/plugin/storage/gcs"
 	"github.com/usememos/memos/plugin/storage/s3"
+	"github.com/usememos/memos/plugin/storage/sftp"
+	"github.com/usememos/memos/plugin/webhook"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
@@ -93,6 +104,9 @@ func (s *APIV1Service) CreateAttachment(ctx context.Context, request *v1pb.Creat
 	create.Size = int64(size)
 	create.Blob = request.Attachment.Content
 
+	if err := s.scanAttachment(ctx, create, bytes.NewReader(create.Blob)); err != nil {
+		return nil, err
+	}
 	if err := SaveAttachmentBlob(ctx, s.Profile, s.Store, create); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to save attachment blob: %v", err)
 	}
@@ -115,8 +129,14 @@ func (s *APIV1Service) CreateAttachment(ctx context.Context, request *v1pb.Creat
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create attachment: %v", err)
 	}
+	s.generateAttachmentThumbnails(ctx, attachment)
 
-	return s.convertAttachmentFromStore(ctx, attachment), nil
+	attachmentMessage := s.convertAttachmentFromStore(ctx, attachment)
+	// Try to dispatch webhook when attachment is created.
+	if err := s.dispatchAttachmentCreatedWebhook(ctx, user.ID, attachmentMessage); err != nil {
+		slog.Warn("Failed to dispatch attachment created webhook", slog.Any("err", err))
+	}
+	return attachmentMessage, nil
 }
 
 func (s *APIV1Service) ListAttachments(ctx context.Context, request *v1pb.ListAttachmentsRequest) (*v1pb.ListAttachmentsResponse, error) {
@@ -227,30 +247,44 @@ func (s *APIV1Service) GetAttachmentBinary(ctx context.Context, request *v1pb.Ge
 	if attachment == nil {
 		return nil, status.Errorf(codes.NotFound, "attachment not found")
 	}
-	// Check the related memo visibility.
-	if attachment.MemoID != nil {
-		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
-			ID: attachment.MemoID,
-		})
+	if err := s.checkAttachmentAccess(ctx, attachment, &attachmentShare{Token: request.ShareToken, Password: request.SharePassword}); err != nil {
+		return nil, err
+	}
+
+	if request.Poster {
+		if !s.hasPoster(attachment) {
+			return nil, status.Errorf(codes.NotFound, "poster frame not available")
+		}
+		posterBlob, err := s.getOrGeneratePoster(ctx, attachment)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to find memo by ID: %v", attachment.MemoID)
+			return nil, status.Errorf(codes.Internal, "failed to get poster frame: %v", err)
 		}
-		if memo != nil && memo.Visibility != store.Public {
-			user, err := s.GetCurrentUser(ctx)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
-			}
-			if user == nil {
-				return nil, status.Errorf(codes.Unauthenticated, "unauthorized access")
-			}
-			if memo.Visibility == store.Private && user.ID != attachment.CreatorID {
-				return nil, status.Errorf(codes.Unauthenticated, "unauthorized access")
-			}
+		return &httpbody.HttpBody{
+			ContentType: "image/jpeg",
+			Data:        posterBlob,
+		}, nil
+	}
+
+	if request.Preview {
+		if !s.hasPreview(attachment) {
+			return nil, status.Errorf(codes.NotFound, "preview not available")
 		}
+		previewBlob, err := s.getOrGeneratePreview(ctx, attachment)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get preview: %v", err)
+		}
+		return &httpbody.HttpBody{
+			ContentType: "image/jpeg",
+			Data:        previewBlob,
+		}, nil
 	}
 
-	if request.Thumbnail && util.HasPrefixes(attachment.Type, SupportedThumbnailMimeTypes...) {
-		thumbnailBlob, err := s.getOrGenerateThumbnail(attachment)
+	if request.Thumbnail != "" && util.HasPrefixes(attachment.Type, SupportedThumbnailMimeTypes...) {
+		thumbnailSize, ok := thumbnailSizes[request.Thumbnail]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid thumbnail size %q, must be small, medium or large", request.Thumbnail)
+		}
+		thumbnailBlob, err := s.getOrGenerateThumbnail(attachment, thumbnailSize)
 		if err != nil {
 			// thumbnail failures are logged as warnings and not cosidered critical failures as
 			// a attachment image can be used in its place.
@@ -268,16 +302,7 @@ func (s *APIV1Service) GetAttachmentBinary(ctx context.Context, request *v1pb.Ge
 		return nil, status.Errorf(codes.Internal, "failed to get attachment blob: %v", err)
 	}
 
-	contentType := attachment.Type
-	if strings.HasPrefix(contentType, "text/") {
-		contentType += "; charset=utf-8"
-	}
-	// Prevent XSS attacks by serving potentially unsafe files with a content type that prevents script execution.
-	if strings.EqualFold(contentType, "image/svg+xml") ||
-		strings.EqualFold(contentType, "text/html") ||
-		strings.EqualFold(contentType, "application/xhtml+xml") {
-		contentType = "application/octet-stream"
-	}
+	contentType := getAttachmentContentType(attachment)
 
 	// Extract range header from gRPC metadata for iOS Safari video support
 	var rangeHeader string
@@ -323,6 +348,68 @@ func (s *APIV1Service) GetAttachmentBinary(ctx context.Context, request *v1pb.Ge
 	}, nil
 }
 
+// attachmentShare is the share link of the memo of an attachment, given along with the attachment requests
+// of the visitors of the link.
+type attachmentShare struct {
+	Token    string
+	Password string
+}
+
+// checkAttachmentAccess checks the current user can read the attachment, by the visibility of its memo, or that the share
+// link, if any, is a valid link of its memo.
+func (s *APIV1Service) checkAttachmentAccess(ctx context.Context, attachment *store.Attachment, share *attachmentShare) error {
+	if attachment.MemoID == nil {
+		return nil
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+		ID: attachment.MemoID,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to find memo by ID: %v", attachment.MemoID)
+	}
+	if memo == nil || memo.Visibility == store.Public {
+		return nil
+	}
+	if share != nil && share.Token != "" {
+		memoShare, err := s.getOpenableMemoShare(ctx, share.Token, share.Password)
+		if err != nil {
+			return err
+		}
+		if memoShare.MemoID != memo.ID {
+			return status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+		return nil
+	}
+
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return status.Errorf(codes.Unauthenticated, "unauthorized access")
+	}
+	// The memos are also read through the tag shares, the read grants, the spaces and the groups.
+	if (memo.Visibility == store.Private || memo.Visibility == store.Group) && user.ID != memo.CreatorID && user.ID != attachment.CreatorID {
+		return s.checkMemoSharedWith(ctx, user.ID, memo)
+	}
+	return nil
+}
+
+// getAttachmentContentType returns the content type the attachment is served with.
+func getAttachmentContentType(attachment *store.Attachment) string {
+	contentType := attachment.Type
+	if strings.HasPrefix(contentType, "text/") {
+		contentType += "; charset=utf-8"
+	}
+	// Prevent XSS attacks by serving potentially unsafe files with a content type that prevents script execution.
+	if strings.EqualFold(contentType, "image/svg+xml") ||
+		strings.EqualFold(contentType, "text/html") ||
+		strings.EqualFold(contentType, "application/xhtml+xml") {
+		contentType = "application/octet-stream"
+	}
+	return contentType
+}
+
 func (s *APIV1Service) UpdateAttachment(ctx context.Context, request *v1pb.UpdateAttachmentRequest) (*v1pb.Attachment, error) {
 	attachmentUID, err := ExtractAttachmentUIDFromName(request.Attachment.Name)
 	if err != nil {
@@ -383,6 +470,15 @@ func (s *APIV1Service) DeleteAttachment(ctx context.Context, request *v1pb.Delet
 	return &emptypb.Empty{}, nil
 }
 
+// dispatchAttachmentCreatedWebhook dispatches webhook when attachment is created.
+func (s *APIV1Service) dispatchAttachmentCreatedWebhook(ctx context.Context, creatorID int32, attachment *v1pb.Attachment) error {
+	return s.dispatchWebhook(ctx, creatorID, &webhook.WebhookRequestPayload{
+		ActivityType: webhook.ActivityTypeAttachmentCreated,
+		Creator:      fmt.Sprintf("%s%d", UserNamePrefix, creatorID),
+		Attachment:   attachment,
+	})
+}
+
 func (s *APIV1Service) convertAttachmentFromStore(ctx context.Context, attachment *store.Attachment) *v1pb.Attachment {
 	attachmentMessage := &v1pb.Attachment{
 		Name:       fmt.Sprintf("%s%s", AttachmentNamePrefix, attachment.UID),
@@ -391,9 +487,15 @@ func (s *APIV1Service) convertAttachmentFromStore(ctx context.Context, attachmen
 		Type:       attachment.Type,
 		Size:       attachment.Size,
 	}
-	if attachment.StorageType == storepb.AttachmentStorageType_EXTERNAL || attachment.StorageType == storepb.AttachmentStorageType_S3 {
+	if isExternalAttachment(attachment) {
 		attachmentMessage.ExternalLink = attachment.Reference
 	}
+	if s.hasPoster(attachment) {
+		attachmentMessage.PosterLink = fmt.Sprintf("/file/%s/%s?poster=true", attachmentMessage.Name, url.PathEscape(attachment.Filename))
+	}
+	if s.hasPreview(attachment) {
+		attachmentMessage.PreviewLink = fmt.Sprintf("/file/%s/%s?preview=true", attachmentMessage.Name, url.PathEscape(attachment.Filename))
+	}
 	if attachment.MemoID != nil {
 		memo, _ := s.Store.GetMemo(ctx, &store.FindMemo{
 			ID: attachment.MemoID,
@@ -409,11 +511,53 @@ func (s *APIV1Service) convertAttachmentFromStore(ctx context.Context, attachmen
 
 // SaveAttachmentBlob save the blob of attachment based on the storage config.
 func SaveAttachmentBlob(ctx context.Context, profile *profile.Profile, stores *store.Store, create *store.Attachment) error {
+	blob := create.Blob
+	create.Blob = nil
+	return saveAttachmentContent(ctx, profile, stores, create, bytes.NewReader(blob))
+}
+
+// saveAttachmentContent saves the content of attachment read from the reader based on the storage config.
+// The content already stored for another attachment is reused rather than stored again.
+func saveAttachmentContent(ctx context.Context, profile *profile.Profile, stores *store.Store, create *store.Attachment, content io.ReadSeeker) error {
 	workspaceStorageSetting, err := stores.GetWorkspaceStorageSetting(ctx)
 	if err != nil {
 		return errors.Wrap(err, "Failed to find workspace storage setting")
 	}
 
+	// The images are stripped and compressed before hashing, so that the results are deduplicated.
+	content, err = stripImageMetadata(ctx, stores, workspaceStorageSetting, create, content)
+	if err != nil {
+		return err
+	}
+	content, err = compressImage(ctx, profile, workspaceStorageSetting, create, content)
+	if err != nil {
+		return err
+	}
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, content); err != nil {
+		return errors.Wrap(err, "Failed to hash content")
+	}
+	if _, err := content.Seek(0, io.SeekStart); err != nil {
+		return errors.Wrap(err, "Failed to rewind content")
+	}
+	create.ContentHash = hex.EncodeToString(hash.Sum(nil))
+	reusable, err := findReusableAttachment(ctx, profile, stores, workspaceStorageSetting, create.ContentHash)
+	if err != nil {
+		return err
+	}
+	if reusable != nil {
+		create.Reference = reusable.Reference
+		create.StorageType = reusable.StorageType
+		create.Payload = reusable.Payload
+		return nil
+	}
+	return writeAttachmentContent(ctx, profile, workspaceStorageSetting, create, content)
+}
+
+// writeAttachmentContent writes the content of attachment to the storage of the storage setting,
+// and sets the reference, storage type and payload, or the blob, of the attachment.
+func writeAttachmentContent(ctx context.Context, profile *profile.Profile, workspaceStorageSetting *storepb.WorkspaceStorageSetting, create *store.Attachment, content io.Reader) error {
 	if workspaceStorageSetting.StorageType == storepb.WorkspaceStorageSetting_LOCAL {
 		filepathTemplate := "assets/{timestamp}_{filename}"
 		if workspaceStorageSetting.FilepathTemplate != "" {
@@ -421,10 +565,10 @@ func SaveAttachmentBlob(ctx context.Context, profile *profile.Profile, stores *s
 		}
 
 		internalPath := filepathTemplate
-		if !strings.Contains(internalPath, "{filename}") {
+		if !hasFilenameKey(internalPath) {
 			internalPath = filepath.Join(internalPath, "{filename}")
 		}
-		internalPath = replaceFilenameWithPathTemplate(internalPath, create.Filename)
+		internalPath = replaceFilenameWithPathTemplate(internalPath, create.Filename, create.ContentHash)
 		internalPath = filepath.ToSlash(internalPath)
 
 		// Ensure the directory exists.
@@ -433,7 +577,7 @@ func SaveAttachmentBlob(ctx context.Context, profile *profile.Profile, stores *s
 			osPath = filepath.Join(profile.Data, osPath)
 		}
 		dir := filepath.Dir(osPath)
-		if err = os.MkdirAll(dir, os.ModePerm); err != nil {
+		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
 			return errors.Wrap(err, "Failed to create directory")
 		}
 		dst, err := os.Create(osPath)
@@ -442,12 +586,11 @@ func SaveAttachmentBlob(ctx context.Context, profile *profile.Profile, stores *s
 		}
 		defer dst.Close()
 
-		// Write the blob to the file.
-		if err := os.WriteFile(osPath, create.Blob, 0644); err != nil {
+		// Write the content to the file.
+		if _, err := io.Copy(dst, content); err != nil {
 			return errors.Wrap(err, "Failed to write file")
 		}
 		create.Reference = internalPath
-		create.Blob = nil
 		create.StorageType = storepb.AttachmentStorageType_LOCAL
 	} else if workspaceStorageSetting.StorageType == storepb.WorkspaceStorageSetting_S3 {
 		s3Config := workspaceStorageSetting.S3Config
@@ -459,37 +602,310 @@ func SaveAttachmentBlob(ctx context.Context, profile *profile.Profile, stores *s
 			return errors.Wrap(err, "Failed to create s3 client")
 		}
 
-		filepathTemplate := workspaceStorageSetting.FilepathTemplate
-		if !strings.Contains(filepathTemplate, "{filename}") {
-			filepathTemplate = filepath.Join(filepathTemplate, "{filename}")
-		}
-		filepathTemplate = replaceFilenameWithPathTemplate(filepathTemplate, create.Filename)
-		key, err := s3Client.UploadObject(ctx, filepathTemplate, create.Type, bytes.NewReader(create.Blob))
+		key, err := s3Client.UploadObject(ctx, getS3ObjectKey(workspaceStorageSetting, create.Filename, create.ContentHash), create.Type, content)
 		if err != nil {
 			return errors.Wrap(err, "Failed to upload via s3 client")
 		}
-		presignURL, err := s3Client.PresignGetObject(ctx, key)
+		if err := setS3AttachmentObject(ctx, s3Client, s3Config, create, key); err != nil {
+			return err
+		}
+	} else if workspaceStorageSetting.StorageType == storepb.WorkspaceStorageSetting_GCS {
+		gcsConfig := workspaceStorageSetting.GcsConfig
+		if gcsConfig == nil {
+			return errors.Errorf("No actived external storage found")
+		}
+		gcsClient, err := gcs.NewClient(ctx, gcsConfig)
 		if err != nil {
-			return errors.Wrap(err, "Failed to presign via s3 client")
+			return errors.Wrap(err, "Failed to create gcs client")
 		}
 
-		create.Reference = presignURL
-		create.Blob = nil
-		create.StorageType = storepb.AttachmentStorageType_S3
+		key, err := gcsClient.UploadObject(ctx, getS3ObjectKey(workspaceStorageSetting, create.Filename, create.ContentHash), create.Type, content)
+		if err != nil {
+			return errors.Wrap(err, "Failed to upload via gcs client")
+		}
+		signedURL, err := gcsClient.SignGetObject(key)
+		if err != nil {
+			return errors.Wrap(err, "Failed to sign via gcs client")
+		}
+
+		create.Reference = signedURL
+		create.StorageType = storepb.AttachmentStorageType_GCS
 		create.Payload = &storepb.AttachmentPayload{
-			Payload: &storepb.AttachmentPayload_S3Object_{
-				S3Object: &storepb.AttachmentPayload_S3Object{
-					S3Config:          s3Config,
-					Key:               key,
-					LastPresignedTime: timestamppb.New(time.Now()),
+			Payload: &storepb.AttachmentPayload_GcsObject{
+				GcsObject: &storepb.AttachmentPayload_GCSObject{
+					GcsConfig:      gcsConfig,
+					Key:            key,
+					LastSignedTime: timestamppb.New(time.Now()),
 				},
 			},
 		}
+	} else if workspaceStorageSetting.StorageType == storepb.WorkspaceStorageSetting_SFTP {
+		sftpConfig := workspaceStorageSetting.SftpConfig
+		if sftpConfig == nil {
+			return errors.Errorf("No actived external storage found")
+		}
+		sftpClient, err := sftp.NewClient(ctx, sftpConfig)
+		if err != nil {
+			return errors.Wrap(err, "Failed to create sftp client")
+		}
+		defer sftpClient.Close()
+
+		filePath := filepath.ToSlash(getS3ObjectKey(workspaceStorageSetting, create.Filename, create.ContentHash))
+		if err := sftpClient.UploadObject(ctx, filePath, content); err != nil {
+			return errors.Wrap(err, "Failed to upload via sftp client")
+		}
+		// The content is served by the server, so the reference is only the path on the SFTP server.
+		create.Reference = filePath
+		create.StorageType = storepb.AttachmentStorageType_SFTP
+		create.Payload = &storepb.AttachmentPayload{
+			Payload: &storepb.AttachmentPayload_SftpObject{
+				SftpObject: &storepb.AttachmentPayload_SFTPObject{
+					SftpConfig: sftpConfig,
+					Path:       filePath,
+				},
+			},
+		}
+	} else {
+		// Otherwise the blob is stored in the database.
+		blob, err := io.ReadAll(content)
+		if err != nil {
+			return errors.Wrap(err, "Failed to read content")
+		}
+		create.Blob = blob
+	}
+
+	return nil
+}
+
+// stripImageMetadata strips the EXIF metadata of the image when the workspace or its creator asks for it,
+// and returns the content to store.
+func stripImageMetadata(ctx context.Context, stores *store.Store, workspaceStorageSetting *storepb.WorkspaceStorageSetting, create *store.Attachment, content io.ReadSeeker) (io.ReadSeeker, error) {
+	if !imagemeta.IsSupported(create.Type) {
+		return content, nil
+	}
+	strip, keepOrientation := workspaceStorageSetting.StripImageMetadata, workspaceStorageSetting.KeepImageOrientation
+	userSetting, err := stores.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &create.CreatorID,
+		Key:    storepb.UserSetting_GENERAL,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to find user general setting")
+	}
+	if general := userSetting.GetGeneral(); general != nil && general.StripImageMetadata {
+		// The orientation is kept if either setting stripping the metadata keeps it.
+		keepOrientation = (strip && keepOrientation) || general.KeepImageOrientation
+		strip = true
+	}
+	if !strip {
+		return content, nil
+	}
+
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to read content")
+	}
+	stripped, err := imagemeta.Strip(data, create.Type, keepOrientation)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to strip image metadata")
+	}
+	create.Size = int64(len(stripped))
+	return bytes.NewReader(stripped), nil
+}
+
+// scanAttachment scans the content of the attachment for malware when the workspace asks for it,
+// and returns an error if it is flagged. The flagged content is kept in the quarantine directory if the workspace asks for it.
+func (s *APIV1Service) scanAttachment(ctx context.Context, create *store.Attachment, content io.ReadSeeker) error {
+	workspaceMalwareScanSetting, err := s.Store.GetWorkspaceMalwareScanSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace malware scan setting: %v", err)
+	}
+	if !workspaceMalwareScanSetting.Enabled {
+		return nil
+	}
+
+	var result *malwarescan.Result
+	scanner, err := malwarescan.NewScanner(workspaceMalwareScanSetting)
+	if err == nil {
+		result, err = scanner.Scan(ctx, create.Filename, content)
+	}
+	if _, seekErr := content.Seek(0, io.SeekStart); seekErr != nil {
+		return status.Errorf(codes.Internal, "failed to rewind content: %v", seekErr)
+	}
+	if err != nil {
+		slog.Error("failed to scan attachment", slog.String("filename", create.Filename), slog.Any("error", err))
+		if workspaceMalwareScanSetting.FailOpen {
+			return nil
+		}
+		return status.Errorf(codes.Unavailable, "failed to scan the file for malware")
+	}
+	if !result.Infected {
+		return nil
+	}
+
+	slog.Warn("attachment flagged as malware",
+		slog.String("filename", create.Filename),
+		slog.Int("creator", int(create.CreatorID)),
+		slog.String("signature", result.Signature))
+	if workspaceMalwareScanSetting.Action == storepb.WorkspaceMalwareScanSetting_QUARANTINE {
+		if err := quarantineAttachment(s.Profile, create, content); err != nil {
+			slog.Error("failed to quarantine attachment", slog.String("filename", create.Filename), slog.Any("error", err))
+		}
+	}
+	return status.Errorf(codes.InvalidArgument, "the file is flagged as malware")
+}
+
+// quarantineAttachment keeps a copy of the flagged content in the quarantine directory of the data directory,
+// readable by the server user only, for the host to review.
+func quarantineAttachment(profile *profile.Profile, create *store.Attachment, content io.Reader) error {
+	dir := filepath.Join(profile.Data, "quarantine")
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return errors.Wrap(err, "Failed to create quarantine directory")
+	}
+	filename := fmt.Sprintf("%d_%d_%s", time.Now().UnixNano(), create.CreatorID, filepath.Base(create.Filename))
+	file, err := os.OpenFile(filepath.Join(dir, filename), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return errors.Wrap(err, "Failed to create quarantine file")
+	}
+	defer file.Close()
+	if _, err := io.Copy(file, content); err != nil {
+		return errors.Wrap(err, "Failed to write quarantine file")
+	}
+	return nil
+}
+
+// imageCompressionMimeTypes are the MIME types of the formats to convert the compressed images to.
+var imageCompressionMimeTypes = map[storepb.WorkspaceStorageSetting_ImageCompression_Format]string{
+	storepb.WorkspaceStorageSetting_ImageCompression_JPEG: imageconvert.MimeTypeJPEG,
+	storepb.WorkspaceStorageSetting_ImageCompression_WEBP: imageconvert.MimeTypeWebP,
+	storepb.WorkspaceStorageSetting_ImageCompression_AVIF: imageconvert.MimeTypeAVIF,
+}
+
+// compressImage recompresses the image when the workspace asks for it, and returns the content to store.
+// The images failing to compress, e.g. for a missing ffmpeg, are stored as they are.
+func compressImage(ctx context.Context, profile *profile.Profile, workspaceStorageSetting *storepb.WorkspaceStorageSetting, create *store.Attachment, content io.ReadSeeker) (io.ReadSeeker, error) {
+	imageCompression := workspaceStorageSetting.ImageCompression
+	if !imageCompression.GetEnabled() || !imageconvert.IsSupported(create.Type) {
+		return content, nil
+	}
+
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to read content")
+	}
+	compressed, mimeType, err := imageconvert.Compress(ctx, data, create.Type, imageconvert.Options{
+		MaxDimension: int(imageCompression.MaxDimension),
+		Quality:      int(imageCompression.Quality),
+		MimeType:     imageCompressionMimeTypes[imageCompression.Format],
+		FFmpegPath:   profile.FFmpegPath,
+	})
+	if err != nil {
+		slog.Warn("failed to compress image", slog.String("filename", create.Filename), slog.Any("error", err))
+		return bytes.NewReader(data), nil
+	}
+	if mimeType != create.Type {
+		create.Filename = strings.TrimSuffix(create.Filename, filepath.Ext(create.Filename)) + imageconvert.Extension(mimeType)
+		create.Type = mimeType
+	}
+	create.Size = int64(len(compressed))
+	return bytes.NewReader(compressed), nil
+}
+
+// getS3ObjectKey returns the key of a new S3 or GCS object, or the path of a new SFTP file,
+// of the file from the filepath template of the storage setting.
+func getS3ObjectKey(workspaceStorageSetting *storepb.WorkspaceStorageSetting, filename, contentHash string) string {
+	filepathTemplate := workspaceStorageSetting.FilepathTemplate
+	if !hasFilenameKey(filepathTemplate) {
+		filepathTemplate = filepath.Join(filepathTemplate, "{filename}")
+	}
+	return replaceFilenameWithPathTemplate(filepathTemplate, filename, contentHash)
+}
+
+// setS3AttachmentObject makes the attachment reference the uploaded S3 object.
+func setS3AttachmentObject(ctx context.Context, s3Client *s3.Client, s3Config *storepb.StorageS3Config, create *store.Attachment, key string) error {
+	presignURL, err := s3Client.PresignGetObject(ctx, key)
+	if err != nil {
+		return errors.Wrap(err, "Failed to presign via s3 client")
 	}
 
+	create.Reference = presignURL
+	create.StorageType = storepb.AttachmentStorageType_S3
+	create.Payload = &storepb.AttachmentPayload{
+		Payload: &storepb.AttachmentPayload_S3Object_{
+			S3Object: &storepb.AttachmentPayload_S3Object{
+				S3Config:          s3Config,
+				Key:               key,
+				LastPresignedTime: timestamppb.New(time.Now()),
+			},
+		},
+	}
 	return nil
 }
 
+// findReusableAttachment returns an attachment with the content hash whose file or object is kept by the current storage,
+// or nil if there is none. The blobs stored in the database belong to their row, so they are not reused.
+func findReusableAttachment(ctx context.Context, profile *profile.Profile, stores *store.Store, workspaceStorageSetting *storepb.WorkspaceStorageSetting, contentHash string) (*store.Attachment, error) {
+	var storageType storepb.AttachmentStorageType
+	switch workspaceStorageSetting.StorageType {
+	case storepb.WorkspaceStorageSetting_LOCAL:
+		storageType = storepb.AttachmentStorageType_LOCAL
+	case storepb.WorkspaceStorageSetting_S3:
+		storageType = storepb.AttachmentStorageType_S3
+	case storepb.WorkspaceStorageSetting_GCS:
+		storageType = storepb.AttachmentStorageType_GCS
+	case storepb.WorkspaceStorageSetting_SFTP:
+		storageType = storepb.AttachmentStorageType_SFTP
+	default:
+		return nil, nil
+	}
+	attachments, err := stores.ListAttachments(ctx, &store.FindAttachment{
+		ContentHash: &contentHash,
+		StorageType: &storageType,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to find attachments with the same content")
+	}
+	for _, attachment := range attachments {
+		if storageType == storepb.AttachmentStorageType_S3 {
+			if attachment.Payload.GetS3Object() != nil {
+				return attachment, nil
+			}
+			continue
+		}
+		if storageType == storepb.AttachmentStorageType_GCS {
+			if attachment.Payload.GetGcsObject() != nil {
+				return attachment, nil
+			}
+			continue
+		}
+		if storageType == storepb.AttachmentStorageType_SFTP {
+			if attachment.Payload.GetSftpObject() != nil {
+				return attachment, nil
+			}
+			continue
+		}
+		// The local file may have been removed outside of memos.
+		attachmentPath := filepath.FromSlash(attachment.Reference)
+		if !filepath.IsAbs(attachmentPath) {
+			attachmentPath = filepath.Join(profile.Data, attachmentPath)
+		}
+		if _, err := os.Stat(attachmentPath); err == nil {
+			return attachment, nil
+		}
+	}
+	return nil, nil
+}
+
+// isExternalAttachment returns whether the content of the attachment is not stored by the server,
+// but in an external link or an object storage, which serves it by its reference.
+func isExternalAttachment(attachment *store.Attachment) bool {
+	switch attachment.StorageType {
+	case storepb.AttachmentStorageType_EXTERNAL, storepb.AttachmentStorageType_S3, storepb.AttachmentStorageType_GCS:
+		return true
+	default:
+		return false
+	}
+}
+
 func (s *APIV1Service) GetAttachmentBlob(attachment *store.Attachment) ([]byte, error) {
 	// For local storage, read the file from the local disk.
 	if attachment.StorageType == storepb.AttachmentStorageType_LOCAL {
@@ -512,42 +928,70 @@ func (s *APIV1Service) GetAttachmentBlob(attachment *store.Attachment) ([]byte,
 		}
 		return blob, nil
 	}
+	// For SFTP storage, read the file from the SFTP server.
+	if attachment.StorageType == storepb.AttachmentStorageType_SFTP {
+		sftpObjectPayload := attachment.Payload.GetSftpObject()
+		if sftpObjectPayload == nil {
+			return nil, errors.New("no sftp object found")
+		}
+		ctx := context.Background()
+		sftpConfig := sftpObjectPayload.SftpConfig
+		if sftpConfig == nil {
+			workspaceStorageSetting, err := s.Store.GetWorkspaceStorageSetting(ctx)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get workspace storage setting")
+			}
+			if workspaceStorageSetting.SftpConfig == nil {
+				return nil, errors.New("sftp config is not found")
+			}
+			sftpConfig = workspaceStorageSetting.SftpConfig
+		}
+		sftpClient, err := sftp.NewClient(ctx, sftpConfig)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create sftp client")
+		}
+		defer sftpClient.Close()
+		return sftpClient.GetObject(ctx, sftpObjectPayload.Path)
+	}
 	// For database storage, return the blob from the database.
 	return attachment.Blob, nil
 }
 
-const (
-	// thumbnailRatio is the ratio of the thumbnail image.
-	thumbnailRatio = 0.8
+// thumbnailSize is a size of the thumbnails, which fit in a square of its pixels.
+type thumbnailSize struct {
+	name   string
+	pixels int
+}
+
+var (
+	smallThumbnailSize  = thumbnailSize{name: "small", pixels: 240}
+	mediumThumbnailSize = thumbnailSize{name: "medium", pixels: 640}
+	largeThumbnailSize  = thumbnailSize{name: "large", pixels: 1280}
+
+	// thumbnailSizes are the thumbnail sizes by the value of the thumbnail parameter.
+	thumbnailSizes = map[string]thumbnailSize{
+		"small":  smallThumbnailSize,
+		"medium": mediumThumbnailSize,
+		"large":  largeThumbnailSize,
+		// Kept for the clients requesting ?thumbnail=true.
+		"true": mediumThumbnailSize,
+	}
 )
 
-// getOrGenerateThumbnail returns the thumbnail image of the attachment.
-func (s *APIV1Service) getOrGenerateThumbnail(attachment *store.Attachment) ([]byte, error) {
-	thumbnailCacheFolder := filepath.Join(s.Profile.Data, ThumbnailCacheFolder)
-	if err := os.MkdirAll(thumbnailCacheFolder, os.ModePerm); err != nil {
-		return nil, errors.Wrap(err, "failed to create thumbnail cache folder")
+// getOrGenerateThumbnail returns the thumbnail image of the attachment, generating it on the first request.
+func (s *APIV1Service) getOrGenerateThumbnail(attachment *store.Attachment, size thumbnailSize) ([]byte, error) {
+	filePath, err := s.getThumbnailPath(attachment, size)
+	if err != nil {
+		return nil, err
 	}
-	filePath := filepath.Join(thumbnailCacheFolder, fmt.Sprintf("%d%s", attachment.ID, filepath.Ext(attachment.Filename)))
 	if _, err := os.Stat(filePath); err != nil {
 		if !os.IsNotExist(err) {
 			return nil, errors.Wrap(err, "failed to check thumbnail image stat")
 		}
 
 		// If thumbnail image does not exist, generate and save the thumbnail image.
-		blob, err := s.GetAttachmentBlob(attachment)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to get attachment blob")
-		}
-		img, err := imaging.Decode(bytes.NewReader(blob), imaging.AutoOrientation(true))
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to decode thumbnail image")
-		}
-
-		thumbnailWidth := int(float64(img.Bounds().Dx()) * thumbnailRatio)
-		// Resize the image to the thumbnailWidth.
-		thumbnailImage := imaging.Resize(img, thumbnailWidth, 0, imaging.Lanczos)
-		if err := imaging.Save(thumbnailImage, filePath); err != nil {
-			return nil, errors.Wrap(err, "failed to save thumbnail file")
+		if err := s.generateThumbnails(attachment, size); err != nil {
+			return nil, err
 		}
 	}
 
@@ -563,9 +1007,122 @@ func (s *APIV1Service) getOrGenerateThumbnail(attachment *store.Attachment) ([]b
 	return blob, nil
 }
 
+// generateAttachmentThumbnails enqueues the generation of the thumbnails of all sizes of a new image attachment,
+// the poster frame of a new video attachment, or the preview of a new PDF attachment,
+// so that list views do not wait for them on their first request.
+func (s *APIV1Service) generateAttachmentThumbnails(ctx context.Context, attachment *store.Attachment) {
+	if isExternalAttachment(attachment) {
+		return
+	}
+	if !util.HasPrefixes(attachment.Type, SupportedThumbnailMimeTypes...) && !s.hasPoster(attachment) && !s.hasPreview(attachment) {
+		return
+	}
+	s.enqueueJob(ctx, attachmentThumbnailsJobKind, attachmentJobPayload{AttachmentID: attachment.ID})
+}
+
+// runAttachmentThumbnailsJob generates the thumbnails, the poster frame or the preview of the attachment of the job.
+func (s *APIV1Service) runAttachmentThumbnailsJob(ctx context.Context, data []byte) error {
+	var payload attachmentJobPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return errors.Wrap(err, "invalid job payload")
+	}
+	attachment, err := s.Store.GetAttachment(ctx, &store.FindAttachment{ID: &payload.AttachmentID, GetBlob: true})
+	if err != nil {
+		return errors.Wrap(err, "failed to get attachment")
+	}
+	// The attachment may be deleted before the job runs.
+	if attachment == nil {
+		return nil
+	}
+	if util.HasPrefixes(attachment.Type, SupportedThumbnailMimeTypes...) {
+		return s.generateThumbnails(attachment, smallThumbnailSize, mediumThumbnailSize, largeThumbnailSize)
+	} else if s.hasPoster(attachment) {
+		return s.generatePoster(ctx, attachment)
+	} else if s.hasPreview(attachment) {
+		return s.generatePreview(ctx, attachment)
+	}
+	return nil
+}
+
+// generateThumbnails decodes the image of the attachment once and saves its thumbnails of the sizes.
+func (s *APIV1Service) generateThumbnails(attachment *store.Attachment, sizes ...thumbnailSize) error {
+	blob, err := s.GetAttachmentBlob(attachment)
+	if err != nil {
+		return errors.Wrap(err, "failed to get attachment blob")
+	}
+	img, err := imaging.Decode(bytes.NewReader(blob), imaging.AutoOrientation(true))
+	if err != nil {
+		return errors.Wrap(err, "failed to decode thumbnail image")
+	}
+
+	for _, size := range sizes {
+		filePath, err := s.getThumbnailPath(attachment, size)
+		if err != nil {
+			return err
+		}
+		// Images smaller than the size are kept as they are.
+		thumbnailImage := imaging.Fit(img, size.pixels, size.pixels, imaging.Lanczos)
+		if err := saveThumbnail(thumbnailImage, filePath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getThumbnailPath returns the path of the thumbnail of the attachment in the size, creating its folder.
+func (s *APIV1Service) getThumbnailPath(attachment *store.Attachment, size thumbnailSize) (string, error) {
+	thumbnailCacheFolder := filepath.Join(s.Profile.Data, ThumbnailCacheFolder)
+	if err := os.MkdirAll(thumbnailCacheFolder, os.ModePerm); err != nil {
+		return "", errors.Wrap(err, "failed to create thumbnail cache folder")
+	}
+	// The thumbnail keeps the format of the image, so that it is served with the type of the attachment.
+	ext := ".jpg"
+	if attachment.Type == "image/png" {
+		ext = ".png"
+	}
+	return filepath.Join(thumbnailCacheFolder, fmt.Sprintf("%d_%s%s", attachment.ID, size.name, ext)), nil
+}
+
+// saveThumbnail saves the thumbnail image to the path in the format of its extension.
+func saveThumbnail(thumbnailImage image.Image, filePath string) error {
+	format, err := imaging.FormatFromFilename(filePath)
+	if err != nil {
+		return errors.Wrap(err, "failed to get thumbnail format")
+	}
+	return writeCacheFile(filePath, func(w io.Writer) error {
+		return imaging.Encode(w, thumbnailImage, format)
+	})
+}
+
+// writeCacheFile writes a temporary file renamed to the path,
+// so that concurrent requests never read a partial file.
+func writeCacheFile(filePath string, write func(io.Writer) error) error {
+	tempFile, err := os.CreateTemp(filepath.Dir(filePath), "*.tmp")
+	if err != nil {
+		return errors.Wrap(err, "failed to create cache file")
+	}
+	defer os.Remove(tempFile.Name())
+	if err := write(tempFile); err != nil {
+		tempFile.Close()
+		return errors.Wrap(err, "failed to write cache file")
+	}
+	if err := tempFile.Close(); err != nil {
+		return errors.Wrap(err, "failed to write cache file")
+	}
+	if err := os.Rename(tempFile.Name(), filePath); err != nil {
+		return errors.Wrap(err, "failed to rename cache file")
+	}
+	return nil
+}
+
 var fileKeyPattern = regexp.MustCompile(`\{[a-z]{1,9}\}`)
 
-func replaceFilenameWithPathTemplate(path, filename string) string {
+// hasFilenameKey returns true if the filepath template names the files, by their filename or by their content hash.
+func hasFilenameKey(filepathTemplate string) bool {
+	return strings.Contains(filepathTemplate, "{filename}") || strings.Contains(filepathTemplate, "{hash}")
+}
+
+func replaceFilenameWithPathTemplate(path, filename, contentHash string) string {
 	t := time.Now()
 	path = fileKeyPattern.ReplaceAllStringFunc(path, func(s string) string {
 		switch s {
@@ -587,6 +1144,8 @@ func replaceFilenameWithPathTemplate(path, filename string) string {
 			return fmt.Sprintf("%02d", t.Second())
 		case "{uuid}":
 			return util.GenUUID()
+		case "{hash}":
+			return contentHash
 		}
 		return s
 	})
@@ -0,0 +1,339 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/profile"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/attachmenttext"
+	"github.com/usememos/memos/store"
+)
+
+// attachmentStorageMigrationBatchSize is the number of attachments listed at once.
+const attachmentStorageMigrationBatchSize = 100
+
+// attachmentStorageMigrationJobKind migrates the attachments to the storage of the workspace.
+const attachmentStorageMigrationJobKind = "attachment_storage_migration"
+
+type attachmentStorageMigrationJobPayload struct {
+	DeleteSource bool `json:"deleteSource"`
+}
+
+var (
+	// attachmentStorageMigrationMutex guards the last storage migration, which is the only one running at a time.
+	attachmentStorageMigrationMutex sync.Mutex
+	attachmentStorageMigration      *v1pb.AttachmentStorageMigration
+)
+
+// attachmentStorageTypes are the attachment storage types of the workspace storage types.
+var attachmentStorageTypes = map[storepb.WorkspaceStorageSetting_StorageType]storepb.AttachmentStorageType{
+	storepb.WorkspaceStorageSetting_DATABASE: storepb.AttachmentStorageType_ATTACHMENT_STORAGE_TYPE_UNSPECIFIED,
+	storepb.WorkspaceStorageSetting_LOCAL:    storepb.AttachmentStorageType_LOCAL,
+	storepb.WorkspaceStorageSetting_S3:       storepb.AttachmentStorageType_S3,
+	storepb.WorkspaceStorageSetting_GCS:      storepb.AttachmentStorageType_GCS,
+	storepb.WorkspaceStorageSetting_SFTP:     storepb.AttachmentStorageType_SFTP,
+}
+
+// AttachmentStorageMigrationOptions are the options of a storage migration.
+type AttachmentStorageMigrationOptions struct {
+	// DryRun counts the attachments to migrate without migrating them.
+	DryRun bool
+	// DeleteSource removes the content from its previous storage once it is migrated and verified.
+	DeleteSource bool
+}
+
+// AttachmentStorageMigrationProgress is the progress of a storage migration, reported after each attachment.
+type AttachmentStorageMigrationProgress struct {
+	// Total is the number of attachments to migrate.
+	Total    int
+	Migrated int
+	Failures []*AttachmentStorageMigrationFailure
+}
+
+// AttachmentStorageMigrationFailure is an attachment failing to migrate.
+type AttachmentStorageMigrationFailure struct {
+	AttachmentUID string
+	Error         string
+}
+
+// MigrateAttachmentStorage moves the content of the attachments stored elsewhere to the storage of the workspace storage setting.
+// Each content is read back and checked against its hash before the attachment references it, so the attachments failing
+// to migrate keep their previous storage. They are reported, and the migration can be run again to retry them.
+// The external links are not migrated.
+func MigrateAttachmentStorage(ctx context.Context, profile *profile.Profile, stores *store.Store, options AttachmentStorageMigrationOptions, onProgress func(*AttachmentStorageMigrationProgress)) (*AttachmentStorageMigrationProgress, error) {
+	workspaceStorageSetting, err := stores.GetWorkspaceStorageSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace storage setting")
+	}
+	targetStorageType, ok := attachmentStorageTypes[workspaceStorageSetting.StorageType]
+	if !ok {
+		return nil, errors.Errorf("unsupported storage type %v", workspaceStorageSetting.StorageType)
+	}
+
+	attachments := []*store.Attachment{}
+	for offset := 0; ; offset += attachmentStorageMigrationBatchSize {
+		limit := attachmentStorageMigrationBatchSize
+		batch, err := stores.ListAttachments(ctx, &store.FindAttachment{
+			Limit:  &limit,
+			Offset: &offset,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list attachments")
+		}
+		for _, attachment := range batch {
+			if attachment.StorageType != targetStorageType && attachment.StorageType != storepb.AttachmentStorageType_EXTERNAL {
+				attachments = append(attachments, attachment)
+			}
+		}
+		if len(batch) < limit {
+			break
+		}
+	}
+
+	progress := &AttachmentStorageMigrationProgress{
+		Total:    len(attachments),
+		Failures: []*AttachmentStorageMigrationFailure{},
+	}
+	onProgress(progress)
+	if options.DryRun {
+		return progress, nil
+	}
+
+	// The attachments sharing a content keep sharing it in the new storage.
+	migrated := map[string]*store.Attachment{}
+	for _, attachment := range attachments {
+		if err := ctx.Err(); err != nil {
+			return progress, err
+		}
+		if err := migrateAttachmentContent(ctx, profile, stores, workspaceStorageSetting, attachment, options, migrated); err != nil {
+			slog.Warn("failed to migrate attachment", slog.String("attachment", attachment.UID), slog.Any("error", err))
+			progress.Failures = append(progress.Failures, &AttachmentStorageMigrationFailure{
+				AttachmentUID: attachment.UID,
+				Error:         err.Error(),
+			})
+		} else {
+			progress.Migrated++
+		}
+		onProgress(progress)
+	}
+	return progress, nil
+}
+
+func migrateAttachmentContent(ctx context.Context, profile *profile.Profile, stores *store.Store, workspaceStorageSetting *storepb.WorkspaceStorageSetting, attachment *store.Attachment, options AttachmentStorageMigrationOptions, migrated map[string]*store.Attachment) error {
+	blob, err := attachmenttext.ReadAttachmentBlob(ctx, stores, profile, attachment)
+	if err != nil {
+		return errors.Wrap(err, "failed to read content")
+	}
+	contentHash := hashContent(blob)
+	if attachment.ContentHash != "" && attachment.ContentHash != contentHash {
+		return errors.New("the content does not match its hash")
+	}
+
+	target, ok := migrated[contentHash]
+	if !ok {
+		// The UID keeps apart the files of the same name written in the same second.
+		target = &store.Attachment{
+			ID:          attachment.ID,
+			Filename:    fmt.Sprintf("%s_%s", attachment.UID, attachment.Filename),
+			Type:        attachment.Type,
+			ContentHash: contentHash,
+		}
+		if err := writeAttachmentContent(ctx, profile, workspaceStorageSetting, target, bytes.NewReader(blob)); err != nil {
+			return errors.Wrap(err, "failed to write content")
+		}
+		// The content stored in the database is checked once the attachment is updated.
+		if target.Blob == nil {
+			written, err := attachmenttext.ReadAttachmentBlob(ctx, stores, profile, target)
+			if err != nil {
+				return errors.Wrap(err, "failed to read the written content")
+			}
+			if hashContent(written) != contentHash {
+				return errors.New("the written content does not match")
+			}
+		}
+		migrated[contentHash] = target
+	}
+
+	payload := target.Payload
+	if payload == nil {
+		payload = &storepb.AttachmentPayload{}
+	}
+	// The blob is cleared when the content leaves the database.
+	targetBlob := target.Blob
+	if targetBlob == nil {
+		targetBlob = []byte{}
+	}
+	if err := stores.UpdateAttachment(ctx, &store.UpdateAttachment{
+		ID:          attachment.ID,
+		Reference:   &target.Reference,
+		Payload:     payload,
+		StorageType: &target.StorageType,
+		Blob:        &targetBlob,
+	}); err != nil {
+		return errors.Wrap(err, "failed to update attachment")
+	}
+	if target.Blob != nil {
+		updated, err := stores.GetAttachment(ctx, &store.FindAttachment{ID: &attachment.ID, GetBlob: true})
+		if err != nil || updated == nil || hashContent(updated.Blob) != contentHash {
+			// The attachment references its previous storage again.
+			previousPayload := attachment.Payload
+			if previousPayload == nil {
+				previousPayload = &storepb.AttachmentPayload{}
+			}
+			emptyBlob := []byte{}
+			if err := stores.UpdateAttachment(ctx, &store.UpdateAttachment{
+				ID:          attachment.ID,
+				Reference:   &attachment.Reference,
+				Payload:     previousPayload,
+				StorageType: &attachment.StorageType,
+				Blob:        &emptyBlob,
+			}); err != nil {
+				return errors.Wrap(err, "failed to restore attachment")
+			}
+			return errors.New("the written content does not match")
+		}
+	}
+
+	if options.DeleteSource {
+		if err := stores.DeleteAttachmentContent(ctx, attachment); err != nil {
+			slog.Warn("failed to delete migrated content", slog.String("attachment", attachment.UID), slog.Any("error", err))
+		}
+	}
+	return nil
+}
+
+func hashContent(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
+// MigrateAttachmentStorage starts a storage migration, or runs a dry run, unless a migration is running already.
+func (s *APIV1Service) MigrateAttachmentStorage(ctx context.Context, request *v1pb.MigrateAttachmentStorageRequest) (*v1pb.AttachmentStorageMigration, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if err := s.checkPermission(ctx, user, storepb.Permission_MANAGE_STORAGE); err != nil {
+		return nil, err
+	}
+
+	attachmentStorageMigrationMutex.Lock()
+	if attachmentStorageMigration != nil && attachmentStorageMigration.State == v1pb.AttachmentStorageMigration_RUNNING {
+		attachmentStorageMigrationMutex.Unlock()
+		return nil, status.Errorf(codes.FailedPrecondition, "a storage migration is running already")
+	}
+	migration := &v1pb.AttachmentStorageMigration{
+		State:     v1pb.AttachmentStorageMigration_RUNNING,
+		DryRun:    request.DryRun,
+		StartTime: timestamppb.Now(),
+	}
+	attachmentStorageMigration = migration
+	attachmentStorageMigrationMutex.Unlock()
+
+	options := AttachmentStorageMigrationOptions{
+		DryRun:       request.DryRun,
+		DeleteSource: request.DeleteSource,
+	}
+	if request.DryRun {
+		s.runAttachmentStorageMigration(ctx, migration, options)
+		return getAttachmentStorageMigration(), nil
+	}
+	// The migration outlives the request.
+	if _, err := s.JobQueue.Enqueue(ctx, attachmentStorageMigrationJobKind, &attachmentStorageMigrationJobPayload{DeleteSource: request.DeleteSource}); err != nil {
+		attachmentStorageMigrationMutex.Lock()
+		migration.State = v1pb.AttachmentStorageMigration_FAILED
+		migration.Error = err.Error()
+		migration.EndTime = timestamppb.Now()
+		attachmentStorageMigrationMutex.Unlock()
+		return nil, status.Errorf(codes.Internal, "failed to enqueue storage migration: %v", err)
+	}
+	return getAttachmentStorageMigration(), nil
+}
+
+func (s *APIV1Service) runAttachmentStorageMigrationJob(ctx context.Context, data []byte) error {
+	var payload attachmentStorageMigrationJobPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return errors.Wrap(err, "invalid job payload")
+	}
+	attachmentStorageMigrationMutex.Lock()
+	migration := attachmentStorageMigration
+	// The migration is started anew when the job is resumed after a restart, or run by another instance.
+	if migration == nil || migration.State != v1pb.AttachmentStorageMigration_RUNNING {
+		migration = &v1pb.AttachmentStorageMigration{
+			State:     v1pb.AttachmentStorageMigration_RUNNING,
+			StartTime: timestamppb.Now(),
+		}
+		attachmentStorageMigration = migration
+	}
+	attachmentStorageMigrationMutex.Unlock()
+
+	s.runAttachmentStorageMigration(ctx, migration, AttachmentStorageMigrationOptions{DeleteSource: payload.DeleteSource})
+	return nil
+}
+
+// GetAttachmentStorageMigration returns the progress of the last storage migration.
+func (s *APIV1Service) GetAttachmentStorageMigration(ctx context.Context, _ *v1pb.GetAttachmentStorageMigrationRequest) (*v1pb.AttachmentStorageMigration, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if err := s.checkPermission(ctx, user, storepb.Permission_MANAGE_STORAGE); err != nil {
+		return nil, err
+	}
+	migration := getAttachmentStorageMigration()
+	if migration == nil {
+		return nil, status.Errorf(codes.NotFound, "no storage migration found")
+	}
+	return migration, nil
+}
+
+func (s *APIV1Service) runAttachmentStorageMigration(ctx context.Context, migration *v1pb.AttachmentStorageMigration, options AttachmentStorageMigrationOptions) {
+	_, err := MigrateAttachmentStorage(ctx, s.Profile, s.Store, options, func(progress *AttachmentStorageMigrationProgress) {
+		attachmentStorageMigrationMutex.Lock()
+		defer attachmentStorageMigrationMutex.Unlock()
+		migration.Total = int32(progress.Total)
+		migration.Migrated = int32(progress.Migrated)
+		migration.Failures = []*v1pb.AttachmentStorageMigration_Failure{}
+		for _, failure := range progress.Failures {
+			migration.Failures = append(migration.Failures, &v1pb.AttachmentStorageMigration_Failure{
+				Attachment: fmt.Sprintf("%s%s", AttachmentNamePrefix, failure.AttachmentUID),
+				Error:      failure.Error,
+			})
+		}
+	})
+
+	attachmentStorageMigrationMutex.Lock()
+	defer attachmentStorageMigrationMutex.Unlock()
+	migration.State = v1pb.AttachmentStorageMigration_COMPLETED
+	if err != nil {
+		slog.Error("failed to migrate attachment storage", slog.Any("error", err))
+		migration.State = v1pb.AttachmentStorageMigration_FAILED
+		migration.Error = err.Error()
+	}
+	migration.EndTime = timestamppb.New(time.Now())
+}
+
+// getAttachmentStorageMigration returns a copy of the last storage migration, which is updated while it runs.
+func getAttachmentStorageMigration() *v1pb.AttachmentStorageMigration {
+	attachmentStorageMigrationMutex.Lock()
+	defer attachmentStorageMigrationMutex.Unlock()
+	if attachmentStorageMigration == nil {
+		return nil
+	}
+	return proto.Clone(attachmentStorageMigration).(*v1pb.AttachmentStorageMigration)
+}
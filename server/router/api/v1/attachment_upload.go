@@ -0,0 +1,634 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/base"
+	"github.com/usememos/memos/plugin/storage/s3"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// UploadCacheFolder is the folder name where the content of unfinished uploads is stored.
+	UploadCacheFolder = ".upload_cache"
+	// uploadExpiration is the duration after which an unfinished upload is discarded.
+	uploadExpiration = 24 * time.Hour
+)
+
+// attachmentUploadMutex serializes the changes to the uploads, so that concurrent chunks do not interleave.
+var attachmentUploadMutex sync.Mutex
+
+// attachmentUploadLockTTL bounds the time an upload stays locked by a crashed instance of the cluster.
+const attachmentUploadLockTTL = 10 * time.Minute
+
+// attachmentUpload is the state of an upload, stored next to its content.
+type attachmentUpload struct {
+	CreatorID int32  `json:"creatorId"`
+	Filename  string `json:"filename"`
+	Type      string `json:"type"`
+	Memo      string `json:"memo,omitempty"`
+	Size      int64  `json:"size"`
+	CreatedTs int64  `json:"createdTs"`
+	// Key is the S3 object key of a presigned upload, whose content is put to S3 directly.
+	Key string `json:"key,omitempty"`
+}
+
+// lockAttachmentUpload holds attachmentUploadMutex and, in a cluster, the lock of the upload shared by the instances,
+// whose unfinished uploads are stored in the shared data directory. It returns the function releasing them.
+func (s *APIV1Service) lockAttachmentUpload(ctx context.Context, uploadUID string) (func(), error) {
+	attachmentUploadMutex.Lock()
+	if s.Cluster == nil {
+		return attachmentUploadMutex.Unlock, nil
+	}
+	lock, err := s.Cluster.Lock(ctx, "attachment_upload:"+uploadUID, attachmentUploadLockTTL)
+	if err != nil {
+		attachmentUploadMutex.Unlock()
+		return nil, err
+	}
+	return func() {
+		if err := lock.Unlock(context.WithoutCancel(ctx)); err != nil {
+			slog.Warn("failed to unlock upload", slog.String("upload", uploadUID), slog.Any("error", err))
+		}
+		attachmentUploadMutex.Unlock()
+	}, nil
+}
+
+func (s *APIV1Service) CreateAttachmentUpload(ctx context.Context, request *v1pb.CreateAttachmentUploadRequest) (*v1pb.AttachmentUpload, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if request.AttachmentUpload == nil || request.AttachmentUpload.Attachment == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "attachment is required")
+	}
+	attachment := request.AttachmentUpload.Attachment
+	if attachment.Filename == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "filename is required")
+	}
+	if attachment.Type == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "type is required")
+	}
+	if len(attachment.Content) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "content is appended to the upload")
+	}
+
+	workspaceStorageSetting, err := s.Store.GetWorkspaceStorageSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace storage setting: %v", err)
+	}
+	size := request.AttachmentUpload.Size
+	uploadSizeLimit := int64(workspaceStorageSetting.UploadSizeLimitMb) * MebiByte
+	if uploadSizeLimit == 0 {
+		uploadSizeLimit = MaxUploadBufferSizeBytes
+	}
+	if size <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "size must be positive")
+	}
+	if size > uploadSizeLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "file size exceeds the limit")
+	}
+
+	if attachment.Memo != nil {
+		memoUID, err := ExtractMemoUIDFromName(*attachment.Memo)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+		}
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to find memo: %v", err)
+		}
+		if memo == nil {
+			return nil, status.Errorf(codes.NotFound, "memo not found: %s", *attachment.Memo)
+		}
+	}
+
+	// The upload shares its UID with the attachment it creates.
+	uploadUID := request.AttachmentId
+	if uploadUID == "" {
+		uploadUID = shortuuid.New()
+	}
+	if !base.UIDMatcher.MatchString(uploadUID) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attachment id: %s", uploadUID)
+	}
+	existing, err := s.Store.GetAttachment(ctx, &store.FindAttachment{UID: &uploadUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get attachment: %v", err)
+	}
+	if existing != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "attachment %s already exists", uploadUID)
+	}
+
+	unlock, err := s.lockAttachmentUpload(ctx, uploadUID)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to lock upload: %v", err)
+	}
+	defer unlock()
+	s.removeExpiredAttachmentUploads()
+
+	upload := &attachmentUpload{
+		CreatorID: user.ID,
+		Filename:  attachment.Filename,
+		Type:      attachment.Type,
+		Memo:      attachment.GetMemo(),
+		Size:      size,
+		CreatedTs: time.Now().Unix(),
+	}
+	contentPath, statePath, err := s.getAttachmentUploadPaths(uploadUID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get upload paths: %v", err)
+	}
+	if _, err := os.Stat(statePath); err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "upload %s already exists", uploadUID)
+	}
+	if request.Presigned {
+		if workspaceStorageSetting.StorageType != storepb.WorkspaceStorageSetting_S3 || workspaceStorageSetting.S3Config == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "presigned uploads require the S3 storage")
+		}
+		// The key of a presigned upload is chosen before its content is known.
+		if strings.Contains(workspaceStorageSetting.FilepathTemplate, "{hash}") {
+			return nil, status.Errorf(codes.FailedPrecondition, "presigned uploads do not support the {hash} filepath template")
+		}
+		upload.Key = getS3ObjectKey(workspaceStorageSetting, upload.Filename, "")
+	} else if err := os.WriteFile(contentPath, nil, 0644); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create upload: %v", err)
+	}
+	if err := writeAttachmentUpload(statePath, upload); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create upload: %v", err)
+	}
+	return s.convertAttachmentUploadFromState(ctx, uploadUID, upload, 0)
+}
+
+func (s *APIV1Service) GetAttachmentUpload(ctx context.Context, request *v1pb.GetAttachmentUploadRequest) (*v1pb.AttachmentUpload, error) {
+	uploadUID, err := ExtractAttachmentUploadUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid upload name: %v", err)
+	}
+
+	unlock, err := s.lockAttachmentUpload(ctx, uploadUID)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to lock upload: %v", err)
+	}
+	defer unlock()
+	upload, offset, err := s.getAttachmentUpload(ctx, uploadUID)
+	if err != nil {
+		return nil, err
+	}
+	if upload == nil {
+		// The upload of a created attachment is done, in case the response to its last chunk was lost.
+		return s.getDoneAttachmentUpload(ctx, uploadUID)
+	}
+	return s.convertAttachmentUploadFromState(ctx, uploadUID, upload, offset)
+}
+
+func (s *APIV1Service) AppendAttachmentUpload(ctx context.Context, request *v1pb.AppendAttachmentUploadRequest) (*v1pb.AttachmentUpload, error) {
+	uploadUID, err := ExtractAttachmentUploadUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid upload name: %v", err)
+	}
+	if len(request.Data) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "data is required")
+	}
+	if len(request.Data) > MaxUploadBufferSizeBytes {
+		return nil, status.Errorf(codes.InvalidArgument, "chunk size exceeds %d bytes", MaxUploadBufferSizeBytes)
+	}
+
+	unlock, err := s.lockAttachmentUpload(ctx, uploadUID)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to lock upload: %v", err)
+	}
+	defer unlock()
+	upload, offset, err := s.getAttachmentUpload(ctx, uploadUID)
+	if err != nil {
+		return nil, err
+	}
+	if upload == nil {
+		return nil, status.Errorf(codes.NotFound, "upload not found")
+	}
+	if upload.Key != "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "the content of a presigned upload is put to its upload URL")
+	}
+	if request.Offset != offset {
+		return nil, status.Errorf(codes.FailedPrecondition, "offset %d does not match the upload offset %d", request.Offset, offset)
+	}
+	if offset+int64(len(request.Data)) > upload.Size {
+		return nil, status.Errorf(codes.InvalidArgument, "data exceeds the upload size %d", upload.Size)
+	}
+
+	contentPath, statePath, err := s.getAttachmentUploadPaths(uploadUID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get upload paths: %v", err)
+	}
+	if err := appendAttachmentUploadContent(contentPath, request.Data); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to append data: %v", err)
+	}
+	offset += int64(len(request.Data))
+	if offset < upload.Size {
+		return s.convertAttachmentUploadFromState(ctx, uploadUID, upload, offset)
+	}
+
+	// The last chunk is appended, so create the attachment.
+	create, err := s.newAttachmentFromUpload(ctx, uploadUID, upload)
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.Open(contentPath)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to open upload content: %v", err)
+	}
+	defer content.Close()
+	if err := s.scanAttachment(ctx, create, content); err != nil {
+		removeAttachmentUploadFiles(contentPath, statePath)
+		return nil, err
+	}
+	if err := saveAttachmentContent(ctx, s.Profile, s.Store, create, content); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save attachment content: %v", err)
+	}
+	attachment, err := s.Store.CreateAttachment(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create attachment: %v", err)
+	}
+	removeAttachmentUploadFiles(contentPath, statePath)
+	s.generateAttachmentThumbnails(ctx, attachment)
+
+	attachmentMessage := s.convertAttachmentFromStore(ctx, attachment)
+	// Try to dispatch webhook when attachment is created.
+	if err := s.dispatchAttachmentCreatedWebhook(ctx, attachment.CreatorID, attachmentMessage); err != nil {
+		slog.Warn("Failed to dispatch attachment created webhook", slog.Any("err", err))
+	}
+
+	return &v1pb.AttachmentUpload{
+		Name:       fmt.Sprintf("%s%s", AttachmentUploadNamePrefix, uploadUID),
+		Attachment: attachmentMessage,
+		Size:       upload.Size,
+		Offset:     upload.Size,
+		Done:       true,
+	}, nil
+}
+
+func (s *APIV1Service) CompleteAttachmentUpload(ctx context.Context, request *v1pb.CompleteAttachmentUploadRequest) (*v1pb.AttachmentUpload, error) {
+	uploadUID, err := ExtractAttachmentUploadUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid upload name: %v", err)
+	}
+
+	unlock, err := s.lockAttachmentUpload(ctx, uploadUID)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to lock upload: %v", err)
+	}
+	defer unlock()
+	upload, _, err := s.getAttachmentUpload(ctx, uploadUID)
+	if err != nil {
+		return nil, err
+	}
+	if upload == nil {
+		// The upload may be completed already, in case the response to the completion was lost.
+		return s.getDoneAttachmentUpload(ctx, uploadUID)
+	}
+	if upload.Key == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "only presigned uploads are completed, the others complete with their last chunk")
+	}
+
+	s3Config, s3Client, err := s.getAttachmentUploadS3Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	size, err := s3Client.GetObjectSize(ctx, upload.Key)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "the content is not uploaded: %v", err)
+	}
+	if size != upload.Size {
+		return nil, status.Errorf(codes.FailedPrecondition, "the uploaded content has %d bytes instead of %d", size, upload.Size)
+	}
+
+	create, err := s.newAttachmentFromUpload(ctx, uploadUID, upload)
+	if err != nil {
+		return nil, err
+	}
+	contentPath, statePath, err := s.getAttachmentUploadPaths(uploadUID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get upload paths: %v", err)
+	}
+	// The content uploaded directly to S3 is scanned once uploaded, and removed if it is flagged.
+	if err := s.scanAttachmentUploadObject(ctx, s3Client, upload.Key, create); err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			if err := s3Client.DeleteObject(ctx, upload.Key); err != nil {
+				slog.Warn("failed to delete flagged upload object", slog.String("key", upload.Key), slog.Any("error", err))
+			}
+			removeAttachmentUploadFiles(contentPath, statePath)
+		}
+		return nil, err
+	}
+	if err := setS3AttachmentObject(ctx, s3Client, s3Config, create, upload.Key); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save attachment content: %v", err)
+	}
+	attachment, err := s.Store.CreateAttachment(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create attachment: %v", err)
+	}
+	removeAttachmentUploadFiles(contentPath, statePath)
+
+	attachmentMessage := s.convertAttachmentFromStore(ctx, attachment)
+	// Try to dispatch webhook when attachment is created.
+	if err := s.dispatchAttachmentCreatedWebhook(ctx, attachment.CreatorID, attachmentMessage); err != nil {
+		slog.Warn("Failed to dispatch attachment created webhook", slog.Any("err", err))
+	}
+
+	return &v1pb.AttachmentUpload{
+		Name:       fmt.Sprintf("%s%s", AttachmentUploadNamePrefix, uploadUID),
+		Attachment: attachmentMessage,
+		Size:       upload.Size,
+		Offset:     upload.Size,
+		Done:       true,
+	}, nil
+}
+
+func (s *APIV1Service) DeleteAttachmentUpload(ctx context.Context, request *v1pb.DeleteAttachmentUploadRequest) (*emptypb.Empty, error) {
+	uploadUID, err := ExtractAttachmentUploadUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid upload name: %v", err)
+	}
+
+	unlock, err := s.lockAttachmentUpload(ctx, uploadUID)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to lock upload: %v", err)
+	}
+	defer unlock()
+	upload, _, err := s.getAttachmentUpload(ctx, uploadUID)
+	if err != nil {
+		return nil, err
+	}
+	if upload == nil {
+		return nil, status.Errorf(codes.NotFound, "upload not found")
+	}
+	contentPath, statePath, err := s.getAttachmentUploadPaths(uploadUID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get upload paths: %v", err)
+	}
+	if upload.Key != "" {
+		// The content may have been put to S3 already.
+		if _, s3Client, err := s.getAttachmentUploadS3Client(ctx); err == nil {
+			if err := s3Client.DeleteObject(ctx, upload.Key); err != nil {
+				slog.Warn("failed to delete uploaded object", slog.String("key", upload.Key), slog.Any("error", err))
+			}
+		}
+	}
+	removeAttachmentUploadFiles(contentPath, statePath)
+	return &emptypb.Empty{}, nil
+}
+
+// newAttachmentFromUpload returns the attachment to create for the upload, without its content.
+func (s *APIV1Service) newAttachmentFromUpload(ctx context.Context, uploadUID string, upload *attachmentUpload) (*store.Attachment, error) {
+	create := &store.Attachment{
+		UID:       uploadUID,
+		CreatorID: upload.CreatorID,
+		Filename:  upload.Filename,
+		Type:      upload.Type,
+		Size:      upload.Size,
+	}
+	if upload.Memo != "" {
+		memoUID, err := ExtractMemoUIDFromName(upload.Memo)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+		}
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to find memo: %v", err)
+		}
+		if memo != nil {
+			create.MemoID = &memo.ID
+		}
+	}
+	return create, nil
+}
+
+// getAttachmentUploadS3Client returns the S3 client of the workspace storage, to which presigned uploads put their content.
+// scanAttachmentUploadObject scans the content of a presigned upload, when the workspace scans the attachments.
+func (s *APIV1Service) scanAttachmentUploadObject(ctx context.Context, s3Client *s3.Client, key string, create *store.Attachment) error {
+	workspaceMalwareScanSetting, err := s.Store.GetWorkspaceMalwareScanSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace malware scan setting: %v", err)
+	}
+	if !workspaceMalwareScanSetting.Enabled {
+		return nil
+	}
+	content, err := s3Client.GetObject(ctx, key)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get the uploaded content: %v", err)
+	}
+	return s.scanAttachment(ctx, create, bytes.NewReader(content))
+}
+
+func (s *APIV1Service) getAttachmentUploadS3Client(ctx context.Context) (*storepb.StorageS3Config, *s3.Client, error) {
+	workspaceStorageSetting, err := s.Store.GetWorkspaceStorageSetting(ctx)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get workspace storage setting: %v", err)
+	}
+	if workspaceStorageSetting.StorageType != storepb.WorkspaceStorageSetting_S3 || workspaceStorageSetting.S3Config == nil {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "presigned uploads require the S3 storage")
+	}
+	s3Client, err := s3.NewClient(ctx, workspaceStorageSetting.S3Config)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to create s3 client: %v", err)
+	}
+	return workspaceStorageSetting.S3Config, s3Client, nil
+}
+
+// getAttachmentUpload returns the unfinished upload of the current user and its offset, or nil if there is none.
+// The caller must hold attachmentUploadMutex.
+func (s *APIV1Service) getAttachmentUpload(ctx context.Context, uploadUID string) (*attachmentUpload, int64, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, 0, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if !base.UIDMatcher.MatchString(uploadUID) {
+		return nil, 0, status.Errorf(codes.InvalidArgument, "invalid upload id: %s", uploadUID)
+	}
+
+	contentPath, statePath, err := s.getAttachmentUploadPaths(uploadUID)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "failed to get upload paths: %v", err)
+	}
+	upload, err := readAttachmentUpload(statePath)
+	if err != nil {
+		if os.IsNotExist(errors.Cause(err)) {
+			return nil, 0, nil
+		}
+		return nil, 0, status.Errorf(codes.Internal, "failed to read upload: %v", err)
+	}
+	if upload.CreatorID != user.ID {
+		return nil, 0, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if time.Since(time.Unix(upload.CreatedTs, 0)) > uploadExpiration {
+		removeAttachmentUploadFiles(contentPath, statePath)
+		return nil, 0, nil
+	}
+	if upload.Key != "" {
+		return upload, 0, nil
+	}
+	// As with tus, the offset is the size of the received content, which survives interrupted chunks.
+	stat, err := os.Stat(contentPath)
+	if err != nil {
+		return nil, 0, status.Errorf(codes.Internal, "failed to read upload content: %v", err)
+	}
+	return upload, stat.Size(), nil
+}
+
+// getDoneAttachmentUpload returns the upload of an attachment created by an upload of the current user.
+func (s *APIV1Service) getDoneAttachmentUpload(ctx context.Context, uploadUID string) (*v1pb.AttachmentUpload, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	attachment, err := s.Store.GetAttachment(ctx, &store.FindAttachment{
+		UID:       &uploadUID,
+		CreatorID: &user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get attachment: %v", err)
+	}
+	if attachment == nil {
+		return nil, status.Errorf(codes.NotFound, "upload not found")
+	}
+	return &v1pb.AttachmentUpload{
+		Name:       fmt.Sprintf("%s%s", AttachmentUploadNamePrefix, uploadUID),
+		Attachment: s.convertAttachmentFromStore(ctx, attachment),
+		Size:       attachment.Size,
+		Offset:     attachment.Size,
+		Done:       true,
+	}, nil
+}
+
+// getAttachmentUploadPaths returns the paths of the content and the state of the upload, creating their folder.
+func (s *APIV1Service) getAttachmentUploadPaths(uploadUID string) (string, string, error) {
+	uploadCacheFolder := filepath.Join(s.Profile.Data, UploadCacheFolder)
+	if err := os.MkdirAll(uploadCacheFolder, os.ModePerm); err != nil {
+		return "", "", errors.Wrap(err, "failed to create upload cache folder")
+	}
+	contentPath := filepath.Join(uploadCacheFolder, uploadUID)
+	return contentPath, contentPath + ".json", nil
+}
+
+// removeExpiredAttachmentUploads discards the unfinished uploads older than uploadExpiration.
+// The caller must hold attachmentUploadMutex.
+func (s *APIV1Service) removeExpiredAttachmentUploads() {
+	uploadCacheFolder := filepath.Join(s.Profile.Data, UploadCacheFolder)
+	entries, err := os.ReadDir(uploadCacheFolder)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		uploadUID, ok := strings.CutSuffix(entry.Name(), ".json")
+		if !ok {
+			continue
+		}
+		statePath := filepath.Join(uploadCacheFolder, entry.Name())
+		upload, err := readAttachmentUpload(statePath)
+		if err != nil {
+			slog.Warn("failed to read upload", slog.String("upload", uploadUID), slog.Any("error", err))
+			continue
+		}
+		if time.Since(time.Unix(upload.CreatedTs, 0)) > uploadExpiration {
+			removeAttachmentUploadFiles(filepath.Join(uploadCacheFolder, uploadUID), statePath)
+		}
+	}
+}
+
+func readAttachmentUpload(statePath string) (*attachmentUpload, error) {
+	data, err := os.ReadFile(statePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read upload state")
+	}
+	upload := &attachmentUpload{}
+	if err := json.Unmarshal(data, upload); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal upload state")
+	}
+	return upload, nil
+}
+
+func writeAttachmentUpload(statePath string, upload *attachmentUpload) error {
+	data, err := json.Marshal(upload)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal upload state")
+	}
+	if err := os.WriteFile(statePath, data, 0644); err != nil {
+		return errors.Wrap(err, "failed to write upload state")
+	}
+	return nil
+}
+
+func appendAttachmentUploadContent(contentPath string, data []byte) error {
+	file, err := os.OpenFile(contentPath, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return errors.Wrap(err, "failed to open upload content")
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return errors.Wrap(err, "failed to write upload content")
+	}
+	return file.Close()
+}
+
+func removeAttachmentUploadFiles(contentPath, statePath string) {
+	for _, path := range []string{contentPath, statePath} {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			slog.Warn("failed to remove upload file", slog.String("path", path), slog.Any("error", err))
+		}
+	}
+}
+
+func (s *APIV1Service) convertAttachmentUploadFromState(ctx context.Context, uploadUID string, upload *attachmentUpload, offset int64) (*v1pb.AttachmentUpload, error) {
+	attachment := &v1pb.Attachment{
+		Name:     fmt.Sprintf("%s%s", AttachmentNamePrefix, uploadUID),
+		Filename: upload.Filename,
+		Type:     upload.Type,
+	}
+	if upload.Memo != "" {
+		attachment.Memo = &upload.Memo
+	}
+	expireTime := time.Unix(upload.CreatedTs, 0).Add(uploadExpiration)
+	attachmentUpload := &v1pb.AttachmentUpload{
+		Name:       fmt.Sprintf("%s%s", AttachmentUploadNamePrefix, uploadUID),
+		Attachment: attachment,
+		Size:       upload.Size,
+		Offset:     offset,
+		ExpireTime: timestamppb.New(expireTime),
+	}
+	if upload.Key != "" {
+		// The upload URL is presigned again on each request, so that clients losing it can resume.
+		_, s3Client, err := s.getAttachmentUploadS3Client(ctx)
+		if err != nil {
+			return nil, err
+		}
+		uploadURL, err := s3Client.PresignPutObject(ctx, upload.Key, upload.Type, upload.Size, time.Until(expireTime))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to presign upload: %v", err)
+		}
+		attachmentUpload.UploadUrl = uploadURL
+	}
+	return attachmentUpload, nil
+}
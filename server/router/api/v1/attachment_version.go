@@ -0,0 +1,257 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ReplaceAttachmentContent(ctx context.Context, request *v1pb.ReplaceAttachmentContentRequest) (*v1pb.Attachment, error) {
+	attachmentUID, err := ExtractAttachmentUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attachment id: %v", err)
+	}
+	attachment, err := s.getCreatorAttachment(ctx, attachmentUID)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceStorageSetting, err := s.Store.GetWorkspaceStorageSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace storage setting: %v", err)
+	}
+	size := binary.Size(request.Content)
+	uploadSizeLimit := int(workspaceStorageSetting.UploadSizeLimitMb) * MebiByte
+	if uploadSizeLimit == 0 {
+		uploadSizeLimit = MaxUploadBufferSizeBytes
+	}
+	if size > uploadSizeLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "file size exceeds the limit")
+	}
+
+	replacement := &store.Attachment{
+		UID:       attachment.UID,
+		CreatorID: attachment.CreatorID,
+		Filename:  attachment.Filename,
+		Type:      attachment.Type,
+		Size:      int64(size),
+		Blob:      request.Content,
+	}
+	if request.Filename != "" {
+		replacement.Filename = request.Filename
+	}
+	if request.Type != "" {
+		replacement.Type = request.Type
+	}
+	if err := s.scanAttachment(ctx, replacement, bytes.NewReader(replacement.Blob)); err != nil {
+		return nil, err
+	}
+	if err := SaveAttachmentBlob(ctx, s.Profile, s.Store, replacement); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save attachment blob: %v", err)
+	}
+
+	attachment, err = s.replaceAttachmentContent(ctx, attachment, replacement)
+	if err != nil {
+		return nil, err
+	}
+	return s.convertAttachmentFromStore(ctx, attachment), nil
+}
+
+func (s *APIV1Service) ListAttachmentVersions(ctx context.Context, request *v1pb.ListAttachmentVersionsRequest) (*v1pb.ListAttachmentVersionsResponse, error) {
+	attachmentUID, err := ExtractAttachmentUIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attachment id: %v", err)
+	}
+	attachment, err := s.getCreatorAttachment(ctx, attachmentUID)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := s.Store.ListAttachmentVersions(ctx, &store.FindAttachmentVersion{AttachmentID: &attachment.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list attachment versions: %v", err)
+	}
+
+	response := &v1pb.ListAttachmentVersionsResponse{}
+	for _, version := range versions {
+		response.Versions = append(response.Versions, &v1pb.AttachmentVersion{
+			Name:       fmt.Sprintf("%s%s/%s%d", AttachmentNamePrefix, attachment.UID, AttachmentVersionNamePrefix, version.ID),
+			CreateTime: timestamppb.New(time.Unix(version.CreatedTs, 0)),
+			Filename:   version.Filename,
+			Type:       version.Type,
+			Size:       version.Size,
+		})
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) RestoreAttachmentVersion(ctx context.Context, request *v1pb.RestoreAttachmentVersionRequest) (*v1pb.Attachment, error) {
+	attachmentUID, versionID, err := ExtractAttachmentVersionIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attachment version name: %v", err)
+	}
+	attachment, err := s.getCreatorAttachment(ctx, attachmentUID)
+	if err != nil {
+		return nil, err
+	}
+	version, err := s.Store.GetAttachmentVersion(ctx, &store.FindAttachmentVersion{
+		GetBlob:      true,
+		ID:           &versionID,
+		AttachmentID: &attachment.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get attachment version: %v", err)
+	}
+	if version == nil {
+		return nil, status.Errorf(codes.NotFound, "attachment version not found")
+	}
+
+	// The restored version is kept, sharing its content with the attachment.
+	attachment, err = s.replaceAttachmentContent(ctx, attachment, &store.Attachment{
+		Filename:    version.Filename,
+		Blob:        version.Blob,
+		Type:        version.Type,
+		Size:        version.Size,
+		StorageType: version.StorageType,
+		Reference:   version.Reference,
+		Payload:     version.Payload,
+		ContentHash: version.ContentHash,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.convertAttachmentFromStore(ctx, attachment), nil
+}
+
+// getCreatorAttachment returns the attachment of the current user with its blob, which only its creator versions.
+func (s *APIV1Service) getCreatorAttachment(ctx context.Context, attachmentUID string) (*store.Attachment, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	attachment, err := s.Store.GetAttachment(ctx, &store.FindAttachment{
+		GetBlob:   true,
+		UID:       &attachmentUID,
+		CreatorID: &user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get attachment: %v", err)
+	}
+	if attachment == nil {
+		return nil, status.Errorf(codes.NotFound, "attachment not found")
+	}
+	if attachment.StorageType == storepb.AttachmentStorageType_EXTERNAL {
+		return nil, status.Errorf(codes.FailedPrecondition, "the content of an external link is not versioned")
+	}
+	return attachment, nil
+}
+
+// replaceAttachmentContent keeps the current content of the attachment as a version,
+// and replaces it with the stored content of the replacement.
+func (s *APIV1Service) replaceAttachmentContent(ctx context.Context, attachment *store.Attachment, replacement *store.Attachment) (*store.Attachment, error) {
+	version, err := s.Store.CreateAttachmentVersion(ctx, &store.AttachmentVersion{
+		AttachmentID: attachment.ID,
+		Filename:     attachment.Filename,
+		Blob:         attachment.Blob,
+		Type:         attachment.Type,
+		Size:         attachment.Size,
+		StorageType:  attachment.StorageType,
+		Reference:    attachment.Reference,
+		Payload:      attachment.Payload,
+		ContentHash:  attachment.ContentHash,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create attachment version: %v", err)
+	}
+
+	currentTs := time.Now().Unix()
+	payload := replacement.Payload
+	if payload == nil {
+		payload = &storepb.AttachmentPayload{}
+	}
+	if err := s.Store.UpdateAttachment(ctx, &store.UpdateAttachment{
+		ID:          attachment.ID,
+		UpdatedTs:   &currentTs,
+		Filename:    &replacement.Filename,
+		Type:        &replacement.Type,
+		Size:        &replacement.Size,
+		StorageType: &replacement.StorageType,
+		Reference:   &replacement.Reference,
+		Payload:     payload,
+		Blob:        &replacement.Blob,
+		ContentHash: &replacement.ContentHash,
+	}); err != nil {
+		// The version shares its content with the attachment, so only the version is deleted.
+		if err := s.Store.DeleteAttachmentVersion(ctx, &store.DeleteAttachmentVersion{ID: version.ID}); err != nil {
+			slog.Warn("failed to delete attachment version", slog.String("attachment", attachment.UID), slog.Any("error", err))
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update attachment: %v", err)
+	}
+
+	attachment, err = s.Store.GetAttachment(ctx, &store.FindAttachment{GetBlob: true, ID: &attachment.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get attachment: %v", err)
+	}
+	if err := s.resetAttachmentDerivedData(ctx, attachment); err != nil {
+		slog.Warn("failed to reset attachment derived data", slog.String("attachment", attachment.UID), slog.Any("error", err))
+	}
+	s.generateAttachmentThumbnails(ctx, attachment)
+	return attachment, nil
+}
+
+// resetAttachmentDerivedData removes what is derived from the replaced content of the attachment:
+// the cached thumbnails, poster frame and preview, the indexed text and the transcript in the memo payload.
+func (s *APIV1Service) resetAttachmentDerivedData(ctx context.Context, attachment *store.Attachment) error {
+	cacheFiles, err := filepath.Glob(filepath.Join(s.Profile.Data, ThumbnailCacheFolder, fmt.Sprintf("%d_*", attachment.ID)))
+	if err != nil {
+		return errors.Wrap(err, "failed to find cache files")
+	}
+	for _, cacheFile := range cacheFiles {
+		if err := os.Remove(cacheFile); err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, "failed to remove cache file")
+		}
+	}
+
+	// The runners index the new content on their next run.
+	if err := s.Store.DeleteAttachmentText(ctx, &store.DeleteAttachmentText{AttachmentID: attachment.ID}); err != nil {
+		return errors.Wrap(err, "failed to delete attachment text")
+	}
+	if attachment.MemoID == nil {
+		return nil
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: attachment.MemoID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo")
+	}
+	if memo == nil || memo.Payload == nil {
+		return nil
+	}
+	transcripts := slices.DeleteFunc(slices.Clone(memo.Payload.Transcripts), func(transcript *storepb.MemoPayload_Transcript) bool {
+		return transcript.Attachment == attachment.UID
+	})
+	if len(transcripts) == len(memo.Payload.Transcripts) {
+		return nil
+	}
+	memo.Payload.Transcripts = transcripts
+	if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Payload: memo.Payload}); err != nil {
+		return errors.Wrap(err, "failed to update memo")
+	}
+	return nil
+}
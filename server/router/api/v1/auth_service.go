@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -19,7 +20,9 @@ import (
 
 	"github.com/usememos/memos/internal/util"
 	"github.com/usememos/memos/plugin/idp"
+	"github.com/usememos/memos/plugin/idp/ldap"
 	"github.com/usememos/memos/plugin/idp/oauth2"
+	"github.com/usememos/memos/plugin/webhook"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
@@ -42,7 +45,9 @@ func (s *APIV1Service) GetCurrentSession(ctx context.Context, _ *v1pb.GetCurrent
 		return nil, status.Errorf(codes.Unauthenticated, "user not found")
 	}
 
-	var lastAccessedAt *timestamppb.Timestamp
+	response := &v1pb.GetCurrentSessionResponse{
+		User: convertUserFromStore(user),
+	}
 	// Update session last accessed time if we have a session ID and get the current session info
 	if sessionID, ok := ctx.Value(sessionIDContextKey).(string); ok && sessionID != "" {
 		now := timestamppb.Now()
@@ -50,18 +55,32 @@ func (s *APIV1Service) GetCurrentSession(ctx context.Context, _ *v1pb.GetCurrent
 			// Log error but don't fail the request
 			slog.Error("failed to update session last accessed time", "error", err)
 		}
-		lastAccessedAt = now
+		response.LastAccessedAt = now
+
+		session, err := s.Store.GetUserSession(ctx, user.ID, sessionID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user session: %v", err)
+		}
+		if session != nil && session.ImpersonatorId != 0 {
+			response.Impersonator = fmt.Sprintf("%s%d", UserNamePrefix, session.ImpersonatorId)
+			response.ExpireTime = session.ExpireTime
+		}
 	}
 
-	return &v1pb.GetCurrentSessionResponse{
-		User:           convertUserFromStore(user),
-		LastAccessedAt: lastAccessedAt,
-	}, nil
+	password, err := s.Store.GetUserPassword(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user password: %v", err)
+	}
+	response.PasswordChangeRequired = password.ChangeRequired
+	return response, nil
 }
 
 func (s *APIV1Service) CreateSession(ctx context.Context, request *v1pb.CreateSessionRequest) (*v1pb.CreateSessionResponse, error) {
 	var existingUser *store.User
 	if passwordCredentials := request.GetPasswordCredentials(); passwordCredentials != nil {
+		if err := s.checkSignInCaptcha(ctx, passwordCredentials.Username, request.CaptchaToken); err != nil {
+			return nil, err
+		}
 		user, err := s.Store.GetUser(ctx, &store.FindUser{
 			Username: &passwordCredentials.Username,
 		})
@@ -69,12 +88,15 @@ func (s *APIV1Service) CreateSession(ctx context.Context, request *v1pb.CreateSe
 			return nil, status.Errorf(codes.Internal, "failed to get user, error: %v", err)
 		}
 		if user == nil {
+			s.recordSignInFailure(ctx, passwordCredentials.Username)
 			return nil, status.Errorf(codes.InvalidArgument, unmatchedUsernameAndPasswordError)
 		}
 		// Compare the stored hashed password, with the hashed version of the password that was received.
 		if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(passwordCredentials.Password)); err != nil {
+			s.recordSignInFailure(ctx, passwordCredentials.Username)
 			return nil, status.Errorf(codes.InvalidArgument, unmatchedUsernameAndPasswordError)
 		}
+		s.resetSignInFailures(ctx, passwordCredentials.Username)
 		workspaceGeneralSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get workspace general setting, error: %v", err)
@@ -83,6 +105,18 @@ func (s *APIV1Service) CreateSession(ctx context.Context, request *v1pb.CreateSe
 		if workspaceGeneralSetting.DisallowPasswordAuth && user.Role == store.RoleUser {
 			return nil, status.Errorf(codes.PermissionDenied, "password signin is not allowed")
 		}
+		if workspaceGeneralSetting.SsoOnly {
+			if user.Role != store.RoleHost {
+				return nil, status.Errorf(codes.PermissionDenied, "password signin is disabled, sign in with an identity provider")
+			}
+			slog.Warn("break-glass password signin of the host in sso-only mode", slog.String("username", user.Username))
+		}
+		if err := s.checkEmailVerified(ctx, user); err != nil {
+			return nil, err
+		}
+		if err := s.checkSignInTwoFactor(ctx, user, request.TwoFactorCode); err != nil {
+			return nil, err
+		}
 		existingUser = user
 	} else if ssoCredentials := request.GetSsoCredentials(); ssoCredentials != nil {
 		identityProvider, err := s.Store.GetIdentityProvider(ctx, &store.FindIdentityProvider{
@@ -109,57 +143,49 @@ func (s *APIV1Service) CreateSession(ctx context.Context, request *v1pb.CreateSe
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to get user info, error: %v", err)
 			}
+		} else {
+			// The LDAP identity providers sign in with their own credentials.
+			return nil, status.Errorf(codes.InvalidArgument, "identity provider does not support sso")
 		}
 
-		identifierFilter := identityProvider.IdentifierFilter
-		if identifierFilter != "" {
-			identifierFilterRegex, err := regexp.Compile(identifierFilter)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to compile identifier filter regex, error: %v", err)
-			}
-			if !identifierFilterRegex.MatchString(userInfo.Identifier) {
-				return nil, status.Errorf(codes.PermissionDenied, "identifier %s is not allowed", userInfo.Identifier)
-			}
+		user, err := s.getOrCreateIdentityProviderUser(ctx, identityProvider, userInfo)
+		if err != nil {
+			return nil, err
 		}
-
-		user, err := s.Store.GetUser(ctx, &store.FindUser{
-			Username: &userInfo.Identifier,
+		existingUser = user
+	} else if ldapCredentials := request.GetLdapCredentials(); ldapCredentials != nil {
+		identityProvider, err := s.Store.GetIdentityProvider(ctx, &store.FindIdentityProvider{
+			ID: &ldapCredentials.IdpId,
 		})
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get user, error: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to get identity provider, error: %v", err)
 		}
-		if user == nil {
-			// Check if the user is allowed to sign up.
-			workspaceGeneralSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to get workspace general setting, error: %v", err)
-			}
-			if workspaceGeneralSetting.DisallowUserRegistration {
-				return nil, status.Errorf(codes.PermissionDenied, "user registration is not allowed")
-			}
-
-			// Create a new user with the user info from the identity provider.
-			userCreate := &store.User{
-				Username: userInfo.Identifier,
-				// The new signup user should be normal user by default.
-				Role:      store.RoleUser,
-				Nickname:  userInfo.DisplayName,
-				Email:     userInfo.Email,
-				AvatarURL: userInfo.AvatarURL,
-			}
-			password, err := util.RandomString(20)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to generate random password, error: %v", err)
-			}
-			passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to generate password hash, error: %v", err)
-			}
-			userCreate.PasswordHash = string(passwordHash)
-			user, err = s.Store.CreateUser(ctx, userCreate)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to create user, error: %v", err)
+		if identityProvider == nil || identityProvider.Type != storepb.IdentityProvider_LDAP {
+			return nil, status.Errorf(codes.InvalidArgument, "identity provider not found")
+		}
+		ldapIdentityProvider, err := ldap.NewIdentityProvider(identityProvider.Config.GetLdapConfig())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create ldap identity provider, error: %v", err)
+		}
+		if err := s.checkSignInCaptcha(ctx, ldapCredentials.Username, request.CaptchaToken); err != nil {
+			return nil, err
+		}
+		userInfo, err := ldapIdentityProvider.Authenticate(ldapCredentials.Username, ldapCredentials.Password)
+		if err != nil {
+			if errors.Is(err, ldap.ErrInvalidCredentials) {
+				s.recordSignInFailure(ctx, ldapCredentials.Username)
+				return nil, status.Errorf(codes.InvalidArgument, unmatchedUsernameAndPasswordError)
 			}
+			return nil, status.Errorf(codes.Internal, "failed to authenticate with ldap, error: %v", err)
+		}
+		s.resetSignInFailures(ctx, ldapCredentials.Username)
+		user, err := s.getOrCreateIdentityProviderUser(ctx, identityProvider, userInfo)
+		if err != nil {
+			return nil, err
+		}
+		// The directory checks the password only, so the two-factor authentication of the user still applies.
+		if err := s.checkSignInTwoFactor(ctx, user, request.TwoFactorCode); err != nil {
+			return nil, err
 		}
 		existingUser = user
 	}
@@ -170,12 +196,22 @@ func (s *APIV1Service) CreateSession(ctx context.Context, request *v1pb.CreateSe
 	if existingUser.RowStatus == store.Archived {
 		return nil, status.Errorf(codes.PermissionDenied, "user has been archived with username %s", existingUser.Username)
 	}
+	if err := checkUserNotSuspended(ctx, s.Store, existingUser); err != nil {
+		return nil, err
+	}
 
 	// Default session expiration time is 100 year
 	expireTime := time.Now().Add(100 * 365 * 24 * time.Hour)
 	if err := s.doSignIn(ctx, existingUser, expireTime); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to sign in, error: %v", err)
 	}
+	// Try to dispatch webhook when user signs in.
+	if err := s.dispatchWebhook(ctx, existingUser.ID, &webhook.WebhookRequestPayload{
+		ActivityType: webhook.ActivityTypeUserSignedIn,
+		Creator:      fmt.Sprintf("%s%d", UserNamePrefix, existingUser.ID),
+	}); err != nil {
+		slog.Warn("Failed to dispatch user signed in webhook", slog.Any("err", err))
+	}
 
 	return &v1pb.CreateSessionResponse{
 		User:           convertUserFromStore(existingUser),
@@ -183,6 +219,155 @@ func (s *APIV1Service) CreateSession(ctx context.Context, request *v1pb.CreateSe
 	}, nil
 }
 
+// getOrCreateIdentityProviderUser returns the user of the identity provider user info,
+// creating it on the first sign in if the registration is allowed.
+func (s *APIV1Service) getOrCreateIdentityProviderUser(ctx context.Context, identityProvider *storepb.IdentityProvider, userInfo *idp.IdentityProviderUserInfo) (*store.User, error) {
+	identifierFilter := identityProvider.IdentifierFilter
+	if identifierFilter != "" {
+		identifierFilterRegex, err := regexp.Compile(identifierFilter)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to compile identifier filter regex, error: %v", err)
+		}
+		if !identifierFilterRegex.MatchString(userInfo.Identifier) {
+			return nil, status.Errorf(codes.PermissionDenied, "identifier %s is not allowed", userInfo.Identifier)
+		}
+	}
+
+	// The roles mapped from the groups of the user are re-evaluated on each sign in.
+	groupRoleMappings := identityProvider.Config.GetOauth2Config().GetGroupRoleMappings()
+	role := getGroupRole(groupRoleMappings, userInfo.Groups)
+
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		Username: &userInfo.Identifier,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user, error: %v", err)
+	}
+	if user != nil && len(groupRoleMappings) > 0 && user.Role != role {
+		user, err = s.updateGroupRole(ctx, user, role)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if user == nil {
+		// Check if the user is allowed to sign up.
+		workspaceGeneralSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get workspace general setting, error: %v", err)
+		}
+		if workspaceGeneralSetting.DisallowUserRegistration {
+			return nil, status.Errorf(codes.PermissionDenied, "user registration is not allowed")
+		}
+
+		// Create a new user with the user info from the identity provider.
+		userCreate := &store.User{
+			Username: userInfo.Identifier,
+			// The new signup user should be normal user by default, unless mapped from their groups.
+			Role:      role,
+			Nickname:  userInfo.DisplayName,
+			Email:     userInfo.Email,
+			AvatarURL: userInfo.AvatarURL,
+		}
+		password, err := util.RandomString(20)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate random password, error: %v", err)
+		}
+		passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate password hash, error: %v", err)
+		}
+		userCreate.PasswordHash = string(passwordHash)
+		user, err = s.Store.CreateUser(ctx, userCreate)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create user, error: %v", err)
+		}
+	}
+
+	// The user groups mapped from the groups of the user are re-evaluated on each sign in too.
+	if err := s.updateGroupMemberships(ctx, user, identityProvider.Config.GetOauth2Config().GetGroupMembershipMappings(), userInfo.Groups); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// updateGroupRole updates the role of the user to the role mapped from their groups.
+// The last host is never demoted, so that the workspace keeps a host.
+func (s *APIV1Service) updateGroupRole(ctx context.Context, user *store.User, role store.Role) (*store.User, error) {
+	if user.Role == store.RoleHost {
+		hostRole := store.RoleHost
+		hosts, err := s.Store.ListUsers(ctx, &store.FindUser{Role: &hostRole})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list host users, error: %v", err)
+		}
+		if len(hosts) <= 1 {
+			slog.Warn("the last host is not demoted by the group role mappings", "user", user.Username)
+			return user, nil
+		}
+	}
+	updatedUser, err := s.Store.UpdateUser(ctx, &store.UpdateUser{
+		ID:   user.ID,
+		Role: &role,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update user role, error: %v", err)
+	}
+	return updatedUser, nil
+}
+
+// updateGroupMemberships adds the user to the user groups mapped from their groups,
+// and removes them from the other mapped user groups. The user groups that no longer exist are skipped.
+func (s *APIV1Service) updateGroupMemberships(ctx context.Context, user *store.User, groupMembershipMappings []*storepb.GroupMembershipMapping, groups []string) error {
+	isMember := map[int32]bool{}
+	for _, groupMembershipMapping := range groupMembershipMappings {
+		isMember[groupMembershipMapping.UserGroupId] = isMember[groupMembershipMapping.UserGroupId] || slices.Contains(groups, groupMembershipMapping.Group)
+	}
+	for userGroupID, shouldBeMember := range isMember {
+		userGroup, err := s.Store.GetUserGroup(ctx, &store.FindUserGroup{ID: &userGroupID})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get user group, error: %v", err)
+		}
+		if userGroup == nil {
+			continue
+		}
+		members, err := s.Store.ListUserGroupMembers(ctx, &store.FindUserGroupMember{GroupID: &userGroupID})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list user group members, error: %v", err)
+		}
+		userIDs := []int32{}
+		for _, member := range members {
+			if member.UserID != user.ID {
+				userIDs = append(userIDs, member.UserID)
+			}
+		}
+		if wasMember := len(userIDs) < len(members); wasMember == shouldBeMember {
+			continue
+		}
+		if shouldBeMember {
+			userIDs = append(userIDs, user.ID)
+		}
+		if err := s.Store.SetUserGroupMembers(ctx, userGroupID, userIDs); err != nil {
+			return status.Errorf(codes.Internal, "failed to update user group members, error: %v", err)
+		}
+	}
+	return nil
+}
+
+// getGroupRole returns the highest role mapped from the groups, or the USER role if none is mapped.
+func getGroupRole(groupRoleMappings []*storepb.GroupRoleMapping, groups []string) store.Role {
+	rank := map[store.Role]int{store.RoleUser: 0, store.RoleAdmin: 1, store.RoleHost: 2}
+	role := store.RoleUser
+	for _, groupRoleMapping := range groupRoleMappings {
+		mappedRole := store.Role(groupRoleMapping.Role)
+		if _, ok := rank[mappedRole]; !ok || !slices.Contains(groups, groupRoleMapping.Group) {
+			continue
+		}
+		if rank[mappedRole] > rank[role] {
+			role = mappedRole
+		}
+	}
+	return role
+}
+
 func (s *APIV1Service) doSignIn(ctx context.Context, user *store.User, expireTime time.Time) error {
 	// Generate unique session ID for web use
 	sessionID, err := GenerateSessionID()
@@ -197,8 +382,12 @@ func (s *APIV1Service) doSignIn(ctx context.Context, user *store.User, expireTim
 		slog.Error("failed to track user session", "error", err)
 	}
 
-	// Set session cookie for web use (format: userID-sessionID)
-	sessionCookieValue := BuildSessionCookieValue(user.ID, sessionID)
+	return s.setSessionCookie(ctx, user.ID, sessionID, expireTime)
+}
+
+// setSessionCookie sets the session cookie for web use (format: userID-sessionID).
+func (s *APIV1Service) setSessionCookie(ctx context.Context, userID int32, sessionID string, expireTime time.Time) error {
+	sessionCookieValue := BuildSessionCookieValue(userID, sessionID)
 	sessionCookie, err := s.buildSessionCookie(ctx, sessionCookieValue, expireTime)
 	if err != nil {
 		return status.Errorf(codes.Internal, "failed to build session cookie, error: %v", err)
@@ -223,6 +412,15 @@ func (s *APIV1Service) DeleteSession(ctx context.Context, _ *v1pb.DeleteSessionR
 
 	// Check if we have a session ID (from cookie-based auth)
 	if sessionID, ok := ctx.Value(sessionIDContextKey).(string); ok && sessionID != "" {
+		session, err := s.Store.GetUserSession(ctx, user.ID, sessionID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user session: %v", err)
+		}
+		if session != nil && session.ImpersonatorId != 0 {
+			if err := s.createImpersonationActivity(ctx, store.ActivityTypeUserImpersonationEnd, user.ID, session); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to create activity: %v", err)
+			}
+		}
 		// Remove session from user settings
 		if err := s.Store.RemoveUserSession(ctx, user.ID, sessionID); err != nil {
 			slog.Error("failed to remove user session", "error", err)
@@ -338,18 +536,23 @@ func (s *APIV1Service) extractClientInfo(ctx context.Context) *storepb.SessionsU
 			// Parse user agent to extract device type, OS, browser info
 			s.parseUserAgent(userAgent, clientInfo)
 		}
-		if forwardedFor := md.Get("x-forwarded-for"); len(forwardedFor) > 0 {
-			ipAddress := strings.Split(forwardedFor[0], ",")[0] // Get the first IP in case of multiple
-			ipAddress = strings.TrimSpace(ipAddress)
-			clientInfo.IpAddress = ipAddress
-		} else if realIP := md.Get("x-real-ip"); len(realIP) > 0 {
-			clientInfo.IpAddress = realIP[0]
-		}
+		clientInfo.IpAddress = getClientIPFromMetadata(md)
 	}
 
 	return clientInfo
 }
 
+// getClientIPFromMetadata returns the client IP from the X-Forwarded-For or X-Real-IP headers, empty if neither is set.
+func getClientIPFromMetadata(md metadata.MD) string {
+	if forwardedFor := md.Get("x-forwarded-for"); len(forwardedFor) > 0 {
+		// Get the first IP in case of multiple
+		return strings.TrimSpace(strings.Split(forwardedFor[0], ",")[0])
+	} else if realIP := md.Get("x-real-ip"); len(realIP) > 0 {
+		return realIP[0]
+	}
+	return ""
+}
+
 // parseUserAgent extracts device type, OS, and browser information from user agent string.
 func (*APIV1Service) parseUserAgent(userAgent string, clientInfo *storepb.SessionsUserSetting_ClientInfo) {
 	if userAgent == "" {
@@ -0,0 +1,285 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/captcha"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+const (
+	// defaultProofOfWorkDifficulty takes about a second to solve in a browser.
+	defaultProofOfWorkDifficulty = 20
+	// maxProofOfWorkDifficulty keeps the challenges solvable.
+	maxProofOfWorkDifficulty = 32
+	// captchaChallengeLifetime is the time to solve a proof-of-work challenge.
+	captchaChallengeLifetime = 10 * time.Minute
+	// signInFailureWindow is the time after the last failed sign-in of a username or a client until its failures are forgotten.
+	signInFailureWindow = time.Hour
+)
+
+// captchaGuard tracks the failed sign-ins and the solved proof-of-work challenges, in memory.
+// Its zero value is ready to use.
+type captchaGuard struct {
+	mutex sync.Mutex
+	// signInFailures are the failed sign-ins of the usernames and the client IPs, keyed by their kind and value.
+	signInFailures map[string]*signInFailure
+	// solvedChallenges are the expirations of the solved challenges, rejected when replayed.
+	solvedChallenges map[string]time.Time
+}
+
+type signInFailure struct {
+	count    int32
+	lastTime time.Time
+}
+
+// failures returns the number of the recent failed sign-ins of any of the keys.
+func (g *captchaGuard) failures(keys ...string) int32 {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	count := int32(0)
+	for _, key := range keys {
+		if failure, ok := g.signInFailures[key]; ok && time.Since(failure.lastTime) < signInFailureWindow {
+			count = max(count, failure.count)
+		}
+	}
+	return count
+}
+
+// recordFailure records a failed sign-in of each of the keys.
+func (g *captchaGuard) recordFailure(keys ...string) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	if g.signInFailures == nil {
+		g.signInFailures = map[string]*signInFailure{}
+	}
+	now := time.Now()
+	for key, failure := range g.signInFailures {
+		if now.Sub(failure.lastTime) >= signInFailureWindow {
+			delete(g.signInFailures, key)
+		}
+	}
+	for _, key := range keys {
+		failure, ok := g.signInFailures[key]
+		if !ok {
+			failure = &signInFailure{}
+			g.signInFailures[key] = failure
+		}
+		failure.count++
+		failure.lastTime = now
+	}
+}
+
+// resetFailures forgets the failed sign-ins of the key.
+func (g *captchaGuard) resetFailures(key string) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	delete(g.signInFailures, key)
+}
+
+// solve marks the challenge as solved, returning false if it already was.
+func (g *captchaGuard) solve(challenge string) bool {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	if g.solvedChallenges == nil {
+		g.solvedChallenges = map[string]time.Time{}
+	}
+	now := time.Now()
+	for solved, expiresAt := range g.solvedChallenges {
+		if now.After(expiresAt) {
+			delete(g.solvedChallenges, solved)
+		}
+	}
+	if _, ok := g.solvedChallenges[challenge]; ok {
+		return false
+	}
+	// The challenges expire within their lifetime, after which they are rejected anyway.
+	g.solvedChallenges[challenge] = now.Add(captchaChallengeLifetime)
+	return true
+}
+
+func (s *APIV1Service) CreateCaptchaChallenge(ctx context.Context, _ *v1pb.CreateCaptchaChallengeRequest) (*v1pb.CaptchaChallenge, error) {
+	setting, err := s.Store.GetWorkspaceCaptchaSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace captcha setting: %v", err)
+	}
+	if setting.Provider != storepb.WorkspaceCaptchaSetting_PROOF_OF_WORK {
+		return nil, status.Errorf(codes.FailedPrecondition, "proof-of-work captcha is not enabled")
+	}
+	challenge, err := captcha.NewChallenge([]byte(s.Secret), time.Now().Add(captchaChallengeLifetime))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create captcha challenge: %v", err)
+	}
+	return &v1pb.CaptchaChallenge{
+		Challenge:  challenge,
+		Difficulty: int32(getProofOfWorkDifficulty(setting)),
+	}, nil
+}
+
+// checkSignUpCaptcha returns an error unless the CAPTCHA token is valid, if the workspace requires one to sign up.
+func (s *APIV1Service) checkSignUpCaptcha(ctx context.Context, token string) error {
+	setting, err := s.Store.GetWorkspaceCaptchaSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace captcha setting: %v", err)
+	}
+	if !setting.RequireOnSignUp {
+		return nil
+	}
+	return s.verifyCaptcha(ctx, setting, token)
+}
+
+// checkSignInCaptcha returns an error unless the CAPTCHA token is valid, if the sign-ins of the username
+// or the client have failed as many times as the workspace allows without one.
+func (s *APIV1Service) checkSignInCaptcha(ctx context.Context, username, token string) error {
+	setting, err := s.Store.GetWorkspaceCaptchaSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace captcha setting: %v", err)
+	}
+	if setting.SignInFailureThreshold <= 0 || s.getSignInFailures(ctx, signInFailureKeys(ctx, username)) < setting.SignInFailureThreshold {
+		return nil
+	}
+	return s.verifyCaptcha(ctx, setting, token)
+}
+
+// getSignInFailures returns the number of the recent failed sign-ins of any of the keys,
+// on all the instances of the cluster if any.
+func (s *APIV1Service) getSignInFailures(ctx context.Context, keys []string) int32 {
+	if s.Cluster != nil {
+		count := int64(0)
+		for _, key := range keys {
+			failures, err := s.Cluster.GetCounter(ctx, "sign_in_failure:"+key)
+			if err != nil {
+				slog.Warn("failed to get sign-in failures from the cluster", slog.Any("error", err))
+				return s.captchaGuard.failures(keys...)
+			}
+			count = max(count, failures)
+		}
+		return int32(count)
+	}
+	return s.captchaGuard.failures(keys...)
+}
+
+// recordSignInFailure records a failed sign-in of the username and of the client.
+func (s *APIV1Service) recordSignInFailure(ctx context.Context, username string) {
+	keys := signInFailureKeys(ctx, username)
+	s.captchaGuard.recordFailure(keys...)
+	if s.Cluster != nil {
+		for _, key := range keys {
+			if _, err := s.Cluster.Increment(ctx, "sign_in_failure:"+key, signInFailureWindow); err != nil {
+				slog.Warn("failed to record sign-in failure in the cluster", slog.Any("error", err))
+			}
+		}
+	}
+}
+
+// resetSignInFailures forgets the failed sign-ins of the username once its user signs in.
+// The failures of the client are kept, so that signing in to an own account does not reset them.
+func (s *APIV1Service) resetSignInFailures(ctx context.Context, username string) {
+	s.captchaGuard.resetFailures("username:" + username)
+	if s.Cluster != nil {
+		if err := s.Cluster.DeleteCounter(ctx, "sign_in_failure:username:"+username); err != nil {
+			slog.Warn("failed to reset sign-in failures in the cluster", slog.Any("error", err))
+		}
+	}
+}
+
+// solveCaptchaChallenge marks the challenge as solved, returning false if it already was,
+// on any instance of the cluster if any.
+func (s *APIV1Service) solveCaptchaChallenge(ctx context.Context, challenge string) bool {
+	if !s.captchaGuard.solve(challenge) {
+		return false
+	}
+	if s.Cluster != nil {
+		claimed, err := s.Cluster.Claim(ctx, "captcha_challenge:"+challenge, captchaChallengeLifetime)
+		if err != nil {
+			slog.Warn("failed to mark captcha challenge as solved in the cluster", slog.Any("error", err))
+			return true
+		}
+		return claimed
+	}
+	return true
+}
+
+func signInFailureKeys(ctx context.Context, username string) []string {
+	keys := []string{"username:" + username}
+	if clientIP := getClientIP(ctx); clientIP != "" {
+		keys = append(keys, "ip:"+clientIP)
+	}
+	return keys
+}
+
+// verifyCaptcha returns a FailedPrecondition error if the token is missing, so that the client shows the challenge,
+// and an InvalidArgument error if it is invalid.
+func (s *APIV1Service) verifyCaptcha(ctx context.Context, setting *storepb.WorkspaceCaptchaSetting, token string) error {
+	if setting.Provider == storepb.WorkspaceCaptchaSetting_PROVIDER_UNSPECIFIED {
+		return nil
+	}
+	if token == "" {
+		return status.Errorf(codes.FailedPrecondition, "captcha token is required")
+	}
+
+	switch setting.Provider {
+	case storepb.WorkspaceCaptchaSetting_PROOF_OF_WORK:
+		challenge, ok := captcha.VerifySolution([]byte(s.Secret), token, getProofOfWorkDifficulty(setting), time.Now())
+		if !ok || !s.solveCaptchaChallenge(ctx, challenge) {
+			return status.Errorf(codes.InvalidArgument, "invalid captcha token")
+		}
+	default:
+		endpoint := setting.VerifyEndpoint
+		if endpoint == "" {
+			endpoint = captcha.TurnstileEndpoint
+			if setting.Provider == storepb.WorkspaceCaptchaSetting_HCAPTCHA {
+				endpoint = captcha.HCaptchaEndpoint
+			}
+		}
+		ok, err := captcha.NewSiteVerifier(endpoint, setting.SecretKey).Verify(ctx, token, getClientIP(ctx))
+		if err != nil {
+			slog.Error("failed to verify captcha token", slog.Any("err", err))
+			return status.Errorf(codes.Unavailable, "failed to verify captcha token")
+		}
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "invalid captcha token")
+		}
+	}
+	return nil
+}
+
+func getProofOfWorkDifficulty(setting *storepb.WorkspaceCaptchaSetting) int {
+	if setting.ProofOfWorkDifficulty <= 0 {
+		return defaultProofOfWorkDifficulty
+	}
+	return int(setting.ProofOfWorkDifficulty)
+}
+
+func getClientIP(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	return getClientIPFromMetadata(md)
+}
+
+// validateWorkspaceCaptchaSetting returns an InvalidArgument error if the setting cannot verify the tokens of its provider.
+func validateWorkspaceCaptchaSetting(setting *storepb.WorkspaceCaptchaSetting) error {
+	if setting.GetSignInFailureThreshold() < 0 {
+		return status.Errorf(codes.InvalidArgument, "sign-in failure threshold must not be negative")
+	}
+	if setting.GetProofOfWorkDifficulty() < 0 || setting.GetProofOfWorkDifficulty() > maxProofOfWorkDifficulty {
+		return status.Errorf(codes.InvalidArgument, "proof-of-work difficulty must be between 0 and %d", maxProofOfWorkDifficulty)
+	}
+	switch setting.GetProvider() {
+	case storepb.WorkspaceCaptchaSetting_TURNSTILE, storepb.WorkspaceCaptchaSetting_HCAPTCHA:
+		if setting.GetSiteKey() == "" || setting.GetSecretKey() == "" {
+			return status.Errorf(codes.InvalidArgument, "site key and secret key are required")
+		}
+	}
+	return nil
+}
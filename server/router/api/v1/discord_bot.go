@@ -0,0 +1,222 @@
+package v1
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/cron"
+	"github.com/usememos/memos/plugin/discord"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// maxDiscordInteractionSize is the maximum size of the interactions of Discord, far above their actual size.
+	maxDiscordInteractionSize = 1 << 20
+	// discordMemoCommand is the slash command saving memos and linking the accounts, and
+	// discordSaveMessageCommand the command of the context menu of the messages saving them.
+	discordMemoCommand        = "memo"
+	discordSaveMessageCommand = "Save to memos"
+)
+
+// DiscordCommands are the application commands of the bot, handled by the interactions endpoint.
+var DiscordCommands = []*discord.Command{
+	{
+		Name:        discordMemoCommand,
+		Type:        discord.CommandTypeChatInput,
+		Description: "Save memos",
+		Options: []*discord.CommandOption{
+			{
+				Name:        "save",
+				Type:        discord.OptionTypeSubCommand,
+				Description: "Save a memo",
+				Options: []*discord.CommandOption{
+					{Name: "content", Type: discord.OptionTypeString, Description: "The content of the memo", Required: true},
+				},
+			},
+			{
+				Name:        "link",
+				Type:        discord.OptionTypeSubCommand,
+				Description: "Link your memos account",
+				Options: []*discord.CommandOption{
+					{Name: "code", Type: discord.OptionTypeString, Description: "The link code generated in your memos settings", Required: true},
+				},
+			},
+		},
+	},
+	{
+		Name: discordSaveMessageCommand,
+		Type: discord.CommandTypeMessage,
+	},
+}
+
+// RegisterDiscordRoutes registers the interactions endpoint of the Discord application, receiving the commands of the bot.
+func (s *APIV1Service) RegisterDiscordRoutes(echoServer *echo.Echo) {
+	echoServer.POST("/discord/interactions", s.HandleDiscordInteraction)
+}
+
+// HandleDiscordInteraction handles the commands of the bot: "/memo save" creates a memo of its content
+// and "Save to memos" a memo of the message, as the user linked to the Discord account, which "/memo link" links
+// with a code generated by the user. The replies are only shown to the Discord user.
+func (s *APIV1Service) HandleDiscordInteraction(c echo.Context) error {
+	ctx := c.Request().Context()
+	discordSetting, err := s.Store.GetWorkspaceDiscordSetting(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get workspace discord setting").SetInternal(err)
+	}
+	if discordSetting.PublicKey == "" {
+		return echo.NewHTTPError(http.StatusNotFound, "the Discord bot is not enabled")
+	}
+	body, err := io.ReadAll(io.LimitReader(c.Request().Body, maxDiscordInteractionSize))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "failed to read request").SetInternal(err)
+	}
+	if err := discord.Verify(discordSetting.PublicKey, c.Request().Header, body); err != nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	interaction := &discord.Interaction{}
+	if err := json.Unmarshal(body, interaction); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid interaction").SetInternal(err)
+	}
+	if interaction.Type == discord.InteractionTypePing {
+		return c.JSON(http.StatusOK, &discord.InteractionResponse{Type: discord.ResponseTypePong})
+	}
+	if interaction.Type != discord.InteractionTypeApplicationCommand || interaction.Data == nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "unsupported interaction")
+	}
+
+	data := interaction.Data
+	switch {
+	case data.Type == discord.CommandTypeChatInput && data.Name == discordMemoCommand && len(data.Options) > 0:
+		subCommand := data.Options[0]
+		if subCommand.Name == "link" {
+			user, err := s.linkDiscordAccount(ctx, discord.GetString(subCommand.Options, "code"), interaction.UserID())
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "failed to link discord account").SetInternal(err)
+			}
+			if user == nil {
+				return replyDiscordInteraction(c, "The link code is invalid or expired, generate a new one in your memos settings.")
+			}
+			return replyDiscordInteraction(c, fmt.Sprintf("Your Discord account is linked to %s.", user.Username))
+		}
+		return s.replyDiscordSave(c, interaction.UserID(), discord.GetString(subCommand.Options, "content"))
+	case data.Type == discord.CommandTypeMessage && data.Name == discordSaveMessageCommand:
+		if data.Resolved == nil || data.Resolved.Messages[data.TargetID] == nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "missing message")
+		}
+		message := data.Resolved.Messages[data.TargetID]
+		if message.GuildID == "" {
+			message.GuildID = interaction.GuildID
+		}
+		return s.replyDiscordSave(c, interaction.UserID(), getDiscordMessageContent(message))
+	default:
+		return replyDiscordInteraction(c, "Unknown command.")
+	}
+}
+
+// replyDiscordSave saves the content as a memo of the user linked to the Discord account, and replies with its link.
+func (s *APIV1Service) replyDiscordSave(c echo.Context, discordUserID, content string) error {
+	ctx := c.Request().Context()
+	user, err := s.findDiscordUser(ctx, discordUserID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to find discord user").SetInternal(err)
+	}
+	if user == nil {
+		return replyDiscordInteraction(c, fmt.Sprintf("Your Discord account is not linked yet: generate a link code in your memos settings and send `/%s link`.", discordMemoCommand))
+	}
+	memo, err := s.createDiscordMemo(ctx, user, content)
+	if err != nil {
+		if code := status.Code(err); code == codes.InvalidArgument || code == codes.PermissionDenied {
+			return replyDiscordInteraction(c, "Failed to save the memo: "+status.Convert(err).Message())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to create memo").SetInternal(err)
+	}
+	if s.Profile.InstanceURL == "" {
+		return replyDiscordInteraction(c, "Saved the memo.")
+	}
+	return replyDiscordInteraction(c, fmt.Sprintf("Saved the [memo](<%s>).", s.getMemoURL(memo.Name)))
+}
+
+// NewDiscordHandler returns the handler saving the messages the users react to as memos.
+func (s *APIV1Service) NewDiscordHandler() discord.Handler {
+	return &discordHandler{service: s}
+}
+
+type discordHandler struct {
+	service *APIV1Service
+}
+
+func (h *discordHandler) SaveMessage(ctx context.Context, discordUserID string, message *discord.Message) error {
+	user, err := h.service.findDiscordUser(ctx, discordUserID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return discord.ErrUserNotLinked
+	}
+	_, err = h.service.createDiscordMemo(ctx, user, getDiscordMessageContent(message))
+	return err
+}
+
+// createDiscordMemo creates a memo of the content as the user, with their default visibility.
+func (s *APIV1Service) createDiscordMemo(ctx context.Context, user *store.User, content string) (*v1pb.Memo, error) {
+	if strings.TrimSpace(content) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "the memo is empty")
+	}
+	visibility, err := s.getUserDefaultVisibility(ctx, user.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get default visibility")
+	}
+	return s.CreateMemo(context.WithValue(ctx, userIDContextKey, user.ID), &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:    content,
+			Visibility: visibility,
+		},
+	})
+}
+
+// getDiscordMessageContent returns the content of the memo of a message, followed by the link of the message.
+func getDiscordMessageContent(message *discord.Message) string {
+	content := strings.TrimSpace(message.Content)
+	if content == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s\n\n[Discord message](%s)", content, message.Link())
+}
+
+func replyDiscordInteraction(c echo.Context, content string) error {
+	return c.JSON(http.StatusOK, &discord.InteractionResponse{
+		Type: discord.ResponseTypeChannelMessageWithSource,
+		Data: &discord.InteractionResponseData{
+			Content: content,
+			Flags:   discord.MessageFlagEphemeral,
+		},
+	})
+}
+
+// validateWorkspaceDiscordSetting rejects the public keys and the digest schedules the bot could not use.
+func validateWorkspaceDiscordSetting(setting *storepb.WorkspaceDiscordSetting) error {
+	if setting.GetPublicKey() != "" {
+		if key, err := hex.DecodeString(setting.GetPublicKey()); err != nil || len(key) != ed25519.PublicKeySize {
+			return status.Errorf(codes.InvalidArgument, "public key must be the hex public key of the Discord application")
+		}
+	}
+	if setting.GetDigestSchedule() != "" {
+		if _, err := cron.ParseStandard(setting.GetDigestSchedule()); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid digest schedule: %v", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,232 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/filter"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// Helper function to extract user ID and filter macro name from filter macro resource name.
+// Format: users/{user}/filterMacros/{filter_macro}.
+func extractUserAndFilterMacroNameFromName(name string) (int32, string, error) {
+	parts := strings.Split(name, "/")
+	if len(parts) != 4 || parts[0] != "users" || parts[2] != "filterMacros" {
+		return 0, "", errors.Errorf("invalid filter macro name format: %s", name)
+	}
+
+	userID, err := util.ConvertStringToInt32(parts[1])
+	if err != nil {
+		return 0, "", errors.Errorf("invalid user ID %q", parts[1])
+	}
+
+	macroName := parts[3]
+	if macroName == "" {
+		return 0, "", errors.Errorf("empty filter macro ID in name: %s", name)
+	}
+
+	return userID, macroName, nil
+}
+
+// Helper function to construct filter macro resource name.
+func constructFilterMacroName(userID int32, macroName string) string {
+	return fmt.Sprintf("users/%d/filterMacros/%s", userID, macroName)
+}
+
+func (s *APIV1Service) ListFilterMacros(ctx context.Context, request *v1pb.ListFilterMacrosRequest) (*v1pb.ListFilterMacrosResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	filterMacros, err := s.Store.GetUserFilterMacros(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get filter macros: %v", err)
+	}
+	response := &v1pb.ListFilterMacrosResponse{
+		FilterMacros: []*v1pb.FilterMacro{},
+	}
+	for _, filterMacro := range filterMacros {
+		response.FilterMacros = append(response.FilterMacros, convertFilterMacroFromStore(userID, filterMacro))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) CreateFilterMacro(ctx context.Context, request *v1pb.CreateFilterMacroRequest) (*v1pb.FilterMacro, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	if request.FilterMacro == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "filter macro is required")
+	}
+	if err := filter.ValidateMacroName(request.FilterMacroId); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter macro id: %v", err)
+	}
+
+	filterMacros, err := s.Store.GetUserFilterMacros(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get filter macros: %v", err)
+	}
+	if slices.ContainsFunc(filterMacros, func(filterMacro *storepb.FilterMacrosUserSetting_FilterMacro) bool {
+		return filterMacro.Name == request.FilterMacroId
+	}) {
+		return nil, status.Errorf(codes.AlreadyExists, "filter macro %q already exists", request.FilterMacroId)
+	}
+
+	newFilterMacro := &storepb.FilterMacrosUserSetting_FilterMacro{
+		Name:       request.FilterMacroId,
+		Expression: strings.TrimSpace(request.FilterMacro.Expression),
+	}
+	filterMacros = append(filterMacros, newFilterMacro)
+	if err := s.validateFilterMacro(ctx, newFilterMacro, filterMacros); err != nil {
+		return nil, err
+	}
+	if err := s.Store.UpsertUserFilterMacros(ctx, userID, filterMacros); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create filter macro: %v", err)
+	}
+	return convertFilterMacroFromStore(userID, newFilterMacro), nil
+}
+
+func (s *APIV1Service) UpdateFilterMacro(ctx context.Context, request *v1pb.UpdateFilterMacroRequest) (*v1pb.FilterMacro, error) {
+	if request.FilterMacro == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "filter macro is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	userID, macroName, err := extractUserAndFilterMacroNameFromName(request.FilterMacro.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter macro name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	filterMacros, err := s.Store.GetUserFilterMacros(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get filter macros: %v", err)
+	}
+	index := slices.IndexFunc(filterMacros, func(filterMacro *storepb.FilterMacrosUserSetting_FilterMacro) bool {
+		return filterMacro.Name == macroName
+	})
+	if index < 0 {
+		return nil, status.Errorf(codes.NotFound, "filter macro not found")
+	}
+
+	filterMacro := filterMacros[index]
+	for _, field := range request.UpdateMask.Paths {
+		switch field {
+		case "expression":
+			filterMacro.Expression = strings.TrimSpace(request.FilterMacro.Expression)
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update field: %s", field)
+		}
+	}
+	if err := s.validateFilterMacro(ctx, filterMacro, filterMacros); err != nil {
+		return nil, err
+	}
+	if err := s.Store.UpsertUserFilterMacros(ctx, userID, filterMacros); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update filter macro: %v", err)
+	}
+	return convertFilterMacroFromStore(userID, filterMacro), nil
+}
+
+func (s *APIV1Service) DeleteFilterMacro(ctx context.Context, request *v1pb.DeleteFilterMacroRequest) (*emptypb.Empty, error) {
+	userID, macroName, err := extractUserAndFilterMacroNameFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter macro name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	filterMacros, err := s.Store.GetUserFilterMacros(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get filter macros: %v", err)
+	}
+	newFilterMacros := slices.DeleteFunc(slices.Clone(filterMacros), func(filterMacro *storepb.FilterMacrosUserSetting_FilterMacro) bool {
+		return filterMacro.Name == macroName
+	})
+	if len(newFilterMacros) == len(filterMacros) {
+		return nil, status.Errorf(codes.NotFound, "filter macro not found")
+	}
+	if err := s.Store.UpsertUserFilterMacros(ctx, userID, newFilterMacros); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete filter macro: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// validateFilterMacro checks that the macro expands to a valid filter among the macros of the user.
+func (s *APIV1Service) validateFilterMacro(ctx context.Context, filterMacro *storepb.FilterMacrosUserSetting_FilterMacro, filterMacros []*storepb.FilterMacrosUserSetting_FilterMacro) error {
+	if filterMacro.Expression == "" {
+		return status.Errorf(codes.InvalidArgument, "expression is required")
+	}
+	expanded, err := filter.ExpandMacros(filterMacro.Name, convertFilterMacrosToMap(filterMacros))
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid expression: %v", err)
+	}
+	if err := s.validateExpandedFilter(ctx, expanded); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid expression: %v", err)
+	}
+	return nil
+}
+
+// expandFilterMacros replaces the filter macros of the current user in the filter.
+// Filters of anonymous users are returned unchanged.
+func (s *APIV1Service) expandFilterMacros(ctx context.Context, filterStr string) (string, error) {
+	if filterStr == "" {
+		return filterStr, nil
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get current user")
+	}
+	if currentUser == nil {
+		return filterStr, nil
+	}
+	return s.expandUserFilterMacros(ctx, currentUser.ID, filterStr)
+}
+
+// expandUserFilterMacros replaces the filter macros of the user in the filter.
+func (s *APIV1Service) expandUserFilterMacros(ctx context.Context, userID int32, filterStr string) (string, error) {
+	if filterStr == "" {
+		return filterStr, nil
+	}
+	filterMacros, err := s.Store.GetUserFilterMacros(ctx, userID)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get filter macros")
+	}
+	return filter.ExpandMacros(filterStr, convertFilterMacrosToMap(filterMacros))
+}
+
+func convertFilterMacrosToMap(filterMacros []*storepb.FilterMacrosUserSetting_FilterMacro) map[string]string {
+	macros := map[string]string{}
+	for _, filterMacro := range filterMacros {
+		macros[filterMacro.Name] = filterMacro.Expression
+	}
+	return macros
+}
+
+func convertFilterMacroFromStore(userID int32, filterMacro *storepb.FilterMacrosUserSetting_FilterMacro) *v1pb.FilterMacro {
+	return &v1pb.FilterMacro{
+		Name:       constructFilterMacroName(userID, filterMacro.Name),
+		Expression: filterMacro.Expression,
+	}
+}
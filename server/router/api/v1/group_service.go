@@ -0,0 +1,289 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListGroups(ctx context.Context, _ *v1pb.ListGroupsRequest) (*v1pb.ListGroupsResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	userGroups, err := s.Store.ListUserGroups(ctx, &store.FindUserGroup{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list groups: %v", err)
+	}
+	response := &v1pb.ListGroupsResponse{
+		Groups: []*v1pb.Group{},
+	}
+	for _, userGroup := range userGroups {
+		groupMessage, err := s.convertGroupFromStore(ctx, userGroup)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert group: %v", err)
+		}
+		response.Groups = append(response.Groups, groupMessage)
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetGroup(ctx context.Context, request *v1pb.GetGroupRequest) (*v1pb.Group, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	userGroup, err := s.getUserGroup(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	groupMessage, err := s.convertGroupFromStore(ctx, userGroup)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert group: %v", err)
+	}
+	return groupMessage, nil
+}
+
+func (s *APIV1Service) CreateGroup(ctx context.Context, request *v1pb.CreateGroupRequest) (*v1pb.Group, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if err := s.checkPermission(ctx, user, storepb.Permission_MANAGE_USERS); err != nil {
+		return nil, err
+	}
+	if request.Group == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "group is required")
+	}
+	displayName := strings.TrimSpace(request.Group.DisplayName)
+	if displayName == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "display name is required")
+	}
+	if err := s.checkUserGroupNameAvailable(ctx, displayName, nil); err != nil {
+		return nil, err
+	}
+	memberIDs, err := s.getGroupMemberIDs(ctx, request.Group.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	userGroup, err := s.Store.CreateUserGroup(ctx, &store.UserGroup{
+		Name:        displayName,
+		Description: request.Group.Description,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create group: %v", err)
+	}
+	if err := s.Store.SetUserGroupMembers(ctx, userGroup.ID, memberIDs); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set group members: %v", err)
+	}
+	groupMessage, err := s.convertGroupFromStore(ctx, userGroup)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert group: %v", err)
+	}
+	return groupMessage, nil
+}
+
+func (s *APIV1Service) UpdateGroup(ctx context.Context, request *v1pb.UpdateGroupRequest) (*v1pb.Group, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if err := s.checkPermission(ctx, user, storepb.Permission_MANAGE_USERS); err != nil {
+		return nil, err
+	}
+	if request.Group == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "group is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	userGroup, err := s.getUserGroup(ctx, request.Group.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedTs := time.Now().Unix()
+	update := &store.UpdateUserGroup{
+		ID:        userGroup.ID,
+		UpdatedTs: &updatedTs,
+	}
+	var memberIDs []int32
+	for _, field := range request.UpdateMask.Paths {
+		switch field {
+		case "display_name":
+			displayName := strings.TrimSpace(request.Group.DisplayName)
+			if displayName == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "display name is required")
+			}
+			if err := s.checkUserGroupNameAvailable(ctx, displayName, &userGroup.ID); err != nil {
+				return nil, err
+			}
+			update.Name = &displayName
+		case "description":
+			update.Description = &request.Group.Description
+		case "members":
+			memberIDs, err = s.getGroupMemberIDs(ctx, request.Group.Members)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update field: %s", field)
+		}
+	}
+	if err := s.Store.UpdateUserGroup(ctx, update); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update group: %v", err)
+	}
+	if memberIDs != nil {
+		if err := s.Store.SetUserGroupMembers(ctx, userGroup.ID, memberIDs); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to set group members: %v", err)
+		}
+	}
+	userGroup, err = s.Store.GetUserGroup(ctx, &store.FindUserGroup{ID: &userGroup.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get group: %v", err)
+	}
+	groupMessage, err := s.convertGroupFromStore(ctx, userGroup)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert group: %v", err)
+	}
+	return groupMessage, nil
+}
+
+func (s *APIV1Service) DeleteGroup(ctx context.Context, request *v1pb.DeleteGroupRequest) (*emptypb.Empty, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if err := s.checkPermission(ctx, user, storepb.Permission_MANAGE_USERS); err != nil {
+		return nil, err
+	}
+	userGroup, err := s.getUserGroup(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	// The GROUP memos of the group become readable to their creators only.
+	if err := s.Store.DeleteUserGroup(ctx, &store.DeleteUserGroup{ID: userGroup.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete group: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) getUserGroup(ctx context.Context, name string) (*store.UserGroup, error) {
+	groupID, err := ExtractGroupIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid group name: %v", err)
+	}
+	userGroup, err := s.Store.GetUserGroup(ctx, &store.FindUserGroup{ID: &groupID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get group: %v", err)
+	}
+	if userGroup == nil {
+		return nil, status.Errorf(codes.NotFound, "group not found")
+	}
+	return userGroup, nil
+}
+
+// checkUserGroupNameAvailable returns an error if another group than the excluded one already has the name.
+func (s *APIV1Service) checkUserGroupNameAvailable(ctx context.Context, name string, excludedID *int32) error {
+	existing, err := s.Store.GetUserGroup(ctx, &store.FindUserGroup{Name: &name})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get group: %v", err)
+	}
+	if existing != nil && (excludedID == nil || existing.ID != *excludedID) {
+		return status.Errorf(codes.AlreadyExists, "group %s already exists", name)
+	}
+	return nil
+}
+
+// getGroupMemberIDs returns the ids of the users of the member names, or an error if any of them does not exist.
+func (s *APIV1Service) getGroupMemberIDs(ctx context.Context, members []string) ([]int32, error) {
+	memberIDs := []int32{}
+	for _, member := range members {
+		userID, err := ExtractUserIDFromName(member)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+		}
+		user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+		}
+		if user == nil {
+			return nil, status.Errorf(codes.NotFound, "user %s not found", member)
+		}
+		memberIDs = append(memberIDs, user.ID)
+	}
+	return memberIDs, nil
+}
+
+// getMemoGroupIDs returns the ids of the groups of the group names, or an error if any of them does not exist.
+func (s *APIV1Service) getMemoGroupIDs(ctx context.Context, groups []string) ([]int32, error) {
+	groupIDs := []int32{}
+	for _, group := range groups {
+		userGroup, err := s.getUserGroup(ctx, group)
+		if err != nil {
+			return nil, err
+		}
+		groupIDs = append(groupIDs, userGroup.ID)
+	}
+	return groupIDs, nil
+}
+
+// canReadMemoViaGroup returns whether the user is a member of a selected group of the GROUP memo.
+func (s *APIV1Service) canReadMemoViaGroup(ctx context.Context, userID int32, memo *store.Memo) (bool, error) {
+	if memo.Visibility != store.Group {
+		return false, nil
+	}
+	memoGroups, err := s.Store.ListMemoGroups(ctx, &store.FindMemoGroup{MemoID: &memo.ID})
+	if err != nil {
+		return false, err
+	}
+	for _, memoGroup := range memoGroups {
+		members, err := s.Store.ListUserGroupMembers(ctx, &store.FindUserGroupMember{
+			GroupID: &memoGroup.GroupID,
+			UserID:  &userID,
+		})
+		if err != nil {
+			return false, err
+		}
+		if len(members) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *APIV1Service) convertGroupFromStore(ctx context.Context, userGroup *store.UserGroup) (*v1pb.Group, error) {
+	members, err := s.Store.ListUserGroupMembers(ctx, &store.FindUserGroupMember{GroupID: &userGroup.ID})
+	if err != nil {
+		return nil, err
+	}
+	groupMessage := &v1pb.Group{
+		Name:        fmt.Sprintf("%s%d", GroupNamePrefix, userGroup.ID),
+		DisplayName: userGroup.Name,
+		Description: userGroup.Description,
+		Members:     []string{},
+		CreateTime:  timestamppb.New(time.Unix(userGroup.CreatedTs, 0)),
+		UpdateTime:  timestamppb.New(time.Unix(userGroup.UpdatedTs, 0)),
+	}
+	for _, member := range members {
+		groupMessage.Members = append(groupMessage.Members, fmt.Sprintf("%s%d", UserNamePrefix, member.UserID))
+	}
+	return groupMessage, nil
+}
@@ -38,8 +38,16 @@ func (s *APIV1Service) ListIdentityProviders(ctx context.Context, _ *v1pb.ListId
 	response := &v1pb.ListIdentityProvidersResponse{
 		IdentityProviders: []*v1pb.IdentityProvider{},
 	}
+	isHost, err := s.isCurrentUserHost(ctx)
+	if err != nil {
+		return nil, err
+	}
 	for _, identityProvider := range identityProviders {
-		response.IdentityProviders = append(response.IdentityProviders, convertIdentityProviderFromStore(identityProvider))
+		identityProviderMessage := convertIdentityProviderFromStore(identityProvider)
+		if !isHost {
+			clearIdentityProviderBindPassword(identityProviderMessage)
+		}
+		response.IdentityProviders = append(response.IdentityProviders, identityProviderMessage)
 	}
 	return response, nil
 }
@@ -58,7 +66,15 @@ func (s *APIV1Service) GetIdentityProvider(ctx context.Context, request *v1pb.Ge
 	if identityProvider == nil {
 		return nil, status.Errorf(codes.NotFound, "identity provider not found")
 	}
-	return convertIdentityProviderFromStore(identityProvider), nil
+	isHost, err := s.isCurrentUserHost(ctx)
+	if err != nil {
+		return nil, err
+	}
+	identityProviderMessage := convertIdentityProviderFromStore(identityProvider)
+	if !isHost {
+		clearIdentityProviderBindPassword(identityProviderMessage)
+	}
+	return identityProviderMessage, nil
 }
 
 func (s *APIV1Service) UpdateIdentityProvider(ctx context.Context, request *v1pb.UpdateIdentityProviderRequest) (*v1pb.IdentityProvider, error) {
@@ -106,6 +122,19 @@ func (s *APIV1Service) DeleteIdentityProvider(ctx context.Context, request *v1pb
 	if identityProvider == nil {
 		return nil, status.Errorf(codes.NotFound, "identity provider not found")
 	}
+	workspaceGeneralSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace general setting: %v", err)
+	}
+	if workspaceGeneralSetting.SsoOnly {
+		identityProviders, err := s.Store.ListIdentityProviders(ctx, &store.FindIdentityProvider{})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list identity providers: %v", err)
+		}
+		if len(identityProviders) <= 1 {
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot delete the last identity provider in sso-only mode")
+		}
+	}
 
 	if err := s.Store.DeleteIdentityProvider(ctx, &store.DeleteIdentityProvider{ID: id}); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete identity provider, error: %+v", err)
@@ -113,6 +142,36 @@ func (s *APIV1Service) DeleteIdentityProvider(ctx context.Context, request *v1pb
 	return &emptypb.Empty{}, nil
 }
 
+// checkIdentityProviderConfigured returns a FailedPrecondition error if no identity provider is configured,
+// so that the sso-only mode cannot lock the users out.
+func (s *APIV1Service) checkIdentityProviderConfigured(ctx context.Context) error {
+	identityProviders, err := s.Store.ListIdentityProviders(ctx, &store.FindIdentityProvider{})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list identity providers: %v", err)
+	}
+	if len(identityProviders) == 0 {
+		return status.Errorf(codes.FailedPrecondition, "configure an identity provider before enabling the sso-only mode")
+	}
+	return nil
+}
+
+// isCurrentUserHost returns whether the current user is the host, who manages the identity providers.
+func (s *APIV1Service) isCurrentUserHost(ctx context.Context) (bool, error) {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	return currentUser != nil && currentUser.Role == store.RoleHost, nil
+}
+
+// clearIdentityProviderBindPassword clears the password of the LDAP service account,
+// as the identity providers are listed to everyone on the sign in page.
+func clearIdentityProviderBindPassword(identityProvider *v1pb.IdentityProvider) {
+	if ldapConfig := identityProvider.GetConfig().GetLdapConfig(); ldapConfig != nil {
+		ldapConfig.BindPassword = ""
+	}
+}
+
 func convertIdentityProviderFromStore(identityProvider *storepb.IdentityProvider) *v1pb.IdentityProvider {
 	temp := &v1pb.IdentityProvider{
 		Name:             fmt.Sprintf("%s%d", IdentityProviderNamePrefix, identityProvider.Id),
@@ -136,6 +195,31 @@ func convertIdentityProviderFromStore(identityProvider *storepb.IdentityProvider
 						DisplayName: oauth2Config.FieldMapping.DisplayName,
 						Email:       oauth2Config.FieldMapping.Email,
 						AvatarUrl:   oauth2Config.FieldMapping.AvatarUrl,
+						Groups:      oauth2Config.FieldMapping.Groups,
+					},
+					GroupRoleMappings:       convertGroupRoleMappingsFromStore(oauth2Config.GroupRoleMappings),
+					GroupMembershipMappings: convertGroupMembershipMappingsFromStore(oauth2Config.GroupMembershipMappings),
+				},
+			},
+		}
+	} else if identityProvider.Type == storepb.IdentityProvider_LDAP {
+		ldapConfig := identityProvider.Config.GetLdapConfig()
+		temp.Config = &v1pb.IdentityProviderConfig{
+			Config: &v1pb.IdentityProviderConfig_LdapConfig{
+				LdapConfig: &v1pb.LDAPConfig{
+					Url:                ldapConfig.Url,
+					BindDn:             ldapConfig.BindDn,
+					BindPassword:       ldapConfig.BindPassword,
+					BaseDn:             ldapConfig.BaseDn,
+					UserFilter:         ldapConfig.UserFilter,
+					StartTls:           ldapConfig.StartTls,
+					InsecureSkipVerify: ldapConfig.InsecureSkipVerify,
+					FieldMapping: &v1pb.FieldMapping{
+						Identifier:  ldapConfig.GetFieldMapping().GetIdentifier(),
+						DisplayName: ldapConfig.GetFieldMapping().GetDisplayName(),
+						Email:       ldapConfig.GetFieldMapping().GetEmail(),
+						AvatarUrl:   ldapConfig.GetFieldMapping().GetAvatarUrl(),
+						Groups:      ldapConfig.GetFieldMapping().GetGroups(),
 					},
 				},
 			},
@@ -174,6 +258,31 @@ func convertIdentityProviderConfigToStore(identityProviderType v1pb.IdentityProv
 						DisplayName: oauth2Config.FieldMapping.DisplayName,
 						Email:       oauth2Config.FieldMapping.Email,
 						AvatarUrl:   oauth2Config.FieldMapping.AvatarUrl,
+						Groups:      oauth2Config.FieldMapping.Groups,
+					},
+					GroupRoleMappings:       convertGroupRoleMappingsToStore(oauth2Config.GroupRoleMappings),
+					GroupMembershipMappings: convertGroupMembershipMappingsToStore(oauth2Config.GroupMembershipMappings),
+				},
+			},
+		}
+	} else if identityProviderType == v1pb.IdentityProvider_LDAP {
+		ldapConfig := config.GetLdapConfig()
+		return &storepb.IdentityProviderConfig{
+			Config: &storepb.IdentityProviderConfig_LdapConfig{
+				LdapConfig: &storepb.LDAPConfig{
+					Url:                ldapConfig.GetUrl(),
+					BindDn:             ldapConfig.GetBindDn(),
+					BindPassword:       ldapConfig.GetBindPassword(),
+					BaseDn:             ldapConfig.GetBaseDn(),
+					UserFilter:         ldapConfig.GetUserFilter(),
+					StartTls:           ldapConfig.GetStartTls(),
+					InsecureSkipVerify: ldapConfig.GetInsecureSkipVerify(),
+					FieldMapping: &storepb.FieldMapping{
+						Identifier:  ldapConfig.GetFieldMapping().GetIdentifier(),
+						DisplayName: ldapConfig.GetFieldMapping().GetDisplayName(),
+						Email:       ldapConfig.GetFieldMapping().GetEmail(),
+						AvatarUrl:   ldapConfig.GetFieldMapping().GetAvatarUrl(),
+						Groups:      ldapConfig.GetFieldMapping().GetGroups(),
 					},
 				},
 			},
@@ -181,3 +290,51 @@ func convertIdentityProviderConfigToStore(identityProviderType v1pb.IdentityProv
 	}
 	return nil
 }
+
+func convertGroupRoleMappingsFromStore(groupRoleMappings []*storepb.GroupRoleMapping) []*v1pb.GroupRoleMapping {
+	mappings := []*v1pb.GroupRoleMapping{}
+	for _, groupRoleMapping := range groupRoleMappings {
+		mappings = append(mappings, &v1pb.GroupRoleMapping{
+			Group: groupRoleMapping.Group,
+			Role:  convertUserRoleFromStore(store.Role(groupRoleMapping.Role)),
+		})
+	}
+	return mappings
+}
+
+func convertGroupRoleMappingsToStore(groupRoleMappings []*v1pb.GroupRoleMapping) []*storepb.GroupRoleMapping {
+	mappings := []*storepb.GroupRoleMapping{}
+	for _, groupRoleMapping := range groupRoleMappings {
+		mappings = append(mappings, &storepb.GroupRoleMapping{
+			Group: groupRoleMapping.Group,
+			Role:  convertUserRoleToStore(groupRoleMapping.Role).String(),
+		})
+	}
+	return mappings
+}
+
+func convertGroupMembershipMappingsFromStore(groupMembershipMappings []*storepb.GroupMembershipMapping) []*v1pb.GroupMembershipMapping {
+	mappings := []*v1pb.GroupMembershipMapping{}
+	for _, groupMembershipMapping := range groupMembershipMappings {
+		mappings = append(mappings, &v1pb.GroupMembershipMapping{
+			Group:     groupMembershipMapping.Group,
+			UserGroup: fmt.Sprintf("%s%d", GroupNamePrefix, groupMembershipMapping.UserGroupId),
+		})
+	}
+	return mappings
+}
+
+func convertGroupMembershipMappingsToStore(groupMembershipMappings []*v1pb.GroupMembershipMapping) []*storepb.GroupMembershipMapping {
+	mappings := []*storepb.GroupMembershipMapping{}
+	for _, groupMembershipMapping := range groupMembershipMappings {
+		userGroupID, err := ExtractGroupIDFromName(groupMembershipMapping.UserGroup)
+		if err != nil {
+			continue
+		}
+		mappings = append(mappings, &storepb.GroupMembershipMapping{
+			Group:       groupMembershipMapping.Group,
+			UserGroupId: userGroupID,
+		})
+	}
+	return mappings
+}
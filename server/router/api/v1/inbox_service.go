@@ -11,6 +11,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
@@ -32,9 +33,9 @@ func (s *APIV1Service) ListInboxes(ctx context.Context, request *v1pb.ListInboxe
 
 	// Check if current user can access the requested user's inboxes
 	if currentUser.ID != userID {
-		// Only allow hosts and admins to access other users' inboxes
-		if currentUser.Role != store.RoleHost && currentUser.Role != store.RoleAdmin {
-			return nil, status.Errorf(codes.PermissionDenied, "cannot access inboxes for user %q", request.Parent)
+		// Only allow the user managers to access other users' inboxes
+		if err := s.checkPermission(ctx, currentUser, storepb.Permission_MANAGE_USERS); err != nil {
+			return nil, status.Errorf(status.Code(err), "cannot access inboxes for user %q", request.Parent)
 		}
 	}
 
@@ -191,6 +192,63 @@ func (s *APIV1Service) DeleteInbox(ctx context.Context, request *v1pb.DeleteInbo
 	return &emptypb.Empty{}, nil
 }
 
+func (s *APIV1Service) MarkAllInboxRead(ctx context.Context, request *v1pb.MarkAllInboxReadRequest) (*v1pb.MarkAllInboxReadResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent name %q: %v", request.Parent, err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	count, err := s.Store.UpdateInboxes(ctx, &store.UpdateInboxes{
+		ReceiverID: userID,
+		FromStatus: store.UNREAD,
+		Status:     store.ARCHIVED,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update inboxes: %v", err)
+	}
+	return &v1pb.MarkAllInboxReadResponse{UpdatedCount: int32(count)}, nil
+}
+
+func (s *APIV1Service) BatchDeleteInboxes(ctx context.Context, request *v1pb.BatchDeleteInboxesRequest) (*v1pb.BatchDeleteInboxesResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent name %q: %v", request.Parent, err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	if len(request.Names) == 0 && request.Status == v1pb.Inbox_STATUS_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "names or status is required")
+	}
+	if len(request.Names) > MaxPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d inboxes can be deleted at once", MaxPageSize)
+	}
+
+	// Only the inboxes received by the user are deleted, whatever the names.
+	delete := &store.DeleteInboxes{
+		ReceiverID: &userID,
+	}
+	for _, name := range request.Names {
+		inboxID, err := ExtractInboxIDFromName(name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid inbox name %q: %v", name, err)
+		}
+		delete.IDList = append(delete.IDList, inboxID)
+	}
+	if request.Status != v1pb.Inbox_STATUS_UNSPECIFIED {
+		inboxStatus := convertInboxStatusToStore(request.Status)
+		delete.Status = &inboxStatus
+	}
+	count, err := s.Store.DeleteInboxes(ctx, delete)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete inboxes: %v", err)
+	}
+	return &v1pb.BatchDeleteInboxesResponse{DeletedCount: int32(count)}, nil
+}
+
 func convertInboxFromStore(inbox *store.Inbox) *v1pb.Inbox {
 	return &v1pb.Inbox{
 		Name:       fmt.Sprintf("%s%d", InboxNamePrefix, inbox.ID),
@@ -200,6 +258,7 @@ func convertInboxFromStore(inbox *store.Inbox) *v1pb.Inbox {
 		CreateTime: timestamppb.New(time.Unix(inbox.CreatedTs, 0)),
 		Type:       v1pb.Inbox_Type(inbox.Message.Type),
 		ActivityId: inbox.Message.ActivityId,
+		Count:      max(inbox.Message.Count, 1),
 	}
 }
 
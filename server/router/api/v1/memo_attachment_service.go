@@ -1,15 +1,22 @@
 package v1
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
+	"fmt"
+	"path"
 	"slices"
+	"strings"
 	"time"
 
+	"google.golang.org/genproto/googleapis/api/httpbody"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
@@ -100,3 +107,89 @@ func (s *APIV1Service) ListMemoAttachments(ctx context.Context, request *v1pb.Li
 	}
 	return response, nil
 }
+
+func (s *APIV1Service) GetMemoAttachmentsArchive(ctx context.Context, request *v1pb.GetMemoAttachmentsArchiveRequest) (*httpbody.HttpBody, error) {
+	memoUID, err := ExtractMemoUIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if memo.Visibility != store.Public {
+		user, err := s.GetCurrentUser(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user")
+		}
+		if user == nil {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+		if (memo.Visibility == store.Private || memo.Visibility == store.Group) && memo.CreatorID != user.ID {
+			if err := s.checkMemoSharedWith(ctx, user.ID, memo); err != nil {
+				return nil, err
+			}
+		}
+	}
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{
+		GetBlob: true,
+		MemoID:  &memo.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list attachments: %v", err)
+	}
+
+	var buffer bytes.Buffer
+	writer := zip.NewWriter(&buffer)
+	filenames := map[string]bool{}
+	for _, attachment := range attachments {
+		// The content of external links and of S3 or GCS objects is not stored by the server, but served by their links.
+		switch attachment.StorageType {
+		case storepb.AttachmentStorageType_EXTERNAL, storepb.AttachmentStorageType_S3, storepb.AttachmentStorageType_GCS:
+			continue
+		}
+		blob, err := s.GetAttachmentBlob(attachment)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get attachment blob: %v", err)
+		}
+		file, err := writer.CreateHeader(&zip.FileHeader{
+			Name:     getArchiveFilename(attachment, filenames),
+			Method:   zip.Deflate,
+			Modified: time.Unix(attachment.UpdatedTs, 0),
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create archive file: %v", err)
+		}
+		if _, err := file.Write(blob); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to write archive file: %v", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to close archive: %v", err)
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: "application/zip",
+		Data:        buffer.Bytes(),
+	}, nil
+}
+
+// getArchiveFilename returns the name of the attachment in the archive, which is its filename without directories,
+// numbered like "photo (2).jpg" when another attachment of the archive has the same filename.
+func getArchiveFilename(attachment *store.Attachment, filenames map[string]bool) string {
+	filename := path.Base(strings.ReplaceAll(attachment.Filename, "\\", "/"))
+	if filename == "." || filename == "/" || filename == ".." {
+		filename = attachment.UID
+	}
+	ext := path.Ext(filename)
+	base := strings.TrimSuffix(filename, ext)
+	// The filenames are compared case-insensitively, as they are extracted on case-insensitive file systems.
+	for i := 2; filenames[strings.ToLower(filename)]; i++ {
+		filename = fmt.Sprintf("%s (%d)%s", base, i, ext)
+	}
+	filenames[strings.ToLower(filename)] = true
+	return filename
+}
@@ -0,0 +1,107 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// defaultMemoChangePageSize is the default number of changes listed by a call.
+	defaultMemoChangePageSize = 100
+	// maxMemoChangePageSize is the maximum number of changes listed by a call.
+	maxMemoChangePageSize = 1000
+)
+
+func (s *APIV1Service) ListMemoChanges(ctx context.Context, request *v1pb.ListMemoChangesRequest) (*v1pb.ListMemoChangesResponse, error) {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	pageSize := int(request.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultMemoChangePageSize
+	}
+	pageSize = min(pageSize, maxMemoChangePageSize)
+
+	find := &store.FindMemoChange{CreatorID: &currentUser.ID}
+	if request.Cursor != "" {
+		cursor, err := strconv.ParseInt(request.Cursor, 10, 32)
+		if err != nil || cursor <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cursor %q", request.Cursor)
+		}
+		changeID := int32(cursor)
+		// The cursor is the last change listed, which is gone once expired, so the changes since may be missing.
+		change, err := s.Store.GetMemoChange(ctx, &store.FindMemoChange{ID: &changeID, CreatorID: &currentUser.ID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo change: %v", err)
+		}
+		if change == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "the cursor has expired, the memos must be listed again")
+		}
+		find.IDAfter = &changeID
+	}
+	limit := pageSize + 1
+	find.Limit = &limit
+	changes, err := s.Store.ListMemoChanges(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo changes: %v", err)
+	}
+
+	response := &v1pb.ListMemoChangesResponse{
+		Changes:    []*v1pb.MemoChange{},
+		NextCursor: request.Cursor,
+		HasMore:    len(changes) > pageSize,
+	}
+	changes = changes[:min(len(changes), pageSize)]
+	// The current state of each memo is only looked up once per call.
+	memos := map[int32]*v1pb.Memo{}
+	for _, change := range changes {
+		memo, ok := memos[change.MemoID]
+		if !ok {
+			storeMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &change.MemoID})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+			}
+			if storeMemo != nil {
+				memo, err = s.convertMemoFromStore(ctx, storeMemo)
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to convert memo: %v", err)
+				}
+			}
+			memos[change.MemoID] = memo
+		}
+		response.Changes = append(response.Changes, &v1pb.MemoChange{
+			Memo:       fmt.Sprintf("%s%s", MemoNamePrefix, change.MemoUID),
+			Type:       convertMemoChangeTypeFromStore(change.Type),
+			ChangeTime: timestamppb.New(time.Unix(change.CreatedTs, 0)),
+			MemoData:   memo,
+		})
+		response.NextCursor = strconv.FormatInt(int64(change.ID), 10)
+	}
+	return response, nil
+}
+
+func convertMemoChangeTypeFromStore(changeType store.MemoChangeType) v1pb.MemoChange_Type {
+	switch changeType {
+	case store.MemoChangeCreated:
+		return v1pb.MemoChange_CREATED
+	case store.MemoChangeUpdated:
+		return v1pb.MemoChange_UPDATED
+	case store.MemoChangeDeleted:
+		return v1pb.MemoChange_DELETED
+	default:
+		return v1pb.MemoChange_TYPE_UNSPECIFIED
+	}
+}
@@ -28,9 +28,19 @@ const (
 type ExportData struct {
 	Version    string       `json:"version"`
 	ExportedAt time.Time    `json:"exported_at"`
+	User       *ExportUser  `json:"user,omitempty"`
 	Memos      []ExportMemo `json:"memos"`
 }
 
+// ExportUser represents the profile of the user in the final export of their deleted account
+type ExportUser struct {
+	Username    string    `json:"username"`
+	Nickname    string    `json:"nickname,omitempty"`
+	Email       string    `json:"email,omitempty"`
+	Description string    `json:"description,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
 // ExportMemo represents a memo in the export format
 type ExportMemo struct {
 	UID         string               `json:"uid"`
@@ -91,8 +101,11 @@ func (s *APIV1Service) ExportMemos(ctx context.Context, request *v1pb.ExportMemo
 
 	// Apply filters if specified
 	if request.Filter != "" {
-		// Use existing filter validation from shortcut service
-		memoFind.Filter = &request.Filter
+		filter, err := s.expandFilterMacros(ctx, request.Filter)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		memoFind.Filter = &filter
 	}
 
 	// Include archived memos if requested
@@ -107,15 +120,7 @@ func (s *APIV1Service) ExportMemos(ctx context.Context, request *v1pb.ExportMemo
 	}
 
 	// Convert memos to export format
-	exportMemos := make([]ExportMemo, 0, len(memos))
-	for _, memo := range memos {
-		exportMemo, err := s.convertMemoToExport(ctx, memo, request.IncludeAttachments, request.IncludeRelations)
-		if err != nil {
-			slog.Warn("Failed to convert memo to export format", slog.Any("memo_id", memo.ID), slog.Any("error", err))
-			continue
-		}
-		exportMemos = append(exportMemos, *exportMemo)
-	}
+	exportMemos := s.convertMemosToExport(ctx, memos, request.IncludeAttachments, request.IncludeRelations)
 
 	// Create export data structure
 	exportData := &ExportData{
@@ -206,6 +211,9 @@ func (s *APIV1Service) ImportMemos(ctx context.Context, request *v1pb.ImportMemo
 		}
 	}
 
+	if createdCount+updatedCount > 0 {
+		s.memoSuggester.invalidate()
+	}
 	duration := time.Since(startTime)
 
 	summary := &v1pb.ImportSummary{
@@ -227,6 +235,20 @@ func (s *APIV1Service) ImportMemos(ctx context.Context, request *v1pb.ImportMemo
 	}, nil
 }
 
+// convertMemosToExport converts the memos to the export format, skipping the memos failing to convert.
+func (s *APIV1Service) convertMemosToExport(ctx context.Context, memos []*store.Memo, includeAttachments, includeRelations bool) []ExportMemo {
+	exportMemos := make([]ExportMemo, 0, len(memos))
+	for _, memo := range memos {
+		exportMemo, err := s.convertMemoToExport(ctx, memo, includeAttachments, includeRelations)
+		if err != nil {
+			slog.Warn("Failed to convert memo to export format", slog.Any("memo_id", memo.ID), slog.Any("error", err))
+			continue
+		}
+		exportMemos = append(exportMemos, *exportMemo)
+	}
+	return exportMemos
+}
+
 // convertMemoToExport converts a store memo to export format
 func (s *APIV1Service) convertMemoToExport(ctx context.Context, memo *store.Memo, includeAttachments, includeRelations bool) (*ExportMemo, error) {
 	exportMemo := &ExportMemo{
@@ -334,6 +356,9 @@ func (s *APIV1Service) importSingleMemo(ctx context.Context, userID int32, expor
 		visibility = store.Protected
 	case "PRIVATE":
 		visibility = store.Private
+	case "GROUP":
+		// The groups of the memo are not exported, so that the imported memo is readable to its creator only.
+		visibility = store.Private
 	default:
 		result.Warnings = append(result.Warnings, fmt.Sprintf("Unknown visibility %s for memo %s, defaulting to PRIVATE", exportMemo.Visibility, exportMemo.UID))
 	}
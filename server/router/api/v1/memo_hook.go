@@ -0,0 +1,84 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/hook"
+	"github.com/usememos/memos/store"
+)
+
+// memoHookJobKind calls a memo.post_create hook of the instance.
+const memoHookJobKind = "memo_hook"
+
+type memoHookJobPayload struct {
+	Hook string     `json:"hook"`
+	Memo *hook.Memo `json:"memo"`
+}
+
+// runMemoPreCreateHooks returns the content of the memo to create once replaced by the memo.pre_create hooks.
+func (s *APIV1Service) runMemoPreCreateHooks(ctx context.Context, create *store.Memo) (string, error) {
+	content, err := s.Hooks.PreCreate(ctx, &hook.Memo{
+		Creator:    fmt.Sprintf("%s%d", UserNamePrefix, create.CreatorID),
+		Content:    create.Content,
+		Visibility: create.Visibility.String(),
+	})
+	if err != nil {
+		var rejectedErr *hook.RejectedError
+		if errors.As(err, &rejectedErr) {
+			return "", status.Errorf(codes.InvalidArgument, "%s", rejectedErr.Message)
+		}
+		return "", status.Errorf(codes.Unavailable, "failed to run memo hooks: %v", err)
+	}
+	return content, nil
+}
+
+// enqueueMemoPostCreateHooks enqueues the calls of the memo.post_create hooks with the created memo.
+func (s *APIV1Service) enqueueMemoPostCreateHooks(ctx context.Context, memo *store.Memo) {
+	for _, postCreateHook := range s.Hooks.Hooks(hook.EventMemoPostCreate) {
+		s.enqueueJob(ctx, memoHookJobKind, &memoHookJobPayload{
+			Hook: postCreateHook.Name,
+			Memo: convertMemoToHook(memo),
+		})
+	}
+}
+
+func (s *APIV1Service) runMemoHookJob(ctx context.Context, payload []byte) error {
+	var p memoHookJobPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return errors.Wrap(err, "failed to unmarshal job payload")
+	}
+	return s.Hooks.PostCreate(ctx, p.Hook, p.Memo)
+}
+
+// renderMemoContent returns the content of the memo to render once replaced by the memo.pre_render hooks.
+func (s *APIV1Service) renderMemoContent(ctx context.Context, memo *store.Memo) string {
+	return s.Hooks.Render(ctx, convertMemoToHook(memo))
+}
+
+// renderMemoContents renders the contents of a page of memos by the memo.pre_render hooks at once, caching them
+// so that converting the memos does not call the hooks memo by memo.
+func (s *APIV1Service) renderMemoContents(ctx context.Context, memos []*store.Memo) {
+	if len(s.Hooks.Hooks(hook.EventMemoPreRender)) == 0 {
+		return
+	}
+	hookMemos := make([]*hook.Memo, 0, len(memos))
+	for _, memo := range memos {
+		hookMemos = append(hookMemos, convertMemoToHook(memo))
+	}
+	s.Hooks.RenderAll(ctx, hookMemos)
+}
+
+func convertMemoToHook(memo *store.Memo) *hook.Memo {
+	return &hook.Memo{
+		Name:       fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+		Creator:    fmt.Sprintf("%s%d", UserNamePrefix, memo.CreatorID),
+		Content:    memo.Content,
+		Visibility: memo.Visibility.String(),
+	}
+}
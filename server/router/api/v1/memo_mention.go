@@ -0,0 +1,131 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/webhook"
+	"github.com/usememos/memos/plugin/webpush"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// notifyMemoMentions notifies the users newly mentioned by the memo, who can see it, with an inbox message and a webhook.
+// The failures are only logged, the memo being saved.
+func (s *APIV1Service) notifyMemoMentions(ctx context.Context, memo *store.Memo, memoMessage *v1pb.Memo, previousMentions []string) {
+	for _, username := range memo.Payload.GetMentions() {
+		if slices.Contains(previousMentions, username) {
+			continue
+		}
+		if err := s.notifyMemoMention(ctx, memo, memoMessage, username); err != nil {
+			slog.Warn("Failed to notify memo mention", slog.String("memo", memo.UID), slog.String("username", username), slog.Any("err", err))
+		}
+	}
+}
+
+func (s *APIV1Service) notifyMemoMention(ctx context.Context, memo *store.Memo, memoMessage *v1pb.Memo, username string) error {
+	user, err := s.Store.GetUser(ctx, &store.FindUser{Username: &username})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user")
+	}
+	if user == nil || user.ID == memo.CreatorID || user.RowStatus == store.Archived {
+		return nil
+	}
+	canView, err := s.canUserViewMemo(ctx, user, memo)
+	if err != nil {
+		return err
+	}
+	if !canView {
+		return nil
+	}
+
+	activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: memo.CreatorID,
+		Type:      store.ActivityTypeMemoMention,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoMention: &storepb.ActivityMemoMentionPayload{
+				MemoId: memo.ID,
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	if _, err := s.createInbox(ctx, &store.Inbox{
+		SenderID:   memo.CreatorID,
+		ReceiverID: user.ID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type:       storepb.InboxMessage_MEMO_MENTION,
+			ActivityId: &activity.ID,
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to create inbox")
+	}
+	payload, err := convertMemoToWebhookPayload(memoMessage)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert memo to webhook payload")
+	}
+	payload.ActivityType = webhook.ActivityTypeMemoMentioned
+	return s.dispatchWebhook(ctx, user.ID, payload)
+}
+
+// canUserViewMemo returns whether the user can see the memo, as its creator, as it is public or protected,
+// or as it is shared with them.
+func (s *APIV1Service) canUserViewMemo(ctx context.Context, user *store.User, memo *store.Memo) (bool, error) {
+	if memo.CreatorID == user.ID || memo.Visibility == store.Public || memo.Visibility == store.Protected {
+		return true, nil
+	}
+	err := s.checkMemoSharedWith(ctx, user.ID, memo)
+	if status.Code(err) == codes.PermissionDenied {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// getMemoMentionNotification returns the notification of the mention of the receiver by a memo.
+func (s *APIV1Service) getMemoMentionNotification(ctx context.Context, inbox *store.Inbox) (*webpush.Notification, error) {
+	activity, err := s.Store.GetActivity(ctx, &store.FindActivity{ID: inbox.Message.ActivityId})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get activity")
+	}
+	if activity == nil || activity.Payload.GetMemoMention() == nil {
+		return nil, nil
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &activity.Payload.MemoMention.MemoId})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get memo")
+	}
+	sender, err := s.Store.GetUser(ctx, &store.FindUser{ID: &inbox.SenderID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get sender")
+	}
+	if memo == nil || sender == nil {
+		return nil, nil
+	}
+	snippet, err := getMemoContentSnippet(memo.Content)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get memo snippet")
+	}
+	senderName := sender.Nickname
+	if senderName == "" {
+		senderName = sender.Username
+	}
+	notification := &webpush.Notification{
+		Title: fmt.Sprintf("%s mentioned you", senderName),
+		Body:  snippet,
+		URL:   s.getMemoURL(fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)),
+	}
+	if inbox.ID != 0 {
+		notification.Tag = fmt.Sprintf("%s%d", InboxNamePrefix, inbox.ID)
+	}
+	return notification, nil
+}
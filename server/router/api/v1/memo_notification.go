@@ -0,0 +1,180 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/webpush"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// notifyMemoComment notifies the creator of the memo of the comment, and the users who commented on the memo before
+// of the reply. The unread notifications of the same memo are aggregated into one.
+func (s *APIV1Service) notifyMemoComment(ctx context.Context, comment *store.Memo, relatedMemo *store.Memo) error {
+	type receiver struct {
+		userID      int32
+		messageType storepb.InboxMessage_Type
+	}
+	receivers := []receiver{}
+	if comment.CreatorID != relatedMemo.CreatorID {
+		receivers = append(receivers, receiver{userID: relatedMemo.CreatorID, messageType: storepb.InboxMessage_MEMO_COMMENT})
+	}
+	commentType := store.MemoRelationComment
+	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+		RelatedMemoID: &relatedMemo.ID,
+		Type:          &commentType,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo comments")
+	}
+	notified := []int32{comment.CreatorID, relatedMemo.CreatorID}
+	for _, relation := range relations {
+		previousComment, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &relation.MemoID, ExcludeContent: true})
+		if err != nil {
+			return errors.Wrap(err, "failed to get memo comment")
+		}
+		if previousComment == nil || slices.Contains(notified, previousComment.CreatorID) {
+			continue
+		}
+		notified = append(notified, previousComment.CreatorID)
+		user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &previousComment.CreatorID})
+		if err != nil {
+			return errors.Wrap(err, "failed to get user")
+		}
+		if user == nil || user.RowStatus == store.Archived {
+			continue
+		}
+		canView, err := s.canUserViewMemo(ctx, user, relatedMemo)
+		if err != nil {
+			return err
+		}
+		if canView {
+			receivers = append(receivers, receiver{userID: user.ID, messageType: storepb.InboxMessage_MEMO_COMMENT_REPLY})
+		}
+	}
+	if len(receivers) == 0 {
+		return nil
+	}
+
+	activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: comment.CreatorID,
+		Type:      store.ActivityTypeMemoComment,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoComment: &storepb.ActivityMemoCommentPayload{
+				MemoId:        comment.ID,
+				RelatedMemoId: relatedMemo.ID,
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	for _, receiver := range receivers {
+		if _, err := s.createInbox(ctx, &store.Inbox{
+			SenderID:   comment.CreatorID,
+			ReceiverID: receiver.userID,
+			Status:     store.UNREAD,
+			Message: &storepb.InboxMessage{
+				Type:       receiver.messageType,
+				ActivityId: &activity.ID,
+				MemoId:     &relatedMemo.ID,
+			},
+		}); err != nil {
+			return errors.Wrap(err, "failed to create inbox")
+		}
+	}
+	return nil
+}
+
+// notifyMemoReaction notifies the creator of the memo of the reaction, unless they reacted themselves.
+// The unread reactions to the same memo are aggregated into one notification.
+func (s *APIV1Service) notifyMemoReaction(ctx context.Context, reaction *store.Reaction) error {
+	memoUID, err := ExtractMemoUIDFromName(reaction.ContentID)
+	if err != nil {
+		// Only the reactions to the memos are notified.
+		return nil
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID, ExcludeContent: true})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo")
+	}
+	if memo == nil || memo.CreatorID == reaction.CreatorID {
+		return nil
+	}
+
+	activity, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: reaction.CreatorID,
+		Type:      store.ActivityTypeMemoReaction,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoReaction: &storepb.ActivityMemoReactionPayload{
+				MemoId:       memo.ID,
+				ReactionType: reaction.ReactionType,
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	if _, err := s.createInbox(ctx, &store.Inbox{
+		SenderID:   reaction.CreatorID,
+		ReceiverID: memo.CreatorID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type:       storepb.InboxMessage_MEMO_REACTION,
+			ActivityId: &activity.ID,
+			MemoId:     &memo.ID,
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to create inbox")
+	}
+	return nil
+}
+
+// getMemoReactionNotification returns the notification of the reactions to a memo of the receiver.
+func (s *APIV1Service) getMemoReactionNotification(ctx context.Context, inbox *store.Inbox) (*webpush.Notification, error) {
+	activity, err := s.Store.GetActivity(ctx, &store.FindActivity{ID: inbox.Message.ActivityId})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get activity")
+	}
+	if activity == nil || activity.Payload.GetMemoReaction() == nil {
+		return nil, nil
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &activity.Payload.MemoReaction.MemoId})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get memo")
+	}
+	sender, err := s.Store.GetUser(ctx, &store.FindUser{ID: &inbox.SenderID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get sender")
+	}
+	if memo == nil || sender == nil {
+		return nil, nil
+	}
+	snippet, err := getMemoContentSnippet(memo.Content)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get memo snippet")
+	}
+	senderName := sender.Nickname
+	if senderName == "" {
+		senderName = sender.Username
+	}
+	title := fmt.Sprintf("%s reacted %s to your memo", senderName, activity.Payload.MemoReaction.ReactionType)
+	if count := inbox.Message.GetCount(); count > 1 {
+		title = fmt.Sprintf("%d new reactions to your memo", count)
+	}
+	notification := &webpush.Notification{
+		Title: title,
+		Body:  snippet,
+		URL:   s.getMemoURL(fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID)),
+	}
+	if inbox.ID != 0 {
+		notification.Tag = fmt.Sprintf("%s%d", InboxNamePrefix, inbox.ID)
+	}
+	return notification, nil
+}
@@ -0,0 +1,227 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/webpush"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/onthisday"
+	"github.com/usememos/memos/store"
+)
+
+const onThisDayNameSuffix = "/onThisDay"
+
+func (s *APIV1Service) ListOnThisDayMemos(ctx context.Context, request *v1pb.ListOnThisDayMemosRequest) (*v1pb.ListOnThisDayMemosResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent name %q: %v", request.Parent, err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	location := time.UTC
+	if request.TimeZone != "" {
+		location, err = time.LoadLocation(request.TimeZone)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid time zone: %v", err)
+		}
+	}
+	day := time.Now().In(location)
+	if request.Date != "" {
+		day, err = time.ParseInLocation(time.DateOnly, request.Date, location)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid date: %v", err)
+		}
+	}
+
+	memos, err := s.listOnThisDayMemos(ctx, userID, day)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	response := &v1pb.ListOnThisDayMemosResponse{
+		Memos: []*v1pb.Memo{},
+	}
+	s.renderMemoContents(ctx, memos)
+	for _, memo := range memos {
+		memoMessage, err := s.convertMemoFromStore(ctx, memo)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert memo: %v", err)
+		}
+		response.Memos = append(response.Memos, memoMessage)
+	}
+	return response, nil
+}
+
+// listOnThisDayMemos returns the memos of the user created on the same day as the day in past years, in its time zone,
+// the most recent first.
+func (s *APIV1Service) listOnThisDayMemos(ctx context.Context, userID int32, day time.Time) ([]*store.Memo, error) {
+	normalStatus := store.Normal
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:       &userID,
+		RowStatus:       &normalStatus,
+		ExcludeComments: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	onThisDay := []*store.Memo{}
+	for _, memo := range memos {
+		createdTime := time.Unix(memo.CreatedTs, 0).In(day.Location())
+		if createdTime.Year() < day.Year() && createdTime.Month() == day.Month() && createdTime.Day() == day.Day() {
+			onThisDay = append(onThisDay, memo)
+		}
+	}
+	return onThisDay, nil
+}
+
+func (s *APIV1Service) GetUserOnThisDay(ctx context.Context, request *v1pb.GetUserOnThisDayRequest) (*v1pb.UserOnThisDay, error) {
+	userID, err := extractUserIDFromOnThisDayName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid on this day name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	onThisDay, err := s.Store.GetUserOnThisDay(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user on this day: %v", err)
+	}
+	return convertUserOnThisDayFromStore(request.Name, onThisDay), nil
+}
+
+func (s *APIV1Service) UpdateUserOnThisDay(ctx context.Context, request *v1pb.UpdateUserOnThisDayRequest) (*v1pb.UserOnThisDay, error) {
+	update := request.OnThisDay
+	if update == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "on this day is required")
+	}
+	userID, err := extractUserIDFromOnThisDayName(update.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid on this day name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	if update.Hour < 0 || update.Hour > 23 {
+		return nil, status.Errorf(codes.InvalidArgument, "hour must be between 0 and 23")
+	}
+	if _, err := time.LoadLocation(update.TimeZone); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid time zone: %v", err)
+	}
+
+	// The first notification is sent at the next scheduled time.
+	onThisDay := &storepb.OnThisDayUserSetting{
+		Enabled:        update.Enabled,
+		Hour:           update.Hour,
+		TimeZone:       update.TimeZone,
+		LastNotifyTime: timestamppb.Now(),
+	}
+	if err := s.Store.UpsertUserOnThisDay(ctx, userID, onThisDay); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user on this day: %v", err)
+	}
+	return convertUserOnThisDayFromStore(update.Name, onThisDay), nil
+}
+
+// NewOnThisDayNotifier returns the notifier sending the daily inbox messages of the memos created on this day.
+func (s *APIV1Service) NewOnThisDayNotifier() onthisday.Notifier {
+	return &onThisDayNotifier{service: s}
+}
+
+type onThisDayNotifier struct {
+	service *APIV1Service
+}
+
+// NotifyOnThisDay sends an inbox message to the user, through the channels of their preference, if they have memos
+// created on the day in past years.
+func (n *onThisDayNotifier) NotifyOnThisDay(ctx context.Context, userID int32, day time.Time) error {
+	user, err := n.service.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user")
+	}
+	if user == nil || user.RowStatus == store.Archived {
+		return nil
+	}
+	memos, err := n.service.listOnThisDayMemos(ctx, userID, day)
+	if err != nil {
+		return errors.Wrap(err, "failed to list memos")
+	}
+	if len(memos) == 0 {
+		return nil
+	}
+	if _, err := n.service.createInbox(ctx, &store.Inbox{
+		SenderID:   store.SystemBotID,
+		ReceiverID: userID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type: storepb.InboxMessage_MEMO_ON_THIS_DAY,
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to create inbox")
+	}
+	return nil
+}
+
+// getOnThisDayNotification returns the notification of the memos of the receiver created on this day in past years,
+// in the time zone of their schedule.
+func (s *APIV1Service) getOnThisDayNotification(ctx context.Context, inbox *store.Inbox) (*webpush.Notification, error) {
+	onThisDay, err := s.Store.GetUserOnThisDay(ctx, inbox.ReceiverID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user on this day")
+	}
+	location, err := time.LoadLocation(onThisDay.TimeZone)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid time zone")
+	}
+	memos, err := s.listOnThisDayMemos(ctx, inbox.ReceiverID, time.Now().In(location))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memos")
+	}
+	if len(memos) == 0 {
+		return nil, nil
+	}
+	snippet, err := getMemoContentSnippet(memos[0].Content)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get memo snippet")
+	}
+	title := "1 memo from this day in past years"
+	if len(memos) > 1 {
+		title = fmt.Sprintf("%d memos from this day in past years", len(memos))
+	}
+	notification := &webpush.Notification{
+		Title: title,
+		Body:  snippet,
+		URL:   s.getMemoURL(fmt.Sprintf("%s%s", MemoNamePrefix, memos[0].UID)),
+	}
+	if inbox.ID != 0 {
+		notification.Tag = fmt.Sprintf("%s%d", InboxNamePrefix, inbox.ID)
+	}
+	return notification, nil
+}
+
+func convertUserOnThisDayFromStore(name string, onThisDay *storepb.OnThisDayUserSetting) *v1pb.UserOnThisDay {
+	return &v1pb.UserOnThisDay{
+		Name:           name,
+		Enabled:        onThisDay.Enabled,
+		Hour:           onThisDay.Hour,
+		TimeZone:       onThisDay.TimeZone,
+		LastNotifyTime: onThisDay.LastNotifyTime,
+	}
+}
+
+// extractUserIDFromOnThisDayName returns the user ID from an on this day name.
+// e.g., "users/1/onThisDay" -> 1.
+func extractUserIDFromOnThisDayName(name string) (int32, error) {
+	userName, ok := strings.CutSuffix(name, onThisDayNameSuffix)
+	if !ok {
+		return 0, errors.Errorf("invalid on this day name %q", name)
+	}
+	return ExtractUserIDFromName(userName)
+}
@@ -79,7 +79,7 @@ func (s *APIV1Service) ListMemoRelations(ctx context.Context, request *v1pb.List
 	if currentUser == nil {
 		memoFilter = `visibility == "PUBLIC"`
 	} else {
-		memoFilter = fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"]`, currentUser.ID)
+		memoFilter = fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"] || group_visible_to(%d)`, currentUser.ID, currentUser.ID)
 	}
 	relationList := []*v1pb.MemoRelation{}
 	tempList, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
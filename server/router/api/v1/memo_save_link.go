@@ -0,0 +1,156 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net/url"
+	"path"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/httpgetter"
+	"github.com/usememos/memos/plugin/readability"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// defaultSaveLinkTag is the tag of the links saved without tags.
+const defaultSaveLinkTag = "readlater"
+
+// SaveLink fetches the page of the URL and creates a memo of its article as markdown, with its lead image attached.
+// The article is truncated to fit the content length limit, and the lead image failing to be fetched is left out.
+func (s *APIV1Service) SaveLink(ctx context.Context, request *v1pb.SaveLinkRequest) (*v1pb.Memo, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	linkURL, err := url.Parse(strings.TrimSpace(request.Url))
+	if err != nil || (linkURL.Scheme != "http" && linkURL.Scheme != "https") || linkURL.Host == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid url: %s", request.Url)
+	}
+	tags := []string{}
+	for _, tag := range request.Tags {
+		tag = normalizeTagPath(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+		if tag == "" || strings.ContainsAny(tag, " \t\n") {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid tag: %s", tag)
+		}
+		tags = append(tags, "#"+tag)
+	}
+	if len(tags) == 0 {
+		tags = append(tags, "#"+defaultSaveLinkTag)
+	}
+
+	getHTML := s.GetHTML
+	if getHTML == nil {
+		getHTML = httpgetter.GetHTML
+	}
+	page, err := getHTML(ctx, linkURL.String())
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to fetch page: %v", err)
+	}
+	article, err := readability.Extract(bytes.NewReader(page.Body), page.URL)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to extract article: %v", err)
+	}
+
+	contentLengthLimit, err := s.getContentLengthLimit(ctx)
+	if err != nil {
+		return nil, err
+	}
+	content := buildSaveLinkContent(article, page.URL, tags, contentLengthLimit)
+	visibility := request.Visibility
+	if visibility == v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+		if visibility, err = s.getUserDefaultVisibility(ctx, user.ID); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get default visibility: %v", err)
+		}
+	}
+	memo, err := s.CreateMemo(ctx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:    content,
+			Visibility: visibility,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if article.LeadImage == "" {
+		return memo, nil
+	}
+	if err := s.attachLeadImage(ctx, memo, article.LeadImage); err != nil {
+		slog.Warn("Failed to attach lead image", slog.String("memo", memo.Name), slog.String("url", article.LeadImage), slog.Any("err", err))
+		return memo, nil
+	}
+	return s.GetMemo(ctx, &v1pb.GetMemoRequest{Name: memo.Name})
+}
+
+// buildSaveLinkContent returns the markdown of the article: its title, its source, as much of its content as fits
+// the limit and the tags.
+func buildSaveLinkContent(article *readability.Article, pageURL *url.URL, tags []string, limit int) string {
+	title := article.Title
+	if title == "" {
+		title = pageURL.Host
+	}
+	source := article.SiteName
+	if source == "" {
+		source = pageURL.Host
+	}
+	source = fmt.Sprintf("[%s](%s)", readability.EscapeMarkdown(source), pageURL.String())
+	if article.Byline != "" {
+		source = readability.EscapeMarkdown(article.Byline) + " · " + source
+	}
+
+	header := "# " + readability.EscapeMarkdown(title) + "\n\n" + source
+	footer := strings.Join(tags, " ")
+	sections := []string{header}
+	if body := article.TruncatedContent(limit - len(header) - len(footer) - 4); body != "" {
+		sections = append(sections, body)
+	}
+	sections = append(sections, footer)
+	return strings.Join(sections, "\n\n")
+}
+
+// attachLeadImage downloads the image of the URL and attaches it to the memo.
+func (s *APIV1Service) attachLeadImage(ctx context.Context, memo *v1pb.Memo, imageURL string) error {
+	getImage := s.GetImage
+	if getImage == nil {
+		getImage = httpgetter.GetImageWithContext
+	}
+	image, err := getImage(ctx, imageURL)
+	if err != nil {
+		return err
+	}
+	_, err = s.CreateAttachment(ctx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{
+			Filename: getLeadImageFilename(imageURL, image.Mediatype),
+			Type:     image.Mediatype,
+			Content:  image.Blob,
+			Memo:     &memo.Name,
+		},
+	})
+	return err
+}
+
+// getLeadImageFilename returns the last segment of the path of the image URL, with an extension of its mediatype
+// if it has none.
+func getLeadImageFilename(imageURL, mediatype string) string {
+	filename := "lead-image"
+	if u, err := url.Parse(imageURL); err == nil {
+		if base := path.Base(u.Path); base != "." && base != "/" {
+			filename = base
+		}
+	}
+	if path.Ext(filename) == "" {
+		if extensions, err := mime.ExtensionsByType(mediatype); err == nil && len(extensions) > 0 {
+			filename += extensions[0]
+		}
+	}
+	return filename
+}
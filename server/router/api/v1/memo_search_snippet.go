@@ -0,0 +1,134 @@
+package v1
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/usememos/memos/plugin/fuzzysearch"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+// snippetRadius is the number of characters kept around the first match in search snippets.
+const snippetRadius = 80
+
+// maxRegexMatches is the maximum number of regex matches located in a text.
+const maxRegexMatches = 100
+
+// textRange is the range [start, end) of a match in a text, in characters.
+type textRange struct {
+	start, end int
+}
+
+// textMatcher returns the ranges of the text matching a search query.
+type textMatcher func(text []rune) []textRange
+
+// buildSearchSnippet returns an excerpt of the text around the first match, or the start of
+// the text if nothing matches, with the ranges of the matches in the excerpt.
+// Whitespaces are collapsed so that the excerpt renders on a single line.
+func buildSearchSnippet(text string, findMatches textMatcher) (string, []*v1pb.TextHighlight) {
+	runes := []rune(text)
+	matches := findMatches(runes)
+	start, end := 0, 0
+	if len(matches) > 0 {
+		start, end = matches[0].start, matches[0].end
+	}
+	start, end = max(0, start-snippetRadius), min(len(runes), end+snippetRadius)
+
+	snippet := []rune{}
+	if start > 0 {
+		snippet = append(snippet, '…')
+	}
+	// offsets maps the offsets of the text in the window to the offsets of the snippet.
+	offsets := make([]int, end-start+1)
+	written, pendingSpace := false, false
+	for i := start; i < end; i++ {
+		if unicode.IsSpace(runes[i]) {
+			pendingSpace = written
+			offsets[i-start] = len(snippet)
+			continue
+		}
+		if pendingSpace {
+			snippet = append(snippet, ' ')
+			pendingSpace = false
+		}
+		offsets[i-start] = len(snippet)
+		snippet = append(snippet, runes[i])
+		written = true
+	}
+	offsets[end-start] = len(snippet)
+	if end < len(runes) {
+		snippet = append(snippet, '…')
+	}
+
+	highlights := []*v1pb.TextHighlight{}
+	for _, match := range matches {
+		if match.end <= start || match.start >= end {
+			continue
+		}
+		highlightStart, highlightEnd := offsets[max(match.start, start)-start], offsets[min(match.end, end)-start]
+		if highlightStart < highlightEnd {
+			highlights = append(highlights, &v1pb.TextHighlight{
+				Start: int32(highlightStart),
+				End:   int32(highlightEnd),
+			})
+		}
+	}
+	return string(snippet), highlights
+}
+
+// newWordMatcher returns a matcher of the words starting with a term of the query,
+// or also matching a term with a few typos in fuzzy mode.
+func newWordMatcher(query string, fuzzy bool) textMatcher {
+	terms := strings.FieldsFunc(strings.ToLower(query), isNotWordRune)
+	return func(text []rune) []textRange {
+		matches := []textRange{}
+		for start := 0; start < len(text); {
+			if isNotWordRune(text[start]) {
+				start++
+				continue
+			}
+			end := start
+			for end < len(text) && !isNotWordRune(text[end]) {
+				end++
+			}
+			word := strings.ToLower(string(text[start:end]))
+			for _, term := range terms {
+				if strings.HasPrefix(word, term) || (fuzzy && fuzzysearch.MatchWord(term, word)) {
+					matches = append(matches, textRange{start: start, end: end})
+					break
+				}
+			}
+			start = end
+		}
+		return matches
+	}
+}
+
+// newRegexMatcher returns a matcher of the non-empty matches of the regular expression.
+func newRegexMatcher(re *regexp.Regexp) textMatcher {
+	return func(text []rune) []textRange {
+		s := string(text)
+		matches := []textRange{}
+		// Convert the byte offsets of the matches, in increasing order, to character offsets.
+		offset, runeOffset := 0, 0
+		toRuneOffset := func(byteOffset int) int {
+			runeOffset += utf8.RuneCountInString(s[offset:byteOffset])
+			offset = byteOffset
+			return runeOffset
+		}
+		for _, loc := range re.FindAllStringIndex(s, maxRegexMatches) {
+			if loc[0] == loc[1] {
+				continue
+			}
+			start := toRuneOffset(loc[0])
+			matches = append(matches, textRange{start: start, end: toRuneOffset(loc[1])})
+		}
+		return matches
+	}
+}
+
+func isNotWordRune(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+}
@@ -0,0 +1,122 @@
+package v1
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/embedding"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	defaultSemanticSearchPageSize = 10
+	maxSemanticSearchPageSize     = 50
+)
+
+func (s *APIV1Service) SemanticSearchMemos(ctx context.Context, request *v1pb.SemanticSearchMemosRequest) (*v1pb.SemanticSearchMemosResponse, error) {
+	query := strings.TrimSpace(request.Query)
+	if query == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "query is required")
+	}
+	workspaceEmbeddingSetting, err := s.Store.GetWorkspaceEmbeddingSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace embedding setting: %v", err)
+	}
+	if !workspaceEmbeddingSetting.Enabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "semantic search is not enabled")
+	}
+	provider, err := embedding.NewProvider(workspaceEmbeddingSetting)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "invalid embedding setting: %v", err)
+	}
+
+	normalStatus := store.Normal
+	memoFind := &store.FindMemo{
+		RowStatus:       &normalStatus,
+		ExcludeComments: true,
+	}
+	if request.Parent != "" && request.Parent != "users/-" {
+		userID, err := ExtractUserIDFromName(request.Parent)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+		}
+		memoFind.CreatorID = &userID
+	}
+	if err := s.restrictMemoFindToVisible(ctx, memoFind); err != nil {
+		return nil, err
+	}
+	pageSize := int(request.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultSemanticSearchPageSize
+	}
+	pageSize = min(pageSize, maxSemanticSearchPageSize)
+
+	vectors, err := provider.Embed(ctx, []string{query})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to embed query: %v", err)
+	}
+	model := provider.Model()
+	memoEmbeddings, err := s.Store.ListMemoEmbeddings(ctx, &store.FindMemoEmbedding{
+		Model: &model,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo embeddings: %v", err)
+	}
+	vectorsByMemoID := make(map[int32][]float32, len(memoEmbeddings))
+	for _, memoEmbedding := range memoEmbeddings {
+		vectorsByMemoID[memoEmbedding.MemoID] = memoEmbedding.Embedding
+	}
+
+	memos, err := s.Store.ListMemos(ctx, memoFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	type scoredMemo struct {
+		memo  *store.Memo
+		score float64
+	}
+	scoredMemos := []*scoredMemo{}
+	for _, memo := range memos {
+		vector, ok := vectorsByMemoID[memo.ID]
+		if !ok {
+			continue
+		}
+		scoredMemos = append(scoredMemos, &scoredMemo{
+			memo:  memo,
+			score: embedding.CosineSimilarity(vectors[0], vector),
+		})
+	}
+	slices.SortStableFunc(scoredMemos, func(a, b *scoredMemo) int {
+		return cmp.Compare(b.score, a.score)
+	})
+	if len(scoredMemos) > pageSize {
+		scoredMemos = scoredMemos[:pageSize]
+	}
+
+	response := &v1pb.SemanticSearchMemosResponse{
+		Results: []*v1pb.SemanticSearchMemosResponse_Result{},
+	}
+	resultMemos := make([]*store.Memo, 0, len(scoredMemos))
+	for _, scoredMemo := range scoredMemos {
+		resultMemos = append(resultMemos, scoredMemo.memo)
+	}
+	s.renderMemoContents(ctx, resultMemos)
+	for _, scoredMemo := range scoredMemos {
+		memoMessage, err := s.convertMemoFromStore(ctx, scoredMemo.memo)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert memo")
+		}
+		response.Results = append(response.Results, &v1pb.SemanticSearchMemosResponse_Result{
+			Memo:  memoMessage,
+			Score: float32(scoredMemo.score),
+		})
+	}
+	return response, nil
+}
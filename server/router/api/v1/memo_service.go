@@ -1,24 +1,31 @@
 package v1
 
 import (
+	"cmp"
 	"context"
+	"encoding/json"
 	"fmt"
 	"log/slog"
+	"maps"
+	"math/rand/v2"
+	"regexp"
+	"regexp/syntax"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 	"unicode/utf8"
 
 	"github.com/lithammer/shortuuid/v4"
 	"github.com/pkg/errors"
-	"github.com/usememos/gomark/ast"
 	"github.com/usememos/gomark/parser"
 	"github.com/usememos/gomark/parser/tokenizer"
 	"github.com/usememos/gomark/renderer"
-	"github.com/usememos/gomark/restore"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/usememos/memos/plugin/fuzzysearch"
 	"github.com/usememos/memos/plugin/webhook"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
@@ -45,6 +52,11 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 	if workspaceMemoRelatedSetting.DisallowPublicVisibility && create.Visibility == store.Public {
 		return nil, status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
 	}
+	// The hooks may reject the memo or change its content, which is checked once changed.
+	create.Content, err = s.runMemoPreCreateHooks(ctx, create)
+	if err != nil {
+		return nil, err
+	}
 	contentLengthLimit, err := s.getContentLengthLimit(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get content length limit")
@@ -58,11 +70,31 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 	if request.Memo.Location != nil {
 		create.Payload.Location = convertLocationToStore(request.Memo.Location)
 	}
+	if request.Memo.Space != "" {
+		space, _, err := s.getSpaceAsMember(ctx, request.Memo.Space, store.SpaceRoleEditor)
+		if err != nil {
+			return nil, err
+		}
+		create.SpaceID = space.ID
+	}
+	groupIDs, err := s.getMemoGroupIDs(ctx, request.Memo.Groups)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMemoGroups(create.Visibility, groupIDs); err != nil {
+		return nil, err
+	}
 
 	memo, err := s.Store.CreateMemo(ctx, create)
 	if err != nil {
 		return nil, err
 	}
+	if len(groupIDs) > 0 {
+		if err := s.Store.SetMemoGroups(ctx, memo.ID, groupIDs); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to set memo groups: %v", err)
+		}
+	}
+	s.memoSuggester.invalidate()
 	if len(request.Memo.Attachments) > 0 {
 		_, err := s.SetMemoAttachments(ctx, &v1pb.SetMemoAttachmentsRequest{
 			Name:        fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
@@ -90,6 +122,11 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 	if err := s.DispatchMemoCreatedWebhook(ctx, memoMessage); err != nil {
 		slog.Warn("Failed to dispatch memo created webhook", slog.Any("err", err))
 	}
+	s.notifyMemoMentions(ctx, memo, memoMessage, nil)
+	if memo.Visibility == store.Public {
+		s.publishMemoFeeds(ctx, memo.CreatorID)
+	}
+	s.enqueueMemoPostCreateHooks(ctx, memo)
 
 	return memoMessage, nil
 }
@@ -127,35 +164,32 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 		if err := s.parseMemoOrderBy(request.OrderBy, memoFind); err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid order_by: %v", err)
 		}
+		if memoFind.OrderByRelevance {
+			return nil, status.Errorf(codes.InvalidArgument, "relevance order requires a search query, use SearchMemos instead")
+		}
 	} else {
 		// Default ordering by display_time desc
 		memoFind.OrderByTimeAsc = false
 	}
 
 	if request.Filter != "" {
-		if err := s.validateFilter(ctx, request.Filter); err != nil {
+		filter, err := s.expandFilterMacros(ctx, request.Filter)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		if err := s.validateExpandedFilter(ctx, filter); err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 		}
-		memoFind.Filter = &request.Filter
+		memoFind.Filter = &filter
 	}
 
-	currentUser, err := s.GetCurrentUser(ctx)
+	inSharedSpace, err := s.scopeMemoFindToSpace(ctx, memoFind, request.Space)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get user")
+		return nil, err
 	}
-	if currentUser == nil {
-		memoFind.VisibilityList = []store.Visibility{store.Public}
-	} else {
-		if memoFind.CreatorID == nil {
-			internalFilter := fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"]`, currentUser.ID)
-			if memoFind.Filter != nil {
-				filter := fmt.Sprintf("(%s) && (%s)", *memoFind.Filter, internalFilter)
-				memoFind.Filter = &filter
-			} else {
-				memoFind.Filter = &internalFilter
-			}
-		} else if *memoFind.CreatorID != currentUser.ID {
-			memoFind.VisibilityList = []store.Visibility{store.Public, store.Protected}
+	if !inSharedSpace {
+		if err := s.restrictMemoFindToVisible(ctx, memoFind); err != nil {
+			return nil, err
 		}
 	}
 
@@ -163,7 +197,8 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get workspace memo related setting")
 	}
-	if workspaceMemoRelatedSetting.DisplayWithUpdateTime {
+	// The display time is the update time if the workspace displays it, other orders are kept as requested.
+	if workspaceMemoRelatedSetting.DisplayWithUpdateTime && isDisplayTimeOrder(request.OrderBy) {
 		memoFind.OrderByUpdatedTs = true
 	}
 
@@ -175,6 +210,9 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 		}
 		limit = int(pageToken.Limit)
 		offset = int(pageToken.Offset)
+		if memoFind.OrderByRandomSeed != nil && pageToken.RandomSeed != 0 {
+			memoFind.OrderByRandomSeed = &pageToken.RandomSeed
+		}
 	} else {
 		limit = int(request.PageSize)
 	}
@@ -193,11 +231,12 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	nextPageToken := ""
 	if len(memos) == limitPlusOne {
 		memos = memos[:limit]
-		nextPageToken, err = getPageToken(limit, offset+limit)
+		nextPageToken, err = getMemoPageToken(limit, offset+limit, memoFind)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
 		}
 	}
+	s.renderMemoContents(ctx, memos)
 	for _, memo := range memos {
 		memoMessage, err := s.convertMemoFromStore(ctx, memo)
 		if err != nil {
@@ -213,6 +252,458 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 	return response, nil
 }
 
+func (s *APIV1Service) SearchMemos(ctx context.Context, request *v1pb.SearchMemosRequest) (*v1pb.SearchMemosResponse, error) {
+	query := strings.TrimSpace(request.Query)
+	if query == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "query is required")
+	}
+	normalStatus := store.Normal
+	memoFind := &store.FindMemo{
+		RowStatus:       &normalStatus,
+		ExcludeComments: true,
+	}
+	if request.Scope != v1pb.SearchMemosRequest_SCOPE_UNSPECIFIED && request.Parent != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "parent and scope are exclusive")
+	}
+	switch request.Scope {
+	case v1pb.SearchMemosRequest_OWN:
+		currentUser, err := s.GetCurrentUser(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user")
+		}
+		if currentUser == nil {
+			return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+		}
+		memoFind.CreatorID = &currentUser.ID
+	case v1pb.SearchMemosRequest_ACCESSIBLE:
+		// Without a creator, the memos are restricted to the visible ones below.
+	default:
+		if request.Parent != "" && request.Parent != "users/-" {
+			userID, err := ExtractUserIDFromName(request.Parent)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid parent: %v", err)
+			}
+			memoFind.CreatorID = &userID
+		}
+	}
+	if request.OrderBy != "" {
+		if err := s.parseMemoOrderBy(request.OrderBy, memoFind); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid order_by: %v", err)
+		}
+	} else {
+		memoFind.OrderByRelevance = true
+	}
+	withCreatorFacets := request.Scope == v1pb.SearchMemosRequest_ACCESSIBLE
+	if request.Filter != "" {
+		filter, err := s.expandFilterMacros(ctx, request.Filter)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		if err := s.validateExpandedFilter(ctx, filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		memoFind.Filter = &filter
+	}
+	inSharedSpace, err := s.scopeMemoFindToSpace(ctx, memoFind, request.Space)
+	if err != nil {
+		return nil, err
+	}
+	if !inSharedSpace {
+		if err := s.restrictMemoFindToVisible(ctx, memoFind); err != nil {
+			return nil, err
+		}
+	}
+	if currentUser, err := s.GetCurrentUser(ctx); err == nil && currentUser != nil && request.PageToken == "" {
+		s.memoSuggester.addRecentQuery(currentUser.ID, query)
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+		if memoFind.OrderByRelevance && pageToken.LastId != 0 {
+			memoFind.RelevanceCursor = &store.RelevanceCursor{Score: pageToken.LastScore, ID: pageToken.LastId}
+		}
+		if memoFind.OrderByRandomSeed != nil && pageToken.RandomSeed != 0 {
+			memoFind.OrderByRandomSeed = &pageToken.RandomSeed
+		}
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	limitPlusOne := limit + 1
+	var memos []*store.Memo
+	var creatorFacets []*v1pb.SearchMemosResponse_CreatorFacet
+	matcher := newWordMatcher(query, request.Fuzzy)
+	if request.Regex {
+		if request.Fuzzy {
+			return nil, status.Errorf(codes.InvalidArgument, "fuzzy and regex searches are exclusive")
+		}
+		re, err := compileSearchRegex(query)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid regex: %v", err)
+		}
+		memos, err = s.regexSearchMemos(ctx, memoFind, re)
+		if err != nil {
+			return nil, err
+		}
+		if withCreatorFacets {
+			creatorFacets = buildCreatorFacets(memos)
+		}
+		memos = paginateMatchedMemos(memos, memoFind, offset, limitPlusOne)
+		matcher = newRegexMatcher(re)
+	} else if request.Fuzzy {
+		memos, err = s.fuzzySearchMemos(ctx, memoFind, query)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to search memos: %v", err)
+		}
+		if withCreatorFacets {
+			creatorFacets = buildCreatorFacets(memos)
+		}
+		memos = paginateMatchedMemos(memos, memoFind, offset, limitPlusOne)
+	} else {
+		memoFind.FullTextSearch = &query
+		if withCreatorFacets {
+			// The facets count every match, so the unpaginated results are listed without their content.
+			facetFind := *memoFind
+			facetFind.ExcludeContent = true
+			facetFind.RelevanceCursor = nil
+			matchedMemos, err := s.Store.ListMemos(ctx, &facetFind)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to search memos: %v", err)
+			}
+			creatorFacets = buildCreatorFacets(matchedMemos)
+		}
+		memoFind.Limit = &limitPlusOne
+		memoFind.Offset = &offset
+		memos, err = s.Store.ListMemos(ctx, memoFind)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to search memos: %v", err)
+		}
+	}
+
+	response := &v1pb.SearchMemosResponse{
+		Memos:         []*v1pb.Memo{},
+		MemoMatches:   []*v1pb.SearchMemosResponse_MemoMatch{},
+		CreatorFacets: creatorFacets,
+	}
+	if len(memos) == limitPlusOne {
+		memos = memos[:limit]
+		if memoFind.OrderByRelevance {
+			// The cursor of the last memo keeps the pages consistent when memos are created or deleted in between.
+			lastMemo := memos[limit-1]
+			response.NextPageToken, err = marshalPageToken(&v1pb.PageToken{
+				Limit:     int32(limit),
+				LastScore: lastMemo.Score,
+				LastId:    lastMemo.ID,
+			})
+		} else {
+			response.NextPageToken, err = getMemoPageToken(limit, offset+limit, memoFind)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+	s.renderMemoContents(ctx, memos)
+	for _, memo := range memos {
+		memoMessage, err := s.convertMemoFromStore(ctx, memo)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert memo")
+		}
+		snippet, highlights := buildSearchSnippet(memo.Content, matcher)
+		response.MemoMatches = append(response.MemoMatches, &v1pb.SearchMemosResponse_MemoMatch{
+			Memo:       memoMessage.Name,
+			Snippet:    snippet,
+			Highlights: highlights,
+			Score:      memo.Score,
+		})
+		if request.ExcludeContent {
+			memoMessage.Content = ""
+			memoMessage.Nodes = nil
+			memoMessage.Snippet = ""
+		}
+		response.Memos = append(response.Memos, memoMessage)
+	}
+	response.AttachmentMatches = []*v1pb.SearchMemosResponse_AttachmentMatch{}
+	if !request.Regex {
+		response.AttachmentMatches, err = s.listAttachmentMatches(ctx, memos, query)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list attachment matches: %v", err)
+		}
+	}
+	return response, nil
+}
+
+// buildCreatorFacets counts the memos per creator, the creators with the most memos first.
+func buildCreatorFacets(memos []*store.Memo) []*v1pb.SearchMemosResponse_CreatorFacet {
+	counts := map[int32]int32{}
+	for _, memo := range memos {
+		counts[memo.CreatorID]++
+	}
+	creatorIDs := slices.Collect(maps.Keys(counts))
+	slices.SortFunc(creatorIDs, func(a, b int32) int {
+		if counts[a] != counts[b] {
+			return cmp.Compare(counts[b], counts[a])
+		}
+		return cmp.Compare(a, b)
+	})
+	facets := []*v1pb.SearchMemosResponse_CreatorFacet{}
+	for _, creatorID := range creatorIDs {
+		facets = append(facets, &v1pb.SearchMemosResponse_CreatorFacet{
+			Creator:   fmt.Sprintf("%s%d", UserNamePrefix, creatorID),
+			MemoCount: counts[creatorID],
+		})
+	}
+	return facets
+}
+
+// listAttachmentMatches returns the attachments of the memos whose extracted text matches the query,
+// in the order of the memos.
+func (s *APIV1Service) listAttachmentMatches(ctx context.Context, memos []*store.Memo, query string) ([]*v1pb.SearchMemosResponse_AttachmentMatch, error) {
+	matches := []*v1pb.SearchMemosResponse_AttachmentMatch{}
+	if len(memos) == 0 {
+		return matches, nil
+	}
+	memoIDs := []int32{}
+	memoPositions := map[int32]int{}
+	for i, memo := range memos {
+		memoIDs = append(memoIDs, memo.ID)
+		memoPositions[memo.ID] = i
+	}
+	attachmentTexts, err := s.Store.ListAttachmentTexts(ctx, &store.FindAttachmentText{
+		MemoIDList:     memoIDs,
+		FullTextSearch: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortStableFunc(attachmentTexts, func(a, b *store.AttachmentText) int {
+		return cmp.Compare(memoPositions[*a.MemoID], memoPositions[*b.MemoID])
+	})
+	for _, attachmentText := range attachmentTexts {
+		attachment, err := s.Store.GetAttachment(ctx, &store.FindAttachment{ID: &attachmentText.AttachmentID})
+		if err != nil {
+			return nil, err
+		}
+		if attachment == nil {
+			continue
+		}
+		snippet, highlights := buildSearchSnippet(attachmentText.Content, newWordMatcher(query, false))
+		matches = append(matches, &v1pb.SearchMemosResponse_AttachmentMatch{
+			Memo:       fmt.Sprintf("%s%s", MemoNamePrefix, memos[memoPositions[*attachmentText.MemoID]].UID),
+			Attachment: fmt.Sprintf("%s%s", AttachmentNamePrefix, attachment.UID),
+			Filename:   attachment.Filename,
+			Snippet:    snippet,
+			Highlights: highlights,
+		})
+	}
+	return matches, nil
+}
+
+// maxFuzzySearchCandidates bounds the memos matched in memory by a fuzzy search, the most recent ones being matched.
+const maxFuzzySearchCandidates = 1000
+
+// fuzzySearchMemos returns the memos matching the query with typo tolerance, the best matches first.
+// The candidates are the memos containing a fragment of each term of the query, found by the database.
+func (s *APIV1Service) fuzzySearchMemos(ctx context.Context, memoFind *store.FindMemo, query string) ([]*store.Memo, error) {
+	limit := maxFuzzySearchCandidates
+	candidateFind := *memoFind
+	candidateFind.ContentFragments = fuzzysearch.Fragments(query)
+	candidateFind.Limit = &limit
+	candidateFind.Offset = nil
+	memos, err := s.Store.ListMemos(ctx, &candidateFind)
+	if err != nil {
+		return nil, err
+	}
+	matched := []*store.Memo{}
+	for _, memo := range memos {
+		if score, ok := fuzzysearch.Match(query, memo.Content); ok {
+			if memoFind.OrderByRelevance {
+				memo.Score = score
+			}
+			matched = append(matched, memo)
+		}
+	}
+	return matched, nil
+}
+
+// getMemoPageToken returns the page token of the memos at the offset, keeping the seed of a random order.
+func getMemoPageToken(limit, offset int, memoFind *store.FindMemo) (string, error) {
+	pageToken := &v1pb.PageToken{
+		Limit:  int32(limit),
+		Offset: int32(offset),
+	}
+	if memoFind.OrderByRandomSeed != nil {
+		pageToken.RandomSeed = *memoFind.OrderByRandomSeed
+	}
+	return marshalPageToken(pageToken)
+}
+
+// paginateMatchedMemos returns the page of the memos matched in memory.
+// When ordering by relevance, the memos are sorted like the full-text search results and paginated by the cursor.
+func paginateMatchedMemos(memos []*store.Memo, memoFind *store.FindMemo, offset, limit int) []*store.Memo {
+	if memoFind.OrderByRelevance {
+		slices.SortFunc(memos, func(a, b *store.Memo) int {
+			if a.Score != b.Score {
+				return cmp.Compare(b.Score, a.Score)
+			}
+			return cmp.Compare(b.ID, a.ID)
+		})
+		if cursor := memoFind.RelevanceCursor; cursor != nil {
+			memos = slices.DeleteFunc(memos, func(memo *store.Memo) bool {
+				return memo.Score > cursor.Score || (memo.Score == cursor.Score && memo.ID >= cursor.ID)
+			})
+		}
+	}
+	return memos[min(offset, len(memos)):min(offset+limit, len(memos))]
+}
+
+const (
+	// maxRegexLength is the maximum length of a regex search pattern.
+	maxRegexLength = 512
+	// maxRegexInstructions is the maximum size of the compiled program of a regex search pattern,
+	// which bounds the matching cost per character.
+	maxRegexInstructions = 2000
+	// regexSearchTimeout is the maximum duration of a regex search.
+	regexSearchTimeout = 5 * time.Second
+)
+
+// compileSearchRegex compiles the regex search pattern, rejecting overly complex patterns.
+// RE2 matches in linear time of the text, so the pattern complexity is the only cost to bound.
+func compileSearchRegex(pattern string) (*regexp.Regexp, error) {
+	if len(pattern) > maxRegexLength {
+		return nil, errors.Errorf("pattern is longer than %d characters", maxRegexLength)
+	}
+	parsed, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil, err
+	}
+	prog, err := syntax.Compile(parsed.Simplify())
+	if err != nil {
+		return nil, err
+	}
+	if len(prog.Inst) > maxRegexInstructions {
+		return nil, errors.New("pattern is too complex")
+	}
+	return regexp.Compile(pattern)
+}
+
+// regexSearchMemos returns the memos whose content matches the regular expression, in the default ordering.
+func (s *APIV1Service) regexSearchMemos(ctx context.Context, memoFind *store.FindMemo, re *regexp.Regexp) ([]*store.Memo, error) {
+	ctx, cancel := context.WithTimeout(ctx, regexSearchTimeout)
+	defer cancel()
+
+	memos, err := s.Store.ListMemos(ctx, memoFind)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, status.Errorf(codes.DeadlineExceeded, "regex search timed out")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to search memos: %v", err)
+	}
+	matched := []*store.Memo{}
+	for _, memo := range memos {
+		if ctx.Err() != nil {
+			return nil, status.Errorf(codes.DeadlineExceeded, "regex search timed out, narrow it down with a parent or a filter")
+		}
+		if re.MatchString(memo.Content) {
+			matched = append(matched, memo)
+		}
+	}
+	return matched, nil
+}
+
+// restrictMemoFindToVisible restricts the memo find to the memos the current user can read.
+func (s *APIV1Service) restrictMemoFindToVisible(ctx context.Context, memoFind *store.FindMemo) error {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user")
+	}
+	if currentUser == nil {
+		memoFind.VisibilityList = []store.Visibility{store.Public}
+	} else {
+		// Private memos carrying a tag shared with the current user, or covered by a read grant, are visible as well.
+		grantedTagShares, err := s.listGrantedTagShares(ctx, currentUser.ID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list tag shares: %v", err)
+		}
+		grantedReadGrants, err := s.listGrantedReadGrants(ctx, currentUser.ID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list read grants: %v", err)
+		}
+		if memoFind.CreatorID == nil {
+			internalFilter := fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"] || group_visible_to(%d)`, currentUser.ID, currentUser.ID)
+			for _, tagShares := range grantedTagShares {
+				internalFilter = fmt.Sprintf("%s || %s", internalFilter, buildTagShareFilter(tagShares))
+			}
+			for _, readGrants := range grantedReadGrants {
+				internalFilter = fmt.Sprintf("%s || %s", internalFilter, buildReadGrantFilter(readGrants))
+			}
+			appendMemoFilter(memoFind, internalFilter)
+		} else if *memoFind.CreatorID != currentUser.ID {
+			internalFilter := fmt.Sprintf(`visibility in ["PUBLIC", "PROTECTED"] || group_visible_to(%d)`, currentUser.ID)
+			if tagShares, ok := grantedTagShares[*memoFind.CreatorID]; ok {
+				internalFilter = fmt.Sprintf("%s || %s", internalFilter, buildTagShareFilter(tagShares))
+			}
+			if readGrants, ok := grantedReadGrants[*memoFind.CreatorID]; ok {
+				internalFilter = fmt.Sprintf("%s || %s", internalFilter, buildReadGrantFilter(readGrants))
+			}
+			appendMemoFilter(memoFind, internalFilter)
+		}
+	}
+	return nil
+}
+
+// validateMemoGroups returns an InvalidArgument error unless the groups are selected along with the GROUP visibility.
+func validateMemoGroups(visibility store.Visibility, groupIDs []int32) error {
+	if visibility == store.Group && len(groupIDs) == 0 {
+		return status.Errorf(codes.InvalidArgument, "group visibility requires at least one group")
+	}
+	if visibility != store.Group && len(groupIDs) > 0 {
+		return status.Errorf(codes.InvalidArgument, "groups can only be set on memos with the group visibility")
+	}
+	return nil
+}
+
+// checkMemoSharedWith returns a PermissionDenied error unless the private or group memo is shared with the user,
+// either by a tag share, by a read grant, by a space the user is a member of or by a selected group of the memo.
+func (s *APIV1Service) checkMemoSharedWith(ctx context.Context, userID int32, memo *store.Memo) error {
+	shared, err := s.canReadMemoViaTagShare(ctx, userID, memo)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check tag shares: %v", err)
+	}
+	if shared {
+		return nil
+	}
+	shared, err = s.canReadMemoViaReadGrant(ctx, userID, memo)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check read grants: %v", err)
+	}
+	if shared {
+		return nil
+	}
+	shared, err = s.canReadMemoViaSpace(ctx, userID, memo)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check space membership: %v", err)
+	}
+	if shared {
+		return nil
+	}
+	shared, err = s.canReadMemoViaGroup(ctx, userID, memo)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check group membership: %v", err)
+	}
+	if !shared {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
 func (s *APIV1Service) GetMemo(ctx context.Context, request *v1pb.GetMemoRequest) (*v1pb.Memo, error) {
 	memoUID, err := ExtractMemoUIDFromName(request.Name)
 	if err != nil {
@@ -227,6 +718,9 @@ func (s *APIV1Service) GetMemo(ctx context.Context, request *v1pb.GetMemoRequest
 	if memo == nil {
 		return nil, status.Errorf(codes.NotFound, "memo not found")
 	}
+	if err := s.checkMemoCreatorNotSuspended(ctx, memo); err != nil {
+		return nil, err
+	}
 	if memo.Visibility != store.Public {
 		user, err := s.GetCurrentUser(ctx)
 		if err != nil {
@@ -235,8 +729,10 @@ func (s *APIV1Service) GetMemo(ctx context.Context, request *v1pb.GetMemoRequest
 		if user == nil {
 			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 		}
-		if memo.Visibility == store.Private && memo.CreatorID != user.ID {
-			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		if (memo.Visibility == store.Private || memo.Visibility == store.Group) && memo.CreatorID != user.ID {
+			if err := s.checkMemoSharedWith(ctx, user.ID, memo); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -268,14 +764,21 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user")
 	}
-	// Only the creator or admin can update the memo.
-	if memo.CreatorID != user.ID && !isSuperUser(user) {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	// Only the creator or the moderators can update the memo.
+	if memo.CreatorID != user.ID {
+		if err := s.checkPermission(ctx, user, storepb.Permission_MODERATE_MEMOS); err != nil {
+			return nil, err
+		}
 	}
 
+	// The feeds change if the memo was public, or becomes public.
+	wasPublic := memo.Visibility == store.Public
+	// Only the users newly mentioned are notified.
+	previousMentions := slices.Clone(memo.Payload.GetMentions())
 	update := &store.UpdateMemo{
 		ID: memo.ID,
 	}
+	var groupIDs []int32
 	for _, path := range request.UpdateMask.Paths {
 		if path == "content" {
 			contentLengthLimit, err := s.getContentLengthLimit(ctx)
@@ -346,12 +849,42 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 			if err != nil {
 				return nil, errors.Wrap(err, "failed to set memo relations")
 			}
+		} else if path == "groups" {
+			groupIDs, err = s.getMemoGroupIDs(ctx, request.Memo.Groups)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	// The groups are kept along with the GROUP visibility only.
+	visibility := memo.Visibility
+	if update.Visibility != nil {
+		visibility = *update.Visibility
+	}
+	if groupIDs == nil && visibility == store.Group {
+		memoGroups, err := s.Store.ListMemoGroups(ctx, &store.FindMemoGroup{MemoID: &memo.ID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list memo groups: %v", err)
+		}
+		groupIDs = []int32{}
+		for _, memoGroup := range memoGroups {
+			groupIDs = append(groupIDs, memoGroup.GroupID)
 		}
 	}
+	if err := validateMemoGroups(visibility, groupIDs); err != nil {
+		return nil, err
+	}
 
+	archived := update.RowStatus != nil && *update.RowStatus == store.Archived && memo.RowStatus != store.Archived
 	if err = s.Store.UpdateMemo(ctx, update); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update memo")
 	}
+	if groupIDs != nil || memo.Visibility == store.Group {
+		if err := s.Store.SetMemoGroups(ctx, memo.ID, groupIDs); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to set memo groups: %v", err)
+		}
+	}
+	s.memoSuggester.invalidate()
 
 	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{
 		ID: &memo.ID,
@@ -367,6 +900,15 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 	if err := s.DispatchMemoUpdatedWebhook(ctx, memoMessage); err != nil {
 		slog.Warn("Failed to dispatch memo updated webhook", slog.Any("err", err))
 	}
+	if archived {
+		if err := s.DispatchMemoArchivedWebhook(ctx, memoMessage); err != nil {
+			slog.Warn("Failed to dispatch memo archived webhook", slog.Any("err", err))
+		}
+	}
+	s.notifyMemoMentions(ctx, memo, memoMessage, previousMentions)
+	if wasPublic || memo.Visibility == store.Public {
+		s.publishMemoFeeds(ctx, memo.CreatorID)
+	}
 
 	return memoMessage, nil
 }
@@ -390,11 +932,21 @@ func (s *APIV1Service) DeleteMemo(ctx context.Context, request *v1pb.DeleteMemoR
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user")
 	}
-	// Only the creator or admin can update the memo.
-	if memo.CreatorID != user.ID && !isSuperUser(user) {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	// Only the creator or the moderators can update the memo.
+	if memo.CreatorID != user.ID {
+		if err := s.checkPermission(ctx, user, storepb.Permission_MODERATE_MEMOS); err != nil {
+			return nil, err
+		}
 	}
 
+	if err := s.deleteMemo(ctx, memo); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// deleteMemo deletes the memo with its relations, embedding, attachments and comments.
+func (s *APIV1Service) deleteMemo(ctx context.Context, memo *store.Memo) error {
 	if memoMessage, err := s.convertMemoFromStore(ctx, memo); err == nil {
 		// Try to dispatch webhook when memo is deleted.
 		if err := s.DispatchMemoDeletedWebhook(ctx, memoMessage); err != nil {
@@ -402,23 +954,42 @@ func (s *APIV1Service) DeleteMemo(ctx context.Context, request *v1pb.DeleteMemoR
 		}
 	}
 
-	if err = s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo")
+	if err := s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID}); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete memo")
+	}
+	s.memoSuggester.invalidate()
+	if memo.Visibility == store.Public {
+		s.publishMemoFeeds(ctx, memo.CreatorID)
 	}
 
 	// Delete memo relation
 	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{MemoID: &memo.ID}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo relations")
+		return status.Errorf(codes.Internal, "failed to delete memo relations")
+	}
+
+	// Delete memo groups
+	if err := s.Store.SetMemoGroups(ctx, memo.ID, nil); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete memo groups")
+	}
+
+	// Revoke memo share links
+	if err := s.Store.DeleteMemoShare(ctx, &store.DeleteMemoShare{MemoID: &memo.ID}); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete memo shares")
+	}
+
+	// Delete memo embedding
+	if err := s.Store.DeleteMemoEmbedding(ctx, &store.DeleteMemoEmbedding{MemoID: memo.ID}); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete memo embedding")
 	}
 
 	// Delete related attachments.
 	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{MemoID: &memo.ID})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list attachments")
+		return status.Errorf(codes.Internal, "failed to list attachments")
 	}
 	for _, attachment := range attachments {
 		if err := s.Store.DeleteAttachment(ctx, &store.DeleteAttachment{ID: attachment.ID}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to delete attachment")
+			return status.Errorf(codes.Internal, "failed to delete attachment")
 		}
 	}
 
@@ -426,21 +997,21 @@ func (s *APIV1Service) DeleteMemo(ctx context.Context, request *v1pb.DeleteMemoR
 	commentType := store.MemoRelationComment
 	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoID: &memo.ID, Type: &commentType})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list memo comments")
+		return status.Errorf(codes.Internal, "failed to list memo comments")
 	}
 	for _, relation := range relations {
 		if err := s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: relation.MemoID}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to delete memo comment")
+			return status.Errorf(codes.Internal, "failed to delete memo comment")
 		}
 	}
 
 	// Delete memo references
 	referenceType := store.MemoRelationReference
 	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{RelatedMemoID: &memo.ID, Type: &referenceType}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo references")
+		return status.Errorf(codes.Internal, "failed to delete memo references")
 	}
 
-	return &emptypb.Empty{}, nil
+	return nil
 }
 
 func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.CreateMemoCommentRequest) (*v1pb.Memo, error) {
@@ -480,31 +1051,16 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid memo creator")
 	}
-	if memoComment.Visibility != v1pb.Visibility_PRIVATE && creatorID != relatedMemo.CreatorID {
-		activity, err := s.Store.CreateActivity(ctx, &store.Activity{
-			CreatorID: creatorID,
-			Type:      store.ActivityTypeMemoComment,
-			Level:     store.ActivityLevelInfo,
-			Payload: &storepb.ActivityPayload{
-				MemoComment: &storepb.ActivityMemoCommentPayload{
-					MemoId:        memo.ID,
-					RelatedMemoId: relatedMemo.ID,
-				},
-			},
-		})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to create activity")
-		}
-		if _, err := s.Store.CreateInbox(ctx, &store.Inbox{
-			SenderID:   creatorID,
-			ReceiverID: relatedMemo.CreatorID,
-			Status:     store.UNREAD,
-			Message: &storepb.InboxMessage{
-				Type:       storepb.InboxMessage_MEMO_COMMENT,
-				ActivityId: &activity.ID,
-			},
-		}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to create inbox")
+	if memoComment.Visibility != v1pb.Visibility_PRIVATE {
+		if err := s.notifyMemoComment(ctx, memo, relatedMemo); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to notify memo comment: %v", err)
+		}
+	}
+	// Try to dispatch webhook to the creator of the memo, unless the comment is private to another user.
+	if memoComment.Visibility != v1pb.Visibility_PRIVATE || creatorID == relatedMemo.CreatorID {
+		memoComment.Parent = &request.Name
+		if err := s.dispatchCommentCreatedWebhook(ctx, memoComment, relatedMemo.CreatorID); err != nil {
+			slog.Warn("Failed to dispatch comment created webhook", slog.Any("err", err))
 		}
 	}
 
@@ -529,7 +1085,7 @@ func (s *APIV1Service) ListMemoComments(ctx context.Context, request *v1pb.ListM
 	if currentUser == nil {
 		memoFilter = `visibility == "PUBLIC"`
 	} else {
-		memoFilter = fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"]`, currentUser.ID)
+		memoFilter = fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"] || group_visible_to(%d)`, currentUser.ID, currentUser.ID)
 	}
 	memoRelationComment := store.MemoRelationComment
 	memoRelations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
@@ -541,7 +1097,7 @@ func (s *APIV1Service) ListMemoComments(ctx context.Context, request *v1pb.ListM
 		return nil, status.Errorf(codes.Internal, "failed to list memo relations")
 	}
 
-	var memos []*v1pb.Memo
+	comments := []*store.Memo{}
 	for _, memoRelation := range memoRelations {
 		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
 			ID: &memoRelation.MemoID,
@@ -550,12 +1106,17 @@ func (s *APIV1Service) ListMemoComments(ctx context.Context, request *v1pb.ListM
 			return nil, status.Errorf(codes.Internal, "failed to get memo")
 		}
 		if memo != nil {
-			memoMessage, err := s.convertMemoFromStore(ctx, memo)
-			if err != nil {
-				return nil, errors.Wrap(err, "failed to convert memo")
-			}
-			memos = append(memos, memoMessage)
+			comments = append(comments, memo)
+		}
+	}
+	s.renderMemoContents(ctx, comments)
+	var memos []*v1pb.Memo
+	for _, comment := range comments {
+		memoMessage, err := s.convertMemoFromStore(ctx, comment)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert memo")
 		}
+		memos = append(memos, memoMessage)
 	}
 
 	response := &v1pb.ListMemoCommentsResponse{
@@ -589,18 +1150,8 @@ func (s *APIV1Service) RenameMemoTag(ctx context.Context, request *v1pb.RenameMe
 	}
 
 	for _, memo := range memos {
-		nodes, err := parser.Parse(tokenizer.Tokenize(memo.Content))
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to parse memo: %v", err)
-		}
-		memopayload.TraverseASTNodes(nodes, func(node ast.Node) {
-			if tag, ok := node.(*ast.Tag); ok && tag.Content == request.OldTag {
-				tag.Content = request.NewTag
-			}
-		})
-		memo.Content = restore.Restore(nodes)
-		if err := memopayload.RebuildMemoPayload(memo); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to rebuild memo payload: %v", err)
+		if _, err := renameMemoTag(memo, request.OldTag, request.NewTag); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to rename memo tag: %v", err)
 		}
 		if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
 			ID:      memo.ID,
@@ -611,6 +1162,7 @@ func (s *APIV1Service) RenameMemoTag(ctx context.Context, request *v1pb.RenameMe
 		}
 	}
 
+	s.memoSuggester.invalidate()
 	return &emptypb.Empty{}, nil
 }
 
@@ -657,6 +1209,7 @@ func (s *APIV1Service) DeleteMemoTag(ctx context.Context, request *v1pb.DeleteMe
 		}
 	}
 
+	s.memoSuggester.invalidate()
 	return &emptypb.Empty{}, nil
 }
 
@@ -670,17 +1223,32 @@ func (s *APIV1Service) getContentLengthLimit(ctx context.Context) (int, error) {
 
 // DispatchMemoCreatedWebhook dispatches webhook when memo is created.
 func (s *APIV1Service) DispatchMemoCreatedWebhook(ctx context.Context, memo *v1pb.Memo) error {
-	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.created")
+	return s.dispatchMemoRelatedWebhook(ctx, memo, webhook.ActivityTypeMemoCreated)
 }
 
 // DispatchMemoUpdatedWebhook dispatches webhook when memo is updated.
 func (s *APIV1Service) DispatchMemoUpdatedWebhook(ctx context.Context, memo *v1pb.Memo) error {
-	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.updated")
+	return s.dispatchMemoRelatedWebhook(ctx, memo, webhook.ActivityTypeMemoUpdated)
 }
 
 // DispatchMemoDeletedWebhook dispatches webhook when memo is deleted.
 func (s *APIV1Service) DispatchMemoDeletedWebhook(ctx context.Context, memo *v1pb.Memo) error {
-	return s.dispatchMemoRelatedWebhook(ctx, memo, "memos.memo.deleted")
+	return s.dispatchMemoRelatedWebhook(ctx, memo, webhook.ActivityTypeMemoDeleted)
+}
+
+// DispatchMemoArchivedWebhook dispatches webhook when memo is archived, after its updated webhook.
+func (s *APIV1Service) DispatchMemoArchivedWebhook(ctx context.Context, memo *v1pb.Memo) error {
+	return s.dispatchMemoRelatedWebhook(ctx, memo, webhook.ActivityTypeMemoArchived)
+}
+
+// dispatchCommentCreatedWebhook dispatches webhook to the creator of the commented memo when a comment is created.
+func (s *APIV1Service) dispatchCommentCreatedWebhook(ctx context.Context, comment *v1pb.Memo, memoCreatorID int32) error {
+	payload, err := convertMemoToWebhookPayload(comment)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert memo to webhook payload")
+	}
+	payload.ActivityType = webhook.ActivityTypeCommentCreated
+	return s.dispatchWebhook(ctx, memoCreatorID, payload)
 }
 
 func (s *APIV1Service) dispatchMemoRelatedWebhook(ctx context.Context, memo *v1pb.Memo, activityType string) error {
@@ -688,22 +1256,38 @@ func (s *APIV1Service) dispatchMemoRelatedWebhook(ctx context.Context, memo *v1p
 	if err != nil {
 		return status.Errorf(codes.InvalidArgument, "invalid memo creator")
 	}
-	webhooks, err := s.Store.GetUserWebhooks(ctx, creatorID)
+	payload, err := convertMemoToWebhookPayload(memo)
 	if err != nil {
-		return err
+		return errors.Wrap(err, "failed to convert memo to webhook payload")
 	}
-	for _, hook := range webhooks {
-		payload, err := convertMemoToWebhookPayload(memo)
-		if err != nil {
-			return errors.Wrap(err, "failed to convert memo to webhook payload")
-		}
-		payload.ActivityType = activityType
-		payload.URL = hook.Url
+	payload.ActivityType = activityType
+	return s.dispatchWebhook(ctx, creatorID, payload)
+}
 
-		// Use asynchronous webhook dispatch
-		webhook.PostAsync(payload)
+// publishMemoFeeds enqueues the notification of the WebSub hub of the update of the feeds of the public memos
+// of the creator. The failures are retried, the subscribers still polling the feeds in the meantime.
+func (s *APIV1Service) publishMemoFeeds(ctx context.Context, creatorID int32) {
+	if s.PublishFeeds == nil {
+		return
 	}
-	return nil
+	creator, err := s.Store.GetUser(ctx, &store.FindUser{ID: &creatorID})
+	if err != nil || creator == nil {
+		slog.Warn("Failed to get memo creator to publish feeds", slog.Int("creator", int(creatorID)), slog.Any("err", err))
+		return
+	}
+	s.enqueueJob(ctx, feedPublishJobKind, feedPublishJobPayload{Username: creator.Username})
+}
+
+// runFeedPublishJob notifies the WebSub hub of the update of the feeds of the user of the job.
+func (s *APIV1Service) runFeedPublishJob(ctx context.Context, data []byte) error {
+	var payload feedPublishJobPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return errors.Wrap(err, "invalid job payload")
+	}
+	if s.PublishFeeds == nil {
+		return nil
+	}
+	return s.PublishFeeds(ctx, payload.Username)
 }
 
 func convertMemoToWebhookPayload(memo *v1pb.Memo) (*webhook.WebhookRequestPayload, error) {
@@ -749,13 +1333,19 @@ func substring(s string, length int) string {
 	return s[:byteIndex]
 }
 
+// maxRandomSeed bounds the seeds of random orders, see store.FindMemo.OrderByRandomSeed.
+const maxRandomSeed = 1 << 31
+
 // parseMemoOrderBy parses the order_by field and sets the appropriate ordering in memoFind.
 func (*APIV1Service) parseMemoOrderBy(orderBy string, memoFind *store.FindMemo) error {
-	// Parse order_by field like "display_time desc" or "create_time asc"
+	// Parse order_by field like "display_time desc", "create_time asc" or "random(42)"
 	parts := strings.Fields(strings.TrimSpace(orderBy))
 	if len(parts) == 0 {
 		return errors.New("empty order_by")
 	}
+	if len(parts) > 2 {
+		return errors.Errorf("invalid order_by: %s", orderBy)
+	}
 
 	field := parts[0]
 	direction := "desc" // default
@@ -766,20 +1356,58 @@ func (*APIV1Service) parseMemoOrderBy(orderBy string, memoFind *store.FindMemo)
 		}
 	}
 
+	if field == "random" || strings.HasPrefix(field, "random(") {
+		if len(parts) > 1 {
+			return errors.New("random order does not support a direction")
+		}
+		seed, err := parseRandomSeed(field)
+		if err != nil {
+			return err
+		}
+		memoFind.OrderByRandomSeed = &seed
+		return nil
+	}
+
 	switch field {
 	case "display_time":
 		memoFind.OrderByTimeAsc = direction == "asc"
-	case "create_time":
+	case "create_time", "created_time":
 		memoFind.OrderByTimeAsc = direction == "asc"
-	case "update_time":
+	case "update_time", "updated_time":
 		memoFind.OrderByUpdatedTs = true
 		memoFind.OrderByTimeAsc = direction == "asc"
 	case "name":
 		// For ordering by memo name/id - not commonly used but supported
 		memoFind.OrderByTimeAsc = direction == "asc"
+	case "relevance":
+		if direction != "desc" {
+			return errors.New("relevance order only supports 'desc'")
+		}
+		memoFind.OrderByRelevance = true
 	default:
-		return errors.Errorf("unsupported order field: %s, supported fields are: display_time, create_time, update_time, name", field)
+		return errors.Errorf("unsupported order field: %s, supported fields are: display_time, create_time, update_time, name, relevance, random", field)
 	}
 
 	return nil
 }
+
+// parseRandomSeed parses the seed of "random(<seed>)", picking a seed for a bare "random".
+func parseRandomSeed(field string) (int64, error) {
+	if field == "random" {
+		return rand.Int64N(maxRandomSeed-1) + 1, nil
+	}
+	if !strings.HasSuffix(field, ")") {
+		return 0, errors.Errorf("invalid random order: %s", field)
+	}
+	seed, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(field, "random("), ")"), 10, 64)
+	if err != nil || seed <= 0 || seed >= maxRandomSeed {
+		return 0, errors.Errorf("invalid random seed in %s, must be a positive integer below %d", field, maxRandomSeed)
+	}
+	return seed, nil
+}
+
+// isDisplayTimeOrder returns whether the order_by field orders by display time, which is the default.
+func isDisplayTimeOrder(orderBy string) bool {
+	parts := strings.Fields(orderBy)
+	return len(parts) == 0 || parts[0] == "display_time"
+}
@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -54,6 +55,24 @@ func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Mem
 		parentName := fmt.Sprintf("%s%s", MemoNamePrefix, parent.UID)
 		memoMessage.Parent = &parentName
 	}
+	if memo.SpaceID != store.PersonalSpaceID {
+		space, err := s.Store.GetSpace(ctx, &store.FindSpace{ID: &memo.SpaceID})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get space")
+		}
+		if space != nil {
+			memoMessage.Space = fmt.Sprintf("%s%s", SpaceNamePrefix, space.UID)
+		}
+	}
+	if memo.Visibility == store.Group {
+		memoGroups, err := s.Store.ListMemoGroups(ctx, &store.FindMemoGroup{MemoID: &memo.ID})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list memo groups")
+		}
+		for _, memoGroup := range memoGroups {
+			memoMessage.Groups = append(memoMessage.Groups, fmt.Sprintf("%s%d", GroupNamePrefix, memoGroup.GroupID))
+		}
+	}
 
 	listMemoRelationsResponse, err := s.ListMemoRelations(ctx, &v1pb.ListMemoRelationsRequest{Name: name})
 	if err != nil {
@@ -66,6 +85,10 @@ func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Mem
 		return nil, errors.Wrap(err, "failed to list memo attachments")
 	}
 	memoMessage.Attachments = listMemoAttachmentsResponse.Attachments
+	if memo.Payload != nil {
+		memoMessage.Transcript = getMemoTranscript(memo.Payload.Transcripts, memoMessage.Attachments)
+		memoMessage.LinkPreviews = convertLinkPreviewsFromStore(memo.Payload.LinkPreviews)
+	}
 
 	listMemoReactionsResponse, err := s.ListMemoReactions(ctx, &v1pb.ListMemoReactionsRequest{Name: name})
 	if err != nil {
@@ -73,13 +96,15 @@ func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Mem
 	}
 	memoMessage.Reactions = listMemoReactionsResponse.Reactions
 
-	nodes, err := parser.Parse(tokenizer.Tokenize(memo.Content))
+	// The nodes and the snippet render the content changed by the hooks, while the content is the stored one.
+	renderedContent := s.renderMemoContent(ctx, memo)
+	nodes, err := parser.Parse(tokenizer.Tokenize(renderedContent))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to parse content")
 	}
 	memoMessage.Nodes = convertFromASTNodes(nodes)
 
-	snippet, err := getMemoContentSnippet(memo.Content)
+	snippet, err := getMemoContentSnippet(renderedContent)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get memo content snippet")
 	}
@@ -88,6 +113,40 @@ func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Mem
 	return memoMessage, nil
 }
 
+// getMemoTranscript joins the transcripts of the audio attachments still attached to the memo.
+func getMemoTranscript(transcripts []*storepb.MemoPayload_Transcript, attachments []*v1pb.Attachment) string {
+	attached := map[string]bool{}
+	for _, attachment := range attachments {
+		attached[attachment.Name] = true
+	}
+	texts := []string{}
+	for _, transcript := range transcripts {
+		if attached[fmt.Sprintf("%s%s", AttachmentNamePrefix, transcript.Attachment)] && transcript.Text != "" {
+			texts = append(texts, transcript.Text)
+		}
+	}
+	return strings.Join(texts, "\n\n")
+}
+
+// convertLinkPreviewsFromStore returns the previews of the pages fetched successfully.
+func convertLinkPreviewsFromStore(linkPreviews []*storepb.MemoPayload_LinkPreview) []*v1pb.LinkPreview {
+	result := []*v1pb.LinkPreview{}
+	for _, linkPreview := range linkPreviews {
+		if linkPreview.Failed {
+			continue
+		}
+		result = append(result, &v1pb.LinkPreview{
+			Url:         linkPreview.Url,
+			Title:       linkPreview.Title,
+			Description: linkPreview.Description,
+			Image:       linkPreview.Image,
+			Favicon:     linkPreview.Favicon,
+			SiteName:    linkPreview.SiteName,
+		})
+	}
+	return result
+}
+
 func convertMemoPropertyFromStore(property *storepb.MemoPayload_Property) *v1pb.Memo_Property {
 	if property == nil {
 		return nil
@@ -97,6 +156,7 @@ func convertMemoPropertyFromStore(property *storepb.MemoPayload_Property) *v1pb.
 		HasTaskList:        property.HasTaskList,
 		HasCode:            property.HasCode,
 		HasIncompleteTasks: property.HasIncompleteTasks,
+		WordCount:          property.WordCount,
 	}
 }
 
@@ -130,6 +190,8 @@ func convertVisibilityFromStore(visibility store.Visibility) v1pb.Visibility {
 		return v1pb.Visibility_PROTECTED
 	case store.Public:
 		return v1pb.Visibility_PUBLIC
+	case store.Group:
+		return v1pb.Visibility_GROUP
 	default:
 		return v1pb.Visibility_VISIBILITY_UNSPECIFIED
 	}
@@ -143,6 +205,8 @@ func convertVisibilityToStore(visibility v1pb.Visibility) store.Visibility {
 		return store.Protected
 	case v1pb.Visibility_PUBLIC:
 		return store.Public
+	case v1pb.Visibility_GROUP:
+		return store.Group
 	default:
 		return store.Private
 	}
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/google/cel-go/cel"
 	"github.com/pkg/errors"
@@ -166,3 +167,13 @@ func findMemoField(callExpr *exprv1.Expr_Call, filter *MemoFilter) {
 		}
 	}
 }
+
+// appendMemoFilter combines the filter with the existing filter of the memo find.
+func appendMemoFilter(find *store.FindMemo, filter string) {
+	if find.Filter != nil {
+		combined := fmt.Sprintf("(%s) && (%s)", *find.Filter, filter)
+		find.Filter = &combined
+	} else {
+		find.Filter = &filter
+	}
+}
@@ -0,0 +1,191 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// memoShareTokenLength is the length of the secret token of memo share links.
+const memoShareTokenLength = 32
+
+func (s *APIV1Service) ListMemoShares(ctx context.Context, request *v1pb.ListMemoSharesRequest) (*v1pb.ListMemoSharesResponse, error) {
+	memo, err := s.getOwnedMemo(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	memoShares, err := s.Store.ListMemoShares(ctx, &store.FindMemoShare{
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo shares: %v", err)
+	}
+	response := &v1pb.ListMemoSharesResponse{
+		MemoShares: []*v1pb.MemoShare{},
+	}
+	for _, memoShare := range memoShares {
+		response.MemoShares = append(response.MemoShares, convertMemoShareFromStore(memo, memoShare))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) CreateMemoShare(ctx context.Context, request *v1pb.CreateMemoShareRequest) (*v1pb.MemoShare, error) {
+	memo, err := s.getOwnedMemo(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if request.MemoShare == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "memo share is required")
+	}
+	// The public and protected memos are already readable without a link.
+	if memo.Visibility != store.Private {
+		return nil, status.Errorf(codes.FailedPrecondition, "only private memos can be shared via a link")
+	}
+
+	create := &store.MemoShare{
+		MemoID:    memo.ID,
+		CreatorID: memo.CreatorID,
+	}
+	if request.MemoShare.ExpireTime != nil {
+		expireTime := request.MemoShare.ExpireTime.AsTime()
+		if !expireTime.After(time.Now()) {
+			return nil, status.Errorf(codes.InvalidArgument, "expire time must be in the future")
+		}
+		create.ExpiresTs = expireTime.Unix()
+	}
+	if request.MemoShare.Password != "" {
+		passwordHash, err := bcrypt.GenerateFromPassword([]byte(request.MemoShare.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate password hash: %v", err)
+		}
+		create.PasswordHash = string(passwordHash)
+	}
+	token, err := util.RandomString(memoShareTokenLength)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
+	}
+	create.Token = token
+
+	memoShare, err := s.Store.CreateMemoShare(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create memo share: %v", err)
+	}
+	return convertMemoShareFromStore(memo, memoShare), nil
+}
+
+func (s *APIV1Service) DeleteMemoShare(ctx context.Context, request *v1pb.DeleteMemoShareRequest) (*emptypb.Empty, error) {
+	memoUID, memoShareID, err := ExtractMemoShareIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo share name: %v", err)
+	}
+	memo, err := s.getOwnedMemo(ctx, fmt.Sprintf("%s%s", MemoNamePrefix, memoUID))
+	if err != nil {
+		return nil, err
+	}
+	memoShare, err := s.Store.GetMemoShare(ctx, &store.FindMemoShare{
+		ID:     &memoShareID,
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo share: %v", err)
+	}
+	if memoShare == nil {
+		return nil, status.Errorf(codes.NotFound, "memo share not found")
+	}
+	if err := s.Store.DeleteMemoShare(ctx, &store.DeleteMemoShare{ID: &memoShare.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete memo share: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) GetSharedMemo(ctx context.Context, request *v1pb.GetSharedMemoRequest) (*v1pb.Memo, error) {
+	memoShare, err := s.getOpenableMemoShare(ctx, request.Token, request.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+		ID: &memoShare.MemoID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	memoMessage, err := s.convertMemoFromStore(ctx, memo)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert memo: %v", err)
+	}
+	return memoMessage, nil
+}
+
+// getOpenableMemoShare returns the share link of the token, or an error if it expired or its password is not the given one.
+func (s *APIV1Service) getOpenableMemoShare(ctx context.Context, token, password string) (*store.MemoShare, error) {
+	if token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token is required")
+	}
+	memoShare, err := s.Store.GetMemoShare(ctx, &store.FindMemoShare{
+		Token: &token,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo share: %v", err)
+	}
+	// The expired links are reported as not found, so that they reveal nothing about the memo.
+	if memoShare == nil || (memoShare.ExpiresTs != 0 && time.Now().Unix() >= memoShare.ExpiresTs) {
+		return nil, status.Errorf(codes.NotFound, "memo share not found")
+	}
+	if memoShare.PasswordHash != "" {
+		if password == "" {
+			return nil, status.Errorf(codes.Unauthenticated, "password is required")
+		}
+		if err := bcrypt.CompareHashAndPassword([]byte(memoShare.PasswordHash), []byte(password)); err != nil {
+			return nil, status.Errorf(codes.PermissionDenied, "incorrect password")
+		}
+	}
+	return memoShare, nil
+}
+
+// getOwnedMemo returns the memo of the name, or an error if the current user is not its creator.
+func (s *APIV1Service) getOwnedMemo(ctx context.Context, name string) (*store.Memo, error) {
+	memoUID, err := ExtractMemoUIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+		UID: &memoUID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if err := s.checkResourceOwner(ctx, memo.CreatorID); err != nil {
+		return nil, err
+	}
+	return memo, nil
+}
+
+func convertMemoShareFromStore(memo *store.Memo, memoShare *store.MemoShare) *v1pb.MemoShare {
+	memoShareMessage := &v1pb.MemoShare{
+		Name:              fmt.Sprintf("%s%s/%s%d", MemoNamePrefix, memo.UID, MemoShareNamePrefix, memoShare.ID),
+		Token:             memoShare.Token,
+		PasswordProtected: memoShare.PasswordHash != "",
+		CreateTime:        timestamppb.New(time.Unix(memoShare.CreatedTs, 0)),
+	}
+	if memoShare.ExpiresTs != 0 {
+		memoShareMessage.ExpireTime = timestamppb.New(time.Unix(memoShare.ExpiresTs, 0))
+	}
+	return memoShareMessage
+}
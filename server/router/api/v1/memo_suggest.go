@@ -0,0 +1,194 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/typeahead"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// suggestIndexTTL is how long the suggestion index is used before it is rebuilt from the store.
+	suggestIndexTTL = time.Minute
+	// defaultSuggestLimit is the default number of suggestions of each kind.
+	defaultSuggestLimit = 10
+	// maxSuggestLimit is the maximum number of suggestions of each kind.
+	maxSuggestLimit = 50
+	// maxRecentQueries is the number of recent search queries remembered per user.
+	maxRecentQueries = 20
+	// maxSuggestTitleLength is the maximum length of a memo title, in characters.
+	maxSuggestTitleLength = 100
+)
+
+// suggestEntry is the owner and visibility of an indexed tag or memo title.
+type suggestEntry struct {
+	memoUID    string
+	creatorID  int32
+	visibility store.Visibility
+}
+
+// memoSuggester keeps the in-memory indexes of the tags and memo titles, and the recent search queries of the users.
+// Its zero value is ready to use.
+type memoSuggester struct {
+	mu sync.Mutex
+	// tags and titles are indexed by the position of their entry.
+	tags    *typeahead.Index
+	titles  *typeahead.Index
+	entries []*suggestEntry
+	builtAt time.Time
+	// recentQueries are the recent search queries of each user, the most recent first.
+	recentQueries map[int32][]string
+}
+
+func (s *APIV1Service) SuggestMemos(ctx context.Context, request *v1pb.SuggestMemosRequest) (*v1pb.SuggestMemosResponse, error) {
+	prefix := strings.TrimSpace(request.Prefix)
+	if prefix == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "prefix is required")
+	}
+	limit := int(request.Limit)
+	if limit <= 0 {
+		limit = defaultSuggestLimit
+	}
+	limit = min(limit, maxSuggestLimit)
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+
+	tags, titles, entries, err := s.memoSuggester.getIndexes(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to build suggestion index: %v", err)
+	}
+	isVisible := func(item *typeahead.Item) bool {
+		entry := entries[item.ID]
+		if currentUser == nil {
+			return entry.visibility == store.Public
+		}
+		return entry.creatorID == currentUser.ID || entry.visibility == store.Public || entry.visibility == store.Protected
+	}
+
+	response := &v1pb.SuggestMemosResponse{
+		Tags:          []string{},
+		Memos:         []*v1pb.SuggestMemosResponse_MemoSuggestion{},
+		RecentQueries: []string{},
+	}
+	// The same tag is indexed once per creator and visibility.
+	tagPrefix := strings.TrimPrefix(prefix, "#")
+	for _, item := range tags.Search(tagPrefix, len(entries), isVisible) {
+		if !slices.Contains(response.Tags, item.Text) {
+			response.Tags = append(response.Tags, item.Text)
+		}
+		if len(response.Tags) == limit {
+			break
+		}
+	}
+	for _, item := range titles.Search(prefix, limit, isVisible) {
+		response.Memos = append(response.Memos, &v1pb.SuggestMemosResponse_MemoSuggestion{
+			Memo:  fmt.Sprintf("%s%s", MemoNamePrefix, entries[item.ID].memoUID),
+			Title: item.Text,
+		})
+	}
+	if currentUser != nil {
+		lowerPrefix := strings.ToLower(prefix)
+		for _, query := range s.memoSuggester.getRecentQueries(currentUser.ID) {
+			if strings.HasPrefix(strings.ToLower(query), lowerPrefix) {
+				response.RecentQueries = append(response.RecentQueries, query)
+			}
+			if len(response.RecentQueries) == limit {
+				break
+			}
+		}
+	}
+	return response, nil
+}
+
+// getIndexes returns the tag and title indexes, rebuilding them if they are stale.
+func (m *memoSuggester) getIndexes(ctx context.Context, s *store.Store) (*typeahead.Index, *typeahead.Index, []*suggestEntry, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.tags != nil && time.Since(m.builtAt) < suggestIndexTTL {
+		return m.tags, m.titles, m.entries, nil
+	}
+
+	normalStatus := store.Normal
+	memos, err := s.ListMemos(ctx, &store.FindMemo{
+		RowStatus:       &normalStatus,
+		ExcludeComments: true,
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	entries := []*suggestEntry{}
+	tagItems := []*typeahead.Item{}
+	titleItems := []*typeahead.Item{}
+	// Tags are weighted by their number of memos for each creator and visibility.
+	tagPositions := map[suggestEntry]map[string]int{}
+	for _, memo := range memos {
+		if title := memopayload.GetMemoTitle(memo.Content, maxSuggestTitleLength); title != "" {
+			titleItems = append(titleItems, &typeahead.Item{ID: len(entries), Text: title, Weight: memo.UpdatedTs})
+			entries = append(entries, &suggestEntry{memoUID: memo.UID, creatorID: memo.CreatorID, visibility: memo.Visibility})
+		}
+		if memo.Payload == nil {
+			continue
+		}
+		owner := suggestEntry{creatorID: memo.CreatorID, visibility: memo.Visibility}
+		if tagPositions[owner] == nil {
+			tagPositions[owner] = map[string]int{}
+		}
+		for _, tag := range memo.Payload.Tags {
+			position, ok := tagPositions[owner][tag]
+			if !ok {
+				position = len(tagItems)
+				tagPositions[owner][tag] = position
+				tagItems = append(tagItems, &typeahead.Item{ID: len(entries), Text: tag})
+				entries = append(entries, &suggestEntry{creatorID: memo.CreatorID, visibility: memo.Visibility})
+			}
+			tagItems[position].Weight++
+		}
+	}
+	m.tags = typeahead.NewIndex(tagItems)
+	m.titles = typeahead.NewIndex(titleItems)
+	m.entries = entries
+	m.builtAt = time.Now()
+	return m.tags, m.titles, m.entries, nil
+}
+
+// invalidate makes the next suggestion rebuild the indexes, e.g. after a memo is changed.
+func (m *memoSuggester) invalidate() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tags, m.titles, m.entries = nil, nil, nil
+}
+
+// addRecentQuery remembers the search query of the user, moving it first if it was already remembered.
+func (m *memoSuggester) addRecentQuery(userID int32, query string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.recentQueries == nil {
+		m.recentQueries = map[int32][]string{}
+	}
+	queries := slices.DeleteFunc(m.recentQueries[userID], func(q string) bool {
+		return strings.EqualFold(q, query)
+	})
+	queries = append([]string{query}, queries...)
+	if len(queries) > maxRecentQueries {
+		queries = queries[:maxRecentQueries]
+	}
+	m.recentQueries[userID] = queries
+}
+
+func (m *memoSuggester) getRecentQueries(userID int32) []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.recentQueries[userID])
+}
@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"context"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/internal/passwordpolicy"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// checkPasswordPolicy returns an InvalidArgument error if the password does not satisfy the workspace password policy.
+func (s *APIV1Service) checkPasswordPolicy(ctx context.Context, password string) error {
+	policy, err := s.Store.GetWorkspacePasswordPolicySetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace password policy setting: %v", err)
+	}
+	if err := passwordpolicy.Check(ctx, policy, password); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return nil
+}
+
+// updatePasswordChangeRequirement requires the user to change the password set by another user, if the workspace requires it,
+// and lifts the requirement once the user sets their own password.
+func (s *APIV1Service) updatePasswordChangeRequirement(ctx context.Context, setter, user *store.User) error {
+	changeRequired := false
+	if setter != nil && setter.ID != user.ID {
+		policy, err := s.Store.GetWorkspacePasswordPolicySetting(ctx)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get workspace password policy setting: %v", err)
+		}
+		changeRequired = policy.RequireChangeAfterReset
+	}
+	password, err := s.Store.GetUserPassword(ctx, user.ID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user password: %v", err)
+	}
+	if password.ChangeRequired == changeRequired {
+		return nil
+	}
+	if err := s.Store.UpsertUserPassword(ctx, user.ID, &storepb.PasswordUserSetting{ChangeRequired: changeRequired}); err != nil {
+		return status.Errorf(codes.Internal, "failed to upsert user password: %v", err)
+	}
+	return nil
+}
+
+// checkPasswordRotated returns an InvalidArgument error if the user required to change their password sets the same one again.
+func (s *APIV1Service) checkPasswordRotated(ctx context.Context, user *store.User, password string) error {
+	userPassword, err := s.Store.GetUserPassword(ctx, user.ID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user password: %v", err)
+	}
+	if userPassword.ChangeRequired && bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)) == nil {
+		return status.Errorf(codes.InvalidArgument, "new password must differ from the one set by the admin")
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"google.golang.org/grpc/codes"
@@ -48,6 +49,9 @@ func (s *APIV1Service) UpsertMemoReaction(ctx context.Context, request *v1pb.Ups
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to upsert reaction")
 	}
+	if err := s.notifyMemoReaction(ctx, reaction); err != nil {
+		slog.Warn("Failed to notify memo reaction", slog.Int("reaction", int(reaction.ID)), slog.Any("err", err))
+	}
 
 	reactionMessage, err := s.convertReactionFromStore(ctx, reaction)
 	if err != nil {
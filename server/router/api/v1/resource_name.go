@@ -10,15 +10,27 @@ import (
 )
 
 const (
-	WorkspaceSettingNamePrefix = "workspace/settings/"
-	UserNamePrefix             = "users/"
-	MemoNamePrefix             = "memos/"
-	AttachmentNamePrefix       = "attachments/"
-	ReactionNamePrefix         = "reactions/"
-	InboxNamePrefix            = "inboxes/"
-	IdentityProviderNamePrefix = "identityProviders/"
-	ActivityNamePrefix         = "activities/"
-	WebhookNamePrefix          = "webhooks/"
+	WorkspaceSettingNamePrefix    = "workspace/settings/"
+	JobNamePrefix                 = "workspace/jobs/"
+	ScheduledTaskNamePrefix       = "workspace/scheduledTasks/"
+	UserNamePrefix                = "users/"
+	MemoNamePrefix                = "memos/"
+	AttachmentNamePrefix          = "attachments/"
+	AttachmentUploadNamePrefix    = "attachmentUploads/"
+	AttachmentVersionNamePrefix   = "versions/"
+	ReactionNamePrefix            = "reactions/"
+	InboxNamePrefix               = "inboxes/"
+	IdentityProviderNamePrefix    = "identityProviders/"
+	ActivityNamePrefix            = "activities/"
+	WebhookNamePrefix             = "webhooks/"
+	WebhookDeliveryNamePrefix     = "deliveries/"
+	InvitationNamePrefix          = "invitations/"
+	MemoShareNamePrefix           = "shares/"
+	SpaceNamePrefix               = "spaces/"
+	SpaceMemberNamePrefix         = "members/"
+	GroupNamePrefix               = "groups/"
+	ReadGrantNamePrefix           = "readGrants/"
+	WebPushSubscriptionNamePrefix = "webPushSubscriptions/"
 )
 
 // GetNameParentTokens returns the tokens from a resource name.
@@ -73,6 +85,46 @@ func ExtractUserIDFromName(name string) (int32, error) {
 	return id, nil
 }
 
+// ExtractInvitationIDFromName returns the invitation ID from a resource name.
+func ExtractInvitationIDFromName(name string) (int32, error) {
+	tokens, err := GetNameParentTokens(name, InvitationNamePrefix)
+	if err != nil {
+		return 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, errors.Errorf("invalid invitation ID %q", tokens[0])
+	}
+	return id, nil
+}
+
+// ExtractJobIDFromName returns the job ID from a resource name.
+// e.g., "workspace/jobs/1" -> 1.
+func ExtractJobIDFromName(name string) (int32, error) {
+	if !strings.HasPrefix(name, JobNamePrefix) {
+		return 0, errors.Errorf("invalid job name: expected prefix %q, got %q", JobNamePrefix, name)
+	}
+	rawID := strings.TrimPrefix(name, JobNamePrefix)
+	id, err := util.ConvertStringToInt32(rawID)
+	if err != nil {
+		return 0, errors.Errorf("invalid job ID %q", rawID)
+	}
+	return id, nil
+}
+
+// ExtractScheduledTaskNameFromName returns the task name from a resource name.
+// e.g., "workspace/scheduledTasks/memo_change_cleanup" -> "memo_change_cleanup".
+func ExtractScheduledTaskNameFromName(name string) (string, error) {
+	if !strings.HasPrefix(name, ScheduledTaskNamePrefix) {
+		return "", errors.Errorf("invalid scheduled task name: expected prefix %q, got %q", ScheduledTaskNamePrefix, name)
+	}
+	taskName := strings.TrimPrefix(name, ScheduledTaskNamePrefix)
+	if taskName == "" || strings.Contains(taskName, "/") {
+		return "", errors.Errorf("invalid scheduled task name %q", name)
+	}
+	return taskName, nil
+}
+
 // ExtractMemoUIDFromName returns the memo UID from a resource name.
 // e.g., "memos/uuid" -> "uuid".
 func ExtractMemoUIDFromName(name string) (string, error) {
@@ -94,6 +146,100 @@ func ExtractAttachmentUIDFromName(name string) (string, error) {
 	return id, nil
 }
 
+// ExtractAttachmentVersionIDFromName returns the attachment UID and the version ID from a resource name.
+// e.g., "attachments/uuid/versions/1" -> "uuid", 1.
+func ExtractAttachmentVersionIDFromName(name string) (string, int32, error) {
+	tokens, err := GetNameParentTokens(name, AttachmentNamePrefix, AttachmentVersionNamePrefix)
+	if err != nil {
+		return "", 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil {
+		return "", 0, errors.Errorf("invalid attachment version ID %q", tokens[1])
+	}
+	return tokens[0], id, nil
+}
+
+// ExtractMemoShareIDFromName returns the memo UID and the memo share ID from a resource name.
+// e.g., "memos/uuid/shares/1" -> "uuid", 1.
+func ExtractMemoShareIDFromName(name string) (string, int32, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, MemoShareNamePrefix)
+	if err != nil {
+		return "", 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil {
+		return "", 0, errors.Errorf("invalid memo share ID %q", tokens[1])
+	}
+	return tokens[0], id, nil
+}
+
+// ExtractSpaceUIDFromName returns the space UID from a resource name.
+// e.g., "spaces/uuid" -> "uuid".
+func ExtractSpaceUIDFromName(name string) (string, error) {
+	tokens, err := GetNameParentTokens(name, SpaceNamePrefix)
+	if err != nil {
+		return "", err
+	}
+	return tokens[0], nil
+}
+
+// ExtractSpaceMemberIDFromName returns the space UID and the user ID of the member from a resource name.
+// e.g., "spaces/uuid/members/1" -> "uuid", 1.
+func ExtractSpaceMemberIDFromName(name string) (string, int32, error) {
+	tokens, err := GetNameParentTokens(name, SpaceNamePrefix, SpaceMemberNamePrefix)
+	if err != nil {
+		return "", 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil {
+		return "", 0, errors.Errorf("invalid space member ID %q", tokens[1])
+	}
+	return tokens[0], id, nil
+}
+
+// ExtractGroupIDFromName returns the user group ID from a resource name.
+// e.g., "groups/1" -> 1.
+func ExtractGroupIDFromName(name string) (int32, error) {
+	tokens, err := GetNameParentTokens(name, GroupNamePrefix)
+	if err != nil {
+		return 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, errors.Errorf("invalid group ID %q", tokens[0])
+	}
+	return id, nil
+}
+
+// ExtractReadGrantIDFromName returns the user ID and the read grant ID from a resource name.
+// e.g., "users/1/readGrants/2" -> 1, 2.
+func ExtractReadGrantIDFromName(name string) (int32, int32, error) {
+	tokens, err := GetNameParentTokens(name, UserNamePrefix, ReadGrantNamePrefix)
+	if err != nil {
+		return 0, 0, err
+	}
+	userID, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid user ID %q", tokens[0])
+	}
+	id, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid read grant ID %q", tokens[1])
+	}
+	return userID, id, nil
+}
+
+// ExtractAttachmentUploadUIDFromName returns the attachment upload UID from a resource name.
+func ExtractAttachmentUploadUIDFromName(name string) (string, error) {
+	tokens, err := GetNameParentTokens(name, AttachmentUploadNamePrefix)
+	if err != nil {
+		return "", err
+	}
+	id := tokens[0]
+	return id, nil
+}
+
 // ExtractReactionIDFromName returns the reaction ID from a resource name.
 // e.g., "reactions/123" -> 123.
 func ExtractReactionIDFromName(name string) (int32, error) {
@@ -145,6 +291,20 @@ func ExtractActivityIDFromName(name string) (int32, error) {
 	return id, nil
 }
 
+// ExtractWebPushSubscriptionIDFromName returns the user ID and the subscription ID from a resource name.
+// e.g., "users/1/webPushSubscriptions/abc" -> 1, "abc".
+func ExtractWebPushSubscriptionIDFromName(name string) (int32, string, error) {
+	tokens, err := GetNameParentTokens(name, UserNamePrefix, WebPushSubscriptionNamePrefix)
+	if err != nil {
+		return 0, "", err
+	}
+	userID, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, "", errors.Errorf("invalid user ID %q", tokens[0])
+	}
+	return userID, tokens[1], nil
+}
+
 // ExtractWebhookIDFromName returns the webhook ID from a resource name.
 func ExtractWebhookIDFromName(name string) (string, error) {
 	tokens, err := GetNameParentTokens(name, UserNamePrefix, WebhookNamePrefix)
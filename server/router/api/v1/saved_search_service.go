@@ -0,0 +1,291 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// Helper function to extract user ID and saved search ID from saved search resource name.
+// Format: users/{user}/savedSearches/{saved_search}.
+func extractUserAndSavedSearchIDFromName(name string) (int32, string, error) {
+	parts := strings.Split(name, "/")
+	if len(parts) != 4 || parts[0] != "users" || parts[2] != "savedSearches" {
+		return 0, "", errors.Errorf("invalid saved search name format: %s", name)
+	}
+
+	userID, err := util.ConvertStringToInt32(parts[1])
+	if err != nil {
+		return 0, "", errors.Errorf("invalid user ID %q", parts[1])
+	}
+
+	savedSearchID := parts[3]
+	if savedSearchID == "" {
+		return 0, "", errors.Errorf("empty saved search ID in name: %s", name)
+	}
+
+	return userID, savedSearchID, nil
+}
+
+// Helper function to construct saved search resource name.
+func constructSavedSearchName(userID int32, savedSearchID string) string {
+	return fmt.Sprintf("users/%d/savedSearches/%s", userID, savedSearchID)
+}
+
+func (s *APIV1Service) ListSavedSearches(ctx context.Context, request *v1pb.ListSavedSearchesRequest) (*v1pb.ListSavedSearchesResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	savedSearches, err := s.Store.GetUserSavedSearches(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get saved searches: %v", err)
+	}
+	response := &v1pb.ListSavedSearchesResponse{
+		SavedSearches: []*v1pb.SavedSearch{},
+	}
+	for _, savedSearch := range savedSearches {
+		response.SavedSearches = append(response.SavedSearches, convertSavedSearchFromStore(userID, savedSearch))
+	}
+	// Pinned saved searches first, otherwise in creation order.
+	slices.SortStableFunc(response.SavedSearches, func(a, b *v1pb.SavedSearch) int {
+		if a.Pinned == b.Pinned {
+			return 0
+		}
+		if a.Pinned {
+			return -1
+		}
+		return 1
+	})
+	return response, nil
+}
+
+func (s *APIV1Service) GetSavedSearch(ctx context.Context, request *v1pb.GetSavedSearchRequest) (*v1pb.SavedSearch, error) {
+	userID, savedSearch, err := s.getSavedSearch(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return convertSavedSearchFromStore(userID, savedSearch), nil
+}
+
+func (s *APIV1Service) CreateSavedSearch(ctx context.Context, request *v1pb.CreateSavedSearchRequest) (*v1pb.SavedSearch, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	if request.SavedSearch == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "saved search is required")
+	}
+
+	newSavedSearch := &storepb.SavedSearchesUserSetting_SavedSearch{
+		Id:      util.GenUUID(),
+		Title:   request.SavedSearch.Title,
+		Query:   strings.TrimSpace(request.SavedSearch.Query),
+		Filter:  request.SavedSearch.Filter,
+		OrderBy: request.SavedSearch.OrderBy,
+		Fuzzy:   request.SavedSearch.Fuzzy,
+		Pinned:  request.SavedSearch.Pinned,
+	}
+	if err := s.validateSavedSearch(ctx, newSavedSearch); err != nil {
+		return nil, err
+	}
+
+	savedSearches, err := s.Store.GetUserSavedSearches(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get saved searches: %v", err)
+	}
+	if err := s.Store.UpsertUserSavedSearches(ctx, userID, append(savedSearches, newSavedSearch)); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create saved search: %v", err)
+	}
+	return convertSavedSearchFromStore(userID, newSavedSearch), nil
+}
+
+func (s *APIV1Service) UpdateSavedSearch(ctx context.Context, request *v1pb.UpdateSavedSearchRequest) (*v1pb.SavedSearch, error) {
+	if request.SavedSearch == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "saved search is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	userID, savedSearchID, err := extractUserAndSavedSearchIDFromName(request.SavedSearch.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid saved search name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	savedSearches, err := s.Store.GetUserSavedSearches(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get saved searches: %v", err)
+	}
+	index := slices.IndexFunc(savedSearches, func(savedSearch *storepb.SavedSearchesUserSetting_SavedSearch) bool {
+		return savedSearch.Id == savedSearchID
+	})
+	if index < 0 {
+		return nil, status.Errorf(codes.NotFound, "saved search not found")
+	}
+
+	savedSearch := savedSearches[index]
+	for _, field := range request.UpdateMask.Paths {
+		switch field {
+		case "title":
+			savedSearch.Title = request.SavedSearch.Title
+		case "query":
+			savedSearch.Query = strings.TrimSpace(request.SavedSearch.Query)
+		case "filter":
+			savedSearch.Filter = request.SavedSearch.Filter
+		case "order_by":
+			savedSearch.OrderBy = request.SavedSearch.OrderBy
+		case "fuzzy":
+			savedSearch.Fuzzy = request.SavedSearch.Fuzzy
+		case "pinned":
+			savedSearch.Pinned = request.SavedSearch.Pinned
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update field: %s", field)
+		}
+	}
+	if err := s.validateSavedSearch(ctx, savedSearch); err != nil {
+		return nil, err
+	}
+	if err := s.Store.UpsertUserSavedSearches(ctx, userID, savedSearches); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update saved search: %v", err)
+	}
+	return convertSavedSearchFromStore(userID, savedSearch), nil
+}
+
+func (s *APIV1Service) DeleteSavedSearch(ctx context.Context, request *v1pb.DeleteSavedSearchRequest) (*emptypb.Empty, error) {
+	userID, savedSearchID, err := extractUserAndSavedSearchIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid saved search name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	savedSearches, err := s.Store.GetUserSavedSearches(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get saved searches: %v", err)
+	}
+	newSavedSearches := slices.DeleteFunc(slices.Clone(savedSearches), func(savedSearch *storepb.SavedSearchesUserSetting_SavedSearch) bool {
+		return savedSearch.Id == savedSearchID
+	})
+	if len(newSavedSearches) == len(savedSearches) {
+		return nil, status.Errorf(codes.NotFound, "saved search not found")
+	}
+	if err := s.Store.UpsertUserSavedSearches(ctx, userID, newSavedSearches); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete saved search: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) ExecuteSavedSearch(ctx context.Context, request *v1pb.ExecuteSavedSearchRequest) (*v1pb.ExecuteSavedSearchResponse, error) {
+	_, savedSearch, err := s.getSavedSearch(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if savedSearch.Query == "" {
+		listResponse, err := s.ListMemos(ctx, &v1pb.ListMemosRequest{
+			Filter:    savedSearch.Filter,
+			OrderBy:   savedSearch.OrderBy,
+			PageSize:  request.PageSize,
+			PageToken: request.PageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &v1pb.ExecuteSavedSearchResponse{
+			Memos:         listResponse.Memos,
+			NextPageToken: listResponse.NextPageToken,
+		}, nil
+	}
+
+	searchResponse, err := s.SearchMemos(ctx, &v1pb.SearchMemosRequest{
+		Query:     savedSearch.Query,
+		Filter:    savedSearch.Filter,
+		OrderBy:   savedSearch.OrderBy,
+		Fuzzy:     savedSearch.Fuzzy,
+		PageSize:  request.PageSize,
+		PageToken: request.PageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.ExecuteSavedSearchResponse{
+		Memos:         searchResponse.Memos,
+		NextPageToken: searchResponse.NextPageToken,
+	}, nil
+}
+
+// getSavedSearch returns the saved search of the name, after checking that the current user owns it.
+func (s *APIV1Service) getSavedSearch(ctx context.Context, name string) (int32, *storepb.SavedSearchesUserSetting_SavedSearch, error) {
+	userID, savedSearchID, err := extractUserAndSavedSearchIDFromName(name)
+	if err != nil {
+		return 0, nil, status.Errorf(codes.InvalidArgument, "invalid saved search name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return 0, nil, err
+	}
+
+	savedSearches, err := s.Store.GetUserSavedSearches(ctx, userID)
+	if err != nil {
+		return 0, nil, status.Errorf(codes.Internal, "failed to get saved searches: %v", err)
+	}
+	for _, savedSearch := range savedSearches {
+		if savedSearch.Id == savedSearchID {
+			return userID, savedSearch, nil
+		}
+	}
+	return 0, nil, status.Errorf(codes.NotFound, "saved search not found")
+}
+
+func (s *APIV1Service) validateSavedSearch(ctx context.Context, savedSearch *storepb.SavedSearchesUserSetting_SavedSearch) error {
+	if savedSearch.Title == "" {
+		return status.Errorf(codes.InvalidArgument, "title is required")
+	}
+	if savedSearch.Filter != "" {
+		if err := s.validateFilter(ctx, savedSearch.Filter); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+	}
+	if savedSearch.OrderBy != "" {
+		if err := s.parseMemoOrderBy(savedSearch.OrderBy, &store.FindMemo{}); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid order_by: %v", err)
+		}
+	}
+	if savedSearch.Fuzzy && savedSearch.Query == "" {
+		return status.Errorf(codes.InvalidArgument, "fuzzy requires a query")
+	}
+	return nil
+}
+
+func convertSavedSearchFromStore(userID int32, savedSearch *storepb.SavedSearchesUserSetting_SavedSearch) *v1pb.SavedSearch {
+	return &v1pb.SavedSearch{
+		Name:    constructSavedSearchName(userID, savedSearch.Id),
+		Title:   savedSearch.Title,
+		Query:   savedSearch.Query,
+		Filter:  savedSearch.Filter,
+		OrderBy: savedSearch.OrderBy,
+		Fuzzy:   savedSearch.Fuzzy,
+		Pinned:  savedSearch.Pinned,
+	}
+}
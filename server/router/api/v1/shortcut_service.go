@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -73,11 +74,7 @@ func (s *APIV1Service) ListShortcuts(ctx context.Context, request *v1pb.ListShor
 	shortcutsUserSetting := userSetting.GetShortcuts()
 	shortcuts := []*v1pb.Shortcut{}
 	for _, shortcut := range shortcutsUserSetting.GetShortcuts() {
-		shortcuts = append(shortcuts, &v1pb.Shortcut{
-			Name:   constructShortcutName(userID, shortcut.GetId()),
-			Title:  shortcut.GetTitle(),
-			Filter: shortcut.GetFilter(),
-		})
+		shortcuts = append(shortcuts, convertShortcutFromStore(userID, shortcut))
 	}
 
 	return &v1pb.ListShortcutsResponse{
@@ -95,7 +92,7 @@ func (s *APIV1Service) GetShortcut(ctx context.Context, request *v1pb.GetShortcu
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	if currentUser == nil || currentUser.ID != userID {
+	if currentUser == nil {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
@@ -107,23 +104,68 @@ func (s *APIV1Service) GetShortcut(ctx context.Context, request *v1pb.GetShortcu
 		return nil, err
 	}
 	if userSetting == nil {
+		if currentUser.ID != userID {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
 		return nil, status.Errorf(codes.NotFound, "shortcut not found")
 	}
 
 	shortcutsUserSetting := userSetting.GetShortcuts()
 	for _, shortcut := range shortcutsUserSetting.GetShortcuts() {
-		if shortcut.GetId() == shortcutID {
-			return &v1pb.Shortcut{
-				Name:   constructShortcutName(userID, shortcut.GetId()),
-				Title:  shortcut.GetTitle(),
-				Filter: shortcut.GetFilter(),
-			}, nil
+		if shortcut.GetId() != shortcutID {
+			continue
+		}
+		if currentUser.ID == userID {
+			return convertShortcutFromStore(userID, shortcut), nil
 		}
+		if !canReadShortcut(currentUser.ID, shortcut) {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+		return s.convertSharedShortcutFromStore(ctx, userID, shortcut)
 	}
 
+	if currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
 	return nil, status.Errorf(codes.NotFound, "shortcut not found")
 }
 
+func (s *APIV1Service) ListSharedShortcuts(ctx context.Context, _ *v1pb.ListSharedShortcutsRequest) (*v1pb.ListSharedShortcutsResponse, error) {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	userSettings, err := s.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSetting_SHORTCUTS,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list shortcuts: %v", err)
+	}
+	response := &v1pb.ListSharedShortcutsResponse{
+		Shortcuts: []*v1pb.Shortcut{},
+	}
+	for _, userSetting := range userSettings {
+		if userSetting.UserId == currentUser.ID {
+			continue
+		}
+		for _, shortcut := range userSetting.GetShortcuts().GetShortcuts() {
+			if !canReadShortcut(currentUser.ID, shortcut) {
+				continue
+			}
+			sharedShortcut, err := s.convertSharedShortcutFromStore(ctx, userSetting.UserId, shortcut)
+			if err != nil {
+				return nil, err
+			}
+			response.Shortcuts = append(response.Shortcuts, sharedShortcut)
+		}
+	}
+	return response, nil
+}
+
 func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateShortcutRequest) (*v1pb.Shortcut, error) {
 	userID, err := ExtractUserIDFromName(request.Parent)
 	if err != nil {
@@ -139,9 +181,10 @@ func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateS
 	}
 
 	newShortcut := &storepb.ShortcutsUserSetting_Shortcut{
-		Id:     util.GenUUID(),
-		Title:  request.Shortcut.GetTitle(),
-		Filter: request.Shortcut.GetFilter(),
+		Id:         util.GenUUID(),
+		Title:      request.Shortcut.GetTitle(),
+		Filter:     request.Shortcut.GetFilter(),
+		Visibility: storepb.ShortcutsUserSetting_Visibility(request.Shortcut.GetVisibility()),
 	}
 	if newShortcut.Title == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "title is required")
@@ -149,12 +192,14 @@ func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateS
 	if err := s.validateFilter(ctx, newShortcut.Filter); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 	}
+	if newShortcut.SharedUserIds, err = s.convertShortcutSharedUsers(ctx, request.Shortcut.GetSharedUsers()); err != nil {
+		return nil, err
+	}
+	if err := validateShortcutSharing(currentUser, newShortcut); err != nil {
+		return nil, err
+	}
 	if request.ValidateOnly {
-		return &v1pb.Shortcut{
-			Name:   constructShortcutName(userID, newShortcut.GetId()),
-			Title:  newShortcut.GetTitle(),
-			Filter: newShortcut.GetFilter(),
-		}, nil
+		return convertShortcutFromStore(userID, newShortcut), nil
 	}
 
 	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
@@ -189,11 +234,7 @@ func (s *APIV1Service) CreateShortcut(ctx context.Context, request *v1pb.CreateS
 		return nil, err
 	}
 
-	return &v1pb.Shortcut{
-		Name:   constructShortcutName(userID, newShortcut.GetId()),
-		Title:  newShortcut.GetTitle(),
-		Filter: newShortcut.GetFilter(),
-	}, nil
+	return convertShortcutFromStore(userID, newShortcut), nil
 }
 
 func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateShortcutRequest) (*v1pb.Shortcut, error) {
@@ -242,8 +283,19 @@ func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateS
 						return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 					}
 					shortcut.Filter = request.Shortcut.GetFilter()
+				} else if field == "visibility" {
+					shortcut.Visibility = storepb.ShortcutsUserSetting_Visibility(request.Shortcut.GetVisibility())
+				} else if field == "shared_users" {
+					sharedUserIDs, err := s.convertShortcutSharedUsers(ctx, request.Shortcut.GetSharedUsers())
+					if err != nil {
+						return nil, err
+					}
+					shortcut.SharedUserIds = sharedUserIDs
 				}
 			}
+			if err := validateShortcutSharing(currentUser, shortcut); err != nil {
+				return nil, err
+			}
 		}
 		newShortcuts = append(newShortcuts, shortcut)
 	}
@@ -261,11 +313,7 @@ func (s *APIV1Service) UpdateShortcut(ctx context.Context, request *v1pb.UpdateS
 		return nil, err
 	}
 
-	return &v1pb.Shortcut{
-		Name:   constructShortcutName(userID, foundShortcut.GetId()),
-		Title:  foundShortcut.GetTitle(),
-		Filter: foundShortcut.GetFilter(),
-	}, nil
+	return convertShortcutFromStore(userID, foundShortcut), nil
 }
 
 func (s *APIV1Service) DeleteShortcut(ctx context.Context, request *v1pb.DeleteShortcutRequest) (*emptypb.Empty, error) {
@@ -319,7 +367,20 @@ func (s *APIV1Service) DeleteShortcut(ctx context.Context, request *v1pb.DeleteS
 	return &emptypb.Empty{}, nil
 }
 
-func (s *APIV1Service) validateFilter(_ context.Context, filterStr string) error {
+// validateFilter validates the filter after expanding the filter macros of the current user.
+func (s *APIV1Service) validateFilter(ctx context.Context, filterStr string) error {
+	if filterStr == "" {
+		return errors.New("filter cannot be empty")
+	}
+	expanded, err := s.expandFilterMacros(ctx, filterStr)
+	if err != nil {
+		return errors.Wrap(err, "failed to expand filter macros")
+	}
+	return s.validateExpandedFilter(ctx, expanded)
+}
+
+// validateExpandedFilter validates a filter whose macros are already expanded.
+func (s *APIV1Service) validateExpandedFilter(_ context.Context, filterStr string) error {
 	if filterStr == "" {
 		return errors.New("filter cannot be empty")
 	}
@@ -335,3 +396,93 @@ func (s *APIV1Service) validateFilter(_ context.Context, filterStr string) error
 	}
 	return nil
 }
+
+// validateShortcutSharing checks the visibility and the shared users of the shortcut owned by the user.
+func validateShortcutSharing(user *store.User, shortcut *storepb.ShortcutsUserSetting_Shortcut) error {
+	switch shortcut.Visibility {
+	case storepb.ShortcutsUserSetting_VISIBILITY_UNSPECIFIED, storepb.ShortcutsUserSetting_PRIVATE, storepb.ShortcutsUserSetting_WORKSPACE:
+		if len(shortcut.SharedUserIds) > 0 {
+			return status.Errorf(codes.InvalidArgument, "shared users require the SHARED visibility")
+		}
+		if shortcut.Visibility == storepb.ShortcutsUserSetting_WORKSPACE && !isSuperUser(user) {
+			return status.Errorf(codes.PermissionDenied, "only admins publish shortcuts to the workspace")
+		}
+	case storepb.ShortcutsUserSetting_SHARED:
+		if len(shortcut.SharedUserIds) == 0 {
+			return status.Errorf(codes.InvalidArgument, "shared users are required for the SHARED visibility")
+		}
+		if slices.Contains(shortcut.SharedUserIds, user.ID) {
+			return status.Errorf(codes.InvalidArgument, "cannot share a shortcut with its owner")
+		}
+	default:
+		return status.Errorf(codes.InvalidArgument, "invalid visibility: %v", shortcut.Visibility)
+	}
+	return nil
+}
+
+// convertShortcutSharedUsers converts the names of the shared users to their ids, checking that they exist.
+func (s *APIV1Service) convertShortcutSharedUsers(ctx context.Context, sharedUsers []string) ([]int32, error) {
+	userIDs := []int32{}
+	for _, sharedUser := range sharedUsers {
+		userID, err := ExtractUserIDFromName(sharedUser)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid shared user: %v", err)
+		}
+		if slices.Contains(userIDs, userID) {
+			continue
+		}
+		user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+		}
+		if user == nil {
+			return nil, status.Errorf(codes.NotFound, "shared user %s not found", sharedUser)
+		}
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs, nil
+}
+
+// canReadShortcut returns whether the user, who does not own the shortcut, may read it.
+func canReadShortcut(userID int32, shortcut *storepb.ShortcutsUserSetting_Shortcut) bool {
+	switch shortcut.Visibility {
+	case storepb.ShortcutsUserSetting_WORKSPACE:
+		return true
+	case storepb.ShortcutsUserSetting_SHARED:
+		return slices.Contains(shortcut.SharedUserIds, userID)
+	default:
+		return false
+	}
+}
+
+// convertSharedShortcutFromStore converts a shortcut for a reader other than its owner.
+// The filter macros of the owner are expanded, since the reader's filters expand the reader's macros.
+func (s *APIV1Service) convertSharedShortcutFromStore(ctx context.Context, ownerID int32, shortcut *storepb.ShortcutsUserSetting_Shortcut) (*v1pb.Shortcut, error) {
+	sharedShortcut := convertShortcutFromStore(ownerID, shortcut)
+	expanded, err := s.expandUserFilterMacros(ctx, ownerID, shortcut.Filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to expand filter macros: %v", err)
+	}
+	sharedShortcut.Filter = expanded
+	// The other readers of the shortcut are private to its owner.
+	sharedShortcut.SharedUsers = nil
+	return sharedShortcut, nil
+}
+
+func convertShortcutFromStore(userID int32, shortcut *storepb.ShortcutsUserSetting_Shortcut) *v1pb.Shortcut {
+	sharedUsers := []string{}
+	for _, sharedUserID := range shortcut.GetSharedUserIds() {
+		sharedUsers = append(sharedUsers, fmt.Sprintf("%s%d", UserNamePrefix, sharedUserID))
+	}
+	visibility := v1pb.Shortcut_Visibility(shortcut.GetVisibility())
+	if visibility == v1pb.Shortcut_VISIBILITY_UNSPECIFIED {
+		visibility = v1pb.Shortcut_PRIVATE
+	}
+	return &v1pb.Shortcut{
+		Name:        constructShortcutName(userID, shortcut.GetId()),
+		Title:       shortcut.GetTitle(),
+		Filter:      shortcut.GetFilter(),
+		Visibility:  visibility,
+		SharedUsers: sharedUsers,
+	}
+}
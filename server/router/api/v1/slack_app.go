@@ -0,0 +1,267 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/usememos/gomark/parser"
+	"github.com/usememos/gomark/parser/tokenizer"
+	"github.com/usememos/gomark/renderer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/slack"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// maxSlackRequestSize is the maximum size of the requests of Slack, far above their actual size.
+	maxSlackRequestSize = 1 << 20
+	// maxSlackUnfurlTextLength is the maximum number of characters of the previews of the memos.
+	maxSlackUnfurlTextLength = 300
+)
+
+// RegisterSlackRoutes registers the request URLs of the Slack app: the slash command capturing memos,
+// and the events of the links of the memos shared in Slack, which are unfurled.
+func (s *APIV1Service) RegisterSlackRoutes(echoServer *echo.Echo) {
+	echoServer.POST("/slack/commands", s.HandleSlackCommand)
+	echoServer.POST("/slack/events", s.HandleSlackEvent)
+}
+
+// HandleSlackCommand handles the slash command, which creates a memo of its text as the user linked to the Slack account,
+// or links the Slack account with "link" and a code generated by the user.
+// The replies are only shown to the Slack user.
+func (s *APIV1Service) HandleSlackCommand(c echo.Context) error {
+	ctx := c.Request().Context()
+	body, _, err := s.readSlackRequest(c)
+	if err != nil {
+		return err
+	}
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid slash command").SetInternal(err)
+	}
+	teamID, slackUserID, command := form.Get("team_id"), form.Get("user_id"), form.Get("command")
+	text := strings.TrimSpace(form.Get("text"))
+
+	if text == "" || strings.EqualFold(text, "help") {
+		return replySlackCommand(c, fmt.Sprintf("Use `%s <content>` to save a memo, and `%s link <code>` with the code generated in your memos settings to link your account.", command, command))
+	}
+	if action, code, _ := strings.Cut(text, " "); strings.EqualFold(action, "link") {
+		user, err := s.linkSlackAccount(ctx, code, teamID, slackUserID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "failed to link slack account").SetInternal(err)
+		}
+		if user == nil {
+			return replySlackCommand(c, "The link code is invalid or expired, generate a new one in your memos settings.")
+		}
+		return replySlackCommand(c, fmt.Sprintf("Your Slack account is linked to %s.", escapeSlackText(user.Username)))
+	}
+
+	user, err := s.findSlackUser(ctx, teamID, slackUserID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to find slack user").SetInternal(err)
+	}
+	if user == nil {
+		return replySlackCommand(c, fmt.Sprintf("Your Slack account is not linked yet: generate a link code in your memos settings and send `%s link <code>`.", command))
+	}
+	visibility, err := s.getUserDefaultVisibility(ctx, user.ID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to get default visibility").SetInternal(err)
+	}
+	memo, err := s.CreateMemo(context.WithValue(ctx, userIDContextKey, user.ID), &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:    text,
+			Visibility: visibility,
+		},
+	})
+	if err != nil {
+		if code := status.Code(err); code == codes.InvalidArgument || code == codes.PermissionDenied {
+			return replySlackCommand(c, "Failed to save the memo: "+escapeSlackText(status.Convert(err).Message()))
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, "failed to create memo").SetInternal(err)
+	}
+	if s.Profile.InstanceURL == "" {
+		return replySlackCommand(c, "Saved the memo.")
+	}
+	return replySlackCommand(c, fmt.Sprintf("Saved the <%s|memo>.", s.getMemoURL(memo.Name)))
+}
+
+// HandleSlackEvent handles the events of the Events API: it answers the verification of the request URL,
+// and unfurls the links of the public memos shared in Slack if the app has a bot token.
+func (s *APIV1Service) HandleSlackEvent(c echo.Context) error {
+	body, slackSetting, err := s.readSlackRequest(c)
+	if err != nil {
+		return err
+	}
+	request := struct {
+		Type      string `json:"type"`
+		Challenge string `json:"challenge"`
+		Event     struct {
+			Type      string `json:"type"`
+			Channel   string `json:"channel"`
+			MessageTS string `json:"message_ts"`
+			Links     []struct {
+				URL string `json:"url"`
+			} `json:"links"`
+		} `json:"event"`
+	}{}
+	if err := json.Unmarshal(body, &request); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid event").SetInternal(err)
+	}
+	switch request.Type {
+	case "url_verification":
+		return c.JSON(http.StatusOK, map[string]string{"challenge": request.Challenge})
+	case "event_callback":
+		if request.Event.Type != "link_shared" || slackSetting.BotToken == "" {
+			return c.NoContent(http.StatusOK)
+		}
+		links := []string{}
+		for _, link := range request.Event.Links {
+			links = append(links, link.URL)
+		}
+		// Slack expects an answer within 3 seconds, so the links are unfurled in the background.
+		client := &slack.Client{Token: slackSetting.BotToken, APIEndpoint: slackSetting.ApiEndpoint}
+		go s.unfurlSlackLinks(context.WithoutCancel(c.Request().Context()), client, request.Event.Channel, request.Event.MessageTS, links)
+		return c.NoContent(http.StatusOK)
+	default:
+		return c.NoContent(http.StatusOK)
+	}
+}
+
+// unfurlSlackLinks unfurls the links of the public memos with their title and a preview of their content.
+// The links of the other memos are left as they are.
+func (s *APIV1Service) unfurlSlackLinks(ctx context.Context, client *slack.Client, channel, messageTS string, links []string) {
+	unfurls := map[string]*slack.Unfurl{}
+	for _, link := range links {
+		unfurl, err := s.getSlackUnfurl(ctx, link)
+		if err != nil {
+			slog.Warn("Failed to unfurl Slack link", slog.String("link", link), slog.Any("err", err))
+			continue
+		}
+		if unfurl != nil {
+			unfurls[link] = unfurl
+		}
+	}
+	if len(unfurls) == 0 {
+		return
+	}
+	if err := client.Unfurl(ctx, channel, messageTS, unfurls); err != nil {
+		slog.Warn("Failed to unfurl Slack links", slog.String("channel", channel), slog.Any("err", err))
+	}
+}
+
+// getSlackUnfurl returns the preview of the link if it links to a public memo, e.g. https://memos.example.com/memos/{uid}.
+func (s *APIV1Service) getSlackUnfurl(ctx context.Context, link string) (*slack.Unfurl, error) {
+	linkURL, err := url.Parse(link)
+	if err != nil {
+		return nil, nil
+	}
+	uid, ok := strings.CutPrefix(strings.TrimSuffix(linkURL.Path, "/"), "/"+MemoNamePrefix)
+	if !ok || uid == "" || strings.Contains(uid, "/") {
+		return nil, nil
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{UID: &uid})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get memo")
+	}
+	if memo == nil || memo.Visibility != store.Public || memo.RowStatus != store.Normal {
+		return nil, nil
+	}
+	// The memos of the suspended users are hidden, as on their pages.
+	suspension, err := s.Store.GetUserSuspension(ctx, memo.CreatorID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user suspension")
+	}
+	if suspension.Suspended {
+		return nil, nil
+	}
+	creator, err := s.Store.GetUser(ctx, &store.FindUser{ID: &memo.CreatorID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get memo creator")
+	}
+
+	nodes, err := parser.Parse(tokenizer.Tokenize(memo.Content))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse content")
+	}
+	plainText := strings.TrimSpace(renderer.NewStringRenderer().Render(nodes))
+	title, text, _ := strings.Cut(plainText, "\n")
+	unfurl := &slack.Unfurl{
+		Title:     escapeSlackText(getSlackUnfurlTitle(title)),
+		TitleLink: link,
+		Text:      escapeSlackText(getSlackUnfurlText(text)),
+		Timestamp: memo.CreatedTs,
+	}
+	if creator != nil {
+		unfurl.Footer = creator.Nickname
+		if unfurl.Footer == "" {
+			unfurl.Footer = creator.Username
+		}
+	}
+	return unfurl, nil
+}
+
+// getSlackUnfurlTitle returns the first line of the memo as the title of its preview, shortened like the memo snippets.
+func getSlackUnfurlTitle(line string) string {
+	if len([]rune(line)) > 64 {
+		return substring(line, 64) + "..."
+	}
+	return line
+}
+
+// getSlackUnfurlText returns the rest of the memo as the text of its preview, shortened to maxSlackUnfurlTextLength.
+func getSlackUnfurlText(text string) string {
+	text = strings.TrimSpace(text)
+	if len([]rune(text)) > maxSlackUnfurlTextLength {
+		return substring(text, maxSlackUnfurlTextLength) + "..."
+	}
+	return text
+}
+
+// readSlackRequest reads the body of the request of Slack and verifies its signature with the workspace Slack setting.
+func (s *APIV1Service) readSlackRequest(c echo.Context) ([]byte, *storepb.WorkspaceSlackSetting, error) {
+	slackSetting, err := s.Store.GetWorkspaceSlackSetting(c.Request().Context())
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to get workspace slack setting").SetInternal(err)
+	}
+	if slackSetting.SigningSecret == "" {
+		return nil, nil, echo.NewHTTPError(http.StatusNotFound, "the Slack app is not enabled")
+	}
+	body, err := io.ReadAll(io.LimitReader(c.Request().Body, maxSlackRequestSize))
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusBadRequest, "failed to read request").SetInternal(err)
+	}
+	if err := slack.Verify(slackSetting.SigningSecret, c.Request().Header, body, time.Now()); err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+	}
+	return body, slackSetting, nil
+}
+
+// getMemoURL returns the URL of the memo page of the instance.
+func (s *APIV1Service) getMemoURL(memoName string) string {
+	return strings.TrimSuffix(s.Profile.InstanceURL, "/") + "/" + memoName
+}
+
+func replySlackCommand(c echo.Context, text string) error {
+	return c.JSON(http.StatusOK, map[string]string{
+		"response_type": "ephemeral",
+		"text":          text,
+	})
+}
+
+// escapeSlackText escapes the control characters of the Slack message formatting.
+func escapeSlackText(text string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+}
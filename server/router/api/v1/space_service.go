@@ -0,0 +1,468 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lithammer/shortuuid/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/base"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListSpaces(ctx context.Context, _ *v1pb.ListSpacesRequest) (*v1pb.ListSpacesResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	spaces, err := s.Store.ListSpaces(ctx, &store.FindSpace{
+		MemberID: &user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list spaces: %v", err)
+	}
+	spaceMembers, err := s.Store.ListSpaceMembers(ctx, &store.FindSpaceMember{
+		UserID: &user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list space members: %v", err)
+	}
+	roles := map[int32]store.SpaceRole{}
+	for _, spaceMember := range spaceMembers {
+		roles[spaceMember.SpaceID] = spaceMember.Role
+	}
+
+	response := &v1pb.ListSpacesResponse{
+		Spaces: []*v1pb.Space{},
+	}
+	for _, space := range spaces {
+		response.Spaces = append(response.Spaces, convertSpaceFromStore(space, roles[space.ID]))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetSpace(ctx context.Context, request *v1pb.GetSpaceRequest) (*v1pb.Space, error) {
+	space, member, err := s.getSpaceAsMember(ctx, request.Name, store.SpaceRoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	return convertSpaceFromStore(space, member.Role), nil
+}
+
+func (s *APIV1Service) CreateSpace(ctx context.Context, request *v1pb.CreateSpaceRequest) (*v1pb.Space, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if request.Space == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "space is required")
+	}
+	displayName := strings.TrimSpace(request.Space.DisplayName)
+	if displayName == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "display name is required")
+	}
+	spaceUID := request.SpaceId
+	if spaceUID == "" {
+		spaceUID = shortuuid.New()
+	}
+	if !base.UIDMatcher.MatchString(spaceUID) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid space id: %s", spaceUID)
+	}
+	existing, err := s.Store.GetSpace(ctx, &store.FindSpace{UID: &spaceUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get space: %v", err)
+	}
+	if existing != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "space %s already exists", spaceUID)
+	}
+
+	space, err := s.Store.CreateSpace(ctx, &store.Space{
+		UID:       spaceUID,
+		CreatorID: user.ID,
+		Name:      displayName,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create space: %v", err)
+	}
+	if _, err := s.Store.UpsertSpaceMember(ctx, &store.SpaceMember{
+		SpaceID: space.ID,
+		UserID:  user.ID,
+		Role:    store.SpaceRoleOwner,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add space owner: %v", err)
+	}
+	return convertSpaceFromStore(space, store.SpaceRoleOwner), nil
+}
+
+func (s *APIV1Service) UpdateSpace(ctx context.Context, request *v1pb.UpdateSpaceRequest) (*v1pb.Space, error) {
+	if request.Space == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "space is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	space, member, err := s.getSpaceAsMember(ctx, request.Space.Name, store.SpaceRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedTs := time.Now().Unix()
+	update := &store.UpdateSpace{
+		ID:        space.ID,
+		UpdatedTs: &updatedTs,
+	}
+	for _, field := range request.UpdateMask.Paths {
+		switch field {
+		case "display_name":
+			displayName := strings.TrimSpace(request.Space.DisplayName)
+			if displayName == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "display name is required")
+			}
+			update.Name = &displayName
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update field: %s", field)
+		}
+	}
+	if err := s.Store.UpdateSpace(ctx, update); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update space: %v", err)
+	}
+	space, err = s.Store.GetSpace(ctx, &store.FindSpace{ID: &space.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get space: %v", err)
+	}
+	return convertSpaceFromStore(space, member.Role), nil
+}
+
+func (s *APIV1Service) DeleteSpace(ctx context.Context, request *v1pb.DeleteSpaceRequest) (*emptypb.Empty, error) {
+	space, _, err := s.getSpaceAsMember(ctx, request.Name, store.SpaceRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	// The memos are never deleted along with their space, so that no member loses their memos by surprise.
+	limit := 1
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		SpaceID:        &space.ID,
+		ExcludeContent: true,
+		Limit:          &limit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	if len(memos) > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "space still has memos")
+	}
+	if err := s.Store.DeleteSpace(ctx, &store.DeleteSpace{ID: space.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete space: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) ListSpaceMembers(ctx context.Context, request *v1pb.ListSpaceMembersRequest) (*v1pb.ListSpaceMembersResponse, error) {
+	space, _, err := s.getSpaceAsMember(ctx, request.Parent, store.SpaceRoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	spaceMembers, err := s.Store.ListSpaceMembers(ctx, &store.FindSpaceMember{
+		SpaceID: &space.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list space members: %v", err)
+	}
+	response := &v1pb.ListSpaceMembersResponse{
+		SpaceMembers: []*v1pb.SpaceMember{},
+	}
+	for _, spaceMember := range spaceMembers {
+		response.SpaceMembers = append(response.SpaceMembers, convertSpaceMemberFromStore(space, spaceMember))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) CreateSpaceMember(ctx context.Context, request *v1pb.CreateSpaceMemberRequest) (*v1pb.SpaceMember, error) {
+	if request.SpaceMember == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "space member is required")
+	}
+	space, _, err := s.getSpaceAsMember(ctx, request.Parent, store.SpaceRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := ExtractUserIDFromName(request.SpaceMember.User)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	role := convertSpaceRoleToStore(request.SpaceMember.Role)
+	if role == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "role is required")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	existing, err := s.Store.GetSpaceMember(ctx, &store.FindSpaceMember{
+		SpaceID: &space.ID,
+		UserID:  &user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get space member: %v", err)
+	}
+	if existing != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "user is already a member of the space")
+	}
+
+	spaceMember, err := s.Store.UpsertSpaceMember(ctx, &store.SpaceMember{
+		SpaceID: space.ID,
+		UserID:  user.ID,
+		Role:    role,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add space member: %v", err)
+	}
+	return convertSpaceMemberFromStore(space, spaceMember), nil
+}
+
+func (s *APIV1Service) UpdateSpaceMember(ctx context.Context, request *v1pb.UpdateSpaceMemberRequest) (*v1pb.SpaceMember, error) {
+	if request.SpaceMember == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "space member is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	spaceUID, userID, err := ExtractSpaceMemberIDFromName(request.SpaceMember.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid space member name: %v", err)
+	}
+	space, _, err := s.getSpaceAsMember(ctx, fmt.Sprintf("%s%s", SpaceNamePrefix, spaceUID), store.SpaceRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	spaceMember, err := s.getSpaceMember(ctx, space.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range request.UpdateMask.Paths {
+		switch field {
+		case "role":
+			role := convertSpaceRoleToStore(request.SpaceMember.Role)
+			if role == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "role is required")
+			}
+			if spaceMember.Role == store.SpaceRoleOwner && role != store.SpaceRoleOwner {
+				if err := s.checkSpaceHasOtherOwner(ctx, space.ID, userID); err != nil {
+					return nil, err
+				}
+			}
+			spaceMember.Role = role
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update field: %s", field)
+		}
+	}
+	spaceMember, err = s.Store.UpsertSpaceMember(ctx, spaceMember)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update space member: %v", err)
+	}
+	return convertSpaceMemberFromStore(space, spaceMember), nil
+}
+
+func (s *APIV1Service) DeleteSpaceMember(ctx context.Context, request *v1pb.DeleteSpaceMemberRequest) (*emptypb.Empty, error) {
+	spaceUID, userID, err := ExtractSpaceMemberIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid space member name: %v", err)
+	}
+	space, currentMember, err := s.getSpaceAsMember(ctx, fmt.Sprintf("%s%s", SpaceNamePrefix, spaceUID), store.SpaceRoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	// The members can leave the space, but only the owners remove the other members.
+	if currentMember.UserID != userID && currentMember.Role != store.SpaceRoleOwner {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	spaceMember, err := s.getSpaceMember(ctx, space.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if spaceMember.Role == store.SpaceRoleOwner {
+		if err := s.checkSpaceHasOtherOwner(ctx, space.ID, userID); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.Store.DeleteSpaceMember(ctx, &store.DeleteSpaceMember{
+		SpaceID: space.ID,
+		UserID:  &userID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete space member: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getSpaceAsMember returns the space of the name along with the membership of the current user,
+// or a PermissionDenied error if the user is not a member with at least the given role.
+func (s *APIV1Service) getSpaceAsMember(ctx context.Context, name string, role store.SpaceRole) (*store.Space, *store.SpaceMember, error) {
+	spaceUID, err := ExtractSpaceUIDFromName(name)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid space name: %v", err)
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	space, err := s.Store.GetSpace(ctx, &store.FindSpace{UID: &spaceUID})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get space: %v", err)
+	}
+	if space == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "space not found")
+	}
+	spaceMember, err := s.Store.GetSpaceMember(ctx, &store.FindSpaceMember{
+		SpaceID: &space.ID,
+		UserID:  &user.ID,
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get space member: %v", err)
+	}
+	if spaceMember == nil || spaceRoleRank(spaceMember.Role) < spaceRoleRank(role) {
+		return nil, nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return space, spaceMember, nil
+}
+
+func (s *APIV1Service) getSpaceMember(ctx context.Context, spaceID, userID int32) (*store.SpaceMember, error) {
+	spaceMember, err := s.Store.GetSpaceMember(ctx, &store.FindSpaceMember{
+		SpaceID: &spaceID,
+		UserID:  &userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get space member: %v", err)
+	}
+	if spaceMember == nil {
+		return nil, status.Errorf(codes.NotFound, "space member not found")
+	}
+	return spaceMember, nil
+}
+
+// checkSpaceHasOtherOwner returns a FailedPrecondition error if the user is the last owner of the space,
+// so that a space is never left without anyone to manage it.
+func (s *APIV1Service) checkSpaceHasOtherOwner(ctx context.Context, spaceID, userID int32) error {
+	spaceMembers, err := s.Store.ListSpaceMembers(ctx, &store.FindSpaceMember{
+		SpaceID: &spaceID,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list space members: %v", err)
+	}
+	for _, spaceMember := range spaceMembers {
+		if spaceMember.UserID != userID && spaceMember.Role == store.SpaceRoleOwner {
+			return nil
+		}
+	}
+	return status.Errorf(codes.FailedPrecondition, "the space must keep at least one owner")
+}
+
+// scopeMemoFindToSpace restricts the memo find to the memos of the shared space of the name, or to the personal spaces if empty.
+// It returns true for a shared space, as its members read all of its memos whatever their visibility.
+func (s *APIV1Service) scopeMemoFindToSpace(ctx context.Context, memoFind *store.FindMemo, name string) (bool, error) {
+	if name == "" {
+		personalSpaceID := store.PersonalSpaceID
+		memoFind.SpaceID = &personalSpaceID
+		return false, nil
+	}
+	space, _, err := s.getSpaceAsMember(ctx, name, store.SpaceRoleViewer)
+	if err != nil {
+		return false, err
+	}
+	memoFind.SpaceID = &space.ID
+	return true, nil
+}
+
+// canReadMemoViaSpace returns true if the memo belongs to a shared space the user is a member of.
+func (s *APIV1Service) canReadMemoViaSpace(ctx context.Context, userID int32, memo *store.Memo) (bool, error) {
+	if memo.SpaceID == store.PersonalSpaceID {
+		return false, nil
+	}
+	spaceMember, err := s.Store.GetSpaceMember(ctx, &store.FindSpaceMember{
+		SpaceID: &memo.SpaceID,
+		UserID:  &userID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return spaceMember != nil, nil
+}
+
+// spaceRoleRank returns the rank of the role, a role being granted all the permissions of the lower ranks.
+func spaceRoleRank(role store.SpaceRole) int {
+	switch role {
+	case store.SpaceRoleOwner:
+		return 3
+	case store.SpaceRoleEditor:
+		return 2
+	case store.SpaceRoleViewer:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func convertSpaceFromStore(space *store.Space, role store.SpaceRole) *v1pb.Space {
+	return &v1pb.Space{
+		Name:        fmt.Sprintf("%s%s", SpaceNamePrefix, space.UID),
+		DisplayName: space.Name,
+		Creator:     fmt.Sprintf("%s%d", UserNamePrefix, space.CreatorID),
+		CreateTime:  timestamppb.New(time.Unix(space.CreatedTs, 0)),
+		UpdateTime:  timestamppb.New(time.Unix(space.UpdatedTs, 0)),
+		Role:        convertSpaceRoleFromStore(role),
+	}
+}
+
+func convertSpaceMemberFromStore(space *store.Space, spaceMember *store.SpaceMember) *v1pb.SpaceMember {
+	return &v1pb.SpaceMember{
+		Name:       fmt.Sprintf("%s%s/%s%d", SpaceNamePrefix, space.UID, SpaceMemberNamePrefix, spaceMember.UserID),
+		User:       fmt.Sprintf("%s%d", UserNamePrefix, spaceMember.UserID),
+		Role:       convertSpaceRoleFromStore(spaceMember.Role),
+		CreateTime: timestamppb.New(time.Unix(spaceMember.CreatedTs, 0)),
+	}
+}
+
+func convertSpaceRoleFromStore(role store.SpaceRole) v1pb.SpaceMember_Role {
+	switch role {
+	case store.SpaceRoleOwner:
+		return v1pb.SpaceMember_OWNER
+	case store.SpaceRoleEditor:
+		return v1pb.SpaceMember_EDITOR
+	case store.SpaceRoleViewer:
+		return v1pb.SpaceMember_VIEWER
+	default:
+		return v1pb.SpaceMember_ROLE_UNSPECIFIED
+	}
+}
+
+// convertSpaceRoleToStore returns the store role, or an empty role if unspecified.
+func convertSpaceRoleToStore(role v1pb.SpaceMember_Role) store.SpaceRole {
+	switch role {
+	case v1pb.SpaceMember_OWNER:
+		return store.SpaceRoleOwner
+	case v1pb.SpaceMember_EDITOR:
+		return store.SpaceRoleEditor
+	case v1pb.SpaceMember_VIEWER:
+		return store.SpaceRoleViewer
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,233 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// tagColorMatcher matches hex colors, e.g. "#3b82f6".
+var tagColorMatcher = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// Helper function to extract user ID and tag from tag metadata resource name.
+// Format: users/{user}/tagMetadata/{tag}, the tag itself may contain slashes.
+func extractUserAndTagFromTagMetadataName(name string) (int32, string, error) {
+	parts := strings.SplitN(name, "/", 4)
+	if len(parts) != 4 || parts[0] != "users" || parts[2] != "tagMetadata" {
+		return 0, "", errors.Errorf("invalid tag metadata name format: %s", name)
+	}
+
+	userID, err := util.ConvertStringToInt32(parts[1])
+	if err != nil {
+		return 0, "", errors.Errorf("invalid user ID %q", parts[1])
+	}
+
+	tag := normalizeTagPath(parts[3])
+	if tag == "" {
+		return 0, "", errors.Errorf("empty tag in name: %s", name)
+	}
+
+	return userID, tag, nil
+}
+
+// Helper function to construct tag metadata resource name.
+func constructTagMetadataName(userID int32, tag string) string {
+	return fmt.Sprintf("users/%d/tagMetadata/%s", userID, tag)
+}
+
+func (s *APIV1Service) ListTagMetadata(ctx context.Context, request *v1pb.ListTagMetadataRequest) (*v1pb.ListTagMetadataResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	tags, err := s.Store.GetUserTagMetadata(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get tag metadata: %v", err)
+	}
+	tagMetadata := []*v1pb.TagMetadata{}
+	for _, tag := range tags {
+		tagMetadata = append(tagMetadata, convertTagMetadataFromStore(userID, tag))
+	}
+	slices.SortFunc(tagMetadata, func(a, b *v1pb.TagMetadata) int {
+		return strings.Compare(a.Tag, b.Tag)
+	})
+	return &v1pb.ListTagMetadataResponse{
+		TagMetadata: tagMetadata,
+	}, nil
+}
+
+func (s *APIV1Service) GetTagMetadata(ctx context.Context, request *v1pb.GetTagMetadataRequest) (*v1pb.TagMetadata, error) {
+	userID, tag, err := extractUserAndTagFromTagMetadataName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag metadata name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	tags, err := s.Store.GetUserTagMetadata(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get tag metadata: %v", err)
+	}
+	for _, t := range tags {
+		if t.Tag == tag {
+			return convertTagMetadataFromStore(userID, t), nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "tag metadata not found")
+}
+
+func (s *APIV1Service) CreateTagMetadata(ctx context.Context, request *v1pb.CreateTagMetadataRequest) (*v1pb.TagMetadata, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	if request.TagMetadata == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "tag metadata is required")
+	}
+
+	newTag := &storepb.TagsUserSetting_Tag{
+		Tag:         normalizeTagPath(request.TagMetadata.Tag),
+		Description: request.TagMetadata.Description,
+		Color:       request.TagMetadata.Color,
+		Icon:        request.TagMetadata.Icon,
+		Pinned:      request.TagMetadata.Pinned,
+	}
+	if newTag.Tag == "" || strings.ContainsAny(newTag.Tag, " \t\n") {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag: %q", request.TagMetadata.Tag)
+	}
+	if err := validateTagColor(newTag.Color); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	tags, err := s.Store.GetUserTagMetadata(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get tag metadata: %v", err)
+	}
+	for _, t := range tags {
+		if t.Tag == newTag.Tag {
+			return nil, status.Errorf(codes.AlreadyExists, "tag metadata already exists")
+		}
+	}
+	if err := s.Store.UpsertUserTagMetadata(ctx, userID, append(tags, newTag)); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create tag metadata: %v", err)
+	}
+	return convertTagMetadataFromStore(userID, newTag), nil
+}
+
+func (s *APIV1Service) UpdateTagMetadata(ctx context.Context, request *v1pb.UpdateTagMetadataRequest) (*v1pb.TagMetadata, error) {
+	if request.TagMetadata == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "tag metadata is required")
+	}
+	userID, tag, err := extractUserAndTagFromTagMetadataName(request.TagMetadata.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag metadata name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+
+	tags, err := s.Store.GetUserTagMetadata(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get tag metadata: %v", err)
+	}
+	var foundTag *storepb.TagsUserSetting_Tag
+	for _, t := range tags {
+		if t.Tag == tag {
+			foundTag = t
+			break
+		}
+	}
+	if foundTag == nil {
+		return nil, status.Errorf(codes.NotFound, "tag metadata not found")
+	}
+
+	for _, field := range request.UpdateMask.Paths {
+		switch field {
+		case "description":
+			foundTag.Description = request.TagMetadata.Description
+		case "color":
+			if err := validateTagColor(request.TagMetadata.Color); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+			}
+			foundTag.Color = request.TagMetadata.Color
+		case "icon":
+			foundTag.Icon = request.TagMetadata.Icon
+		case "pinned":
+			foundTag.Pinned = request.TagMetadata.Pinned
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update field: %s", field)
+		}
+	}
+	if err := s.Store.UpsertUserTagMetadata(ctx, userID, tags); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update tag metadata: %v", err)
+	}
+	return convertTagMetadataFromStore(userID, foundTag), nil
+}
+
+func (s *APIV1Service) DeleteTagMetadata(ctx context.Context, request *v1pb.DeleteTagMetadataRequest) (*emptypb.Empty, error) {
+	userID, tag, err := extractUserAndTagFromTagMetadataName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag metadata name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	tags, err := s.Store.GetUserTagMetadata(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get tag metadata: %v", err)
+	}
+	newTags := make([]*storepb.TagsUserSetting_Tag, 0, len(tags))
+	for _, t := range tags {
+		if t.Tag != tag {
+			newTags = append(newTags, t)
+		}
+	}
+	if len(newTags) == len(tags) {
+		return nil, status.Errorf(codes.NotFound, "tag metadata not found")
+	}
+	if err := s.Store.UpsertUserTagMetadata(ctx, userID, newTags); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete tag metadata: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func validateTagColor(color string) error {
+	if color != "" && !tagColorMatcher.MatchString(color) {
+		return errors.Errorf("invalid color %q, expected a hex color like #3b82f6", color)
+	}
+	return nil
+}
+
+func convertTagMetadataFromStore(userID int32, tag *storepb.TagsUserSetting_Tag) *v1pb.TagMetadata {
+	return &v1pb.TagMetadata{
+		Name:        constructTagMetadataName(userID, tag.Tag),
+		Tag:         tag.Tag,
+		Description: tag.Description,
+		Color:       tag.Color,
+		Icon:        tag.Icon,
+		Pinned:      tag.Pinned,
+	}
+}
@@ -0,0 +1,592 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/usememos/gomark/ast"
+	"github.com/usememos/gomark/parser"
+	"github.com/usememos/gomark/parser/tokenizer"
+	"github.com/usememos/gomark/restore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/tagsuggest"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+// tagPathSeparator separates the segments of a hierarchical tag, e.g. "work/project".
+const tagPathSeparator = "/"
+
+const (
+	defaultTagSuggestionCount = 5
+	maxTagSuggestionCount     = 20
+	// maxTagSuggestionMemos bounds the memos the tags are suggested from, the most recent ones being used,
+	// as the model is built on each call.
+	maxTagSuggestionMemos = 1000
+)
+
+func (s *APIV1Service) ListTags(ctx context.Context, request *v1pb.ListTagsRequest) (*v1pb.ListTagsResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	// The tags of the public memos seen by the anonymous visitors are cached until the memos change.
+	cacheKey := "tags:" + request.Parent
+	if currentUser == nil {
+		if content, ok := s.Store.GetPublicContent(ctx, cacheKey); ok {
+			response := &v1pb.ListTagsResponse{}
+			if err := proto.Unmarshal(content, response); err == nil {
+				return response, nil
+			}
+		}
+	}
+	memos, err := s.listVisibleUserMemos(ctx, currentUser, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+
+	memoTags := [][]string{}
+	for _, memo := range memos {
+		if memo.Payload != nil && len(memo.Payload.Tags) > 0 {
+			memoTags = append(memoTags, memo.Payload.Tags)
+		}
+	}
+	tags := buildTagTree(memoTags)
+	if currentUser != nil && currentUser.ID == userID {
+		tagMetadata, err := s.Store.GetUserTagMetadata(ctx, userID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get tag metadata: %v", err)
+		}
+		metadataByTag := map[string]*v1pb.TagMetadata{}
+		for _, metadata := range tagMetadata {
+			metadataByTag[metadata.Tag] = convertTagMetadataFromStore(userID, metadata)
+		}
+		attachTagMetadata(tags, metadataByTag)
+	}
+	response := &v1pb.ListTagsResponse{
+		Tags: tags,
+	}
+	if currentUser == nil {
+		if content, err := proto.Marshal(response); err == nil {
+			s.Store.SetPublicContent(ctx, cacheKey, content)
+		}
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) ListTagStats(ctx context.Context, request *v1pb.ListTagStatsRequest) (*v1pb.ListTagStatsResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	location := time.UTC
+	if request.TimeZone != "" {
+		location, err = time.LoadLocation(request.TimeZone)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid time zone: %v", err)
+		}
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace memo related setting: %v", err)
+	}
+	memos, err := s.listVisibleUserMemos(ctx, currentUser, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+
+	type tagUsage struct {
+		firstTs, lastTs int64
+		count           int32
+		monthlyCounts   map[string]int32
+	}
+	usages := map[string]*tagUsage{}
+	for _, memo := range memos {
+		displayTs := memo.CreatedTs
+		if workspaceMemoRelatedSetting.DisplayWithUpdateTime {
+			displayTs = memo.UpdatedTs
+		}
+		month := time.Unix(displayTs, 0).In(location).Format("2006-01")
+		for _, tag := range memo.Payload.GetTags() {
+			usage, ok := usages[tag]
+			if !ok {
+				usage = &tagUsage{
+					firstTs:       displayTs,
+					lastTs:        displayTs,
+					monthlyCounts: map[string]int32{},
+				}
+				usages[tag] = usage
+			}
+			usage.firstTs = min(usage.firstTs, displayTs)
+			usage.lastTs = max(usage.lastTs, displayTs)
+			usage.count++
+			usage.monthlyCounts[month]++
+		}
+	}
+
+	response := &v1pb.ListTagStatsResponse{
+		TagStats: []*v1pb.TagStats{},
+	}
+	for tag, usage := range usages {
+		tagStats := &v1pb.TagStats{
+			Tag:           tag,
+			MemoCount:     usage.count,
+			FirstUsedTime: timestamppb.New(time.Unix(usage.firstTs, 0)),
+			LastUsedTime:  timestamppb.New(time.Unix(usage.lastTs, 0)),
+		}
+		for _, month := range slices.Sorted(maps.Keys(usage.monthlyCounts)) {
+			tagStats.MonthlyCounts = append(tagStats.MonthlyCounts, &v1pb.TagStats_MonthlyCount{
+				Month:     month,
+				MemoCount: usage.monthlyCounts[month],
+			})
+		}
+		response.TagStats = append(response.TagStats, tagStats)
+	}
+	slices.SortFunc(response.TagStats, func(a, b *v1pb.TagStats) int {
+		return strings.Compare(a.Tag, b.Tag)
+	})
+	return response, nil
+}
+
+// listVisibleUserMemos lists the memos of the user, without content, that the current user can see.
+func (s *APIV1Service) listVisibleUserMemos(ctx context.Context, currentUser *store.User, userID int32) ([]*store.Memo, error) {
+	normalStatus := store.Normal
+	memoFind := &store.FindMemo{
+		CreatorID:       &userID,
+		ExcludeComments: true,
+		ExcludeContent:  true,
+		RowStatus:       &normalStatus,
+	}
+	if currentUser == nil {
+		memoFind.VisibilityList = []store.Visibility{store.Public}
+	} else if currentUser.ID != userID {
+		appendMemoFilter(memoFind, fmt.Sprintf(`visibility in ["PUBLIC", "PROTECTED"] || group_visible_to(%d)`, currentUser.ID))
+	}
+	return s.Store.ListMemos(ctx, memoFind)
+}
+
+func (s *APIV1Service) RenameTag(ctx context.Context, request *v1pb.RenameTagRequest) (*v1pb.RenameTagResponse, error) {
+	oldTag, newTag := normalizeTagPath(request.OldTag), normalizeTagPath(request.NewTag)
+	if oldTag == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "tag must not be empty")
+	}
+	if oldTag == newTag {
+		return nil, status.Errorf(codes.InvalidArgument, "new tag must differ from old tag")
+	}
+
+	affectedMemoCount, err := s.retagMemos(ctx, request.Parent, []string{oldTag}, newTag, request.ValidateOnly)
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.RenameTagResponse{
+		AffectedMemoCount: affectedMemoCount,
+	}, nil
+}
+
+func (s *APIV1Service) MergeTags(ctx context.Context, request *v1pb.MergeTagsRequest) (*v1pb.MergeTagsResponse, error) {
+	targetTag := normalizeTagPath(request.TargetTag)
+	sourceTags := []string{}
+	for _, tag := range request.SourceTags {
+		tag = normalizeTagPath(tag)
+		if tag == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "tag must not be empty")
+		}
+		// Merging a tag into itself is a no-op.
+		if tag != targetTag && !slices.Contains(sourceTags, tag) {
+			sourceTags = append(sourceTags, tag)
+		}
+	}
+	if len(sourceTags) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "source tags must not be empty")
+	}
+
+	affectedMemoCount, err := s.retagMemos(ctx, request.Parent, sourceTags, targetTag, request.ValidateOnly)
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.MergeTagsResponse{
+		AffectedMemoCount: affectedMemoCount,
+	}, nil
+}
+
+func (s *APIV1Service) CheckTagConsistency(ctx context.Context, request *v1pb.CheckTagConsistencyRequest) (*v1pb.CheckTagConsistencyResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID: &userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+
+	response := &v1pb.CheckTagConsistencyResponse{
+		Inconsistencies: []*v1pb.CheckTagConsistencyResponse_Inconsistency{},
+	}
+	updates := []*store.UpdateMemo{}
+	for _, memo := range memos {
+		storedTags := memo.Payload.GetTags()
+		if err := memopayload.RebuildMemoPayload(memo); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to rebuild payload of memo %s: %v", memo.UID, err)
+		}
+		contentTags := memo.Payload.GetTags()
+
+		inconsistency := &v1pb.CheckTagConsistencyResponse_Inconsistency{
+			Memo:     fmt.Sprintf("%s%s", MemoNamePrefix, memo.UID),
+			Repaired: request.Repair,
+		}
+		for _, tag := range storedTags {
+			if !slices.Contains(contentTags, tag) {
+				inconsistency.OrphanedTags = append(inconsistency.OrphanedTags, tag)
+			}
+		}
+		for _, tag := range contentTags {
+			if !slices.Contains(storedTags, tag) {
+				inconsistency.MissingTags = append(inconsistency.MissingTags, tag)
+			}
+		}
+		if len(inconsistency.OrphanedTags) == 0 && len(inconsistency.MissingTags) == 0 {
+			continue
+		}
+		response.Inconsistencies = append(response.Inconsistencies, inconsistency)
+		updates = append(updates, &store.UpdateMemo{
+			ID:      memo.ID,
+			Payload: memo.Payload,
+		})
+	}
+
+	if request.Repair && len(updates) > 0 {
+		if err := s.Store.UpdateMemos(ctx, updates); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update memos: %v", err)
+		}
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) SuggestTags(ctx context.Context, request *v1pb.SuggestTagsRequest) (*v1pb.SuggestTagsResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	limit := int(request.PageSize)
+	if limit <= 0 {
+		limit = defaultTagSuggestionCount
+	}
+	limit = min(limit, maxTagSuggestionCount)
+
+	// The tags the content already has are not suggested again.
+	draft := &store.Memo{Content: request.Content}
+	if err := memopayload.RebuildMemoPayload(draft); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse content: %v", err)
+	}
+
+	normalStatus := store.Normal
+	memoLimit := maxTagSuggestionMemos
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:       &userID,
+		RowStatus:       &normalStatus,
+		ExcludeComments: true,
+		Limit:           &memoLimit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	documents := []*tagsuggest.Document{}
+	for _, memo := range memos {
+		documents = append(documents, &tagsuggest.Document{
+			Content: memo.Content,
+			Tags:    memo.Payload.GetTags(),
+		})
+	}
+
+	response := &v1pb.SuggestTagsResponse{
+		Suggestions: []*v1pb.SuggestTagsResponse_Suggestion{},
+	}
+	for _, suggestion := range tagsuggest.NewModel(documents).Suggest(request.Content, draft.Payload.GetTags(), limit) {
+		response.Suggestions = append(response.Suggestions, &v1pb.SuggestTagsResponse_Suggestion{
+			Tag:   suggestion.Tag,
+			Score: suggestion.Score,
+		})
+	}
+	return response, nil
+}
+
+// retagMemos replaces the old tags, including their descendants, with the new tag in all memos of the user
+// in a single transaction and returns the number of affected memos.
+func (s *APIV1Service) retagMemos(ctx context.Context, parent string, oldTags []string, newTag string, validateOnly bool) (int32, error) {
+	userID, err := ExtractUserIDFromName(parent)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || currentUser.ID != userID {
+		return 0, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if newTag == "" {
+		return 0, status.Errorf(codes.InvalidArgument, "tag must not be empty")
+	}
+	if strings.ContainsAny(newTag, " \t\n") {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid tag: %s", newTag)
+	}
+
+	// The tag searches of a memo find are ANDed, so the memos are listed per old tag.
+	memos := []*store.Memo{}
+	memoIDs := map[int32]bool{}
+	for _, oldTag := range oldTags {
+		list, err := s.Store.ListMemos(ctx, &store.FindMemo{
+			CreatorID:   &userID,
+			PayloadFind: &store.FindMemoPayload{TagSearch: []string{oldTag}},
+		})
+		if err != nil {
+			return 0, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+		}
+		for _, memo := range list {
+			if memoIDs[memo.ID] {
+				continue
+			}
+			memoIDs[memo.ID] = true
+			memos = append(memos, memo)
+		}
+	}
+
+	updates := []*store.UpdateMemo{}
+	for _, memo := range memos {
+		retagged, err := retagMemo(memo, oldTags, newTag)
+		if err != nil {
+			return 0, status.Errorf(codes.Internal, "failed to retag memo %s: %v", memo.UID, err)
+		}
+		if !retagged {
+			continue
+		}
+		updates = append(updates, &store.UpdateMemo{
+			ID:      memo.ID,
+			Content: &memo.Content,
+			Payload: memo.Payload,
+		})
+	}
+
+	if !validateOnly && len(updates) > 0 {
+		if err := s.Store.UpdateMemos(ctx, updates); err != nil {
+			return 0, status.Errorf(codes.Internal, "failed to update memos: %v", err)
+		}
+	}
+	return int32(len(updates)), nil
+}
+
+// checkResourceOwner ensures the current user is the given user, who owns the requested resources.
+func (s *APIV1Service) checkResourceOwner(ctx context.Context, userID int32) error {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || currentUser.ID != userID {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+// renameMemoTag rewrites the tag and its descendants in the memo content and rebuilds the payload.
+func renameMemoTag(memo *store.Memo, oldTag, newTag string) (bool, error) {
+	return retagMemo(memo, []string{oldTag}, newTag)
+}
+
+// retagMemo rewrites the old tags and their descendants in the memo content to the new tag and rebuilds the payload.
+// Renaming a parent tag moves its whole subtree, e.g. "work" -> "job" also renames "work/project" to "job/project".
+// The rewritten tags that duplicate another tag of the memo are dropped from the content, while the other tags are kept as is.
+func retagMemo(memo *store.Memo, oldTags []string, newTag string) (bool, error) {
+	nodes, err := parser.Parse(tokenizer.Tokenize(memo.Content))
+	if err != nil {
+		return false, errors.Wrap(err, "failed to parse content")
+	}
+	rewrittenTags := map[*ast.Tag]bool{}
+	// keptTags are the tags of the memo which are not rewritten, and the rewritten ones kept in the content.
+	keptTags := map[string]bool{}
+	memopayload.TraverseASTNodes(nodes, func(node ast.Node) {
+		tag, ok := node.(*ast.Tag)
+		if !ok {
+			return
+		}
+		for _, oldTag := range oldTags {
+			if isTagOrDescendant(tag.Content, oldTag) {
+				tag.Content = newTag + strings.TrimPrefix(tag.Content, oldTag)
+				rewrittenTags[tag] = true
+				return
+			}
+		}
+		keptTags[tag.Content] = true
+	})
+	if len(rewrittenTags) == 0 {
+		return false, nil
+	}
+
+	dropDuplicateTags := func(children []ast.Node) []ast.Node {
+		return dropRewrittenDuplicateTags(children, rewrittenTags, keptTags)
+	}
+	memopayload.TraverseASTNodes(nodes, func(node ast.Node) {
+		switch n := node.(type) {
+		case *ast.Paragraph:
+			n.Children = dropDuplicateTags(n.Children)
+		case *ast.Heading:
+			n.Children = dropDuplicateTags(n.Children)
+		case *ast.OrderedListItem:
+			n.Children = dropDuplicateTags(n.Children)
+		case *ast.UnorderedListItem:
+			n.Children = dropDuplicateTags(n.Children)
+		case *ast.TaskListItem:
+			n.Children = dropDuplicateTags(n.Children)
+		case *ast.Bold:
+			n.Children = dropDuplicateTags(n.Children)
+		}
+	})
+
+	memo.Content = restore.Restore(nodes)
+	if err := memopayload.RebuildMemoPayload(memo); err != nil {
+		return false, errors.Wrap(err, "failed to rebuild payload")
+	}
+	return true, nil
+}
+
+// dropRewrittenDuplicateTags removes the rewritten tags which duplicate a kept tag, together with the whitespace separating
+// them from the previous node, or from the next one if they come first. The rewritten tags kept are added to the kept tags.
+func dropRewrittenDuplicateTags(children []ast.Node, rewrittenTags map[*ast.Tag]bool, keptTags map[string]bool) []ast.Node {
+	result := []ast.Node{}
+	dropNextWhitespace := false
+	for _, child := range children {
+		if dropNextWhitespace {
+			dropNextWhitespace = false
+			if text, ok := child.(*ast.Text); ok && strings.TrimSpace(text.Content) == "" {
+				continue
+			}
+		}
+		tag, ok := child.(*ast.Tag)
+		if !ok || !rewrittenTags[tag] {
+			result = append(result, child)
+			continue
+		}
+		if !keptTags[tag.Content] {
+			keptTags[tag.Content] = true
+			result = append(result, child)
+			continue
+		}
+		if len(result) == 0 {
+			dropNextWhitespace = true
+		} else if text, ok := result[len(result)-1].(*ast.Text); ok && strings.TrimSpace(text.Content) == "" {
+			result = result[:len(result)-1]
+		}
+	}
+	return result
+}
+
+// normalizeTagPath trims surrounding separators and drops empty segments from a tag path.
+func normalizeTagPath(tag string) string {
+	segments := []string{}
+	for _, segment := range strings.Split(tag, tagPathSeparator) {
+		if segment = strings.TrimSpace(segment); segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	return strings.Join(segments, tagPathSeparator)
+}
+
+// isTagOrDescendant returns true if tag equals ancestor or is nested below it.
+func isTagOrDescendant(tag, ancestor string) bool {
+	return tag == ancestor || strings.HasPrefix(tag, ancestor+tagPathSeparator)
+}
+
+// buildTagTree builds the tag hierarchy from the tags of each memo.
+// A memo is counted at most once per node, even if it has several tags under that node.
+func buildTagTree(memoTags [][]string) []*v1pb.Tag {
+	nodes := map[string]*v1pb.Tag{}
+	roots := []*v1pb.Tag{}
+	getOrCreateNode := func(path string) *v1pb.Tag {
+		if node, ok := nodes[path]; ok {
+			return node
+		}
+		node := &v1pb.Tag{
+			Path:        path,
+			DisplayName: path[strings.LastIndex(path, tagPathSeparator)+1:],
+		}
+		nodes[path] = node
+		if index := strings.LastIndex(path, tagPathSeparator); index >= 0 {
+			parent := nodes[path[:index]]
+			parent.Children = append(parent.Children, node)
+		} else {
+			roots = append(roots, node)
+		}
+		return node
+	}
+
+	for _, tags := range memoTags {
+		exactPaths := map[string]bool{}
+		totalPaths := map[string]bool{}
+		for _, tag := range tags {
+			path := normalizeTagPath(tag)
+			if path == "" {
+				continue
+			}
+			exactPaths[path] = true
+			segments := strings.Split(path, tagPathSeparator)
+			for i := range segments {
+				prefix := strings.Join(segments[:i+1], tagPathSeparator)
+				getOrCreateNode(prefix)
+				totalPaths[prefix] = true
+			}
+		}
+		for path := range exactPaths {
+			nodes[path].MemoCount++
+		}
+		for path := range totalPaths {
+			nodes[path].TotalMemoCount++
+		}
+	}
+
+	sortTags(roots)
+	return roots
+}
+
+func attachTagMetadata(tags []*v1pb.Tag, metadataByTag map[string]*v1pb.TagMetadata) {
+	for _, tag := range tags {
+		tag.Metadata = metadataByTag[tag.Path]
+		attachTagMetadata(tag.Children, metadataByTag)
+	}
+}
+
+func sortTags(tags []*v1pb.Tag) {
+	slices.SortFunc(tags, func(a, b *v1pb.Tag) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	for _, tag := range tags {
+		sortTags(tag.Children)
+	}
+}
@@ -0,0 +1,264 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// tagShareTokenLength is the length of the secret token of tag share links.
+const tagShareTokenLength = 32
+
+// Helper function to extract user ID and tag share ID from tag share resource name.
+// Format: users/{user}/tagShares/{tag_share}.
+func extractUserAndTagShareIDFromName(name string) (int32, int32, error) {
+	parts := strings.Split(name, "/")
+	if len(parts) != 4 || parts[0] != "users" || parts[2] != "tagShares" {
+		return 0, 0, errors.Errorf("invalid tag share name format: %s", name)
+	}
+
+	userID, err := util.ConvertStringToInt32(parts[1])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid user ID %q", parts[1])
+	}
+	tagShareID, err := util.ConvertStringToInt32(parts[3])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid tag share ID %q", parts[3])
+	}
+	return userID, tagShareID, nil
+}
+
+func (s *APIV1Service) ListTagShares(ctx context.Context, request *v1pb.ListTagSharesRequest) (*v1pb.ListTagSharesResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	tagShares, err := s.Store.ListTagShares(ctx, &store.FindTagShare{
+		CreatorID: &userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tag shares: %v", err)
+	}
+	response := &v1pb.ListTagSharesResponse{
+		TagShares: []*v1pb.TagShare{},
+	}
+	for _, tagShare := range tagShares {
+		response.TagShares = append(response.TagShares, convertTagShareFromStore(tagShare))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) CreateTagShare(ctx context.Context, request *v1pb.CreateTagShareRequest) (*v1pb.TagShare, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	if request.TagShare == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "tag share is required")
+	}
+
+	create := &store.TagShare{
+		CreatorID: userID,
+		Tag:       normalizeTagPath(request.TagShare.Tag),
+	}
+	if create.Tag == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "tag must not be empty")
+	}
+	if request.TagShare.Grantee != "" {
+		granteeID, err := ExtractUserIDFromName(request.TagShare.Grantee)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid grantee: %v", err)
+		}
+		if granteeID == userID {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot share a tag with yourself")
+		}
+		grantee, err := s.Store.GetUser(ctx, &store.FindUser{ID: &granteeID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get grantee: %v", err)
+		}
+		if grantee == nil {
+			return nil, status.Errorf(codes.NotFound, "grantee not found")
+		}
+		create.GranteeID = granteeID
+	} else {
+		token, err := util.RandomString(tagShareTokenLength)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
+		}
+		create.Token = token
+	}
+
+	tagShare, err := s.Store.CreateTagShare(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create tag share: %v", err)
+	}
+	return convertTagShareFromStore(tagShare), nil
+}
+
+func (s *APIV1Service) DeleteTagShare(ctx context.Context, request *v1pb.DeleteTagShareRequest) (*emptypb.Empty, error) {
+	userID, tagShareID, err := extractUserAndTagShareIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag share name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	tagShare, err := s.Store.GetTagShare(ctx, &store.FindTagShare{
+		ID:        &tagShareID,
+		CreatorID: &userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get tag share: %v", err)
+	}
+	if tagShare == nil {
+		return nil, status.Errorf(codes.NotFound, "tag share not found")
+	}
+	if err := s.Store.DeleteTagShare(ctx, &store.DeleteTagShare{ID: tagShare.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete tag share: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) ListSharedTagMemos(ctx context.Context, request *v1pb.ListSharedTagMemosRequest) (*v1pb.ListSharedTagMemosResponse, error) {
+	if request.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token is required")
+	}
+	tagShare, err := s.Store.GetTagShare(ctx, &store.FindTagShare{
+		Token: &request.Token,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get tag share: %v", err)
+	}
+	if tagShare == nil {
+		return nil, status.Errorf(codes.NotFound, "tag share not found")
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	limitPlusOne := limit + 1
+	normalStatus := store.Normal
+	filter := buildTagShareFilter([]*store.TagShare{tagShare})
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:       &tagShare.CreatorID,
+		RowStatus:       &normalStatus,
+		ExcludeComments: true,
+		Filter:          &filter,
+		Limit:           &limitPlusOne,
+		Offset:          &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+
+	response := &v1pb.ListSharedTagMemosResponse{
+		Memos: []*v1pb.Memo{},
+	}
+	if len(memos) == limitPlusOne {
+		memos = memos[:limit]
+		response.NextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+	s.renderMemoContents(ctx, memos)
+	for _, memo := range memos {
+		memoMessage, err := s.convertMemoFromStore(ctx, memo)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to convert memo")
+		}
+		response.Memos = append(response.Memos, memoMessage)
+	}
+	return response, nil
+}
+
+// listGrantedTagShares returns the tag shares granted to the user, grouped by the sharing user.
+func (s *APIV1Service) listGrantedTagShares(ctx context.Context, userID int32) (map[int32][]*store.TagShare, error) {
+	tagShares, err := s.Store.ListTagShares(ctx, &store.FindTagShare{
+		GranteeID: &userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	grantedTagShares := map[int32][]*store.TagShare{}
+	for _, tagShare := range tagShares {
+		grantedTagShares[tagShare.CreatorID] = append(grantedTagShares[tagShare.CreatorID], tagShare)
+	}
+	return grantedTagShares, nil
+}
+
+// canReadMemoViaTagShare returns true if the memo carries a tag its creator shared with the user.
+func (s *APIV1Service) canReadMemoViaTagShare(ctx context.Context, userID int32, memo *store.Memo) (bool, error) {
+	if memo.Payload == nil || len(memo.Payload.Tags) == 0 {
+		return false, nil
+	}
+	tagShares, err := s.Store.ListTagShares(ctx, &store.FindTagShare{
+		CreatorID: &memo.CreatorID,
+		GranteeID: &userID,
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, tagShare := range tagShares {
+		for _, tag := range memo.Payload.Tags {
+			if isTagOrDescendant(tag, tagShare.Tag) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// buildTagShareFilter builds a memo filter matching the memos covered by the tag shares of a single creator.
+// The filter matches the tags exactly, like canReadMemoViaTagShare.
+func buildTagShareFilter(tagShares []*store.TagShare) string {
+	tags := []string{}
+	for _, tagShare := range tagShares {
+		tags = append(tags, strconv.Quote(tagShare.Tag))
+	}
+	return fmt.Sprintf("(creator_id == %d && tag in [%s])", tagShares[0].CreatorID, strings.Join(tags, ", "))
+}
+
+func convertTagShareFromStore(tagShare *store.TagShare) *v1pb.TagShare {
+	tagShareMessage := &v1pb.TagShare{
+		Name:       fmt.Sprintf("%s%d/tagShares/%d", UserNamePrefix, tagShare.CreatorID, tagShare.ID),
+		Tag:        tagShare.Tag,
+		Token:      tagShare.Token,
+		CreateTime: timestamppb.New(time.Unix(tagShare.CreatedTs, 0)),
+	}
+	if tagShare.GranteeID != 0 {
+		tagShareMessage.Grantee = fmt.Sprintf("%s%d", UserNamePrefix, tagShare.GranteeID)
+	}
+	return tagShareMessage
+}
@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/accesslog"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestAccessLogMiddleware(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "john")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	accessToken, err := ts.Service.CreateUserAccessToken(userCtx, &v1pb.CreateUserAccessTokenRequest{
+		Parent:      fmt.Sprintf("users/%d", user.ID),
+		AccessToken: &v1pb.UserAccessToken{Description: "test"},
+	})
+	require.NoError(t, err)
+
+	buffer := &bytes.Buffer{}
+	e := echo.New()
+	e.Use(ts.Service.AccessLogMiddleware(accesslog.NewWriterLogger(buffer)))
+	e.GET("/hello", func(c echo.Context) error {
+		return c.String(http.StatusOK, "hello")
+	})
+	request := func(target, authorization string) *accesslog.Entry {
+		buffer.Reset()
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		req.Header.Set("User-Agent", "test")
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		e.ServeHTTP(httptest.NewRecorder(), req)
+		require.True(t, strings.HasSuffix(buffer.String(), "\n"))
+		entry := &accesslog.Entry{}
+		require.NoError(t, json.Unmarshal(buffer.Bytes(), entry))
+		return entry
+	}
+
+	// The query of the requests is not logged, as it may contain secrets.
+	entry := request("/hello?token=secret", "Bearer "+accessToken.AccessToken)
+	require.Equal(t, http.MethodGet, entry.Method)
+	require.Equal(t, "/hello", entry.Path)
+	require.Equal(t, "john", entry.User)
+	require.Equal(t, http.StatusOK, entry.Status)
+	require.Equal(t, int64(len("hello")), entry.Bytes)
+	require.Equal(t, "test", entry.UserAgent)
+	require.NotEmpty(t, entry.RemoteIP)
+	require.False(t, entry.Time.IsZero())
+
+	// The anonymous visitors, the invalid tokens and the errors of the handlers are logged too.
+	entry = request("/missing", "Bearer invalid")
+	require.Empty(t, entry.User)
+	require.Equal(t, http.StatusNotFound, entry.Status)
+	require.NotZero(t, entry.Bytes)
+}
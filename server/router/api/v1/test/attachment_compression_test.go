@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestAttachmentImageCompression(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "testuser")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_STORAGE,
+		Value: &storepb.WorkspaceSetting_StorageSetting{
+			StorageSetting: &storepb.WorkspaceStorageSetting{
+				StorageType: storepb.WorkspaceStorageSetting_DATABASE,
+				ImageCompression: &storepb.WorkspaceStorageSetting_ImageCompression{
+					Enabled:      true,
+					MaxDimension: 300,
+					Format:       storepb.WorkspaceStorageSetting_ImageCompression_JPEG,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// A photo of random pixels, which compresses badly.
+	random := rand.New(rand.NewSource(1))
+	img := image.NewRGBA(image.Rect(0, 0, 600, 400))
+	for i := range img.Pix {
+		img.Pix[i] = byte(random.Intn(256))
+	}
+	var content bytes.Buffer
+	require.NoError(t, png.Encode(&content, img))
+
+	attachment, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "photo.png", Type: "image/png", Content: content.Bytes()},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "photo.jpg", attachment.Filename)
+	require.Equal(t, "image/jpeg", attachment.Type)
+	require.Less(t, attachment.Size, int64(content.Len()))
+
+	binary, err := ts.Service.GetAttachmentBinary(userCtx, &v1pb.GetAttachmentBinaryRequest{
+		Name:     attachment.Name,
+		Filename: attachment.Filename,
+	})
+	require.NoError(t, err)
+	config, err := jpeg.DecodeConfig(bytes.NewReader(binary.Data))
+	require.NoError(t, err)
+	require.Equal(t, 300, config.Width)
+	require.Equal(t, 200, config.Height)
+
+	// The conversion to WebP without ffmpeg keeps the images as they are.
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_STORAGE,
+		Value: &storepb.WorkspaceSetting_StorageSetting{
+			StorageSetting: &storepb.WorkspaceStorageSetting{
+				StorageType: storepb.WorkspaceStorageSetting_DATABASE,
+				ImageCompression: &storepb.WorkspaceStorageSetting_ImageCompression{
+					Enabled: true,
+					Format:  storepb.WorkspaceStorageSetting_ImageCompression_WEBP,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	attachment, err = ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "photo.png", Type: "image/png", Content: content.Bytes()},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "photo.png", attachment.Filename)
+	require.Equal(t, int64(content.Len()), attachment.Size)
+}
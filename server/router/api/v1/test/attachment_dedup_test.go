@@ -0,0 +1,85 @@
+package v1
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestAttachmentDeduplication(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	// The files are stored at absolute paths, as the store resolves relative paths in its own data directory.
+	assetsDir := t.TempDir()
+	_, err := ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_STORAGE,
+		Value: &storepb.WorkspaceSetting_StorageSetting{
+			StorageSetting: &storepb.WorkspaceStorageSetting{
+				StorageType:      storepb.WorkspaceStorageSetting_LOCAL,
+				FilepathTemplate: filepath.Join(assetsDir, "{uuid}_{filename}"),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	user, err := ts.CreateRegularUser(ctx, "testuser")
+	require.NoError(t, err)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+
+	getStored := func(name string) *store.Attachment {
+		uid := name[len("attachments/"):]
+		attachment, err := ts.Store.GetAttachment(ctx, &store.FindAttachment{UID: &uid})
+		require.NoError(t, err)
+		require.NotNil(t, attachment)
+		return attachment
+	}
+	create := func(userID int32, filename string, content []byte) *store.Attachment {
+		attachment, err := ts.Service.CreateAttachment(ts.CreateUserContext(ctx, userID), &v1pb.CreateAttachmentRequest{
+			Attachment: &v1pb.Attachment{Filename: filename, Type: "text/plain", Content: content},
+		})
+		require.NoError(t, err)
+		return getStored(attachment.Name)
+	}
+
+	first := create(user.ID, "screenshot.txt", []byte("same content"))
+	second := create(other.ID, "copy.txt", []byte("same content"))
+	different := create(user.ID, "screenshot.txt", []byte("other content"))
+	require.Len(t, first.ContentHash, 64)
+	require.Equal(t, first.ContentHash, second.ContentHash)
+	require.Equal(t, first.Reference, second.Reference)
+	require.NotEqual(t, first.Reference, different.Reference)
+	require.Equal(t, "copy.txt", second.Filename)
+
+	assets, err := filepath.Glob(filepath.Join(assetsDir, "*"))
+	require.NoError(t, err)
+	require.Len(t, assets, 2)
+
+	// The shared file is kept until its last attachment is deleted.
+	filePath := filepath.FromSlash(first.Reference)
+	require.NoError(t, ts.Store.DeleteAttachment(ctx, &store.DeleteAttachment{ID: first.ID}))
+	_, err = os.Stat(filePath)
+	require.NoError(t, err)
+	blob, err := ts.Service.GetAttachmentBlob(getStored("attachments/" + second.UID))
+	require.NoError(t, err)
+	require.Equal(t, []byte("same content"), blob)
+
+	require.NoError(t, ts.Store.DeleteAttachment(ctx, &store.DeleteAttachment{ID: second.ID}))
+	_, err = os.Stat(filePath)
+	require.True(t, os.IsNotExist(err))
+
+	// A new upload of the content stores it again.
+	third := create(user.ID, "screenshot.txt", []byte("same content"))
+	require.NotEqual(t, first.Reference, third.Reference)
+	_, err = os.Stat(filepath.FromSlash(third.Reference))
+	require.NoError(t, err)
+}
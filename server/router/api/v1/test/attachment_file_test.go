@@ -0,0 +1,156 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestServeAttachmentFile(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	ts.Profile.Data = t.TempDir()
+
+	user, err := ts.CreateRegularUser(ctx, "testuser")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	accessToken, err := ts.Service.CreateUserAccessToken(userCtx, &v1pb.CreateUserAccessTokenRequest{
+		Parent:      fmt.Sprintf("users/%d", user.ID),
+		AccessToken: &v1pb.UserAccessToken{Description: "test"},
+	})
+	require.NoError(t, err)
+	_, err = ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "private-memo",
+		CreatorID:  user.ID,
+		Content:    "Private videos",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	e := echo.New()
+	handler := ts.Service.ServeAttachmentFile(func(c echo.Context) error {
+		return c.String(http.StatusTeapot, "gateway")
+	})
+	e.GET("/file/attachments/:uid/:filename", handler)
+	e.HEAD("/file/attachments/:uid/:filename", handler)
+	request := func(method, target string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, nil)
+		req.Header.Set("Authorization", "Bearer "+accessToken.AccessToken)
+		for key, value := range headers {
+			req.Header.Set(key, value)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+	setStorageSetting := func(setting *storepb.WorkspaceStorageSetting) {
+		_, err := ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+			Key:   storepb.WorkspaceSettingKey_STORAGE,
+			Value: &storepb.WorkspaceSetting_StorageSetting{StorageSetting: setting},
+		})
+		require.NoError(t, err)
+	}
+	memoName := "memos/private-memo"
+	createAttachment := func(uid string) {
+		_, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+			Attachment: &v1pb.Attachment{
+				Filename: "video.mp4",
+				Type:     "video/mp4",
+				Content:  []byte("0123456789abcdef"),
+				Memo:     &memoName,
+			},
+			AttachmentId: uid,
+		})
+		require.NoError(t, err)
+	}
+	s3Server, _ := newFakeS3Server(t)
+
+	for _, storage := range []struct {
+		name    string
+		setting *storepb.WorkspaceStorageSetting
+	}{
+		{
+			name: "local",
+			// The files are stored at absolute paths, as the store resolves relative paths in its own data directory.
+			setting: &storepb.WorkspaceStorageSetting{
+				StorageType:      storepb.WorkspaceStorageSetting_LOCAL,
+				FilepathTemplate: filepath.Join(t.TempDir(), "{filename}"),
+			},
+		},
+		{
+			name:    "database",
+			setting: &storepb.WorkspaceStorageSetting{StorageType: storepb.WorkspaceStorageSetting_DATABASE},
+		},
+		{
+			name: "s3",
+			setting: &storepb.WorkspaceStorageSetting{
+				StorageType: storepb.WorkspaceStorageSetting_S3,
+				S3Config: &storepb.StorageS3Config{
+					AccessKeyId:     "key",
+					AccessKeySecret: "secret",
+					Endpoint:        s3Server.URL,
+					Region:          "us-east-1",
+					Bucket:          "memos",
+					UsePathStyle:    true,
+				},
+			},
+		},
+	} {
+		t.Run(storage.name, func(t *testing.T) {
+			setStorageSetting(storage.setting)
+			createAttachment(storage.name)
+			target := "/file/attachments/" + storage.name + "/video.mp4"
+
+			rec := request(http.MethodGet, target, nil)
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.Equal(t, "0123456789abcdef", rec.Body.String())
+			require.Equal(t, "video/mp4", rec.Header().Get("Content-Type"))
+			require.Equal(t, "bytes", rec.Header().Get("Accept-Ranges"))
+
+			rec = request(http.MethodGet, target, map[string]string{"Range": "bytes=2-5"})
+			require.Equal(t, http.StatusPartialContent, rec.Code)
+			require.Equal(t, "2345", rec.Body.String())
+			require.Equal(t, "bytes 2-5/16", rec.Header().Get("Content-Range"))
+			require.Equal(t, "4", rec.Header().Get("Content-Length"))
+
+			rec = request(http.MethodGet, target, map[string]string{"Range": "bytes=100-"})
+			require.Equal(t, http.StatusRequestedRangeNotSatisfiable, rec.Code)
+			require.Equal(t, "bytes */16", rec.Header().Get("Content-Range"))
+
+			rec = request(http.MethodHead, target, nil)
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.Equal(t, "16", rec.Header().Get("Content-Length"))
+			require.Empty(t, rec.Body.String())
+		})
+	}
+
+	// The attachments of private memos are only served to their creator.
+	req := httptest.NewRequest(http.MethodGet, "/file/attachments/local/video.mp4", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	// The derived images are served by the gateway.
+	rec = request(http.MethodGet, "/file/attachments/local/video.mp4?poster=true", nil)
+	require.Equal(t, http.StatusTeapot, rec.Code)
+
+	rec = request(http.MethodGet, "/file/attachments/missing/video.mp4", nil)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	// The suspended users are anonymous visitors, as for the gateway.
+	require.NoError(t, ts.Store.UpsertUserSuspension(ctx, user.ID, &storepb.SuspensionUserSetting{Suspended: true}))
+	rec = request(http.MethodGet, "/file/attachments/local/video.mp4", nil)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+}
@@ -0,0 +1,101 @@
+package v1
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestGCSAttachmentStorage(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	// A fake GCS issuing tokens and storing the uploaded objects.
+	var mutex sync.Mutex
+	objects := map[string][]byte{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		switch {
+		case r.URL.Path == "/token":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":3600}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/upload/storage/v1/b/memos/o":
+			name := r.URL.Query().Get("name")
+			objects[name], _ = io.ReadAll(r.Body)
+			_ = json.NewEncoder(w).Encode(map[string]string{"name": name})
+		case r.Method == http.MethodDelete:
+			delete(objects, strings.TrimPrefix(r.URL.Path, "/storage/v1/b/memos/o/"))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	credentials, err := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"client_email": "memos@project.iam.gserviceaccount.com",
+		"private_key":  string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})),
+		"token_uri":    server.URL + "/token",
+	})
+	require.NoError(t, err)
+
+	host, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	_, err = ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting: &v1pb.WorkspaceSetting{
+			Name: "workspace/settings/STORAGE",
+			Value: &v1pb.WorkspaceSetting_StorageSetting{
+				StorageSetting: &v1pb.WorkspaceStorageSetting{
+					StorageType:      v1pb.WorkspaceStorageSetting_GCS,
+					FilepathTemplate: "assets/{filename}",
+					GcsConfig: &v1pb.WorkspaceStorageSetting_GCSConfig{
+						Credentials: string(credentials),
+						Bucket:      "memos",
+						Endpoint:    server.URL,
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	attachment, err := ts.Service.CreateAttachment(hostCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "notes.txt", Type: "text/plain", Content: []byte("notes")},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte("notes"), objects["assets/notes.txt"])
+	require.True(t, strings.HasPrefix(attachment.ExternalLink, server.URL+"/memos/assets/notes.txt?"), attachment.ExternalLink)
+	require.Contains(t, attachment.ExternalLink, "X-Goog-Signature=")
+
+	attachmentUID := strings.TrimPrefix(attachment.Name, "attachments/")
+	stored, err := ts.Store.GetAttachment(ctx, &store.FindAttachment{UID: &attachmentUID})
+	require.NoError(t, err)
+	require.Equal(t, storepb.AttachmentStorageType_GCS, stored.StorageType)
+	require.Equal(t, "assets/notes.txt", stored.Payload.GetGcsObject().GetKey())
+
+	_, err = ts.Service.DeleteAttachment(hostCtx, &v1pb.DeleteAttachmentRequest{Name: attachment.Name})
+	require.NoError(t, err)
+	require.Empty(t, objects)
+}
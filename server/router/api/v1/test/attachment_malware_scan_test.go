@@ -0,0 +1,131 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestAttachmentMalwareScan(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	ts.Profile.Data = t.TempDir()
+
+	user, err := ts.CreateRegularUser(ctx, "testuser")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// A fake clamscan flagging the files containing "virus".
+	scannerPath := filepath.Join(t.TempDir(), "clamscan")
+	script := "#!/bin/sh\nif grep -q virus \"$1\"; then echo \"$1: Test.Virus FOUND\"; exit 1; fi\n"
+	require.NoError(t, os.WriteFile(scannerPath, []byte(script), 0o755))
+	setScanSetting := func(setting *storepb.WorkspaceMalwareScanSetting) {
+		_, err := ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+			Key:   storepb.WorkspaceSettingKey_MALWARE_SCAN,
+			Value: &storepb.WorkspaceSetting_MalwareScanSetting{MalwareScanSetting: setting},
+		})
+		require.NoError(t, err)
+	}
+	createAttachment := func(content string) (*v1pb.Attachment, error) {
+		return ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+			Attachment: &v1pb.Attachment{Filename: "invoice.pdf", Type: "application/pdf", Content: []byte(content)},
+		})
+	}
+	quarantined := func() []string {
+		entries, err := os.ReadDir(filepath.Join(ts.Profile.Data, "quarantine"))
+		if os.IsNotExist(err) {
+			return nil
+		}
+		require.NoError(t, err)
+		names := []string{}
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+		}
+		return names
+	}
+
+	// The files are not scanned by default.
+	_, err = createAttachment("virus")
+	require.NoError(t, err)
+
+	setScanSetting(&storepb.WorkspaceMalwareScanSetting{
+		Enabled: true,
+		Scanner: storepb.WorkspaceMalwareScanSetting_COMMAND,
+		Command: scannerPath,
+	})
+	_, err = createAttachment("clean")
+	require.NoError(t, err)
+	_, err = createAttachment("virus")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Empty(t, quarantined())
+
+	// The quarantined files are rejected and kept for review.
+	setScanSetting(&storepb.WorkspaceMalwareScanSetting{
+		Enabled: true,
+		Scanner: storepb.WorkspaceMalwareScanSetting_COMMAND,
+		Command: scannerPath,
+		Action:  storepb.WorkspaceMalwareScanSetting_QUARANTINE,
+	})
+	_, err = createAttachment("virus")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	names := quarantined()
+	require.Len(t, names, 1)
+	require.Contains(t, names[0], "invoice.pdf")
+	content, err := os.ReadFile(filepath.Join(ts.Profile.Data, "quarantine", names[0]))
+	require.NoError(t, err)
+	require.Equal(t, "virus", string(content))
+
+	// The flagged last chunk of an upload fails the upload.
+	upload, err := ts.Service.CreateAttachmentUpload(userCtx, &v1pb.CreateAttachmentUploadRequest{
+		AttachmentUpload: &v1pb.AttachmentUpload{
+			Attachment: &v1pb.Attachment{Filename: "invoice.pdf", Type: "application/pdf"},
+			Size:       5,
+		},
+		AttachmentId: "upload",
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.AppendAttachmentUpload(userCtx, &v1pb.AppendAttachmentUploadRequest{
+		Name: upload.Name, Offset: 0, Data: []byte("virus"),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	uploadUID := "upload"
+	attachment, err := ts.Store.GetAttachment(ctx, &store.FindAttachment{UID: &uploadUID})
+	require.NoError(t, err)
+	require.Nil(t, attachment)
+	_, err = ts.Service.GetAttachmentUpload(userCtx, &v1pb.GetAttachmentUploadRequest{Name: upload.Name})
+	require.Error(t, err)
+
+	// The files are rejected when the scanner fails, unless the setting fails open.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+	setScanSetting(&storepb.WorkspaceMalwareScanSetting{
+		Enabled:  true,
+		Scanner:  storepb.WorkspaceMalwareScanSetting_API,
+		Endpoint: server.URL,
+	})
+	_, err = createAttachment("clean")
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	setScanSetting(&storepb.WorkspaceMalwareScanSetting{
+		Enabled:  true,
+		Scanner:  storepb.WorkspaceMalwareScanSetting_API,
+		Endpoint: server.URL,
+		FailOpen: true,
+	})
+	_, err = createAttachment("clean")
+	require.NoError(t, err)
+}
@@ -0,0 +1,98 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// jpegWithExif returns a JPEG image with an EXIF segment of the orientation 6 and a camera model.
+func jpegWithExif(t *testing.T) []byte {
+	var encoded bytes.Buffer
+	require.NoError(t, jpeg.Encode(&encoded, image.NewRGBA(image.Rect(0, 0, 8, 8)), nil))
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08\x00\x02")
+	// Orientation.
+	tiff = append(tiff, 0x01, 0x12, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01, 0x00, 0x06, 0x00, 0x00)
+	// Model, stored after the IFD.
+	tiff = append(tiff, 0x01, 0x10, 0x00, 0x02, 0x00, 0x00, 0x00, 0x0A, 0x00, 0x00, 0x00, 0x26)
+	tiff = append(tiff, 0x00, 0x00, 0x00, 0x00)
+	tiff = append(tiff, []byte("Canon EOS\x00")...)
+	exif := append([]byte("Exif\x00\x00"), tiff...)
+	data := []byte{0xFF, 0xD8, 0xFF, 0xE1, byte((len(exif) + 2) >> 8), byte(len(exif) + 2)}
+	data = append(data, exif...)
+	return append(data, encoded.Bytes()[2:]...)
+}
+
+func TestAttachmentMetadataStripping(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "testuser")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	content := jpegWithExif(t)
+
+	upload := func() []byte {
+		attachment, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+			Attachment: &v1pb.Attachment{Filename: "photo.jpg", Type: "image/jpeg", Content: content},
+		})
+		require.NoError(t, err)
+		uid := strings.TrimPrefix(attachment.Name, "attachments/")
+		stored, err := ts.Store.GetAttachment(ctx, &store.FindAttachment{UID: &uid, GetBlob: true})
+		require.NoError(t, err)
+		require.Equal(t, int64(len(stored.Blob)), attachment.Size)
+		return stored.Blob
+	}
+
+	// The metadata are kept by default.
+	require.Contains(t, string(upload()), "Canon")
+
+	// The user strips the metadata of their images, keeping the orientation.
+	_, err = ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+		Setting: &v1pb.UserSetting{
+			Name:                 fmt.Sprintf("users/%d", user.ID),
+			StripImageMetadata:   true,
+			KeepImageOrientation: true,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"strip_image_metadata", "keep_image_orientation"}},
+	})
+	require.NoError(t, err)
+	blob := upload()
+	require.NotContains(t, string(blob), "Canon")
+	require.Contains(t, string(blob), "Exif")
+	_, err = jpeg.Decode(bytes.NewReader(blob))
+	require.NoError(t, err)
+
+	// The workspace strips the metadata of all the images.
+	_, err = ts.Service.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+		Setting:    &v1pb.UserSetting{Name: fmt.Sprintf("users/%d", user.ID)},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"strip_image_metadata", "keep_image_orientation"}},
+	})
+	require.NoError(t, err)
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_STORAGE,
+		Value: &storepb.WorkspaceSetting_StorageSetting{
+			StorageSetting: &storepb.WorkspaceStorageSetting{
+				StorageType:        storepb.WorkspaceStorageSetting_DATABASE,
+				StripImageMetadata: true,
+			},
+		},
+	})
+	require.NoError(t, err)
+	blob = upload()
+	require.NotContains(t, string(blob), "Canon")
+	require.NotContains(t, string(blob), "Exif")
+}
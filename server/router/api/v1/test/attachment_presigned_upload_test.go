@@ -0,0 +1,168 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// newFakeS3Server returns a server storing the objects put to it, in the path style, and serving their ranges.
+func newFakeS3Server(t *testing.T) (*httptest.Server, map[string][]byte) {
+	var mutex sync.Mutex
+	objects := map[string][]byte{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		switch r.Method {
+		case http.MethodPut:
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			objects[r.URL.Path] = body
+		case http.MethodHead:
+			object, ok := objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Length", strconv.Itoa(len(object)))
+		case http.MethodGet:
+			object, ok := objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(object))
+		case http.MethodDelete:
+			delete(objects, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, objects
+}
+
+func TestPresignedAttachmentUpload(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Content is put to S3 and the upload completed", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+		ts.Profile.Data = t.TempDir()
+
+		server, objects := newFakeS3Server(t)
+		_, err := ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+			Key: storepb.WorkspaceSettingKey_STORAGE,
+			Value: &storepb.WorkspaceSetting_StorageSetting{
+				StorageSetting: &storepb.WorkspaceStorageSetting{
+					StorageType:      storepb.WorkspaceStorageSetting_S3,
+					FilepathTemplate: "assets/{filename}",
+					S3Config: &storepb.StorageS3Config{
+						AccessKeyId:     "key",
+						AccessKeySecret: "secret",
+						Endpoint:        server.URL,
+						Region:          "us-east-1",
+						Bucket:          "memos",
+						UsePathStyle:    true,
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		user, err := ts.CreateRegularUser(ctx, "testuser")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+
+		content := bytes.Repeat([]byte("0123456789"), 100)
+		upload, err := ts.Service.CreateAttachmentUpload(userCtx, &v1pb.CreateAttachmentUploadRequest{
+			AttachmentUpload: &v1pb.AttachmentUpload{
+				Attachment: &v1pb.Attachment{Filename: "video.mp4", Type: "video/mp4"},
+				Size:       int64(len(content)),
+			},
+			AttachmentId: "video",
+			Presigned:    true,
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, upload.UploadUrl)
+
+		// The content is not appended through the server.
+		_, err = ts.Service.AppendAttachmentUpload(userCtx, &v1pb.AppendAttachmentUploadRequest{
+			Name: upload.Name, Offset: 0, Data: content,
+		})
+		require.Error(t, err)
+
+		// An upload without its content is not completed.
+		_, err = ts.Service.CompleteAttachmentUpload(userCtx, &v1pb.CompleteAttachmentUploadRequest{Name: upload.Name})
+		require.Error(t, err)
+
+		put := func(data []byte) {
+			request, err := http.NewRequest(http.MethodPut, upload.UploadUrl, bytes.NewReader(data))
+			require.NoError(t, err)
+			request.Header.Set("Content-Type", "video/mp4")
+			response, err := http.DefaultClient.Do(request)
+			require.NoError(t, err)
+			require.NoError(t, response.Body.Close())
+			require.Equal(t, http.StatusOK, response.StatusCode)
+		}
+		// Content of another size than declared is rejected.
+		put(content[:10])
+		_, err = ts.Service.CompleteAttachmentUpload(userCtx, &v1pb.CompleteAttachmentUploadRequest{Name: upload.Name})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "bytes")
+
+		put(content)
+		upload, err = ts.Service.CompleteAttachmentUpload(userCtx, &v1pb.CompleteAttachmentUploadRequest{Name: upload.Name})
+		require.NoError(t, err)
+		require.True(t, upload.Done)
+		require.Equal(t, "attachments/video", upload.Attachment.Name)
+		require.Equal(t, content, objects["/memos/assets/video.mp4"])
+
+		attachmentUID := "video"
+		attachment, err := ts.Store.GetAttachment(ctx, &store.FindAttachment{UID: &attachmentUID})
+		require.NoError(t, err)
+		require.Equal(t, storepb.AttachmentStorageType_S3, attachment.StorageType)
+		require.Equal(t, "assets/video.mp4", attachment.Payload.GetS3Object().Key)
+
+		// Completing again reports the done upload.
+		upload, err = ts.Service.CompleteAttachmentUpload(userCtx, &v1pb.CompleteAttachmentUploadRequest{Name: upload.Name})
+		require.NoError(t, err)
+		require.True(t, upload.Done)
+	})
+
+	t.Run("Presigned uploads require the S3 storage", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+		ts.Profile.Data = t.TempDir()
+
+		user, err := ts.CreateRegularUser(ctx, "testuser")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+
+		_, err = ts.Service.CreateAttachmentUpload(userCtx, &v1pb.CreateAttachmentUploadRequest{
+			AttachmentUpload: &v1pb.AttachmentUpload{
+				Attachment: &v1pb.Attachment{Filename: "video.mp4", Type: "video/mp4"},
+				Size:       10,
+			},
+			Presigned: true,
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "S3")
+	})
+}
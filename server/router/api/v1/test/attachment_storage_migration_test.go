@@ -0,0 +1,155 @@
+package v1
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestAttachmentStorageMigration(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	ts.Profile.Data = t.TempDir()
+
+	host, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	user, err := ts.CreateRegularUser(ctx, "testuser")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	setStorageSetting := func(setting *storepb.WorkspaceStorageSetting) {
+		_, err := ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+			Key:   storepb.WorkspaceSettingKey_STORAGE,
+			Value: &storepb.WorkspaceSetting_StorageSetting{StorageSetting: setting},
+		})
+		require.NoError(t, err)
+	}
+	getStored := func(uid string) *store.Attachment {
+		attachment, err := ts.Store.GetAttachment(ctx, &store.FindAttachment{UID: &uid, GetBlob: true})
+		require.NoError(t, err)
+		require.NotNil(t, attachment)
+		return attachment
+	}
+	contents := map[string]string{
+		"first":  "same content",
+		"second": "same content",
+		"third":  "png content",
+		"fourth": "other png content",
+	}
+	filenames := map[string]string{
+		"first":  "notes.txt",
+		"second": "copy.txt",
+		"third":  "image.png",
+		"fourth": "image.png",
+	}
+	for _, uid := range []string{"first", "second", "third", "fourth"} {
+		_, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+			Attachment:   &v1pb.Attachment{Filename: filenames[uid], Type: "text/plain", Content: []byte(contents[uid])},
+			AttachmentId: uid,
+		})
+		require.NoError(t, err)
+	}
+	// An external link is not migrated.
+	_, err = ts.Store.CreateAttachment(ctx, &store.Attachment{
+		UID:         "link",
+		CreatorID:   user.ID,
+		Filename:    "link.png",
+		Type:        "image/png",
+		StorageType: storepb.AttachmentStorageType_EXTERNAL,
+		Reference:   "https://example.com/link.png",
+	})
+	require.NoError(t, err)
+
+	// The files are stored at absolute paths, as the store resolves relative paths in its own data directory.
+	// The files of the same name written in the same second are kept apart.
+	assetsDir := t.TempDir()
+	setStorageSetting(&storepb.WorkspaceStorageSetting{
+		StorageType:      storepb.WorkspaceStorageSetting_LOCAL,
+		FilepathTemplate: filepath.Join(assetsDir, "{timestamp}_{filename}"),
+	})
+
+	// Only the host migrates the storage.
+	_, err = ts.Service.MigrateAttachmentStorage(userCtx, &v1pb.MigrateAttachmentStorageRequest{DryRun: true})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	migration, err := ts.Service.MigrateAttachmentStorage(hostCtx, &v1pb.MigrateAttachmentStorageRequest{DryRun: true})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.AttachmentStorageMigration_COMPLETED, migration.State)
+	require.True(t, migration.DryRun)
+	require.Equal(t, int32(4), migration.Total)
+	require.Zero(t, migration.Migrated)
+	require.Equal(t, storepb.AttachmentStorageType_ATTACHMENT_STORAGE_TYPE_UNSPECIFIED, getStored("first").StorageType)
+
+	reported := []int{}
+	progress, err := apiv1.MigrateAttachmentStorage(ctx, ts.Profile, ts.Store, apiv1.AttachmentStorageMigrationOptions{DeleteSource: true}, func(progress *apiv1.AttachmentStorageMigrationProgress) {
+		reported = append(reported, progress.Migrated)
+	})
+	require.NoError(t, err)
+	require.Equal(t, 4, progress.Total)
+	require.Equal(t, 4, progress.Migrated)
+	require.Empty(t, progress.Failures)
+	require.Equal(t, []int{0, 1, 2, 3, 4}, reported)
+	for uid, content := range contents {
+		attachment := getStored(uid)
+		require.Equal(t, storepb.AttachmentStorageType_LOCAL, attachment.StorageType)
+		require.Empty(t, attachment.Blob)
+		blob, err := ts.Service.GetAttachmentBlob(attachment)
+		require.NoError(t, err)
+		require.Equal(t, content, string(blob))
+		require.Equal(t, filenames[uid], attachment.Filename)
+	}
+	// The attachments sharing a content keep sharing it.
+	require.Equal(t, getStored("first").Reference, getStored("second").Reference)
+	assets, err := filepath.Glob(filepath.Join(assetsDir, "*"))
+	require.NoError(t, err)
+	require.Len(t, assets, 3)
+
+	// The attachments failing to migrate keep their storage, while the others are migrated.
+	require.NoError(t, os.Remove(getStored("third").Reference))
+	setStorageSetting(&storepb.WorkspaceStorageSetting{
+		StorageType: storepb.WorkspaceStorageSetting_DATABASE,
+	})
+	_, err = ts.Service.MigrateAttachmentStorage(hostCtx, &v1pb.MigrateAttachmentStorageRequest{DeleteSource: true})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		migration, err = ts.Service.GetAttachmentStorageMigration(hostCtx, &v1pb.GetAttachmentStorageMigrationRequest{})
+		require.NoError(t, err)
+		return migration.State != v1pb.AttachmentStorageMigration_RUNNING
+	}, 10*time.Second, 10*time.Millisecond)
+	require.Equal(t, v1pb.AttachmentStorageMigration_COMPLETED, migration.State)
+	require.Equal(t, int32(4), migration.Total)
+	require.Equal(t, int32(3), migration.Migrated)
+	require.Len(t, migration.Failures, 1)
+	require.Equal(t, "attachments/third", migration.Failures[0].Attachment)
+	require.NotNil(t, migration.EndTime)
+	// The migration is run by the job queue.
+	jobs, err := ts.Service.ListJobs(hostCtx, &v1pb.ListJobsRequest{Kind: "attachment_storage_migration"})
+	require.NoError(t, err)
+	require.Len(t, jobs.Jobs, 1)
+
+	require.Equal(t, storepb.AttachmentStorageType_LOCAL, getStored("third").StorageType)
+	for _, uid := range []string{"first", "second", "fourth"} {
+		attachment := getStored(uid)
+		require.Equal(t, storepb.AttachmentStorageType_ATTACHMENT_STORAGE_TYPE_UNSPECIFIED, attachment.StorageType)
+		require.Empty(t, attachment.Reference)
+		require.Equal(t, contents[uid], string(attachment.Blob))
+	}
+	// The migrated files are removed.
+	assets, err = filepath.Glob(filepath.Join(assetsDir, "*"))
+	require.NoError(t, err)
+	require.Empty(t, assets)
+}
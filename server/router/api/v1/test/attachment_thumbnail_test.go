@@ -0,0 +1,214 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+)
+
+func TestAttachmentThumbnails(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	ts.Profile.Data = t.TempDir()
+
+	user, err := ts.CreateRegularUser(ctx, "testuser")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	var content bytes.Buffer
+	require.NoError(t, png.Encode(&content, image.NewRGBA(image.Rect(0, 0, 2000, 1000))))
+	attachment, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "photo.png", Type: "image/png", Content: content.Bytes()},
+	})
+	require.NoError(t, err)
+
+	// The thumbnails of all sizes are generated on upload.
+	thumbnailCacheFolder := filepath.Join(ts.Profile.Data, apiv1.ThumbnailCacheFolder)
+	require.Eventually(t, func() bool {
+		matches, _ := filepath.Glob(filepath.Join(thumbnailCacheFolder, "*_*.png"))
+		return len(matches) == 3
+	}, 10*time.Second, 10*time.Millisecond)
+
+	for thumbnail, width := range map[string]int{
+		"small":  240,
+		"medium": 640,
+		"large":  1280,
+		"true":   640,
+	} {
+		body, err := ts.Service.GetAttachmentBinary(userCtx, &v1pb.GetAttachmentBinaryRequest{
+			Name:      attachment.Name,
+			Filename:  attachment.Filename,
+			Thumbnail: thumbnail,
+		})
+		require.NoError(t, err, thumbnail)
+		require.Equal(t, "image/png", body.ContentType)
+		config, err := png.DecodeConfig(bytes.NewReader(body.Data))
+		require.NoError(t, err, thumbnail)
+		require.Equal(t, width, config.Width, thumbnail)
+		require.Equal(t, width/2, config.Height, thumbnail)
+	}
+
+	// The thumbnails are generated lazily when missing from the cache.
+	require.NoError(t, os.RemoveAll(thumbnailCacheFolder))
+	body, err := ts.Service.GetAttachmentBinary(userCtx, &v1pb.GetAttachmentBinaryRequest{
+		Name:      attachment.Name,
+		Filename:  attachment.Filename,
+		Thumbnail: "small",
+	})
+	require.NoError(t, err)
+	config, err := png.DecodeConfig(bytes.NewReader(body.Data))
+	require.NoError(t, err)
+	require.Equal(t, 240, config.Width)
+
+	_, err = ts.Service.GetAttachmentBinary(userCtx, &v1pb.GetAttachmentBinaryRequest{
+		Name:      attachment.Name,
+		Filename:  attachment.Filename,
+		Thumbnail: "huge",
+	})
+	require.Error(t, err)
+}
+
+func TestAttachmentPosters(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	ts.Profile.Data = t.TempDir()
+
+	user, err := ts.CreateRegularUser(ctx, "testuser")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// Without ffmpeg, videos have no poster frame.
+	withoutPoster, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "clip.mp4", Type: "video/mp4", Content: []byte("video")},
+	})
+	require.NoError(t, err)
+	require.Empty(t, withoutPoster.PosterLink)
+
+	// A fake ffmpeg outputting a JPEG frame.
+	var frame bytes.Buffer
+	require.NoError(t, jpeg.Encode(&frame, image.NewRGBA(image.Rect(0, 0, 16, 9)), nil))
+	framePath := filepath.Join(t.TempDir(), "frame.jpg")
+	require.NoError(t, os.WriteFile(framePath, frame.Bytes(), 0o644))
+	ffmpegPath := filepath.Join(t.TempDir(), "ffmpeg")
+	require.NoError(t, os.WriteFile(ffmpegPath, []byte(fmt.Sprintf("#!/bin/sh\ncat %s\n", framePath)), 0o755))
+	ts.Profile.FFmpegPath = ffmpegPath
+
+	attachment, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "my clip.mp4", Type: "video/mp4", Content: []byte("video")},
+	})
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("/file/%s/my%%20clip.mp4?poster=true", attachment.Name), attachment.PosterLink)
+
+	// The poster frame is generated on upload.
+	thumbnailCacheFolder := filepath.Join(ts.Profile.Data, apiv1.ThumbnailCacheFolder)
+	require.Eventually(t, func() bool {
+		matches, _ := filepath.Glob(filepath.Join(thumbnailCacheFolder, "*_poster.jpg"))
+		return len(matches) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// The poster frames of earlier videos are generated lazily.
+	for _, name := range []string{attachment.Name, withoutPoster.Name} {
+		body, err := ts.Service.GetAttachmentBinary(userCtx, &v1pb.GetAttachmentBinaryRequest{
+			Name:     name,
+			Filename: "clip.mp4",
+			Poster:   true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "image/jpeg", body.ContentType)
+		require.Equal(t, frame.Bytes(), body.Data)
+	}
+
+	text, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "notes.txt", Type: "text/plain", Content: []byte("notes")},
+	})
+	require.NoError(t, err)
+	require.Empty(t, text.PosterLink)
+	_, err = ts.Service.GetAttachmentBinary(userCtx, &v1pb.GetAttachmentBinaryRequest{
+		Name:     text.Name,
+		Filename: text.Filename,
+		Poster:   true,
+	})
+	require.Error(t, err)
+}
+
+func TestAttachmentPreviews(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	ts.Profile.Data = t.TempDir()
+
+	user, err := ts.CreateRegularUser(ctx, "testuser")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// Without pdftoppm, documents have no preview.
+	withoutPreview, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "report.pdf", Type: "application/pdf", Content: []byte("%PDF-1.7")},
+	})
+	require.NoError(t, err)
+	require.Empty(t, withoutPreview.PreviewLink)
+
+	// A fake pdftoppm outputting a JPEG page of the document it reads.
+	var page bytes.Buffer
+	require.NoError(t, jpeg.Encode(&page, image.NewRGBA(image.Rect(0, 0, 9, 16)), nil))
+	pagePath := filepath.Join(t.TempDir(), "page.jpg")
+	require.NoError(t, os.WriteFile(pagePath, page.Bytes(), 0o644))
+	pdftoppmPath := filepath.Join(t.TempDir(), "pdftoppm")
+	script := fmt.Sprintf("#!/bin/sh\nfor last; do :; done\ngrep -q %%PDF \"$last\" && cat %s\n", pagePath)
+	require.NoError(t, os.WriteFile(pdftoppmPath, []byte(script), 0o755))
+	ts.Profile.PdftoppmPath = pdftoppmPath
+
+	attachment, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "annual report.pdf", Type: "application/pdf", Content: []byte("%PDF-1.7")},
+	})
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("/file/%s/annual%%20report.pdf?preview=true", attachment.Name), attachment.PreviewLink)
+
+	// The preview is generated on upload.
+	thumbnailCacheFolder := filepath.Join(ts.Profile.Data, apiv1.ThumbnailCacheFolder)
+	require.Eventually(t, func() bool {
+		matches, _ := filepath.Glob(filepath.Join(thumbnailCacheFolder, "*_preview.jpg"))
+		return len(matches) == 1
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// The previews of earlier documents are generated lazily.
+	for _, name := range []string{attachment.Name, withoutPreview.Name} {
+		body, err := ts.Service.GetAttachmentBinary(userCtx, &v1pb.GetAttachmentBinaryRequest{
+			Name:     name,
+			Filename: "report.pdf",
+			Preview:  true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "image/jpeg", body.ContentType)
+		require.Equal(t, page.Bytes(), body.Data)
+	}
+
+	text, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "notes.txt", Type: "text/plain", Content: []byte("notes")},
+	})
+	require.NoError(t, err)
+	require.Empty(t, text.PreviewLink)
+	_, err = ts.Service.GetAttachmentBinary(userCtx, &v1pb.GetAttachmentBinaryRequest{
+		Name:     text.Name,
+		Filename: text.Filename,
+		Preview:  true,
+	})
+	require.Error(t, err)
+}
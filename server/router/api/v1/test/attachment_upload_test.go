@@ -0,0 +1,157 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestAttachmentUpload(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Chunks are appended until the attachment is created", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+		ts.Profile.Data = t.TempDir()
+
+		user, err := ts.CreateRegularUser(ctx, "testuser")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+
+		content := bytes.Repeat([]byte("0123456789"), 100)
+		upload, err := ts.Service.CreateAttachmentUpload(userCtx, &v1pb.CreateAttachmentUploadRequest{
+			AttachmentUpload: &v1pb.AttachmentUpload{
+				Attachment: &v1pb.Attachment{Filename: "video.mp4", Type: "video/mp4"},
+				Size:       int64(len(content)),
+			},
+			AttachmentId: "video",
+		})
+		require.NoError(t, err)
+		require.Equal(t, "attachmentUploads/video", upload.Name)
+		require.Zero(t, upload.Offset)
+		require.False(t, upload.Done)
+		require.NotNil(t, upload.ExpireTime)
+
+		upload, err = ts.Service.AppendAttachmentUpload(userCtx, &v1pb.AppendAttachmentUploadRequest{
+			Name: upload.Name, Offset: 0, Data: content[:400],
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(400), upload.Offset)
+
+		// A chunk at a stale offset, e.g. retried after a lost response, is rejected.
+		_, err = ts.Service.AppendAttachmentUpload(userCtx, &v1pb.AppendAttachmentUploadRequest{
+			Name: upload.Name, Offset: 0, Data: content[:400],
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "offset")
+
+		// The upload resumes from the offset it reports.
+		upload, err = ts.Service.GetAttachmentUpload(userCtx, &v1pb.GetAttachmentUploadRequest{Name: upload.Name})
+		require.NoError(t, err)
+		require.Equal(t, int64(400), upload.Offset)
+
+		// A chunk beyond the declared size is rejected.
+		_, err = ts.Service.AppendAttachmentUpload(userCtx, &v1pb.AppendAttachmentUploadRequest{
+			Name: upload.Name, Offset: 400, Data: append(content[400:], 'x'),
+		})
+		require.Error(t, err)
+
+		upload, err = ts.Service.AppendAttachmentUpload(userCtx, &v1pb.AppendAttachmentUploadRequest{
+			Name: upload.Name, Offset: 400, Data: content[400:],
+		})
+		require.NoError(t, err)
+		require.True(t, upload.Done)
+		require.Equal(t, "attachments/video", upload.Attachment.Name)
+		require.Equal(t, int64(len(content)), upload.Attachment.Size)
+
+		attachmentUID := "video"
+		attachment, err := ts.Store.GetAttachment(ctx, &store.FindAttachment{UID: &attachmentUID, GetBlob: true})
+		require.NoError(t, err)
+		blob, err := ts.Service.GetAttachmentBlob(attachment)
+		require.NoError(t, err)
+		require.Equal(t, content, blob)
+
+		// The done upload is still reported, in case the response to the last chunk was lost.
+		upload, err = ts.Service.GetAttachmentUpload(userCtx, &v1pb.GetAttachmentUploadRequest{Name: upload.Name})
+		require.NoError(t, err)
+		require.True(t, upload.Done)
+		require.Equal(t, int64(len(content)), upload.Offset)
+	})
+
+	t.Run("Uploads are private to their creator", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+		ts.Profile.Data = t.TempDir()
+
+		user, err := ts.CreateRegularUser(ctx, "testuser")
+		require.NoError(t, err)
+		other, err := ts.CreateRegularUser(ctx, "other")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+		upload, err := ts.Service.CreateAttachmentUpload(userCtx, &v1pb.CreateAttachmentUploadRequest{
+			AttachmentUpload: &v1pb.AttachmentUpload{
+				Attachment: &v1pb.Attachment{Filename: "notes.txt", Type: "text/plain"},
+				Size:       10,
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = ts.Service.AppendAttachmentUpload(otherCtx, &v1pb.AppendAttachmentUploadRequest{
+			Name: upload.Name, Offset: 0, Data: []byte("0123456789"),
+		})
+		require.Error(t, err)
+		_, err = ts.Service.DeleteAttachmentUpload(otherCtx, &v1pb.DeleteAttachmentUploadRequest{Name: upload.Name})
+		require.Error(t, err)
+
+		_, err = ts.Service.DeleteAttachmentUpload(userCtx, &v1pb.DeleteAttachmentUploadRequest{Name: upload.Name})
+		require.NoError(t, err)
+		_, err = ts.Service.GetAttachmentUpload(userCtx, &v1pb.GetAttachmentUploadRequest{Name: upload.Name})
+		require.Error(t, err)
+	})
+
+	t.Run("CreateAttachmentUpload validation", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+		ts.Profile.Data = t.TempDir()
+
+		user, err := ts.CreateRegularUser(ctx, "testuser")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+
+		for name, request := range map[string]*v1pb.CreateAttachmentUploadRequest{
+			"missing size": {AttachmentUpload: &v1pb.AttachmentUpload{
+				Attachment: &v1pb.Attachment{Filename: "a.txt", Type: "text/plain"},
+			}},
+			"size over the limit": {AttachmentUpload: &v1pb.AttachmentUpload{
+				Attachment: &v1pb.Attachment{Filename: "a.txt", Type: "text/plain"},
+				Size:       1 << 40,
+			}},
+			"missing type": {AttachmentUpload: &v1pb.AttachmentUpload{
+				Attachment: &v1pb.Attachment{Filename: "a.txt"},
+				Size:       10,
+			}},
+			"invalid id": {AttachmentUpload: &v1pb.AttachmentUpload{
+				Attachment: &v1pb.Attachment{Filename: "a.txt", Type: "text/plain"},
+				Size:       10,
+			}, AttachmentId: "../escape"},
+		} {
+			_, err := ts.Service.CreateAttachmentUpload(userCtx, request)
+			require.Error(t, err, name)
+		}
+
+		_, err = ts.Service.CreateAttachmentUpload(ctx, &v1pb.CreateAttachmentUploadRequest{
+			AttachmentUpload: &v1pb.AttachmentUpload{
+				Attachment: &v1pb.Attachment{Filename: "a.txt", Type: "text/plain"},
+				Size:       10,
+			},
+		})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,146 @@
+package v1
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestAttachmentVersions(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	ts.Profile.Data = t.TempDir()
+
+	user, err := ts.CreateRegularUser(ctx, "testuser")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	getContent := func(name string) string {
+		body, err := ts.Service.GetAttachmentBinary(userCtx, &v1pb.GetAttachmentBinaryRequest{Name: name, Filename: "diagram"})
+		require.NoError(t, err)
+		return string(body.Data)
+	}
+
+	attachment, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "diagram.txt", Type: "text/plain", Content: []byte("first")},
+	})
+	require.NoError(t, err)
+
+	// The content is replaced under the same name.
+	replaced, err := ts.Service.ReplaceAttachmentContent(userCtx, &v1pb.ReplaceAttachmentContentRequest{
+		Name:     attachment.Name,
+		Content:  []byte("second draft"),
+		Filename: "diagram-v2.txt",
+	})
+	require.NoError(t, err)
+	require.Equal(t, attachment.Name, replaced.Name)
+	require.Equal(t, "diagram-v2.txt", replaced.Filename)
+	require.Equal(t, "text/plain", replaced.Type)
+	require.Equal(t, int64(12), replaced.Size)
+	require.Equal(t, "second draft", getContent(attachment.Name))
+
+	versions, err := ts.Service.ListAttachmentVersions(userCtx, &v1pb.ListAttachmentVersionsRequest{Parent: attachment.Name})
+	require.NoError(t, err)
+	require.Len(t, versions.Versions, 1)
+	require.Equal(t, "diagram.txt", versions.Versions[0].Filename)
+	require.Equal(t, int64(5), versions.Versions[0].Size)
+	firstVersion := versions.Versions[0].Name
+
+	// Only the creator versions the attachment.
+	_, err = ts.Service.ListAttachmentVersions(otherCtx, &v1pb.ListAttachmentVersionsRequest{Parent: attachment.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = ts.Service.ReplaceAttachmentContent(otherCtx, &v1pb.ReplaceAttachmentContentRequest{Name: attachment.Name, Content: []byte("other")})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = ts.Service.RestoreAttachmentVersion(otherCtx, &v1pb.RestoreAttachmentVersionRequest{Name: firstVersion})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Restoring a version keeps the replaced content as a version.
+	restored, err := ts.Service.RestoreAttachmentVersion(userCtx, &v1pb.RestoreAttachmentVersionRequest{Name: firstVersion})
+	require.NoError(t, err)
+	require.Equal(t, "diagram.txt", restored.Filename)
+	require.Equal(t, "first", getContent(attachment.Name))
+	versions, err = ts.Service.ListAttachmentVersions(userCtx, &v1pb.ListAttachmentVersionsRequest{Parent: attachment.Name})
+	require.NoError(t, err)
+	require.Len(t, versions.Versions, 2)
+	require.Equal(t, "diagram-v2.txt", versions.Versions[0].Filename)
+	require.Equal(t, firstVersion, versions.Versions[1].Name)
+
+	_, err = ts.Service.RestoreAttachmentVersion(userCtx, &v1pb.RestoreAttachmentVersionRequest{Name: attachment.Name + "/versions/999"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// The external links have no content to version.
+	link, err := ts.Store.CreateAttachment(ctx, &store.Attachment{
+		UID:         "link",
+		CreatorID:   user.ID,
+		Filename:    "link.png",
+		Type:        "image/png",
+		StorageType: storepb.AttachmentStorageType_EXTERNAL,
+		Reference:   "https://example.com/link.png",
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.ReplaceAttachmentContent(userCtx, &v1pb.ReplaceAttachmentContentRequest{Name: "attachments/" + link.UID, Content: []byte("image")})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestAttachmentVersionsLocalStorage(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	ts.Profile.Data = t.TempDir()
+
+	user, err := ts.CreateRegularUser(ctx, "testuser")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// The files are stored at absolute paths, as the store resolves relative paths in its own data directory.
+	assetsDir := t.TempDir()
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_STORAGE,
+		Value: &storepb.WorkspaceSetting_StorageSetting{StorageSetting: &storepb.WorkspaceStorageSetting{
+			StorageType:      storepb.WorkspaceStorageSetting_LOCAL,
+			FilepathTemplate: filepath.Join(assetsDir, "{uuid}_{filename}"),
+		}},
+	})
+	require.NoError(t, err)
+	countAssets := func() int {
+		assets, err := filepath.Glob(filepath.Join(assetsDir, "*"))
+		require.NoError(t, err)
+		return len(assets)
+	}
+
+	attachment, err := ts.Service.CreateAttachment(userCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "notes.txt", Type: "text/plain", Content: []byte("first")},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.ReplaceAttachmentContent(userCtx, &v1pb.ReplaceAttachmentContentRequest{Name: attachment.Name, Content: []byte("second")})
+	require.NoError(t, err)
+	require.Equal(t, 2, countAssets())
+	versions, err := ts.Service.ListAttachmentVersions(userCtx, &v1pb.ListAttachmentVersionsRequest{Parent: attachment.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.RestoreAttachmentVersion(userCtx, &v1pb.RestoreAttachmentVersionRequest{Name: versions.Versions[0].Name})
+	require.NoError(t, err)
+	// The restored file is shared with its version rather than copied.
+	require.Equal(t, 2, countAssets())
+	body, err := ts.Service.GetAttachmentBinary(userCtx, &v1pb.GetAttachmentBinaryRequest{Name: attachment.Name, Filename: "notes.txt"})
+	require.NoError(t, err)
+	require.Equal(t, "first", string(body.Data))
+
+	// The files of the versions are deleted with the attachment.
+	_, err = ts.Service.DeleteAttachment(userCtx, &v1pb.DeleteAttachmentRequest{Name: attachment.Name})
+	require.NoError(t, err)
+	require.Zero(t, countAssets())
+}
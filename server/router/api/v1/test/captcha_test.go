@@ -0,0 +1,99 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/captcha"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestCaptcha(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	_, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	_, err = ts.Service.CreateCaptchaChallenge(ctx, &v1pb.CreateCaptchaChallengeRequest{})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_CAPTCHA,
+		Value: &storepb.WorkspaceSetting_CaptchaSetting{
+			CaptchaSetting: &storepb.WorkspaceCaptchaSetting{
+				Provider:               storepb.WorkspaceCaptchaSetting_PROOF_OF_WORK,
+				SecretKey:              "secret",
+				RequireOnSignUp:        true,
+				SignInFailureThreshold: 2,
+				ProofOfWorkDifficulty:  4,
+			},
+		},
+	})
+	require.NoError(t, err)
+	solveChallenge := func() string {
+		challenge, err := ts.Service.CreateCaptchaChallenge(ctx, &v1pb.CreateCaptchaChallengeRequest{})
+		require.NoError(t, err)
+		for nonce := 0; ; nonce++ {
+			token := challenge.Challenge + ":" + strconv.Itoa(nonce)
+			if captcha.LeadingZeroBits(sha256.Sum256([]byte(token))) >= int(challenge.Difficulty) {
+				return token
+			}
+		}
+	}
+
+	// The secret key is hidden from the visitors.
+	setting, err := ts.Service.GetWorkspaceSetting(ctx, &v1pb.GetWorkspaceSettingRequest{Name: "workspace/settings/CAPTCHA"})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.WorkspaceCaptchaSetting_PROOF_OF_WORK, setting.GetCaptchaSetting().Provider)
+	require.Empty(t, setting.GetCaptchaSetting().SecretKey)
+
+	// Signing up requires a solved challenge, which is solved once.
+	signUp := func(username, captchaToken string) error {
+		_, err := ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{
+			User:         &v1pb.User{Username: username, Password: "password"},
+			CaptchaToken: captchaToken,
+		})
+		return err
+	}
+	require.Equal(t, codes.FailedPrecondition, status.Code(signUp("john", "")))
+	require.Equal(t, codes.InvalidArgument, status.Code(signUp("john", "invalid")))
+	captchaToken := solveChallenge()
+	require.NoError(t, signUp("john", captchaToken))
+	require.Equal(t, codes.InvalidArgument, status.Code(signUp("jane", captchaToken)))
+
+	// Signing in requires a solved challenge after the failed sign-ins.
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+	require.NoError(t, err)
+	_, err = ts.Store.CreateUser(ctx, &store.User{Username: "jane", Role: store.RoleUser, PasswordHash: string(passwordHash)})
+	require.NoError(t, err)
+	signInCtx := grpc.NewContextWithServerTransportStream(metadata.NewIncomingContext(ctx, metadata.MD{}), fakeServerTransportStream{})
+	signIn := func(password, captchaToken string) error {
+		_, err := ts.Service.CreateSession(signInCtx, &v1pb.CreateSessionRequest{
+			Credentials: &v1pb.CreateSessionRequest_PasswordCredentials_{
+				PasswordCredentials: &v1pb.CreateSessionRequest_PasswordCredentials{Username: "jane", Password: password},
+			},
+			CaptchaToken: captchaToken,
+		})
+		return err
+	}
+	require.NoError(t, signIn("password", ""))
+	require.Equal(t, codes.InvalidArgument, status.Code(signIn("wrong", "")))
+	require.Equal(t, codes.InvalidArgument, status.Code(signIn("wrong", "")))
+	require.Equal(t, codes.FailedPrecondition, status.Code(signIn("password", "")))
+	require.NoError(t, signIn("password", solveChallenge()))
+	// The successful sign-in forgets the failures of the username.
+	require.NoError(t, signIn("password", ""))
+}
@@ -0,0 +1,200 @@
+package v1
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/discord"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/discorddigest"
+	"github.com/usememos/memos/store"
+)
+
+func TestDiscordBot(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	user, err := ts.CreateRegularUser(ctx, "jane")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	discordName := fmt.Sprintf("users/%d/discord", user.ID)
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	// The fake Discord API records the messages posted to the channels.
+	var mu sync.Mutex
+	messages := map[string]string{}
+	discordAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		message := struct {
+			Content string `json:"content"`
+		}{}
+		_ = json.NewDecoder(r.Body).Decode(&message)
+		mu.Lock()
+		messages[r.URL.Path] = message.Content
+		mu.Unlock()
+		fmt.Fprint(w, `{}`)
+	}))
+	defer discordAPI.Close()
+
+	// The link codes are only generated if the Discord bot is enabled.
+	_, err = ts.Service.GenerateUserDiscordLinkCode(userCtx, &v1pb.GenerateUserDiscordLinkCodeRequest{Name: discordName})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	discordSetting := &v1pb.WorkspaceDiscordSetting{
+		ApplicationId:   "A1",
+		PublicKey:       "invalid",
+		BotToken:        "bot-token",
+		DigestChannelId: "C9",
+		DigestSchedule:  "0 9 * * *",
+		ApiEndpoint:     discordAPI.URL,
+	}
+	updateDiscordSetting := func() error {
+		_, err := ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+			Setting: &v1pb.WorkspaceSetting{
+				Name:  "workspace/settings/DISCORD",
+				Value: &v1pb.WorkspaceSetting_DiscordSetting{DiscordSetting: discordSetting},
+			},
+		})
+		return err
+	}
+	require.Equal(t, codes.InvalidArgument, status.Code(updateDiscordSetting()))
+	discordSetting.PublicKey = hex.EncodeToString(publicKey)
+	require.NoError(t, updateDiscordSetting())
+
+	e := echo.New()
+	ts.Service.RegisterDiscordRoutes(e)
+	send := func(body string, key ed25519.PrivateKey) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/discord/interactions", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		timestamp := fmt.Sprint(time.Now().Unix())
+		req.Header.Set(discord.TimestampHeader, timestamp)
+		req.Header.Set(discord.SignatureHeader, hex.EncodeToString(ed25519.Sign(key, []byte(timestamp+body))))
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+	command := func(subCommand, option, value string) string {
+		rec := send(fmt.Sprintf(`{"type":2,"guild_id":"G1","member":{"user":{"id":"D1"}},"data":{"name":"memo","type":1,"options":[{"name":%q,"type":1,"options":[{"name":%q,"type":3,"value":%q}]}]}}`, subCommand, option, value), privateKey)
+		require.Equal(t, http.StatusOK, rec.Code)
+		response := &discord.InteractionResponse{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), response))
+		require.Equal(t, discord.MessageFlagEphemeral, response.Data.Flags)
+		return response.Data.Content
+	}
+
+	// The interactions not signed by Discord are rejected, and the pings are answered.
+	_, anotherKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	rec := send(`{"type":1}`, anotherKey)
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+	rec = send(`{"type":1}`, privateKey)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"type":1}`, rec.Body.String())
+
+	// The memos are only saved once the Discord account is linked with a link code of the user.
+	require.Contains(t, command("save", "content", "Call mom"), "not linked")
+	require.Contains(t, command("link", "code", "WRONG"), "invalid or expired")
+	_, err = ts.Service.GenerateUserDiscordLinkCode(hostCtx, &v1pb.GenerateUserDiscordLinkCodeRequest{Name: discordName})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	userDiscord, err := ts.Service.GenerateUserDiscordLinkCode(userCtx, &v1pb.GenerateUserDiscordLinkCodeRequest{Name: discordName})
+	require.NoError(t, err)
+	require.Len(t, userDiscord.LinkCode, 8)
+	require.Contains(t, command("link", "code", strings.ToLower(userDiscord.LinkCode)), "linked to jane")
+	userDiscord, err = ts.Service.GetUserDiscord(userCtx, &v1pb.GetUserDiscordRequest{Name: discordName})
+	require.NoError(t, err)
+	require.True(t, userDiscord.Linked)
+	require.Equal(t, "D1", userDiscord.UserId)
+	require.Empty(t, userDiscord.LinkCode)
+
+	// The content of the slash command becomes a memo of the user, with their default visibility.
+	require.Contains(t, command("save", "content", "Call mom #family"), "Saved the [memo](<http://localhost:8080/memos/")
+	memos, err := ts.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	require.Equal(t, "Call mom #family", memos[0].Content)
+	require.Equal(t, store.Private, memos[0].Visibility)
+
+	// The messages saved from their context menu or with a reaction link back to Discord.
+	rec = send(`{"type":2,"guild_id":"G1","member":{"user":{"id":"D1"}},"data":{"name":"Save to memos","type":3,"target_id":"M1","resolved":{"messages":{"M1":{"id":"M1","channel_id":"C1","content":"Ship it"}}}}}`, privateKey)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), "Saved the")
+	handler := ts.Service.NewDiscordHandler()
+	err = handler.SaveMessage(ctx, "D2", &discord.Message{ID: "M2", ChannelID: "C1", Content: "Not linked"})
+	require.ErrorIs(t, err, discord.ErrUserNotLinked)
+	err = handler.SaveMessage(ctx, "D1", &discord.Message{ID: "M3", ChannelID: "C2", Content: "Buy milk"})
+	require.NoError(t, err)
+	memos, err = ts.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	contents := []string{}
+	for _, memo := range memos {
+		contents = append(contents, memo.Content)
+	}
+	require.ElementsMatch(t, []string{
+		"Call mom #family",
+		"Ship it\n\n[Discord message](https://discord.com/channels/G1/C1/M1)",
+		"Buy milk\n\n[Discord message](https://discord.com/channels/@me/C2/M3)",
+	}, contents)
+
+	// Once unlinked, the Discord account can no longer save memos.
+	_, err = ts.Service.UnlinkUserDiscord(userCtx, &v1pb.UnlinkUserDiscordRequest{Name: discordName})
+	require.NoError(t, err)
+	require.Contains(t, command("save", "content", "Call mom"), "not linked")
+
+	// The digest lists the public memos only, unless the protected ones are included.
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "# Release *notes*\n\nThe new version is out.", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Team lunch", Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+	digestRunner := discorddigest.NewRunner(ts.Store, ts.Profile)
+	now := time.Now()
+	require.NoError(t, digestRunner.RunOnce(ctx, now.Add(-time.Hour), now.Add(time.Minute)))
+	mu.Lock()
+	digest := messages["/channels/C9/messages"]
+	mu.Unlock()
+	require.Contains(t, digest, "Release notes")
+	require.Contains(t, digest, "by jane")
+	require.NotContains(t, digest, "Team lunch")
+	require.NotContains(t, digest, "Call mom")
+
+	discordSetting.DigestIncludeProtected = true
+	require.NoError(t, updateDiscordSetting())
+	require.NoError(t, digestRunner.RunOnce(ctx, now.Add(-time.Hour), now.Add(time.Minute)))
+	mu.Lock()
+	digest = messages["/channels/C9/messages"]
+	mu.Unlock()
+	require.Contains(t, digest, "[Team lunch](<http://localhost:8080/memos/")
+	require.Contains(t, digest, "by jane (workspace)")
+
+	// The digests without memos are not posted.
+	mu.Lock()
+	delete(messages, "/channels/C9/messages")
+	mu.Unlock()
+	require.NoError(t, digestRunner.RunOnce(ctx, now.Add(time.Hour), now.Add(2*time.Hour)))
+	mu.Lock()
+	require.NotContains(t, messages, "/channels/C9/messages")
+	mu.Unlock()
+}
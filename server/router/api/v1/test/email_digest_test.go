@@ -0,0 +1,101 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime/quotedprintable"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/email/emailtest"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/emaildigest"
+	"github.com/usememos/memos/store"
+)
+
+func TestEmailDigest(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	ts.Profile.InstanceURL = "https://memos.example.com"
+
+	server := emailtest.NewServer(t)
+	_, err := ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_EMAIL,
+		Value: &storepb.WorkspaceSetting_EmailSetting{
+			EmailSetting: &storepb.WorkspaceEmailSetting{
+				SmtpHost:  server.Host,
+				SmtpPort:  int32(server.Port),
+				FromEmail: "memos@example.com",
+			},
+		},
+	})
+	require.NoError(t, err)
+	user, err := ts.CreateRegularUser(ctx, "jane")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	name := fmt.Sprintf("users/%d/emailDigest", user.ID)
+
+	now := time.Now().UTC()
+	sendTime := time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), 0, 0, 0, time.UTC)
+	updateDigest := func(emailDigest *v1pb.UserEmailDigest) error {
+		emailDigest.Name = name
+		_, err := ts.Service.UpdateUserEmailDigest(userCtx, &v1pb.UpdateUserEmailDigestRequest{EmailDigest: emailDigest})
+		return err
+	}
+	require.Equal(t, codes.InvalidArgument, status.Code(updateDigest(&v1pb.UserEmailDigest{Frequency: v1pb.UserEmailDigest_DAILY, Hour: 24})))
+	require.Equal(t, codes.InvalidArgument, status.Code(updateDigest(&v1pb.UserEmailDigest{Frequency: v1pb.UserEmailDigest_DAILY, TimeZone: "Mars/Olympus"})))
+	require.NoError(t, updateDigest(&v1pb.UserEmailDigest{Frequency: v1pb.UserEmailDigest_DAILY, Hour: int32(sendTime.Hour())}))
+	other, err := ts.CreateRegularUser(ctx, "john")
+	require.NoError(t, err)
+	_, err = ts.Service.GetUserEmailDigest(ts.CreateUserContext(ctx, other.ID), &v1pb.GetUserEmailDigestRequest{Name: name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	createMemo := func(content string, createdTime time.Time) {
+		memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content, Visibility: v1pb.Visibility_PRIVATE}})
+		require.NoError(t, err)
+		memoUID := strings.TrimPrefix(memo.Name, "memos/")
+		stored, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+		require.NoError(t, err)
+		createdTs := createdTime.Unix()
+		require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: stored.ID, CreatedTs: &createdTs}))
+	}
+	createMemo("Release notes", sendTime.Add(-time.Hour))
+	createMemo("First trip to Rome", sendTime.AddDate(-2, 0, 0))
+	createMemo("- [ ] Renew passport "+sendTime.Add(time.Hour).Format("2006-01-02 15:04")+"\n- [x] Book hotel", sendTime.AddDate(0, 0, -3))
+
+	// The digest is sent once its scheduled time has come.
+	runner := emaildigest.NewRunner(ts.Store, ts.Profile)
+	require.NoError(t, runner.RunOnce(ctx, now))
+	require.Empty(t, server.Emails())
+	emailDigest, err := ts.Store.GetUserEmailDigest(ctx, user.ID)
+	require.NoError(t, err)
+	emailDigest.LastSendTime = timestamppb.New(sendTime.Add(-time.Hour))
+	require.NoError(t, ts.Store.UpsertUserEmailDigest(ctx, user.ID, emailDigest))
+	require.NoError(t, runner.RunOnce(ctx, now))
+	require.Len(t, server.Emails(), 1)
+	require.Equal(t, []string{"jane@example.com"}, server.Emails()[0].To)
+	_, encodedBody, _ := strings.Cut(server.Emails()[0].Data, "\r\n\r\n")
+	body, err := io.ReadAll(quotedprintable.NewReader(strings.NewReader(encodedBody)))
+	require.NoError(t, err)
+	require.Contains(t, string(body), "Memos created (1)\r\n- Release notes (https://memos.example.com/memos/")
+	require.Contains(t, string(body), "Open tasks (1)\r\n- Renew passport")
+	require.Contains(t, string(body), "Coming up (1)\r\n- "+sendTime.Add(time.Hour).Format("Mon Jan 2 15:04")+": Renew passport")
+	require.Contains(t, string(body), fmt.Sprintf("On this day (1)\r\n- %d: First trip to Rome", sendTime.Year()-2))
+	require.NotContains(t, string(body), "Book hotel")
+
+	// The digest sent is recorded, so that it is not sent again.
+	require.NoError(t, runner.RunOnce(ctx, now))
+	require.Len(t, server.Emails(), 1)
+	digest, err := ts.Service.GetUserEmailDigest(userCtx, &v1pb.GetUserEmailDigestRequest{Name: name})
+	require.NoError(t, err)
+	require.Equal(t, sendTime.Unix(), digest.LastSendTime.AsTime().Unix())
+}
@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/eventbus"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+type testEventPublisher struct {
+	mu     sync.Mutex
+	events []*eventbus.Event
+}
+
+func (p *testEventPublisher) Publish(_ context.Context, event *eventbus.Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, event)
+	return nil
+}
+
+func (*testEventPublisher) Close() error {
+	return nil
+}
+
+func TestEventBusPublishing(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	publisher := &testEventPublisher{}
+	ts.Service.EventPublisher = publisher
+
+	user, err := ts.CreateRegularUser(ctx, "jane")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// The activities are published without any webhook, with the same fields as the webhook payloads.
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Hello", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+
+	publisher.mu.Lock()
+	defer publisher.mu.Unlock()
+	require.Len(t, publisher.events, 2)
+	require.Equal(t, "memos.memo.created", publisher.events[0].ActivityType)
+	require.Equal(t, memo.Creator, publisher.events[0].Creator)
+	require.Equal(t, memo.Name, publisher.events[0].Memo.Name)
+	require.Equal(t, "memos.memo.deleted", publisher.events[1].ActivityType)
+}
@@ -0,0 +1,160 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestFilterMacros(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("FilterMacro CRUD", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user, err := ts.CreateRegularUser(ctx, "testuser")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		parent := fmt.Sprintf("users/%d", user.ID)
+
+		work, err := ts.Service.CreateFilterMacro(userCtx, &v1pb.CreateFilterMacroRequest{
+			Parent:        parent,
+			FilterMacroId: "work",
+			FilterMacro:   &v1pb.FilterMacro{Expression: `tag in ["work", "meeting"]`},
+		})
+		require.NoError(t, err)
+		require.Equal(t, parent+"/filterMacros/work", work.Name)
+
+		updated, err := ts.Service.UpdateFilterMacro(userCtx, &v1pb.UpdateFilterMacroRequest{
+			FilterMacro: &v1pb.FilterMacro{Name: work.Name, Expression: `tag in ["work"]`},
+			UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"expression"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, `tag in ["work"]`, updated.Expression)
+
+		listResp, err := ts.Service.ListFilterMacros(userCtx, &v1pb.ListFilterMacrosRequest{Parent: parent})
+		require.NoError(t, err)
+		require.Len(t, listResp.FilterMacros, 1)
+
+		_, err = ts.Service.DeleteFilterMacro(userCtx, &v1pb.DeleteFilterMacroRequest{Name: work.Name})
+		require.NoError(t, err)
+		listResp, err = ts.Service.ListFilterMacros(userCtx, &v1pb.ListFilterMacrosRequest{Parent: parent})
+		require.NoError(t, err)
+		require.Empty(t, listResp.FilterMacros)
+
+		// Only the owner manages the macros.
+		other, err := ts.CreateRegularUser(ctx, "other")
+		require.NoError(t, err)
+		_, err = ts.Service.ListFilterMacros(ts.CreateUserContext(ctx, other.ID), &v1pb.ListFilterMacrosRequest{Parent: parent})
+		require.Error(t, err)
+	})
+
+	t.Run("FilterMacro validation", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user, err := ts.CreateRegularUser(ctx, "testuser")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		parent := fmt.Sprintf("users/%d", user.ID)
+
+		_, err = ts.Service.CreateFilterMacro(userCtx, &v1pb.CreateFilterMacroRequest{
+			Parent:        parent,
+			FilterMacroId: "urgent",
+			FilterMacro:   &v1pb.FilterMacro{Expression: `pinned`},
+		})
+		require.NoError(t, err)
+
+		for id, expression := range map[string]string{
+			"Invalid":  `pinned`,
+			"pinned":   `has_tags`,
+			"now":      `has_tags`,
+			"in":       `has_tags`,
+			"empty":    ``,
+			"unknown":  `unknown_field == 1`,
+			"urgent":   `has_tags`,
+			"loop":     `loop_back || pinned`,
+			"selfloop": `selfloop && pinned`,
+		} {
+			_, err := ts.Service.CreateFilterMacro(userCtx, &v1pb.CreateFilterMacroRequest{
+				Parent:        parent,
+				FilterMacroId: id,
+				FilterMacro:   &v1pb.FilterMacro{Expression: expression},
+			})
+			require.Error(t, err, id)
+		}
+	})
+
+	t.Run("Filters expand the macros of the current user", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user, err := ts.CreateRegularUser(ctx, "testuser")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		parent := fmt.Sprintf("users/%d", user.ID)
+
+		for _, memo := range []*store.Memo{
+			{UID: "work-memo", Content: "#work standup", Payload: &storepb.MemoPayload{Tags: []string{"work"}}},
+			{UID: "meeting-memo", Content: "#meeting notes", Payload: &storepb.MemoPayload{Tags: []string{"meeting"}}},
+			{UID: "home-memo", Content: "#home chores", Payload: &storepb.MemoPayload{Tags: []string{"home"}}},
+		} {
+			memo.CreatorID = user.ID
+			memo.Visibility = store.Private
+			_, err := ts.Store.CreateMemo(ctx, memo)
+			require.NoError(t, err)
+		}
+		// A macro references the macros created before it.
+		for _, filterMacro := range []struct{ id, expression string }{
+			{"work", `tag in ["work", "meeting"]`},
+			{"not_home", `!(tag in ["home"])`},
+			{"office", `work && not_home`},
+		} {
+			_, err := ts.Service.CreateFilterMacro(userCtx, &v1pb.CreateFilterMacroRequest{
+				Parent:        parent,
+				FilterMacroId: filterMacro.id,
+				FilterMacro:   &v1pb.FilterMacro{Expression: filterMacro.expression},
+			})
+			require.NoError(t, err, filterMacro.id)
+		}
+
+		listNames := func(filter string) []string {
+			resp, err := ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{Filter: filter})
+			require.NoError(t, err, filter)
+			names := []string{}
+			for _, memo := range resp.Memos {
+				names = append(names, memo.Name)
+			}
+			return names
+		}
+		require.ElementsMatch(t, []string{"memos/work-memo", "memos/meeting-memo"}, listNames(`work`))
+		require.ElementsMatch(t, []string{"memos/work-memo", "memos/meeting-memo"}, listNames(`office`))
+		require.ElementsMatch(t, []string{"memos/meeting-memo"}, listNames(`work && content.contains("notes")`))
+		// A string mentioning a macro is not expanded.
+		require.Empty(t, listNames(`content.contains("office")`))
+
+		// Saved searches keep the macro, so they follow its changes.
+		savedSearch, err := ts.Service.CreateSavedSearch(userCtx, &v1pb.CreateSavedSearchRequest{
+			Parent:      parent,
+			SavedSearch: &v1pb.SavedSearch{Title: "Work", Filter: `work`},
+		})
+		require.NoError(t, err)
+		executeResp, err := ts.Service.ExecuteSavedSearch(userCtx, &v1pb.ExecuteSavedSearchRequest{Name: savedSearch.Name})
+		require.NoError(t, err)
+		require.Len(t, executeResp.Memos, 2)
+
+		// The macros of a user are unknown to other users.
+		other, err := ts.CreateRegularUser(ctx, "other")
+		require.NoError(t, err)
+		_, err = ts.Service.ListMemos(ts.CreateUserContext(ctx, other.ID), &v1pb.ListMemosRequest{Filter: `work`})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,148 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/usememos/memos/plugin/gist"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/gistsync"
+)
+
+func TestGistSync(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	user, err := ts.CreateRegularUser(ctx, "jane")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	gistSyncName := fmt.Sprintf("users/%d/gistSync", user.ID)
+
+	// The fake GitHub API keeps the gists in memory, each update moving their update time forward.
+	var mu sync.Mutex
+	gists := map[string]*gist.Gist{}
+	updateTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		id := strings.TrimPrefix(r.URL.Path, "/gists/")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/gists":
+			created := &gist.Gist{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(created))
+			created.ID = fmt.Sprintf("g%d", len(gists)+1)
+			created.HTMLURL = "https://gist.github.com/" + created.ID
+			updateTime = updateTime.Add(time.Minute)
+			created.UpdatedAt = updateTime
+			gists[created.ID] = created
+			w.WriteHeader(http.StatusCreated)
+			require.NoError(t, json.NewEncoder(w).Encode(created))
+		case r.Method == http.MethodPatch && gists[id] != nil:
+			update := &gist.Gist{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(update))
+			gists[id].Description = update.Description
+			gists[id].Files = update.Files
+			updateTime = updateTime.Add(time.Minute)
+			gists[id].UpdatedAt = updateTime
+			require.NoError(t, json.NewEncoder(w).Encode(gists[id]))
+		case r.Method == http.MethodGet && gists[id] != nil:
+			require.NoError(t, json.NewEncoder(w).Encode(gists[id]))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	editGist := func(id, content string) {
+		mu.Lock()
+		defer mu.Unlock()
+		gists[id].Files["memo.md"] = &gist.File{Content: content}
+		updateTime = updateTime.Add(time.Minute)
+		gists[id].UpdatedAt = updateTime
+	}
+	getGistContent := func(id string) string {
+		mu.Lock()
+		defer mu.Unlock()
+		return gists[id].Files["memo.md"].Content
+	}
+	runner := gistsync.NewRunner(ts.Store, ts.Service.NewGistHandler())
+	runner.APIEndpoint = server.URL
+
+	// The access token is a secret of the user, hidden from the admins too.
+	_, err = ts.Service.ConnectUserGistSync(hostCtx, &v1pb.ConnectUserGistSyncRequest{Name: gistSyncName, Token: "secret"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	gistSync, err := ts.Service.ConnectUserGistSync(userCtx, &v1pb.ConnectUserGistSyncRequest{Name: gistSyncName, Token: "secret"})
+	require.NoError(t, err)
+	require.True(t, gistSync.Connected)
+
+	// Only the memos tagged #gist are mirrored to gists.
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "# Reverse a slice\n\n```go\nslices.Reverse(s)\n```\n\n#gist", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "Not shared", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	runner.RunOnce(ctx)
+	gistSync, err = ts.Service.GetUserGistSync(userCtx, &v1pb.GetUserGistSyncRequest{Name: gistSyncName})
+	require.NoError(t, err)
+	require.Empty(t, gistSync.LastSyncError)
+	require.NotNil(t, gistSync.LastSyncTime)
+	require.Equal(t, map[string]string{memo.Name: "https://gist.github.com/g1"}, gistSync.GistUrls)
+	require.Len(t, gists, 1)
+	require.Equal(t, "Reverse a slice", gists["g1"].Description)
+	require.True(t, gists["g1"].Public)
+	require.Equal(t, memo.Content, getGistContent("g1"))
+
+	// The gists edited on GitHub are pulled into their memos.
+	editGist("g1", "# Reverse a slice\n\n```go\nslices.Reverse(items)\n```\n\n#gist")
+	runner.RunOnce(ctx)
+	memo, err = ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Contains(t, memo.Content, "slices.Reverse(items)")
+
+	// The memos edited in memos are pushed to their gists, overriding the edits on GitHub.
+	editGist("g1", "Edited on GitHub #gist")
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Content: "# Reverse a slice\n\nUse `slices.Reverse`.\n\n#gist"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	runner.RunOnce(ctx)
+	require.Equal(t, "# Reverse a slice\n\nUse `slices.Reverse`.\n\n#gist", getGistContent("g1"))
+	require.Len(t, gists, 1)
+
+	// The errors of the sync are reported, and the disconnected accounts are no longer synced.
+	_, err = ts.Service.ConnectUserGistSync(userCtx, &v1pb.ConnectUserGistSyncRequest{Name: gistSyncName, Token: "revoked"})
+	require.NoError(t, err)
+	runner.RunOnce(ctx)
+	gistSync, err = ts.Service.GetUserGistSync(userCtx, &v1pb.GetUserGistSyncRequest{Name: gistSyncName})
+	require.NoError(t, err)
+	require.Contains(t, gistSync.LastSyncError, "invalid GitHub access token")
+	gistSync, err = ts.Service.DisconnectUserGistSync(userCtx, &v1pb.DisconnectUserGistSyncRequest{Name: gistSyncName})
+	require.NoError(t, err)
+	require.False(t, gistSync.Connected)
+	require.Empty(t, gistSync.LastSyncError)
+	require.Len(t, gistSync.GistUrls, 1)
+}
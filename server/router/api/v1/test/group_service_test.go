@@ -0,0 +1,99 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestGroupVisibility(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	host, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, host.ID)
+	author, err := ts.CreateRegularUser(ctx, "author")
+	require.NoError(t, err)
+	authorCtx := ts.CreateUserContext(ctx, author.ID)
+	member, err := ts.CreateRegularUser(ctx, "member")
+	require.NoError(t, err)
+	memberCtx := ts.CreateUserContext(ctx, member.ID)
+	outsider, err := ts.CreateRegularUser(ctx, "outsider")
+	require.NoError(t, err)
+	outsiderCtx := ts.CreateUserContext(ctx, outsider.ID)
+
+	// Only the admins manage the groups.
+	_, err = ts.Service.CreateGroup(authorCtx, &v1pb.CreateGroupRequest{Group: &v1pb.Group{DisplayName: "Engineering"}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	group, err := ts.Service.CreateGroup(hostCtx, &v1pb.CreateGroupRequest{
+		Group: &v1pb.Group{
+			DisplayName: "Engineering",
+			Members:     []string{fmt.Sprintf("users/%d", author.ID), fmt.Sprintf("users/%d", member.ID)},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, group.Members, 2)
+	_, err = ts.Service.CreateGroup(hostCtx, &v1pb.CreateGroupRequest{Group: &v1pb.Group{DisplayName: "Engineering"}})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	groups, err := ts.Service.ListGroups(outsiderCtx, &v1pb.ListGroupsRequest{})
+	require.NoError(t, err)
+	require.Len(t, groups.Groups, 1)
+
+	// The group visibility requires at least one group.
+	_, err = ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "group memo", Visibility: v1pb.Visibility_GROUP},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	memo, err := ts.Service.CreateMemo(authorCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "group memo", Visibility: v1pb.Visibility_GROUP, Groups: []string{group.Name}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{group.Name}, memo.Groups)
+
+	// The members of the group read the memo, and the other users do not.
+	_, err = ts.Service.GetMemo(memberCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.GetMemo(outsiderCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	memos, err := ts.Service.ListMemos(memberCtx, &v1pb.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 1)
+	memos, err = ts.Service.ListMemos(outsiderCtx, &v1pb.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Empty(t, memos.Memos)
+	memos, err = ts.Service.ListMemos(memberCtx, &v1pb.ListMemosRequest{Parent: fmt.Sprintf("users/%d", author.ID)})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 1)
+
+	// Removing the user from the group revokes the access.
+	_, err = ts.Service.UpdateGroup(hostCtx, &v1pb.UpdateGroupRequest{
+		Group:      &v1pb.Group{Name: group.Name, Members: []string{fmt.Sprintf("users/%d", author.ID)}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"members"}},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.GetMemo(memberCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Changing the visibility drops the groups of the memo.
+	memo, err = ts.Service.UpdateMemo(authorCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Visibility: v1pb.Visibility_PRIVATE},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, memo.Groups)
+
+	_, err = ts.Service.DeleteGroup(hostCtx, &v1pb.DeleteGroupRequest{Name: group.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.GetGroup(hostCtx, &v1pb.GetGroupRequest{Name: group.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -2,12 +2,24 @@ package v1
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
+	"github.com/usememos/memos/plugin/idp/ldap/ldaptest"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
 )
 
 func TestCreateIdentityProvider(t *testing.T) {
@@ -517,3 +529,264 @@ func TestIdentityProviderPermissions(t *testing.T) {
 		require.Contains(t, err.Error(), "permission denied")
 	})
 }
+
+func TestLDAPIdentityProvider(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+
+	url := ldaptest.NewServer(t,
+		ldaptest.Entry{DN: "cn=reader,dc=example,dc=com", Password: "reader-password"},
+		ldaptest.Entry{
+			DN:       "uid=jane,ou=people,dc=example,dc=com",
+			Password: "jane-password",
+			Attributes: map[string][]string{
+				"uid":  {"jane"},
+				"cn":   {"Jane Doe"},
+				"mail": {"jane@example.com"},
+			},
+		},
+	)
+	identityProvider, err := ts.Service.CreateIdentityProvider(hostCtx, &v1pb.CreateIdentityProviderRequest{
+		IdentityProvider: &v1pb.IdentityProvider{
+			Title: "Directory",
+			Type:  v1pb.IdentityProvider_LDAP,
+			Config: &v1pb.IdentityProviderConfig{
+				Config: &v1pb.IdentityProviderConfig_LdapConfig{
+					LdapConfig: &v1pb.LDAPConfig{
+						Url:          url,
+						BindDn:       "cn=reader,dc=example,dc=com",
+						BindPassword: "reader-password",
+						BaseDn:       "ou=people,dc=example,dc=com",
+						UserFilter:   "(uid={username})",
+						FieldMapping: &v1pb.FieldMapping{
+							Identifier:  "uid",
+							DisplayName: "cn",
+							Email:       "mail",
+						},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "reader-password", identityProvider.Config.GetLdapConfig().BindPassword)
+
+	// The password of the service account is only returned to the host.
+	listResponse, err := ts.Service.ListIdentityProviders(ctx, &v1pb.ListIdentityProvidersRequest{})
+	require.NoError(t, err)
+	require.Len(t, listResponse.IdentityProviders, 1)
+	require.Equal(t, url, listResponse.IdentityProviders[0].Config.GetLdapConfig().Url)
+	require.Empty(t, listResponse.IdentityProviders[0].Config.GetLdapConfig().BindPassword)
+	identityProvider, err = ts.Service.GetIdentityProvider(hostCtx, &v1pb.GetIdentityProviderRequest{Name: identityProvider.Name})
+	require.NoError(t, err)
+	require.Equal(t, "reader-password", identityProvider.Config.GetLdapConfig().BindPassword)
+
+	idpID, err := apiv1.ExtractIdentityProviderIDFromName(identityProvider.Name)
+	require.NoError(t, err)
+	signIn := func(password string) (*v1pb.CreateSessionResponse, error) {
+		signInCtx := grpc.NewContextWithServerTransportStream(metadata.NewIncomingContext(ctx, metadata.MD{}), fakeServerTransportStream{})
+		return ts.Service.CreateSession(signInCtx, &v1pb.CreateSessionRequest{
+			Credentials: &v1pb.CreateSessionRequest_LdapCredentials{
+				LdapCredentials: &v1pb.CreateSessionRequest_LDAPCredentials{IdpId: idpID, Username: "jane", Password: password},
+			},
+		})
+	}
+	_, err = signIn("wrong-password")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The user is created on the first sign in, and found on the next ones.
+	response, err := signIn("jane-password")
+	require.NoError(t, err)
+	require.Equal(t, "jane", response.User.Username)
+	require.Equal(t, "Jane Doe", response.User.DisplayName)
+	require.Equal(t, "jane@example.com", response.User.Email)
+	require.Equal(t, v1pb.User_USER, response.User.Role)
+	nextResponse, err := signIn("jane-password")
+	require.NoError(t, err)
+	require.Equal(t, response.User.Name, nextResponse.User.Name)
+
+	// The LDAP identity providers do not support the authorization code flow.
+	_, err = ts.Service.CreateSession(ctx, &v1pb.CreateSessionRequest{
+		Credentials: &v1pb.CreateSessionRequest_SsoCredentials{
+			SsoCredentials: &v1pb.CreateSessionRequest_SSOCredentials{IdpId: idpID, Code: "code", RedirectUri: "https://example.com"},
+		},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestOAuth2GroupRoleMapping(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+
+	userInfos := map[string]map[string]any{}
+	server := newOAuth2TestServer(t, userInfos)
+	defer server.Close()
+
+	identityProvider, err := ts.Service.CreateIdentityProvider(hostCtx, &v1pb.CreateIdentityProviderRequest{
+		IdentityProvider: &v1pb.IdentityProvider{
+			Title: "OIDC",
+			Type:  v1pb.IdentityProvider_OAUTH2,
+			Config: &v1pb.IdentityProviderConfig{
+				Config: &v1pb.IdentityProviderConfig_Oauth2Config{
+					Oauth2Config: &v1pb.OAuth2Config{
+						ClientId:     "client-id",
+						ClientSecret: "client-secret",
+						TokenUrl:     server.URL + "/token",
+						UserInfoUrl:  server.URL + "/userinfo",
+						FieldMapping: &v1pb.FieldMapping{
+							Identifier: "sub",
+							Groups:     "groups",
+						},
+						GroupRoleMappings: []*v1pb.GroupRoleMapping{
+							{Group: "staff", Role: v1pb.User_USER},
+							{Group: "admins", Role: v1pb.User_ADMIN},
+						},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, identityProvider.Config.GetOauth2Config().GroupRoleMappings, 2)
+	require.Equal(t, v1pb.User_ADMIN, identityProvider.Config.GetOauth2Config().GroupRoleMappings[1].Role)
+	idpID, err := apiv1.ExtractIdentityProviderIDFromName(identityProvider.Name)
+	require.NoError(t, err)
+
+	signIn := newOAuth2SignIn(ctx, t, ts, idpID, userInfos)
+
+	// The role is mapped on the first sign in, and re-evaluated on the next ones.
+	require.Equal(t, v1pb.User_ADMIN, signIn("jane", "staff", "admins").Role)
+	require.Equal(t, v1pb.User_USER, signIn("jane", "staff").Role)
+	require.Equal(t, v1pb.User_USER, signIn("john").Role)
+	require.Equal(t, v1pb.User_ADMIN, signIn("john", "admins").Role)
+
+	// The last host is not demoted.
+	require.Equal(t, v1pb.User_HOST, signIn("host", "staff").Role)
+}
+
+func TestOAuth2GroupMembershipMapping(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	userInfos := map[string]map[string]any{}
+	server := newOAuth2TestServer(t, userInfos)
+	defer server.Close()
+
+	engineering, err := ts.Store.CreateUserGroup(ctx, &store.UserGroup{Name: "engineering"})
+	require.NoError(t, err)
+	design, err := ts.Store.CreateUserGroup(ctx, &store.UserGroup{Name: "design"})
+	require.NoError(t, err)
+	engineeringName := fmt.Sprintf("%s%d", apiv1.GroupNamePrefix, engineering.ID)
+	identityProvider, err := ts.Service.CreateIdentityProvider(hostCtx, &v1pb.CreateIdentityProviderRequest{
+		IdentityProvider: &v1pb.IdentityProvider{
+			Title: "OIDC",
+			Type:  v1pb.IdentityProvider_OAUTH2,
+			Config: &v1pb.IdentityProviderConfig{
+				Config: &v1pb.IdentityProviderConfig_Oauth2Config{
+					Oauth2Config: &v1pb.OAuth2Config{
+						ClientId:     "client-id",
+						ClientSecret: "client-secret",
+						TokenUrl:     server.URL + "/token",
+						UserInfoUrl:  server.URL + "/userinfo",
+						FieldMapping: &v1pb.FieldMapping{
+							Identifier: "sub",
+							Groups:     "groups",
+						},
+						GroupMembershipMappings: []*v1pb.GroupMembershipMapping{
+							{Group: "backend", UserGroup: engineeringName},
+							{Group: "frontend", UserGroup: engineeringName},
+							{Group: "designers", UserGroup: fmt.Sprintf("%s%d", apiv1.GroupNamePrefix, design.ID)},
+						},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, identityProvider.Config.GetOauth2Config().GroupMembershipMappings, 3)
+	require.Equal(t, engineeringName, identityProvider.Config.GetOauth2Config().GroupMembershipMappings[0].UserGroup)
+	idpID, err := apiv1.ExtractIdentityProviderIDFromName(identityProvider.Name)
+	require.NoError(t, err)
+
+	// The members of the unmapped groups are kept.
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	require.NoError(t, ts.Store.SetUserGroupMembers(ctx, engineering.ID, []int32{other.ID}))
+	listMembers := func(userGroupID int32) []int32 {
+		members, err := ts.Store.ListUserGroupMembers(ctx, &store.FindUserGroupMember{GroupID: &userGroupID})
+		require.NoError(t, err)
+		userIDs := []int32{}
+		for _, member := range members {
+			userIDs = append(userIDs, member.UserID)
+		}
+		return userIDs
+	}
+	signIn := newOAuth2SignIn(ctx, t, ts, idpID, userInfos)
+
+	// The memberships are mapped on the first sign in, and re-evaluated on the next ones.
+	jane := signIn("jane", "backend", "designers")
+	janeID, err := apiv1.ExtractUserIDFromName(jane.Name)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []int32{other.ID, janeID}, listMembers(engineering.ID))
+	require.ElementsMatch(t, []int32{janeID}, listMembers(design.ID))
+	signIn("jane", "frontend")
+	require.ElementsMatch(t, []int32{other.ID, janeID}, listMembers(engineering.ID))
+	require.Empty(t, listMembers(design.ID))
+	signIn("jane")
+	require.ElementsMatch(t, []int32{other.ID}, listMembers(engineering.ID))
+
+	// The deleted user groups are skipped.
+	require.NoError(t, ts.Store.DeleteUserGroup(ctx, &store.DeleteUserGroup{ID: design.ID}))
+	signIn("jane", "backend", "designers")
+	require.ElementsMatch(t, []int32{other.ID, janeID}, listMembers(engineering.ID))
+}
+
+// newOAuth2TestServer returns a mock OAuth2 server returning the user info of the code from userInfos.
+func newOAuth2TestServer(t *testing.T, userInfos map[string]map[string]any) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+			"access_token": r.Form.Get("code"),
+			"token_type":   "Bearer",
+		}))
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(userInfos[strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")]))
+	})
+	return httptest.NewServer(mux)
+}
+
+// newOAuth2SignIn returns a function signing the user in with the groups of the test.
+func newOAuth2SignIn(ctx context.Context, t *testing.T, ts *TestService, idpID int32, userInfos map[string]map[string]any) func(username string, groups ...string) *v1pb.User {
+	return func(username string, groups ...string) *v1pb.User {
+		userInfos[username] = map[string]any{"sub": username, "groups": groups}
+		signInCtx := grpc.NewContextWithServerTransportStream(metadata.NewIncomingContext(ctx, metadata.MD{}), fakeServerTransportStream{})
+		response, err := ts.Service.CreateSession(signInCtx, &v1pb.CreateSessionRequest{
+			Credentials: &v1pb.CreateSessionRequest_SsoCredentials{
+				SsoCredentials: &v1pb.CreateSessionRequest_SSOCredentials{IdpId: idpID, Code: username, RedirectUri: "https://example.com/callback"},
+			},
+		})
+		require.NoError(t, err)
+		return response.User
+	}
+}
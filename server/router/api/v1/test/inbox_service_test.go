@@ -557,3 +557,74 @@ func TestInboxCRUDComplete(t *testing.T) {
 		require.Equal(t, int32(0), listResp.TotalSize)
 	})
 }
+
+func TestInboxBulkOperations(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "testuser")
+	require.NoError(t, err)
+	otherUser, err := ts.CreateRegularUser(ctx, "otheruser")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	parent := fmt.Sprintf("users/%d", user.ID)
+
+	const systemBotID int32 = 0
+	createInbox := func(receiverID int32) *store.Inbox {
+		inbox, err := ts.Store.CreateInbox(ctx, &store.Inbox{
+			SenderID:   systemBotID,
+			ReceiverID: receiverID,
+			Status:     store.UNREAD,
+			Message: &storepb.InboxMessage{
+				Type: storepb.InboxMessage_MEMO_COMMENT,
+			},
+		})
+		require.NoError(t, err)
+		return inbox
+	}
+	first, second := createInbox(user.ID), createInbox(user.ID)
+	createInbox(user.ID)
+	otherInbox := createInbox(otherUser.ID)
+
+	// Only the owner can mark their inboxes as read.
+	_, err = ts.Service.MarkAllInboxRead(ts.CreateUserContext(ctx, otherUser.ID), &v1pb.MarkAllInboxReadRequest{Parent: parent})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	markResp, err := ts.Service.MarkAllInboxRead(userCtx, &v1pb.MarkAllInboxReadRequest{Parent: parent})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), markResp.UpdatedCount)
+	listResp, err := ts.Service.ListInboxes(userCtx, &v1pb.ListInboxesRequest{Parent: parent})
+	require.NoError(t, err)
+	for _, inbox := range listResp.Inboxes {
+		require.Equal(t, v1pb.Inbox_ARCHIVED, inbox.Status)
+	}
+
+	// Either names or status is required.
+	_, err = ts.Service.BatchDeleteInboxes(userCtx, &v1pb.BatchDeleteInboxesRequest{Parent: parent})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The inboxes of other users are not deleted.
+	deleteResp, err := ts.Service.BatchDeleteInboxes(userCtx, &v1pb.BatchDeleteInboxesRequest{
+		Parent: parent,
+		Names:  []string{fmt.Sprintf("inboxes/%d", first.ID), fmt.Sprintf("inboxes/%d", otherInbox.ID)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), deleteResp.DeletedCount)
+	otherInboxes, err := ts.Store.ListInboxes(ctx, &store.FindInbox{ID: &otherInbox.ID})
+	require.NoError(t, err)
+	require.Len(t, otherInboxes, 1)
+
+	// All the read inboxes are deleted by status.
+	_, err = ts.Store.UpdateInbox(ctx, &store.UpdateInbox{ID: second.ID, Status: store.UNREAD})
+	require.NoError(t, err)
+	deleteResp, err = ts.Service.BatchDeleteInboxes(userCtx, &v1pb.BatchDeleteInboxesRequest{
+		Parent: parent,
+		Status: v1pb.Inbox_ARCHIVED,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), deleteResp.DeletedCount)
+	listResp, err = ts.Service.ListInboxes(userCtx, &v1pb.ListInboxesRequest{Parent: parent})
+	require.NoError(t, err)
+	require.Len(t, listResp.Inboxes, 1)
+	require.Equal(t, fmt.Sprintf("inboxes/%d", second.ID), listResp.Inboxes[0].Name)
+}
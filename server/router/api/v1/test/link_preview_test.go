@@ -0,0 +1,66 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/usememos/memos/plugin/httpgetter"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/linkpreview"
+)
+
+func TestLinkPreviews(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:    "Read https://example.com/post and https://broken.example.com but not [this](https://example.com/other).",
+			Visibility: v1pb.Visibility_PRIVATE,
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, memo.LinkPreviews)
+
+	fetched := []string{}
+	runner := linkpreview.NewRunner(ts.Store)
+	runner.GetHTMLMeta = func(_ context.Context, url string) (*httpgetter.HTMLMeta, error) {
+		fetched = append(fetched, url)
+		if url != "https://example.com/post" {
+			return nil, errors.New("not found")
+		}
+		return &httpgetter.HTMLMeta{Title: "A post", Description: "About things.", Favicon: "https://example.com/favicon.ico", SiteName: "Example"}, nil
+	}
+	count, err := runner.UpdateLinkPreviews(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	require.Equal(t, []string{"https://example.com/post", "https://broken.example.com"}, fetched)
+
+	// The pages failing to be fetched have no preview, and are not fetched again until stale.
+	memo, err = ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Len(t, memo.LinkPreviews, 1)
+	require.Equal(t, "https://example.com/post", memo.LinkPreviews[0].Url)
+	require.Equal(t, "A post", memo.LinkPreviews[0].Title)
+	require.Equal(t, "Example", memo.LinkPreviews[0].SiteName)
+	count, err = runner.UpdateLinkPreviews(ctx)
+	require.NoError(t, err)
+	require.Zero(t, count)
+	require.Len(t, fetched, 2)
+
+	// The previews of the URLs removed from the content are dropped.
+	memo, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Content: "Only https://broken.example.com now"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, memo.LinkPreviews)
+}
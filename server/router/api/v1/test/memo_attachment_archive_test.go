@@ -0,0 +1,81 @@
+package v1
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestGetMemoAttachmentsArchive(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "testuser")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	otherUser, err := ts.CreateRegularUser(ctx, "otheruser")
+	require.NoError(t, err)
+	otherUserCtx := ts.CreateUserContext(ctx, otherUser.ID)
+
+	memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "trip-memo",
+		CreatorID:  user.ID,
+		Content:    "Trip photos",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	for _, attachment := range []*store.Attachment{
+		{UID: "first-photo", Filename: "photo.jpg", Type: "image/jpeg", Blob: []byte("first photo")},
+		{UID: "second-photo", Filename: "Photo.jpg", Type: "image/jpeg", Blob: []byte("second photo")},
+		{UID: "notes", Filename: "../notes.txt", Type: "text/plain", Blob: []byte("notes")},
+		{UID: "link", Filename: "link.png", Type: "image/png", StorageType: storepb.AttachmentStorageType_EXTERNAL, Reference: "https://example.com/link.png"},
+	} {
+		attachment.CreatorID = user.ID
+		attachment.Size = int64(len(attachment.Blob))
+		attachment.MemoID = &memo.ID
+		_, err := ts.Store.CreateAttachment(ctx, attachment)
+		require.NoError(t, err)
+	}
+
+	archive, err := ts.Service.GetMemoAttachmentsArchive(userCtx, &v1pb.GetMemoAttachmentsArchiveRequest{Name: "memos/trip-memo"})
+	require.NoError(t, err)
+	require.Equal(t, "application/zip", archive.ContentType)
+	reader, err := zip.NewReader(bytes.NewReader(archive.Data), int64(len(archive.Data)))
+	require.NoError(t, err)
+	files := map[string]string{}
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		require.NoError(t, rc.Close())
+		files[file.Name] = string(content)
+	}
+	// The external link is left out, and the filenames are kept apart without directories.
+	require.Equal(t, map[string]string{
+		"photo.jpg":     "first photo",
+		"Photo (2).jpg": "second photo",
+		"notes.txt":     "notes",
+	}, files)
+
+	// The archive of a private memo is only downloaded by its creator.
+	_, err = ts.Service.GetMemoAttachmentsArchive(otherUserCtx, &v1pb.GetMemoAttachmentsArchiveRequest{Name: "memos/trip-memo"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.GetMemoAttachmentsArchive(ctx, &v1pb.GetMemoAttachmentsArchiveRequest{Name: "memos/trip-memo"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = ts.Service.GetMemoAttachmentsArchive(userCtx, &v1pb.GetMemoAttachmentsArchiveRequest{Name: "memos/missing-memo"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
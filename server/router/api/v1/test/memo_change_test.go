@@ -0,0 +1,85 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestListMemoChanges(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "jane")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	otherUser, err := ts.CreateRegularUser(ctx, "john")
+	require.NoError(t, err)
+	otherUserCtx := ts.CreateUserContext(ctx, otherUser.ID)
+
+	_, err = ts.Service.ListMemoChanges(ctx, &v1pb.ListMemoChangesRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	createMemo := func(ctx context.Context, content string) *v1pb.Memo {
+		memo, err := ts.Service.CreateMemo(ctx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: content, Visibility: v1pb.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+		return memo
+	}
+	first := createMemo(userCtx, "First")
+	second := createMemo(userCtx, "Second")
+	createMemo(otherUserCtx, "Not mine")
+
+	// The changes of the memos of the current user are listed from the oldest one.
+	response, err := ts.Service.ListMemoChanges(userCtx, &v1pb.ListMemoChangesRequest{PageSize: 1})
+	require.NoError(t, err)
+	require.Len(t, response.Changes, 1)
+	require.True(t, response.HasMore)
+	require.Equal(t, first.Name, response.Changes[0].Memo)
+	require.Equal(t, v1pb.MemoChange_CREATED, response.Changes[0].Type)
+	require.Equal(t, "First", response.Changes[0].MemoData.Content)
+	response, err = ts.Service.ListMemoChanges(userCtx, &v1pb.ListMemoChangesRequest{Cursor: response.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, response.Changes, 1)
+	require.False(t, response.HasMore)
+	require.Equal(t, second.Name, response.Changes[0].Memo)
+	cursor := response.NextCursor
+
+	// Polling again returns the same cursor until there are new changes, the deleted memos included.
+	response, err = ts.Service.ListMemoChanges(userCtx, &v1pb.ListMemoChangesRequest{Cursor: cursor})
+	require.NoError(t, err)
+	require.Empty(t, response.Changes)
+	require.Equal(t, cursor, response.NextCursor)
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: first.Name, Content: "First, edited"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: second.Name})
+	require.NoError(t, err)
+	response, err = ts.Service.ListMemoChanges(userCtx, &v1pb.ListMemoChangesRequest{Cursor: cursor})
+	require.NoError(t, err)
+	require.Len(t, response.Changes, 2)
+	require.Equal(t, v1pb.MemoChange_UPDATED, response.Changes[0].Type)
+	require.Equal(t, "First, edited", response.Changes[0].MemoData.Content)
+	require.Equal(t, v1pb.MemoChange_DELETED, response.Changes[1].Type)
+	require.Equal(t, second.Name, response.Changes[1].Memo)
+	require.Nil(t, response.Changes[1].MemoData)
+
+	// The expired cursors are rejected, as the changes since may be gone.
+	require.NoError(t, ts.Store.DeleteMemoChanges(ctx, &store.DeleteMemoChange{}))
+	_, err = ts.Service.ListMemoChanges(userCtx, &v1pb.ListMemoChangesRequest{Cursor: cursor})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = ts.Service.ListMemoChanges(userCtx, &v1pb.ListMemoChangesRequest{Cursor: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
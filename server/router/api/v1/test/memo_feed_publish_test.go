@@ -0,0 +1,55 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestPublishMemoFeeds(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "jane")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	published := make(chan string, 10)
+	ts.Service.PublishFeeds = func(_ context.Context, username string) error {
+		published <- username
+		return nil
+	}
+	expectPublished := func(expected bool) {
+		select {
+		case username := <-published:
+			require.True(t, expected, "unexpected publish")
+			require.Equal(t, "jane", username)
+		case <-time.After(200 * time.Millisecond):
+			require.False(t, expected, "feeds not published")
+		}
+	}
+
+	// Only the changes of the public memos update the feeds.
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Private", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+	expectPublished(false)
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Public", Visibility: v1pb.Visibility_PUBLIC}})
+	require.NoError(t, err)
+	expectPublished(true)
+
+	// The memos no longer public are removed from the feeds.
+	memo, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Visibility: v1pb.Visibility_PRIVATE},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+	require.NoError(t, err)
+	expectPublished(true)
+	_, err = ts.Service.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	expectPublished(false)
+}
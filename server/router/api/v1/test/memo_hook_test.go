@@ -0,0 +1,87 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/hook"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoHooks(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "jane")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// The hooks reject the memos mentioning spam, sign the new memos and render the abbreviations in full,
+	// while the created memos are archived.
+	archived := make(chan *hook.Memo, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := &hook.Request{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(request))
+		response := &hook.Response{}
+		switch request.Event {
+		case hook.EventMemoPreCreate:
+			if strings.Contains(request.Memo.Content, "spam") {
+				response.Reject, response.Message = true, "spam is not allowed"
+			}
+			content := request.Memo.Content + "\n\n— " + request.Memo.Creator
+			response.Content = &content
+		case hook.EventMemoPreRender:
+			content := strings.ReplaceAll(request.Memo.Content, "asap", "as soon as possible")
+			response.Content = &content
+		case hook.EventMemoPostCreate:
+			archived <- request.Memo
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(response))
+	}))
+	defer server.Close()
+	config := &hook.Config{Hooks: []*hook.Hook{
+		{Name: "sign", Event: hook.EventMemoPreCreate, URL: server.URL},
+		{Name: "expand", Event: hook.EventMemoPreRender, URL: server.URL},
+		{Name: "archive", Event: hook.EventMemoPostCreate, URL: server.URL},
+	}}
+	require.NoError(t, config.Validate())
+	ts.Service.Hooks = hook.NewRunner(config)
+
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Reply asap", Visibility: v1pb.Visibility_PUBLIC}})
+	require.NoError(t, err)
+	require.Equal(t, "Reply asap\n\n— "+memo.Creator, memo.Content)
+	require.Contains(t, memo.Snippet, "Reply as soon as possible")
+	select {
+	case archivedMemo := <-archived:
+		require.Equal(t, memo.Name, archivedMemo.Name)
+		require.Equal(t, memo.Content, archivedMemo.Content)
+		require.Equal(t, "PUBLIC", archivedMemo.Visibility)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "memo not archived")
+	}
+
+	// The stored content is unchanged by the render hooks.
+	memo, err = ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, "Reply asap\n\n— "+memo.Creator, memo.Content)
+	require.Contains(t, memo.Snippet, "as soon as possible")
+
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Buy spam", Visibility: v1pb.Visibility_PRIVATE}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Contains(t, err.Error(), "spam is not allowed")
+
+	// The memos are not created while a hook is down.
+	server.Close()
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Hello", Visibility: v1pb.Visibility_PRIVATE}})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoMention(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	jane, err := ts.CreateRegularUser(ctx, "jane")
+	require.NoError(t, err)
+	john, err := ts.CreateRegularUser(ctx, "john")
+	require.NoError(t, err)
+	janeCtx := ts.CreateUserContext(ctx, jane.ID)
+	johnCtx := ts.CreateUserContext(ctx, john.ID)
+	listMentions := func() []*v1pb.Inbox {
+		response, err := ts.Service.ListInboxes(johnCtx, &v1pb.ListInboxesRequest{Parent: fmt.Sprintf("users/%d", john.ID)})
+		require.NoError(t, err)
+		return response.Inboxes
+	}
+
+	// The users mentioned by a memo they cannot see are not notified.
+	_, err = ts.Service.CreateMemo(janeCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Ask @john", Visibility: v1pb.Visibility_PRIVATE}})
+	require.NoError(t, err)
+	require.Empty(t, listMentions())
+
+	memo, err := ts.Service.CreateMemo(janeCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Hi @john, not me@example.com", Visibility: v1pb.Visibility_PROTECTED}})
+	require.NoError(t, err)
+	inboxes := listMentions()
+	require.Len(t, inboxes, 1)
+	require.Equal(t, v1pb.Inbox_MEMO_MENTION, inboxes[0].Type)
+	activity, err := ts.Service.GetActivity(johnCtx, &v1pb.GetActivityRequest{Name: fmt.Sprintf("activities/%d", inboxes[0].GetActivityId())})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Activity_MEMO_MENTION, activity.Type)
+	require.Equal(t, memo.Name, activity.Payload.GetMemoMention().Memo)
+
+	// Only the users newly mentioned are notified, the unknown users and the creator being ignored.
+	_, err = ts.Service.UpdateMemo(janeCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Content: "Hi @john and @nobody, @jane"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, listMentions(), 1)
+
+	_, err = ts.Service.CreateMemoComment(janeCtx, &v1pb.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &v1pb.Memo{Content: "@john ping", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	require.Len(t, listMentions(), 2)
+}
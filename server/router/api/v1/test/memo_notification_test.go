@@ -0,0 +1,88 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestMemoNotificationAggregation(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	jane, err := ts.CreateRegularUser(ctx, "jane")
+	require.NoError(t, err)
+	john, err := ts.CreateRegularUser(ctx, "john")
+	require.NoError(t, err)
+	bob, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	janeCtx := ts.CreateUserContext(ctx, jane.ID)
+	johnCtx := ts.CreateUserContext(ctx, john.ID)
+	bobCtx := ts.CreateUserContext(ctx, bob.ID)
+	listInboxes := func(userCtx context.Context, userID int32) []*v1pb.Inbox {
+		response, err := ts.Service.ListInboxes(userCtx, &v1pb.ListInboxesRequest{Parent: fmt.Sprintf("users/%d", userID)})
+		require.NoError(t, err)
+		return response.Inboxes
+	}
+	comment := func(userCtx context.Context, memo *v1pb.Memo, content string) {
+		_, err := ts.Service.CreateMemoComment(userCtx, &v1pb.CreateMemoCommentRequest{
+			Name:    memo.Name,
+			Comment: &v1pb.Memo{Content: content, Visibility: v1pb.Visibility_PROTECTED},
+		})
+		require.NoError(t, err)
+	}
+
+	memo, err := ts.Service.CreateMemo(janeCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: "Trip plans", Visibility: v1pb.Visibility_PROTECTED}})
+	require.NoError(t, err)
+
+	// The comments on the same memo are aggregated into one unread notification, updated with the latest one.
+	comment(johnCtx, memo, "Count me in")
+	comment(bobCtx, memo, "Me too")
+	inboxes := listInboxes(janeCtx, jane.ID)
+	require.Len(t, inboxes, 1)
+	require.Equal(t, v1pb.Inbox_MEMO_COMMENT, inboxes[0].Type)
+	require.Equal(t, int32(2), inboxes[0].Count)
+	require.Equal(t, fmt.Sprintf("users/%d", bob.ID), inboxes[0].Sender)
+
+	// The users who commented before are notified of the replies.
+	inboxes = listInboxes(johnCtx, john.ID)
+	require.Len(t, inboxes, 1)
+	require.Equal(t, v1pb.Inbox_MEMO_COMMENT_REPLY, inboxes[0].Type)
+	require.Equal(t, int32(1), inboxes[0].Count)
+	require.Empty(t, listInboxes(bobCtx, bob.ID))
+
+	// The reactions are aggregated apart from the comments, the reactions of the creator being ignored.
+	for _, userCtx := range []context.Context{johnCtx, bobCtx, janeCtx} {
+		_, err := ts.Service.UpsertMemoReaction(userCtx, &v1pb.UpsertMemoReactionRequest{
+			Name:     memo.Name,
+			Reaction: &v1pb.Reaction{ContentId: memo.Name, ReactionType: "👍"},
+		})
+		require.NoError(t, err)
+	}
+	inboxes = listInboxes(janeCtx, jane.ID)
+	require.Len(t, inboxes, 2)
+	require.Equal(t, v1pb.Inbox_MEMO_REACTION, inboxes[0].Type)
+	require.Equal(t, int32(2), inboxes[0].Count)
+	activity, err := ts.Service.GetActivity(janeCtx, &v1pb.GetActivityRequest{Name: fmt.Sprintf("activities/%d", inboxes[0].GetActivityId())})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Activity_MEMO_REACTION, activity.Type)
+	require.Equal(t, memo.Name, activity.Payload.GetMemoReaction().Memo)
+
+	// Once read, the notification is no longer updated.
+	_, err = ts.Service.UpdateInbox(janeCtx, &v1pb.UpdateInboxRequest{
+		Inbox:      &v1pb.Inbox{Name: inboxes[1].Name, Status: v1pb.Inbox_ARCHIVED},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"status"}},
+	})
+	require.NoError(t, err)
+	comment(johnCtx, memo, "When do we leave?")
+	inboxes = listInboxes(janeCtx, jane.ID)
+	require.Len(t, inboxes, 3)
+	require.Equal(t, v1pb.Inbox_MEMO_COMMENT, inboxes[0].Type)
+	require.Equal(t, int32(1), inboxes[0].Count)
+}
@@ -0,0 +1,85 @@
+package v1
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/httpgetter"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+const savedPage = `<html><head>
+<meta property="og:title" content="Saving links">
+<meta property="og:site_name" content="Example">
+<meta property="og:image" content="/images/lead">
+</head><body><article>
+<p>Links saved for later are read in <a href="/memos">memos</a>, with their images.</p>
+</article></body></html>`
+
+func TestSaveLink(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	ts.Service.GetHTML = func(_ context.Context, rawURL string) (*httpgetter.HTMLPage, error) {
+		if rawURL != "https://example.com/post" {
+			return nil, errors.New("not found")
+		}
+		pageURL, err := url.Parse(rawURL)
+		if err != nil {
+			return nil, err
+		}
+		return &httpgetter.HTMLPage{URL: pageURL, Body: []byte(savedPage)}, nil
+	}
+	fetchedImages := []string{}
+	ts.Service.GetImage = func(_ context.Context, rawURL string) (*httpgetter.Image, error) {
+		fetchedImages = append(fetchedImages, rawURL)
+		return &httpgetter.Image{Blob: []byte("image"), Mediatype: "image/png"}, nil
+	}
+
+	// Saving links requires a user.
+	_, err = ts.Service.SaveLink(ctx, &v1pb.SaveLinkRequest{Url: "https://example.com/post"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	memo, err := ts.Service.SaveLink(userCtx, &v1pb.SaveLinkRequest{Url: "https://example.com/post"})
+	require.NoError(t, err)
+	require.Equal(t, strings.Join([]string{
+		"# Saving links",
+		"[Example](https://example.com/post)",
+		"Links saved for later are read in [memos](https://example.com/memos), with their images.",
+		"#readlater",
+	}, "\n\n"), memo.Content)
+	require.Equal(t, v1pb.Visibility_PRIVATE, memo.Visibility)
+	require.Equal(t, []string{"readlater"}, memo.Tags)
+	require.Equal(t, []string{"https://example.com/images/lead"}, fetchedImages)
+	require.Len(t, memo.Attachments, 1)
+	require.Equal(t, "lead.png", memo.Attachments[0].Filename)
+	require.Equal(t, "image/png", memo.Attachments[0].Type)
+
+	memo, err = ts.Service.SaveLink(userCtx, &v1pb.SaveLinkRequest{
+		Url:        "https://example.com/post",
+		Tags:       []string{"#articles/tech", "later"},
+		Visibility: v1pb.Visibility_PROTECTED,
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasSuffix(memo.Content, "\n\n#articles/tech #later"))
+	require.Equal(t, v1pb.Visibility_PROTECTED, memo.Visibility)
+
+	// The invalid URLs and tags, and the pages failing to be fetched, are refused.
+	_, err = ts.Service.SaveLink(userCtx, &v1pb.SaveLinkRequest{Url: "ftp://example.com/post"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.SaveLink(userCtx, &v1pb.SaveLinkRequest{Url: "https://example.com/post", Tags: []string{"two words"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.SaveLink(userCtx, &v1pb.SaveLinkRequest{Url: "https://example.com/missing"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -0,0 +1,108 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestListMemosOrderBy(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	// The memos are created a second apart, and the first one is edited last.
+	for i := 0; i < 10; i++ {
+		memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    fmt.Sprintf("memo %d", i),
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+		createdTs, updatedTs := int64(1700000000+i), int64(1700000000+i)
+		if i == 0 {
+			updatedTs = 1800000000
+		}
+		require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, CreatedTs: &createdTs, UpdatedTs: &updatedTs}))
+	}
+
+	listNames := func(request *v1pb.ListMemosRequest) []string {
+		resp, err := ts.Service.ListMemos(userCtx, request)
+		require.NoError(t, err)
+		names := []string{}
+		for _, memo := range resp.Memos {
+			names = append(names, memo.Name)
+		}
+		return names
+	}
+
+	t.Run("ListMemos orders by time", func(t *testing.T) {
+		require.Equal(t, "memos/memo-9", listNames(&v1pb.ListMemosRequest{})[0])
+		require.Equal(t, "memos/memo-0", listNames(&v1pb.ListMemosRequest{OrderBy: "updated_time desc"})[0])
+		require.Equal(t, "memos/memo-0", listNames(&v1pb.ListMemosRequest{OrderBy: "update_time"})[0])
+		require.Equal(t, "memos/memo-0", listNames(&v1pb.ListMemosRequest{OrderBy: "created_time asc"})[0])
+	})
+
+	t.Run("ListMemos keeps an explicit order when displaying the update time", func(t *testing.T) {
+		_, err := ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+			Key: storepb.WorkspaceSettingKey_MEMO_RELATED,
+			Value: &storepb.WorkspaceSetting_MemoRelatedSetting{
+				MemoRelatedSetting: &storepb.WorkspaceMemoRelatedSetting{DisplayWithUpdateTime: true},
+			},
+		})
+		require.NoError(t, err)
+		defer func() {
+			_, err := ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+				Key:   storepb.WorkspaceSettingKey_MEMO_RELATED,
+				Value: &storepb.WorkspaceSetting_MemoRelatedSetting{MemoRelatedSetting: &storepb.WorkspaceMemoRelatedSetting{}},
+			})
+			require.NoError(t, err)
+		}()
+
+		require.Equal(t, "memos/memo-0", listNames(&v1pb.ListMemosRequest{OrderBy: "display_time desc"})[0])
+		require.Equal(t, "memos/memo-9", listNames(&v1pb.ListMemosRequest{OrderBy: "created_time desc"})[0])
+	})
+
+	t.Run("ListMemos orders randomly by seed", func(t *testing.T) {
+		order := listNames(&v1pb.ListMemosRequest{OrderBy: "random(42)"})
+		require.Len(t, order, 10)
+		require.Equal(t, order, listNames(&v1pb.ListMemosRequest{OrderBy: "random(42)"}))
+		require.NotEqual(t, order, listNames(&v1pb.ListMemosRequest{OrderBy: "random(7)"}))
+	})
+
+	t.Run("ListMemos keeps the picked seed across pages", func(t *testing.T) {
+		resp, err := ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{OrderBy: "random", PageSize: 5})
+		require.NoError(t, err)
+		require.Len(t, resp.Memos, 5)
+		require.NotEmpty(t, resp.NextPageToken)
+		names := map[string]bool{}
+		for _, memo := range resp.Memos {
+			names[memo.Name] = true
+		}
+		resp, err = ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{OrderBy: "random", PageSize: 5, PageToken: resp.NextPageToken})
+		require.NoError(t, err)
+		for _, memo := range resp.Memos {
+			names[memo.Name] = true
+		}
+		require.Len(t, names, 10)
+	})
+
+	t.Run("ListMemos rejects invalid orders", func(t *testing.T) {
+		for _, orderBy := range []string{"relevance", "random(0)", "random(abc)", "random asc", "unknown_field"} {
+			_, err := ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{OrderBy: orderBy})
+			require.Error(t, err, orderBy)
+		}
+	})
+}
@@ -0,0 +1,413 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/attachmentocr"
+	"github.com/usememos/memos/server/runner/attachmenttext"
+	"github.com/usememos/memos/server/runner/attachmenttranscription"
+	"github.com/usememos/memos/store"
+)
+
+func TestSearchMemos(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user1, err := ts.CreateRegularUser(ctx, "user1")
+	require.NoError(t, err)
+	user2, err := ts.CreateRegularUser(ctx, "user2")
+	require.NoError(t, err)
+
+	for _, memo := range []*store.Memo{
+		{UID: "public-memo", CreatorID: user1.ID, Content: "Travel plans for the summer", Visibility: store.Public},
+		{UID: "protected-memo", CreatorID: user1.ID, Content: "Travel budget, travel insurance", Visibility: store.Protected},
+		{UID: "private-memo", CreatorID: user1.ID, Content: "Private travel diary", Visibility: store.Private},
+		{UID: "other-memo", CreatorID: user2.ID, Content: "Grocery list", Visibility: store.Public},
+	} {
+		_, err := ts.Store.CreateMemo(ctx, memo)
+		require.NoError(t, err)
+	}
+
+	memoNames := func(memos []*v1pb.Memo) []string {
+		names := []string{}
+		for _, memo := range memos {
+			names = append(names, memo.Name)
+		}
+		return names
+	}
+
+	t.Run("SearchMemos ranks by relevance", func(t *testing.T) {
+		resp, err := ts.Service.SearchMemos(ts.CreateUserContext(ctx, user1.ID), &v1pb.SearchMemosRequest{Query: "travel"})
+		require.NoError(t, err)
+		require.Len(t, resp.Memos, 3)
+		require.Equal(t, "memos/protected-memo", resp.Memos[0].Name)
+	})
+
+	t.Run("SearchMemos respects visibility", func(t *testing.T) {
+		resp, err := ts.Service.SearchMemos(ts.CreateUserContext(ctx, user2.ID), &v1pb.SearchMemosRequest{Query: "travel"})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"memos/public-memo", "memos/protected-memo"}, memoNames(resp.Memos))
+
+		resp, err = ts.Service.SearchMemos(ctx, &v1pb.SearchMemosRequest{Query: "travel"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"memos/public-memo"}, memoNames(resp.Memos))
+	})
+
+	t.Run("SearchMemos with parent and pagination", func(t *testing.T) {
+		userCtx := ts.CreateUserContext(ctx, user1.ID)
+		resp, err := ts.Service.SearchMemos(userCtx, &v1pb.SearchMemosRequest{
+			Query:    "travel",
+			Parent:   fmt.Sprintf("users/%d", user1.ID),
+			PageSize: 2,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Memos, 2)
+		require.NotEmpty(t, resp.NextPageToken)
+
+		resp, err = ts.Service.SearchMemos(userCtx, &v1pb.SearchMemosRequest{
+			Query:     "travel",
+			Parent:    fmt.Sprintf("users/%d", user1.ID),
+			PageToken: resp.NextPageToken,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Memos, 1)
+		require.Empty(t, resp.NextPageToken)
+
+		resp, err = ts.Service.SearchMemos(userCtx, &v1pb.SearchMemosRequest{
+			Query:  "travel",
+			Parent: fmt.Sprintf("users/%d", user2.ID),
+		})
+		require.NoError(t, err)
+		require.Empty(t, resp.Memos)
+	})
+
+	t.Run("SearchMemos cursor pagination by relevance", func(t *testing.T) {
+		memoIDs := map[string]int32{}
+		for uid, content := range map[string]string{
+			"itinerary-high":   "Itinerary: itinerary for the trip, itinerary again",
+			"itinerary-medium": "Itinerary draft and itinerary notes",
+			"itinerary-low":    "An itinerary",
+		} {
+			memo, err := ts.Store.CreateMemo(ctx, &store.Memo{UID: uid, CreatorID: user2.ID, Content: content, Visibility: store.Public})
+			require.NoError(t, err)
+			memoIDs[uid] = memo.ID
+		}
+
+		resp, err := ts.Service.SearchMemos(ctx, &v1pb.SearchMemosRequest{Query: "itinerary", PageSize: 1})
+		require.NoError(t, err)
+		require.Equal(t, []string{"memos/itinerary-high"}, memoNames(resp.Memos))
+		require.Greater(t, resp.MemoMatches[0].Score, 0.0)
+		firstScore := resp.MemoMatches[0].Score
+
+		// Archiving a memo of a previous page does not shift the subsequent pages.
+		archived := store.Archived
+		require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: memoIDs["itinerary-high"], RowStatus: &archived}))
+		resp, err = ts.Service.SearchMemos(ctx, &v1pb.SearchMemosRequest{Query: "itinerary", PageToken: resp.NextPageToken})
+		require.NoError(t, err)
+		require.Equal(t, []string{"memos/itinerary-medium"}, memoNames(resp.Memos))
+		require.Less(t, resp.MemoMatches[0].Score, firstScore)
+
+		resp, err = ts.Service.SearchMemos(ctx, &v1pb.SearchMemosRequest{Query: "itinerary", PageToken: resp.NextPageToken})
+		require.NoError(t, err)
+		require.Equal(t, []string{"memos/itinerary-low"}, memoNames(resp.Memos))
+		require.Empty(t, resp.NextPageToken)
+
+		// The same cursor applies to fuzzy searches.
+		resp, err = ts.Service.SearchMemos(ctx, &v1pb.SearchMemosRequest{Query: "itinerary", Fuzzy: true, PageSize: 1})
+		require.NoError(t, err)
+		require.Len(t, resp.Memos, 1)
+		seen := memoNames(resp.Memos)
+		resp, err = ts.Service.SearchMemos(ctx, &v1pb.SearchMemosRequest{Query: "itinerary", Fuzzy: true, PageToken: resp.NextPageToken})
+		require.NoError(t, err)
+		require.Len(t, resp.Memos, 1)
+		require.NotContains(t, seen, resp.Memos[0].Name)
+	})
+
+	t.Run("SearchMemos fuzzy", func(t *testing.T) {
+		userCtx := ts.CreateUserContext(ctx, user2.ID)
+		resp, err := ts.Service.SearchMemos(userCtx, &v1pb.SearchMemosRequest{Query: "trvael"})
+		require.NoError(t, err)
+		require.Empty(t, resp.Memos)
+
+		resp, err = ts.Service.SearchMemos(userCtx, &v1pb.SearchMemosRequest{Query: "trvael", Fuzzy: true})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"memos/public-memo", "memos/protected-memo"}, memoNames(resp.Memos))
+
+		resp, err = ts.Service.SearchMemos(userCtx, &v1pb.SearchMemosRequest{Query: "trvael", Fuzzy: true, PageSize: 1})
+		require.NoError(t, err)
+		require.Len(t, resp.Memos, 1)
+		require.NotEmpty(t, resp.NextPageToken)
+	})
+
+	t.Run("SearchMemos returns snippets", func(t *testing.T) {
+		userCtx := ts.CreateUserContext(ctx, user1.ID)
+		resp, err := ts.Service.SearchMemos(userCtx, &v1pb.SearchMemosRequest{
+			Query:          "travel",
+			Parent:         fmt.Sprintf("users/%d", user1.ID),
+			ExcludeContent: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.MemoMatches, 3)
+		for i, memo := range resp.Memos {
+			require.Empty(t, memo.Content)
+			require.Equal(t, memo.Name, resp.MemoMatches[i].Memo)
+		}
+		match := resp.MemoMatches[0]
+		require.Equal(t, "memos/protected-memo", match.Memo)
+		require.Equal(t, "Travel budget, travel insurance", match.Snippet)
+		require.Equal(t, []*v1pb.TextHighlight{{Start: 0, End: 6}, {Start: 15, End: 21}}, match.Highlights)
+
+		// Long contents are cut around the first match.
+		_, err = ts.Store.CreateMemo(ctx, &store.Memo{
+			UID:        "long-memo",
+			CreatorID:  user1.ID,
+			Content:    strings.Repeat("filler ", 50) + "Hiking trip\n\nin the Alps " + strings.Repeat("filler ", 50),
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+		resp, err = ts.Service.SearchMemos(userCtx, &v1pb.SearchMemosRequest{Query: "hikng", Fuzzy: true})
+		require.NoError(t, err)
+		require.Len(t, resp.MemoMatches, 1)
+		require.NotEmpty(t, resp.Memos[0].Content)
+		snippet := []rune(resp.MemoMatches[0].Snippet)
+		require.Equal(t, "…", string(snippet[0]))
+		require.Equal(t, "…", string(snippet[len(snippet)-1]))
+		require.Contains(t, string(snippet), "Hiking trip in the Alps")
+		require.Len(t, resp.MemoMatches[0].Highlights, 1)
+		highlight := resp.MemoMatches[0].Highlights[0]
+		require.Equal(t, "Hiking", string(snippet[highlight.Start:highlight.End]))
+	})
+
+	t.Run("SearchMemos matches attachment text", func(t *testing.T) {
+		memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+			UID:        "attachment-memo",
+			CreatorID:  user2.ID,
+			Content:    "Meeting notes",
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+		for _, attachment := range []*store.Attachment{
+			{UID: "report-attachment", Filename: "report.txt", Type: "text/plain", Blob: []byte("The quarterly revenue report")},
+			{UID: "image-attachment", Filename: "chart.png", Type: "image/png", Blob: []byte("revenue")},
+		} {
+			attachment.CreatorID = user2.ID
+			attachment.Size = int64(len(attachment.Blob))
+			attachment.MemoID = &memo.ID
+			_, err := ts.Store.CreateAttachment(ctx, attachment)
+			require.NoError(t, err)
+		}
+
+		count, err := attachmenttext.IndexAttachments(ctx, ts.Store, ts.Profile)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+		// Indexed attachments are skipped on the next run.
+		count, err = attachmenttext.IndexAttachments(ctx, ts.Store, ts.Profile)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		resp, err := ts.Service.SearchMemos(ctx, &v1pb.SearchMemosRequest{Query: "Revenue"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"memos/attachment-memo"}, memoNames(resp.Memos))
+		require.Len(t, resp.AttachmentMatches, 1)
+		require.Equal(t, "memos/attachment-memo", resp.AttachmentMatches[0].Memo)
+		require.Equal(t, "attachments/report-attachment", resp.AttachmentMatches[0].Attachment)
+		require.Equal(t, "report.txt", resp.AttachmentMatches[0].Filename)
+		require.Equal(t, "The quarterly revenue report", resp.AttachmentMatches[0].Snippet)
+		require.Equal(t, []*v1pb.TextHighlight{{Start: 14, End: 21}}, resp.AttachmentMatches[0].Highlights)
+
+		// Memos matching by content have no attachment matches.
+		resp, err = ts.Service.SearchMemos(ctx, &v1pb.SearchMemosRequest{Query: "meeting"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"memos/attachment-memo"}, memoNames(resp.Memos))
+		require.Empty(t, resp.AttachmentMatches)
+	})
+
+	t.Run("SearchMemos matches recognized image text", func(t *testing.T) {
+		// The image attachment of the previous test is not indexed until recognized.
+		provider := &fakeOCRProvider{err: errors.New("service unavailable")}
+		count, err := attachmentocr.RecognizeAttachments(ctx, ts.Store, ts.Profile, provider)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		provider.err = nil
+		provider.text = "Whiteboard sketch of the roadmap"
+		count, err = attachmentocr.RecognizeAttachments(ctx, ts.Store, ts.Profile, provider)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+
+		resp, err := ts.Service.SearchMemos(ctx, &v1pb.SearchMemosRequest{Query: "roadmap"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"memos/attachment-memo"}, memoNames(resp.Memos))
+		require.Len(t, resp.AttachmentMatches, 1)
+		require.Equal(t, "chart.png", resp.AttachmentMatches[0].Filename)
+	})
+
+	t.Run("SearchMemos matches audio transcripts", func(t *testing.T) {
+		memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+			UID:        "voice-memo",
+			CreatorID:  user2.ID,
+			Content:    "Voice memo",
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+		for _, attachment := range []*store.Attachment{
+			{UID: "first-recording", Filename: "first.webm", Type: "audio/webm", Blob: []byte("first")},
+			{UID: "second-recording", Filename: "second.m4a", Type: "audio/mp4", Blob: []byte("second")},
+		} {
+			attachment.CreatorID = user2.ID
+			attachment.Size = int64(len(attachment.Blob))
+			attachment.MemoID = &memo.ID
+			_, err := ts.Store.CreateAttachment(ctx, attachment)
+			require.NoError(t, err)
+		}
+
+		provider := &fakeTranscriptionProvider{err: errors.New("service unavailable")}
+		count, err := attachmenttranscription.TranscribeAttachments(ctx, ts.Store, ts.Profile, provider)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		provider.err = nil
+		provider.texts = map[string]string{
+			"first.webm": "Remember to water the tomatoes",
+			"second.m4a": "and call the plumber",
+		}
+		count, err = attachmenttranscription.TranscribeAttachments(ctx, ts.Store, ts.Profile, provider)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+
+		userCtx := ts.CreateUserContext(ctx, user2.ID)
+		got, err := ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: "memos/voice-memo"})
+		require.NoError(t, err)
+		require.Equal(t, "Remember to water the tomatoes\n\nand call the plumber", got.Transcript)
+
+		resp, err := ts.Service.SearchMemos(ctx, &v1pb.SearchMemosRequest{Query: "plumber"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"memos/voice-memo"}, memoNames(resp.Memos))
+		require.Len(t, resp.AttachmentMatches, 1)
+		require.Equal(t, "second.m4a", resp.AttachmentMatches[0].Filename)
+
+		// The transcripts of the detached recordings are left out.
+		_, err = ts.Service.SetMemoAttachments(userCtx, &v1pb.SetMemoAttachmentsRequest{
+			Name:        "memos/voice-memo",
+			Attachments: []*v1pb.Attachment{{Name: "attachments/first-recording"}},
+		})
+		require.NoError(t, err)
+		got, err = ts.Service.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: "memos/voice-memo"})
+		require.NoError(t, err)
+		require.Equal(t, "Remember to water the tomatoes", got.Transcript)
+	})
+
+	t.Run("SearchMemos regex", func(t *testing.T) {
+		_, err := ts.Store.CreateMemo(ctx, &store.Memo{
+			UID:        "ticket-memo",
+			CreatorID:  user2.ID,
+			Content:    "Fixed TICKET-123,\nsee also TICKET-4567",
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+
+		resp, err := ts.Service.SearchMemos(ctx, &v1pb.SearchMemosRequest{Query: `TICKET-\d{3,}`, Regex: true})
+		require.NoError(t, err)
+		require.Equal(t, []string{"memos/ticket-memo"}, memoNames(resp.Memos))
+		require.Equal(t, "Fixed TICKET-123, see also TICKET-4567", resp.MemoMatches[0].Snippet)
+		require.Equal(t, []*v1pb.TextHighlight{{Start: 6, End: 16}, {Start: 27, End: 38}}, resp.MemoMatches[0].Highlights)
+
+		// Patterns are case sensitive unless specified otherwise.
+		resp, err = ts.Service.SearchMemos(ctx, &v1pb.SearchMemosRequest{Query: `ticket-\d+`, Regex: true})
+		require.NoError(t, err)
+		require.Empty(t, resp.Memos)
+		resp, err = ts.Service.SearchMemos(ctx, &v1pb.SearchMemosRequest{Query: `(?i)ticket-\d+`, Regex: true})
+		require.NoError(t, err)
+		require.Len(t, resp.Memos, 1)
+
+		_, err = ts.Service.SearchMemos(ctx, &v1pb.SearchMemosRequest{Query: `TICKET-(`, Regex: true})
+		require.Error(t, err)
+		// Patterns compiling to large programs are rejected.
+		_, err = ts.Service.SearchMemos(ctx, &v1pb.SearchMemosRequest{Query: `a{900}b{900}c{900}`, Regex: true})
+		require.ErrorContains(t, err, "too complex")
+		_, err = ts.Service.SearchMemos(ctx, &v1pb.SearchMemosRequest{Query: "ticket", Regex: true, Fuzzy: true})
+		require.Error(t, err)
+	})
+
+	t.Run("SearchMemos accessible scope with creator facets", func(t *testing.T) {
+		_, err := ts.Store.CreateMemo(ctx, &store.Memo{
+			UID:        "own-travel-memo",
+			CreatorID:  user2.ID,
+			Content:    "My travel checklist",
+			Visibility: store.Private,
+		})
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user2.ID)
+		wantFacets := []*v1pb.SearchMemosResponse_CreatorFacet{
+			{Creator: fmt.Sprintf("users/%d", user1.ID), MemoCount: 2},
+			{Creator: fmt.Sprintf("users/%d", user2.ID), MemoCount: 1},
+		}
+
+		resp, err := ts.Service.SearchMemos(userCtx, &v1pb.SearchMemosRequest{
+			Query:    "travel",
+			Scope:    v1pb.SearchMemosRequest_ACCESSIBLE,
+			PageSize: 1,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Memos, 1)
+		require.Equal(t, wantFacets, resp.CreatorFacets)
+
+		resp, err = ts.Service.SearchMemos(userCtx, &v1pb.SearchMemosRequest{
+			Query: "travel",
+			Scope: v1pb.SearchMemosRequest_ACCESSIBLE,
+			Fuzzy: true,
+		})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"memos/public-memo", "memos/protected-memo", "memos/own-travel-memo"}, memoNames(resp.Memos))
+		require.Equal(t, wantFacets, resp.CreatorFacets)
+
+		resp, err = ts.Service.SearchMemos(userCtx, &v1pb.SearchMemosRequest{
+			Query: "travel",
+			Scope: v1pb.SearchMemosRequest_OWN,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"memos/own-travel-memo"}, memoNames(resp.Memos))
+		require.Empty(t, resp.CreatorFacets)
+
+		_, err = ts.Service.SearchMemos(ctx, &v1pb.SearchMemosRequest{Query: "travel", Scope: v1pb.SearchMemosRequest_OWN})
+		require.Error(t, err)
+		_, err = ts.Service.SearchMemos(userCtx, &v1pb.SearchMemosRequest{
+			Query:  "travel",
+			Parent: fmt.Sprintf("users/%d", user1.ID),
+			Scope:  v1pb.SearchMemosRequest_ACCESSIBLE,
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("SearchMemos requires a query", func(t *testing.T) {
+		_, err := ts.Service.SearchMemos(ctx, &v1pb.SearchMemosRequest{Query: "  "})
+		require.Error(t, err)
+	})
+}
+
+type fakeOCRProvider struct {
+	text string
+	err  error
+}
+
+func (p *fakeOCRProvider) Recognize(context.Context, string, []byte) (string, error) {
+	return p.text, p.err
+}
+
+type fakeTranscriptionProvider struct {
+	texts map[string]string
+	err   error
+}
+
+func (p *fakeTranscriptionProvider) Transcribe(_ context.Context, _, filename string, _ []byte) (string, error) {
+	return p.texts[filename], p.err
+}
@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/embedding"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memoembedding"
+	"github.com/usememos/memos/store"
+)
+
+func TestSemanticSearchMemos(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user1, err := ts.CreateRegularUser(ctx, "user1")
+	require.NoError(t, err)
+	user2, err := ts.CreateRegularUser(ctx, "user2")
+	require.NoError(t, err)
+	user2Ctx := ts.CreateUserContext(ctx, user2.ID)
+
+	for _, memo := range []*store.Memo{
+		{UID: "garden-memo", CreatorID: user1.ID, Content: "Planting tomatoes in the garden", Visibility: store.Public},
+		{UID: "tax-memo", CreatorID: user1.ID, Content: "Quarterly tax report", Visibility: store.Public},
+		{UID: "private-memo", CreatorID: user1.ID, Content: "Private gardening notes", Visibility: store.Private},
+	} {
+		_, err := ts.Store.CreateMemo(ctx, memo)
+		require.NoError(t, err)
+	}
+
+	// Semantic search is disabled by default.
+	_, err = ts.Service.SemanticSearchMemos(user2Ctx, &v1pb.SemanticSearchMemosRequest{Query: "gardening"})
+	require.Error(t, err)
+
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_EMBEDDING,
+		Value: &storepb.WorkspaceSetting_EmbeddingSetting{
+			EmbeddingSetting: &storepb.WorkspaceEmbeddingSetting{
+				Enabled:  true,
+				Provider: storepb.WorkspaceEmbeddingSetting_LOCAL,
+			},
+		},
+	})
+	require.NoError(t, err)
+	count, err := memoembedding.IndexMemos(ctx, ts.Store, embedding.NewLocalProvider())
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+	// Memos with an up to date embedding are skipped.
+	count, err = memoembedding.IndexMemos(ctx, ts.Store, embedding.NewLocalProvider())
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+
+	resp, err := ts.Service.SemanticSearchMemos(user2Ctx, &v1pb.SemanticSearchMemosRequest{Query: "gardening tomato"})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 2)
+	require.Equal(t, "memos/garden-memo", resp.Results[0].Memo.Name)
+	require.Greater(t, resp.Results[0].Score, resp.Results[1].Score)
+
+	resp, err = ts.Service.SemanticSearchMemos(ts.CreateUserContext(ctx, user1.ID), &v1pb.SemanticSearchMemosRequest{Query: "gardening", PageSize: 1})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 1)
+	require.Equal(t, "memos/private-memo", resp.Results[0].Memo.Name)
+}
@@ -0,0 +1,88 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestSuggestMemos(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user1, err := ts.CreateRegularUser(ctx, "user1")
+	require.NoError(t, err)
+	user2, err := ts.CreateRegularUser(ctx, "user2")
+	require.NoError(t, err)
+
+	for _, memo := range []*store.Memo{
+		{UID: "public-memo", CreatorID: user1.ID, Content: "# Project kickoff\n#work/project notes", Visibility: store.Public, Payload: &storepb.MemoPayload{Tags: []string{"work/project"}}},
+		{UID: "protected-memo", CreatorID: user1.ID, Content: "Project budget #work", Visibility: store.Protected, Payload: &storepb.MemoPayload{Tags: []string{"work"}}},
+		{UID: "private-memo", CreatorID: user1.ID, Content: "Private project diary #personal", Visibility: store.Private, Payload: &storepb.MemoPayload{Tags: []string{"personal"}}},
+	} {
+		_, err := ts.Store.CreateMemo(ctx, memo)
+		require.NoError(t, err)
+	}
+	memoTitles := func(resp *v1pb.SuggestMemosResponse) []string {
+		titles := []string{}
+		for _, memo := range resp.Memos {
+			titles = append(titles, memo.Title)
+		}
+		return titles
+	}
+
+	t.Run("SuggestMemos respects visibility", func(t *testing.T) {
+		resp, err := ts.Service.SuggestMemos(ctx, &v1pb.SuggestMemosRequest{Prefix: "proj"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"work/project"}, resp.Tags)
+		require.Equal(t, []string{"Project kickoff"}, memoTitles(resp))
+		require.Equal(t, "memos/public-memo", resp.Memos[0].Memo)
+
+		resp, err = ts.Service.SuggestMemos(ts.CreateUserContext(ctx, user2.ID), &v1pb.SuggestMemosRequest{Prefix: "proj"})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"Project kickoff", "Project budget #work"}, memoTitles(resp))
+
+		resp, err = ts.Service.SuggestMemos(ts.CreateUserContext(ctx, user1.ID), &v1pb.SuggestMemosRequest{Prefix: "#p"})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"personal", "work/project"}, resp.Tags)
+	})
+
+	t.Run("SuggestMemos returns recent queries", func(t *testing.T) {
+		userCtx := ts.CreateUserContext(ctx, user2.ID)
+		for _, query := range []string{"budget", "kickoff", "Budget"} {
+			_, err := ts.Service.SearchMemos(userCtx, &v1pb.SearchMemosRequest{Query: query})
+			require.NoError(t, err)
+		}
+		resp, err := ts.Service.SuggestMemos(userCtx, &v1pb.SuggestMemosRequest{Prefix: "b"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"Budget"}, resp.RecentQueries)
+
+		// Recent queries are private to the user.
+		resp, err = ts.Service.SuggestMemos(ts.CreateUserContext(ctx, user1.ID), &v1pb.SuggestMemosRequest{Prefix: "b"})
+		require.NoError(t, err)
+		require.Empty(t, resp.RecentQueries)
+	})
+
+	t.Run("SuggestMemos includes new memos", func(t *testing.T) {
+		userCtx := ts.CreateUserContext(ctx, user2.ID)
+		_, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+			Memo: &v1pb.Memo{Content: "Zebra sightings", Visibility: v1pb.Visibility_PRIVATE},
+		})
+		require.NoError(t, err)
+		resp, err := ts.Service.SuggestMemos(userCtx, &v1pb.SuggestMemosRequest{Prefix: "zeb"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"Zebra sightings"}, memoTitles(resp))
+	})
+
+	t.Run("SuggestMemos requires a prefix", func(t *testing.T) {
+		_, err := ts.Service.SuggestMemos(ctx, &v1pb.SuggestMemosRequest{Prefix: " "})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,119 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoShare(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	owner, err := ts.CreateRegularUser(ctx, "owner")
+	require.NoError(t, err)
+	ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+	memos := map[string]*store.Memo{}
+	for uid, visibility := range map[string]store.Visibility{
+		"private-memo": store.Private,
+		"public-memo":  store.Public,
+	} {
+		memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  owner.ID,
+			Content:    "test memo",
+			Visibility: visibility,
+		})
+		require.NoError(t, err)
+		memos[uid] = memo
+	}
+	publicMemo := memos["public-memo"]
+
+	// Only the creator can share their private memos.
+	_, err = ts.Service.CreateMemoShare(otherCtx, &v1pb.CreateMemoShareRequest{Parent: "memos/private-memo", MemoShare: &v1pb.MemoShare{}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.CreateMemoShare(ownerCtx, &v1pb.CreateMemoShareRequest{Parent: "memos/public-memo", MemoShare: &v1pb.MemoShare{}})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	memoShare, err := ts.Service.CreateMemoShare(ownerCtx, &v1pb.CreateMemoShareRequest{Parent: "memos/private-memo", MemoShare: &v1pb.MemoShare{}})
+	require.NoError(t, err)
+	require.NotEmpty(t, memoShare.Token)
+	require.False(t, memoShare.PasswordProtected)
+	memo, err := ts.Service.GetSharedMemo(ctx, &v1pb.GetSharedMemoRequest{Token: memoShare.Token})
+	require.NoError(t, err)
+	require.Equal(t, "memos/private-memo", memo.Name)
+	require.Equal(t, v1pb.Visibility_PRIVATE, memo.Visibility)
+	_, err = ts.Service.GetSharedMemo(ctx, &v1pb.GetSharedMemoRequest{Token: "invalid"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	protectedShare, err := ts.Service.CreateMemoShare(ownerCtx, &v1pb.CreateMemoShareRequest{
+		Parent:    "memos/private-memo",
+		MemoShare: &v1pb.MemoShare{Password: "secret", ExpireTime: timestamppb.New(time.Now().Add(time.Hour))},
+	})
+	require.NoError(t, err)
+	require.True(t, protectedShare.PasswordProtected)
+	require.NotNil(t, protectedShare.ExpireTime)
+	_, err = ts.Service.GetSharedMemo(ctx, &v1pb.GetSharedMemoRequest{Token: protectedShare.Token})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = ts.Service.GetSharedMemo(ctx, &v1pb.GetSharedMemoRequest{Token: protectedShare.Token, Password: "wrong"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.GetSharedMemo(ctx, &v1pb.GetSharedMemoRequest{Token: protectedShare.Token, Password: "secret"})
+	require.NoError(t, err)
+
+	// The links grant access to the attachments of their memo.
+	memoName := "memos/private-memo"
+	attachment, err := ts.Service.CreateAttachment(ownerCtx, &v1pb.CreateAttachmentRequest{
+		Attachment: &v1pb.Attachment{Filename: "notes.txt", Type: "text/plain", Content: []byte("shared notes"), Memo: &memoName},
+	})
+	require.NoError(t, err)
+	getAttachment := func(token, password string) error {
+		_, err := ts.Service.GetAttachmentBinary(ctx, &v1pb.GetAttachmentBinaryRequest{
+			Name:          attachment.Name,
+			Filename:      attachment.Filename,
+			ShareToken:    token,
+			SharePassword: password,
+		})
+		return err
+	}
+	require.Equal(t, codes.Unauthenticated, status.Code(getAttachment("", "")))
+	require.NoError(t, getAttachment(memoShare.Token, ""))
+	require.Equal(t, codes.NotFound, status.Code(getAttachment("invalid", "")))
+	require.Equal(t, codes.Unauthenticated, status.Code(getAttachment(protectedShare.Token, "")))
+	require.NoError(t, getAttachment(protectedShare.Token, "secret"))
+	publicShare, err := ts.Store.CreateMemoShare(ctx, &store.MemoShare{MemoID: publicMemo.ID, CreatorID: owner.ID, Token: "public-token"})
+	require.NoError(t, err)
+	require.Equal(t, codes.PermissionDenied, status.Code(getAttachment(publicShare.Token, "")))
+	require.NoError(t, ts.Store.DeleteMemoShare(ctx, &store.DeleteMemoShare{ID: &publicShare.ID}))
+
+	listResponse, err := ts.Service.ListMemoShares(ownerCtx, &v1pb.ListMemoSharesRequest{Parent: "memos/private-memo"})
+	require.NoError(t, err)
+	require.Len(t, listResponse.MemoShares, 2)
+
+	// The revoked link no longer grants access.
+	_, err = ts.Service.DeleteMemoShare(otherCtx, &v1pb.DeleteMemoShareRequest{Name: memoShare.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.DeleteMemoShare(ownerCtx, &v1pb.DeleteMemoShareRequest{Name: memoShare.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.GetSharedMemo(ctx, &v1pb.GetSharedMemoRequest{Token: memoShare.Token})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Deleting the memo revokes its links.
+	_, err = ts.Service.DeleteMemo(ownerCtx, &v1pb.DeleteMemoRequest{Name: "memos/private-memo"})
+	require.NoError(t, err)
+	memoShares, err := ts.Store.ListMemoShares(ctx, &store.FindMemoShare{})
+	require.NoError(t, err)
+	require.Empty(t, memoShares)
+}
@@ -0,0 +1,91 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/onthisday"
+	"github.com/usememos/memos/store"
+)
+
+func TestOnThisDay(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "jane")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	parent := fmt.Sprintf("users/%d", user.ID)
+
+	now := time.Now().UTC()
+	createMemo := func(content string, createdTime time.Time) {
+		memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Memo: &v1pb.Memo{Content: content, Visibility: v1pb.Visibility_PRIVATE}})
+		require.NoError(t, err)
+		memoUID := strings.TrimPrefix(memo.Name, "memos/")
+		stored, err := ts.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID})
+		require.NoError(t, err)
+		createdTs := createdTime.Unix()
+		require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{ID: stored.ID, CreatedTs: &createdTs}))
+	}
+	createMemo("Today", now)
+	createMemo("First trip to Rome", now.AddDate(-2, 0, 0))
+	createMemo("Back in Rome", now.AddDate(-1, 0, 0))
+	createMemo("Another day", now.AddDate(-1, 0, -1))
+
+	// The memos created on this day in past years are listed, the most recent first.
+	response, err := ts.Service.ListOnThisDayMemos(userCtx, &v1pb.ListOnThisDayMemosRequest{Parent: parent})
+	require.NoError(t, err)
+	require.Len(t, response.Memos, 2)
+	require.Equal(t, "Back in Rome", response.Memos[0].Content)
+	require.Equal(t, "First trip to Rome", response.Memos[1].Content)
+	response, err = ts.Service.ListOnThisDayMemos(userCtx, &v1pb.ListOnThisDayMemosRequest{Parent: parent, Date: now.AddDate(0, 0, -1).Format(time.DateOnly)})
+	require.NoError(t, err)
+	require.Len(t, response.Memos, 1)
+	require.Equal(t, "Another day", response.Memos[0].Content)
+	_, err = ts.Service.ListOnThisDayMemos(userCtx, &v1pb.ListOnThisDayMemosRequest{Parent: parent, Date: "yesterday"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	other, err := ts.CreateRegularUser(ctx, "john")
+	require.NoError(t, err)
+	_, err = ts.Service.ListOnThisDayMemos(ts.CreateUserContext(ctx, other.ID), &v1pb.ListOnThisDayMemosRequest{Parent: parent})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The users who enabled it are notified daily in their inbox, from the next scheduled time.
+	name := fmt.Sprintf("users/%d/onThisDay", user.ID)
+	_, err = ts.Service.UpdateUserOnThisDay(userCtx, &v1pb.UpdateUserOnThisDayRequest{OnThisDay: &v1pb.UserOnThisDay{Name: name, Enabled: true, Hour: 24}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.UpdateUserOnThisDay(userCtx, &v1pb.UpdateUserOnThisDayRequest{OnThisDay: &v1pb.UserOnThisDay{Name: name, Enabled: true, Hour: int32(now.Hour())}})
+	require.NoError(t, err)
+	listInboxes := func() []*v1pb.Inbox {
+		response, err := ts.Service.ListInboxes(userCtx, &v1pb.ListInboxesRequest{Parent: parent})
+		require.NoError(t, err)
+		return response.Inboxes
+	}
+	runner := onthisday.NewRunner(ts.Store, ts.Service.NewOnThisDayNotifier())
+	runner.RunOnce(ctx, now)
+	require.Empty(t, listInboxes())
+
+	setting, err := ts.Store.GetUserOnThisDay(ctx, user.ID)
+	require.NoError(t, err)
+	setting.LastNotifyTime = timestamppb.New(now.Add(-24 * time.Hour))
+	require.NoError(t, ts.Store.UpsertUserOnThisDay(ctx, user.ID, setting))
+	runner.RunOnce(ctx, now)
+	inboxes := listInboxes()
+	require.Len(t, inboxes, 1)
+	require.Equal(t, v1pb.Inbox_MEMO_ON_THIS_DAY, inboxes[0].Type)
+	runner.RunOnce(ctx, now)
+	require.Len(t, listInboxes(), 1)
+	onThisDay, err := ts.Service.GetUserOnThisDay(userCtx, &v1pb.GetUserOnThisDayRequest{Name: name})
+	require.NoError(t, err)
+	require.True(t, onThisDay.Enabled)
+	require.Equal(t, time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), 0, 0, 0, time.UTC).Unix(), onThisDay.LastNotifyTime.AsTime().Unix())
+}
@@ -0,0 +1,105 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+)
+
+func TestPasswordPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	// The range API knows a single breached password.
+	sum := sha1.Sum([]byte("breached password"))
+	breachedHash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/range/"+breachedHash[:5] {
+			fmt.Fprintf(w, "%s:42\r\n", breachedHash[5:])
+		}
+	}))
+	defer server.Close()
+
+	hostUser, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_PASSWORD_POLICY,
+		Value: &storepb.WorkspaceSetting_PasswordPolicySetting{
+			PasswordPolicySetting: &storepb.WorkspacePasswordPolicySetting{
+				MinLength:               12,
+				CheckBreached:           true,
+				BreachCheckEndpoint:     server.URL,
+				RequireChangeAfterReset: true,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{User: &v1pb.User{Username: "testuser", Password: "short"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{User: &v1pb.User{Username: "testuser", Password: "breached password"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	user, err := ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{User: &v1pb.User{Username: "testuser", Password: "a long enough password"}})
+	require.NoError(t, err)
+	userID, err := apiv1.ExtractUserIDFromName(user.Name)
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, userID)
+	userPassword, err := ts.Store.GetUserPassword(ctx, userID)
+	require.NoError(t, err)
+	require.False(t, userPassword.ChangeRequired)
+
+	// The password reset by the host has to be changed by the user before using the API.
+	updatePassword := func(ctx context.Context, password string) error {
+		_, err := ts.Service.UpdateUser(ctx, &v1pb.UpdateUserRequest{
+			User:       &v1pb.User{Name: user.Name, Password: password},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"password"}},
+		})
+		return err
+	}
+	require.NoError(t, updatePassword(hostCtx, "temporary password"))
+	session, err := ts.Service.GetCurrentSession(userCtx, &v1pb.GetCurrentSessionRequest{})
+	require.NoError(t, err)
+	require.True(t, session.PasswordChangeRequired)
+	interceptor := apiv1.NewGRPCAuthInterceptor(ts.Store, ts.Secret)
+	accessToken, err := ts.Service.CreateUserAccessToken(userCtx, &v1pb.CreateUserAccessTokenRequest{
+		Parent:      user.Name,
+		AccessToken: &v1pb.UserAccessToken{Description: "script"},
+	})
+	require.NoError(t, err)
+	call := func(method string) error {
+		requestCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+accessToken.AccessToken))
+		_, err := interceptor.AuthenticationInterceptor(requestCtx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, any) (any, error) {
+			return nil, nil
+		})
+		return err
+	}
+	require.Equal(t, codes.FailedPrecondition, status.Code(call("/memos.api.v1.MemoService/CreateMemo")))
+	require.NoError(t, call("/memos.api.v1.UserService/UpdateUser"))
+
+	require.Equal(t, codes.InvalidArgument, status.Code(updatePassword(userCtx, "temporary password")))
+	require.Equal(t, codes.InvalidArgument, status.Code(updatePassword(userCtx, "breached password")))
+	require.NoError(t, updatePassword(userCtx, "my own new password"))
+	session, err = ts.Service.GetCurrentSession(userCtx, &v1pb.GetCurrentSessionRequest{})
+	require.NoError(t, err)
+	require.False(t, session.PasswordChangeRequired)
+	require.NoError(t, call("/memos.api.v1.MemoService/CreateMemo"))
+}
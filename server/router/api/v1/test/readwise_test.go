@@ -0,0 +1,100 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/readwisesync"
+	"github.com/usememos/memos/store"
+)
+
+func TestReadwiseSync(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	user, err := ts.CreateRegularUser(ctx, "jane")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	readwiseName := fmt.Sprintf("users/%d/readwise", user.ID)
+
+	// The fake Readwise API lists the updated highlights with updatedAfter, and all the highlights with ids.
+	var mu sync.Mutex
+	highlights := `{"id":11,"text":"I must not fear.","location":20},{"id":10,"text":"Fear is the mind-killer.","note":"Classic","location":7}`
+	updatedHighlights := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Header.Get("Authorization") != "Token secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		book := `{"user_book_id":1,"title":"Dune","author":"Frank Herbert","category":"books","highlights":[%s]}`
+		switch {
+		case r.URL.Query().Get("updatedAfter") != "" && updatedHighlights == "":
+			fmt.Fprint(w, `{"count":0,"nextPageCursor":null,"results":[]}`)
+		case r.URL.Query().Get("updatedAfter") != "":
+			fmt.Fprintf(w, `{"count":1,"nextPageCursor":null,"results":[`+book+`]}`, updatedHighlights)
+		default:
+			fmt.Fprintf(w, `{"count":1,"nextPageCursor":null,"results":[`+book+`]}`, highlights)
+		}
+	}))
+	defer server.Close()
+	runner := readwisesync.NewRunner(ts.Store, ts.Service.NewReadwiseHandler())
+	runner.APIEndpoint = server.URL
+
+	// The access token is a secret of the user, hidden from the admins too.
+	_, err = ts.Service.ConnectUserReadwise(hostCtx, &v1pb.ConnectUserReadwiseRequest{Name: readwiseName, Token: "secret"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	readwise, err := ts.Service.ConnectUserReadwise(userCtx, &v1pb.ConnectUserReadwiseRequest{Name: readwiseName, Token: "secret", Tag: "#reading"})
+	require.NoError(t, err)
+	require.True(t, readwise.Connected)
+	require.Equal(t, "reading", readwise.Tag)
+
+	// The first sync creates a memo per book with its highlights in the order of the book.
+	runner.RunOnce(ctx)
+	memos, err := ts.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	require.Equal(t, "# Dune\n\nby Frank Herbert\n\n> Fear is the mind-killer.\n\n**Note:** Classic\n\n> I must not fear.\n\n#reading/books", memos[0].Content)
+	readwise, err = ts.Service.GetUserReadwise(userCtx, &v1pb.GetUserReadwiseRequest{Name: readwiseName})
+	require.NoError(t, err)
+	require.NotNil(t, readwise.LastSyncTime)
+	require.Empty(t, readwise.LastSyncError)
+
+	// A new highlight updates the memo of its book rather than creating another one.
+	mu.Lock()
+	highlights += `,{"id":12,"text":"Fear is the little-death.","location":8}`
+	updatedHighlights = `{"id":12,"text":"Fear is the little-death.","location":8}`
+	mu.Unlock()
+	runner.RunOnce(ctx)
+	memos, err = ts.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	require.Contains(t, memos[0].Content, "**Note:** Classic\n\n> Fear is the little-death.\n\n> I must not fear.")
+
+	// The errors of the sync are reported, and the disconnected accounts are no longer synced.
+	_, err = ts.Service.ConnectUserReadwise(userCtx, &v1pb.ConnectUserReadwiseRequest{Name: readwiseName, Token: "revoked"})
+	require.NoError(t, err)
+	runner.RunOnce(ctx)
+	readwise, err = ts.Service.GetUserReadwise(userCtx, &v1pb.GetUserReadwiseRequest{Name: readwiseName})
+	require.NoError(t, err)
+	require.Contains(t, readwise.LastSyncError, "invalid Readwise access token")
+	readwise, err = ts.Service.DisconnectUserReadwise(userCtx, &v1pb.DisconnectUserReadwiseRequest{Name: readwiseName})
+	require.NoError(t, err)
+	require.False(t, readwise.Connected)
+	require.Empty(t, readwise.LastSyncError)
+}
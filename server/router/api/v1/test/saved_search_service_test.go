@@ -0,0 +1,159 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestSavedSearches(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("SavedSearch CRUD", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user, err := ts.CreateRegularUser(ctx, "testuser")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		parent := fmt.Sprintf("users/%d", user.ID)
+
+		first, err := ts.Service.CreateSavedSearch(userCtx, &v1pb.CreateSavedSearchRequest{
+			Parent: parent,
+			SavedSearch: &v1pb.SavedSearch{
+				Title: "Work",
+				Query: "meeting",
+			},
+		})
+		require.NoError(t, err)
+		second, err := ts.Service.CreateSavedSearch(userCtx, &v1pb.CreateSavedSearchRequest{
+			Parent: parent,
+			SavedSearch: &v1pb.SavedSearch{
+				Title:   "Oldest todos",
+				Filter:  `tag in ["todo"]`,
+				OrderBy: "create_time asc",
+			},
+		})
+		require.NoError(t, err)
+
+		updated, err := ts.Service.UpdateSavedSearch(userCtx, &v1pb.UpdateSavedSearchRequest{
+			SavedSearch: &v1pb.SavedSearch{
+				Name:   second.Name,
+				Pinned: true,
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"pinned"}},
+		})
+		require.NoError(t, err)
+		require.True(t, updated.Pinned)
+		require.Equal(t, "create_time asc", updated.OrderBy)
+
+		// Pinned saved searches are listed first.
+		listResp, err := ts.Service.ListSavedSearches(userCtx, &v1pb.ListSavedSearchesRequest{Parent: parent})
+		require.NoError(t, err)
+		require.Len(t, listResp.SavedSearches, 2)
+		require.Equal(t, second.Name, listResp.SavedSearches[0].Name)
+		require.Equal(t, first.Name, listResp.SavedSearches[1].Name)
+
+		_, err = ts.Service.DeleteSavedSearch(userCtx, &v1pb.DeleteSavedSearchRequest{Name: first.Name})
+		require.NoError(t, err)
+		_, err = ts.Service.GetSavedSearch(userCtx, &v1pb.GetSavedSearchRequest{Name: first.Name})
+		require.Error(t, err)
+	})
+
+	t.Run("SavedSearch validation", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user, err := ts.CreateRegularUser(ctx, "testuser")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		parent := fmt.Sprintf("users/%d", user.ID)
+
+		for _, savedSearch := range []*v1pb.SavedSearch{
+			{Query: "missing title"},
+			{Title: "Invalid filter", Filter: "invalid filter"},
+			{Title: "Invalid order", OrderBy: "unknown_field"},
+			{Title: "Fuzzy without query", Fuzzy: true},
+		} {
+			_, err := ts.Service.CreateSavedSearch(userCtx, &v1pb.CreateSavedSearchRequest{
+				Parent:      parent,
+				SavedSearch: savedSearch,
+			})
+			require.Error(t, err, savedSearch.Title)
+		}
+
+		other, err := ts.CreateRegularUser(ctx, "other")
+		require.NoError(t, err)
+		_, err = ts.Service.ListSavedSearches(ts.CreateUserContext(ctx, other.ID), &v1pb.ListSavedSearchesRequest{Parent: parent})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "permission denied")
+	})
+
+	t.Run("ExecuteSavedSearch", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user, err := ts.CreateRegularUser(ctx, "testuser")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		parent := fmt.Sprintf("users/%d", user.ID)
+
+		for i, memo := range []struct {
+			content string
+			tags    []string
+		}{
+			{"#todo Call the bank", []string{"todo"}},
+			{"#todo Book the meeting room", []string{"todo"}},
+			{"Meeting notes", nil},
+		} {
+			_, err := ts.Store.CreateMemo(ctx, &store.Memo{
+				UID:        fmt.Sprintf("memo-%d", i),
+				CreatorID:  user.ID,
+				CreatedTs:  int64(1700000000 + i),
+				Content:    memo.content,
+				Visibility: store.Private,
+				Payload:    &storepb.MemoPayload{Tags: memo.tags},
+			})
+			require.NoError(t, err)
+		}
+
+		todos, err := ts.Service.CreateSavedSearch(userCtx, &v1pb.CreateSavedSearchRequest{
+			Parent: parent,
+			SavedSearch: &v1pb.SavedSearch{
+				Title:   "Oldest todos",
+				Filter:  `tag in ["todo"]`,
+				OrderBy: "create_time asc",
+			},
+		})
+		require.NoError(t, err)
+		resp, err := ts.Service.ExecuteSavedSearch(userCtx, &v1pb.ExecuteSavedSearchRequest{Name: todos.Name, PageSize: 1})
+		require.NoError(t, err)
+		require.Len(t, resp.Memos, 1)
+		require.Equal(t, "memos/memo-0", resp.Memos[0].Name)
+		resp, err = ts.Service.ExecuteSavedSearch(userCtx, &v1pb.ExecuteSavedSearchRequest{Name: todos.Name, PageToken: resp.NextPageToken})
+		require.NoError(t, err)
+		require.Len(t, resp.Memos, 1)
+		require.Equal(t, "memos/memo-1", resp.Memos[0].Name)
+
+		meetings, err := ts.Service.CreateSavedSearch(userCtx, &v1pb.CreateSavedSearchRequest{
+			Parent: parent,
+			SavedSearch: &v1pb.SavedSearch{
+				Title:  "Meeting todos",
+				Query:  "meeting",
+				Filter: `tag in ["todo"]`,
+			},
+		})
+		require.NoError(t, err)
+		resp, err = ts.Service.ExecuteSavedSearch(userCtx, &v1pb.ExecuteSavedSearchRequest{Name: meetings.Name})
+		require.NoError(t, err)
+		require.Len(t, resp.Memos, 1)
+		require.Equal(t, "memos/memo-1", resp.Memos[0].Name)
+	})
+}
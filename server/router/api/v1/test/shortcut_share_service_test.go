@@ -0,0 +1,140 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestShortcutSharing(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Shared shortcuts are readable by the shared users", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		owner, err := ts.CreateRegularUser(ctx, "owner")
+		require.NoError(t, err)
+		reader, err := ts.CreateRegularUser(ctx, "reader")
+		require.NoError(t, err)
+		stranger, err := ts.CreateRegularUser(ctx, "stranger")
+		require.NoError(t, err)
+		ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+		readerCtx := ts.CreateUserContext(ctx, reader.ID)
+		strangerCtx := ts.CreateUserContext(ctx, stranger.ID)
+		parent := fmt.Sprintf("users/%d", owner.ID)
+
+		_, err = ts.Service.CreateFilterMacro(ownerCtx, &v1pb.CreateFilterMacroRequest{
+			Parent:        parent,
+			FilterMacroId: "work",
+			FilterMacro:   &v1pb.FilterMacro{Expression: `tag in ["work"]`},
+		})
+		require.NoError(t, err)
+
+		shortcut, err := ts.Service.CreateShortcut(ownerCtx, &v1pb.CreateShortcutRequest{
+			Parent: parent,
+			Shortcut: &v1pb.Shortcut{
+				Title:       "Work",
+				Filter:      `work && pinned`,
+				Visibility:  v1pb.Shortcut_SHARED,
+				SharedUsers: []string{fmt.Sprintf("users/%d", reader.ID)},
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, v1pb.Shortcut_SHARED, shortcut.Visibility)
+		require.Equal(t, []string{fmt.Sprintf("users/%d", reader.ID)}, shortcut.SharedUsers)
+
+		// The reader sees the filter with the macros of the owner expanded.
+		got, err := ts.Service.GetShortcut(readerCtx, &v1pb.GetShortcutRequest{Name: shortcut.Name})
+		require.NoError(t, err)
+		require.Equal(t, `(tag in ["work"]) && pinned`, got.Filter)
+		require.Empty(t, got.SharedUsers)
+		listResp, err := ts.Service.ListSharedShortcuts(readerCtx, &v1pb.ListSharedShortcutsRequest{})
+		require.NoError(t, err)
+		require.Len(t, listResp.Shortcuts, 1)
+		require.Equal(t, shortcut.Name, listResp.Shortcuts[0].Name)
+
+		_, err = ts.Service.GetShortcut(strangerCtx, &v1pb.GetShortcutRequest{Name: shortcut.Name})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "permission denied")
+		listResp, err = ts.Service.ListSharedShortcuts(strangerCtx, &v1pb.ListSharedShortcutsRequest{})
+		require.NoError(t, err)
+		require.Empty(t, listResp.Shortcuts)
+
+		// Making the shortcut private again revokes the access.
+		_, err = ts.Service.UpdateShortcut(ownerCtx, &v1pb.UpdateShortcutRequest{
+			Shortcut:   &v1pb.Shortcut{Name: shortcut.Name, Visibility: v1pb.Shortcut_PRIVATE},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility", "shared_users"}},
+		})
+		require.NoError(t, err)
+		_, err = ts.Service.GetShortcut(readerCtx, &v1pb.GetShortcutRequest{Name: shortcut.Name})
+		require.Error(t, err)
+	})
+
+	t.Run("Only admins publish shortcuts to the workspace", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		admin, err := ts.CreateHostUser(ctx, "admin")
+		require.NoError(t, err)
+		user, err := ts.CreateRegularUser(ctx, "user")
+		require.NoError(t, err)
+		adminCtx := ts.CreateUserContext(ctx, admin.ID)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+
+		_, err = ts.Service.CreateShortcut(userCtx, &v1pb.CreateShortcutRequest{
+			Parent:   fmt.Sprintf("users/%d", user.ID),
+			Shortcut: &v1pb.Shortcut{Title: "Pinned", Filter: `pinned`, Visibility: v1pb.Shortcut_WORKSPACE},
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "only admins")
+
+		shortcut, err := ts.Service.CreateShortcut(adminCtx, &v1pb.CreateShortcutRequest{
+			Parent:   fmt.Sprintf("users/%d", admin.ID),
+			Shortcut: &v1pb.Shortcut{Title: "Pinned", Filter: `pinned`, Visibility: v1pb.Shortcut_WORKSPACE},
+		})
+		require.NoError(t, err)
+
+		got, err := ts.Service.GetShortcut(userCtx, &v1pb.GetShortcutRequest{Name: shortcut.Name})
+		require.NoError(t, err)
+		require.Equal(t, v1pb.Shortcut_WORKSPACE, got.Visibility)
+		listResp, err := ts.Service.ListSharedShortcuts(userCtx, &v1pb.ListSharedShortcutsRequest{})
+		require.NoError(t, err)
+		require.Len(t, listResp.Shortcuts, 1)
+
+		// The own shortcuts of the admin are not listed as shared.
+		listResp, err = ts.Service.ListSharedShortcuts(adminCtx, &v1pb.ListSharedShortcutsRequest{})
+		require.NoError(t, err)
+		require.Empty(t, listResp.Shortcuts)
+	})
+
+	t.Run("Shortcut sharing validation", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user, err := ts.CreateRegularUser(ctx, "user")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		parent := fmt.Sprintf("users/%d", user.ID)
+
+		for name, shortcut := range map[string]*v1pb.Shortcut{
+			"shared without users":     {Visibility: v1pb.Shortcut_SHARED},
+			"shared with the owner":    {Visibility: v1pb.Shortcut_SHARED, SharedUsers: []string{parent}},
+			"shared with unknown user": {Visibility: v1pb.Shortcut_SHARED, SharedUsers: []string{"users/999"}},
+			"private with users":       {Visibility: v1pb.Shortcut_PRIVATE, SharedUsers: []string{"users/999"}},
+		} {
+			shortcut.Title = "Test"
+			shortcut.Filter = `pinned`
+			_, err := ts.Service.CreateShortcut(userCtx, &v1pb.CreateShortcutRequest{Parent: parent, Shortcut: shortcut})
+			require.Error(t, err, name)
+		}
+
+		_, err = ts.Service.ListSharedShortcuts(ctx, &v1pb.ListSharedShortcutsRequest{})
+		require.Error(t, err)
+	})
+}
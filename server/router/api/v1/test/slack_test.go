@@ -0,0 +1,161 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/slack"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestSlackApp(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	user, err := ts.CreateRegularUser(ctx, "jane")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	slackName := fmt.Sprintf("users/%d/slack", user.ID)
+
+	// The fake Slack Web API records the unfurls.
+	var mu sync.Mutex
+	unfurls := map[string]map[string]string{}
+	slackAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := struct {
+			Unfurls map[string]map[string]string `json:"unfurls"`
+		}{}
+		_ = json.NewDecoder(r.Body).Decode(&args)
+		mu.Lock()
+		for link, unfurl := range args.Unfurls {
+			unfurls[link] = unfurl
+		}
+		mu.Unlock()
+		fmt.Fprint(w, `{"ok": true}`)
+	}))
+	defer slackAPI.Close()
+
+	// The link codes are only generated if the Slack app is enabled.
+	_, err = ts.Service.GenerateUserSlackLinkCode(userCtx, &v1pb.GenerateUserSlackLinkCodeRequest{Name: slackName})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting: &v1pb.WorkspaceSetting{
+			Name: "workspace/settings/SLACK",
+			Value: &v1pb.WorkspaceSetting_SlackSetting{
+				SlackSetting: &v1pb.WorkspaceSlackSetting{
+					SigningSecret: "signing-secret",
+					BotToken:      "xoxb-token",
+					ApiEndpoint:   slackAPI.URL,
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	e := echo.New()
+	ts.Service.RegisterSlackRoutes(e)
+	send := func(path, contentType, body, secret string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, contentType)
+		now := time.Now()
+		req.Header.Set(slack.TimestampHeader, strconv.FormatInt(now.Unix(), 10))
+		req.Header.Set(slack.SignatureHeader, slack.Sign(secret, now, []byte(body)))
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+	command := func(text string) (int, string) {
+		form := url.Values{"team_id": {"T1"}, "user_id": {"U1"}, "command": {"/memo"}, "text": {text}}
+		rec := send("/slack/commands", echo.MIMEApplicationForm, form.Encode(), "signing-secret")
+		reply := map[string]string{}
+		_ = json.Unmarshal(rec.Body.Bytes(), &reply)
+		return rec.Code, reply["text"]
+	}
+
+	// The requests not signed with the signing secret are rejected.
+	rec := send("/slack/commands", echo.MIMEApplicationForm, "text=hello", "another-secret")
+	require.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	// The memos are only captured once the Slack account is linked with a link code of the user.
+	code, reply := command("Call mom")
+	require.Equal(t, http.StatusOK, code)
+	require.Contains(t, reply, "not linked")
+	code, reply = command("link WRONG")
+	require.Equal(t, http.StatusOK, code)
+	require.Contains(t, reply, "invalid or expired")
+	_, err = ts.Service.GenerateUserSlackLinkCode(hostCtx, &v1pb.GenerateUserSlackLinkCodeRequest{Name: slackName})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	userSlack, err := ts.Service.GenerateUserSlackLinkCode(userCtx, &v1pb.GenerateUserSlackLinkCodeRequest{Name: slackName})
+	require.NoError(t, err)
+	require.Len(t, userSlack.LinkCode, 8)
+	_, reply = command("link " + strings.ToLower(userSlack.LinkCode))
+	require.Contains(t, reply, "linked to jane")
+	userSlack, err = ts.Service.GetUserSlack(userCtx, &v1pb.GetUserSlackRequest{Name: slackName})
+	require.NoError(t, err)
+	require.True(t, userSlack.Linked)
+	require.Equal(t, "U1", userSlack.UserId)
+	require.Empty(t, userSlack.LinkCode)
+
+	// The text of the slash command becomes a memo of the user, with their default visibility.
+	_, reply = command("Call mom #family")
+	require.Contains(t, reply, "Saved the <http://localhost:8080/memos/")
+	memos, err := ts.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	require.Equal(t, "Call mom #family", memos[0].Content)
+	require.Equal(t, store.Private, memos[0].Visibility)
+
+	// The links of the public memos are unfurled, the others are not.
+	publicMemo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "# Release notes\n\nThe **new** version is out.", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	publicLink := "http://localhost:8080/" + publicMemo.Name
+	privateLink := "http://localhost:8080/memos/" + memos[0].UID
+	rec = send("/slack/events", echo.MIMEApplicationJSON, `{"type":"url_verification","challenge":"abc"}`, "signing-secret")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"challenge":"abc"}`, rec.Body.String())
+	rec = send("/slack/events", echo.MIMEApplicationJSON, fmt.Sprintf(`{"type":"event_callback","event":{"type":"link_shared","channel":"C1","message_ts":"1.2","links":[{"url":%q},{"url":%q}]}}`, publicLink, privateLink), "signing-secret")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(unfurls) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	mu.Lock()
+	require.Len(t, unfurls, 1)
+	require.Equal(t, "Release notes", unfurls[publicLink]["title"])
+	require.Equal(t, "The new version is out.", unfurls[publicLink]["text"])
+	mu.Unlock()
+
+	// Unlinking the Slack account stops the slash command.
+	userSlack, err = ts.Service.UnlinkUserSlack(userCtx, &v1pb.UnlinkUserSlackRequest{Name: slackName})
+	require.NoError(t, err)
+	require.False(t, userSlack.Linked)
+	_, reply = command("Call dad")
+	require.Contains(t, reply, "not linked")
+
+	// The Slack setting holds secrets, so only the host can read it.
+	_, err = ts.Service.GetWorkspaceSetting(userCtx, &v1pb.GetWorkspaceSettingRequest{Name: "workspace/settings/" + storepb.WorkspaceSettingKey_SLACK.String()})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
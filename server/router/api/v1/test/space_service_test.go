@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestSpace(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	owner, err := ts.CreateRegularUser(ctx, "owner")
+	require.NoError(t, err)
+	ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+	viewer, err := ts.CreateRegularUser(ctx, "viewer")
+	require.NoError(t, err)
+	viewerCtx := ts.CreateUserContext(ctx, viewer.ID)
+	outsider, err := ts.CreateRegularUser(ctx, "outsider")
+	require.NoError(t, err)
+	outsiderCtx := ts.CreateUserContext(ctx, outsider.ID)
+
+	space, err := ts.Service.CreateSpace(ownerCtx, &v1pb.CreateSpaceRequest{Space: &v1pb.Space{DisplayName: "Team"}})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.SpaceMember_OWNER, space.Role)
+	viewerMember, err := ts.Service.CreateSpaceMember(ownerCtx, &v1pb.CreateSpaceMemberRequest{
+		Parent:      space.Name,
+		SpaceMember: &v1pb.SpaceMember{User: fmt.Sprintf("users/%d", viewer.ID), Role: v1pb.SpaceMember_VIEWER},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateSpaceMember(viewerCtx, &v1pb.CreateSpaceMemberRequest{
+		Parent:      space.Name,
+		SpaceMember: &v1pb.SpaceMember{User: fmt.Sprintf("users/%d", outsider.ID), Role: v1pb.SpaceMember_VIEWER},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Only the editors create memos in the space.
+	_, err = ts.Service.CreateMemo(viewerCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "viewer memo", Visibility: v1pb.Visibility_PRIVATE, Space: space.Name},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	spaceMemo, err := ts.Service.CreateMemo(ownerCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "space memo", Visibility: v1pb.Visibility_PRIVATE, Space: space.Name},
+	})
+	require.NoError(t, err)
+	require.Equal(t, space.Name, spaceMemo.Space)
+	_, err = ts.Service.CreateMemo(ownerCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "personal memo", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+
+	// The members read the private memos of the space, which stay out of the personal lists.
+	memos, err := ts.Service.ListMemos(viewerCtx, &v1pb.ListMemosRequest{Space: space.Name})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 1)
+	require.Equal(t, spaceMemo.Name, memos.Memos[0].Name)
+	_, err = ts.Service.GetMemo(viewerCtx, &v1pb.GetMemoRequest{Name: spaceMemo.Name})
+	require.NoError(t, err)
+	memos, err = ts.Service.ListMemos(ownerCtx, &v1pb.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, memos.Memos, 1)
+	require.Equal(t, "personal memo", memos.Memos[0].Content)
+	searchResponse, err := ts.Service.SearchMemos(viewerCtx, &v1pb.SearchMemosRequest{Query: "memo", Space: space.Name})
+	require.NoError(t, err)
+	require.Len(t, searchResponse.MemoMatches, 1)
+	require.Equal(t, spaceMemo.Name, searchResponse.MemoMatches[0].Memo)
+	searchResponse, err = ts.Service.SearchMemos(ownerCtx, &v1pb.SearchMemosRequest{Query: "memo"})
+	require.NoError(t, err)
+	require.Len(t, searchResponse.MemoMatches, 1)
+	require.NotEqual(t, spaceMemo.Name, searchResponse.MemoMatches[0].Memo)
+	stats, err := ts.Service.GetUserStats(viewerCtx, &v1pb.GetUserStatsRequest{Name: fmt.Sprintf("users/%d", owner.ID), Space: space.Name})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), stats.TotalMemoCount)
+
+	// The outsiders read nothing of the space.
+	_, err = ts.Service.ListMemos(outsiderCtx, &v1pb.ListMemosRequest{Space: space.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.SearchMemos(outsiderCtx, &v1pb.SearchMemosRequest{Query: "memo", Space: space.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.GetMemo(outsiderCtx, &v1pb.GetMemoRequest{Name: spaceMemo.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The space keeps at least one owner, and can only be deleted once empty.
+	ownerMember := fmt.Sprintf("%s/members/%d", space.Name, owner.ID)
+	_, err = ts.Service.DeleteSpaceMember(ownerCtx, &v1pb.DeleteSpaceMemberRequest{Name: ownerMember})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = ts.Service.UpdateSpaceMember(ownerCtx, &v1pb.UpdateSpaceMemberRequest{
+		SpaceMember: &v1pb.SpaceMember{Name: viewerMember.Name, Role: v1pb.SpaceMember_EDITOR},
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"role"}},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.DeleteSpace(ownerCtx, &v1pb.DeleteSpaceRequest{Name: space.Name})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// The members can leave the space.
+	_, err = ts.Service.DeleteSpaceMember(viewerCtx, &v1pb.DeleteSpaceMemberRequest{Name: viewerMember.Name})
+	require.NoError(t, err)
+	spaces, err := ts.Service.ListSpaces(viewerCtx, &v1pb.ListSpacesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, spaces.Spaces)
+}
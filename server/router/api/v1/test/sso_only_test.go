@@ -0,0 +1,87 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestSSOOnlyMode(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+	require.NoError(t, err)
+	hostUser, err := ts.Store.CreateUser(ctx, &store.User{Username: "host", Role: store.RoleHost, PasswordHash: string(passwordHash)})
+	require.NoError(t, err)
+	_, err = ts.Store.CreateUser(ctx, &store.User{Username: "jane", Role: store.RoleUser, PasswordHash: string(passwordHash)})
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	enableSSOOnly := func() error {
+		_, err := ts.Service.UpdateWorkspaceSetting(hostCtx, &v1pb.UpdateWorkspaceSettingRequest{
+			Setting: &v1pb.WorkspaceSetting{
+				Name: "workspace/settings/GENERAL",
+				Value: &v1pb.WorkspaceSetting_GeneralSetting{
+					GeneralSetting: &v1pb.WorkspaceGeneralSetting{SsoOnly: true},
+				},
+			},
+		})
+		return err
+	}
+
+	// The mode cannot lock everyone out without an identity provider.
+	require.Equal(t, codes.FailedPrecondition, status.Code(enableSSOOnly()))
+	identityProvider, err := ts.Store.CreateIdentityProvider(ctx, &storepb.IdentityProvider{
+		Name: "SSO",
+		Type: storepb.IdentityProvider_OAUTH2,
+		Config: &storepb.IdentityProviderConfig{
+			Config: &storepb.IdentityProviderConfig_Oauth2Config{
+				Oauth2Config: &storepb.OAuth2Config{
+					ClientId:     "client_id",
+					ClientSecret: "client_secret",
+					AuthUrl:      "https://sso.example.com/auth",
+					TokenUrl:     "https://sso.example.com/token",
+					UserInfoUrl:  "https://sso.example.com/user",
+					FieldMapping: &storepb.FieldMapping{Identifier: "login"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, enableSSOOnly())
+
+	// Only the host can still sign in with a password.
+	signInCtx := grpc.NewContextWithServerTransportStream(metadata.NewIncomingContext(ctx, metadata.MD{}), fakeServerTransportStream{})
+	signIn := func(username string) error {
+		_, err := ts.Service.CreateSession(signInCtx, &v1pb.CreateSessionRequest{
+			Credentials: &v1pb.CreateSessionRequest_PasswordCredentials_{
+				PasswordCredentials: &v1pb.CreateSessionRequest_PasswordCredentials{Username: username, Password: "password"},
+			},
+		})
+		return err
+	}
+	require.Equal(t, codes.PermissionDenied, status.Code(signIn("jane")))
+	require.NoError(t, signIn("host"))
+
+	_, err = ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{User: &v1pb.User{Username: "john", Password: "password"}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = ts.Service.DeleteIdentityProvider(hostCtx, &v1pb.DeleteIdentityProviderRequest{
+		Name: fmt.Sprintf("%s%d", apiv1.IdentityProviderNamePrefix, identityProvider.Id),
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -0,0 +1,143 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestTagMetadata(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("TagMetadata CRUD", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user, err := ts.CreateRegularUser(ctx, "testuser")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		parent := fmt.Sprintf("users/%d", user.ID)
+
+		created, err := ts.Service.CreateTagMetadata(userCtx, &v1pb.CreateTagMetadataRequest{
+			Parent: parent,
+			TagMetadata: &v1pb.TagMetadata{
+				Tag:         "/work/project/",
+				Description: "Project notes",
+				Color:       "#3b82f6",
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, fmt.Sprintf("users/%d/tagMetadata/work/project", user.ID), created.Name)
+		require.Equal(t, "work/project", created.Tag)
+
+		// Metadata of the same tag cannot be created twice.
+		_, err = ts.Service.CreateTagMetadata(userCtx, &v1pb.CreateTagMetadataRequest{
+			Parent:      parent,
+			TagMetadata: &v1pb.TagMetadata{Tag: "work/project"},
+		})
+		require.Error(t, err)
+
+		got, err := ts.Service.GetTagMetadata(userCtx, &v1pb.GetTagMetadataRequest{Name: created.Name})
+		require.NoError(t, err)
+		require.Equal(t, "Project notes", got.Description)
+
+		updated, err := ts.Service.UpdateTagMetadata(userCtx, &v1pb.UpdateTagMetadataRequest{
+			TagMetadata: &v1pb.TagMetadata{
+				Name:   created.Name,
+				Icon:   "📁",
+				Pinned: true,
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"icon", "pinned"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "📁", updated.Icon)
+		require.True(t, updated.Pinned)
+		require.Equal(t, "#3b82f6", updated.Color)
+
+		listResp, err := ts.Service.ListTagMetadata(userCtx, &v1pb.ListTagMetadataRequest{Parent: parent})
+		require.NoError(t, err)
+		require.Len(t, listResp.TagMetadata, 1)
+
+		_, err = ts.Service.DeleteTagMetadata(userCtx, &v1pb.DeleteTagMetadataRequest{Name: created.Name})
+		require.NoError(t, err)
+		_, err = ts.Service.GetTagMetadata(userCtx, &v1pb.GetTagMetadataRequest{Name: created.Name})
+		require.Error(t, err)
+	})
+
+	t.Run("TagMetadata invalid color", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user, err := ts.CreateRegularUser(ctx, "testuser")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+
+		_, err = ts.Service.CreateTagMetadata(userCtx, &v1pb.CreateTagMetadataRequest{
+			Parent: fmt.Sprintf("users/%d", user.ID),
+			TagMetadata: &v1pb.TagMetadata{
+				Tag:   "work",
+				Color: "blue",
+			},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("TagMetadata permission denied for different user", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user1, err := ts.CreateRegularUser(ctx, "user1")
+		require.NoError(t, err)
+		user2, err := ts.CreateRegularUser(ctx, "user2")
+		require.NoError(t, err)
+
+		_, err = ts.Service.CreateTagMetadata(ts.CreateUserContext(ctx, user1.ID), &v1pb.CreateTagMetadataRequest{
+			Parent:      fmt.Sprintf("users/%d", user2.ID),
+			TagMetadata: &v1pb.TagMetadata{Tag: "work"},
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "permission denied")
+	})
+
+	t.Run("ListTags includes metadata for the owner", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		user, err := ts.CreateRegularUser(ctx, "testuser")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, user.ID)
+		parent := fmt.Sprintf("users/%d", user.ID)
+
+		_, err = ts.Store.CreateMemo(ctx, &store.Memo{
+			UID:        "test-memo",
+			CreatorID:  user.ID,
+			Content:    "#work",
+			Visibility: store.Public,
+			Payload:    &storepb.MemoPayload{Tags: []string{"work"}},
+		})
+		require.NoError(t, err)
+		_, err = ts.Service.CreateTagMetadata(userCtx, &v1pb.CreateTagMetadataRequest{
+			Parent:      parent,
+			TagMetadata: &v1pb.TagMetadata{Tag: "work", Color: "#ff0000"},
+		})
+		require.NoError(t, err)
+
+		resp, err := ts.Service.ListTags(userCtx, &v1pb.ListTagsRequest{Parent: parent})
+		require.NoError(t, err)
+		require.Len(t, resp.Tags, 1)
+		require.Equal(t, "#ff0000", resp.Tags[0].Metadata.GetColor())
+
+		// Metadata is private to the owner.
+		resp, err = ts.Service.ListTags(ctx, &v1pb.ListTagsRequest{Parent: parent})
+		require.NoError(t, err)
+		require.Len(t, resp.Tags, 1)
+		require.Nil(t, resp.Tags[0].Metadata)
+	})
+}
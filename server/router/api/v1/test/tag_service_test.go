@@ -0,0 +1,475 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestListTags_Tree(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateHostUser(ctx, "test_user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memoTags := [][]string{
+		{"work/project-a", "work/project-b"},
+		{"work"},
+		{"work/project-a/todo"},
+		{"personal"},
+	}
+	for i, tags := range memoTags {
+		_, err := ts.Store.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("test-memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    "test memo",
+			Visibility: store.Public,
+			Payload: &storepb.MemoPayload{
+				Tags: tags,
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	response, err := ts.Service.ListTags(userCtx, &v1pb.ListTagsRequest{
+		Parent: fmt.Sprintf("users/%d", user.ID),
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Tags, 2)
+
+	personal := response.Tags[0]
+	require.Equal(t, "personal", personal.Path)
+	require.Equal(t, int32(1), personal.TotalMemoCount)
+
+	work := response.Tags[1]
+	require.Equal(t, "work", work.Path)
+	require.Equal(t, int32(1), work.MemoCount)
+	require.Equal(t, int32(3), work.TotalMemoCount)
+	require.Len(t, work.Children, 2)
+
+	projectA := work.Children[0]
+	require.Equal(t, "work/project-a", projectA.Path)
+	require.Equal(t, "project-a", projectA.DisplayName)
+	require.Equal(t, int32(1), projectA.MemoCount)
+	require.Equal(t, int32(2), projectA.TotalMemoCount)
+	require.Len(t, projectA.Children, 1)
+	require.Equal(t, "work/project-a/todo", projectA.Children[0].Path)
+}
+
+func TestListTags_Visibility(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateHostUser(ctx, "test_user")
+	require.NoError(t, err)
+
+	_, err = ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "private-memo",
+		CreatorID:  user.ID,
+		Content:    "private memo",
+		Visibility: store.Private,
+		Payload: &storepb.MemoPayload{
+			Tags: []string{"secret"},
+		},
+	})
+	require.NoError(t, err)
+
+	// Anonymous users only see tags of public memos.
+	response, err := ts.Service.ListTags(ctx, &v1pb.ListTagsRequest{
+		Parent: fmt.Sprintf("users/%d", user.ID),
+	})
+	require.NoError(t, err)
+	require.Empty(t, response.Tags)
+}
+
+func TestRenameMemoTag_Subtree(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateHostUser(ctx, "test_user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "test-memo",
+		CreatorID:  user.ID,
+		Content:    "#work #work/project #workshop",
+		Visibility: store.Public,
+		Payload: &storepb.MemoPayload{
+			Tags: []string{"work", "work/project", "workshop"},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.RenameMemoTag(userCtx, &v1pb.RenameMemoTagRequest{
+		Parent: "memos/-",
+		OldTag: "work",
+		NewTag: "job",
+	})
+	require.NoError(t, err)
+
+	memo, err = ts.Store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, "#job #job/project #workshop", memo.Content)
+	require.ElementsMatch(t, []string{"job", "job/project", "workshop"}, memo.Payload.Tags)
+}
+
+func TestRenameTag(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateHostUser(ctx, "test_user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	contents := []string{
+		"#work/project meeting notes",
+		"#work todo",
+		"#personal groceries",
+	}
+	memos := []*store.Memo{}
+	for i, content := range contents {
+		memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("test-memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    content,
+			Visibility: store.Private,
+		})
+		require.NoError(t, err)
+		memos = append(memos, memo)
+	}
+	// Tags are derived from the content by the payload runner, set them up manually here.
+	for i, tags := range [][]string{{"work/project"}, {"work"}, {"personal"}} {
+		require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{
+			ID:      memos[i].ID,
+			Payload: &storepb.MemoPayload{Tags: tags},
+		}))
+	}
+
+	// Dry run only counts the affected memos.
+	response, err := ts.Service.RenameTag(userCtx, &v1pb.RenameTagRequest{
+		Parent:       fmt.Sprintf("users/%d", user.ID),
+		OldTag:       "work",
+		NewTag:       "job",
+		ValidateOnly: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), response.AffectedMemoCount)
+	memo, err := ts.Store.GetMemo(ctx, &store.FindMemo{ID: &memos[0].ID})
+	require.NoError(t, err)
+	require.Equal(t, contents[0], memo.Content)
+
+	response, err = ts.Service.RenameTag(userCtx, &v1pb.RenameTagRequest{
+		Parent: fmt.Sprintf("users/%d", user.ID),
+		OldTag: "work",
+		NewTag: "job",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), response.AffectedMemoCount)
+
+	memo, err = ts.Store.GetMemo(ctx, &store.FindMemo{ID: &memos[0].ID})
+	require.NoError(t, err)
+	require.Equal(t, "#job/project meeting notes", memo.Content)
+	require.Equal(t, []string{"job/project"}, memo.Payload.Tags)
+	memo, err = ts.Store.GetMemo(ctx, &store.FindMemo{ID: &memos[2].ID})
+	require.NoError(t, err)
+	require.Equal(t, contents[2], memo.Content)
+
+	// Other users cannot rename the tags.
+	other, err := ts.CreateRegularUser(ctx, "other_user")
+	require.NoError(t, err)
+	_, err = ts.Service.RenameTag(ts.CreateUserContext(ctx, other.ID), &v1pb.RenameTagRequest{
+		Parent: fmt.Sprintf("users/%d", user.ID),
+		OldTag: "job",
+		NewTag: "work",
+	})
+	require.Error(t, err)
+}
+
+func TestMergeTags(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateHostUser(ctx, "test_user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	contents := []string{
+		"#js #javascript closures",
+		"#JS/react hooks",
+		"#golang channels",
+		"#todo #js later #todo",
+	}
+	memos := []*store.Memo{}
+	for i, content := range contents {
+		memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("test-memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    content,
+			Visibility: store.Private,
+		})
+		require.NoError(t, err)
+		memos = append(memos, memo)
+	}
+	for i, tags := range [][]string{{"js", "javascript"}, {"JS/react"}, {"golang"}, {"todo", "js"}} {
+		require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{
+			ID:      memos[i].ID,
+			Payload: &storepb.MemoPayload{Tags: tags},
+		}))
+	}
+
+	response, err := ts.Service.MergeTags(userCtx, &v1pb.MergeTagsRequest{
+		Parent:     fmt.Sprintf("users/%d", user.ID),
+		SourceTags: []string{"js", "JS"},
+		TargetTag:  "javascript",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(3), response.AffectedMemoCount)
+
+	// The merged tag is not duplicated.
+	memo, err := ts.Store.GetMemo(ctx, &store.FindMemo{ID: &memos[0].ID})
+	require.NoError(t, err)
+	require.Equal(t, "#javascript closures", memo.Content)
+	require.Equal(t, []string{"javascript"}, memo.Payload.Tags)
+	memo, err = ts.Store.GetMemo(ctx, &store.FindMemo{ID: &memos[1].ID})
+	require.NoError(t, err)
+	require.Equal(t, "#javascript/react hooks", memo.Content)
+	memo, err = ts.Store.GetMemo(ctx, &store.FindMemo{ID: &memos[2].ID})
+	require.NoError(t, err)
+	require.Equal(t, contents[2], memo.Content)
+	// The other tags are kept as written, even when repeated.
+	memo, err = ts.Store.GetMemo(ctx, &store.FindMemo{ID: &memos[3].ID})
+	require.NoError(t, err)
+	require.Equal(t, "#todo #javascript later #todo", memo.Content)
+
+	// The source tags of separate memos are all merged.
+	for i, tag := range []string{"a", "b"} {
+		_, err := ts.Store.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("disjoint-memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    "#" + tag + " notes",
+			Visibility: store.Private,
+			Payload:    &storepb.MemoPayload{Tags: []string{tag}},
+		})
+		require.NoError(t, err)
+	}
+	response, err = ts.Service.MergeTags(userCtx, &v1pb.MergeTagsRequest{
+		Parent:     fmt.Sprintf("users/%d", user.ID),
+		SourceTags: []string{"a", "b"},
+		TargetTag:  "c",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), response.AffectedMemoCount)
+
+	// Merging a tag only into itself is rejected.
+	_, err = ts.Service.MergeTags(userCtx, &v1pb.MergeTagsRequest{
+		Parent:     fmt.Sprintf("users/%d", user.ID),
+		SourceTags: []string{"javascript"},
+		TargetTag:  "javascript",
+	})
+	require.Error(t, err)
+}
+
+func TestSuggestTags(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateHostUser(ctx, "test_user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memos := map[string][]string{
+		"#cooking Pasta with tomato sauce":     {"cooking"},
+		"#cooking Tomato soup for the weekend": {"cooking"},
+		"#work Backend release planning":       {"work"},
+	}
+	i := 0
+	for content, tags := range memos {
+		_, err := ts.Store.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("test-memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    content,
+			Visibility: store.Private,
+			Payload:    &storepb.MemoPayload{Tags: tags},
+		})
+		require.NoError(t, err)
+		i++
+	}
+
+	response, err := ts.Service.SuggestTags(userCtx, &v1pb.SuggestTagsRequest{
+		Parent:  fmt.Sprintf("users/%d", user.ID),
+		Content: "Tomato salad",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, response.Suggestions)
+	require.Equal(t, "cooking", response.Suggestions[0].Tag)
+
+	// Tags the content already has are not suggested.
+	response, err = ts.Service.SuggestTags(userCtx, &v1pb.SuggestTagsRequest{
+		Parent:  fmt.Sprintf("users/%d", user.ID),
+		Content: "#cooking Tomato salad",
+	})
+	require.NoError(t, err)
+	for _, suggestion := range response.Suggestions {
+		require.NotEqual(t, "cooking", suggestion.Tag)
+	}
+
+	// Other users cannot use the memos of the user.
+	other, err := ts.CreateRegularUser(ctx, "other_user")
+	require.NoError(t, err)
+	_, err = ts.Service.SuggestTags(ts.CreateUserContext(ctx, other.ID), &v1pb.SuggestTagsRequest{
+		Parent:  fmt.Sprintf("users/%d", user.ID),
+		Content: "Tomato salad",
+	})
+	require.Error(t, err)
+}
+
+func TestListTagStats(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateHostUser(ctx, "test_user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	memoTimes := []struct {
+		createdTs int64
+		tags      []string
+	}{
+		{time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC).Unix(), []string{"work"}},
+		{time.Date(2025, 1, 20, 12, 0, 0, 0, time.UTC).Unix(), []string{"work", "personal"}},
+		{time.Date(2025, 3, 5, 12, 0, 0, 0, time.UTC).Unix(), []string{"work"}},
+	}
+	for i, memoTime := range memoTimes {
+		memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("test-memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    "test memo",
+			Visibility: store.Private,
+			Payload:    &storepb.MemoPayload{Tags: memoTime.tags},
+		})
+		require.NoError(t, err)
+		require.NoError(t, ts.Store.UpdateMemo(ctx, &store.UpdateMemo{
+			ID:        memo.ID,
+			CreatedTs: &memoTime.createdTs,
+		}))
+	}
+
+	response, err := ts.Service.ListTagStats(userCtx, &v1pb.ListTagStatsRequest{
+		Parent: fmt.Sprintf("users/%d", user.ID),
+	})
+	require.NoError(t, err)
+	require.Len(t, response.TagStats, 2)
+	require.Equal(t, "personal", response.TagStats[0].Tag)
+
+	work := response.TagStats[1]
+	require.Equal(t, "work", work.Tag)
+	require.Equal(t, int32(3), work.MemoCount)
+	require.Equal(t, memoTimes[0].createdTs, work.FirstUsedTime.AsTime().Unix())
+	require.Equal(t, memoTimes[2].createdTs, work.LastUsedTime.AsTime().Unix())
+	require.Len(t, work.MonthlyCounts, 2)
+	require.Equal(t, "2025-01", work.MonthlyCounts[0].Month)
+	require.Equal(t, int32(2), work.MonthlyCounts[0].MemoCount)
+	require.Equal(t, "2025-03", work.MonthlyCounts[1].Month)
+
+	// Private memos are not counted for other users.
+	response, err = ts.Service.ListTagStats(ctx, &v1pb.ListTagStatsRequest{
+		Parent: fmt.Sprintf("users/%d", user.ID),
+	})
+	require.NoError(t, err)
+	require.Empty(t, response.TagStats)
+
+	_, err = ts.Service.ListTagStats(userCtx, &v1pb.ListTagStatsRequest{
+		Parent:   fmt.Sprintf("users/%d", user.ID),
+		TimeZone: "Invalid/Zone",
+	})
+	require.Error(t, err)
+}
+
+func TestCheckTagConsistency(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateHostUser(ctx, "test_user")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	drifted, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "drifted-memo",
+		CreatorID:  user.ID,
+		Content:    "#work notes",
+		Visibility: store.Private,
+		Payload:    &storepb.MemoPayload{Tags: []string{"old"}},
+	})
+	require.NoError(t, err)
+	_, err = ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "consistent-memo",
+		CreatorID:  user.ID,
+		Content:    "#personal notes",
+		Visibility: store.Private,
+		Payload:    &storepb.MemoPayload{Tags: []string{"personal"}},
+	})
+	require.NoError(t, err)
+
+	response, err := ts.Service.CheckTagConsistency(userCtx, &v1pb.CheckTagConsistencyRequest{
+		Parent: fmt.Sprintf("users/%d", user.ID),
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Inconsistencies, 1)
+	require.Equal(t, "memos/drifted-memo", response.Inconsistencies[0].Memo)
+	require.Equal(t, []string{"old"}, response.Inconsistencies[0].OrphanedTags)
+	require.Equal(t, []string{"work"}, response.Inconsistencies[0].MissingTags)
+	require.False(t, response.Inconsistencies[0].Repaired)
+
+	response, err = ts.Service.CheckTagConsistency(userCtx, &v1pb.CheckTagConsistencyRequest{
+		Parent: fmt.Sprintf("users/%d", user.ID),
+		Repair: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Inconsistencies, 1)
+	require.True(t, response.Inconsistencies[0].Repaired)
+	memo, err := ts.Store.GetMemo(ctx, &store.FindMemo{ID: &drifted.ID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"work"}, memo.Payload.Tags)
+
+	// Untagged memos can be filtered.
+	_, err = ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "untagged-memo",
+		CreatorID:  user.ID,
+		Content:    "no tags",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	listResponse, err := ts.Service.ListMemos(userCtx, &v1pb.ListMemosRequest{
+		Parent: fmt.Sprintf("users/%d", user.ID),
+		Filter: "!has_tags",
+	})
+	require.NoError(t, err)
+	require.Len(t, listResponse.Memos, 1)
+	require.Equal(t, "memos/untagged-memo", listResponse.Memos[0].Name)
+}
@@ -0,0 +1,161 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestTagShare(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("TagShare grants access to tagged private memos", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		owner, err := ts.CreateRegularUser(ctx, "owner")
+		require.NoError(t, err)
+		grantee, err := ts.CreateRegularUser(ctx, "grantee")
+		require.NoError(t, err)
+		ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+		granteeCtx := ts.CreateUserContext(ctx, grantee.ID)
+
+		for uid, tags := range map[string][]string{
+			"shared-memo":   {"work/project"},
+			"private-memo":  {"personal"},
+			"untagged-memo": nil,
+		} {
+			_, err := ts.Store.CreateMemo(ctx, &store.Memo{
+				UID:        uid,
+				CreatorID:  owner.ID,
+				Content:    "test memo",
+				Visibility: store.Private,
+				Payload:    &storepb.MemoPayload{Tags: tags},
+			})
+			require.NoError(t, err)
+		}
+
+		_, err = ts.Service.GetMemo(granteeCtx, &v1pb.GetMemoRequest{Name: "memos/shared-memo"})
+		require.Error(t, err)
+
+		tagShare, err := ts.Service.CreateTagShare(ownerCtx, &v1pb.CreateTagShareRequest{
+			Parent: fmt.Sprintf("users/%d", owner.ID),
+			TagShare: &v1pb.TagShare{
+				Tag:     "work",
+				Grantee: fmt.Sprintf("users/%d", grantee.ID),
+			},
+		})
+		require.NoError(t, err)
+		require.Empty(t, tagShare.Token)
+
+		memo, err := ts.Service.GetMemo(granteeCtx, &v1pb.GetMemoRequest{Name: "memos/shared-memo"})
+		require.NoError(t, err)
+		require.Equal(t, "memos/shared-memo", memo.Name)
+		_, err = ts.Service.GetMemo(granteeCtx, &v1pb.GetMemoRequest{Name: "memos/private-memo"})
+		require.Error(t, err)
+
+		for _, parent := range []string{"", fmt.Sprintf("users/%d", owner.ID)} {
+			resp, err := ts.Service.ListMemos(granteeCtx, &v1pb.ListMemosRequest{Parent: parent})
+			require.NoError(t, err)
+			require.Len(t, resp.Memos, 1)
+			require.Equal(t, "memos/shared-memo", resp.Memos[0].Name)
+		}
+
+		// Revoking the share removes the access.
+		_, err = ts.Service.DeleteTagShare(ownerCtx, &v1pb.DeleteTagShareRequest{Name: tagShare.Name})
+		require.NoError(t, err)
+		_, err = ts.Service.GetMemo(granteeCtx, &v1pb.GetMemoRequest{Name: "memos/shared-memo"})
+		require.Error(t, err)
+	})
+
+	t.Run("TagShare matches the tags case-sensitively", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		owner, err := ts.CreateRegularUser(ctx, "owner")
+		require.NoError(t, err)
+		grantee, err := ts.CreateRegularUser(ctx, "grantee")
+		require.NoError(t, err)
+		ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+		granteeCtx := ts.CreateUserContext(ctx, grantee.ID)
+
+		for uid, tags := range map[string][]string{
+			"shared-memo":    {"Work/project"},
+			"lowercase-memo": {"work"},
+		} {
+			_, err := ts.Store.CreateMemo(ctx, &store.Memo{
+				UID:        uid,
+				CreatorID:  owner.ID,
+				Content:    "test memo",
+				Visibility: store.Private,
+				Payload:    &storepb.MemoPayload{Tags: tags},
+			})
+			require.NoError(t, err)
+		}
+		_, err = ts.Service.CreateTagShare(ownerCtx, &v1pb.CreateTagShareRequest{
+			Parent: fmt.Sprintf("users/%d", owner.ID),
+			TagShare: &v1pb.TagShare{
+				Tag:     "Work",
+				Grantee: fmt.Sprintf("users/%d", grantee.ID),
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = ts.Service.GetMemo(granteeCtx, &v1pb.GetMemoRequest{Name: "memos/lowercase-memo"})
+		require.Error(t, err)
+		resp, err := ts.Service.ListMemos(granteeCtx, &v1pb.ListMemosRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Memos, 1)
+		require.Equal(t, "memos/shared-memo", resp.Memos[0].Name)
+	})
+
+	t.Run("TagShare link", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		owner, err := ts.CreateRegularUser(ctx, "owner")
+		require.NoError(t, err)
+		ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+
+		for uid, tags := range map[string][]string{
+			"shared-memo":  {"travel"},
+			"private-memo": {"personal"},
+		} {
+			_, err := ts.Store.CreateMemo(ctx, &store.Memo{
+				UID:        uid,
+				CreatorID:  owner.ID,
+				Content:    "test memo",
+				Visibility: store.Private,
+				Payload:    &storepb.MemoPayload{Tags: tags},
+			})
+			require.NoError(t, err)
+		}
+
+		tagShare, err := ts.Service.CreateTagShare(ownerCtx, &v1pb.CreateTagShareRequest{
+			Parent:   fmt.Sprintf("users/%d", owner.ID),
+			TagShare: &v1pb.TagShare{Tag: "travel"},
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, tagShare.Token)
+
+		resp, err := ts.Service.ListSharedTagMemos(ctx, &v1pb.ListSharedTagMemosRequest{Token: tagShare.Token})
+		require.NoError(t, err)
+		require.Len(t, resp.Memos, 1)
+		require.Equal(t, "memos/shared-memo", resp.Memos[0].Name)
+
+		_, err = ts.Service.ListSharedTagMemos(ctx, &v1pb.ListSharedTagMemosRequest{Token: "invalid"})
+		require.Error(t, err)
+
+		listResp, err := ts.Service.ListTagShares(ownerCtx, &v1pb.ListTagSharesRequest{
+			Parent: fmt.Sprintf("users/%d", owner.ID),
+		})
+		require.NoError(t, err)
+		require.Len(t, listResp.TagShares, 1)
+	})
+}
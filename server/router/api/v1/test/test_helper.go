@@ -6,6 +6,8 @@ import (
 
 	"github.com/usememos/memos/internal/profile"
 	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/server/runner/jobqueue"
+	"github.com/usememos/memos/server/runner/scheduler"
 	"github.com/usememos/memos/store"
 	teststore "github.com/usememos/memos/store/test"
 )
@@ -16,6 +18,8 @@ type TestService struct {
 	Store   *store.Store
 	Profile *profile.Profile
 	Secret  string
+	// stopJobQueue stops the job queue running the background jobs of the service.
+	stopJobQueue func()
 }
 
 // NewTestService creates a new test service with SQLite database.
@@ -25,9 +29,10 @@ func NewTestService(t *testing.T) *TestService {
 	// Create a test store with SQLite
 	testStore := teststore.NewTestingStore(ctx, t)
 
-	// Create a test profile
+	// Create a test profile, whose data folder keeps the files written by the tests, e.g. the thumbnails.
 	testProfile := &profile.Profile{
 		Mode:        "dev",
+		Data:        t.TempDir(),
 		Version:     "test-1.0.0",
 		InstanceURL: "http://localhost:8080",
 		Driver:      "sqlite",
@@ -42,16 +47,38 @@ func NewTestService(t *testing.T) *TestService {
 		Store:   testStore,
 	}
 
+	// Run the background jobs of the service, e.g. the thumbnails of the uploads.
+	jobQueue := jobqueue.NewQueue(testStore)
+	service.UseJobQueue(jobQueue)
+	// The tests register the tasks of the scheduler, which is not run on its schedule.
+	service.Scheduler = scheduler.NewScheduler(testStore, jobQueue)
+	jobQueueCtx, cancel := context.WithCancel(ctx)
+	stopped := make(chan struct{})
+	go func() {
+		jobQueue.Run(jobQueueCtx)
+		close(stopped)
+	}()
+
 	return &TestService{
 		Service: service,
 		Store:   testStore,
 		Profile: testProfile,
 		Secret:  secret,
+		stopJobQueue: func() {
+			cancel()
+			<-stopped
+		},
 	}
 }
 
+// StopJobQueue stops the job queue, waiting for its running jobs to finish.
+func (ts *TestService) StopJobQueue() {
+	ts.stopJobQueue()
+}
+
 // Cleanup clears caches and closes resources after test.
 func (ts *TestService) Cleanup() {
+	ts.stopJobQueue()
 	ts.Store.Close()
 	// Note: Owner cache is package-level in parent package, cannot clear from test package
 }
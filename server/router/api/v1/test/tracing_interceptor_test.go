@@ -0,0 +1,84 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestTracingInterceptor(t *testing.T) {
+	ctx := context.Background()
+
+	// The spans are recorded by the global tracer provider, set before the database is opened.
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previousTracerProvider, previousPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() {
+		otel.SetTracerProvider(previousTracerProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	}()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "john")
+	require.NoError(t, err)
+	_, err = ts.Store.CreateMemo(ctx, &store.Memo{UID: "memo", CreatorID: user.ID, Content: "hello", Visibility: store.Public})
+	require.NoError(t, err)
+
+	// The trace of the caller is continued, and the SQL queries of the call are its children.
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	callCtx := metadata.NewIncomingContext(ts.CreateUserContext(ctx, user.ID), metadata.Pairs("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01"))
+	interceptor := apiv1.NewTracingInterceptor()
+	serverInfo := &grpc.UnaryServerInfo{FullMethod: "/memos.api.v1.MemoService/ListMemos"}
+	recorder.Reset()
+	_, err = interceptor.TracingInterceptor(callCtx, &v1pb.ListMemosRequest{}, serverInfo, func(ctx context.Context, request any) (any, error) {
+		return ts.Service.ListMemos(ctx, request.(*v1pb.ListMemosRequest))
+	})
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	var callSpan sdktrace.ReadOnlySpan
+	for _, span := range spans {
+		if span.Name() == serverInfo.FullMethod {
+			callSpan = span
+		}
+	}
+	require.NotNil(t, callSpan)
+	require.Equal(t, traceID, callSpan.SpanContext().TraceID().String())
+	require.Equal(t, "00f067aa0ba902b7", callSpan.Parent().SpanID().String())
+	queries := 0
+	for _, span := range spans {
+		if span.Parent().SpanID() == callSpan.SpanContext().SpanID() {
+			queries++
+		}
+	}
+	require.NotZero(t, queries)
+
+	// The errors of the calls are recorded.
+	recorder.Reset()
+	_, err = interceptor.TracingInterceptor(ctx, &v1pb.GetMemoRequest{}, serverInfo, func(context.Context, any) (any, error) {
+		return nil, status.Errorf(grpccodes.NotFound, "memo not found")
+	})
+	require.Error(t, err)
+	spans = recorder.Ended()
+	require.Len(t, spans, 1)
+	require.Equal(t, codes.Error, spans[0].Status().Code)
+	require.Equal(t, "memo not found", spans[0].Status().Description)
+}
@@ -0,0 +1,179 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/server/runner/accesstokencleanup"
+)
+
+func TestUserAccessTokenRateLimit(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "testuser")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	otherUser, err := ts.CreateRegularUser(ctx, "otheruser")
+	require.NoError(t, err)
+	otherUserCtx := ts.CreateUserContext(ctx, otherUser.ID)
+	parent := fmt.Sprintf("users/%d", user.ID)
+
+	_, err = ts.Service.CreateUserAccessToken(userCtx, &v1pb.CreateUserAccessTokenRequest{
+		Parent:      parent,
+		AccessToken: &v1pb.UserAccessToken{RateLimitPerMinute: -1},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	accessToken, err := ts.Service.CreateUserAccessToken(userCtx, &v1pb.CreateUserAccessTokenRequest{
+		Parent:      parent,
+		AccessToken: &v1pb.UserAccessToken{Description: "script", RateLimitPerMinute: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), accessToken.RateLimitPerMinute)
+
+	interceptor := apiv1.NewGRPCAuthInterceptor(ts.Store, ts.Secret)
+	call := func() error {
+		requestCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+accessToken.AccessToken))
+		_, err := interceptor.AuthenticationInterceptor(requestCtx, nil, &grpc.UnaryServerInfo{FullMethod: "/memos.api.v1.MemoService/CreateMemo"}, func(context.Context, any) (any, error) {
+			return nil, nil
+		})
+		return err
+	}
+	require.NoError(t, call())
+	require.NoError(t, call())
+	require.Equal(t, codes.ResourceExhausted, status.Code(call()))
+
+	// The throttled request is not counted.
+	listResponse, err := ts.Service.ListUserAccessTokens(userCtx, &v1pb.ListUserAccessTokensRequest{Parent: parent})
+	require.NoError(t, err)
+	require.Len(t, listResponse.AccessTokens, 1)
+	require.Equal(t, int64(2), listResponse.AccessTokens[0].RequestCount)
+	require.NotNil(t, listResponse.AccessTokens[0].LastUsedAt)
+
+	// The rate limit is lifted without revoking the access token.
+	_, err = ts.Service.UpdateUserAccessToken(otherUserCtx, &v1pb.UpdateUserAccessTokenRequest{
+		AccessToken: &v1pb.UserAccessToken{Name: accessToken.Name},
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"rate_limit_per_minute"}},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.UpdateUserAccessToken(userCtx, &v1pb.UpdateUserAccessTokenRequest{
+		AccessToken: &v1pb.UserAccessToken{Name: accessToken.Name},
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"expires_at"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	updatedAccessToken, err := ts.Service.UpdateUserAccessToken(userCtx, &v1pb.UpdateUserAccessTokenRequest{
+		AccessToken: &v1pb.UserAccessToken{Name: accessToken.Name},
+		UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"rate_limit_per_minute"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), updatedAccessToken.RateLimitPerMinute)
+	require.Equal(t, "script", updatedAccessToken.Description)
+	require.Equal(t, int64(2), updatedAccessToken.RequestCount)
+	require.NoError(t, call())
+
+	_, err = ts.Service.DeleteUserAccessToken(userCtx, &v1pb.DeleteUserAccessTokenRequest{Name: accessToken.Name})
+	require.NoError(t, err)
+	usages, err := ts.Store.GetUserAccessTokenUsages(ctx, user.ID)
+	require.NoError(t, err)
+	require.Empty(t, usages)
+	require.Equal(t, codes.Unauthenticated, status.Code(call()))
+}
+
+func TestUserAccessTokenPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	user, err := ts.CreateRegularUser(ctx, "testuser")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	parent := fmt.Sprintf("users/%d", user.ID)
+	updatePolicy := func(policy *v1pb.WorkspaceAccessTokenPolicySetting) error {
+		_, err := ts.Service.UpdateWorkspaceSetting(ts.CreateUserContext(ctx, hostUser.ID), &v1pb.UpdateWorkspaceSettingRequest{
+			Setting: &v1pb.WorkspaceSetting{
+				Name:  "workspace/settings/ACCESS_TOKEN_POLICY",
+				Value: &v1pb.WorkspaceSetting_AccessTokenPolicySetting{AccessTokenPolicySetting: policy},
+			},
+		})
+		return err
+	}
+	require.Equal(t, codes.InvalidArgument, status.Code(updatePolicy(&v1pb.WorkspaceAccessTokenPolicySetting{MaxLifetimeDays: -1})))
+	require.NoError(t, updatePolicy(&v1pb.WorkspaceAccessTokenPolicySetting{MaxLifetimeDays: 30, IdleRevocationDays: 7}))
+
+	// The maximum lifetime is the default expiration, and bounds the requested one.
+	_, err = ts.Service.CreateUserAccessToken(userCtx, &v1pb.CreateUserAccessTokenRequest{
+		Parent:      parent,
+		AccessToken: &v1pb.UserAccessToken{ExpiresAt: timestamppb.New(time.Now().AddDate(0, 0, 60))},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	accessToken, err := ts.Service.CreateUserAccessToken(userCtx, &v1pb.CreateUserAccessTokenRequest{
+		Parent:      parent,
+		AccessToken: &v1pb.UserAccessToken{Description: "script"},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, accessToken.ExpiresAt)
+	require.WithinDuration(t, time.Now().AddDate(0, 0, 30), accessToken.ExpiresAt.AsTime(), time.Minute)
+
+	interceptor := apiv1.NewGRPCAuthInterceptor(ts.Store, ts.Secret)
+	call := func(token string) error {
+		requestCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token, "x-forwarded-for", "203.0.113.1, 198.51.100.1"))
+		_, err := interceptor.AuthenticationInterceptor(requestCtx, nil, &grpc.UnaryServerInfo{FullMethod: "/memos.api.v1.MemoService/CreateMemo"}, func(context.Context, any) (any, error) {
+			return nil, nil
+		})
+		return err
+	}
+	require.NoError(t, call(accessToken.AccessToken))
+	listResponse, err := ts.Service.ListUserAccessTokens(userCtx, &v1pb.ListUserAccessTokensRequest{Parent: parent})
+	require.NoError(t, err)
+	require.Len(t, listResponse.AccessTokens, 1)
+	require.Equal(t, "203.0.113.1", listResponse.AccessTokens[0].LastUsedIp)
+
+	// A token issued before the policy, older than the maximum lifetime, is rejected.
+	oldToken := jwt.NewWithClaims(jwt.SigningMethodHS256, &apiv1.ClaimsMessage{
+		Name: user.Username,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:   apiv1.Issuer,
+			Audience: jwt.ClaimStrings{apiv1.AccessTokenAudienceName},
+			IssuedAt: jwt.NewNumericDate(time.Now().AddDate(0, 0, -40)),
+			Subject:  fmt.Sprint(user.ID),
+		},
+	})
+	oldToken.Header["kid"] = apiv1.KeyID
+	oldAccessToken, err := oldToken.SignedString([]byte(ts.Secret))
+	require.NoError(t, err)
+	require.NoError(t, ts.Service.UpsertAccessTokenToStore(ctx, user, &storepb.AccessTokensUserSetting_AccessToken{AccessToken: oldAccessToken}))
+	require.Equal(t, codes.Unauthenticated, status.Code(call(oldAccessToken)))
+
+	// The never used token is idle since it was issued, the used one since its last request.
+	count, err := accesstokencleanup.RevokeIdleAccessTokens(ctx, ts.Store, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	count, err = accesstokencleanup.RevokeIdleAccessTokens(ctx, ts.Store, time.Now().AddDate(0, 0, 8))
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	accessTokens, err := ts.Store.GetUserAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Empty(t, accessTokens)
+	usages, err := ts.Store.GetUserAccessTokenUsages(ctx, user.ID)
+	require.NoError(t, err)
+	require.Empty(t, usages)
+}
@@ -0,0 +1,167 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/totp"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestDeleteUserAccount(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+	require.NoError(t, err)
+	user, err := ts.Store.CreateUser(ctx, &store.User{Username: "testuser", Role: store.RoleUser, PasswordHash: string(passwordHash)})
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	userID := user.ID
+	userName := fmt.Sprintf("users/%d", user.ID)
+	otherUser, err := ts.CreateRegularUser(ctx, "otheruser")
+	require.NoError(t, err)
+	otherUserCtx := ts.CreateUserContext(ctx, otherUser.ID)
+
+	// The user has a memo with a stored attachment, a comment and a reaction on the memo of another user.
+	memo, err := ts.Store.CreateMemo(ctx, &store.Memo{UID: "own-memo", CreatorID: user.ID, Content: "mine", Visibility: store.Private})
+	require.NoError(t, err)
+	filePath := filepath.Join(t.TempDir(), "photo.png")
+	require.NoError(t, os.WriteFile(filePath, []byte("png"), 0644))
+	_, err = ts.Store.CreateAttachment(ctx, &store.Attachment{
+		UID:         "photo",
+		CreatorID:   user.ID,
+		Filename:    "photo.png",
+		Type:        "image/png",
+		Size:        3,
+		StorageType: storepb.AttachmentStorageType_LOCAL,
+		Reference:   filePath,
+		MemoID:      &memo.ID,
+	})
+	require.NoError(t, err)
+	otherMemo, err := ts.Store.CreateMemo(ctx, &store.Memo{UID: "other-memo", CreatorID: otherUser.ID, Content: "theirs", Visibility: store.Public})
+	require.NoError(t, err)
+	comment, err := ts.Service.CreateMemoComment(userCtx, &v1pb.CreateMemoCommentRequest{
+		Name:    fmt.Sprintf("memos/%s", otherMemo.UID),
+		Comment: &v1pb.Memo{Content: "nice", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	_, err = ts.Store.UpsertReaction(ctx, &store.Reaction{CreatorID: user.ID, ContentID: fmt.Sprintf("memos/%s", otherMemo.UID), ReactionType: "👍"})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateUserAccessToken(userCtx, &v1pb.CreateUserAccessTokenRequest{
+		Parent:      userName,
+		AccessToken: &v1pb.UserAccessToken{Description: "script"},
+	})
+	require.NoError(t, err)
+
+	_, err = ts.Service.DeleteUserAccount(otherUserCtx, &v1pb.DeleteUserAccountRequest{Name: userName, ConfirmUsername: "testuser", Password: "password"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.DeleteUserAccount(userCtx, &v1pb.DeleteUserAccountRequest{Name: userName, ConfirmUsername: "otheruser", Password: "password"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.DeleteUserAccount(hostCtx, &v1pb.DeleteUserAccountRequest{Name: fmt.Sprintf("users/%d", hostUser.ID), ConfirmUsername: "host"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	// The user must give their password again.
+	_, err = ts.Service.DeleteUserAccount(userCtx, &v1pb.DeleteUserAccountRequest{Name: userName, ConfirmUsername: "testuser"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.DeleteUserAccount(userCtx, &v1pb.DeleteUserAccountRequest{Name: userName, ConfirmUsername: "testuser", Password: "wrong"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	response, err := ts.Service.DeleteUserAccount(userCtx, &v1pb.DeleteUserAccountRequest{Name: userName, ConfirmUsername: "testuser", Password: "password"})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), response.MemoCount)
+	exportData := &apiv1.ExportData{}
+	require.NoError(t, json.Unmarshal(response.Data, exportData))
+	require.Equal(t, "testuser", exportData.User.Username)
+	require.Len(t, exportData.Memos, 2)
+
+	// Nothing of the user is left, but the memo of the other user.
+	user, err = ts.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	require.NoError(t, err)
+	require.Nil(t, user)
+	memos, err := ts.Store.ListMemos(ctx, &store.FindMemo{})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	require.Equal(t, otherMemo.ID, memos[0].ID)
+	_, err = ts.Service.GetMemo(otherUserCtx, &v1pb.GetMemoRequest{Name: comment.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = os.Stat(filePath)
+	require.True(t, os.IsNotExist(err))
+	attachments, err := ts.Store.ListAttachments(ctx, &store.FindAttachment{})
+	require.NoError(t, err)
+	require.Empty(t, attachments)
+	reactions, err := ts.Store.ListReactions(ctx, &store.FindReaction{})
+	require.NoError(t, err)
+	require.Empty(t, reactions)
+	userSettings, err := ts.Store.ListUserSettings(ctx, &store.FindUserSetting{UserID: &userID})
+	require.NoError(t, err)
+	require.Empty(t, userSettings)
+
+	// The deletion is recorded for the admins only.
+	activities, err := ts.Service.ListActivities(hostCtx, &v1pb.ListActivitiesRequest{})
+	require.NoError(t, err)
+	var deleteActivity *v1pb.Activity
+	for _, activity := range activities.Activities {
+		if activity.Type == v1pb.Activity_USER_DELETE {
+			deleteActivity = activity
+		}
+	}
+	require.NotNil(t, deleteActivity)
+	require.Equal(t, userName, deleteActivity.Payload.GetUserDelete().User)
+	require.Equal(t, int32(1), deleteActivity.Payload.GetUserDelete().AttachmentCount)
+	require.Equal(t, int32(1), deleteActivity.Payload.GetUserDelete().ReactionCount)
+	activities, err = ts.Service.ListActivities(otherUserCtx, &v1pb.ListActivitiesRequest{})
+	require.NoError(t, err)
+	for _, activity := range activities.Activities {
+		require.NotEqual(t, v1pb.Activity_USER_DELETE, activity.Type)
+	}
+}
+
+func TestDeleteUserAccountWithTwoFactor(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+	require.NoError(t, err)
+	user, err := ts.Store.CreateUser(ctx, &store.User{Username: "testuser", Role: store.RoleUser, PasswordHash: string(passwordHash)})
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	userName := fmt.Sprintf("users/%d", user.ID)
+	twoFactorName := userName + "/twoFactor"
+	setup, err := ts.Service.SetupUserTwoFactor(userCtx, &v1pb.SetupUserTwoFactorRequest{Name: twoFactorName})
+	require.NoError(t, err)
+	code, err := totp.GenerateCode(setup.Secret, time.Now())
+	require.NoError(t, err)
+	enabled, err := ts.Service.EnableUserTwoFactor(userCtx, &v1pb.EnableUserTwoFactorRequest{Name: twoFactorName, Code: code})
+	require.NoError(t, err)
+
+	// The password is not enough once the two-factor authentication is enabled.
+	_, err = ts.Service.DeleteUserAccount(userCtx, &v1pb.DeleteUserAccountRequest{Name: userName, ConfirmUsername: "testuser", Password: "password"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.DeleteUserAccount(userCtx, &v1pb.DeleteUserAccountRequest{Name: userName, ConfirmUsername: "testuser", Code: "000000"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.DeleteUserAccount(userCtx, &v1pb.DeleteUserAccountRequest{Name: userName, ConfirmUsername: "testuser", Code: enabled.RecoveryCodes[0]})
+	require.NoError(t, err)
+	deleted, err := ts.Store.GetUser(ctx, &store.FindUser{ID: &user.ID})
+	require.NoError(t, err)
+	require.Nil(t, deleted)
+}
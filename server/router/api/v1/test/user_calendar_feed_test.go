@@ -0,0 +1,72 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/router/calendar"
+)
+
+func TestUserCalendarFeed(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	user, err := ts.CreateRegularUser(ctx, "jane")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	calendarFeedName := fmt.Sprintf("users/%d/calendarFeed", user.ID)
+
+	// The URL of the feed is a secret of the user, hidden from the admins too.
+	_, err = ts.Service.ResetUserCalendarFeed(hostCtx, &v1pb.ResetUserCalendarFeedRequest{Name: calendarFeedName})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	calendarFeed, err := ts.Service.GetUserCalendarFeed(userCtx, &v1pb.GetUserCalendarFeedRequest{Name: calendarFeedName})
+	require.NoError(t, err)
+	require.False(t, calendarFeed.Enabled)
+	require.Empty(t, calendarFeed.Url)
+	calendarFeed, err = ts.Service.ResetUserCalendarFeed(userCtx, &v1pb.ResetUserCalendarFeedRequest{Name: calendarFeedName})
+	require.NoError(t, err)
+	require.True(t, calendarFeed.Enabled)
+	require.True(t, strings.HasPrefix(calendarFeed.Url, "http://localhost:8080/u/jane/calendar.ics?token="))
+
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "- [ ] Pay rent 2025-07-01", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	e := echo.New()
+	calendar.NewCalendarService(ts.Profile, ts.Store).RegisterRoutes(e.Group(""))
+	get := func(url string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, strings.TrimPrefix(url, ts.Profile.InstanceURL), nil))
+		return recorder
+	}
+	recorder := get(calendarFeed.Url)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Contains(t, recorder.Body.String(), "SUMMARY:Pay rent")
+
+	// A new URL replaces the previous one, and the disabled feeds are no longer published.
+	previousURL := calendarFeed.Url
+	calendarFeed, err = ts.Service.ResetUserCalendarFeed(userCtx, &v1pb.ResetUserCalendarFeedRequest{Name: calendarFeedName})
+	require.NoError(t, err)
+	require.NotEqual(t, previousURL, calendarFeed.Url)
+	require.Equal(t, http.StatusNotFound, get(previousURL).Code)
+	require.Equal(t, http.StatusOK, get(calendarFeed.Url).Code)
+	calendarFeed, err = ts.Service.DisableUserCalendarFeed(userCtx, &v1pb.DisableUserCalendarFeedRequest{Name: calendarFeedName})
+	require.NoError(t, err)
+	require.False(t, calendarFeed.Enabled)
+	require.Equal(t, http.StatusNotFound, get(previousURL).Code)
+}
@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"context"
+	"io"
+	"mime/quotedprintable"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/email/emailtest"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+var emailTokenPattern = regexp.MustCompile(`\?token=([\w.-]+)`)
+
+// waitForEmailToken returns the token of the link in the nth email received by the server.
+func waitForEmailToken(t *testing.T, server *emailtest.Server, n int) string {
+	require.Eventually(t, func() bool { return len(server.Emails()) >= n }, 5*time.Second, 10*time.Millisecond)
+	_, encodedBody, _ := strings.Cut(server.Emails()[n-1].Data, "\r\n\r\n")
+	body, err := io.ReadAll(quotedprintable.NewReader(strings.NewReader(encodedBody)))
+	require.NoError(t, err)
+	matches := emailTokenPattern.FindStringSubmatch(string(body))
+	require.Len(t, matches, 2)
+	return matches[1]
+}
+
+func TestEmailVerificationAndPasswordReset(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	server := emailtest.NewServer(t)
+	_, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_EMAIL,
+		Value: &storepb.WorkspaceSetting_EmailSetting{
+			EmailSetting: &storepb.WorkspaceEmailSetting{
+				SmtpHost:                 server.Host,
+				SmtpPort:                 int32(server.Port),
+				FromEmail:                "memos@example.com",
+				RequireEmailVerification: true,
+				AllowPasswordReset:       true,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// Signing up requires a valid email, to verify before signing in.
+	_, err = ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{User: &v1pb.User{Username: "jane", Password: "password"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	user, err := ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{User: &v1pb.User{Username: "jane", Email: "jane@example.com", Password: "password"}})
+	require.NoError(t, err)
+	signInCtx := grpc.NewContextWithServerTransportStream(metadata.NewIncomingContext(ctx, metadata.MD{}), fakeServerTransportStream{})
+	signIn := func(password string) error {
+		_, err := ts.Service.CreateSession(signInCtx, &v1pb.CreateSessionRequest{
+			Credentials: &v1pb.CreateSessionRequest_PasswordCredentials_{
+				PasswordCredentials: &v1pb.CreateSessionRequest_PasswordCredentials{Username: "jane", Password: password},
+			},
+		})
+		return err
+	}
+	require.Equal(t, codes.PermissionDenied, status.Code(signIn("password")))
+
+	verificationToken := waitForEmailToken(t, server, 1)
+	require.Equal(t, []string{"jane@example.com"}, server.Emails()[0].To)
+	_, err = ts.Service.VerifyEmail(ctx, &v1pb.VerifyEmailRequest{Token: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.VerifyEmail(ctx, &v1pb.VerifyEmailRequest{Token: verificationToken})
+	require.NoError(t, err)
+	require.NoError(t, signIn("password"))
+
+	// The verification token cannot reset the password.
+	_, err = ts.Service.ResetPassword(ctx, &v1pb.ResetPasswordRequest{Token: verificationToken, Password: "new password"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The reset of an unknown email succeeds without sending anything.
+	_, err = ts.Service.RequestPasswordReset(ctx, &v1pb.RequestPasswordResetRequest{Email: "unknown@example.com"})
+	require.NoError(t, err)
+	_, err = ts.Service.RequestPasswordReset(ctx, &v1pb.RequestPasswordResetRequest{Email: "jane@example.com"})
+	require.NoError(t, err)
+	resetToken := waitForEmailToken(t, server, 2)
+	require.Len(t, server.Emails(), 2)
+	_, err = ts.Service.ResetPassword(ctx, &v1pb.ResetPasswordRequest{Token: resetToken, Password: "new password"})
+	require.NoError(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(signIn("password")))
+	require.NoError(t, signIn("new password"))
+
+	// The token is void once the password has changed.
+	_, err = ts.Service.ResetPassword(ctx, &v1pb.ResetPasswordRequest{Token: resetToken, Password: "another password"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	userID, err := apiv1.ExtractUserIDFromName(user.Name)
+	require.NoError(t, err)
+	storedUser, err := ts.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	require.NoError(t, err)
+	require.NoError(t, bcrypt.CompareHashAndPassword([]byte(storedUser.PasswordHash), []byte("new password")))
+}
@@ -0,0 +1,118 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+)
+
+func TestUserImpersonation(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := grpc.NewContextWithServerTransportStream(metadata.NewIncomingContext(ts.CreateUserContext(ctx, hostUser.ID), metadata.MD{}), fakeServerTransportStream{})
+	user, err := ts.CreateRegularUser(ctx, "testuser")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	userName := fmt.Sprintf("users/%d", user.ID)
+
+	_, err = ts.Service.CreateImpersonationSession(userCtx, &v1pb.CreateImpersonationSessionRequest{User: fmt.Sprintf("users/%d", hostUser.ID)})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.CreateImpersonationSession(hostCtx, &v1pb.CreateImpersonationSessionRequest{User: fmt.Sprintf("users/%d", hostUser.ID)})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.CreateImpersonationSession(hostCtx, &v1pb.CreateImpersonationSessionRequest{
+		User:       userName,
+		ExpireTime: timestamppb.New(time.Now().Add(24 * time.Hour)),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	response, err := ts.Service.CreateImpersonationSession(hostCtx, &v1pb.CreateImpersonationSessionRequest{User: userName})
+	require.NoError(t, err)
+	require.Equal(t, userName, response.User.Name)
+
+	sessions, err := ts.Store.GetUserSessions(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, hostUser.ID, sessions[0].ImpersonatorId)
+	require.NotNil(t, sessions[0].ExpireTime)
+
+	interceptor := apiv1.NewGRPCAuthInterceptor(ts.Store, ts.Secret)
+	call := func(sessionID, method string, handler grpc.UnaryHandler) (any, error) {
+		requestCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("cookie", fmt.Sprintf("%s=%d-%s", apiv1.SessionCookieName, user.ID, sessionID)))
+		requestCtx = grpc.NewContextWithServerTransportStream(requestCtx, fakeServerTransportStream{})
+		return interceptor.AuthenticationInterceptor(requestCtx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	}
+	noop := func(context.Context, any) (any, error) {
+		return nil, nil
+	}
+
+	// The impersonation session is read-only, and does not reveal the credentials of the user.
+	sessionID := sessions[0].SessionId
+	_, err = call(sessionID, "/memos.api.v1.MemoService/ListMemos", noop)
+	require.NoError(t, err)
+	_, err = call(sessionID, "/memos.api.v1.MemoService/CreateMemo", noop)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	for _, method := range []string{
+		"/memos.api.v1.UserService/ListUserAccessTokens",
+		"/memos.api.v1.UserService/GetUserCalendarFeed",
+		"/memos.api.v1.WebhookService/ListWebhooks",
+		"/memos.api.v1.WebhookService/GetWebhook",
+		"/memos.api.v1.MemoService/ListMemoShares",
+		"/memos.api.v1.TagService/ListTagShares",
+		"/memos.api.v1.UserService/GetUserMemoEmail",
+		"/memos.api.v1.UserService/ListUserSessions",
+		"/memos.api.v1.UserService/ListUserWebPushSubscriptions",
+		// The read methods are denied until reviewed.
+		"/memos.api.v1.UserService/GetUserUnreviewed",
+	} {
+		_, err = call(sessionID, method, noop)
+		require.Equal(t, codes.PermissionDenied, status.Code(err), method)
+	}
+	currentSession, err := call(sessionID, "/memos.api.v1.AuthService/GetCurrentSession", func(ctx context.Context, _ any) (any, error) {
+		return ts.Service.GetCurrentSession(ctx, &v1pb.GetCurrentSessionRequest{})
+	})
+	require.NoError(t, err)
+	require.Equal(t, userName, currentSession.(*v1pb.GetCurrentSessionResponse).User.Name)
+	require.Equal(t, fmt.Sprintf("users/%d", hostUser.ID), currentSession.(*v1pb.GetCurrentSessionResponse).Impersonator)
+
+	// The start and the end of the impersonation are only visible to the host.
+	_, err = call(sessionID, "/memos.api.v1.AuthService/DeleteSession", func(ctx context.Context, _ any) (any, error) {
+		return ts.Service.DeleteSession(ctx, &v1pb.DeleteSessionRequest{})
+	})
+	require.NoError(t, err)
+	activities, err := ts.Service.ListActivities(hostCtx, &v1pb.ListActivitiesRequest{})
+	require.NoError(t, err)
+	require.Len(t, activities.Activities, 2)
+	for _, activity := range activities.Activities {
+		require.Equal(t, fmt.Sprintf("users/%d", hostUser.ID), activity.Creator)
+		require.Equal(t, userName, activity.Payload.GetUserImpersonation().User)
+	}
+	activities, err = ts.Service.ListActivities(userCtx, &v1pb.ListActivitiesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, activities.Activities)
+
+	// The impersonation session expires at its expiration, even if accessed since.
+	require.NoError(t, ts.Store.AddUserSession(ctx, user.ID, &storepb.SessionsUserSetting_Session{
+		SessionId:        "expired",
+		LastAccessedTime: timestamppb.Now(),
+		ImpersonatorId:   hostUser.ID,
+		ExpireTime:       timestamppb.New(time.Now().Add(-time.Minute)),
+	}))
+	_, err = call("expired", "/memos.api.v1.MemoService/CreateMemo", noop)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,82 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestInvitation(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	adminUser, err := ts.Store.CreateUser(ctx, &store.User{Username: "admin", Role: store.RoleAdmin})
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, adminUser.ID)
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_GENERAL,
+		Value: &storepb.WorkspaceSetting_GeneralSetting{
+			GeneralSetting: &storepb.WorkspaceGeneralSetting{DisallowUserRegistration: true},
+		},
+	})
+	require.NoError(t, err)
+
+	signUp := func(username, invitationToken string) (*v1pb.User, error) {
+		return ts.Service.CreateUser(ctx, &v1pb.CreateUserRequest{
+			User:            &v1pb.User{Username: username, Password: "password"},
+			InvitationToken: invitationToken,
+		})
+	}
+	_, err = signUp("jane", "")
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = signUp("jane", "unknown")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Only the host can invite admins.
+	_, err = ts.Service.CreateInvitation(adminCtx, &v1pb.CreateInvitationRequest{Invitation: &v1pb.Invitation{Role: v1pb.User_ADMIN}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	adminInvitation, err := ts.Service.CreateInvitation(hostCtx, &v1pb.CreateInvitationRequest{Invitation: &v1pb.Invitation{Role: v1pb.User_ADMIN, MaxUses: 1}})
+	require.NoError(t, err)
+	require.NotEmpty(t, adminInvitation.Token)
+	// A failed sign up does not use the invitation.
+	_, err = signUp("admin", adminInvitation.Token)
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	user, err := signUp("jane", adminInvitation.Token)
+	require.NoError(t, err)
+	require.Equal(t, v1pb.User_ADMIN, user.Role)
+	_, err = signUp("john", adminInvitation.Token)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	userInvitation, err := ts.Service.CreateInvitation(adminCtx, &v1pb.CreateInvitationRequest{Invitation: &v1pb.Invitation{
+		ExpireTime: timestamppb.New(time.Now().Add(time.Hour)),
+	}})
+	require.NoError(t, err)
+	user, err = signUp("john", userInvitation.Token)
+	require.NoError(t, err)
+	require.Equal(t, v1pb.User_USER, user.Role)
+
+	invitations, err := ts.Service.ListInvitations(adminCtx, &v1pb.ListInvitationsRequest{})
+	require.NoError(t, err)
+	require.Len(t, invitations.Invitations, 2)
+	require.Equal(t, int32(1), invitations.Invitations[1].UseCount)
+
+	// A revoked invitation no longer allows signing up.
+	_, err = ts.Service.DeleteInvitation(adminCtx, &v1pb.DeleteInvitationRequest{Name: userInvitation.Name})
+	require.NoError(t, err)
+	_, err = signUp("alice", userInvitation.Token)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
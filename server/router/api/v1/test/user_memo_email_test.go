@@ -0,0 +1,161 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/mailin"
+	"github.com/usememos/memos/plugin/mailin/imaptest"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/imapingest"
+	"github.com/usememos/memos/store"
+)
+
+func TestUserMemoEmail(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	user, err := ts.CreateRegularUser(ctx, "jane")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	memoEmailName := fmt.Sprintf("users/%d/memoEmail", user.ID)
+
+	// The addresses are only generated if the instance receives emails.
+	_, err = ts.Service.ResetUserMemoEmail(userCtx, &v1pb.ResetUserMemoEmailRequest{Name: memoEmailName})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	ts.Profile.SMTPAddr = "127.0.0.1:0"
+	ts.Profile.SMTPDomain = "memos.test"
+
+	// The address is a secret of the user, hidden from the admins too.
+	_, err = ts.Service.ResetUserMemoEmail(hostCtx, &v1pb.ResetUserMemoEmailRequest{Name: memoEmailName})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	memoEmail, err := ts.Service.GetUserMemoEmail(userCtx, &v1pb.GetUserMemoEmailRequest{Name: memoEmailName})
+	require.NoError(t, err)
+	require.False(t, memoEmail.Enabled)
+	memoEmail, err = ts.Service.ResetUserMemoEmail(userCtx, &v1pb.ResetUserMemoEmailRequest{Name: memoEmailName})
+	require.NoError(t, err)
+	require.True(t, memoEmail.Enabled)
+	require.True(t, strings.HasSuffix(memoEmail.Address, "@memos.test"))
+	address := memoEmail.Address
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	serverCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	server := &mailin.Server{Hostname: "memos.test", Handler: ts.Service.NewMemoEmailHandler()}
+	go func() {
+		_ = server.Serve(serverCtx, listener)
+	}()
+	sendMail := func(to string) error {
+		message := "From: jane@example.com\r\n" +
+			"Subject: Groceries\r\n" +
+			"Content-Type: multipart/mixed; boundary=\"mixed\"\r\n" +
+			"\r\n" +
+			"--mixed\r\n" +
+			"Content-Type: text/plain\r\n" +
+			"\r\n" +
+			"Milk and #coffee\r\n" +
+			"--mixed\r\n" +
+			"Content-Type: text/plain\r\n" +
+			"Content-Disposition: attachment; filename=\"list.txt\"\r\n" +
+			"\r\n" +
+			"beans\r\n" +
+			"--mixed--\r\n"
+		return smtp.SendMail(listener.Addr().String(), nil, "jane@example.com", []string{to}, []byte(message))
+	}
+
+	// The subject and the text of the email become the memo, and its attachments the attachments of the memo.
+	// The subaddresses reach the user too.
+	require.NoError(t, sendMail(strings.Replace(address, "@", "+shopping@", 1)))
+	memos, err := ts.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	require.Equal(t, "# Groceries\n\nMilk and #coffee", memos[0].Content)
+	require.Equal(t, store.Private, memos[0].Visibility)
+	require.Equal(t, []string{"coffee"}, memos[0].Payload.Tags)
+	attachments, err := ts.Store.ListAttachments(ctx, &store.FindAttachment{MemoID: &memos[0].ID})
+	require.NoError(t, err)
+	require.Len(t, attachments, 1)
+	require.Equal(t, "list.txt", attachments[0].Filename)
+
+	// The unknown addresses are rejected.
+	require.ErrorContains(t, sendMail("unknown@memos.test"), "550")
+
+	// Resetting the address replaces the previous one, and disabling it removes it.
+	memoEmail, err = ts.Service.ResetUserMemoEmail(userCtx, &v1pb.ResetUserMemoEmailRequest{Name: memoEmailName})
+	require.NoError(t, err)
+	require.NotEqual(t, address, memoEmail.Address)
+	require.ErrorContains(t, sendMail(address), "550")
+	memoEmail, err = ts.Service.DisableUserMemoEmail(userCtx, &v1pb.DisableUserMemoEmailRequest{Name: memoEmailName})
+	require.NoError(t, err)
+	require.False(t, memoEmail.Enabled)
+	require.Empty(t, memoEmail.Address)
+}
+
+func TestIMAPIngestion(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	_, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	user, err := ts.CreateRegularUser(ctx, "jane")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+
+	mailbox := imaptest.NewServer(t, "memos@example.com", "secret")
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_EMAIL,
+		Value: &storepb.WorkspaceSetting_EmailSetting{
+			EmailSetting: &storepb.WorkspaceEmailSetting{
+				ImapHost:     mailbox.Host,
+				ImapPort:     int32(mailbox.Port),
+				ImapUsername: mailbox.Username,
+				ImapPassword: mailbox.Password,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// The addresses are the subaddresses of the IMAP mailbox.
+	memoEmail, err := ts.Service.ResetUserMemoEmail(userCtx, &v1pb.ResetUserMemoEmailRequest{Name: fmt.Sprintf("users/%d/memoEmail", user.ID)})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(memoEmail.Address, "memos+"))
+	require.True(t, strings.HasSuffix(memoEmail.Address, "@example.com"))
+
+	mailbox.Deliver("INBOX", "From: jane@example.com\r\nTo: "+memoEmail.Address+"\r\nSubject: Call mom\r\n\r\nOn Sunday.\r\n")
+	mailbox.Deliver("INBOX", "From: spam@example.com\r\nTo: memos@example.com\r\nSubject: Offer\r\n\r\nBuy now.\r\n")
+	runner := imapingest.NewRunner(ts.Store, ts.Service.NewMemoEmailHandler())
+	runner.RunOnce(ctx)
+
+	// The emails to the users are created as memos and archived, the others are marked as seen.
+	memos, err := ts.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	require.Equal(t, "# Call mom\n\nOn Sunday.", memos[0].Content)
+	inbox := mailbox.Emails("INBOX")
+	require.Len(t, inbox, 1)
+	require.True(t, inbox[0].Seen)
+	require.Len(t, mailbox.Emails("Archive"), 1)
+
+	// The processed emails are not created again.
+	runner.RunOnce(ctx)
+	memos, err = ts.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+}
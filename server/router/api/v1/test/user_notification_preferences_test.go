@@ -0,0 +1,73 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestUserNotificationPreferences(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	author, err := ts.CreateRegularUser(ctx, "author")
+	require.NoError(t, err)
+	commenter, err := ts.CreateRegularUser(ctx, "commenter")
+	require.NoError(t, err)
+	authorCtx := ts.CreateUserContext(ctx, author.ID)
+	commenterCtx := ts.CreateUserContext(ctx, commenter.ID)
+	name := fmt.Sprintf("users/%d/notificationPreferences", author.ID)
+
+	_, err = ts.Service.GetUserNotificationPreferences(commenterCtx, &v1pb.GetUserNotificationPreferencesRequest{Name: name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The events notify with the inbox and the push by default.
+	preferences, err := ts.Service.GetUserNotificationPreferences(authorCtx, &v1pb.GetUserNotificationPreferencesRequest{Name: name})
+	require.NoError(t, err)
+	require.Len(t, preferences.Preferences, 6)
+	for _, preference := range preferences.Preferences {
+		require.True(t, preference.Inbox)
+		require.True(t, preference.Push)
+		require.False(t, preference.Email)
+	}
+
+	_, err = ts.Service.UpdateUserNotificationPreferences(authorCtx, &v1pb.UpdateUserNotificationPreferencesRequest{
+		NotificationPreferences: &v1pb.UserNotificationPreferences{
+			Name:        name,
+			Preferences: []*v1pb.UserNotificationPreferences_Preference{{Event: v1pb.UserNotificationPreferences_EVENT_UNSPECIFIED}},
+		},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	preferences, err = ts.Service.UpdateUserNotificationPreferences(authorCtx, &v1pb.UpdateUserNotificationPreferencesRequest{
+		NotificationPreferences: &v1pb.UserNotificationPreferences{
+			Name:        name,
+			Preferences: []*v1pb.UserNotificationPreferences_Preference{{Event: v1pb.UserNotificationPreferences_COMMENT, Email: true}},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.UserNotificationPreferences_COMMENT, preferences.Preferences[0].Event)
+	require.False(t, preferences.Preferences[0].Inbox)
+	require.True(t, preferences.Preferences[0].Email)
+	// The preferences of the other events are kept.
+	require.True(t, preferences.Preferences[1].Inbox)
+
+	// The comments no longer create inbox messages.
+	memo, err := ts.Store.CreateMemo(ctx, &store.Memo{UID: "memo", CreatorID: author.ID, Content: "Hello", Visibility: store.Public})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemoComment(commenterCtx, &v1pb.CreateMemoCommentRequest{
+		Name:    fmt.Sprintf("memos/%s", memo.UID),
+		Comment: &v1pb.Memo{Content: "Nice!", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+	inboxes, err := ts.Store.ListInboxes(ctx, &store.FindInbox{ReceiverID: &author.ID})
+	require.NoError(t, err)
+	require.Empty(t, inboxes)
+}
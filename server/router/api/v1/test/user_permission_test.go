@@ -0,0 +1,115 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestCustomRoles(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	adminUser, err := ts.Store.CreateUser(ctx, &store.User{Username: "admin", Role: store.RoleAdmin})
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, adminUser.ID)
+	author, err := ts.CreateRegularUser(ctx, "author")
+	require.NoError(t, err)
+	moderator, err := ts.CreateRegularUser(ctx, "moderator")
+	require.NoError(t, err)
+	moderatorCtx := ts.CreateUserContext(ctx, moderator.ID)
+	moderatorName := fmt.Sprintf("users/%d", moderator.ID)
+	memo, err := ts.Store.CreateMemo(ctx, &store.Memo{UID: "spam", CreatorID: author.ID, Content: "spam", Visibility: store.Public})
+	require.NoError(t, err)
+
+	// Only the host defines the roles.
+	updateRoles := func(ctx context.Context, roles ...*v1pb.WorkspaceRolesSetting_CustomRole) error {
+		_, err := ts.Service.UpdateWorkspaceSetting(ctx, &v1pb.UpdateWorkspaceSettingRequest{
+			Setting: &v1pb.WorkspaceSetting{
+				Name:  "workspace/settings/ROLES",
+				Value: &v1pb.WorkspaceSetting_RolesSetting{RolesSetting: &v1pb.WorkspaceRolesSetting{Roles: roles}},
+			},
+		})
+		return err
+	}
+	moderatorRole := &v1pb.WorkspaceRolesSetting_CustomRole{Id: "moderator", Title: "Moderator", Permissions: []v1pb.Permission{v1pb.Permission_MODERATE_MEMOS}}
+	storageRole := &v1pb.WorkspaceRolesSetting_CustomRole{Id: "storage", Title: "Storage", Permissions: []v1pb.Permission{v1pb.Permission_MANAGE_STORAGE}}
+	require.Equal(t, codes.PermissionDenied, status.Code(updateRoles(adminCtx, moderatorRole)))
+	require.Equal(t, codes.InvalidArgument, status.Code(updateRoles(hostCtx, moderatorRole, moderatorRole)))
+	require.NoError(t, updateRoles(hostCtx, moderatorRole, storageRole))
+
+	// The settings holding credentials, e.g. the SCIM token, are only updated by the host, as they are only read by it.
+	_, err = ts.Service.UpdateWorkspaceSetting(adminCtx, &v1pb.UpdateWorkspaceSettingRequest{
+		Setting: &v1pb.WorkspaceSetting{
+			Name:  "workspace/settings/SCIM",
+			Value: &v1pb.WorkspaceSetting_ScimSetting{ScimSetting: &v1pb.WorkspaceSCIMSetting{Token: "stolen"}},
+		},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	updateMemo := func() error {
+		_, err := ts.Service.UpdateMemo(moderatorCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: "memos/" + memo.UID, Visibility: v1pb.Visibility_PRIVATE},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+		})
+		return err
+	}
+	require.Equal(t, codes.PermissionDenied, status.Code(updateMemo()))
+
+	// The admins assign the roles within their own permissions only.
+	_, err = ts.Service.SetUserCustomRole(adminCtx, &v1pb.SetUserCustomRoleRequest{Name: moderatorName, CustomRole: "storage"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.SetUserCustomRole(adminCtx, &v1pb.SetUserCustomRoleRequest{Name: moderatorName, CustomRole: "unknown"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	permissions, err := ts.Service.SetUserCustomRole(adminCtx, &v1pb.SetUserCustomRoleRequest{Name: moderatorName, CustomRole: "moderator"})
+	require.NoError(t, err)
+	require.Equal(t, "moderator", permissions.CustomRole)
+	require.Equal(t, []v1pb.Permission{v1pb.Permission_MODERATE_MEMOS}, permissions.Permissions)
+	require.NoError(t, updateMemo())
+	permissions, err = ts.Service.GetUserPermissions(moderatorCtx, &v1pb.GetUserPermissionsRequest{Name: moderatorName + "/permissions"})
+	require.NoError(t, err)
+	require.Equal(t, []v1pb.Permission{v1pb.Permission_MODERATE_MEMOS}, permissions.Permissions)
+
+	// The storage role allows the storage migration endpoints, which the admins cannot call.
+	interceptor := apiv1.NewGRPCAuthInterceptor(ts.Store, ts.Secret)
+	call := func(ctx context.Context, method string) error {
+		_, err := interceptor.AuthenticationInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, any) (any, error) {
+			return nil, nil
+		})
+		return err
+	}
+	accessToken, err := ts.Service.CreateUserAccessToken(moderatorCtx, &v1pb.CreateUserAccessTokenRequest{
+		Parent:      moderatorName,
+		AccessToken: &v1pb.UserAccessToken{Description: "script"},
+	})
+	require.NoError(t, err)
+	requestCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+accessToken.AccessToken))
+	const migrationMethod = "/memos.api.v1.AttachmentService/GetAttachmentStorageMigration"
+	require.Error(t, call(requestCtx, migrationMethod))
+	_, err = ts.Service.SetUserCustomRole(hostCtx, &v1pb.SetUserCustomRoleRequest{Name: moderatorName, CustomRole: "storage"})
+	require.NoError(t, err)
+	require.NoError(t, call(requestCtx, migrationMethod))
+	// The admins cannot take away a role with more permissions than theirs.
+	_, err = ts.Service.SetUserCustomRole(adminCtx, &v1pb.SetUserCustomRoleRequest{Name: moderatorName})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// A removed role grants nothing.
+	require.NoError(t, updateRoles(hostCtx, moderatorRole))
+	require.Error(t, call(requestCtx, migrationMethod))
+}
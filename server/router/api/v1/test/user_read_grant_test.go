@@ -0,0 +1,135 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestUserReadGrant(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ReadGrant without tag grants access to all private memos", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		owner, err := ts.CreateRegularUser(ctx, "owner")
+		require.NoError(t, err)
+		assistant, err := ts.CreateRegularUser(ctx, "assistant")
+		require.NoError(t, err)
+		outsider, err := ts.CreateRegularUser(ctx, "outsider")
+		require.NoError(t, err)
+		ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+		assistantCtx := ts.CreateUserContext(ctx, assistant.ID)
+		outsiderCtx := ts.CreateUserContext(ctx, outsider.ID)
+
+		for _, uid := range []string{"memo-one", "memo-two"} {
+			_, err := ts.Store.CreateMemo(ctx, &store.Memo{
+				UID:        uid,
+				CreatorID:  owner.ID,
+				Content:    "private memo",
+				Visibility: store.Private,
+			})
+			require.NoError(t, err)
+		}
+
+		_, err = ts.Service.CreateUserReadGrant(outsiderCtx, &v1pb.CreateUserReadGrantRequest{
+			Parent:    fmt.Sprintf("users/%d", owner.ID),
+			ReadGrant: &v1pb.UserReadGrant{Grantee: fmt.Sprintf("users/%d", outsider.ID)},
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		readGrant, err := ts.Service.CreateUserReadGrant(ownerCtx, &v1pb.CreateUserReadGrantRequest{
+			Parent:    fmt.Sprintf("users/%d", owner.ID),
+			ReadGrant: &v1pb.UserReadGrant{Grantee: fmt.Sprintf("users/%d", assistant.ID)},
+		})
+		require.NoError(t, err)
+		_, err = ts.Service.CreateUserReadGrant(ownerCtx, &v1pb.CreateUserReadGrantRequest{
+			Parent:    fmt.Sprintf("users/%d", owner.ID),
+			ReadGrant: &v1pb.UserReadGrant{Grantee: fmt.Sprintf("users/%d", assistant.ID)},
+		})
+		require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+		_, err = ts.Service.GetMemo(assistantCtx, &v1pb.GetMemoRequest{Name: "memos/memo-one"})
+		require.NoError(t, err)
+		_, err = ts.Service.GetMemo(outsiderCtx, &v1pb.GetMemoRequest{Name: "memos/memo-one"})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		for _, parent := range []string{"", fmt.Sprintf("users/%d", owner.ID)} {
+			resp, err := ts.Service.ListMemos(assistantCtx, &v1pb.ListMemosRequest{Parent: parent})
+			require.NoError(t, err)
+			require.Len(t, resp.Memos, 2)
+		}
+
+		// The grant also gives access to the attachments of the memos.
+		memoName := "memos/memo-one"
+		attachment, err := ts.Service.CreateAttachment(ownerCtx, &v1pb.CreateAttachmentRequest{
+			Attachment: &v1pb.Attachment{Filename: "notes.txt", Type: "text/plain", Content: []byte("private notes"), Memo: &memoName},
+		})
+		require.NoError(t, err)
+		getAttachmentRequest := &v1pb.GetAttachmentBinaryRequest{Name: attachment.Name, Filename: attachment.Filename}
+		_, err = ts.Service.GetAttachmentBinary(assistantCtx, getAttachmentRequest)
+		require.NoError(t, err)
+		_, err = ts.Service.GetAttachmentBinary(outsiderCtx, getAttachmentRequest)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		readGrants, err := ts.Service.ListUserReadGrants(ownerCtx, &v1pb.ListUserReadGrantsRequest{Parent: fmt.Sprintf("users/%d", owner.ID)})
+		require.NoError(t, err)
+		require.Len(t, readGrants.ReadGrants, 1)
+
+		// Revoking the grant removes the access.
+		_, err = ts.Service.DeleteUserReadGrant(ownerCtx, &v1pb.DeleteUserReadGrantRequest{Name: readGrant.Name})
+		require.NoError(t, err)
+		_, err = ts.Service.GetMemo(assistantCtx, &v1pb.GetMemoRequest{Name: "memos/memo-one"})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = ts.Service.GetAttachmentBinary(assistantCtx, getAttachmentRequest)
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("ReadGrant with tag grants access to tagged private memos", func(t *testing.T) {
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		owner, err := ts.CreateRegularUser(ctx, "owner")
+		require.NoError(t, err)
+		partner, err := ts.CreateRegularUser(ctx, "partner")
+		require.NoError(t, err)
+		ownerCtx := ts.CreateUserContext(ctx, owner.ID)
+		partnerCtx := ts.CreateUserContext(ctx, partner.ID)
+
+		for uid, tags := range map[string][]string{
+			"family-memo": {"family/trips"},
+			"work-memo":   {"work"},
+		} {
+			_, err := ts.Store.CreateMemo(ctx, &store.Memo{
+				UID:        uid,
+				CreatorID:  owner.ID,
+				Content:    "private memo",
+				Visibility: store.Private,
+				Payload:    &storepb.MemoPayload{Tags: tags},
+			})
+			require.NoError(t, err)
+		}
+
+		_, err = ts.Service.CreateUserReadGrant(ownerCtx, &v1pb.CreateUserReadGrantRequest{
+			Parent:    fmt.Sprintf("users/%d", owner.ID),
+			ReadGrant: &v1pb.UserReadGrant{Grantee: fmt.Sprintf("users/%d", partner.ID), Tag: "family"},
+		})
+		require.NoError(t, err)
+
+		_, err = ts.Service.GetMemo(partnerCtx, &v1pb.GetMemoRequest{Name: "memos/family-memo"})
+		require.NoError(t, err)
+		_, err = ts.Service.GetMemo(partnerCtx, &v1pb.GetMemoRequest{Name: "memos/work-memo"})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+		resp, err := ts.Service.ListMemos(partnerCtx, &v1pb.ListMemosRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Memos, 1)
+		require.Equal(t, "memos/family-memo", resp.Memos[0].Name)
+	})
+}
@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+)
+
+func TestUpdateUser_UsernameRedirect(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateHostUser(ctx, "alice")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	other, err := ts.CreateRegularUser(ctx, "bob")
+	require.NoError(t, err)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+
+	_, err = ts.Service.UpdateUser(userCtx, &v1pb.UpdateUserRequest{
+		User: &v1pb.User{
+			Name:     fmt.Sprintf("users/%d", user.ID),
+			Username: "alice-new",
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"username"}},
+	})
+	require.NoError(t, err)
+
+	// The old username keeps resolving to the renamed user.
+	redirectedUser, err := ts.Store.GetUserByRedirectedUsername(ctx, "alice")
+	require.NoError(t, err)
+	require.NotNil(t, redirectedUser)
+	require.Equal(t, "alice-new", redirectedUser.Username)
+
+	// Other users cannot take over the old username.
+	_, err = ts.Service.UpdateUser(otherCtx, &v1pb.UpdateUserRequest{
+		User: &v1pb.User{
+			Name:     fmt.Sprintf("users/%d", other.ID),
+			Username: "alice",
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"username"}},
+	})
+	require.Error(t, err)
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// The user can switch back to the old username, which releases the redirect.
+	_, err = ts.Service.UpdateUser(userCtx, &v1pb.UpdateUserRequest{
+		User: &v1pb.User{
+			Name:     fmt.Sprintf("users/%d", user.ID),
+			Username: "alice",
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"username"}},
+	})
+	require.NoError(t, err)
+	redirectedUser, err = ts.Store.GetUserByRedirectedUsername(ctx, "alice")
+	require.NoError(t, err)
+	require.Nil(t, redirectedUser)
+	redirectedUser, err = ts.Store.GetUserByRedirectedUsername(ctx, "alice-new")
+	require.NoError(t, err)
+	require.NotNil(t, redirectedUser)
+}
@@ -0,0 +1,101 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestUserSuspension(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	adminUser, err := ts.Store.CreateUser(ctx, &store.User{Username: "admin", Role: store.RoleAdmin, Email: "admin@example.com"})
+	require.NoError(t, err)
+	adminCtx := ts.CreateUserContext(ctx, adminUser.ID)
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	user, err := ts.Store.CreateUser(ctx, &store.User{
+		Username:     "testuser",
+		Role:         store.RoleUser,
+		Email:        "testuser@example.com",
+		PasswordHash: string(passwordHash),
+	})
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	userName := fmt.Sprintf("users/%d", user.ID)
+	memo, err := ts.Store.CreateMemo(ctx, &store.Memo{UID: "public-memo", CreatorID: user.ID, Content: "hello", Visibility: store.Public})
+	require.NoError(t, err)
+
+	// The admins suspend the users, but neither themselves, the other admins nor the host.
+	_, err = ts.Service.SuspendUser(userCtx, &v1pb.SuspendUserRequest{Name: fmt.Sprintf("users/%d", adminUser.ID)})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.SuspendUser(adminCtx, &v1pb.SuspendUserRequest{Name: fmt.Sprintf("users/%d", adminUser.ID)})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.SuspendUser(adminCtx, &v1pb.SuspendUserRequest{Name: fmt.Sprintf("users/%d", hostUser.ID)})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	suspension, err := ts.Service.SuspendUser(adminCtx, &v1pb.SuspendUserRequest{Name: userName, Reason: "spam"})
+	require.NoError(t, err)
+	require.True(t, suspension.Suspended)
+	require.Equal(t, fmt.Sprintf("users/%d", adminUser.ID), suspension.Suspender)
+	suspension, err = ts.Service.GetUserSuspension(hostCtx, &v1pb.GetUserSuspensionRequest{Name: userName + "/suspension"})
+	require.NoError(t, err)
+	require.Equal(t, "spam", suspension.Reason)
+
+	// The suspended user cannot sign in, and is told the reason.
+	signInCtx := grpc.NewContextWithServerTransportStream(metadata.NewIncomingContext(ctx, metadata.MD{}), fakeServerTransportStream{})
+	_, err = ts.Service.CreateSession(signInCtx, &v1pb.CreateSessionRequest{
+		Credentials: &v1pb.CreateSessionRequest_PasswordCredentials_{
+			PasswordCredentials: &v1pb.CreateSessionRequest_PasswordCredentials{Username: "testuser", Password: "password"},
+		},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Contains(t, status.Convert(err).Message(), "spam")
+
+	// The existing access tokens of the suspended user are rejected.
+	accessToken, err := ts.Service.CreateUserAccessToken(userCtx, &v1pb.CreateUserAccessTokenRequest{
+		Parent:      userName,
+		AccessToken: &v1pb.UserAccessToken{Description: "script"},
+	})
+	require.NoError(t, err)
+	interceptor := apiv1.NewGRPCAuthInterceptor(ts.Store, ts.Secret)
+	call := func(method string) error {
+		requestCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+accessToken.AccessToken))
+		_, err := interceptor.AuthenticationInterceptor(requestCtx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, any) (any, error) {
+			return nil, nil
+		})
+		return err
+	}
+	require.Equal(t, codes.PermissionDenied, status.Code(call("/memos.api.v1.MemoService/CreateMemo")))
+	require.NoError(t, call("/memos.api.v1.MemoService/ListMemos"))
+
+	// The public memos of the suspended user are kept, but only shown to the admins.
+	memoName := fmt.Sprintf("memos/%s", memo.UID)
+	_, err = ts.Service.GetMemo(ctx, &v1pb.GetMemoRequest{Name: memoName})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.GetMemo(adminCtx, &v1pb.GetMemoRequest{Name: memoName})
+	require.NoError(t, err)
+
+	suspension, err = ts.Service.UnsuspendUser(adminCtx, &v1pb.UnsuspendUserRequest{Name: userName})
+	require.NoError(t, err)
+	require.False(t, suspension.Suspended)
+	require.NoError(t, call("/memos.api.v1.MemoService/CreateMemo"))
+	_, err = ts.Service.GetMemo(ctx, &v1pb.GetMemoRequest{Name: memoName})
+	require.NoError(t, err)
+}
@@ -0,0 +1,154 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/totp"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// fakeServerTransportStream lets the sign in set its session cookie header outside a gRPC server.
+type fakeServerTransportStream struct{}
+
+func (fakeServerTransportStream) Method() string               { return "" }
+func (fakeServerTransportStream) SetHeader(metadata.MD) error  { return nil }
+func (fakeServerTransportStream) SendHeader(metadata.MD) error { return nil }
+func (fakeServerTransportStream) SetTrailer(metadata.MD) error { return nil }
+
+func TestUserTwoFactor(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	user, err := ts.Store.CreateUser(ctx, &store.User{
+		Username:     "testuser",
+		Role:         store.RoleUser,
+		Email:        "testuser@example.com",
+		PasswordHash: string(passwordHash),
+	})
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	otherUser, err := ts.CreateRegularUser(ctx, "otheruser")
+	require.NoError(t, err)
+	otherUserCtx := ts.CreateUserContext(ctx, otherUser.ID)
+	hostUser, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	name := fmt.Sprintf("users/%d/twoFactor", user.ID)
+
+	setup, err := ts.Service.SetupUserTwoFactor(userCtx, &v1pb.SetupUserTwoFactorRequest{Name: name})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(setup.ProvisioningUri, "otpauth://totp/Memos:testuser?"))
+	require.Contains(t, setup.ProvisioningUri, "secret="+setup.Secret)
+	_, err = ts.Service.SetupUserTwoFactor(otherUserCtx, &v1pb.SetupUserTwoFactorRequest{Name: name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The two-factor authentication is only enabled with a code of the new secret.
+	_, err = ts.Service.EnableUserTwoFactor(userCtx, &v1pb.EnableUserTwoFactorRequest{Name: name, Code: "000000x"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	now := time.Now()
+	code, err := totp.GenerateCode(setup.Secret, now)
+	require.NoError(t, err)
+	enabled, err := ts.Service.EnableUserTwoFactor(userCtx, &v1pb.EnableUserTwoFactorRequest{Name: name, Code: code})
+	require.NoError(t, err)
+	require.Len(t, enabled.RecoveryCodes, 10)
+	twoFactor, err := ts.Service.GetUserTwoFactor(userCtx, &v1pb.GetUserTwoFactorRequest{Name: name})
+	require.NoError(t, err)
+	require.True(t, twoFactor.Enabled)
+	require.Equal(t, int32(10), twoFactor.RecoveryCodeCount)
+
+	signIn := func(code string) error {
+		signInCtx := grpc.NewContextWithServerTransportStream(metadata.NewIncomingContext(ctx, metadata.MD{}), fakeServerTransportStream{})
+		_, err := ts.Service.CreateSession(signInCtx, &v1pb.CreateSessionRequest{
+			Credentials: &v1pb.CreateSessionRequest_PasswordCredentials_{
+				PasswordCredentials: &v1pb.CreateSessionRequest_PasswordCredentials{Username: "testuser", Password: "password"},
+			},
+			TwoFactorCode: code,
+		})
+		return err
+	}
+	require.Equal(t, codes.Unauthenticated, status.Code(signIn("")))
+	require.Equal(t, codes.InvalidArgument, status.Code(signIn("123")))
+	// The code used to enable the two-factor authentication is not accepted again.
+	require.Equal(t, codes.InvalidArgument, status.Code(signIn(code)))
+	nextCode, err := totp.GenerateCode(setup.Secret, now.Add(totp.Period*time.Second))
+	require.NoError(t, err)
+	require.NoError(t, signIn(nextCode))
+
+	// The recovery codes are only used once.
+	require.NoError(t, signIn(strings.ToUpper(enabled.RecoveryCodes[0])))
+	require.Equal(t, codes.InvalidArgument, status.Code(signIn(enabled.RecoveryCodes[0])))
+	twoFactor, err = ts.Service.GetUserTwoFactor(userCtx, &v1pb.GetUserTwoFactorRequest{Name: name})
+	require.NoError(t, err)
+	require.Equal(t, int32(9), twoFactor.RecoveryCodeCount)
+
+	// The user disables the two-factor authentication with a code, the host without.
+	_, err = ts.Service.DisableUserTwoFactor(userCtx, &v1pb.DisableUserTwoFactorRequest{Name: name})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.DisableUserTwoFactor(otherUserCtx, &v1pb.DisableUserTwoFactorRequest{Name: name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.DisableUserTwoFactor(hostCtx, &v1pb.DisableUserTwoFactorRequest{Name: name})
+	require.NoError(t, err)
+	require.NoError(t, signIn(""))
+}
+
+func TestRequireAdminTwoFactor(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "host")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	accessToken, err := ts.Service.CreateUserAccessToken(hostCtx, &v1pb.CreateUserAccessTokenRequest{
+		Parent:      fmt.Sprintf("users/%d", hostUser.ID),
+		AccessToken: &v1pb.UserAccessToken{Description: "test"},
+	})
+	require.NoError(t, err)
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_GENERAL,
+		Value: &storepb.WorkspaceSetting_GeneralSetting{
+			GeneralSetting: &storepb.WorkspaceGeneralSetting{RequireAdminTwoFactor: true},
+		},
+	})
+	require.NoError(t, err)
+
+	interceptor := apiv1.NewGRPCAuthInterceptor(ts.Store, ts.Secret)
+	call := func(method string) error {
+		requestCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+accessToken.AccessToken))
+		_, err := interceptor.AuthenticationInterceptor(requestCtx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, any) (any, error) {
+			return nil, nil
+		})
+		return err
+	}
+	listMemos := "/memos.api.v1.MemoService/ListMemos"
+	require.Equal(t, codes.FailedPrecondition, status.Code(call(listMemos)))
+	require.NoError(t, call("/memos.api.v1.UserService/SetupUserTwoFactor"))
+
+	name := fmt.Sprintf("users/%d/twoFactor", hostUser.ID)
+	setup, err := ts.Service.SetupUserTwoFactor(hostCtx, &v1pb.SetupUserTwoFactorRequest{Name: name})
+	require.NoError(t, err)
+	code, err := totp.GenerateCode(setup.Secret, time.Now())
+	require.NoError(t, err)
+	_, err = ts.Service.EnableUserTwoFactor(hostCtx, &v1pb.EnableUserTwoFactorRequest{Name: name, Code: code})
+	require.NoError(t, err)
+	require.NoError(t, call(listMemos))
+}
@@ -0,0 +1,157 @@
+package v1
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/webpush"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// newWebPushKeys returns the keys of a browser subscribing to the notifications.
+func newWebPushKeys(t *testing.T) *v1pb.UserWebPushSubscription_Keys {
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	auth := make([]byte, 16)
+	_, err = rand.Read(auth)
+	require.NoError(t, err)
+	return &v1pb.UserWebPushSubscription_Keys{
+		P256Dh: base64.RawURLEncoding.EncodeToString(key.PublicKey().Bytes()),
+		Auth:   base64.RawURLEncoding.EncodeToString(auth),
+	}
+}
+
+func TestUserWebPushSubscription(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+	other, err := ts.CreateRegularUser(ctx, "other")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, user.ID)
+	otherCtx := ts.CreateUserContext(ctx, other.ID)
+	parent := fmt.Sprintf("users/%d", user.ID)
+
+	_, err = ts.Service.CreateUserWebPushSubscription(otherCtx, &v1pb.CreateUserWebPushSubscriptionRequest{
+		Parent:              parent,
+		WebPushSubscription: &v1pb.UserWebPushSubscription{Endpoint: "https://push.example.com/1", Keys: newWebPushKeys(t)},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.CreateUserWebPushSubscription(userCtx, &v1pb.CreateUserWebPushSubscriptionRequest{
+		Parent:              parent,
+		WebPushSubscription: &v1pb.UserWebPushSubscription{Endpoint: "http://push.example.com/1", Keys: newWebPushKeys(t)},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.CreateUserWebPushSubscription(userCtx, &v1pb.CreateUserWebPushSubscriptionRequest{
+		Parent:              parent,
+		WebPushSubscription: &v1pb.UserWebPushSubscription{Endpoint: "https://push.example.com/1"},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// A browser subscribing again replaces its subscription.
+	for range 2 {
+		_, err = ts.Service.CreateUserWebPushSubscription(userCtx, &v1pb.CreateUserWebPushSubscriptionRequest{
+			Parent:              parent,
+			WebPushSubscription: &v1pb.UserWebPushSubscription{Endpoint: "https://push.example.com/1", Keys: newWebPushKeys(t), UserAgent: "Firefox"},
+		})
+		require.NoError(t, err)
+	}
+	subscription, err := ts.Service.CreateUserWebPushSubscription(userCtx, &v1pb.CreateUserWebPushSubscriptionRequest{
+		Parent:              parent,
+		WebPushSubscription: &v1pb.UserWebPushSubscription{Endpoint: "https://push.example.com/2", Keys: newWebPushKeys(t)},
+	})
+	require.NoError(t, err)
+	require.Nil(t, subscription.Keys)
+	response, err := ts.Service.ListUserWebPushSubscriptions(userCtx, &v1pb.ListUserWebPushSubscriptionsRequest{Parent: parent})
+	require.NoError(t, err)
+	require.Len(t, response.WebPushSubscriptions, 2)
+	require.Equal(t, "Firefox", response.WebPushSubscriptions[0].UserAgent)
+
+	_, err = ts.Service.DeleteUserWebPushSubscription(otherCtx, &v1pb.DeleteUserWebPushSubscriptionRequest{Name: subscription.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.DeleteUserWebPushSubscription(userCtx, &v1pb.DeleteUserWebPushSubscriptionRequest{Name: subscription.Name})
+	require.NoError(t, err)
+	_, err = ts.Service.DeleteUserWebPushSubscription(userCtx, &v1pb.DeleteUserWebPushSubscriptionRequest{Name: subscription.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	response, err = ts.Service.ListUserWebPushSubscriptions(userCtx, &v1pb.ListUserWebPushSubscriptionsRequest{Parent: parent})
+	require.NoError(t, err)
+	require.Len(t, response.WebPushSubscriptions, 1)
+}
+
+func TestWebPushInboxNotification(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+	ts.Profile.InstanceURL = "https://memos.example.com"
+
+	privateKey, publicKey, err := webpush.GenerateVAPIDKeys()
+	require.NoError(t, err)
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_BASIC,
+		Value: &storepb.WorkspaceSetting_BasicSetting{BasicSetting: &storepb.WorkspaceBasicSetting{
+			VapidPrivateKey: privateKey,
+			VapidPublicKey:  publicKey,
+		}},
+	})
+	require.NoError(t, err)
+	profile, err := ts.Service.GetWorkspaceProfile(ctx, &v1pb.GetWorkspaceProfileRequest{})
+	require.NoError(t, err)
+	require.Equal(t, publicKey, profile.WebPushPublicKey)
+
+	// The push service of the browser is gone, so the subscription is deleted once pushed to.
+	pushes := make(chan *http.Request, 1)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusGone)
+		pushes <- r
+	}))
+	defer server.Close()
+	transport := http.DefaultTransport.(*http.Transport)
+	tlsClientConfig := transport.TLSClientConfig
+	transport.TLSClientConfig = server.Client().Transport.(*http.Transport).TLSClientConfig
+	defer func() { transport.TLSClientConfig = tlsClientConfig }()
+
+	author, err := ts.CreateRegularUser(ctx, "author")
+	require.NoError(t, err)
+	commenter, err := ts.CreateRegularUser(ctx, "commenter")
+	require.NoError(t, err)
+	authorCtx := ts.CreateUserContext(ctx, author.ID)
+	_, err = ts.Service.CreateUserWebPushSubscription(authorCtx, &v1pb.CreateUserWebPushSubscriptionRequest{
+		Parent:              fmt.Sprintf("users/%d", author.ID),
+		WebPushSubscription: &v1pb.UserWebPushSubscription{Endpoint: server.URL + "/push", Keys: newWebPushKeys(t)},
+	})
+	require.NoError(t, err)
+	memo, err := ts.Store.CreateMemo(ctx, &store.Memo{UID: "memo", CreatorID: author.ID, Content: "Hello", Visibility: store.Public})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemoComment(ts.CreateUserContext(ctx, commenter.ID), &v1pb.CreateMemoCommentRequest{
+		Name:    fmt.Sprintf("memos/%s", memo.UID),
+		Comment: &v1pb.Memo{Content: "Nice!", Visibility: v1pb.Visibility_PUBLIC},
+	})
+	require.NoError(t, err)
+
+	select {
+	case push := <-pushes:
+		require.Equal(t, "/push", push.URL.Path)
+		require.Equal(t, "aes128gcm", push.Header.Get("Content-Encoding"))
+	case <-time.After(5 * time.Second):
+		t.Fatal("the notification was not pushed")
+	}
+	require.Eventually(t, func() bool {
+		subscriptions, err := ts.Store.GetUserWebPushSubscriptions(ctx, author.ID)
+		return err == nil && len(subscriptions) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -3,12 +3,20 @@ package v1
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/webhookdelivery"
+	"github.com/usememos/memos/store"
 )
 
 func TestCreateWebhook(t *testing.T) {
@@ -305,6 +313,37 @@ func TestUpdateWebhook(t *testing.T) {
 		require.NotNil(t, resp)
 		require.Equal(t, createdWebhook.Name, resp.Name)
 		require.Equal(t, "https://updated.example.com/webhook", resp.Url)
+		require.Equal(t, createdWebhook.Secret, resp.Secret)
+	})
+
+	t.Run("UpdateWebhook rotates the secret", func(t *testing.T) {
+		// Create test service for this specific test
+		ts := NewTestService(t)
+		defer ts.Cleanup()
+
+		// Create host user and authenticate
+		hostUser, err := ts.CreateHostUser(ctx, "admin")
+		require.NoError(t, err)
+		userCtx := ts.CreateUserContext(ctx, hostUser.ID)
+		// Create a webhook, whose secret is generated
+		createdWebhook, err := ts.Service.CreateWebhook(userCtx, &v1pb.CreateWebhookRequest{
+			Parent: fmt.Sprintf("users/%d", hostUser.ID),
+			Webhook: &v1pb.Webhook{
+				DisplayName: "Signed Webhook",
+				Url:         "https://example.com/webhook",
+			},
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, createdWebhook.Secret)
+
+		// Update the secret to empty to generate another one
+		resp, err := ts.Service.UpdateWebhook(userCtx, &v1pb.UpdateWebhookRequest{
+			Webhook:    &v1pb.Webhook{Name: createdWebhook.Name},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"secret"}},
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.Secret)
+		require.NotEqual(t, createdWebhook.Secret, resp.Secret)
 	})
 
 	t.Run("UpdateWebhook fails without authentication", func(t *testing.T) {
@@ -404,3 +443,269 @@ func TestDeleteWebhook(t *testing.T) {
 		require.Contains(t, err.Error(), "not found")
 	})
 }
+
+func TestWebhookDeliveries(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	var succeeding atomic.Bool
+	var received atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		received.Add(1)
+		if !succeeding.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, "database is down")
+			return
+		}
+		fmt.Fprint(w, `{"code": 0}`)
+	}))
+	defer server.Close()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	hook, err := ts.Service.CreateWebhook(userCtx, &v1pb.CreateWebhookRequest{
+		Parent:  fmt.Sprintf("users/%d", hostUser.ID),
+		Webhook: &v1pb.Webhook{DisplayName: "Flaky Webhook", Url: server.URL},
+	})
+	require.NoError(t, err)
+
+	// The memo is delivered at once, and its failed delivery is kept pending.
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "hello", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		hook, err := ts.Service.GetWebhook(userCtx, &v1pb.GetWebhookRequest{Name: hook.Name})
+		return err == nil && hook.FailureCount == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	deliveries, err := ts.Service.ListWebhookDeliveries(userCtx, &v1pb.ListWebhookDeliveriesRequest{Parent: hook.Name})
+	require.NoError(t, err)
+	require.Len(t, deliveries.Deliveries, 1)
+	delivery := deliveries.Deliveries[0]
+	require.Equal(t, "memos.memo.created", delivery.ActivityType)
+	require.Equal(t, v1pb.WebhookDelivery_PENDING, delivery.State)
+	require.Equal(t, int32(1), delivery.AttemptCount)
+	require.Contains(t, delivery.LastError, "status code: 500")
+	require.Equal(t, int32(http.StatusInternalServerError), delivery.ResponseStatusCode)
+	require.Equal(t, "database is down", delivery.ResponseBody)
+	require.NotNil(t, delivery.LastAttemptTime)
+	require.True(t, delivery.NextAttemptTime.AsTime().After(time.Now()))
+
+	// The delivery is dead-lettered once every attempt failed.
+	_, err = ts.Service.RetryWebhookDelivery(userCtx, &v1pb.RetryWebhookDeliveryRequest{Name: delivery.Name})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	storeDeliveries, err := ts.Store.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{CreatorID: &hostUser.ID})
+	require.NoError(t, err)
+	for storeDeliveries[0].Status == store.WebhookDeliveryPending {
+		require.NoError(t, webhookdelivery.Deliver(ctx, ts.Store, storeDeliveries[0], time.Now()))
+		storeDeliveries, err = ts.Store.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{CreatorID: &hostUser.ID})
+		require.NoError(t, err)
+	}
+	require.Equal(t, store.WebhookDeliveryDead, storeDeliveries[0].Status)
+	require.Equal(t, int32(webhookdelivery.MaxAttempts), storeDeliveries[0].AttemptCount)
+	require.Equal(t, int32(webhookdelivery.MaxAttempts), received.Load())
+
+	// The retried delivery is attempted again by the runner, resetting the failures of the webhook.
+	succeeding.Store(true)
+	delivery, err = ts.Service.RetryWebhookDelivery(userCtx, &v1pb.RetryWebhookDeliveryRequest{Name: delivery.Name})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.WebhookDelivery_PENDING, delivery.State)
+	require.Zero(t, delivery.AttemptCount)
+	webhookdelivery.NewRunner(ts.Store).RunOnce(ctx)
+	deliveries, err = ts.Service.ListWebhookDeliveries(userCtx, &v1pb.ListWebhookDeliveriesRequest{Parent: hook.Name})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.WebhookDelivery_SUCCEEDED, deliveries.Deliveries[0].State)
+	hook, err = ts.Service.GetWebhook(userCtx, &v1pb.GetWebhookRequest{Name: hook.Name})
+	require.NoError(t, err)
+	require.Zero(t, hook.FailureCount)
+
+	// The webhook failing continuously is disabled, and receives no deliveries until it is enabled again.
+	succeeding.Store(false)
+	webhooks, err := ts.Store.GetUserWebhooks(ctx, hostUser.ID)
+	require.NoError(t, err)
+	webhooks[0].FailureCount = webhookdelivery.DisableThreshold - 1
+	require.NoError(t, ts.Store.UpdateUserWebhook(ctx, hostUser.ID, webhooks[0]))
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "hello again", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		hook, err := ts.Service.GetWebhook(userCtx, &v1pb.GetWebhookRequest{Name: hook.Name})
+		return err == nil && hook.Disabled
+	}, 5*time.Second, 10*time.Millisecond)
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "hello once more", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	deliveries, err = ts.Service.ListWebhookDeliveries(userCtx, &v1pb.ListWebhookDeliveriesRequest{Parent: hook.Name})
+	require.NoError(t, err)
+	require.Len(t, deliveries.Deliveries, 2)
+
+	hook, err = ts.Service.UpdateWebhook(userCtx, &v1pb.UpdateWebhookRequest{
+		Webhook:    &v1pb.Webhook{Name: hook.Name, Disabled: false},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"disabled"}},
+	})
+	require.NoError(t, err)
+	require.False(t, hook.Disabled)
+	require.Zero(t, hook.FailureCount)
+
+	// The redelivered payload is a new delivery, attempted at once.
+	succeeding.Store(true)
+	redelivery, err := ts.Service.RedeliverWebhookDelivery(userCtx, &v1pb.RedeliverWebhookDeliveryRequest{Name: delivery.Name})
+	require.NoError(t, err)
+	require.NotEqual(t, delivery.Name, redelivery.Name)
+	require.Equal(t, "memos.memo.created", redelivery.ActivityType)
+	require.Eventually(t, func() bool {
+		deliveries, err := ts.Service.ListWebhookDeliveries(userCtx, &v1pb.ListWebhookDeliveriesRequest{Parent: hook.Name})
+		return err == nil && deliveries.Deliveries[0].Name == redelivery.Name && deliveries.Deliveries[0].ResponseStatusCode == http.StatusOK
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The deliveries are deleted with their webhook.
+	_, err = ts.Service.DeleteWebhook(userCtx, &v1pb.DeleteWebhookRequest{Name: hook.Name})
+	require.NoError(t, err)
+	storeDeliveries, err = ts.Store.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{CreatorID: &hostUser.ID})
+	require.NoError(t, err)
+	require.Empty(t, storeDeliveries)
+}
+
+func TestWebhookEventTypes(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"code": 0}`)
+	}))
+	defer server.Close()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	_, err = ts.Service.CreateWebhook(userCtx, &v1pb.CreateWebhookRequest{
+		Parent:  fmt.Sprintf("users/%d", hostUser.ID),
+		Webhook: &v1pb.Webhook{DisplayName: "Invalid Webhook", Url: server.URL, EventTypes: []string{"memo.created"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	hook, err := ts.Service.CreateWebhook(userCtx, &v1pb.CreateWebhookRequest{
+		Parent: fmt.Sprintf("users/%d", hostUser.ID),
+		Webhook: &v1pb.Webhook{
+			DisplayName: "Filtered Webhook",
+			Url:         server.URL,
+			EventTypes:  []string{"memos.memo.archived", "memos.comment.created"},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"memos.memo.archived", "memos.comment.created"}, hook.EventTypes)
+
+	// Only the subscribed activities are delivered.
+	memo, err := ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "hello", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.CreateMemoComment(userCtx, &v1pb.CreateMemoCommentRequest{
+		Name:    memo.Name,
+		Comment: &v1pb.Memo{Content: "a comment", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	_, err = ts.Service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, State: v1pb.State_ARCHIVED},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state"}},
+	})
+	require.NoError(t, err)
+	deliveries, err := ts.Service.ListWebhookDeliveries(userCtx, &v1pb.ListWebhookDeliveriesRequest{Parent: hook.Name})
+	require.NoError(t, err)
+	activityTypes := []string{}
+	for _, delivery := range deliveries.Deliveries {
+		activityTypes = append(activityTypes, delivery.ActivityType)
+	}
+	require.Equal(t, []string{"memos.memo.archived", "memos.comment.created"}, activityTypes)
+
+	hook, err = ts.Service.UpdateWebhook(userCtx, &v1pb.UpdateWebhookRequest{
+		Webhook:    &v1pb.Webhook{Name: hook.Name},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"event_types"}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, hook.EventTypes)
+}
+
+func TestWebhookDeliveriesOnShutdown(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	requested := make(chan struct{}, 1)
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requested <- struct{}{}
+		<-release
+		fmt.Fprint(w, `{"code": 0}`)
+	}))
+	defer server.Close()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	userCtx := ts.CreateUserContext(ctx, hostUser.ID)
+	hook, err := ts.Service.CreateWebhook(userCtx, &v1pb.CreateWebhookRequest{
+		Parent:  fmt.Sprintf("users/%d", hostUser.ID),
+		Webhook: &v1pb.Webhook{DisplayName: "Slow Webhook", Url: server.URL},
+	})
+	require.NoError(t, err)
+	listStates := func() []v1pb.WebhookDelivery_State {
+		deliveries, err := ts.Service.ListWebhookDeliveries(userCtx, &v1pb.ListWebhookDeliveriesRequest{Parent: hook.Name})
+		require.NoError(t, err)
+		states := []v1pb.WebhookDelivery_State{}
+		for _, delivery := range deliveries.Deliveries {
+			states = append(states, delivery.State)
+		}
+		return states
+	}
+
+	// The job queue stopped on shutdown waits for the first attempt of the delivery of the activity to be recorded.
+	_, err = ts.Service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{Content: "hello", Visibility: v1pb.Visibility_PRIVATE},
+	})
+	require.NoError(t, err)
+	<-requested
+	jobQueueStopped := make(chan struct{})
+	go func() {
+		ts.StopJobQueue()
+		close(jobQueueStopped)
+	}()
+	select {
+	case <-jobQueueStopped:
+		require.Fail(t, "job queue stopped before its running job")
+	case <-time.After(50 * time.Millisecond):
+	}
+	release <- struct{}{}
+	<-jobQueueStopped
+	require.Equal(t, []v1pb.WebhookDelivery_State{v1pb.WebhookDelivery_SUCCEEDED}, listStates())
+
+	// The runner stopped on shutdown finishes the delivery in flight, and leaves the next ones pending.
+	webhooks, err := ts.Store.GetUserWebhooks(ctx, hostUser.ID)
+	require.NoError(t, err)
+	for _, content := range []string{"first", "second"} {
+		_, err := ts.Store.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+			CreatorID:    hostUser.ID,
+			WebhookID:    webhooks[0].Id,
+			ActivityType: "memos.memo.created",
+			Payload:      fmt.Sprintf(`{"content": %q}`, content),
+			Status:       store.WebhookDeliveryPending,
+		})
+		require.NoError(t, err)
+	}
+	runnerCtx, stopRunner := context.WithCancel(ctx)
+	stopped := make(chan struct{})
+	go func() {
+		webhookdelivery.NewRunner(ts.Store).RunOnce(runnerCtx)
+		close(stopped)
+	}()
+	<-requested
+	stopRunner()
+	close(release)
+	<-stopped
+	states := listStates()
+	require.Len(t, states, 3)
+	require.ElementsMatch(t, []v1pb.WebhookDelivery_State{v1pb.WebhookDelivery_SUCCEEDED, v1pb.WebhookDelivery_SUCCEEDED, v1pb.WebhookDelivery_PENDING}, states)
+}
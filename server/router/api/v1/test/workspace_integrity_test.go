@@ -0,0 +1,166 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/attachmentintegrity"
+	"github.com/usememos/memos/store"
+)
+
+func TestCheckWorkspaceIntegrity(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+
+	memo, err := ts.Store.CreateMemo(ctx, &store.Memo{
+		UID:        "drifted-memo",
+		CreatorID:  hostUser.ID,
+		Content:    "memo with #fresh tag",
+		Visibility: store.Private,
+		Payload: &storepb.MemoPayload{
+			Tags: []string{"stale"},
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
+		MemoID:        memo.ID,
+		RelatedMemoID: 9999,
+		Type:          store.MemoRelationReference,
+	})
+	require.NoError(t, err)
+
+	report, err := ts.Service.CheckWorkspaceIntegrity(hostCtx, &v1pb.CheckWorkspaceIntegrityRequest{})
+	require.NoError(t, err)
+	issueTypes := []v1pb.WorkspaceIntegrityReport_Issue_Type{}
+	for _, issue := range report.Issues {
+		require.False(t, issue.Repaired)
+		issueTypes = append(issueTypes, issue.Type)
+	}
+	require.ElementsMatch(t, []v1pb.WorkspaceIntegrityReport_Issue_Type{
+		v1pb.WorkspaceIntegrityReport_Issue_MEMO_PAYLOAD_DRIFT,
+		v1pb.WorkspaceIntegrityReport_Issue_MEMO_RELATION_DANGLING,
+	}, issueTypes)
+
+	report, err = ts.Service.CheckWorkspaceIntegrity(hostCtx, &v1pb.CheckWorkspaceIntegrityRequest{Repair: true})
+	require.NoError(t, err)
+	require.Len(t, report.Issues, 2)
+	for _, issue := range report.Issues {
+		require.True(t, issue.Repaired)
+	}
+
+	// Everything is fixed after the repair.
+	report, err = ts.Service.CheckWorkspaceIntegrity(hostCtx, &v1pb.CheckWorkspaceIntegrityRequest{})
+	require.NoError(t, err)
+	require.Empty(t, report.Issues)
+	memo, err = ts.Store.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"fresh"}, memo.Payload.Tags)
+}
+
+func TestCheckWorkspaceIntegrity_CorruptedAttachments(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+
+	// The files are stored by their content, at absolute paths as the store resolves relative paths in its own data directory.
+	assetsDir := t.TempDir()
+	_, err = ts.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_STORAGE,
+		Value: &storepb.WorkspaceSetting_StorageSetting{
+			StorageSetting: &storepb.WorkspaceStorageSetting{
+				StorageType:      storepb.WorkspaceStorageSetting_LOCAL,
+				FilepathTemplate: filepath.Join(assetsDir, "{hash}"),
+			},
+		},
+	})
+	require.NoError(t, err)
+	hash := sha256.Sum256([]byte("local content"))
+	contentHash := hex.EncodeToString(hash[:])
+	_, err = ts.Service.CreateAttachment(hostCtx, &v1pb.CreateAttachmentRequest{
+		Attachment:   &v1pb.Attachment{Filename: "local.txt", Type: "text/plain", Content: []byte("local content")},
+		AttachmentId: "local",
+	})
+	require.NoError(t, err)
+	uid := "local"
+	local, err := ts.Store.GetAttachment(ctx, &store.FindAttachment{UID: &uid})
+	require.NoError(t, err)
+	require.Equal(t, contentHash, local.ContentHash)
+	require.Equal(t, filepath.Join(assetsDir, contentHash), local.Reference)
+
+	hash = sha256.Sum256([]byte("database content"))
+	database, err := ts.Store.CreateAttachment(ctx, &store.Attachment{
+		UID:         "database",
+		CreatorID:   hostUser.ID,
+		Filename:    "database.txt",
+		Type:        "text/plain",
+		Blob:        []byte("database content"),
+		Size:        16,
+		ContentHash: hex.EncodeToString(hash[:]),
+	})
+	require.NoError(t, err)
+
+	corruptedResources := func() []string {
+		report, err := ts.Service.CheckWorkspaceIntegrity(hostCtx, &v1pb.CheckWorkspaceIntegrityRequest{Repair: true})
+		require.NoError(t, err)
+		resources := []string{}
+		for _, issue := range report.Issues {
+			if issue.Type == v1pb.WorkspaceIntegrityReport_Issue_ATTACHMENT_BLOB_CORRUPTED {
+				require.False(t, issue.Repaired)
+				resources = append(resources, issue.Resource)
+			}
+		}
+		return resources
+	}
+
+	count, err := attachmentintegrity.VerifyAttachments(ctx, ts.Store, ts.Profile)
+	require.NoError(t, err)
+	require.Zero(t, count)
+	require.Empty(t, corruptedResources())
+
+	require.NoError(t, os.WriteFile(local.Reference, []byte("local c0ntent"), 0o600))
+	corruptedBlob := []byte("database c0ntent")
+	require.NoError(t, ts.Store.UpdateAttachment(ctx, &store.UpdateAttachment{ID: database.ID, Blob: &corruptedBlob}))
+	count, err = attachmentintegrity.VerifyAttachments(ctx, ts.Store, ts.Profile)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	require.ElementsMatch(t, []string{"attachments/local", "attachments/database"}, corruptedResources())
+
+	// The corruption is cleared once the content matches again.
+	require.NoError(t, os.WriteFile(local.Reference, []byte("local content"), 0o600))
+	count, err = attachmentintegrity.VerifyAttachments(ctx, ts.Store, ts.Profile)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	require.Equal(t, []string{"attachments/database"}, corruptedResources())
+}
+
+func TestCheckWorkspaceIntegrity_PermissionDenied(t *testing.T) {
+	ctx := context.Background()
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	user, err := ts.CreateRegularUser(ctx, "user")
+	require.NoError(t, err)
+
+	_, err = ts.Service.CheckWorkspaceIntegrity(ts.CreateUserContext(ctx, user.ID), &v1pb.CheckWorkspaceIntegrityRequest{})
+	require.Error(t, err)
+}
@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/server/runner/jobqueue"
+)
+
+func TestWorkspaceJobs(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	regularUser, err := ts.CreateRegularUser(ctx, "john")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+
+	// The job fails at its only attempt, then succeeds once retried.
+	attempts := atomic.Int32{}
+	ts.Service.JobQueue.Register("test", jobqueue.Handler{
+		Run: func(_ context.Context, payload []byte) error {
+			if string(payload) != `{"value":"hello"}` {
+				return errors.Errorf("unexpected payload %s", payload)
+			}
+			if attempts.Add(1) == 1 {
+				return errors.New("service unavailable")
+			}
+			return nil
+		},
+		MaxAttempts: 1,
+	})
+	job, err := ts.Service.JobQueue.Enqueue(ctx, "test", map[string]string{"value": "hello"})
+	require.NoError(t, err)
+	name := fmt.Sprintf("%s%d", apiv1.JobNamePrefix, job.ID)
+	require.Eventually(t, func() bool {
+		job, err := ts.Service.GetJob(hostCtx, &v1pb.GetJobRequest{Name: name})
+		return err == nil && job.State == v1pb.Job_FAILED
+	}, 5*time.Second, 10*time.Millisecond)
+	failedJob, err := ts.Service.GetJob(hostCtx, &v1pb.GetJobRequest{Name: name})
+	require.NoError(t, err)
+	require.Equal(t, "test", failedJob.Kind)
+	require.Equal(t, int32(1), failedJob.AttemptCount)
+	require.Equal(t, int32(1), failedJob.MaxAttempts)
+	require.Equal(t, "service unavailable", failedJob.LastError)
+	require.NotNil(t, failedJob.FinishTime)
+
+	jobs, err := ts.Service.ListJobs(hostCtx, &v1pb.ListJobsRequest{State: v1pb.Job_FAILED})
+	require.NoError(t, err)
+	require.Len(t, jobs.Jobs, 1)
+	require.Equal(t, name, jobs.Jobs[0].Name)
+	jobs, err = ts.Service.ListJobs(hostCtx, &v1pb.ListJobsRequest{Kind: "feed_publish"})
+	require.NoError(t, err)
+	require.Empty(t, jobs.Jobs)
+
+	retriedJob, err := ts.Service.RetryJob(hostCtx, &v1pb.RetryJobRequest{Name: name})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Job_PENDING, retriedJob.State)
+	require.Zero(t, retriedJob.AttemptCount)
+	require.Eventually(t, func() bool {
+		job, err := ts.Service.GetJob(hostCtx, &v1pb.GetJobRequest{Name: name})
+		return err == nil && job.State == v1pb.Job_SUCCEEDED
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, int32(2), attempts.Load())
+
+	// Only the failed jobs can be retried.
+	_, err = ts.Service.RetryJob(hostCtx, &v1pb.RetryJobRequest{Name: name})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = ts.Service.GetJob(hostCtx, &v1pb.GetJobRequest{Name: "workspace/jobs/999"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = ts.Service.GetJob(hostCtx, &v1pb.GetJobRequest{Name: "workspace/settings/1"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Only the users managing the workspace can see the jobs.
+	regularCtx := ts.CreateUserContext(ctx, regularUser.ID)
+	_, err = ts.Service.ListJobs(regularCtx, &v1pb.ListJobsRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.GetJob(regularCtx, &v1pb.GetJobRequest{Name: name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestReloadWorkspace(t *testing.T) {
+	ctx := context.Background()
+	defer slog.SetLogLoggerLevel(slog.LevelInfo)
+
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	regularUser, err := ts.CreateRegularUser(ctx, "john")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+
+	// Only the users managing the workspace can reload it.
+	_, err = ts.Service.ReloadWorkspace(ts.CreateUserContext(ctx, regularUser.ID), &v1pb.ReloadWorkspaceRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = ts.Service.ReloadWorkspace(hostCtx, &v1pb.ReloadWorkspaceRequest{LogLevel: "verbose"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	response, err := ts.Service.ReloadWorkspace(hostCtx, &v1pb.ReloadWorkspaceRequest{LogLevel: "debug"})
+	require.NoError(t, err)
+	require.Equal(t, "debug", response.LogLevel)
+	require.True(t, slog.Default().Enabled(ctx, slog.LevelDebug))
+
+	// The level is kept when not given.
+	response, err = ts.Service.ReloadWorkspace(hostCtx, &v1pb.ReloadWorkspaceRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "debug", response.LogLevel)
+
+	// The settings edited directly in the database are read again after the reload.
+	workspaceGeneralSetting, err := ts.Store.GetWorkspaceGeneralSetting(ctx)
+	require.NoError(t, err)
+	require.False(t, workspaceGeneralSetting.DisallowUserRegistration)
+	value, err := protojson.Marshal(&storepb.WorkspaceGeneralSetting{DisallowUserRegistration: true})
+	require.NoError(t, err)
+	_, err = ts.Store.GetDriver().UpsertWorkspaceSetting(ctx, &store.WorkspaceSetting{
+		Name:  storepb.WorkspaceSettingKey_GENERAL.String(),
+		Value: string(value),
+	})
+	require.NoError(t, err)
+	workspaceGeneralSetting, err = ts.Store.GetWorkspaceGeneralSetting(ctx)
+	require.NoError(t, err)
+	require.False(t, workspaceGeneralSetting.DisallowUserRegistration)
+
+	response, err = ts.Service.ReloadWorkspace(hostCtx, &v1pb.ReloadWorkspaceRequest{LogLevel: "warn"})
+	require.NoError(t, err)
+	require.Equal(t, "warn", response.LogLevel)
+	workspaceGeneralSetting, err = ts.Store.GetWorkspaceGeneralSetting(ctx)
+	require.NoError(t, err)
+	require.True(t, workspaceGeneralSetting.DisallowUserRegistration)
+}
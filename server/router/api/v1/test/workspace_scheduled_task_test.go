@@ -0,0 +1,134 @@
+package v1
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/scheduler"
+)
+
+func TestWorkspaceScheduledTasks(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	hostUser, err := ts.CreateHostUser(ctx, "admin")
+	require.NoError(t, err)
+	regularUser, err := ts.CreateRegularUser(ctx, "john")
+	require.NoError(t, err)
+	hostCtx := ts.CreateUserContext(ctx, hostUser.ID)
+
+	// The task fails at its first run, then succeeds.
+	runs := atomic.Int32{}
+	ts.Service.Scheduler.Register(scheduler.Task{
+		Name:            "cleanup",
+		Description:     "Deletes the expired data.",
+		DefaultSchedule: "0 * * * *",
+		Run: func(context.Context) error {
+			if runs.Add(1) == 1 {
+				return errors.New("database is locked")
+			}
+			return nil
+		},
+	})
+	name := "workspace/scheduledTasks/cleanup"
+
+	tasks, err := ts.Service.ListScheduledTasks(hostCtx, &v1pb.ListScheduledTasksRequest{})
+	require.NoError(t, err)
+	require.Len(t, tasks.Tasks, 1)
+	task := tasks.Tasks[0]
+	require.Equal(t, name, task.Name)
+	require.Equal(t, "Deletes the expired data.", task.Description)
+	require.Equal(t, "0 * * * *", task.Schedule)
+	require.Equal(t, "0 * * * *", task.DefaultSchedule)
+	require.Equal(t, v1pb.ScheduledTask_STATE_UNSPECIFIED, task.LastRunState)
+	require.Zero(t, task.NextRunTime.AsTime().Minute())
+
+	// The task is run by hand, its last run being recorded.
+	task, err = ts.Service.RunScheduledTask(hostCtx, &v1pb.RunScheduledTaskRequest{Name: name})
+	require.NoError(t, err)
+	require.NotNil(t, task.LastRunTime)
+	require.Eventually(t, func() bool {
+		tasks, err := ts.Service.ListScheduledTasks(hostCtx, &v1pb.ListScheduledTasksRequest{})
+		return err == nil && tasks.Tasks[0].LastRunState == v1pb.ScheduledTask_FAILED
+	}, 5*time.Second, 10*time.Millisecond)
+	tasks, err = ts.Service.ListScheduledTasks(hostCtx, &v1pb.ListScheduledTasksRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "database is locked", tasks.Tasks[0].LastError)
+	require.NotNil(t, tasks.Tasks[0].LastFinishTime)
+	_, err = ts.Service.RunScheduledTask(hostCtx, &v1pb.RunScheduledTaskRequest{Name: name})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		tasks, err := ts.Service.ListScheduledTasks(hostCtx, &v1pb.ListScheduledTasksRequest{})
+		return err == nil && tasks.Tasks[0].LastRunState == v1pb.ScheduledTask_SUCCEEDED
+	}, 5*time.Second, 10*time.Millisecond)
+	tasks, err = ts.Service.ListScheduledTasks(hostCtx, &v1pb.ListScheduledTasksRequest{})
+	require.NoError(t, err)
+	require.Empty(t, tasks.Tasks[0].LastError)
+	require.Equal(t, int32(2), runs.Load())
+
+	// The schedule is changed, then reset to the default one, and the task is disabled.
+	task, err = ts.Service.UpdateScheduledTask(hostCtx, &v1pb.UpdateScheduledTaskRequest{
+		Task:       &v1pb.ScheduledTask{Name: name, Schedule: "30 3 * * *"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"schedule"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "30 3 * * *", task.Schedule)
+	require.Equal(t, 30, task.NextRunTime.AsTime().Minute())
+	require.Equal(t, v1pb.ScheduledTask_SUCCEEDED, task.LastRunState)
+	task, err = ts.Service.UpdateScheduledTask(hostCtx, &v1pb.UpdateScheduledTaskRequest{
+		Task:       &v1pb.ScheduledTask{Name: name, Disabled: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"schedule", "disabled"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "0 * * * *", task.Schedule)
+	require.True(t, task.Disabled)
+	require.Nil(t, task.NextRunTime)
+	_, err = ts.Service.UpdateScheduledTask(hostCtx, &v1pb.UpdateScheduledTaskRequest{
+		Task:       &v1pb.ScheduledTask{Name: name, Schedule: "every day"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"schedule"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = ts.Service.RunScheduledTask(hostCtx, &v1pb.RunScheduledTaskRequest{Name: "workspace/scheduledTasks/backup"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Only the users managing the workspace can manage the tasks.
+	regularCtx := ts.CreateUserContext(ctx, regularUser.ID)
+	_, err = ts.Service.ListScheduledTasks(regularCtx, &v1pb.ListScheduledTasksRequest{})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = ts.Service.RunScheduledTask(regularCtx, &v1pb.RunScheduledTaskRequest{Name: name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestSchedulerRunOnce(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestService(t)
+	defer ts.Cleanup()
+
+	runs := atomic.Int32{}
+	ts.Service.Scheduler.Register(scheduler.Task{
+		Name:            "cleanup",
+		DefaultSchedule: "0 * * * *",
+		Run: func(context.Context) error {
+			runs.Add(1)
+			return nil
+		},
+	})
+
+	// The task is run once its scheduled time has come, then not before the next one.
+	ts.Service.Scheduler.RunOnce(ctx, time.Now())
+	require.Never(t, func() bool { return runs.Load() > 0 }, 100*time.Millisecond, 10*time.Millisecond)
+	ts.Service.Scheduler.RunOnce(ctx, time.Now().Add(time.Hour))
+	require.Eventually(t, func() bool { return runs.Load() == 1 }, 5*time.Second, 10*time.Millisecond)
+	ts.Service.Scheduler.RunOnce(ctx, time.Now().Add(time.Hour))
+	require.Never(t, func() bool { return runs.Load() > 1 }, 100*time.Millisecond, 10*time.Millisecond)
+}
@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"context"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// tracerName is the name of the tracer of the spans of the API calls.
+const tracerName = "github.com/usememos/memos/server/router/api/v1"
+
+type TracingInterceptor struct {
+}
+
+func NewTracingInterceptor() *TracingInterceptor {
+	return &TracingInterceptor{}
+}
+
+// TracingInterceptor starts a span for the call, continuing the trace of the caller propagated in the metadata,
+// so that the spans of the SQL queries and the storage requests of the call are its children.
+func (*TracingInterceptor) TracingInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+	}
+	service, method, _ := strings.Cut(strings.TrimPrefix(serverInfo.FullMethod, "/"), "/")
+	ctx, span := otel.Tracer(tracerName).Start(ctx, serverInfo.FullMethod,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "grpc"),
+			attribute.String("rpc.service", service),
+			attribute.String("rpc.method", method),
+		),
+	)
+	defer span.End()
+
+	resp, err := handler(ctx, request)
+	st := status.Convert(err)
+	span.SetAttributes(attribute.Int("rpc.grpc.status_code", int(st.Code())))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, st.Message())
+	}
+	return resp, err
+}
+
+// incomingHeaderMatcher forwards the trace context of the HTTP requests to the gRPC calls of the gateway,
+// along with the headers forwarded by default.
+func incomingHeaderMatcher(key string) (string, bool) {
+	switch strings.ToLower(key) {
+	case "traceparent", "tracestate", "baggage":
+		return strings.ToLower(key), true
+	default:
+		return runtime.DefaultHeaderMatcher(key)
+	}
+}
+
+// metadataCarrier reads the trace context propagated in the metadata of the gRPC calls.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
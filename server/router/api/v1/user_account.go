@@ -0,0 +1,201 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) DeleteUserAccount(ctx context.Context, request *v1pb.DeleteUserAccountRequest) (*v1pb.DeleteUserAccountResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil || user.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if user.Role == store.RoleHost {
+		return nil, status.Errorf(codes.FailedPrecondition, "the host account cannot be deleted")
+	}
+	if request.ConfirmUsername != user.Username {
+		return nil, status.Errorf(codes.InvalidArgument, "the username does not match")
+	}
+	// The user authenticates again, as with a stolen session the account would be lost with its memos.
+	twoFactor, err := s.Store.GetUserTwoFactor(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user two-factor: %v", err)
+	}
+	if twoFactor.Enabled {
+		ok, err := s.verifyTwoFactorCode(ctx, user.ID, twoFactor, request.Code)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid two-factor code")
+		}
+	} else if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(request.Password)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "the password does not match")
+	}
+
+	// The final export is built before anything is deleted, with the comments and the archived memos.
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID: &user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	exportData := &ExportData{
+		Version:    "1.0",
+		ExportedAt: time.Now(),
+		User: &ExportUser{
+			Username:    user.Username,
+			Nickname:    user.Nickname,
+			Email:       user.Email,
+			Description: user.Description,
+			CreatedAt:   time.Unix(user.CreatedTs, 0),
+		},
+		Memos: s.convertMemosToExport(ctx, memos, true, true),
+	}
+	jsonData, err := json.MarshalIndent(exportData, "", "  ")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal export data: %v", err)
+	}
+
+	if err := s.deleteUserData(ctx, user, user); err != nil {
+		return nil, err
+	}
+	return &v1pb.DeleteUserAccountResponse{
+		Data:      jsonData,
+		Filename:  fmt.Sprintf("%s_export_%s.json", user.Username, time.Now().Format("20060102_150405")),
+		MemoCount: int32(len(exportData.Memos)),
+	}, nil
+}
+
+// deleteUserData deletes the user with their memos and comments, attachments with their stored content, reactions,
+// inbox messages, old handles, tag shares and settings, and records the deletion by the deleter for audit.
+func (s *APIV1Service) deleteUserData(ctx context.Context, deleter, user *store.User) error {
+	payload := &storepb.ActivityUserDeletePayload{
+		UserId: user.ID,
+	}
+
+	// The attachments are deleted first, so that they are counted whether attached to a memo or not.
+	attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{
+		CreatorID: &user.ID,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list attachments: %v", err)
+	}
+	for _, attachment := range attachments {
+		if err := s.Store.DeleteAttachment(ctx, &store.DeleteAttachment{ID: attachment.ID}); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete attachment: %v", err)
+		}
+	}
+	payload.AttachmentCount = int32(len(attachments))
+
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID: &user.ID,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	for _, memo := range memos {
+		if err := s.deleteMemo(ctx, memo); err != nil {
+			return err
+		}
+	}
+	payload.MemoCount = int32(len(memos))
+
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{
+		CreatorID: &user.ID,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list reactions: %v", err)
+	}
+	for _, reaction := range reactions {
+		if err := s.Store.DeleteReaction(ctx, &store.DeleteReaction{ID: reaction.ID}); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete reaction: %v", err)
+		}
+	}
+	payload.ReactionCount = int32(len(reactions))
+
+	for _, find := range []*store.FindInbox{{SenderID: &user.ID}, {ReceiverID: &user.ID}} {
+		inboxes, err := s.Store.ListInboxes(ctx, find)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list inboxes: %v", err)
+		}
+		for _, inbox := range inboxes {
+			if err := s.Store.DeleteInbox(ctx, &store.DeleteInbox{ID: inbox.ID}); err != nil {
+				return status.Errorf(codes.Internal, "failed to delete inbox: %v", err)
+			}
+		}
+	}
+
+	if err := s.Store.DeleteUser(ctx, &store.DeleteUser{
+		ID: user.ID,
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete user: %v", err)
+	}
+	// Release the old handles of the deleted user.
+	redirects, err := s.Store.ListUsernameRedirects(ctx, &store.FindUsernameRedirect{
+		UserID: &user.ID,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list username redirects: %v", err)
+	}
+	for _, redirect := range redirects {
+		if err := s.Store.DeleteUsernameRedirect(ctx, &store.DeleteUsernameRedirect{
+			Username: redirect.Username,
+		}); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete username redirect: %v", err)
+		}
+	}
+	// Revoke the tag shares created by or granted to the deleted user.
+	for _, find := range []*store.FindTagShare{{CreatorID: &user.ID}, {GranteeID: &user.ID}} {
+		tagShares, err := s.Store.ListTagShares(ctx, find)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list tag shares: %v", err)
+		}
+		for _, tagShare := range tagShares {
+			if err := s.Store.DeleteTagShare(ctx, &store.DeleteTagShare{ID: tagShare.ID}); err != nil {
+				return status.Errorf(codes.Internal, "failed to delete tag share: %v", err)
+			}
+		}
+	}
+	// Revoke the read grants given by or to the deleted user.
+	for _, find := range []*store.FindReadGrant{{CreatorID: &user.ID}, {GranteeID: &user.ID}} {
+		readGrants, err := s.Store.ListReadGrants(ctx, find)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list read grants: %v", err)
+		}
+		for _, readGrant := range readGrants {
+			if err := s.Store.DeleteReadGrant(ctx, &store.DeleteReadGrant{ID: readGrant.ID}); err != nil {
+				return status.Errorf(codes.Internal, "failed to delete read grant: %v", err)
+			}
+		}
+	}
+
+	if _, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: deleter.ID,
+		Type:      store.ActivityTypeUserDelete,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			UserDelete: payload,
+		},
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to create activity: %v", err)
+	}
+	return nil
+}
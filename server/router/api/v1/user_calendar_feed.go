@@ -0,0 +1,103 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	calendarFeedNameSuffix = "/calendarFeed"
+	// calendarFeedTokenLength is the length of the random tokens of the feed URLs, enough not to be guessed.
+	calendarFeedTokenLength = 32
+)
+
+func (s *APIV1Service) GetUserCalendarFeed(ctx context.Context, request *v1pb.GetUserCalendarFeedRequest) (*v1pb.UserCalendarFeed, error) {
+	user, err := s.getCalendarFeedOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	calendarFeed, err := s.Store.GetUserCalendarFeed(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user calendar feed: %v", err)
+	}
+	return s.convertUserCalendarFeedFromStore(request.Name, user, calendarFeed), nil
+}
+
+func (s *APIV1Service) ResetUserCalendarFeed(ctx context.Context, request *v1pb.ResetUserCalendarFeedRequest) (*v1pb.UserCalendarFeed, error) {
+	user, err := s.getCalendarFeedOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	token, err := util.RandomString(calendarFeedTokenLength)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate calendar feed token: %v", err)
+	}
+	calendarFeed := &storepb.CalendarFeedUserSetting{Token: token}
+	if err := s.Store.UpsertUserCalendarFeed(ctx, user.ID, calendarFeed); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user calendar feed: %v", err)
+	}
+	return s.convertUserCalendarFeedFromStore(request.Name, user, calendarFeed), nil
+}
+
+func (s *APIV1Service) DisableUserCalendarFeed(ctx context.Context, request *v1pb.DisableUserCalendarFeedRequest) (*v1pb.UserCalendarFeed, error) {
+	user, err := s.getCalendarFeedOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	calendarFeed := &storepb.CalendarFeedUserSetting{}
+	if err := s.Store.UpsertUserCalendarFeed(ctx, user.ID, calendarFeed); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user calendar feed: %v", err)
+	}
+	return s.convertUserCalendarFeedFromStore(request.Name, user, calendarFeed), nil
+}
+
+// convertUserCalendarFeedFromStore converts the calendar feed of the user, with the URL served by the calendar router.
+func (s *APIV1Service) convertUserCalendarFeedFromStore(name string, user *store.User, calendarFeed *storepb.CalendarFeedUserSetting) *v1pb.UserCalendarFeed {
+	userCalendarFeed := &v1pb.UserCalendarFeed{
+		Name: name,
+	}
+	if calendarFeed.Token == "" {
+		return userCalendarFeed
+	}
+	userCalendarFeed.Enabled = true
+	userCalendarFeed.Url = fmt.Sprintf("%s/u/%s/calendar.ics?token=%s", strings.TrimSuffix(s.Profile.InstanceURL, "/"), url.PathEscape(user.Username), url.QueryEscape(calendarFeed.Token))
+	return userCalendarFeed
+}
+
+// getCalendarFeedOwner returns the current user if the calendar feed belongs to them.
+// The URL of the feed is a secret of its user, so it is not shown to the admins either.
+func (s *APIV1Service) getCalendarFeedOwner(ctx context.Context, name string) (*store.User, error) {
+	userID, err := extractUserIDFromCalendarFeedName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid calendar feed name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return currentUser, nil
+}
+
+// extractUserIDFromCalendarFeedName returns the user ID from a calendar feed name.
+// e.g., "users/1/calendarFeed" -> 1.
+func extractUserIDFromCalendarFeedName(name string) (int32, error) {
+	userName, ok := strings.CutSuffix(name, calendarFeedNameSuffix)
+	if !ok {
+		return 0, errors.Errorf("invalid calendar feed name %q", name)
+	}
+	return ExtractUserIDFromName(userName)
+}
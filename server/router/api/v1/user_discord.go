@@ -0,0 +1,191 @@
+package v1
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	discordNameSuffix = "/discord"
+	// discordLinkCodeLength and discordLinkCodeLifetime keep the link codes short enough to be typed, and too short-lived to be guessed.
+	discordLinkCodeLength   = 8
+	discordLinkCodeLifetime = 10 * time.Minute
+)
+
+func (s *APIV1Service) GetUserDiscord(ctx context.Context, request *v1pb.GetUserDiscordRequest) (*v1pb.UserDiscord, error) {
+	user, err := s.getDiscordOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	discord, err := s.Store.GetUserDiscord(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user discord: %v", err)
+	}
+	return convertUserDiscordFromStore(request.Name, discord, false), nil
+}
+
+func (s *APIV1Service) GenerateUserDiscordLinkCode(ctx context.Context, request *v1pb.GenerateUserDiscordLinkCodeRequest) (*v1pb.UserDiscord, error) {
+	user, err := s.getDiscordOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	discordSetting, err := s.Store.GetWorkspaceDiscordSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace discord setting: %v", err)
+	}
+	if discordSetting.PublicKey == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "the Discord bot is not enabled on this instance")
+	}
+	code, err := util.RandomString(discordLinkCodeLength)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate discord link code: %v", err)
+	}
+	discord, err := s.Store.GetUserDiscord(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user discord: %v", err)
+	}
+	// The codes are compared in uppercase, as they are typed by the users.
+	discord.LinkCode = strings.ToUpper(code)
+	discord.LinkCodeExpireTime = timestamppb.New(time.Now().Add(discordLinkCodeLifetime))
+	if err := s.Store.UpsertUserDiscord(ctx, user.ID, discord); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user discord: %v", err)
+	}
+	return convertUserDiscordFromStore(request.Name, discord, true), nil
+}
+
+func (s *APIV1Service) UnlinkUserDiscord(ctx context.Context, request *v1pb.UnlinkUserDiscordRequest) (*v1pb.UserDiscord, error) {
+	user, err := s.getDiscordOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	discord := &storepb.DiscordUserSetting{}
+	if err := s.Store.UpsertUserDiscord(ctx, user.ID, discord); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user discord: %v", err)
+	}
+	return convertUserDiscordFromStore(request.Name, discord, false), nil
+}
+
+// linkDiscordAccount links the Discord account to the user of the unexpired link code, and unlinks it from any other user.
+// It returns nil if no user has the code.
+func (s *APIV1Service) linkDiscordAccount(ctx context.Context, code, discordUserID string) (*store.User, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" || discordUserID == "" {
+		return nil, nil
+	}
+	userSettings, err := s.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSetting_DISCORD,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list user discords")
+	}
+	var userID int32
+	for _, userSetting := range userSettings {
+		discord := userSetting.GetDiscord()
+		if discord.GetLinkCode() == code && discord.GetLinkCodeExpireTime().AsTime().After(time.Now()) {
+			userID = userSetting.UserId
+			break
+		}
+	}
+	if userID == 0 {
+		return nil, nil
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user")
+	}
+	if user == nil || user.RowStatus == store.Archived || checkUserNotSuspended(ctx, s.Store, user) != nil {
+		return nil, nil
+	}
+
+	for _, userSetting := range userSettings {
+		if userSetting.UserId != userID && userSetting.GetDiscord().GetUserId() == discordUserID {
+			if err := s.Store.UpsertUserDiscord(ctx, userSetting.UserId, &storepb.DiscordUserSetting{}); err != nil {
+				return nil, errors.Wrap(err, "failed to unlink user discord")
+			}
+		}
+	}
+	if err := s.Store.UpsertUserDiscord(ctx, userID, &storepb.DiscordUserSetting{
+		UserId: discordUserID,
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to link user discord")
+	}
+	return user, nil
+}
+
+// findDiscordUser returns the user linked to the Discord account, nil if there is none or if the user can no longer create memos.
+func (s *APIV1Service) findDiscordUser(ctx context.Context, discordUserID string) (*store.User, error) {
+	if discordUserID == "" {
+		return nil, nil
+	}
+	userSettings, err := s.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSetting_DISCORD,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list user discords")
+	}
+	for _, userSetting := range userSettings {
+		if userSetting.GetDiscord().GetUserId() != discordUserID {
+			continue
+		}
+		user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userSetting.UserId})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get user")
+		}
+		if user == nil || user.RowStatus == store.Archived || checkUserNotSuspended(ctx, s.Store, user) != nil {
+			return nil, nil
+		}
+		return user, nil
+	}
+	return nil, nil
+}
+
+// convertUserDiscordFromStore converts the Discord account of a user, with its unexpired link code if requested.
+func convertUserDiscordFromStore(name string, discord *storepb.DiscordUserSetting, withLinkCode bool) *v1pb.UserDiscord {
+	userDiscord := &v1pb.UserDiscord{
+		Name:   name,
+		Linked: discord.UserId != "",
+		UserId: discord.UserId,
+	}
+	if withLinkCode && discord.LinkCode != "" {
+		userDiscord.LinkCode = discord.LinkCode
+		userDiscord.LinkCodeExpireTime = discord.LinkCodeExpireTime
+	}
+	return userDiscord
+}
+
+// getDiscordOwner returns the current user if the Discord account belongs to them.
+func (s *APIV1Service) getDiscordOwner(ctx context.Context, name string) (*store.User, error) {
+	userID, err := extractUserIDFromDiscordName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid discord name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return currentUser, nil
+}
+
+// extractUserIDFromDiscordName returns the user ID from a discord name.
+// e.g., "users/1/discord" -> 1.
+func extractUserIDFromDiscordName(name string) (int32, error) {
+	userName, ok := strings.CutSuffix(name, discordNameSuffix)
+	if !ok {
+		return 0, errors.Errorf("invalid discord name %q", name)
+	}
+	return ExtractUserIDFromName(userName)
+}
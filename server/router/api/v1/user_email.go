@@ -0,0 +1,321 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/email"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// EmailVerificationAudienceName is the audience name of the email verification token.
+	EmailVerificationAudienceName = "user.email-verification"
+	// PasswordResetAudienceName is the audience name of the password reset token.
+	PasswordResetAudienceName = "user.password-reset"
+
+	emailVerificationTokenDuration = 24 * time.Hour
+	passwordResetTokenDuration     = time.Hour
+
+	invalidEmailTokenError = "invalid or expired token"
+)
+
+// userEmailClaims are the claims of the tokens sent by email.
+type userEmailClaims struct {
+	// Binding is the digest of the email to verify, or of the password hash to reset,
+	// so that the token is void once the email or the password has changed.
+	Binding string `json:"binding"`
+	jwt.RegisteredClaims
+}
+
+func (s *APIV1Service) VerifyEmail(ctx context.Context, request *v1pb.VerifyEmailRequest) (*emptypb.Empty, error) {
+	user, claims, err := s.parseUserEmailToken(ctx, request.Token, EmailVerificationAudienceName)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Binding != emailTokenBinding(user.Email) {
+		return nil, status.Errorf(codes.InvalidArgument, invalidEmailTokenError)
+	}
+	if err := s.Store.UpsertUserEmailVerification(ctx, user.ID, &storepb.EmailVerificationUserSetting{Pending: false}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user email verification: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) SendVerificationEmail(ctx context.Context, request *v1pb.SendVerificationEmailRequest) (*emptypb.Empty, error) {
+	setting, err := s.getConfiguredWorkspaceEmailSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	users, err := s.listUsersByEmail(ctx, request.Email)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		emailVerification, err := s.Store.GetUserEmailVerification(ctx, user.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user email verification: %v", err)
+		}
+		if !emailVerification.Pending {
+			continue
+		}
+		if err := s.sendVerificationEmail(setting, user); err != nil {
+			return nil, err
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) RequestPasswordReset(ctx context.Context, request *v1pb.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	setting, err := s.getConfiguredWorkspaceEmailSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !setting.AllowPasswordReset {
+		return nil, status.Errorf(codes.FailedPrecondition, "password reset is not allowed")
+	}
+	workspaceGeneralSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace general setting: %v", err)
+	}
+	users, err := s.listUsersByEmail(ctx, request.Email)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		if user.RowStatus == store.Archived {
+			continue
+		}
+		// In sso-only mode, the passwords of the users but the host are unused.
+		if workspaceGeneralSetting.SsoOnly && user.Role != store.RoleHost {
+			continue
+		}
+		token, err := s.generateUserEmailToken(user, PasswordResetAudienceName, emailTokenBinding(user.PasswordHash), passwordResetTokenDuration)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate password reset token: %v", err)
+		}
+		email.SendAsync(convertWorkspaceEmailSettingToConfig(setting), &email.Message{
+			To:      user.Email,
+			Subject: "Reset your password",
+			Body: fmt.Sprintf("Hello %s,\n\nSomeone asked to reset the password of your account. Choose a new password within an hour with:\n\n%s\n\nIf it was not you, ignore this email: your password is unchanged.\n",
+				user.Username, s.buildEmailTokenLink("/auth/reset-password", token)),
+		})
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) ResetPassword(ctx context.Context, request *v1pb.ResetPasswordRequest) (*emptypb.Empty, error) {
+	setting, err := s.Store.GetWorkspaceEmailSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace email setting: %v", err)
+	}
+	if !setting.AllowPasswordReset {
+		return nil, status.Errorf(codes.FailedPrecondition, "password reset is not allowed")
+	}
+	user, claims, err := s.parseUserEmailToken(ctx, request.Token, PasswordResetAudienceName)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Binding != emailTokenBinding(user.PasswordHash) || user.RowStatus == store.Archived {
+		return nil, status.Errorf(codes.InvalidArgument, invalidEmailTokenError)
+	}
+	if err := s.checkPasswordPolicy(ctx, request.Password); err != nil {
+		return nil, err
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate password hash: %v", err)
+	}
+	passwordHashStr := string(passwordHash)
+	if _, err := s.Store.UpdateUser(ctx, &store.UpdateUser{
+		ID:           user.ID,
+		PasswordHash: &passwordHashStr,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
+	}
+	// The user has chosen the password themselves.
+	if err := s.updatePasswordChangeRequirement(ctx, user, user); err != nil {
+		return nil, err
+	}
+	if err := s.Store.RemoveAllUserSessions(ctx, user.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove user sessions: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// checkSignUpEmail returns an InvalidArgument error if the workspace requires the users signing up to verify a valid email.
+func (s *APIV1Service) checkSignUpEmail(ctx context.Context, userEmail string) error {
+	setting, err := s.Store.GetWorkspaceEmailSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace email setting: %v", err)
+	}
+	if setting.RequireEmailVerification && !util.ValidateEmail(userEmail) {
+		return status.Errorf(codes.InvalidArgument, "a valid email is required to sign up")
+	}
+	return nil
+}
+
+// startEmailVerification marks the email of the user who signed up as pending, and sends them the verification email,
+// if the workspace requires it.
+func (s *APIV1Service) startEmailVerification(ctx context.Context, user *store.User) error {
+	setting, err := s.Store.GetWorkspaceEmailSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace email setting: %v", err)
+	}
+	if !setting.RequireEmailVerification {
+		return nil
+	}
+	if err := s.Store.UpsertUserEmailVerification(ctx, user.ID, &storepb.EmailVerificationUserSetting{Pending: true}); err != nil {
+		return status.Errorf(codes.Internal, "failed to upsert user email verification: %v", err)
+	}
+	if setting.SmtpHost == "" {
+		// The user can ask for the email again once the workspace has configured it.
+		return nil
+	}
+	return s.sendVerificationEmail(setting, user)
+}
+
+// checkEmailVerified returns a PermissionDenied error if the user has not verified their email while the workspace requires it.
+func (s *APIV1Service) checkEmailVerified(ctx context.Context, user *store.User) error {
+	setting, err := s.Store.GetWorkspaceEmailSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace email setting: %v", err)
+	}
+	if !setting.RequireEmailVerification {
+		return nil
+	}
+	emailVerification, err := s.Store.GetUserEmailVerification(ctx, user.ID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user email verification: %v", err)
+	}
+	if emailVerification.Pending {
+		return status.Errorf(codes.PermissionDenied, "email address is not verified")
+	}
+	return nil
+}
+
+func (s *APIV1Service) sendVerificationEmail(setting *storepb.WorkspaceEmailSetting, user *store.User) error {
+	token, err := s.generateUserEmailToken(user, EmailVerificationAudienceName, emailTokenBinding(user.Email), emailVerificationTokenDuration)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to generate email verification token: %v", err)
+	}
+	email.SendAsync(convertWorkspaceEmailSettingToConfig(setting), &email.Message{
+		To:      user.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf("Hello %s,\n\nConfirm your email address within a day with:\n\n%s\n\nIf you did not sign up, ignore this email.\n",
+			user.Username, s.buildEmailTokenLink("/auth/verify-email", token)),
+	})
+	return nil
+}
+
+// getConfiguredWorkspaceEmailSetting returns the workspace email setting, or a FailedPrecondition error if no SMTP server is configured.
+func (s *APIV1Service) getConfiguredWorkspaceEmailSetting(ctx context.Context) (*storepb.WorkspaceEmailSetting, error) {
+	setting, err := s.Store.GetWorkspaceEmailSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace email setting: %v", err)
+	}
+	if setting.SmtpHost == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "email is not configured")
+	}
+	return setting, nil
+}
+
+// listUsersByEmail returns the users with the email, which is not unique.
+func (s *APIV1Service) listUsersByEmail(ctx context.Context, userEmail string) ([]*store.User, error) {
+	if !util.ValidateEmail(userEmail) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid email: %s", userEmail)
+	}
+	users, err := s.Store.ListUsers(ctx, &store.FindUser{
+		Email: &userEmail,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
+	}
+	return users, nil
+}
+
+// buildEmailTokenLink returns the link of the page using the token, or the token alone without instance URL.
+func (s *APIV1Service) buildEmailTokenLink(path, token string) string {
+	if s.Profile.InstanceURL == "" {
+		return token
+	}
+	return strings.TrimSuffix(s.Profile.InstanceURL, "/") + path + "?token=" + url.QueryEscape(token)
+}
+
+func (s *APIV1Service) generateUserEmailToken(user *store.User, audience, binding string, duration time.Duration) (string, error) {
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &userEmailClaims{
+		Binding: binding,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    Issuer,
+			Audience:  jwt.ClaimStrings{audience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(duration)),
+			Subject:   fmt.Sprint(user.ID),
+		},
+	})
+	token.Header["kid"] = KeyID
+	return token.SignedString([]byte(s.Secret))
+}
+
+// parseUserEmailToken returns the user and the claims of the token sent by email for the audience.
+func (s *APIV1Service) parseUserEmailToken(ctx context.Context, token, audience string) (*store.User, *userEmailClaims, error) {
+	claims := &userEmailClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		if kid, ok := t.Header["kid"].(string); ok && kid == KeyID {
+			return []byte(s.Secret), nil
+		}
+		return nil, errors.Errorf("unexpected token kid=%v", t.Header["kid"])
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Name}), jwt.WithAudience(audience), jwt.WithIssuer(Issuer), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, invalidEmailTokenError)
+	}
+	userID, err := util.ConvertStringToInt32(claims.Subject)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, invalidEmailTokenError)
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, invalidEmailTokenError)
+	}
+	return user, claims, nil
+}
+
+// emailTokenBinding returns the digest binding a token to the value.
+func emailTokenBinding(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+func convertWorkspaceEmailSettingToConfig(setting *storepb.WorkspaceEmailSetting) *email.Config {
+	return &email.Config{
+		Host:      setting.SmtpHost,
+		Port:      int(setting.SmtpPort),
+		Username:  setting.SmtpUsername,
+		Password:  setting.SmtpPassword,
+		FromEmail: setting.FromEmail,
+		FromName:  setting.FromName,
+		TLS:       setting.UseTls,
+	}
+}
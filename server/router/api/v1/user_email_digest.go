@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+const emailDigestNameSuffix = "/emailDigest"
+
+func (s *APIV1Service) GetUserEmailDigest(ctx context.Context, request *v1pb.GetUserEmailDigestRequest) (*v1pb.UserEmailDigest, error) {
+	userID, err := extractUserIDFromEmailDigestName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid email digest name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	emailDigest, err := s.Store.GetUserEmailDigest(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user email digest: %v", err)
+	}
+	return convertUserEmailDigestFromStore(request.Name, emailDigest), nil
+}
+
+func (s *APIV1Service) UpdateUserEmailDigest(ctx context.Context, request *v1pb.UpdateUserEmailDigestRequest) (*v1pb.UserEmailDigest, error) {
+	update := request.EmailDigest
+	if update == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "email digest is required")
+	}
+	userID, err := extractUserIDFromEmailDigestName(update.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid email digest name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	if _, ok := v1pb.UserEmailDigest_Frequency_name[int32(update.Frequency)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid frequency: %v", update.Frequency)
+	}
+	if update.Hour < 0 || update.Hour > 23 {
+		return nil, status.Errorf(codes.InvalidArgument, "hour must be between 0 and 23")
+	}
+	if update.Weekday < 0 || update.Weekday > 6 {
+		return nil, status.Errorf(codes.InvalidArgument, "weekday must be between 0 and 6")
+	}
+	if _, err := time.LoadLocation(update.TimeZone); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid time zone: %v", err)
+	}
+
+	// The first digest is sent at the next scheduled time, summarizing the period since the update.
+	emailDigest := &storepb.EmailDigestUserSetting{
+		Frequency:    storepb.EmailDigestUserSetting_Frequency(update.Frequency),
+		Hour:         update.Hour,
+		Weekday:      update.Weekday,
+		TimeZone:     update.TimeZone,
+		LastSendTime: timestamppb.Now(),
+	}
+	if err := s.Store.UpsertUserEmailDigest(ctx, userID, emailDigest); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user email digest: %v", err)
+	}
+	return convertUserEmailDigestFromStore(update.Name, emailDigest), nil
+}
+
+func convertUserEmailDigestFromStore(name string, emailDigest *storepb.EmailDigestUserSetting) *v1pb.UserEmailDigest {
+	return &v1pb.UserEmailDigest{
+		Name:         name,
+		Frequency:    v1pb.UserEmailDigest_Frequency(emailDigest.Frequency),
+		Hour:         emailDigest.Hour,
+		Weekday:      emailDigest.Weekday,
+		TimeZone:     emailDigest.TimeZone,
+		LastSendTime: emailDigest.LastSendTime,
+	}
+}
+
+// extractUserIDFromEmailDigestName returns the user ID from an email digest name.
+// e.g., "users/1/emailDigest" -> 1.
+func extractUserIDFromEmailDigestName(name string) (int32, error) {
+	userName, ok := strings.CutSuffix(name, emailDigestNameSuffix)
+	if !ok {
+		return 0, errors.Errorf("invalid email digest name %q", name)
+	}
+	return ExtractUserIDFromName(userName)
+}
@@ -0,0 +1,135 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/usememos/memos/plugin/gist"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const gistSyncNameSuffix = "/gistSync"
+
+func (s *APIV1Service) GetUserGistSync(ctx context.Context, request *v1pb.GetUserGistSyncRequest) (*v1pb.UserGistSync, error) {
+	user, err := s.getGistSyncOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	setting, err := s.Store.GetUserGistSync(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user gist sync: %v", err)
+	}
+	return convertUserGistSyncFromStore(request.Name, setting), nil
+}
+
+func (s *APIV1Service) ConnectUserGistSync(ctx context.Context, request *v1pb.ConnectUserGistSyncRequest) (*v1pb.UserGistSync, error) {
+	user, err := s.getGistSyncOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	token := strings.TrimSpace(request.Token)
+	if token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token is required")
+	}
+	setting, err := s.Store.GetUserGistSync(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user gist sync: %v", err)
+	}
+	// The gists already synced are updated if they belong to the account, and created again otherwise.
+	setting.Token = token
+	setting.LastSyncTime = nil
+	setting.LastSyncError = ""
+	if err := s.Store.UpsertUserGistSync(ctx, user.ID, setting); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user gist sync: %v", err)
+	}
+	return convertUserGistSyncFromStore(request.Name, setting), nil
+}
+
+func (s *APIV1Service) DisconnectUserGistSync(ctx context.Context, request *v1pb.DisconnectUserGistSyncRequest) (*v1pb.UserGistSync, error) {
+	user, err := s.getGistSyncOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	setting, err := s.Store.GetUserGistSync(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user gist sync: %v", err)
+	}
+	// The gists of the memos are kept, to be updated rather than duplicated if the account is connected again.
+	setting.Token = ""
+	setting.LastSyncTime = nil
+	setting.LastSyncError = ""
+	if err := s.Store.UpsertUserGistSync(ctx, user.ID, setting); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user gist sync: %v", err)
+	}
+	return convertUserGistSyncFromStore(request.Name, setting), nil
+}
+
+func convertUserGistSyncFromStore(name string, setting *storepb.GistSyncUserSetting) *v1pb.UserGistSync {
+	gistURLs := map[string]string{}
+	for memoUID, memoGist := range setting.MemoGists {
+		gistURLs[fmt.Sprintf("%s%s", MemoNamePrefix, memoUID)] = memoGist.Url
+	}
+	return &v1pb.UserGistSync{
+		Name:          name,
+		Connected:     setting.Token != "",
+		LastSyncTime:  setting.LastSyncTime,
+		LastSyncError: setting.LastSyncError,
+		GistUrls:      gistURLs,
+	}
+}
+
+// getGistSyncOwner returns the current user if the gist sync belongs to them.
+// The access token of the GitHub account is a secret of its user, so it is not managed by the admins either.
+func (s *APIV1Service) getGistSyncOwner(ctx context.Context, name string) (*store.User, error) {
+	userID, err := extractUserIDFromGistSyncName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid gist sync name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return currentUser, nil
+}
+
+// extractUserIDFromGistSyncName returns the user ID from a gist sync name.
+// e.g., "users/1/gistSync" -> 1.
+func extractUserIDFromGistSyncName(name string) (int32, error) {
+	userName, ok := strings.CutSuffix(name, gistSyncNameSuffix)
+	if !ok {
+		return 0, errors.Errorf("invalid gist sync name %q", name)
+	}
+	return ExtractUserIDFromName(userName)
+}
+
+// NewGistHandler returns the handler pulling the gists edited on GitHub into the memos.
+func (s *APIV1Service) NewGistHandler() gist.Handler {
+	return &gistHandler{service: s}
+}
+
+type gistHandler struct {
+	service *APIV1Service
+}
+
+// UpdateMemoContent replaces the content of the memo as the user, as if they edited it.
+func (h *gistHandler) UpdateMemoContent(ctx context.Context, userID int32, memoUID string, content string) error {
+	userCtx := context.WithValue(ctx, userIDContextKey, userID)
+	if _, err := h.service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: fmt.Sprintf("%s%s", MemoNamePrefix, memoUID), Content: content},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	}); err != nil {
+		return errors.Wrap(err, "failed to update memo")
+	}
+	return nil
+}
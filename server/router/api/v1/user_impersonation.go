@@ -0,0 +1,102 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// defaultImpersonationDuration is the duration of the impersonation sessions requested without expiration.
+	defaultImpersonationDuration = time.Hour
+	// maxImpersonationDuration bounds the duration of the impersonation sessions.
+	maxImpersonationDuration = 8 * time.Hour
+)
+
+func (s *APIV1Service) CreateImpersonationSession(ctx context.Context, request *v1pb.CreateImpersonationSessionRequest) (*v1pb.CreateSessionResponse, error) {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || currentUser.Role != store.RoleHost {
+		return nil, status.Errorf(codes.PermissionDenied, "only the host can impersonate users")
+	}
+	userID, err := ExtractUserIDFromName(request.User)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil || user.ID == store.SystemBotID {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	if user.Role == store.RoleHost {
+		return nil, status.Errorf(codes.InvalidArgument, "the host cannot be impersonated")
+	}
+	if user.RowStatus == store.Archived {
+		return nil, status.Errorf(codes.FailedPrecondition, "user has been archived with username %s", user.Username)
+	}
+
+	now := time.Now()
+	expireTime := now.Add(defaultImpersonationDuration)
+	if request.ExpireTime != nil {
+		expireTime = request.ExpireTime.AsTime()
+		if !expireTime.After(now) || expireTime.After(now.Add(maxImpersonationDuration)) {
+			return nil, status.Errorf(codes.InvalidArgument, "expire time must be in the next %v", maxImpersonationDuration)
+		}
+	}
+	sessionID, err := GenerateSessionID()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate session ID: %v", err)
+	}
+	session := &storepb.SessionsUserSetting_Session{
+		SessionId:        sessionID,
+		CreateTime:       timestamppb.New(now),
+		LastAccessedTime: timestamppb.New(now),
+		ClientInfo:       s.extractClientInfo(ctx),
+		ImpersonatorId:   currentUser.ID,
+		ExpireTime:       timestamppb.New(expireTime),
+	}
+	if err := s.Store.AddUserSession(ctx, user.ID, session); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add user session: %v", err)
+	}
+	if err := s.createImpersonationActivity(ctx, store.ActivityTypeUserImpersonationStart, user.ID, session); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create activity: %v", err)
+	}
+	if err := s.setSessionCookie(ctx, user.ID, sessionID, expireTime); err != nil {
+		return nil, err
+	}
+
+	return &v1pb.CreateSessionResponse{
+		User:           convertUserFromStore(user),
+		LastAccessedAt: session.LastAccessedTime,
+	}, nil
+}
+
+// createImpersonationActivity records the start or the end of the impersonation session of the user by its host.
+func (s *APIV1Service) createImpersonationActivity(ctx context.Context, activityType store.ActivityType, userID int32, session *storepb.SessionsUserSetting_Session) error {
+	_, err := s.Store.CreateActivity(ctx, &store.Activity{
+		CreatorID: session.ImpersonatorId,
+		Type:      activityType,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			UserImpersonation: &storepb.ActivityUserImpersonationPayload{
+				UserId:     userID,
+				SessionId:  session.SessionId,
+				ExpireTime: session.ExpireTime,
+			},
+		},
+	})
+	return err
+}
@@ -0,0 +1,140 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// invitationTokenLength is the length of the secret token of invitations.
+const invitationTokenLength = 32
+
+func (s *APIV1Service) ListInvitations(ctx context.Context, _ *v1pb.ListInvitationsRequest) (*v1pb.ListInvitationsResponse, error) {
+	invitations, err := s.Store.ListInvitations(ctx, &store.FindInvitation{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list invitations: %v", err)
+	}
+	response := &v1pb.ListInvitationsResponse{
+		Invitations: []*v1pb.Invitation{},
+	}
+	for _, invitation := range invitations {
+		response.Invitations = append(response.Invitations, convertInvitationFromStore(invitation))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) CreateInvitation(ctx context.Context, request *v1pb.CreateInvitationRequest) (*v1pb.Invitation, error) {
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if request.Invitation == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invitation is required")
+	}
+
+	create := &store.Invitation{
+		CreatorID: currentUser.ID,
+		Role:      store.RoleUser,
+		MaxUses:   request.Invitation.MaxUses,
+	}
+	switch request.Invitation.Role {
+	case v1pb.User_ROLE_UNSPECIFIED, v1pb.User_USER:
+	case v1pb.User_ADMIN:
+		// Like the users created directly, only the host can preset a role.
+		if currentUser.Role != store.RoleHost {
+			return nil, status.Errorf(codes.PermissionDenied, "only the host can invite admins")
+		}
+		create.Role = store.RoleAdmin
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid invitation role: %v", request.Invitation.Role)
+	}
+	if create.MaxUses < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "max uses must not be negative")
+	}
+	if request.Invitation.ExpireTime != nil {
+		expireTime := request.Invitation.ExpireTime.AsTime()
+		if !expireTime.After(time.Now()) {
+			return nil, status.Errorf(codes.InvalidArgument, "expire time must be in the future")
+		}
+		create.ExpiresTs = expireTime.Unix()
+	}
+	token, err := util.RandomString(invitationTokenLength)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate invitation token: %v", err)
+	}
+	create.Token = token
+
+	invitation, err := s.Store.CreateInvitation(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create invitation: %v", err)
+	}
+	return convertInvitationFromStore(invitation), nil
+}
+
+func (s *APIV1Service) DeleteInvitation(ctx context.Context, request *v1pb.DeleteInvitationRequest) (*emptypb.Empty, error) {
+	invitationID, err := ExtractInvitationIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid invitation name: %v", err)
+	}
+	invitation, err := s.Store.GetInvitation(ctx, &store.FindInvitation{
+		ID: &invitationID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get invitation: %v", err)
+	}
+	if invitation == nil {
+		return nil, status.Errorf(codes.NotFound, "invitation not found")
+	}
+	if err := s.Store.DeleteInvitation(ctx, &store.DeleteInvitation{ID: invitation.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete invitation: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getValidInvitation returns the invitation of the token, or an error if it has expired or has no use left.
+func (s *APIV1Service) getValidInvitation(ctx context.Context, token string) (*store.Invitation, error) {
+	invitation, err := s.Store.GetInvitation(ctx, &store.FindInvitation{
+		Token: &token,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get invitation: %v", err)
+	}
+	if invitation == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid invitation")
+	}
+	if invitation.ExpiresTs != 0 && time.Now().Unix() >= invitation.ExpiresTs {
+		return nil, status.Errorf(codes.FailedPrecondition, "invitation has expired")
+	}
+	if invitation.MaxUses != 0 && invitation.UseCount >= invitation.MaxUses {
+		return nil, status.Errorf(codes.FailedPrecondition, "invitation has no use left")
+	}
+	return invitation, nil
+}
+
+func convertInvitationFromStore(invitation *store.Invitation) *v1pb.Invitation {
+	invitationMessage := &v1pb.Invitation{
+		Name:       fmt.Sprintf("%s%d", InvitationNamePrefix, invitation.ID),
+		Creator:    fmt.Sprintf("%s%d", UserNamePrefix, invitation.CreatorID),
+		Token:      invitation.Token,
+		Role:       convertUserRoleFromStore(invitation.Role),
+		MaxUses:    invitation.MaxUses,
+		UseCount:   invitation.UseCount,
+		CreateTime: timestamppb.New(time.Unix(invitation.CreatedTs, 0)),
+	}
+	if invitation.ExpiresTs != 0 {
+		invitationMessage.ExpireTime = timestamppb.New(time.Unix(invitation.ExpiresTs, 0))
+	}
+	return invitationMessage
+}
@@ -0,0 +1,257 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/mailin"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	memoEmailNameSuffix = "/memoEmail"
+	// memoEmailTokenLength is the length of the random local part of the addresses, enough not to be guessed.
+	memoEmailTokenLength = 24
+)
+
+func (s *APIV1Service) GetUserMemoEmail(ctx context.Context, request *v1pb.GetUserMemoEmailRequest) (*v1pb.UserMemoEmail, error) {
+	user, err := s.getMemoEmailOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	memoEmail, err := s.Store.GetUserMemoEmail(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user memo email: %v", err)
+	}
+	return s.convertUserMemoEmailFromStore(ctx, request.Name, memoEmail)
+}
+
+func (s *APIV1Service) ResetUserMemoEmail(ctx context.Context, request *v1pb.ResetUserMemoEmailRequest) (*v1pb.UserMemoEmail, error) {
+	user, err := s.getMemoEmailOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	token, err := util.RandomString(memoEmailTokenLength)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate memo email token: %v", err)
+	}
+	// The local parts are lowercase, as some mail servers do not keep their case.
+	token = strings.ToLower(token)
+	address, err := s.getMemoEmailAddress(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if address == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "email-to-memo is not enabled on this instance")
+	}
+	memoEmail := &storepb.MemoEmailUserSetting{Token: token}
+	if err := s.Store.UpsertUserMemoEmail(ctx, user.ID, memoEmail); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user memo email: %v", err)
+	}
+	return s.convertUserMemoEmailFromStore(ctx, request.Name, memoEmail)
+}
+
+func (s *APIV1Service) DisableUserMemoEmail(ctx context.Context, request *v1pb.DisableUserMemoEmailRequest) (*v1pb.UserMemoEmail, error) {
+	user, err := s.getMemoEmailOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	memoEmail := &storepb.MemoEmailUserSetting{}
+	if err := s.Store.UpsertUserMemoEmail(ctx, user.ID, memoEmail); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user memo email: %v", err)
+	}
+	return s.convertUserMemoEmailFromStore(ctx, request.Name, memoEmail)
+}
+
+// NewMemoEmailHandler returns the handler creating the memos emailed by the users to their addresses.
+func (s *APIV1Service) NewMemoEmailHandler() mailin.Handler {
+	return &memoEmailHandler{service: s}
+}
+
+type memoEmailHandler struct {
+	service *APIV1Service
+}
+
+func (h *memoEmailHandler) Accept(ctx context.Context, recipient string) bool {
+	user, err := h.service.findMemoEmailUser(ctx, recipient)
+	if err != nil {
+		slog.Error("failed to find memo email user", slog.Any("err", err))
+		return false
+	}
+	return user != nil
+}
+
+// Handle creates a memo of the subject and the text of the message as the user, with its attachments.
+// The attachments failing to be created are left out rather than failing the memo.
+func (h *memoEmailHandler) Handle(ctx context.Context, recipient string, message *mailin.Message) error {
+	s := h.service
+	user, err := s.findMemoEmailUser(ctx, recipient)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return errors.Wrap(mailin.ErrRejected, "mailbox unavailable")
+	}
+	content := message.Text
+	if message.Subject != "" {
+		content = strings.TrimSpace("# " + message.Subject + "\n\n" + content)
+	}
+	if content == "" && len(message.Attachments) == 0 {
+		return errors.Wrap(mailin.ErrRejected, "message is empty")
+	}
+
+	visibility, err := s.getUserDefaultVisibility(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	ctx = context.WithValue(ctx, userIDContextKey, user.ID)
+	memo, err := s.CreateMemo(ctx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:    content,
+			Visibility: visibility,
+		},
+	})
+	if err != nil {
+		if code := status.Code(err); code == codes.InvalidArgument || code == codes.PermissionDenied {
+			return errors.Wrap(mailin.ErrRejected, status.Convert(err).Message())
+		}
+		return errors.Wrap(err, "failed to create memo")
+	}
+	for _, attachment := range message.Attachments {
+		if _, err := s.CreateAttachment(ctx, &v1pb.CreateAttachmentRequest{
+			Attachment: &v1pb.Attachment{
+				Filename: attachment.Filename,
+				Type:     attachment.Type,
+				Content:  attachment.Content,
+				Memo:     &memo.Name,
+			},
+		}); err != nil {
+			slog.Warn("Failed to create emailed attachment", slog.String("memo", memo.Name), slog.String("filename", attachment.Filename), slog.Any("err", err))
+		}
+	}
+	return nil
+}
+
+// findMemoEmailUser returns the user receiving the memos at the address, nil if there is none or if the user
+// can no longer create memos. The domain is ignored and the token is either the local part, followed by
+// an optional subaddress so that the emails can be filtered by the mail clients, or the subaddress itself,
+// so that a shared mailbox fetched over IMAP receives the memos of all the users.
+func (s *APIV1Service) findMemoEmailUser(ctx context.Context, address string) (*store.User, error) {
+	localPart := address
+	if index := strings.LastIndex(address, "@"); index >= 0 {
+		localPart = address[:index]
+	}
+	mailbox, subaddress, _ := strings.Cut(strings.ToLower(localPart), "+")
+	if mailbox == "" {
+		return nil, nil
+	}
+
+	userSettings, err := s.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSetting_MEMO_EMAIL,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list user memo emails")
+	}
+	for _, userSetting := range userSettings {
+		token := userSetting.GetMemoEmail().GetToken()
+		if token == "" || (token != mailbox && token != subaddress) {
+			continue
+		}
+		user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userSetting.UserId})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get user")
+		}
+		if user == nil || user.RowStatus == store.Archived || checkUserNotSuspended(ctx, s.Store, user) != nil {
+			return nil, nil
+		}
+		return user, nil
+	}
+	return nil, nil
+}
+
+// getUserDefaultVisibility returns the visibility of the new memos of the user, from their general setting.
+func (s *APIV1Service) getUserDefaultVisibility(ctx context.Context, userID int32) (v1pb.Visibility, error) {
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_GENERAL,
+	})
+	if err != nil {
+		return v1pb.Visibility_VISIBILITY_UNSPECIFIED, errors.Wrap(err, "failed to get user general setting")
+	}
+	if visibility, ok := v1pb.Visibility_value[userSetting.GetGeneral().GetMemoVisibility()]; ok && visibility != int32(v1pb.Visibility_VISIBILITY_UNSPECIFIED) {
+		return v1pb.Visibility(visibility), nil
+	}
+	return v1pb.Visibility_PRIVATE, nil
+}
+
+func (s *APIV1Service) convertUserMemoEmailFromStore(ctx context.Context, name string, memoEmail *storepb.MemoEmailUserSetting) (*v1pb.UserMemoEmail, error) {
+	userMemoEmail := &v1pb.UserMemoEmail{
+		Name: name,
+	}
+	if memoEmail.Token == "" {
+		return userMemoEmail, nil
+	}
+	address, err := s.getMemoEmailAddress(ctx, memoEmail.Token)
+	if err != nil {
+		return nil, err
+	}
+	userMemoEmail.Enabled = address != ""
+	userMemoEmail.Address = address
+	return userMemoEmail, nil
+}
+
+// getMemoEmailAddress returns the address of the token at the built-in SMTP server, or else its subaddress
+// of the IMAP mailbox, if its username is an address. It returns an empty address if neither receives emails.
+func (s *APIV1Service) getMemoEmailAddress(ctx context.Context, token string) (string, error) {
+	if s.Profile.SMTPAddr != "" {
+		return fmt.Sprintf("%s@%s", token, s.Profile.GetSMTPDomain()), nil
+	}
+	emailSetting, err := s.Store.GetWorkspaceEmailSetting(ctx)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get workspace email setting: %v", err)
+	}
+	if emailSetting.ImapHost == "" {
+		return "", nil
+	}
+	mailbox, domain, ok := strings.Cut(emailSetting.ImapUsername, "@")
+	if !ok {
+		return "", nil
+	}
+	return fmt.Sprintf("%s+%s@%s", mailbox, token, domain), nil
+}
+
+// getMemoEmailOwner returns the current user if the memo email belongs to them.
+// The address is a secret of its user, so it is not shown to the admins either.
+func (s *APIV1Service) getMemoEmailOwner(ctx context.Context, name string) (*store.User, error) {
+	userID, err := extractUserIDFromMemoEmailName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo email name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return currentUser, nil
+}
+
+// extractUserIDFromMemoEmailName returns the user ID from a memo email name.
+// e.g., "users/1/memoEmail" -> 1.
+func extractUserIDFromMemoEmailName(name string) (int32, error) {
+	userName, ok := strings.CutSuffix(name, memoEmailNameSuffix)
+	if !ok {
+		return 0, errors.Errorf("invalid memo email name %q", name)
+	}
+	return ExtractUserIDFromName(userName)
+}
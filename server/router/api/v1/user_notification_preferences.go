@@ -0,0 +1,165 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/email"
+	"github.com/usememos/memos/plugin/webpush"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const notificationPreferencesNameSuffix = "/notificationPreferences"
+
+func (s *APIV1Service) GetUserNotificationPreferences(ctx context.Context, request *v1pb.GetUserNotificationPreferencesRequest) (*v1pb.UserNotificationPreferences, error) {
+	userID, err := extractUserIDFromNotificationPreferencesName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid notification preferences name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	preferences, err := s.Store.GetUserNotificationPreferences(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get notification preferences: %v", err)
+	}
+	return convertUserNotificationPreferencesFromStore(request.Name, preferences), nil
+}
+
+func (s *APIV1Service) UpdateUserNotificationPreferences(ctx context.Context, request *v1pb.UpdateUserNotificationPreferencesRequest) (*v1pb.UserNotificationPreferences, error) {
+	if request.NotificationPreferences == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "notification preferences are required")
+	}
+	name := request.NotificationPreferences.Name
+	userID, err := extractUserIDFromNotificationPreferencesName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid notification preferences name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	preferences, err := s.Store.GetUserNotificationPreferences(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get notification preferences: %v", err)
+	}
+	for _, update := range request.NotificationPreferences.Preferences {
+		event := storepb.NotificationPreferencesUserSetting_Event(update.Event)
+		if _, ok := storepb.NotificationPreferencesUserSetting_Event_name[int32(event)]; !ok || event == storepb.NotificationPreferencesUserSetting_EVENT_UNSPECIFIED {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid event: %v", update.Event)
+		}
+		for _, preference := range preferences {
+			if preference.Event == event {
+				preference.Inbox = update.Inbox
+				preference.Email = update.Email
+				preference.Push = update.Push
+			}
+		}
+	}
+	if err := s.Store.UpsertUserNotificationPreferences(ctx, userID, preferences); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update notification preferences: %v", err)
+	}
+	return convertUserNotificationPreferencesFromStore(name, preferences), nil
+}
+
+// sendInboxNotification sends the notification of the inbox message to the receiver with the channels of the preference.
+func (s *APIV1Service) sendInboxNotification(ctx context.Context, inbox *store.Inbox, preference *storepb.NotificationPreferencesUserSetting_Preference) {
+	notification, err := s.getInboxNotification(ctx, inbox)
+	if err != nil {
+		slog.Warn("Failed to get inbox notification", slog.Int("inbox", int(inbox.ID)), slog.Any("err", err))
+		return
+	}
+	if notification == nil {
+		return
+	}
+	if preference.Push {
+		s.pushInboxNotification(ctx, inbox, notification)
+	}
+	if preference.Email {
+		if err := s.emailInboxNotification(ctx, inbox, notification); err != nil {
+			slog.Warn("Failed to email inbox notification", slog.Int("user", int(inbox.ReceiverID)), slog.Any("err", err))
+		}
+	}
+}
+
+// emailInboxNotification emails the notification of the inbox message to the receiver, if the workspace has an SMTP
+// server and the receiver a verified email.
+func (s *APIV1Service) emailInboxNotification(ctx context.Context, inbox *store.Inbox, notification *webpush.Notification) error {
+	setting, err := s.Store.GetWorkspaceEmailSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace email setting")
+	}
+	if setting.SmtpHost == "" {
+		return nil
+	}
+	receiver, err := s.Store.GetUser(ctx, &store.FindUser{ID: &inbox.ReceiverID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get receiver")
+	}
+	if receiver == nil || receiver.Email == "" {
+		return nil
+	}
+	emailVerification, err := s.Store.GetUserEmailVerification(ctx, receiver.ID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get user email verification")
+	}
+	if emailVerification.Pending {
+		return nil
+	}
+	return email.Send(convertWorkspaceEmailSettingToConfig(setting), &email.Message{
+		To:      receiver.Email,
+		Subject: notification.Title,
+		Body: fmt.Sprintf("%s\n\n%s\n\nChoose the notifications you receive in your settings.\n",
+			notification.Body, notification.URL),
+	})
+}
+
+// getInboxMessageEvent returns the event of the inbox message, whose preference notifies the receiver.
+func getInboxMessageEvent(message *storepb.InboxMessage) storepb.NotificationPreferencesUserSetting_Event {
+	switch message.GetType() {
+	case storepb.InboxMessage_MEMO_MENTION:
+		return storepb.NotificationPreferencesUserSetting_MENTION
+	case storepb.InboxMessage_VERSION_UPDATE:
+		return storepb.NotificationPreferencesUserSetting_ANNOUNCEMENT
+	case storepb.InboxMessage_MEMO_REACTION:
+		return storepb.NotificationPreferencesUserSetting_REACTION
+	case storepb.InboxMessage_MEMO_ON_THIS_DAY:
+		return storepb.NotificationPreferencesUserSetting_ON_THIS_DAY
+	default:
+		return storepb.NotificationPreferencesUserSetting_COMMENT
+	}
+}
+
+func convertUserNotificationPreferencesFromStore(name string, preferences []*storepb.NotificationPreferencesUserSetting_Preference) *v1pb.UserNotificationPreferences {
+	notificationPreferences := &v1pb.UserNotificationPreferences{
+		Name:        name,
+		Preferences: []*v1pb.UserNotificationPreferences_Preference{},
+	}
+	for _, preference := range preferences {
+		notificationPreferences.Preferences = append(notificationPreferences.Preferences, &v1pb.UserNotificationPreferences_Preference{
+			Event: v1pb.UserNotificationPreferences_Event(preference.Event),
+			Inbox: preference.Inbox,
+			Email: preference.Email,
+			Push:  preference.Push,
+		})
+	}
+	return notificationPreferences
+}
+
+// extractUserIDFromNotificationPreferencesName returns the user ID from a notification preferences name.
+// e.g., "users/1/notificationPreferences" -> 1.
+func extractUserIDFromNotificationPreferencesName(name string) (int32, error) {
+	userName, ok := strings.CutSuffix(name, notificationPreferencesNameSuffix)
+	if !ok {
+		return 0, errors.Errorf("invalid notification preferences name %q", name)
+	}
+	return ExtractUserIDFromName(userName)
+}
@@ -0,0 +1,245 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/internal/base"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const permissionsNameSuffix = "/permissions"
+
+// adminPermissions are the permissions of the built-in admin role, the host having them all.
+var adminPermissions = []storepb.Permission{
+	storepb.Permission_MANAGE_USERS,
+	storepb.Permission_VIEW_USERS,
+	storepb.Permission_MODERATE_MEMOS,
+	storepb.Permission_MANAGE_WEBHOOKS,
+	storepb.Permission_CHECK_INTEGRITY,
+}
+
+func (s *APIV1Service) GetUserPermissions(ctx context.Context, request *v1pb.GetUserPermissionsRequest) (*v1pb.UserPermissions, error) {
+	userName, ok := strings.CutSuffix(request.Name, permissionsNameSuffix)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid permissions name: %s", request.Name)
+	}
+	userID, err := ExtractUserIDFromName(userName)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if currentUser.ID != userID {
+		if err := s.checkPermission(ctx, currentUser, storepb.Permission_VIEW_USERS); err != nil {
+			return nil, err
+		}
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	return s.getUserPermissionsMessage(ctx, user)
+}
+
+func (s *APIV1Service) SetUserCustomRole(ctx context.Context, request *v1pb.SetUserCustomRoleRequest) (*v1pb.UserPermissions, error) {
+	userID, err := ExtractUserIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+	if err := s.checkPermission(ctx, currentUser, storepb.Permission_MANAGE_USERS); err != nil {
+		return nil, err
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	if user.ID == currentUser.ID || user.Role == store.RoleHost {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot set the custom role of yourself or the host")
+	}
+
+	rolesSetting, err := s.Store.GetWorkspaceRolesSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace roles setting: %v", err)
+	}
+	currentPermissions, err := getUserPermissions(ctx, s.Store, currentUser)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user permissions: %v", err)
+	}
+	// Both the removed and the assigned roles must be within the permissions of the caller,
+	// so that nobody can grant, or take away, more than they have.
+	customRole, err := s.Store.GetUserCustomRole(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user custom role: %v", err)
+	}
+	if role := findCustomRole(rolesSetting, customRole.RoleId); role != nil && !containsAllPermissions(currentPermissions, role.Permissions) {
+		return nil, status.Errorf(codes.PermissionDenied, "the current role of the user has permissions you do not have")
+	}
+	if request.CustomRole != "" {
+		role := findCustomRole(rolesSetting, request.CustomRole)
+		if role == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "custom role %q not found", request.CustomRole)
+		}
+		if !containsAllPermissions(currentPermissions, role.Permissions) {
+			return nil, status.Errorf(codes.PermissionDenied, "the role has permissions you do not have")
+		}
+	}
+
+	if err := s.Store.UpsertUserCustomRole(ctx, user.ID, &storepb.CustomRoleUserSetting{RoleId: request.CustomRole}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user custom role: %v", err)
+	}
+	return s.getUserPermissionsMessage(ctx, user)
+}
+
+func (s *APIV1Service) getUserPermissionsMessage(ctx context.Context, user *store.User) (*v1pb.UserPermissions, error) {
+	customRole, err := s.Store.GetUserCustomRole(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user custom role: %v", err)
+	}
+	permissions, err := getUserPermissions(ctx, s.Store, user)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user permissions: %v", err)
+	}
+	userPermissions := &v1pb.UserPermissions{
+		Name:        fmt.Sprintf("%s%d%s", UserNamePrefix, user.ID, permissionsNameSuffix),
+		CustomRole:  customRole.RoleId,
+		Permissions: []v1pb.Permission{},
+	}
+	for _, permission := range permissions {
+		userPermissions.Permissions = append(userPermissions.Permissions, convertPermissionFromStore(permission))
+	}
+	return userPermissions, nil
+}
+
+// checkPermission returns a PermissionDenied error if the user does not have the permission.
+func (s *APIV1Service) checkPermission(ctx context.Context, user *store.User, permission storepb.Permission) error {
+	ok, err := hasPermission(ctx, s.Store, user, permission)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user permissions: %v", err)
+	}
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+// hasPermission returns whether the built-in or the custom role of the user grants the permission.
+func hasPermission(ctx context.Context, stores *store.Store, user *store.User, permission storepb.Permission) (bool, error) {
+	if user == nil {
+		return false, nil
+	}
+	permissions, err := getUserPermissions(ctx, stores, user)
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(permissions, permission), nil
+}
+
+// getUserPermissions returns the sorted permissions granted by the built-in and the custom roles of the user.
+// A custom role removed from the workspace grants nothing.
+func getUserPermissions(ctx context.Context, stores *store.Store, user *store.User) ([]storepb.Permission, error) {
+	var permissions []storepb.Permission
+	switch user.Role {
+	case store.RoleHost:
+		for value := range storepb.Permission_name {
+			if permission := storepb.Permission(value); permission != storepb.Permission_PERMISSION_UNSPECIFIED {
+				permissions = append(permissions, permission)
+			}
+		}
+	case store.RoleAdmin:
+		permissions = append(permissions, adminPermissions...)
+	}
+
+	customRole, err := stores.GetUserCustomRole(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if customRole.RoleId != "" {
+		rolesSetting, err := stores.GetWorkspaceRolesSetting(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if role := findCustomRole(rolesSetting, customRole.RoleId); role != nil {
+			permissions = append(permissions, role.Permissions...)
+		}
+	}
+	slices.Sort(permissions)
+	return slices.Compact(permissions), nil
+}
+
+func findCustomRole(rolesSetting *storepb.WorkspaceRolesSetting, id string) *storepb.WorkspaceCustomRole {
+	for _, role := range rolesSetting.GetRoles() {
+		if role.Id == id {
+			return role
+		}
+	}
+	return nil
+}
+
+func containsAllPermissions(permissions, required []storepb.Permission) bool {
+	for _, permission := range required {
+		if !slices.Contains(permissions, permission) {
+			return false
+		}
+	}
+	return true
+}
+
+// validateWorkspaceRolesSetting returns an InvalidArgument error if the ids of the roles are invalid or duplicated,
+// or if a role has an unspecified permission.
+func validateWorkspaceRolesSetting(setting *storepb.WorkspaceRolesSetting) error {
+	ids := map[string]bool{}
+	for _, role := range setting.GetRoles() {
+		if !base.UIDMatcher.MatchString(role.Id) {
+			return status.Errorf(codes.InvalidArgument, "invalid custom role id: %s", role.Id)
+		}
+		if ids[role.Id] {
+			return status.Errorf(codes.InvalidArgument, "duplicate custom role id: %s", role.Id)
+		}
+		ids[role.Id] = true
+		for _, permission := range role.Permissions {
+			if _, ok := storepb.Permission_name[int32(permission)]; !ok || permission == storepb.Permission_PERMISSION_UNSPECIFIED {
+				return status.Errorf(codes.InvalidArgument, "invalid permission of custom role %s: %v", role.Id, permission)
+			}
+		}
+	}
+	return nil
+}
+
+// The permissions have the same values in the store and the API.
+func convertPermissionFromStore(permission storepb.Permission) v1pb.Permission {
+	return v1pb.Permission(permission)
+}
+
+func convertPermissionToStore(permission v1pb.Permission) storepb.Permission {
+	return storepb.Permission(permission)
+}
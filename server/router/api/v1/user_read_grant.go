@@ -0,0 +1,178 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListUserReadGrants(ctx context.Context, request *v1pb.ListUserReadGrantsRequest) (*v1pb.ListUserReadGrantsResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	readGrants, err := s.Store.ListReadGrants(ctx, &store.FindReadGrant{
+		CreatorID: &userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list read grants: %v", err)
+	}
+	response := &v1pb.ListUserReadGrantsResponse{
+		ReadGrants: []*v1pb.UserReadGrant{},
+	}
+	for _, readGrant := range readGrants {
+		response.ReadGrants = append(response.ReadGrants, convertReadGrantFromStore(readGrant))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) CreateUserReadGrant(ctx context.Context, request *v1pb.CreateUserReadGrantRequest) (*v1pb.UserReadGrant, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	if request.ReadGrant == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "read grant is required")
+	}
+	granteeID, err := ExtractUserIDFromName(request.ReadGrant.Grantee)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid grantee: %v", err)
+	}
+	if granteeID == userID {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot grant read access to yourself")
+	}
+	grantee, err := s.Store.GetUser(ctx, &store.FindUser{ID: &granteeID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get grantee: %v", err)
+	}
+	if grantee == nil {
+		return nil, status.Errorf(codes.NotFound, "grantee not found")
+	}
+
+	tag := normalizeTagPath(request.ReadGrant.Tag)
+	readGrants, err := s.Store.ListReadGrants(ctx, &store.FindReadGrant{
+		CreatorID: &userID,
+		GranteeID: &granteeID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list read grants: %v", err)
+	}
+	for _, readGrant := range readGrants {
+		if readGrant.Tag == tag {
+			return nil, status.Errorf(codes.AlreadyExists, "read grant already exists")
+		}
+	}
+
+	readGrant, err := s.Store.CreateReadGrant(ctx, &store.ReadGrant{
+		CreatorID: userID,
+		GranteeID: granteeID,
+		Tag:       tag,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create read grant: %v", err)
+	}
+	return convertReadGrantFromStore(readGrant), nil
+}
+
+func (s *APIV1Service) DeleteUserReadGrant(ctx context.Context, request *v1pb.DeleteUserReadGrantRequest) (*emptypb.Empty, error) {
+	userID, readGrantID, err := ExtractReadGrantIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid read grant name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	readGrant, err := s.Store.GetReadGrant(ctx, &store.FindReadGrant{
+		ID:        &readGrantID,
+		CreatorID: &userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get read grant: %v", err)
+	}
+	if readGrant == nil {
+		return nil, status.Errorf(codes.NotFound, "read grant not found")
+	}
+	if err := s.Store.DeleteReadGrant(ctx, &store.DeleteReadGrant{ID: readGrant.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete read grant: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// listGrantedReadGrants returns the read grants given to the user, grouped by the granting user.
+func (s *APIV1Service) listGrantedReadGrants(ctx context.Context, userID int32) (map[int32][]*store.ReadGrant, error) {
+	readGrants, err := s.Store.ListReadGrants(ctx, &store.FindReadGrant{
+		GranteeID: &userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	grantedReadGrants := map[int32][]*store.ReadGrant{}
+	for _, readGrant := range readGrants {
+		grantedReadGrants[readGrant.CreatorID] = append(grantedReadGrants[readGrant.CreatorID], readGrant)
+	}
+	return grantedReadGrants, nil
+}
+
+// canReadMemoViaReadGrant returns true if the creator of the private memo granted the user read access to it.
+func (s *APIV1Service) canReadMemoViaReadGrant(ctx context.Context, userID int32, memo *store.Memo) (bool, error) {
+	if memo.Visibility != store.Private {
+		return false, nil
+	}
+	readGrants, err := s.Store.ListReadGrants(ctx, &store.FindReadGrant{
+		CreatorID: &memo.CreatorID,
+		GranteeID: &userID,
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, readGrant := range readGrants {
+		if readGrant.Tag == "" {
+			return true, nil
+		}
+		for _, tag := range memo.Payload.GetTags() {
+			if isTagOrDescendant(tag, readGrant.Tag) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// buildReadGrantFilter builds a memo filter matching the private memos covered by the read grants of a single creator.
+func buildReadGrantFilter(readGrants []*store.ReadGrant) string {
+	tags := []string{}
+	for _, readGrant := range readGrants {
+		if readGrant.Tag == "" {
+			return fmt.Sprintf(`(creator_id == %d && visibility == "PRIVATE")`, readGrant.CreatorID)
+		}
+		tags = append(tags, strconv.Quote(readGrant.Tag))
+	}
+	return fmt.Sprintf(`(creator_id == %d && visibility == "PRIVATE" && tag in [%s])`, readGrants[0].CreatorID, strings.Join(tags, ", "))
+}
+
+func convertReadGrantFromStore(readGrant *store.ReadGrant) *v1pb.UserReadGrant {
+	return &v1pb.UserReadGrant{
+		Name:       fmt.Sprintf("%s%d/%s%d", UserNamePrefix, readGrant.CreatorID, ReadGrantNamePrefix, readGrant.ID),
+		Grantee:    fmt.Sprintf("%s%d", UserNamePrefix, readGrant.GranteeID),
+		Tag:        readGrant.Tag,
+		CreateTime: timestamppb.New(time.Unix(readGrant.CreatedTs, 0)),
+	}
+}
@@ -0,0 +1,223 @@
+package v1
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/usememos/memos/plugin/readwise"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const readwiseNameSuffix = "/readwise"
+
+func (s *APIV1Service) GetUserReadwise(ctx context.Context, request *v1pb.GetUserReadwiseRequest) (*v1pb.UserReadwise, error) {
+	user, err := s.getReadwiseOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	setting, err := s.Store.GetUserReadwise(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user readwise: %v", err)
+	}
+	return convertUserReadwiseFromStore(request.Name, setting), nil
+}
+
+func (s *APIV1Service) ConnectUserReadwise(ctx context.Context, request *v1pb.ConnectUserReadwiseRequest) (*v1pb.UserReadwise, error) {
+	user, err := s.getReadwiseOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	token := strings.TrimSpace(request.Token)
+	if token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token is required")
+	}
+	tag := strings.Trim(strings.TrimSpace(request.Tag), "#/")
+	if strings.ContainsAny(tag, " \t\n") {
+		return nil, status.Errorf(codes.InvalidArgument, "tag must not contain spaces")
+	}
+	setting, err := s.Store.GetUserReadwise(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user readwise: %v", err)
+	}
+	// The next sync fetches all the books again, updating the memos already synced.
+	setting.Token = token
+	setting.Tag = tag
+	setting.LastSyncTime = nil
+	setting.LastSyncError = ""
+	if err := s.Store.UpsertUserReadwise(ctx, user.ID, setting); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user readwise: %v", err)
+	}
+	return convertUserReadwiseFromStore(request.Name, setting), nil
+}
+
+func (s *APIV1Service) DisconnectUserReadwise(ctx context.Context, request *v1pb.DisconnectUserReadwiseRequest) (*v1pb.UserReadwise, error) {
+	user, err := s.getReadwiseOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	setting, err := s.Store.GetUserReadwise(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user readwise: %v", err)
+	}
+	// The memos of the books are kept, to be updated rather than duplicated if the account is connected again.
+	setting.Token = ""
+	setting.LastSyncTime = nil
+	setting.LastSyncError = ""
+	if err := s.Store.UpsertUserReadwise(ctx, user.ID, setting); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user readwise: %v", err)
+	}
+	return convertUserReadwiseFromStore(request.Name, setting), nil
+}
+
+func convertUserReadwiseFromStore(name string, setting *storepb.ReadwiseUserSetting) *v1pb.UserReadwise {
+	return &v1pb.UserReadwise{
+		Name:          name,
+		Connected:     setting.Token != "",
+		Tag:           getReadwiseTag(setting.Tag),
+		LastSyncTime:  setting.LastSyncTime,
+		LastSyncError: setting.LastSyncError,
+	}
+}
+
+// getReadwiseOwner returns the current user if the Readwise account belongs to them.
+// The access token of the account is a secret of its user, so it is not managed by the admins either.
+func (s *APIV1Service) getReadwiseOwner(ctx context.Context, name string) (*store.User, error) {
+	userID, err := extractUserIDFromReadwiseName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid readwise name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return currentUser, nil
+}
+
+// extractUserIDFromReadwiseName returns the user ID from a Readwise account name.
+// e.g., "users/1/readwise" -> 1.
+func extractUserIDFromReadwiseName(name string) (int32, error) {
+	userName, ok := strings.CutSuffix(name, readwiseNameSuffix)
+	if !ok {
+		return 0, errors.Errorf("invalid readwise name %q", name)
+	}
+	return ExtractUserIDFromName(userName)
+}
+
+// NewReadwiseHandler returns the handler saving the synced Readwise books as memos.
+func (s *APIV1Service) NewReadwiseHandler() readwise.Handler {
+	return &readwiseHandler{service: s}
+}
+
+type readwiseHandler struct {
+	service *APIV1Service
+}
+
+// SaveBook creates the memo of the book with the default visibility of the user, or replaces the content of
+// its memo if it changed. The memos deleted by the user are created again, but not the memos without highlights.
+func (h *readwiseHandler) SaveBook(ctx context.Context, userID int32, memoUID string, book *readwise.Book, tag string) (string, error) {
+	content, ok := getReadwiseBookContent(book, getReadwiseTag(tag))
+	userCtx := context.WithValue(ctx, userIDContextKey, userID)
+	if memoUID != "" {
+		memo, err := h.service.Store.GetMemo(ctx, &store.FindMemo{UID: &memoUID, CreatorID: &userID})
+		if err != nil {
+			return "", errors.Wrap(err, "failed to get memo")
+		}
+		if memo != nil {
+			if memo.Content == content {
+				return memoUID, nil
+			}
+			if _, err := h.service.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+				Memo:       &v1pb.Memo{Name: fmt.Sprintf("%s%s", MemoNamePrefix, memoUID), Content: content},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+			}); err != nil {
+				return "", errors.Wrap(err, "failed to update memo")
+			}
+			return memoUID, nil
+		}
+	}
+	if !ok {
+		return "", nil
+	}
+	visibility, err := h.service.getUserDefaultVisibility(ctx, userID)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get default visibility")
+	}
+	memo, err := h.service.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Memo: &v1pb.Memo{
+			Content:    content,
+			Visibility: visibility,
+		},
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create memo")
+	}
+	return ExtractMemoUIDFromName(memo.Name)
+}
+
+// getReadwiseBookContent returns the content of the memo of the book: its title and source, its highlights
+// in the order of the book with their notes, and the tag of its category. It reports whether the book has highlights.
+func getReadwiseBookContent(book *readwise.Book, tag string) (string, bool) {
+	highlights := []*readwise.Highlight{}
+	for _, highlight := range book.Highlights {
+		if !highlight.IsDeleted && strings.TrimSpace(highlight.Text) != "" {
+			highlights = append(highlights, highlight)
+		}
+	}
+	slices.SortStableFunc(highlights, func(a, b *readwise.Highlight) int {
+		return cmp.Or(cmp.Compare(a.Location, b.Location), cmp.Compare(a.ID, b.ID))
+	})
+
+	var builder strings.Builder
+	title := strings.TrimSpace(book.Title)
+	if title == "" {
+		title = "Untitled"
+	}
+	fmt.Fprintf(&builder, "# %s\n\n", title)
+	source := []string{}
+	if author := strings.TrimSpace(book.Author); author != "" {
+		source = append(source, "by "+author)
+	}
+	if book.SourceURL != "" {
+		source = append(source, fmt.Sprintf("[Source](%s)", book.SourceURL))
+	}
+	if book.ReadwiseURL != "" {
+		source = append(source, fmt.Sprintf("[Readwise](%s)", book.ReadwiseURL))
+	}
+	if len(source) > 0 {
+		fmt.Fprintf(&builder, "%s\n\n", strings.Join(source, " · "))
+	}
+	for _, highlight := range highlights {
+		for _, line := range strings.Split(strings.TrimSpace(highlight.Text), "\n") {
+			fmt.Fprintf(&builder, "> %s\n", strings.TrimSpace(line))
+		}
+		builder.WriteString("\n")
+		if note := strings.TrimSpace(highlight.Note); note != "" {
+			fmt.Fprintf(&builder, "**Note:** %s\n\n", strings.ReplaceAll(note, "\n", " "))
+		}
+	}
+	builder.WriteString("#" + tag)
+	if category := strings.Join(strings.Fields(strings.ToLower(book.Category)), "-"); category != "" {
+		builder.WriteString("/" + category)
+	}
+	return builder.String(), len(highlights) > 0
+}
+
+func getReadwiseTag(tag string) string {
+	if tag == "" {
+		return readwise.DefaultTag
+	}
+	return tag
+}
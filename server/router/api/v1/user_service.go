@@ -17,6 +17,7 @@ import (
 	"google.golang.org/genproto/googleapis/api/httpbody"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -31,8 +32,8 @@ func (s *APIV1Service) ListUsers(ctx context.Context, _ *v1pb.ListUsersRequest)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
 	}
-	if currentUser.Role != store.RoleHost && currentUser.Role != store.RoleAdmin {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	if err := s.checkPermission(ctx, currentUser, storepb.Permission_VIEW_USERS); err != nil {
+		return nil, err
 	}
 
 	users, err := s.Store.ListUsers(ctx, &store.FindUser{})
@@ -79,8 +80,8 @@ func (s *APIV1Service) SearchUsers(ctx context.Context, request *v1pb.SearchUser
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
 	}
-	if currentUser.Role != store.RoleHost && currentUser.Role != store.RoleAdmin {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	if err := s.checkPermission(ctx, currentUser, storepb.Permission_VIEW_USERS); err != nil {
+		return nil, err
 	}
 
 	// Search users by username, email, or display name
@@ -154,6 +155,7 @@ func (s *APIV1Service) CreateUser(ctx context.Context, request *v1pb.CreateUserR
 
 	// Determine the role to assign and check permissions
 	var roleToAssign store.Role
+	signUp := false
 	if len(existedHostUsers) == 0 {
 		// First-time setup: create the first user as HOST (no authentication required)
 		roleToAssign = store.RoleHost
@@ -172,11 +174,48 @@ func (s *APIV1Service) CreateUser(ctx context.Context, request *v1pb.CreateUserR
 			// Unauthenticated or non-HOST users can only create normal users
 			roleToAssign = store.RoleUser
 		}
+		signUp = err == nil && currentUser == nil
 	}
 
 	if !base.UIDMatcher.MatchString(strings.ToLower(request.User.Username)) {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid username: %s", request.User.Username)
 	}
+	if err := s.checkUsernameNotRedirected(ctx, request.User.Username, 0); err != nil {
+		return nil, err
+	}
+	// The username is checked before using the invitation, which a failed creation would waste.
+	existingUser, err := s.Store.GetUser(ctx, &store.FindUser{Username: &request.User.Username})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if existingUser != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "username %s already exists", request.User.Username)
+	}
+	if err := s.checkPasswordPolicy(ctx, request.User.Password); err != nil {
+		return nil, err
+	}
+	var invitation *store.Invitation
+	if signUp {
+		workspaceGeneralSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get workspace general setting: %v", err)
+		}
+		if workspaceGeneralSetting.SsoOnly {
+			return nil, status.Errorf(codes.PermissionDenied, "password signup is disabled, sign up with an identity provider")
+		}
+		if request.InvitationToken != "" {
+			invitation, err = s.getValidInvitation(ctx, request.InvitationToken)
+			if err != nil {
+				return nil, err
+			}
+			roleToAssign = invitation.Role
+		} else if workspaceGeneralSetting.DisallowUserRegistration {
+			return nil, status.Errorf(codes.PermissionDenied, "user registration is not allowed")
+		}
+		if err := s.checkSignUpEmail(ctx, request.User.Email); err != nil {
+			return nil, err
+		}
+	}
 
 	// If validate_only is true, just validate without creating
 	if request.ValidateOnly {
@@ -189,6 +228,22 @@ func (s *APIV1Service) CreateUser(ctx context.Context, request *v1pb.CreateUserR
 		}, nil
 	}
 
+	// The CAPTCHA tokens are verified once, so not when only validating.
+	if signUp {
+		if err := s.checkSignUpCaptcha(ctx, request.CaptchaToken); err != nil {
+			return nil, err
+		}
+	}
+	if invitation != nil {
+		ok, err := s.Store.UseInvitation(ctx, invitation.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to use invitation: %v", err)
+		}
+		if !ok {
+			return nil, status.Errorf(codes.FailedPrecondition, "invitation has no use left")
+		}
+	}
+
 	passwordHash, err := bcrypt.GenerateFromPassword([]byte(request.User.Password), bcrypt.DefaultCost)
 	if err != nil {
 		return nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to generate password hash").SetInternal(err)
@@ -204,6 +259,17 @@ func (s *APIV1Service) CreateUser(ctx context.Context, request *v1pb.CreateUserR
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
 	}
+	// The password of a user created by another user is set by them, like a reset one.
+	if currentUser, err := s.GetCurrentUser(ctx); err == nil && currentUser != nil {
+		if err := s.updatePasswordChangeRequirement(ctx, currentUser, user); err != nil {
+			return nil, err
+		}
+	}
+	if signUp {
+		if err := s.startEmailVerification(ctx, user); err != nil {
+			return nil, err
+		}
+	}
 
 	return convertUserFromStore(user), nil
 }
@@ -221,9 +287,11 @@ func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserR
 		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
 	}
 	// Check permission.
-	// Only allow admin or self to update user.
-	if currentUser.ID != userID && currentUser.Role != store.RoleAdmin && currentUser.Role != store.RoleHost {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	// Only allow the user managers or self to update user.
+	if currentUser.ID != userID {
+		if err := s.checkPermission(ctx, currentUser, storepb.Permission_MANAGE_USERS); err != nil {
+			return nil, err
+		}
 	}
 
 	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
@@ -257,6 +325,9 @@ func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserR
 			if !base.UIDMatcher.MatchString(strings.ToLower(request.User.Username)) {
 				return nil, status.Errorf(codes.InvalidArgument, "invalid username: %s", request.User.Username)
 			}
+			if err := s.checkUsernameNotRedirected(ctx, request.User.Username, user.ID); err != nil {
+				return nil, err
+			}
 			update.Username = &request.User.Username
 		case "display_name":
 			if workspaceGeneralSetting.DisallowChangeNickname {
@@ -270,13 +341,21 @@ func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserR
 		case "description":
 			update.Description = &request.User.Description
 		case "role":
-			// Only allow admin to update role.
-			if currentUser.Role != store.RoleAdmin && currentUser.Role != store.RoleHost {
-				return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+			// Only allow the user managers to update role.
+			if err := s.checkPermission(ctx, currentUser, storepb.Permission_MANAGE_USERS); err != nil {
+				return nil, err
 			}
 			role := convertUserRoleToStore(request.User.Role)
 			update.Role = &role
 		case "password":
+			if err := s.checkPasswordPolicy(ctx, request.User.Password); err != nil {
+				return nil, err
+			}
+			if currentUser.ID == user.ID {
+				if err := s.checkPasswordRotated(ctx, user, request.User.Password); err != nil {
+					return nil, err
+				}
+			}
 			passwordHash, err := bcrypt.GenerateFromPassword([]byte(request.User.Password), bcrypt.DefaultCost)
 			if err != nil {
 				return nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to generate password hash").SetInternal(err)
@@ -295,6 +374,26 @@ func (s *APIV1Service) UpdateUser(ctx context.Context, request *v1pb.UpdateUserR
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
 	}
+	if update.PasswordHash != nil {
+		if err := s.updatePasswordChangeRequirement(ctx, currentUser, user); err != nil {
+			return nil, err
+		}
+	}
+	if updatedUser.Username != user.Username {
+		// Keep the old handle pointing to the user so shared links still resolve.
+		if _, err := s.Store.UpsertUsernameRedirect(ctx, &store.UsernameRedirect{
+			Username: user.Username,
+			UserID:   user.ID,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create username redirect: %v", err)
+		}
+		// The new username may be one the user had before, which is no longer a redirect.
+		if err := s.Store.DeleteUsernameRedirect(ctx, &store.DeleteUsernameRedirect{
+			Username: updatedUser.Username,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to delete username redirect: %v", err)
+		}
+	}
 
 	return convertUserFromStore(updatedUser), nil
 }
@@ -308,8 +407,10 @@ func (s *APIV1Service) DeleteUser(ctx context.Context, request *v1pb.DeleteUserR
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
 	}
-	if currentUser.ID != userID && currentUser.Role != store.RoleAdmin && currentUser.Role != store.RoleHost {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	if currentUser.ID != userID {
+		if err := s.checkPermission(ctx, currentUser, storepb.Permission_MANAGE_USERS); err != nil {
+			return nil, err
+		}
 	}
 
 	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
@@ -320,15 +421,28 @@ func (s *APIV1Service) DeleteUser(ctx context.Context, request *v1pb.DeleteUserR
 		return nil, status.Errorf(codes.NotFound, "user not found")
 	}
 
-	if err := s.Store.DeleteUser(ctx, &store.DeleteUser{
-		ID: user.ID,
-	}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete user: %v", err)
+	if err := s.deleteUserData(ctx, currentUser, user); err != nil {
+		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
+// checkUsernameNotRedirected returns an error if the username is an old handle of another user.
+// Old handles stay reserved so that links shared with them keep redirecting to their owner.
+func (s *APIV1Service) checkUsernameNotRedirected(ctx context.Context, username string, userID int32) error {
+	redirect, err := s.Store.GetUsernameRedirect(ctx, &store.FindUsernameRedirect{
+		Username: &username,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get username redirect: %v", err)
+	}
+	if redirect != nil && redirect.UserID != userID {
+		return status.Errorf(codes.AlreadyExists, "username %s is reserved", username)
+	}
+	return nil
+}
+
 func getDefaultUserSetting() *v1pb.UserSetting {
 	return &v1pb.UserSetting{
 		Name:           "", // Will be set by caller
@@ -373,6 +487,8 @@ func (s *APIV1Service) GetUserSetting(ctx context.Context, request *v1pb.GetUser
 				userSettingMessage.Appearance = general.Appearance
 				userSettingMessage.MemoVisibility = general.MemoVisibility
 				userSettingMessage.Theme = general.Theme
+				userSettingMessage.StripImageMetadata = general.StripImageMetadata
+				userSettingMessage.KeepImageOrientation = general.KeepImageOrientation
 			}
 		}
 	}
@@ -438,6 +554,8 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 		generalSetting.Appearance = existing.Appearance
 		generalSetting.MemoVisibility = existing.MemoVisibility
 		generalSetting.Theme = existing.Theme
+		generalSetting.StripImageMetadata = existing.StripImageMetadata
+		generalSetting.KeepImageOrientation = existing.KeepImageOrientation
 	}
 
 	// Apply updates based on the update mask
@@ -451,6 +569,10 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 			generalSetting.MemoVisibility = request.Setting.MemoVisibility
 		case "theme":
 			generalSetting.Theme = request.Setting.Theme
+		case "strip_image_metadata":
+			generalSetting.StripImageMetadata = request.Setting.StripImageMetadata
+		case "keep_image_orientation":
+			generalSetting.KeepImageOrientation = request.Setting.KeepImageOrientation
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", field)
 		}
@@ -492,35 +614,19 @@ func (s *APIV1Service) ListUserAccessTokens(ctx context.Context, request *v1pb.L
 		return nil, status.Errorf(codes.Internal, "failed to list access tokens: %v", err)
 	}
 
+	accessTokenUsages, err := s.Store.GetUserAccessTokenUsages(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list access token usages: %v", err)
+	}
+
 	accessTokens := []*v1pb.UserAccessToken{}
 	for _, userAccessToken := range userAccessTokens {
-		claims := &ClaimsMessage{}
-		_, err := jwt.ParseWithClaims(userAccessToken.AccessToken, claims, func(t *jwt.Token) (any, error) {
-			if t.Method.Alg() != jwt.SigningMethodHS256.Name {
-				return nil, errors.Errorf("unexpected access token signing method=%v, expect %v", t.Header["alg"], jwt.SigningMethodHS256)
-			}
-			if kid, ok := t.Header["kid"].(string); ok {
-				if kid == "v1" {
-					return []byte(s.Secret), nil
-				}
-			}
-			return nil, errors.Errorf("unexpected access token kid=%v", t.Header["kid"])
-		})
+		claims, err := s.parseAccessTokenClaims(userAccessToken.AccessToken)
 		if err != nil {
 			// If the access token is invalid or expired, just ignore it.
 			continue
 		}
-
-		accessTokenResponse := &v1pb.UserAccessToken{
-			Name:        fmt.Sprintf("users/%d/accessTokens/%s", userID, userAccessToken.AccessToken),
-			AccessToken: userAccessToken.AccessToken,
-			Description: userAccessToken.Description,
-			IssuedAt:    timestamppb.New(claims.IssuedAt.Time),
-		}
-		if claims.ExpiresAt != nil {
-			accessTokenResponse.ExpiresAt = timestamppb.New(claims.ExpiresAt.Time)
-		}
-		accessTokens = append(accessTokens, accessTokenResponse)
+		accessTokens = append(accessTokens, convertUserAccessTokenFromStore(userID, userAccessToken, claims, accessTokenUsages))
 	}
 
 	// Sort by issued time in descending order.
@@ -549,62 +655,117 @@ func (s *APIV1Service) CreateUserAccessToken(ctx context.Context, request *v1pb.
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
+	if request.AccessToken.RateLimitPerMinute < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "rate limit must not be negative")
+	}
 	expiresAt := time.Time{}
 	if request.AccessToken.ExpiresAt != nil {
 		expiresAt = request.AccessToken.ExpiresAt.AsTime()
 	}
+	expiresAt, err = s.getAccessTokenExpiration(ctx, expiresAt)
+	if err != nil {
+		return nil, err
+	}
 
 	accessToken, err := GenerateAccessToken(currentUser.Username, currentUser.ID, expiresAt, []byte(s.Secret))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to generate access token: %v", err)
 	}
 
-	claims := &ClaimsMessage{}
-	_, err = jwt.ParseWithClaims(accessToken, claims, func(t *jwt.Token) (any, error) {
-		if t.Method.Alg() != jwt.SigningMethodHS256.Name {
-			return nil, errors.Errorf("unexpected access token signing method=%v, expect %v", t.Header["alg"], jwt.SigningMethodHS256)
-		}
-		if kid, ok := t.Header["kid"].(string); ok {
-			if kid == "v1" {
-				return []byte(s.Secret), nil
-			}
-		}
-		return nil, errors.Errorf("unexpected access token kid=%v", t.Header["kid"])
-	})
+	claims, err := s.parseAccessTokenClaims(accessToken)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to parse access token: %v", err)
 	}
 
 	// Upsert the access token to user setting store.
-	if err := s.UpsertAccessTokenToStore(ctx, currentUser, accessToken, request.AccessToken.Description); err != nil {
+	storeAccessToken := &storepb.AccessTokensUserSetting_AccessToken{
+		AccessToken:        accessToken,
+		Description:        request.AccessToken.Description,
+		RateLimitPerMinute: request.AccessToken.RateLimitPerMinute,
+	}
+	if err := s.UpsertAccessTokenToStore(ctx, currentUser, storeAccessToken); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to upsert access token to store: %v", err)
 	}
+	return convertUserAccessTokenFromStore(userID, storeAccessToken, claims, nil), nil
+}
 
-	userAccessToken := &v1pb.UserAccessToken{
-		Name:        fmt.Sprintf("users/%d/accessTokens/%s", userID, accessToken),
-		AccessToken: accessToken,
-		Description: request.AccessToken.Description,
-		IssuedAt:    timestamppb.New(claims.IssuedAt.Time),
+func (s *APIV1Service) UpdateUserAccessToken(ctx context.Context, request *v1pb.UpdateUserAccessTokenRequest) (*v1pb.UserAccessToken, error) {
+	if request.AccessToken == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "access token is required")
 	}
-	if claims.ExpiresAt != nil {
-		userAccessToken.ExpiresAt = timestamppb.New(claims.ExpiresAt.Time)
+	userID, accessTokenToUpdate, err := extractAccessTokenFromName(request.AccessToken.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid access token name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is empty")
 	}
-	return userAccessToken, nil
-}
 
-func (s *APIV1Service) DeleteUserAccessToken(ctx context.Context, request *v1pb.DeleteUserAccessTokenRequest) (*emptypb.Empty, error) {
-	// Extract user ID from the access token resource name
-	// Format: users/{user}/accessTokens/{access_token}
-	parts := strings.Split(request.Name, "/")
-	if len(parts) != 4 || parts[0] != "users" || parts[2] != "accessTokens" {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid access token name format: %s", request.Name)
+	userAccessTokens, err := s.Store.GetUserAccessTokens(ctx, currentUser.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list access tokens: %v", err)
+	}
+	index := slices.IndexFunc(userAccessTokens, func(userAccessToken *storepb.AccessTokensUserSetting_AccessToken) bool {
+		return userAccessToken.AccessToken == accessTokenToUpdate
+	})
+	if index < 0 {
+		return nil, status.Errorf(codes.NotFound, "access token not found")
+	}
+	// The token is cloned, as the cached setting must not change before it is stored.
+	userAccessToken := proto.Clone(userAccessTokens[index]).(*storepb.AccessTokensUserSetting_AccessToken)
+	for _, field := range request.UpdateMask.Paths {
+		switch field {
+		case "description":
+			userAccessToken.Description = request.AccessToken.Description
+		case "rate_limit_per_minute":
+			if request.AccessToken.RateLimitPerMinute < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "rate limit must not be negative")
+			}
+			userAccessToken.RateLimitPerMinute = request.AccessToken.RateLimitPerMinute
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", field)
+		}
+	}
+	updatedUserAccessTokens := slices.Clone(userAccessTokens)
+	updatedUserAccessTokens[index] = userAccessToken
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: currentUser.ID,
+		Key:    storepb.UserSetting_ACCESS_TOKENS,
+		Value: &storepb.UserSetting_AccessTokens{
+			AccessTokens: &storepb.AccessTokensUserSetting{
+				AccessTokens: updatedUserAccessTokens,
+			},
+		},
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
 	}
 
-	userID, err := ExtractUserIDFromName(fmt.Sprintf("users/%s", parts[1]))
+	claims, err := s.parseAccessTokenClaims(userAccessToken.AccessToken)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to parse access token: %v", err)
+	}
+	accessTokenUsages, err := s.Store.GetUserAccessTokenUsages(ctx, currentUser.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list access token usages: %v", err)
+	}
+	return convertUserAccessTokenFromStore(userID, userAccessToken, claims, accessTokenUsages), nil
+}
+
+func (s *APIV1Service) DeleteUserAccessToken(ctx context.Context, request *v1pb.DeleteUserAccessTokenRequest) (*emptypb.Empty, error) {
+	userID, accessTokenToDelete, err := extractAccessTokenFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid access token name: %v", err)
 	}
-	accessTokenToDelete := parts[3]
 
 	currentUser, err := s.GetCurrentUser(ctx)
 	if err != nil {
@@ -639,6 +800,9 @@ func (s *APIV1Service) DeleteUserAccessToken(ctx context.Context, request *v1pb.
 	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
 	}
+	if err := s.Store.RemoveUserAccessTokenUsage(ctx, currentUser.ID, accessTokenToDelete); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove access token usage: %v", err)
+	}
 
 	return &emptypb.Empty{}, nil
 }
@@ -742,16 +906,12 @@ func (s *APIV1Service) UpsertUserSession(ctx context.Context, userID int32, sess
 	return s.Store.AddUserSession(ctx, userID, session)
 }
 
-func (s *APIV1Service) UpsertAccessTokenToStore(ctx context.Context, user *store.User, accessToken, description string) error {
+func (s *APIV1Service) UpsertAccessTokenToStore(ctx context.Context, user *store.User, userAccessToken *storepb.AccessTokensUserSetting_AccessToken) error {
 	userAccessTokens, err := s.Store.GetUserAccessTokens(ctx, user.ID)
 	if err != nil {
 		return errors.Wrap(err, "failed to get user access tokens")
 	}
-	userAccessToken := storepb.AccessTokensUserSetting_AccessToken{
-		AccessToken: accessToken,
-		Description: description,
-	}
-	userAccessTokens = append(userAccessTokens, &userAccessToken)
+	userAccessTokens = append(userAccessTokens, userAccessToken)
 
 	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
 		UserId: user.ID,
@@ -767,6 +927,62 @@ func (s *APIV1Service) UpsertAccessTokenToStore(ctx context.Context, user *store
 	return nil
 }
 
+// parseAccessTokenClaims returns the claims of the access token, signed with the secret of the server.
+func (s *APIV1Service) parseAccessTokenClaims(accessToken string) (*ClaimsMessage, error) {
+	claims := &ClaimsMessage{}
+	_, err := jwt.ParseWithClaims(accessToken, claims, func(t *jwt.Token) (any, error) {
+		if t.Method.Alg() != jwt.SigningMethodHS256.Name {
+			return nil, errors.Errorf("unexpected access token signing method=%v, expect %v", t.Header["alg"], jwt.SigningMethodHS256)
+		}
+		if kid, ok := t.Header["kid"].(string); ok {
+			if kid == "v1" {
+				return []byte(s.Secret), nil
+			}
+		}
+		return nil, errors.Errorf("unexpected access token kid=%v", t.Header["kid"])
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// extractAccessTokenFromName returns the user ID and the access token of the name.
+// Format: users/{user}/accessTokens/{access_token}
+func extractAccessTokenFromName(name string) (int32, string, error) {
+	parts := strings.Split(name, "/")
+	if len(parts) != 4 || parts[0] != "users" || parts[2] != "accessTokens" {
+		return 0, "", errors.Errorf("invalid access token name format: %s", name)
+	}
+	userID, err := ExtractUserIDFromName(fmt.Sprintf("users/%s", parts[1]))
+	if err != nil {
+		return 0, "", err
+	}
+	return userID, parts[3], nil
+}
+
+func convertUserAccessTokenFromStore(userID int32, userAccessToken *storepb.AccessTokensUserSetting_AccessToken, claims *ClaimsMessage, usages []*storepb.AccessTokenUsagesUserSetting_Usage) *v1pb.UserAccessToken {
+	accessToken := &v1pb.UserAccessToken{
+		Name:               fmt.Sprintf("users/%d/accessTokens/%s", userID, userAccessToken.AccessToken),
+		AccessToken:        userAccessToken.AccessToken,
+		Description:        userAccessToken.Description,
+		IssuedAt:           timestamppb.New(claims.IssuedAt.Time),
+		RateLimitPerMinute: userAccessToken.RateLimitPerMinute,
+	}
+	if claims.ExpiresAt != nil {
+		accessToken.ExpiresAt = timestamppb.New(claims.ExpiresAt.Time)
+	}
+	for _, usage := range usages {
+		if usage.AccessToken == userAccessToken.AccessToken {
+			accessToken.RequestCount = usage.RequestCount
+			accessToken.LastUsedAt = usage.LastUsedTime
+			accessToken.LastUsedIp = usage.LastUsedIp
+			break
+		}
+	}
+	return accessToken
+}
+
 func convertUserFromStore(user *store.User) *v1pb.User {
 	userpb := &v1pb.User{
 		Name:        fmt.Sprintf("%s%d", UserNamePrefix, user.ID),
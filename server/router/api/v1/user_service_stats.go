@@ -14,7 +14,7 @@ import (
 	"github.com/usememos/memos/store"
 )
 
-func (s *APIV1Service) ListAllUserStats(ctx context.Context, _ *v1pb.ListAllUserStatsRequest) (*v1pb.ListAllUserStatsResponse, error) {
+func (s *APIV1Service) ListAllUserStats(ctx context.Context, request *v1pb.ListAllUserStatsRequest) (*v1pb.ListAllUserStatsResponse, error) {
 	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get workspace memo related setting")
@@ -28,15 +28,21 @@ func (s *APIV1Service) ListAllUserStats(ctx context.Context, _ *v1pb.ListAllUser
 		RowStatus:       &normalStatus,
 	}
 
+	inSharedSpace, err := s.scopeMemoFindToSpace(ctx, memoFind, request.Space)
+	if err != nil {
+		return nil, err
+	}
 	currentUser, err := s.GetCurrentUser(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
 	}
-	if currentUser == nil {
+	if inSharedSpace {
+		// The members read all the memos of the space.
+	} else if currentUser == nil {
 		memoFind.VisibilityList = []store.Visibility{store.Public}
 	} else {
 		if memoFind.CreatorID == nil {
-			internalFilter := fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"]`, currentUser.ID)
+			internalFilter := fmt.Sprintf(`creator_id == %d || visibility in ["PUBLIC", "PROTECTED"] || group_visible_to(%d)`, currentUser.ID, currentUser.ID)
 			if memoFind.Filter != nil {
 				filter := fmt.Sprintf("(%s) && (%s)", *memoFind.Filter, internalFilter)
 				memoFind.Filter = &filter
@@ -44,7 +50,7 @@ func (s *APIV1Service) ListAllUserStats(ctx context.Context, _ *v1pb.ListAllUser
 				memoFind.Filter = &internalFilter
 			}
 		} else if *memoFind.CreatorID != currentUser.ID {
-			memoFind.VisibilityList = []store.Visibility{store.Public, store.Protected}
+			appendMemoFilter(memoFind, fmt.Sprintf(`visibility in ["PUBLIC", "PROTECTED"] || group_visible_to(%d)`, currentUser.ID))
 		}
 	}
 	memos, err := s.Store.ListMemos(ctx, memoFind)
@@ -95,10 +101,16 @@ func (s *APIV1Service) GetUserStats(ctx context.Context, request *v1pb.GetUserSt
 		RowStatus:       &normalStatus,
 	}
 
-	if currentUser == nil {
+	inSharedSpace, err := s.scopeMemoFindToSpace(ctx, memoFind, request.Space)
+	if err != nil {
+		return nil, err
+	}
+	if inSharedSpace {
+		// The members read all the memos of the space.
+	} else if currentUser == nil {
 		memoFind.VisibilityList = []store.Visibility{store.Public}
 	} else if currentUser.ID != userID {
-		memoFind.VisibilityList = []store.Visibility{store.Public, store.Protected}
+		appendMemoFilter(memoFind, fmt.Sprintf(`visibility in ["PUBLIC", "PROTECTED"] || group_visible_to(%d)`, currentUser.ID))
 	}
 
 	memos, err := s.Store.ListMemos(ctx, memoFind)
@@ -0,0 +1,195 @@
+package v1
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	slackNameSuffix = "/slack"
+	// slackLinkCodeLength and slackLinkCodeLifetime keep the link codes short enough to be typed, and too short-lived to be guessed.
+	slackLinkCodeLength   = 8
+	slackLinkCodeLifetime = 10 * time.Minute
+)
+
+func (s *APIV1Service) GetUserSlack(ctx context.Context, request *v1pb.GetUserSlackRequest) (*v1pb.UserSlack, error) {
+	user, err := s.getSlackOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	slack, err := s.Store.GetUserSlack(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user slack: %v", err)
+	}
+	return convertUserSlackFromStore(request.Name, slack, false), nil
+}
+
+func (s *APIV1Service) GenerateUserSlackLinkCode(ctx context.Context, request *v1pb.GenerateUserSlackLinkCodeRequest) (*v1pb.UserSlack, error) {
+	user, err := s.getSlackOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	slackSetting, err := s.Store.GetWorkspaceSlackSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace slack setting: %v", err)
+	}
+	if slackSetting.SigningSecret == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "the Slack app is not enabled on this instance")
+	}
+	code, err := util.RandomString(slackLinkCodeLength)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate slack link code: %v", err)
+	}
+	slack, err := s.Store.GetUserSlack(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user slack: %v", err)
+	}
+	// The codes are compared in uppercase, as they are typed by the users.
+	slack.LinkCode = strings.ToUpper(code)
+	slack.LinkCodeExpireTime = timestamppb.New(time.Now().Add(slackLinkCodeLifetime))
+	if err := s.Store.UpsertUserSlack(ctx, user.ID, slack); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user slack: %v", err)
+	}
+	return convertUserSlackFromStore(request.Name, slack, true), nil
+}
+
+func (s *APIV1Service) UnlinkUserSlack(ctx context.Context, request *v1pb.UnlinkUserSlackRequest) (*v1pb.UserSlack, error) {
+	user, err := s.getSlackOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	slack := &storepb.SlackUserSetting{}
+	if err := s.Store.UpsertUserSlack(ctx, user.ID, slack); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user slack: %v", err)
+	}
+	return convertUserSlackFromStore(request.Name, slack, false), nil
+}
+
+// linkSlackAccount links the Slack account to the user of the unexpired link code, and unlinks it from any other user.
+// It returns nil if no user has the code.
+func (s *APIV1Service) linkSlackAccount(ctx context.Context, code, teamID, slackUserID string) (*store.User, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return nil, nil
+	}
+	userSettings, err := s.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSetting_SLACK,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list user slacks")
+	}
+	var userID int32
+	for _, userSetting := range userSettings {
+		slack := userSetting.GetSlack()
+		if slack.GetLinkCode() == code && slack.GetLinkCodeExpireTime().AsTime().After(time.Now()) {
+			userID = userSetting.UserId
+			break
+		}
+	}
+	if userID == 0 {
+		return nil, nil
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user")
+	}
+	if user == nil || user.RowStatus == store.Archived || checkUserNotSuspended(ctx, s.Store, user) != nil {
+		return nil, nil
+	}
+
+	for _, userSetting := range userSettings {
+		slack := userSetting.GetSlack()
+		if userSetting.UserId != userID && slack.GetTeamId() == teamID && slack.GetUserId() == slackUserID {
+			if err := s.Store.UpsertUserSlack(ctx, userSetting.UserId, &storepb.SlackUserSetting{}); err != nil {
+				return nil, errors.Wrap(err, "failed to unlink user slack")
+			}
+		}
+	}
+	if err := s.Store.UpsertUserSlack(ctx, userID, &storepb.SlackUserSetting{
+		TeamId: teamID,
+		UserId: slackUserID,
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to link user slack")
+	}
+	return user, nil
+}
+
+// findSlackUser returns the user linked to the Slack account, nil if there is none or if the user can no longer create memos.
+func (s *APIV1Service) findSlackUser(ctx context.Context, teamID, slackUserID string) (*store.User, error) {
+	if slackUserID == "" {
+		return nil, nil
+	}
+	userSettings, err := s.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSetting_SLACK,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list user slacks")
+	}
+	for _, userSetting := range userSettings {
+		slack := userSetting.GetSlack()
+		if slack.GetTeamId() != teamID || slack.GetUserId() != slackUserID {
+			continue
+		}
+		user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userSetting.UserId})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get user")
+		}
+		if user == nil || user.RowStatus == store.Archived || checkUserNotSuspended(ctx, s.Store, user) != nil {
+			return nil, nil
+		}
+		return user, nil
+	}
+	return nil, nil
+}
+
+// convertUserSlackFromStore converts the Slack account of a user, with its unexpired link code if requested.
+func convertUserSlackFromStore(name string, slack *storepb.SlackUserSetting, withLinkCode bool) *v1pb.UserSlack {
+	userSlack := &v1pb.UserSlack{
+		Name:   name,
+		Linked: slack.UserId != "",
+		TeamId: slack.TeamId,
+		UserId: slack.UserId,
+	}
+	if withLinkCode && slack.LinkCode != "" {
+		userSlack.LinkCode = slack.LinkCode
+		userSlack.LinkCodeExpireTime = slack.LinkCodeExpireTime
+	}
+	return userSlack
+}
+
+// getSlackOwner returns the current user if the Slack account belongs to them.
+func (s *APIV1Service) getSlackOwner(ctx context.Context, name string) (*store.User, error) {
+	userID, err := extractUserIDFromSlackName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid slack name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return currentUser, nil
+}
+
+// extractUserIDFromSlackName returns the user ID from a slack name.
+// e.g., "users/1/slack" -> 1.
+func extractUserIDFromSlackName(name string) (int32, error) {
+	userName, ok := strings.CutSuffix(name, slackNameSuffix)
+	if !ok {
+		return 0, errors.Errorf("invalid slack name %q", name)
+	}
+	return ExtractUserIDFromName(userName)
+}
@@ -0,0 +1,149 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const suspensionNameSuffix = "/suspension"
+
+func (s *APIV1Service) GetUserSuspension(ctx context.Context, request *v1pb.GetUserSuspensionRequest) (*v1pb.UserSuspension, error) {
+	userName, ok := strings.CutSuffix(request.Name, suspensionNameSuffix)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid suspension name: %s", request.Name)
+	}
+	userID, err := ExtractUserIDFromName(userName)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if err := s.checkPermission(ctx, currentUser, storepb.Permission_MANAGE_USERS); err != nil {
+		return nil, err
+	}
+
+	suspension, err := s.Store.GetUserSuspension(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user suspension: %v", err)
+	}
+	return convertUserSuspensionFromStore(userID, suspension), nil
+}
+
+func (s *APIV1Service) SuspendUser(ctx context.Context, request *v1pb.SuspendUserRequest) (*v1pb.UserSuspension, error) {
+	currentUser, user, err := s.getSuspensionTarget(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	suspension := &storepb.SuspensionUserSetting{
+		Suspended:   true,
+		Reason:      strings.TrimSpace(request.Reason),
+		SuspendTime: timestamppb.Now(),
+		SuspenderId: currentUser.ID,
+	}
+	if err := s.Store.UpsertUserSuspension(ctx, user.ID, suspension); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user suspension: %v", err)
+	}
+	return convertUserSuspensionFromStore(user.ID, suspension), nil
+}
+
+func (s *APIV1Service) UnsuspendUser(ctx context.Context, request *v1pb.UnsuspendUserRequest) (*v1pb.UserSuspension, error) {
+	_, user, err := s.getSuspensionTarget(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	suspension := &storepb.SuspensionUserSetting{}
+	if err := s.Store.UpsertUserSuspension(ctx, user.ID, suspension); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user suspension: %v", err)
+	}
+	return convertUserSuspensionFromStore(user.ID, suspension), nil
+}
+
+// getSuspensionTarget returns the current user and the user of the name, if the current user may suspend them:
+// the host may suspend the admins and the users, the admins only the users.
+func (s *APIV1Service) getSuspensionTarget(ctx context.Context, name string) (*store.User, *store.User, error) {
+	userID, err := ExtractUserIDFromName(name)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if err := s.checkPermission(ctx, currentUser, storepb.Permission_MANAGE_USERS); err != nil {
+		return nil, nil, err
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil || user.ID == store.SystemBotID {
+		return nil, nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	if user.ID == currentUser.ID || user.Role == store.RoleHost || (user.Role == store.RoleAdmin && currentUser.Role != store.RoleHost) {
+		return nil, nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return currentUser, user, nil
+}
+
+// checkUserNotSuspended returns a PermissionDenied error with the reason of the suspension if the user is suspended.
+func checkUserNotSuspended(ctx context.Context, stores *store.Store, user *store.User) error {
+	suspension, err := stores.GetUserSuspension(ctx, user.ID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user suspension: %v", err)
+	}
+	if !suspension.Suspended {
+		return nil
+	}
+	if suspension.Reason != "" {
+		return status.Errorf(codes.PermissionDenied, "user %s is suspended: %s", user.Username, suspension.Reason)
+	}
+	return status.Errorf(codes.PermissionDenied, "user %s is suspended", user.Username)
+}
+
+// checkMemoCreatorNotSuspended hides the memos of the suspended users from everyone but the admins,
+// while keeping their links so that they resolve again once the suspension is lifted.
+func (s *APIV1Service) checkMemoCreatorNotSuspended(ctx context.Context, memo *store.Memo) error {
+	suspension, err := s.Store.GetUserSuspension(ctx, memo.CreatorID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user suspension: %v", err)
+	}
+	if !suspension.Suspended {
+		return nil
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if ok, err := hasPermission(ctx, s.Store, currentUser, storepb.Permission_MANAGE_USERS); err != nil {
+		return status.Errorf(codes.Internal, "failed to get user permissions: %v", err)
+	} else if ok {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "the creator of the memo is suspended")
+}
+
+func convertUserSuspensionFromStore(userID int32, suspension *storepb.SuspensionUserSetting) *v1pb.UserSuspension {
+	userSuspension := &v1pb.UserSuspension{
+		Name:        fmt.Sprintf("%s%d%s", UserNamePrefix, userID, suspensionNameSuffix),
+		Suspended:   suspension.Suspended,
+		Reason:      suspension.Reason,
+		SuspendTime: suspension.SuspendTime,
+	}
+	if suspension.SuspenderId != 0 {
+		userSuspension.Suspender = fmt.Sprintf("%s%d", UserNamePrefix, suspension.SuspenderId)
+	}
+	return userSuspension
+}
@@ -0,0 +1,301 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/totp"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	twoFactorNameSuffix = "/twoFactor"
+	// recoveryCodeCount is the number of recovery codes generated at once.
+	recoveryCodeCount = 10
+	// defaultTwoFactorIssuer is the issuer shown by the authenticator apps when the workspace has no title.
+	defaultTwoFactorIssuer = "Memos"
+)
+
+func (s *APIV1Service) GetUserTwoFactor(ctx context.Context, request *v1pb.GetUserTwoFactorRequest) (*v1pb.UserTwoFactor, error) {
+	userID, err := extractUserIDFromTwoFactorName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid two-factor name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	// Only allow the user managers or self to get the two-factor authentication.
+	if currentUser == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if currentUser.ID != userID {
+		if err := s.checkPermission(ctx, currentUser, storepb.Permission_MANAGE_USERS); err != nil {
+			return nil, err
+		}
+	}
+
+	twoFactor, err := s.Store.GetUserTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user two-factor: %v", err)
+	}
+	return &v1pb.UserTwoFactor{
+		Name:              request.Name,
+		Enabled:           twoFactor.Enabled,
+		RecoveryCodeCount: int32(len(twoFactor.RecoveryCodeHashes)),
+	}, nil
+}
+
+func (s *APIV1Service) SetupUserTwoFactor(ctx context.Context, request *v1pb.SetupUserTwoFactorRequest) (*v1pb.SetupUserTwoFactorResponse, error) {
+	user, err := s.getTwoFactorOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	twoFactor, err := s.Store.GetUserTwoFactor(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user two-factor: %v", err)
+	}
+	if twoFactor.Enabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate secret: %v", err)
+	}
+	if err := s.Store.UpsertUserTwoFactor(ctx, user.ID, &storepb.TwoFactorUserSetting{
+		Secret: secret,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user two-factor: %v", err)
+	}
+
+	issuer := defaultTwoFactorIssuer
+	workspaceGeneralSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace general setting: %v", err)
+	}
+	if title := workspaceGeneralSetting.GetCustomProfile().GetTitle(); title != "" {
+		issuer = title
+	}
+	return &v1pb.SetupUserTwoFactorResponse{
+		Secret:          secret,
+		ProvisioningUri: totp.ProvisioningURI(issuer, user.Username, secret),
+	}, nil
+}
+
+func (s *APIV1Service) EnableUserTwoFactor(ctx context.Context, request *v1pb.EnableUserTwoFactorRequest) (*v1pb.EnableUserTwoFactorResponse, error) {
+	user, err := s.getTwoFactorOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	twoFactor, err := s.Store.GetUserTwoFactor(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user two-factor: %v", err)
+	}
+	if twoFactor.Enabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+	if twoFactor.Secret == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is not set up")
+	}
+	step, ok := totp.Validate(twoFactor.Secret, request.Code, time.Now())
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid two-factor code")
+	}
+
+	recoveryCodes, recoveryCodeHashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate recovery codes: %v", err)
+	}
+	if err := s.Store.UpsertUserTwoFactor(ctx, user.ID, &storepb.TwoFactorUserSetting{
+		Secret:             twoFactor.Secret,
+		Enabled:            true,
+		RecoveryCodeHashes: recoveryCodeHashes,
+		LastUsedStep:       step,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user two-factor: %v", err)
+	}
+	return &v1pb.EnableUserTwoFactorResponse{
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+func (s *APIV1Service) DisableUserTwoFactor(ctx context.Context, request *v1pb.DisableUserTwoFactorRequest) (*emptypb.Empty, error) {
+	userID, err := extractUserIDFromTwoFactorName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid two-factor name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	// Only allow the user managers or self to disable the two-factor authentication,
+	// the user managers disabling it for the users who lost their authenticator and recovery codes.
+	if currentUser.ID != userID {
+		if err := s.checkPermission(ctx, currentUser, storepb.Permission_MANAGE_USERS); err != nil {
+			return nil, err
+		}
+	}
+
+	twoFactor, err := s.Store.GetUserTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user two-factor: %v", err)
+	}
+	if currentUser.ID == userID && twoFactor.Enabled {
+		ok, err := s.verifyTwoFactorCode(ctx, userID, twoFactor, request.Code)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid two-factor code")
+		}
+	}
+
+	if err := s.Store.UpsertUserTwoFactor(ctx, userID, &storepb.TwoFactorUserSetting{}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user two-factor: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) RegenerateUserRecoveryCodes(ctx context.Context, request *v1pb.RegenerateUserRecoveryCodesRequest) (*v1pb.RegenerateUserRecoveryCodesResponse, error) {
+	user, err := s.getTwoFactorOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	twoFactor, err := s.Store.GetUserTwoFactor(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user two-factor: %v", err)
+	}
+	if !twoFactor.Enabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is not enabled")
+	}
+	step, ok := totp.Validate(twoFactor.Secret, request.Code, time.Now())
+	if !ok || step <= twoFactor.LastUsedStep {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid two-factor code")
+	}
+
+	recoveryCodes, recoveryCodeHashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate recovery codes: %v", err)
+	}
+	twoFactor = proto.Clone(twoFactor).(*storepb.TwoFactorUserSetting)
+	twoFactor.RecoveryCodeHashes = recoveryCodeHashes
+	twoFactor.LastUsedStep = step
+	if err := s.Store.UpsertUserTwoFactor(ctx, user.ID, twoFactor); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user two-factor: %v", err)
+	}
+	return &v1pb.RegenerateUserRecoveryCodesResponse{
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// checkSignInTwoFactor checks the two-factor code of the password sign in of the user, if the user has enabled it.
+// The users signing in with SSO rely on the multi-factor authentication of their identity provider instead.
+func (s *APIV1Service) checkSignInTwoFactor(ctx context.Context, user *store.User, code string) error {
+	twoFactor, err := s.Store.GetUserTwoFactor(ctx, user.ID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user two-factor, error: %v", err)
+	}
+	if !twoFactor.Enabled {
+		return nil
+	}
+	if strings.TrimSpace(code) == "" {
+		return status.Errorf(codes.Unauthenticated, "two-factor code required")
+	}
+	ok, err := s.verifyTwoFactorCode(ctx, user.ID, twoFactor, code)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "invalid two-factor code")
+	}
+	return nil
+}
+
+// verifyTwoFactorCode returns whether the code is a TOTP code or an unused recovery code of the enabled two-factor
+// authentication. The step of the TOTP code is recorded so that the code is not used again, and the recovery code is consumed.
+func (s *APIV1Service) verifyTwoFactorCode(ctx context.Context, userID int32, twoFactor *storepb.TwoFactorUserSetting, code string) (bool, error) {
+	// The setting is cached, so it is cloned before being modified.
+	twoFactor = proto.Clone(twoFactor).(*storepb.TwoFactorUserSetting)
+	if step, ok := totp.Validate(twoFactor.Secret, code, time.Now()); ok {
+		if step <= twoFactor.LastUsedStep {
+			return false, nil
+		}
+		twoFactor.LastUsedStep = step
+	} else {
+		index := slices.Index(twoFactor.RecoveryCodeHashes, hashRecoveryCode(code))
+		if index < 0 {
+			return false, nil
+		}
+		twoFactor.RecoveryCodeHashes = slices.Delete(twoFactor.RecoveryCodeHashes, index, index+1)
+	}
+	if err := s.Store.UpsertUserTwoFactor(ctx, userID, twoFactor); err != nil {
+		return false, status.Errorf(codes.Internal, "failed to upsert user two-factor: %v", err)
+	}
+	return true, nil
+}
+
+// getTwoFactorOwner returns the current user if the two-factor authentication belongs to them.
+func (s *APIV1Service) getTwoFactorOwner(ctx context.Context, name string) (*store.User, error) {
+	userID, err := extractUserIDFromTwoFactorName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid two-factor name: %v", err)
+	}
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil || currentUser.ID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return currentUser, nil
+}
+
+// extractUserIDFromTwoFactorName returns the user ID from a two-factor name.
+// e.g., "users/1/twoFactor" -> 1.
+func extractUserIDFromTwoFactorName(name string) (int32, error) {
+	userName, ok := strings.CutSuffix(name, twoFactorNameSuffix)
+	if !ok {
+		return 0, errors.Errorf("invalid two-factor name %q", name)
+	}
+	return ExtractUserIDFromName(userName)
+}
+
+// generateRecoveryCodes returns new recovery codes, formatted as "xxxxx-xxxxx", with their hashes.
+func generateRecoveryCodes() ([]string, []string, error) {
+	recoveryCodes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for range recoveryCodeCount {
+		random, err := util.RandomString(10)
+		if err != nil {
+			return nil, nil, err
+		}
+		code := strings.ToLower(random[:5] + "-" + random[5:])
+		recoveryCodes = append(recoveryCodes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	return recoveryCodes, hashes, nil
+}
+
+// hashRecoveryCode returns the SHA-256 hash of the recovery code, ignoring its case, spaces and dashes.
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	hash := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,376 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/webpush"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// maxWebPushSubscriptions bounds the browsers of a user, dropping the oldest subscriptions beyond.
+const maxWebPushSubscriptions = 20
+
+// webPushJobKind sends the notification of an inbox message to the browsers and the email of its receiver.
+const webPushJobKind = "web_push"
+
+type webPushJobPayload struct {
+	// InboxID is zero for the messages left out of the inbox by the preferences of the receiver, which are not saved,
+	// hence the message given along.
+	InboxID    int32           `json:"inboxId"`
+	ReceiverID int32           `json:"receiverId"`
+	SenderID   int32           `json:"senderId"`
+	Message    json.RawMessage `json:"message"`
+	Push       bool            `json:"push"`
+	Email      bool            `json:"email"`
+}
+
+func (s *APIV1Service) ListUserWebPushSubscriptions(ctx context.Context, request *v1pb.ListUserWebPushSubscriptionsRequest) (*v1pb.ListUserWebPushSubscriptionsResponse, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := s.Store.GetUserWebPushSubscriptions(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get web push subscriptions: %v", err)
+	}
+	response := &v1pb.ListUserWebPushSubscriptionsResponse{
+		WebPushSubscriptions: []*v1pb.UserWebPushSubscription{},
+	}
+	for _, subscription := range subscriptions {
+		response.WebPushSubscriptions = append(response.WebPushSubscriptions, convertWebPushSubscriptionFromStore(userID, subscription))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) CreateUserWebPushSubscription(ctx context.Context, request *v1pb.CreateUserWebPushSubscriptionRequest) (*v1pb.UserWebPushSubscription, error) {
+	userID, err := ExtractUserIDFromName(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+	create := request.WebPushSubscription
+	if create == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "web push subscription is required")
+	}
+	if !strings.HasPrefix(create.Endpoint, "https://") {
+		return nil, status.Errorf(codes.InvalidArgument, "the endpoint must be an https URL")
+	}
+	if create.Keys.GetP256Dh() == "" || create.Keys.GetAuth() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "the p256dh and auth keys are required")
+	}
+
+	subscriptions, err := s.Store.GetUserWebPushSubscriptions(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get web push subscriptions: %v", err)
+	}
+	// A browser subscribing again replaces its previous subscription, its keys may have changed.
+	subscriptions = slices.DeleteFunc(slices.Clone(subscriptions), func(subscription *storepb.WebPushSubscriptionsUserSetting_Subscription) bool {
+		return subscription.Endpoint == create.Endpoint
+	})
+	subscription := &storepb.WebPushSubscriptionsUserSetting_Subscription{
+		Id:         util.GenUUID(),
+		Endpoint:   create.Endpoint,
+		P256Dh:     create.Keys.GetP256Dh(),
+		Auth:       create.Keys.GetAuth(),
+		CreateTime: timestamppb.Now(),
+		UserAgent:  create.UserAgent,
+	}
+	subscriptions = append(subscriptions, subscription)
+	if len(subscriptions) > maxWebPushSubscriptions {
+		subscriptions = subscriptions[len(subscriptions)-maxWebPushSubscriptions:]
+	}
+	if err := s.Store.UpsertUserWebPushSubscriptions(ctx, userID, subscriptions); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create web push subscription: %v", err)
+	}
+	return convertWebPushSubscriptionFromStore(userID, subscription), nil
+}
+
+func (s *APIV1Service) DeleteUserWebPushSubscription(ctx context.Context, request *v1pb.DeleteUserWebPushSubscriptionRequest) (*emptypb.Empty, error) {
+	userID, subscriptionID, err := ExtractWebPushSubscriptionIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid web push subscription name: %v", err)
+	}
+	if err := s.checkResourceOwner(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := s.Store.GetUserWebPushSubscriptions(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get web push subscriptions: %v", err)
+	}
+	newSubscriptions := slices.DeleteFunc(slices.Clone(subscriptions), func(subscription *storepb.WebPushSubscriptionsUserSetting_Subscription) bool {
+		return subscription.Id == subscriptionID
+	})
+	if len(newSubscriptions) == len(subscriptions) {
+		return nil, status.Errorf(codes.NotFound, "web push subscription not found")
+	}
+	if err := s.Store.UpsertUserWebPushSubscriptions(ctx, userID, newSubscriptions); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete web push subscription: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// createInbox creates the inbox message and notifies the receiver of it in the background, with the channels they
+// chose for its event. The message is returned unsaved if the receiver turned the inbox off for its event.
+func (s *APIV1Service) createInbox(ctx context.Context, create *store.Inbox) (*store.Inbox, error) {
+	preference, err := s.Store.GetUserNotificationPreference(ctx, create.ReceiverID, getInboxMessageEvent(create.Message))
+	if err != nil {
+		return nil, err
+	}
+	inbox := create
+	if preference.Inbox {
+		inbox, err = s.aggregateInbox(ctx, create)
+		if err != nil {
+			return nil, err
+		}
+		if inbox == nil {
+			inbox, err = s.Store.CreateInbox(ctx, create)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if preference.Push || preference.Email {
+		message, err := protojson.Marshal(inbox.Message)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal inbox message")
+		}
+		s.enqueueJob(ctx, webPushJobKind, &webPushJobPayload{
+			InboxID:    inbox.ID,
+			ReceiverID: inbox.ReceiverID,
+			SenderID:   inbox.SenderID,
+			Message:    message,
+			Push:       preference.Push,
+			Email:      preference.Email,
+		})
+	}
+	return inbox, nil
+}
+
+func (s *APIV1Service) runWebPushJob(ctx context.Context, data []byte) error {
+	var payload webPushJobPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return errors.Wrap(err, "invalid job payload")
+	}
+	message := &storepb.InboxMessage{}
+	if err := protojson.Unmarshal(payload.Message, message); err != nil {
+		return errors.Wrap(err, "invalid inbox message")
+	}
+	// The notifications are sent once, the failures being logged, as retrying would send them again to the
+	// browsers which got them.
+	s.sendInboxNotification(ctx, &store.Inbox{
+		ID:         payload.InboxID,
+		ReceiverID: payload.ReceiverID,
+		SenderID:   payload.SenderID,
+		Message:    message,
+	}, &storepb.NotificationPreferencesUserSetting_Preference{
+		Push:  payload.Push,
+		Email: payload.Email,
+	})
+	return nil
+}
+
+// aggregateInbox updates in place the unread message of the receiver of the same type about the same memo, if any,
+// with the latest event, counting the events aggregated. It returns nil if there is no such message.
+func (s *APIV1Service) aggregateInbox(ctx context.Context, create *store.Inbox) (*store.Inbox, error) {
+	if create.Message.MemoId == nil {
+		return nil, nil
+	}
+	unread := store.UNREAD
+	inboxes, err := s.Store.ListInboxes(ctx, &store.FindInbox{
+		ReceiverID: &create.ReceiverID,
+		Status:     &unread,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, inbox := range inboxes {
+		if inbox.Message.GetType() != create.Message.GetType() || inbox.Message.GetMemoId() != create.Message.GetMemoId() {
+			continue
+		}
+		message := proto.Clone(create.Message).(*storepb.InboxMessage)
+		message.Count = max(inbox.Message.Count, 1) + 1
+		createdTs := time.Now().Unix()
+		return s.Store.UpdateInbox(ctx, &store.UpdateInbox{
+			ID:        inbox.ID,
+			Status:    store.UNREAD,
+			CreatedTs: &createdTs,
+			SenderID:  &create.SenderID,
+			Message:   message,
+		})
+	}
+	return nil, nil
+}
+
+// pushInboxNotification pushes the notification of the inbox message to the browsers subscribed by the receiver,
+// unsubscribing the browsers whose subscription is gone.
+func (s *APIV1Service) pushInboxNotification(ctx context.Context, inbox *store.Inbox, notification *webpush.Notification) {
+	subscriptions, err := s.Store.GetUserWebPushSubscriptions(ctx, inbox.ReceiverID)
+	if err != nil {
+		slog.Warn("Failed to get web push subscriptions", slog.Int("user", int(inbox.ReceiverID)), slog.Any("err", err))
+		return
+	}
+	if len(subscriptions) == 0 {
+		return
+	}
+	sender, err := s.getWebPushSender(ctx)
+	if err != nil {
+		slog.Warn("Failed to get web push sender", slog.Any("err", err))
+		return
+	}
+
+	goneIDs := []string{}
+	for _, subscription := range subscriptions {
+		err := sender.Send(ctx, &webpush.Subscription{
+			Endpoint: subscription.Endpoint,
+			P256dh:   subscription.P256Dh,
+			Auth:     subscription.Auth,
+		}, notification)
+		if errors.Is(err, webpush.ErrSubscriptionGone) {
+			goneIDs = append(goneIDs, subscription.Id)
+		} else if err != nil {
+			slog.Warn("Failed to send web push notification", slog.Int("user", int(inbox.ReceiverID)), slog.Any("err", err))
+		}
+	}
+	if len(goneIDs) == 0 {
+		return
+	}
+	// The subscriptions are read again, the user may have changed them in the meantime.
+	subscriptions, err = s.Store.GetUserWebPushSubscriptions(ctx, inbox.ReceiverID)
+	if err != nil {
+		slog.Warn("Failed to get web push subscriptions", slog.Int("user", int(inbox.ReceiverID)), slog.Any("err", err))
+		return
+	}
+	subscriptions = slices.DeleteFunc(slices.Clone(subscriptions), func(subscription *storepb.WebPushSubscriptionsUserSetting_Subscription) bool {
+		return slices.Contains(goneIDs, subscription.Id)
+	})
+	if err := s.Store.UpsertUserWebPushSubscriptions(ctx, inbox.ReceiverID, subscriptions); err != nil {
+		slog.Warn("Failed to delete gone web push subscriptions", slog.Int("user", int(inbox.ReceiverID)), slog.Any("err", err))
+	}
+}
+
+// getWebPushSender returns the sender of the notifications, signed with the VAPID keys of the workspace.
+func (s *APIV1Service) getWebPushSender(ctx context.Context) (*webpush.Sender, error) {
+	workspaceBasicSetting, err := s.Store.GetWorkspaceBasicSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace basic setting")
+	}
+	if workspaceBasicSetting.VapidPrivateKey == "" || workspaceBasicSetting.VapidPublicKey == "" {
+		return nil, errors.New("the workspace has no VAPID keys")
+	}
+	// The push services contact the instance through its https URL, or else the email of its owner.
+	subscriber := s.Profile.InstanceURL
+	if !strings.HasPrefix(subscriber, "https://") {
+		owner, err := s.GetInstanceOwner(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get instance owner")
+		}
+		if owner == nil || owner.Email == "" {
+			return nil, errors.New("the instance has neither an https URL nor an owner email to give to the push services")
+		}
+		subscriber = owner.Email
+	}
+	return &webpush.Sender{
+		VAPIDPublicKey:  workspaceBasicSetting.VapidPublicKey,
+		VAPIDPrivateKey: workspaceBasicSetting.VapidPrivateKey,
+		Subscriber:      subscriber,
+	}, nil
+}
+
+// getInboxNotification returns the notification of the inbox message, nil if its type has none.
+func (s *APIV1Service) getInboxNotification(ctx context.Context, inbox *store.Inbox) (*webpush.Notification, error) {
+	if inbox.Message.GetType() == storepb.InboxMessage_MEMO_MENTION && inbox.Message.ActivityId != nil {
+		return s.getMemoMentionNotification(ctx, inbox)
+	}
+	if inbox.Message.GetType() == storepb.InboxMessage_MEMO_ON_THIS_DAY {
+		return s.getOnThisDayNotification(ctx, inbox)
+	}
+	if inbox.Message.GetType() == storepb.InboxMessage_MEMO_REACTION && inbox.Message.ActivityId != nil {
+		return s.getMemoReactionNotification(ctx, inbox)
+	}
+	isReply := inbox.Message.GetType() == storepb.InboxMessage_MEMO_COMMENT_REPLY
+	if (inbox.Message.GetType() != storepb.InboxMessage_MEMO_COMMENT && !isReply) || inbox.Message.ActivityId == nil {
+		return nil, nil
+	}
+	activity, err := s.Store.GetActivity(ctx, &store.FindActivity{ID: inbox.Message.ActivityId})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get activity")
+	}
+	if activity == nil || activity.Payload.GetMemoComment() == nil {
+		return nil, nil
+	}
+	comment, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &activity.Payload.MemoComment.MemoId})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get comment")
+	}
+	relatedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &activity.Payload.MemoComment.RelatedMemoId})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get memo")
+	}
+	sender, err := s.Store.GetUser(ctx, &store.FindUser{ID: &inbox.SenderID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get sender")
+	}
+	if comment == nil || relatedMemo == nil || sender == nil {
+		return nil, nil
+	}
+	snippet, err := getMemoContentSnippet(comment.Content)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get comment snippet")
+	}
+	senderName := sender.Nickname
+	if senderName == "" {
+		senderName = sender.Username
+	}
+	title := fmt.Sprintf("%s commented on your memo", senderName)
+	if count := inbox.Message.GetCount(); count > 1 {
+		title = fmt.Sprintf("%d new comments on your memo", count)
+		if isReply {
+			title = fmt.Sprintf("%d new replies on a memo you commented on", count)
+		}
+	} else if isReply {
+		title = fmt.Sprintf("%s replied on a memo you commented on", senderName)
+	}
+	notification := &webpush.Notification{
+		Title: title,
+		Body:  snippet,
+		URL:   s.getMemoURL(fmt.Sprintf("%s%s", MemoNamePrefix, relatedMemo.UID)),
+	}
+	// The messages left out of the inbox by the preferences of the receiver are not saved.
+	if inbox.ID != 0 {
+		notification.Tag = fmt.Sprintf("%s%d", InboxNamePrefix, inbox.ID)
+	}
+	return notification, nil
+}
+
+func convertWebPushSubscriptionFromStore(userID int32, subscription *storepb.WebPushSubscriptionsUserSetting_Subscription) *v1pb.UserWebPushSubscription {
+	return &v1pb.UserWebPushSubscription{
+		Name:       fmt.Sprintf("%s%d/%s%s", UserNamePrefix, userID, WebPushSubscriptionNamePrefix, subscription.Id),
+		Endpoint:   subscription.Endpoint,
+		UserAgent:  subscription.UserAgent,
+		CreateTime: subscription.CreateTime,
+	}
+}
@@ -15,7 +15,13 @@ import (
 	"google.golang.org/grpc/reflection"
 
 	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/cluster"
+	"github.com/usememos/memos/plugin/eventbus"
+	"github.com/usememos/memos/plugin/hook"
+	"github.com/usememos/memos/plugin/httpgetter"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/server/runner/jobqueue"
+	"github.com/usememos/memos/server/runner/scheduler"
 	"github.com/usememos/memos/store"
 )
 
@@ -28,17 +34,45 @@ type APIV1Service struct {
 	v1pb.UnimplementedMemoServiceServer
 	v1pb.UnimplementedAttachmentServiceServer
 	v1pb.UnimplementedShortcutServiceServer
+	v1pb.UnimplementedSavedSearchServiceServer
+	v1pb.UnimplementedFilterMacroServiceServer
+	v1pb.UnimplementedTagServiceServer
 	v1pb.UnimplementedInboxServiceServer
 	v1pb.UnimplementedActivityServiceServer
 	v1pb.UnimplementedWebhookServiceServer
 	v1pb.UnimplementedMarkdownServiceServer
 	v1pb.UnimplementedIdentityProviderServiceServer
+	v1pb.UnimplementedSpaceServiceServer
+	v1pb.UnimplementedGroupServiceServer
 
 	Secret  string
 	Profile *profile.Profile
 	Store   *store.Store
+	// EventPublisher publishes the activities of the webhooks to the event bus, disabled if nil.
+	EventPublisher eventbus.Publisher
+	// PublishFeeds notifies the WebSub hub that the feeds of the public memos of the user are updated, disabled if nil.
+	PublishFeeds func(ctx context.Context, username string) error
+	// GetHTML fetches the pages saved by SaveLink, httpgetter.GetHTML if nil.
+	GetHTML func(ctx context.Context, url string) (*httpgetter.HTMLPage, error)
+	// GetImage fetches the lead images of the pages saved by SaveLink, httpgetter.GetImageWithContext if nil.
+	GetImage func(ctx context.Context, url string) (*httpgetter.Image, error)
+	// JobQueue runs the background work of the requests, e.g. generating the thumbnails, which is skipped if nil.
+	JobQueue *jobqueue.Queue
+	// Hooks calls the hooks of the instance on the events of the memos, if not nil.
+	Hooks *hook.Runner
+	// Scheduler runs the maintenance tasks on their schedule, managed by the admins if not nil.
+	Scheduler *scheduler.Scheduler
+	// Cluster shares the locks of the uploads and the state of the CAPTCHA among the instances, if not nil.
+	Cluster *cluster.Cluster
+	// AuthInterceptor authorizes the requests served outside of the gateway, e.g. the attachment files, sharing the rate
+	// limits of the access tokens with the gRPC server. A new interceptor is used if nil.
+	AuthInterceptor *GRPCAuthInterceptor
 
 	grpcServer *grpc.Server
+	// memoSuggester backs the typeahead suggestions of SuggestMemos.
+	memoSuggester memoSuggester
+	// captchaGuard tracks the failed sign-ins requiring a CAPTCHA and the solved challenges.
+	captchaGuard captchaGuard
 }
 
 func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store, grpcServer *grpc.Server) *APIV1Service {
@@ -56,11 +90,16 @@ func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store
 	v1pb.RegisterMemoServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterAttachmentServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterShortcutServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterSavedSearchServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterFilterMacroServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterTagServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterInboxServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterActivityServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterWebhookServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterMarkdownServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterIdentityProviderServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterSpaceServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterGroupServiceServer(grpcServer, apiv1Service)
 	reflection.Register(grpcServer)
 	return apiv1Service
 }
@@ -82,7 +121,7 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 		return err
 	}
 
-	gwMux := runtime.NewServeMux()
+	gwMux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher))
 	if err := v1pb.RegisterWorkspaceServiceHandler(ctx, gwMux, conn); err != nil {
 		return err
 	}
@@ -101,6 +140,15 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 	if err := v1pb.RegisterShortcutServiceHandler(ctx, gwMux, conn); err != nil {
 		return err
 	}
+	if err := v1pb.RegisterSavedSearchServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
+	if err := v1pb.RegisterFilterMacroServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
+	if err := v1pb.RegisterTagServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
 	if err := v1pb.RegisterInboxServiceHandler(ctx, gwMux, conn); err != nil {
 		return err
 	}
@@ -116,12 +164,24 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 	if err := v1pb.RegisterIdentityProviderServiceHandler(ctx, gwMux, conn); err != nil {
 		return err
 	}
+	if err := v1pb.RegisterSpaceServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
+	if err := v1pb.RegisterGroupServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
 	gwGroup := echoServer.Group("")
 	gwGroup.Use(middleware.CORS())
 	handler := echo.WrapHandler(gwMux)
 
 	gwGroup.Any("/api/v1/*", handler)
+	// The original content of attachments is served directly to support ranges of large media.
+	gwGroup.GET("/file/attachments/:uid/:filename", s.ServeAttachmentFile(handler))
+	gwGroup.HEAD("/file/attachments/:uid/:filename", s.ServeAttachmentFile(handler))
 	gwGroup.Any("/file/*", handler)
+	// The Slack app and the Discord bot are requested by Slack and Discord directly, and authenticated with their signatures.
+	s.RegisterSlackRoutes(echoServer)
+	s.RegisterDiscordRoutes(echoServer)
 
 	// GRPC web proxy.
 	options := []grpcweb.Option{
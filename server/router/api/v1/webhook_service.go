@@ -4,18 +4,31 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"log/slog"
+	"slices"
 	"strings"
+	"time"
 
+	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/eventbus"
+	"github.com/usememos/memos/plugin/webhook"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/webhookdelivery"
+	"github.com/usememos/memos/store"
 )
 
+// webhookSecretLength is the length of the generated webhook secrets.
+const webhookSecretLength = 32
+
 func (s *APIV1Service) CreateWebhook(ctx context.Context, request *v1pb.CreateWebhookRequest) (*v1pb.Webhook, error) {
 	currentUser, err := s.GetCurrentUser(ctx)
 	if err != nil {
@@ -36,9 +49,9 @@ func (s *APIV1Service) CreateWebhook(ctx context.Context, request *v1pb.CreateWe
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
 
-	// Only host users can create webhooks
-	if !isSuperUser(currentUser) {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	// Only the webhook managers can create webhooks
+	if err := s.checkPermission(ctx, currentUser, storepb.Permission_MANAGE_WEBHOOKS); err != nil {
+		return nil, err
 	}
 
 	// Validate required fields
@@ -48,6 +61,9 @@ func (s *APIV1Service) CreateWebhook(ctx context.Context, request *v1pb.CreateWe
 	if strings.TrimSpace(request.Webhook.Url) == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "webhook URL is required")
 	}
+	if err := validateWebhookEventTypes(request.Webhook.EventTypes); err != nil {
+		return nil, err
+	}
 
 	// Handle validate_only field
 	if request.ValidateOnly {
@@ -56,13 +72,23 @@ func (s *APIV1Service) CreateWebhook(ctx context.Context, request *v1pb.CreateWe
 			Name:        fmt.Sprintf("users/%d/webhooks/validate", currentUser.ID),
 			DisplayName: request.Webhook.DisplayName,
 			Url:         request.Webhook.Url,
+			EventTypes:  request.Webhook.EventTypes,
 		}, nil
 	}
 
+	secret := request.Webhook.Secret
+	if secret == "" {
+		secret, err = util.RandomString(webhookSecretLength)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate webhook secret: %v", err)
+		}
+	}
 	err = s.Store.AddUserWebhook(ctx, currentUser.ID, &storepb.WebhooksUserSetting_Webhook{
-		Id:    generateWebhookID(),
-		Title: request.Webhook.DisplayName,
-		Url:   strings.TrimSpace(request.Webhook.Url),
+		Id:         generateWebhookID(),
+		Title:      request.Webhook.DisplayName,
+		Url:        strings.TrimSpace(request.Webhook.Url),
+		Secret:     secret,
+		EventTypes: request.Webhook.EventTypes,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create webhook, error: %+v", err)
@@ -219,9 +245,13 @@ func (s *APIV1Service) UpdateWebhook(ctx context.Context, request *v1pb.UpdateWe
 
 	// Create updated webhook
 	updatedWebhook := &storepb.WebhooksUserSetting_Webhook{
-		Id:    existingWebhook.Id,
-		Title: existingWebhook.Title,
-		Url:   existingWebhook.Url,
+		Id:           existingWebhook.Id,
+		Title:        existingWebhook.Title,
+		Url:          existingWebhook.Url,
+		Secret:       existingWebhook.Secret,
+		Disabled:     existingWebhook.Disabled,
+		FailureCount: existingWebhook.FailureCount,
+		EventTypes:   existingWebhook.EventTypes,
 	}
 
 	// Apply updates based on update mask
@@ -231,6 +261,26 @@ func (s *APIV1Service) UpdateWebhook(ctx context.Context, request *v1pb.UpdateWe
 			updatedWebhook.Title = request.Webhook.DisplayName
 		case "url":
 			updatedWebhook.Url = request.Webhook.Url
+		case "secret":
+			// The secret is rotated by updating it to empty.
+			updatedWebhook.Secret = request.Webhook.Secret
+			if updatedWebhook.Secret == "" {
+				updatedWebhook.Secret, err = util.RandomString(webhookSecretLength)
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to generate webhook secret: %v", err)
+				}
+			}
+		case "event_types":
+			if err := validateWebhookEventTypes(request.Webhook.EventTypes); err != nil {
+				return nil, err
+			}
+			updatedWebhook.EventTypes = request.Webhook.EventTypes
+		case "disabled":
+			updatedWebhook.Disabled = request.Webhook.Disabled
+			// Enabling the webhook again gives its endpoint another chance.
+			if !updatedWebhook.Disabled {
+				updatedWebhook.FailureCount = 0
+			}
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", field)
 		}
@@ -299,15 +349,273 @@ func (s *APIV1Service) DeleteWebhook(ctx context.Context, request *v1pb.DeleteWe
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete webhook: %v", err)
 	}
+	if err := s.Store.DeleteWebhookDeliveries(ctx, &store.DeleteWebhookDelivery{
+		CreatorID: &currentUser.ID,
+		WebhookID: &webhookID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete webhook deliveries: %v", err)
+	}
 	return &emptypb.Empty{}, nil
 }
 
+func (s *APIV1Service) ListWebhookDeliveries(ctx context.Context, request *v1pb.ListWebhookDeliveriesRequest) (*v1pb.ListWebhookDeliveriesResponse, error) {
+	// Extract user ID and webhook ID from parent (format: users/{user}/webhooks/{webhook})
+	tokens, err := GetNameParentTokens(request.Parent, UserNamePrefix, WebhookNamePrefix)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid webhook name: %v", err)
+	}
+	requestedUserID, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID in webhook name: %v", err)
+	}
+	webhookID := tokens[1]
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	// Users can only list the deliveries of their own webhooks
+	if requestedUserID != currentUser.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+	limitPlusOne := limit + 1
+
+	deliveries, err := s.Store.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{
+		CreatorID: &currentUser.ID,
+		WebhookID: &webhookID,
+		Limit:     &limitPlusOne,
+		Offset:    &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webhook deliveries: %v", err)
+	}
+
+	response := &v1pb.ListWebhookDeliveriesResponse{
+		Deliveries: []*v1pb.WebhookDelivery{},
+	}
+	if len(deliveries) == limitPlusOne {
+		deliveries = deliveries[:limit]
+		response.NextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token: %v", err)
+		}
+	}
+	for _, delivery := range deliveries {
+		response.Deliveries = append(response.Deliveries, convertWebhookDeliveryFromStore(delivery))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) RetryWebhookDelivery(ctx context.Context, request *v1pb.RetryWebhookDeliveryRequest) (*v1pb.WebhookDelivery, error) {
+	delivery, err := s.getWebhookDeliveryByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if delivery.Status != store.WebhookDeliveryDead {
+		return nil, status.Errorf(codes.FailedPrecondition, "only dead-lettered deliveries can be retried")
+	}
+	if err := s.checkWebhookEnabled(ctx, delivery.CreatorID, delivery.WebhookID); err != nil {
+		return nil, err
+	}
+
+	// The runner attempts the delivery on its next run.
+	pending, attemptCount, nextAttemptTs := store.WebhookDeliveryPending, int32(0), time.Now().Unix()
+	if err := s.Store.UpdateWebhookDelivery(ctx, &store.UpdateWebhookDelivery{
+		ID:            delivery.ID,
+		Status:        &pending,
+		AttemptCount:  &attemptCount,
+		NextAttemptTs: &nextAttemptTs,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update webhook delivery: %v", err)
+	}
+	delivery.Status, delivery.AttemptCount, delivery.NextAttemptTs = pending, attemptCount, nextAttemptTs
+	return convertWebhookDeliveryFromStore(delivery), nil
+}
+
+func (s *APIV1Service) RedeliverWebhookDelivery(ctx context.Context, request *v1pb.RedeliverWebhookDeliveryRequest) (*v1pb.WebhookDelivery, error) {
+	delivery, err := s.getWebhookDeliveryByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkWebhookEnabled(ctx, delivery.CreatorID, delivery.WebhookID); err != nil {
+		return nil, err
+	}
+
+	redelivery, err := webhookdelivery.Enqueue(ctx, s.Store, s.JobQueue, delivery.CreatorID, delivery.WebhookID, delivery.ActivityType, []byte(delivery.Payload))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to redeliver webhook delivery: %v", err)
+	}
+	return convertWebhookDeliveryFromStore(redelivery), nil
+}
+
+// getWebhookDeliveryByName returns the delivery of a webhook of the current user.
+func (s *APIV1Service) getWebhookDeliveryByName(ctx context.Context, name string) (*store.WebhookDelivery, error) {
+	// Extract user ID, webhook ID and delivery ID from name (format: users/{user}/webhooks/{webhook}/deliveries/{delivery})
+	tokens, err := GetNameParentTokens(name, UserNamePrefix, WebhookNamePrefix, WebhookDeliveryNamePrefix)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid webhook delivery name: %v", err)
+	}
+	requestedUserID, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID in webhook delivery name: %v", err)
+	}
+	webhookID := tokens[1]
+	deliveryID, err := util.ConvertStringToInt32(tokens[2])
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delivery ID in webhook delivery name: %v", err)
+	}
+
+	currentUser, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not authenticated")
+	}
+
+	// Users can only access the deliveries of their own webhooks
+	if requestedUserID != currentUser.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	delivery, err := s.Store.GetWebhookDelivery(ctx, &store.FindWebhookDelivery{
+		ID:        &deliveryID,
+		CreatorID: &currentUser.ID,
+		WebhookID: &webhookID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get webhook delivery: %v", err)
+	}
+	if delivery == nil {
+		return nil, status.Errorf(codes.NotFound, "webhook delivery not found")
+	}
+	return delivery, nil
+}
+
+// checkWebhookEnabled returns a FailedPrecondition error if the webhook is disabled, as its deliveries would be dead-lettered.
+func (s *APIV1Service) checkWebhookEnabled(ctx context.Context, userID int32, webhookID string) error {
+	webhooks, err := s.Store.GetUserWebhooks(ctx, userID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get webhooks: %v", err)
+	}
+	for _, webhook := range webhooks {
+		if webhook.Id == webhookID && webhook.Disabled {
+			return status.Errorf(codes.FailedPrecondition, "webhook is disabled")
+		}
+	}
+	return nil
+}
+
 func convertWebhookFromUserSetting(webhook *storepb.WebhooksUserSetting_Webhook, userID int32) *v1pb.Webhook {
 	return &v1pb.Webhook{
-		Name:        fmt.Sprintf("users/%d/webhooks/%s", userID, webhook.Id),
-		DisplayName: webhook.Title,
-		Url:         webhook.Url,
+		Name:         fmt.Sprintf("users/%d/webhooks/%s", userID, webhook.Id),
+		DisplayName:  webhook.Title,
+		Url:          webhook.Url,
+		Secret:       webhook.Secret,
+		Disabled:     webhook.Disabled,
+		FailureCount: webhook.FailureCount,
+		EventTypes:   webhook.EventTypes,
+	}
+}
+
+func convertWebhookDeliveryFromStore(delivery *store.WebhookDelivery) *v1pb.WebhookDelivery {
+	webhookDelivery := &v1pb.WebhookDelivery{
+		Name:         fmt.Sprintf("%s%d/%s%s/%s%d", UserNamePrefix, delivery.CreatorID, WebhookNamePrefix, delivery.WebhookID, WebhookDeliveryNamePrefix, delivery.ID),
+		ActivityType: delivery.ActivityType,
+		AttemptCount: delivery.AttemptCount,
+		LastError:    delivery.LastError,
+		CreateTime:   timestamppb.New(time.Unix(delivery.CreatedTs, 0)),
+		// The response of the last attempt, if any.
+		ResponseStatusCode: delivery.ResponseStatusCode,
+		ResponseBody:       delivery.ResponseBody,
+		LatencyMs:          delivery.LatencyMs,
+	}
+	if delivery.LastAttemptTs != 0 {
+		webhookDelivery.LastAttemptTime = timestamppb.New(time.Unix(delivery.LastAttemptTs, 0))
+	}
+	switch delivery.Status {
+	case store.WebhookDeliveryPending:
+		webhookDelivery.State = v1pb.WebhookDelivery_PENDING
+		webhookDelivery.NextAttemptTime = timestamppb.New(time.Unix(delivery.NextAttemptTs, 0))
+	case store.WebhookDeliverySucceeded:
+		webhookDelivery.State = v1pb.WebhookDelivery_SUCCEEDED
+	case store.WebhookDeliveryDead:
+		webhookDelivery.State = v1pb.WebhookDelivery_DEAD
+	}
+	return webhookDelivery
+}
+
+// dispatchWebhook delivers the payload to the enabled webhooks of the user subscribed to its activity type.
+// The activities of all the users are also published to the event bus, if any.
+func (s *APIV1Service) dispatchWebhook(ctx context.Context, userID int32, payload *webhook.WebhookRequestPayload) error {
+	s.publishEvent(ctx, payload)
+	webhooks, err := s.Store.GetUserWebhooks(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, hook := range webhooks {
+		if hook.Disabled || (len(hook.EventTypes) > 0 && !slices.Contains(hook.EventTypes, payload.ActivityType)) {
+			continue
+		}
+		payload.URL = hook.Url
+		body, err := json.Marshal(payload)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal webhook payload")
+		}
+
+		// The delivery is attempted asynchronously, and again by the runner if it fails.
+		if _, err := webhookdelivery.Enqueue(ctx, s.Store, s.JobQueue, userID, hook.Id, payload.ActivityType, body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// publishEvent publishes the activity of the payload to the event bus, if any.
+// The failures are only logged, the event bus being a best-effort feed unlike the retried webhook deliveries.
+func (s *APIV1Service) publishEvent(ctx context.Context, payload *webhook.WebhookRequestPayload) {
+	if s.EventPublisher == nil {
+		return
+	}
+	if err := s.EventPublisher.Publish(ctx, &eventbus.Event{
+		ActivityType: payload.ActivityType,
+		Creator:      payload.Creator,
+		Memo:         payload.Memo,
+		Attachment:   payload.Attachment,
+	}); err != nil {
+		slog.Warn("Failed to publish event", slog.String("activityType", payload.ActivityType), slog.Any("err", err))
+	}
+}
+
+func validateWebhookEventTypes(eventTypes []string) error {
+	for _, eventType := range eventTypes {
+		if !slices.Contains(webhook.ActivityTypes, eventType) {
+			return status.Errorf(codes.InvalidArgument, "invalid webhook event type: %s", eventType)
+		}
 	}
+	return nil
 }
 
 func generateWebhookID() string {
@@ -0,0 +1,238 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/jobqueue"
+	"github.com/usememos/memos/server/runner/webhookdelivery"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// attachmentThumbnailsJobKind generates the thumbnails, poster frame or preview of a new attachment.
+	attachmentThumbnailsJobKind = "attachment_thumbnails"
+	// feedPublishJobKind notifies the WebSub hub of the update of the feeds of a user.
+	feedPublishJobKind = "feed_publish"
+)
+
+type attachmentJobPayload struct {
+	AttachmentID int32 `json:"attachmentId"`
+}
+
+type feedPublishJobPayload struct {
+	Username string `json:"username"`
+}
+
+// UseJobQueue runs the background work of the requests as jobs of the queue, whose handlers it registers.
+func (s *APIV1Service) UseJobQueue(queue *jobqueue.Queue) {
+	queue.Register(attachmentThumbnailsJobKind, jobqueue.Handler{
+		Run: s.runAttachmentThumbnailsJob,
+		// Decoding large images takes much memory, so few of them are decoded at once.
+		Concurrency: 2,
+	})
+	queue.Register(feedPublishJobKind, jobqueue.Handler{
+		Run:         s.runFeedPublishJob,
+		MaxAttempts: 5,
+		Timeout:     time.Minute,
+	})
+	queue.Register(memoHookJobKind, jobqueue.Handler{
+		Run:         s.runMemoHookJob,
+		MaxAttempts: 5,
+	})
+	queue.Register(attachmentStorageMigrationJobKind, jobqueue.Handler{
+		Run: s.runAttachmentStorageMigrationJob,
+		// The migration reports the attachments failing to migrate, and may be started again to retry them.
+		MaxAttempts: 1,
+		Timeout:     24 * time.Hour,
+		Concurrency: 1,
+	})
+	queue.Register(webPushJobKind, jobqueue.Handler{
+		Run:         s.runWebPushJob,
+		MaxAttempts: 1,
+		Timeout:     time.Minute,
+	})
+	webhookdelivery.RegisterJobHandler(queue, s.Store)
+	s.JobQueue = queue
+}
+
+// enqueueJob enqueues a job of the kind, only logging the failures as the background work is not essential
+// to the requests.
+func (s *APIV1Service) enqueueJob(ctx context.Context, kind string, payload any) {
+	if s.JobQueue == nil {
+		return
+	}
+	if _, err := s.JobQueue.Enqueue(ctx, kind, payload); err != nil {
+		slog.Warn("Failed to enqueue job", slog.String("kind", kind), slog.Any("err", err))
+	}
+}
+
+func (s *APIV1Service) ListJobs(ctx context.Context, request *v1pb.ListJobsRequest) (*v1pb.ListJobsResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if err := s.checkPermission(ctx, user, storepb.Permission_MANAGE_WORKSPACE); err != nil {
+		return nil, err
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken v1pb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+	limitPlusOne := limit + 1
+
+	find := &store.FindJob{
+		Limit:  &limitPlusOne,
+		Offset: &offset,
+	}
+	if request.Kind != "" {
+		find.Kind = &request.Kind
+	}
+	if request.State != v1pb.Job_STATE_UNSPECIFIED {
+		jobStatus, err := convertJobStateToStore(request.State)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		find.Status = &jobStatus
+	}
+	jobs, err := s.Store.ListJobs(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list jobs: %v", err)
+	}
+
+	response := &v1pb.ListJobsResponse{
+		Jobs: []*v1pb.Job{},
+	}
+	if len(jobs) == limitPlusOne {
+		jobs = jobs[:limit]
+		response.NextPageToken, err = getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token: %v", err)
+		}
+	}
+	for _, job := range jobs {
+		response.Jobs = append(response.Jobs, convertJobFromStore(job))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetJob(ctx context.Context, request *v1pb.GetJobRequest) (*v1pb.Job, error) {
+	job, err := s.getJobByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return convertJobFromStore(job), nil
+}
+
+func (s *APIV1Service) RetryJob(ctx context.Context, request *v1pb.RetryJobRequest) (*v1pb.Job, error) {
+	job, err := s.getJobByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status != store.JobFailed {
+		return nil, status.Errorf(codes.FailedPrecondition, "only failed jobs can be retried")
+	}
+
+	pending, attemptCount, nextAttemptTs, finishedTs := store.JobPending, int32(0), time.Now().Unix(), int64(0)
+	if err := s.Store.UpdateJob(ctx, &store.UpdateJob{
+		ID:            job.ID,
+		Status:        &pending,
+		AttemptCount:  &attemptCount,
+		NextAttemptTs: &nextAttemptTs,
+		FinishedTs:    &finishedTs,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update job: %v", err)
+	}
+	if s.JobQueue != nil {
+		s.JobQueue.Wake()
+	}
+	job.Status, job.AttemptCount, job.NextAttemptTs, job.FinishedTs = pending, attemptCount, nextAttemptTs, finishedTs
+	return convertJobFromStore(job), nil
+}
+
+// getJobByName returns the job of the name, checking that the current user manages the workspace.
+func (s *APIV1Service) getJobByName(ctx context.Context, name string) (*store.Job, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if err := s.checkPermission(ctx, user, storepb.Permission_MANAGE_WORKSPACE); err != nil {
+		return nil, err
+	}
+	jobID, err := ExtractJobIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	job, err := s.Store.GetJob(ctx, &store.FindJob{ID: &jobID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get job: %v", err)
+	}
+	if job == nil {
+		return nil, status.Errorf(codes.NotFound, "job not found")
+	}
+	return job, nil
+}
+
+func convertJobFromStore(job *store.Job) *v1pb.Job {
+	jobMessage := &v1pb.Job{
+		Name:         fmt.Sprintf("%s%d", JobNamePrefix, job.ID),
+		Kind:         job.Kind,
+		AttemptCount: job.AttemptCount,
+		MaxAttempts:  job.MaxAttempts,
+		LastError:    job.LastError,
+		CreateTime:   timestamppb.New(time.Unix(job.CreatedTs, 0)),
+	}
+	switch job.Status {
+	case store.JobPending:
+		jobMessage.State = v1pb.Job_PENDING
+		jobMessage.NextAttemptTime = timestamppb.New(time.Unix(job.NextAttemptTs, 0))
+	case store.JobRunning:
+		jobMessage.State = v1pb.Job_RUNNING
+	case store.JobSucceeded:
+		jobMessage.State = v1pb.Job_SUCCEEDED
+	case store.JobFailed:
+		jobMessage.State = v1pb.Job_FAILED
+	}
+	if job.FinishedTs != 0 {
+		jobMessage.FinishTime = timestamppb.New(time.Unix(job.FinishedTs, 0))
+	}
+	return jobMessage
+}
+
+func convertJobStateToStore(state v1pb.Job_State) (store.JobStatus, error) {
+	switch state {
+	case v1pb.Job_PENDING:
+		return store.JobPending, nil
+	case v1pb.Job_RUNNING:
+		return store.JobRunning, nil
+	case v1pb.Job_SUCCEEDED:
+		return store.JobSucceeded, nil
+	case v1pb.Job_FAILED:
+		return store.JobFailed, nil
+	default:
+		return "", errors.Errorf("invalid job state: %v", state)
+	}
+}
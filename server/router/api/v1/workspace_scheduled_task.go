@@ -0,0 +1,166 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/scheduler"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListScheduledTasks(ctx context.Context, _ *v1pb.ListScheduledTasksRequest) (*v1pb.ListScheduledTasksResponse, error) {
+	if err := s.checkManageScheduledTasks(ctx); err != nil {
+		return nil, err
+	}
+	states, err := s.Store.ListScheduledTasks(ctx, &store.FindScheduledTask{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list scheduled tasks: %v", err)
+	}
+	stateByName := map[string]*store.ScheduledTask{}
+	for _, state := range states {
+		stateByName[state.Name] = state
+	}
+
+	response := &v1pb.ListScheduledTasksResponse{
+		Tasks: []*v1pb.ScheduledTask{},
+	}
+	for _, task := range s.Scheduler.Tasks() {
+		response.Tasks = append(response.Tasks, s.convertScheduledTaskFromStore(task, stateByName[task.Name]))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) UpdateScheduledTask(ctx context.Context, request *v1pb.UpdateScheduledTaskRequest) (*v1pb.ScheduledTask, error) {
+	if request.Task == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "task is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	task, state, err := s.getScheduledTaskByName(ctx, request.Task.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	upsert := &store.ScheduledTask{
+		Name:      task.Name,
+		UpdatedTs: time.Now().Unix(),
+	}
+	if state != nil {
+		upsert.Schedule, upsert.Disabled = state.Schedule, state.Disabled
+	}
+	for _, field := range request.UpdateMask.Paths {
+		switch field {
+		case "schedule":
+			if request.Task.Schedule != "" {
+				if _, err := scheduler.ParseSchedule(request.Task.Schedule); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid schedule: %v", err)
+				}
+			}
+			upsert.Schedule = request.Task.Schedule
+		case "disabled":
+			upsert.Disabled = request.Task.Disabled
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", field)
+		}
+	}
+	state, err = s.Store.UpsertScheduledTask(ctx, upsert)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update scheduled task: %v", err)
+	}
+	return s.convertScheduledTaskFromStore(task, state), nil
+}
+
+func (s *APIV1Service) RunScheduledTask(ctx context.Context, request *v1pb.RunScheduledTaskRequest) (*v1pb.ScheduledTask, error) {
+	task, _, err := s.getScheduledTaskByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Scheduler.RunNow(ctx, task.Name); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to run scheduled task: %v", err)
+	}
+	state, err := s.Store.GetScheduledTask(ctx, &store.FindScheduledTask{Name: &task.Name})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get scheduled task: %v", err)
+	}
+	return s.convertScheduledTaskFromStore(task, state), nil
+}
+
+// checkManageScheduledTasks checks that the current user manages the workspace, whose scheduler is running.
+func (s *APIV1Service) checkManageScheduledTasks(ctx context.Context) error {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if err := s.checkPermission(ctx, user, storepb.Permission_MANAGE_WORKSPACE); err != nil {
+		return err
+	}
+	if s.Scheduler == nil {
+		return status.Errorf(codes.Unimplemented, "scheduler is not enabled")
+	}
+	return nil
+}
+
+// getScheduledTaskByName returns the task of the name and its state in the store, nil if it was never configured nor run.
+func (s *APIV1Service) getScheduledTaskByName(ctx context.Context, name string) (*scheduler.Task, *store.ScheduledTask, error) {
+	if err := s.checkManageScheduledTasks(ctx); err != nil {
+		return nil, nil, err
+	}
+	taskName, err := ExtractScheduledTaskNameFromName(name)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	task := s.Scheduler.GetTask(taskName)
+	if task == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "scheduled task not found")
+	}
+	state, err := s.Store.GetScheduledTask(ctx, &store.FindScheduledTask{Name: &taskName})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get scheduled task: %v", err)
+	}
+	return task, state, nil
+}
+
+func (s *APIV1Service) convertScheduledTaskFromStore(task *scheduler.Task, state *store.ScheduledTask) *v1pb.ScheduledTask {
+	taskMessage := &v1pb.ScheduledTask{
+		Name:            ScheduledTaskNamePrefix + task.Name,
+		Description:     task.Description,
+		Schedule:        task.DefaultSchedule,
+		DefaultSchedule: task.DefaultSchedule,
+	}
+	// The schedules are validated when they are set, so the error is only about an invalid default schedule.
+	if nextRunTime, err := s.Scheduler.NextRunTime(task, state); err == nil && !nextRunTime.IsZero() {
+		taskMessage.NextRunTime = timestamppb.New(nextRunTime)
+	}
+	if state == nil {
+		return taskMessage
+	}
+	if state.Schedule != "" {
+		taskMessage.Schedule = state.Schedule
+	}
+	taskMessage.Disabled = state.Disabled
+	taskMessage.LastError = state.LastError
+	switch state.LastStatus {
+	case store.ScheduledTaskPending:
+		taskMessage.LastRunState = v1pb.ScheduledTask_PENDING
+	case store.ScheduledTaskRunning:
+		taskMessage.LastRunState = v1pb.ScheduledTask_RUNNING
+	case store.ScheduledTaskSucceeded:
+		taskMessage.LastRunState = v1pb.ScheduledTask_SUCCEEDED
+	case store.ScheduledTaskFailed:
+		taskMessage.LastRunState = v1pb.ScheduledTask_FAILED
+	}
+	if state.LastRunTs != 0 {
+		taskMessage.LastRunTime = timestamppb.New(time.Unix(state.LastRunTs, 0))
+	}
+	if state.LastFinishedTs != 0 {
+		taskMessage.LastFinishTime = timestamppb.New(time.Unix(state.LastFinishedTs, 0))
+	}
+	return taskMessage
+}
@@ -3,11 +3,15 @@ package v1
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"strings"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/usememos/memos/internal/doctor"
+	"github.com/usememos/memos/internal/profile"
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
@@ -27,6 +31,11 @@ func (s *APIV1Service) GetWorkspaceProfile(ctx context.Context, _ *v1pb.GetWorks
 	if owner != nil {
 		workspaceProfile.Owner = owner.Name
 	}
+	workspaceBasicSetting, err := s.Store.GetWorkspaceBasicSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace basic setting: %v", err)
+	}
+	workspaceProfile.WebPushPublicKey = workspaceBasicSetting.VapidPublicKey
 	return workspaceProfile, nil
 }
 
@@ -47,6 +56,30 @@ func (s *APIV1Service) GetWorkspaceSetting(ctx context.Context, request *v1pb.Ge
 		_, err = s.Store.GetWorkspaceMemoRelatedSetting(ctx)
 	case storepb.WorkspaceSettingKey_STORAGE:
 		_, err = s.Store.GetWorkspaceStorageSetting(ctx)
+	case storepb.WorkspaceSettingKey_EMBEDDING:
+		_, err = s.Store.GetWorkspaceEmbeddingSetting(ctx)
+	case storepb.WorkspaceSettingKey_OCR:
+		_, err = s.Store.GetWorkspaceOCRSetting(ctx)
+	case storepb.WorkspaceSettingKey_MALWARE_SCAN:
+		_, err = s.Store.GetWorkspaceMalwareScanSetting(ctx)
+	case storepb.WorkspaceSettingKey_TRANSCRIPTION:
+		_, err = s.Store.GetWorkspaceTranscriptionSetting(ctx)
+	case storepb.WorkspaceSettingKey_SCIM:
+		_, err = s.Store.GetWorkspaceSCIMSetting(ctx)
+	case storepb.WorkspaceSettingKey_PASSWORD_POLICY:
+		_, err = s.Store.GetWorkspacePasswordPolicySetting(ctx)
+	case storepb.WorkspaceSettingKey_EMAIL:
+		_, err = s.Store.GetWorkspaceEmailSetting(ctx)
+	case storepb.WorkspaceSettingKey_ROLES:
+		_, err = s.Store.GetWorkspaceRolesSetting(ctx)
+	case storepb.WorkspaceSettingKey_ACCESS_TOKEN_POLICY:
+		_, err = s.Store.GetWorkspaceAccessTokenPolicySetting(ctx)
+	case storepb.WorkspaceSettingKey_CAPTCHA:
+		_, err = s.Store.GetWorkspaceCaptchaSetting(ctx)
+	case storepb.WorkspaceSettingKey_SLACK:
+		_, err = s.Store.GetWorkspaceSlackSetting(ctx)
+	case storepb.WorkspaceSettingKey_DISCORD:
+		_, err = s.Store.GetWorkspaceDiscordSetting(ctx)
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, "unsupported workspace setting key: %v", workspaceSettingKey)
 	}
@@ -64,8 +97,7 @@ func (s *APIV1Service) GetWorkspaceSetting(ctx context.Context, request *v1pb.Ge
 		return nil, status.Errorf(codes.NotFound, "workspace setting not found")
 	}
 
-	// For storage setting, only host can get it.
-	if workspaceSetting.Key == storepb.WorkspaceSettingKey_STORAGE {
+	if isHostOnlyWorkspaceSetting(workspaceSetting.Key) {
 		user, err := s.GetCurrentUser(ctx)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
@@ -75,7 +107,18 @@ func (s *APIV1Service) GetWorkspaceSetting(ctx context.Context, request *v1pb.Ge
 		}
 	}
 
-	return convertWorkspaceSettingFromStore(workspaceSetting), nil
+	setting := convertWorkspaceSettingFromStore(workspaceSetting)
+	// The CAPTCHA setting is public for the sign-in and sign-up pages, except for its secret key.
+	if workspaceSetting.Key == storepb.WorkspaceSettingKey_CAPTCHA {
+		user, err := s.GetCurrentUser(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+		}
+		if user == nil || user.Role != store.RoleHost {
+			setting.GetCaptchaSetting().SecretKey = ""
+		}
+	}
+	return setting, nil
 }
 
 func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb.UpdateWorkspaceSettingRequest) (*v1pb.WorkspaceSetting, error) {
@@ -83,14 +126,50 @@ func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	if user.Role != store.RoleHost {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	if err := s.checkPermission(ctx, user, storepb.Permission_MANAGE_WORKSPACE); err != nil {
+		return nil, err
 	}
 
 	// TODO: Apply update_mask if specified
 	_ = request.UpdateMask
 
 	updateSetting := convertWorkspaceSettingToStore(request.Setting)
+	if updateSetting.Key == storepb.WorkspaceSettingKey_GENERAL && updateSetting.GetGeneralSetting().GetSsoOnly() {
+		if err := s.checkIdentityProviderConfigured(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if updateSetting.Key == storepb.WorkspaceSettingKey_GENERAL && updateSetting.GetGeneralSetting().GetInboxRetentionDays() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "inbox retention days cannot be negative")
+	}
+	if isHostOnlyWorkspaceSetting(updateSetting.Key) && user.Role != store.RoleHost {
+		return nil, status.Errorf(codes.PermissionDenied, "only the host can update the %s setting", updateSetting.Key)
+	}
+	if updateSetting.Key == storepb.WorkspaceSettingKey_ROLES {
+		// The roles grant the permissions, so only the host, who has them all, can define them.
+		if user.Role != store.RoleHost {
+			return nil, status.Errorf(codes.PermissionDenied, "only the host can update the roles")
+		}
+		if err := validateWorkspaceRolesSetting(updateSetting.GetRolesSetting()); err != nil {
+			return nil, err
+		}
+	}
+	if updateSetting.Key == storepb.WorkspaceSettingKey_ACCESS_TOKEN_POLICY {
+		policy := updateSetting.GetAccessTokenPolicySetting()
+		if policy.GetMaxLifetimeDays() < 0 || policy.GetIdleRevocationDays() < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "access token policy days must not be negative")
+		}
+	}
+	if updateSetting.Key == storepb.WorkspaceSettingKey_CAPTCHA {
+		if err := validateWorkspaceCaptchaSetting(updateSetting.GetCaptchaSetting()); err != nil {
+			return nil, err
+		}
+	}
+	if updateSetting.Key == storepb.WorkspaceSettingKey_DISCORD {
+		if err := validateWorkspaceDiscordSetting(updateSetting.GetDiscordSetting()); err != nil {
+			return nil, err
+		}
+	}
 	workspaceSetting, err := s.Store.UpsertWorkspaceSetting(ctx, updateSetting)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to upsert workspace setting: %v", err)
@@ -99,6 +178,20 @@ func (s *APIV1Service) UpdateWorkspaceSetting(ctx context.Context, request *v1pb
 	return convertWorkspaceSettingFromStore(workspaceSetting), nil
 }
 
+// isHostOnlyWorkspaceSetting returns whether only the host can get and update the setting, as it holds credentials,
+// e.g. the keys of the storage, embedding, OCR, malware scan and transcription services, the SCIM token,
+// the SMTP password and the Slack and Discord secrets.
+func isHostOnlyWorkspaceSetting(key storepb.WorkspaceSettingKey) bool {
+	switch key {
+	case storepb.WorkspaceSettingKey_STORAGE, storepb.WorkspaceSettingKey_EMBEDDING, storepb.WorkspaceSettingKey_OCR,
+		storepb.WorkspaceSettingKey_MALWARE_SCAN, storepb.WorkspaceSettingKey_TRANSCRIPTION, storepb.WorkspaceSettingKey_SCIM,
+		storepb.WorkspaceSettingKey_EMAIL, storepb.WorkspaceSettingKey_SLACK, storepb.WorkspaceSettingKey_DISCORD:
+		return true
+	default:
+		return false
+	}
+}
+
 func convertWorkspaceSettingFromStore(setting *storepb.WorkspaceSetting) *v1pb.WorkspaceSetting {
 	workspaceSetting := &v1pb.WorkspaceSetting{
 		Name: fmt.Sprintf("workspace/settings/%s", setting.Key.String()),
@@ -116,6 +209,54 @@ func convertWorkspaceSettingFromStore(setting *storepb.WorkspaceSetting) *v1pb.W
 		workspaceSetting.Value = &v1pb.WorkspaceSetting_MemoRelatedSetting{
 			MemoRelatedSetting: convertWorkspaceMemoRelatedSettingFromStore(setting.GetMemoRelatedSetting()),
 		}
+	case *storepb.WorkspaceSetting_EmbeddingSetting:
+		workspaceSetting.Value = &v1pb.WorkspaceSetting_EmbeddingSetting{
+			EmbeddingSetting: convertWorkspaceEmbeddingSettingFromStore(setting.GetEmbeddingSetting()),
+		}
+	case *storepb.WorkspaceSetting_OcrSetting:
+		workspaceSetting.Value = &v1pb.WorkspaceSetting_OcrSetting{
+			OcrSetting: convertWorkspaceOCRSettingFromStore(setting.GetOcrSetting()),
+		}
+	case *storepb.WorkspaceSetting_MalwareScanSetting:
+		workspaceSetting.Value = &v1pb.WorkspaceSetting_MalwareScanSetting{
+			MalwareScanSetting: convertWorkspaceMalwareScanSettingFromStore(setting.GetMalwareScanSetting()),
+		}
+	case *storepb.WorkspaceSetting_TranscriptionSetting:
+		workspaceSetting.Value = &v1pb.WorkspaceSetting_TranscriptionSetting{
+			TranscriptionSetting: convertWorkspaceTranscriptionSettingFromStore(setting.GetTranscriptionSetting()),
+		}
+	case *storepb.WorkspaceSetting_ScimSetting:
+		workspaceSetting.Value = &v1pb.WorkspaceSetting_ScimSetting{
+			ScimSetting: convertWorkspaceSCIMSettingFromStore(setting.GetScimSetting()),
+		}
+	case *storepb.WorkspaceSetting_PasswordPolicySetting:
+		workspaceSetting.Value = &v1pb.WorkspaceSetting_PasswordPolicySetting{
+			PasswordPolicySetting: convertWorkspacePasswordPolicySettingFromStore(setting.GetPasswordPolicySetting()),
+		}
+	case *storepb.WorkspaceSetting_EmailSetting:
+		workspaceSetting.Value = &v1pb.WorkspaceSetting_EmailSetting{
+			EmailSetting: convertWorkspaceEmailSettingFromStore(setting.GetEmailSetting()),
+		}
+	case *storepb.WorkspaceSetting_RolesSetting:
+		workspaceSetting.Value = &v1pb.WorkspaceSetting_RolesSetting{
+			RolesSetting: convertWorkspaceRolesSettingFromStore(setting.GetRolesSetting()),
+		}
+	case *storepb.WorkspaceSetting_AccessTokenPolicySetting:
+		workspaceSetting.Value = &v1pb.WorkspaceSetting_AccessTokenPolicySetting{
+			AccessTokenPolicySetting: convertWorkspaceAccessTokenPolicySettingFromStore(setting.GetAccessTokenPolicySetting()),
+		}
+	case *storepb.WorkspaceSetting_CaptchaSetting:
+		workspaceSetting.Value = &v1pb.WorkspaceSetting_CaptchaSetting{
+			CaptchaSetting: convertWorkspaceCaptchaSettingFromStore(setting.GetCaptchaSetting()),
+		}
+	case *storepb.WorkspaceSetting_SlackSetting:
+		workspaceSetting.Value = &v1pb.WorkspaceSetting_SlackSetting{
+			SlackSetting: convertWorkspaceSlackSettingFromStore(setting.GetSlackSetting()),
+		}
+	case *storepb.WorkspaceSetting_DiscordSetting:
+		workspaceSetting.Value = &v1pb.WorkspaceSetting_DiscordSetting{
+			DiscordSetting: convertWorkspaceDiscordSettingFromStore(setting.GetDiscordSetting()),
+		}
 	}
 	return workspaceSetting
 }
@@ -141,6 +282,54 @@ func convertWorkspaceSettingToStore(setting *v1pb.WorkspaceSetting) *storepb.Wor
 		workspaceSetting.Value = &storepb.WorkspaceSetting_MemoRelatedSetting{
 			MemoRelatedSetting: convertWorkspaceMemoRelatedSettingToStore(setting.GetMemoRelatedSetting()),
 		}
+	case storepb.WorkspaceSettingKey_EMBEDDING:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_EmbeddingSetting{
+			EmbeddingSetting: convertWorkspaceEmbeddingSettingToStore(setting.GetEmbeddingSetting()),
+		}
+	case storepb.WorkspaceSettingKey_OCR:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_OcrSetting{
+			OcrSetting: convertWorkspaceOCRSettingToStore(setting.GetOcrSetting()),
+		}
+	case storepb.WorkspaceSettingKey_MALWARE_SCAN:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_MalwareScanSetting{
+			MalwareScanSetting: convertWorkspaceMalwareScanSettingToStore(setting.GetMalwareScanSetting()),
+		}
+	case storepb.WorkspaceSettingKey_TRANSCRIPTION:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_TranscriptionSetting{
+			TranscriptionSetting: convertWorkspaceTranscriptionSettingToStore(setting.GetTranscriptionSetting()),
+		}
+	case storepb.WorkspaceSettingKey_SCIM:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_ScimSetting{
+			ScimSetting: convertWorkspaceSCIMSettingToStore(setting.GetScimSetting()),
+		}
+	case storepb.WorkspaceSettingKey_PASSWORD_POLICY:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_PasswordPolicySetting{
+			PasswordPolicySetting: convertWorkspacePasswordPolicySettingToStore(setting.GetPasswordPolicySetting()),
+		}
+	case storepb.WorkspaceSettingKey_EMAIL:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_EmailSetting{
+			EmailSetting: convertWorkspaceEmailSettingToStore(setting.GetEmailSetting()),
+		}
+	case storepb.WorkspaceSettingKey_ROLES:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_RolesSetting{
+			RolesSetting: convertWorkspaceRolesSettingToStore(setting.GetRolesSetting()),
+		}
+	case storepb.WorkspaceSettingKey_ACCESS_TOKEN_POLICY:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_AccessTokenPolicySetting{
+			AccessTokenPolicySetting: convertWorkspaceAccessTokenPolicySettingToStore(setting.GetAccessTokenPolicySetting()),
+		}
+	case storepb.WorkspaceSettingKey_CAPTCHA:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_CaptchaSetting{
+			CaptchaSetting: convertWorkspaceCaptchaSettingToStore(setting.GetCaptchaSetting()),
+		}
+	case storepb.WorkspaceSettingKey_SLACK:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_SlackSetting{
+			SlackSetting: convertWorkspaceSlackSettingToStore(setting.GetSlackSetting()),
+		}
+	case storepb.WorkspaceSettingKey_DISCORD:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_DiscordSetting{
+			DiscordSetting: convertWorkspaceDiscordSettingToStore(setting.GetDiscordSetting()),
+		}
 	}
 	return workspaceSetting
 }
@@ -164,6 +353,9 @@ func convertWorkspaceGeneralSettingFromStore(setting *storepb.WorkspaceGeneralSe
 		WeekStartDayOffset:       setting.WeekStartDayOffset,
 		DisallowChangeUsername:   setting.DisallowChangeUsername,
 		DisallowChangeNickname:   setting.DisallowChangeNickname,
+		RequireAdminTwoFactor:    setting.RequireAdminTwoFactor,
+		SsoOnly:                  setting.SsoOnly,
+		InboxRetentionDays:       setting.InboxRetentionDays,
 	}
 	if setting.CustomProfile != nil {
 		generalSetting.CustomProfile = &v1pb.WorkspaceCustomProfile{
@@ -190,6 +382,9 @@ func convertWorkspaceGeneralSettingToStore(setting *v1pb.WorkspaceGeneralSetting
 		WeekStartDayOffset:       setting.WeekStartDayOffset,
 		DisallowChangeUsername:   setting.DisallowChangeUsername,
 		DisallowChangeNickname:   setting.DisallowChangeNickname,
+		RequireAdminTwoFactor:    setting.RequireAdminTwoFactor,
+		SsoOnly:                  setting.SsoOnly,
+		InboxRetentionDays:       setting.InboxRetentionDays,
 	}
 	if setting.CustomProfile != nil {
 		generalSetting.CustomProfile = &storepb.WorkspaceCustomProfile{
@@ -208,9 +403,11 @@ func convertWorkspaceStorageSettingFromStore(settingpb *storepb.WorkspaceStorage
 		return nil
 	}
 	setting := &v1pb.WorkspaceStorageSetting{
-		StorageType:       v1pb.WorkspaceStorageSetting_StorageType(settingpb.StorageType),
-		FilepathTemplate:  settingpb.FilepathTemplate,
-		UploadSizeLimitMb: settingpb.UploadSizeLimitMb,
+		StorageType:          v1pb.WorkspaceStorageSetting_StorageType(settingpb.StorageType),
+		FilepathTemplate:     settingpb.FilepathTemplate,
+		UploadSizeLimitMb:    settingpb.UploadSizeLimitMb,
+		StripImageMetadata:   settingpb.StripImageMetadata,
+		KeepImageOrientation: settingpb.KeepImageOrientation,
 	}
 	if settingpb.S3Config != nil {
 		setting.S3Config = &v1pb.WorkspaceStorageSetting_S3Config{
@@ -222,6 +419,30 @@ func convertWorkspaceStorageSettingFromStore(settingpb *storepb.WorkspaceStorage
 			UsePathStyle:    settingpb.S3Config.UsePathStyle,
 		}
 	}
+	if settingpb.GcsConfig != nil {
+		setting.GcsConfig = &v1pb.WorkspaceStorageSetting_GCSConfig{
+			Credentials: settingpb.GcsConfig.Credentials,
+			Bucket:      settingpb.GcsConfig.Bucket,
+			Endpoint:    settingpb.GcsConfig.Endpoint,
+		}
+	}
+	if settingpb.ImageCompression != nil {
+		setting.ImageCompression = &v1pb.WorkspaceStorageSetting_ImageCompression{
+			Enabled:      settingpb.ImageCompression.Enabled,
+			MaxDimension: settingpb.ImageCompression.MaxDimension,
+			Quality:      settingpb.ImageCompression.Quality,
+			Format:       v1pb.WorkspaceStorageSetting_ImageCompression_Format(settingpb.ImageCompression.Format),
+		}
+	}
+	if settingpb.SftpConfig != nil {
+		setting.SftpConfig = &v1pb.WorkspaceStorageSetting_SFTPConfig{
+			Host:       settingpb.SftpConfig.Host,
+			Username:   settingpb.SftpConfig.Username,
+			Password:   settingpb.SftpConfig.Password,
+			PrivateKey: settingpb.SftpConfig.PrivateKey,
+			HostKey:    settingpb.SftpConfig.HostKey,
+		}
+	}
 	return setting
 }
 
@@ -230,9 +451,11 @@ func convertWorkspaceStorageSettingToStore(setting *v1pb.WorkspaceStorageSetting
 		return nil
 	}
 	settingpb := &storepb.WorkspaceStorageSetting{
-		StorageType:       storepb.WorkspaceStorageSetting_StorageType(setting.StorageType),
-		FilepathTemplate:  setting.FilepathTemplate,
-		UploadSizeLimitMb: setting.UploadSizeLimitMb,
+		StorageType:          storepb.WorkspaceStorageSetting_StorageType(setting.StorageType),
+		FilepathTemplate:     setting.FilepathTemplate,
+		UploadSizeLimitMb:    setting.UploadSizeLimitMb,
+		StripImageMetadata:   setting.StripImageMetadata,
+		KeepImageOrientation: setting.KeepImageOrientation,
 	}
 	if setting.S3Config != nil {
 		settingpb.S3Config = &storepb.StorageS3Config{
@@ -244,6 +467,30 @@ func convertWorkspaceStorageSettingToStore(setting *v1pb.WorkspaceStorageSetting
 			UsePathStyle:    setting.S3Config.UsePathStyle,
 		}
 	}
+	if setting.GcsConfig != nil {
+		settingpb.GcsConfig = &storepb.StorageGCSConfig{
+			Credentials: setting.GcsConfig.Credentials,
+			Bucket:      setting.GcsConfig.Bucket,
+			Endpoint:    setting.GcsConfig.Endpoint,
+		}
+	}
+	if setting.ImageCompression != nil {
+		settingpb.ImageCompression = &storepb.WorkspaceStorageSetting_ImageCompression{
+			Enabled:      setting.ImageCompression.Enabled,
+			MaxDimension: setting.ImageCompression.MaxDimension,
+			Quality:      setting.ImageCompression.Quality,
+			Format:       storepb.WorkspaceStorageSetting_ImageCompression_Format(setting.ImageCompression.Format),
+		}
+	}
+	if setting.SftpConfig != nil {
+		settingpb.SftpConfig = &storepb.StorageSFTPConfig{
+			Host:       setting.SftpConfig.Host,
+			Username:   setting.SftpConfig.Username,
+			Password:   setting.SftpConfig.Password,
+			PrivateKey: setting.SftpConfig.PrivateKey,
+			HostKey:    setting.SftpConfig.HostKey,
+		}
+	}
 	return settingpb
 }
 
@@ -283,8 +530,428 @@ func convertWorkspaceMemoRelatedSettingToStore(setting *v1pb.WorkspaceMemoRelate
 	}
 }
 
+func convertWorkspaceEmbeddingSettingFromStore(setting *storepb.WorkspaceEmbeddingSetting) *v1pb.WorkspaceEmbeddingSetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.WorkspaceEmbeddingSetting{
+		Enabled:  setting.Enabled,
+		Provider: v1pb.WorkspaceEmbeddingSetting_Provider(setting.Provider),
+		Endpoint: setting.Endpoint,
+		ApiKey:   setting.ApiKey,
+		Model:    setting.Model,
+	}
+}
+
+func convertWorkspaceEmbeddingSettingToStore(setting *v1pb.WorkspaceEmbeddingSetting) *storepb.WorkspaceEmbeddingSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.WorkspaceEmbeddingSetting{
+		Enabled:  setting.Enabled,
+		Provider: storepb.WorkspaceEmbeddingSetting_Provider(setting.Provider),
+		Endpoint: setting.Endpoint,
+		ApiKey:   setting.ApiKey,
+		Model:    setting.Model,
+	}
+}
+
+func convertWorkspaceOCRSettingFromStore(setting *storepb.WorkspaceOCRSetting) *v1pb.WorkspaceOCRSetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.WorkspaceOCRSetting{
+		Enabled:       setting.Enabled,
+		Provider:      v1pb.WorkspaceOCRSetting_Provider(setting.Provider),
+		TesseractPath: setting.TesseractPath,
+		Languages:     setting.Languages,
+		Endpoint:      setting.Endpoint,
+		ApiKey:        setting.ApiKey,
+	}
+}
+
+func convertWorkspaceOCRSettingToStore(setting *v1pb.WorkspaceOCRSetting) *storepb.WorkspaceOCRSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.WorkspaceOCRSetting{
+		Enabled:       setting.Enabled,
+		Provider:      storepb.WorkspaceOCRSetting_Provider(setting.Provider),
+		TesseractPath: setting.TesseractPath,
+		Languages:     setting.Languages,
+		Endpoint:      setting.Endpoint,
+		ApiKey:        setting.ApiKey,
+	}
+}
+
+func convertWorkspaceMalwareScanSettingFromStore(setting *storepb.WorkspaceMalwareScanSetting) *v1pb.WorkspaceMalwareScanSetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.WorkspaceMalwareScanSetting{
+		Enabled:       setting.Enabled,
+		Scanner:       v1pb.WorkspaceMalwareScanSetting_Scanner(setting.Scanner),
+		ClamavAddress: setting.ClamavAddress,
+		Command:       setting.Command,
+		Endpoint:      setting.Endpoint,
+		ApiKey:        setting.ApiKey,
+		Action:        v1pb.WorkspaceMalwareScanSetting_Action(setting.Action),
+		FailOpen:      setting.FailOpen,
+	}
+}
+
+func convertWorkspaceMalwareScanSettingToStore(setting *v1pb.WorkspaceMalwareScanSetting) *storepb.WorkspaceMalwareScanSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.WorkspaceMalwareScanSetting{
+		Enabled:       setting.Enabled,
+		Scanner:       storepb.WorkspaceMalwareScanSetting_Scanner(setting.Scanner),
+		ClamavAddress: setting.ClamavAddress,
+		Command:       setting.Command,
+		Endpoint:      setting.Endpoint,
+		ApiKey:        setting.ApiKey,
+		Action:        storepb.WorkspaceMalwareScanSetting_Action(setting.Action),
+		FailOpen:      setting.FailOpen,
+	}
+}
+
+func convertWorkspaceTranscriptionSettingFromStore(setting *storepb.WorkspaceTranscriptionSetting) *v1pb.WorkspaceTranscriptionSetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.WorkspaceTranscriptionSetting{
+		Enabled:          setting.Enabled,
+		Provider:         v1pb.WorkspaceTranscriptionSetting_Provider(setting.Provider),
+		WhisperPath:      setting.WhisperPath,
+		WhisperModelPath: setting.WhisperModelPath,
+		Language:         setting.Language,
+		Endpoint:         setting.Endpoint,
+		ApiKey:           setting.ApiKey,
+		Model:            setting.Model,
+	}
+}
+
+func convertWorkspaceTranscriptionSettingToStore(setting *v1pb.WorkspaceTranscriptionSetting) *storepb.WorkspaceTranscriptionSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.WorkspaceTranscriptionSetting{
+		Enabled:          setting.Enabled,
+		Provider:         storepb.WorkspaceTranscriptionSetting_Provider(setting.Provider),
+		WhisperPath:      setting.WhisperPath,
+		WhisperModelPath: setting.WhisperModelPath,
+		Language:         setting.Language,
+		Endpoint:         setting.Endpoint,
+		ApiKey:           setting.ApiKey,
+		Model:            setting.Model,
+	}
+}
+
+func convertWorkspaceSCIMSettingFromStore(setting *storepb.WorkspaceSCIMSetting) *v1pb.WorkspaceSCIMSetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.WorkspaceSCIMSetting{
+		Enabled: setting.Enabled,
+		Token:   setting.Token,
+	}
+}
+
+func convertWorkspaceSCIMSettingToStore(setting *v1pb.WorkspaceSCIMSetting) *storepb.WorkspaceSCIMSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.WorkspaceSCIMSetting{
+		Enabled: setting.Enabled,
+		Token:   setting.Token,
+	}
+}
+
+func convertWorkspacePasswordPolicySettingFromStore(setting *storepb.WorkspacePasswordPolicySetting) *v1pb.WorkspacePasswordPolicySetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.WorkspacePasswordPolicySetting{
+		MinLength:               setting.MinLength,
+		CheckBreached:           setting.CheckBreached,
+		BreachCheckEndpoint:     setting.BreachCheckEndpoint,
+		RequireChangeAfterReset: setting.RequireChangeAfterReset,
+	}
+}
+
+func convertWorkspacePasswordPolicySettingToStore(setting *v1pb.WorkspacePasswordPolicySetting) *storepb.WorkspacePasswordPolicySetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.WorkspacePasswordPolicySetting{
+		MinLength:               setting.MinLength,
+		CheckBreached:           setting.CheckBreached,
+		BreachCheckEndpoint:     setting.BreachCheckEndpoint,
+		RequireChangeAfterReset: setting.RequireChangeAfterReset,
+	}
+}
+
+func convertWorkspaceAccessTokenPolicySettingFromStore(setting *storepb.WorkspaceAccessTokenPolicySetting) *v1pb.WorkspaceAccessTokenPolicySetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.WorkspaceAccessTokenPolicySetting{
+		MaxLifetimeDays:    setting.MaxLifetimeDays,
+		IdleRevocationDays: setting.IdleRevocationDays,
+	}
+}
+
+func convertWorkspaceAccessTokenPolicySettingToStore(setting *v1pb.WorkspaceAccessTokenPolicySetting) *storepb.WorkspaceAccessTokenPolicySetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.WorkspaceAccessTokenPolicySetting{
+		MaxLifetimeDays:    setting.MaxLifetimeDays,
+		IdleRevocationDays: setting.IdleRevocationDays,
+	}
+}
+
+func convertWorkspaceCaptchaSettingFromStore(setting *storepb.WorkspaceCaptchaSetting) *v1pb.WorkspaceCaptchaSetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.WorkspaceCaptchaSetting{
+		Provider:               v1pb.WorkspaceCaptchaSetting_Provider(setting.Provider),
+		SiteKey:                setting.SiteKey,
+		SecretKey:              setting.SecretKey,
+		RequireOnSignUp:        setting.RequireOnSignUp,
+		SignInFailureThreshold: setting.SignInFailureThreshold,
+		ProofOfWorkDifficulty:  setting.ProofOfWorkDifficulty,
+		VerifyEndpoint:         setting.VerifyEndpoint,
+	}
+}
+
+func convertWorkspaceCaptchaSettingToStore(setting *v1pb.WorkspaceCaptchaSetting) *storepb.WorkspaceCaptchaSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.WorkspaceCaptchaSetting{
+		Provider:               storepb.WorkspaceCaptchaSetting_Provider(setting.Provider),
+		SiteKey:                setting.SiteKey,
+		SecretKey:              setting.SecretKey,
+		RequireOnSignUp:        setting.RequireOnSignUp,
+		SignInFailureThreshold: setting.SignInFailureThreshold,
+		ProofOfWorkDifficulty:  setting.ProofOfWorkDifficulty,
+		VerifyEndpoint:         setting.VerifyEndpoint,
+	}
+}
+
+func convertWorkspaceSlackSettingFromStore(setting *storepb.WorkspaceSlackSetting) *v1pb.WorkspaceSlackSetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.WorkspaceSlackSetting{
+		SigningSecret: setting.SigningSecret,
+		BotToken:      setting.BotToken,
+		ApiEndpoint:   setting.ApiEndpoint,
+	}
+}
+
+func convertWorkspaceSlackSettingToStore(setting *v1pb.WorkspaceSlackSetting) *storepb.WorkspaceSlackSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.WorkspaceSlackSetting{
+		SigningSecret: setting.SigningSecret,
+		BotToken:      setting.BotToken,
+		ApiEndpoint:   setting.ApiEndpoint,
+	}
+}
+
+func convertWorkspaceDiscordSettingFromStore(setting *storepb.WorkspaceDiscordSetting) *v1pb.WorkspaceDiscordSetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.WorkspaceDiscordSetting{
+		ApplicationId:          setting.ApplicationId,
+		PublicKey:              setting.PublicKey,
+		BotToken:               setting.BotToken,
+		SaveReaction:           setting.SaveReaction,
+		DigestChannelId:        setting.DigestChannelId,
+		DigestSchedule:         setting.DigestSchedule,
+		DigestIncludeProtected: setting.DigestIncludeProtected,
+		ApiEndpoint:            setting.ApiEndpoint,
+	}
+}
+
+func convertWorkspaceDiscordSettingToStore(setting *v1pb.WorkspaceDiscordSetting) *storepb.WorkspaceDiscordSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.WorkspaceDiscordSetting{
+		ApplicationId:          setting.ApplicationId,
+		PublicKey:              setting.PublicKey,
+		BotToken:               setting.BotToken,
+		SaveReaction:           setting.SaveReaction,
+		DigestChannelId:        setting.DigestChannelId,
+		DigestSchedule:         setting.DigestSchedule,
+		DigestIncludeProtected: setting.DigestIncludeProtected,
+		ApiEndpoint:            setting.ApiEndpoint,
+	}
+}
+
+func convertWorkspaceEmailSettingFromStore(setting *storepb.WorkspaceEmailSetting) *v1pb.WorkspaceEmailSetting {
+	if setting == nil {
+		return nil
+	}
+	return &v1pb.WorkspaceEmailSetting{
+		SmtpHost:                 setting.SmtpHost,
+		SmtpPort:                 setting.SmtpPort,
+		SmtpUsername:             setting.SmtpUsername,
+		SmtpPassword:             setting.SmtpPassword,
+		UseTls:                   setting.UseTls,
+		FromEmail:                setting.FromEmail,
+		FromName:                 setting.FromName,
+		RequireEmailVerification: setting.RequireEmailVerification,
+		AllowPasswordReset:       setting.AllowPasswordReset,
+		ImapHost:                 setting.ImapHost,
+		ImapPort:                 setting.ImapPort,
+		ImapUsername:             setting.ImapUsername,
+		ImapPassword:             setting.ImapPassword,
+		ImapUseTls:               setting.ImapUseTls,
+		ImapFolder:               setting.ImapFolder,
+		ImapArchiveFolder:        setting.ImapArchiveFolder,
+	}
+}
+
+func convertWorkspaceEmailSettingToStore(setting *v1pb.WorkspaceEmailSetting) *storepb.WorkspaceEmailSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.WorkspaceEmailSetting{
+		SmtpHost:                 setting.SmtpHost,
+		SmtpPort:                 setting.SmtpPort,
+		SmtpUsername:             setting.SmtpUsername,
+		SmtpPassword:             setting.SmtpPassword,
+		UseTls:                   setting.UseTls,
+		FromEmail:                setting.FromEmail,
+		FromName:                 setting.FromName,
+		RequireEmailVerification: setting.RequireEmailVerification,
+		AllowPasswordReset:       setting.AllowPasswordReset,
+		ImapHost:                 setting.ImapHost,
+		ImapPort:                 setting.ImapPort,
+		ImapUsername:             setting.ImapUsername,
+		ImapPassword:             setting.ImapPassword,
+		ImapUseTls:               setting.ImapUseTls,
+		ImapFolder:               setting.ImapFolder,
+		ImapArchiveFolder:        setting.ImapArchiveFolder,
+	}
+}
+
+func convertWorkspaceRolesSettingFromStore(setting *storepb.WorkspaceRolesSetting) *v1pb.WorkspaceRolesSetting {
+	if setting == nil {
+		return nil
+	}
+	rolesSetting := &v1pb.WorkspaceRolesSetting{
+		Roles: []*v1pb.WorkspaceRolesSetting_CustomRole{},
+	}
+	for _, role := range setting.Roles {
+		customRole := &v1pb.WorkspaceRolesSetting_CustomRole{
+			Id:    role.Id,
+			Title: role.Title,
+		}
+		for _, permission := range role.Permissions {
+			customRole.Permissions = append(customRole.Permissions, convertPermissionFromStore(permission))
+		}
+		rolesSetting.Roles = append(rolesSetting.Roles, customRole)
+	}
+	return rolesSetting
+}
+
+func convertWorkspaceRolesSettingToStore(setting *v1pb.WorkspaceRolesSetting) *storepb.WorkspaceRolesSetting {
+	if setting == nil {
+		return nil
+	}
+	rolesSetting := &storepb.WorkspaceRolesSetting{}
+	for _, role := range setting.Roles {
+		customRole := &storepb.WorkspaceCustomRole{
+			Id:    role.Id,
+			Title: role.Title,
+		}
+		for _, permission := range role.Permissions {
+			customRole.Permissions = append(customRole.Permissions, convertPermissionToStore(permission))
+		}
+		rolesSetting.Roles = append(rolesSetting.Roles, customRole)
+	}
+	return rolesSetting
+}
+
 var ownerCache *v1pb.User
 
+// CheckWorkspaceIntegrity verifies the referential integrity of the workspace data.
+func (s *APIV1Service) CheckWorkspaceIntegrity(ctx context.Context, request *v1pb.CheckWorkspaceIntegrityRequest) (*v1pb.WorkspaceIntegrityReport, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if err := s.checkPermission(ctx, user, storepb.Permission_CHECK_INTEGRITY); err != nil {
+		return nil, err
+	}
+
+	report, err := doctor.NewDoctor(s.Profile, s.Store).Check(ctx, request.Repair)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check workspace integrity: %v", err)
+	}
+	response := &v1pb.WorkspaceIntegrityReport{
+		Issues: []*v1pb.WorkspaceIntegrityReport_Issue{},
+	}
+	for _, issue := range report.Issues {
+		response.Issues = append(response.Issues, &v1pb.WorkspaceIntegrityReport_Issue{
+			Type:        v1pb.WorkspaceIntegrityReport_Issue_Type(v1pb.WorkspaceIntegrityReport_Issue_Type_value[string(issue.Type)]),
+			Resource:    issue.Resource,
+			Description: issue.Description,
+			Repaired:    issue.Repaired,
+		})
+	}
+	return response, nil
+}
+
+// ReloadWorkspace reloads the settings of the workspace, e.g. the rate limits of the access tokens, the SMTP server
+// and the credentials of the storage, which are cached by the store, and optionally sets the level of the server logs.
+func (s *APIV1Service) ReloadWorkspace(ctx context.Context, request *v1pb.ReloadWorkspaceRequest) (*v1pb.ReloadWorkspaceResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if err := s.checkPermission(ctx, user, storepb.Permission_MANAGE_WORKSPACE); err != nil {
+		return nil, err
+	}
+	var logLevel *slog.Level
+	if request.LogLevel != "" {
+		level, err := profile.ParseLogLevel(request.LogLevel)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		logLevel = &level
+	}
+
+	s.Store.ReloadSettings(ctx)
+	if logLevel != nil {
+		slog.SetLogLoggerLevel(*logLevel)
+	}
+	currentLogLevel := getLogLevel(ctx)
+	slog.Info("configuration reloaded", slog.String("logLevel", currentLogLevel.String()), slog.String("user", user.Username))
+	return &v1pb.ReloadWorkspaceResponse{
+		LogLevel: strings.ToLower(currentLogLevel.String()),
+	}, nil
+}
+
+// getLogLevel returns the lowest level enabled by the default logger.
+func getLogLevel(ctx context.Context) slog.Level {
+	for _, level := range []slog.Level{slog.LevelDebug, slog.LevelInfo, slog.LevelWarn} {
+		if slog.Default().Enabled(ctx, level) {
+			return level
+		}
+	}
+	return slog.LevelError
+}
+
 func (s *APIV1Service) GetInstanceOwner(ctx context.Context) (*v1pb.User, error) {
 	if ownerCache != nil {
 		return ownerCache, nil
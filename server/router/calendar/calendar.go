@@ -0,0 +1,189 @@
+package calendar
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/usememos/gomark/ast"
+	"github.com/usememos/gomark/parser"
+	"github.com/usememos/gomark/parser/tokenizer"
+	"github.com/usememos/gomark/renderer"
+
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/ical"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+// datePattern matches the dates of the memos and the tasks, e.g. "2025-07-01", optionally followed by a time, e.g. "2025-07-01 14:30".
+var datePattern = regexp.MustCompile(`\b(\d{4}-\d{2}-\d{2})(?:[ T](\d{2}:\d{2}))?\b`)
+
+type CalendarService struct {
+	Profile *profile.Profile
+	Store   *store.Store
+}
+
+func NewCalendarService(profile *profile.Profile, store *store.Store) *CalendarService {
+	return &CalendarService{
+		Profile: profile,
+		Store:   store,
+	}
+}
+
+func (s *CalendarService) RegisterRoutes(g *echo.Group) {
+	g.GET("/u/:username/calendar.ics", s.GetUserCalendar)
+}
+
+// GetUserCalendar returns the calendar feed of the user, listing their memos dated in their first line
+// and their incomplete tasks dated in their text. The feed is published with the token of the user,
+// as it lists the private memos too.
+func (s *CalendarService) GetUserCalendar(c echo.Context) error {
+	ctx := c.Request().Context()
+	username := c.Param("username")
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		Username: &username,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
+	}
+	if user == nil {
+		// The user may have been renamed, redirect old links to the current username.
+		redirectedUser, err := s.Store.GetUserByRedirectedUsername(ctx, username)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
+		}
+		if redirectedUser == nil {
+			return echo.NewHTTPError(http.StatusNotFound, "Calendar not found")
+		}
+		return c.Redirect(http.StatusMovedPermanently, fmt.Sprintf("/u/%s/calendar.ics?token=%s", url.PathEscape(redirectedUser.Username), url.QueryEscape(c.QueryParam("token"))))
+	}
+
+	calendarFeed, err := s.Store.GetUserCalendarFeed(ctx, user.ID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get calendar feed").SetInternal(err)
+	}
+	// The calendars of the disabled feeds, the wrong tokens and the suspended users are not told apart.
+	if calendarFeed.Token == "" || subtle.ConstantTimeCompare([]byte(calendarFeed.Token), []byte(c.QueryParam("token"))) != 1 || user.RowStatus == store.Archived {
+		return echo.NewHTTPError(http.StatusNotFound, "Calendar not found")
+	}
+	suspension, err := s.Store.GetUserSuspension(ctx, user.ID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get user suspension").SetInternal(err)
+	}
+	if suspension.Suspended {
+		return echo.NewHTTPError(http.StatusNotFound, "Calendar not found")
+	}
+
+	normalStatus := store.Normal
+	memoList, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID: &user.ID,
+		RowStatus: &normalStatus,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find memo list").SetInternal(err)
+	}
+
+	baseURL := strings.TrimSuffix(s.Profile.InstanceURL, "/")
+	if baseURL == "" {
+		baseURL = c.Scheme() + "://" + c.Request().Host
+	}
+	name := user.Nickname
+	if name == "" {
+		name = user.Username
+	}
+	calendar := &ical.Calendar{
+		Name: fmt.Sprintf("Memos of %s", name),
+	}
+	for _, memo := range memoList {
+		calendar.Events = append(calendar.Events, GetMemoEvents(memo, baseURL)...)
+	}
+	c.Response().Header().Set(echo.HeaderContentType, ical.ContentType)
+	return c.String(http.StatusOK, calendar.String())
+}
+
+// GetMemoEvents returns the event of the memo if its first line is dated, and the events of its dated incomplete tasks.
+func GetMemoEvents(memo *store.Memo, baseURL string) []*ical.Event {
+	if !datePattern.MatchString(memo.Content) {
+		return nil
+	}
+	nodes, err := parser.Parse(tokenizer.Tokenize(memo.Content))
+	if err != nil {
+		return nil
+	}
+	memoURL := fmt.Sprintf("%s/memos/%s", baseURL, memo.UID)
+	newEvent := func(uid, text string) *ical.Event {
+		start, allDay, summary, ok := parseDatedText(text)
+		if !ok {
+			return nil
+		}
+		return &ical.Event{
+			UID:     uid,
+			Summary: summary,
+			URL:     memoURL,
+			Start:   start,
+			AllDay:  allDay,
+			Stamp:   time.Unix(memo.UpdatedTs, 0),
+		}
+	}
+
+	events := []*ical.Event{}
+	if len(nodes) > 0 {
+		switch nodes[0].(type) {
+		case *ast.Heading, *ast.Paragraph:
+			firstLine, _, _ := strings.Cut(renderer.NewStringRenderer().Render(nodes[:1]), "\n")
+			if event := newEvent(fmt.Sprintf("%s@memos", memo.UID), firstLine); event != nil {
+				events = append(events, event)
+			}
+		}
+	}
+	// The tasks are numbered in the memo, completed or not, so that their events keep their identifiers when others are completed.
+	taskIndex := 0
+	memopayload.TraverseASTNodes(nodes, func(node ast.Node) {
+		task, ok := node.(*ast.TaskListItem)
+		if !ok {
+			return
+		}
+		taskIndex++
+		if task.Complete {
+			return
+		}
+		if event := newEvent(fmt.Sprintf("%s-task-%d@memos", memo.UID, taskIndex), renderer.NewStringRenderer().Render(task.Children)); event != nil {
+			events = append(events, event)
+		}
+	})
+	return events
+}
+
+// parseDatedText returns the date of the text, whether it is an all-day date without time, and the text without it as summary.
+func parseDatedText(text string) (time.Time, bool, string, bool) {
+	match := datePattern.FindStringSubmatchIndex(text)
+	if match == nil {
+		return time.Time{}, false, "", false
+	}
+	date, clock := text[match[2]:match[3]], ""
+	if match[4] >= 0 {
+		clock = text[match[4]:match[5]]
+	}
+	allDay := clock == ""
+	var start time.Time
+	var err error
+	if allDay {
+		start, err = time.Parse("2006-01-02", date)
+	} else {
+		start, err = time.Parse("2006-01-02 15:04", date+" "+clock)
+	}
+	if err != nil {
+		return time.Time{}, false, "", false
+	}
+	summary := strings.Join(strings.Fields(text[:match[0]]+" "+text[match[1]:]), " ")
+	if summary == "" {
+		summary = "Memo"
+	}
+	return start, allDay, summary, true
+}
@@ -0,0 +1,98 @@
+package calendar
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/profile"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+func TestGetUserCalendar(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	defer ts.Close()
+
+	user, err := ts.CreateUser(ctx, &store.User{Username: "jane", Nickname: "Jane", Role: store.RoleUser})
+	require.NoError(t, err)
+	for uid, content := range map[string]string{
+		"dentist": "# Dentist 2025-07-02 14:30\n\nBring the forms.",
+		"chores":  "Chores\n\n- [ ] Pay rent 2025-07-01\n- [x] Call mom 2025-06-01\n- [ ] Water plants\n- [ ] Renew passport 2025-09-15",
+		"undated": "Nothing planned\n\nSee 2025-07-01 later",
+	} {
+		_, err := ts.CreateMemo(ctx, &store.Memo{UID: uid, CreatorID: user.ID, Content: content, Visibility: store.Private})
+		require.NoError(t, err)
+	}
+
+	e := echo.New()
+	NewCalendarService(&profile.Profile{InstanceURL: "https://memos.example.com/"}, ts).RegisterRoutes(e.Group(""))
+	get := func(path string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		return recorder
+	}
+
+	// The feed is only published with the token of the user.
+	require.Equal(t, http.StatusNotFound, get("/u/jane/calendar.ics?token=").Code)
+	require.NoError(t, ts.UpsertUserCalendarFeed(ctx, user.ID, &storepb.CalendarFeedUserSetting{Token: "secret"}))
+	require.Equal(t, http.StatusNotFound, get("/u/jane/calendar.ics?token=wrong").Code)
+	require.Equal(t, http.StatusNotFound, get("/u/john/calendar.ics?token=secret").Code)
+
+	recorder := get("/u/jane/calendar.ics?token=secret")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "text/calendar; charset=utf-8", recorder.Header().Get(echo.HeaderContentType))
+	calendar := strings.ReplaceAll(recorder.Body.String(), "\r\n", "\n")
+	require.Contains(t, calendar, "X-WR-CALNAME:Memos of Jane\n")
+	require.Contains(t, calendar, "UID:dentist@memos\n")
+	require.Contains(t, calendar, "DTSTART:20250702T143000\n")
+	require.Contains(t, calendar, "SUMMARY:Dentist\n")
+	require.Contains(t, calendar, "URL:https://memos.example.com/memos/dentist\n")
+	require.Contains(t, calendar, "UID:chores-task-1@memos\nDTSTAMP:")
+	require.Contains(t, calendar, "DTSTART;VALUE=DATE:20250701\n")
+	require.Contains(t, calendar, "SUMMARY:Pay rent\n")
+	require.Contains(t, calendar, "UID:chores-task-4@memos\n")
+	require.Contains(t, calendar, "SUMMARY:Renew passport\n")
+	// The completed tasks, the undated tasks and the memos dated past their first line are left out.
+	require.Equal(t, 3, strings.Count(calendar, "BEGIN:VEVENT"))
+	require.NotContains(t, calendar, "Call mom")
+	require.NotContains(t, calendar, "undated")
+
+	// The feed is no longer published once disabled.
+	require.NoError(t, ts.UpsertUserCalendarFeed(ctx, user.ID, &storepb.CalendarFeedUserSetting{}))
+	require.Equal(t, http.StatusNotFound, get("/u/jane/calendar.ics?token=").Code)
+}
+
+func TestParseDatedText(t *testing.T) {
+	tests := []struct {
+		text    string
+		start   time.Time
+		allDay  bool
+		summary string
+		ok      bool
+	}{
+		{text: "Pay rent 2025-07-01", start: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC), allDay: true, summary: "Pay rent", ok: true},
+		{text: "2025-07-02T09:15 standup", start: time.Date(2025, 7, 2, 9, 15, 0, 0, time.UTC), summary: "standup", ok: true},
+		{text: "2025-07-03", start: time.Date(2025, 7, 3, 0, 0, 0, 0, time.UTC), allDay: true, summary: "Memo", ok: true},
+		{text: "Invalid 2025-13-01", ok: false},
+		{text: "No date", ok: false},
+	}
+	for _, test := range tests {
+		start, allDay, summary, ok := parseDatedText(test.text)
+		require.Equal(t, test.ok, ok, test.text)
+		if !ok {
+			continue
+		}
+		require.Equal(t, test.start, start, test.text)
+		require.Equal(t, test.allDay, allDay, test.text)
+		require.Equal(t, test.summary, summary, test.text)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"embed"
 	"io/fs"
 	"net/http"
+	"net/url"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -29,10 +30,10 @@ func NewFrontendService(profile *profile.Profile, store *store.Store) *FrontendS
 	}
 }
 
-func (*FrontendService) Serve(_ context.Context, e *echo.Echo) {
+func (s *FrontendService) Serve(_ context.Context, e *echo.Echo) {
 	skipper := func(c echo.Context) bool {
-		// Skip API routes.
-		if util.HasPrefixes(c.Path(), "/api", "/memos.api.v1") {
+		// Skip API and SCIM routes.
+		if util.HasPrefixes(c.Path(), "/api", "/memos.api.v1", "/scim") {
 			return true
 		}
 		// Skip setting cache headers for index.html
@@ -44,6 +45,10 @@ func (*FrontendService) Serve(_ context.Context, e *echo.Echo) {
 		return false
 	}
 
+	// Redirect profile links using an old username to the current one.
+	// Unknown usernames fall through to the SPA.
+	e.GET("/u/:username", s.redirectRenamedUser)
+
 	// Route to serve the main app with HTML5 fallback for SPA behavior.
 	e.Use(middleware.StaticWithConfig(middleware.StaticConfig{
 		Filesystem: getFileSystem("dist"),
@@ -52,6 +57,31 @@ func (*FrontendService) Serve(_ context.Context, e *echo.Echo) {
 	}))
 }
 
+func (s *FrontendService) redirectRenamedUser(c echo.Context) error {
+	ctx := c.Request().Context()
+	username, err := url.PathUnescape(c.Param("username"))
+	if err != nil {
+		return echo.ErrNotFound
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		Username: &username,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
+	}
+	if user != nil {
+		return echo.ErrNotFound
+	}
+	redirectedUser, err := s.Store.GetUserByRedirectedUsername(ctx, username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
+	}
+	if redirectedUser == nil {
+		return echo.ErrNotFound
+	}
+	return c.Redirect(http.StatusMovedPermanently, "/u/"+url.PathEscape(redirectedUser.Username))
+}
+
 func getFileSystem(path string) http.FileSystem {
 	fs, err := fs.Sub(embeddedFiles, path)
 	if err != nil {
@@ -0,0 +1,197 @@
+// Package health serves the liveness and readiness probes of the server, reporting the statuses of its components.
+package health
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/storage/gcs"
+	"github.com/usememos/memos/plugin/storage/s3"
+	"github.com/usememos/memos/plugin/storage/sftp"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// checkTimeout is the timeout of the check of each component, shorter than the default timeout of the Kubernetes probes.
+const checkTimeout = 5 * time.Second
+
+const (
+	StatusOK          = "ok"
+	StatusUnavailable = "unavailable"
+)
+
+// Response is the status of the server and of its components.
+type Response struct {
+	// Status is StatusOK if all the components are available, StatusUnavailable otherwise.
+	Status     string                      `json:"status"`
+	Components map[string]*ComponentStatus `json:"components"`
+}
+
+// ComponentStatus is the status of a component checked by the probe.
+type ComponentStatus struct {
+	Status string `json:"status"`
+	// Detail describes the component, or why it is unavailable.
+	Detail    string  `json:"detail,omitempty"`
+	LatencyMs float64 `json:"latencyMs"`
+}
+
+type HealthService struct {
+	Profile *profile.Profile
+	Store   *store.Store
+}
+
+func NewHealthService(profile *profile.Profile, store *store.Store) *HealthService {
+	return &HealthService{
+		Profile: profile,
+		Store:   store,
+	}
+}
+
+func (s *HealthService) RegisterRoutes(e *echo.Echo) {
+	e.GET("/healthz", s.GetHealth)
+	e.GET("/readyz", s.GetReadiness)
+}
+
+// GetHealth is the liveness probe, which only checks the database the server cannot serve any request without.
+func (s *HealthService) GetHealth(c echo.Context) error {
+	return s.respond(c, map[string]func(context.Context) (string, error){
+		"database": s.checkDatabase,
+	})
+}
+
+// GetReadiness is the readiness probe, which also checks the storage backend of the attachments and that the database
+// is migrated to the schema of the server.
+func (s *HealthService) GetReadiness(c echo.Context) error {
+	return s.respond(c, map[string]func(context.Context) (string, error){
+		"database":  s.checkDatabase,
+		"storage":   s.checkStorage,
+		"migration": s.checkMigration,
+	})
+}
+
+// respond runs the checks of the components concurrently, responding with 503 Service Unavailable if any fails.
+func (*HealthService) respond(c echo.Context, checks map[string]func(context.Context) (string, error)) error {
+	response := &Response{
+		Status:     StatusOK,
+		Components: map[string]*ComponentStatus{},
+	}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for name, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(c.Request().Context(), checkTimeout)
+			defer cancel()
+			start := time.Now()
+			detail, err := check(ctx)
+			component := &ComponentStatus{
+				Status:    StatusOK,
+				Detail:    detail,
+				LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+			}
+			if err != nil {
+				component.Status = StatusUnavailable
+				component.Detail = err.Error()
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			response.Components[name] = component
+			if err != nil {
+				response.Status = StatusUnavailable
+			}
+		}()
+	}
+	wg.Wait()
+
+	// The probes must not be cached by the proxies.
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	if response.Status != StatusOK {
+		return c.JSON(http.StatusServiceUnavailable, response)
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+func (s *HealthService) checkDatabase(ctx context.Context) (string, error) {
+	if err := s.Store.Ping(ctx); err != nil {
+		return "", errors.Wrap(err, "failed to ping database")
+	}
+	return s.Profile.Driver, nil
+}
+
+// checkStorage checks that the storage backend of the new attachments is reachable with its credentials.
+func (s *HealthService) checkStorage(ctx context.Context) (string, error) {
+	workspaceStorageSetting, err := s.Store.GetWorkspaceStorageSetting(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get workspace storage setting")
+	}
+	storageType := workspaceStorageSetting.StorageType
+	switch storageType {
+	case storepb.WorkspaceStorageSetting_LOCAL:
+		// The local files are stored in the data directory, which must be writable.
+		file, err := os.CreateTemp(s.Profile.Data, ".healthz-*")
+		if err != nil {
+			return "", errors.Wrap(err, "data directory is not writable")
+		}
+		file.Close()
+		if err := os.Remove(file.Name()); err != nil {
+			return "", errors.Wrap(err, "failed to remove probe file")
+		}
+	case storepb.WorkspaceStorageSetting_S3:
+		if workspaceStorageSetting.S3Config == nil {
+			return "", errors.New("S3 storage is not configured")
+		}
+		s3Client, err := s3.NewClient(ctx, workspaceStorageSetting.S3Config)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to create s3 client")
+		}
+		if err := s3Client.HeadBucket(ctx); err != nil {
+			return "", err
+		}
+	case storepb.WorkspaceStorageSetting_GCS:
+		if workspaceStorageSetting.GcsConfig == nil {
+			return "", errors.New("GCS storage is not configured")
+		}
+		gcsClient, err := gcs.NewClient(ctx, workspaceStorageSetting.GcsConfig)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to create gcs client")
+		}
+		if err := gcsClient.GetBucket(ctx); err != nil {
+			return "", err
+		}
+	case storepb.WorkspaceStorageSetting_SFTP:
+		if workspaceStorageSetting.SftpConfig == nil {
+			return "", errors.New("SFTP storage is not configured")
+		}
+		// The client connects to the server and opens the SFTP session when created.
+		sftpClient, err := sftp.NewClient(ctx, workspaceStorageSetting.SftpConfig)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to connect to sftp server")
+		}
+		sftpClient.Close()
+	default:
+		// The attachments are stored in the database.
+		storageType = storepb.WorkspaceStorageSetting_DATABASE
+	}
+	return storageType.String(), nil
+}
+
+// checkMigration checks that the database is migrated to the schema of the server,
+// as the server started by a rolling update of another replica may not be.
+func (s *HealthService) checkMigration(ctx context.Context) (string, error) {
+	pendingSchemaVersion, err := s.Store.GetPendingSchemaVersion(ctx)
+	if err != nil {
+		return "", err
+	}
+	if pendingSchemaVersion != "" {
+		return "", errors.Errorf("database is not migrated to schema version %s", pendingSchemaVersion)
+	}
+	return "", nil
+}
@@ -0,0 +1,102 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/profile"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+func TestHealthService(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	defer ts.Close()
+
+	instanceProfile := &profile.Profile{Mode: "prod", Driver: "sqlite", Data: t.TempDir()}
+	e := echo.New()
+	NewHealthService(instanceProfile, ts).RegisterRoutes(e)
+	get := func(path string) (int, *Response) {
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		require.Equal(t, "no-store", recorder.Header().Get(echo.HeaderCacheControl))
+		response := &Response{}
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), response))
+		return recorder.Code, response
+	}
+	setStorageSetting := func(setting *storepb.WorkspaceStorageSetting) {
+		_, err := ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+			Key:   storepb.WorkspaceSettingKey_STORAGE,
+			Value: &storepb.WorkspaceSetting_StorageSetting{StorageSetting: setting},
+		})
+		require.NoError(t, err)
+	}
+
+	// The liveness probe only checks the database.
+	code, response := get("/healthz")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, StatusOK, response.Status)
+	require.Len(t, response.Components, 1)
+	require.Equal(t, &ComponentStatus{Status: StatusOK, Detail: "sqlite", LatencyMs: response.Components["database"].LatencyMs}, response.Components["database"])
+
+	setStorageSetting(&storepb.WorkspaceStorageSetting{StorageType: storepb.WorkspaceStorageSetting_LOCAL})
+	code, response = get("/readyz")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, StatusOK, response.Status)
+	require.Equal(t, StatusOK, response.Components["database"].Status)
+	require.Equal(t, "LOCAL", response.Components["storage"].Detail)
+	require.Equal(t, StatusOK, response.Components["migration"].Status)
+
+	// The unreachable storage backend makes the server unready, but still alive.
+	instanceProfile.Data = filepath.Join(t.TempDir(), "missing")
+	code, response = get("/readyz")
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, StatusUnavailable, response.Status)
+	require.Equal(t, StatusUnavailable, response.Components["storage"].Status)
+	require.Contains(t, response.Components["storage"].Detail, "data directory is not writable")
+	require.Equal(t, StatusOK, response.Components["database"].Status)
+	code, _ = get("/healthz")
+	require.Equal(t, http.StatusOK, code)
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+	setStorageSetting(&storepb.WorkspaceStorageSetting{
+		StorageType: storepb.WorkspaceStorageSetting_S3,
+		S3Config: &storepb.StorageS3Config{
+			AccessKeyId:     "key",
+			AccessKeySecret: "secret",
+			Endpoint:        server.URL,
+			Region:          "us-east-1",
+			Bucket:          "memos",
+			UsePathStyle:    true,
+		},
+	})
+	code, response = get("/readyz")
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, StatusUnavailable, response.Components["storage"].Status)
+	require.Contains(t, response.Components["storage"].Detail, "failed to head bucket")
+
+	// The database not migrated to the schema of the server yet makes it unready.
+	setStorageSetting(&storepb.WorkspaceStorageSetting{StorageType: storepb.WorkspaceStorageSetting_DATABASE})
+	workspaceBasicSetting, err := ts.GetWorkspaceBasicSetting(ctx)
+	require.NoError(t, err)
+	workspaceBasicSetting.SchemaVersion = "0.1.0"
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_BASIC,
+		Value: &storepb.WorkspaceSetting_BasicSetting{BasicSetting: workspaceBasicSetting},
+	})
+	require.NoError(t, err)
+	code, response = get("/readyz")
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, StatusOK, response.Components["storage"].Status)
+	require.Equal(t, StatusUnavailable, response.Components["migration"].Status)
+	require.Contains(t, response.Components["migration"].Detail, "database is not migrated to schema version")
+}
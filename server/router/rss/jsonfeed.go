@@ -0,0 +1,107 @@
+package rss
+
+import (
+	"context"
+	"time"
+
+	"github.com/usememos/gomark"
+	"github.com/usememos/gomark/renderer"
+
+	"github.com/usememos/memos/store"
+)
+
+const (
+	jsonFeedVersion     = "https://jsonfeed.org/version/1.1"
+	jsonFeedContentType = "application/feed+json; charset=utf-8"
+)
+
+// JSONFeed is a JSON Feed 1.1 feed, see https://www.jsonfeed.org/version/1.1/.
+type JSONFeed struct {
+	Version     string          `json:"version"`
+	Title       string          `json:"title"`
+	HomePageURL string          `json:"home_page_url,omitempty"`
+	FeedURL     string          `json:"feed_url,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Hubs        []*JSONFeedHub  `json:"hubs,omitempty"`
+	Items       []*JSONFeedItem `json:"items"`
+}
+
+// JSONFeedHub is an endpoint notifying the subscribers of the updates of the feed, such as a WebSub hub.
+type JSONFeedHub struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+type JSONFeedItem struct {
+	ID            string                `json:"id"`
+	URL           string                `json:"url,omitempty"`
+	Title         string                `json:"title,omitempty"`
+	ContentHTML   string                `json:"content_html,omitempty"`
+	ContentText   string                `json:"content_text,omitempty"`
+	DatePublished string                `json:"date_published,omitempty"`
+	DateModified  string                `json:"date_modified,omitempty"`
+	Tags          []string              `json:"tags,omitempty"`
+	Attachments   []*JSONFeedAttachment `json:"attachments,omitempty"`
+}
+
+type JSONFeedAttachment struct {
+	URL         string `json:"url"`
+	MimeType    string `json:"mime_type"`
+	Title       string `json:"title,omitempty"`
+	SizeInBytes int64  `json:"size_in_bytes,omitempty"`
+}
+
+// generateJSONFeedFromMemoList returns the JSON Feed of the memos, with the same items as their RSS feed,
+// listing all the attachments of the memos and their tags.
+func (s *RSSService) generateJSONFeedFromMemoList(ctx context.Context, memoList []*store.Memo, baseURL, feedURL string) (*JSONFeed, error) {
+	rssHeading, err := getRSSHeading(ctx, s.Store)
+	if err != nil {
+		return nil, err
+	}
+	feed := &JSONFeed{
+		Version:     jsonFeedVersion,
+		Title:       rssHeading.Title,
+		HomePageURL: baseURL,
+		FeedURL:     feedURL,
+		Description: rssHeading.Description,
+		Items:       []*JSONFeedItem{},
+	}
+
+	memoList = memoList[:min(len(memoList), maxRSSItemCount)]
+	contents := s.renderMemoContents(ctx, memoList)
+	for i, memo := range memoList {
+		nodes, err := gomark.Parse(contents[i])
+		if err != nil {
+			return nil, err
+		}
+		attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{
+			MemoID: &memo.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		link := baseURL + "/memos/" + memo.UID
+		item := &JSONFeedItem{
+			ID:            link,
+			URL:           link,
+			Title:         getRSSItemTitle(nodes),
+			ContentHTML:   getRSSItemContent(nodes, attachments, memo.Payload.GetLinkPreviews(), baseURL),
+			ContentText:   renderer.NewStringRenderer().Render(nodes),
+			DatePublished: time.Unix(memo.CreatedTs, 0).UTC().Format(time.RFC3339),
+			DateModified:  time.Unix(memo.UpdatedTs, 0).UTC().Format(time.RFC3339),
+		}
+		if memo.Payload != nil {
+			item.Tags = memo.Payload.Tags
+		}
+		for _, attachment := range attachments {
+			item.Attachments = append(item.Attachments, &JSONFeedAttachment{
+				URL:         getAttachmentURL(attachment, baseURL),
+				MimeType:    attachment.Type,
+				Title:       attachment.Filename,
+				SizeInBytes: attachment.Size,
+			})
+		}
+		feed.Items = append(feed.Items, item)
+	}
+	return feed, nil
+}
@@ -2,28 +2,49 @@ package rss
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"html"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/gorilla/feeds"
 	"github.com/labstack/echo/v4"
 	"github.com/usememos/gomark"
+	"github.com/usememos/gomark/ast"
 	"github.com/usememos/gomark/renderer"
 
 	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/filter"
+	"github.com/usememos/memos/plugin/hook"
+	"github.com/usememos/memos/plugin/websub"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
 const (
 	maxRSSItemCount = 100
+	// maxRSSItemTitleLength is the maximum number of characters of the titles of the items.
+	maxRSSItemTitleLength = 100
 )
 
 type RSSService struct {
 	Profile *profile.Profile
 	Store   *store.Store
+	// Hooks calls the memo.pre_render hooks of the instance on the memos of the feeds, if not nil.
+	Hooks *hook.Runner
+
+	// instanceURL is the instance URL without trailing slash, the base of the topics of WebSub.
+	instanceURL string
+	// hubURL is the URL of the WebSub hub advertised in the feeds, WebSub being disabled if empty.
+	hubURL string
+	// hub is the embedded WebSub hub, if enabled.
+	hub       *websub.Hub
+	publisher websub.Publisher
 }
 
 type RSSHeading struct {
@@ -32,39 +53,62 @@ type RSSHeading struct {
 }
 
 func NewRSSService(profile *profile.Profile, store *store.Store) *RSSService {
-	return &RSSService{
+	s := &RSSService{
 		Profile: profile,
 		Store:   store,
 	}
+	s.setupWebSub()
+	return s
 }
 
+// feedFormat is the format of a feed, named after the file of its route.
+type feedFormat string
+
+const (
+	feedFormatRSS  feedFormat = "rss.xml"
+	feedFormatJSON feedFormat = "feed.json"
+)
+
 func (s *RSSService) RegisterRoutes(g *echo.Group) {
 	g.GET("/explore/rss.xml", s.GetExploreRSS)
 	g.GET("/u/:username/rss.xml", s.GetUserRSS)
+	g.GET("/explore/feed.json", s.GetExploreJSONFeed)
+	g.GET("/u/:username/feed.json", s.GetUserJSONFeed)
+	if s.hub != nil {
+		g.POST(webSubHubPath, echo.WrapHandler(s.hub))
+	}
 }
 
+// GetExploreRSS returns the feed of the public memos, narrowed down by the optional "tag" and "filter" query parameters.
 func (s *RSSService) GetExploreRSS(c echo.Context) error {
-	ctx := c.Request().Context()
+	return s.renderExploreFeed(c, feedFormatRSS)
+}
+
+// GetUserRSS returns the feed of the public memos of the user, narrowed down by the optional "tag" and "filter" query parameters.
+func (s *RSSService) GetUserRSS(c echo.Context) error {
+	return s.renderUserFeed(c, feedFormatRSS)
+}
+
+// GetExploreJSONFeed returns the JSON Feed of the public memos, like GetExploreRSS.
+func (s *RSSService) GetExploreJSONFeed(c echo.Context) error {
+	return s.renderExploreFeed(c, feedFormatJSON)
+}
+
+// GetUserJSONFeed returns the JSON Feed of the public memos of the user, like GetUserRSS.
+func (s *RSSService) GetUserJSONFeed(c echo.Context) error {
+	return s.renderUserFeed(c, feedFormatJSON)
+}
+
+func (s *RSSService) renderExploreFeed(c echo.Context, format feedFormat) error {
 	normalStatus := store.Normal
 	memoFind := store.FindMemo{
 		RowStatus:      &normalStatus,
 		VisibilityList: []store.Visibility{store.Public},
 	}
-	memoList, err := s.Store.ListMemos(ctx, &memoFind)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find memo list").SetInternal(err)
-	}
-
-	baseURL := c.Scheme() + "://" + c.Request().Host
-	rss, err := s.generateRSSFromMemoList(ctx, memoList, baseURL)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate rss").SetInternal(err)
-	}
-	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationXMLCharsetUTF8)
-	return c.String(http.StatusOK, rss)
+	return s.renderFeed(c, &memoFind, format)
 }
 
-func (s *RSSService) GetUserRSS(c echo.Context) error {
+func (s *RSSService) renderUserFeed(c echo.Context, format feedFormat) error {
 	ctx := c.Request().Context()
 	username := c.Param("username")
 	user, err := s.Store.GetUser(ctx, &store.FindUser{
@@ -74,7 +118,19 @@ func (s *RSSService) GetUserRSS(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
 	}
 	if user == nil {
-		return echo.NewHTTPError(http.StatusNotFound, "User not found")
+		// The user may have been renamed, redirect old links to the current username.
+		redirectedUser, err := s.Store.GetUserByRedirectedUsername(ctx, username)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
+		}
+		if redirectedUser == nil {
+			return echo.NewHTTPError(http.StatusNotFound, "User not found")
+		}
+		location := fmt.Sprintf("/u/%s/%s", url.PathEscape(redirectedUser.Username), format)
+		if c.QueryString() != "" {
+			location += "?" + c.QueryString()
+		}
+		return c.Redirect(http.StatusMovedPermanently, location)
 	}
 
 	normalStatus := store.Normal
@@ -83,18 +139,82 @@ func (s *RSSService) GetUserRSS(c echo.Context) error {
 		RowStatus:      &normalStatus,
 		VisibilityList: []store.Visibility{store.Public},
 	}
-	memoList, err := s.Store.ListMemos(ctx, &memoFind)
+	return s.renderFeed(c, &memoFind, format)
+}
+
+// renderFeed renders the feed of the latest memos found, restricted to the tag and the CEL filter of the query parameters.
+func (s *RSSService) renderFeed(c echo.Context, memoFind *store.FindMemo, format feedFormat) error {
+	ctx := c.Request().Context()
+	if tag := strings.TrimPrefix(c.QueryParam("tag"), "#"); tag != "" {
+		memoFind.PayloadFind = &store.FindMemoPayload{TagSearch: []string{tag}}
+	}
+	if filterStr := c.QueryParam("filter"); filterStr != "" {
+		if err := s.validateFilter(filterStr); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid filter").SetInternal(err)
+		}
+		memoFind.Filter = &filterStr
+	}
+
+	baseURL := c.Scheme() + "://" + c.Request().Host
+	// The feeds advertise the WebSub hub notifying their subscribers of their updates, if any.
+	selfURL := ""
+	if s.hubURL != "" {
+		selfURL = s.getSelfURL(c.Request().URL.RequestURI())
+		c.Response().Header().Set("Link", websub.LinkHeader(s.hubURL, selfURL))
+	}
+	contentType := echo.MIMEApplicationXMLCharsetUTF8
+	if format == feedFormatJSON {
+		contentType = jsonFeedContentType
+	}
+	// The feeds polled by many readers are rendered once until the memos change.
+	cacheKey := "feed:" + baseURL + c.Request().URL.RequestURI()
+	if content, ok := s.Store.GetPublicContent(ctx, cacheKey); ok {
+		return c.Blob(http.StatusOK, contentType, content)
+	}
+
+	limit := maxRSSItemCount
+	memoFind.Limit = &limit
+	memoList, err := s.Store.ListMemos(ctx, memoFind)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find memo list").SetInternal(err)
 	}
 
-	baseURL := c.Scheme() + "://" + c.Request().Host
-	rss, err := s.generateRSSFromMemoList(ctx, memoList, baseURL)
+	var content []byte
+	if format == feedFormatJSON {
+		jsonFeed, err := s.generateJSONFeedFromMemoList(ctx, memoList, baseURL, baseURL+c.Request().URL.RequestURI())
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate json feed").SetInternal(err)
+		}
+		if s.hubURL != "" {
+			jsonFeed.FeedURL = selfURL
+			jsonFeed.Hubs = []*JSONFeedHub{{Type: "WebSub", URL: s.hubURL}}
+		}
+		content, err = json.Marshal(jsonFeed)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to marshal json feed").SetInternal(err)
+		}
+	} else {
+		rss, err := s.generateRSSFromMemoList(ctx, memoList, baseURL)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate rss").SetInternal(err)
+		}
+		if s.hubURL != "" {
+			rss = addRSSHubLinks(rss, s.hubURL, selfURL)
+		}
+		content = []byte(rss)
+	}
+	s.Store.SetPublicContent(ctx, cacheKey, content)
+	return c.Blob(http.StatusOK, contentType, content)
+}
+
+// validateFilter checks that the CEL filter of the feed can be converted by the driver.
+// The filters only narrow down the public memos of the feeds, so they are not restricted further.
+func (s *RSSService) validateFilter(filterStr string) error {
+	parsedExpr, err := filter.Parse(filterStr, filter.MemoFilterCELAttributes...)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate rss").SetInternal(err)
+		return err
 	}
-	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEApplicationXMLCharsetUTF8)
-	return c.String(http.StatusOK, rss)
+	return s.Store.GetDriver().ConvertExprToSQL(filter.NewConvertContext(), parsedExpr.GetExpr())
 }
 
 func (s *RSSService) generateRSSFromMemoList(ctx context.Context, memoList []*store.Memo, baseURL string) (string, error) {
@@ -111,36 +231,36 @@ func (s *RSSService) generateRSSFromMemoList(ctx context.Context, memoList []*st
 
 	var itemCountLimit = min(len(memoList), maxRSSItemCount)
 	feed.Items = make([]*feeds.Item, itemCountLimit)
+	contents := s.renderMemoContents(ctx, memoList[:itemCountLimit])
 	for i := 0; i < itemCountLimit; i++ {
 		memo := memoList[i]
-		description, err := getRSSItemDescription(memo.Content)
+		nodes, err := gomark.Parse(contents[i])
 		if err != nil {
 			return "", err
 		}
-		link := &feeds.Link{Href: baseURL + "/memos/" + memo.UID}
-		feed.Items[i] = &feeds.Item{
-			Link:        link,
-			Description: description,
-			Created:     time.Unix(memo.CreatedTs, 0),
-			Id:          link.Href,
-		}
 		attachments, err := s.Store.ListAttachments(ctx, &store.FindAttachment{
 			MemoID: &memo.ID,
 		})
 		if err != nil {
 			return "", err
 		}
+		link := &feeds.Link{Href: baseURL + "/memos/" + memo.UID}
+		feed.Items[i] = &feeds.Item{
+			Title:       getRSSItemTitle(nodes),
+			Link:        link,
+			Description: renderer.NewStringRenderer().Render(nodes),
+			Content:     getRSSItemContent(nodes, attachments, memo.Payload.GetLinkPreviews(), baseURL),
+			Created:     time.Unix(memo.CreatedTs, 0),
+			Id:          link.Href,
+		}
 		if len(attachments) > 0 {
+			// The items have a single enclosure, the other attachments are linked in their content.
 			attachment := attachments[0]
-			enclosure := feeds.Enclosure{}
-			if attachment.StorageType == storepb.AttachmentStorageType_EXTERNAL || attachment.StorageType == storepb.AttachmentStorageType_S3 {
-				enclosure.Url = attachment.Reference
-			} else {
-				enclosure.Url = fmt.Sprintf("%s/file/attachments/%s/%s", baseURL, attachment.UID, attachment.Filename)
+			feed.Items[i].Enclosure = &feeds.Enclosure{
+				Url:    getAttachmentURL(attachment, baseURL),
+				Length: strconv.Itoa(int(attachment.Size)),
+				Type:   attachment.Type,
 			}
-			enclosure.Length = strconv.Itoa(int(attachment.Size))
-			enclosure.Type = attachment.Type
-			feed.Items[i].Enclosure = &enclosure
 		}
 	}
 
@@ -151,13 +271,61 @@ func (s *RSSService) generateRSSFromMemoList(ctx context.Context, memoList []*st
 	return rss, nil
 }
 
-func getRSSItemDescription(content string) (string, error) {
-	nodes, err := gomark.Parse(content)
-	if err != nil {
-		return "", err
+// renderMemoContents returns the contents of the memos to render once changed by the memo.pre_render hooks.
+func (s *RSSService) renderMemoContents(ctx context.Context, memos []*store.Memo) []string {
+	hookMemos := make([]*hook.Memo, 0, len(memos))
+	for _, memo := range memos {
+		hookMemos = append(hookMemos, &hook.Memo{
+			Name:       "memos/" + memo.UID,
+			Creator:    fmt.Sprintf("users/%d", memo.CreatorID),
+			Content:    memo.Content,
+			Visibility: memo.Visibility.String(),
+		})
+	}
+	return s.Hooks.RenderAll(ctx, hookMemos)
+}
+
+// getRSSItemTitle returns the first line of the plain text of the memo, shortened to maxRSSItemTitleLength.
+func getRSSItemTitle(nodes []ast.Node) string {
+	title, _, _ := strings.Cut(strings.TrimSpace(renderer.NewStringRenderer().Render(nodes)), "\n")
+	if utf8.RuneCountInString(title) > maxRSSItemTitleLength {
+		title = string([]rune(title)[:maxRSSItemTitleLength]) + "…"
+	}
+	return title
+}
+
+// getRSSItemContent returns the full HTML of the memo, followed by the cards of its links and its attachments,
+// the images being shown inline.
+func getRSSItemContent(nodes []ast.Node, attachments []*store.Attachment, linkPreviews []*storepb.MemoPayload_LinkPreview, baseURL string) string {
+	var builder strings.Builder
+	builder.WriteString(renderer.NewHTMLRenderer().Render(nodes))
+	for _, linkPreview := range linkPreviews {
+		if linkPreview.Failed || linkPreview.Title == "" {
+			continue
+		}
+		fmt.Fprintf(&builder, `<blockquote><p><a href="%s"><strong>%s</strong></a></p>`, html.EscapeString(linkPreview.Url), html.EscapeString(linkPreview.Title))
+		if linkPreview.Description != "" {
+			fmt.Fprintf(&builder, `<p>%s</p>`, html.EscapeString(linkPreview.Description))
+		}
+		builder.WriteString(`</blockquote>`)
+	}
+	for _, attachment := range attachments {
+		attachmentURL := html.EscapeString(getAttachmentURL(attachment, baseURL))
+		if strings.HasPrefix(attachment.Type, "image/") {
+			fmt.Fprintf(&builder, `<p><img src="%s" alt="%s"></p>`, attachmentURL, html.EscapeString(attachment.Filename))
+		} else {
+			fmt.Fprintf(&builder, `<p><a href="%s">%s</a></p>`, attachmentURL, html.EscapeString(attachment.Filename))
+		}
+	}
+	return builder.String()
+}
+
+// getAttachmentURL returns the URL of the attachment, served by the instance unless it is stored externally.
+func getAttachmentURL(attachment *store.Attachment, baseURL string) string {
+	if attachment.StorageType == storepb.AttachmentStorageType_EXTERNAL || attachment.StorageType == storepb.AttachmentStorageType_S3 || attachment.StorageType == storepb.AttachmentStorageType_GCS {
+		return attachment.Reference
 	}
-	result := renderer.NewHTMLRenderer().Render(nodes)
-	return result, nil
+	return fmt.Sprintf("%s/file/attachments/%s/%s", baseURL, attachment.UID, url.PathEscape(attachment.Filename))
 }
 
 func getRSSHeading(ctx context.Context, stores *store.Store) (RSSHeading, error) {
@@ -0,0 +1,226 @@
+package rss
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/profile"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+type rssItem struct {
+	Title       string `xml:"title"`
+	Description string `xml:"description"`
+	Content     string `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+	Enclosure   *struct {
+		URL  string `xml:"url,attr"`
+		Type string `xml:"type,attr"`
+	} `xml:"enclosure"`
+}
+
+func TestRSSFeeds(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	defer ts.Close()
+
+	user, err := ts.CreateUser(ctx, &store.User{Username: "jane", Role: store.RoleUser})
+	require.NoError(t, err)
+	createMemo := func(uid, content string, visibility store.Visibility, tags ...string) *store.Memo {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    content,
+			Visibility: visibility,
+			Payload:    &storepb.MemoPayload{Tags: tags},
+		})
+		require.NoError(t, err)
+		return memo
+	}
+	release := createMemo("release", "# Release notes\n\nThe **new** version is out, see https://example.com/blog. #product/release", store.Public, "product/release")
+	release.Payload.LinkPreviews = []*storepb.MemoPayload_LinkPreview{{Url: "https://example.com/blog", Title: "Blog & news", Description: "What's new."}}
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: release.ID, Payload: release.Payload}))
+	createMemo("lunch", "Team lunch #food", store.Public, "food")
+	createMemo("secret", "Secret plans #product", store.Private, "product")
+	_, err = ts.CreateAttachment(ctx, &store.Attachment{UID: "shot", CreatorID: user.ID, Filename: "screen shot.png", Type: "image/png", Size: 3, Blob: []byte("png"), MemoID: &release.ID})
+	require.NoError(t, err)
+	_, err = ts.CreateAttachment(ctx, &store.Attachment{UID: "notes", CreatorID: user.ID, Filename: "notes.pdf", Type: "application/pdf", Size: 3, Blob: []byte("pdf"), MemoID: &release.ID})
+	require.NoError(t, err)
+
+	e := echo.New()
+	NewRSSService(nil, ts).RegisterRoutes(e.Group(""))
+	get := func(path string) (int, []*rssItem) {
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		feed := struct {
+			Items []*rssItem `xml:"channel>item"`
+		}{}
+		if recorder.Code == http.StatusOK {
+			require.NoError(t, xml.Unmarshal(recorder.Body.Bytes(), &feed))
+		}
+		return recorder.Code, feed.Items
+	}
+
+	// The items have a title, the full plain text as description and the full HTML with the attachments as content.
+	code, items := get("/explore/rss.xml")
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, items, 2)
+	code, items = get("/u/jane/rss.xml?tag=product")
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, items, 1)
+	item := items[0]
+	require.Equal(t, "Release notes", item.Title)
+	require.Contains(t, item.Description, "The new version is out")
+	require.Contains(t, item.Content, "<strong>new</strong>")
+	require.Contains(t, item.Content, `<blockquote><p><a href="https://example.com/blog"><strong>Blog &amp; news</strong></a></p><p>What&#39;s new.</p></blockquote>`)
+	require.Contains(t, item.Content, `<img src="http://example.com/file/attachments/shot/screen%20shot.png" alt="screen shot.png">`)
+	require.Contains(t, item.Content, `<a href="http://example.com/file/attachments/notes/notes.pdf">notes.pdf</a>`)
+	require.NotNil(t, item.Enclosure)
+	require.Equal(t, "image/png", item.Enclosure.Type)
+
+	// The feeds are narrowed down by the CEL filters, which must be valid.
+	code, items = get("/explore/rss.xml?filter=" + url.QueryEscape(`content.contains("lunch")`))
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, items, 1)
+	require.Equal(t, "Team lunch #food", items[0].Title)
+	code, _ = get("/explore/rss.xml?filter=" + url.QueryEscape(`unknown == 1`))
+	require.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestJSONFeed(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	defer ts.Close()
+
+	user, err := ts.CreateUser(ctx, &store.User{Username: "jane", Role: store.RoleUser})
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "release",
+		CreatorID:  user.ID,
+		Content:    "# Release notes\n\nThe **new** version is out. #product/release",
+		Visibility: store.Public,
+		Payload:    &storepb.MemoPayload{Tags: []string{"product/release"}},
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{UID: "secret", CreatorID: user.ID, Content: "Secret", Visibility: store.Private})
+	require.NoError(t, err)
+	_, err = ts.CreateAttachment(ctx, &store.Attachment{UID: "notes", CreatorID: user.ID, Filename: "notes.pdf", Type: "application/pdf", Size: 3, Blob: []byte("pdf"), MemoID: &memo.ID})
+	require.NoError(t, err)
+	_, err = ts.CreateAttachment(ctx, &store.Attachment{UID: "video", CreatorID: user.ID, Filename: "demo.mp4", Type: "video/mp4", StorageType: storepb.AttachmentStorageType_EXTERNAL, Reference: "https://cdn.example.com/demo.mp4", MemoID: &memo.ID})
+	require.NoError(t, err)
+
+	e := echo.New()
+	NewRSSService(nil, ts).RegisterRoutes(e.Group(""))
+	recorder := httptest.NewRecorder()
+	e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/u/jane/feed.json?tag=product", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, jsonFeedContentType, recorder.Header().Get(echo.HeaderContentType))
+	feed := &JSONFeed{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), feed))
+	require.Equal(t, jsonFeedVersion, feed.Version)
+	require.Equal(t, "http://example.com/u/jane/feed.json?tag=product", feed.FeedURL)
+	require.Len(t, feed.Items, 1)
+	item := feed.Items[0]
+	require.Equal(t, "http://example.com/memos/release", item.ID)
+	require.Equal(t, "Release notes", item.Title)
+	require.Contains(t, item.ContentHTML, "<strong>new</strong>")
+	require.Equal(t, []string{"product/release"}, item.Tags)
+	require.Len(t, item.Attachments, 2)
+	require.Equal(t, "http://example.com/file/attachments/notes/notes.pdf", item.Attachments[0].URL)
+	require.Equal(t, "application/pdf", item.Attachments[0].MimeType)
+	require.Equal(t, int64(3), item.Attachments[0].SizeInBytes)
+	require.Equal(t, "https://cdn.example.com/demo.mp4", item.Attachments[1].URL)
+
+	// The feeds of the renamed users are redirected in their format.
+	newUsername := "janet"
+	_, err = ts.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, Username: &newUsername})
+	require.NoError(t, err)
+	_, err = ts.UpsertUsernameRedirect(ctx, &store.UsernameRedirect{Username: "jane", UserID: user.ID})
+	require.NoError(t, err)
+	recorder = httptest.NewRecorder()
+	e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/u/jane/feed.json?tag=product", nil))
+	require.Equal(t, http.StatusMovedPermanently, recorder.Code)
+	require.Equal(t, "/u/janet/feed.json?tag=product", recorder.Header().Get(echo.HeaderLocation))
+}
+
+func TestWebSub(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	defer ts.Close()
+
+	user, err := ts.CreateUser(ctx, &store.User{Username: "jane", Role: store.RoleUser})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{UID: "hello", CreatorID: user.ID, Content: "Hello", Visibility: store.Public})
+	require.NoError(t, err)
+
+	// The external hub is pinged with the feeds of the user and of all users.
+	topics := []string{}
+	hub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		topics = append(topics, r.PostForm.Get("hub.url"))
+	}))
+	defer hub.Close()
+	service := NewRSSService(&profile.Profile{InstanceURL: "https://memos.example.com/", WebSubHub: hub.URL}, ts)
+	require.True(t, service.IsWebSubEnabled())
+	require.NoError(t, service.PublishUserFeeds(ctx, "jane"))
+	require.Equal(t, []string{
+		"https://memos.example.com/explore/rss.xml",
+		"https://memos.example.com/explore/feed.json",
+		"https://memos.example.com/u/jane/rss.xml",
+		"https://memos.example.com/u/jane/feed.json",
+	}, topics)
+
+	// The feeds advertise the hub and their canonical URL.
+	e := echo.New()
+	service.RegisterRoutes(e.Group(""))
+	recorder := httptest.NewRecorder()
+	e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/u/jane/rss.xml?tag=go", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, `<`+hub.URL+`>; rel="hub", <https://memos.example.com/u/jane/rss.xml?tag=go>; rel="self"`, recorder.Header().Get("Link"))
+	feed := struct {
+		Channel struct {
+			Links []struct {
+				Rel  string `xml:"rel,attr"`
+				Href string `xml:"href,attr"`
+			} `xml:"http://www.w3.org/2005/Atom link"`
+		} `xml:"channel"`
+	}{}
+	require.NoError(t, xml.Unmarshal(recorder.Body.Bytes(), &feed))
+	require.Len(t, feed.Channel.Links, 2)
+	require.Equal(t, "hub", feed.Channel.Links[0].Rel)
+	require.Equal(t, hub.URL, feed.Channel.Links[0].Href)
+	require.Equal(t, "https://memos.example.com/u/jane/rss.xml?tag=go", feed.Channel.Links[1].Href)
+	recorder = httptest.NewRecorder()
+	e.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/explore/feed.json", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	jsonFeed := &JSONFeed{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), jsonFeed))
+	require.Equal(t, "https://memos.example.com/explore/feed.json", jsonFeed.FeedURL)
+	require.Equal(t, []*JSONFeedHub{{Type: "WebSub", URL: hub.URL}}, jsonFeed.Hubs)
+
+	// The embedded hub only accepts the feeds of the instance.
+	service = NewRSSService(&profile.Profile{InstanceURL: "https://memos.example.com", WebSubHub: "embedded"}, ts)
+	require.Equal(t, "https://memos.example.com/websub", service.hubURL)
+	require.True(t, service.isFeedURL("https://memos.example.com/explore/rss.xml"))
+	require.True(t, service.isFeedURL("https://memos.example.com/u/jane/feed.json?filter=pinned"))
+	require.False(t, service.isFeedURL("https://memos.example.com/u/jane/avatar.png"))
+	require.False(t, service.isFeedURL("https://memos.example.com.evil.com/explore/rss.xml"))
+	require.False(t, service.isFeedURL("https://other.example.com/explore/rss.xml"))
+	e = echo.New()
+	service.RegisterRoutes(e.Group(""))
+	recorder = httptest.NewRecorder()
+	e.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/websub", nil))
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	// WebSub requires the instance URL, the topics being absolute URLs.
+	require.False(t, NewRSSService(&profile.Profile{WebSubHub: "embedded"}, ts).IsWebSubEnabled())
+}
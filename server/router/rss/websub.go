@@ -0,0 +1,90 @@
+package rss
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+
+	"github.com/usememos/memos/plugin/websub"
+)
+
+const (
+	// embeddedWebSubHub is the value of the WebSub hub of the profile serving the embedded hub.
+	embeddedWebSubHub = "embedded"
+	// webSubHubPath is the path the embedded hub is served at.
+	webSubHubPath = "/websub"
+)
+
+// setupWebSub advertises the WebSub hub of the profile in the feeds, and creates the embedded hub if requested.
+func (s *RSSService) setupWebSub() {
+	if s.Profile == nil || s.Profile.WebSubHub == "" {
+		return
+	}
+	if s.Profile.InstanceURL == "" {
+		slog.Warn("WebSub is disabled as the instance URL is not set")
+		return
+	}
+	s.instanceURL = strings.TrimSuffix(s.Profile.InstanceURL, "/")
+	if s.Profile.WebSubHub == embeddedWebSubHub {
+		s.hub = websub.NewHub(s.instanceURL+webSubHubPath, s.isFeedURL)
+		s.hubURL = s.hub.URL
+		s.publisher = s.hub
+		return
+	}
+	s.hubURL = s.Profile.WebSubHub
+	s.publisher = &websub.Client{HubURL: s.hubURL}
+}
+
+// IsWebSubEnabled reports whether the updates of the feeds are published to a WebSub hub.
+func (s *RSSService) IsWebSubEnabled() bool {
+	return s.publisher != nil
+}
+
+// PublishUserFeeds notifies the WebSub hub that the feeds of the public memos of the user, and of all users,
+// are updated. The feeds narrowed down by a tag or a filter are notified by the embedded hub along with them.
+func (s *RSSService) PublishUserFeeds(ctx context.Context, username string) error {
+	if s.publisher == nil {
+		return nil
+	}
+	userPath := fmt.Sprintf("/u/%s/", url.PathEscape(username))
+	return s.publisher.Publish(ctx,
+		s.instanceURL+"/explore/"+string(feedFormatRSS),
+		s.instanceURL+"/explore/"+string(feedFormatJSON),
+		s.instanceURL+userPath+string(feedFormatRSS),
+		s.instanceURL+userPath+string(feedFormatJSON),
+	)
+}
+
+// isFeedURL reports whether the URL is a feed of the instance, the only topics of the embedded hub.
+func (s *RSSService) isFeedURL(topicURL string) bool {
+	path, ok := strings.CutPrefix(topicURL, s.instanceURL)
+	if !ok {
+		return false
+	}
+	path, _, _ = strings.Cut(path, "?")
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	feedFile := feedFormat(segments[len(segments)-1])
+	if feedFile != feedFormatRSS && feedFile != feedFormatJSON {
+		return false
+	}
+	return (len(segments) == 2 && segments[0] == "explore") || (len(segments) == 3 && segments[0] == "u" && segments[1] != "")
+}
+
+// getSelfURL returns the canonical URL of the requested feed, the topic of its subscriptions.
+func (s *RSSService) getSelfURL(requestURI string) string {
+	return s.instanceURL + requestURI
+}
+
+// addRSSHubLinks adds the atom:link elements advertising the hub and the canonical URL to the RSS feed,
+// as gorilla/feeds does not support them.
+func addRSSHubLinks(rss, hubURL, selfURL string) string {
+	links := fmt.Sprintf(`<atom:link rel="hub" href="%s"></atom:link><atom:link rel="self" href="%s"></atom:link>`, escapeXMLAttr(hubURL), escapeXMLAttr(selfURL))
+	rss = strings.Replace(rss, "<rss ", `<rss xmlns:atom="http://www.w3.org/2005/Atom" `, 1)
+	return strings.Replace(rss, "<channel>", "<channel>\n    "+links, 1)
+}
+
+func escapeXMLAttr(value string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;").Replace(value)
+}
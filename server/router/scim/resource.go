@@ -0,0 +1,223 @@
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/usememos/memos/store"
+)
+
+const (
+	userSchema                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	listResponseSchema          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	errorSchema                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	serviceProviderConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+)
+
+// User is the SCIM User resource, mapped to the fields of a Memos user.
+type User struct {
+	Schemas     []string `json:"schemas"`
+	ID          string   `json:"id,omitempty"`
+	UserName    string   `json:"userName"`
+	Name        *Name    `json:"name,omitempty"`
+	DisplayName string   `json:"displayName,omitempty"`
+	Emails      []Email  `json:"emails,omitempty"`
+	Active      *bool    `json:"active,omitempty"`
+	// Password is only read from the requests, it is never returned.
+	Password string `json:"password,omitempty"`
+	Meta     *Meta  `json:"meta,omitempty"`
+}
+
+type Name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type Meta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created"`
+	LastModified string `json:"lastModified"`
+	Location     string `json:"location"`
+}
+
+type ListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []*User  `json:"Resources"`
+}
+
+type PatchRequest struct {
+	Schemas    []string          `json:"schemas"`
+	Operations []*PatchOperation `json:"Operations"`
+}
+
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// Error is a SCIM error response. The handlers return it as an error, and the middleware writes it.
+type Error struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+
+	code int
+}
+
+func newError(code int, scimType string, format string, args ...any) *Error {
+	return &Error{
+		Schemas:  []string{errorSchema},
+		Status:   strconv.Itoa(code),
+		ScimType: scimType,
+		Detail:   fmt.Sprintf(format, args...),
+		code:     code,
+	}
+}
+
+func (e *Error) Error() string {
+	return e.Detail
+}
+
+func convertUserFromStore(user *store.User, baseURL string) *User {
+	id := strconv.Itoa(int(user.ID))
+	active := user.RowStatus == store.Normal
+	resource := &User{
+		Schemas:     []string{userSchema},
+		ID:          id,
+		UserName:    user.Username,
+		DisplayName: user.Nickname,
+		Active:      &active,
+		Meta: &Meta{
+			ResourceType: "User",
+			Created:      time.Unix(user.CreatedTs, 0).UTC().Format(time.RFC3339),
+			LastModified: time.Unix(user.UpdatedTs, 0).UTC().Format(time.RFC3339),
+			Location:     baseURL + "/scim/v2/Users/" + id,
+		},
+	}
+	if user.Nickname != "" {
+		resource.Name = &Name{Formatted: user.Nickname}
+	}
+	if user.Email != "" {
+		resource.Emails = []Email{{Value: user.Email, Type: "work", Primary: true}}
+	}
+	return resource
+}
+
+// getDisplayName returns the display name of the resource, falling back to its name parts.
+func (u *User) getDisplayName() string {
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	if u.Name == nil {
+		return ""
+	}
+	if u.Name.Formatted != "" {
+		return u.Name.Formatted
+	}
+	return strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName)
+}
+
+// getEmail returns the primary email of the resource, or its first email.
+func (u *User) getEmail() string {
+	for _, email := range u.Emails {
+		if email.Primary {
+			return email.Value
+		}
+	}
+	if len(u.Emails) > 0 {
+		return u.Emails[0].Value
+	}
+	return ""
+}
+
+func (u *User) isActive() bool {
+	return u.Active == nil || *u.Active
+}
+
+// applyPatchOperation applies the add or replace operation to the resource.
+// Attributes that Memos does not store, such as externalId or title, are ignored.
+func (u *User) applyPatchOperation(operation *PatchOperation) error {
+	switch strings.ToLower(operation.Op) {
+	case "add", "replace":
+	default:
+		return newError(http.StatusBadRequest, "invalidSyntax", "unsupported patch operation %q", operation.Op)
+	}
+	if operation.Path == "" {
+		values := map[string]json.RawMessage{}
+		if err := json.Unmarshal(operation.Value, &values); err != nil {
+			return newError(http.StatusBadRequest, "invalidValue", "the value of a patch operation without path must be an object")
+		}
+		for path, value := range values {
+			if err := u.applyAttribute(path, value); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return u.applyAttribute(operation.Path, operation.Value)
+}
+
+func (u *User) applyAttribute(path string, value json.RawMessage) error {
+	path = strings.ToLower(path)
+	var err error
+	switch {
+	case path == "active":
+		var active bool
+		active, err = unmarshalBool(value)
+		u.Active = &active
+	case path == "username":
+		err = json.Unmarshal(value, &u.UserName)
+	case path == "displayname":
+		err = json.Unmarshal(value, &u.DisplayName)
+	case path == "password":
+		err = json.Unmarshal(value, &u.Password)
+	case path == "name":
+		u.Name = &Name{}
+		err = json.Unmarshal(value, u.Name)
+		u.DisplayName = ""
+	case path == "name.formatted":
+		u.Name = &Name{}
+		err = json.Unmarshal(value, &u.Name.Formatted)
+		u.DisplayName = ""
+	case path == "emails":
+		err = json.Unmarshal(value, &u.Emails)
+	case strings.HasPrefix(path, "emails[") && strings.HasSuffix(path, "].value"):
+		// The filter of the email, e.g. [type eq "work"], selects the only email that Memos stores.
+		var email string
+		err = json.Unmarshal(value, &email)
+		u.Emails = []Email{{Value: email, Type: "work", Primary: true}}
+	}
+	if err != nil {
+		return newError(http.StatusBadRequest, "invalidValue", "invalid value of %q", path)
+	}
+	return nil
+}
+
+// unmarshalBool accepts booleans as well as their string form, which some identity providers send.
+func unmarshalBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return false, err
+	}
+	return strconv.ParseBool(s)
+}
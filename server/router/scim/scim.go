@@ -0,0 +1,379 @@
+// Package scim serves the SCIM 2.0 Users endpoint (RFC 7643, RFC 7644), so that enterprise identity providers
+// can provision, update and deactivate the accounts of the workspace.
+package scim
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/usememos/memos/internal/base"
+	"github.com/usememos/memos/internal/passwordpolicy"
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	contentType = "application/scim+json"
+	// defaultCount is the number of users returned by a list request without count.
+	defaultCount = 100
+)
+
+// filterMatcher matches the only filter supported by the list of users, e.g. userName eq "jane".
+var filterMatcher = regexp.MustCompile(`(?i)^\s*userName\s+eq\s+("(?:[^"\\]|\\.)*")\s*$`)
+
+type SCIMService struct {
+	Profile *profile.Profile
+	Store   *store.Store
+}
+
+func NewSCIMService(profile *profile.Profile, store *store.Store) *SCIMService {
+	return &SCIMService{
+		Profile: profile,
+		Store:   store,
+	}
+}
+
+func (s *SCIMService) RegisterRoutes(g *echo.Group) {
+	scimGroup := g.Group("/scim/v2", s.authenticate)
+	scimGroup.GET("/ServiceProviderConfig", s.GetServiceProviderConfig)
+	scimGroup.GET("/Users", s.ListUsers)
+	scimGroup.POST("/Users", s.CreateUser)
+	scimGroup.GET("/Users/:id", s.GetUser)
+	scimGroup.PUT("/Users/:id", s.ReplaceUser)
+	scimGroup.PATCH("/Users/:id", s.PatchUser)
+	scimGroup.DELETE("/Users/:id", s.DeleteUser)
+}
+
+// authenticate checks the bearer token against the token of the workspace SCIM setting,
+// and writes the SCIM errors returned by the handlers.
+func (s *SCIMService) authenticate(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		c.Response().Header().Set(echo.HeaderContentType, contentType)
+		err := s.checkToken(c)
+		if err == nil {
+			err = next(c)
+		}
+		var scimErr *Error
+		if errors.As(err, &scimErr) {
+			return c.JSON(scimErr.code, scimErr)
+		}
+		return err
+	}
+}
+
+func (s *SCIMService) checkToken(c echo.Context) error {
+	workspaceSCIMSetting, err := s.Store.GetWorkspaceSCIMSetting(c.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get workspace SCIM setting").SetInternal(err)
+	}
+	// An empty token would accept any request without token.
+	if !workspaceSCIMSetting.Enabled || workspaceSCIMSetting.Token == "" {
+		return newError(http.StatusUnauthorized, "", "SCIM provisioning is disabled")
+	}
+	token, ok := strings.CutPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+	if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(workspaceSCIMSetting.Token)) != 1 {
+		c.Response().Header().Set(echo.HeaderWWWAuthenticate, "Bearer")
+		return newError(http.StatusUnauthorized, "", "invalid bearer token")
+	}
+	return nil
+}
+
+func (*SCIMService) GetServiceProviderConfig(c echo.Context) error {
+	return c.JSON(http.StatusOK, map[string]any{
+		"schemas":        []string{serviceProviderConfigSchema},
+		"patch":          map[string]any{"supported": true},
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": defaultCount},
+		"changePassword": map[string]any{"supported": true},
+		"sort":           map[string]any{"supported": false},
+		"etag":           map[string]any{"supported": false},
+		"authenticationSchemes": []map[string]any{
+			{
+				"type":        "oauthbearertoken",
+				"name":        "Bearer Token",
+				"description": "The token of the workspace SCIM setting.",
+				"primary":     true,
+			},
+		},
+	})
+}
+
+func (s *SCIMService) ListUsers(c echo.Context) error {
+	ctx := c.Request().Context()
+	userFind := &store.FindUser{}
+	if filter := c.QueryParam("filter"); filter != "" {
+		matches := filterMatcher.FindStringSubmatch(filter)
+		if matches == nil {
+			return newError(http.StatusBadRequest, "invalidFilter", "unsupported filter %q, only userName eq is supported", filter)
+		}
+		username, err := strconv.Unquote(matches[1])
+		if err != nil {
+			return newError(http.StatusBadRequest, "invalidFilter", "invalid filter %q", filter)
+		}
+		userFind.Username = &username
+	}
+	users, err := s.Store.ListUsers(ctx, userFind)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to list users").SetInternal(err)
+	}
+
+	// The start index is 1-based, and out of range values are clamped as specified by RFC 7644.
+	startIndex, err := strconv.Atoi(c.QueryParam("startIndex"))
+	if err != nil || startIndex < 1 {
+		startIndex = 1
+	}
+	count, err := strconv.Atoi(c.QueryParam("count"))
+	if err != nil || count > defaultCount {
+		count = defaultCount
+	}
+	count = max(count, 0)
+	resources := []*User{}
+	baseURL := getBaseURL(c)
+	for i := startIndex - 1; i < len(users) && len(resources) < count; i++ {
+		resources = append(resources, convertUserFromStore(users[i], baseURL))
+	}
+	return c.JSON(http.StatusOK, &ListResponse{
+		Schemas:      []string{listResponseSchema},
+		TotalResults: len(users),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
+
+func (s *SCIMService) GetUser(c echo.Context) error {
+	user, err := s.getUser(c)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, convertUserFromStore(user, getBaseURL(c)))
+}
+
+// CreateUser creates a user with the user role. Without password, the user signs in with an identity provider.
+func (s *SCIMService) CreateUser(c echo.Context) error {
+	ctx := c.Request().Context()
+	resource := &User{}
+	if err := json.NewDecoder(c.Request().Body).Decode(resource); err != nil {
+		return newError(http.StatusBadRequest, "invalidSyntax", "invalid request body")
+	}
+	if err := s.validateUser(ctx, resource, 0); err != nil {
+		return err
+	}
+	if err := s.checkPasswordPolicy(ctx, resource.Password); err != nil {
+		return err
+	}
+	password := resource.Password
+	if password == "" {
+		randomPassword, err := util.RandomString(20)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate random password").SetInternal(err)
+		}
+		password = randomPassword
+	}
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate password hash").SetInternal(err)
+	}
+	user, err := s.Store.CreateUser(ctx, &store.User{
+		Username:     resource.UserName,
+		Role:         store.RoleUser,
+		Email:        resource.getEmail(),
+		Nickname:     resource.getDisplayName(),
+		PasswordHash: string(passwordHash),
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create user").SetInternal(err)
+	}
+	if !resource.isActive() {
+		archived := store.Archived
+		if user, err = s.Store.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, RowStatus: &archived}); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to archive user").SetInternal(err)
+		}
+	}
+
+	created := convertUserFromStore(user, getBaseURL(c))
+	c.Response().Header().Set(echo.HeaderLocation, created.Meta.Location)
+	return c.JSON(http.StatusCreated, created)
+}
+
+// ReplaceUser replaces the attributes of the user with the ones of the request.
+func (s *SCIMService) ReplaceUser(c echo.Context) error {
+	user, err := s.getUser(c)
+	if err != nil {
+		return err
+	}
+	resource := &User{}
+	if err := json.NewDecoder(c.Request().Body).Decode(resource); err != nil {
+		return newError(http.StatusBadRequest, "invalidSyntax", "invalid request body")
+	}
+	return s.updateUser(c, user, resource)
+}
+
+// PatchUser applies the add and replace operations of the request to the attributes of the user.
+func (s *SCIMService) PatchUser(c echo.Context) error {
+	user, err := s.getUser(c)
+	if err != nil {
+		return err
+	}
+	request := &PatchRequest{}
+	if err := json.NewDecoder(c.Request().Body).Decode(request); err != nil {
+		return newError(http.StatusBadRequest, "invalidSyntax", "invalid request body")
+	}
+	resource := convertUserFromStore(user, "")
+	for _, operation := range request.Operations {
+		if err := resource.applyPatchOperation(operation); err != nil {
+			return err
+		}
+	}
+	return s.updateUser(c, user, resource)
+}
+
+// DeleteUser archives the user instead of deleting it, so that its memos are kept and the host can restore it.
+func (s *SCIMService) DeleteUser(c echo.Context) error {
+	user, err := s.getUser(c)
+	if err != nil {
+		return err
+	}
+	if user.Role == store.RoleHost {
+		return newError(http.StatusBadRequest, "mutability", "the host user cannot be deactivated")
+	}
+	archived := store.Archived
+	if _, err := s.Store.UpdateUser(c.Request().Context(), &store.UpdateUser{ID: user.ID, RowStatus: &archived}); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to archive user").SetInternal(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *SCIMService) getUser(c echo.Context) (*store.User, error) {
+	id, err := util.ConvertStringToInt32(c.Param("id"))
+	if err != nil {
+		return nil, newError(http.StatusNotFound, "", "user %s not found", c.Param("id"))
+	}
+	user, err := s.Store.GetUser(c.Request().Context(), &store.FindUser{ID: &id})
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to get user").SetInternal(err)
+	}
+	// The system bot is not an account of the workspace.
+	if user == nil || user.ID == store.SystemBotID {
+		return nil, newError(http.StatusNotFound, "", "user %s not found", c.Param("id"))
+	}
+	return user, nil
+}
+
+// updateUser updates the user with the attributes of the resource.
+func (s *SCIMService) updateUser(c echo.Context, user *store.User, resource *User) error {
+	ctx := c.Request().Context()
+	if err := s.validateUser(ctx, resource, user.ID); err != nil {
+		return err
+	}
+	if user.Role == store.RoleHost && !resource.isActive() {
+		return newError(http.StatusBadRequest, "mutability", "the host user cannot be deactivated")
+	}
+	// The credentials of the host and the admins are not managed by the identity provider, so that the SCIM token
+	// cannot take over their accounts, e.g. by resetting their password or their email to reset it.
+	if user.Role == store.RoleHost || user.Role == store.RoleAdmin {
+		if resource.Password != "" || resource.UserName != user.Username || resource.getEmail() != user.Email {
+			return newError(http.StatusBadRequest, "mutability", "the password, userName and email of the host and admin users cannot be changed")
+		}
+	}
+	if err := s.checkPasswordPolicy(ctx, resource.Password); err != nil {
+		return err
+	}
+
+	email, nickname := resource.getEmail(), resource.getDisplayName()
+	rowStatus := store.Normal
+	if !resource.isActive() {
+		rowStatus = store.Archived
+	}
+	update := &store.UpdateUser{
+		ID:        user.ID,
+		Username:  &resource.UserName,
+		Email:     &email,
+		Nickname:  &nickname,
+		RowStatus: &rowStatus,
+	}
+	if resource.Password != "" {
+		passwordHash, err := bcrypt.GenerateFromPassword([]byte(resource.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate password hash").SetInternal(err)
+		}
+		passwordHashStr := string(passwordHash)
+		update.PasswordHash = &passwordHashStr
+	}
+	updatedUser, err := s.Store.UpdateUser(ctx, update)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to update user").SetInternal(err)
+	}
+	if updatedUser.Username != user.Username {
+		// Keep the old handle pointing to the user so shared links still resolve.
+		if _, err := s.Store.UpsertUsernameRedirect(ctx, &store.UsernameRedirect{
+			Username: user.Username,
+			UserID:   user.ID,
+		}); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create username redirect").SetInternal(err)
+		}
+		// The new username may be one the user had before, which is no longer a redirect.
+		if err := s.Store.DeleteUsernameRedirect(ctx, &store.DeleteUsernameRedirect{
+			Username: updatedUser.Username,
+		}); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to delete username redirect").SetInternal(err)
+		}
+	}
+	return c.JSON(http.StatusOK, convertUserFromStore(updatedUser, getBaseURL(c)))
+}
+
+// checkPasswordPolicy returns an invalidValue error if the password set by the identity provider, if any,
+// does not satisfy the workspace password policy.
+func (s *SCIMService) checkPasswordPolicy(ctx context.Context, password string) error {
+	if password == "" {
+		return nil
+	}
+	policy, err := s.Store.GetWorkspacePasswordPolicySetting(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get workspace password policy setting").SetInternal(err)
+	}
+	if err := passwordpolicy.Check(ctx, policy, password); err != nil {
+		return newError(http.StatusBadRequest, "invalidValue", "%v", err)
+	}
+	return nil
+}
+
+// validateUser checks the attributes of the resource for the user, or for a new user if the id is 0.
+func (s *SCIMService) validateUser(ctx context.Context, resource *User, userID int32) error {
+	if !base.UIDMatcher.MatchString(strings.ToLower(resource.UserName)) {
+		return newError(http.StatusBadRequest, "invalidValue", "invalid userName %q", resource.UserName)
+	}
+	if email := resource.getEmail(); email != "" && !util.ValidateEmail(email) {
+		return newError(http.StatusBadRequest, "invalidValue", "invalid email %q", email)
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{Username: &resource.UserName})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get user").SetInternal(err)
+	}
+	if user != nil && user.ID != userID {
+		return newError(http.StatusConflict, "uniqueness", "userName %q is already taken", resource.UserName)
+	}
+	// Old handles stay reserved so that links shared with them keep redirecting to their owner.
+	redirect, err := s.Store.GetUsernameRedirect(ctx, &store.FindUsernameRedirect{Username: &resource.UserName})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get username redirect").SetInternal(err)
+	}
+	if redirect != nil && redirect.UserID != userID {
+		return newError(http.StatusConflict, "uniqueness", "userName %q is reserved", resource.UserName)
+	}
+	return nil
+}
+
+func getBaseURL(c echo.Context) string {
+	return c.Scheme() + "://" + c.Request().Host
+}
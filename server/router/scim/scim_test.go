@@ -0,0 +1,168 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/store/test"
+)
+
+func TestSCIMUsers(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	defer ts.Close()
+
+	hostUser, err := ts.CreateUser(ctx, &store.User{Username: "host", Role: store.RoleHost})
+	require.NoError(t, err)
+
+	e := echo.New()
+	NewSCIMService(nil, ts).RegisterRoutes(e.Group(""))
+	do := func(method, path, token, body string) (*httptest.ResponseRecorder, map[string]any) {
+		request := httptest.NewRequest(method, path, strings.NewReader(body))
+		request.Header.Set(echo.HeaderContentType, contentType)
+		if token != "" {
+			request.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+		}
+		recorder := httptest.NewRecorder()
+		e.ServeHTTP(recorder, request)
+		result := map[string]any{}
+		if recorder.Body.Len() > 0 {
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &result))
+		}
+		return recorder, result
+	}
+
+	// The endpoint is disabled until the host enables it with a token.
+	recorder, _ := do(http.MethodGet, "/scim/v2/Users", "", "")
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_SCIM,
+		Value: &storepb.WorkspaceSetting_ScimSetting{
+			ScimSetting: &storepb.WorkspaceSCIMSetting{Enabled: true, Token: "secret-token"},
+		},
+	})
+	require.NoError(t, err)
+	recorder, result := do(http.MethodGet, "/scim/v2/Users", "wrong-token", "")
+	require.Equal(t, http.StatusUnauthorized, recorder.Code)
+	require.Equal(t, []any{errorSchema}, result["schemas"])
+	const token = "secret-token"
+
+	recorder, result = do(http.MethodPost, "/scim/v2/Users", token, `{
+		"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+		"userName": "jane",
+		"name": {"givenName": "Jane", "familyName": "Doe"},
+		"emails": [{"value": "jane@example.com", "type": "work", "primary": true}],
+		"active": true
+	}`)
+	require.Equal(t, http.StatusCreated, recorder.Code)
+	require.Equal(t, contentType, recorder.Header().Get(echo.HeaderContentType))
+	id := result["id"].(string)
+	require.Equal(t, "Jane Doe", result["displayName"])
+	require.Equal(t, true, result["active"])
+	username := "jane"
+	user, err := ts.GetUser(ctx, &store.FindUser{Username: &username})
+	require.NoError(t, err)
+	require.Equal(t, store.RoleUser, user.Role)
+	require.Equal(t, "jane@example.com", user.Email)
+
+	recorder, result = do(http.MethodPost, "/scim/v2/Users", token, `{"userName": "jane"}`)
+	require.Equal(t, http.StatusConflict, recorder.Code)
+	require.Equal(t, "uniqueness", result["scimType"])
+	recorder, _ = do(http.MethodPost, "/scim/v2/Users", token, `{"userName": "jane doe"}`)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	recorder, result = do(http.MethodGet, `/scim/v2/Users?filter=userName+eq+%22jane%22`, token, "")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, float64(1), result["totalResults"])
+	require.Equal(t, id, result["Resources"].([]any)[0].(map[string]any)["id"])
+	recorder, result = do(http.MethodGet, "/scim/v2/Users?startIndex=2&count=10", token, "")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, float64(2), result["totalResults"])
+	require.Len(t, result["Resources"], 1)
+	recorder, _ = do(http.MethodGet, `/scim/v2/Users?filter=emails+co+%22example%22`, token, "")
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	recorder, _ = do(http.MethodGet, "/scim/v2/Users/999", token, "")
+	require.Equal(t, http.StatusNotFound, recorder.Code)
+
+	// The deactivation archives the user, with active sent as a string by some identity providers.
+	recorder, result = do(http.MethodPatch, "/scim/v2/Users/"+id, token, `{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [
+			{"op": "Replace", "path": "active", "value": "False"},
+			{"op": "replace", "path": "emails[type eq \"work\"].value", "value": "jane.doe@example.com"}
+		]
+	}`)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, false, result["active"])
+	user, err = ts.GetUser(ctx, &store.FindUser{ID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, store.Archived, user.RowStatus)
+	require.Equal(t, "jane.doe@example.com", user.Email)
+	require.Equal(t, "Jane Doe", user.Nickname)
+
+	recorder, result = do(http.MethodPut, "/scim/v2/Users/"+id, token, `{"userName": "janed", "displayName": "Jane D.", "active": true}`)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "janed", result["userName"])
+	user, err = ts.GetUser(ctx, &store.FindUser{ID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, store.Normal, user.RowStatus)
+	redirectedUser, err := ts.GetUserByRedirectedUsername(ctx, "jane")
+	require.NoError(t, err)
+	require.Equal(t, user.ID, redirectedUser.ID)
+
+	recorder, _ = do(http.MethodDelete, "/scim/v2/Users/"+id, token, "")
+	require.Equal(t, http.StatusNoContent, recorder.Code)
+	user, err = ts.GetUser(ctx, &store.FindUser{ID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, store.Archived, user.RowStatus)
+
+	// The host user is never deactivated, so that the workspace keeps a host.
+	recorder, result = do(http.MethodDelete, fmt.Sprintf("/scim/v2/Users/%d", hostUser.ID), token, "")
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	require.Equal(t, "mutability", result["scimType"])
+
+	// Nor are the credentials of the host and the admins changed, while their profile is.
+	hostPath := fmt.Sprintf("/scim/v2/Users/%d", hostUser.ID)
+	for _, operation := range []string{
+		`{"op": "replace", "path": "password", "value": "taken over"}`,
+		`{"op": "replace", "path": "userName", "value": "hijacked"}`,
+		`{"op": "replace", "path": "emails", "value": [{"value": "attacker@example.com", "primary": true}]}`,
+	} {
+		recorder, result = do(http.MethodPatch, hostPath, token, `{"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"], "Operations": [`+operation+`]}`)
+		require.Equal(t, http.StatusBadRequest, recorder.Code, operation)
+		require.Equal(t, "mutability", result["scimType"])
+	}
+	recorder, _ = do(http.MethodPatch, hostPath, token, `{"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"], "Operations": [{"op": "replace", "path": "displayName", "value": "Host"}]}`)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	hostUser, err = ts.GetUser(ctx, &store.FindUser{ID: &hostUser.ID})
+	require.NoError(t, err)
+	require.Equal(t, "host", hostUser.Username)
+	require.Equal(t, "Host", hostUser.Nickname)
+
+	// The passwords set by the identity provider satisfy the password policy.
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_PASSWORD_POLICY,
+		Value: &storepb.WorkspaceSetting_PasswordPolicySetting{
+			PasswordPolicySetting: &storepb.WorkspacePasswordPolicySetting{MinLength: 12},
+		},
+	})
+	require.NoError(t, err)
+	recorder, result = do(http.MethodPost, "/scim/v2/Users", token, `{"userName": "joe", "password": "short"}`)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	require.Equal(t, "invalidValue", result["scimType"])
+	recorder, result = do(http.MethodPatch, "/scim/v2/Users/"+id, token, `{"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"], "Operations": [{"op": "replace", "path": "password", "value": "short"}]}`)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	require.Equal(t, "invalidValue", result["scimType"])
+	recorder, _ = do(http.MethodPost, "/scim/v2/Users", token, `{"userName": "joe", "password": "long enough password"}`)
+	require.Equal(t, http.StatusCreated, recorder.Code)
+}
@@ -0,0 +1,117 @@
+package accesstokencleanup
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// usageFlushInterval is the interval of storing the requests of the access tokens counted in memory.
+const usageFlushInterval = time.Minute
+
+// Run stores the requests of the access tokens counted by the instance every minute, and once more when stopped.
+// It runs on every instance of the cluster, each counting the requests it authenticates.
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(usageFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.flushUsages(ctx)
+		case <-ctx.Done():
+			r.flushUsages(context.WithoutCancel(ctx))
+			return
+		}
+	}
+}
+
+func (r *Runner) flushUsages(ctx context.Context) {
+	if err := r.Store.FlushUserAccessTokenUsages(ctx); err != nil {
+		slog.Warn("Failed to store access token usages", "error", err)
+	}
+}
+
+// RunOnce revokes the idle access tokens, run on the schedule of the access token cleanup task.
+func (r *Runner) RunOnce(ctx context.Context) error {
+	count, err := RevokeIdleAccessTokens(ctx, r.Store, time.Now())
+	if count > 0 {
+		slog.Info("Revoked idle access tokens", "count", count)
+	}
+	return err
+}
+
+// RevokeIdleAccessTokens revokes the access tokens unused for the idle revocation period of the workspace, if any,
+// and returns the number of revoked tokens. The tokens never used are idle since they were issued.
+func RevokeIdleAccessTokens(ctx context.Context, s *store.Store, now time.Time) (int, error) {
+	policy, err := s.GetWorkspaceAccessTokenPolicySetting(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if policy.IdleRevocationDays <= 0 {
+		return 0, nil
+	}
+	idleSince := now.AddDate(0, 0, -int(policy.IdleRevocationDays))
+
+	users, err := s.ListUsers(ctx, &store.FindUser{})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to list users")
+	}
+	count := 0
+	for _, user := range users {
+		accessTokens, err := s.GetUserAccessTokens(ctx, user.ID)
+		if err != nil {
+			return count, errors.Wrap(err, "failed to get user access tokens")
+		}
+		if len(accessTokens) == 0 {
+			continue
+		}
+		usages, err := s.GetUserAccessTokenUsages(ctx, user.ID)
+		if err != nil {
+			return count, errors.Wrap(err, "failed to get user access token usages")
+		}
+		lastUsedTimes := map[string]time.Time{}
+		for _, usage := range usages {
+			if usage.LastUsedTime != nil {
+				lastUsedTimes[usage.AccessToken] = usage.LastUsedTime.AsTime()
+			}
+		}
+		for _, accessToken := range accessTokens {
+			lastUsedTime, ok := lastUsedTimes[accessToken.AccessToken]
+			if !ok {
+				// The token is stored by the server, so its claims are trusted without verifying its signature.
+				claims := &jwt.RegisteredClaims{}
+				if _, _, err := jwt.NewParser().ParseUnverified(accessToken.AccessToken, claims); err != nil || claims.IssuedAt == nil {
+					continue
+				}
+				lastUsedTime = claims.IssuedAt.Time
+			}
+			if lastUsedTime.After(idleSince) {
+				continue
+			}
+			if err := s.RemoveUserAccessToken(ctx, user.ID, accessToken.AccessToken); err != nil {
+				return count, errors.Wrap(err, "failed to remove user access token")
+			}
+			if err := s.RemoveUserAccessTokenUsage(ctx, user.ID, accessToken.AccessToken); err != nil {
+				return count, errors.Wrap(err, "failed to remove user access token usage")
+			}
+			count++
+		}
+	}
+	return count, nil
+}
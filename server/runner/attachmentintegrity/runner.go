@@ -0,0 +1,167 @@
+package attachmentintegrity
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/profile"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/attachmenttext"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store   *store.Store
+	Profile *profile.Profile
+}
+
+func NewRunner(store *store.Store, profile *profile.Profile) *Runner {
+	return &Runner{
+		Store:   store,
+		Profile: profile,
+	}
+}
+
+// Schedule runner every day, as every stored file is read.
+const runnerInterval = time.Hour * 24
+
+// batchSize is the number of attachments loaded at once.
+const batchSize = 100
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	count, err := VerifyAttachments(ctx, r.Store, r.Profile)
+	if err != nil {
+		slog.Error("Failed to verify attachments", "error", err)
+	}
+	if count > 0 {
+		slog.Warn("Found corrupted attachments", "count", count)
+	}
+}
+
+// VerifyAttachments compares the content of the attachments stored by the server, in local files or in the database,
+// with their content hash, and returns the number of attachments whose content does not match.
+// The time the corruption is detected is kept in the attachment payload, and reported by the doctor.
+// Remote objects are not verified, as their storages check their integrity themselves.
+func VerifyAttachments(ctx context.Context, s *store.Store, profile *profile.Profile) (int, error) {
+	count := 0
+	// The files shared by several attachments are hashed once.
+	fileHashes := map[string]string{}
+	offset := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return count, err
+		}
+		limit := batchSize
+		attachments, err := s.ListAttachments(ctx, &store.FindAttachment{
+			Limit:  &limit,
+			Offset: &offset,
+		})
+		if err != nil {
+			return count, errors.Wrap(err, "failed to list attachments")
+		}
+		if len(attachments) == 0 {
+			break
+		}
+
+		for _, attachment := range attachments {
+			if attachment.ContentHash == "" {
+				continue
+			}
+			var contentHash string
+			switch attachment.StorageType {
+			case storepb.AttachmentStorageType_LOCAL:
+				hash, ok := fileHashes[attachment.Reference]
+				if !ok {
+					hash, err = hashAttachmentFile(profile, attachment)
+					if err != nil {
+						// The missing files are reported by the doctor.
+						if !os.IsNotExist(errors.Cause(err)) {
+							slog.Warn("Failed to hash attachment file", "attachment", attachment.UID, "error", err)
+						}
+						continue
+					}
+					fileHashes[attachment.Reference] = hash
+				}
+				contentHash = hash
+			case storepb.AttachmentStorageType_ATTACHMENT_STORAGE_TYPE_UNSPECIFIED:
+				blob, err := attachmenttext.ReadAttachmentBlob(ctx, s, profile, attachment)
+				if err != nil {
+					slog.Warn("Failed to read attachment", "attachment", attachment.UID, "error", err)
+					continue
+				}
+				hash := sha256.Sum256(blob)
+				contentHash = hex.EncodeToString(hash[:])
+			default:
+				continue
+			}
+
+			corrupted := contentHash != attachment.ContentHash
+			if corrupted {
+				slog.Warn("Attachment content does not match its hash", "attachment", attachment.UID)
+				count++
+			}
+			if corrupted == (attachment.Payload.GetCorruptionDetectedTime() != nil) {
+				continue
+			}
+			payload := &storepb.AttachmentPayload{}
+			if attachment.Payload != nil {
+				payload = proto.Clone(attachment.Payload).(*storepb.AttachmentPayload)
+			}
+			payload.CorruptionDetectedTime = nil
+			if corrupted {
+				payload.CorruptionDetectedTime = timestamppb.Now()
+			}
+			if err := s.UpdateAttachment(ctx, &store.UpdateAttachment{
+				ID:      attachment.ID,
+				Payload: payload,
+			}); err != nil {
+				return count, errors.Wrap(err, "failed to update attachment")
+			}
+		}
+
+		offset += len(attachments)
+	}
+	return count, nil
+}
+
+// hashAttachmentFile returns the SHA-256 hash of the local file of the attachment, read without loading it at once.
+func hashAttachmentFile(profile *profile.Profile, attachment *store.Attachment) (string, error) {
+	attachmentPath := filepath.FromSlash(attachment.Reference)
+	if !filepath.IsAbs(attachmentPath) {
+		attachmentPath = filepath.Join(profile.Data, attachmentPath)
+	}
+	file, err := os.Open(attachmentPath)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to open the file")
+	}
+	defer file.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", errors.Wrap(err, "failed to read the file")
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
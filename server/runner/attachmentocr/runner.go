@@ -0,0 +1,140 @@
+package attachmentocr
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/ocr"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/attachmenttext"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store   *store.Store
+	Profile *profile.Profile
+}
+
+func NewRunner(store *store.Store, profile *profile.Profile) *Runner {
+	return &Runner{
+		Store:   store,
+		Profile: profile,
+	}
+}
+
+// Schedule runner every 10 minutes.
+const runnerInterval = time.Minute * 10
+
+const (
+	// batchSize is the number of attachments loaded at once.
+	batchSize = 100
+	// maxImageSize is the size above which images are not recognized.
+	maxImageSize = 20 << 20
+)
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	workspaceOCRSetting, err := r.Store.GetWorkspaceOCRSetting(ctx)
+	if err != nil {
+		slog.Error("Failed to get workspace OCR setting", "error", err)
+		return
+	}
+	if !workspaceOCRSetting.Enabled {
+		return
+	}
+	provider, err := ocr.NewProvider(workspaceOCRSetting)
+	if err != nil {
+		slog.Error("Failed to create OCR provider", "error", err)
+		return
+	}
+	count, err := RecognizeAttachments(ctx, r.Store, r.Profile, provider)
+	if err != nil {
+		slog.Error("Failed to recognize image attachments", "error", err)
+	}
+	if count > 0 {
+		slog.Info("Recognized image attachments", "count", count)
+	}
+}
+
+// RecognizeAttachments indexes the text of the memo image attachments not indexed yet,
+// and returns the number of indexed attachments.
+// Images failing to be recognized, e.g. while the OCR service is unavailable, are retried on the next run.
+func RecognizeAttachments(ctx context.Context, s *store.Store, profile *profile.Profile, provider ocr.Provider) (int, error) {
+	count := 0
+	offset := 0
+	for {
+		limit := batchSize
+		attachments, err := s.ListAttachments(ctx, &store.FindAttachment{
+			HasRelatedMemo: true,
+			Limit:          &limit,
+			Offset:         &offset,
+		})
+		if err != nil {
+			return count, errors.Wrap(err, "failed to list attachments")
+		}
+		if len(attachments) == 0 {
+			break
+		}
+
+		attachmentIDs := []int32{}
+		for _, attachment := range attachments {
+			attachmentIDs = append(attachmentIDs, attachment.ID)
+		}
+		attachmentTexts, err := s.ListAttachmentTexts(ctx, &store.FindAttachmentText{
+			AttachmentIDList: attachmentIDs,
+		})
+		if err != nil {
+			return count, errors.Wrap(err, "failed to list attachment texts")
+		}
+		indexed := map[int32]bool{}
+		for _, attachmentText := range attachmentTexts {
+			indexed[attachmentText.AttachmentID] = true
+		}
+
+		for _, attachment := range attachments {
+			if indexed[attachment.ID] || !ocr.IsSupported(attachment.Type) || attachment.StorageType == storepb.AttachmentStorageType_EXTERNAL {
+				continue
+			}
+			content := ""
+			if attachment.Size <= maxImageSize {
+				blob, err := attachmenttext.ReadAttachmentBlob(ctx, s, profile, attachment)
+				if err != nil {
+					slog.Warn("Failed to read attachment", "attachment", attachment.UID, "error", err)
+					continue
+				}
+				content, err = provider.Recognize(ctx, attachment.Type, blob)
+				if err != nil {
+					slog.Warn("Failed to recognize attachment", "attachment", attachment.UID, "error", err)
+					continue
+				}
+			}
+			if _, err := s.UpsertAttachmentText(ctx, &store.AttachmentText{
+				AttachmentID: attachment.ID,
+				Content:      content,
+			}); err != nil {
+				return count, errors.Wrap(err, "failed to upsert attachment text")
+			}
+			count++
+		}
+
+		offset += len(attachments)
+	}
+	return count, nil
+}
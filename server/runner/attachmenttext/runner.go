@@ -0,0 +1,226 @@
+package attachmenttext
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/storage/gcs"
+	"github.com/usememos/memos/plugin/storage/s3"
+	"github.com/usememos/memos/plugin/storage/sftp"
+	"github.com/usememos/memos/plugin/textextract"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store   *store.Store
+	Profile *profile.Profile
+}
+
+func NewRunner(store *store.Store, profile *profile.Profile) *Runner {
+	return &Runner{
+		Store:   store,
+		Profile: profile,
+	}
+}
+
+// Schedule runner every 10 minutes.
+const runnerInterval = time.Minute * 10
+
+const (
+	// batchSize is the number of attachments loaded at once.
+	batchSize = 100
+	// maxAttachmentSize is the size above which the text of attachments is not extracted.
+	maxAttachmentSize = 32 << 20
+)
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	count, err := IndexAttachments(ctx, r.Store, r.Profile)
+	if err != nil {
+		slog.Error("Failed to index attachment texts", "error", err)
+	}
+	if count > 0 {
+		slog.Info("Indexed attachment texts", "count", count)
+	}
+}
+
+// IndexAttachments extracts the text of the memo attachments not indexed yet,
+// and returns the number of indexed attachments.
+// Attachments whose text cannot be extracted are indexed with an empty text so that they are not retried.
+func IndexAttachments(ctx context.Context, s *store.Store, profile *profile.Profile) (int, error) {
+	count := 0
+	offset := 0
+	for {
+		limit := batchSize
+		attachments, err := s.ListAttachments(ctx, &store.FindAttachment{
+			HasRelatedMemo: true,
+			Limit:          &limit,
+			Offset:         &offset,
+		})
+		if err != nil {
+			return count, errors.Wrap(err, "failed to list attachments")
+		}
+		if len(attachments) == 0 {
+			break
+		}
+
+		attachmentIDs := []int32{}
+		for _, attachment := range attachments {
+			attachmentIDs = append(attachmentIDs, attachment.ID)
+		}
+		attachmentTexts, err := s.ListAttachmentTexts(ctx, &store.FindAttachmentText{
+			AttachmentIDList: attachmentIDs,
+		})
+		if err != nil {
+			return count, errors.Wrap(err, "failed to list attachment texts")
+		}
+		indexed := map[int32]bool{}
+		for _, attachmentText := range attachmentTexts {
+			indexed[attachmentText.AttachmentID] = true
+		}
+
+		for _, attachment := range attachments {
+			if indexed[attachment.ID] || !textextract.IsSupported(attachment.Type) {
+				continue
+			}
+			content := ""
+			// The content of external attachments is not stored by memos.
+			if attachment.StorageType != storepb.AttachmentStorageType_EXTERNAL && attachment.Size <= maxAttachmentSize {
+				blob, err := ReadAttachmentBlob(ctx, s, profile, attachment)
+				if err != nil {
+					// The storage may be temporarily unavailable, retry on the next run.
+					slog.Warn("Failed to read attachment", "attachment", attachment.UID, "error", err)
+					continue
+				}
+				content, err = textextract.Extract(attachment.Type, blob)
+				if err != nil {
+					slog.Warn("Failed to extract attachment text", "attachment", attachment.UID, "error", err)
+				}
+			}
+			if _, err := s.UpsertAttachmentText(ctx, &store.AttachmentText{
+				AttachmentID: attachment.ID,
+				Content:      content,
+			}); err != nil {
+				return count, errors.Wrap(err, "failed to upsert attachment text")
+			}
+			count++
+		}
+
+		offset += len(attachments)
+	}
+	return count, nil
+}
+
+// ReadAttachmentBlob returns the content of the attachment from its storage.
+func ReadAttachmentBlob(ctx context.Context, s *store.Store, profile *profile.Profile, attachment *store.Attachment) ([]byte, error) {
+	switch attachment.StorageType {
+	case storepb.AttachmentStorageType_LOCAL:
+		attachmentPath := filepath.FromSlash(attachment.Reference)
+		if !filepath.IsAbs(attachmentPath) {
+			attachmentPath = filepath.Join(profile.Data, attachmentPath)
+		}
+		file, err := os.Open(attachmentPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to open the file")
+		}
+		defer file.Close()
+		return io.ReadAll(file)
+	case storepb.AttachmentStorageType_S3:
+		s3ObjectPayload := attachment.Payload.GetS3Object()
+		if s3ObjectPayload == nil {
+			return nil, errors.New("no s3 object found")
+		}
+		s3Config := s3ObjectPayload.S3Config
+		if s3Config == nil {
+			workspaceStorageSetting, err := s.GetWorkspaceStorageSetting(ctx)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get workspace storage setting")
+			}
+			if workspaceStorageSetting.S3Config == nil {
+				return nil, errors.New("s3 config is not found")
+			}
+			s3Config = workspaceStorageSetting.S3Config
+		}
+		s3Client, err := s3.NewClient(ctx, s3Config)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create s3 client")
+		}
+		return s3Client.GetObject(ctx, s3ObjectPayload.Key)
+	case storepb.AttachmentStorageType_GCS:
+		gcsObjectPayload := attachment.Payload.GetGcsObject()
+		if gcsObjectPayload == nil {
+			return nil, errors.New("no gcs object found")
+		}
+		gcsConfig := gcsObjectPayload.GcsConfig
+		if gcsConfig == nil {
+			workspaceStorageSetting, err := s.GetWorkspaceStorageSetting(ctx)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get workspace storage setting")
+			}
+			if workspaceStorageSetting.GcsConfig == nil {
+				return nil, errors.New("gcs config is not found")
+			}
+			gcsConfig = workspaceStorageSetting.GcsConfig
+		}
+		gcsClient, err := gcs.NewClient(ctx, gcsConfig)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create gcs client")
+		}
+		return gcsClient.GetObject(ctx, gcsObjectPayload.Key)
+	case storepb.AttachmentStorageType_SFTP:
+		sftpObjectPayload := attachment.Payload.GetSftpObject()
+		if sftpObjectPayload == nil {
+			return nil, errors.New("no sftp object found")
+		}
+		sftpConfig := sftpObjectPayload.SftpConfig
+		if sftpConfig == nil {
+			workspaceStorageSetting, err := s.GetWorkspaceStorageSetting(ctx)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get workspace storage setting")
+			}
+			if workspaceStorageSetting.SftpConfig == nil {
+				return nil, errors.New("sftp config is not found")
+			}
+			sftpConfig = workspaceStorageSetting.SftpConfig
+		}
+		sftpClient, err := sftp.NewClient(ctx, sftpConfig)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create sftp client")
+		}
+		defer sftpClient.Close()
+		return sftpClient.GetObject(ctx, sftpObjectPayload.Path)
+	default:
+		attachment, err := s.GetAttachment(ctx, &store.FindAttachment{
+			ID:      &attachment.ID,
+			GetBlob: true,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get attachment")
+		}
+		if attachment == nil {
+			return nil, errors.New("attachment not found")
+		}
+		return attachment.Blob, nil
+	}
+}
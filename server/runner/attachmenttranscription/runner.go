@@ -0,0 +1,180 @@
+package attachmenttranscription
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/transcription"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/attachmenttext"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store   *store.Store
+	Profile *profile.Profile
+}
+
+func NewRunner(store *store.Store, profile *profile.Profile) *Runner {
+	return &Runner{
+		Store:   store,
+		Profile: profile,
+	}
+}
+
+// Schedule runner every 10 minutes.
+const runnerInterval = time.Minute * 10
+
+const (
+	// batchSize is the number of attachments loaded at once.
+	batchSize = 100
+	// maxAudioSize is the size above which recordings are not transcribed, the upload limit of the OpenAI API.
+	maxAudioSize = 25 << 20
+)
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	workspaceTranscriptionSetting, err := r.Store.GetWorkspaceTranscriptionSetting(ctx)
+	if err != nil {
+		slog.Error("Failed to get workspace transcription setting", "error", err)
+		return
+	}
+	if !workspaceTranscriptionSetting.Enabled {
+		return
+	}
+	provider, err := transcription.NewProvider(workspaceTranscriptionSetting, r.Profile.FFmpegPath)
+	if err != nil {
+		slog.Error("Failed to create transcription provider", "error", err)
+		return
+	}
+	count, err := TranscribeAttachments(ctx, r.Store, r.Profile, provider)
+	if err != nil {
+		slog.Error("Failed to transcribe audio attachments", "error", err)
+	}
+	if count > 0 {
+		slog.Info("Transcribed audio attachments", "count", count)
+	}
+}
+
+// TranscribeAttachments indexes the transcript of the memo audio attachments not indexed yet,
+// keeps it in the payload of their memo, and returns the number of indexed attachments.
+// Recordings failing to be transcribed, e.g. while the transcription service is unavailable, are retried on the next run.
+func TranscribeAttachments(ctx context.Context, s *store.Store, profile *profile.Profile, provider transcription.Provider) (int, error) {
+	count := 0
+	offset := 0
+	for {
+		limit := batchSize
+		attachments, err := s.ListAttachments(ctx, &store.FindAttachment{
+			HasRelatedMemo: true,
+			Limit:          &limit,
+			Offset:         &offset,
+		})
+		if err != nil {
+			return count, errors.Wrap(err, "failed to list attachments")
+		}
+		if len(attachments) == 0 {
+			break
+		}
+
+		attachmentIDs := []int32{}
+		for _, attachment := range attachments {
+			attachmentIDs = append(attachmentIDs, attachment.ID)
+		}
+		attachmentTexts, err := s.ListAttachmentTexts(ctx, &store.FindAttachmentText{
+			AttachmentIDList: attachmentIDs,
+		})
+		if err != nil {
+			return count, errors.Wrap(err, "failed to list attachment texts")
+		}
+		indexed := map[int32]bool{}
+		for _, attachmentText := range attachmentTexts {
+			indexed[attachmentText.AttachmentID] = true
+		}
+
+		for _, attachment := range attachments {
+			if indexed[attachment.ID] || !transcription.IsSupported(attachment.Type) || attachment.StorageType == storepb.AttachmentStorageType_EXTERNAL {
+				continue
+			}
+			content := ""
+			if attachment.Size <= maxAudioSize {
+				blob, err := attachmenttext.ReadAttachmentBlob(ctx, s, profile, attachment)
+				if err != nil {
+					slog.Warn("Failed to read attachment", "attachment", attachment.UID, "error", err)
+					continue
+				}
+				content, err = provider.Transcribe(ctx, attachment.Type, attachment.Filename, blob)
+				if err != nil {
+					slog.Warn("Failed to transcribe attachment", "attachment", attachment.UID, "error", err)
+					continue
+				}
+			}
+			if content != "" {
+				if err := setMemoTranscript(ctx, s, attachment, content); err != nil {
+					return count, err
+				}
+			}
+			if _, err := s.UpsertAttachmentText(ctx, &store.AttachmentText{
+				AttachmentID: attachment.ID,
+				Content:      content,
+			}); err != nil {
+				return count, errors.Wrap(err, "failed to upsert attachment text")
+			}
+			count++
+		}
+
+		offset += len(attachments)
+	}
+	return count, nil
+}
+
+// setMemoTranscript keeps the transcript of the attachment in the payload of its memo.
+func setMemoTranscript(ctx context.Context, s *store.Store, attachment *store.Attachment, text string) error {
+	memo, err := s.GetMemo(ctx, &store.FindMemo{ID: attachment.MemoID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo")
+	}
+	if memo == nil {
+		return nil
+	}
+	payload := memo.Payload
+	if payload == nil {
+		payload = &storepb.MemoPayload{}
+	}
+	found := false
+	for _, transcript := range payload.Transcripts {
+		if transcript.Attachment == attachment.UID {
+			transcript.Text = text
+			found = true
+		}
+	}
+	if !found {
+		payload.Transcripts = append(payload.Transcripts, &storepb.MemoPayload_Transcript{
+			Attachment: attachment.UID,
+			Text:       text,
+		})
+	}
+	if err := s.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:      memo.ID,
+		Payload: payload,
+	}); err != nil {
+		return errors.Wrap(err, "failed to update memo")
+	}
+	return nil
+}
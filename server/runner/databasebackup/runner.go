@@ -0,0 +1,85 @@
+// Package databasebackup copies the SQLite database into the backups directory of the data directory, keeping
+// the latest copies. The MySQL and PostgreSQL databases are backed up with their own tools.
+package databasebackup
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// backupDir is the directory of the backups, relative to the data directory.
+	backupDir = "backups"
+	// maxBackups is the number of backups kept, the older ones being deleted.
+	maxBackups = 7
+)
+
+type Runner struct {
+	Store   *store.Store
+	Profile *profile.Profile
+}
+
+func NewRunner(store *store.Store, profile *profile.Profile) *Runner {
+	return &Runner{
+		Store:   store,
+		Profile: profile,
+	}
+}
+
+// RunOnce backs up the database, run on the schedule of the database backup task.
+func (r *Runner) RunOnce(ctx context.Context) error {
+	path, err := Backup(ctx, r.Store, r.Profile, time.Now())
+	if err != nil {
+		return err
+	}
+	slog.Info("Backed up database", "path", path)
+	return nil
+}
+
+// Backup copies the database into a new file of the backups directory, named after the time, and deletes the oldest
+// backups beyond the number kept. It returns the path of the backup.
+func Backup(ctx context.Context, s *store.Store, profile *profile.Profile, now time.Time) (string, error) {
+	if profile.Driver != "sqlite" {
+		return "", errors.Errorf("database backups are not supported by the %s driver", profile.Driver)
+	}
+	dir := filepath.Join(profile.Data, backupDir)
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return "", errors.Wrap(err, "failed to create backup directory")
+	}
+	path := filepath.Join(dir, fmt.Sprintf("memos_%s_%s.db", profile.Mode, now.UTC().Format("20060102T150405Z")))
+	// VACUUM INTO writes a consistent copy of the database while the server keeps writing to it.
+	if _, err := s.GetDriver().GetDB().ExecContext(ctx, "VACUUM INTO ?", path); err != nil {
+		return "", errors.Wrap(err, "failed to back up database")
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return path, errors.Wrap(err, "failed to read backup directory")
+	}
+	backups := []string{}
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasPrefix(entry.Name(), "memos_"+profile.Mode+"_") && strings.HasSuffix(entry.Name(), ".db") {
+			backups = append(backups, entry.Name())
+		}
+	}
+	// The names sort by time, the oldest first.
+	slices.Sort(backups)
+	for len(backups) > maxBackups {
+		if err := os.Remove(filepath.Join(dir, backups[0])); err != nil {
+			return path, errors.Wrap(err, "failed to delete old backup")
+		}
+		backups = backups[1:]
+	}
+	return path, nil
+}
@@ -0,0 +1,157 @@
+package discordbot
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usememos/memos/plugin/discord"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// settingCheckInterval is the interval of the checks of the workspace Discord setting, which restart the bot once changed.
+	settingCheckInterval = time.Minute
+	// minReconnectDelay and maxReconnectDelay bound the backoff of the reconnections to the gateway.
+	minReconnectDelay = 5 * time.Second
+	maxReconnectDelay = 5 * time.Minute
+)
+
+// Runner runs the Discord bot of the workspace Discord setting: it registers the commands of the bot,
+// and listens to the reactions on the gateway to save the messages with the handler.
+type Runner struct {
+	Store    *store.Store
+	Handler  discord.Handler
+	Commands []*discord.Command
+
+	// registeredSetting is the setting the commands were last registered with.
+	registeredSetting *storepb.WorkspaceDiscordSetting
+}
+
+func NewRunner(store *store.Store, handler discord.Handler, commands []*discord.Command) *Runner {
+	return &Runner{
+		Store:    store,
+		Handler:  handler,
+		Commands: commands,
+	}
+}
+
+func (r *Runner) Run(ctx context.Context) {
+	reconnectDelay := minReconnectDelay
+	for {
+		setting, err := r.Store.GetWorkspaceDiscordSetting(ctx)
+		if err != nil {
+			slog.Error("Failed to get workspace discord setting", "error", err)
+		} else {
+			r.registerCommands(ctx, setting)
+		}
+
+		delay := settingCheckInterval
+		if setting != nil && setting.BotToken != "" && setting.SaveReaction != "" {
+			start := time.Now()
+			err := r.listen(ctx, setting)
+			if ctx.Err() != nil {
+				return
+			}
+			// The connections lasting long enough reset the backoff, as do the restarts for a new setting.
+			if err == nil || time.Since(start) > maxReconnectDelay {
+				reconnectDelay = minReconnectDelay
+			}
+			delay = 0
+			if err != nil {
+				slog.Warn("Disconnected from Discord gateway", "error", err, "retryIn", reconnectDelay)
+				delay = reconnectDelay
+				reconnectDelay = min(reconnectDelay*2, maxReconnectDelay)
+			}
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// registerCommands registers the commands of the bot once per setting, if its interactions endpoint is enabled.
+func (r *Runner) registerCommands(ctx context.Context, setting *storepb.WorkspaceDiscordSetting) {
+	if setting.ApplicationId == "" || setting.BotToken == "" || setting.PublicKey == "" {
+		return
+	}
+	if r.registeredSetting != nil && r.registeredSetting.ApplicationId == setting.ApplicationId && r.registeredSetting.BotToken == setting.BotToken {
+		return
+	}
+	client := &discord.Client{Token: setting.BotToken, APIEndpoint: setting.ApiEndpoint}
+	if err := client.RegisterCommands(ctx, setting.ApplicationId, r.Commands); err != nil {
+		slog.Error("Failed to register Discord commands", "error", err)
+		return
+	}
+	r.registeredSetting = setting
+}
+
+// listen connects to the gateway until the setting changes, in which case it returns nil, or the connection fails.
+func (r *Runner) listen(ctx context.Context, setting *storepb.WorkspaceDiscordSetting) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	settingChanged := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(settingCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				current, err := r.Store.GetWorkspaceDiscordSetting(ctx)
+				if err == nil && !proto.Equal(current, setting) {
+					close(settingChanged)
+					cancel()
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	client := &discord.Client{Token: setting.BotToken, APIEndpoint: setting.ApiEndpoint}
+	err := client.ConnectGateway(ctx, discord.IntentGuildMessageReactions|discord.IntentDirectMessageReactions, func(ctx context.Context, eventType string, data json.RawMessage) {
+		if eventType != discord.EventMessageReactionAdd {
+			return
+		}
+		event := &discord.ReactionEvent{}
+		if err := json.Unmarshal(data, event); err != nil {
+			slog.Warn("Failed to unmarshal Discord reaction", "error", err)
+			return
+		}
+		if event.Emoji.Name != setting.SaveReaction || (event.Member != nil && event.Member.User != nil && event.Member.User.Bot) {
+			return
+		}
+		if err := r.saveMessage(ctx, client, event); err != nil {
+			if errors.Is(err, discord.ErrUserNotLinked) {
+				slog.Debug("Skipped Discord reaction of unlinked user", "user", event.UserID)
+				return
+			}
+			slog.Warn("Failed to save Discord message", "message", event.MessageID, "error", err)
+		}
+	})
+	select {
+	case <-settingChanged:
+		return nil
+	default:
+		return err
+	}
+}
+
+func (r *Runner) saveMessage(ctx context.Context, client *discord.Client, event *discord.ReactionEvent) error {
+	message, err := client.GetMessage(ctx, event.ChannelID, event.MessageID)
+	if err != nil {
+		return err
+	}
+	// The messages fetched from the API do not tell their guild.
+	message.GuildID = event.GuildID
+	return r.Handler.SaveMessage(ctx, event.UserID, message)
+}
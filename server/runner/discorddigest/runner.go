@@ -0,0 +1,216 @@
+package discorddigest
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/cron"
+	"github.com/usememos/memos/plugin/discord"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// defaultSchedule posts the digest every day at 9:00 UTC.
+	defaultSchedule = "0 9 * * *"
+	// maxDigestMemos is the maximum number of memos listed by a digest.
+	maxDigestMemos = 50
+	// maxTitleLength is the maximum number of characters of the titles of the memos in the digest.
+	maxTitleLength = 80
+)
+
+// Runner posts the digest of the new public memos, and optionally the protected ones, to the channel
+// of the workspace Discord setting on its schedule.
+type Runner struct {
+	Store   *store.Store
+	Profile *profile.Profile
+
+	// schedule is the schedule of the digests, parsed from spec, and next the time of the next digest.
+	spec     string
+	schedule cron.Schedule
+	next     time.Time
+}
+
+func NewRunner(store *store.Store, profile *profile.Profile) *Runner {
+	return &Runner{
+		Store:   store,
+		Profile: profile,
+	}
+}
+
+// Check the schedule every minute.
+const runnerInterval = time.Minute
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.tick(ctx, time.Now().UTC())
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// tick posts the digest once its time has come, with the memos created during the period of the schedule
+// before it, e.g. the last day for the daily digests. The digests missed while the server was down are not caught up with.
+func (r *Runner) tick(ctx context.Context, now time.Time) {
+	setting, err := r.Store.GetWorkspaceDiscordSetting(ctx)
+	if err != nil {
+		slog.Error("Failed to get workspace discord setting", "error", err)
+		return
+	}
+	if setting.BotToken == "" || setting.DigestChannelId == "" {
+		r.schedule = nil
+		return
+	}
+	spec := setting.DigestSchedule
+	if spec == "" {
+		spec = defaultSchedule
+	}
+	if r.schedule == nil || spec != r.spec {
+		schedule, err := cron.ParseStandard(spec)
+		if err != nil {
+			slog.Error("Invalid Discord digest schedule", "schedule", spec, "error", err)
+			return
+		}
+		r.spec, r.schedule, r.next = spec, schedule, schedule.Next(now)
+	}
+	if now.Before(r.next) {
+		return
+	}
+
+	digestTime := r.next
+	r.next = r.schedule.Next(now)
+	since := digestTime.Add(-r.schedule.Next(digestTime).Sub(digestTime))
+	if err := r.RunOnce(ctx, since, digestTime); err != nil {
+		slog.Error("Failed to post Discord digest", "error", err)
+	}
+}
+
+// RunOnce posts the digest of the memos created between since and until, unless there are none.
+func (r *Runner) RunOnce(ctx context.Context, since, until time.Time) error {
+	setting, err := r.Store.GetWorkspaceDiscordSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace discord setting")
+	}
+	if setting.BotToken == "" || setting.DigestChannelId == "" {
+		return nil
+	}
+
+	visibilities := []store.Visibility{store.Public}
+	if setting.DigestIncludeProtected {
+		visibilities = append(visibilities, store.Protected)
+	}
+	normalStatus := store.Normal
+	personalSpaceID := store.PersonalSpaceID
+	createdTsAfter, createdTsBefore := since.Unix()-1, until.Unix()
+	limit := maxDigestMemos + 1
+	memos, err := r.Store.ListMemos(ctx, &store.FindMemo{
+		RowStatus:       &normalStatus,
+		SpaceID:         &personalSpaceID,
+		VisibilityList:  visibilities,
+		CreatedTsAfter:  &createdTsAfter,
+		CreatedTsBefore: &createdTsBefore,
+		ExcludeComments: true,
+		OrderByTimeAsc:  true,
+		Limit:           &limit,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memos")
+	}
+
+	lines := []string{}
+	for _, memo := range memos {
+		creator, err := r.getVisibleCreator(ctx, memo.CreatorID)
+		if err != nil {
+			return err
+		}
+		if creator == nil {
+			continue
+		}
+		lines = append(lines, r.formatMemo(memo, creator))
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	client := &discord.Client{Token: setting.BotToken, APIEndpoint: setting.ApiEndpoint}
+	return client.CreateMessage(ctx, setting.DigestChannelId, buildDigest(lines, len(memos) > maxDigestMemos))
+}
+
+// getVisibleCreator returns the creator of the memos, nil if the creator is suspended and their memos hidden.
+func (r *Runner) getVisibleCreator(ctx context.Context, creatorID int32) (*store.User, error) {
+	creator, err := r.Store.GetUser(ctx, &store.FindUser{ID: &creatorID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get memo creator")
+	}
+	if creator == nil {
+		return nil, nil
+	}
+	suspension, err := r.Store.GetUserSuspension(ctx, creatorID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user suspension")
+	}
+	if suspension.Suspended {
+		return nil, nil
+	}
+	return creator, nil
+}
+
+// formatMemo returns the line of the memo in the digest, with the link of the memo if the instance URL is known.
+func (r *Runner) formatMemo(memo *store.Memo, creator *store.User) string {
+	title := getMemoTitle(memo.Content)
+	if r.Profile.InstanceURL != "" {
+		title = fmt.Sprintf("[%s](<%s/memos/%s>)", title, strings.TrimSuffix(r.Profile.InstanceURL, "/"), memo.UID)
+	}
+	name := creator.Nickname
+	if name == "" {
+		name = creator.Username
+	}
+	visibility := ""
+	if memo.Visibility == store.Protected {
+		visibility = " (workspace)"
+	}
+	return fmt.Sprintf("- %s by %s%s", title, escapeMarkdown(name), visibility)
+}
+
+// buildDigest returns the message of the digest, with as many lines as fit in a Discord message.
+func buildDigest(lines []string, truncated bool) string {
+	var builder strings.Builder
+	builder.WriteString("**Memos digest**\n")
+	for i, line := range lines {
+		if utf8.RuneCountInString(builder.String())+utf8.RuneCountInString(line)+64 > discord.MaxMessageLength {
+			fmt.Fprintf(&builder, "…and %d more.", len(lines)-i)
+			return builder.String()
+		}
+		builder.WriteString(line + "\n")
+	}
+	if truncated {
+		builder.WriteString("…and more.")
+	}
+	return strings.TrimSuffix(builder.String(), "\n")
+}
+
+// getMemoTitle returns the first line of the plain text of the memo, shortened to maxTitleLength.
+func getMemoTitle(content string) string {
+	title := memopayload.GetMemoTitle(content, maxTitleLength)
+	if title == "" {
+		title = "Untitled memo"
+	}
+	return escapeMarkdown(title)
+}
+
+// escapeMarkdown escapes the characters of the Discord markdown, so that the titles and the names are shown as they are.
+func escapeMarkdown(text string) string {
+	return strings.NewReplacer(`\`, `\\`, "*", `\*`, "_", `\_`, "~", `\~`, "`", "\\`", "|", `\|`, "[", `\[`, "]", `\]`, ">", `\>`).Replace(text)
+}
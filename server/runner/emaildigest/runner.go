@@ -0,0 +1,284 @@
+package emaildigest
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"github.com/usememos/gomark/ast"
+	"github.com/usememos/gomark/parser"
+	"github.com/usememos/gomark/parser/tokenizer"
+	"github.com/usememos/gomark/renderer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/email"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/router/calendar"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// maxSectionItems is the maximum number of items listed by each section of a digest.
+	maxSectionItems = 20
+	// maxTitleLength is the maximum number of characters of the titles of the memos and the tasks in the digest.
+	maxTitleLength = 80
+)
+
+// Runner emails the users their digest on their schedule: the memos they created during the period, their open tasks,
+// their dated memos and tasks coming up during the next period, and their memos created on the same day in past years.
+type Runner struct {
+	Store   *store.Store
+	Profile *profile.Profile
+}
+
+func NewRunner(store *store.Store, profile *profile.Profile) *Runner {
+	return &Runner{
+		Store:   store,
+		Profile: profile,
+	}
+}
+
+// RunOnce emails the digests whose scheduled time has come by now, run on the schedule of the email digest task
+// which checks the schedules of the users. The digests missed while the server was down are sent once, for their
+// last scheduled time. The failures of the digests of the users are only logged.
+func (r *Runner) RunOnce(ctx context.Context, now time.Time) error {
+	emailSetting, err := r.Store.GetWorkspaceEmailSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace email setting")
+	}
+	if emailSetting.SmtpHost == "" {
+		return nil
+	}
+	userSettings, err := r.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSetting_EMAIL_DIGEST,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list email digest user settings")
+	}
+	for _, userSetting := range userSettings {
+		setting := userSetting.GetEmailDigest()
+		if setting.GetFrequency() == storepb.EmailDigestUserSetting_FREQUENCY_UNSPECIFIED {
+			continue
+		}
+		sendTime, period, err := getLastScheduledTime(setting, now)
+		if err != nil {
+			slog.Error("Invalid email digest schedule", "user", userSetting.UserId, "error", err)
+			continue
+		}
+		if !setting.LastSendTime.AsTime().Before(sendTime) {
+			continue
+		}
+		if err := r.sendDigest(ctx, emailSetting, userSetting.UserId, sendTime, period); err != nil {
+			slog.Error("Failed to send email digest", "user", userSetting.UserId, "error", err)
+			continue
+		}
+		// The schedule may have been changed in the meantime, only the digest sent is recorded.
+		current, err := r.Store.GetUserEmailDigest(ctx, userSetting.UserId)
+		if err != nil {
+			slog.Error("Failed to get user email digest", "user", userSetting.UserId, "error", err)
+			continue
+		}
+		if current.LastSendTime.AsTime().Before(sendTime) {
+			current.LastSendTime = timestamppb.New(sendTime)
+			if err := r.Store.UpsertUserEmailDigest(ctx, userSetting.UserId, current); err != nil {
+				slog.Error("Failed to upsert user email digest", "user", userSetting.UserId, "error", err)
+			}
+		}
+	}
+	return nil
+}
+
+// getLastScheduledTime returns the last scheduled time of the digest by now, and the period between two digests.
+func getLastScheduledTime(setting *storepb.EmailDigestUserSetting, now time.Time) (time.Time, time.Duration, error) {
+	location, err := time.LoadLocation(setting.TimeZone)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+	now = now.In(location)
+	scheduled := time.Date(now.Year(), now.Month(), now.Day(), int(setting.Hour), 0, 0, 0, location)
+	if setting.Frequency == storepb.EmailDigestUserSetting_WEEKLY {
+		scheduled = scheduled.AddDate(0, 0, -((int(scheduled.Weekday()) - int(setting.Weekday) + 7) % 7))
+		if scheduled.After(now) {
+			scheduled = scheduled.AddDate(0, 0, -7)
+		}
+		return scheduled, 7 * 24 * time.Hour, nil
+	}
+	if scheduled.After(now) {
+		scheduled = scheduled.AddDate(0, 0, -1)
+	}
+	return scheduled, 24 * time.Hour, nil
+}
+
+// sendDigest emails the digest of the period ending at the send time to the user, unless it has nothing to tell or
+// the user has no verified email.
+func (r *Runner) sendDigest(ctx context.Context, emailSetting *storepb.WorkspaceEmailSetting, userID int32, sendTime time.Time, period time.Duration) error {
+	user, err := r.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user")
+	}
+	if user == nil || user.RowStatus == store.Archived || user.Email == "" {
+		return nil
+	}
+	emailVerification, err := r.Store.GetUserEmailVerification(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get user email verification")
+	}
+	if emailVerification.Pending {
+		return nil
+	}
+
+	normalStatus := store.Normal
+	memos, err := r.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:       &userID,
+		RowStatus:       &normalStatus,
+		ExcludeComments: true,
+		OrderByTimeAsc:  true,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memos")
+	}
+	digest := r.buildDigest(memos, sendTime, period)
+	if digest.isEmpty() {
+		return nil
+	}
+
+	name := user.Nickname
+	if name == "" {
+		name = user.Username
+	}
+	frequency := "daily"
+	if period > 24*time.Hour {
+		frequency = "weekly"
+	}
+	return email.Send(&email.Config{
+		Host:      emailSetting.SmtpHost,
+		Port:      int(emailSetting.SmtpPort),
+		Username:  emailSetting.SmtpUsername,
+		Password:  emailSetting.SmtpPassword,
+		FromEmail: emailSetting.FromEmail,
+		FromName:  emailSetting.FromName,
+		TLS:       emailSetting.UseTls,
+	}, &email.Message{
+		To:      user.Email,
+		Subject: fmt.Sprintf("Your %s memos digest", frequency),
+		Body:    fmt.Sprintf("Hello %s,\n\nHere is your %s digest of %s.\n\n%s\nChoose the schedule of the digest in your settings.\n", name, frequency, sendTime.Format("Monday, January 2"), digest.String()),
+	})
+}
+
+// digest is the summary of the memos of a user.
+type digest struct {
+	created   []string
+	tasks     []string
+	upcoming  []string
+	onThisDay []string
+}
+
+// buildDigest summarizes the memos for the digest sent at the send time, in its time zone.
+func (r *Runner) buildDigest(memos []*store.Memo, sendTime time.Time, period time.Duration) *digest {
+	baseURL := strings.TrimSuffix(r.Profile.InstanceURL, "/")
+	location := sendTime.Location()
+	since, until := sendTime.Add(-period), sendTime.Add(period)
+	digest := &digest{}
+	for _, memo := range memos {
+		link := ""
+		if baseURL != "" {
+			link = fmt.Sprintf(" (%s/memos/%s)", baseURL, memo.UID)
+		}
+		createdTime := time.Unix(memo.CreatedTs, 0).In(location)
+		if !createdTime.Before(since) && createdTime.Before(sendTime) {
+			digest.created = append(digest.created, getMemoTitle(memo.Content)+link)
+		}
+		if createdTime.Year() < sendTime.Year() && createdTime.Month() == sendTime.Month() && createdTime.Day() == sendTime.Day() {
+			digest.onThisDay = append(digest.onThisDay, fmt.Sprintf("%d: %s%s", createdTime.Year(), getMemoTitle(memo.Content), link))
+		}
+		if memo.Payload.GetProperty().GetHasIncompleteTasks() {
+			for _, task := range getIncompleteTasks(memo.Content) {
+				digest.tasks = append(digest.tasks, task+link)
+			}
+		}
+		// The dates of the memos and the tasks are in the time zone of the user.
+		for _, event := range calendar.GetMemoEvents(memo, baseURL) {
+			start := time.Date(event.Start.Year(), event.Start.Month(), event.Start.Day(), event.Start.Hour(), event.Start.Minute(), 0, 0, location)
+			if start.Before(sendTime) || !start.Before(until) {
+				continue
+			}
+			layout := "Mon Jan 2 15:04"
+			if event.AllDay {
+				layout = "Mon Jan 2"
+			}
+			digest.upcoming = append(digest.upcoming, fmt.Sprintf("%s: %s%s", start.Format(layout), truncate(event.Summary), link))
+		}
+	}
+	slices.Reverse(digest.onThisDay)
+	slices.Sort(digest.upcoming)
+	return digest
+}
+
+func (d *digest) isEmpty() bool {
+	return len(d.created) == 0 && len(d.tasks) == 0 && len(d.upcoming) == 0 && len(d.onThisDay) == 0
+}
+
+// String returns the sections of the digest having items.
+func (d *digest) String() string {
+	var builder strings.Builder
+	writeSection := func(title string, items []string) {
+		if len(items) == 0 {
+			return
+		}
+		fmt.Fprintf(&builder, "%s (%d)\n", title, len(items))
+		for i, item := range items {
+			if i == maxSectionItems {
+				fmt.Fprintf(&builder, "…and %d more.\n", len(items)-i)
+				break
+			}
+			builder.WriteString("- " + item + "\n")
+		}
+		builder.WriteString("\n")
+	}
+	writeSection("Memos created", d.created)
+	writeSection("Open tasks", d.tasks)
+	writeSection("Coming up", d.upcoming)
+	writeSection("On this day", d.onThisDay)
+	return builder.String()
+}
+
+// getIncompleteTasks returns the text of the incomplete tasks of the content.
+func getIncompleteTasks(content string) []string {
+	nodes, err := parser.Parse(tokenizer.Tokenize(content))
+	if err != nil {
+		return nil
+	}
+	tasks := []string{}
+	memopayload.TraverseASTNodes(nodes, func(node ast.Node) {
+		task, ok := node.(*ast.TaskListItem)
+		if !ok || task.Complete {
+			return
+		}
+		if text := strings.TrimSpace(renderer.NewStringRenderer().Render(task.Children)); text != "" {
+			tasks = append(tasks, truncate(text))
+		}
+	})
+	return tasks
+}
+
+// getMemoTitle returns the first line of the plain text of the memo, shortened to maxTitleLength.
+func getMemoTitle(content string) string {
+	if title := memopayload.GetMemoTitle(content, maxTitleLength); title != "" {
+		return title
+	}
+	return "Untitled memo"
+}
+
+func truncate(text string) string {
+	if utf8.RuneCountInString(text) > maxTitleLength {
+		return string([]rune(text)[:maxTitleLength]) + "…"
+	}
+	return text
+}
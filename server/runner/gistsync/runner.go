@@ -0,0 +1,225 @@
+package gistsync
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/gist"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// gistFilename is the name of the file of the gists holding the content of their memo.
+	gistFilename = "memo.md"
+	// maxDescriptionLength is the maximum number of characters of the descriptions of the gists.
+	maxDescriptionLength = 256
+)
+
+// Runner mirrors the memos tagged #gist of the connected GitHub accounts to their gists, and pulls the gists
+// edited on GitHub back into the memos with the handler.
+type Runner struct {
+	Store   *store.Store
+	Handler gist.Handler
+	// APIEndpoint is the endpoint of the GitHub API, the default one if empty.
+	APIEndpoint string
+}
+
+func NewRunner(store *store.Store, handler gist.Handler) *Runner {
+	return &Runner{
+		Store:   store,
+		Handler: handler,
+	}
+}
+
+// Schedule runner every 10 minutes.
+const runnerInterval = time.Minute * 10
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	userSettings, err := r.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSetting_GIST_SYNC,
+	})
+	if err != nil {
+		slog.Error("Failed to list gist sync user settings", "error", err)
+		return
+	}
+	for _, userSetting := range userSettings {
+		setting := userSetting.GetGistSync()
+		if setting.GetToken() == "" {
+			continue
+		}
+		user, err := r.Store.GetUser(ctx, &store.FindUser{ID: &userSetting.UserId})
+		if err != nil {
+			slog.Error("Failed to get user", "user", userSetting.UserId, "error", err)
+			continue
+		}
+		if user == nil || user.RowStatus == store.Archived {
+			continue
+		}
+		if err := r.syncUser(ctx, user.ID, setting); err != nil {
+			slog.Error("Failed to sync gists", "user", user.ID, "error", err)
+		}
+	}
+}
+
+// syncUser syncs the memos of the user tagged #gist with their gists. The gists synced before a failure are recorded.
+func (r *Runner) syncUser(ctx context.Context, userID int32, setting *storepb.GistSyncUserSetting) error {
+	memoGists := maps.Clone(setting.MemoGists)
+	if memoGists == nil {
+		memoGists = map[string]*storepb.GistSyncUserSetting_MemoGist{}
+	}
+	syncErr := r.sync(ctx, userID, setting.Token, memoGists)
+
+	// The account may have been disconnected or replaced during the sync.
+	current, err := r.Store.GetUserGistSync(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get user gist sync")
+	}
+	if current.Token != setting.Token {
+		return syncErr
+	}
+	current.MemoGists = memoGists
+	if syncErr != nil {
+		current.LastSyncError = syncErr.Error()
+	} else {
+		current.LastSyncTime = timestamppb.Now()
+		current.LastSyncError = ""
+	}
+	if err := r.Store.UpsertUserGistSync(ctx, userID, current); err != nil {
+		return errors.Wrap(err, "failed to upsert user gist sync")
+	}
+	return syncErr
+}
+
+// sync creates the gists of the new memos tagged #gist, pushes the memos updated since the last sync to their gists,
+// and pulls the gists updated on GitHub into their memos. The memo wins if both were updated. The memos no longer
+// tagged are no longer synced, but their gists are kept.
+func (r *Runner) sync(ctx context.Context, userID int32, token string, memoGists map[string]*storepb.GistSyncUserSetting_MemoGist) error {
+	normalStatus := store.Normal
+	memos, err := r.Store.ListMemos(ctx, &store.FindMemo{
+		CreatorID:   &userID,
+		RowStatus:   &normalStatus,
+		PayloadFind: &store.FindMemoPayload{TagSearch: []string{gist.Tag}},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memos")
+	}
+	tagged := map[string]bool{}
+	for _, memo := range memos {
+		tagged[memo.UID] = true
+	}
+	for memoUID := range memoGists {
+		if !tagged[memoUID] {
+			delete(memoGists, memoUID)
+		}
+	}
+
+	client := &gist.Client{Token: token, APIEndpoint: r.APIEndpoint}
+	for _, memo := range memos {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		memoGist := memoGists[memo.UID]
+		var remote *gist.Gist
+		if memoGist != nil {
+			remote, err = client.GetGist(ctx, memoGist.GistId)
+			if err != nil && !errors.Is(err, gist.ErrNotFound) {
+				return errors.Wrapf(err, "failed to get gist of memo %s", memo.UID)
+			}
+		}
+		// The gists deleted on GitHub are created again while their memo is tagged.
+		if remote == nil {
+			created, err := client.CreateGist(ctx, &gist.Gist{
+				Description: getGistDescription(memo.Content),
+				Public:      memo.Visibility == store.Public,
+				Files:       map[string]*gist.File{gistFilename: {Content: memo.Content}},
+			})
+			if err != nil {
+				return errors.Wrapf(err, "failed to create gist of memo %s", memo.UID)
+			}
+			memoGists[memo.UID] = newMemoGist(memo.Content, created)
+			continue
+		}
+
+		if getContentHash(memo.Content) != memoGist.ContentHash {
+			updated, err := client.UpdateGist(ctx, remote.ID, &gist.Gist{
+				Description: getGistDescription(memo.Content),
+				Files:       map[string]*gist.File{gistFilename: {Content: memo.Content}},
+			})
+			if err != nil {
+				return errors.Wrapf(err, "failed to update gist of memo %s", memo.UID)
+			}
+			memoGists[memo.UID] = newMemoGist(memo.Content, updated)
+			continue
+		}
+		if remote.UpdatedAt.Equal(memoGist.GistUpdateTime.AsTime()) {
+			continue
+		}
+		memoGists[memo.UID] = newMemoGist(r.pull(ctx, userID, memo, remote), remote)
+	}
+	return nil
+}
+
+// pull replaces the content of the memo with the file of the gist, and returns the content of the memo as updated.
+// The gists without the file or whose file is truncated by the API are not pulled, nor are the contents
+// refused for the memo, which are logged not to block the other memos.
+func (r *Runner) pull(ctx context.Context, userID int32, memo *store.Memo, remote *gist.Gist) string {
+	file := remote.Files[gistFilename]
+	if file == nil || file.Truncated || file.Content == memo.Content {
+		return memo.Content
+	}
+	if err := r.Handler.UpdateMemoContent(ctx, userID, memo.UID, file.Content); err != nil {
+		slog.Warn("Failed to pull gist into memo", "memo", memo.UID, "gist", remote.ID, "error", err)
+		return memo.Content
+	}
+	return file.Content
+}
+
+func newMemoGist(content string, remote *gist.Gist) *storepb.GistSyncUserSetting_MemoGist {
+	return &storepb.GistSyncUserSetting_MemoGist{
+		GistId:         remote.ID,
+		Url:            remote.HTMLURL,
+		ContentHash:    getContentHash(content),
+		GistUpdateTime: timestamppb.New(remote.UpdatedAt),
+	}
+}
+
+func getContentHash(content string) string {
+	hash := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(hash[:])
+}
+
+// getGistDescription returns the first line of the content without its heading marks.
+func getGistDescription(content string) string {
+	for _, line := range strings.Split(content, "\n") {
+		if line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "#")); line != "" {
+			if runes := []rune(line); len(runes) > maxDescriptionLength {
+				return string(runes[:maxDescriptionLength])
+			}
+			return line
+		}
+	}
+	return ""
+}
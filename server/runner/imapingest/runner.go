@@ -0,0 +1,156 @@
+package imapingest
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/mailin"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	defaultFolder        = "INBOX"
+	defaultArchiveFolder = "Archive"
+	// batchSize is the maximum number of emails ingested in one run.
+	batchSize = 50
+)
+
+// Runner polls the IMAP mailbox of the workspace email setting and creates its emails as memos with the handler.
+type Runner struct {
+	Store   *store.Store
+	Handler mailin.Handler
+}
+
+func NewRunner(store *store.Store, handler mailin.Handler) *Runner {
+	return &Runner{
+		Store:   store,
+		Handler: handler,
+	}
+}
+
+// Schedule runner every minute.
+const runnerInterval = time.Minute
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	setting, err := r.Store.GetWorkspaceEmailSetting(ctx)
+	if err != nil {
+		slog.Error("Failed to get workspace email setting", "error", err)
+		return
+	}
+	if setting.ImapHost == "" {
+		return
+	}
+	if err := r.ingest(ctx, &mailin.IMAPConfig{
+		Host:     setting.ImapHost,
+		Port:     getIMAPPort(setting.ImapPort, setting.ImapUseTls),
+		Username: setting.ImapUsername,
+		Password: setting.ImapPassword,
+		UseTLS:   setting.ImapUseTls,
+	}, getFolder(setting.ImapFolder, defaultFolder), getFolder(setting.ImapArchiveFolder, defaultArchiveFolder)); err != nil {
+		slog.Error("Failed to ingest IMAP mailbox", "error", err)
+	}
+}
+
+// ingest creates the unseen emails of the folder as memos and moves them to the archive folder.
+// The emails without a recipient receiving memos are marked as seen and left in the folder,
+// as are the invalid ones, while the emails the handler fails to create are retried at the next run.
+func (r *Runner) ingest(ctx context.Context, config *mailin.IMAPConfig, folder, archiveFolder string) error {
+	client, err := mailin.DialIMAP(config)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	if err := client.Select(folder); err != nil {
+		return err
+	}
+	uids, err := client.SearchUnseen()
+	if err != nil {
+		return err
+	}
+	if len(uids) > batchSize {
+		uids = uids[:batchSize]
+	}
+
+	for _, uid := range uids {
+		if ctx.Err() != nil {
+			return nil
+		}
+		data, err := client.Fetch(uid)
+		if err != nil {
+			return err
+		}
+		message, err := mailin.ParseMessage(bytes.NewReader(data))
+		if err != nil {
+			slog.Warn("Failed to parse IMAP email", "uid", uid, "error", err)
+			if err := client.MarkSeen(uid); err != nil {
+				return err
+			}
+			continue
+		}
+		recipient := r.findRecipient(ctx, message)
+		if recipient == "" {
+			slog.Info("Skipped IMAP email without memo recipient", "uid", uid)
+			if err := client.MarkSeen(uid); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := r.Handler.Handle(ctx, recipient, message); err != nil {
+			if !errors.Is(err, mailin.ErrRejected) {
+				slog.Warn("Failed to create memo from IMAP email", "uid", uid, "error", err)
+				continue
+			}
+			slog.Info("Rejected IMAP email", "uid", uid, "error", err)
+		}
+		if err := client.Archive(uid, archiveFolder); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findRecipient returns the first recipient of the email receiving memos, so that an email delivered to
+// the same user under several addresses is created once.
+func (r *Runner) findRecipient(ctx context.Context, message *mailin.Message) string {
+	for _, recipient := range message.Recipients {
+		if r.Handler.Accept(ctx, recipient) {
+			return recipient
+		}
+	}
+	return ""
+}
+
+func getIMAPPort(port int32, useTLS bool) int {
+	if port != 0 {
+		return int(port)
+	}
+	if useTLS {
+		return 993
+	}
+	return 143
+}
+
+func getFolder(folder, defaultFolder string) string {
+	if folder == "" {
+		return defaultFolder
+	}
+	return folder
+}
@@ -0,0 +1,46 @@
+package inboxcleanup
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// RunOnce deletes the read inboxes older than the retention days of the workspace, if any,
+// run on the schedule of the inbox cleanup task.
+func (r *Runner) RunOnce(ctx context.Context) error {
+	generalSetting, err := r.Store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace general setting")
+	}
+	if generalSetting.InboxRetentionDays <= 0 {
+		return nil
+	}
+	status := store.ARCHIVED
+	createdTsBefore := time.Now().AddDate(0, 0, -int(generalSetting.InboxRetentionDays)).Unix()
+	count, err := r.Store.DeleteInboxes(ctx, &store.DeleteInboxes{
+		Status:          &status,
+		CreatedTsBefore: &createdTsBefore,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to delete expired inboxes")
+	}
+	if count > 0 {
+		slog.Info("Deleted expired inboxes", "count", count)
+	}
+	return nil
+}
@@ -0,0 +1,270 @@
+// Package jobqueue runs the background jobs kept in the job table by a pool of workers, so that the work started by
+// the requests, e.g. generating the thumbnails of an upload, is retried on failures and resumed after a restart.
+package jobqueue
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// DefaultMaxAttempts is the number of attempts of the jobs whose handler does not set it.
+	DefaultMaxAttempts = 3
+	// DefaultTimeout is the time the handlers run at most when they do not set it.
+	DefaultTimeout = 10 * time.Minute
+	// DefaultConcurrency is the number of jobs of a kind run at once when the handler does not set it.
+	DefaultConcurrency = 2
+	// firstRetryDelay is the delay after the first failed attempt of a job, doubled after each of the next ones.
+	firstRetryDelay = 30 * time.Second
+	// retentionPeriod is the time the finished jobs are kept for.
+	retentionPeriod = 7 * 24 * time.Hour
+	// batchSize is the maximum number of due jobs loaded at once.
+	batchSize = 100
+)
+
+// Schedule runner every 10 seconds, while the enqueued jobs are run at once.
+const runnerInterval = 10 * time.Second
+
+// HandlerFunc runs a job, whose payload is the JSON argument given to Enqueue.
+// The job is retried if it returns an error, until it fails at each of its attempts.
+type HandlerFunc func(ctx context.Context, payload []byte) error
+
+// Handler runs the jobs of a kind.
+type Handler struct {
+	Run HandlerFunc
+	// MaxAttempts is the number of attempts of the jobs, DefaultMaxAttempts if zero.
+	MaxAttempts int32
+	// Timeout is the time a job runs at most, DefaultTimeout if zero. The job is claimed again after it,
+	// as its worker is assumed to have died, so it bounds the time a job is delayed by a restart.
+	Timeout time.Duration
+	// Concurrency is the number of the jobs of the kind run at once by the instance, DefaultConcurrency if zero.
+	Concurrency int
+}
+
+// Queue runs the jobs of the registered kinds. Several instances of the server may run the jobs of a shared
+// database, as each job is claimed by one of them.
+type Queue struct {
+	Store *store.Store
+
+	mu       sync.Mutex
+	handlers map[string]*Handler
+	// running is the number of the running jobs of each kind.
+	running map[string]int
+	// wake signals the jobs enqueued to the runner, so that they are run without waiting for its next run.
+	wake chan struct{}
+	// inFlight tracks the running jobs, which are waited for on shutdown.
+	inFlight sync.WaitGroup
+}
+
+func NewQueue(store *store.Store) *Queue {
+	return &Queue{
+		Store:    store,
+		handlers: map[string]*Handler{},
+		running:  map[string]int{},
+		wake:     make(chan struct{}, 1),
+	}
+}
+
+// Register sets the handler of the jobs of the kind. The handlers are registered before the queue is run.
+func (q *Queue) Register(kind string, handler Handler) {
+	if handler.MaxAttempts <= 0 {
+		handler.MaxAttempts = DefaultMaxAttempts
+	}
+	if handler.Timeout <= 0 {
+		handler.Timeout = DefaultTimeout
+	}
+	if handler.Concurrency <= 0 {
+		handler.Concurrency = DefaultConcurrency
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.handlers[kind] = &handler
+}
+
+// Enqueue creates a job of the kind with the payload marshaled to JSON, which is run as soon as a worker is free.
+func (q *Queue) Enqueue(ctx context.Context, kind string, payload any) (*store.Job, error) {
+	q.mu.Lock()
+	handler, ok := q.handlers[kind]
+	q.mu.Unlock()
+	if !ok {
+		return nil, errors.Errorf("unknown job kind %q", kind)
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal job payload")
+	}
+	job, err := q.Store.CreateJob(ctx, &store.Job{
+		Kind:          kind,
+		Payload:       string(data),
+		Status:        store.JobPending,
+		MaxAttempts:   handler.MaxAttempts,
+		NextAttemptTs: time.Now().Unix(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create job")
+	}
+	q.Wake()
+	return job, nil
+}
+
+// Wake starts the due jobs without waiting for the next run, e.g. after a job is retried.
+func (q *Queue) Wake() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run runs the due jobs until the context is done, then waits for the running jobs to finish.
+func (q *Queue) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+	defer q.inFlight.Wait()
+
+	q.RunOnce(ctx)
+	for {
+		select {
+		case <-ticker.C:
+			q.RunOnce(ctx)
+		case <-q.wake:
+			q.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunOnce starts the due jobs of the kinds with free workers, and deletes the expired finished jobs.
+func (q *Queue) RunOnce(ctx context.Context) {
+	now := time.Now()
+	nowTs, limit := now.Unix(), batchSize
+	jobs, err := q.Store.ListJobs(ctx, &store.FindJob{
+		DueBefore: &nowTs,
+		Limit:     &limit,
+	})
+	if err != nil {
+		slog.Error("Failed to list due jobs", "error", err)
+		return
+	}
+	for _, job := range jobs {
+		if ctx.Err() != nil {
+			return
+		}
+		// The job whose worker died at its last attempt is not run again, in case it made the server crash.
+		if job.Status == store.JobRunning && job.AttemptCount >= job.MaxAttempts {
+			failed, lastError, finishedTs := store.JobFailed, "job timed out", now.Unix()
+			if err := q.Store.UpdateJob(ctx, &store.UpdateJob{
+				ID:         job.ID,
+				Status:     &failed,
+				LastError:  &lastError,
+				FinishedTs: &finishedTs,
+			}); err != nil {
+				slog.Error("Failed to update job", "job", job.ID, "error", err)
+			}
+			continue
+		}
+		handler, ok := q.acquire(job.Kind)
+		if !ok {
+			continue
+		}
+		claimed, err := q.Store.ClaimJob(ctx, &store.ClaimJob{
+			ID:           job.ID,
+			AttemptCount: job.AttemptCount,
+			LeaseEndTs:   time.Now().Add(handler.Timeout).Unix(),
+		})
+		if err != nil || !claimed {
+			if err != nil {
+				slog.Error("Failed to claim job", "job", job.ID, "error", err)
+			}
+			q.release(job.Kind)
+			continue
+		}
+		job.AttemptCount++
+		q.inFlight.Add(1)
+		go func() {
+			defer q.inFlight.Done()
+			// On shutdown, the running jobs are finished, while the pending ones are left to the next start.
+			if err := q.runJob(context.WithoutCancel(ctx), handler, job); err != nil {
+				slog.Error("Failed to update job", "job", job.ID, "error", err)
+			}
+			// The freed worker starts the next due job of the kind, if any.
+			q.release(job.Kind)
+			q.Wake()
+		}()
+	}
+
+	finishedTsBefore := now.Add(-retentionPeriod).Unix()
+	for _, status := range []store.JobStatus{store.JobSucceeded, store.JobFailed} {
+		if err := q.Store.DeleteJobs(ctx, &store.DeleteJob{
+			Status:           &status,
+			FinishedTsBefore: &finishedTsBefore,
+		}); err != nil {
+			slog.Error("Failed to delete expired jobs", "error", err)
+		}
+	}
+}
+
+// acquire reserves a worker for a job of the kind, returning false if the kind is unknown or all its workers are busy.
+func (q *Queue) acquire(kind string) (*Handler, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	handler, ok := q.handlers[kind]
+	if !ok || q.running[kind] >= handler.Concurrency {
+		return nil, false
+	}
+	q.running[kind]++
+	return handler, true
+}
+
+func (q *Queue) release(kind string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.running[kind]--
+}
+
+// runJob runs the claimed job and records its result: a failed attempt is retried after a delay doubled at each
+// attempt, until the job fails at its last attempt. The returned error is about the store.
+func (q *Queue) runJob(ctx context.Context, handler *Handler, job *store.Job) error {
+	runCtx, cancel := context.WithTimeout(ctx, handler.Timeout)
+	runErr := handler.Run(runCtx, []byte(job.Payload))
+	cancel()
+
+	now := time.Now()
+	if runErr == nil {
+		succeeded, lastError, finishedTs := store.JobSucceeded, "", now.Unix()
+		return q.Store.UpdateJob(ctx, &store.UpdateJob{
+			ID:         job.ID,
+			Status:     &succeeded,
+			LastError:  &lastError,
+			FinishedTs: &finishedTs,
+		})
+	}
+
+	slog.Warn("Failed to run job", "job", job.ID, "kind", job.Kind, "attempt", job.AttemptCount, "error", runErr)
+	lastError := runErr.Error()
+	update := &store.UpdateJob{
+		ID:        job.ID,
+		LastError: &lastError,
+	}
+	if job.AttemptCount >= job.MaxAttempts {
+		failed, finishedTs := store.JobFailed, now.Unix()
+		update.Status, update.FinishedTs = &failed, &finishedTs
+	} else {
+		pending, nextAttemptTs := store.JobPending, now.Add(RetryDelay(firstRetryDelay, job.AttemptCount)).Unix()
+		update.Status, update.NextAttemptTs = &pending, &nextAttemptTs
+	}
+	return q.Store.UpdateJob(ctx, update)
+}
+
+// RetryDelay returns the delay before the next attempt after the number of failed attempts, the first delay being
+// doubled after each of the next ones, e.g. for the jobs and the webhook deliveries.
+func RetryDelay(firstDelay time.Duration, attemptCount int32) time.Duration {
+	return firstDelay << (attemptCount - 1)
+}
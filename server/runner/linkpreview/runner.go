@@ -0,0 +1,187 @@
+package linkpreview
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/httpgetter"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/memopayload"
+	"github.com/usememos/memos/store"
+	"github.com/usememos/memos/store/cache"
+)
+
+const (
+	// batchSize is the number of memos loaded at once.
+	batchSize = 100
+	// maxLinkPreviews is the maximum number of URLs of a memo previewed, the first ones of the content.
+	maxLinkPreviews = 5
+	// retryInterval is how long the pages failing to be fetched wait before being fetched again.
+	retryInterval = 24 * time.Hour
+	// cacheTTL is how long the previews fetched are reused for the same URL in other memos.
+	cacheTTL = 24 * time.Hour
+)
+
+// Runner fetches the previews of the bare URLs of the memos into their payload.
+type Runner struct {
+	Store *store.Store
+	// GetHTMLMeta fetches the metadata of a page, refusing the internal addresses.
+	GetHTMLMeta func(ctx context.Context, url string) (*httpgetter.HTMLMeta, error)
+
+	cache *cache.Cache
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store:       store,
+		GetHTMLMeta: httpgetter.GetHTMLMetaWithContext,
+		cache: cache.New(cache.Config{
+			DefaultTTL:      cacheTTL,
+			CleanupInterval: time.Hour,
+			MaxItems:        1000,
+		}),
+	}
+}
+
+// Schedule runner every 10 minutes.
+const runnerInterval = time.Minute * 10
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+	defer r.cache.Close()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	count, err := r.UpdateLinkPreviews(ctx)
+	if err != nil {
+		slog.Error("Failed to update link previews", "error", err)
+	}
+	if count > 0 {
+		slog.Info("Updated link previews", "memos", count)
+	}
+}
+
+// UpdateLinkPreviews fetches the missing previews of the bare URLs of the memos, retrying the failed ones once stale,
+// and returns the number of memos updated.
+func (r *Runner) UpdateLinkPreviews(ctx context.Context) (int, error) {
+	count := 0
+	offset := 0
+	for {
+		limit := batchSize
+		memos, err := r.Store.ListMemos(ctx, &store.FindMemo{
+			PayloadFind: &store.FindMemoPayload{HasLink: true},
+			Limit:       &limit,
+			Offset:      &offset,
+		})
+		if err != nil {
+			return count, errors.Wrap(err, "failed to list memos")
+		}
+		if len(memos) == 0 {
+			break
+		}
+
+		for _, memo := range memos {
+			if ctx.Err() != nil {
+				return count, ctx.Err()
+			}
+			updated, err := r.updateMemoLinkPreviews(ctx, memo)
+			if err != nil {
+				return count, err
+			}
+			if updated {
+				count++
+			}
+		}
+
+		offset += len(memos)
+	}
+	return count, nil
+}
+
+func (r *Runner) updateMemoLinkPreviews(ctx context.Context, memo *store.Memo) (bool, error) {
+	urls, err := memopayload.ListBareURLs(memo.Content)
+	if err != nil {
+		slog.Warn("Failed to list memo URLs", "memo", memo.UID, "error", err)
+		return false, nil
+	}
+	urls = urls[:min(len(urls), maxLinkPreviews)]
+	payload := memo.Payload
+	if payload == nil {
+		payload = &storepb.MemoPayload{}
+	}
+
+	updated := false
+	linkPreviews := []*storepb.MemoPayload_LinkPreview{}
+	for _, url := range urls {
+		index := slices.IndexFunc(payload.LinkPreviews, func(linkPreview *storepb.MemoPayload_LinkPreview) bool {
+			return linkPreview.Url == url
+		})
+		if index >= 0 {
+			linkPreview := payload.LinkPreviews[index]
+			if !linkPreview.Failed || time.Since(linkPreview.FetchTime.AsTime()) < retryInterval {
+				linkPreviews = append(linkPreviews, linkPreview)
+				continue
+			}
+		}
+		linkPreviews = append(linkPreviews, r.getLinkPreview(ctx, url))
+		updated = true
+	}
+	if !updated {
+		return false, nil
+	}
+	// The pages of a canceled run are not failures.
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	payload.LinkPreviews = linkPreviews
+	if err := r.Store.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:      memo.ID,
+		Payload: payload,
+	}); err != nil {
+		return false, errors.Wrap(err, "failed to update memo")
+	}
+	return true, nil
+}
+
+// getLinkPreview returns the preview of the page of the URL, a failed one if it could not be fetched.
+func (r *Runner) getLinkPreview(ctx context.Context, url string) *storepb.MemoPayload_LinkPreview {
+	if value, ok := r.cache.Get(ctx, url); ok {
+		return value.(*storepb.MemoPayload_LinkPreview)
+	}
+	htmlMeta, err := r.GetHTMLMeta(ctx, url)
+	if err != nil {
+		slog.Debug("Failed to fetch link preview", "url", url, "error", err)
+		return &storepb.MemoPayload_LinkPreview{
+			Url:       url,
+			FetchTime: timestamppb.Now(),
+			Failed:    true,
+		}
+	}
+	linkPreview := &storepb.MemoPayload_LinkPreview{
+		Url:         url,
+		Title:       htmlMeta.Title,
+		Description: htmlMeta.Description,
+		Image:       htmlMeta.Image,
+		Favicon:     htmlMeta.Favicon,
+		SiteName:    htmlMeta.SiteName,
+		FetchTime:   timestamppb.Now(),
+	}
+	r.cache.Set(ctx, url, linkPreview)
+	return linkPreview
+}
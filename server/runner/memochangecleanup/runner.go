@@ -0,0 +1,34 @@
+package memochangecleanup
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+// retentionPeriod is the time the memo changes are kept for, the clients polling less often having to list the memos again.
+const retentionPeriod = 30 * 24 * time.Hour
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// RunOnce deletes the memo changes older than the retention period, run on the schedule of the memo change cleanup task.
+func (r *Runner) RunOnce(ctx context.Context) error {
+	createdTsBefore := time.Now().Add(-retentionPeriod).Unix()
+	if err := r.Store.DeleteMemoChanges(ctx, &store.DeleteMemoChange{
+		CreatedTsBefore: &createdTsBefore,
+	}); err != nil {
+		return errors.Wrap(err, "failed to delete expired memo changes")
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package memoembedding
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/embedding"
+	"github.com/usememos/memos/store"
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// Schedule runner every 10 minutes.
+const runnerInterval = time.Minute * 10
+
+const (
+	// batchSize is the number of memos loaded at once.
+	batchSize = 100
+	// embedBatchSize is the number of memos embedded in a single provider call.
+	embedBatchSize = 32
+)
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	workspaceEmbeddingSetting, err := r.Store.GetWorkspaceEmbeddingSetting(ctx)
+	if err != nil {
+		slog.Error("Failed to get workspace embedding setting", "error", err)
+		return
+	}
+	if !workspaceEmbeddingSetting.Enabled {
+		return
+	}
+	provider, err := embedding.NewProvider(workspaceEmbeddingSetting)
+	if err != nil {
+		slog.Error("Failed to create embedding provider", "error", err)
+		return
+	}
+	count, err := IndexMemos(ctx, r.Store, provider)
+	if err != nil {
+		slog.Error("Failed to index memo embeddings", "error", err)
+	}
+	if count > 0 {
+		slog.Info("Indexed memo embeddings", "count", count)
+	}
+}
+
+// IndexMemos embeds the memos without an up to date embedding of the provider model,
+// and returns the number of embedded memos.
+func IndexMemos(ctx context.Context, s *store.Store, provider embedding.Provider) (int, error) {
+	model := provider.Model()
+	memoEmbeddings, err := s.ListMemoEmbeddings(ctx, &store.FindMemoEmbedding{
+		Model: &model,
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to list memo embeddings")
+	}
+	contentHashes := map[int32]string{}
+	for _, memoEmbedding := range memoEmbeddings {
+		contentHashes[memoEmbedding.MemoID] = memoEmbedding.ContentHash
+	}
+
+	count := 0
+	normalStatus := store.Normal
+	offset := 0
+	for {
+		limit := batchSize
+		memos, err := s.ListMemos(ctx, &store.FindMemo{
+			RowStatus:       &normalStatus,
+			ExcludeComments: true,
+			Limit:           &limit,
+			Offset:          &offset,
+		})
+		if err != nil {
+			return count, errors.Wrap(err, "failed to list memos")
+		}
+		if len(memos) == 0 {
+			break
+		}
+
+		pending := []*store.Memo{}
+		for _, memo := range memos {
+			if strings.TrimSpace(memo.Content) == "" {
+				continue
+			}
+			if contentHashes[memo.ID] != hashContent(memo.Content) {
+				pending = append(pending, memo)
+			}
+		}
+		for start := 0; start < len(pending); start += embedBatchSize {
+			batch := pending[start:min(start+embedBatchSize, len(pending))]
+			texts := make([]string, len(batch))
+			for i, memo := range batch {
+				texts[i] = memo.Content
+			}
+			vectors, err := provider.Embed(ctx, texts)
+			if err != nil {
+				return count, errors.Wrap(err, "failed to embed memos")
+			}
+			for i, memo := range batch {
+				if _, err := s.UpsertMemoEmbedding(ctx, &store.MemoEmbedding{
+					MemoID:      memo.ID,
+					Model:       model,
+					ContentHash: hashContent(memo.Content),
+					Embedding:   vectors[i],
+				}); err != nil {
+					return count, errors.Wrap(err, "failed to upsert memo embedding")
+				}
+				count++
+			}
+		}
+
+		offset += len(memos)
+	}
+	return count, nil
+}
+
+func hashContent(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
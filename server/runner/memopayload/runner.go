@@ -3,17 +3,25 @@ package memopayload
 import (
 	"context"
 	"log/slog"
+	"regexp"
 	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/pkg/errors"
 	"github.com/usememos/gomark/ast"
 	"github.com/usememos/gomark/parser"
 	"github.com/usememos/gomark/parser/tokenizer"
+	"github.com/usememos/gomark/renderer"
 
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
+// mentionPattern matches the mentions of the users in the text, e.g. "@jane", but not the emails.
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@.])@([a-zA-Z0-9](?:[a-zA-Z0-9-]{0,30}[a-zA-Z0-9])?)\b`)
+
 type Runner struct {
 	Store *store.Store
 }
@@ -82,9 +90,16 @@ func RebuildMemoPayload(memo *store.Memo) error {
 		memo.Payload = &storepb.MemoPayload{}
 	}
 	tags := []string{}
+	mentions := []string{}
 	property := &storepb.MemoPayload_Property{}
 	TraverseASTNodes(nodes, func(node ast.Node) {
 		switch n := node.(type) {
+		case *ast.Text:
+			for _, match := range mentionPattern.FindAllStringSubmatch(n.Content, -1) {
+				if !slices.Contains(mentions, match[1]) {
+					mentions = append(mentions, match[1])
+				}
+			}
 		case *ast.Tag:
 			tag := n.Content
 			if !slices.Contains(tags, tag) {
@@ -104,11 +119,66 @@ func RebuildMemoPayload(memo *store.Memo) error {
 			property.References = append(property.References, n.ResourceName)
 		}
 	})
+	property.WordCount = countWords(memo.Content)
 	memo.Payload.Tags = tags
+	memo.Payload.Mentions = mentions
 	memo.Payload.Property = property
+	// The previews of the URLs removed from the content are dropped, the new URLs get theirs in the background.
+	bareURLs := listBareURLs(nodes)
+	memo.Payload.LinkPreviews = slices.DeleteFunc(memo.Payload.LinkPreviews, func(linkPreview *storepb.MemoPayload_LinkPreview) bool {
+		return !slices.Contains(bareURLs, linkPreview.Url)
+	})
 	return nil
 }
 
+// ListBareURLs returns the URLs written as is in the content, without link text, in their order.
+func ListBareURLs(content string) ([]string, error) {
+	nodes, err := parser.Parse(tokenizer.Tokenize(content))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse content")
+	}
+	return listBareURLs(nodes), nil
+}
+
+func listBareURLs(nodes []ast.Node) []string {
+	urls := []string{}
+	TraverseASTNodes(nodes, func(node ast.Node) {
+		if n, ok := node.(*ast.AutoLink); ok && !slices.Contains(urls, n.URL) {
+			urls = append(urls, n.URL)
+		}
+	})
+	return urls
+}
+
+// GetMemoTitle returns the first line of the plain text of the memo content, shortened to maxLength characters,
+// or an empty string if the content has no text.
+func GetMemoTitle(content string, maxLength int) string {
+	plainText := content
+	if nodes, err := parser.Parse(tokenizer.Tokenize(content)); err == nil {
+		plainText = renderer.NewStringRenderer().Render(nodes)
+	}
+	title, _, _ := strings.Cut(strings.TrimSpace(plainText), "\n")
+	title = strings.TrimSpace(title)
+	if utf8.RuneCountInString(title) > maxLength {
+		title = string([]rune(title)[:maxLength]) + "…"
+	}
+	return title
+}
+
+// countWords counts the whitespace separated words of the content containing a letter or a number,
+// so that markdown syntax like list markers and separators is not counted.
+func countWords(content string) int32 {
+	var count int32
+	for _, field := range strings.Fields(content) {
+		if strings.IndexFunc(field, func(r rune) bool {
+			return unicode.IsLetter(r) || unicode.IsNumber(r)
+		}) >= 0 {
+			count++
+		}
+	}
+	return count
+}
+
 func TraverseASTNodes(nodes []ast.Node, fn func(ast.Node)) {
 	for _, node := range nodes {
 		fn(node)
@@ -0,0 +1,104 @@
+package onthisday
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// Notifier notifies the users of their memos created on this day in past years.
+type Notifier interface {
+	// NotifyOnThisDay notifies the user of their memos created on the day in past years, if any.
+	NotifyOnThisDay(ctx context.Context, userID int32, day time.Time) error
+}
+
+// Runner notifies the users who enabled it of their memos created on this day in past years, daily at their hour.
+type Runner struct {
+	Store    *store.Store
+	Notifier Notifier
+}
+
+func NewRunner(store *store.Store, notifier Notifier) *Runner {
+	return &Runner{
+		Store:    store,
+		Notifier: notifier,
+	}
+}
+
+// Check the schedules every 5 minutes.
+const runnerInterval = time.Minute * 5
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx, time.Now())
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunOnce sends the notifications whose scheduled time has come by now. The notifications missed while the server
+// was down are sent once, for their last scheduled time.
+func (r *Runner) RunOnce(ctx context.Context, now time.Time) {
+	userSettings, err := r.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSetting_ON_THIS_DAY,
+	})
+	if err != nil {
+		slog.Error("Failed to list on this day user settings", "error", err)
+		return
+	}
+	for _, userSetting := range userSettings {
+		setting := userSetting.GetOnThisDay()
+		if !setting.GetEnabled() {
+			continue
+		}
+		notifyTime, err := getLastScheduledTime(setting, now)
+		if err != nil {
+			slog.Error("Invalid on this day schedule", "user", userSetting.UserId, "error", err)
+			continue
+		}
+		if !setting.LastNotifyTime.AsTime().Before(notifyTime) {
+			continue
+		}
+		if err := r.Notifier.NotifyOnThisDay(ctx, userSetting.UserId, notifyTime); err != nil {
+			slog.Error("Failed to notify on this day", "user", userSetting.UserId, "error", err)
+			continue
+		}
+		// The schedule may have been changed in the meantime, only the notification sent is recorded.
+		current, err := r.Store.GetUserOnThisDay(ctx, userSetting.UserId)
+		if err != nil {
+			slog.Error("Failed to get user on this day", "user", userSetting.UserId, "error", err)
+			continue
+		}
+		if current.LastNotifyTime.AsTime().Before(notifyTime) {
+			current.LastNotifyTime = timestamppb.New(notifyTime)
+			if err := r.Store.UpsertUserOnThisDay(ctx, userSetting.UserId, current); err != nil {
+				slog.Error("Failed to upsert user on this day", "user", userSetting.UserId, "error", err)
+			}
+		}
+	}
+}
+
+// getLastScheduledTime returns the last scheduled time of the notification by now, in its time zone.
+func getLastScheduledTime(setting *storepb.OnThisDayUserSetting, now time.Time) (time.Time, error) {
+	location, err := time.LoadLocation(setting.TimeZone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	now = now.In(location)
+	scheduled := time.Date(now.Year(), now.Month(), now.Day(), int(setting.Hour), 0, 0, 0, location)
+	if scheduled.After(now) {
+		scheduled = scheduled.AddDate(0, 0, -1)
+	}
+	return scheduled, nil
+}
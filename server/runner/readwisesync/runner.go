@@ -0,0 +1,149 @@
+package readwisesync
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/plugin/readwise"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// maxBookIDs is the maximum number of books fetched by an export, keeping its URL short.
+const maxBookIDs = 100
+
+// Runner syncs the highlights of the connected Readwise accounts as a memo per book with the handler.
+type Runner struct {
+	Store   *store.Store
+	Handler readwise.Handler
+	// APIEndpoint is the endpoint of the Readwise API, the default one if empty.
+	APIEndpoint string
+}
+
+func NewRunner(store *store.Store, handler readwise.Handler) *Runner {
+	return &Runner{
+		Store:   store,
+		Handler: handler,
+	}
+}
+
+// Schedule runner every hour.
+const runnerInterval = time.Hour
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	userSettings, err := r.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSetting_READWISE,
+	})
+	if err != nil {
+		slog.Error("Failed to list readwise user settings", "error", err)
+		return
+	}
+	for _, userSetting := range userSettings {
+		setting := userSetting.GetReadwise()
+		if setting.GetToken() == "" {
+			continue
+		}
+		user, err := r.Store.GetUser(ctx, &store.FindUser{ID: &userSetting.UserId})
+		if err != nil {
+			slog.Error("Failed to get user", "user", userSetting.UserId, "error", err)
+			continue
+		}
+		if user == nil || user.RowStatus == store.Archived {
+			continue
+		}
+		if err := r.syncUser(ctx, user.ID, setting); err != nil {
+			slog.Error("Failed to sync readwise highlights", "user", user.ID, "error", err)
+		}
+	}
+}
+
+// syncUser saves the books of the user updated since the last successful sync, or all the books for the first one.
+// The memos saved before a failure are recorded, and the books are fetched again at the next sync.
+func (r *Runner) syncUser(ctx context.Context, userID int32, setting *storepb.ReadwiseUserSetting) error {
+	startTime := time.Now()
+	bookMemos := maps.Clone(setting.BookMemos)
+	if bookMemos == nil {
+		bookMemos = map[int64]string{}
+	}
+	syncErr := r.sync(ctx, userID, setting, bookMemos)
+
+	// The account may have been disconnected or replaced during the sync.
+	current, err := r.Store.GetUserReadwise(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get user readwise")
+	}
+	if current.Token != setting.Token {
+		return syncErr
+	}
+	current.BookMemos = bookMemos
+	if syncErr != nil {
+		current.LastSyncError = syncErr.Error()
+	} else {
+		current.LastSyncTime = timestamppb.New(startTime)
+		current.LastSyncError = ""
+	}
+	if err := r.Store.UpsertUserReadwise(ctx, userID, current); err != nil {
+		return errors.Wrap(err, "failed to upsert user readwise")
+	}
+	return syncErr
+}
+
+// sync saves the updated books with the handler, recording their memos in bookMemos.
+// The updated books only list their updated highlights, so they are fetched again with all their highlights.
+func (r *Runner) sync(ctx context.Context, userID int32, setting *storepb.ReadwiseUserSetting, bookMemos map[int64]string) error {
+	client := &readwise.Client{Token: setting.Token, APIEndpoint: r.APIEndpoint}
+	books := []*readwise.Book{}
+	if setting.LastSyncTime == nil {
+		list, err := client.Export(ctx, time.Time{}, nil)
+		if err != nil {
+			return err
+		}
+		books = list
+	} else {
+		updatedBooks, err := client.Export(ctx, setting.LastSyncTime.AsTime(), nil)
+		if err != nil {
+			return err
+		}
+		for start := 0; start < len(updatedBooks); start += maxBookIDs {
+			ids := []int64{}
+			for _, book := range updatedBooks[start:min(start+maxBookIDs, len(updatedBooks))] {
+				ids = append(ids, book.ID)
+			}
+			list, err := client.Export(ctx, time.Time{}, ids)
+			if err != nil {
+				return err
+			}
+			books = append(books, list...)
+		}
+	}
+
+	for _, book := range books {
+		memoUID, err := r.Handler.SaveBook(ctx, userID, bookMemos[book.ID], book, setting.Tag)
+		if err != nil {
+			return errors.Wrapf(err, "failed to save book %q", book.Title)
+		}
+		if memoUID != "" {
+			bookMemos[book.ID] = memoUID
+		}
+	}
+	return nil
+}
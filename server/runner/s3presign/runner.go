@@ -7,6 +7,7 @@ import (
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/usememos/memos/plugin/storage/gcs"
 	"github.com/usememos/memos/plugin/storage/s3"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
@@ -41,6 +42,7 @@ func (r *Runner) Run(ctx context.Context) {
 
 func (r *Runner) RunOnce(ctx context.Context) {
 	r.CheckAndPresign(ctx)
+	r.CheckAndSignGCS(ctx)
 }
 
 func (r *Runner) CheckAndPresign(ctx context.Context) {
@@ -132,3 +134,86 @@ func (r *Runner) CheckAndPresign(ctx context.Context) {
 		offset += len(attachments)
 	}
 }
+
+// CheckAndSignGCS signs again the URLs of the GCS attachments before they expire.
+func (r *Runner) CheckAndSignGCS(ctx context.Context) {
+	workspaceStorageSetting, err := r.Store.GetWorkspaceStorageSetting(ctx)
+	if err != nil {
+		return
+	}
+
+	gcsStorageType := storepb.AttachmentStorageType_GCS
+	const batchSize = 100
+	offset := 0
+
+	for {
+		limit := batchSize
+		attachments, err := r.Store.ListAttachments(ctx, &store.FindAttachment{
+			GetBlob:     false,
+			StorageType: &gcsStorageType,
+			Limit:       &limit,
+			Offset:      &offset,
+		})
+		if err != nil {
+			slog.Error("Failed to list attachments for signing", "error", err)
+			return
+		}
+		if len(attachments) == 0 {
+			break
+		}
+
+		signCount := 0
+		for _, attachment := range attachments {
+			gcsObjectPayload := attachment.Payload.GetGcsObject()
+			if gcsObjectPayload == nil {
+				continue
+			}
+
+			// Skip if the signed URL is still valid for the next day.
+			if gcsObjectPayload.LastSignedTime != nil && time.Now().Before(gcsObjectPayload.LastSignedTime.AsTime().Add(gcs.SignedURLExpiration-24*time.Hour)) {
+				continue
+			}
+
+			gcsConfig := workspaceStorageSetting.GetGcsConfig()
+			if gcsObjectPayload.GcsConfig != nil {
+				gcsConfig = gcsObjectPayload.GcsConfig
+			}
+			if gcsConfig == nil {
+				slog.Error("GCS config is not found")
+				continue
+			}
+
+			gcsClient, err := gcs.NewClient(ctx, gcsConfig)
+			if err != nil {
+				slog.Error("Failed to create GCS client", "error", err)
+				continue
+			}
+
+			signedURL, err := gcsClient.SignGetObject(gcsObjectPayload.Key)
+			if err != nil {
+				slog.Error("Failed to sign URL", "error", err, "attachmentID", attachment.ID)
+				continue
+			}
+
+			gcsObjectPayload.GcsConfig = gcsConfig
+			gcsObjectPayload.LastSignedTime = timestamppb.New(time.Now())
+			if err := r.Store.UpdateAttachment(ctx, &store.UpdateAttachment{
+				ID:        attachment.ID,
+				Reference: &signedURL,
+				Payload: &storepb.AttachmentPayload{
+					Payload: &storepb.AttachmentPayload_GcsObject{
+						GcsObject: gcsObjectPayload,
+					},
+				},
+			}); err != nil {
+				slog.Error("Failed to update attachment", "error", err, "attachmentID", attachment.ID)
+				continue
+			}
+			signCount++
+		}
+
+		slog.Info("Signed batch of GCS attachments", "batchSize", len(attachments), "signed", signCount)
+
+		offset += len(attachments)
+	}
+}
@@ -0,0 +1,234 @@
+// Package scheduler runs the built-in maintenance tasks, e.g. the cleanups, the database backups and the digests,
+// on the cron schedules configured by the admins. The runs are jobs of the job queue, so that they are run by a single
+// instance of the cluster, and their last status is kept by the scheduled task table.
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/cron"
+	"github.com/usememos/memos/server/runner/jobqueue"
+	"github.com/usememos/memos/store"
+)
+
+// JobKind is the kind of the jobs running the tasks.
+const JobKind = "scheduled_task"
+
+// Check the schedules every minute, the precision of the cron expressions.
+const runnerInterval = time.Minute
+
+// Task is a maintenance task run on a schedule.
+type Task struct {
+	// Name identifies the task, e.g. "memo_change_cleanup".
+	Name        string
+	Description string
+	// DefaultSchedule is the cron expression of the task until the admins change it.
+	DefaultSchedule string
+	Run             func(ctx context.Context) error
+}
+
+type jobPayload struct {
+	Name string `json:"name"`
+}
+
+// Scheduler runs the registered tasks on their schedule.
+type Scheduler struct {
+	Store *store.Store
+	Queue *jobqueue.Queue
+
+	tasks []*Task
+	// startTime is the time the scheduler was created, from which the tasks that never ran are scheduled,
+	// as the runs missed while the server was down are not caught up with.
+	startTime time.Time
+}
+
+// NewScheduler creates a scheduler running the tasks as jobs of the queue, whose handler it registers.
+func NewScheduler(store *store.Store, queue *jobqueue.Queue) *Scheduler {
+	s := &Scheduler{
+		Store:     store,
+		Queue:     queue,
+		startTime: time.Now(),
+	}
+	queue.Register(JobKind, jobqueue.Handler{
+		Run: s.runJob,
+		// A failed run is not retried, the task running again on its schedule.
+		MaxAttempts: 1,
+		Timeout:     time.Hour,
+		// The tasks run one at a time, e.g. so that a backup does not run during a cleanup.
+		Concurrency: 1,
+	})
+	return s
+}
+
+// Register adds a task. The tasks are registered before the scheduler is run.
+func (s *Scheduler) Register(task Task) {
+	s.tasks = append(s.tasks, &task)
+}
+
+// Tasks returns the registered tasks, in the order of their registration.
+func (s *Scheduler) Tasks() []*Task {
+	return s.tasks
+}
+
+// GetTask returns the task of the name, nil if there is none.
+func (s *Scheduler) GetTask(name string) *Task {
+	for _, task := range s.tasks {
+		if task.Name == name {
+			return task
+		}
+	}
+	return nil
+}
+
+// ParseSchedule parses a standard cron expression, e.g. "0 3 * * *" for every day at 3:00 UTC.
+func ParseSchedule(spec string) (cron.Schedule, error) {
+	return cron.ParseStandard(spec)
+}
+
+// NextRunTime returns the time of the next scheduled run of the task, given its state in the store which is nil
+// if the task was never configured nor run. It returns the zero time if the task is disabled.
+func (s *Scheduler) NextRunTime(task *Task, state *store.ScheduledTask) (time.Time, error) {
+	spec, since := task.DefaultSchedule, s.startTime
+	if state != nil {
+		if state.Disabled {
+			return time.Time{}, nil
+		}
+		if state.Schedule != "" {
+			spec = state.Schedule
+		}
+		// A changed schedule applies from the time of the change.
+		for _, ts := range []int64{state.LastRunTs, state.UpdatedTs} {
+			if t := time.Unix(ts, 0); t.After(since) {
+				since = t
+			}
+		}
+	}
+	schedule, err := ParseSchedule(spec)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "invalid schedule %q", spec)
+	}
+	return schedule.Next(since), nil
+}
+
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.RunOnce(ctx, time.Now())
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// RunOnce starts the tasks whose scheduled time has come by now.
+func (s *Scheduler) RunOnce(ctx context.Context, now time.Time) {
+	states, err := s.Store.ListScheduledTasks(ctx, &store.FindScheduledTask{})
+	if err != nil {
+		slog.Error("Failed to list scheduled tasks", "error", err)
+		return
+	}
+	stateByName := map[string]*store.ScheduledTask{}
+	for _, state := range states {
+		stateByName[state.Name] = state
+	}
+	for _, task := range s.tasks {
+		next, err := s.NextRunTime(task, stateByName[task.Name])
+		if err != nil {
+			slog.Error("Failed to schedule task", "task", task.Name, "error", err)
+			continue
+		}
+		if next.IsZero() || next.After(now) {
+			continue
+		}
+		if err := s.run(ctx, task.Name, now); err != nil {
+			slog.Error("Failed to run scheduled task", "task", task.Name, "error", err)
+		}
+	}
+}
+
+// RunNow starts a run of the task by hand, which is pending until a worker of the queue runs it.
+func (s *Scheduler) RunNow(ctx context.Context, name string) error {
+	return s.run(ctx, name, time.Now())
+}
+
+// run starts a run of the task at the time, from which its next run is scheduled.
+func (s *Scheduler) run(ctx context.Context, name string, now time.Time) error {
+	if s.GetTask(name) == nil {
+		return errors.Errorf("unknown task %q", name)
+	}
+	pending, lastRunTs := store.ScheduledTaskPending, now.Unix()
+	if err := s.updateTask(ctx, &store.UpdateScheduledTask{
+		Name:       name,
+		LastRunTs:  &lastRunTs,
+		LastStatus: &pending,
+	}); err != nil {
+		return err
+	}
+	if _, err := s.Queue.Enqueue(ctx, JobKind, &jobPayload{Name: name}); err != nil {
+		return errors.Wrap(err, "failed to enqueue task")
+	}
+	return nil
+}
+
+// runJob runs the task of the job and records its status. The task is not run if it was removed since it was enqueued.
+func (s *Scheduler) runJob(ctx context.Context, payload []byte) error {
+	var p jobPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return errors.Wrap(err, "failed to unmarshal job payload")
+	}
+	task := s.GetTask(p.Name)
+	if task == nil {
+		return nil
+	}
+
+	running := store.ScheduledTaskRunning
+	if err := s.updateTask(ctx, &store.UpdateScheduledTask{
+		Name:       task.Name,
+		LastStatus: &running,
+	}); err != nil {
+		return err
+	}
+	runErr := task.Run(ctx)
+	status, lastError, lastFinishedTs := store.ScheduledTaskSucceeded, "", time.Now().Unix()
+	if runErr != nil {
+		status, lastError = store.ScheduledTaskFailed, runErr.Error()
+	}
+	if err := s.updateTask(ctx, &store.UpdateScheduledTask{
+		Name:           task.Name,
+		LastStatus:     &status,
+		LastError:      &lastError,
+		LastFinishedTs: &lastFinishedTs,
+	}); err != nil {
+		return err
+	}
+	return runErr
+}
+
+// updateTask records the run of the task, creating its row with the default configuration if it never ran.
+func (s *Scheduler) updateTask(ctx context.Context, update *store.UpdateScheduledTask) error {
+	state, err := s.Store.GetScheduledTask(ctx, &store.FindScheduledTask{Name: &update.Name})
+	if err != nil {
+		return errors.Wrap(err, "failed to get scheduled task")
+	}
+	if state == nil {
+		if _, err := s.Store.UpsertScheduledTask(ctx, &store.ScheduledTask{
+			Name:      update.Name,
+			UpdatedTs: s.startTime.Unix(),
+		}); err != nil {
+			return errors.Wrap(err, "failed to create scheduled task")
+		}
+	}
+	if err := s.Store.UpdateScheduledTask(ctx, update); err != nil {
+		return errors.Wrap(err, "failed to update scheduled task")
+	}
+	return nil
+}
@@ -0,0 +1,244 @@
+package webhookdelivery
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/webhook"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/runner/jobqueue"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// MaxAttempts is the number of the failed attempts of a delivery after which it is dead-lettered.
+	MaxAttempts = 8
+	// DisableThreshold is the number of the failed attempts in a row after which a webhook is disabled,
+	// i.e. a few deliveries dead-lettered one after another.
+	DisableThreshold = 20
+	// firstRetryDelay is the delay after the first failed attempt, doubled after each of the next ones,
+	// so that a delivery is dead-lettered about two hours after it is created.
+	firstRetryDelay = time.Minute
+	// retentionPeriod is the time the finished deliveries are kept for.
+	retentionPeriod = 30 * 24 * time.Hour
+	// batchSize is the maximum number of deliveries attempted in one run.
+	batchSize = 100
+	// maxResponseBodyLength is the maximum length of the kept response bodies, enough for the error messages.
+	maxResponseBodyLength = 1024
+)
+
+type Runner struct {
+	Store *store.Store
+}
+
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		Store: store,
+	}
+}
+
+// Schedule runner every 30 seconds, as the shortest retry delay is a minute.
+const runnerInterval = 30 * time.Second
+
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(runnerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) RunOnce(ctx context.Context) {
+	now := time.Now()
+	pending, nowTs, limit := store.WebhookDeliveryPending, now.Unix(), batchSize
+	deliveries, err := r.Store.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{
+		Status:              &pending,
+		NextAttemptTsBefore: &nowTs,
+		Limit:               &limit,
+	})
+	if err != nil {
+		slog.Error("Failed to list due webhook deliveries", "error", err)
+		return
+	}
+	for _, delivery := range deliveries {
+		// On shutdown, the delivery in flight is finished while the next ones are left to the next start.
+		if ctx.Err() != nil {
+			return
+		}
+		if err := Deliver(context.WithoutCancel(ctx), r.Store, delivery, time.Now()); err != nil {
+			slog.Error("Failed to deliver webhook", "delivery", delivery.ID, "error", err)
+		}
+	}
+
+	createdTsBefore := now.Add(-retentionPeriod).Unix()
+	for _, status := range []store.WebhookDeliveryStatus{store.WebhookDeliverySucceeded, store.WebhookDeliveryDead} {
+		if err := r.Store.DeleteWebhookDeliveries(ctx, &store.DeleteWebhookDelivery{
+			Status:          &status,
+			CreatedTsBefore: &createdTsBefore,
+		}); err != nil {
+			slog.Error("Failed to delete expired webhook deliveries", "error", err)
+		}
+	}
+}
+
+// JobKind is the kind of the jobs of the first attempts of the deliveries.
+const JobKind = "webhook_delivery"
+
+type jobPayload struct {
+	DeliveryID int32 `json:"deliveryId"`
+}
+
+// RegisterJobHandler sets the handler of the first attempts of the deliveries enqueued to the job queue.
+// The job is not retried, as the failed deliveries are attempted again by the runner.
+func RegisterJobHandler(queue *jobqueue.Queue, s *store.Store) {
+	queue.Register(JobKind, jobqueue.Handler{
+		Run: func(ctx context.Context, data []byte) error {
+			var payload jobPayload
+			if err := json.Unmarshal(data, &payload); err != nil {
+				return errors.Wrap(err, "invalid job payload")
+			}
+			delivery, err := s.GetWebhookDelivery(ctx, &store.FindWebhookDelivery{ID: &payload.DeliveryID})
+			if err != nil {
+				return errors.Wrap(err, "failed to get webhook delivery")
+			}
+			// The delivery may be deleted with its webhook, or attempted by the runner, before the job runs.
+			if delivery == nil || delivery.Status != store.WebhookDeliveryPending || delivery.AttemptCount > 0 {
+				return nil
+			}
+			return Deliver(ctx, s, delivery, time.Now())
+		},
+		MaxAttempts: 1,
+		Timeout:     time.Minute,
+		Concurrency: 4,
+	})
+}
+
+// Enqueue creates a delivery of the payload to the webhook of the creator, and enqueues its first attempt to the
+// job queue, if any, while the runner attempts it again if it fails.
+func Enqueue(ctx context.Context, s *store.Store, queue *jobqueue.Queue, creatorID int32, webhookID, activityType string, payload []byte) (*store.WebhookDelivery, error) {
+	delivery, err := s.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+		CreatorID:    creatorID,
+		WebhookID:    webhookID,
+		ActivityType: activityType,
+		Payload:      string(payload),
+		Status:       store.WebhookDeliveryPending,
+		// The runner leaves the delivery to the first attempt until its retry delay.
+		NextAttemptTs: time.Now().Add(firstRetryDelay).Unix(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create webhook delivery")
+	}
+	if queue != nil {
+		if _, err := queue.Enqueue(ctx, JobKind, jobPayload{DeliveryID: delivery.ID}); err != nil {
+			slog.Warn("Failed to enqueue webhook delivery", "delivery", delivery.ID, "error", err)
+		}
+	}
+	return delivery, nil
+}
+
+// Deliver attempts the delivery to the current URL of its webhook. A failed attempt is retried after a delay
+// doubled at each attempt, until the delivery is dead-lettered after MaxAttempts. The webhook is disabled once
+// its attempts fail DisableThreshold times in a row, dead-lettering its pending deliveries as they come due.
+// The returned error is about the store, the failures of the endpoint are recorded in the delivery.
+func Deliver(ctx context.Context, s *store.Store, delivery *store.WebhookDelivery, now time.Time) error {
+	hook, err := findWebhook(ctx, s, delivery.CreatorID, delivery.WebhookID)
+	if err != nil {
+		return err
+	}
+	dead := store.WebhookDeliveryDead
+	if hook == nil || hook.Disabled {
+		lastError := "webhook is deleted"
+		if hook != nil {
+			lastError = "webhook is disabled"
+		}
+		return s.UpdateWebhookDelivery(ctx, &store.UpdateWebhookDelivery{
+			ID:        delivery.ID,
+			Status:    &dead,
+			LastError: &lastError,
+		})
+	}
+
+	startTime := time.Now()
+	response, postErr := webhook.PostBody(hook.Url, hook.Secret, []byte(delivery.Payload))
+	update := newAttemptUpdate(delivery.ID, now, response, time.Since(startTime))
+	if postErr != nil {
+		attemptCount, lastError := delivery.AttemptCount+1, postErr.Error()
+		update.AttemptCount = &attemptCount
+		update.LastError = &lastError
+		if attemptCount >= MaxAttempts {
+			update.Status = &dead
+		} else {
+			nextAttemptTs := now.Add(jobqueue.RetryDelay(firstRetryDelay, attemptCount)).Unix()
+			update.NextAttemptTs = &nextAttemptTs
+		}
+		if err := s.UpdateWebhookDelivery(ctx, update); err != nil {
+			return errors.Wrap(err, "failed to update webhook delivery")
+		}
+
+		hook.FailureCount++
+		if hook.FailureCount >= DisableThreshold {
+			hook.Disabled = true
+			slog.Warn("Disabled failing webhook", "user", delivery.CreatorID, "webhook", hook.Id)
+		}
+		return s.UpdateUserWebhook(ctx, delivery.CreatorID, hook)
+	}
+
+	succeeded := store.WebhookDeliverySucceeded
+	update.Status = &succeeded
+	if err := s.UpdateWebhookDelivery(ctx, update); err != nil {
+		return errors.Wrap(err, "failed to update webhook delivery")
+	}
+	if hook.FailureCount > 0 {
+		hook.FailureCount = 0
+		return s.UpdateUserWebhook(ctx, delivery.CreatorID, hook)
+	}
+	return nil
+}
+
+// newAttemptUpdate returns the update recording the result of an attempt, whose response is nil if the endpoint did not respond.
+func newAttemptUpdate(id int32, now time.Time, response *webhook.Response, latency time.Duration) *store.UpdateWebhookDelivery {
+	lastAttemptTs, statusCode, body, latencyMs := now.Unix(), int32(0), "", int32(latency.Milliseconds())
+	if response != nil {
+		statusCode = int32(response.StatusCode)
+		body = truncateResponseBody(response.Body)
+	}
+	return &store.UpdateWebhookDelivery{
+		ID:                 id,
+		LastAttemptTs:      &lastAttemptTs,
+		ResponseStatusCode: &statusCode,
+		ResponseBody:       &body,
+		LatencyMs:          &latencyMs,
+	}
+}
+
+// truncateResponseBody returns the beginning of the body, at most maxResponseBodyLength bytes of valid UTF-8.
+func truncateResponseBody(body []byte) string {
+	if len(body) > maxResponseBodyLength {
+		body = body[:maxResponseBodyLength]
+	}
+	return strings.ToValidUTF8(string(body), "")
+}
+
+func findWebhook(ctx context.Context, s *store.Store, userID int32, webhookID string) (*storepb.WebhooksUserSetting_Webhook, error) {
+	webhooks, err := s.GetUserWebhooks(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user webhooks")
+	}
+	for _, hook := range webhooks {
+		if hook.Id == webhookID {
+			return hook, nil
+		}
+	}
+	return nil, nil
+}
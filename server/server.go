@@ -8,6 +8,7 @@ import (
 	"net"
 	"net/http"
 	"runtime"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,12 +20,45 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/accesslog"
+	"github.com/usememos/memos/plugin/cluster"
+	"github.com/usememos/memos/plugin/discord"
+	"github.com/usememos/memos/plugin/eventbus"
+	"github.com/usememos/memos/plugin/gist"
+	"github.com/usememos/memos/plugin/hook"
+	"github.com/usememos/memos/plugin/httpcompress"
+	"github.com/usememos/memos/plugin/mailin"
+	"github.com/usememos/memos/plugin/readwise"
+	"github.com/usememos/memos/plugin/webpush"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/server/profiler"
 	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/server/router/calendar"
 	"github.com/usememos/memos/server/router/frontend"
+	"github.com/usememos/memos/server/router/health"
 	"github.com/usememos/memos/server/router/rss"
+	"github.com/usememos/memos/server/router/scim"
+	"github.com/usememos/memos/server/runner/accesstokencleanup"
+	"github.com/usememos/memos/server/runner/attachmentintegrity"
+	"github.com/usememos/memos/server/runner/attachmentocr"
+	"github.com/usememos/memos/server/runner/attachmenttext"
+	"github.com/usememos/memos/server/runner/attachmenttranscription"
+	"github.com/usememos/memos/server/runner/databasebackup"
+	"github.com/usememos/memos/server/runner/discordbot"
+	"github.com/usememos/memos/server/runner/discorddigest"
+	"github.com/usememos/memos/server/runner/emaildigest"
+	"github.com/usememos/memos/server/runner/gistsync"
+	"github.com/usememos/memos/server/runner/imapingest"
+	"github.com/usememos/memos/server/runner/inboxcleanup"
+	"github.com/usememos/memos/server/runner/jobqueue"
+	"github.com/usememos/memos/server/runner/linkpreview"
+	"github.com/usememos/memos/server/runner/memochangecleanup"
+	"github.com/usememos/memos/server/runner/memoembedding"
+	"github.com/usememos/memos/server/runner/onthisday"
+	"github.com/usememos/memos/server/runner/readwisesync"
 	"github.com/usememos/memos/server/runner/s3presign"
+	"github.com/usememos/memos/server/runner/scheduler"
+	"github.com/usememos/memos/server/runner/webhookdelivery"
 	"github.com/usememos/memos/store"
 )
 
@@ -36,7 +70,23 @@ type Server struct {
 	echoServer        *echo.Echo
 	grpcServer        *grpc.Server
 	profiler          *profiler.Profiler
+	memoEmailHandler  mailin.Handler
+	discordHandler    discord.Handler
+	readwiseHandler   readwise.Handler
+	gistHandler       gist.Handler
+	onThisDayNotifier onthisday.Notifier
+	eventPublisher    eventbus.Publisher
+	accessLogger      *accesslog.Logger
+	mailinServer      *mailin.Server
+	// cluster coordinates the instances of the server, if running in cluster mode.
+	cluster *cluster.Cluster
+	// jobQueue runs the background jobs enqueued by the requests.
+	jobQueue *jobqueue.Queue
+	// scheduler runs the maintenance tasks on their schedule, as jobs of the queue.
+	scheduler         *scheduler.Scheduler
 	runnerCancelFuncs []context.CancelFunc
+	// runners tracks the background runners and the SMTP server, which are waited for on shutdown.
+	runners sync.WaitGroup
 }
 
 func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store) (*Server, error) {
@@ -50,6 +100,8 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	echoServer.HideBanner = true
 	echoServer.HidePort = true
 	echoServer.Use(middleware.Recover())
+	// Compress the responses, e.g. the API, the feeds and the exports, for the clients accepting it.
+	echoServer.Use(compressMiddleware)
 	s.echoServer = echoServer
 
 	// Initialize profiler
@@ -68,10 +120,8 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	}
 	s.Secret = secret
 
-	// Register healthz endpoint.
-	echoServer.GET("/healthz", func(c echo.Context) error {
-		return c.String(http.StatusOK, "Service ready.")
-	})
+	// Register the liveness and readiness probes.
+	health.NewHealthService(profile, store).RegisterRoutes(echoServer)
 
 	// Serve frontend static files.
 	frontend.NewFrontendService(profile, store).Serve(ctx, echoServer)
@@ -79,24 +129,100 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	rootGroup := echoServer.Group("")
 
 	// Create and register RSS routes.
-	rss.NewRSSService(s.Profile, s.Store).RegisterRoutes(rootGroup)
+	rssService := rss.NewRSSService(s.Profile, s.Store)
+	rssService.RegisterRoutes(rootGroup)
+
+	// Create and register calendar feed routes.
+	calendar.NewCalendarService(s.Profile, s.Store).RegisterRoutes(rootGroup)
+
+	// Create and register SCIM routes.
+	scim.NewSCIMService(s.Profile, s.Store).RegisterRoutes(rootGroup)
+
+	// Coordinate the instances of the cluster through the Redis server of the cache, if enabled.
+	if profile.Cluster {
+		redisClient := store.GetRedisClient()
+		if redisClient == nil {
+			return nil, errors.New("cluster mode requires the redis cache")
+		}
+		s.cluster = cluster.New(redisClient)
+		slog.Info("running in cluster mode", slog.String("instance", s.cluster.InstanceID()))
+	}
+	authInterceptor := apiv1.NewGRPCAuthInterceptor(store, secret)
+	authInterceptor.Cluster = s.cluster
 
 	grpcServer := grpc.NewServer(
 		// Override the maximum receiving message size to math.MaxInt32 for uploading large attachments.
 		grpc.MaxRecvMsgSize(math.MaxInt32),
 		grpc.ChainUnaryInterceptor(
+			apiv1.NewTracingInterceptor().TracingInterceptor,
 			apiv1.NewLoggerInterceptor().LoggerInterceptor,
 			grpcrecovery.UnaryServerInterceptor(),
-			apiv1.NewGRPCAuthInterceptor(store, secret).AuthenticationInterceptor,
+			authInterceptor.AuthenticationInterceptor,
 		))
 	s.grpcServer = grpcServer
 
 	apiV1Service := apiv1.NewAPIV1Service(s.Secret, profile, store, grpcServer)
+	apiV1Service.Cluster = s.cluster
+	apiV1Service.AuthInterceptor = authInterceptor
+	s.jobQueue = jobqueue.NewQueue(store)
+	apiV1Service.UseJobQueue(s.jobQueue)
+	// Call the hooks of the instance on the events of the memos, if enabled.
+	if profile.Hooks != "" {
+		hookConfig, err := hook.LoadConfig(profile.Hooks)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load hooks")
+		}
+		hooks := hook.NewRunner(hookConfig)
+		apiV1Service.Hooks = hooks
+		rssService.Hooks = hooks
+	}
+	s.scheduler = scheduler.NewScheduler(store, s.jobQueue)
+	s.registerScheduledTasks()
+	apiV1Service.Scheduler = s.scheduler
+	// Publish the activities to the event bus, if enabled.
+	if profile.EventBusURL != "" {
+		eventPublisher, err := eventbus.NewPublisher(profile.EventBusURL, profile.EventBusTopic)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create event bus publisher")
+		}
+		s.eventPublisher = eventPublisher
+		apiV1Service.EventPublisher = eventPublisher
+	}
+	// Notify the WebSub hub of the updates of the feeds, if enabled.
+	if rssService.IsWebSubEnabled() {
+		apiV1Service.PublishFeeds = rssService.PublishUserFeeds
+	}
+	// Log the requests served by the echo server, if enabled.
+	if profile.AccessLog != "" {
+		accessLogger, err := accesslog.NewLogger(profile.AccessLog, int64(profile.AccessLogMaxSize)*1024*1024, profile.AccessLogMaxBackups)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create access logger")
+		}
+		s.accessLogger = accessLogger
+		echoServer.Use(apiV1Service.AccessLogMiddleware(accessLogger))
+	}
 	// Register gRPC gateway as api v1.
 	if err := apiV1Service.RegisterGateway(ctx, echoServer); err != nil {
 		return nil, errors.Wrap(err, "failed to register gRPC gateway")
 	}
 
+	// Receive the memos emailed by the users, if enabled, while the IMAP mailbox is polled by its runner.
+	s.memoEmailHandler = apiV1Service.NewMemoEmailHandler()
+	if profile.SMTPAddr != "" {
+		s.mailinServer = &mailin.Server{
+			Hostname: profile.GetSMTPDomain(),
+			Handler:  s.memoEmailHandler,
+		}
+	}
+	// Save the messages the users react to on Discord, while the interactions are received by the API routes.
+	s.discordHandler = apiV1Service.NewDiscordHandler()
+	// Save the books of the connected Readwise accounts, synced by their runner.
+	s.readwiseHandler = apiV1Service.NewReadwiseHandler()
+	// Pull the gists edited on GitHub into the memos, synced by their runner.
+	s.gistHandler = apiV1Service.NewGistHandler()
+	// Notify the users of their memos created on this day, scheduled by their runner.
+	s.onThisDayNotifier = apiV1Service.NewOnThisDayNotifier()
+
 	return s, nil
 }
 
@@ -124,40 +250,105 @@ func (s *Server) Start(ctx context.Context) error {
 	go func() {
 		httpListener := muxServer.Match(cmux.HTTP1Fast(http.MethodPatch))
 		s.echoServer.Listener = httpListener
-		if err := s.echoServer.Start(address); err != nil {
+		if err := s.echoServer.Start(address); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			slog.Error("failed to start echo server", "error", err)
 		}
 	}()
 	go func() {
-		if err := muxServer.Serve(); err != nil {
+		// The listener is closed by the shutdown of the echo server.
+		if err := muxServer.Serve(); err != nil && !errors.Is(err, net.ErrClosed) {
 			slog.Error("mux server listen error", "error", err)
 		}
 	}()
+	if s.mailinServer != nil {
+		smtpListener, err := net.Listen("tcp", s.Profile.SMTPAddr)
+		if err != nil {
+			return errors.Wrap(err, "failed to listen for SMTP")
+		}
+		mailinContext, mailinCancel := context.WithCancel(ctx)
+		s.runnerCancelFuncs = append(s.runnerCancelFuncs, mailinCancel)
+		s.runners.Add(1)
+		go func() {
+			defer s.runners.Done()
+			if err := s.mailinServer.Serve(mailinContext, smtpListener); err != nil {
+				slog.Error("failed to serve SMTP", "error", err)
+			}
+		}()
+	}
 	s.StartBackgroundRunners(ctx)
 
 	return nil
 }
 
+// Reload reloads the settings of the workspace from the database, and applies the log level of the profile again,
+// reverting the level set by the ReloadWorkspace API.
+func (s *Server) Reload(ctx context.Context) {
+	s.Store.ReloadSettings(ctx)
+	slog.SetLogLoggerLevel(s.Profile.GetLogLevel())
+	slog.Info("configuration reloaded", slog.String("logLevel", s.Profile.GetLogLevel().String()))
+}
+
+// Shutdown drains the server within the shutdown timeout of the profile: the new requests are refused while the
+// in-flight ones are finished, then the background runners finish their current item and stop, before the store is closed.
 func (s *Server) Shutdown(ctx context.Context) {
-	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	shutdownTimeout := s.Profile.ShutdownTimeout
+	if shutdownTimeout <= 0 {
+		shutdownTimeout = profile.DefaultShutdownTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, shutdownTimeout)
 	defer cancel()
 
-	slog.Info("server shutting down")
+	slog.Info("server shutting down", slog.Duration("timeout", shutdownTimeout))
+
+	// Shutdown echo server, which closes the listener and waits for the in-flight HTTP requests,
+	// including the ones of the gRPC gateway calling the gRPC server.
+	if err := s.echoServer.Shutdown(ctx); err != nil {
+		slog.Error("failed to shutdown server", slog.String("error", err.Error()))
+	}
+
+	// Shutdown gRPC server, waiting for the in-flight calls of the gRPC clients until the timeout.
+	grpcStopped := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(grpcStopped)
+	}()
+	select {
+	case <-grpcStopped:
+	case <-ctx.Done():
+		slog.Warn("gRPC calls still in flight after the shutdown timeout")
+		s.grpcServer.Stop()
+	}
 
-	// Cancel all background runners
+	// Cancel all background runners, which stop after their current item, and wait for them.
 	for _, cancelFunc := range s.runnerCancelFuncs {
 		if cancelFunc != nil {
 			cancelFunc()
 		}
 	}
+	runnersStopped := make(chan struct{})
+	go func() {
+		s.runners.Wait()
+		close(runnersStopped)
+	}()
+	select {
+	case <-runnersStopped:
+	case <-ctx.Done():
+		slog.Warn("background runners still running after the shutdown timeout")
+	}
 
-	// Shutdown echo server.
-	if err := s.echoServer.Shutdown(ctx); err != nil {
-		slog.Error("failed to shutdown server", slog.String("error", err.Error()))
+	// Publish the pending events.
+	if s.eventPublisher != nil {
+		if err := s.eventPublisher.Close(); err != nil {
+			slog.Error("failed to close event bus publisher", slog.String("error", err.Error()))
+		}
 	}
 
-	// Shutdown gRPC server.
-	s.grpcServer.GracefulStop()
+	// Close the access log.
+	if s.accessLogger != nil {
+		if err := s.accessLogger.Close(); err != nil {
+			slog.Error("failed to close access log", slog.String("error", err.Error()))
+		}
+	}
 
 	// Stop the profiler
 	if s.profiler != nil {
@@ -182,27 +373,237 @@ func (s *Server) Shutdown(ctx context.Context) {
 }
 
 func (s *Server) StartBackgroundRunners(ctx context.Context) {
-	// Create a separate context for each background runner
-	// This allows us to control cancellation for each runner independently
-	s3Context, s3Cancel := context.WithCancel(ctx)
-
+	runnersCtx, cancel := context.WithCancel(ctx)
 	// Store the cancel function so we can properly shut down runners
-	s.runnerCancelFuncs = append(s.runnerCancelFuncs, s3Cancel)
+	s.runnerCancelFuncs = append(s.runnerCancelFuncs, cancel)
 
-	// Create and start S3 presign runner
+	// Start the job queue, on each instance of the cluster as every job is claimed by one of them.
+	s.runners.Add(1)
+	go func() {
+		defer s.runners.Done()
+		s.jobQueue.Run(runnersCtx)
+		slog.Info("job queue stopped")
+	}()
+
+	// Start storing the requests of the access tokens, on each instance of the cluster as each counts its requests.
+	accessTokenUsageRunner := accesstokencleanup.NewRunner(s.Store)
+	s.runners.Add(1)
+	go func() {
+		defer s.runners.Done()
+		accessTokenUsageRunner.Run(runnersCtx)
+		slog.Info("access token usage runner stopped")
+	}()
+
+	if s.cluster == nil {
+		s.startBackgroundRunners(runnersCtx, &s.runners)
+		return
+	}
+
+	// Only the leader of the cluster runs the background runners, which are taken over by another instance if it fails.
+	s.runners.Add(1)
+	go func() {
+		defer s.runners.Done()
+		s.cluster.RunAsLeader(runnersCtx, "runners", func(ctx context.Context) {
+			var runners sync.WaitGroup
+			s.startBackgroundRunners(ctx, &runners)
+			<-ctx.Done()
+			runners.Wait()
+		})
+	}()
+}
+
+// startBackgroundRunners starts the background runners, which stop when the context is done.
+func (s *Server) startBackgroundRunners(ctx context.Context, runners *sync.WaitGroup) {
+	// Create and start S3 presign runner, which also signs the GCS URLs again
 	s3presignRunner := s3presign.NewRunner(s.Store)
 	s3presignRunner.RunOnce(ctx)
 
 	// Start continuous S3 presign runner
+	runners.Add(1)
 	go func() {
-		s3presignRunner.Run(s3Context)
+		defer runners.Done()
+		s3presignRunner.Run(ctx)
 		slog.Info("s3presign runner stopped")
 	}()
 
+	// Start memo embedding runner, the first run calls the embedding provider so it is not awaited.
+	memoEmbeddingRunner := memoembedding.NewRunner(s.Store)
+	runners.Add(1)
+	go func() {
+		defer runners.Done()
+		memoEmbeddingRunner.RunOnce(ctx)
+		memoEmbeddingRunner.Run(ctx)
+		slog.Info("memo embedding runner stopped")
+	}()
+
+	// Start attachment text runner, the first run reads attachment files so it is not awaited.
+	attachmentTextRunner := attachmenttext.NewRunner(s.Store, s.Profile)
+	runners.Add(1)
+	go func() {
+		defer runners.Done()
+		attachmentTextRunner.RunOnce(ctx)
+		attachmentTextRunner.Run(ctx)
+		slog.Info("attachment text runner stopped")
+	}()
+
+	// Start attachment OCR runner, the first run recognizes images so it is not awaited.
+	attachmentOCRRunner := attachmentocr.NewRunner(s.Store, s.Profile)
+	runners.Add(1)
+	go func() {
+		defer runners.Done()
+		attachmentOCRRunner.RunOnce(ctx)
+		attachmentOCRRunner.Run(ctx)
+		slog.Info("attachment OCR runner stopped")
+	}()
+
+	// Start attachment transcription runner, the first run transcribes recordings so it is not awaited.
+	attachmentTranscriptionRunner := attachmenttranscription.NewRunner(s.Store, s.Profile)
+	runners.Add(1)
+	go func() {
+		defer runners.Done()
+		attachmentTranscriptionRunner.RunOnce(ctx)
+		attachmentTranscriptionRunner.Run(ctx)
+		slog.Info("attachment transcription runner stopped")
+	}()
+
+	// Start attachment integrity runner, the first run reads every stored file so it is not awaited.
+	attachmentIntegrityRunner := attachmentintegrity.NewRunner(s.Store, s.Profile)
+	runners.Add(1)
+	go func() {
+		defer runners.Done()
+		attachmentIntegrityRunner.RunOnce(ctx)
+		attachmentIntegrityRunner.Run(ctx)
+		slog.Info("attachment integrity runner stopped")
+	}()
+
+	// Start link preview runner, the first run fetches the pages of the links so it is not awaited.
+	linkPreviewRunner := linkpreview.NewRunner(s.Store)
+	runners.Add(1)
+	go func() {
+		defer runners.Done()
+		linkPreviewRunner.RunOnce(ctx)
+		linkPreviewRunner.Run(ctx)
+		slog.Info("link preview runner stopped")
+	}()
+
+	// Start webhook delivery runner, the first run posts the due deliveries so it is not awaited.
+	webhookDeliveryRunner := webhookdelivery.NewRunner(s.Store)
+	runners.Add(1)
+	go func() {
+		defer runners.Done()
+		webhookDeliveryRunner.RunOnce(ctx)
+		webhookDeliveryRunner.Run(ctx)
+		slog.Info("webhook delivery runner stopped")
+	}()
+
+	// Start IMAP ingestion runner, the first run connects to the IMAP server so it is not awaited.
+	imapIngestRunner := imapingest.NewRunner(s.Store, s.memoEmailHandler)
+	runners.Add(1)
+	go func() {
+		defer runners.Done()
+		imapIngestRunner.RunOnce(ctx)
+		imapIngestRunner.Run(ctx)
+		slog.Info("IMAP ingestion runner stopped")
+	}()
+
+	// Start Discord bot runner, which registers the commands and listens to the reactions on the gateway.
+	discordBotRunner := discordbot.NewRunner(s.Store, s.discordHandler, apiv1.DiscordCommands)
+	runners.Add(1)
+	go func() {
+		defer runners.Done()
+		discordBotRunner.Run(ctx)
+		slog.Info("Discord bot runner stopped")
+	}()
+
+	// Start Discord digest runner, which posts the digests on their schedule.
+	discordDigestRunner := discorddigest.NewRunner(s.Store, s.Profile)
+	runners.Add(1)
+	go func() {
+		defer runners.Done()
+		discordDigestRunner.Run(ctx)
+		slog.Info("Discord digest runner stopped")
+	}()
+
+	onThisDayRunner := onthisday.NewRunner(s.Store, s.onThisDayNotifier)
+	runners.Add(1)
+	go func() {
+		defer runners.Done()
+		onThisDayRunner.Run(ctx)
+		slog.Info("On this day runner stopped")
+	}()
+
+	// Start Readwise sync runner, the first run fetches the highlights so it is not awaited.
+	readwiseSyncRunner := readwisesync.NewRunner(s.Store, s.readwiseHandler)
+	runners.Add(1)
+	go func() {
+		defer runners.Done()
+		readwiseSyncRunner.RunOnce(ctx)
+		readwiseSyncRunner.Run(ctx)
+		slog.Info("Readwise sync runner stopped")
+	}()
+
+	// Start gist sync runner, the first run calls the GitHub API so it is not awaited.
+	gistSyncRunner := gistsync.NewRunner(s.Store, s.gistHandler)
+	runners.Add(1)
+	go func() {
+		defer runners.Done()
+		gistSyncRunner.RunOnce(ctx)
+		gistSyncRunner.Run(ctx)
+		slog.Info("Gist sync runner stopped")
+	}()
+
+	// Start the scheduler, which runs the maintenance tasks on their schedule.
+	runners.Add(1)
+	go func() {
+		defer runners.Done()
+		s.scheduler.Run(ctx)
+		slog.Info("scheduler stopped")
+	}()
+
 	// Log the number of goroutines running
 	slog.Info("background runners started", "goroutines", runtime.NumGoroutine())
 }
 
+// registerScheduledTasks registers the maintenance tasks run by the scheduler, whose schedules are managed by the admins.
+func (s *Server) registerScheduledTasks() {
+	s.scheduler.Register(scheduler.Task{
+		Name:            "access_token_cleanup",
+		Description:     "Revokes the access tokens unused for the idle revocation period of the workspace.",
+		DefaultSchedule: "0 * * * *",
+		Run:             accesstokencleanup.NewRunner(s.Store).RunOnce,
+	})
+	s.scheduler.Register(scheduler.Task{
+		Name:            "memo_change_cleanup",
+		Description:     "Deletes the memo changes synced by the clients after 30 days.",
+		DefaultSchedule: "0 * * * *",
+		Run:             memochangecleanup.NewRunner(s.Store).RunOnce,
+	})
+	s.scheduler.Register(scheduler.Task{
+		Name:            "inbox_cleanup",
+		Description:     "Deletes the archived inboxes older than the inbox retention days of the workspace.",
+		DefaultSchedule: "0 * * * *",
+		Run:             inboxcleanup.NewRunner(s.Store).RunOnce,
+	})
+	// The users choose the time of their digests, which the task checks.
+	emailDigestRunner := emaildigest.NewRunner(s.Store, s.Profile)
+	s.scheduler.Register(scheduler.Task{
+		Name:            "email_digest",
+		Description:     "Emails the users their digest once its scheduled time has come.",
+		DefaultSchedule: "*/5 * * * *",
+		Run: func(ctx context.Context) error {
+			return emailDigestRunner.RunOnce(ctx, time.Now())
+		},
+	})
+	if s.Profile.Driver == "sqlite" {
+		s.scheduler.Register(scheduler.Task{
+			Name:            "database_backup",
+			Description:     "Copies the SQLite database into the backups directory of the data directory, keeping the last 7 copies.",
+			DefaultSchedule: "0 3 * * *",
+			Run:             databasebackup.NewRunner(s.Store, s.Profile).RunOnce,
+		})
+	}
+}
+
 func (s *Server) getOrUpsertWorkspaceBasicSetting(ctx context.Context) (*storepb.WorkspaceBasicSetting, error) {
 	workspaceBasicSetting, err := s.Store.GetWorkspaceBasicSetting(ctx)
 	if err != nil {
@@ -213,6 +614,15 @@ func (s *Server) getOrUpsertWorkspaceBasicSetting(ctx context.Context) (*storepb
 		workspaceBasicSetting.SecretKey = uuid.NewString()
 		modified = true
 	}
+	if workspaceBasicSetting.VapidPrivateKey == "" || workspaceBasicSetting.VapidPublicKey == "" {
+		privateKey, publicKey, err := webpush.GenerateVAPIDKeys()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to generate VAPID keys")
+		}
+		workspaceBasicSetting.VapidPrivateKey = privateKey
+		workspaceBasicSetting.VapidPublicKey = publicKey
+		modified = true
+	}
 	if modified {
 		workspaceSetting, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
 			Key:   storepb.WorkspaceSettingKey_BASIC,
@@ -225,3 +635,22 @@ func (s *Server) getOrUpsertWorkspaceBasicSetting(ctx context.Context) (*storepb
 	}
 	return workspaceBasicSetting, nil
 }
+
+// compressMiddleware compresses the responses of the echo handlers with httpcompress. The errors are handled within
+// the compressed response, so that the error responses are written before it is closed.
+func compressMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		response := c.Response()
+		httpcompress.Handler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			writer := response.Writer
+			response.Writer = w
+			defer func() {
+				response.Writer = writer
+			}()
+			if err := next(c); err != nil {
+				c.Error(err)
+			}
+		})).ServeHTTP(response.Writer, c.Request())
+		return nil
+	}
+}
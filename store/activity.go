@@ -10,6 +10,15 @@ type ActivityType string
 
 const (
 	ActivityTypeMemoComment ActivityType = "MEMO_COMMENT"
+	// A memo mentioned a user.
+	ActivityTypeMemoMention ActivityType = "MEMO_MENTION"
+	// A user reacted to a memo.
+	ActivityTypeMemoReaction ActivityType = "MEMO_REACTION"
+	// The host started or stopped to impersonate a user.
+	ActivityTypeUserImpersonationStart ActivityType = "USER_IMPERSONATION_START"
+	ActivityTypeUserImpersonationEnd   ActivityType = "USER_IMPERSONATION_END"
+	// A user account was deleted with its data.
+	ActivityTypeUserDelete ActivityType = "USER_DELETE"
 )
 
 func (t ActivityType) String() string {
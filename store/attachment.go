@@ -9,7 +9,9 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/usememos/memos/internal/base"
+	"github.com/usememos/memos/plugin/storage/gcs"
 	"github.com/usememos/memos/plugin/storage/s3"
+	"github.com/usememos/memos/plugin/storage/sftp"
 	storepb "github.com/usememos/memos/proto/gen/store"
 )
 
@@ -32,6 +34,8 @@ type Attachment struct {
 	StorageType storepb.AttachmentStorageType
 	Reference   string
 	Payload     *storepb.AttachmentPayload
+	// ContentHash is the hex SHA-256 of the content, shared by the attachments reusing the same stored file.
+	ContentHash string
 
 	// The related memo ID.
 	MemoID *int32
@@ -45,6 +49,7 @@ type FindAttachment struct {
 	Filename       *string
 	FilenameSearch *string
 	MemoID         *int32
+	ContentHash    *string
 	HasRelatedMemo bool
 	StorageType    *storepb.AttachmentStorageType
 	Limit          *int
@@ -59,6 +64,13 @@ type UpdateAttachment struct {
 	MemoID    *int32
 	Reference *string
 	Payload   *storepb.AttachmentPayload
+	// StorageType and Blob are updated when the content moves to another storage.
+	StorageType *storepb.AttachmentStorageType
+	Blob        *[]byte
+	// Type, Size and ContentHash are updated when the content is replaced.
+	Type        *string
+	Size        *int64
+	ContentHash *string
 }
 
 type DeleteAttachment struct {
@@ -70,7 +82,13 @@ func (s *Store) CreateAttachment(ctx context.Context, create *Attachment) (*Atta
 	if !base.UIDMatcher.MatchString(create.UID) {
 		return nil, errors.New("invalid uid")
 	}
-	return s.driver.CreateAttachment(ctx, create)
+	attachment, err := s.driver.CreateAttachment(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidatePublicContent(ctx)
+	s.invalidateMemoListCache(ctx)
+	return attachment, nil
 }
 
 func (s *Store) ListAttachments(ctx context.Context, find *FindAttachment) ([]*Attachment, error) {
@@ -105,7 +123,12 @@ func (s *Store) UpdateAttachment(ctx context.Context, update *UpdateAttachment)
 	if update.UID != nil && !base.UIDMatcher.MatchString(*update.UID) {
 		return errors.New("invalid uid")
 	}
-	return s.driver.UpdateAttachment(ctx, update)
+	if err := s.driver.UpdateAttachment(ctx, update); err != nil {
+		return err
+	}
+	s.invalidatePublicContent(ctx)
+	s.invalidateMemoListCache(ctx)
+	return nil
 }
 
 func (s *Store) DeleteAttachment(ctx context.Context, delete *DeleteAttachment) error {
@@ -117,50 +140,192 @@ func (s *Store) DeleteAttachment(ctx context.Context, delete *DeleteAttachment)
 		return errors.New("attachment not found")
 	}
 
-	if attachment.StorageType == storepb.AttachmentStorageType_LOCAL {
-		if err := func() error {
-			p := filepath.FromSlash(attachment.Reference)
-			if !filepath.IsAbs(p) {
-				p = filepath.Join(s.profile.Data, p)
-			}
-			err := os.Remove(p)
-			if err != nil {
-				return errors.Wrap(err, "failed to delete local file")
-			}
-			return nil
-		}(); err != nil {
+	// The versions are deleted first, so that the content they share with the attachment is deleted with it.
+	versions, err := s.ListAttachmentVersions(ctx, &FindAttachmentVersion{AttachmentID: &delete.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list attachment versions")
+	}
+	for _, version := range versions {
+		if err := s.DeleteAttachmentVersion(ctx, &DeleteAttachmentVersion{ID: version.ID}); err != nil {
+			return errors.Wrap(err, "failed to delete attachment version")
+		}
+	}
+
+	if err := s.DeleteAttachmentContent(ctx, attachment); err != nil {
+		// The remote objects left behind are only wasted space, unlike the local files.
+		if attachment.StorageType == storepb.AttachmentStorageType_LOCAL {
+			return err
+		}
+		slog.Warn("Failed to delete attachment content", slog.Any("err", err))
+	}
+
+	if err := s.driver.DeleteAttachmentText(ctx, &DeleteAttachmentText{AttachmentID: delete.ID}); err != nil {
+		return errors.Wrap(err, "failed to delete attachment text")
+	}
+	if err := s.driver.DeleteAttachment(ctx, delete); err != nil {
+		return err
+	}
+	s.invalidatePublicContent(ctx)
+	s.invalidateMemoListCache(ctx)
+	return nil
+}
+
+// DeleteAttachmentContent removes the stored file or object of the attachment, unless other attachments or versions reuse it.
+// The content stored in the database is removed with the attachment itself.
+func (s *Store) DeleteAttachmentContent(ctx context.Context, attachment *Attachment) error {
+	return s.deleteAttachmentContent(ctx, attachment, 0)
+}
+
+// deleteAttachmentContent removes the stored file or object of the attachment,
+// or of the version with the ID if not zero, unless others reuse it.
+func (s *Store) deleteAttachmentContent(ctx context.Context, attachment *Attachment, versionID int32) error {
+	// The content shared with other attachments is kept until its last attachment is deleted.
+	shared, err := s.isAttachmentContentShared(ctx, attachment, versionID)
+	if err != nil {
+		return errors.Wrap(err, "failed to check shared attachment content")
+	}
+	if shared {
+		return nil
+	}
+
+	switch attachment.StorageType {
+	case storepb.AttachmentStorageType_LOCAL:
+		p := filepath.FromSlash(attachment.Reference)
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(s.profile.Data, p)
+		}
+		if err := os.Remove(p); err != nil {
 			return errors.Wrap(err, "failed to delete local file")
 		}
-	} else if attachment.StorageType == storepb.AttachmentStorageType_S3 {
-		if err := func() error {
-			s3ObjectPayload := attachment.Payload.GetS3Object()
-			if s3ObjectPayload == nil {
-				return errors.Errorf("No s3 object found")
+	case storepb.AttachmentStorageType_S3:
+		s3ObjectPayload := attachment.Payload.GetS3Object()
+		if s3ObjectPayload == nil {
+			return errors.Errorf("No s3 object found")
+		}
+		s3Config := s3ObjectPayload.S3Config
+		if s3Config == nil {
+			workspaceStorageSetting, err := s.GetWorkspaceStorageSetting(ctx)
+			if err != nil {
+				return errors.Wrap(err, "failed to get workspace storage setting")
+			}
+			if workspaceStorageSetting.S3Config == nil {
+				return errors.Errorf("S3 config is not found")
 			}
+			s3Config = workspaceStorageSetting.S3Config
+		}
+
+		s3Client, err := s3.NewClient(ctx, s3Config)
+		if err != nil {
+			return errors.Wrap(err, "Failed to create s3 client")
+		}
+		if err := s3Client.DeleteObject(ctx, s3ObjectPayload.Key); err != nil {
+			return errors.Wrap(err, "Failed to delete s3 object")
+		}
+	case storepb.AttachmentStorageType_GCS:
+		gcsObjectPayload := attachment.Payload.GetGcsObject()
+		if gcsObjectPayload == nil {
+			return errors.Errorf("No gcs object found")
+		}
+		gcsConfig := gcsObjectPayload.GcsConfig
+		if gcsConfig == nil {
 			workspaceStorageSetting, err := s.GetWorkspaceStorageSetting(ctx)
 			if err != nil {
 				return errors.Wrap(err, "failed to get workspace storage setting")
 			}
-			s3Config := s3ObjectPayload.S3Config
-			if s3Config == nil {
-				if workspaceStorageSetting.S3Config == nil {
-					return errors.Errorf("S3 config is not found")
-				}
-				s3Config = workspaceStorageSetting.S3Config
+			if workspaceStorageSetting.GcsConfig == nil {
+				return errors.Errorf("GCS config is not found")
 			}
+			gcsConfig = workspaceStorageSetting.GcsConfig
+		}
 
-			s3Client, err := s3.NewClient(ctx, s3Config)
+		gcsClient, err := gcs.NewClient(ctx, gcsConfig)
+		if err != nil {
+			return errors.Wrap(err, "Failed to create gcs client")
+		}
+		if err := gcsClient.DeleteObject(ctx, gcsObjectPayload.Key); err != nil {
+			return errors.Wrap(err, "Failed to delete gcs object")
+		}
+	case storepb.AttachmentStorageType_SFTP:
+		sftpObjectPayload := attachment.Payload.GetSftpObject()
+		if sftpObjectPayload == nil {
+			return errors.Errorf("No sftp object found")
+		}
+		sftpConfig := sftpObjectPayload.SftpConfig
+		if sftpConfig == nil {
+			workspaceStorageSetting, err := s.GetWorkspaceStorageSetting(ctx)
 			if err != nil {
-				return errors.Wrap(err, "Failed to create s3 client")
+				return errors.Wrap(err, "failed to get workspace storage setting")
 			}
-			if err := s3Client.DeleteObject(ctx, s3ObjectPayload.Key); err != nil {
-				return errors.Wrap(err, "Failed to delete s3 object")
+			if workspaceStorageSetting.SftpConfig == nil {
+				return errors.Errorf("SFTP config is not found")
 			}
-			return nil
-		}(); err != nil {
-			slog.Warn("Failed to delete s3 object", slog.Any("err", err))
+			sftpConfig = workspaceStorageSetting.SftpConfig
 		}
+
+		sftpClient, err := sftp.NewClient(ctx, sftpConfig)
+		if err != nil {
+			return errors.Wrap(err, "Failed to create sftp client")
+		}
+		defer sftpClient.Close()
+		if err := sftpClient.DeleteObject(ctx, sftpObjectPayload.Path); err != nil {
+			return errors.Wrap(err, "Failed to delete sftp file")
+		}
+	default:
 	}
+	return nil
+}
 
-	return s.driver.DeleteAttachment(ctx, delete)
+// isAttachmentContentShared returns whether other attachments or versions reuse the stored file or object of the attachment,
+// or of the version with the ID if not zero.
+func (s *Store) isAttachmentContentShared(ctx context.Context, attachment *Attachment, versionID int32) (bool, error) {
+	if attachment.ContentHash == "" {
+		return false, nil
+	}
+	attachments, err := s.driver.ListAttachments(ctx, &FindAttachment{
+		ContentHash: &attachment.ContentHash,
+		StorageType: &attachment.StorageType,
+	})
+	if err != nil {
+		return false, err
+	}
+	others := []*Attachment{}
+	for _, other := range attachments {
+		if other.ID != attachment.ID {
+			others = append(others, other)
+		}
+	}
+	versions, err := s.driver.ListAttachmentVersions(ctx, &FindAttachmentVersion{
+		ContentHash: &attachment.ContentHash,
+		StorageType: &attachment.StorageType,
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, version := range versions {
+		if version.ID != versionID {
+			others = append(others, version.content())
+		}
+	}
+	for _, other := range others {
+		switch attachment.StorageType {
+		case storepb.AttachmentStorageType_LOCAL:
+			if other.Reference == attachment.Reference {
+				return true, nil
+			}
+		case storepb.AttachmentStorageType_S3:
+			if other.Payload.GetS3Object().GetKey() == attachment.Payload.GetS3Object().GetKey() {
+				return true, nil
+			}
+		case storepb.AttachmentStorageType_GCS:
+			if other.Payload.GetGcsObject().GetKey() == attachment.Payload.GetGcsObject().GetKey() {
+				return true, nil
+			}
+		case storepb.AttachmentStorageType_SFTP:
+			if other.Payload.GetSftpObject().GetPath() == attachment.Payload.GetSftpObject().GetPath() {
+				return true, nil
+			}
+		default:
+		}
+	}
+	return false, nil
 }
@@ -0,0 +1,48 @@
+package store
+
+import (
+	"context"
+)
+
+// AttachmentText is the text extracted from an attachment, indexed for full-text search.
+type AttachmentText struct {
+	AttachmentID int32
+	Content      string
+
+	// Composed fields
+	// MemoID is the ID of the memo the attachment belongs to, if any.
+	MemoID *int32
+}
+
+type FindAttachmentText struct {
+	AttachmentID     *int32
+	AttachmentIDList []int32
+	MemoIDList       []int32
+	// FullTextSearch matches the texts containing all the words of the search text.
+	FullTextSearch *string
+}
+
+type DeleteAttachmentText struct {
+	AttachmentID int32
+}
+
+func (s *Store) UpsertAttachmentText(ctx context.Context, upsert *AttachmentText) (*AttachmentText, error) {
+	attachmentText, err := s.driver.UpsertAttachmentText(ctx, upsert)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidateMemoListCache(ctx)
+	return attachmentText, nil
+}
+
+func (s *Store) ListAttachmentTexts(ctx context.Context, find *FindAttachmentText) ([]*AttachmentText, error) {
+	return s.driver.ListAttachmentTexts(ctx, find)
+}
+
+func (s *Store) DeleteAttachmentText(ctx context.Context, delete *DeleteAttachmentText) error {
+	if err := s.driver.DeleteAttachmentText(ctx, delete); err != nil {
+		return err
+	}
+	s.invalidateMemoListCache(ctx)
+	return nil
+}
@@ -0,0 +1,90 @@
+package store
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// AttachmentVersion is a previous content of an attachment, kept when its content is replaced.
+type AttachmentVersion struct {
+	ID           int32
+	AttachmentID int32
+	// CreatedTs is the time the content was replaced.
+	CreatedTs int64
+
+	// The fields of the attachment describing its previous content.
+	Filename    string
+	Blob        []byte
+	Type        string
+	Size        int64
+	StorageType storepb.AttachmentStorageType
+	Reference   string
+	Payload     *storepb.AttachmentPayload
+	ContentHash string
+}
+
+type FindAttachmentVersion struct {
+	GetBlob      bool
+	ID           *int32
+	AttachmentID *int32
+	ContentHash  *string
+	StorageType  *storepb.AttachmentStorageType
+}
+
+type DeleteAttachmentVersion struct {
+	ID int32
+}
+
+func (s *Store) CreateAttachmentVersion(ctx context.Context, create *AttachmentVersion) (*AttachmentVersion, error) {
+	return s.driver.CreateAttachmentVersion(ctx, create)
+}
+
+// ListAttachmentVersions returns the versions, the latest first.
+func (s *Store) ListAttachmentVersions(ctx context.Context, find *FindAttachmentVersion) ([]*AttachmentVersion, error) {
+	return s.driver.ListAttachmentVersions(ctx, find)
+}
+
+func (s *Store) GetAttachmentVersion(ctx context.Context, find *FindAttachmentVersion) (*AttachmentVersion, error) {
+	list, err := s.ListAttachmentVersions(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// DeleteAttachmentVersion deletes the version with its stored content, unless other attachments or versions reuse it.
+func (s *Store) DeleteAttachmentVersion(ctx context.Context, delete *DeleteAttachmentVersion) error {
+	version, err := s.GetAttachmentVersion(ctx, &FindAttachmentVersion{ID: &delete.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get attachment version")
+	}
+	if version == nil {
+		return errors.New("attachment version not found")
+	}
+
+	if err := s.deleteAttachmentContent(ctx, version.content(), version.ID); err != nil {
+		// The remote objects left behind are only wasted space, unlike the local files.
+		if version.StorageType == storepb.AttachmentStorageType_LOCAL {
+			return err
+		}
+		slog.Warn("Failed to delete attachment version content", slog.Any("err", err))
+	}
+	return s.driver.DeleteAttachmentVersion(ctx, delete)
+}
+
+// content returns an attachment with the stored content of the version, not matching any attachment ID.
+func (v *AttachmentVersion) content() *Attachment {
+	return &Attachment{
+		StorageType: v.StorageType,
+		Reference:   v.Reference,
+		Payload:     v.Payload,
+		ContentHash: v.ContentHash,
+	}
+}
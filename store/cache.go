@@ -1,9 +1,86 @@
 package store
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
 )
 
 func getUserSettingCacheKey(userID int32, key string) string {
 	return fmt.Sprintf("%d-%s", userID, key)
 }
+
+// GetPublicContent returns the content rendered for the anonymous visitors with the key, e.g. an RSS feed, if cached.
+func (s *Store) GetPublicContent(ctx context.Context, key string) ([]byte, bool) {
+	if s.publicContentCache == nil {
+		return nil, false
+	}
+	value, ok := s.publicContentCache.Get(ctx, key)
+	if !ok {
+		return nil, false
+	}
+	content, ok := value.([]byte)
+	return content, ok
+}
+
+// SetPublicContent caches the content rendered for the anonymous visitors with the key, until any memo, attachment,
+// user or workspace setting changes.
+func (s *Store) SetPublicContent(ctx context.Context, key string, content []byte) {
+	if s.publicContentCache == nil {
+		return
+	}
+	s.publicContentCache.Set(ctx, key, content)
+}
+
+// invalidatePublicContent drops the cached public content, which may depend on any public memo.
+func (s *Store) invalidatePublicContent(ctx context.Context) {
+	if s.publicContentCache == nil {
+		return
+	}
+	s.publicContentCache.Clear(ctx)
+}
+
+// getMemoListCacheKey returns the key of the memos found, with the values of the pointers of the find, or false if
+// they are not cached: the unpaginated lists may be large, and the filters using now() find other memos over time.
+func (s *Store) getMemoListCacheKey(find *FindMemo) (string, bool) {
+	if find.Limit == nil || (find.Filter != nil && strings.Contains(*find.Filter, "now(")) {
+		return "", false
+	}
+	data, err := json.Marshal(find)
+	if err != nil {
+		return "", false
+	}
+	return fmt.Sprintf("%d:%s", s.memoListCacheGeneration.Load(), data), true
+}
+
+// invalidateMemoListCache drops the cached memo lists, which may depend on the memos, their relations and attachments,
+// and on the groups and spaces the memos are visible to.
+func (s *Store) invalidateMemoListCache(ctx context.Context) {
+	if s.memoListCache == nil {
+		return
+	}
+	s.memoListCacheGeneration.Add(1)
+	s.memoListCache.Clear(ctx)
+}
+
+// cloneMemos copies the memos, so that the cached ones are not modified by the callers.
+func cloneMemos(memos []*Memo) []*Memo {
+	clones := make([]*Memo, 0, len(memos))
+	for _, memo := range memos {
+		clone := *memo
+		if memo.Payload != nil {
+			clone.Payload = proto.Clone(memo.Payload).(*storepb.MemoPayload)
+		}
+		if memo.ParentID != nil {
+			parentID := *memo.ParentID
+			clone.ParentID = &parentID
+		}
+		clones = append(clones, &clone)
+	}
+	return clones
+}
@@ -0,0 +1,116 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// lruItem is the value of an element of the recency list of an LRU.
+type lruItem struct {
+	key        string
+	value      any
+	expiration time.Time
+}
+
+// LRU is a thread-safe in-memory cache evicting the least recently used item when holding Config.MaxItems items.
+// Unlike Cache, the expired items are only dropped when read or evicted, so it has no background task.
+type LRU struct {
+	mu     sync.Mutex
+	config Config
+	// items is the recency list, the most recently used item first.
+	items *list.List
+	keys  map[string]*list.Element
+}
+
+// NewLRU creates a new LRU cache with the given configuration.
+func NewLRU(config Config) *LRU {
+	return &LRU{
+		config: config,
+		items:  list.New(),
+		keys:   map[string]*list.Element{},
+	}
+}
+
+// Set adds a value to the cache with the default TTL.
+func (c *LRU) Set(ctx context.Context, key string, value any) {
+	c.SetWithTTL(ctx, key, value, c.config.DefaultTTL)
+}
+
+// SetWithTTL adds a value to the cache with a custom TTL.
+func (c *LRU) SetWithTTL(_ context.Context, key string, value any, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiration := time.Now().Add(ttl)
+	if element, ok := c.keys[key]; ok {
+		item := element.Value.(*lruItem)
+		item.value, item.expiration = value, expiration
+		c.items.MoveToFront(element)
+		return
+	}
+	c.keys[key] = c.items.PushFront(&lruItem{key: key, value: value, expiration: expiration})
+	for c.config.MaxItems > 0 && c.items.Len() > c.config.MaxItems {
+		c.remove(c.items.Back())
+	}
+}
+
+// Get retrieves a value from the cache, making it the most recently used.
+func (c *LRU) Get(_ context.Context, key string) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.keys[key]
+	if !ok {
+		return nil, false
+	}
+	item := element.Value.(*lruItem)
+	if time.Now().After(item.expiration) {
+		c.remove(element)
+		return nil, false
+	}
+	c.items.MoveToFront(element)
+	return item.value, true
+}
+
+// Delete removes a value from the cache.
+func (c *LRU) Delete(_ context.Context, key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.keys[key]; ok {
+		c.remove(element)
+	}
+}
+
+// Clear removes all values from the cache.
+func (c *LRU) Clear(_ context.Context) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for c.items.Len() > 0 {
+		c.remove(c.items.Back())
+	}
+}
+
+// Size returns the number of items in the cache, including the expired ones not dropped yet.
+func (c *LRU) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return int64(c.items.Len())
+}
+
+// Close does nothing, as the cache has no background task.
+func (*LRU) Close() error {
+	return nil
+}
+
+// remove drops the element from the cache, calling OnEviction. The mutex must be held.
+func (c *LRU) remove(element *list.Element) {
+	item := c.items.Remove(element).(*lruItem)
+	delete(c.keys, item.key)
+	if c.config.OnEviction != nil {
+		c.config.OnEviction(item.key, item.value)
+	}
+}
@@ -0,0 +1,82 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLRUEviction(t *testing.T) {
+	ctx := context.Background()
+	evicted := []string{}
+	config := DefaultConfig()
+	config.MaxItems = 2
+	config.OnEviction = func(key string, _ any) {
+		evicted = append(evicted, key)
+	}
+	cache := NewLRU(config)
+
+	cache.Set(ctx, "a", 1)
+	cache.Set(ctx, "b", 2)
+	// Reading "a" makes "b" the least recently used item.
+	if _, ok := cache.Get(ctx, "a"); !ok {
+		t.Fatalf("Key 'a' should be cached")
+	}
+	cache.Set(ctx, "c", 3)
+	if _, ok := cache.Get(ctx, "b"); ok {
+		t.Errorf("Key 'b' should have been evicted")
+	}
+	if val, ok := cache.Get(ctx, "a"); !ok || val != 1 {
+		t.Errorf("Expected 1, got %v, exists: %v", val, ok)
+	}
+	if cache.Size() != 2 {
+		t.Errorf("Expected size 2, got %d", cache.Size())
+	}
+
+	// Setting an existing key replaces its value without evicting.
+	cache.Set(ctx, "c", 4)
+	if val, ok := cache.Get(ctx, "c"); !ok || val != 4 {
+		t.Errorf("Expected 4, got %v, exists: %v", val, ok)
+	}
+	if len(evicted) != 1 || evicted[0] != "b" {
+		t.Errorf("Expected 'b' to be evicted, got %v", evicted)
+	}
+
+	cache.SetWithTTL(ctx, "d", 5, 10*time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	if _, ok := cache.Get(ctx, "d"); ok {
+		t.Errorf("Key 'd' should have expired")
+	}
+
+	cache.Delete(ctx, "c")
+	cache.Clear(ctx)
+	if cache.Size() != 0 {
+		t.Errorf("Expected size 0, got %d", cache.Size())
+	}
+}
+
+func TestLRUConcurrency(t *testing.T) {
+	ctx := context.Background()
+	config := DefaultConfig()
+	config.MaxItems = 50
+	cache := NewLRU(config)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				key := fmt.Sprintf("key-%d-%d", i, j)
+				cache.Set(ctx, key, j)
+				cache.Get(ctx, key)
+			}
+		}(i)
+	}
+	wg.Wait()
+	if cache.Size() != 50 {
+		t.Errorf("Expected size 50, got %d", cache.Size())
+	}
+}
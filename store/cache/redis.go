@@ -0,0 +1,193 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+)
+
+// redisScanCount is the number of keys scanned at once when clearing or counting the keys of a RedisCache.
+const redisScanCount = 500
+
+// Codec encodes the values of a RedisCache, which are stored as bytes.
+type Codec struct {
+	Marshal   func(value any) ([]byte, error)
+	Unmarshal func(data []byte) (any, error)
+}
+
+// ProtoCodec encodes the protobuf messages created by newMessage with the binary encoding.
+func ProtoCodec(newMessage func() proto.Message) Codec {
+	return Codec{
+		Marshal: func(value any) ([]byte, error) {
+			message, ok := value.(proto.Message)
+			if !ok {
+				return nil, errors.Errorf("unexpected value of type %T", value)
+			}
+			return proto.Marshal(message)
+		},
+		Unmarshal: func(data []byte) (any, error) {
+			message := newMessage()
+			if err := proto.Unmarshal(data, message); err != nil {
+				return nil, err
+			}
+			return message, nil
+		},
+	}
+}
+
+// JSONCodec encodes the values of type *T as JSON.
+func JSONCodec[T any]() Codec {
+	return Codec{
+		Marshal: json.Marshal,
+		Unmarshal: func(data []byte) (any, error) {
+			value := new(T)
+			if err := json.Unmarshal(data, value); err != nil {
+				return nil, err
+			}
+			return value, nil
+		},
+	}
+}
+
+// BytesCodec stores the values of type []byte as is.
+func BytesCodec() Codec {
+	return Codec{
+		Marshal: func(value any) ([]byte, error) {
+			data, ok := value.([]byte)
+			if !ok {
+				return nil, errors.Errorf("unexpected value of type %T", value)
+			}
+			return data, nil
+		},
+		Unmarshal: func(data []byte) (any, error) {
+			return data, nil
+		},
+	}
+}
+
+// RedisCache is a cache stored in Redis, shared by the instances of the server.
+// Its keys are prefixed, so that several caches can share the same Redis database.
+// The errors of Redis are logged and treated as cache misses, so that the values are read again from the database.
+type RedisCache struct {
+	client redis.UniversalClient
+	prefix string
+	codec  Codec
+	config Config
+}
+
+// NewRedisCache creates a new cache of the keys with the prefix. The client is not closed with the cache.
+func NewRedisCache(client redis.UniversalClient, prefix string, codec Codec, config Config) *RedisCache {
+	return &RedisCache{
+		client: client,
+		prefix: prefix,
+		codec:  codec,
+		config: config,
+	}
+}
+
+// Set adds a value to the cache with the default TTL.
+func (c *RedisCache) Set(ctx context.Context, key string, value any) {
+	c.SetWithTTL(ctx, key, value, c.config.DefaultTTL)
+}
+
+// SetWithTTL adds a value to the cache with a custom TTL.
+func (c *RedisCache) SetWithTTL(ctx context.Context, key string, value any, ttl time.Duration) {
+	data, err := c.codec.Marshal(value)
+	if err != nil {
+		slog.Warn("failed to encode cached value", slog.String("key", c.prefix+key), slog.Any("error", err))
+		c.Delete(ctx, key)
+		return
+	}
+	if err := c.client.Set(ctx, c.prefix+key, data, ttl).Err(); err != nil {
+		slog.Warn("failed to set cached value", slog.String("key", c.prefix+key), slog.Any("error", err))
+	}
+}
+
+// Get retrieves a value from the cache.
+func (c *RedisCache) Get(ctx context.Context, key string) (any, bool) {
+	data, err := c.client.Get(ctx, c.prefix+key).Bytes()
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			slog.Warn("failed to get cached value", slog.String("key", c.prefix+key), slog.Any("error", err))
+		}
+		return nil, false
+	}
+	value, err := c.codec.Unmarshal(data)
+	if err != nil {
+		slog.Warn("failed to decode cached value", slog.String("key", c.prefix+key), slog.Any("error", err))
+		return nil, false
+	}
+	return value, true
+}
+
+// Delete removes a value from the cache.
+func (c *RedisCache) Delete(ctx context.Context, key string) {
+	if err := c.client.Del(ctx, c.prefix+key).Err(); err != nil {
+		slog.Warn("failed to delete cached value", slog.String("key", c.prefix+key), slog.Any("error", err))
+	}
+}
+
+// Clear removes all values from the cache.
+func (c *RedisCache) Clear(ctx context.Context) {
+	if err := c.scan(ctx, func(keys []string) error {
+		return c.client.Del(ctx, keys...).Err()
+	}); err != nil {
+		slog.Warn("failed to clear cache", slog.String("prefix", c.prefix), slog.Any("error", err))
+	}
+}
+
+// Size returns the number of items in the cache.
+func (c *RedisCache) Size() int64 {
+	size := int64(0)
+	if err := c.scan(context.Background(), func(keys []string) error {
+		size += int64(len(keys))
+		return nil
+	}); err != nil {
+		slog.Warn("failed to count cached values", slog.String("prefix", c.prefix), slog.Any("error", err))
+	}
+	return size
+}
+
+// Close does nothing, as the client is shared by the caches.
+func (*RedisCache) Close() error {
+	return nil
+}
+
+// scan calls fn with the batches of the keys of the cache.
+func (c *RedisCache) scan(ctx context.Context, fn func(keys []string) error) error {
+	cursor := uint64(0)
+	for {
+		keys, next, err := c.client.Scan(ctx, cursor, c.prefix+"*", redisScanCount).Result()
+		if err != nil {
+			return err
+		}
+		if len(keys) > 0 {
+			if err := fn(keys); err != nil {
+				return err
+			}
+		}
+		if next == 0 {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+// NewRedisClient connects to the Redis server of the URL, e.g. "redis://:password@localhost:6379/0".
+func NewRedisClient(ctx context.Context, url string) (redis.UniversalClient, error) {
+	options, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid redis url")
+	}
+	client := redis.NewClient(options)
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close()
+		return nil, errors.Wrap(err, "failed to connect to redis")
+	}
+	return client, nil
+}
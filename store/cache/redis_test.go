@@ -0,0 +1,89 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestRedisCache(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	defer client.Close()
+
+	type user struct {
+		ID   int32
+		Name string
+	}
+	config := DefaultConfig()
+	users := NewRedisCache(client, "test:user:", JSONCodec[user](), config)
+	messages := NewRedisCache(client, "test:message:", ProtoCodec(func() proto.Message { return &wrapperspb.StringValue{} }), config)
+	contents := NewRedisCache(client, "test:content:", BytesCodec(), config)
+
+	users.Set(ctx, "1", &user{ID: 1, Name: "john"})
+	value, ok := users.Get(ctx, "1")
+	require.True(t, ok)
+	require.Equal(t, &user{ID: 1, Name: "john"}, value)
+	_, ok = users.Get(ctx, "2")
+	require.False(t, ok)
+
+	messages.Set(ctx, "1", wrapperspb.String("hello"))
+	value, ok = messages.Get(ctx, "1")
+	require.True(t, ok)
+	require.True(t, proto.Equal(wrapperspb.String("hello"), value.(proto.Message)))
+
+	// The values of an unexpected type are not cached.
+	contents.Set(ctx, "1", "not bytes")
+	_, ok = contents.Get(ctx, "1")
+	require.False(t, ok)
+	contents.Set(ctx, "1", []byte("feed"))
+	contents.Set(ctx, "2", []byte("tags"))
+	value, ok = contents.Get(ctx, "1")
+	require.True(t, ok)
+	require.Equal(t, []byte("feed"), value)
+	require.Equal(t, int64(2), contents.Size())
+
+	users.Delete(ctx, "1")
+	_, ok = users.Get(ctx, "1")
+	require.False(t, ok)
+
+	// Clearing a cache keeps the values of the other caches.
+	contents.Clear(ctx)
+	require.Zero(t, contents.Size())
+	require.Equal(t, int64(1), messages.Size())
+
+	messages.SetWithTTL(ctx, "2", wrapperspb.String("expiring"), time.Minute)
+	server.FastForward(2 * time.Minute)
+	_, ok = messages.Get(ctx, "2")
+	require.False(t, ok)
+	_, ok = messages.Get(ctx, "1")
+	require.True(t, ok)
+
+	// The unavailable server is a cache miss.
+	server.Close()
+	_, ok = messages.Get(ctx, "1")
+	require.False(t, ok)
+}
+
+func TestNewRedisClient(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	addr := server.Addr()
+
+	client, err := NewRedisClient(ctx, "redis://"+addr+"/0")
+	require.NoError(t, err)
+	client.Close()
+
+	_, err = NewRedisClient(ctx, "http://"+addr)
+	require.ErrorContains(t, err, "invalid redis url")
+	server.Close()
+	_, err = NewRedisClient(ctx, "redis://"+addr+"/0")
+	require.ErrorContains(t, err, "failed to connect to redis")
+}
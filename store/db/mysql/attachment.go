@@ -14,8 +14,8 @@ import (
 )
 
 func (d *DB) CreateAttachment(ctx context.Context, create *store.Attachment) (*store.Attachment, error) {
-	fields := []string{"`uid`", "`filename`", "`blob`", "`type`", "`size`", "`creator_id`", "`memo_id`", "`storage_type`", "`reference`", "`payload`"}
-	placeholder := []string{"?", "?", "?", "?", "?", "?", "?", "?", "?", "?"}
+	fields := []string{"`uid`", "`filename`", "`blob`", "`type`", "`size`", "`creator_id`", "`memo_id`", "`storage_type`", "`reference`", "`payload`", "`content_hash`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?", "?", "?", "?", "?", "?"}
 	storageType := ""
 	if create.StorageType != storepb.AttachmentStorageType_ATTACHMENT_STORAGE_TYPE_UNSPECIFIED {
 		storageType = create.StorageType.String()
@@ -28,7 +28,7 @@ func (d *DB) CreateAttachment(ctx context.Context, create *store.Attachment) (*s
 		}
 		payloadString = string(bytes)
 	}
-	args := []any{create.UID, create.Filename, create.Blob, create.Type, create.Size, create.CreatorID, create.MemoID, storageType, create.Reference, payloadString}
+	args := []any{create.UID, create.Filename, create.Blob, create.Type, create.Size, create.CreatorID, create.MemoID, storageType, create.Reference, payloadString, create.ContentHash}
 
 	stmt := "INSERT INTO `resource` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
 	result, err := d.db.ExecContext(ctx, stmt, args...)
@@ -66,6 +66,9 @@ func (d *DB) ListAttachments(ctx context.Context, find *store.FindAttachment) ([
 	if v := find.MemoID; v != nil {
 		where, args = append(where, "`memo_id` = ?"), append(args, *v)
 	}
+	if v := find.ContentHash; v != nil {
+		where, args = append(where, "`content_hash` = ?"), append(args, *v)
+	}
 	if find.HasRelatedMemo {
 		where = append(where, "`memo_id` IS NOT NULL")
 	}
@@ -73,7 +76,7 @@ func (d *DB) ListAttachments(ctx context.Context, find *store.FindAttachment) ([
 		where, args = append(where, "`storage_type` = ?"), append(args, find.StorageType.String())
 	}
 
-	fields := []string{"`id`", "`uid`", "`filename`", "`type`", "`size`", "`creator_id`", "UNIX_TIMESTAMP(`created_ts`)", "UNIX_TIMESTAMP(`updated_ts`)", "`memo_id`", "`storage_type`", "`reference`", "`payload`"}
+	fields := []string{"`id`", "`uid`", "`filename`", "`type`", "`size`", "`creator_id`", "UNIX_TIMESTAMP(`created_ts`)", "UNIX_TIMESTAMP(`updated_ts`)", "`memo_id`", "`storage_type`", "`reference`", "`payload`", "`content_hash`"}
 	if find.GetBlob {
 		fields = append(fields, "`blob`")
 	}
@@ -111,6 +114,7 @@ func (d *DB) ListAttachments(ctx context.Context, find *store.FindAttachment) ([
 			&storageType,
 			&attachment.Reference,
 			&payloadBytes,
+			&attachment.ContentHash,
 		}
 		if find.GetBlob {
 			dests = append(dests, &attachment.Blob)
@@ -175,6 +179,25 @@ func (d *DB) UpdateAttachment(ctx context.Context, update *store.UpdateAttachmen
 		}
 		set, args = append(set, "`payload` = ?"), append(args, string(bytes))
 	}
+	if v := update.StorageType; v != nil {
+		storageType := ""
+		if *v != storepb.AttachmentStorageType_ATTACHMENT_STORAGE_TYPE_UNSPECIFIED {
+			storageType = v.String()
+		}
+		set, args = append(set, "`storage_type` = ?"), append(args, storageType)
+	}
+	if v := update.Blob; v != nil {
+		set, args = append(set, "`blob` = ?"), append(args, *v)
+	}
+	if v := update.Type; v != nil {
+		set, args = append(set, "`type` = ?"), append(args, *v)
+	}
+	if v := update.Size; v != nil {
+		set, args = append(set, "`size` = ?"), append(args, *v)
+	}
+	if v := update.ContentHash; v != nil {
+		set, args = append(set, "`content_hash` = ?"), append(args, *v)
+	}
 
 	args = append(args, update.ID)
 	stmt := "UPDATE `resource` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
@@ -0,0 +1,132 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateAttachmentVersion(ctx context.Context, create *store.AttachmentVersion) (*store.AttachmentVersion, error) {
+	fields := []string{"`attachment_id`", "`filename`", "`blob`", "`type`", "`size`", "`storage_type`", "`reference`", "`payload`", "`content_hash`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?", "?", "?", "?"}
+	storageType := ""
+	if create.StorageType != storepb.AttachmentStorageType_ATTACHMENT_STORAGE_TYPE_UNSPECIFIED {
+		storageType = create.StorageType.String()
+	}
+	payloadString := "{}"
+	if create.Payload != nil {
+		bytes, err := protojson.Marshal(create.Payload)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal attachment payload")
+		}
+		payloadString = string(bytes)
+	}
+	args := []any{create.AttachmentID, create.Filename, create.Blob, create.Type, create.Size, storageType, create.Reference, payloadString, create.ContentHash}
+
+	stmt := "INSERT INTO `attachment_version` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	id32 := int32(id)
+	list, err := d.ListAttachmentVersions(ctx, &store.FindAttachmentVersion{ID: &id32})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, errors.Errorf("failed to create attachment version")
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListAttachmentVersions(ctx context.Context, find *store.FindAttachmentVersion) ([]*store.AttachmentVersion, error) {
+	where, args := []string{"1 = 1"}, []any{}
+
+	if v := find.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := find.AttachmentID; v != nil {
+		where, args = append(where, "`attachment_id` = ?"), append(args, *v)
+	}
+	if v := find.ContentHash; v != nil {
+		where, args = append(where, "`content_hash` = ?"), append(args, *v)
+	}
+	if find.StorageType != nil {
+		where, args = append(where, "`storage_type` = ?"), append(args, find.StorageType.String())
+	}
+
+	fields := []string{"`id`", "`attachment_id`", "UNIX_TIMESTAMP(`created_ts`)", "`filename`", "`type`", "`size`", "`storage_type`", "`reference`", "`payload`", "`content_hash`"}
+	if find.GetBlob {
+		fields = append(fields, "`blob`")
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM `attachment_version` WHERE %s ORDER BY `created_ts` DESC, `id` DESC", strings.Join(fields, ", "), strings.Join(where, " AND "))
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := make([]*store.AttachmentVersion, 0)
+	for rows.Next() {
+		version := store.AttachmentVersion{}
+		var storageType string
+		var payloadBytes []byte
+		dests := []any{
+			&version.ID,
+			&version.AttachmentID,
+			&version.CreatedTs,
+			&version.Filename,
+			&version.Type,
+			&version.Size,
+			&storageType,
+			&version.Reference,
+			&payloadBytes,
+			&version.ContentHash,
+		}
+		if find.GetBlob {
+			dests = append(dests, &version.Blob)
+		}
+		if err := rows.Scan(dests...); err != nil {
+			return nil, err
+		}
+
+		version.StorageType = storepb.AttachmentStorageType(storepb.AttachmentStorageType_value[storageType])
+		payload := &storepb.AttachmentPayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payloadBytes, payload); err != nil {
+			return nil, err
+		}
+		version.Payload = payload
+		list = append(list, &version)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteAttachmentVersion(ctx context.Context, delete *store.DeleteAttachmentVersion) error {
+	stmt := "DELETE FROM `attachment_version` WHERE `id` = ?"
+	result, err := d.db.ExecContext(ctx, stmt, delete.ID)
+	if err != nil {
+		return err
+	}
+	if _, err := result.RowsAffected(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateInvitation(ctx context.Context, create *store.Invitation) (*store.Invitation, error) {
+	fields := []string{"`creator_id`", "`token`", "`role`", "`max_uses`", "`expires_ts`"}
+	placeholder := []string{"?", "?", "?", "?", "?"}
+	args := []any{create.CreatorID, create.Token, create.Role, create.MaxUses, create.ExpiresTs}
+	stmt := "INSERT INTO `invitation` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	rawID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	id := int32(rawID)
+	list, err := d.ListInvitations(ctx, &store.FindInvitation{ID: &id})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("failed to create invitation")
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListInvitations(ctx context.Context, find *store.FindInvitation) ([]*store.Invitation, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.Token != nil {
+		where, args = append(where, "`token` = ?"), append(args, *find.Token)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			id,
+			UNIX_TIMESTAMP(created_ts) AS created_ts,
+			creator_id,
+			token,
+			role,
+			max_uses,
+			use_count,
+			expires_ts
+		FROM invitation
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.Invitation{}
+	for rows.Next() {
+		invitation := &store.Invitation{}
+		if err := rows.Scan(
+			&invitation.ID,
+			&invitation.CreatedTs,
+			&invitation.CreatorID,
+			&invitation.Token,
+			&invitation.Role,
+			&invitation.MaxUses,
+			&invitation.UseCount,
+			&invitation.ExpiresTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, invitation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UseInvitation(ctx context.Context, id int32) (bool, error) {
+	result, err := d.db.ExecContext(ctx, "UPDATE `invitation` SET `use_count` = `use_count` + 1 WHERE `id` = ? AND (`max_uses` = 0 OR `use_count` < `max_uses`)", id)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+func (d *DB) DeleteInvitation(ctx context.Context, delete *store.DeleteInvitation) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM `invitation` WHERE `id` = ?", delete.ID)
+	return err
+}
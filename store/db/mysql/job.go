@@ -0,0 +1,141 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateJob(ctx context.Context, create *store.Job) (*store.Job, error) {
+	fields := []string{"`kind`", "`payload`", "`status`", "`attempt_count`", "`max_attempts`", "`next_attempt_ts`", "`last_error`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?", "?"}
+	args := []any{create.Kind, create.Payload, create.Status.String(), create.AttemptCount, create.MaxAttempts, create.NextAttemptTs, create.LastError}
+	stmt := "INSERT INTO `job` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	rawID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	id := int32(rawID)
+	list, err := d.ListJobs(ctx, &store.FindJob{ID: &id})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("failed to create job")
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListJobs(ctx context.Context, find *store.FindJob) ([]*store.Job, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.Kind != nil {
+		where, args = append(where, "`kind` = ?"), append(args, *find.Kind)
+	}
+	if find.Status != nil {
+		where, args = append(where, "`status` = ?"), append(args, find.Status.String())
+	}
+	if find.DueBefore != nil {
+		where, args = append(where, "`status` IN (?, ?) AND `next_attempt_ts` <= ?"), append(args, store.JobPending.String(), store.JobRunning.String(), *find.DueBefore)
+	}
+
+	query := "SELECT `id`, UNIX_TIMESTAMP(`created_ts`), `kind`, `payload`, `status`, `attempt_count`, `max_attempts`, `next_attempt_ts`, `last_error`, `finished_ts` FROM `job` WHERE " + strings.Join(where, " AND ") + " ORDER BY `id` DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.Job{}
+	for rows.Next() {
+		job := &store.Job{}
+		if err := rows.Scan(
+			&job.ID,
+			&job.CreatedTs,
+			&job.Kind,
+			&job.Payload,
+			&job.Status,
+			&job.AttemptCount,
+			&job.MaxAttempts,
+			&job.NextAttemptTs,
+			&job.LastError,
+			&job.FinishedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, job)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateJob(ctx context.Context, update *store.UpdateJob) error {
+	set, args := []string{}, []any{}
+	if v := update.Status; v != nil {
+		set, args = append(set, "`status` = ?"), append(args, v.String())
+	}
+	if v := update.AttemptCount; v != nil {
+		set, args = append(set, "`attempt_count` = ?"), append(args, *v)
+	}
+	if v := update.NextAttemptTs; v != nil {
+		set, args = append(set, "`next_attempt_ts` = ?"), append(args, *v)
+	}
+	if v := update.LastError; v != nil {
+		set, args = append(set, "`last_error` = ?"), append(args, *v)
+	}
+	if v := update.FinishedTs; v != nil {
+		set, args = append(set, "`finished_ts` = ?"), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil
+	}
+	args = append(args, update.ID)
+	_, err := d.db.ExecContext(ctx, "UPDATE `job` SET "+strings.Join(set, ", ")+" WHERE `id` = ?", args...)
+	return err
+}
+
+func (d *DB) ClaimJob(ctx context.Context, claim *store.ClaimJob) (bool, error) {
+	stmt := "UPDATE `job` SET `status` = ?, `attempt_count` = `attempt_count` + 1, `next_attempt_ts` = ? WHERE `id` = ? AND `attempt_count` = ? AND `status` IN (?, ?)"
+	result, err := d.db.ExecContext(ctx, stmt, store.JobRunning.String(), claim.LeaseEndTs, claim.ID, claim.AttemptCount, store.JobPending.String(), store.JobRunning.String())
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+func (d *DB) DeleteJobs(ctx context.Context, delete *store.DeleteJob) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.Status != nil {
+		where, args = append(where, "`status` = ?"), append(args, delete.Status.String())
+	}
+	if delete.FinishedTsBefore != nil {
+		where, args = append(where, "`finished_ts` < ?"), append(args, *delete.FinishedTsBefore)
+	}
+	_, err := d.db.ExecContext(ctx, "DELETE FROM `job` WHERE "+strings.Join(where, " AND "), args...)
+	return err
+}
@@ -13,9 +13,13 @@ import (
 	"github.com/usememos/memos/store"
 )
 
+// memoScoreExpr is the relevance of a memo to the full-text query bound to its placeholder.
+// Memos only matching by their attachments score 0.
+const memoScoreExpr = "MATCH(`memo`.`content`) AGAINST (? IN BOOLEAN MODE)"
+
 func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, error) {
-	fields := []string{"`uid`", "`creator_id`", "`content`", "`visibility`", "`payload`"}
-	placeholder := []string{"?", "?", "?", "?", "?"}
+	fields := []string{"`uid`", "`creator_id`", "`content`", "`visibility`", "`payload`", "`space_id`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?"}
 	payload := "{}"
 	if create.Payload != nil {
 		payloadBytes, err := protojson.Marshal(create.Payload)
@@ -24,7 +28,7 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 		}
 		payload = string(payloadBytes)
 	}
-	args := []any{create.UID, create.CreatorID, create.Content, create.Visibility, payload}
+	args := []any{create.UID, create.CreatorID, create.Content, create.Visibility, payload, create.SpaceID}
 
 	stmt := "INSERT INTO `memo` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
 	result, err := d.db.ExecContext(ctx, stmt, args...)
@@ -59,6 +63,9 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if v := find.CreatorID; v != nil {
 		where, args = append(where, "`memo`.`creator_id` = ?"), append(args, *v)
 	}
+	if v := find.SpaceID; v != nil {
+		where, args = append(where, "`memo`.`space_id` = ?"), append(args, *v)
+	}
 	if v := find.RowStatus; v != nil {
 		where, args = append(where, "`memo`.`row_status` = ?"), append(args, *v)
 	}
@@ -79,6 +86,21 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			where, args = append(where, "`memo`.`content` LIKE ?"), append(args, "%"+s+"%")
 		}
 	}
+	for _, fragments := range find.ContentFragments {
+		conditions := []string{}
+		for _, fragment := range fragments {
+			conditions, args = append(conditions, "`memo`.`content` LIKE ?"), append(args, "%"+fragment+"%")
+		}
+		where = append(where, "("+strings.Join(conditions, " OR ")+")")
+	}
+	if v := find.FullTextSearch; v != nil {
+		ftsQuery := buildFullTextQuery(*v)
+		where, args = append(where, "(MATCH(`memo`.`content`) AGAINST (? IN BOOLEAN MODE) OR "+
+			"`memo`.`id` IN (SELECT `resource`.`memo_id` FROM `resource` JOIN `attachment_text` ON `attachment_text`.`attachment_id` = `resource`.`id` WHERE MATCH(`attachment_text`.`content`) AGAINST (? IN BOOLEAN MODE)))"), append(args, ftsQuery, ftsQuery)
+		if c := find.RelevanceCursor; c != nil && find.OrderByRelevance {
+			where, args = append(where, fmt.Sprintf("(%s < ? OR (%s = ? AND `memo`.`id` < ?))", memoScoreExpr, memoScoreExpr)), append(args, ftsQuery, c.Score, ftsQuery, c.Score, c.ID)
+		}
+	}
 	if v := find.VisibilityList; len(v) != 0 {
 		placeholder := []string{}
 		for _, visibility := range v {
@@ -96,7 +118,7 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		}
 		if len(v.TagSearch) != 0 {
 			for _, tag := range v.TagSearch {
-				where, args = append(where, "(JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?))"), append(args, fmt.Sprintf(`"%s"`, tag), fmt.Sprintf(`"%s/"`, tag))
+				where, args = append(where, "(JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR JSON_SEARCH(JSON_EXTRACT(`memo`.`payload`, '$.tags'), 'one', ?) IS NOT NULL)"), append(args, fmt.Sprintf(`"%s"`, tag), fmt.Sprintf(`%s/%%`, tag))
 			}
 		}
 		if v.HasLink {
@@ -138,14 +160,24 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.OrderByTimeAsc {
 		order = "ASC"
 	}
+	orderByRelevance := find.FullTextSearch != nil && find.OrderByRelevance
 	orderBy := []string{}
-	if find.OrderByPinned {
-		orderBy = append(orderBy, "`pinned` DESC")
-	}
-	if find.OrderByUpdatedTs {
-		orderBy = append(orderBy, "`updated_ts` "+order)
+	if orderByRelevance {
+		orderBy = append(orderBy, "`score` DESC", "`memo`.`id` DESC")
+	} else if find.OrderByRandomSeed != nil {
+		// A seeded hash of the id shuffles the memos, squaring it breaks the patterns of a linear hash.
+		hash := fmt.Sprintf("((`memo`.`id` * 1103515245 + %d) %% 2147483647)", *find.OrderByRandomSeed)
+		hash = fmt.Sprintf("((%s * %s + %d) %% 2147483647)", hash, hash, *find.OrderByRandomSeed)
+		orderBy = append(orderBy, fmt.Sprintf("(%s * %s) %% 2147483647", hash, hash), "`memo`.`id`")
 	} else {
-		orderBy = append(orderBy, "`created_ts` "+order)
+		if find.OrderByPinned {
+			orderBy = append(orderBy, "`pinned` DESC")
+		}
+		if find.OrderByUpdatedTs {
+			orderBy = append(orderBy, "`updated_ts` "+order)
+		} else {
+			orderBy = append(orderBy, "`created_ts` "+order)
+		}
 	}
 	fields := []string{
 		"`memo`.`id` AS `id`",
@@ -156,12 +188,17 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		"`memo`.`row_status` AS `row_status`",
 		"`memo`.`visibility` AS `visibility`",
 		"`memo`.`pinned` AS `pinned`",
+		"`memo`.`space_id` AS `space_id`",
 		"`memo`.`payload` AS `payload`",
 		"`memo_relation`.`related_memo_id` AS `parent_id`",
 	}
 	if !find.ExcludeContent {
 		fields = append(fields, "`memo`.`content` AS `content`")
 	}
+	if orderByRelevance {
+		// The arguments of the selected fields precede the ones of the conditions.
+		fields, args = append(fields, memoScoreExpr+" AS `score`"), append([]any{buildFullTextQuery(*find.FullTextSearch)}, args...)
+	}
 
 	query := "SELECT " + strings.Join(fields, ", ") + " FROM `memo`" + " " +
 		"LEFT JOIN `memo_relation` ON `memo`.`id` = `memo_relation`.`memo_id` AND `memo_relation`.`type` = 'COMMENT'" + " " +
@@ -194,12 +231,16 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			&memo.RowStatus,
 			&memo.Visibility,
 			&memo.Pinned,
+			&memo.SpaceID,
 			&payloadBytes,
 			&memo.ParentID,
 		}
 		if !find.ExcludeContent {
 			dests = append(dests, &memo.Content)
 		}
+		if orderByRelevance {
+			dests = append(dests, &memo.Score)
+		}
 		if err := rows.Scan(dests...); err != nil {
 			return nil, err
 		}
@@ -232,6 +273,25 @@ func (d *DB) GetMemo(ctx context.Context, find *store.FindMemo) (*store.Memo, er
 }
 
 func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
+	return updateMemo(ctx, d.db, update)
+}
+
+func (d *DB) UpdateMemos(ctx context.Context, updates []*store.UpdateMemo) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, update := range updates {
+		if err := updateMemo(ctx, tx, update); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func updateMemo(ctx context.Context, executor executor, update *store.UpdateMemo) error {
 	set, args := []string{}, []any{}
 	if v := update.UID; v != nil {
 		set, args = append(set, "`uid` = ?"), append(args, *v)
@@ -267,7 +327,7 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	args = append(args, update.ID)
 
 	stmt := "UPDATE `memo` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
-	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+	if _, err := executor.ExecContext(ctx, stmt, args...); err != nil {
 		return err
 	}
 	return nil
@@ -285,3 +345,19 @@ func (d *DB) DeleteMemo(ctx context.Context, delete *store.DeleteMemo) error {
 	}
 	return nil
 }
+
+// buildFullTextQuery converts the search text into a boolean mode query matching the memos
+// containing every term. Boolean operators are stripped and terms are matched as prefixes.
+func buildFullTextQuery(text string) string {
+	text = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`+-<>()~*"@`, r) {
+			return ' '
+		}
+		return r
+	}, text)
+	terms := []string{}
+	for _, term := range strings.Fields(text) {
+		terms = append(terms, "+"+term+"*")
+	}
+	return strings.Join(terms, " ")
+}
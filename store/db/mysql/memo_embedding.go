@@ -0,0 +1,66 @@
+package mysql
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertMemoEmbedding(ctx context.Context, upsert *store.MemoEmbedding) (*store.MemoEmbedding, error) {
+	embedding, err := json.Marshal(upsert.Embedding)
+	if err != nil {
+		return nil, err
+	}
+	stmt := "INSERT INTO `memo_embedding` (`memo_id`, `model`, `content_hash`, `embedding`) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE `model` = ?, `content_hash` = ?, `embedding` = ?"
+	if _, err := d.db.ExecContext(ctx, stmt, upsert.MemoID, upsert.Model, upsert.ContentHash, string(embedding), upsert.Model, upsert.ContentHash, string(embedding)); err != nil {
+		return nil, err
+	}
+	return upsert, nil
+}
+
+func (d *DB) ListMemoEmbeddings(ctx context.Context, find *store.FindMemoEmbedding) ([]*store.MemoEmbedding, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.MemoID != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *find.MemoID)
+	}
+	if find.Model != nil {
+		where, args = append(where, "`model` = ?"), append(args, *find.Model)
+	}
+
+	rows, err := d.db.QueryContext(ctx, "SELECT `memo_id`, `model`, `content_hash`, `embedding` FROM `memo_embedding` WHERE "+strings.Join(where, " AND ")+" ORDER BY `memo_id` ASC", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoEmbedding{}
+	for rows.Next() {
+		memoEmbedding := &store.MemoEmbedding{}
+		var embedding string
+		if err := rows.Scan(
+			&memoEmbedding.MemoID,
+			&memoEmbedding.Model,
+			&memoEmbedding.ContentHash,
+			&embedding,
+		); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(embedding), &memoEmbedding.Embedding); err != nil {
+			return nil, err
+		}
+		list = append(list, memoEmbedding)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteMemoEmbedding(ctx context.Context, delete *store.DeleteMemoEmbedding) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM `memo_embedding` WHERE `memo_id` = ?", delete.MemoID)
+	return err
+}
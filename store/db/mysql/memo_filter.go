@@ -97,7 +97,7 @@ func (d *DB) convertWithTemplates(ctx *filter.ConvertContext, expr *exprv1.Expr)
 			if err != nil {
 				return err
 			}
-			if !slices.Contains([]string{"creator_id", "created_ts", "updated_ts", "visibility", "content", "has_task_list"}, identifier) {
+			if !slices.Contains([]string{"creator_id", "created_ts", "updated_ts", "visibility", "content", "has_task_list", "has_tags", "has_incomplete_tasks", "word_count", "attachment_count", "attachment_size"}, identifier) {
 				return errors.Errorf("invalid identifier for %s", v.CallExpr.Function)
 			}
 			value, err := filter.GetExprValue(v.CallExpr.Args[1])
@@ -176,6 +176,45 @@ func (d *DB) convertWithTemplates(ctx *filter.ConvertContext, expr *exprv1.Expr)
 				if _, err := ctx.Buffer.WriteString(sqlTemplate); err != nil {
 					return err
 				}
+			} else if identifier == "has_tags" {
+				if operator != "=" && operator != "!=" {
+					return errors.Errorf("invalid operator for %s", v.CallExpr.Function)
+				}
+				valueBool, ok := value.(bool)
+				if !ok {
+					return errors.New("invalid boolean value for has_tags")
+				}
+				sqlTemplate := filter.GetSQL("has_no_tags", dbType)
+				if valueBool == (operator == "=") {
+					sqlTemplate = filter.GetSQL("has_tags", dbType)
+				}
+				if _, err := ctx.Buffer.WriteString(sqlTemplate); err != nil {
+					return err
+				}
+			} else if identifier == "has_incomplete_tasks" {
+				if operator != "=" && operator != "!=" {
+					return errors.Errorf("invalid operator for %s", v.CallExpr.Function)
+				}
+				valueBool, ok := value.(bool)
+				if !ok {
+					return errors.New("invalid boolean value for has_incomplete_tasks")
+				}
+				sqlTemplate := filter.GetSQL("has_no_incomplete_tasks", dbType)
+				if valueBool == (operator == "=") {
+					sqlTemplate = filter.GetSQL("has_incomplete_tasks", dbType)
+				}
+				if _, err := ctx.Buffer.WriteString(sqlTemplate); err != nil {
+					return err
+				}
+			} else if identifier == "word_count" || identifier == "attachment_count" || identifier == "attachment_size" {
+				valueInt, ok := value.(int64)
+				if !ok {
+					return errors.Errorf("invalid integer value for %s", identifier)
+				}
+				if _, err := ctx.Buffer.WriteString(fmt.Sprintf("%s %s ?", filter.GetSQL(identifier, dbType), operator)); err != nil {
+					return err
+				}
+				ctx.Args = append(ctx.Args, valueInt)
 			}
 		case "@in":
 			if len(v.CallExpr.Args) != 2 {
@@ -224,8 +263,9 @@ func (d *DB) convertWithTemplates(ctx *filter.ConvertContext, expr *exprv1.Expr)
 				subconditions := []string{}
 				args := []any{}
 				for _, v := range values {
-					subconditions = append(subconditions, filter.GetSQL("json_contains_tag", dbType))
-					args = append(args, filter.GetParameterValue(dbType, "json_contains_tag", v))
+					// A parent tag also matches all of its descendants.
+					subconditions = append(subconditions, fmt.Sprintf("(%s OR %s)", filter.GetSQL("json_contains_tag", dbType), filter.GetSQL("json_contains_tag_descendant", dbType)))
+					args = append(args, filter.GetParameterValue(dbType, "json_contains_tag", v), filter.GetParameterValue(dbType, "json_contains_tag_descendant", v))
 				}
 				if len(subconditions) == 1 {
 					if _, err := ctx.Buffer.WriteString(subconditions[0]); err != nil {
@@ -245,6 +285,69 @@ func (d *DB) convertWithTemplates(ctx *filter.ConvertContext, expr *exprv1.Expr)
 				}
 				ctx.Args = append(ctx.Args, values...)
 			}
+		case "has_attachment_type", "in_relation_with":
+			if len(v.CallExpr.Args) != 1 {
+				return errors.Errorf("invalid number of arguments for %s", v.CallExpr.Function)
+			}
+			arg, err := filter.GetConstValue(v.CallExpr.Args[0])
+			if err != nil {
+				return err
+			}
+			if _, ok := arg.(string); !ok {
+				return errors.Errorf("argument of %s must be a string", v.CallExpr.Function)
+			}
+			if _, err := ctx.Buffer.WriteString(filter.GetSQL(v.CallExpr.Function, dbType)); err != nil {
+				return err
+			}
+			ctx.Args = append(ctx.Args, filter.GetParameterValue(dbType, v.CallExpr.Function, arg))
+		case "group_visible_to":
+			if len(v.CallExpr.Args) != 1 {
+				return errors.Errorf("invalid number of arguments for %s", v.CallExpr.Function)
+			}
+			arg, err := filter.GetConstValue(v.CallExpr.Args[0])
+			if err != nil {
+				return err
+			}
+			if _, ok := arg.(int64); !ok {
+				return errors.Errorf("argument of %s must be an integer", v.CallExpr.Function)
+			}
+			if _, err := ctx.Buffer.WriteString(filter.GetSQL(v.CallExpr.Function, dbType)); err != nil {
+				return err
+			}
+			ctx.Args = append(ctx.Args, arg)
+		case "has_attachment_class":
+			if len(v.CallExpr.Args) != 1 {
+				return errors.Errorf("invalid number of arguments for %s", v.CallExpr.Function)
+			}
+			arg, err := filter.GetConstValue(v.CallExpr.Args[0])
+			if err != nil {
+				return err
+			}
+			class, ok := arg.(string)
+			if !ok {
+				return errors.Errorf("argument of %s must be a string", v.CallExpr.Function)
+			}
+			types, err := filter.GetAttachmentClassTypes(class)
+			if err != nil {
+				return err
+			}
+			conditions := []string{}
+			for _, t := range types {
+				conditions = append(conditions, filter.GetSQL("attachment_type_like", dbType))
+				ctx.Args = append(ctx.Args, filter.GetParameterValue(dbType, "attachment_type_like", t))
+			}
+			if _, err := ctx.Buffer.WriteString(fmt.Sprintf(filter.GetSQL("has_attachment_class", dbType), strings.Join(conditions, " OR "))); err != nil {
+				return err
+			}
+		case "within":
+			within, err := filter.ParseLocationWithin(v.CallExpr)
+			if err != nil {
+				return err
+			}
+			if _, err := ctx.Buffer.WriteString(filter.GetSQL("location_within", dbType)); err != nil {
+				return err
+			}
+			ctx.Args = append(ctx.Args, within.Args()...)
 		case "contains":
 			if len(v.CallExpr.Args) != 1 {
 				return errors.Errorf("invalid number of arguments for %s", v.CallExpr.Function)
@@ -267,7 +370,7 @@ func (d *DB) convertWithTemplates(ctx *filter.ConvertContext, expr *exprv1.Expr)
 		}
 	} else if v, ok := expr.ExprKind.(*exprv1.Expr_IdentExpr); ok {
 		identifier := v.IdentExpr.GetName()
-		if !slices.Contains([]string{"pinned", "has_task_list"}, identifier) {
+		if !slices.Contains([]string{"pinned", "has_task_list", "has_tags", "has_incomplete_tasks"}, identifier) {
 			return errors.Errorf("invalid identifier %s", identifier)
 		}
 		if identifier == "pinned" {
@@ -279,6 +382,14 @@ func (d *DB) convertWithTemplates(ctx *filter.ConvertContext, expr *exprv1.Expr)
 			if _, err := ctx.Buffer.WriteString(filter.GetSQL("boolean_check", dbType)); err != nil {
 				return err
 			}
+		} else if identifier == "has_tags" {
+			if _, err := ctx.Buffer.WriteString(filter.GetSQL("has_tags", dbType)); err != nil {
+				return err
+			}
+		} else if identifier == "has_incomplete_tasks" {
+			if _, err := ctx.Buffer.WriteString(filter.GetSQL("has_incomplete_tasks", dbType)); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -17,13 +17,13 @@ func TestConvertExprToSQL(t *testing.T) {
 	}{
 		{
 			filter: `tag in ["tag1", "tag2"]`,
-			want:   "(JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?))",
-			args:   []any{"tag1", "tag2"},
+			want:   "((JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR JSON_SEARCH(JSON_EXTRACT(`memo`.`payload`, '$.tags'), 'one', ?) IS NOT NULL) OR (JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR JSON_SEARCH(JSON_EXTRACT(`memo`.`payload`, '$.tags'), 'one', ?) IS NOT NULL))",
+			args:   []any{"tag1", "tag1/%", "tag2", "tag2/%"},
 		},
 		{
 			filter: `!(tag in ["tag1", "tag2"])`,
-			want:   "NOT ((JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?)))",
-			args:   []any{"tag1", "tag2"},
+			want:   "NOT (((JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR JSON_SEARCH(JSON_EXTRACT(`memo`.`payload`, '$.tags'), 'one', ?) IS NOT NULL) OR (JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR JSON_SEARCH(JSON_EXTRACT(`memo`.`payload`, '$.tags'), 'one', ?) IS NOT NULL)))",
+			args:   []any{"tag1", "tag1/%", "tag2", "tag2/%"},
 		},
 		{
 			filter: `content.contains("memos")`,
@@ -42,8 +42,8 @@ func TestConvertExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `tag in ['tag1'] || content.contains('hello')`,
-			want:   "(JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR `memo`.`content` LIKE ?)",
-			args:   []any{"tag1", "%hello%"},
+			want:   "((JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.tags'), ?) OR JSON_SEARCH(JSON_EXTRACT(`memo`.`payload`, '$.tags'), 'one', ?) IS NOT NULL) OR `memo`.`content` LIKE ?)",
+			args:   []any{"tag1", "tag1/%", "%hello%"},
 		},
 		{
 			filter: `1`,
@@ -115,6 +115,66 @@ func TestConvertExprToSQL(t *testing.T) {
 			want:   "JSON_LENGTH(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.tags'), JSON_ARRAY())) = ?",
 			args:   []any{int64(2)},
 		},
+		{
+			filter: `has_tags`,
+			want:   "JSON_LENGTH(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.tags'), JSON_ARRAY())) > 0",
+			args:   []any{},
+		},
+		{
+			filter: `!has_tags`,
+			want:   "NOT (JSON_LENGTH(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.tags'), JSON_ARRAY())) > 0)",
+			args:   []any{},
+		},
+		{
+			filter: `has_tags == false`,
+			want:   "JSON_LENGTH(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.tags'), JSON_ARRAY())) = 0",
+			args:   []any{},
+		},
+		{
+			filter: `has_incomplete_tasks`,
+			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasIncompleteTasks') = CAST('true' AS JSON)",
+			args:   []any{},
+		},
+		{
+			filter: `has_incomplete_tasks == false`,
+			want:   "COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.property.hasIncompleteTasks'), CAST('false' AS JSON)) = CAST('false' AS JSON)",
+			args:   []any{},
+		},
+		{
+			filter: `word_count > 100`,
+			want:   "COALESCE(CAST(JSON_EXTRACT(`memo`.`payload`, '$.property.wordCount') AS SIGNED), 0) > ?",
+			args:   []any{int64(100)},
+		},
+		{
+			filter: `attachment_count >= 2`,
+			want:   "(SELECT COUNT(*) FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id`) >= ?",
+			args:   []any{int64(2)},
+		},
+		{
+			filter: `attachment_size > 1048576`,
+			want:   "(SELECT COALESCE(SUM(`resource`.`size`), 0) FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id`) > ?",
+			args:   []any{int64(1048576)},
+		},
+		{
+			filter: `has_attachment_class("archive")`,
+			want:   "EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id` AND (`resource`.`type` LIKE ? OR `resource`.`type` LIKE ? OR `resource`.`type` LIKE ? OR `resource`.`type` LIKE ? OR `resource`.`type` LIKE ? OR `resource`.`type` LIKE ? OR `resource`.`type` LIKE ?))",
+			args:   []any{"application/zip", "application/gzip", "application/x-tar", "application/x-bzip2", "application/x-7z-compressed", "application/x-rar-compressed", "application/vnd.rar"},
+		},
+		{
+			filter: `has_attachment_type("image/*")`,
+			want:   "EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id` AND `resource`.`type` LIKE ?)",
+			args:   []any{"image/%"},
+		},
+		{
+			filter: `in_relation_with("abc")`,
+			want:   "EXISTS (SELECT 1 FROM `memo_relation` AS `relation` JOIN `memo` AS `related_memo` ON `related_memo`.`uid` = ? WHERE (`relation`.`memo_id` = `memo`.`id` AND `relation`.`related_memo_id` = `related_memo`.`id`) OR (`relation`.`related_memo_id` = `memo`.`id` AND `relation`.`memo_id` = `related_memo`.`id`))",
+			args:   []any{"abc"},
+		},
+		{
+			filter: `location.within(0, 0, 111.32)`,
+			want:   "(JSON_EXTRACT(`memo`.`payload`, '$.location') IS NOT NULL AND COALESCE(CAST(JSON_EXTRACT(`memo`.`payload`, '$.location.latitude') AS DOUBLE), 0) BETWEEN ? AND ? AND COALESCE(CAST(JSON_EXTRACT(`memo`.`payload`, '$.location.longitude') AS DOUBLE), 0) BETWEEN ? AND ? AND (COALESCE(CAST(JSON_EXTRACT(`memo`.`payload`, '$.location.latitude') AS DOUBLE), 0) - ?) * (COALESCE(CAST(JSON_EXTRACT(`memo`.`payload`, '$.location.latitude') AS DOUBLE), 0) - ?) + (COALESCE(CAST(JSON_EXTRACT(`memo`.`payload`, '$.location.longitude') AS DOUBLE), 0) - ?) * (COALESCE(CAST(JSON_EXTRACT(`memo`.`payload`, '$.location.longitude') AS DOUBLE), 0) - ?) * ? <= ?)",
+			args:   []any{-1.0, 1.0, -1.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 1.0},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,68 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) SetMemoGroups(ctx context.Context, memoID int32, groupIDs []int32) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM `memo_group` WHERE `memo_id` = ?", memoID); err != nil {
+		return err
+	}
+	for _, groupID := range groupIDs {
+		if _, err := tx.ExecContext(ctx, "INSERT IGNORE INTO `memo_group` (`memo_id`, `group_id`) VALUES (?, ?)", memoID, groupID); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (d *DB) ListMemoGroups(ctx context.Context, find *store.FindMemoGroup) ([]*store.MemoGroup, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.MemoID != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *find.MemoID)
+	}
+	if find.GroupID != nil {
+		where, args = append(where, "`group_id` = ?"), append(args, *find.GroupID)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			memo_id,
+			group_id
+		FROM memo_group
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY memo_id ASC, group_id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoGroup{}
+	for rows.Next() {
+		memoGroup := &store.MemoGroup{}
+		if err := rows.Scan(
+			&memoGroup.MemoID,
+			&memoGroup.GroupID,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, memoGroup)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
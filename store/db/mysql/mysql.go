@@ -8,6 +8,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/tracing"
 	"github.com/usememos/memos/store"
 )
 
@@ -32,7 +33,7 @@ func NewDB(profile *profile.Profile) (store.Driver, error) {
 		return nil, errors.New("Parse DSN eroor")
 	}
 
-	driver.db, err = sql.Open("mysql", dsn)
+	driver.db, err = tracing.OpenDB("mysql", dsn)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to open db: %s", profile.DSN)
 	}
@@ -158,5 +158,9 @@ func (d *DB) DeleteUser(ctx context.Context, delete *store.DeleteUser) error {
 	if _, err := result.RowsAffected(); err != nil {
 		return err
 	}
+	// The settings of the user hold their sessions and access tokens, so they go with the user.
+	if _, err := d.db.ExecContext(ctx, "DELETE FROM `user_setting` WHERE `user_id` = ?", delete.ID); err != nil {
+		return err
+	}
 	return nil
 }
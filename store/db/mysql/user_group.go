@@ -0,0 +1,184 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateUserGroup(ctx context.Context, create *store.UserGroup) (*store.UserGroup, error) {
+	fields := []string{"`name`", "`description`"}
+	placeholder := []string{"?", "?"}
+	args := []any{create.Name, create.Description}
+	stmt := "INSERT INTO `user_group` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	rawID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	id := int32(rawID)
+	list, err := d.ListUserGroups(ctx, &store.FindUserGroup{ID: &id})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("failed to create user group")
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListUserGroups(ctx context.Context, find *store.FindUserGroup) ([]*store.UserGroup, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.Name != nil {
+		where, args = append(where, "`name` = ?"), append(args, *find.Name)
+	}
+	if find.MemberID != nil {
+		where, args = append(where, "`id` IN (SELECT `group_id` FROM `user_group_member` WHERE `user_id` = ?)"), append(args, *find.MemberID)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			id,
+			UNIX_TIMESTAMP(created_ts) AS created_ts,
+			UNIX_TIMESTAMP(updated_ts) AS updated_ts,
+			name,
+			description
+		FROM user_group
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.UserGroup{}
+	for rows.Next() {
+		userGroup := &store.UserGroup{}
+		if err := rows.Scan(
+			&userGroup.ID,
+			&userGroup.CreatedTs,
+			&userGroup.UpdatedTs,
+			&userGroup.Name,
+			&userGroup.Description,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, userGroup)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateUserGroup(ctx context.Context, update *store.UpdateUserGroup) error {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "`updated_ts` = FROM_UNIXTIME(?)"), append(args, *v)
+	}
+	if v := update.Name; v != nil {
+		set, args = append(set, "`name` = ?"), append(args, *v)
+	}
+	if v := update.Description; v != nil {
+		set, args = append(set, "`description` = ?"), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil
+	}
+	args = append(args, update.ID)
+	_, err := d.db.ExecContext(ctx, "UPDATE `user_group` SET "+strings.Join(set, ", ")+" WHERE `id` = ?", args...)
+	return err
+}
+
+func (d *DB) DeleteUserGroup(ctx context.Context, delete *store.DeleteUserGroup) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM `user_group_member` WHERE `group_id` = ?", delete.ID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM `memo_group` WHERE `group_id` = ?", delete.ID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM `user_group` WHERE `id` = ?", delete.ID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (d *DB) SetUserGroupMembers(ctx context.Context, groupID int32, userIDs []int32) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM `user_group_member` WHERE `group_id` = ?", groupID); err != nil {
+		return err
+	}
+	for _, userID := range userIDs {
+		if _, err := tx.ExecContext(ctx, "INSERT IGNORE INTO `user_group_member` (`group_id`, `user_id`) VALUES (?, ?)", groupID, userID); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (d *DB) ListUserGroupMembers(ctx context.Context, find *store.FindUserGroupMember) ([]*store.UserGroupMember, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.GroupID != nil {
+		where, args = append(where, "`group_id` = ?"), append(args, *find.GroupID)
+	}
+	if find.UserID != nil {
+		where, args = append(where, "`user_id` = ?"), append(args, *find.UserID)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			group_id,
+			user_id
+		FROM user_group_member
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY group_id ASC, user_id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.UserGroupMember{}
+	for rows.Next() {
+		userGroupMember := &store.UserGroupMember{}
+		if err := rows.Scan(
+			&userGroupMember.GroupID,
+			&userGroupMember.UserID,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, userGroupMember)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -0,0 +1,75 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertUsernameRedirect(ctx context.Context, upsert *store.UsernameRedirect) (*store.UsernameRedirect, error) {
+	stmt := "INSERT INTO `username_redirect` (`username`, `user_id`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `user_id` = VALUES(`user_id`)"
+	if _, err := d.db.ExecContext(ctx, stmt, upsert.Username, upsert.UserID); err != nil {
+		return nil, err
+	}
+
+	list, err := d.ListUsernameRedirects(ctx, &store.FindUsernameRedirect{Username: &upsert.Username})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, errors.Errorf("failed to create username redirect")
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListUsernameRedirects(ctx context.Context, find *store.FindUsernameRedirect) ([]*store.UsernameRedirect, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.Username != nil {
+		where, args = append(where, "`username` = ?"), append(args, *find.Username)
+	}
+	if find.UserID != nil {
+		where, args = append(where, "`user_id` = ?"), append(args, *find.UserID)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			username,
+			user_id,
+			UNIX_TIMESTAMP(created_ts) AS created_ts
+		FROM username_redirect
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY created_ts DESC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.UsernameRedirect{}
+	for rows.Next() {
+		redirect := &store.UsernameRedirect{}
+		if err := rows.Scan(
+			&redirect.Username,
+			&redirect.UserID,
+			&redirect.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, redirect)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteUsernameRedirect(ctx context.Context, delete *store.DeleteUsernameRedirect) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM `username_redirect` WHERE `username` = ?", delete.Username)
+	return err
+}
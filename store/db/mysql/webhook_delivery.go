@@ -0,0 +1,150 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateWebhookDelivery(ctx context.Context, create *store.WebhookDelivery) (*store.WebhookDelivery, error) {
+	fields := []string{"`creator_id`", "`webhook_id`", "`activity_type`", "`payload`", "`status`", "`attempt_count`", "`next_attempt_ts`", "`last_error`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?", "?", "?"}
+	args := []any{create.CreatorID, create.WebhookID, create.ActivityType, create.Payload, create.Status.String(), create.AttemptCount, create.NextAttemptTs, create.LastError}
+	stmt := "INSERT INTO `webhook_delivery` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	rawID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	id := int32(rawID)
+	list, err := d.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{ID: &id})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("failed to create webhook delivery")
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListWebhookDeliveries(ctx context.Context, find *store.FindWebhookDelivery) ([]*store.WebhookDelivery, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.CreatorID != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *find.CreatorID)
+	}
+	if find.WebhookID != nil {
+		where, args = append(where, "`webhook_id` = ?"), append(args, *find.WebhookID)
+	}
+	if find.Status != nil {
+		where, args = append(where, "`status` = ?"), append(args, find.Status.String())
+	}
+	if find.NextAttemptTsBefore != nil {
+		where, args = append(where, "`next_attempt_ts` <= ?"), append(args, *find.NextAttemptTsBefore)
+	}
+
+	query := "SELECT `id`, UNIX_TIMESTAMP(`created_ts`), `creator_id`, `webhook_id`, `activity_type`, `payload`, `status`, `attempt_count`, `next_attempt_ts`, `last_error`, `last_attempt_ts`, `response_status_code`, `response_body`, `latency_ms` FROM `webhook_delivery` WHERE " + strings.Join(where, " AND ") + " ORDER BY `id` DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+		if find.Offset != nil {
+			query = fmt.Sprintf("%s OFFSET %d", query, *find.Offset)
+		}
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.WebhookDelivery{}
+	for rows.Next() {
+		delivery := &store.WebhookDelivery{}
+		if err := rows.Scan(
+			&delivery.ID,
+			&delivery.CreatedTs,
+			&delivery.CreatorID,
+			&delivery.WebhookID,
+			&delivery.ActivityType,
+			&delivery.Payload,
+			&delivery.Status,
+			&delivery.AttemptCount,
+			&delivery.NextAttemptTs,
+			&delivery.LastError,
+			&delivery.LastAttemptTs,
+			&delivery.ResponseStatusCode,
+			&delivery.ResponseBody,
+			&delivery.LatencyMs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateWebhookDelivery(ctx context.Context, update *store.UpdateWebhookDelivery) error {
+	set, args := []string{}, []any{}
+	if v := update.Status; v != nil {
+		set, args = append(set, "`status` = ?"), append(args, v.String())
+	}
+	if v := update.AttemptCount; v != nil {
+		set, args = append(set, "`attempt_count` = ?"), append(args, *v)
+	}
+	if v := update.NextAttemptTs; v != nil {
+		set, args = append(set, "`next_attempt_ts` = ?"), append(args, *v)
+	}
+	if v := update.LastError; v != nil {
+		set, args = append(set, "`last_error` = ?"), append(args, *v)
+	}
+	if v := update.LastAttemptTs; v != nil {
+		set, args = append(set, "`last_attempt_ts` = ?"), append(args, *v)
+	}
+	if v := update.ResponseStatusCode; v != nil {
+		set, args = append(set, "`response_status_code` = ?"), append(args, *v)
+	}
+	if v := update.ResponseBody; v != nil {
+		set, args = append(set, "`response_body` = ?"), append(args, *v)
+	}
+	if v := update.LatencyMs; v != nil {
+		set, args = append(set, "`latency_ms` = ?"), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil
+	}
+	args = append(args, update.ID)
+	_, err := d.db.ExecContext(ctx, "UPDATE `webhook_delivery` SET "+strings.Join(set, ", ")+" WHERE `id` = ?", args...)
+	return err
+}
+
+func (d *DB) DeleteWebhookDeliveries(ctx context.Context, delete *store.DeleteWebhookDelivery) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.CreatorID != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *delete.CreatorID)
+	}
+	if delete.WebhookID != nil {
+		where, args = append(where, "`webhook_id` = ?"), append(args, *delete.WebhookID)
+	}
+	if delete.Status != nil {
+		where, args = append(where, "`status` = ?"), append(args, delete.Status.String())
+	}
+	if delete.CreatedTsBefore != nil {
+		where, args = append(where, "UNIX_TIMESTAMP(`created_ts`) < ?"), append(args, *delete.CreatedTsBefore)
+	}
+	_, err := d.db.ExecContext(ctx, "DELETE FROM `webhook_delivery` WHERE "+strings.Join(where, " AND "), args...)
+	return err
+}
@@ -14,7 +14,7 @@ import (
 )
 
 func (d *DB) CreateAttachment(ctx context.Context, create *store.Attachment) (*store.Attachment, error) {
-	fields := []string{"uid", "filename", "blob", "type", "size", "creator_id", "memo_id", "storage_type", "reference", "payload"}
+	fields := []string{"uid", "filename", "blob", "type", "size", "creator_id", "memo_id", "storage_type", "reference", "payload", "content_hash"}
 	storageType := ""
 	if create.StorageType != storepb.AttachmentStorageType_ATTACHMENT_STORAGE_TYPE_UNSPECIFIED {
 		storageType = create.StorageType.String()
@@ -27,7 +27,7 @@ func (d *DB) CreateAttachment(ctx context.Context, create *store.Attachment) (*s
 		}
 		payloadString = string(bytes)
 	}
-	args := []any{create.UID, create.Filename, create.Blob, create.Type, create.Size, create.CreatorID, create.MemoID, storageType, create.Reference, payloadString}
+	args := []any{create.UID, create.Filename, create.Blob, create.Type, create.Size, create.CreatorID, create.MemoID, storageType, create.Reference, payloadString, create.ContentHash}
 
 	stmt := "INSERT INTO resource (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts, updated_ts"
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(&create.ID, &create.CreatedTs, &create.UpdatedTs); err != nil {
@@ -57,6 +57,9 @@ func (d *DB) ListAttachments(ctx context.Context, find *store.FindAttachment) ([
 	if v := find.MemoID; v != nil {
 		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := find.ContentHash; v != nil {
+		where, args = append(where, "content_hash = "+placeholder(len(args)+1)), append(args, *v)
+	}
 	if find.HasRelatedMemo {
 		where = append(where, "memo_id IS NOT NULL")
 	}
@@ -64,7 +67,7 @@ func (d *DB) ListAttachments(ctx context.Context, find *store.FindAttachment) ([
 		where, args = append(where, "storage_type = "+placeholder(len(args)+1)), append(args, v.String())
 	}
 
-	fields := []string{"id", "uid", "filename", "type", "size", "creator_id", "created_ts", "updated_ts", "memo_id", "storage_type", "reference", "payload"}
+	fields := []string{"id", "uid", "filename", "type", "size", "creator_id", "created_ts", "updated_ts", "memo_id", "storage_type", "reference", "payload", "content_hash"}
 	if find.GetBlob {
 		fields = append(fields, "blob")
 	}
@@ -108,6 +111,7 @@ func (d *DB) ListAttachments(ctx context.Context, find *store.FindAttachment) ([
 			&storageType,
 			&attachment.Reference,
 			&payloadBytes,
+			&attachment.ContentHash,
 		}
 		if find.GetBlob {
 			dests = append(dests, &attachment.Blob)
@@ -160,6 +164,25 @@ func (d *DB) UpdateAttachment(ctx context.Context, update *store.UpdateAttachmen
 		}
 		set, args = append(set, "payload = "+placeholder(len(args)+1)), append(args, string(bytes))
 	}
+	if v := update.StorageType; v != nil {
+		storageType := ""
+		if *v != storepb.AttachmentStorageType_ATTACHMENT_STORAGE_TYPE_UNSPECIFIED {
+			storageType = v.String()
+		}
+		set, args = append(set, "storage_type = "+placeholder(len(args)+1)), append(args, storageType)
+	}
+	if v := update.Blob; v != nil {
+		set, args = append(set, "blob = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Type; v != nil {
+		set, args = append(set, "type = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Size; v != nil {
+		set, args = append(set, "size = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.ContentHash; v != nil {
+		set, args = append(set, "content_hash = "+placeholder(len(args)+1)), append(args, *v)
+	}
 
 	stmt := `UPDATE resource SET ` + strings.Join(set, ", ") + ` WHERE id = ` + placeholder(len(args)+1)
 	args = append(args, update.ID)
@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertAttachmentText(ctx context.Context, upsert *store.AttachmentText) (*store.AttachmentText, error) {
+	stmt := `
+		INSERT INTO attachment_text (
+			attachment_id, content
+		)
+		VALUES ($1, $2)
+		ON CONFLICT(attachment_id) DO UPDATE
+		SET content = EXCLUDED.content
+	`
+	if _, err := d.db.ExecContext(ctx, stmt, upsert.AttachmentID, upsert.Content); err != nil {
+		return nil, err
+	}
+	return upsert, nil
+}
+
+func (d *DB) ListAttachmentTexts(ctx context.Context, find *store.FindAttachmentText) ([]*store.AttachmentText, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.AttachmentID != nil {
+		where, args = append(where, "attachment_text.attachment_id = "+placeholder(len(args)+1)), append(args, *find.AttachmentID)
+	}
+	if len(find.AttachmentIDList) > 0 {
+		holders := make([]string, 0, len(find.AttachmentIDList))
+		for _, id := range find.AttachmentIDList {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, id)
+		}
+		where = append(where, fmt.Sprintf("attachment_text.attachment_id IN (%s)", strings.Join(holders, ", ")))
+	}
+	if len(find.MemoIDList) > 0 {
+		holders := make([]string, 0, len(find.MemoIDList))
+		for _, id := range find.MemoIDList {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, id)
+		}
+		where = append(where, fmt.Sprintf("resource.memo_id IN (%s)", strings.Join(holders, ", ")))
+	}
+	if v := find.FullTextSearch; v != nil {
+		where, args = append(where, "attachment_text.content_search @@ plainto_tsquery('simple', "+placeholder(len(args)+1)+")"), append(args, *v)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			attachment_text.attachment_id,
+			attachment_text.content,
+			resource.memo_id
+		FROM attachment_text
+		LEFT JOIN resource ON resource.id = attachment_text.attachment_id
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY attachment_text.attachment_id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.AttachmentText{}
+	for rows.Next() {
+		attachmentText := &store.AttachmentText{}
+		if err := rows.Scan(
+			&attachmentText.AttachmentID,
+			&attachmentText.Content,
+			&attachmentText.MemoID,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, attachmentText)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteAttachmentText(ctx context.Context, delete *store.DeleteAttachmentText) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM attachment_text WHERE attachment_id = $1", delete.AttachmentID)
+	return err
+}
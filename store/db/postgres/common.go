@@ -1,12 +1,19 @@
 package postgres
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
+// executor is implemented by both *sql.DB and *sql.Tx.
+type executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
 var (
 	protojsonUnmarshaler = protojson.UnmarshalOptions{
 		DiscardUnknown: true,
@@ -13,8 +13,14 @@ import (
 	"github.com/usememos/memos/store"
 )
 
+// memoScoreExpr returns the relevance of a memo to the full-text query bound to the placeholder.
+// Memos only matching by their attachments score 0.
+func memoScoreExpr(fullTextSearchPlaceholder string) string {
+	return "ts_rank(memo.content_search, plainto_tsquery('simple', " + fullTextSearchPlaceholder + "))::float8"
+}
+
 func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, error) {
-	fields := []string{"uid", "creator_id", "content", "visibility", "payload"}
+	fields := []string{"uid", "creator_id", "content", "visibility", "payload", "space_id"}
 	payload := "{}"
 	if create.Payload != nil {
 		payloadBytes, err := protojson.Marshal(create.Payload)
@@ -23,7 +29,7 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 		}
 		payload = string(payloadBytes)
 	}
-	args := []any{create.UID, create.CreatorID, create.Content, create.Visibility, payload}
+	args := []any{create.UID, create.CreatorID, create.Content, create.Visibility, payload, create.SpaceID}
 
 	stmt := "INSERT INTO memo (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts, updated_ts, row_status"
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
@@ -50,6 +56,9 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if v := find.CreatorID; v != nil {
 		where, args = append(where, "memo.creator_id = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := find.SpaceID; v != nil {
+		where, args = append(where, "memo.space_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
 	if v := find.RowStatus; v != nil {
 		where, args = append(where, "memo.row_status = "+placeholder(len(args)+1)), append(args, *v)
 	}
@@ -70,6 +79,24 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			where, args = append(where, "memo.content ILIKE "+placeholder(len(args)+1)), append(args, fmt.Sprintf("%%%s%%", s))
 		}
 	}
+	for _, fragments := range find.ContentFragments {
+		conditions := []string{}
+		for _, fragment := range fragments {
+			conditions, args = append(conditions, "memo.content ILIKE "+placeholder(len(args)+1)), append(args, fmt.Sprintf("%%%s%%", fragment))
+		}
+		where = append(where, "("+strings.Join(conditions, " OR ")+")")
+	}
+	fullTextSearchPlaceholder := ""
+	if v := find.FullTextSearch; v != nil {
+		fullTextSearchPlaceholder = placeholder(len(args) + 1)
+		where, args = append(where, "(memo.content_search @@ plainto_tsquery('simple', "+fullTextSearchPlaceholder+") OR "+
+			"memo.id IN (SELECT resource.memo_id FROM resource JOIN attachment_text ON attachment_text.attachment_id = resource.id WHERE attachment_text.content_search @@ plainto_tsquery('simple', "+fullTextSearchPlaceholder+")))"), append(args, *v)
+		if c := find.RelevanceCursor; c != nil && find.OrderByRelevance {
+			scoreExpr := memoScoreExpr(fullTextSearchPlaceholder)
+			where = append(where, fmt.Sprintf("(%s < %s OR (%s = %s AND memo.id < %s))", scoreExpr, placeholder(len(args)+1), scoreExpr, placeholder(len(args)+1), placeholder(len(args)+2)))
+			args = append(args, c.Score, c.ID)
+		}
+	}
 	if v := find.VisibilityList; len(v) != 0 {
 		holders := []string{}
 		for _, visibility := range v {
@@ -130,14 +157,24 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.OrderByTimeAsc {
 		order = "ASC"
 	}
+	orderByRelevance := find.FullTextSearch != nil && find.OrderByRelevance
 	orderBy := []string{}
-	if find.OrderByPinned {
-		orderBy = append(orderBy, "pinned DESC")
-	}
-	if find.OrderByUpdatedTs {
-		orderBy = append(orderBy, "updated_ts "+order)
+	if orderByRelevance {
+		orderBy = append(orderBy, "score DESC", "memo.id DESC")
+	} else if find.OrderByRandomSeed != nil {
+		// A seeded hash of the id shuffles the memos, squaring it breaks the patterns of a linear hash.
+		hash := fmt.Sprintf("((memo.id::BIGINT * 1103515245 + %d) %% 2147483647)", *find.OrderByRandomSeed)
+		hash = fmt.Sprintf("((%s * %s + %d) %% 2147483647)", hash, hash, *find.OrderByRandomSeed)
+		orderBy = append(orderBy, fmt.Sprintf("(%s * %s) %% 2147483647", hash, hash), "memo.id")
 	} else {
-		orderBy = append(orderBy, "created_ts "+order)
+		if find.OrderByPinned {
+			orderBy = append(orderBy, "pinned DESC")
+		}
+		if find.OrderByUpdatedTs {
+			orderBy = append(orderBy, "updated_ts "+order)
+		} else {
+			orderBy = append(orderBy, "created_ts "+order)
+		}
 	}
 	fields := []string{
 		`memo.id AS id`,
@@ -148,12 +185,16 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		`memo.row_status AS row_status`,
 		`memo.visibility AS visibility`,
 		`memo.pinned AS pinned`,
+		`memo.space_id AS space_id`,
 		`memo.payload AS payload`,
 		`memo_relation.related_memo_id AS parent_id`,
 	}
 	if !find.ExcludeContent {
 		fields = append(fields, `memo.content AS content`)
 	}
+	if orderByRelevance {
+		fields = append(fields, memoScoreExpr(fullTextSearchPlaceholder)+` AS score`)
+	}
 
 	query := `SELECT ` + strings.Join(fields, ", ") + `
 		FROM memo
@@ -186,12 +227,16 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			&memo.RowStatus,
 			&memo.Visibility,
 			&memo.Pinned,
+			&memo.SpaceID,
 			&payloadBytes,
 			&memo.ParentID,
 		}
 		if !find.ExcludeContent {
 			dests = append(dests, &memo.Content)
 		}
+		if orderByRelevance {
+			dests = append(dests, &memo.Score)
+		}
 		if err := rows.Scan(dests...); err != nil {
 			return nil, err
 		}
@@ -224,6 +269,25 @@ func (d *DB) GetMemo(ctx context.Context, find *store.FindMemo) (*store.Memo, er
 }
 
 func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
+	return updateMemo(ctx, d.db, update)
+}
+
+func (d *DB) UpdateMemos(ctx context.Context, updates []*store.UpdateMemo) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, update := range updates {
+		if err := updateMemo(ctx, tx, update); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func updateMemo(ctx context.Context, executor executor, update *store.UpdateMemo) error {
 	set, args := []string{}, []any{}
 	if v := update.UID; v != nil {
 		set, args = append(set, "uid = "+placeholder(len(args)+1)), append(args, *v)
@@ -259,7 +323,7 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 
 	stmt := `UPDATE memo SET ` + strings.Join(set, ", ") + ` WHERE id = ` + placeholder(len(args)+1)
 	args = append(args, update.ID)
-	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+	if _, err := executor.ExecContext(ctx, stmt, args...); err != nil {
 		return err
 	}
 	return nil
@@ -104,7 +104,7 @@ func (d *DB) convertWithParameterIndex(ctx *filter.ConvertContext, expr *exprv1.
 			if err != nil {
 				return paramIndex, err
 			}
-			if !slices.Contains([]string{"creator_id", "created_ts", "updated_ts", "visibility", "content", "has_task_list"}, identifier) {
+			if !slices.Contains([]string{"creator_id", "created_ts", "updated_ts", "visibility", "content", "has_task_list", "has_tags", "has_incomplete_tasks", "word_count", "attachment_count", "attachment_size"}, identifier) {
 				return paramIndex, errors.Errorf("invalid identifier for %s", v.CallExpr.Function)
 			}
 			value, err := filter.GetExprValue(v.CallExpr.Args[1])
@@ -185,6 +185,47 @@ func (d *DB) convertWithParameterIndex(ctx *filter.ConvertContext, expr *exprv1.
 				}
 				ctx.Args = append(ctx.Args, valueBool)
 				return paramIndex + 1, nil
+			} else if identifier == "has_tags" {
+				if operator != "=" && operator != "!=" {
+					return paramIndex, errors.Errorf("invalid operator for %s", v.CallExpr.Function)
+				}
+				valueBool, ok := value.(bool)
+				if !ok {
+					return paramIndex, errors.New("invalid boolean value for has_tags")
+				}
+				sqlTemplate := filter.GetSQL("has_no_tags", dbType)
+				if valueBool == (operator == "=") {
+					sqlTemplate = filter.GetSQL("has_tags", dbType)
+				}
+				if _, err := ctx.Buffer.WriteString(sqlTemplate); err != nil {
+					return paramIndex, err
+				}
+			} else if identifier == "has_incomplete_tasks" {
+				if operator != "=" && operator != "!=" {
+					return paramIndex, errors.Errorf("invalid operator for %s", v.CallExpr.Function)
+				}
+				valueBool, ok := value.(bool)
+				if !ok {
+					return paramIndex, errors.New("invalid boolean value for has_incomplete_tasks")
+				}
+				sqlTemplate := filter.GetSQL("has_no_incomplete_tasks", dbType)
+				if valueBool == (operator == "=") {
+					sqlTemplate = filter.GetSQL("has_incomplete_tasks", dbType)
+				}
+				if _, err := ctx.Buffer.WriteString(sqlTemplate); err != nil {
+					return paramIndex, err
+				}
+			} else if identifier == "word_count" || identifier == "attachment_count" || identifier == "attachment_size" {
+				valueInt, ok := value.(int64)
+				if !ok {
+					return paramIndex, errors.Errorf("invalid integer value for %s", identifier)
+				}
+				if _, err := ctx.Buffer.WriteString(fmt.Sprintf("%s %s %s", filter.GetSQL(identifier, dbType), operator,
+					filter.GetParameterPlaceholder(dbType, paramIndex))); err != nil {
+					return paramIndex, err
+				}
+				ctx.Args = append(ctx.Args, valueInt)
+				return paramIndex + 1, nil
 			}
 		case "@in":
 			if len(v.CallExpr.Args) != 2 {
@@ -237,12 +278,12 @@ func (d *DB) convertWithParameterIndex(ctx *filter.ConvertContext, expr *exprv1.
 				args := []any{}
 				currentParamIndex := paramIndex
 				for _, v := range values {
-					// Use parameter index for each placeholder
-					placeholder := filter.GetParameterPlaceholder(dbType, currentParamIndex)
-					subcondition := strings.Replace(filter.GetSQL("json_contains_tag", dbType), "?", placeholder, 1)
-					subconditions = append(subconditions, subcondition)
-					args = append(args, filter.GetParameterValue(dbType, "json_contains_tag", v))
-					currentParamIndex++
+					// Use parameter index for each placeholder. A parent tag also matches all of its descendants.
+					containsSQL := strings.Replace(filter.GetSQL("json_contains_tag", dbType), "?", filter.GetParameterPlaceholder(dbType, currentParamIndex), 1)
+					descendantSQL := strings.Replace(filter.GetSQL("json_contains_tag_descendant", dbType), "?", filter.GetParameterPlaceholder(dbType, currentParamIndex+1), 1)
+					subconditions = append(subconditions, fmt.Sprintf("(%s OR %s)", containsSQL, descendantSQL))
+					args = append(args, filter.GetParameterValue(dbType, "json_contains_tag", v), filter.GetParameterValue(dbType, "json_contains_tag_descendant", v))
+					currentParamIndex += 2
 				}
 				if len(subconditions) == 1 {
 					if _, err := ctx.Buffer.WriteString(subconditions[0]); err != nil {
@@ -264,6 +305,81 @@ func (d *DB) convertWithParameterIndex(ctx *filter.ConvertContext, expr *exprv1.
 				ctx.Args = append(ctx.Args, values...)
 				return paramIndex + len(values), nil
 			}
+		case "has_attachment_type", "in_relation_with":
+			if len(v.CallExpr.Args) != 1 {
+				return paramIndex, errors.Errorf("invalid number of arguments for %s", v.CallExpr.Function)
+			}
+			arg, err := filter.GetConstValue(v.CallExpr.Args[0])
+			if err != nil {
+				return paramIndex, err
+			}
+			if _, ok := arg.(string); !ok {
+				return paramIndex, errors.Errorf("argument of %s must be a string", v.CallExpr.Function)
+			}
+			placeholder := filter.GetParameterPlaceholder(dbType, paramIndex)
+			sql := strings.Replace(filter.GetSQL(v.CallExpr.Function, dbType), "?", placeholder, 1)
+			if _, err := ctx.Buffer.WriteString(sql); err != nil {
+				return paramIndex, err
+			}
+			ctx.Args = append(ctx.Args, filter.GetParameterValue(dbType, v.CallExpr.Function, arg))
+			return paramIndex + 1, nil
+		case "group_visible_to":
+			if len(v.CallExpr.Args) != 1 {
+				return paramIndex, errors.Errorf("invalid number of arguments for %s", v.CallExpr.Function)
+			}
+			arg, err := filter.GetConstValue(v.CallExpr.Args[0])
+			if err != nil {
+				return paramIndex, err
+			}
+			if _, ok := arg.(int64); !ok {
+				return paramIndex, errors.Errorf("argument of %s must be an integer", v.CallExpr.Function)
+			}
+			sql := strings.Replace(filter.GetSQL(v.CallExpr.Function, dbType), "?", filter.GetParameterPlaceholder(dbType, paramIndex), 1)
+			if _, err := ctx.Buffer.WriteString(sql); err != nil {
+				return paramIndex, err
+			}
+			ctx.Args = append(ctx.Args, arg)
+			return paramIndex + 1, nil
+		case "has_attachment_class":
+			if len(v.CallExpr.Args) != 1 {
+				return paramIndex, errors.Errorf("invalid number of arguments for %s", v.CallExpr.Function)
+			}
+			arg, err := filter.GetConstValue(v.CallExpr.Args[0])
+			if err != nil {
+				return paramIndex, err
+			}
+			class, ok := arg.(string)
+			if !ok {
+				return paramIndex, errors.Errorf("argument of %s must be a string", v.CallExpr.Function)
+			}
+			types, err := filter.GetAttachmentClassTypes(class)
+			if err != nil {
+				return paramIndex, err
+			}
+			conditions := []string{}
+			for i, t := range types {
+				conditions = append(conditions, strings.Replace(filter.GetSQL("attachment_type_like", dbType), "?", filter.GetParameterPlaceholder(dbType, paramIndex+i), 1))
+				ctx.Args = append(ctx.Args, filter.GetParameterValue(dbType, "attachment_type_like", t))
+			}
+			if _, err := ctx.Buffer.WriteString(fmt.Sprintf(filter.GetSQL("has_attachment_class", dbType), strings.Join(conditions, " OR "))); err != nil {
+				return paramIndex, err
+			}
+			return paramIndex + len(types), nil
+		case "within":
+			within, err := filter.ParseLocationWithin(v.CallExpr)
+			if err != nil {
+				return paramIndex, err
+			}
+			args := within.Args()
+			sql := filter.GetSQL("location_within", dbType)
+			for i := range args {
+				sql = strings.Replace(sql, "?", filter.GetParameterPlaceholder(dbType, paramIndex+i), 1)
+			}
+			if _, err := ctx.Buffer.WriteString(sql); err != nil {
+				return paramIndex, err
+			}
+			ctx.Args = append(ctx.Args, args...)
+			return paramIndex + len(args), nil
 		case "contains":
 			if len(v.CallExpr.Args) != 1 {
 				return paramIndex, errors.Errorf("invalid number of arguments for %s", v.CallExpr.Function)
@@ -289,7 +405,7 @@ func (d *DB) convertWithParameterIndex(ctx *filter.ConvertContext, expr *exprv1.
 		}
 	} else if v, ok := expr.ExprKind.(*exprv1.Expr_IdentExpr); ok {
 		identifier := v.IdentExpr.GetName()
-		if !slices.Contains([]string{"pinned", "has_task_list"}, identifier) {
+		if !slices.Contains([]string{"pinned", "has_task_list", "has_tags", "has_incomplete_tasks"}, identifier) {
 			return paramIndex, errors.Errorf("invalid identifier %s", identifier)
 		}
 		if identifier == "pinned" {
@@ -301,6 +417,14 @@ func (d *DB) convertWithParameterIndex(ctx *filter.ConvertContext, expr *exprv1.
 			if _, err := ctx.Buffer.WriteString(filter.GetSQL("boolean_check", dbType)); err != nil {
 				return paramIndex, err
 			}
+		} else if identifier == "has_tags" {
+			if _, err := ctx.Buffer.WriteString(filter.GetSQL("has_tags", dbType)); err != nil {
+				return paramIndex, err
+			}
+		} else if identifier == "has_incomplete_tasks" {
+			if _, err := ctx.Buffer.WriteString(filter.GetSQL("has_incomplete_tasks", dbType)); err != nil {
+				return paramIndex, err
+			}
 		}
 	}
 	return paramIndex, nil
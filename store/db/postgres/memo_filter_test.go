@@ -17,13 +17,13 @@ func TestRestoreExprToSQL(t *testing.T) {
 	}{
 		{
 			filter: `tag in ["tag1", "tag2"]`,
-			want:   "(memo.payload->'tags' @> jsonb_build_array($1) OR memo.payload->'tags' @> jsonb_build_array($2))",
-			args:   []any{"tag1", "tag2"},
+			want:   "((memo.payload->'tags' @> jsonb_build_array($1) OR EXISTS (SELECT 1 FROM jsonb_array_elements_text(memo.payload->'tags') AS tag WHERE tag LIKE $2 ESCAPE '\\')) OR (memo.payload->'tags' @> jsonb_build_array($3) OR EXISTS (SELECT 1 FROM jsonb_array_elements_text(memo.payload->'tags') AS tag WHERE tag LIKE $4 ESCAPE '\\')))",
+			args:   []any{"tag1", "tag1/%", "tag2", "tag2/%"},
 		},
 		{
 			filter: `!(tag in ["tag1", "tag2"])`,
-			want:   `NOT ((memo.payload->'tags' @> jsonb_build_array($1) OR memo.payload->'tags' @> jsonb_build_array($2)))`,
-			args:   []any{"tag1", "tag2"},
+			want:   `NOT (((memo.payload->'tags' @> jsonb_build_array($1) OR EXISTS (SELECT 1 FROM jsonb_array_elements_text(memo.payload->'tags') AS tag WHERE tag LIKE $2 ESCAPE '\')) OR (memo.payload->'tags' @> jsonb_build_array($3) OR EXISTS (SELECT 1 FROM jsonb_array_elements_text(memo.payload->'tags') AS tag WHERE tag LIKE $4 ESCAPE '\'))))`,
+			args:   []any{"tag1", "tag1/%", "tag2", "tag2/%"},
 		},
 		{
 			filter: `content.contains("memos")`,
@@ -42,8 +42,8 @@ func TestRestoreExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `tag in ['tag1'] || content.contains('hello')`,
-			want:   "(memo.payload->'tags' @> jsonb_build_array($1) OR memo.content ILIKE $2)",
-			args:   []any{"tag1", "%hello%"},
+			want:   "((memo.payload->'tags' @> jsonb_build_array($1) OR EXISTS (SELECT 1 FROM jsonb_array_elements_text(memo.payload->'tags') AS tag WHERE tag LIKE $2 ESCAPE '\\')) OR memo.content ILIKE $3)",
+			args:   []any{"tag1", "tag1/%", "%hello%"},
 		},
 		{
 			filter: `1`,
@@ -115,6 +115,66 @@ func TestRestoreExprToSQL(t *testing.T) {
 			want:   "jsonb_array_length(COALESCE(memo.payload->'tags', '[]'::jsonb)) = $1",
 			args:   []any{int64(2)},
 		},
+		{
+			filter: `has_tags`,
+			want:   "jsonb_array_length(COALESCE(memo.payload->'tags', '[]'::jsonb)) > 0",
+			args:   []any{},
+		},
+		{
+			filter: `!has_tags`,
+			want:   "NOT (jsonb_array_length(COALESCE(memo.payload->'tags', '[]'::jsonb)) > 0)",
+			args:   []any{},
+		},
+		{
+			filter: `has_tags == false`,
+			want:   "jsonb_array_length(COALESCE(memo.payload->'tags', '[]'::jsonb)) = 0",
+			args:   []any{},
+		},
+		{
+			filter: `has_incomplete_tasks`,
+			want:   "(memo.payload->'property'->>'hasIncompleteTasks')::boolean IS TRUE",
+			args:   []any{},
+		},
+		{
+			filter: `has_incomplete_tasks == false`,
+			want:   "(memo.payload->'property'->>'hasIncompleteTasks')::boolean IS NOT TRUE",
+			args:   []any{},
+		},
+		{
+			filter: `word_count > 100`,
+			want:   "COALESCE((memo.payload->'property'->>'wordCount')::integer, 0) > $1",
+			args:   []any{int64(100)},
+		},
+		{
+			filter: `attachment_count >= 2 && attachment_size > 1048576`,
+			want:   "((SELECT COUNT(*) FROM resource WHERE resource.memo_id = memo.id) >= $1 AND (SELECT COALESCE(SUM(resource.size), 0) FROM resource WHERE resource.memo_id = memo.id) > $2)",
+			args:   []any{int64(2), int64(1048576)},
+		},
+		{
+			filter: `has_attachment_class("audio") || has_attachment_class("video")`,
+			want:   "(EXISTS (SELECT 1 FROM resource WHERE resource.memo_id = memo.id AND (resource.type LIKE $1)) OR EXISTS (SELECT 1 FROM resource WHERE resource.memo_id = memo.id AND (resource.type LIKE $2)))",
+			args:   []any{"audio/%", "video/%"},
+		},
+		{
+			filter: `has_attachment_type("image/*") && word_count <= 10`,
+			want:   "(EXISTS (SELECT 1 FROM resource WHERE resource.memo_id = memo.id AND resource.type LIKE $1) AND COALESCE((memo.payload->'property'->>'wordCount')::integer, 0) <= $2)",
+			args:   []any{"image/%", int64(10)},
+		},
+		{
+			filter: `in_relation_with("abc")`,
+			want:   "EXISTS (SELECT 1 FROM memo_relation AS relation JOIN memo AS related_memo ON related_memo.uid = $1 WHERE (relation.memo_id = memo.id AND relation.related_memo_id = related_memo.id) OR (relation.related_memo_id = memo.id AND relation.memo_id = related_memo.id))",
+			args:   []any{"abc"},
+		},
+		{
+			filter: `visibility in ["PUBLIC", "PROTECTED"] || group_visible_to(1)`,
+			want:   "(memo.visibility IN ($1,$2) OR (memo.visibility = 'GROUP' AND EXISTS (SELECT 1 FROM memo_group JOIN user_group_member ON user_group_member.group_id = memo_group.group_id WHERE memo_group.memo_id = memo.id AND user_group_member.user_id = $3)))",
+			args:   []any{"PUBLIC", "PROTECTED", int64(1)},
+		},
+		{
+			filter: `location.within(0, 0, 111.32)`,
+			want:   "(memo.payload->'location' IS NOT NULL AND COALESCE((memo.payload->'location'->>'latitude')::double precision, 0) BETWEEN $1 AND $2 AND COALESCE((memo.payload->'location'->>'longitude')::double precision, 0) BETWEEN $3 AND $4 AND (COALESCE((memo.payload->'location'->>'latitude')::double precision, 0) - $5) * (COALESCE((memo.payload->'location'->>'latitude')::double precision, 0) - $6) + (COALESCE((memo.payload->'location'->>'longitude')::double precision, 0) - $7) * (COALESCE((memo.payload->'location'->>'longitude')::double precision, 0) - $8) * $9 <= $10)",
+			args:   []any{-1.0, 1.0, -1.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 1.0},
+		},
 	}
 
 	for _, tt := range tests {
@@ -10,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/plugin/tracing"
 	"github.com/usememos/memos/store"
 )
 
@@ -24,7 +25,7 @@ func NewDB(profile *profile.Profile) (store.Driver, error) {
 	}
 
 	// Open the PostgreSQL connection
-	db, err := sql.Open("postgres", profile.DSN)
+	db, err := tracing.OpenDB("postgres", profile.DSN)
 	if err != nil {
 		log.Printf("Failed to open database: %s", err)
 		return nil, errors.Wrapf(err, "failed to open database: %s", profile.DSN)
@@ -0,0 +1,77 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateReadGrant(ctx context.Context, create *store.ReadGrant) (*store.ReadGrant, error) {
+	fields := []string{"creator_id", "tag", "grantee_id"}
+	args := []any{create.CreatorID, create.Tag, create.GranteeID}
+	stmt := "INSERT INTO read_grant (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListReadGrants(ctx context.Context, find *store.FindReadGrant) ([]*store.ReadGrant, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *find.ID)
+	}
+	if find.CreatorID != nil {
+		where, args = append(where, "creator_id = "+placeholder(len(args)+1)), append(args, *find.CreatorID)
+	}
+	if find.GranteeID != nil {
+		where, args = append(where, "grantee_id = "+placeholder(len(args)+1)), append(args, *find.GranteeID)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			id,
+			created_ts,
+			creator_id,
+			tag,
+			grantee_id
+		FROM read_grant
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ReadGrant{}
+	for rows.Next() {
+		readGrant := &store.ReadGrant{}
+		if err := rows.Scan(
+			&readGrant.ID,
+			&readGrant.CreatedTs,
+			&readGrant.CreatorID,
+			&readGrant.Tag,
+			&readGrant.GranteeID,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, readGrant)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteReadGrant(ctx context.Context, delete *store.DeleteReadGrant) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM read_grant WHERE id = $1", delete.ID)
+	return err
+}
@@ -1,6 +1,16 @@
 package sqlite
 
-import "google.golang.org/protobuf/encoding/protojson"
+import (
+	"context"
+	"database/sql"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// executor is implemented by both *sql.DB and *sql.Tx.
+type executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
 
 var (
 	protojsonUnmarshaler = protojson.UnmarshalOptions{
@@ -53,7 +53,7 @@ func (d *DB) ListInboxes(ctx context.Context, find *store.FindInbox) ([]*store.I
 		where, args = append(where, "`status` = ?"), append(args, *find.Status)
 	}
 
-	query := "SELECT `id`, `created_ts`, `sender_id`, `receiver_id`, `status`, `message` FROM `inbox` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC"
+	query := "SELECT `id`, `created_ts`, `sender_id`, `receiver_id`, `status`, `message` FROM `inbox` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC, `id` DESC"
 	if find.Limit != nil {
 		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
 		if find.Offset != nil {
@@ -98,6 +98,19 @@ func (d *DB) ListInboxes(ctx context.Context, find *store.FindInbox) ([]*store.I
 
 func (d *DB) UpdateInbox(ctx context.Context, update *store.UpdateInbox) (*store.Inbox, error) {
 	set, args := []string{"`status` = ?"}, []any{update.Status.String()}
+	if update.CreatedTs != nil {
+		set, args = append(set, "`created_ts` = ?"), append(args, *update.CreatedTs)
+	}
+	if update.SenderID != nil {
+		set, args = append(set, "`sender_id` = ?"), append(args, *update.SenderID)
+	}
+	if update.Message != nil {
+		bytes, err := protojson.Marshal(update.Message)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal inbox message")
+		}
+		set, args = append(set, "`message` = ?"), append(args, string(bytes))
+	}
 	args = append(args, update.ID)
 	query := "UPDATE `inbox` SET " + strings.Join(set, ", ") + " WHERE `id` = ? RETURNING `id`, `created_ts`, `sender_id`, `receiver_id`, `status`, `message`"
 	inbox := &store.Inbox{}
@@ -130,3 +143,37 @@ func (d *DB) DeleteInbox(ctx context.Context, delete *store.DeleteInbox) error {
 	}
 	return nil
 }
+
+func (d *DB) UpdateInboxes(ctx context.Context, update *store.UpdateInboxes) (int64, error) {
+	result, err := d.db.ExecContext(ctx, "UPDATE `inbox` SET `status` = ? WHERE `receiver_id` = ? AND `status` = ?", update.Status.String(), update.ReceiverID, update.FromStatus.String())
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (d *DB) DeleteInboxes(ctx context.Context, delete *store.DeleteInboxes) (int64, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if len(delete.IDList) > 0 {
+		placeholders := make([]string, 0, len(delete.IDList))
+		for _, id := range delete.IDList {
+			placeholders = append(placeholders, "?")
+			args = append(args, id)
+		}
+		where = append(where, fmt.Sprintf("`id` IN (%s)", strings.Join(placeholders, ", ")))
+	}
+	if delete.ReceiverID != nil {
+		where, args = append(where, "`receiver_id` = ?"), append(args, *delete.ReceiverID)
+	}
+	if delete.Status != nil {
+		where, args = append(where, "`status` = ?"), append(args, delete.Status.String())
+	}
+	if delete.CreatedTsBefore != nil {
+		where, args = append(where, "`created_ts` < ?"), append(args, *delete.CreatedTsBefore)
+	}
+	result, err := d.db.ExecContext(ctx, "DELETE FROM `inbox` WHERE "+strings.Join(where, " AND "), args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -13,9 +13,13 @@ import (
 	"github.com/usememos/memos/store"
 )
 
+// memoScoreExpr is the relevance of a memo to the full-text query bound to its placeholder.
+// bm25 is lower for better matches, and memos only matching by their attachments score 0.
+const memoScoreExpr = "-COALESCE((SELECT bm25(`memo_fts`) FROM `memo_fts` WHERE `memo_fts` MATCH ? AND `memo_fts`.`rowid` = `memo`.`id`), 0)"
+
 func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, error) {
-	fields := []string{"`uid`", "`creator_id`", "`content`", "`visibility`", "`payload`"}
-	placeholder := []string{"?", "?", "?", "?", "?"}
+	fields := []string{"`uid`", "`creator_id`", "`content`", "`visibility`", "`payload`", "`space_id`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?"}
 	payload := "{}"
 	if create.Payload != nil {
 		payloadBytes, err := protojson.Marshal(create.Payload)
@@ -24,7 +28,7 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 		}
 		payload = string(payloadBytes)
 	}
-	args := []any{create.UID, create.CreatorID, create.Content, create.Visibility, payload}
+	args := []any{create.UID, create.CreatorID, create.Content, create.Visibility, payload, create.SpaceID}
 
 	stmt := "INSERT INTO `memo` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `created_ts`, `updated_ts`, `row_status`"
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
@@ -51,6 +55,9 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if v := find.CreatorID; v != nil {
 		where, args = append(where, "`memo`.`creator_id` = ?"), append(args, *v)
 	}
+	if v := find.SpaceID; v != nil {
+		where, args = append(where, "`memo`.`space_id` = ?"), append(args, *v)
+	}
 	if v := find.RowStatus; v != nil {
 		where, args = append(where, "`memo`.`row_status` = ?"), append(args, *v)
 	}
@@ -71,6 +78,21 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			where, args = append(where, "`memo`.`content` LIKE ?"), append(args, fmt.Sprintf("%%%s%%", s))
 		}
 	}
+	for _, fragments := range find.ContentFragments {
+		conditions := []string{}
+		for _, fragment := range fragments {
+			conditions, args = append(conditions, "`memo`.`content` LIKE ?"), append(args, fmt.Sprintf("%%%s%%", fragment))
+		}
+		where = append(where, "("+strings.Join(conditions, " OR ")+")")
+	}
+	if v := find.FullTextSearch; v != nil {
+		ftsQuery := buildFullTextQuery(*v)
+		where, args = append(where, "(`memo`.`id` IN (SELECT `rowid` FROM `memo_fts` WHERE `memo_fts` MATCH ?) OR "+
+			"`memo`.`id` IN (SELECT `resource`.`memo_id` FROM `resource` JOIN `attachment_text_fts` ON `attachment_text_fts`.`rowid` = `resource`.`id` WHERE `attachment_text_fts` MATCH ?))"), append(args, ftsQuery, ftsQuery)
+		if c := find.RelevanceCursor; c != nil && find.OrderByRelevance {
+			where, args = append(where, fmt.Sprintf("(%s < ? OR (%s = ? AND `memo`.`id` < ?))", memoScoreExpr, memoScoreExpr)), append(args, ftsQuery, c.Score, ftsQuery, c.Score, c.ID)
+		}
+	}
 	if v := find.VisibilityList; len(v) != 0 {
 		placeholder := []string{}
 		for _, visibility := range v {
@@ -130,14 +152,24 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.OrderByTimeAsc {
 		order = "ASC"
 	}
+	orderByRelevance := find.FullTextSearch != nil && find.OrderByRelevance
 	orderBy := []string{}
-	if find.OrderByPinned {
-		orderBy = append(orderBy, "`pinned` DESC")
-	}
-	if find.OrderByUpdatedTs {
-		orderBy = append(orderBy, "`updated_ts` "+order)
+	if orderByRelevance {
+		orderBy = append(orderBy, "`score` DESC", "`memo`.`id` DESC")
+	} else if find.OrderByRandomSeed != nil {
+		// A seeded hash of the id shuffles the memos, squaring it breaks the patterns of a linear hash.
+		hash := fmt.Sprintf("((`memo`.`id` * 1103515245 + %d) %% 2147483647)", *find.OrderByRandomSeed)
+		hash = fmt.Sprintf("((%s * %s + %d) %% 2147483647)", hash, hash, *find.OrderByRandomSeed)
+		orderBy = append(orderBy, fmt.Sprintf("(%s * %s) %% 2147483647", hash, hash), "`memo`.`id`")
 	} else {
-		orderBy = append(orderBy, "`created_ts` "+order)
+		if find.OrderByPinned {
+			orderBy = append(orderBy, "`pinned` DESC")
+		}
+		if find.OrderByUpdatedTs {
+			orderBy = append(orderBy, "`updated_ts` "+order)
+		} else {
+			orderBy = append(orderBy, "`created_ts` "+order)
+		}
 	}
 	fields := []string{
 		"`memo`.`id` AS `id`",
@@ -148,12 +180,17 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		"`memo`.`row_status` AS `row_status`",
 		"`memo`.`visibility` AS `visibility`",
 		"`memo`.`pinned` AS `pinned`",
+		"`memo`.`space_id` AS `space_id`",
 		"`memo`.`payload` AS `payload`",
 		"`memo_relation`.`related_memo_id` AS `parent_id`",
 	}
 	if !find.ExcludeContent {
 		fields = append(fields, "`memo`.`content` AS `content`")
 	}
+	if orderByRelevance {
+		// The arguments of the selected fields precede the ones of the conditions.
+		fields, args = append(fields, memoScoreExpr+" AS `score`"), append([]any{buildFullTextQuery(*find.FullTextSearch)}, args...)
+	}
 
 	query := "SELECT " + strings.Join(fields, ", ") + "FROM `memo` " +
 		"LEFT JOIN `memo_relation` ON `memo`.`id` = `memo_relation`.`memo_id` AND `memo_relation`.`type` = \"COMMENT\" " +
@@ -185,12 +222,16 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			&memo.RowStatus,
 			&memo.Visibility,
 			&memo.Pinned,
+			&memo.SpaceID,
 			&payloadBytes,
 			&memo.ParentID,
 		}
 		if !find.ExcludeContent {
 			dests = append(dests, &memo.Content)
 		}
+		if orderByRelevance {
+			dests = append(dests, &memo.Score)
+		}
 		if err := rows.Scan(dests...); err != nil {
 			return nil, err
 		}
@@ -210,6 +251,25 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 }
 
 func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
+	return updateMemo(ctx, d.db, update)
+}
+
+func (d *DB) UpdateMemos(ctx context.Context, updates []*store.UpdateMemo) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, update := range updates {
+		if err := updateMemo(ctx, tx, update); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func updateMemo(ctx context.Context, executor executor, update *store.UpdateMemo) error {
 	set, args := []string{}, []any{}
 	if v := update.UID; v != nil {
 		set, args = append(set, "`uid` = ?"), append(args, *v)
@@ -245,7 +305,7 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	args = append(args, update.ID)
 
 	stmt := "UPDATE `memo` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
-	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+	if _, err := executor.ExecContext(ctx, stmt, args...); err != nil {
 		return err
 	}
 	return nil
@@ -263,3 +323,14 @@ func (d *DB) DeleteMemo(ctx context.Context, delete *store.DeleteMemo) error {
 	}
 	return nil
 }
+
+// buildFullTextQuery converts the search text into an FTS5 query matching the memos
+// containing every term. Terms are quoted so that FTS5 operators are matched literally,
+// and matched as prefixes.
+func buildFullTextQuery(text string) string {
+	terms := []string{}
+	for _, term := range strings.Fields(text) {
+		terms = append(terms, `"`+strings.ReplaceAll(term, `"`, `""`)+`"*`)
+	}
+	return strings.Join(terms, " ")
+}
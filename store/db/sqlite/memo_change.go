@@ -0,0 +1,74 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateMemoChange(ctx context.Context, create *store.MemoChange) (*store.MemoChange, error) {
+	stmt := "INSERT INTO `memo_change` (`creator_id`, `memo_id`, `memo_uid`, `type`) VALUES (?, ?, ?, ?) RETURNING `id`, `created_ts`"
+	if err := d.db.QueryRowContext(ctx, stmt, create.CreatorID, create.MemoID, create.MemoUID, create.Type.String()).Scan(
+		&create.ID,
+		&create.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListMemoChanges(ctx context.Context, find *store.FindMemoChange) ([]*store.MemoChange, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.CreatorID != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *find.CreatorID)
+	}
+	if find.IDAfter != nil {
+		where, args = append(where, "`id` > ?"), append(args, *find.IDAfter)
+	}
+
+	query := "SELECT `id`, `created_ts`, `creator_id`, `memo_id`, `memo_uid`, `type` FROM `memo_change` WHERE " + strings.Join(where, " AND ") + " ORDER BY `id` ASC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoChange{}
+	for rows.Next() {
+		change := &store.MemoChange{}
+		if err := rows.Scan(
+			&change.ID,
+			&change.CreatedTs,
+			&change.CreatorID,
+			&change.MemoID,
+			&change.MemoUID,
+			&change.Type,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteMemoChanges(ctx context.Context, delete *store.DeleteMemoChange) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if delete.CreatedTsBefore != nil {
+		where, args = append(where, "`created_ts` < ?"), append(args, *delete.CreatedTsBefore)
+	}
+	_, err := d.db.ExecContext(ctx, "DELETE FROM `memo_change` WHERE "+strings.Join(where, " AND "), args...)
+	return err
+}
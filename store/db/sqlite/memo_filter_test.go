@@ -17,18 +17,18 @@ func TestConvertExprToSQL(t *testing.T) {
 	}{
 		{
 			filter: `tag in ["tag1", "tag2"]`,
-			want:   "(JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ? OR JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?)",
-			args:   []any{`%"tag1"%`, `%"tag2"%`},
+			want:   "((EXISTS (SELECT 1 FROM JSON_EACH(`memo`.`payload`, '$.tags') WHERE `value` = ?) OR EXISTS (SELECT 1 FROM JSON_EACH(`memo`.`payload`, '$.tags') WHERE INSTR(`value`, ?) = 1)) OR (EXISTS (SELECT 1 FROM JSON_EACH(`memo`.`payload`, '$.tags') WHERE `value` = ?) OR EXISTS (SELECT 1 FROM JSON_EACH(`memo`.`payload`, '$.tags') WHERE INSTR(`value`, ?) = 1)))",
+			args:   []any{"tag1", "tag1/", "tag2", "tag2/"},
 		},
 		{
 			filter: `!(tag in ["tag1", "tag2"])`,
-			want:   "NOT ((JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ? OR JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?))",
-			args:   []any{`%"tag1"%`, `%"tag2"%`},
+			want:   "NOT (((EXISTS (SELECT 1 FROM JSON_EACH(`memo`.`payload`, '$.tags') WHERE `value` = ?) OR EXISTS (SELECT 1 FROM JSON_EACH(`memo`.`payload`, '$.tags') WHERE INSTR(`value`, ?) = 1)) OR (EXISTS (SELECT 1 FROM JSON_EACH(`memo`.`payload`, '$.tags') WHERE `value` = ?) OR EXISTS (SELECT 1 FROM JSON_EACH(`memo`.`payload`, '$.tags') WHERE INSTR(`value`, ?) = 1))))",
+			args:   []any{"tag1", "tag1/", "tag2", "tag2/"},
 		},
 		{
 			filter: `tag in ["tag1", "tag2"] || tag in ["tag3", "tag4"]`,
-			want:   "((JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ? OR JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?) OR (JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ? OR JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?))",
-			args:   []any{`%"tag1"%`, `%"tag2"%`, `%"tag3"%`, `%"tag4"%`},
+			want:   "(((EXISTS (SELECT 1 FROM JSON_EACH(`memo`.`payload`, '$.tags') WHERE `value` = ?) OR EXISTS (SELECT 1 FROM JSON_EACH(`memo`.`payload`, '$.tags') WHERE INSTR(`value`, ?) = 1)) OR (EXISTS (SELECT 1 FROM JSON_EACH(`memo`.`payload`, '$.tags') WHERE `value` = ?) OR EXISTS (SELECT 1 FROM JSON_EACH(`memo`.`payload`, '$.tags') WHERE INSTR(`value`, ?) = 1))) OR ((EXISTS (SELECT 1 FROM JSON_EACH(`memo`.`payload`, '$.tags') WHERE `value` = ?) OR EXISTS (SELECT 1 FROM JSON_EACH(`memo`.`payload`, '$.tags') WHERE INSTR(`value`, ?) = 1)) OR (EXISTS (SELECT 1 FROM JSON_EACH(`memo`.`payload`, '$.tags') WHERE `value` = ?) OR EXISTS (SELECT 1 FROM JSON_EACH(`memo`.`payload`, '$.tags') WHERE INSTR(`value`, ?) = 1))))",
+			args:   []any{"tag1", "tag1/", "tag2", "tag2/", "tag3", "tag3/", "tag4", "tag4/"},
 		},
 		{
 			filter: `content.contains("memos")`,
@@ -47,8 +47,8 @@ func TestConvertExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `tag in ['tag1'] || content.contains('hello')`,
-			want:   "(JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ? OR `memo`.`content` LIKE ?)",
-			args:   []any{`%"tag1"%`, "%hello%"},
+			want:   "((EXISTS (SELECT 1 FROM JSON_EACH(`memo`.`payload`, '$.tags') WHERE `value` = ?) OR EXISTS (SELECT 1 FROM JSON_EACH(`memo`.`payload`, '$.tags') WHERE INSTR(`value`, ?) = 1)) OR `memo`.`content` LIKE ?)",
+			args:   []any{"tag1", "tag1/", "%hello%"},
 		},
 		{
 			filter: `1`,
@@ -122,14 +122,79 @@ func TestConvertExprToSQL(t *testing.T) {
 		},
 		{
 			filter: `"work" in tags`,
-			want:   "JSON_EXTRACT(`memo`.`payload`, '$.tags') LIKE ?",
-			args:   []any{`%"work"%`},
+			want:   "EXISTS (SELECT 1 FROM JSON_EACH(`memo`.`payload`, '$.tags') WHERE `value` = ?)",
+			args:   []any{"work"},
 		},
 		{
 			filter: `size(tags) == 2`,
 			want:   "JSON_ARRAY_LENGTH(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.tags'), JSON_ARRAY())) = ?",
 			args:   []any{int64(2)},
 		},
+		{
+			filter: `has_tags`,
+			want:   "JSON_ARRAY_LENGTH(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.tags'), JSON_ARRAY())) > 0",
+			args:   []any{},
+		},
+		{
+			filter: `!has_tags`,
+			want:   "NOT (JSON_ARRAY_LENGTH(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.tags'), JSON_ARRAY())) > 0)",
+			args:   []any{},
+		},
+		{
+			filter: `has_tags == false`,
+			want:   "JSON_ARRAY_LENGTH(COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.tags'), JSON_ARRAY())) = 0",
+			args:   []any{},
+		},
+		{
+			filter: `has_incomplete_tasks`,
+			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasIncompleteTasks') IS TRUE",
+			args:   []any{},
+		},
+		{
+			filter: `has_incomplete_tasks == false`,
+			want:   "JSON_EXTRACT(`memo`.`payload`, '$.property.hasIncompleteTasks') IS NOT TRUE",
+			args:   []any{},
+		},
+		{
+			filter: `word_count > 100`,
+			want:   "COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.property.wordCount'), 0) > ?",
+			args:   []any{int64(100)},
+		},
+		{
+			filter: `attachment_count >= 2`,
+			want:   "(SELECT COUNT(*) FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id`) >= ?",
+			args:   []any{int64(2)},
+		},
+		{
+			filter: `attachment_size > 1048576`,
+			want:   "(SELECT COALESCE(SUM(`resource`.`size`), 0) FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id`) > ?",
+			args:   []any{int64(1048576)},
+		},
+		{
+			filter: `has_attachment_class("archive")`,
+			want:   "EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id` AND (`resource`.`type` LIKE ? OR `resource`.`type` LIKE ? OR `resource`.`type` LIKE ? OR `resource`.`type` LIKE ? OR `resource`.`type` LIKE ? OR `resource`.`type` LIKE ? OR `resource`.`type` LIKE ?))",
+			args:   []any{"application/zip", "application/gzip", "application/x-tar", "application/x-bzip2", "application/x-7z-compressed", "application/x-rar-compressed", "application/vnd.rar"},
+		},
+		{
+			filter: `has_attachment_type("image/*")`,
+			want:   "EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id` AND `resource`.`type` LIKE ?)",
+			args:   []any{"image/%"},
+		},
+		{
+			filter: `in_relation_with("abc") && pinned`,
+			want:   "(EXISTS (SELECT 1 FROM `memo_relation` AS `relation` JOIN `memo` AS `related_memo` ON `related_memo`.`uid` = ? WHERE (`relation`.`memo_id` = `memo`.`id` AND `relation`.`related_memo_id` = `related_memo`.`id`) OR (`relation`.`related_memo_id` = `memo`.`id` AND `relation`.`memo_id` = `related_memo`.`id`)) AND `memo`.`pinned` IS TRUE)",
+			args:   []any{"abc"},
+		},
+		{
+			filter: `visibility in ["PUBLIC", "PROTECTED"] || group_visible_to(1)`,
+			want:   "(`memo`.`visibility` IN (?,?) OR (`memo`.`visibility` = 'GROUP' AND EXISTS (SELECT 1 FROM `memo_group` JOIN `user_group_member` ON `user_group_member`.`group_id` = `memo_group`.`group_id` WHERE `memo_group`.`memo_id` = `memo`.`id` AND `user_group_member`.`user_id` = ?)))",
+			args:   []any{"PUBLIC", "PROTECTED", int64(1)},
+		},
+		{
+			filter: `location.within(0, 0, 111.32)`,
+			want:   "(JSON_EXTRACT(`memo`.`payload`, '$.location') IS NOT NULL AND COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.location.latitude'), 0) BETWEEN ? AND ? AND COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.location.longitude'), 0) BETWEEN ? AND ? AND (COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.location.latitude'), 0) - ?) * (COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.location.latitude'), 0) - ?) + (COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.location.longitude'), 0) - ?) * (COALESCE(JSON_EXTRACT(`memo`.`payload`, '$.location.longitude'), 0) - ?) * ? <= ?)",
+			args:   []any{-1.0, 1.0, -1.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 1.0},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,74 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertScheduledTask(ctx context.Context, upsert *store.ScheduledTask) error {
+	stmt := "INSERT INTO `scheduled_task` (`name`, `schedule`, `disabled`, `updated_ts`) VALUES (?, ?, ?, ?) ON CONFLICT(`name`) DO UPDATE SET `schedule` = EXCLUDED.`schedule`, `disabled` = EXCLUDED.`disabled`, `updated_ts` = EXCLUDED.`updated_ts`"
+	_, err := d.db.ExecContext(ctx, stmt, upsert.Name, upsert.Schedule, upsert.Disabled, upsert.UpdatedTs)
+	return err
+}
+
+func (d *DB) ListScheduledTasks(ctx context.Context, find *store.FindScheduledTask) ([]*store.ScheduledTask, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.Name != nil {
+		where, args = append(where, "`name` = ?"), append(args, *find.Name)
+	}
+
+	query := "SELECT `name`, `schedule`, `disabled`, `updated_ts`, `last_run_ts`, `last_status`, `last_error`, `last_finished_ts` FROM `scheduled_task` WHERE " + strings.Join(where, " AND ") + " ORDER BY `name` ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.ScheduledTask{}
+	for rows.Next() {
+		task := &store.ScheduledTask{}
+		if err := rows.Scan(
+			&task.Name,
+			&task.Schedule,
+			&task.Disabled,
+			&task.UpdatedTs,
+			&task.LastRunTs,
+			&task.LastStatus,
+			&task.LastError,
+			&task.LastFinishedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, task)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateScheduledTask(ctx context.Context, update *store.UpdateScheduledTask) error {
+	set, args := []string{}, []any{}
+	if v := update.LastRunTs; v != nil {
+		set, args = append(set, "`last_run_ts` = ?"), append(args, *v)
+	}
+	if v := update.LastStatus; v != nil {
+		set, args = append(set, "`last_status` = ?"), append(args, v.String())
+	}
+	if v := update.LastError; v != nil {
+		set, args = append(set, "`last_error` = ?"), append(args, *v)
+	}
+	if v := update.LastFinishedTs; v != nil {
+		set, args = append(set, "`last_finished_ts` = ?"), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil
+	}
+	args = append(args, update.Name)
+	_, err := d.db.ExecContext(ctx, "UPDATE `scheduled_task` SET "+strings.Join(set, ", ")+" WHERE `name` = ?", args...)
+	return err
+}
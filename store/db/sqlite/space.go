@@ -0,0 +1,179 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateSpace(ctx context.Context, create *store.Space) (*store.Space, error) {
+	fields := []string{"`uid`", "`creator_id`", "`name`"}
+	placeholder := []string{"?", "?", "?"}
+	args := []any{create.UID, create.CreatorID, create.Name}
+	stmt := "INSERT INTO `space` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `created_ts`, `updated_ts`"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+		&create.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListSpaces(ctx context.Context, find *store.FindSpace) ([]*store.Space, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.UID != nil {
+		where, args = append(where, "`uid` = ?"), append(args, *find.UID)
+	}
+	if find.MemberID != nil {
+		where, args = append(where, "`id` IN (SELECT `space_id` FROM `space_member` WHERE `user_id` = ?)"), append(args, *find.MemberID)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			id,
+			uid,
+			creator_id,
+			created_ts,
+			updated_ts,
+			name
+		FROM space
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.Space{}
+	for rows.Next() {
+		space := &store.Space{}
+		if err := rows.Scan(
+			&space.ID,
+			&space.UID,
+			&space.CreatorID,
+			&space.CreatedTs,
+			&space.UpdatedTs,
+			&space.Name,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, space)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateSpace(ctx context.Context, update *store.UpdateSpace) error {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "`updated_ts` = ?"), append(args, *v)
+	}
+	if v := update.Name; v != nil {
+		set, args = append(set, "`name` = ?"), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil
+	}
+	args = append(args, update.ID)
+	_, err := d.db.ExecContext(ctx, "UPDATE `space` SET "+strings.Join(set, ", ")+" WHERE `id` = ?", args...)
+	return err
+}
+
+func (d *DB) DeleteSpace(ctx context.Context, delete *store.DeleteSpace) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM `space_member` WHERE `space_id` = ?", delete.ID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM `space` WHERE `id` = ?", delete.ID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (d *DB) UpsertSpaceMember(ctx context.Context, upsert *store.SpaceMember) (*store.SpaceMember, error) {
+	stmt := `
+		INSERT INTO space_member (
+			space_id, user_id, role
+		)
+		VALUES (?, ?, ?)
+		ON CONFLICT(space_id, user_id) DO UPDATE
+		SET role = EXCLUDED.role
+		RETURNING created_ts
+	`
+	if err := d.db.QueryRowContext(ctx, stmt, upsert.SpaceID, upsert.UserID, upsert.Role).Scan(&upsert.CreatedTs); err != nil {
+		return nil, err
+	}
+	return upsert, nil
+}
+
+func (d *DB) ListSpaceMembers(ctx context.Context, find *store.FindSpaceMember) ([]*store.SpaceMember, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.SpaceID != nil {
+		where, args = append(where, "`space_id` = ?"), append(args, *find.SpaceID)
+	}
+	if find.UserID != nil {
+		where, args = append(where, "`user_id` = ?"), append(args, *find.UserID)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			space_id,
+			user_id,
+			role,
+			created_ts
+		FROM space_member
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY created_ts ASC, user_id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.SpaceMember{}
+	for rows.Next() {
+		spaceMember := &store.SpaceMember{}
+		if err := rows.Scan(
+			&spaceMember.SpaceID,
+			&spaceMember.UserID,
+			&spaceMember.Role,
+			&spaceMember.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, spaceMember)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteSpaceMember(ctx context.Context, delete *store.DeleteSpaceMember) error {
+	where, args := []string{"`space_id` = ?"}, []any{delete.SpaceID}
+	if delete.UserID != nil {
+		where, args = append(where, "`user_id` = ?"), append(args, *delete.UserID)
+	}
+	_, err := d.db.ExecContext(ctx, "DELETE FROM `space_member` WHERE "+strings.Join(where, " AND "), args...)
+	return err
+}
@@ -0,0 +1,83 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateTagShare(ctx context.Context, create *store.TagShare) (*store.TagShare, error) {
+	fields := []string{"`creator_id`", "`tag`", "`grantee_id`", "`token`"}
+	placeholder := []string{"?", "?", "?", "?"}
+	args := []any{create.CreatorID, create.Tag, create.GranteeID, create.Token}
+	stmt := "INSERT INTO `tag_share` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `created_ts`"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListTagShares(ctx context.Context, find *store.FindTagShare) ([]*store.TagShare, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.ID != nil {
+		where, args = append(where, "`id` = ?"), append(args, *find.ID)
+	}
+	if find.CreatorID != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *find.CreatorID)
+	}
+	if find.GranteeID != nil {
+		where, args = append(where, "`grantee_id` = ?"), append(args, *find.GranteeID)
+	}
+	if find.Token != nil {
+		where, args = append(where, "`token` = ?"), append(args, *find.Token)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			id,
+			created_ts,
+			creator_id,
+			tag,
+			grantee_id,
+			token
+		FROM tag_share
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY id ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.TagShare{}
+	for rows.Next() {
+		tagShare := &store.TagShare{}
+		if err := rows.Scan(
+			&tagShare.ID,
+			&tagShare.CreatedTs,
+			&tagShare.CreatorID,
+			&tagShare.Tag,
+			&tagShare.GranteeID,
+			&tagShare.Token,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, tagShare)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteTagShare(ctx context.Context, delete *store.DeleteTagShare) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM `tag_share` WHERE `id` = ?", delete.ID)
+	return err
+}
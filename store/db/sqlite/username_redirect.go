@@ -0,0 +1,65 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertUsernameRedirect(ctx context.Context, upsert *store.UsernameRedirect) (*store.UsernameRedirect, error) {
+	stmt := "INSERT INTO `username_redirect` (`username`, `user_id`) VALUES (?, ?) ON CONFLICT(`username`) DO UPDATE SET `user_id` = EXCLUDED.`user_id` RETURNING `created_ts`"
+	if err := d.db.QueryRowContext(ctx, stmt, upsert.Username, upsert.UserID).Scan(&upsert.CreatedTs); err != nil {
+		return nil, err
+	}
+	return upsert, nil
+}
+
+func (d *DB) ListUsernameRedirects(ctx context.Context, find *store.FindUsernameRedirect) ([]*store.UsernameRedirect, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if find.Username != nil {
+		where, args = append(where, "`username` = ?"), append(args, *find.Username)
+	}
+	if find.UserID != nil {
+		where, args = append(where, "`user_id` = ?"), append(args, *find.UserID)
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			username,
+			user_id,
+			created_ts
+		FROM username_redirect
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY created_ts DESC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.UsernameRedirect{}
+	for rows.Next() {
+		redirect := &store.UsernameRedirect{}
+		if err := rows.Scan(
+			&redirect.Username,
+			&redirect.UserID,
+			&redirect.CreatedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, redirect)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteUsernameRedirect(ctx context.Context, delete *store.DeleteUsernameRedirect) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM `username_redirect` WHERE `username` = ?", delete.Username)
+	return err
+}
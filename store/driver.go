@@ -35,6 +35,7 @@ type Driver interface {
 	CreateMemo(ctx context.Context, create *Memo) (*Memo, error)
 	ListMemos(ctx context.Context, find *FindMemo) ([]*Memo, error)
 	UpdateMemo(ctx context.Context, update *UpdateMemo) error
+	UpdateMemos(ctx context.Context, updates []*UpdateMemo) error
 	DeleteMemo(ctx context.Context, delete *DeleteMemo) error
 
 	// MemoRelation model related methods.
@@ -67,13 +68,100 @@ type Driver interface {
 	CreateInbox(ctx context.Context, create *Inbox) (*Inbox, error)
 	ListInboxes(ctx context.Context, find *FindInbox) ([]*Inbox, error)
 	UpdateInbox(ctx context.Context, update *UpdateInbox) (*Inbox, error)
+	UpdateInboxes(ctx context.Context, update *UpdateInboxes) (int64, error)
 	DeleteInbox(ctx context.Context, delete *DeleteInbox) error
+	DeleteInboxes(ctx context.Context, delete *DeleteInboxes) (int64, error)
 
 	// Reaction model related methods.
 	UpsertReaction(ctx context.Context, create *Reaction) (*Reaction, error)
 	ListReactions(ctx context.Context, find *FindReaction) ([]*Reaction, error)
 	DeleteReaction(ctx context.Context, delete *DeleteReaction) error
 
+	// UsernameRedirect model related methods.
+	UpsertUsernameRedirect(ctx context.Context, upsert *UsernameRedirect) (*UsernameRedirect, error)
+	ListUsernameRedirects(ctx context.Context, find *FindUsernameRedirect) ([]*UsernameRedirect, error)
+	DeleteUsernameRedirect(ctx context.Context, delete *DeleteUsernameRedirect) error
+
+	// TagShare model related methods.
+	CreateTagShare(ctx context.Context, create *TagShare) (*TagShare, error)
+	ListTagShares(ctx context.Context, find *FindTagShare) ([]*TagShare, error)
+	DeleteTagShare(ctx context.Context, delete *DeleteTagShare) error
+
+	// Invitation model related methods.
+	CreateInvitation(ctx context.Context, create *Invitation) (*Invitation, error)
+	ListInvitations(ctx context.Context, find *FindInvitation) ([]*Invitation, error)
+	UseInvitation(ctx context.Context, id int32) (bool, error)
+	DeleteInvitation(ctx context.Context, delete *DeleteInvitation) error
+
+	// MemoShare model related methods.
+	CreateMemoShare(ctx context.Context, create *MemoShare) (*MemoShare, error)
+	ListMemoShares(ctx context.Context, find *FindMemoShare) ([]*MemoShare, error)
+	DeleteMemoShare(ctx context.Context, delete *DeleteMemoShare) error
+
+	// Space model related methods.
+	CreateSpace(ctx context.Context, create *Space) (*Space, error)
+	ListSpaces(ctx context.Context, find *FindSpace) ([]*Space, error)
+	UpdateSpace(ctx context.Context, update *UpdateSpace) error
+	DeleteSpace(ctx context.Context, delete *DeleteSpace) error
+	UpsertSpaceMember(ctx context.Context, upsert *SpaceMember) (*SpaceMember, error)
+	ListSpaceMembers(ctx context.Context, find *FindSpaceMember) ([]*SpaceMember, error)
+	DeleteSpaceMember(ctx context.Context, delete *DeleteSpaceMember) error
+
+	// UserGroup model related methods.
+	CreateUserGroup(ctx context.Context, create *UserGroup) (*UserGroup, error)
+	ListUserGroups(ctx context.Context, find *FindUserGroup) ([]*UserGroup, error)
+	UpdateUserGroup(ctx context.Context, update *UpdateUserGroup) error
+	DeleteUserGroup(ctx context.Context, delete *DeleteUserGroup) error
+	SetUserGroupMembers(ctx context.Context, groupID int32, userIDs []int32) error
+	ListUserGroupMembers(ctx context.Context, find *FindUserGroupMember) ([]*UserGroupMember, error)
+
+	// MemoGroup model related methods.
+	SetMemoGroups(ctx context.Context, memoID int32, groupIDs []int32) error
+	ListMemoGroups(ctx context.Context, find *FindMemoGroup) ([]*MemoGroup, error)
+
+	// ReadGrant model related methods.
+	CreateReadGrant(ctx context.Context, create *ReadGrant) (*ReadGrant, error)
+	ListReadGrants(ctx context.Context, find *FindReadGrant) ([]*ReadGrant, error)
+	DeleteReadGrant(ctx context.Context, delete *DeleteReadGrant) error
+
+	// WebhookDelivery model related methods.
+	CreateWebhookDelivery(ctx context.Context, create *WebhookDelivery) (*WebhookDelivery, error)
+	ListWebhookDeliveries(ctx context.Context, find *FindWebhookDelivery) ([]*WebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, update *UpdateWebhookDelivery) error
+	DeleteWebhookDeliveries(ctx context.Context, delete *DeleteWebhookDelivery) error
+
+	// MemoChange model related methods.
+	CreateMemoChange(ctx context.Context, create *MemoChange) (*MemoChange, error)
+	ListMemoChanges(ctx context.Context, find *FindMemoChange) ([]*MemoChange, error)
+	DeleteMemoChanges(ctx context.Context, delete *DeleteMemoChange) error
+
+	// Job model related methods.
+	CreateJob(ctx context.Context, create *Job) (*Job, error)
+	ListJobs(ctx context.Context, find *FindJob) ([]*Job, error)
+	UpdateJob(ctx context.Context, update *UpdateJob) error
+	ClaimJob(ctx context.Context, claim *ClaimJob) (bool, error)
+	DeleteJobs(ctx context.Context, delete *DeleteJob) error
+
+	// ScheduledTask model related methods.
+	UpsertScheduledTask(ctx context.Context, upsert *ScheduledTask) error
+	ListScheduledTasks(ctx context.Context, find *FindScheduledTask) ([]*ScheduledTask, error)
+	UpdateScheduledTask(ctx context.Context, update *UpdateScheduledTask) error
+
+	// MemoEmbedding model related methods.
+	UpsertMemoEmbedding(ctx context.Context, upsert *MemoEmbedding) (*MemoEmbedding, error)
+	ListMemoEmbeddings(ctx context.Context, find *FindMemoEmbedding) ([]*MemoEmbedding, error)
+	DeleteMemoEmbedding(ctx context.Context, delete *DeleteMemoEmbedding) error
+
+	// AttachmentText model related methods.
+	UpsertAttachmentText(ctx context.Context, upsert *AttachmentText) (*AttachmentText, error)
+	ListAttachmentTexts(ctx context.Context, find *FindAttachmentText) ([]*AttachmentText, error)
+	DeleteAttachmentText(ctx context.Context, delete *DeleteAttachmentText) error
+
+	// AttachmentVersion model related methods.
+	CreateAttachmentVersion(ctx context.Context, create *AttachmentVersion) (*AttachmentVersion, error)
+	ListAttachmentVersions(ctx context.Context, find *FindAttachmentVersion) ([]*AttachmentVersion, error)
+	DeleteAttachmentVersion(ctx context.Context, delete *DeleteAttachmentVersion) error
+
 	// Shortcut related methods.
 	ConvertExprToSQL(ctx *filter.ConvertContext, expr *exprv1.Expr) error
 }
@@ -165,6 +165,12 @@ func convertIdentityProviderConfigFromRaw(identityProviderType storepb.IdentityP
 			return nil, errors.Wrap(err, "Failed to unmarshal OAuth2Config")
 		}
 		config.Config = &storepb.IdentityProviderConfig_Oauth2Config{Oauth2Config: oauth2Config}
+	} else if identityProviderType == storepb.IdentityProvider_LDAP {
+		ldapConfig := &storepb.LDAPConfig{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw), ldapConfig); err != nil {
+			return nil, errors.Wrap(err, "Failed to unmarshal LDAPConfig")
+		}
+		config.Config = &storepb.IdentityProviderConfig_LdapConfig{LdapConfig: ldapConfig}
 	}
 	return config, nil
 }
@@ -177,6 +183,12 @@ func convertIdentityProviderConfigToRaw(identityProviderType storepb.IdentityPro
 			return "", errors.Wrap(err, "Failed to marshal OAuth2Config")
 		}
 		raw = string(bytes)
+	} else if identityProviderType == storepb.IdentityProvider_LDAP {
+		bytes, err := protojson.Marshal(config.GetLdapConfig())
+		if err != nil {
+			return "", errors.Wrap(err, "Failed to marshal LDAPConfig")
+		}
+		raw = string(bytes)
 	}
 	return raw, nil
 }
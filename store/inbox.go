@@ -30,6 +30,11 @@ type Inbox struct {
 type UpdateInbox struct {
 	ID     int32
 	Status InboxStatus
+
+	// The messages aggregating several events are updated in place with their latest event.
+	CreatedTs *int64
+	SenderID  *int32
+	Message   *storepb.InboxMessage
 }
 
 type FindInbox struct {
@@ -43,10 +48,25 @@ type FindInbox struct {
 	Offset *int
 }
 
+// UpdateInboxes updates the status of the inboxes of a receiver having a status.
+type UpdateInboxes struct {
+	ReceiverID int32
+	FromStatus InboxStatus
+	Status     InboxStatus
+}
+
 type DeleteInbox struct {
 	ID int32
 }
 
+// DeleteInboxes deletes the inboxes matching all the set fields.
+type DeleteInboxes struct {
+	IDList          []int32
+	ReceiverID      *int32
+	Status          *InboxStatus
+	CreatedTsBefore *int64
+}
+
 func (s *Store) CreateInbox(ctx context.Context, create *Inbox) (*Inbox, error) {
 	return s.driver.CreateInbox(ctx, create)
 }
@@ -59,6 +79,16 @@ func (s *Store) UpdateInbox(ctx context.Context, update *UpdateInbox) (*Inbox, e
 	return s.driver.UpdateInbox(ctx, update)
 }
 
+// UpdateInboxes updates the inboxes at once, returning the number of inboxes updated.
+func (s *Store) UpdateInboxes(ctx context.Context, update *UpdateInboxes) (int64, error) {
+	return s.driver.UpdateInboxes(ctx, update)
+}
+
 func (s *Store) DeleteInbox(ctx context.Context, delete *DeleteInbox) error {
 	return s.driver.DeleteInbox(ctx, delete)
 }
+
+// DeleteInboxes deletes the inboxes at once, returning the number of inboxes deleted.
+func (s *Store) DeleteInboxes(ctx context.Context, delete *DeleteInboxes) (int64, error) {
+	return s.driver.DeleteInboxes(ctx, delete)
+}
@@ -0,0 +1,58 @@
+package store
+
+import (
+	"context"
+)
+
+// Invitation allows signing up with its token, even when the user registration is disallowed.
+type Invitation struct {
+	ID        int32
+	CreatedTs int64
+	CreatorID int32
+	Token     string
+	// Role is the role of the users signing up with the invitation.
+	Role Role
+	// MaxUses is the number of sign ups allowed, or 0 for no limit.
+	MaxUses  int32
+	UseCount int32
+	// ExpiresTs is the time after which the invitation is no longer valid, or 0 if it never expires.
+	ExpiresTs int64
+}
+
+type FindInvitation struct {
+	ID    *int32
+	Token *string
+}
+
+type DeleteInvitation struct {
+	ID int32
+}
+
+func (s *Store) CreateInvitation(ctx context.Context, create *Invitation) (*Invitation, error) {
+	return s.driver.CreateInvitation(ctx, create)
+}
+
+func (s *Store) ListInvitations(ctx context.Context, find *FindInvitation) ([]*Invitation, error) {
+	return s.driver.ListInvitations(ctx, find)
+}
+
+func (s *Store) GetInvitation(ctx context.Context, find *FindInvitation) (*Invitation, error) {
+	list, err := s.ListInvitations(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// UseInvitation counts a sign up with the invitation, returning false if it has no use left.
+// The count is checked and incremented at once, so that concurrent sign ups cannot exceed the limit.
+func (s *Store) UseInvitation(ctx context.Context, id int32) (bool, error) {
+	return s.driver.UseInvitation(ctx, id)
+}
+
+func (s *Store) DeleteInvitation(ctx context.Context, delete *DeleteInvitation) error {
+	return s.driver.DeleteInvitation(ctx, delete)
+}
@@ -0,0 +1,110 @@
+package store
+
+import (
+	"context"
+)
+
+// JobStatus is the status of a background job.
+type JobStatus string
+
+const (
+	// JobPending is the status of the jobs to be run at their next attempt time.
+	JobPending JobStatus = "PENDING"
+	// JobRunning is the status of the jobs claimed by a worker, until their next attempt time,
+	// after which they are claimed again as their worker is assumed to have died, e.g. on a restart.
+	JobRunning   JobStatus = "RUNNING"
+	JobSucceeded JobStatus = "SUCCEEDED"
+	// JobFailed is the status of the jobs failed at each of their attempts, which are no longer run.
+	JobFailed JobStatus = "FAILED"
+)
+
+func (s JobStatus) String() string {
+	return string(s)
+}
+
+// Job is a background job run by the workers of the job queue, kept until it succeeds or fails at each of its attempts,
+// so that it survives the restarts of the server.
+type Job struct {
+	ID        int32
+	CreatedTs int64
+	// Kind selects the handler of the job, e.g. "attachment_thumbnails".
+	Kind string
+	// Payload is the JSON argument of the handler.
+	Payload       string
+	Status        JobStatus
+	AttemptCount  int32
+	MaxAttempts   int32
+	NextAttemptTs int64
+	LastError     string
+	// FinishedTs is the time the job succeeded or failed, zero until then.
+	FinishedTs int64
+}
+
+type FindJob struct {
+	ID     *int32
+	Kind   *string
+	Status *JobStatus
+	// DueBefore finds the pending jobs whose next attempt is due by the time,
+	// and the running jobs whose worker is assumed to have died by then.
+	DueBefore *int64
+
+	// Pagination
+	Limit  *int
+	Offset *int
+}
+
+type UpdateJob struct {
+	ID            int32
+	Status        *JobStatus
+	AttemptCount  *int32
+	NextAttemptTs *int64
+	LastError     *string
+	FinishedTs    *int64
+}
+
+// ClaimJob claims a due job for a worker, as long as no other worker claimed it since it was found.
+type ClaimJob struct {
+	ID int32
+	// AttemptCount is the number of attempts of the job when it was found, incremented by the claim.
+	AttemptCount int32
+	// LeaseEndTs is the time after which the job is claimed again if it is still running.
+	LeaseEndTs int64
+}
+
+type DeleteJob struct {
+	Status           *JobStatus
+	FinishedTsBefore *int64
+}
+
+func (s *Store) CreateJob(ctx context.Context, create *Job) (*Job, error) {
+	return s.driver.CreateJob(ctx, create)
+}
+
+// ListJobs returns the jobs, the latest first.
+func (s *Store) ListJobs(ctx context.Context, find *FindJob) ([]*Job, error) {
+	return s.driver.ListJobs(ctx, find)
+}
+
+func (s *Store) GetJob(ctx context.Context, find *FindJob) (*Job, error) {
+	list, err := s.ListJobs(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateJob(ctx context.Context, update *UpdateJob) error {
+	return s.driver.UpdateJob(ctx, update)
+}
+
+// ClaimJob marks the job as running until the end of the lease, returning false if another worker claimed it first.
+func (s *Store) ClaimJob(ctx context.Context, claim *ClaimJob) (bool, error) {
+	return s.driver.ClaimJob(ctx, claim)
+}
+
+func (s *Store) DeleteJobs(ctx context.Context, delete *DeleteJob) error {
+	return s.driver.DeleteJobs(ctx, delete)
+}
@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 	"errors"
+	"log/slog"
 
 	"github.com/usememos/memos/internal/base"
 
@@ -19,6 +20,8 @@ const (
 	Protected Visibility = "PROTECTED"
 	// Private is the PRIVATE visibility.
 	Private Visibility = "PRIVATE"
+	// Group is the GROUP visibility, the memo being visible to the members of its selected groups.
+	Group Visibility = "GROUP"
 )
 
 func (v Visibility) String() string {
@@ -29,6 +32,8 @@ func (v Visibility) String() string {
 		return "PROTECTED"
 	case Private:
 		return "PRIVATE"
+	case Group:
+		return "GROUP"
 	}
 	return "PRIVATE"
 }
@@ -50,9 +55,20 @@ type Memo struct {
 	Visibility Visibility
 	Pinned     bool
 	Payload    *storepb.MemoPayload
+	// SpaceID is the shared space of the memo, or PersonalSpaceID if it belongs to the personal space of its creator.
+	SpaceID int32
 
 	// Composed fields
 	ParentID *int32
+	// Score is the relevance to FindMemo.FullTextSearch, higher is more relevant.
+	// It is only set when ordering by relevance.
+	Score float64
+}
+
+// RelevanceCursor is the position of a memo in a list ordered by relevance.
+type RelevanceCursor struct {
+	Score float64
+	ID    int32
 }
 
 type FindMemo struct {
@@ -62,13 +78,18 @@ type FindMemo struct {
 	// Standard fields
 	RowStatus       *RowStatus
 	CreatorID       *int32
+	SpaceID         *int32
 	CreatedTsAfter  *int64
 	CreatedTsBefore *int64
 	UpdatedTsAfter  *int64
 	UpdatedTsBefore *int64
 
 	// Domain specific fields
-	ContentSearch   []string
+	ContentSearch []string
+	// ContentFragments matches the content containing one of the fragments of each group.
+	ContentFragments [][]string
+	// FullTextSearch matches the content, or the text of an attachment, against the full-text index.
+	FullTextSearch  *string
 	VisibilityList  []Visibility
 	Pinned          *bool
 	PayloadFind     *FindMemoPayload
@@ -79,11 +100,18 @@ type FindMemo struct {
 	// Pagination
 	Limit  *int
 	Offset *int
+	// RelevanceCursor restricts the memos to the ones ranked after the cursor when ordering by relevance.
+	RelevanceCursor *RelevanceCursor
 
 	// Ordering
-	OrderByUpdatedTs bool
-	OrderByPinned    bool
-	OrderByTimeAsc   bool
+	// OrderByRelevance orders by the relevance to FullTextSearch, then by descending id, ignoring the other orderings.
+	OrderByRelevance bool
+	// OrderByRandomSeed orders pseudo-randomly, the same seed giving the same order, ignoring the other orderings.
+	// The seed must be positive and below 2^31, keeping the hash of the ids within 64 bits.
+	OrderByRandomSeed *int64
+	OrderByUpdatedTs  bool
+	OrderByPinned     bool
+	OrderByTimeAsc    bool
 }
 
 type FindMemoPayload struct {
@@ -115,11 +143,35 @@ func (s *Store) CreateMemo(ctx context.Context, create *Memo) (*Memo, error) {
 	if !base.UIDMatcher.MatchString(create.UID) {
 		return nil, errors.New("invalid uid")
 	}
-	return s.driver.CreateMemo(ctx, create)
+	memo, err := s.driver.CreateMemo(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	s.recordMemoChange(ctx, memo, MemoChangeCreated)
+	s.invalidatePublicContent(ctx)
+	s.invalidateMemoListCache(ctx)
+	return memo, nil
 }
 
 func (s *Store) ListMemos(ctx context.Context, find *FindMemo) ([]*Memo, error) {
-	return s.driver.ListMemos(ctx, find)
+	if s.memoListCache == nil {
+		return s.driver.ListMemos(ctx, find)
+	}
+	cacheKey, ok := s.getMemoListCacheKey(find)
+	if !ok {
+		return s.driver.ListMemos(ctx, find)
+	}
+	if cached, ok := s.memoListCache.Get(ctx, cacheKey); ok {
+		if memos, ok := cached.([]*Memo); ok {
+			return cloneMemos(memos), nil
+		}
+	}
+	memos, err := s.driver.ListMemos(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	s.memoListCache.Set(ctx, cacheKey, cloneMemos(memos))
+	return memos, nil
 }
 
 func (s *Store) GetMemo(ctx context.Context, find *FindMemo) (*Memo, error) {
@@ -139,9 +191,57 @@ func (s *Store) UpdateMemo(ctx context.Context, update *UpdateMemo) error {
 	if update.UID != nil && !base.UIDMatcher.MatchString(*update.UID) {
 		return errors.New("invalid uid")
 	}
-	return s.driver.UpdateMemo(ctx, update)
+	if err := s.driver.UpdateMemo(ctx, update); err != nil {
+		return err
+	}
+	s.recordMemoUpdates(ctx, []*UpdateMemo{update})
+	s.invalidatePublicContent(ctx)
+	s.invalidateMemoListCache(ctx)
+	return nil
+}
+
+// UpdateMemos updates the given memos in a single transaction.
+func (s *Store) UpdateMemos(ctx context.Context, updates []*UpdateMemo) error {
+	for _, update := range updates {
+		if update.UID != nil && !base.UIDMatcher.MatchString(*update.UID) {
+			return errors.New("invalid uid")
+		}
+	}
+	if err := s.driver.UpdateMemos(ctx, updates); err != nil {
+		return err
+	}
+	s.recordMemoUpdates(ctx, updates)
+	s.invalidatePublicContent(ctx)
+	s.invalidateMemoListCache(ctx)
+	return nil
 }
 
 func (s *Store) DeleteMemo(ctx context.Context, delete *DeleteMemo) error {
-	return s.driver.DeleteMemo(ctx, delete)
+	memo, err := s.GetMemo(ctx, &FindMemo{ID: &delete.ID, ExcludeContent: true})
+	if err != nil {
+		return err
+	}
+	if err := s.driver.DeleteMemo(ctx, delete); err != nil {
+		return err
+	}
+	if memo != nil {
+		s.recordMemoChange(ctx, memo, MemoChangeDeleted)
+	}
+	s.invalidatePublicContent(ctx)
+	s.invalidateMemoListCache(ctx)
+	return nil
+}
+
+// recordMemoUpdates records the changes of the updated memos, with their UID after the update.
+func (s *Store) recordMemoUpdates(ctx context.Context, updates []*UpdateMemo) {
+	for _, update := range updates {
+		memo, err := s.GetMemo(ctx, &FindMemo{ID: &update.ID, ExcludeContent: true})
+		if err != nil {
+			slog.Warn("Failed to get updated memo", slog.Int("memo", int(update.ID)), slog.Any("err", err))
+			continue
+		}
+		if memo != nil {
+			s.recordMemoChange(ctx, memo, MemoChangeUpdated)
+		}
+	}
 }
@@ -0,0 +1,82 @@
+package store
+
+import (
+	"context"
+	"log/slog"
+)
+
+// MemoChangeType is the type of a change of a memo.
+type MemoChangeType string
+
+const (
+	MemoChangeCreated MemoChangeType = "CREATED"
+	MemoChangeUpdated MemoChangeType = "UPDATED"
+	MemoChangeDeleted MemoChangeType = "DELETED"
+)
+
+func (t MemoChangeType) String() string {
+	return string(t)
+}
+
+// MemoChange is a change of a memo, recorded by the store as the memos are created, updated and deleted,
+// so that the clients polling the changes since their last one also learn about the deleted memos.
+type MemoChange struct {
+	// ID increases with the changes, it is the cursor of the changes.
+	ID        int32
+	CreatedTs int64
+	CreatorID int32
+	MemoID    int32
+	// MemoUID is the UID of the memo at the time of the change.
+	MemoUID string
+	Type    MemoChangeType
+}
+
+type FindMemoChange struct {
+	ID        *int32
+	CreatorID *int32
+	// IDAfter finds the changes after the change of the ID.
+	IDAfter *int32
+
+	// Pagination
+	Limit *int
+}
+
+type DeleteMemoChange struct {
+	CreatedTsBefore *int64
+}
+
+func (s *Store) CreateMemoChange(ctx context.Context, create *MemoChange) (*MemoChange, error) {
+	return s.driver.CreateMemoChange(ctx, create)
+}
+
+// ListMemoChanges returns the changes in the order they were made.
+func (s *Store) ListMemoChanges(ctx context.Context, find *FindMemoChange) ([]*MemoChange, error) {
+	return s.driver.ListMemoChanges(ctx, find)
+}
+
+func (s *Store) GetMemoChange(ctx context.Context, find *FindMemoChange) (*MemoChange, error) {
+	list, err := s.ListMemoChanges(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteMemoChanges(ctx context.Context, delete *DeleteMemoChange) error {
+	return s.driver.DeleteMemoChanges(ctx, delete)
+}
+
+// recordMemoChange records the change of the memo. The change is already made, so a failure is only logged.
+func (s *Store) recordMemoChange(ctx context.Context, memo *Memo, changeType MemoChangeType) {
+	if _, err := s.driver.CreateMemoChange(ctx, &MemoChange{
+		CreatorID: memo.CreatorID,
+		MemoID:    memo.ID,
+		MemoUID:   memo.UID,
+		Type:      changeType,
+	}); err != nil {
+		slog.Warn("Failed to record memo change", slog.Int("memo", int(memo.ID)), slog.Any("err", err))
+	}
+}
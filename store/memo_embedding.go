@@ -0,0 +1,36 @@
+package store
+
+import (
+	"context"
+)
+
+// MemoEmbedding is the vector of a memo content computed by an embedding model.
+type MemoEmbedding struct {
+	MemoID int32
+	// Model identifies the embedding model, vectors of different models are not comparable.
+	Model string
+	// ContentHash is the hash of the embedded content, used to detect outdated vectors.
+	ContentHash string
+	Embedding   []float32
+}
+
+type FindMemoEmbedding struct {
+	MemoID *int32
+	Model  *string
+}
+
+type DeleteMemoEmbedding struct {
+	MemoID int32
+}
+
+func (s *Store) UpsertMemoEmbedding(ctx context.Context, upsert *MemoEmbedding) (*MemoEmbedding, error) {
+	return s.driver.UpsertMemoEmbedding(ctx, upsert)
+}
+
+func (s *Store) ListMemoEmbeddings(ctx context.Context, find *FindMemoEmbedding) ([]*MemoEmbedding, error) {
+	return s.driver.ListMemoEmbeddings(ctx, find)
+}
+
+func (s *Store) DeleteMemoEmbedding(ctx context.Context, delete *DeleteMemoEmbedding) error {
+	return s.driver.DeleteMemoEmbedding(ctx, delete)
+}
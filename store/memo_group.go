@@ -0,0 +1,29 @@
+package store
+
+import (
+	"context"
+)
+
+// MemoGroup selects a user group whose members can read the memo with the GROUP visibility.
+type MemoGroup struct {
+	MemoID  int32
+	GroupID int32
+}
+
+type FindMemoGroup struct {
+	MemoID  *int32
+	GroupID *int32
+}
+
+// SetMemoGroups replaces the selected groups of the memo with the given groups.
+func (s *Store) SetMemoGroups(ctx context.Context, memoID int32, groupIDs []int32) error {
+	if err := s.driver.SetMemoGroups(ctx, memoID, groupIDs); err != nil {
+		return err
+	}
+	s.invalidateMemoListCache(ctx)
+	return nil
+}
+
+func (s *Store) ListMemoGroups(ctx context.Context, find *FindMemoGroup) ([]*MemoGroup, error) {
+	return s.driver.ListMemoGroups(ctx, find)
+}
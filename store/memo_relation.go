@@ -33,7 +33,12 @@ type DeleteMemoRelation struct {
 }
 
 func (s *Store) UpsertMemoRelation(ctx context.Context, create *MemoRelation) (*MemoRelation, error) {
-	return s.driver.UpsertMemoRelation(ctx, create)
+	memoRelation, err := s.driver.UpsertMemoRelation(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidateMemoListCache(ctx)
+	return memoRelation, nil
 }
 
 func (s *Store) ListMemoRelations(ctx context.Context, find *FindMemoRelation) ([]*MemoRelation, error) {
@@ -41,5 +46,9 @@ func (s *Store) ListMemoRelations(ctx context.Context, find *FindMemoRelation) (
 }
 
 func (s *Store) DeleteMemoRelation(ctx context.Context, delete *DeleteMemoRelation) error {
-	return s.driver.DeleteMemoRelation(ctx, delete)
+	if err := s.driver.DeleteMemoRelation(ctx, delete); err != nil {
+		return err
+	}
+	s.invalidateMemoListCache(ctx)
+	return nil
 }
@@ -0,0 +1,52 @@
+package store
+
+import (
+	"context"
+)
+
+// MemoShare grants read-only access to a single memo to anyone with its secret link, without changing the memo visibility.
+type MemoShare struct {
+	ID        int32
+	CreatedTs int64
+	MemoID    int32
+	CreatorID int32
+	Token     string
+	// PasswordHash is the bcrypt hash of the password required to open the link, empty if none.
+	PasswordHash string
+	// ExpiresTs is the time after which the link is no longer valid, or 0 if it never expires.
+	ExpiresTs int64
+}
+
+type FindMemoShare struct {
+	ID     *int32
+	MemoID *int32
+	Token  *string
+}
+
+type DeleteMemoShare struct {
+	ID     *int32
+	MemoID *int32
+}
+
+func (s *Store) CreateMemoShare(ctx context.Context, create *MemoShare) (*MemoShare, error) {
+	return s.driver.CreateMemoShare(ctx, create)
+}
+
+func (s *Store) ListMemoShares(ctx context.Context, find *FindMemoShare) ([]*MemoShare, error) {
+	return s.driver.ListMemoShares(ctx, find)
+}
+
+func (s *Store) GetMemoShare(ctx context.Context, find *FindMemoShare) (*MemoShare, error) {
+	list, err := s.ListMemoShares(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteMemoShare(ctx context.Context, delete *DeleteMemoShare) error {
+	return s.driver.DeleteMemoShare(ctx, delete)
+}
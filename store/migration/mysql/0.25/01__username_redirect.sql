@@ -0,0 +1,7 @@
+CREATE TABLE `username_redirect` (
+  `username` VARCHAR(256) NOT NULL PRIMARY KEY,
+  `user_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX `idx_username_redirect_user_id` ON `username_redirect` (`user_id`);
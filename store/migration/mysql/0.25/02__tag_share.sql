@@ -0,0 +1,14 @@
+CREATE TABLE `tag_share` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `creator_id` INT NOT NULL,
+  `tag` VARCHAR(256) NOT NULL,
+  `grantee_id` INT NOT NULL DEFAULT 0,
+  `token` VARCHAR(256) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX `idx_tag_share_creator_id` ON `tag_share` (`creator_id`);
+
+CREATE INDEX `idx_tag_share_grantee_id` ON `tag_share` (`grantee_id`);
+
+CREATE INDEX `idx_tag_share_token` ON `tag_share` (`token`);
@@ -0,0 +1,6 @@
+CREATE TABLE `memo_embedding` (
+  `memo_id` INT NOT NULL PRIMARY KEY,
+  `model` VARCHAR(256) NOT NULL,
+  `content_hash` VARCHAR(256) NOT NULL,
+  `embedding` LONGTEXT NOT NULL
+);
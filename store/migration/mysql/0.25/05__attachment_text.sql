@@ -0,0 +1,5 @@
+CREATE TABLE `attachment_text` (
+  `attachment_id` INT NOT NULL PRIMARY KEY,
+  `content` LONGTEXT NOT NULL,
+  FULLTEXT INDEX `idx_attachment_text_content_fulltext` (`content`)
+);
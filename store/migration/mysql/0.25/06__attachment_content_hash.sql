@@ -0,0 +1,3 @@
+ALTER TABLE `resource` ADD COLUMN `content_hash` VARCHAR(64) NOT NULL DEFAULT '';
+
+CREATE INDEX `idx_resource_content_hash` ON `resource` (`content_hash`);
@@ -0,0 +1,17 @@
+CREATE TABLE `attachment_version` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `attachment_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `filename` TEXT NOT NULL,
+  `blob` MEDIUMBLOB,
+  `type` VARCHAR(256) NOT NULL DEFAULT '',
+  `size` INT NOT NULL DEFAULT '0',
+  `storage_type` VARCHAR(256) NOT NULL DEFAULT '',
+  `reference` TEXT NOT NULL DEFAULT (''),
+  `payload` TEXT NOT NULL,
+  `content_hash` VARCHAR(64) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX `idx_attachment_version_attachment_id` ON `attachment_version` (`attachment_id`);
+
+CREATE INDEX `idx_attachment_version_content_hash` ON `attachment_version` (`content_hash`);
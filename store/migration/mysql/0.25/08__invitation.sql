@@ -0,0 +1,10 @@
+CREATE TABLE `invitation` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `creator_id` INT NOT NULL,
+  `token` VARCHAR(256) NOT NULL UNIQUE,
+  `role` VARCHAR(256) NOT NULL DEFAULT 'USER',
+  `max_uses` INT NOT NULL DEFAULT 0,
+  `use_count` INT NOT NULL DEFAULT 0,
+  `expires_ts` BIGINT NOT NULL DEFAULT 0
+);
@@ -0,0 +1,11 @@
+CREATE TABLE `memo_share` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `token` VARCHAR(256) NOT NULL UNIQUE,
+  `password_hash` VARCHAR(256) NOT NULL DEFAULT '',
+  `expires_ts` BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX `idx_memo_share_memo_id` ON `memo_share` (`memo_id`);
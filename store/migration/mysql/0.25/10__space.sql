@@ -0,0 +1,22 @@
+ALTER TABLE `memo` ADD COLUMN `space_id` INT NOT NULL DEFAULT 0;
+
+CREATE INDEX `idx_memo_space_id` ON `memo` (`space_id`);
+
+CREATE TABLE `space` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `uid` VARCHAR(256) NOT NULL UNIQUE,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `name` VARCHAR(256) NOT NULL
+);
+
+CREATE TABLE `space_member` (
+  `space_id` INT NOT NULL,
+  `user_id` INT NOT NULL,
+  `role` VARCHAR(256) NOT NULL DEFAULT 'VIEWER',
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE(`space_id`,`user_id`)
+);
+
+CREATE INDEX `idx_space_member_user_id` ON `space_member` (`user_id`);
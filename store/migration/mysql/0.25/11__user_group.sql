@@ -0,0 +1,23 @@
+CREATE TABLE `user_group` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `name` VARCHAR(256) NOT NULL UNIQUE,
+  `description` TEXT NOT NULL
+);
+
+CREATE TABLE `user_group_member` (
+  `group_id` INT NOT NULL,
+  `user_id` INT NOT NULL,
+  UNIQUE(`group_id`,`user_id`)
+);
+
+CREATE INDEX `idx_user_group_member_user_id` ON `user_group_member` (`user_id`);
+
+CREATE TABLE `memo_group` (
+  `memo_id` INT NOT NULL,
+  `group_id` INT NOT NULL,
+  UNIQUE(`memo_id`,`group_id`)
+);
+
+CREATE INDEX `idx_memo_group_group_id` ON `memo_group` (`group_id`);
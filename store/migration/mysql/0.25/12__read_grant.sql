@@ -0,0 +1,10 @@
+CREATE TABLE `read_grant` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `creator_id` INT NOT NULL,
+  `tag` VARCHAR(256) NOT NULL DEFAULT '',
+  `grantee_id` INT NOT NULL,
+  UNIQUE(`creator_id`,`grantee_id`,`tag`)
+);
+
+CREATE INDEX `idx_read_grant_grantee_id` ON `read_grant` (`grantee_id`);
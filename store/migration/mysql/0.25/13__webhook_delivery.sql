@@ -0,0 +1,16 @@
+CREATE TABLE `webhook_delivery` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `creator_id` INT NOT NULL,
+  `webhook_id` VARCHAR(256) NOT NULL,
+  `activity_type` VARCHAR(256) NOT NULL,
+  `payload` LONGTEXT NOT NULL,
+  `status` VARCHAR(256) NOT NULL DEFAULT 'PENDING',
+  `attempt_count` INT NOT NULL DEFAULT 0,
+  `next_attempt_ts` BIGINT NOT NULL DEFAULT 0,
+  `last_error` TEXT NOT NULL
+);
+
+CREATE INDEX `idx_webhook_delivery_creator_id_webhook_id` ON `webhook_delivery` (`creator_id`, `webhook_id`);
+
+CREATE INDEX `idx_webhook_delivery_status_next_attempt_ts` ON `webhook_delivery` (`status`, `next_attempt_ts`);
@@ -0,0 +1,10 @@
+CREATE TABLE `memo_change` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `creator_id` INT NOT NULL,
+  `memo_id` INT NOT NULL,
+  `memo_uid` VARCHAR(256) NOT NULL,
+  `type` VARCHAR(256) NOT NULL
+);
+
+CREATE INDEX `idx_memo_change_creator_id_id` ON `memo_change` (`creator_id`, `id`);
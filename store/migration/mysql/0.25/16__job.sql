@@ -0,0 +1,14 @@
+CREATE TABLE `job` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `kind` VARCHAR(256) NOT NULL,
+  `payload` LONGTEXT NOT NULL,
+  `status` VARCHAR(256) NOT NULL DEFAULT 'PENDING',
+  `attempt_count` INT NOT NULL DEFAULT 0,
+  `max_attempts` INT NOT NULL DEFAULT 1,
+  `next_attempt_ts` BIGINT NOT NULL DEFAULT 0,
+  `last_error` TEXT NOT NULL,
+  `finished_ts` BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX `idx_job_status_next_attempt_ts` ON `job` (`status`, `next_attempt_ts`);
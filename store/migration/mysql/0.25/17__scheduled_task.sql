@@ -0,0 +1,10 @@
+CREATE TABLE `scheduled_task` (
+  `name` VARCHAR(256) NOT NULL PRIMARY KEY,
+  `schedule` VARCHAR(256) NOT NULL DEFAULT '',
+  `disabled` BOOLEAN NOT NULL DEFAULT FALSE,
+  `updated_ts` BIGINT NOT NULL DEFAULT 0,
+  `last_run_ts` BIGINT NOT NULL DEFAULT 0,
+  `last_status` VARCHAR(256) NOT NULL DEFAULT '',
+  `last_error` TEXT NOT NULL,
+  `last_finished_ts` BIGINT NOT NULL DEFAULT 0
+);
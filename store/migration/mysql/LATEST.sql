@@ -45,9 +45,13 @@ CREATE TABLE `memo` (
   `content` TEXT NOT NULL,
   `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE',
   `pinned` BOOLEAN NOT NULL DEFAULT FALSE,
-  `payload` JSON NOT NULL
+  `payload` JSON NOT NULL,
+  `space_id` INT NOT NULL DEFAULT 0,
+  FULLTEXT INDEX `idx_memo_content_fulltext` (`content`)
 );
 
+CREATE INDEX `idx_memo_space_id` ON `memo` (`space_id`);
+
 -- memo_organizer
 CREATE TABLE `memo_organizer` (
   `memo_id` INT NOT NULL,
@@ -78,9 +82,12 @@ CREATE TABLE `resource` (
   `memo_id` INT DEFAULT NULL,
   `storage_type` VARCHAR(256) NOT NULL DEFAULT '',
   `reference` TEXT NOT NULL DEFAULT (''),
-  `payload` TEXT NOT NULL
+  `payload` TEXT NOT NULL,
+  `content_hash` VARCHAR(64) NOT NULL DEFAULT ''
 );
 
+CREATE INDEX `idx_resource_content_hash` ON `resource` (`content_hash`);
+
 -- activity
 CREATE TABLE `activity` (
   `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
@@ -119,3 +126,209 @@ CREATE TABLE `reaction` (
   `reaction_type` VARCHAR(256) NOT NULL,
   UNIQUE(`creator_id`,`content_id`,`reaction_type`)  
 );
+
+-- username_redirect
+CREATE TABLE `username_redirect` (
+  `username` VARCHAR(256) NOT NULL PRIMARY KEY,
+  `user_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX `idx_username_redirect_user_id` ON `username_redirect` (`user_id`);
+
+-- tag_share
+CREATE TABLE `tag_share` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `creator_id` INT NOT NULL,
+  `tag` VARCHAR(256) NOT NULL,
+  `grantee_id` INT NOT NULL DEFAULT 0,
+  `token` VARCHAR(256) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX `idx_tag_share_creator_id` ON `tag_share` (`creator_id`);
+
+CREATE INDEX `idx_tag_share_grantee_id` ON `tag_share` (`grantee_id`);
+
+CREATE INDEX `idx_tag_share_token` ON `tag_share` (`token`);
+
+-- memo_embedding
+CREATE TABLE `memo_embedding` (
+  `memo_id` INT NOT NULL PRIMARY KEY,
+  `model` VARCHAR(256) NOT NULL,
+  `content_hash` VARCHAR(256) NOT NULL,
+  `embedding` LONGTEXT NOT NULL
+);
+
+-- attachment_text
+CREATE TABLE `attachment_text` (
+  `attachment_id` INT NOT NULL PRIMARY KEY,
+  `content` LONGTEXT NOT NULL,
+  FULLTEXT INDEX `idx_attachment_text_content_fulltext` (`content`)
+);
+
+-- attachment_version
+CREATE TABLE `attachment_version` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `attachment_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `filename` TEXT NOT NULL,
+  `blob` MEDIUMBLOB,
+  `type` VARCHAR(256) NOT NULL DEFAULT '',
+  `size` INT NOT NULL DEFAULT '0',
+  `storage_type` VARCHAR(256) NOT NULL DEFAULT '',
+  `reference` TEXT NOT NULL DEFAULT (''),
+  `payload` TEXT NOT NULL,
+  `content_hash` VARCHAR(64) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX `idx_attachment_version_attachment_id` ON `attachment_version` (`attachment_id`);
+
+CREATE INDEX `idx_attachment_version_content_hash` ON `attachment_version` (`content_hash`);
+
+-- invitation
+CREATE TABLE `invitation` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `creator_id` INT NOT NULL,
+  `token` VARCHAR(256) NOT NULL UNIQUE,
+  `role` VARCHAR(256) NOT NULL DEFAULT 'USER',
+  `max_uses` INT NOT NULL DEFAULT 0,
+  `use_count` INT NOT NULL DEFAULT 0,
+  `expires_ts` BIGINT NOT NULL DEFAULT 0
+);
+
+-- memo_share
+CREATE TABLE `memo_share` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `token` VARCHAR(256) NOT NULL UNIQUE,
+  `password_hash` VARCHAR(256) NOT NULL DEFAULT '',
+  `expires_ts` BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX `idx_memo_share_memo_id` ON `memo_share` (`memo_id`);
+
+-- space
+CREATE TABLE `space` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `uid` VARCHAR(256) NOT NULL UNIQUE,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `name` VARCHAR(256) NOT NULL
+);
+
+-- space_member
+CREATE TABLE `space_member` (
+  `space_id` INT NOT NULL,
+  `user_id` INT NOT NULL,
+  `role` VARCHAR(256) NOT NULL DEFAULT 'VIEWER',
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE(`space_id`,`user_id`)
+);
+
+CREATE INDEX `idx_space_member_user_id` ON `space_member` (`user_id`);
+
+-- user_group
+CREATE TABLE `user_group` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `name` VARCHAR(256) NOT NULL UNIQUE,
+  `description` TEXT NOT NULL
+);
+
+-- user_group_member
+CREATE TABLE `user_group_member` (
+  `group_id` INT NOT NULL,
+  `user_id` INT NOT NULL,
+  UNIQUE(`group_id`,`user_id`)
+);
+
+CREATE INDEX `idx_user_group_member_user_id` ON `user_group_member` (`user_id`);
+
+-- memo_group
+CREATE TABLE `memo_group` (
+  `memo_id` INT NOT NULL,
+  `group_id` INT NOT NULL,
+  UNIQUE(`memo_id`,`group_id`)
+);
+
+CREATE INDEX `idx_memo_group_group_id` ON `memo_group` (`group_id`);
+
+-- read_grant
+CREATE TABLE `read_grant` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `creator_id` INT NOT NULL,
+  `tag` VARCHAR(256) NOT NULL DEFAULT '',
+  `grantee_id` INT NOT NULL,
+  UNIQUE(`creator_id`,`grantee_id`,`tag`)
+);
+
+CREATE INDEX `idx_read_grant_grantee_id` ON `read_grant` (`grantee_id`);
+
+-- webhook_delivery
+CREATE TABLE `webhook_delivery` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `creator_id` INT NOT NULL,
+  `webhook_id` VARCHAR(256) NOT NULL,
+  `activity_type` VARCHAR(256) NOT NULL,
+  `payload` LONGTEXT NOT NULL,
+  `status` VARCHAR(256) NOT NULL DEFAULT 'PENDING',
+  `attempt_count` INT NOT NULL DEFAULT 0,
+  `next_attempt_ts` BIGINT NOT NULL DEFAULT 0,
+  `last_error` TEXT NOT NULL,
+  `last_attempt_ts` BIGINT NOT NULL DEFAULT 0,
+  `response_status_code` INT NOT NULL DEFAULT 0,
+  `response_body` TEXT NOT NULL DEFAULT (''),
+  `latency_ms` INT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX `idx_webhook_delivery_creator_id_webhook_id` ON `webhook_delivery` (`creator_id`, `webhook_id`);
+
+CREATE INDEX `idx_webhook_delivery_status_next_attempt_ts` ON `webhook_delivery` (`status`, `next_attempt_ts`);
+
+-- memo_change
+CREATE TABLE `memo_change` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `creator_id` INT NOT NULL,
+  `memo_id` INT NOT NULL,
+  `memo_uid` VARCHAR(256) NOT NULL,
+  `type` VARCHAR(256) NOT NULL
+);
+
+CREATE INDEX `idx_memo_change_creator_id_id` ON `memo_change` (`creator_id`, `id`);
+
+-- job
+CREATE TABLE `job` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `kind` VARCHAR(256) NOT NULL,
+  `payload` LONGTEXT NOT NULL,
+  `status` VARCHAR(256) NOT NULL DEFAULT 'PENDING',
+  `attempt_count` INT NOT NULL DEFAULT 0,
+  `max_attempts` INT NOT NULL DEFAULT 1,
+  `next_attempt_ts` BIGINT NOT NULL DEFAULT 0,
+  `last_error` TEXT NOT NULL,
+  `finished_ts` BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX `idx_job_status_next_attempt_ts` ON `job` (`status`, `next_attempt_ts`);
+
+-- scheduled_task
+CREATE TABLE `scheduled_task` (
+  `name` VARCHAR(256) NOT NULL PRIMARY KEY,
+  `schedule` VARCHAR(256) NOT NULL DEFAULT '',
+  `disabled` BOOLEAN NOT NULL DEFAULT FALSE,
+  `updated_ts` BIGINT NOT NULL DEFAULT 0,
+  `last_run_ts` BIGINT NOT NULL DEFAULT 0,
+  `last_status` VARCHAR(256) NOT NULL DEFAULT '',
+  `last_error` TEXT NOT NULL,
+  `last_finished_ts` BIGINT NOT NULL DEFAULT 0
+);
@@ -0,0 +1,7 @@
+CREATE TABLE username_redirect (
+  username TEXT NOT NULL PRIMARY KEY,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW())
+);
+
+CREATE INDEX idx_username_redirect_user_id ON username_redirect (user_id);
@@ -0,0 +1,14 @@
+CREATE TABLE tag_share (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  creator_id INTEGER NOT NULL,
+  tag TEXT NOT NULL,
+  grantee_id INTEGER NOT NULL DEFAULT 0,
+  token TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_tag_share_creator_id ON tag_share (creator_id);
+
+CREATE INDEX idx_tag_share_grantee_id ON tag_share (grantee_id);
+
+CREATE INDEX idx_tag_share_token ON tag_share (token);
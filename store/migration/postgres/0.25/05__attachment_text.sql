@@ -0,0 +1,7 @@
+CREATE TABLE attachment_text (
+  attachment_id INTEGER NOT NULL PRIMARY KEY,
+  content TEXT NOT NULL,
+  content_search TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', content)) STORED
+);
+
+CREATE INDEX idx_attachment_text_content_search ON attachment_text USING GIN (content_search);
@@ -0,0 +1,10 @@
+CREATE TABLE invitation (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  creator_id INTEGER NOT NULL,
+  token TEXT NOT NULL UNIQUE,
+  role TEXT NOT NULL DEFAULT 'USER',
+  max_uses INTEGER NOT NULL DEFAULT 0,
+  use_count INTEGER NOT NULL DEFAULT 0,
+  expires_ts BIGINT NOT NULL DEFAULT 0
+);
@@ -0,0 +1,22 @@
+ALTER TABLE memo ADD COLUMN space_id INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_memo_space_id ON memo (space_id);
+
+CREATE TABLE space (
+  id SERIAL PRIMARY KEY,
+  uid TEXT NOT NULL UNIQUE,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  name TEXT NOT NULL
+);
+
+CREATE TABLE space_member (
+  space_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  role TEXT NOT NULL DEFAULT 'VIEWER',
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  UNIQUE(space_id, user_id)
+);
+
+CREATE INDEX idx_space_member_user_id ON space_member (user_id);
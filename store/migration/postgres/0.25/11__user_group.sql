@@ -0,0 +1,23 @@
+CREATE TABLE user_group (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  name TEXT NOT NULL UNIQUE,
+  description TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE user_group_member (
+  group_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  UNIQUE(group_id, user_id)
+);
+
+CREATE INDEX idx_user_group_member_user_id ON user_group_member (user_id);
+
+CREATE TABLE memo_group (
+  memo_id INTEGER NOT NULL,
+  group_id INTEGER NOT NULL,
+  UNIQUE(memo_id, group_id)
+);
+
+CREATE INDEX idx_memo_group_group_id ON memo_group (group_id);
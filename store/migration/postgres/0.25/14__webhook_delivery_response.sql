@@ -0,0 +1,4 @@
+ALTER TABLE webhook_delivery ADD COLUMN last_attempt_ts BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE webhook_delivery ADD COLUMN response_status_code INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE webhook_delivery ADD COLUMN response_body TEXT NOT NULL DEFAULT '';
+ALTER TABLE webhook_delivery ADD COLUMN latency_ms INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,10 @@
+CREATE TABLE memo_change (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  creator_id INTEGER NOT NULL,
+  memo_id INTEGER NOT NULL,
+  memo_uid TEXT NOT NULL,
+  type TEXT NOT NULL
+);
+
+CREATE INDEX idx_memo_change_creator_id_id ON memo_change (creator_id, id);
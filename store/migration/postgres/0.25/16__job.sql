@@ -0,0 +1,14 @@
+CREATE TABLE job (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  kind TEXT NOT NULL,
+  payload TEXT NOT NULL,
+  status TEXT NOT NULL DEFAULT 'PENDING',
+  attempt_count INTEGER NOT NULL DEFAULT 0,
+  max_attempts INTEGER NOT NULL DEFAULT 1,
+  next_attempt_ts BIGINT NOT NULL DEFAULT 0,
+  last_error TEXT NOT NULL DEFAULT '',
+  finished_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_job_status_next_attempt_ts ON job (status, next_attempt_ts);
@@ -0,0 +1,10 @@
+CREATE TABLE scheduled_task (
+  name TEXT NOT NULL PRIMARY KEY,
+  schedule TEXT NOT NULL DEFAULT '',
+  disabled BOOLEAN NOT NULL DEFAULT FALSE,
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  last_run_ts BIGINT NOT NULL DEFAULT 0,
+  last_status TEXT NOT NULL DEFAULT '',
+  last_error TEXT NOT NULL DEFAULT '',
+  last_finished_ts BIGINT NOT NULL DEFAULT 0
+);
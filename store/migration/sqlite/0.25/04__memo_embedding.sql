@@ -0,0 +1,6 @@
+CREATE TABLE memo_embedding (
+  memo_id INTEGER NOT NULL PRIMARY KEY,
+  model TEXT NOT NULL,
+  content_hash TEXT NOT NULL,
+  embedding TEXT NOT NULL
+);
@@ -0,0 +1,19 @@
+CREATE TABLE attachment_text (
+  attachment_id INTEGER NOT NULL PRIMARY KEY,
+  content TEXT NOT NULL
+);
+
+CREATE VIRTUAL TABLE attachment_text_fts USING fts5(content, content='attachment_text', content_rowid='attachment_id');
+
+CREATE TRIGGER attachment_text_fts_after_insert AFTER INSERT ON attachment_text BEGIN
+  INSERT INTO attachment_text_fts (rowid, content) VALUES (new.attachment_id, new.content);
+END;
+
+CREATE TRIGGER attachment_text_fts_after_delete AFTER DELETE ON attachment_text BEGIN
+  INSERT INTO attachment_text_fts (attachment_text_fts, rowid, content) VALUES ('delete', old.attachment_id, old.content);
+END;
+
+CREATE TRIGGER attachment_text_fts_after_update AFTER UPDATE OF content ON attachment_text BEGIN
+  INSERT INTO attachment_text_fts (attachment_text_fts, rowid, content) VALUES ('delete', old.attachment_id, old.content);
+  INSERT INTO attachment_text_fts (rowid, content) VALUES (new.attachment_id, new.content);
+END;
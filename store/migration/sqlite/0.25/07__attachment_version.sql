@@ -0,0 +1,17 @@
+CREATE TABLE attachment_version (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  attachment_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  filename TEXT NOT NULL DEFAULT '',
+  blob BLOB DEFAULT NULL,
+  type TEXT NOT NULL DEFAULT '',
+  size INTEGER NOT NULL DEFAULT 0,
+  storage_type TEXT NOT NULL DEFAULT '',
+  reference TEXT NOT NULL DEFAULT '',
+  payload TEXT NOT NULL DEFAULT '{}',
+  content_hash TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_attachment_version_attachment_id ON attachment_version (attachment_id);
+
+CREATE INDEX idx_attachment_version_content_hash ON attachment_version (content_hash);
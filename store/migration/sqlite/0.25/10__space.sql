@@ -0,0 +1,22 @@
+ALTER TABLE memo ADD COLUMN space_id INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_memo_space_id ON memo (space_id);
+
+CREATE TABLE space (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  uid TEXT NOT NULL UNIQUE,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  name TEXT NOT NULL
+);
+
+CREATE TABLE space_member (
+  space_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('OWNER', 'EDITOR', 'VIEWER')) DEFAULT 'VIEWER',
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  UNIQUE(space_id, user_id)
+);
+
+CREATE INDEX idx_space_member_user_id ON space_member (user_id);
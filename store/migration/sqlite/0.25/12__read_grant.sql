@@ -0,0 +1,10 @@
+CREATE TABLE read_grant (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  creator_id INTEGER NOT NULL,
+  tag TEXT NOT NULL DEFAULT '',
+  grantee_id INTEGER NOT NULL,
+  UNIQUE(creator_id, grantee_id, tag)
+);
+
+CREATE INDEX idx_read_grant_grantee_id ON read_grant (grantee_id);
@@ -0,0 +1,10 @@
+CREATE TABLE memo_change (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  creator_id INTEGER NOT NULL,
+  memo_id INTEGER NOT NULL,
+  memo_uid TEXT NOT NULL,
+  type TEXT NOT NULL CHECK (type IN ('CREATED', 'UPDATED', 'DELETED'))
+);
+
+CREATE INDEX idx_memo_change_creator_id_id ON memo_change (creator_id, id);
@@ -0,0 +1,10 @@
+CREATE TABLE scheduled_task (
+  name TEXT NOT NULL PRIMARY KEY,
+  schedule TEXT NOT NULL DEFAULT '',
+  disabled INTEGER NOT NULL CHECK (disabled IN (0, 1)) DEFAULT 0,
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  last_run_ts BIGINT NOT NULL DEFAULT 0,
+  last_status TEXT NOT NULL DEFAULT '',
+  last_error TEXT NOT NULL DEFAULT '',
+  last_finished_ts BIGINT NOT NULL DEFAULT 0
+);
@@ -46,13 +46,16 @@ CREATE TABLE memo (
   updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
   row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
   content TEXT NOT NULL DEFAULT '',
-  visibility TEXT NOT NULL CHECK (visibility IN ('PUBLIC', 'PROTECTED', 'PRIVATE')) DEFAULT 'PRIVATE',
+  visibility TEXT NOT NULL CHECK (visibility IN ('PUBLIC', 'PROTECTED', 'PRIVATE', 'GROUP')) DEFAULT 'PRIVATE',
   pinned INTEGER NOT NULL CHECK (pinned IN (0, 1)) DEFAULT 0,
-  payload TEXT NOT NULL DEFAULT '{}'
+  payload TEXT NOT NULL DEFAULT '{}',
+  space_id INTEGER NOT NULL DEFAULT 0
 );
 
 CREATE INDEX idx_memo_creator_id ON memo (creator_id);
 
+CREATE INDEX idx_memo_space_id ON memo (space_id);
+
 -- memo_organizer
 CREATE TABLE memo_organizer (
   memo_id INTEGER NOT NULL,
@@ -83,13 +86,16 @@ CREATE TABLE resource (
   memo_id INTEGER,
   storage_type TEXT NOT NULL DEFAULT '',
   reference TEXT NOT NULL DEFAULT '',
-  payload TEXT NOT NULL DEFAULT '{}'
+  payload TEXT NOT NULL DEFAULT '{}',
+  content_hash TEXT NOT NULL DEFAULT ''
 );
 
 CREATE INDEX idx_resource_creator_id ON resource (creator_id);
 
 CREATE INDEX idx_resource_memo_id ON resource (memo_id);
 
+CREATE INDEX idx_resource_content_hash ON resource (content_hash);
+
 -- activity
 CREATE TABLE activity (
   id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -128,3 +134,239 @@ CREATE TABLE reaction (
   reaction_type TEXT NOT NULL,
   UNIQUE(creator_id, content_id, reaction_type)
 );
+
+-- username_redirect
+CREATE TABLE username_redirect (
+  username TEXT NOT NULL PRIMARY KEY,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now'))
+);
+
+CREATE INDEX idx_username_redirect_user_id ON username_redirect (user_id);
+
+-- tag_share
+CREATE TABLE tag_share (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  creator_id INTEGER NOT NULL,
+  tag TEXT NOT NULL,
+  grantee_id INTEGER NOT NULL DEFAULT 0,
+  token TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_tag_share_creator_id ON tag_share (creator_id);
+
+CREATE INDEX idx_tag_share_grantee_id ON tag_share (grantee_id);
+
+CREATE INDEX idx_tag_share_token ON tag_share (token);
+
+-- memo_fts
+CREATE VIRTUAL TABLE memo_fts USING fts5(content, content='memo', content_rowid='id');
+
+CREATE TRIGGER memo_fts_after_insert AFTER INSERT ON memo BEGIN
+  INSERT INTO memo_fts (rowid, content) VALUES (new.id, new.content);
+END;
+
+CREATE TRIGGER memo_fts_after_delete AFTER DELETE ON memo BEGIN
+  INSERT INTO memo_fts (memo_fts, rowid, content) VALUES ('delete', old.id, old.content);
+END;
+
+CREATE TRIGGER memo_fts_after_update AFTER UPDATE OF content ON memo BEGIN
+  INSERT INTO memo_fts (memo_fts, rowid, content) VALUES ('delete', old.id, old.content);
+  INSERT INTO memo_fts (rowid, content) VALUES (new.id, new.content);
+END;
+
+-- memo_embedding
+CREATE TABLE memo_embedding (
+  memo_id INTEGER NOT NULL PRIMARY KEY,
+  model TEXT NOT NULL,
+  content_hash TEXT NOT NULL,
+  embedding TEXT NOT NULL
+);
+
+-- attachment_text
+CREATE TABLE attachment_text (
+  attachment_id INTEGER NOT NULL PRIMARY KEY,
+  content TEXT NOT NULL
+);
+
+CREATE VIRTUAL TABLE attachment_text_fts USING fts5(content, content='attachment_text', content_rowid='attachment_id');
+
+CREATE TRIGGER attachment_text_fts_after_insert AFTER INSERT ON attachment_text BEGIN
+  INSERT INTO attachment_text_fts (rowid, content) VALUES (new.attachment_id, new.content);
+END;
+
+CREATE TRIGGER attachment_text_fts_after_delete AFTER DELETE ON attachment_text BEGIN
+  INSERT INTO attachment_text_fts (attachment_text_fts, rowid, content) VALUES ('delete', old.attachment_id, old.content);
+END;
+
+CREATE TRIGGER attachment_text_fts_after_update AFTER UPDATE OF content ON attachment_text BEGIN
+  INSERT INTO attachment_text_fts (attachment_text_fts, rowid, content) VALUES ('delete', old.attachment_id, old.content);
+  INSERT INTO attachment_text_fts (rowid, content) VALUES (new.attachment_id, new.content);
+END;
+
+-- attachment_version
+CREATE TABLE attachment_version (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  attachment_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  filename TEXT NOT NULL DEFAULT '',
+  blob BLOB DEFAULT NULL,
+  type TEXT NOT NULL DEFAULT '',
+  size INTEGER NOT NULL DEFAULT 0,
+  storage_type TEXT NOT NULL DEFAULT '',
+  reference TEXT NOT NULL DEFAULT '',
+  payload TEXT NOT NULL DEFAULT '{}',
+  content_hash TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_attachment_version_attachment_id ON attachment_version (attachment_id);
+
+CREATE INDEX idx_attachment_version_content_hash ON attachment_version (content_hash);
+
+-- invitation
+CREATE TABLE invitation (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  creator_id INTEGER NOT NULL,
+  token TEXT NOT NULL UNIQUE,
+  role TEXT NOT NULL DEFAULT 'USER',
+  max_uses INTEGER NOT NULL DEFAULT 0,
+  use_count INTEGER NOT NULL DEFAULT 0,
+  expires_ts BIGINT NOT NULL DEFAULT 0
+);
+
+-- memo_share
+CREATE TABLE memo_share (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  token TEXT NOT NULL UNIQUE,
+  password_hash TEXT NOT NULL DEFAULT '',
+  expires_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_memo_share_memo_id ON memo_share (memo_id);
+
+-- space
+CREATE TABLE space (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  uid TEXT NOT NULL UNIQUE,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  name TEXT NOT NULL
+);
+
+-- space_member
+CREATE TABLE space_member (
+  space_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('OWNER', 'EDITOR', 'VIEWER')) DEFAULT 'VIEWER',
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  UNIQUE(space_id, user_id)
+);
+
+CREATE INDEX idx_space_member_user_id ON space_member (user_id);
+
+-- user_group
+CREATE TABLE user_group (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  name TEXT NOT NULL UNIQUE,
+  description TEXT NOT NULL DEFAULT ''
+);
+
+-- user_group_member
+CREATE TABLE user_group_member (
+  group_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  UNIQUE(group_id, user_id)
+);
+
+CREATE INDEX idx_user_group_member_user_id ON user_group_member (user_id);
+
+-- memo_group
+CREATE TABLE memo_group (
+  memo_id INTEGER NOT NULL,
+  group_id INTEGER NOT NULL,
+  UNIQUE(memo_id, group_id)
+);
+
+CREATE INDEX idx_memo_group_group_id ON memo_group (group_id);
+
+-- read_grant
+CREATE TABLE read_grant (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  creator_id INTEGER NOT NULL,
+  tag TEXT NOT NULL DEFAULT '',
+  grantee_id INTEGER NOT NULL,
+  UNIQUE(creator_id, grantee_id, tag)
+);
+
+CREATE INDEX idx_read_grant_grantee_id ON read_grant (grantee_id);
+
+-- webhook_delivery
+CREATE TABLE webhook_delivery (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  creator_id INTEGER NOT NULL,
+  webhook_id TEXT NOT NULL,
+  activity_type TEXT NOT NULL,
+  payload TEXT NOT NULL,
+  status TEXT NOT NULL CHECK (status IN ('PENDING', 'SUCCEEDED', 'DEAD')) DEFAULT 'PENDING',
+  attempt_count INTEGER NOT NULL DEFAULT 0,
+  next_attempt_ts BIGINT NOT NULL DEFAULT 0,
+  last_error TEXT NOT NULL DEFAULT '',
+  last_attempt_ts BIGINT NOT NULL DEFAULT 0,
+  response_status_code INTEGER NOT NULL DEFAULT 0,
+  response_body TEXT NOT NULL DEFAULT '',
+  latency_ms INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_webhook_delivery_creator_id_webhook_id ON webhook_delivery (creator_id, webhook_id);
+
+CREATE INDEX idx_webhook_delivery_status_next_attempt_ts ON webhook_delivery (status, next_attempt_ts);
+
+-- memo_change
+CREATE TABLE memo_change (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  creator_id INTEGER NOT NULL,
+  memo_id INTEGER NOT NULL,
+  memo_uid TEXT NOT NULL,
+  type TEXT NOT NULL CHECK (type IN ('CREATED', 'UPDATED', 'DELETED'))
+);
+
+CREATE INDEX idx_memo_change_creator_id_id ON memo_change (creator_id, id);
+
+-- job
+CREATE TABLE job (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  kind TEXT NOT NULL,
+  payload TEXT NOT NULL,
+  status TEXT NOT NULL CHECK (status IN ('PENDING', 'RUNNING', 'SUCCEEDED', 'FAILED')) DEFAULT 'PENDING',
+  attempt_count INTEGER NOT NULL DEFAULT 0,
+  max_attempts INTEGER NOT NULL DEFAULT 1,
+  next_attempt_ts BIGINT NOT NULL DEFAULT 0,
+  last_error TEXT NOT NULL DEFAULT '',
+  finished_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_job_status_next_attempt_ts ON job (status, next_attempt_ts);
+
+-- scheduled_task
+CREATE TABLE scheduled_task (
+  name TEXT NOT NULL PRIMARY KEY,
+  schedule TEXT NOT NULL DEFAULT '',
+  disabled INTEGER NOT NULL CHECK (disabled IN (0, 1)) DEFAULT 0,
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  last_run_ts BIGINT NOT NULL DEFAULT 0,
+  last_status TEXT NOT NULL DEFAULT '',
+  last_error TEXT NOT NULL DEFAULT '',
+  last_finished_ts BIGINT NOT NULL DEFAULT 0
+);
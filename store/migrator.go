@@ -103,6 +103,26 @@ func (s *Store) Migrate(ctx context.Context) error {
 	return nil
 }
 
+// GetPendingSchemaVersion returns the schema version the database is not migrated to yet, or empty if it is up to date.
+// The schema versions are only tracked in prod mode.
+func (s *Store) GetPendingSchemaVersion(ctx context.Context) (string, error) {
+	if s.profile.Mode != "prod" {
+		return "", nil
+	}
+	workspaceBasicSetting, err := s.GetWorkspaceBasicSetting(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get workspace basic setting")
+	}
+	currentSchemaVersion, err := s.GetCurrentSchemaVersion()
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get current schema version")
+	}
+	if version.IsVersionGreaterThan(currentSchemaVersion, workspaceBasicSetting.SchemaVersion) {
+		return currentSchemaVersion, nil
+	}
+	return "", nil
+}
+
 func (s *Store) preMigrate(ctx context.Context) error {
 	initialized, err := s.driver.IsInitialized(ctx)
 	if err != nil {
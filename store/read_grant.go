@@ -0,0 +1,49 @@
+package store
+
+import (
+	"context"
+)
+
+// ReadGrant delegates read access to the PRIVATE memos of the creator to the grantee, e.g. a trusted partner or an
+// assistant account. The grant covers all the private memos, or the ones carrying the tag or one of its descendants.
+type ReadGrant struct {
+	ID        int32
+	CreatedTs int64
+	CreatorID int32
+	GranteeID int32
+	// Tag restricts the grant to the memos carrying the tag, empty for all the private memos.
+	Tag string
+}
+
+type FindReadGrant struct {
+	ID        *int32
+	CreatorID *int32
+	GranteeID *int32
+}
+
+type DeleteReadGrant struct {
+	ID int32
+}
+
+func (s *Store) CreateReadGrant(ctx context.Context, create *ReadGrant) (*ReadGrant, error) {
+	return s.driver.CreateReadGrant(ctx, create)
+}
+
+func (s *Store) ListReadGrants(ctx context.Context, find *FindReadGrant) ([]*ReadGrant, error) {
+	return s.driver.ListReadGrants(ctx, find)
+}
+
+func (s *Store) GetReadGrant(ctx context.Context, find *FindReadGrant) (*ReadGrant, error) {
+	list, err := s.ListReadGrants(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteReadGrant(ctx context.Context, delete *DeleteReadGrant) error {
+	return s.driver.DeleteReadGrant(ctx, delete)
+}
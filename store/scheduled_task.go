@@ -0,0 +1,81 @@
+package store
+
+import (
+	"context"
+)
+
+// ScheduledTaskStatus is the status of the last run of a scheduled task.
+type ScheduledTaskStatus string
+
+const (
+	// ScheduledTaskPending is the status of the runs enqueued to the job queue, until a worker starts them.
+	ScheduledTaskPending   ScheduledTaskStatus = "PENDING"
+	ScheduledTaskRunning   ScheduledTaskStatus = "RUNNING"
+	ScheduledTaskSucceeded ScheduledTaskStatus = "SUCCEEDED"
+	ScheduledTaskFailed    ScheduledTaskStatus = "FAILED"
+)
+
+func (s ScheduledTaskStatus) String() string {
+	return string(s)
+}
+
+// ScheduledTask is the configuration and the last run of a built-in maintenance task run on a cron schedule,
+// e.g. the cleanups or the database backups. Its row is created when it is first configured or run.
+type ScheduledTask struct {
+	// Name is the name of the task, e.g. "memo_change_cleanup".
+	Name string
+	// Schedule is the cron expression of the task, its default schedule if empty.
+	Schedule  string
+	Disabled  bool
+	UpdatedTs int64
+
+	// LastRunTs is the time the last run was started by the schedule or by hand, zero if the task never ran.
+	LastRunTs int64
+	// LastStatus is the status of the last run, empty if the task never ran.
+	LastStatus ScheduledTaskStatus
+	// LastError is the error of the last run, if it failed.
+	LastError string
+	// LastFinishedTs is the time the last run succeeded or failed, zero until then.
+	LastFinishedTs int64
+}
+
+type FindScheduledTask struct {
+	Name *string
+}
+
+// UpdateScheduledTask records a run of a task, leaving its configuration unchanged.
+type UpdateScheduledTask struct {
+	Name           string
+	LastRunTs      *int64
+	LastStatus     *ScheduledTaskStatus
+	LastError      *string
+	LastFinishedTs *int64
+}
+
+// UpsertScheduledTask creates the task, or updates its schedule and whether it is disabled, leaving its last run unchanged.
+func (s *Store) UpsertScheduledTask(ctx context.Context, upsert *ScheduledTask) (*ScheduledTask, error) {
+	if err := s.driver.UpsertScheduledTask(ctx, upsert); err != nil {
+		return nil, err
+	}
+	return s.GetScheduledTask(ctx, &FindScheduledTask{Name: &upsert.Name})
+}
+
+// ListScheduledTasks returns the tasks configured or run once, ordered by name.
+func (s *Store) ListScheduledTasks(ctx context.Context, find *FindScheduledTask) ([]*ScheduledTask, error) {
+	return s.driver.ListScheduledTasks(ctx, find)
+}
+
+func (s *Store) GetScheduledTask(ctx context.Context, find *FindScheduledTask) (*ScheduledTask, error) {
+	list, err := s.ListScheduledTasks(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateScheduledTask(ctx context.Context, update *UpdateScheduledTask) error {
+	return s.driver.UpdateScheduledTask(ctx, update)
+}
@@ -0,0 +1,135 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	"github.com/usememos/memos/internal/base"
+)
+
+// SpaceRole is the role of a member in a space.
+type SpaceRole string
+
+const (
+	// SpaceRoleOwner manages the space and its members.
+	SpaceRoleOwner SpaceRole = "OWNER"
+	// SpaceRoleEditor creates memos in the space.
+	SpaceRoleEditor SpaceRole = "EDITOR"
+	// SpaceRoleViewer reads the memos of the space.
+	SpaceRoleViewer SpaceRole = "VIEWER"
+)
+
+// PersonalSpaceID is the space id of the memos outside of any shared space.
+const PersonalSpaceID int32 = 0
+
+// Space groups memos shared between its members, whatever their visibility.
+type Space struct {
+	ID        int32
+	UID       string
+	CreatorID int32
+	CreatedTs int64
+	UpdatedTs int64
+	Name      string
+}
+
+type FindSpace struct {
+	ID  *int32
+	UID *string
+	// MemberID restricts the spaces to the ones the user is a member of.
+	MemberID *int32
+}
+
+type UpdateSpace struct {
+	ID        int32
+	UpdatedTs *int64
+	Name      *string
+}
+
+type DeleteSpace struct {
+	ID int32
+}
+
+type SpaceMember struct {
+	SpaceID   int32
+	UserID    int32
+	Role      SpaceRole
+	CreatedTs int64
+}
+
+type FindSpaceMember struct {
+	SpaceID *int32
+	UserID  *int32
+}
+
+type DeleteSpaceMember struct {
+	SpaceID int32
+	UserID  *int32
+}
+
+func (s *Store) CreateSpace(ctx context.Context, create *Space) (*Space, error) {
+	if !base.UIDMatcher.MatchString(create.UID) {
+		return nil, errors.New("invalid uid")
+	}
+	return s.driver.CreateSpace(ctx, create)
+}
+
+func (s *Store) ListSpaces(ctx context.Context, find *FindSpace) ([]*Space, error) {
+	return s.driver.ListSpaces(ctx, find)
+}
+
+func (s *Store) GetSpace(ctx context.Context, find *FindSpace) (*Space, error) {
+	list, err := s.ListSpaces(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateSpace(ctx context.Context, update *UpdateSpace) error {
+	return s.driver.UpdateSpace(ctx, update)
+}
+
+// DeleteSpace deletes the space along with its members.
+func (s *Store) DeleteSpace(ctx context.Context, delete *DeleteSpace) error {
+	if err := s.driver.DeleteSpace(ctx, delete); err != nil {
+		return err
+	}
+	s.invalidateMemoListCache(ctx)
+	return nil
+}
+
+// UpsertSpaceMember adds a member to the space, or changes the role of an existing member.
+func (s *Store) UpsertSpaceMember(ctx context.Context, upsert *SpaceMember) (*SpaceMember, error) {
+	member, err := s.driver.UpsertSpaceMember(ctx, upsert)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidateMemoListCache(ctx)
+	return member, nil
+}
+
+func (s *Store) ListSpaceMembers(ctx context.Context, find *FindSpaceMember) ([]*SpaceMember, error) {
+	return s.driver.ListSpaceMembers(ctx, find)
+}
+
+func (s *Store) GetSpaceMember(ctx context.Context, find *FindSpaceMember) (*SpaceMember, error) {
+	list, err := s.ListSpaceMembers(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteSpaceMember(ctx context.Context, delete *DeleteSpaceMember) error {
+	if err := s.driver.DeleteSpaceMember(ctx, delete); err != nil {
+		return err
+	}
+	s.invalidateMemoListCache(ctx)
+	return nil
+}
@@ -1,9 +1,16 @@
 package store
 
 import (
+	"context"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+
 	"github.com/usememos/memos/internal/profile"
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store/cache"
 )
 
@@ -16,9 +23,22 @@ type Store struct {
 	cacheConfig cache.Config
 
 	// Caches
-	workspaceSettingCache *cache.Cache // cache for workspace settings
-	userCache             *cache.Cache // cache for users
-	userSettingCache      *cache.Cache // cache for user settings
+	workspaceSettingCache cache.Interface // cache for workspace settings
+	userCache             cache.Interface // cache for users
+	userSettingCache      cache.Interface // cache for user settings
+	// publicContentCache caches the content rendered for the anonymous visitors, e.g. the RSS feeds, if enabled.
+	publicContentCache cache.Interface
+	redisClient        redis.UniversalClient
+	// memoListCache caches the memos found by ListMemos, if enabled.
+	memoListCache cache.Interface
+	// memoListCacheGeneration is part of the keys of memoListCache, so that the lists found before a write are not cached.
+	memoListCacheGeneration atomic.Int64
+
+	// accessTokenUsagesMutex serializes the read-modify-write of the access token usages.
+	accessTokenUsagesMutex sync.Mutex
+	// pendingAccessTokenUsages counts the requests of the access tokens by user until they are flushed to the user settings.
+	pendingAccessTokenUsages      map[int32][]*storepb.AccessTokenUsagesUserSetting_Usage
+	pendingAccessTokenUsagesMutex sync.Mutex
 }
 
 // New creates a new instance of Store.
@@ -39,6 +59,11 @@ func New(driver Driver, profile *profile.Profile) *Store {
 		userCache:             cache.New(cacheConfig),
 		userSettingCache:      cache.New(cacheConfig),
 	}
+	if profile.MemoListCacheSize > 0 {
+		memoListCacheConfig := cacheConfig
+		memoListCacheConfig.MaxItems = profile.MemoListCacheSize
+		store.memoListCache = cache.NewLRU(memoListCacheConfig)
+	}
 
 	return store
 }
@@ -47,11 +72,49 @@ func (s *Store) GetDriver() Driver {
 	return s.driver
 }
 
+// GetRedisClient returns the client of the Redis server caching the users and the settings, or nil if not used.
+func (s *Store) GetRedisClient() redis.UniversalClient {
+	return s.redisClient
+}
+
+// Ping checks that the database is reachable.
+func (s *Store) Ping(ctx context.Context) error {
+	return s.driver.GetDB().PingContext(ctx)
+}
+
+// ReloadSettings drops the cached users and settings, so that they are read again from the database,
+// e.g. after they are edited by another instance or directly in the database.
+func (s *Store) ReloadSettings(ctx context.Context) {
+	s.workspaceSettingCache.Clear(ctx)
+	s.userCache.Clear(ctx)
+	s.userSettingCache.Clear(ctx)
+	s.invalidatePublicContent(ctx)
+	s.invalidateMemoListCache(ctx)
+}
+
+// UseRedisCache caches the users and the settings in Redis instead of the memory of the server, so that they are shared
+// by the instances of the server and invalidated by the writes of any of them, and caches the public content too.
+// The client is closed with the store.
+func (s *Store) UseRedisCache(client redis.UniversalClient) {
+	s.workspaceSettingCache.Close()
+	s.userCache.Close()
+	s.userSettingCache.Close()
+
+	s.workspaceSettingCache = cache.NewRedisCache(client, "memos:workspace_setting:", cache.ProtoCodec(func() proto.Message { return &storepb.WorkspaceSetting{} }), s.cacheConfig)
+	s.userCache = cache.NewRedisCache(client, "memos:user:", cache.JSONCodec[User](), s.cacheConfig)
+	s.userSettingCache = cache.NewRedisCache(client, "memos:user_setting:", cache.ProtoCodec(func() proto.Message { return &storepb.UserSetting{} }), s.cacheConfig)
+	s.publicContentCache = cache.NewRedisCache(client, "memos:public_content:", cache.BytesCodec(), s.cacheConfig)
+	s.redisClient = client
+}
+
 func (s *Store) Close() error {
 	// Stop all cache cleanup goroutines
 	s.workspaceSettingCache.Close()
 	s.userCache.Close()
 	s.userSettingCache.Close()
+	if s.redisClient != nil {
+		s.redisClient.Close()
+	}
 
 	return s.driver.Close()
 }
@@ -0,0 +1,52 @@
+package store
+
+import (
+	"context"
+)
+
+// TagShare grants read access to all memos of the creator carrying the tag or one of its descendants.
+// The access is evaluated when memos are read, so it follows the memos as they gain or lose the tag.
+type TagShare struct {
+	ID        int32
+	CreatedTs int64
+	CreatorID int32
+	Tag       string
+	// GranteeID is the user the tag is shared with, or 0 for link shares.
+	GranteeID int32
+	// Token is the secret of link shares, empty for user shares.
+	Token string
+}
+
+type FindTagShare struct {
+	ID        *int32
+	CreatorID *int32
+	GranteeID *int32
+	Token     *string
+}
+
+type DeleteTagShare struct {
+	ID int32
+}
+
+func (s *Store) CreateTagShare(ctx context.Context, create *TagShare) (*TagShare, error) {
+	return s.driver.CreateTagShare(ctx, create)
+}
+
+func (s *Store) ListTagShares(ctx context.Context, find *FindTagShare) ([]*TagShare, error) {
+	return s.driver.ListTagShares(ctx, find)
+}
+
+func (s *Store) GetTagShare(ctx context.Context, find *FindTagShare) (*TagShare, error) {
+	list, err := s.ListTagShares(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteTagShare(ctx context.Context, delete *DeleteTagShare) error {
+	return s.driver.DeleteTagShare(ctx, delete)
+}
@@ -0,0 +1,87 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestAttachmentTextStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-with-report",
+		CreatorID:  user.ID,
+		Content:    "See the attached file",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-without-report",
+		CreatorID:  user.ID,
+		Content:    "Nothing attached",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	attachment, err := ts.CreateAttachment(ctx, &store.Attachment{
+		UID:       shortuuid.New(),
+		CreatorID: user.ID,
+		Filename:  "report.txt",
+		Blob:      []byte("Quarterly revenue grew"),
+		Type:      "text/plain",
+		Size:      22,
+		MemoID:    &memo.ID,
+	})
+	require.NoError(t, err)
+
+	_, err = ts.UpsertAttachmentText(ctx, &store.AttachmentText{
+		AttachmentID: attachment.ID,
+		Content:      "Quarterly revenue grew",
+	})
+	require.NoError(t, err)
+
+	search := "revenue"
+	attachmentTexts, err := ts.ListAttachmentTexts(ctx, &store.FindAttachmentText{
+		FullTextSearch: &search,
+		MemoIDList:     []int32{memo.ID},
+	})
+	require.NoError(t, err)
+	require.Len(t, attachmentTexts, 1)
+	require.Equal(t, attachment.ID, attachmentTexts[0].AttachmentID)
+	require.Equal(t, memo.ID, *attachmentTexts[0].MemoID)
+
+	// Memos are found by the text of their attachments.
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{
+		FullTextSearch:   &search,
+		OrderByRelevance: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	require.Equal(t, memo.ID, memos[0].ID)
+
+	// The text is updated in place, and the index follows.
+	_, err = ts.UpsertAttachmentText(ctx, &store.AttachmentText{
+		AttachmentID: attachment.ID,
+		Content:      "Annual budget",
+	})
+	require.NoError(t, err)
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{FullTextSearch: &search})
+	require.NoError(t, err)
+	require.Empty(t, memos)
+
+	// The text is deleted with the attachment.
+	err = ts.DeleteAttachment(ctx, &store.DeleteAttachment{ID: attachment.ID})
+	require.NoError(t, err)
+	attachmentTexts, err = ts.ListAttachmentTexts(ctx, &store.FindAttachmentText{AttachmentID: &attachment.ID})
+	require.NoError(t, err)
+	require.Empty(t, attachmentTexts)
+
+	ts.Close()
+}
@@ -0,0 +1,88 @@
+package teststore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestAttachmentVersionStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	// The files are stored at absolute paths, outside of the data directory of the store.
+	dir := t.TempDir()
+	writeFile := func(name, content string) string {
+		p := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(p, []byte(content), 0o644))
+		return p
+	}
+	firstPath := writeFile("first.svg", "first")
+	secondPath := writeFile("second.svg", "second")
+
+	attachment, err := ts.CreateAttachment(ctx, &store.Attachment{
+		UID:         shortuuid.New(),
+		CreatorID:   user.ID,
+		Filename:    "diagram.svg",
+		Type:        "image/svg+xml",
+		Size:        6,
+		StorageType: storepb.AttachmentStorageType_LOCAL,
+		Reference:   secondPath,
+		ContentHash: "second",
+	})
+	require.NoError(t, err)
+	first, err := ts.CreateAttachmentVersion(ctx, &store.AttachmentVersion{
+		AttachmentID: attachment.ID,
+		Filename:     "diagram.svg",
+		Type:         "image/svg+xml",
+		Size:         5,
+		StorageType:  storepb.AttachmentStorageType_LOCAL,
+		Reference:    firstPath,
+		ContentHash:  "first",
+	})
+	require.NoError(t, err)
+	require.NotZero(t, first.CreatedTs)
+	// A version restored and replaced again shares its content with the earlier version.
+	second, err := ts.CreateAttachmentVersion(ctx, &store.AttachmentVersion{
+		AttachmentID: attachment.ID,
+		Filename:     "diagram.svg",
+		Type:         "image/svg+xml",
+		Size:         5,
+		StorageType:  storepb.AttachmentStorageType_LOCAL,
+		Reference:    firstPath,
+		ContentHash:  "first",
+	})
+	require.NoError(t, err)
+
+	versions, err := ts.ListAttachmentVersions(ctx, &store.FindAttachmentVersion{AttachmentID: &attachment.ID})
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	require.Equal(t, second.ID, versions[0].ID)
+	require.Equal(t, first.ID, versions[1].ID)
+	require.Equal(t, firstPath, versions[1].Reference)
+
+	// The content shared with another version is kept.
+	require.NoError(t, ts.DeleteAttachmentVersion(ctx, &store.DeleteAttachmentVersion{ID: second.ID}))
+	require.FileExists(t, firstPath)
+	version, err := ts.GetAttachmentVersion(ctx, &store.FindAttachmentVersion{ID: &second.ID})
+	require.NoError(t, err)
+	require.Nil(t, version)
+
+	// The versions are deleted with their attachment.
+	require.NoError(t, ts.DeleteAttachment(ctx, &store.DeleteAttachment{ID: attachment.ID}))
+	require.NoFileExists(t, firstPath)
+	require.NoFileExists(t, secondPath)
+	versions, err = ts.ListAttachmentVersions(ctx, &store.FindAttachmentVersion{AttachmentID: &attachment.ID})
+	require.NoError(t, err)
+	require.Empty(t, versions)
+	ts.Close()
+}
@@ -3,6 +3,7 @@ package teststore
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -52,3 +53,59 @@ func TestInboxStore(t *testing.T) {
 	require.Equal(t, 0, len(inboxes))
 	ts.Close()
 }
+
+func TestInboxStoreBulkOperations(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	const systemBotID int32 = 0
+	for i := 0; i < 3; i++ {
+		_, err := ts.CreateInbox(ctx, &store.Inbox{
+			SenderID:   systemBotID,
+			ReceiverID: user.ID,
+			Status:     store.UNREAD,
+			Message: &storepb.InboxMessage{
+				Type: storepb.InboxMessage_MEMO_COMMENT,
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	count, err := ts.UpdateInboxes(ctx, &store.UpdateInboxes{
+		ReceiverID: user.ID,
+		FromStatus: store.UNREAD,
+		Status:     store.ARCHIVED,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+	status := store.ARCHIVED
+	inboxes, err := ts.ListInboxes(ctx, &store.FindInbox{
+		ReceiverID: &user.ID,
+		Status:     &status,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(inboxes))
+
+	count, err = ts.DeleteInboxes(ctx, &store.DeleteInboxes{
+		IDList:     []int32{inboxes[0].ID},
+		ReceiverID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+	createdTsBefore := time.Now().Add(-time.Hour).Unix()
+	count, err = ts.DeleteInboxes(ctx, &store.DeleteInboxes{
+		Status:          &status,
+		CreatedTsBefore: &createdTsBefore,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), count)
+	createdTsBefore = time.Now().Add(time.Hour).Unix()
+	count, err = ts.DeleteInboxes(ctx, &store.DeleteInboxes{
+		Status:          &status,
+		CreatedTsBefore: &createdTsBefore,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+	ts.Close()
+}
@@ -0,0 +1,63 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestInvitationStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	invitation, err := ts.CreateInvitation(ctx, &store.Invitation{
+		CreatorID: user.ID,
+		Token:     "secret",
+		Role:      store.RoleAdmin,
+		MaxUses:   2,
+		ExpiresTs: 1893456000,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, invitation.ID)
+	unlimited, err := ts.CreateInvitation(ctx, &store.Invitation{
+		CreatorID: user.ID,
+		Token:     "unlimited",
+		Role:      store.RoleUser,
+	})
+	require.NoError(t, err)
+
+	token := "secret"
+	found, err := ts.GetInvitation(ctx, &store.FindInvitation{Token: &token})
+	require.NoError(t, err)
+	require.Equal(t, invitation.ID, found.ID)
+	require.Equal(t, store.RoleAdmin, found.Role)
+	require.Equal(t, int64(1893456000), found.ExpiresTs)
+
+	// The uses stop at the limit.
+	for _, expected := range []bool{true, true, false} {
+		ok, err := ts.UseInvitation(ctx, invitation.ID)
+		require.NoError(t, err)
+		require.Equal(t, expected, ok)
+	}
+	found, err = ts.GetInvitation(ctx, &store.FindInvitation{ID: &invitation.ID})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), found.UseCount)
+	for range 3 {
+		ok, err := ts.UseInvitation(ctx, unlimited.ID)
+		require.NoError(t, err)
+		require.True(t, ok)
+	}
+
+	require.NoError(t, ts.DeleteInvitation(ctx, &store.DeleteInvitation{ID: invitation.ID}))
+	invitations, err := ts.ListInvitations(ctx, &store.FindInvitation{})
+	require.NoError(t, err)
+	require.Len(t, invitations, 1)
+	require.Equal(t, unlimited.ID, invitations[0].ID)
+	ts.Close()
+}
@@ -0,0 +1,98 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestJobStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	now := time.Now().Unix()
+	due, err := ts.CreateJob(ctx, &store.Job{
+		Kind:          "attachment_thumbnails",
+		Payload:       `{"attachmentId":1}`,
+		Status:        store.JobPending,
+		MaxAttempts:   3,
+		NextAttemptTs: now - 1,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, due.ID)
+	_, err = ts.CreateJob(ctx, &store.Job{
+		Kind:          "feed_publish",
+		Payload:       `{"username":"jane"}`,
+		Status:        store.JobPending,
+		MaxAttempts:   3,
+		NextAttemptTs: now + 60,
+	})
+	require.NoError(t, err)
+
+	// The jobs are listed the latest first.
+	jobs, err := ts.ListJobs(ctx, &store.FindJob{})
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	require.Equal(t, "feed_publish", jobs[0].Kind)
+	kind := "attachment_thumbnails"
+	jobs, err = ts.ListJobs(ctx, &store.FindJob{Kind: &kind})
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+
+	// Only the jobs whose next attempt has come are due.
+	jobs, err = ts.ListJobs(ctx, &store.FindJob{DueBefore: &now})
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	require.Equal(t, due.ID, jobs[0].ID)
+	require.Equal(t, `{"attachmentId":1}`, jobs[0].Payload)
+
+	// A due job is claimed by a single worker, until the end of its lease.
+	claimed, err := ts.ClaimJob(ctx, &store.ClaimJob{ID: due.ID, AttemptCount: 0, LeaseEndTs: now + 60})
+	require.NoError(t, err)
+	require.True(t, claimed)
+	claimed, err = ts.ClaimJob(ctx, &store.ClaimJob{ID: due.ID, AttemptCount: 0, LeaseEndTs: now + 60})
+	require.NoError(t, err)
+	require.False(t, claimed)
+	job, err := ts.GetJob(ctx, &store.FindJob{ID: &due.ID})
+	require.NoError(t, err)
+	require.Equal(t, store.JobRunning, job.Status)
+	require.Equal(t, int32(1), job.AttemptCount)
+	jobs, err = ts.ListJobs(ctx, &store.FindJob{DueBefore: &now})
+	require.NoError(t, err)
+	require.Empty(t, jobs)
+
+	// The running job whose lease ended is due again, as its worker is assumed to have died.
+	leaseEndTs := now + 60
+	jobs, err = ts.ListJobs(ctx, &store.FindJob{DueBefore: &leaseEndTs})
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	claimed, err = ts.ClaimJob(ctx, &store.ClaimJob{ID: due.ID, AttemptCount: 1, LeaseEndTs: now + 120})
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	// The finished jobs are no longer due nor claimed, and are deleted once expired.
+	succeeded, finishedTs := store.JobSucceeded, now
+	require.NoError(t, ts.UpdateJob(ctx, &store.UpdateJob{
+		ID:         due.ID,
+		Status:     &succeeded,
+		FinishedTs: &finishedTs,
+	}))
+	claimed, err = ts.ClaimJob(ctx, &store.ClaimJob{ID: due.ID, AttemptCount: 2, LeaseEndTs: now + 180})
+	require.NoError(t, err)
+	require.False(t, claimed)
+	finishedTsBefore := now
+	require.NoError(t, ts.DeleteJobs(ctx, &store.DeleteJob{Status: &succeeded, FinishedTsBefore: &finishedTsBefore}))
+	jobs, err = ts.ListJobs(ctx, &store.FindJob{})
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	finishedTsBefore = now + 1
+	require.NoError(t, ts.DeleteJobs(ctx, &store.DeleteJob{Status: &succeeded, FinishedTsBefore: &finishedTsBefore}))
+	jobs, err = ts.ListJobs(ctx, &store.FindJob{})
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	require.Equal(t, "feed_publish", jobs[0].Kind)
+}
@@ -0,0 +1,51 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoChangeStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	// The changes of the memos are recorded as they are created, updated and deleted.
+	memo, err := ts.CreateMemo(ctx, &store.Memo{UID: "draft", CreatorID: user.ID, Content: "Draft", Visibility: store.Private})
+	require.NoError(t, err)
+	newUID, content := "final", "Final"
+	require.NoError(t, ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, UID: &newUID, Content: &content}))
+	require.NoError(t, ts.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID}))
+
+	changes, err := ts.ListMemoChanges(ctx, &store.FindMemoChange{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+	require.Equal(t, store.MemoChangeCreated, changes[0].Type)
+	require.Equal(t, "draft", changes[0].MemoUID)
+	require.Equal(t, store.MemoChangeUpdated, changes[1].Type)
+	require.Equal(t, "final", changes[1].MemoUID)
+	require.Equal(t, store.MemoChangeDeleted, changes[2].Type)
+	require.Equal(t, memo.ID, changes[2].MemoID)
+
+	// The changes after a cursor are listed in the order they were made.
+	limit := 1
+	changes, err = ts.ListMemoChanges(ctx, &store.FindMemoChange{CreatorID: &user.ID, IDAfter: &changes[0].ID, Limit: &limit})
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Equal(t, store.MemoChangeUpdated, changes[0].Type)
+
+	createdTsBefore := time.Now().Add(time.Hour).Unix()
+	require.NoError(t, ts.DeleteMemoChanges(ctx, &store.DeleteMemoChange{CreatedTsBefore: &createdTsBefore}))
+	changes, err = ts.ListMemoChanges(ctx, &store.FindMemoChange{})
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	ts.Close()
+}
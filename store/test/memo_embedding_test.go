@@ -0,0 +1,61 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoEmbeddingStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-memo",
+		CreatorID:  user.ID,
+		Content:    "test content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	_, err = ts.UpsertMemoEmbedding(ctx, &store.MemoEmbedding{
+		MemoID:      memo.ID,
+		Model:       "model-a",
+		ContentHash: "hash-1",
+		Embedding:   []float32{0.5, -0.25},
+	})
+	require.NoError(t, err)
+	// Upserting replaces the previous embedding of the memo.
+	_, err = ts.UpsertMemoEmbedding(ctx, &store.MemoEmbedding{
+		MemoID:      memo.ID,
+		Model:       "model-b",
+		ContentHash: "hash-2",
+		Embedding:   []float32{1, 0, 0.125},
+	})
+	require.NoError(t, err)
+
+	modelA, modelB := "model-a", "model-b"
+	memoEmbeddings, err := ts.ListMemoEmbeddings(ctx, &store.FindMemoEmbedding{Model: &modelA})
+	require.NoError(t, err)
+	require.Empty(t, memoEmbeddings)
+	memoEmbeddings, err = ts.ListMemoEmbeddings(ctx, &store.FindMemoEmbedding{Model: &modelB})
+	require.NoError(t, err)
+	require.Len(t, memoEmbeddings, 1)
+	require.Equal(t, &store.MemoEmbedding{
+		MemoID:      memo.ID,
+		Model:       "model-b",
+		ContentHash: "hash-2",
+		Embedding:   []float32{1, 0, 0.125},
+	}, memoEmbeddings[0])
+
+	err = ts.DeleteMemoEmbedding(ctx, &store.DeleteMemoEmbedding{MemoID: memo.ID})
+	require.NoError(t, err)
+	memoEmbeddings, err = ts.ListMemoEmbeddings(ctx, &store.FindMemoEmbedding{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Empty(t, memoEmbeddings)
+	ts.Close()
+}
@@ -0,0 +1,87 @@
+package teststore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/profile"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoListCache(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	cachedStore := store.New(ts.GetDriver(), &profile.Profile{Mode: "prod", MemoListCacheSize: 10})
+
+	user, err := createTestingHostUser(ctx, cachedStore)
+	require.NoError(t, err)
+	memo, err := cachedStore.CreateMemo(ctx, &store.Memo{UID: "memo", CreatorID: user.ID, Content: "hello", Visibility: store.Public})
+	require.NoError(t, err)
+	limit := 10
+	listMemos := func(find *store.FindMemo) []*store.Memo {
+		memos, err := cachedStore.ListMemos(ctx, find)
+		require.NoError(t, err)
+		return memos
+	}
+	// The finds with pointers to equal values share the cached list.
+	newFind := func() *store.FindMemo {
+		creatorID, limit := user.ID, limit
+		return &store.FindMemo{CreatorID: &creatorID, Limit: &limit}
+	}
+
+	memos := listMemos(newFind())
+	require.Len(t, memos, 1)
+	require.Equal(t, "hello", memos[0].Content)
+	// The cached memos are not modified by the callers.
+	memos[0].Content = "modified"
+
+	// The writes bypassing the store are not seen until the cache is invalidated.
+	content := "updated"
+	require.NoError(t, ts.GetDriver().UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Content: &content}))
+	memos = listMemos(newFind())
+	require.Equal(t, "hello", memos[0].Content)
+	// The unpaginated lists are not cached.
+	memos = listMemos(&store.FindMemo{CreatorID: &user.ID})
+	require.Equal(t, "updated", memos[0].Content)
+
+	content = "updated again"
+	require.NoError(t, cachedStore.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Content: &content}))
+	memos = listMemos(newFind())
+	require.Equal(t, "updated again", memos[0].Content)
+
+	// The comments are invalidated by their relations.
+	comment, err := cachedStore.CreateMemo(ctx, &store.Memo{UID: "comment", CreatorID: user.ID, Content: "comment", Visibility: store.Public})
+	require.NoError(t, err)
+	commentFind := &store.FindMemo{ID: &comment.ID, Limit: &limit}
+	require.Nil(t, listMemos(commentFind)[0].ParentID)
+	_, err = cachedStore.UpsertMemoRelation(ctx, &store.MemoRelation{MemoID: comment.ID, RelatedMemoID: memo.ID, Type: store.MemoRelationComment})
+	require.NoError(t, err)
+	require.Equal(t, memo.ID, *listMemos(commentFind)[0].ParentID)
+
+	require.NoError(t, cachedStore.DeleteMemo(ctx, &store.DeleteMemo{ID: comment.ID}))
+	require.Len(t, listMemos(newFind()), 1)
+
+	// The GROUP memos are invalidated by the members and the selected groups.
+	member, err := cachedStore.CreateUser(ctx, &store.User{Username: "member", Role: store.RoleUser, Email: "member@test.com"})
+	require.NoError(t, err)
+	userGroup, err := cachedStore.CreateUserGroup(ctx, &store.UserGroup{Name: "Engineering"})
+	require.NoError(t, err)
+	require.NoError(t, cachedStore.SetUserGroupMembers(ctx, userGroup.ID, []int32{member.ID}))
+	groupMemo, err := cachedStore.CreateMemo(ctx, &store.Memo{UID: "group-memo", CreatorID: user.ID, Content: "group", Visibility: store.Group})
+	require.NoError(t, err)
+	filter := fmt.Sprintf("group_visible_to(%d)", member.ID)
+	groupFind := &store.FindMemo{Filter: &filter, Limit: &limit}
+	require.Empty(t, listMemos(groupFind))
+	require.NoError(t, cachedStore.SetMemoGroups(ctx, groupMemo.ID, []int32{userGroup.ID}))
+	require.Len(t, listMemos(groupFind), 1)
+	require.NoError(t, cachedStore.SetUserGroupMembers(ctx, userGroup.ID, []int32{}))
+	require.Empty(t, listMemos(groupFind))
+	require.NoError(t, cachedStore.SetUserGroupMembers(ctx, userGroup.ID, []int32{member.ID}))
+	require.Len(t, listMemos(groupFind), 1)
+	require.NoError(t, cachedStore.DeleteUserGroup(ctx, &store.DeleteUserGroup{ID: userGroup.ID}))
+	require.Empty(t, listMemos(groupFind))
+}
@@ -0,0 +1,65 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoShareStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	memoShare, err := ts.CreateMemoShare(ctx, &store.MemoShare{
+		MemoID:       1,
+		CreatorID:    user.ID,
+		Token:        "secret",
+		PasswordHash: "hash",
+		ExpiresTs:    1893456000,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, memoShare.ID)
+	_, err = ts.CreateMemoShare(ctx, &store.MemoShare{
+		MemoID:    1,
+		CreatorID: user.ID,
+		Token:     "another",
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateMemoShare(ctx, &store.MemoShare{
+		MemoID:    2,
+		CreatorID: user.ID,
+		Token:     "other-memo",
+	})
+	require.NoError(t, err)
+
+	token := "secret"
+	found, err := ts.GetMemoShare(ctx, &store.FindMemoShare{Token: &token})
+	require.NoError(t, err)
+	require.Equal(t, memoShare.ID, found.ID)
+	require.Equal(t, "hash", found.PasswordHash)
+	require.Equal(t, int64(1893456000), found.ExpiresTs)
+
+	memoID := int32(1)
+	memoShares, err := ts.ListMemoShares(ctx, &store.FindMemoShare{MemoID: &memoID})
+	require.NoError(t, err)
+	require.Len(t, memoShares, 2)
+
+	require.NoError(t, ts.DeleteMemoShare(ctx, &store.DeleteMemoShare{ID: &memoShare.ID}))
+	memoShares, err = ts.ListMemoShares(ctx, &store.FindMemoShare{MemoID: &memoID})
+	require.NoError(t, err)
+	require.Len(t, memoShares, 1)
+
+	// Deleting the shares of a memo keeps the shares of the other memos.
+	require.NoError(t, ts.DeleteMemoShare(ctx, &store.DeleteMemoShare{MemoID: &memoID}))
+	memoShares, err = ts.ListMemoShares(ctx, &store.FindMemoShare{})
+	require.NoError(t, err)
+	require.Len(t, memoShares, 1)
+	require.Equal(t, "other-memo", memoShares[0].Token)
+	ts.Close()
+}
@@ -2,6 +2,8 @@ package teststore
 
 import (
 	"context"
+	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -96,6 +98,208 @@ func TestMemoListByTags(t *testing.T) {
 	ts.Close()
 }
 
+func TestMemoListByTagFilterMatchesDescendants(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	for uid, tags := range map[string][]string{
+		"parent":  {"work"},
+		"child":   {"work/project"},
+		"sibling": {"workshop"},
+	} {
+		_, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    "test_content",
+			Visibility: store.Public,
+			Payload: &storepb.MemoPayload{
+				Tags: tags,
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	listMemoUIDs := func(find *store.FindMemo) []string {
+		memoList, err := ts.ListMemos(ctx, find)
+		require.NoError(t, err)
+		uids := []string{}
+		for _, memo := range memoList {
+			uids = append(uids, memo.UID)
+		}
+		return uids
+	}
+	filter := `tag in ["work"]`
+	require.ElementsMatch(t, []string{"parent", "child"}, listMemoUIDs(&store.FindMemo{Filter: &filter}))
+	// The tag search matches the descendants as well, e.g. when renaming a tag.
+	require.ElementsMatch(t, []string{"parent", "child"}, listMemoUIDs(&store.FindMemo{PayloadFind: &store.FindMemoPayload{TagSearch: []string{"work"}}}))
+	ts.Close()
+}
+
+func TestMemoListByTagFilterEscapesWildcards(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	for uid, tags := range map[string][]string{
+		"snake":           {"to_do"},
+		"lookalike":       {"toxdo"},
+		"lookalike-child": {"toxdo/later"},
+	} {
+		_, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    "test_content",
+			Visibility: store.Public,
+			Payload: &storepb.MemoPayload{
+				Tags: tags,
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	// The "_" of the tag is not a wildcard.
+	filter := `tag in ["to_do"]`
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{
+		Filter: &filter,
+	})
+	require.NoError(t, err)
+	require.Len(t, memoList, 1)
+	require.Equal(t, "snake", memoList[0].UID)
+	ts.Close()
+}
+
+func TestMemoListByPropertyAndRelationFilters(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memos := map[string]*store.Memo{}
+	for uid, property := range map[string]*storepb.MemoPayload_Property{
+		"tasks":  {HasTaskList: true, HasIncompleteTasks: true, WordCount: 3},
+		"long":   {WordCount: 150},
+		"photo":  {WordCount: 1},
+		"linked": {},
+	} {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    "test_content",
+			Visibility: store.Public,
+			Payload: &storepb.MemoPayload{
+				Property: property,
+			},
+		})
+		require.NoError(t, err)
+		memos[uid] = memo
+	}
+	_, err = ts.CreateAttachment(ctx, &store.Attachment{
+		UID:       "photo-attachment",
+		CreatorID: user.ID,
+		Filename:  "photo.png",
+		Blob:      []byte("test"),
+		Type:      "image/png",
+		Size:      4,
+		MemoID:    &memos["photo"].ID,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateAttachment(ctx, &store.Attachment{
+		UID:       "voice-note-attachment",
+		CreatorID: user.ID,
+		Filename:  "voice-note.ogg",
+		Blob:      make([]byte, 1000),
+		Type:      "audio/ogg",
+		Size:      1000,
+		MemoID:    &memos["tasks"].ID,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{
+		MemoID:        memos["linked"].ID,
+		RelatedMemoID: memos["long"].ID,
+		Type:          store.MemoRelationReference,
+	})
+	require.NoError(t, err)
+
+	for filter, want := range map[string][]string{
+		`has_incomplete_tasks`:                                  {"tasks"},
+		`!has_incomplete_tasks`:                                 {"long", "photo", "linked"},
+		`word_count > 100`:                                      {"long"},
+		`word_count < 5 && word_count > 0`:                      {"tasks", "photo"},
+		`has_attachment_type("image/*")`:                        {"photo"},
+		`has_attachment_type("video/*")`:                        {},
+		`attachment_count > 0`:                                  {"tasks", "photo"},
+		`attachment_count == 0`:                                 {"long", "linked"},
+		`attachment_size > 100`:                                 {"tasks"},
+		`has_attachment_class("audio")`:                         {"tasks"},
+		`has_attachment_class("image") && attachment_size < 10`: {"photo"},
+		`has_attachment_class("document")`:                      {},
+		`in_relation_with("long")`:                              {"linked"},
+		`in_relation_with("linked")`:                            {"long"},
+	} {
+		memoList, err := ts.ListMemos(ctx, &store.FindMemo{
+			Filter: &filter,
+		})
+		require.NoError(t, err, filter)
+		uids := []string{}
+		for _, memo := range memoList {
+			uids = append(uids, memo.UID)
+		}
+		require.ElementsMatch(t, want, uids, filter)
+	}
+	ts.Close()
+}
+
+func TestMemoListByLocationFilter(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	for uid, location := range map[string]*storepb.MemoPayload_Location{
+		"paris":       {Placeholder: "Paris", Latitude: 48.8566, Longitude: 2.3522},
+		"versailles":  {Placeholder: "Versailles", Latitude: 48.8049, Longitude: 2.1204},
+		"london":      {Placeholder: "London", Latitude: 51.5072, Longitude: -0.1276},
+		"null-island": {Placeholder: "Null Island"},
+		"nowhere":     nil,
+	} {
+		_, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    "test_content",
+			Visibility: store.Public,
+			Payload: &storepb.MemoPayload{
+				Location: location,
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	for filter, want := range map[string][]string{
+		`location.within(48.8566, 2.3522, 5)`:   {"paris"},
+		`location.within(48.8566, 2.3522, 30)`:  {"paris", "versailles"},
+		`location.within(48.8566, 2.3522, 400)`: {"paris", "versailles", "london"},
+		`location.within(0, 0, 1)`:              {"null-island"},
+		`location.within(-33.87, 151.21, 100)`:  {},
+	} {
+		memoList, err := ts.ListMemos(ctx, &store.FindMemo{
+			Filter: &filter,
+		})
+		require.NoError(t, err, filter)
+		uids := []string{}
+		for _, memo := range memoList {
+			uids = append(uids, memo.UID)
+		}
+		require.ElementsMatch(t, want, uids, filter)
+	}
+
+	for _, filter := range []string{`location.within(91, 0, 1)`, `location.within(0, 0, 0)`, `location.within(0, 0, "far")`} {
+		_, err := ts.ListMemos(ctx, &store.FindMemo{
+			Filter: &filter,
+		})
+		require.Error(t, err, filter)
+	}
+	ts.Close()
+}
+
 func TestDeleteMemoStore(t *testing.T) {
 	ctx := context.Background()
 	ts := NewTestingStore(ctx, t)
@@ -116,3 +320,186 @@ func TestDeleteMemoStore(t *testing.T) {
 	require.NoError(t, err)
 	ts.Close()
 }
+
+func TestUpdateMemos(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memos := []*store.Memo{}
+	for _, uid := range []string{"memo-1", "memo-2"} {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    "test_content",
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+		memos = append(memos, memo)
+	}
+
+	content := "updated_content"
+	err = ts.UpdateMemos(ctx, []*store.UpdateMemo{
+		{ID: memos[0].ID, Content: &content},
+		{ID: memos[1].ID, Content: &content},
+	})
+	require.NoError(t, err)
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{
+		CreatorID: &user.ID,
+	})
+	require.NoError(t, err)
+	for _, memo := range memoList {
+		require.Equal(t, content, memo.Content)
+	}
+
+	// An invalid update rejects the whole batch.
+	invalidUID := "invalid uid"
+	otherContent := "other_content"
+	err = ts.UpdateMemos(ctx, []*store.UpdateMemo{
+		{ID: memos[0].ID, Content: &otherContent},
+		{ID: memos[1].ID, UID: &invalidUID},
+	})
+	require.Error(t, err)
+	memo, err := ts.GetMemo(ctx, &store.FindMemo{ID: &memos[0].ID})
+	require.NoError(t, err)
+	require.Equal(t, content, memo.Content)
+	ts.Close()
+}
+
+func TestMemoFullTextSearch(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	search := func(text string) []string {
+		memos, err := ts.ListMemos(ctx, &store.FindMemo{
+			CreatorID:        &user.ID,
+			FullTextSearch:   &text,
+			OrderByRelevance: true,
+		})
+		require.NoError(t, err)
+		uids := []string{}
+		for _, memo := range memos {
+			uids = append(uids, memo.UID)
+		}
+		return uids
+	}
+
+	once, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "search-once",
+		CreatorID:  user.ID,
+		Content:    "Notes about the garden and some other things",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "search-twice",
+		CreatorID:  user.ID,
+		Content:    "Garden plans: garden beds",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "search-none",
+		CreatorID:  user.ID,
+		Content:    "Kitchen renovation",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	// The memo mentioning the term more often ranks first.
+	require.Equal(t, []string{"search-twice", "search-once"}, search("garden"))
+	// Every term must match.
+	require.Equal(t, []string{"search-once"}, search("garden notes"))
+	// Operators in the search text are matched literally.
+	require.Empty(t, search(`"kitchen OR garden`))
+
+	// The index follows content updates.
+	content := "Kitchen cabinets"
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:      once.ID,
+		Content: &content,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"search-twice"}, search("garden"))
+	require.ElementsMatch(t, []string{"search-once", "search-none"}, search("kitchen"))
+
+	// The index follows deletions.
+	err = ts.DeleteMemo(ctx, &store.DeleteMemo{ID: once.ID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"search-none"}, search("kitchen"))
+	ts.Close()
+}
+
+func TestMemoContentFragments(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	for uid, content := range map[string]string{
+		"fragments-garden":  "Notes about the Garden",
+		"fragments-kitchen": "Kitchen renovation",
+		"fragments-both":    "Garden and kitchen",
+	} {
+		_, err := ts.CreateMemo(ctx, &store.Memo{UID: uid, CreatorID: user.ID, Content: content, Visibility: store.Public})
+		require.NoError(t, err)
+	}
+	search := func(fragments [][]string) []string {
+		memos, err := ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID, ContentFragments: fragments})
+		require.NoError(t, err)
+		uids := []string{}
+		for _, memo := range memos {
+			uids = append(uids, memo.UID)
+		}
+		return uids
+	}
+
+	// One fragment of each group must be contained, ignoring case.
+	require.ElementsMatch(t, []string{"fragments-garden", "fragments-both"}, search([][]string{{"gar", "xyz"}}))
+	require.ElementsMatch(t, []string{"fragments-garden", "fragments-kitchen", "fragments-both"}, search([][]string{{"garden", "kitchen"}}))
+	require.Equal(t, []string{"fragments-both"}, search([][]string{{"garden"}, {"kit"}}))
+	require.Empty(t, search([][]string{{"pantry"}}))
+	ts.Close()
+}
+
+func TestMemoListOrderByRandomSeed(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	for i := 0; i < 20; i++ {
+		_, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("random-memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    fmt.Sprintf("memo %d", i),
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+	}
+
+	list := func(seed int64, limit, offset int) []int32 {
+		memos, err := ts.ListMemos(ctx, &store.FindMemo{
+			CreatorID:         &user.ID,
+			OrderByRandomSeed: &seed,
+			Limit:             &limit,
+			Offset:            &offset,
+		})
+		require.NoError(t, err)
+		ids := []int32{}
+		for _, memo := range memos {
+			ids = append(ids, memo.ID)
+		}
+		return ids
+	}
+
+	order := list(42, 20, 0)
+	require.Len(t, order, 20)
+	// The same seed gives the same order, so the pages partition it.
+	require.Equal(t, order, list(42, 20, 0))
+	require.Equal(t, order, append(list(42, 10, 0), list(42, 10, 10)...))
+	require.False(t, slices.IsSorted(order), "the order should not follow the ids")
+	require.NotEqual(t, order, list(7, 20, 0))
+	ts.Close()
+}
@@ -0,0 +1,59 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestReadGrantStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	allGrant, err := ts.CreateReadGrant(ctx, &store.ReadGrant{
+		CreatorID: user.ID,
+		GranteeID: 2,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, allGrant.ID)
+	_, err = ts.CreateReadGrant(ctx, &store.ReadGrant{
+		CreatorID: user.ID,
+		GranteeID: 3,
+		Tag:       "work",
+	})
+	require.NoError(t, err)
+	// The same grant cannot be given twice.
+	_, err = ts.CreateReadGrant(ctx, &store.ReadGrant{
+		CreatorID: user.ID,
+		GranteeID: 3,
+		Tag:       "work",
+	})
+	require.Error(t, err)
+
+	readGrants, err := ts.ListReadGrants(ctx, &store.FindReadGrant{
+		CreatorID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Len(t, readGrants, 2)
+
+	granteeID := int32(3)
+	readGrant, err := ts.GetReadGrant(ctx, &store.FindReadGrant{
+		GranteeID: &granteeID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "work", readGrant.Tag)
+
+	require.NoError(t, ts.DeleteReadGrant(ctx, &store.DeleteReadGrant{ID: allGrant.ID}))
+	readGrants, err = ts.ListReadGrants(ctx, &store.FindReadGrant{
+		CreatorID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Len(t, readGrants, 1)
+	ts.Close()
+}
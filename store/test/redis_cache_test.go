@@ -0,0 +1,92 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/internal/profile"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestRedisCacheSharedByInstances(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	ts.UseRedisCache(redis.NewClient(&redis.Options{Addr: server.Addr()}))
+	// The other instance of the server shares the database and the Redis server.
+	other := store.New(ts.GetDriver(), &profile.Profile{Mode: "prod"})
+	other.UseRedisCache(redis.NewClient(&redis.Options{Addr: server.Addr()}))
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	cachedUser, err := other.GetUser(ctx, &store.FindUser{ID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, user, cachedUser)
+
+	// The writes of an instance are seen by the other instead of its stale cache.
+	nickname := "renamed"
+	_, err = ts.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, Nickname: &nickname})
+	require.NoError(t, err)
+	cachedUser, err = other.GetUser(ctx, &store.FindUser{ID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, nickname, cachedUser.Nickname)
+
+	_, err = other.GetUserAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSetting_ACCESS_TOKENS,
+		Value: &storepb.UserSetting_AccessTokens{AccessTokens: &storepb.AccessTokensUserSetting{
+			AccessTokens: []*storepb.AccessTokensUserSetting_AccessToken{{AccessToken: "token", Description: "test"}},
+		}},
+	})
+	require.NoError(t, err)
+	accessTokens, err := other.GetUserAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, accessTokens, 1)
+	require.Equal(t, "token", accessTokens[0].AccessToken)
+
+	_, err = other.GetWorkspaceGeneralSetting(ctx)
+	require.NoError(t, err)
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_GENERAL,
+		Value: &storepb.WorkspaceSetting_GeneralSetting{GeneralSetting: &storepb.WorkspaceGeneralSetting{DisallowUserRegistration: true}},
+	})
+	require.NoError(t, err)
+	workspaceGeneralSetting, err := other.GetWorkspaceGeneralSetting(ctx)
+	require.NoError(t, err)
+	require.True(t, workspaceGeneralSetting.DisallowUserRegistration)
+
+	// The public content is dropped when any memo changes.
+	ts.SetPublicContent(ctx, "feed", []byte("rss"))
+	content, ok := other.GetPublicContent(ctx, "feed")
+	require.True(t, ok)
+	require.Equal(t, []byte("rss"), content)
+	_, err = other.CreateMemo(ctx, &store.Memo{UID: "memo", CreatorID: user.ID, Content: "hello", Visibility: store.Public})
+	require.NoError(t, err)
+	_, ok = ts.GetPublicContent(ctx, "feed")
+	require.False(t, ok)
+
+	// The values are read from the database while Redis is unavailable.
+	server.Close()
+	cachedUser, err = ts.GetUser(ctx, &store.FindUser{ID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, nickname, cachedUser.Nickname)
+}
+
+func TestPublicContentWithoutRedis(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+
+	// The public content is only cached in Redis.
+	ts.SetPublicContent(ctx, "feed", []byte("rss"))
+	_, ok := ts.GetPublicContent(ctx, "feed")
+	require.False(t, ok)
+}
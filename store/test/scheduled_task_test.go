@@ -0,0 +1,63 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestScheduledTaskStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	name := "memo_change_cleanup"
+	task, err := ts.GetScheduledTask(ctx, &store.FindScheduledTask{Name: &name})
+	require.NoError(t, err)
+	require.Nil(t, task)
+
+	task, err = ts.UpsertScheduledTask(ctx, &store.ScheduledTask{
+		Name:      name,
+		Schedule:  "0 3 * * *",
+		UpdatedTs: 100,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "0 3 * * *", task.Schedule)
+	require.False(t, task.Disabled)
+	require.Equal(t, int64(100), task.UpdatedTs)
+	require.Empty(t, task.LastStatus)
+
+	// The runs are recorded apart from the configuration.
+	failed, lastRunTs, lastError, lastFinishedTs := store.ScheduledTaskFailed, int64(200), "database is locked", int64(210)
+	require.NoError(t, ts.UpdateScheduledTask(ctx, &store.UpdateScheduledTask{
+		Name:           name,
+		LastRunTs:      &lastRunTs,
+		LastStatus:     &failed,
+		LastError:      &lastError,
+		LastFinishedTs: &lastFinishedTs,
+	}))
+	task, err = ts.UpsertScheduledTask(ctx, &store.ScheduledTask{
+		Name:      name,
+		Disabled:  true,
+		UpdatedTs: 300,
+	})
+	require.NoError(t, err)
+	require.Empty(t, task.Schedule)
+	require.True(t, task.Disabled)
+	require.Equal(t, int64(300), task.UpdatedTs)
+	require.Equal(t, store.ScheduledTaskFailed, task.LastStatus)
+	require.Equal(t, int64(200), task.LastRunTs)
+	require.Equal(t, "database is locked", task.LastError)
+	require.Equal(t, int64(210), task.LastFinishedTs)
+
+	// The tasks are listed by name.
+	_, err = ts.UpsertScheduledTask(ctx, &store.ScheduledTask{Name: "inbox_cleanup"})
+	require.NoError(t, err)
+	tasks, err := ts.ListScheduledTasks(ctx, &store.FindScheduledTask{})
+	require.NoError(t, err)
+	require.Len(t, tasks, 2)
+	require.Equal(t, "inbox_cleanup", tasks[0].Name)
+	require.Equal(t, name, tasks[1].Name)
+}
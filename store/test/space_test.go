@@ -0,0 +1,88 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestSpaceStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	space, err := ts.CreateSpace(ctx, &store.Space{
+		UID:       "team",
+		CreatorID: user.ID,
+		Name:      "Team",
+	})
+	require.NoError(t, err)
+	require.NotZero(t, space.ID)
+	_, err = ts.CreateSpace(ctx, &store.Space{
+		UID:       "other",
+		CreatorID: user.ID,
+		Name:      "Other",
+	})
+	require.NoError(t, err)
+
+	name := "Renamed"
+	require.NoError(t, ts.UpdateSpace(ctx, &store.UpdateSpace{ID: space.ID, Name: &name}))
+	found, err := ts.GetSpace(ctx, &store.FindSpace{ID: &space.ID})
+	require.NoError(t, err)
+	require.Equal(t, "Renamed", found.Name)
+
+	_, err = ts.UpsertSpaceMember(ctx, &store.SpaceMember{SpaceID: space.ID, UserID: user.ID, Role: store.SpaceRoleViewer})
+	require.NoError(t, err)
+	// Upserting an existing member changes their role.
+	_, err = ts.UpsertSpaceMember(ctx, &store.SpaceMember{SpaceID: space.ID, UserID: user.ID, Role: store.SpaceRoleOwner})
+	require.NoError(t, err)
+	spaceMembers, err := ts.ListSpaceMembers(ctx, &store.FindSpaceMember{SpaceID: &space.ID})
+	require.NoError(t, err)
+	require.Len(t, spaceMembers, 1)
+	require.Equal(t, store.SpaceRoleOwner, spaceMembers[0].Role)
+
+	spaces, err := ts.ListSpaces(ctx, &store.FindSpace{MemberID: &user.ID})
+	require.NoError(t, err)
+	require.Len(t, spaces, 1)
+	require.Equal(t, "team", spaces[0].UID)
+
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "space-memo",
+		CreatorID:  user.ID,
+		Content:    "space memo",
+		Visibility: store.Private,
+		SpaceID:    space.ID,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "personal-memo",
+		CreatorID:  user.ID,
+		Content:    "personal memo",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{SpaceID: &space.ID})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	require.Equal(t, memo.ID, memos[0].ID)
+	require.Equal(t, space.ID, memos[0].SpaceID)
+	personalSpaceID := store.PersonalSpaceID
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{SpaceID: &personalSpaceID})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	require.Equal(t, "personal-memo", memos[0].UID)
+
+	require.NoError(t, ts.DeleteSpace(ctx, &store.DeleteSpace{ID: space.ID}))
+	spaceMembers, err = ts.ListSpaceMembers(ctx, &store.FindSpaceMember{SpaceID: &space.ID})
+	require.NoError(t, err)
+	require.Empty(t, spaceMembers)
+	spaces, err = ts.ListSpaces(ctx, &store.FindSpace{})
+	require.NoError(t, err)
+	require.Len(t, spaces, 1)
+	ts.Close()
+}
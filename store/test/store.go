@@ -50,7 +50,24 @@ func resetTestingDB(ctx context.Context, profile *profile.Profile, dbDriver stor
 		DROP TABLE IF EXISTS storage;
 		DROP TABLE IF EXISTS idp;
 		DROP TABLE IF EXISTS inbox;
-		DROP TABLE IF EXISTS reaction;`)
+		DROP TABLE IF EXISTS reaction;
+		DROP TABLE IF EXISTS username_redirect;
+		DROP TABLE IF EXISTS tag_share;
+		DROP TABLE IF EXISTS memo_embedding;
+		DROP TABLE IF EXISTS attachment_text;
+		DROP TABLE IF EXISTS attachment_version;
+		DROP TABLE IF EXISTS invitation;
+		DROP TABLE IF EXISTS memo_share;
+		DROP TABLE IF EXISTS space;
+		DROP TABLE IF EXISTS space_member;
+		DROP TABLE IF EXISTS user_group;
+		DROP TABLE IF EXISTS user_group_member;
+		DROP TABLE IF EXISTS memo_group;
+		DROP TABLE IF EXISTS read_grant;
+		DROP TABLE IF EXISTS webhook_delivery;
+		DROP TABLE IF EXISTS memo_change;
+		DROP TABLE IF EXISTS job;
+		DROP TABLE IF EXISTS scheduled_task;`)
 		if err != nil {
 			slog.Error("failed to reset testing db", slog.String("error", err.Error()))
 			panic(err)
@@ -70,7 +87,24 @@ func resetTestingDB(ctx context.Context, profile *profile.Profile, dbDriver stor
 		DROP TABLE IF EXISTS storage CASCADE;
 		DROP TABLE IF EXISTS idp CASCADE;
 		DROP TABLE IF EXISTS inbox CASCADE;
-		DROP TABLE IF EXISTS reaction CASCADE;`)
+		DROP TABLE IF EXISTS reaction CASCADE;
+		DROP TABLE IF EXISTS username_redirect CASCADE;
+		DROP TABLE IF EXISTS tag_share CASCADE;
+		DROP TABLE IF EXISTS memo_embedding CASCADE;
+		DROP TABLE IF EXISTS attachment_text CASCADE;
+		DROP TABLE IF EXISTS attachment_version CASCADE;
+		DROP TABLE IF EXISTS invitation CASCADE;
+		DROP TABLE IF EXISTS memo_share CASCADE;
+		DROP TABLE IF EXISTS space CASCADE;
+		DROP TABLE IF EXISTS space_member CASCADE;
+		DROP TABLE IF EXISTS user_group CASCADE;
+		DROP TABLE IF EXISTS user_group_member CASCADE;
+		DROP TABLE IF EXISTS memo_group CASCADE;
+		DROP TABLE IF EXISTS read_grant CASCADE;
+		DROP TABLE IF EXISTS webhook_delivery CASCADE;
+		DROP TABLE IF EXISTS memo_change CASCADE;
+		DROP TABLE IF EXISTS job CASCADE;
+		DROP TABLE IF EXISTS scheduled_task CASCADE;`)
 		if err != nil {
 			slog.Error("failed to reset testing db", slog.String("error", err.Error()))
 			panic(err)
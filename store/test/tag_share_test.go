@@ -0,0 +1,64 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestTagShareStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	userShare, err := ts.CreateTagShare(ctx, &store.TagShare{
+		CreatorID: user.ID,
+		Tag:       "work",
+		GranteeID: 2,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, userShare.ID)
+	linkShare, err := ts.CreateTagShare(ctx, &store.TagShare{
+		CreatorID: user.ID,
+		Tag:       "travel",
+		Token:     "secret",
+	})
+	require.NoError(t, err)
+
+	tagShares, err := ts.ListTagShares(ctx, &store.FindTagShare{
+		CreatorID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Len(t, tagShares, 2)
+
+	granteeID := int32(2)
+	tagShares, err = ts.ListTagShares(ctx, &store.FindTagShare{
+		GranteeID: &granteeID,
+	})
+	require.NoError(t, err)
+	require.Len(t, tagShares, 1)
+	require.Equal(t, "work", tagShares[0].Tag)
+
+	token := "secret"
+	tagShare, err := ts.GetTagShare(ctx, &store.FindTagShare{
+		Token: &token,
+	})
+	require.NoError(t, err)
+	require.Equal(t, linkShare.ID, tagShare.ID)
+
+	err = ts.DeleteTagShare(ctx, &store.DeleteTagShare{
+		ID: linkShare.ID,
+	})
+	require.NoError(t, err)
+	tagShare, err = ts.GetTagShare(ctx, &store.FindTagShare{
+		Token: &token,
+	})
+	require.NoError(t, err)
+	require.Nil(t, tagShare)
+	ts.Close()
+}
@@ -0,0 +1,88 @@
+package teststore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestUserGroupStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	member, err := ts.CreateUser(ctx, &store.User{
+		Username: "member",
+		Role:     store.RoleUser,
+		Email:    "member@test.com",
+	})
+	require.NoError(t, err)
+
+	userGroup, err := ts.CreateUserGroup(ctx, &store.UserGroup{
+		Name:        "Engineering",
+		Description: "The engineers",
+	})
+	require.NoError(t, err)
+	require.NotZero(t, userGroup.ID)
+
+	description := "All the engineers"
+	require.NoError(t, ts.UpdateUserGroup(ctx, &store.UpdateUserGroup{ID: userGroup.ID, Description: &description}))
+	found, err := ts.GetUserGroup(ctx, &store.FindUserGroup{ID: &userGroup.ID})
+	require.NoError(t, err)
+	require.Equal(t, "All the engineers", found.Description)
+
+	require.NoError(t, ts.SetUserGroupMembers(ctx, userGroup.ID, []int32{user.ID, member.ID}))
+	// Setting the members replaces the previous ones.
+	require.NoError(t, ts.SetUserGroupMembers(ctx, userGroup.ID, []int32{member.ID}))
+	members, err := ts.ListUserGroupMembers(ctx, &store.FindUserGroupMember{GroupID: &userGroup.ID})
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	require.Equal(t, member.ID, members[0].UserID)
+	userGroups, err := ts.ListUserGroups(ctx, &store.FindUserGroup{MemberID: &member.ID})
+	require.NoError(t, err)
+	require.Len(t, userGroups, 1)
+	userGroups, err = ts.ListUserGroups(ctx, &store.FindUserGroup{MemberID: &user.ID})
+	require.NoError(t, err)
+	require.Empty(t, userGroups)
+
+	groupMemo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "group-memo",
+		CreatorID:  user.ID,
+		Content:    "group memo",
+		Visibility: store.Group,
+	})
+	require.NoError(t, err)
+	require.NoError(t, ts.SetMemoGroups(ctx, groupMemo.ID, []int32{userGroup.ID}))
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "private-memo",
+		CreatorID:  user.ID,
+		Content:    "private memo",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	// The group visibility filter matches the GROUP memos of the groups of the user only.
+	filter := fmt.Sprintf("group_visible_to(%d)", member.ID)
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{Filter: &filter})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	require.Equal(t, groupMemo.ID, memos[0].ID)
+	filter = fmt.Sprintf("group_visible_to(%d)", user.ID)
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{Filter: &filter})
+	require.NoError(t, err)
+	require.Empty(t, memos)
+
+	require.NoError(t, ts.DeleteUserGroup(ctx, &store.DeleteUserGroup{ID: userGroup.ID}))
+	members, err = ts.ListUserGroupMembers(ctx, &store.FindUserGroupMember{GroupID: &userGroup.ID})
+	require.NoError(t, err)
+	require.Empty(t, members)
+	memoGroups, err := ts.ListMemoGroups(ctx, &store.FindMemoGroup{MemoID: &groupMemo.ID})
+	require.NoError(t, err)
+	require.Empty(t, memoGroups)
+	ts.Close()
+}
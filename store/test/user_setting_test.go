@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
@@ -26,3 +27,41 @@ func TestUserSettingStore(t *testing.T) {
 	require.Equal(t, 1, len(list))
 	ts.Close()
 }
+
+func TestUserAccessTokenUsages(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	getStoredUsages := func() []*storepb.AccessTokenUsagesUserSetting_Usage {
+		userSetting, err := ts.GetUserSetting(ctx, &store.FindUserSetting{UserID: &user.ID, Key: storepb.UserSetting_ACCESS_TOKEN_USAGES})
+		require.NoError(t, err)
+		return userSetting.GetAccessTokenUsages().GetUsages()
+	}
+
+	// The requests are counted in memory until flushed.
+	ts.IncrementUserAccessTokenUsage(user.ID, "token", timestamppb.Now(), "127.0.0.1")
+	ts.IncrementUserAccessTokenUsage(user.ID, "token", timestamppb.Now(), "127.0.0.2")
+	require.Empty(t, getStoredUsages())
+	usages, err := ts.GetUserAccessTokenUsages(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, usages, 1)
+	require.Equal(t, int64(2), usages[0].RequestCount)
+	require.Equal(t, "127.0.0.2", usages[0].LastUsedIp)
+
+	require.NoError(t, ts.FlushUserAccessTokenUsages(ctx))
+	require.Len(t, getStoredUsages(), 1)
+	ts.IncrementUserAccessTokenUsage(user.ID, "token", timestamppb.Now(), "127.0.0.1")
+	require.NoError(t, ts.FlushUserAccessTokenUsages(ctx))
+	usages, err = ts.GetUserAccessTokenUsages(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, usages, 1)
+	require.Equal(t, int64(3), usages[0].RequestCount)
+
+	// The removed usages are not stored again.
+	ts.IncrementUserAccessTokenUsage(user.ID, "token", timestamppb.Now(), "127.0.0.1")
+	require.NoError(t, ts.RemoveUserAccessTokenUsage(ctx, user.ID, "token"))
+	require.NoError(t, ts.FlushUserAccessTokenUsages(ctx))
+	require.Empty(t, getStoredUsages())
+	ts.Close()
+}
@@ -0,0 +1,46 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestUsernameRedirectStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	redirect, err := ts.UpsertUsernameRedirect(ctx, &store.UsernameRedirect{
+		Username: "old_name",
+		UserID:   user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "old_name", redirect.Username)
+
+	redirectedUser, err := ts.GetUserByRedirectedUsername(ctx, "old_name")
+	require.NoError(t, err)
+	require.NotNil(t, redirectedUser)
+	require.Equal(t, user.ID, redirectedUser.ID)
+
+	redirects, err := ts.ListUsernameRedirects(ctx, &store.FindUsernameRedirect{
+		UserID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Len(t, redirects, 1)
+
+	err = ts.DeleteUsernameRedirect(ctx, &store.DeleteUsernameRedirect{
+		Username: "old_name",
+	})
+	require.NoError(t, err)
+	redirectedUser, err = ts.GetUserByRedirectedUsername(ctx, "old_name")
+	require.NoError(t, err)
+	require.Nil(t, redirectedUser)
+
+	ts.Close()
+}
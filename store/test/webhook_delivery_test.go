@@ -0,0 +1,102 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestWebhookDeliveryStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	now := time.Now().Unix()
+	webhookID := "webhook"
+	due, err := ts.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+		CreatorID:     user.ID,
+		WebhookID:     webhookID,
+		ActivityType:  "memos.memo.created",
+		Payload:       `{"activityType":"memos.memo.created"}`,
+		Status:        store.WebhookDeliveryPending,
+		NextAttemptTs: now - 1,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, due.ID)
+	_, err = ts.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+		CreatorID:     user.ID,
+		WebhookID:     webhookID,
+		ActivityType:  "memos.memo.updated",
+		Payload:       `{"activityType":"memos.memo.updated"}`,
+		Status:        store.WebhookDeliveryPending,
+		NextAttemptTs: now + 60,
+	})
+	require.NoError(t, err)
+
+	// The deliveries are listed the latest first.
+	deliveries, err := ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{
+		CreatorID: &user.ID,
+		WebhookID: &webhookID,
+	})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	require.Equal(t, "memos.memo.updated", deliveries[0].ActivityType)
+
+	// Only the deliveries whose next attempt has come are due.
+	pending := store.WebhookDeliveryPending
+	deliveries, err = ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{
+		Status:              &pending,
+		NextAttemptTsBefore: &now,
+	})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, due.ID, deliveries[0].ID)
+	require.Equal(t, `{"activityType":"memos.memo.created"}`, deliveries[0].Payload)
+
+	dead, attemptCount, lastError := store.WebhookDeliveryDead, int32(8), "status code: 502"
+	statusCode, responseBody, latencyMs := int32(502), "Bad Gateway", int32(120)
+	err = ts.UpdateWebhookDelivery(ctx, &store.UpdateWebhookDelivery{
+		ID:                 due.ID,
+		Status:             &dead,
+		AttemptCount:       &attemptCount,
+		LastError:          &lastError,
+		LastAttemptTs:      &now,
+		ResponseStatusCode: &statusCode,
+		ResponseBody:       &responseBody,
+		LatencyMs:          &latencyMs,
+	})
+	require.NoError(t, err)
+	delivery, err := ts.GetWebhookDelivery(ctx, &store.FindWebhookDelivery{ID: &due.ID})
+	require.NoError(t, err)
+	require.Equal(t, store.WebhookDeliveryDead, delivery.Status)
+	require.Equal(t, attemptCount, delivery.AttemptCount)
+	require.Equal(t, lastError, delivery.LastError)
+	require.Equal(t, now, delivery.LastAttemptTs)
+	require.Equal(t, statusCode, delivery.ResponseStatusCode)
+	require.Equal(t, responseBody, delivery.ResponseBody)
+	require.Equal(t, latencyMs, delivery.LatencyMs)
+
+	err = ts.DeleteWebhookDeliveries(ctx, &store.DeleteWebhookDelivery{
+		Status: &dead,
+	})
+	require.NoError(t, err)
+	deliveries, err = ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	err = ts.DeleteWebhookDeliveries(ctx, &store.DeleteWebhookDelivery{
+		CreatorID: &user.ID,
+		WebhookID: &webhookID,
+	})
+	require.NoError(t, err)
+	deliveries, err = ts.ListWebhookDeliveries(ctx, &store.FindWebhookDelivery{})
+	require.NoError(t, err)
+	require.Empty(t, deliveries)
+
+	ts.Close()
+}
@@ -2,6 +2,8 @@ package store
 
 import (
 	"context"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
 )
 
 // Role is the type of a role.
@@ -108,6 +110,7 @@ func (s *Store) UpdateUser(ctx context.Context, update *UpdateUser) (*User, erro
 	}
 
 	s.userCache.Set(ctx, string(user.ID), user)
+	s.invalidatePublicContent(ctx)
 	return user, nil
 }
 
@@ -155,5 +158,10 @@ func (s *Store) DeleteUser(ctx context.Context, delete *DeleteUser) error {
 		return err
 	}
 	s.userCache.Delete(ctx, string(delete.ID))
+	for key := range storepb.UserSetting_Key_name {
+		s.userSettingCache.Delete(ctx, getUserSettingCacheKey(delete.ID, storepb.UserSetting_Key(key).String()))
+	}
+	s.invalidatePublicContent(ctx)
+	s.invalidateMemoListCache(ctx)
 	return nil
 }
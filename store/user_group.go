@@ -0,0 +1,92 @@
+package store
+
+import (
+	"context"
+)
+
+// UserGroup is a group of users managed by the admins, the memos with the GROUP visibility being visible to the members
+// of their selected groups.
+type UserGroup struct {
+	ID          int32
+	CreatedTs   int64
+	UpdatedTs   int64
+	Name        string
+	Description string
+}
+
+type FindUserGroup struct {
+	ID   *int32
+	Name *string
+	// MemberID restricts the groups to the ones the user is a member of.
+	MemberID *int32
+}
+
+type UpdateUserGroup struct {
+	ID          int32
+	UpdatedTs   *int64
+	Name        *string
+	Description *string
+}
+
+type DeleteUserGroup struct {
+	ID int32
+}
+
+type UserGroupMember struct {
+	GroupID int32
+	UserID  int32
+}
+
+type FindUserGroupMember struct {
+	GroupID *int32
+	UserID  *int32
+}
+
+func (s *Store) CreateUserGroup(ctx context.Context, create *UserGroup) (*UserGroup, error) {
+	return s.driver.CreateUserGroup(ctx, create)
+}
+
+func (s *Store) ListUserGroups(ctx context.Context, find *FindUserGroup) ([]*UserGroup, error) {
+	return s.driver.ListUserGroups(ctx, find)
+}
+
+func (s *Store) GetUserGroup(ctx context.Context, find *FindUserGroup) (*UserGroup, error) {
+	list, err := s.ListUserGroups(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateUserGroup(ctx context.Context, update *UpdateUserGroup) error {
+	if err := s.driver.UpdateUserGroup(ctx, update); err != nil {
+		return err
+	}
+	s.invalidateMemoListCache(ctx)
+	return nil
+}
+
+// DeleteUserGroup deletes the group along with its members and its memo selections.
+func (s *Store) DeleteUserGroup(ctx context.Context, delete *DeleteUserGroup) error {
+	if err := s.driver.DeleteUserGroup(ctx, delete); err != nil {
+		return err
+	}
+	s.invalidateMemoListCache(ctx)
+	return nil
+}
+
+// SetUserGroupMembers replaces the members of the group with the given users.
+func (s *Store) SetUserGroupMembers(ctx context.Context, groupID int32, userIDs []int32) error {
+	if err := s.driver.SetUserGroupMembers(ctx, groupID, userIDs); err != nil {
+		return err
+	}
+	s.invalidateMemoListCache(ctx)
+	return nil
+}
+
+func (s *Store) ListUserGroupMembers(ctx context.Context, find *FindUserGroupMember) ([]*UserGroupMember, error) {
+	return s.driver.ListUserGroupMembers(ctx, find)
+}
@@ -2,9 +2,11 @@ package store
 
 import (
 	"context"
+	"slices"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	storepb "github.com/usememos/memos/proto/gen/store"
@@ -133,6 +135,145 @@ func (s *Store) RemoveUserAccessToken(ctx context.Context, userID int32, token s
 	return err
 }
 
+// GetUserAccessTokenUsages returns the usage of the access tokens of the user, including the requests not flushed yet.
+func (s *Store) GetUserAccessTokenUsages(ctx context.Context, userID int32) ([]*storepb.AccessTokenUsagesUserSetting_Usage, error) {
+	usages, err := s.getStoredUserAccessTokenUsages(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	s.pendingAccessTokenUsagesMutex.Lock()
+	pendingUsages := s.pendingAccessTokenUsages[userID]
+	if len(pendingUsages) > 0 {
+		usages = mergeAccessTokenUsages(cloneAccessTokenUsages(usages), pendingUsages)
+	}
+	s.pendingAccessTokenUsagesMutex.Unlock()
+	return usages, nil
+}
+
+func (s *Store) getStoredUserAccessTokenUsages(ctx context.Context, userID int32) ([]*storepb.AccessTokenUsagesUserSetting_Usage, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_ACCESS_TOKEN_USAGES,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return []*storepb.AccessTokenUsagesUserSetting_Usage{}, nil
+	}
+	return userSetting.GetAccessTokenUsages().Usages, nil
+}
+
+// IncrementUserAccessTokenUsage counts a request authenticated with the access token of the user, from the IP address.
+// The requests are counted in memory, as the access tokens authenticate every request of the scripts using them,
+// and stored by FlushUserAccessTokenUsages.
+func (s *Store) IncrementUserAccessTokenUsage(userID int32, token string, usedTime *timestamppb.Timestamp, usedIP string) {
+	s.pendingAccessTokenUsagesMutex.Lock()
+	defer s.pendingAccessTokenUsagesMutex.Unlock()
+
+	if s.pendingAccessTokenUsages == nil {
+		s.pendingAccessTokenUsages = map[int32][]*storepb.AccessTokenUsagesUserSetting_Usage{}
+	}
+	s.pendingAccessTokenUsages[userID] = mergeAccessTokenUsages(s.pendingAccessTokenUsages[userID], []*storepb.AccessTokenUsagesUserSetting_Usage{
+		{
+			AccessToken:  token,
+			RequestCount: 1,
+			LastUsedTime: usedTime,
+			LastUsedIp:   usedIP,
+		},
+	})
+}
+
+// FlushUserAccessTokenUsages stores the requests counted since the last flush.
+// The usages of the users failing to be stored are kept to be flushed again.
+func (s *Store) FlushUserAccessTokenUsages(ctx context.Context) error {
+	s.pendingAccessTokenUsagesMutex.Lock()
+	pendingAccessTokenUsages := s.pendingAccessTokenUsages
+	s.pendingAccessTokenUsages = nil
+	s.pendingAccessTokenUsagesMutex.Unlock()
+
+	var flushErr error
+	for userID, pendingUsages := range pendingAccessTokenUsages {
+		err := s.updateUserAccessTokenUsages(ctx, userID, func(usages []*storepb.AccessTokenUsagesUserSetting_Usage) []*storepb.AccessTokenUsagesUserSetting_Usage {
+			return mergeAccessTokenUsages(usages, pendingUsages)
+		})
+		if err != nil {
+			flushErr = errors.Wrapf(err, "failed to store the access token usages of user %d", userID)
+			s.pendingAccessTokenUsagesMutex.Lock()
+			if s.pendingAccessTokenUsages == nil {
+				s.pendingAccessTokenUsages = map[int32][]*storepb.AccessTokenUsagesUserSetting_Usage{}
+			}
+			s.pendingAccessTokenUsages[userID] = mergeAccessTokenUsages(pendingUsages, s.pendingAccessTokenUsages[userID])
+			s.pendingAccessTokenUsagesMutex.Unlock()
+		}
+	}
+	return flushErr
+}
+
+// RemoveUserAccessTokenUsage removes the usage of the access token of the user.
+func (s *Store) RemoveUserAccessTokenUsage(ctx context.Context, userID int32, token string) error {
+	s.pendingAccessTokenUsagesMutex.Lock()
+	if pendingUsages, ok := s.pendingAccessTokenUsages[userID]; ok {
+		s.pendingAccessTokenUsages[userID] = slices.DeleteFunc(pendingUsages, func(usage *storepb.AccessTokenUsagesUserSetting_Usage) bool {
+			return usage.AccessToken == token
+		})
+	}
+	s.pendingAccessTokenUsagesMutex.Unlock()
+
+	return s.updateUserAccessTokenUsages(ctx, userID, func(usages []*storepb.AccessTokenUsagesUserSetting_Usage) []*storepb.AccessTokenUsagesUserSetting_Usage {
+		return slices.DeleteFunc(usages, func(usage *storepb.AccessTokenUsagesUserSetting_Usage) bool {
+			return usage.AccessToken == token
+		})
+	})
+}
+
+// updateUserAccessTokenUsages serializes the updates of the usages, so that concurrent updates are all stored.
+func (s *Store) updateUserAccessTokenUsages(ctx context.Context, userID int32, update func([]*storepb.AccessTokenUsagesUserSetting_Usage) []*storepb.AccessTokenUsagesUserSetting_Usage) error {
+	s.accessTokenUsagesMutex.Lock()
+	defer s.accessTokenUsagesMutex.Unlock()
+
+	usages, err := s.getStoredUserAccessTokenUsages(ctx, userID)
+	if err != nil {
+		return err
+	}
+	// The usages are cloned, as the cached setting must not change before it is stored.
+	_, err = s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_ACCESS_TOKEN_USAGES,
+		Value: &storepb.UserSetting_AccessTokenUsages{
+			AccessTokenUsages: &storepb.AccessTokenUsagesUserSetting{
+				Usages: update(cloneAccessTokenUsages(usages)),
+			},
+		},
+	})
+	return err
+}
+
+func cloneAccessTokenUsages(usages []*storepb.AccessTokenUsagesUserSetting_Usage) []*storepb.AccessTokenUsagesUserSetting_Usage {
+	clonedUsages := make([]*storepb.AccessTokenUsagesUserSetting_Usage, 0, len(usages))
+	for _, usage := range usages {
+		clonedUsages = append(clonedUsages, proto.Clone(usage).(*storepb.AccessTokenUsagesUserSetting_Usage))
+	}
+	return clonedUsages
+}
+
+// mergeAccessTokenUsages adds the request counts of the new usages to the usages, whose last use is taken from the new ones.
+func mergeAccessTokenUsages(usages, newUsages []*storepb.AccessTokenUsagesUserSetting_Usage) []*storepb.AccessTokenUsagesUserSetting_Usage {
+	for _, newUsage := range newUsages {
+		index := slices.IndexFunc(usages, func(usage *storepb.AccessTokenUsagesUserSetting_Usage) bool {
+			return usage.AccessToken == newUsage.AccessToken
+		})
+		if index < 0 {
+			usages = append(usages, proto.Clone(newUsage).(*storepb.AccessTokenUsagesUserSetting_Usage))
+			continue
+		}
+		usages[index].RequestCount += newUsage.RequestCount
+		usages[index].LastUsedTime = newUsage.LastUsedTime
+		usages[index].LastUsedIp = newUsage.LastUsedIp
+	}
+	return usages
+}
+
 // GetUserSessions returns the sessions of the user.
 func (s *Store) GetUserSessions(ctx context.Context, userID int32) ([]*storepb.SessionsUserSetting_Session, error) {
 	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
@@ -150,6 +291,20 @@ func (s *Store) GetUserSessions(ctx context.Context, userID int32) ([]*storepb.S
 	return sessionsUserSetting.Sessions, nil
 }
 
+// GetUserSession returns the session of the user, or nil if it does not exist.
+func (s *Store) GetUserSession(ctx context.Context, userID int32, sessionID string) (*storepb.SessionsUserSetting_Session, error) {
+	sessions, err := s.GetUserSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, session := range sessions {
+		if session.SessionId == sessionID {
+			return session, nil
+		}
+	}
+	return nil, nil
+}
+
 // RemoveUserSession removes the session of the user.
 func (s *Store) RemoveUserSession(ctx context.Context, userID int32, sessionID string) error {
 	oldSessions, err := s.GetUserSessions(ctx, userID)
@@ -177,6 +332,18 @@ func (s *Store) RemoveUserSession(ctx context.Context, userID int32, sessionID s
 	return err
 }
 
+// RemoveAllUserSessions signs the user out of every session.
+func (s *Store) RemoveAllUserSessions(ctx context.Context, userID int32) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_SESSIONS,
+		Value: &storepb.UserSetting_Sessions{
+			Sessions: &storepb.SessionsUserSetting{},
+		},
+	})
+	return err
+}
+
 // AddUserSession adds a new session for the user.
 func (s *Store) AddUserSession(ctx context.Context, userID int32, session *storepb.SessionsUserSetting_Session) error {
 	existingSessions, err := s.GetUserSessions(ctx, userID)
@@ -349,6 +516,534 @@ func (s *Store) UpdateUserWebhook(ctx context.Context, userID int32, webhook *st
 	return err
 }
 
+// GetUserTagMetadata returns the tag metadata of the user.
+func (s *Store) GetUserTagMetadata(ctx context.Context, userID int32) ([]*storepb.TagsUserSetting_Tag, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_TAGS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return []*storepb.TagsUserSetting_Tag{}, nil
+	}
+
+	tagsUserSetting := userSetting.GetTags()
+	return tagsUserSetting.Tags, nil
+}
+
+// UpsertUserTagMetadata replaces the tag metadata of the user.
+func (s *Store) UpsertUserTagMetadata(ctx context.Context, userID int32, tags []*storepb.TagsUserSetting_Tag) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_TAGS,
+		Value: &storepb.UserSetting_Tags{
+			Tags: &storepb.TagsUserSetting{
+				Tags: tags,
+			},
+		},
+	})
+	return err
+}
+
+// GetUserSavedSearches returns the saved searches of the user.
+func (s *Store) GetUserSavedSearches(ctx context.Context, userID int32) ([]*storepb.SavedSearchesUserSetting_SavedSearch, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_SAVED_SEARCHES,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return []*storepb.SavedSearchesUserSetting_SavedSearch{}, nil
+	}
+
+	savedSearchesUserSetting := userSetting.GetSavedSearches()
+	return savedSearchesUserSetting.SavedSearches, nil
+}
+
+// UpsertUserSavedSearches replaces the saved searches of the user.
+func (s *Store) UpsertUserSavedSearches(ctx context.Context, userID int32, savedSearches []*storepb.SavedSearchesUserSetting_SavedSearch) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_SAVED_SEARCHES,
+		Value: &storepb.UserSetting_SavedSearches{
+			SavedSearches: &storepb.SavedSearchesUserSetting{
+				SavedSearches: savedSearches,
+			},
+		},
+	})
+	return err
+}
+
+// GetUserFilterMacros returns the filter macros of the user.
+func (s *Store) GetUserFilterMacros(ctx context.Context, userID int32) ([]*storepb.FilterMacrosUserSetting_FilterMacro, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_FILTER_MACROS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return []*storepb.FilterMacrosUserSetting_FilterMacro{}, nil
+	}
+
+	filterMacrosUserSetting := userSetting.GetFilterMacros()
+	return filterMacrosUserSetting.FilterMacros, nil
+}
+
+// UpsertUserFilterMacros replaces the filter macros of the user.
+func (s *Store) UpsertUserFilterMacros(ctx context.Context, userID int32, filterMacros []*storepb.FilterMacrosUserSetting_FilterMacro) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_FILTER_MACROS,
+		Value: &storepb.UserSetting_FilterMacros{
+			FilterMacros: &storepb.FilterMacrosUserSetting{
+				FilterMacros: filterMacros,
+			},
+		},
+	})
+	return err
+}
+
+// GetUserTwoFactor returns the two-factor authentication of the user, empty if it has never been set up.
+func (s *Store) GetUserTwoFactor(ctx context.Context, userID int32) (*storepb.TwoFactorUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_TWO_FACTOR,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.TwoFactorUserSetting{}, nil
+	}
+	return userSetting.GetTwoFactor(), nil
+}
+
+// UpsertUserTwoFactor replaces the two-factor authentication of the user.
+func (s *Store) UpsertUserTwoFactor(ctx context.Context, userID int32, twoFactor *storepb.TwoFactorUserSetting) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_TWO_FACTOR,
+		Value: &storepb.UserSetting_TwoFactor{
+			TwoFactor: twoFactor,
+		},
+	})
+	return err
+}
+
+// GetUserSuspension returns the suspension of the user, empty if the user has never been suspended.
+func (s *Store) GetUserSuspension(ctx context.Context, userID int32) (*storepb.SuspensionUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_SUSPENSION,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.SuspensionUserSetting{}, nil
+	}
+	return userSetting.GetSuspension(), nil
+}
+
+// UpsertUserSuspension replaces the suspension of the user.
+func (s *Store) UpsertUserSuspension(ctx context.Context, userID int32, suspension *storepb.SuspensionUserSetting) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_SUSPENSION,
+		Value: &storepb.UserSetting_Suspension{
+			Suspension: suspension,
+		},
+	})
+	return err
+}
+
+// GetUserPassword returns the password state of the user, empty if never set.
+func (s *Store) GetUserPassword(ctx context.Context, userID int32) (*storepb.PasswordUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_PASSWORD,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.PasswordUserSetting{}, nil
+	}
+	return userSetting.GetPassword(), nil
+}
+
+// UpsertUserPassword replaces the password state of the user.
+func (s *Store) UpsertUserPassword(ctx context.Context, userID int32, password *storepb.PasswordUserSetting) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_PASSWORD,
+		Value: &storepb.UserSetting_Password{
+			Password: password,
+		},
+	})
+	return err
+}
+
+// GetUserEmailVerification returns the email verification of the user, empty if the user never had to verify their email.
+func (s *Store) GetUserEmailVerification(ctx context.Context, userID int32) (*storepb.EmailVerificationUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_EMAIL_VERIFICATION,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.EmailVerificationUserSetting{}, nil
+	}
+	return userSetting.GetEmailVerification(), nil
+}
+
+// UpsertUserEmailVerification replaces the email verification of the user.
+func (s *Store) UpsertUserEmailVerification(ctx context.Context, userID int32, emailVerification *storepb.EmailVerificationUserSetting) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_EMAIL_VERIFICATION,
+		Value: &storepb.UserSetting_EmailVerification{
+			EmailVerification: emailVerification,
+		},
+	})
+	return err
+}
+
+// GetUserCustomRole returns the custom role of the user, empty if the user has none.
+func (s *Store) GetUserCustomRole(ctx context.Context, userID int32) (*storepb.CustomRoleUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_CUSTOM_ROLE,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.CustomRoleUserSetting{}, nil
+	}
+	return userSetting.GetCustomRole(), nil
+}
+
+// UpsertUserCustomRole replaces the custom role of the user.
+func (s *Store) UpsertUserCustomRole(ctx context.Context, userID int32, customRole *storepb.CustomRoleUserSetting) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_CUSTOM_ROLE,
+		Value: &storepb.UserSetting_CustomRole{
+			CustomRole: customRole,
+		},
+	})
+	return err
+}
+
+// GetUserMemoEmail returns the address receiving the memos emailed by the user, empty if the user has none.
+func (s *Store) GetUserMemoEmail(ctx context.Context, userID int32) (*storepb.MemoEmailUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_MEMO_EMAIL,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.MemoEmailUserSetting{}, nil
+	}
+	return userSetting.GetMemoEmail(), nil
+}
+
+// UpsertUserMemoEmail replaces the address receiving the memos emailed by the user.
+func (s *Store) UpsertUserMemoEmail(ctx context.Context, userID int32, memoEmail *storepb.MemoEmailUserSetting) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_MEMO_EMAIL,
+		Value: &storepb.UserSetting_MemoEmail{
+			MemoEmail: memoEmail,
+		},
+	})
+	return err
+}
+
+// GetUserSlack returns the Slack account linked to the user, empty if the user has none.
+func (s *Store) GetUserSlack(ctx context.Context, userID int32) (*storepb.SlackUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_SLACK,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.SlackUserSetting{}, nil
+	}
+	return userSetting.GetSlack(), nil
+}
+
+// UpsertUserSlack replaces the Slack account linked to the user.
+func (s *Store) UpsertUserSlack(ctx context.Context, userID int32, slack *storepb.SlackUserSetting) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_SLACK,
+		Value: &storepb.UserSetting_Slack{
+			Slack: slack,
+		},
+	})
+	return err
+}
+
+// GetUserDiscord returns the Discord account linked to the user, empty if the user has none.
+func (s *Store) GetUserDiscord(ctx context.Context, userID int32) (*storepb.DiscordUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_DISCORD,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.DiscordUserSetting{}, nil
+	}
+	return userSetting.GetDiscord(), nil
+}
+
+// UpsertUserDiscord replaces the Discord account linked to the user.
+func (s *Store) UpsertUserDiscord(ctx context.Context, userID int32, discord *storepb.DiscordUserSetting) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_DISCORD,
+		Value: &storepb.UserSetting_Discord{
+			Discord: discord,
+		},
+	})
+	return err
+}
+
+// GetUserCalendarFeed returns the token of the calendar feed of the user, empty if the user has none.
+func (s *Store) GetUserCalendarFeed(ctx context.Context, userID int32) (*storepb.CalendarFeedUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_CALENDAR_FEED,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.CalendarFeedUserSetting{}, nil
+	}
+	return userSetting.GetCalendarFeed(), nil
+}
+
+// UpsertUserCalendarFeed replaces the token of the calendar feed of the user.
+func (s *Store) UpsertUserCalendarFeed(ctx context.Context, userID int32, calendarFeed *storepb.CalendarFeedUserSetting) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_CALENDAR_FEED,
+		Value: &storepb.UserSetting_CalendarFeed{
+			CalendarFeed: calendarFeed,
+		},
+	})
+	return err
+}
+
+// GetUserReadwise returns the Readwise account of the user, empty if the user has none.
+func (s *Store) GetUserReadwise(ctx context.Context, userID int32) (*storepb.ReadwiseUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_READWISE,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.ReadwiseUserSetting{}, nil
+	}
+	return userSetting.GetReadwise(), nil
+}
+
+// UpsertUserReadwise replaces the Readwise account of the user.
+func (s *Store) UpsertUserReadwise(ctx context.Context, userID int32, readwise *storepb.ReadwiseUserSetting) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_READWISE,
+		Value: &storepb.UserSetting_Readwise{
+			Readwise: readwise,
+		},
+	})
+	return err
+}
+
+// GetUserWebPushSubscriptions returns the Web Push subscriptions of the browsers of the user.
+func (s *Store) GetUserWebPushSubscriptions(ctx context.Context, userID int32) ([]*storepb.WebPushSubscriptionsUserSetting_Subscription, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_WEB_PUSH_SUBSCRIPTIONS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return []*storepb.WebPushSubscriptionsUserSetting_Subscription{}, nil
+	}
+	return userSetting.GetWebPushSubscriptions().Subscriptions, nil
+}
+
+// UpsertUserWebPushSubscriptions replaces the Web Push subscriptions of the browsers of the user.
+func (s *Store) UpsertUserWebPushSubscriptions(ctx context.Context, userID int32, subscriptions []*storepb.WebPushSubscriptionsUserSetting_Subscription) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_WEB_PUSH_SUBSCRIPTIONS,
+		Value: &storepb.UserSetting_WebPushSubscriptions{
+			WebPushSubscriptions: &storepb.WebPushSubscriptionsUserSetting{
+				Subscriptions: subscriptions,
+			},
+		},
+	})
+	return err
+}
+
+// GetUserGistSync returns the GitHub account syncing the gists of the user, empty if the user has none.
+func (s *Store) GetUserGistSync(ctx context.Context, userID int32) (*storepb.GistSyncUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_GIST_SYNC,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.GistSyncUserSetting{}, nil
+	}
+	return userSetting.GetGistSync(), nil
+}
+
+// UpsertUserGistSync replaces the GitHub account syncing the gists of the user.
+func (s *Store) UpsertUserGistSync(ctx context.Context, userID int32, gistSync *storepb.GistSyncUserSetting) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_GIST_SYNC,
+		Value: &storepb.UserSetting_GistSync{
+			GistSync: gistSync,
+		},
+	})
+	return err
+}
+
+// GetUserNotificationPreferences returns the preferences of every event notifying the user, the events the user has
+// not set notifying with the inbox and the push but not the email.
+func (s *Store) GetUserNotificationPreferences(ctx context.Context, userID int32) ([]*storepb.NotificationPreferencesUserSetting_Preference, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_NOTIFICATION_PREFERENCES,
+	})
+	if err != nil {
+		return nil, err
+	}
+	preferences := []*storepb.NotificationPreferencesUserSetting_Preference{}
+	for event := storepb.NotificationPreferencesUserSetting_COMMENT; event <= storepb.NotificationPreferencesUserSetting_ON_THIS_DAY; event++ {
+		preference := &storepb.NotificationPreferencesUserSetting_Preference{
+			Event: event,
+			Inbox: true,
+			Push:  true,
+		}
+		for _, value := range userSetting.GetNotificationPreferences().GetPreferences() {
+			if value.Event == event {
+				preference = value
+			}
+		}
+		preferences = append(preferences, preference)
+	}
+	return preferences, nil
+}
+
+// GetUserNotificationPreference returns the preference of the event notifying the user.
+func (s *Store) GetUserNotificationPreference(ctx context.Context, userID int32, event storepb.NotificationPreferencesUserSetting_Event) (*storepb.NotificationPreferencesUserSetting_Preference, error) {
+	preferences, err := s.GetUserNotificationPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, preference := range preferences {
+		if preference.Event == event {
+			return preference, nil
+		}
+	}
+	return nil, errors.Errorf("unknown notification event: %v", event)
+}
+
+// UpsertUserNotificationPreferences replaces the preferences of the events notifying the user.
+func (s *Store) UpsertUserNotificationPreferences(ctx context.Context, userID int32, preferences []*storepb.NotificationPreferencesUserSetting_Preference) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_NOTIFICATION_PREFERENCES,
+		Value: &storepb.UserSetting_NotificationPreferences{
+			NotificationPreferences: &storepb.NotificationPreferencesUserSetting{
+				Preferences: preferences,
+			},
+		},
+	})
+	return err
+}
+
+// GetUserEmailDigest returns the schedule of the email digest of the user, disabled if the user has none.
+func (s *Store) GetUserEmailDigest(ctx context.Context, userID int32) (*storepb.EmailDigestUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_EMAIL_DIGEST,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.EmailDigestUserSetting{}, nil
+	}
+	return userSetting.GetEmailDigest(), nil
+}
+
+// UpsertUserEmailDigest replaces the schedule of the email digest of the user.
+func (s *Store) UpsertUserEmailDigest(ctx context.Context, userID int32, emailDigest *storepb.EmailDigestUserSetting) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_EMAIL_DIGEST,
+		Value: &storepb.UserSetting_EmailDigest{
+			EmailDigest: emailDigest,
+		},
+	})
+	return err
+}
+
+// GetUserOnThisDay returns the schedule of the on this day notification of the user, disabled if the user has none.
+func (s *Store) GetUserOnThisDay(ctx context.Context, userID int32) (*storepb.OnThisDayUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSetting_ON_THIS_DAY,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.OnThisDayUserSetting{}, nil
+	}
+	return userSetting.GetOnThisDay(), nil
+}
+
+// UpsertUserOnThisDay replaces the schedule of the on this day notification of the user.
+func (s *Store) UpsertUserOnThisDay(ctx context.Context, userID int32, onThisDay *storepb.OnThisDayUserSetting) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSetting_ON_THIS_DAY,
+		Value: &storepb.UserSetting_OnThisDay{
+			OnThisDay: onThisDay,
+		},
+	})
+	return err
+}
+
 func convertUserSettingFromRaw(raw *UserSetting) (*storepb.UserSetting, error) {
 	userSetting := &storepb.UserSetting{
 		UserId: raw.UserID,
@@ -386,6 +1081,120 @@ func convertUserSettingFromRaw(raw *UserSetting) (*storepb.UserSetting, error) {
 			return nil, err
 		}
 		userSetting.Value = &storepb.UserSetting_Webhooks{Webhooks: webhooksUserSetting}
+	case storepb.UserSetting_TAGS:
+		tagsUserSetting := &storepb.TagsUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), tagsUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Tags{Tags: tagsUserSetting}
+	case storepb.UserSetting_SAVED_SEARCHES:
+		savedSearchesUserSetting := &storepb.SavedSearchesUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), savedSearchesUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_SavedSearches{SavedSearches: savedSearchesUserSetting}
+	case storepb.UserSetting_FILTER_MACROS:
+		filterMacrosUserSetting := &storepb.FilterMacrosUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), filterMacrosUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_FilterMacros{FilterMacros: filterMacrosUserSetting}
+	case storepb.UserSetting_TWO_FACTOR:
+		twoFactorUserSetting := &storepb.TwoFactorUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), twoFactorUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_TwoFactor{TwoFactor: twoFactorUserSetting}
+	case storepb.UserSetting_ACCESS_TOKEN_USAGES:
+		accessTokenUsagesUserSetting := &storepb.AccessTokenUsagesUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), accessTokenUsagesUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_AccessTokenUsages{AccessTokenUsages: accessTokenUsagesUserSetting}
+	case storepb.UserSetting_SUSPENSION:
+		suspensionUserSetting := &storepb.SuspensionUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), suspensionUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Suspension{Suspension: suspensionUserSetting}
+	case storepb.UserSetting_PASSWORD:
+		passwordUserSetting := &storepb.PasswordUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), passwordUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Password{Password: passwordUserSetting}
+	case storepb.UserSetting_EMAIL_VERIFICATION:
+		emailVerificationUserSetting := &storepb.EmailVerificationUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), emailVerificationUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_EmailVerification{EmailVerification: emailVerificationUserSetting}
+	case storepb.UserSetting_CUSTOM_ROLE:
+		customRoleUserSetting := &storepb.CustomRoleUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), customRoleUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_CustomRole{CustomRole: customRoleUserSetting}
+	case storepb.UserSetting_MEMO_EMAIL:
+		memoEmailUserSetting := &storepb.MemoEmailUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), memoEmailUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_MemoEmail{MemoEmail: memoEmailUserSetting}
+	case storepb.UserSetting_SLACK:
+		slackUserSetting := &storepb.SlackUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), slackUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Slack{Slack: slackUserSetting}
+	case storepb.UserSetting_DISCORD:
+		discordUserSetting := &storepb.DiscordUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), discordUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Discord{Discord: discordUserSetting}
+	case storepb.UserSetting_CALENDAR_FEED:
+		calendarFeedUserSetting := &storepb.CalendarFeedUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), calendarFeedUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_CalendarFeed{CalendarFeed: calendarFeedUserSetting}
+	case storepb.UserSetting_READWISE:
+		readwiseUserSetting := &storepb.ReadwiseUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), readwiseUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_Readwise{Readwise: readwiseUserSetting}
+	case storepb.UserSetting_WEB_PUSH_SUBSCRIPTIONS:
+		webPushSubscriptionsUserSetting := &storepb.WebPushSubscriptionsUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), webPushSubscriptionsUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_WebPushSubscriptions{WebPushSubscriptions: webPushSubscriptionsUserSetting}
+	case storepb.UserSetting_GIST_SYNC:
+		gistSyncUserSetting := &storepb.GistSyncUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), gistSyncUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_GistSync{GistSync: gistSyncUserSetting}
+	case storepb.UserSetting_NOTIFICATION_PREFERENCES:
+		notificationPreferencesUserSetting := &storepb.NotificationPreferencesUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), notificationPreferencesUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_NotificationPreferences{NotificationPreferences: notificationPreferencesUserSetting}
+	case storepb.UserSetting_EMAIL_DIGEST:
+		emailDigestUserSetting := &storepb.EmailDigestUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), emailDigestUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_EmailDigest{EmailDigest: emailDigestUserSetting}
+	case storepb.UserSetting_ON_THIS_DAY:
+		onThisDayUserSetting := &storepb.OnThisDayUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), onThisDayUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_OnThisDay{OnThisDay: onThisDayUserSetting}
 	default:
 		return nil, nil
 	}
@@ -434,6 +1243,139 @@ func convertUserSettingToRaw(userSetting *storepb.UserSetting) (*UserSetting, er
 			return nil, err
 		}
 		raw.Value = string(value)
+	case storepb.UserSetting_TAGS:
+		tagsUserSetting := userSetting.GetTags()
+		value, err := protojson.Marshal(tagsUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_SAVED_SEARCHES:
+		savedSearchesUserSetting := userSetting.GetSavedSearches()
+		value, err := protojson.Marshal(savedSearchesUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_FILTER_MACROS:
+		filterMacrosUserSetting := userSetting.GetFilterMacros()
+		value, err := protojson.Marshal(filterMacrosUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_TWO_FACTOR:
+		twoFactorUserSetting := userSetting.GetTwoFactor()
+		value, err := protojson.Marshal(twoFactorUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_ACCESS_TOKEN_USAGES:
+		accessTokenUsagesUserSetting := userSetting.GetAccessTokenUsages()
+		value, err := protojson.Marshal(accessTokenUsagesUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_SUSPENSION:
+		suspensionUserSetting := userSetting.GetSuspension()
+		value, err := protojson.Marshal(suspensionUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_PASSWORD:
+		passwordUserSetting := userSetting.GetPassword()
+		value, err := protojson.Marshal(passwordUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_EMAIL_VERIFICATION:
+		emailVerificationUserSetting := userSetting.GetEmailVerification()
+		value, err := protojson.Marshal(emailVerificationUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_CUSTOM_ROLE:
+		customRoleUserSetting := userSetting.GetCustomRole()
+		value, err := protojson.Marshal(customRoleUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_MEMO_EMAIL:
+		memoEmailUserSetting := userSetting.GetMemoEmail()
+		value, err := protojson.Marshal(memoEmailUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_SLACK:
+		slackUserSetting := userSetting.GetSlack()
+		value, err := protojson.Marshal(slackUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_DISCORD:
+		discordUserSetting := userSetting.GetDiscord()
+		value, err := protojson.Marshal(discordUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_CALENDAR_FEED:
+		calendarFeedUserSetting := userSetting.GetCalendarFeed()
+		value, err := protojson.Marshal(calendarFeedUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_READWISE:
+		readwiseUserSetting := userSetting.GetReadwise()
+		value, err := protojson.Marshal(readwiseUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_WEB_PUSH_SUBSCRIPTIONS:
+		webPushSubscriptionsUserSetting := userSetting.GetWebPushSubscriptions()
+		value, err := protojson.Marshal(webPushSubscriptionsUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_GIST_SYNC:
+		gistSyncUserSetting := userSetting.GetGistSync()
+		value, err := protojson.Marshal(gistSyncUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_NOTIFICATION_PREFERENCES:
+		notificationPreferencesUserSetting := userSetting.GetNotificationPreferences()
+		value, err := protojson.Marshal(notificationPreferencesUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_EMAIL_DIGEST:
+		emailDigestUserSetting := userSetting.GetEmailDigest()
+		value, err := protojson.Marshal(emailDigestUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSetting_ON_THIS_DAY:
+		onThisDayUserSetting := userSetting.GetOnThisDay()
+		value, err := protojson.Marshal(onThisDayUserSetting)
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
 	default:
 		return nil, errors.Errorf("unsupported user setting key: %v", userSetting.Key)
 	}
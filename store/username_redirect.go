@@ -0,0 +1,62 @@
+package store
+
+import (
+	"context"
+)
+
+// UsernameRedirect maps a username a user previously had to that user,
+// so links using the old handle keep working after a rename.
+type UsernameRedirect struct {
+	// Username is the old username.
+	Username  string
+	UserID    int32
+	CreatedTs int64
+}
+
+type FindUsernameRedirect struct {
+	Username *string
+	UserID   *int32
+}
+
+type DeleteUsernameRedirect struct {
+	Username string
+}
+
+func (s *Store) UpsertUsernameRedirect(ctx context.Context, upsert *UsernameRedirect) (*UsernameRedirect, error) {
+	return s.driver.UpsertUsernameRedirect(ctx, upsert)
+}
+
+func (s *Store) ListUsernameRedirects(ctx context.Context, find *FindUsernameRedirect) ([]*UsernameRedirect, error) {
+	return s.driver.ListUsernameRedirects(ctx, find)
+}
+
+func (s *Store) GetUsernameRedirect(ctx context.Context, find *FindUsernameRedirect) (*UsernameRedirect, error) {
+	list, err := s.ListUsernameRedirects(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteUsernameRedirect(ctx context.Context, delete *DeleteUsernameRedirect) error {
+	return s.driver.DeleteUsernameRedirect(ctx, delete)
+}
+
+// GetUserByRedirectedUsername returns the user who previously used the given username, if any.
+func (s *Store) GetUserByRedirectedUsername(ctx context.Context, username string) (*User, error) {
+	redirect, err := s.GetUsernameRedirect(ctx, &FindUsernameRedirect{
+		Username: &username,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if redirect == nil {
+		return nil, nil
+	}
+	return s.GetUser(ctx, &FindUser{
+		ID: &redirect.UserID,
+	})
+}
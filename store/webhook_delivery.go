@@ -0,0 +1,104 @@
+package store
+
+import (
+	"context"
+)
+
+// WebhookDeliveryStatus is the status of a webhook delivery.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending is the status of the deliveries to be attempted at their next attempt time.
+	WebhookDeliveryPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	// WebhookDeliveryDead is the status of the dead-lettered deliveries, which are no longer attempted.
+	WebhookDeliveryDead WebhookDeliveryStatus = "DEAD"
+)
+
+func (s WebhookDeliveryStatus) String() string {
+	return string(s)
+}
+
+// WebhookDelivery is a delivery of an activity to a webhook of the creator, kept until it succeeds or is dead-lettered,
+// so that the failed deliveries are attempted again.
+type WebhookDelivery struct {
+	ID           int32
+	CreatedTs    int64
+	CreatorID    int32
+	WebhookID    string
+	ActivityType string
+	// Payload is the JSON body posted to the webhook.
+	Payload       string
+	Status        WebhookDeliveryStatus
+	AttemptCount  int32
+	NextAttemptTs int64
+	LastError     string
+
+	// The result of the last attempt, kept to debug the endpoint.
+	LastAttemptTs int64
+	// ResponseStatusCode is zero if the endpoint did not respond.
+	ResponseStatusCode int32
+	// ResponseBody is the beginning of the response body.
+	ResponseBody string
+	LatencyMs    int32
+}
+
+type FindWebhookDelivery struct {
+	ID        *int32
+	CreatorID *int32
+	WebhookID *string
+	Status    *WebhookDeliveryStatus
+	// NextAttemptTsBefore finds the deliveries whose next attempt is due by the time.
+	NextAttemptTsBefore *int64
+
+	// Pagination
+	Limit  *int
+	Offset *int
+}
+
+type UpdateWebhookDelivery struct {
+	ID                 int32
+	Status             *WebhookDeliveryStatus
+	AttemptCount       *int32
+	NextAttemptTs      *int64
+	LastError          *string
+	LastAttemptTs      *int64
+	ResponseStatusCode *int32
+	ResponseBody       *string
+	LatencyMs          *int32
+}
+
+type DeleteWebhookDelivery struct {
+	CreatorID       *int32
+	WebhookID       *string
+	Status          *WebhookDeliveryStatus
+	CreatedTsBefore *int64
+}
+
+func (s *Store) CreateWebhookDelivery(ctx context.Context, create *WebhookDelivery) (*WebhookDelivery, error) {
+	return s.driver.CreateWebhookDelivery(ctx, create)
+}
+
+// ListWebhookDeliveries returns the deliveries, the latest first.
+func (s *Store) ListWebhookDeliveries(ctx context.Context, find *FindWebhookDelivery) ([]*WebhookDelivery, error) {
+	return s.driver.ListWebhookDeliveries(ctx, find)
+}
+
+func (s *Store) GetWebhookDelivery(ctx context.Context, find *FindWebhookDelivery) (*WebhookDelivery, error) {
+	list, err := s.ListWebhookDeliveries(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateWebhookDelivery(ctx context.Context, update *UpdateWebhookDelivery) error {
+	return s.driver.UpdateWebhookDelivery(ctx, update)
+}
+
+func (s *Store) DeleteWebhookDeliveries(ctx context.Context, delete *DeleteWebhookDelivery) error {
+	return s.driver.DeleteWebhookDeliveries(ctx, delete)
+}
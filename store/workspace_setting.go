@@ -37,6 +37,30 @@ func (s *Store) UpsertWorkspaceSetting(ctx context.Context, upsert *storepb.Work
 		valueBytes, err = protojson.Marshal(upsert.GetStorageSetting())
 	} else if upsert.Key == storepb.WorkspaceSettingKey_MEMO_RELATED {
 		valueBytes, err = protojson.Marshal(upsert.GetMemoRelatedSetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_EMBEDDING {
+		valueBytes, err = protojson.Marshal(upsert.GetEmbeddingSetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_OCR {
+		valueBytes, err = protojson.Marshal(upsert.GetOcrSetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_MALWARE_SCAN {
+		valueBytes, err = protojson.Marshal(upsert.GetMalwareScanSetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_TRANSCRIPTION {
+		valueBytes, err = protojson.Marshal(upsert.GetTranscriptionSetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_SCIM {
+		valueBytes, err = protojson.Marshal(upsert.GetScimSetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_PASSWORD_POLICY {
+		valueBytes, err = protojson.Marshal(upsert.GetPasswordPolicySetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_EMAIL {
+		valueBytes, err = protojson.Marshal(upsert.GetEmailSetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_ROLES {
+		valueBytes, err = protojson.Marshal(upsert.GetRolesSetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_ACCESS_TOKEN_POLICY {
+		valueBytes, err = protojson.Marshal(upsert.GetAccessTokenPolicySetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_CAPTCHA {
+		valueBytes, err = protojson.Marshal(upsert.GetCaptchaSetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_SLACK {
+		valueBytes, err = protojson.Marshal(upsert.GetSlackSetting())
+	} else if upsert.Key == storepb.WorkspaceSettingKey_DISCORD {
+		valueBytes, err = protojson.Marshal(upsert.GetDiscordSetting())
 	} else {
 		return nil, errors.Errorf("unsupported workspace setting key: %v", upsert.Key)
 	}
@@ -54,6 +78,7 @@ func (s *Store) UpsertWorkspaceSetting(ctx context.Context, upsert *storepb.Work
 		return nil, errors.Wrap(err, "Failed to convert workspace setting")
 	}
 	s.workspaceSettingCache.Set(ctx, workspaceSetting.Key.String(), workspaceSetting)
+	s.invalidatePublicContent(ctx)
 	return workspaceSetting, nil
 }
 
@@ -208,6 +233,249 @@ func (s *Store) GetWorkspaceStorageSetting(ctx context.Context) (*storepb.Worksp
 	return workspaceStorageSetting, nil
 }
 
+func (s *Store) GetWorkspaceEmbeddingSetting(ctx context.Context) (*storepb.WorkspaceEmbeddingSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_EMBEDDING.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace embedding setting")
+	}
+
+	workspaceEmbeddingSetting := &storepb.WorkspaceEmbeddingSetting{}
+	if workspaceSetting != nil {
+		workspaceEmbeddingSetting = workspaceSetting.GetEmbeddingSetting()
+	}
+	if workspaceEmbeddingSetting.Provider == storepb.WorkspaceEmbeddingSetting_PROVIDER_UNSPECIFIED {
+		workspaceEmbeddingSetting.Provider = storepb.WorkspaceEmbeddingSetting_LOCAL
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_EMBEDDING.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_EMBEDDING,
+		Value: &storepb.WorkspaceSetting_EmbeddingSetting{EmbeddingSetting: workspaceEmbeddingSetting},
+	})
+	return workspaceEmbeddingSetting, nil
+}
+
+func (s *Store) GetWorkspaceOCRSetting(ctx context.Context) (*storepb.WorkspaceOCRSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_OCR.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace OCR setting")
+	}
+
+	workspaceOCRSetting := &storepb.WorkspaceOCRSetting{}
+	if workspaceSetting != nil {
+		workspaceOCRSetting = workspaceSetting.GetOcrSetting()
+	}
+	if workspaceOCRSetting.Provider == storepb.WorkspaceOCRSetting_PROVIDER_UNSPECIFIED {
+		workspaceOCRSetting.Provider = storepb.WorkspaceOCRSetting_TESSERACT
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_OCR.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_OCR,
+		Value: &storepb.WorkspaceSetting_OcrSetting{OcrSetting: workspaceOCRSetting},
+	})
+	return workspaceOCRSetting, nil
+}
+
+func (s *Store) GetWorkspaceMalwareScanSetting(ctx context.Context) (*storepb.WorkspaceMalwareScanSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_MALWARE_SCAN.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace malware scan setting")
+	}
+
+	workspaceMalwareScanSetting := &storepb.WorkspaceMalwareScanSetting{}
+	if workspaceSetting != nil {
+		workspaceMalwareScanSetting = workspaceSetting.GetMalwareScanSetting()
+	}
+	if workspaceMalwareScanSetting.Scanner == storepb.WorkspaceMalwareScanSetting_SCANNER_UNSPECIFIED {
+		workspaceMalwareScanSetting.Scanner = storepb.WorkspaceMalwareScanSetting_CLAMAV
+	}
+	if workspaceMalwareScanSetting.Action == storepb.WorkspaceMalwareScanSetting_ACTION_UNSPECIFIED {
+		workspaceMalwareScanSetting.Action = storepb.WorkspaceMalwareScanSetting_REJECT
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_MALWARE_SCAN.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_MALWARE_SCAN,
+		Value: &storepb.WorkspaceSetting_MalwareScanSetting{MalwareScanSetting: workspaceMalwareScanSetting},
+	})
+	return workspaceMalwareScanSetting, nil
+}
+
+func (s *Store) GetWorkspaceTranscriptionSetting(ctx context.Context) (*storepb.WorkspaceTranscriptionSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_TRANSCRIPTION.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace transcription setting")
+	}
+
+	workspaceTranscriptionSetting := &storepb.WorkspaceTranscriptionSetting{}
+	if workspaceSetting != nil {
+		workspaceTranscriptionSetting = workspaceSetting.GetTranscriptionSetting()
+	}
+	if workspaceTranscriptionSetting.Provider == storepb.WorkspaceTranscriptionSetting_PROVIDER_UNSPECIFIED {
+		workspaceTranscriptionSetting.Provider = storepb.WorkspaceTranscriptionSetting_WHISPER_CPP
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_TRANSCRIPTION.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_TRANSCRIPTION,
+		Value: &storepb.WorkspaceSetting_TranscriptionSetting{TranscriptionSetting: workspaceTranscriptionSetting},
+	})
+	return workspaceTranscriptionSetting, nil
+}
+
+func (s *Store) GetWorkspaceSCIMSetting(ctx context.Context) (*storepb.WorkspaceSCIMSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_SCIM.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace scim setting")
+	}
+
+	workspaceSCIMSetting := &storepb.WorkspaceSCIMSetting{}
+	if workspaceSetting != nil {
+		workspaceSCIMSetting = workspaceSetting.GetScimSetting()
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_SCIM.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_SCIM,
+		Value: &storepb.WorkspaceSetting_ScimSetting{ScimSetting: workspaceSCIMSetting},
+	})
+	return workspaceSCIMSetting, nil
+}
+
+func (s *Store) GetWorkspacePasswordPolicySetting(ctx context.Context) (*storepb.WorkspacePasswordPolicySetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_PASSWORD_POLICY.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace password policy setting")
+	}
+
+	workspacePasswordPolicySetting := &storepb.WorkspacePasswordPolicySetting{}
+	if workspaceSetting != nil {
+		workspacePasswordPolicySetting = workspaceSetting.GetPasswordPolicySetting()
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_PASSWORD_POLICY.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_PASSWORD_POLICY,
+		Value: &storepb.WorkspaceSetting_PasswordPolicySetting{PasswordPolicySetting: workspacePasswordPolicySetting},
+	})
+	return workspacePasswordPolicySetting, nil
+}
+
+func (s *Store) GetWorkspaceAccessTokenPolicySetting(ctx context.Context) (*storepb.WorkspaceAccessTokenPolicySetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_ACCESS_TOKEN_POLICY.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace access token policy setting")
+	}
+
+	workspaceAccessTokenPolicySetting := &storepb.WorkspaceAccessTokenPolicySetting{}
+	if workspaceSetting != nil {
+		workspaceAccessTokenPolicySetting = workspaceSetting.GetAccessTokenPolicySetting()
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_ACCESS_TOKEN_POLICY.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_ACCESS_TOKEN_POLICY,
+		Value: &storepb.WorkspaceSetting_AccessTokenPolicySetting{AccessTokenPolicySetting: workspaceAccessTokenPolicySetting},
+	})
+	return workspaceAccessTokenPolicySetting, nil
+}
+
+func (s *Store) GetWorkspaceCaptchaSetting(ctx context.Context) (*storepb.WorkspaceCaptchaSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_CAPTCHA.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace captcha setting")
+	}
+
+	workspaceCaptchaSetting := &storepb.WorkspaceCaptchaSetting{}
+	if workspaceSetting != nil {
+		workspaceCaptchaSetting = workspaceSetting.GetCaptchaSetting()
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_CAPTCHA.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_CAPTCHA,
+		Value: &storepb.WorkspaceSetting_CaptchaSetting{CaptchaSetting: workspaceCaptchaSetting},
+	})
+	return workspaceCaptchaSetting, nil
+}
+
+func (s *Store) GetWorkspaceSlackSetting(ctx context.Context) (*storepb.WorkspaceSlackSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_SLACK.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace slack setting")
+	}
+
+	workspaceSlackSetting := &storepb.WorkspaceSlackSetting{}
+	if workspaceSetting != nil {
+		workspaceSlackSetting = workspaceSetting.GetSlackSetting()
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_SLACK.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_SLACK,
+		Value: &storepb.WorkspaceSetting_SlackSetting{SlackSetting: workspaceSlackSetting},
+	})
+	return workspaceSlackSetting, nil
+}
+
+func (s *Store) GetWorkspaceDiscordSetting(ctx context.Context) (*storepb.WorkspaceDiscordSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_DISCORD.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace discord setting")
+	}
+
+	workspaceDiscordSetting := &storepb.WorkspaceDiscordSetting{}
+	if workspaceSetting != nil {
+		workspaceDiscordSetting = workspaceSetting.GetDiscordSetting()
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_DISCORD.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_DISCORD,
+		Value: &storepb.WorkspaceSetting_DiscordSetting{DiscordSetting: workspaceDiscordSetting},
+	})
+	return workspaceDiscordSetting, nil
+}
+
+func (s *Store) GetWorkspaceEmailSetting(ctx context.Context) (*storepb.WorkspaceEmailSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_EMAIL.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace email setting")
+	}
+
+	workspaceEmailSetting := &storepb.WorkspaceEmailSetting{}
+	if workspaceSetting != nil {
+		workspaceEmailSetting = workspaceSetting.GetEmailSetting()
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_EMAIL.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_EMAIL,
+		Value: &storepb.WorkspaceSetting_EmailSetting{EmailSetting: workspaceEmailSetting},
+	})
+	return workspaceEmailSetting, nil
+}
+
+func (s *Store) GetWorkspaceRolesSetting(ctx context.Context) (*storepb.WorkspaceRolesSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSetting(ctx, &FindWorkspaceSetting{
+		Name: storepb.WorkspaceSettingKey_ROLES.String(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace roles setting")
+	}
+
+	workspaceRolesSetting := &storepb.WorkspaceRolesSetting{}
+	if workspaceSetting != nil {
+		workspaceRolesSetting = workspaceSetting.GetRolesSetting()
+	}
+	s.workspaceSettingCache.Set(ctx, storepb.WorkspaceSettingKey_ROLES.String(), &storepb.WorkspaceSetting{
+		Key:   storepb.WorkspaceSettingKey_ROLES,
+		Value: &storepb.WorkspaceSetting_RolesSetting{RolesSetting: workspaceRolesSetting},
+	})
+	return workspaceRolesSetting, nil
+}
+
 func convertWorkspaceSettingFromRaw(workspaceSettingRaw *WorkspaceSetting) (*storepb.WorkspaceSetting, error) {
 	workspaceSetting := &storepb.WorkspaceSetting{
 		Key: storepb.WorkspaceSettingKey(storepb.WorkspaceSettingKey_value[workspaceSettingRaw.Name]),
@@ -237,6 +505,78 @@ func convertWorkspaceSettingFromRaw(workspaceSettingRaw *WorkspaceSetting) (*sto
 			return nil, err
 		}
 		workspaceSetting.Value = &storepb.WorkspaceSetting_MemoRelatedSetting{MemoRelatedSetting: memoRelatedSetting}
+	case storepb.WorkspaceSettingKey_EMBEDDING.String():
+		embeddingSetting := &storepb.WorkspaceEmbeddingSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), embeddingSetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_EmbeddingSetting{EmbeddingSetting: embeddingSetting}
+	case storepb.WorkspaceSettingKey_OCR.String():
+		ocrSetting := &storepb.WorkspaceOCRSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), ocrSetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_OcrSetting{OcrSetting: ocrSetting}
+	case storepb.WorkspaceSettingKey_MALWARE_SCAN.String():
+		malwareScanSetting := &storepb.WorkspaceMalwareScanSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), malwareScanSetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_MalwareScanSetting{MalwareScanSetting: malwareScanSetting}
+	case storepb.WorkspaceSettingKey_TRANSCRIPTION.String():
+		transcriptionSetting := &storepb.WorkspaceTranscriptionSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), transcriptionSetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_TranscriptionSetting{TranscriptionSetting: transcriptionSetting}
+	case storepb.WorkspaceSettingKey_SCIM.String():
+		scimSetting := &storepb.WorkspaceSCIMSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), scimSetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_ScimSetting{ScimSetting: scimSetting}
+	case storepb.WorkspaceSettingKey_PASSWORD_POLICY.String():
+		passwordPolicySetting := &storepb.WorkspacePasswordPolicySetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), passwordPolicySetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_PasswordPolicySetting{PasswordPolicySetting: passwordPolicySetting}
+	case storepb.WorkspaceSettingKey_EMAIL.String():
+		emailSetting := &storepb.WorkspaceEmailSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), emailSetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_EmailSetting{EmailSetting: emailSetting}
+	case storepb.WorkspaceSettingKey_ROLES.String():
+		rolesSetting := &storepb.WorkspaceRolesSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), rolesSetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_RolesSetting{RolesSetting: rolesSetting}
+	case storepb.WorkspaceSettingKey_ACCESS_TOKEN_POLICY.String():
+		accessTokenPolicySetting := &storepb.WorkspaceAccessTokenPolicySetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), accessTokenPolicySetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_AccessTokenPolicySetting{AccessTokenPolicySetting: accessTokenPolicySetting}
+	case storepb.WorkspaceSettingKey_CAPTCHA.String():
+		captchaSetting := &storepb.WorkspaceCaptchaSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), captchaSetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_CaptchaSetting{CaptchaSetting: captchaSetting}
+	case storepb.WorkspaceSettingKey_SLACK.String():
+		slackSetting := &storepb.WorkspaceSlackSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), slackSetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_SlackSetting{SlackSetting: slackSetting}
+	case storepb.WorkspaceSettingKey_DISCORD.String():
+		discordSetting := &storepb.WorkspaceDiscordSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(workspaceSettingRaw.Value), discordSetting); err != nil {
+			return nil, err
+		}
+		workspaceSetting.Value = &storepb.WorkspaceSetting_DiscordSetting{DiscordSetting: discordSetting}
 	default:
 		// Skip unsupported workspace setting key.
 		return nil, nil